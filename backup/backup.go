@@ -0,0 +1,357 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/tstapler/stapler-squad/executor/safeexec"
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// sessionsDBName is the session database; worktree branches are read from it.
+const sessionsDBName = "sessions.db"
+
+// unsafeBundleChars are replaced when a branch name is used in a file name.
+var unsafeBundleChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// Options configures Create.
+type Options struct {
+	// ConfigDir is the stapler-squad config directory (config.GetConfigDir).
+	ConfigDir string
+	// ScrollbackDir holds per-session terminal scrollback. Only read when
+	// IncludeScrollback is set.
+	ScrollbackDir     string
+	IncludeScrollback bool
+	// AppVersion is recorded in the manifest.
+	AppVersion string
+}
+
+// Create writes a backup archive of the state described by opts to w and
+// returns its manifest.
+//
+// Databases are captured with VACUUM INTO, which produces a transactionally
+// consistent copy even while the server is running. Session branches that
+// cannot be bundled (repository moved, branch deleted) are skipped with a
+// warning rather than failing the backup.
+func Create(ctx context.Context, w io.Writer, opts Options) (*Manifest, error) {
+	if opts.ConfigDir == "" {
+		return nil, fmt.Errorf("config directory is required")
+	}
+	staging, err := os.MkdirTemp("", "ssq-backup-*")
+	if err != nil {
+		return nil, fmt.Errorf("create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	hostname, _ := os.Hostname()
+	m := &Manifest{
+		FormatVersion: FormatVersion,
+		CreatedAt:     time.Now().UTC(),
+		AppVersion:    opts.AppVersion,
+		Hostname:      hostname,
+		ConfigDir:     opts.ConfigDir,
+	}
+	if opts.IncludeScrollback {
+		m.ScrollbackDir = opts.ScrollbackDir
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	aw := &archiveWriter{tw: tw, manifest: m}
+
+	if err := aw.addDatabases(ctx, opts.ConfigDir, staging); err != nil {
+		return nil, err
+	}
+	if err := aw.addConfigFiles(opts.ConfigDir); err != nil {
+		return nil, err
+	}
+	if opts.IncludeScrollback && opts.ScrollbackDir != "" {
+		if err := aw.addScrollback(opts.ScrollbackDir); err != nil {
+			return nil, err
+		}
+	}
+	if err := aw.addBundles(ctx, filepath.Join(staging, dbDir, sessionsDBName), staging); err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode manifest: %w", err)
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    ManifestName,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: m.CreatedAt,
+	}); err != nil {
+		return nil, fmt.Errorf("write manifest: %w", err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return nil, fmt.Errorf("write manifest: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("finish archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("finish archive: %w", err)
+	}
+	return m, nil
+}
+
+// archiveWriter appends files to the tar stream and records them in the
+// manifest with their hashes.
+type archiveWriter struct {
+	tw       *tar.Writer
+	manifest *Manifest
+}
+
+// addFile streams src into the archive as name, hashing it on the way.
+func (aw *archiveWriter) addFile(name, src string, kind EntryKind) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", src, err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", src, err)
+	}
+
+	if err := aw.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    int64(info.Mode().Perm()),
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	h := sha256.New()
+	// CopyN pins the entry to the size in the header even if the file grows
+	// while it is being read (e.g. scrollback of a running session).
+	if _, err := io.CopyN(io.MultiWriter(aw.tw, h), f, info.Size()); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	aw.manifest.Entries = append(aw.manifest.Entries, Entry{
+		Path:   name,
+		Kind:   kind,
+		Size:   info.Size(),
+		SHA256: hex.EncodeToString(h.Sum(nil)),
+	})
+	return nil
+}
+
+// addDatabases snapshots every SQLite database in configDir.
+func (aw *archiveWriter) addDatabases(ctx context.Context, dir, staging string) error {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.db"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(matches)
+	if err := os.MkdirAll(filepath.Join(staging, dbDir), 0700); err != nil {
+		return err
+	}
+	for _, src := range matches {
+		name := filepath.Base(src)
+		snapshot := filepath.Join(staging, dbDir, name)
+		if err := snapshotSQLite(ctx, src, snapshot); err != nil {
+			return fmt.Errorf("snapshot %s: %w", name, err)
+		}
+		if err := aw.addFile(path.Join(dbDir, name), snapshot, KindDatabase); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotSQLite writes a consistent copy of the database at src to dst.
+func snapshotSQLite(ctx context.Context, src, dst string) error {
+	db, err := sql.Open("sqlite3", "file:"+src+"?_busy_timeout=5000")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	_, err = db.ExecContext(ctx, "VACUUM INTO '"+strings.ReplaceAll(dst, "'", "''")+"'")
+	return err
+}
+
+// isStateFile reports whether a top-level file in the config directory is
+// configuration worth backing up. Databases are snapshotted separately;
+// their journals, logs and transient files are skipped.
+func isStateFile(name string) bool {
+	for _, suffix := range []string{".db", ".db-wal", ".db-shm", ".db-journal", ".tmp", ".log", ".lock", ".pid", ".sock"} {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	return !strings.HasPrefix(name, ".")
+}
+
+// addConfigFiles archives the top-level regular files in configDir.
+func (aw *archiveWriter) addConfigFiles(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("no stapler-squad state found in %s", dir)
+	}
+	if err != nil {
+		return fmt.Errorf("read config directory: %w", err)
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() || !isStateFile(e.Name()) {
+			continue
+		}
+		if err := aw.addFile(path.Join(configDir, e.Name()), filepath.Join(dir, e.Name()), KindConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addScrollback archives the scrollback directory tree.
+func (aw *archiveWriter) addScrollback(dir string) error {
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		return aw.addFile(path.Join(scrollbackDir, filepath.ToSlash(rel)), p, KindScrollback)
+	})
+	if os.IsNotExist(err) {
+		log.Info("No scrollback to back up", "dir", dir)
+		return nil
+	}
+	return err
+}
+
+// worktreeBranch is a session branch read from the worktrees table.
+type worktreeBranch struct {
+	session    string
+	repoPath   string
+	branch     string
+	baseCommit string
+}
+
+// addBundles bundles the branch of every session worktree recorded in the
+// sessions database snapshot.
+func (aw *archiveWriter) addBundles(ctx context.Context, sessionsDB, staging string) error {
+	if _, err := os.Stat(sessionsDB); err != nil {
+		return nil
+	}
+	branches, err := readWorktreeBranches(ctx, sessionsDB)
+	if err != nil {
+		log.Warn("Skipping branch bundles: cannot read worktrees", "err", err)
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Join(staging, bundleDir), 0700); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, b := range branches {
+		key := b.repoPath + "\x00" + b.branch
+		if b.branch == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		bundle := BranchBundle{Session: b.session, RepoPath: b.repoPath, Branch: b.branch}
+		tip, err := runGit(ctx, b.repoPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+b.branch)
+		if err != nil {
+			log.Warn("Skipping branch bundle", "session", b.session, "repo", b.repoPath, "branch", b.branch, "err", err)
+			continue
+		}
+		if b.baseCommit != "" {
+			if _, err := runGit(ctx, b.repoPath, "merge-base", "--is-ancestor", b.baseCommit, tip); err == nil {
+				bundle.BaseCommit = b.baseCommit
+			}
+		}
+		if bundle.BaseCommit != "" && bundle.BaseCommit == tip {
+			// No commits beyond the base: restore recreates the branch at
+			// BaseCommit without a bundle.
+			aw.manifest.Bundles = append(aw.manifest.Bundles, bundle)
+			continue
+		}
+
+		name := fmt.Sprintf("%03d-%s.bundle", len(aw.manifest.Bundles), unsafeBundleChars.ReplaceAllString(b.branch, "_"))
+		out := filepath.Join(staging, bundleDir, name)
+		args := []string{"bundle", "create", out, "refs/heads/" + b.branch}
+		if bundle.BaseCommit != "" {
+			args = append(args, "^"+bundle.BaseCommit)
+		}
+		if _, err := runGit(ctx, b.repoPath, args...); err != nil {
+			log.Warn("Skipping branch bundle", "session", b.session, "repo", b.repoPath, "branch", b.branch, "err", err)
+			continue
+		}
+		bundle.Path = path.Join(bundleDir, name)
+		if err := aw.addFile(bundle.Path, out, KindBundle); err != nil {
+			return err
+		}
+		aw.manifest.Bundles = append(aw.manifest.Bundles, bundle)
+	}
+	return nil
+}
+
+func readWorktreeBranches(ctx context.Context, dbPath string) ([]worktreeBranch, error) {
+	db, err := sql.Open("sqlite3", "file:"+dbPath+"?mode=ro")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT COALESCE(s.title, w.session_name), w.repo_path, w.branch_name, w.base_commit_sha
+		FROM worktrees w LEFT JOIN sessions s ON w.session_worktree = s.id
+		ORDER BY w.repo_path, w.branch_name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var branches []worktreeBranch
+	for rows.Next() {
+		var b worktreeBranch
+		var base sql.NullString
+		if err := rows.Scan(&b.session, &b.repoPath, &b.branch, &base); err != nil {
+			return nil, err
+		}
+		b.baseCommit = base.String
+		branches = append(branches, b)
+	}
+	return branches, rows.Err()
+}
+
+// runGit runs git in dir and returns its trimmed stdout.
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := safeexec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %w: %s", args[0], err, msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
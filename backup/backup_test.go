@@ -0,0 +1,217 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+// setupState creates a config directory with a sessions database whose single
+// worktree points at a session branch with one commit beyond its base.
+func setupState(t *testing.T) (configDir, repo string) {
+	t.Helper()
+	configDir = t.TempDir()
+	repo = t.TempDir()
+
+	git(t, repo, "init", "-q", "-b", "main")
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "README"), []byte("base\n"), 0644))
+	git(t, repo, "add", ".")
+	git(t, repo, "commit", "-q", "-m", "base")
+	base := git(t, repo, "rev-parse", "HEAD")
+	git(t, repo, "checkout", "-q", "-b", "squad/feature")
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "README"), []byte("feature\n"), 0644))
+	git(t, repo, "commit", "-q", "-am", "feature work")
+	git(t, repo, "checkout", "-q", "main")
+
+	db, err := sql.Open("sqlite3", filepath.Join(configDir, sessionsDBName))
+	require.NoError(t, err)
+	defer db.Close()
+	for _, stmt := range []string{
+		`PRAGMA journal_mode=WAL`,
+		`CREATE TABLE sessions (id INTEGER PRIMARY KEY, title TEXT)`,
+		`CREATE TABLE worktrees (id INTEGER PRIMARY KEY, repo_path TEXT, worktree_path TEXT,
+			session_name TEXT, branch_name TEXT, base_commit_sha TEXT, session_worktree INTEGER)`,
+		`INSERT INTO sessions (id, title) VALUES (1, 'feature')`,
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err)
+	}
+	_, err = db.Exec(`INSERT INTO worktrees (repo_path, worktree_path, session_name, branch_name, base_commit_sha, session_worktree)
+		VALUES (?, '', 'feature', 'squad/feature', ?, 1)`, repo, base)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.json"), []byte(`{"auto_yes": true}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "server.log"), []byte("noise"), 0600))
+	return configDir, repo
+}
+
+func TestCreateRestore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	configDir, repo := setupState(t)
+	scrollback := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(scrollback, "feature"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(scrollback, "feature", "scrollback.log"), []byte("output"), 0600))
+
+	var archive bytes.Buffer
+	m, err := Create(ctx, &archive, Options{ConfigDir: configDir, ScrollbackDir: scrollback, IncludeScrollback: true, AppVersion: "test"})
+	require.NoError(t, err)
+
+	var paths []string
+	for _, e := range m.Entries {
+		paths = append(paths, e.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		"db/sessions.db", "config/config.json", "scrollback/feature/scrollback.log", "bundles/000-squad_feature.bundle",
+	}, paths)
+	require.Len(t, m.Bundles, 1)
+	assert.Equal(t, "feature", m.Bundles[0].Session)
+	assert.NotEmpty(t, m.Bundles[0].BaseCommit)
+
+	// Restore onto a "new machine": the branch is gone and the state
+	// directories are empty.
+	tip := git(t, repo, "rev-parse", "squad/feature")
+	git(t, repo, "branch", "-q", "-D", "squad/feature")
+	target := t.TempDir()
+	targetScrollback := filepath.Join(t.TempDir(), "sessions")
+
+	restoreArchive := func(dryRun bool) *RestoreReport {
+		report, err := Restore(ctx, bytes.NewReader(archive.Bytes()), RestoreOptions{
+			ConfigDir: target, ScrollbackDir: targetScrollback, DryRun: dryRun,
+		})
+		require.NoError(t, err)
+		return report
+	}
+
+	report := restoreArchive(true)
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Files, 4)
+	require.Len(t, report.Branches, 1)
+	assert.Equal(t, BranchCreate, report.Branches[0].Action)
+	_, err = os.Stat(filepath.Join(target, sessionsDBName))
+	assert.True(t, os.IsNotExist(err), "dry run must not write")
+
+	report = restoreArchive(false)
+	require.Len(t, report.Branches, 1)
+	assert.Equal(t, BranchCreate, report.Branches[0].Action, "%v", report.Branches[0].Err)
+	assert.Equal(t, tip, git(t, repo, "rev-parse", "squad/feature"))
+
+	db, err := sql.Open("sqlite3", filepath.Join(target, sessionsDBName))
+	require.NoError(t, err)
+	defer db.Close()
+	var title string
+	require.NoError(t, db.QueryRow(`SELECT title FROM sessions WHERE id = 1`).Scan(&title))
+	assert.Equal(t, "feature", title)
+
+	data, err := os.ReadFile(filepath.Join(target, "config.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"auto_yes": true}`, string(data))
+	data, err = os.ReadFile(filepath.Join(targetScrollback, "feature", "scrollback.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "output", string(data))
+
+	// Restoring again requires --force and moves the existing state aside.
+	_, err = Restore(ctx, bytes.NewReader(archive.Bytes()), RestoreOptions{ConfigDir: target, ScrollbackDir: targetScrollback})
+	assert.True(t, errors.Is(err, ErrStateExists))
+	report, err = Restore(ctx, bytes.NewReader(archive.Bytes()), RestoreOptions{ConfigDir: target, ScrollbackDir: targetScrollback, Force: true})
+	require.NoError(t, err)
+	assert.NotEmpty(t, report.MovedAsideTo)
+	assert.FileExists(t, filepath.Join(report.MovedAsideTo, "db", sessionsDBName))
+	assert.Equal(t, BranchExists, report.Branches[0].Action)
+}
+
+func TestRestore_RejectsTamperedArchive(t *testing.T) {
+	configDir, _ := setupState(t)
+	var archive bytes.Buffer
+	_, err := Create(context.Background(), &archive, Options{ConfigDir: configDir})
+	require.NoError(t, err)
+
+	tampered := rewriteArchive(t, archive.Bytes(), func(name string, data []byte) []byte {
+		if name == "config/config.json" {
+			return []byte(`{"auto_yes": false}`)
+		}
+		return data
+	})
+	target := t.TempDir()
+	_, err = Restore(context.Background(), bytes.NewReader(tampered), RestoreOptions{ConfigDir: target})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "integrity check failed for config/config.json")
+	entries, err := os.ReadDir(target)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "nothing is written when verification fails")
+}
+
+func TestRestore_RejectsNewerFormatVersion(t *testing.T) {
+	configDir, _ := setupState(t)
+	var archive bytes.Buffer
+	_, err := Create(context.Background(), &archive, Options{ConfigDir: configDir})
+	require.NoError(t, err)
+
+	future := rewriteArchive(t, archive.Bytes(), func(name string, data []byte) []byte {
+		if name == ManifestName {
+			return bytes.Replace(data, []byte(`"format_version": 1`), []byte(`"format_version": 99`), 1)
+		}
+		return data
+	})
+	_, err = Restore(context.Background(), bytes.NewReader(future), RestoreOptions{ConfigDir: t.TempDir(), DryRun: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported backup format version 99")
+}
+
+func TestCheckArchivePath(t *testing.T) {
+	assert.NoError(t, checkArchivePath("db/sessions.db"))
+	for _, bad := range []string{"", "/etc/passwd", "../escape", "db/../../escape", "db//x", `db\x`} {
+		assert.Error(t, checkArchivePath(bad), bad)
+	}
+}
+
+// rewriteArchive rebuilds a tar.gz archive, passing every entry through fn.
+func rewriteArchive(t *testing.T, archive []byte, fn func(name string, data []byte) []byte) []byte {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var out bytes.Buffer
+	gw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		data = fn(hdr.Name, data)
+		hdr.Size = int64(len(data))
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return out.Bytes()
+}
@@ -0,0 +1,117 @@
+// Package backup writes and restores portable archives of the complete
+// stapler-squad state: SQLite databases, config files, optional terminal
+// scrollback, and the session branches of every worktree as git bundles.
+//
+// An archive is a gzip-compressed tar file. Every payload entry is listed in
+// manifest.json, the final entry, together with its size and SHA-256 so that
+// restore can verify the whole archive before it touches disk.
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatVersion is the archive layout version written by Create. Restore
+	// rejects archives with a newer version.
+	FormatVersion = 1
+
+	// ManifestName is the archive entry holding the Manifest.
+	ManifestName = "manifest.json"
+)
+
+// Archive directories for each kind of entry.
+const (
+	dbDir         = "db"
+	configDir     = "config"
+	scrollbackDir = "scrollback"
+	bundleDir     = "bundles"
+)
+
+// EntryKind classifies an archive entry.
+type EntryKind string
+
+const (
+	KindDatabase   EntryKind = "database"
+	KindConfig     EntryKind = "config"
+	KindScrollback EntryKind = "scrollback"
+	KindBundle     EntryKind = "bundle"
+)
+
+// Manifest describes the contents of a backup archive.
+type Manifest struct {
+	FormatVersion int       `json:"format_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	AppVersion    string    `json:"app_version,omitempty"`
+	Hostname      string    `json:"hostname,omitempty"`
+	// ConfigDir and ScrollbackDir record where the state lived on the source
+	// machine. They are informational; restore writes to the target's paths.
+	ConfigDir     string         `json:"config_dir"`
+	ScrollbackDir string         `json:"scrollback_dir,omitempty"`
+	Entries       []Entry        `json:"entries"`
+	Bundles       []BranchBundle `json:"bundles,omitempty"`
+}
+
+// Entry is a single file in the archive.
+type Entry struct {
+	Path   string    `json:"path"`
+	Kind   EntryKind `json:"kind"`
+	Size   int64     `json:"size"`
+	SHA256 string    `json:"sha256"`
+}
+
+// BranchBundle records the git bundle holding one session's branch.
+type BranchBundle struct {
+	Session  string `json:"session"`
+	RepoPath string `json:"repo_path"`
+	Branch   string `json:"branch"`
+	// BaseCommit is the bundle's prerequisite: the commit the session branched
+	// from. Empty when the bundle contains the branch's full history.
+	BaseCommit string `json:"base_commit,omitempty"`
+	// Path is the bundle's archive entry.
+	Path string `json:"path"`
+}
+
+// entry returns the manifest entry for the given archive path.
+func (m *Manifest) entry(name string) (Entry, bool) {
+	for _, e := range m.Entries {
+		if e.Path == name {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// decodeManifest parses and sanity-checks a manifest.
+func decodeManifest(data []byte) (*Manifest, error) {
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", ManifestName, err)
+	}
+	if m.FormatVersion < 1 || m.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d (this build supports up to %d)",
+			m.FormatVersion, FormatVersion)
+	}
+	for _, e := range m.Entries {
+		if err := checkArchivePath(e.Path); err != nil {
+			return nil, err
+		}
+	}
+	return &m, nil
+}
+
+// checkArchivePath rejects entry names that would escape the extraction
+// directory.
+func checkArchivePath(name string) error {
+	if name == "" || path.IsAbs(name) || strings.Contains(name, "\\") {
+		return fmt.Errorf("invalid archive path %q", name)
+	}
+	if clean := path.Clean(name); clean != name || clean == ".." || strings.HasPrefix(clean, "../") {
+		return fmt.Errorf("invalid archive path %q", name)
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxManifestSize bounds how much of the manifest entry is read into memory.
+const maxManifestSize = 64 << 20
+
+// restoredBundlesDir keeps the restored bundles under the config directory so
+// branches that could not be fetched can be recovered by hand.
+const restoredBundlesDir = "restored-bundles"
+
+// ErrStateExists is returned by Restore when the target already holds
+// stapler-squad state and RestoreOptions.Force is not set.
+var ErrStateExists = errors.New("existing state would be overwritten")
+
+// RestoreOptions configures Restore.
+type RestoreOptions struct {
+	// ConfigDir and ScrollbackDir are the restore targets on this machine.
+	ConfigDir     string
+	ScrollbackDir string
+	// DryRun verifies the archive and reports what would be restored without
+	// writing anything.
+	DryRun bool
+	// Force allows replacing existing state. Replaced files are moved to a
+	// pre-restore-<timestamp> directory inside ConfigDir, not deleted.
+	Force bool
+}
+
+// FileAction is a file restore writes (or would write, in a dry run).
+type FileAction struct {
+	Entry Entry
+	Dest  string
+	// Replaces is set when Dest already exists.
+	Replaces bool
+}
+
+// Branch restore outcomes reported in BranchAction.Action.
+const (
+	BranchCreate      = "create"       // branch will be (or was) created
+	BranchExists      = "exists"       // repository already has the branch; left untouched
+	BranchRepoMissing = "repo-missing" // repository not found on this machine
+	BranchFailed      = "failed"       // fetch or branch creation failed; see Err
+)
+
+// BranchAction is the outcome of restoring one session branch.
+type BranchAction struct {
+	Bundle BranchBundle
+	Action string
+	Err    error
+}
+
+// RestoreReport describes what Restore did, or would do in a dry run.
+type RestoreReport struct {
+	Manifest *Manifest
+	DryRun   bool
+	Files    []FileAction
+	Branches []BranchAction
+	// MovedAsideTo is the directory replaced files were moved to; empty when
+	// nothing was replaced.
+	MovedAsideTo string
+}
+
+// Restore verifies the archive read from r against its manifest and restores
+// it into the directories in opts. Nothing is written unless every entry's
+// size and SHA-256 match. Session branches are fetched from their bundles into
+// repositories that exist on this machine; branches that already exist are
+// never overwritten.
+//
+// Restore must not run while the server is using the same config directory.
+func Restore(ctx context.Context, r io.Reader, opts RestoreOptions) (*RestoreReport, error) {
+	if opts.ConfigDir == "" {
+		return nil, fmt.Errorf("config directory is required")
+	}
+	staging, err := os.MkdirTemp("", "ssq-restore-*")
+	if err != nil {
+		return nil, fmt.Errorf("create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	m, err := extract(r, staging)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RestoreReport{Manifest: m, DryRun: opts.DryRun}
+	for _, e := range m.Entries {
+		dest, err := destination(e, opts)
+		if err != nil {
+			return nil, err
+		}
+		_, statErr := os.Stat(dest)
+		report.Files = append(report.Files, FileAction{Entry: e, Dest: dest, Replaces: statErr == nil})
+	}
+	for _, b := range m.Bundles {
+		report.Branches = append(report.Branches, planBranch(ctx, b))
+	}
+	if opts.DryRun {
+		return report, nil
+	}
+
+	var replaced []FileAction
+	for _, f := range report.Files {
+		if f.Replaces || f.Entry.Kind == KindDatabase {
+			replaced = append(replaced, f)
+		}
+	}
+	if hasExisting(replaced) && !opts.Force {
+		return report, fmt.Errorf("%w in %s; re-run with --force to move it aside", ErrStateExists, opts.ConfigDir)
+	}
+	if err := moveAside(report, replaced, opts); err != nil {
+		return report, err
+	}
+
+	for _, f := range report.Files {
+		if err := copyFile(filepath.Join(staging, filepath.FromSlash(f.Entry.Path)), f.Dest); err != nil {
+			return report, fmt.Errorf("restore %s: %w", f.Entry.Path, err)
+		}
+	}
+	for i := range report.Branches {
+		restoreBranch(ctx, &report.Branches[i], opts.ConfigDir)
+	}
+	return report, nil
+}
+
+// extract unpacks the archive into dir and verifies every entry against the
+// manifest.
+func extract(r io.Reader, dir string) (*Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	type digest struct {
+		size int64
+		sum  string
+	}
+	digests := make(map[string]digest)
+	var manifestData []byte
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read archive: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("unexpected archive entry %q of type %c", hdr.Name, hdr.Typeflag)
+		}
+		if hdr.Name == ManifestName {
+			if manifestData, err = io.ReadAll(io.LimitReader(tr, maxManifestSize)); err != nil {
+				return nil, fmt.Errorf("read %s: %w", ManifestName, err)
+			}
+			continue
+		}
+		if err := checkArchivePath(hdr.Name); err != nil {
+			return nil, err
+		}
+		size, sum, err := extractFile(tr, filepath.Join(dir, filepath.FromSlash(hdr.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("extract %s: %w", hdr.Name, err)
+		}
+		digests[hdr.Name] = digest{size: size, sum: sum}
+	}
+
+	if manifestData == nil {
+		return nil, fmt.Errorf("not a stapler-squad backup: %s missing", ManifestName)
+	}
+	m, err := decodeManifest(manifestData)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range m.Entries {
+		d, ok := digests[e.Path]
+		if !ok {
+			return nil, fmt.Errorf("archive is missing %s", e.Path)
+		}
+		if d.size != e.Size || d.sum != e.SHA256 {
+			return nil, fmt.Errorf("integrity check failed for %s: archive is corrupt or was modified", e.Path)
+		}
+		delete(digests, e.Path)
+	}
+	if len(digests) > 0 {
+		unlisted := make([]string, 0, len(digests))
+		for name := range digests {
+			unlisted = append(unlisted, name)
+		}
+		sort.Strings(unlisted)
+		return nil, fmt.Errorf("archive entries not listed in the manifest: %s", strings.Join(unlisted, ", "))
+	}
+	for _, b := range m.Bundles {
+		if b.Path == "" {
+			continue
+		}
+		if e, ok := m.entry(b.Path); !ok || e.Kind != KindBundle {
+			return nil, fmt.Errorf("manifest bundle %s for branch %s is not a bundle entry", b.Path, b.Branch)
+		}
+	}
+	return m, nil
+}
+
+func extractFile(r io.Reader, dest string) (int64, string, error) {
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return 0, "", err
+	}
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), f.Close()
+}
+
+// destination maps an archive entry to its restore location.
+func destination(e Entry, opts RestoreOptions) (string, error) {
+	dir, rel, ok := strings.Cut(e.Path, "/")
+	switch {
+	case !ok || rel == "":
+		// Reported as unexpected below.
+	case e.Kind == KindDatabase && dir == dbDir && !strings.Contains(rel, "/"):
+		return filepath.Join(opts.ConfigDir, rel), nil
+	case e.Kind == KindConfig && dir == configDir && !strings.Contains(rel, "/"):
+		return filepath.Join(opts.ConfigDir, rel), nil
+	case e.Kind == KindScrollback && dir == scrollbackDir:
+		if opts.ScrollbackDir == "" {
+			return "", fmt.Errorf("archive contains scrollback but no scrollback directory was given")
+		}
+		return filepath.Join(opts.ScrollbackDir, filepath.FromSlash(rel)), nil
+	case e.Kind == KindBundle && dir == bundleDir && !strings.Contains(rel, "/"):
+		return filepath.Join(opts.ConfigDir, restoredBundlesDir, rel), nil
+	}
+	return "", fmt.Errorf("unexpected %s entry %s", e.Kind, e.Path)
+}
+
+// hasExisting reports whether any of the files already exist on disk.
+func hasExisting(files []FileAction) bool {
+	for _, f := range files {
+		if f.Replaces {
+			return true
+		}
+		if f.Entry.Kind == KindDatabase {
+			if _, err := os.Stat(f.Dest + "-wal"); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// moveAside moves files that restore will replace into a timestamped
+// directory. For databases the WAL and shared-memory files are moved too: a
+// stale WAL next to a restored database would be replayed into it.
+func moveAside(report *RestoreReport, files []FileAction, opts RestoreOptions) error {
+	aside := filepath.Join(opts.ConfigDir, "pre-restore-"+time.Now().Format("20060102-150405"))
+	for _, f := range files {
+		paths := []string{f.Dest}
+		if f.Entry.Kind == KindDatabase {
+			paths = append(paths, f.Dest+"-wal", f.Dest+"-shm", f.Dest+"-journal")
+		}
+		for _, p := range paths {
+			if _, err := os.Lstat(p); err != nil {
+				continue
+			}
+			target := filepath.Join(aside, filepath.FromSlash(path.Dir(f.Entry.Path)), filepath.Base(p))
+			if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+				return err
+			}
+			if err := os.Rename(p, target); err != nil {
+				return fmt.Errorf("move aside %s: %w", p, err)
+			}
+			report.MovedAsideTo = aside
+		}
+	}
+	return nil
+}
+
+// copyFile copies src to dest via a temporary file so dest is never left
+// half-written.
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dest), "."+filepath.Base(dest)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, in); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dest)
+}
+
+// planBranch decides how a session branch will be restored.
+func planBranch(ctx context.Context, b BranchBundle) BranchAction {
+	if _, err := runGit(ctx, b.RepoPath, "rev-parse", "--git-dir"); err != nil {
+		return BranchAction{Bundle: b, Action: BranchRepoMissing}
+	}
+	if _, err := runGit(ctx, b.RepoPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+b.Branch); err == nil {
+		return BranchAction{Bundle: b, Action: BranchExists}
+	}
+	return BranchAction{Bundle: b, Action: BranchCreate}
+}
+
+// restoreBranch creates a planned branch from its bundle, or at its base
+// commit when the branch had no commits of its own.
+func restoreBranch(ctx context.Context, a *BranchAction, configDir string) {
+	if a.Action != BranchCreate {
+		return
+	}
+	b := a.Bundle
+	var err error
+	if b.Path == "" {
+		_, err = runGit(ctx, b.RepoPath, "branch", b.Branch, b.BaseCommit)
+	} else {
+		bundle := filepath.Join(configDir, restoredBundlesDir, path.Base(b.Path))
+		ref := "refs/heads/" + b.Branch
+		_, err = runGit(ctx, b.RepoPath, "fetch", "--no-tags", bundle, ref+":"+ref)
+	}
+	if err != nil {
+		a.Action = BranchFailed
+		a.Err = err
+	}
+}
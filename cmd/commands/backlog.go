@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+)
+
+// NewBacklogCmd returns the "backlog" command group for adding backlog items
+// and spawning sessions to work on them.
+func NewBacklogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backlog",
+		Short: "Add backlog items and spawn sessions from them",
+	}
+	cmd.AddCommand(newBacklogAddCmd(), newBacklogSpawnCmd())
+	return cmd
+}
+
+func newBacklogAddCmd() *cobra.Command {
+	var description, repo, notes string
+	var priority int32
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:          "add <title>",
+		Short:        "Add an item to the backlog",
+		Long:         "Add an item to the backlog and print its ID. --repo defaults to the current directory.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				wd, err := os.Getwd()
+				if err != nil {
+					return fmt.Errorf("could not determine current directory: %w", err)
+				}
+				repo = wd
+			}
+			resp, err := newBacklogClient().CreateBacklogItem(context.Background(),
+				connect.NewRequest(&sessionv1.CreateBacklogItemRequest{
+					Title:       args[0],
+					Description: description,
+					Priority:    priority,
+					RepoPath:    repo,
+					Notes:       notes,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not add backlog item: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			fmt.Println(resp.Msg.Item.Id)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&description, "description", "", "What the item is about")
+	cmd.Flags().Int32Var(&priority, "priority", 0, "Priority; lower numbers are more urgent (server default: 3)")
+	cmd.Flags().StringVar(&repo, "repo", "", "Repository the work happens in (default: current directory)")
+	cmd.Flags().StringVar(&notes, "notes", "", "Free-form notes")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+func newBacklogSpawnCmd() *cobra.Command {
+	var template string
+	var vars []string
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:   "spawn <item-id>",
+		Short: "Start a session working on a backlog item",
+		Long: "Start a session working on a backlog item. With --template the session is created from " +
+			"a template whose prompt is followed by the item's context.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeBacklogItems,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			variables, err := parseVars(vars)
+			if err != nil {
+				return err
+			}
+			resp, err := newBacklogClient().SpawnSessionFromItem(context.Background(),
+				connect.NewRequest(&sessionv1.SpawnSessionFromItemRequest{
+					ItemId:            args[0],
+					Template:          template,
+					TemplateVariables: variables,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not spawn session: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			fmt.Println(resp.Msg.SessionUuid)
+			return nil
+		},
+	}
+	addTemplateFlags(cmd, &template, &vars)
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// completeBacklogItems completes backlog item IDs, described by title.
+func completeBacklogItems(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	resp, err := newBacklogClient().ListBacklogItems(context.Background(),
+		connect.NewRequest(&sessionv1.ListBacklogItemsRequest{}))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var ids []string
+	for _, item := range resp.Msg.Items {
+		if strings.HasPrefix(item.Id, toComplete) {
+			ids = append(ids, item.Id+"\t"+item.Title)
+		}
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
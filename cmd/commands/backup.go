@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tstapler/stapler-squad/backup"
+	"github.com/tstapler/stapler-squad/config"
+)
+
+// scrollbackDir is where the server stores per-session scrollback.
+func scrollbackDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".stapler-squad", "sessions"), nil
+}
+
+// NewBackupCmd returns the "backup" command. version is recorded in the
+// archive manifest.
+func NewBackupCmd(version string) *cobra.Command {
+	var includeScrollback bool
+	cmd := &cobra.Command{
+		Use:   "backup [file]",
+		Short: "Write a backup archive of sessions, config and session branches",
+		Long: "Write a single versioned archive containing a consistent snapshot of the session databases, " +
+			"the config files, the branch of every session worktree as a git bundle and, with " +
+			"--include-scrollback, terminal scrollback. Safe to run while the server is running.\n\n" +
+			"The default file name is stapler-squad-backup-<timestamp>.tar.gz in the current directory.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configDir, err := config.GetConfigDir()
+			if err != nil {
+				return fmt.Errorf("failed to get config directory: %w", err)
+			}
+			sbDir, err := scrollbackDir()
+			if err != nil {
+				return err
+			}
+			out := fmt.Sprintf("stapler-squad-backup-%s.tar.gz", time.Now().Format("20060102-150405"))
+			if len(args) == 1 {
+				out = args[0]
+			}
+
+			// Written to a temp file first so a failed backup never leaves a
+			// truncated archive behind under the final name.
+			f, err := os.CreateTemp(filepath.Dir(out), ".ssq-backup-*.tmp")
+			if err != nil {
+				return fmt.Errorf("failed to create backup file: %w", err)
+			}
+			defer os.Remove(f.Name())
+			m, err := backup.Create(cmd.Context(), f, backup.Options{
+				ConfigDir:         configDir,
+				ScrollbackDir:     sbDir,
+				IncludeScrollback: includeScrollback,
+				AppVersion:        version,
+			})
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return fmt.Errorf("backup failed: %w", err)
+			}
+			if err := os.Rename(f.Name(), out); err != nil {
+				return fmt.Errorf("failed to write backup file: %w", err)
+			}
+
+			counts := make(map[backup.EntryKind]int)
+			for _, e := range m.Entries {
+				counts[e.Kind]++
+			}
+			fmt.Printf("Wrote %s\n", out)
+			fmt.Printf("  databases:  %d\n", counts[backup.KindDatabase])
+			fmt.Printf("  config:     %d files\n", counts[backup.KindConfig])
+			if includeScrollback {
+				fmt.Printf("  scrollback: %d files\n", counts[backup.KindScrollback])
+			}
+			fmt.Printf("  branches:   %d\n", len(m.Bundles))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&includeScrollback, "include-scrollback", false, "Include terminal scrollback (can be large)")
+	return cmd
+}
+
+// NewRestoreCmd returns the "restore" command.
+func NewRestoreCmd() *cobra.Command {
+	var dryRun, force bool
+	cmd := &cobra.Command{
+		Use:   "restore <file>",
+		Short: "Restore a backup archive written by 'backup'",
+		Long: "Verify a backup archive against its manifest and restore it into this machine's config " +
+			"directory. Session branches are fetched from their bundles into repositories that exist " +
+			"locally; existing branches are never overwritten.\n\n" +
+			"Stop the server before restoring. Existing state is only replaced with --force, and is " +
+			"moved to a pre-restore-<timestamp> directory rather than deleted.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configDir, err := config.GetConfigDir()
+			if err != nil {
+				return fmt.Errorf("failed to get config directory: %w", err)
+			}
+			sbDir, err := scrollbackDir()
+			if err != nil {
+				return err
+			}
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open backup: %w", err)
+			}
+			defer f.Close()
+
+			report, err := backup.Restore(cmd.Context(), f, backup.RestoreOptions{
+				ConfigDir:     configDir,
+				ScrollbackDir: sbDir,
+				DryRun:        dryRun,
+				Force:         force,
+			})
+			if report != nil {
+				printRestoreReport(report)
+			}
+			if err != nil {
+				return fmt.Errorf("restore failed: %w", err)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Verify the archive and show what would be restored without writing anything")
+	cmd.Flags().BoolVar(&force, "force", false, "Replace existing state (moved aside, not deleted)")
+	return cmd
+}
+
+func printRestoreReport(report *backup.RestoreReport) {
+	m := report.Manifest
+	fmt.Printf("Backup from %s, created %s (format v%d, version %s)\n",
+		m.Hostname, m.CreatedAt.Local().Format(time.RFC1123), m.FormatVersion, m.AppVersion)
+	fmt.Println("Integrity: all entries verified")
+	if report.DryRun {
+		fmt.Println("\nDry run: nothing will be written.")
+	}
+
+	fmt.Println("\nFiles:")
+	for _, f := range report.Files {
+		verb := "create "
+		if f.Replaces {
+			verb = "replace"
+		}
+		fmt.Printf("  %s %s\n", verb, f.Dest)
+	}
+	if report.MovedAsideTo != "" {
+		fmt.Printf("\nPrevious state moved to %s\n", report.MovedAsideTo)
+	}
+
+	if len(report.Branches) > 0 {
+		fmt.Println("\nBranches:")
+	}
+	for _, b := range report.Branches {
+		line := fmt.Sprintf("  %-12s %s in %s (session %q)", b.Action, b.Bundle.Branch, b.Bundle.RepoPath, b.Bundle.Session)
+		if b.Err != nil {
+			line += ": " + b.Err.Error()
+		}
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/gen/proto/go/session/v1/sessionv1connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// serverURL returns the base URL of the local server, resolving its address
+// the way the server does: config listen_address, then the PORT override.
+func serverURL() string {
+	address := config.LoadConfig().ListenAddress
+	if address == "" {
+		address = "localhost:8543"
+	}
+	if port := os.Getenv("PORT"); port != "" {
+		address = "localhost:" + port
+	}
+	return "http://" + address
+}
+
+// apiURL returns the base URL the server mounts its ConnectRPC services under.
+func apiURL() string {
+	return serverURL() + "/api"
+}
+
+// newSessionClient returns a SessionService client for the local server.
+func newSessionClient() sessionv1connect.SessionServiceClient {
+	return sessionv1connect.NewSessionServiceClient(http.DefaultClient, apiURL())
+}
+
+// newBacklogClient returns a BacklogService client for the local server.
+func newBacklogClient() sessionv1connect.BacklogServiceClient {
+	return sessionv1connect.NewBacklogServiceClient(http.DefaultClient, apiURL())
+}
+
+// addJSONFlag registers the --json flag every client command accepts.
+func addJSONFlag(cmd *cobra.Command, asJSON *bool) {
+	cmd.Flags().BoolVar(asJSON, "json", false, "Print the response as JSON")
+}
+
+// printJSON writes msg to stdout as indented protobuf JSON, using the proto
+// field names so scripts see the same keys as the API documentation.
+func printJSON(msg proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode response: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// completeSessions completes the first argument with the titles of the
+// server's sessions. It completes nothing when the server is unreachable.
+func completeSessions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	resp, err := newSessionClient().ListSessions(context.Background(), connect.NewRequest(&sessionv1.ListSessionsRequest{}))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var titles []string
+	for _, s := range resp.Msg.Sessions {
+		if strings.HasPrefix(s.Title, toComplete) {
+			titles = append(titles, s.Title)
+		}
+	}
+	return titles, cobra.ShellCompDirectiveNoFileComp
+}
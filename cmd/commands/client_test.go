@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+)
+
+// TestNewSessionClient_TargetsAPIPrefix checks that CLI clients call the
+// ConnectRPC services under /api, where the server mounts them.
+func TestNewSessionClient_TargetsAPIPrefix(t *testing.T) {
+	paths := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+		http.Error(w, "not implemented", http.StatusNotImplemented)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	t.Setenv("STAPLER_SQUAD_TEST_DIR", t.TempDir())
+	t.Setenv("PORT", u.Port())
+
+	_, err = newSessionClient().ExportRecording(context.Background(),
+		connect.NewRequest(&sessionv1.ExportRecordingRequest{SessionId: "s"}))
+	require.Error(t, err)
+	assert.Equal(t, "/api/session.v1.SessionService/ExportRecording", <-paths)
+}
@@ -4,23 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
-	"github.com/tstapler/stapler-squad/gen/proto/go/session/v1/sessionv1connect"
 )
 
 var GetSessionCmd = &cobra.Command{
-	Use:   "get-session [name]",
-	Short: "Get information about a session",
-	Args:  cobra.ExactArgs(1),
+	Use:               "get-session [name]",
+	Short:             "Get information about a session",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSessions,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client := sessionv1connect.NewSessionServiceClient(
-			http.DefaultClient,
-			"http://localhost:8080", // Assuming the server runs on localhost:8080
-		)
+		client := newSessionClient()
 		req := &connect.Request[sessionv1.GetSessionRequest]{
 			Msg: &sessionv1.GetSessionRequest{
 				Id: args[0],
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+)
+
+// NewRecordCmd returns the "record" command, which turns terminal recording
+// on or off for a session.
+func NewRecordCmd() *cobra.Command {
+	var off, input bool
+	cmd := &cobra.Command{
+		Use:   "record <session>",
+		Short: "Start or stop recording a session's terminal",
+		Long: "Opt a session in to terminal recording. While the session runs, its terminal output and " +
+			"size changes are recorded with secrets redacted; with --input, input sent to the terminal is " +
+			"recorded too, redacted line by line. Use export-cast to export the recording.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := newSessionClient().SetSessionRecording(context.Background(),
+				connect.NewRequest(&sessionv1.SetSessionRecordingRequest{
+					SessionId:    args[0],
+					Enabled:      !off,
+					IncludeInput: input,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not set recording: %w", err)
+			}
+			switch s := resp.Msg.Settings; {
+			case !s.Enabled:
+				fmt.Printf("Recording stopped for %s\n", args[0])
+			case s.IncludeInput:
+				fmt.Printf("Recording %s (output and input)\n", args[0])
+			default:
+				fmt.Printf("Recording %s\n", args[0])
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&off, "off", false, "Stop recording")
+	cmd.Flags().BoolVar(&input, "input", false, "Also record input sent to the terminal")
+	return cmd
+}
+
+// NewExportCastCmd returns the "export-cast" command, which exports a
+// session's recording as an asciicast v2 file.
+func NewExportCastCmd() *cobra.Command {
+	var (
+		from, to, output string
+		input            bool
+		idleLimit        float64
+	)
+	cmd := &cobra.Command{
+		Use:   "export-cast <session>",
+		Short: "Export a session's terminal recording as an asciicast file",
+		Long: "Export a session's terminal recording as an asciicast v2 file that asciinema and its web " +
+			"player can play. --from and --to take a checkpoint ID or label to export only the range " +
+			"between them; checkpoints become markers in the recording.\n\n" +
+			"The default file name is <session>.cast in the current directory; use -o - for stdout.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := newSessionClient().ExportRecording(context.Background(),
+				connect.NewRequest(&sessionv1.ExportRecordingRequest{
+					SessionId:      args[0],
+					FromCheckpoint: from,
+					ToCheckpoint:   to,
+					IncludeInput:   input,
+					IdleTimeLimit:  idleLimit,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not export recording: %w", err)
+			}
+
+			if output == "-" {
+				_, err := os.Stdout.Write(resp.Msg.Cast)
+				return err
+			}
+			if output == "" {
+				output = strings.ReplaceAll(args[0], "/", "_") + ".cast"
+			}
+			if err := os.WriteFile(output, resp.Msg.Cast, 0o600); err != nil {
+				return fmt.Errorf("could not write %s: %w", output, err)
+			}
+			fmt.Fprintf(os.Stderr, "Wrote %s (%d events, %.1fs)\n", output, resp.Msg.EventCount, resp.Msg.DurationSeconds)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&from, "from", "", "Start at this checkpoint (ID or label)")
+	cmd.Flags().StringVar(&to, "to", "", "End at this checkpoint (ID or label)")
+	cmd.Flags().BoolVar(&input, "input", false, "Include recorded input events")
+	cmd.Flags().Float64Var(&idleLimit, "idle-limit", 0, "Cap pauses during playback at this many seconds")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file, or - for stdout")
+	return cmd
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// NewApproveCmd returns the "approve" command, which allows a pending tool
+// approval.
+func NewApproveCmd() *cobra.Command {
+	return newResolveApprovalCmd("approve", "allow", "Allow a pending tool approval")
+}
+
+// NewDenyCmd returns the "deny" command, which denies a pending tool approval.
+func NewDenyCmd() *cobra.Command {
+	return newResolveApprovalCmd("deny", "deny", "Deny a pending tool approval")
+}
+
+func newResolveApprovalCmd(use, decision, short string) *cobra.Command {
+	var reason string
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:               use + " <approval-id>",
+		Short:             short,
+		Long:              short + ". Run \"queue\" to see pending approvals and their IDs.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeApprovals,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &sessionv1.ResolveApprovalRequest{ApprovalId: args[0], Decision: decision}
+			if reason != "" {
+				req.Message = &reason
+			}
+			resp, err := newSessionClient().ResolveApproval(context.Background(), connect.NewRequest(req))
+			if err != nil {
+				return fmt.Errorf("could not resolve approval: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			fmt.Println(resp.Msg.Message)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "Message passed back to the agent")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// completeApprovals completes pending approval IDs, described by session and tool.
+func completeApprovals(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	resp, err := newSessionClient().ListPendingApprovals(context.Background(),
+		connect.NewRequest(&sessionv1.ListPendingApprovalsRequest{}))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var ids []string
+	for _, a := range resp.Msg.Approvals {
+		if strings.HasPrefix(a.Id, toComplete) {
+			ids = append(ids, fmt.Sprintf("%s\t%s: %s", a.Id, a.SessionId, a.ToolName))
+		}
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
+// NewQueueCmd returns the "queue" command, which lists the sessions waiting
+// on the user and the pending tool approvals.
+func NewQueueCmd() *cobra.Command {
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:          "queue",
+		Short:        "List sessions that need attention and pending approvals",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := newSessionClient()
+			ctx := context.Background()
+			queue, err := client.GetReviewQueue(ctx, connect.NewRequest(&sessionv1.GetReviewQueueRequest{}))
+			if err != nil {
+				return fmt.Errorf("could not get review queue: %w", err)
+			}
+			approvals, err := client.ListPendingApprovals(ctx, connect.NewRequest(&sessionv1.ListPendingApprovalsRequest{}))
+			if err != nil {
+				return fmt.Errorf("could not list approvals: %w", err)
+			}
+			if asJSON {
+				return printQueueJSON(queue.Msg.ReviewQueue, approvals.Msg.Approvals)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			if len(approvals.Msg.Approvals) > 0 {
+				fmt.Fprintln(w, "APPROVAL\tSESSION\tTOOL\tEXPIRES IN")
+				for _, a := range approvals.Msg.Approvals {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", a.Id, a.SessionId, a.ToolName,
+						time.Duration(a.SecondsRemaining)*time.Second)
+				}
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintln(w, "SESSION\tPRIORITY\tREASON\tCONTEXT")
+			for _, item := range queue.Msg.ReviewQueue.GetItems() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.SessionName,
+					enumLabel(item.Priority.String(), "PRIORITY_"),
+					enumLabel(item.Reason.String(), "ATTENTION_REASON_"),
+					firstLine(item.Context))
+			}
+			return w.Flush()
+		},
+	}
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// printQueueJSON prints the review queue and pending approvals as one object.
+func printQueueJSON(queue *sessionv1.ReviewQueue, approvals []*sessionv1.PendingApprovalProto) error {
+	opts := protojson.MarshalOptions{UseProtoNames: true}
+	out := struct {
+		ReviewQueue json.RawMessage   `json:"review_queue"`
+		Approvals   []json.RawMessage `json:"approvals"`
+	}{Approvals: []json.RawMessage{}}
+
+	var err error
+	if out.ReviewQueue, err = opts.Marshal(queue); err != nil {
+		return fmt.Errorf("could not encode review queue: %w", err)
+	}
+	for _, a := range approvals {
+		data, err := opts.Marshal(a)
+		if err != nil {
+			return fmt.Errorf("could not encode approval: %w", err)
+		}
+		out.Approvals = append(out.Approvals, data)
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode response: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// enumLabel turns a proto enum name such as PRIORITY_HIGH into "high".
+func enumLabel(name, prefix string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(name, prefix)), "_", " ")
+}
+
+// firstLine returns the first line of s, truncated for a table cell.
+func firstLine(s string) string {
+	s, _, _ = strings.Cut(strings.TrimSpace(s), "\n")
+	if len(s) > 60 {
+		s = s[:57] + "..."
+	}
+	return s
+}
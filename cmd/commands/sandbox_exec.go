@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tstapler/stapler-squad/session/sandbox"
+)
+
+// NewSandboxExecCmd returns the hidden "sandbox-exec" command that sandboxed
+// sessions run as their tmux program. It exits with the wrapped program's
+// exit code.
+func NewSandboxExecCmd() *cobra.Command {
+	var (
+		policyPath  string
+		inner       bool
+		proxySocket string
+	)
+	cmd := &cobra.Command{
+		Use:    "sandbox-exec --policy <file> -- <program> [args...]",
+		Short:  "Run a session program inside its sandbox",
+		Hidden: true,
+		Args:   cobra.MinimumNArgs(1),
+		// Errors go to the session's terminal, where the user sees why the
+		// agent did not start.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				code int
+				err  error
+			)
+			if inner {
+				code, err = sandbox.RunInner(proxySocket, args)
+			} else {
+				if policyPath == "" {
+					return errors.New("--policy is required")
+				}
+				policy, loadErr := sandbox.LoadPolicy(policyPath)
+				if loadErr != nil {
+					return fmt.Errorf("load sandbox policy: %w", loadErr)
+				}
+				self, exeErr := os.Executable()
+				if exeErr != nil {
+					return fmt.Errorf("locate executable: %w", exeErr)
+				}
+				code, err = sandbox.Run(policy, self, args)
+			}
+			if err != nil {
+				return err
+			}
+			os.Exit(code)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&policyPath, "policy", "", "Sandbox policy file written by the server")
+	cmd.Flags().BoolVar(&inner, "inner", false, "Run as the in-sandbox proxy forwarder")
+	cmd.Flags().StringVar(&proxySocket, "proxy-socket", "", "Allowlist proxy socket (with --inner)")
+	_ = cmd.Flags().MarkHidden("inner")
+	_ = cmd.Flags().MarkHidden("proxy-socket")
+	return cmd
+}
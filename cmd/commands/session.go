@@ -0,0 +1,329 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+)
+
+// NewNewCmd returns the "new" command, which creates a session on the
+// running server.
+func NewNewCmd() *cobra.Command {
+	var (
+		path, branch, program, profile, category, prompt string
+		template                                         string
+		vars, tags                                       []string
+		autoYes, trustSetup, asJSON                      bool
+	)
+	cmd := &cobra.Command{
+		Use:   "new <title>",
+		Short: "Create a session",
+		Long: "Create a session on the running server. The session starts in the current directory " +
+			"unless --path is given; with --branch it gets its own worktree on that branch.\n\n" +
+			"--prompt - reads the prompt from stdin.\n\n" +
+			"--template creates the session from a template, filling its variables from --var " +
+			"key=value flags; the title is then optional and the other flags override the template's. " +
+			"A shared template's setup script runs only after you review it and pass --trust-setup; " +
+			"the trust lasts until the script changes.",
+		Args:         cobra.RangeArgs(0, 1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && template == "" {
+				return fmt.Errorf("a title is required unless --template is given")
+			}
+			var title string
+			if len(args) == 1 {
+				title = args[0]
+			}
+			if path == "" {
+				wd, err := os.Getwd()
+				if err != nil {
+					return fmt.Errorf("could not determine current directory: %w", err)
+				}
+				path = wd
+			}
+			if template != "" {
+				return newFromTemplate(cmd, template, vars, &sessionv1.CreateSessionFromTemplateRequest{
+					RepoPath:         path,
+					Title:            title,
+					Branch:           branch,
+					Program:          program,
+					Category:         category,
+					Tags:             tags,
+					AutoYes:          autoYes,
+					TrustSetupScript: trustSetup,
+				}, asJSON)
+			}
+			for _, name := range []string{"var", "trust-setup"} {
+				if cmd.Flags().Changed(name) {
+					return fmt.Errorf("--%s needs --template", name)
+				}
+			}
+			if prompt == "-" {
+				data, err := io.ReadAll(os.Stdin)
+				if err != nil {
+					return fmt.Errorf("could not read prompt from stdin: %w", err)
+				}
+				prompt = strings.TrimRight(string(data), "\n")
+			}
+
+			resp, err := newSessionClient().CreateSession(context.Background(),
+				connect.NewRequest(&sessionv1.CreateSessionRequest{
+					Title:    title,
+					Path:     path,
+					Branch:   branch,
+					Program:  program,
+					Profile:  profile,
+					Category: category,
+					Prompt:   prompt,
+					AutoYes:  autoYes,
+					Tags:     tags,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not create session: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			printCreated(resp.Msg.Session)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&path, "path", "", "Repository or directory to start in (default: current directory)")
+	cmd.Flags().StringVar(&branch, "branch", "", "Create a worktree on this branch")
+	cmd.Flags().StringVar(&program, "program", "", "Program to run instead of the profile's")
+	cmd.Flags().StringVar(&profile, "profile", "", "Profile to create the session from")
+	cmd.Flags().StringVar(&category, "category", "", "Category to file the session under")
+	cmd.Flags().StringVar(&prompt, "prompt", "", "Prompt to send once the program starts, or - for stdin")
+	cmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag to apply to the session (repeatable)")
+	cmd.Flags().BoolVar(&autoYes, "auto-yes", false, "Automatically accept the program's prompts")
+	addTemplateFlags(cmd, &template, &vars)
+	cmd.Flags().BoolVar(&trustSetup, "trust-setup", false, "Trust the shared template's setup script in this repository")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// newFromTemplate creates a session from template for the new command.
+func newFromTemplate(cmd *cobra.Command, template string, vars []string, req *sessionv1.CreateSessionFromTemplateRequest, asJSON bool) error {
+	for _, name := range []string{"profile", "prompt"} {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("--%s cannot be combined with --template", name)
+		}
+	}
+	variables, err := parseVars(vars)
+	if err != nil {
+		return err
+	}
+	req.Template, req.Variables = template, variables
+	resp, err := newSessionClient().CreateSessionFromTemplate(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("could not create session: %w", err)
+	}
+	if asJSON {
+		return printJSON(resp.Msg)
+	}
+	printCreated(resp.Msg.Session)
+	fmt.Printf("From template %s@%d\n", resp.Msg.Template.Name, resp.Msg.Template.Version)
+	return nil
+}
+
+// printCreated reports a newly created session.
+func printCreated(s *sessionv1.Session) {
+	if s.Branch != "" {
+		fmt.Printf("Created %s on branch %s\n", s.Title, s.Branch)
+	} else {
+		fmt.Printf("Created %s\n", s.Title)
+	}
+}
+
+// NewSendCmd returns the "send" command, which types text into a session.
+func NewSendCmd() *cobra.Command {
+	var noEnter, asJSON bool
+	cmd := &cobra.Command{
+		Use:   "send <session> [text...]",
+		Short: "Send text to a session",
+		Long: "Type text into a session's terminal and press Enter, submitting it as a prompt. " +
+			"Without text arguments the text is read from stdin. --no-enter types the text " +
+			"without submitting it, which also allows control characters such as $'\\x03'.",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			text := strings.Join(args[1:], " ")
+			if len(args) == 1 {
+				data, err := io.ReadAll(os.Stdin)
+				if err != nil {
+					return fmt.Errorf("could not read stdin: %w", err)
+				}
+				text = strings.TrimRight(string(data), "\n")
+			}
+
+			resp, err := newSessionClient().SendSessionInput(context.Background(),
+				connect.NewRequest(&sessionv1.SendSessionInputRequest{
+					SessionId: args[0],
+					Text:      text,
+					Submit:    !noEnter,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not send input: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&noEnter, "no-enter", false, "Type the text without pressing Enter")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// NewDiffCmd returns the "diff" command, which prints a session's changes.
+func NewDiffCmd() *cobra.Command {
+	var stat, asJSON bool
+	cmd := &cobra.Command{
+		Use:               "diff <session>",
+		Short:             "Show a session's uncommitted changes",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := newSessionClient().GetSessionDiff(context.Background(),
+				connect.NewRequest(&sessionv1.GetSessionDiffRequest{Id: args[0]}))
+			if err != nil {
+				return fmt.Errorf("could not get diff: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			d := resp.Msg.DiffStats
+			if stat {
+				fmt.Printf("+%d -%d\n", d.GetAdded(), d.GetRemoved())
+				return nil
+			}
+			fmt.Print(d.GetContent())
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&stat, "stat", false, "Print only the added and removed line counts")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// NewCheckpointCmd returns the "checkpoint" command, which creates or lists
+// a session's checkpoints.
+func NewCheckpointCmd() *cobra.Command {
+	var list, asJSON bool
+	cmd := &cobra.Command{
+		Use:               "checkpoint <session> [label]",
+		Short:             "Checkpoint a session, or list its checkpoints",
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := newSessionClient()
+			if list {
+				resp, err := client.ListCheckpoints(context.Background(),
+					connect.NewRequest(&sessionv1.ListCheckpointsRequest{SessionId: args[0]}))
+				if err != nil {
+					return fmt.Errorf("could not list checkpoints: %w", err)
+				}
+				if asJSON {
+					return printJSON(resp.Msg)
+				}
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "ID\tLABEL\tCOMMIT\tCREATED")
+				for _, c := range resp.Msg.Checkpoints {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Id, c.Label, shortSHA(c.GitCommitSha),
+						c.Timestamp.AsTime().Local().Format(time.DateTime))
+				}
+				return w.Flush()
+			}
+
+			var label string
+			if len(args) == 2 {
+				label = args[1]
+			}
+			resp, err := client.CreateCheckpoint(context.Background(),
+				connect.NewRequest(&sessionv1.CreateCheckpointRequest{SessionId: args[0], Label: label}))
+			if err != nil {
+				return fmt.Errorf("could not create checkpoint: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			fmt.Println(resp.Msg.Checkpoint.Id)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&list, "list", false, "List the session's checkpoints instead")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// NewForkCmd returns the "fork" command, which starts a new session from one
+// of a session's checkpoints.
+func NewForkCmd() *cobra.Command {
+	var checkpoint, title string
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:               "fork <session>",
+		Short:             "Fork a session from a checkpoint",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := newSessionClient().ForkSession(context.Background(),
+				connect.NewRequest(&sessionv1.ForkSessionRequest{
+					SessionId:    args[0],
+					CheckpointId: checkpoint,
+					NewTitle:     title,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not fork session: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			fmt.Printf("Created %s\n", resp.Msg.Session.Title)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&checkpoint, "checkpoint", "", "Checkpoint ID to fork from")
+	cmd.Flags().StringVar(&title, "title", "", "Title of the new session")
+	_ = cmd.MarkFlagRequired("checkpoint")
+	_ = cmd.MarkFlagRequired("title")
+	_ = cmd.RegisterFlagCompletionFunc("checkpoint", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		resp, err := newSessionClient().ListCheckpoints(context.Background(),
+			connect.NewRequest(&sessionv1.ListCheckpointsRequest{SessionId: args[0]}))
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var ids []string
+		for _, c := range resp.Msg.Checkpoints {
+			ids = append(ids, c.Id+"\t"+c.Label)
+		}
+		return ids, cobra.ShellCompDirectiveNoFileComp
+	})
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// shortSHA abbreviates a commit hash for display.
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+
+package commands
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyWinch registers ch to receive SIGWINCH (terminal resize) signals.
+func notifyWinch(ch chan os.Signal) {
+	signal.Notify(ch, syscall.SIGWINCH)
+}
@@ -0,0 +1,8 @@
+//go:build windows
+
+package commands
+
+import "os"
+
+// notifyWinch is a no-op on Windows where SIGWINCH does not exist.
+func notifyWinch(_ chan os.Signal) {}
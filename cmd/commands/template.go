@@ -0,0 +1,307 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/session/templates"
+)
+
+// NewTemplateCmd returns the "template" command group for managing session
+// templates.
+func NewTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "List, show, save and delete session templates",
+		Long: "Session templates bundle a program, profile, branch pattern, tags, a prompt with " +
+			"{{variables}}, a setup script and acceptance criteria. Create a session from one with " +
+			"ssq new --template <name>[@version] --var key=value.\n\n" +
+			"Personal templates are versioned by the server and take precedence over a shared " +
+			"template of the same name. Shared templates are files in a repository's " + templates.RepoDir +
+			" directory; their setup scripts run only once trusted with ssq new --trust-setup.",
+	}
+	cmd.AddCommand(newTemplateListCmd(), newTemplateShowCmd(), newTemplateSaveCmd(), newTemplateDeleteCmd())
+	return cmd
+}
+
+func newTemplateListCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List the templates available in a repository",
+		Long:         "List personal templates and the shared templates of --repo, which defaults to the current directory.",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repo, err := repoOrWorkingDir(repo)
+			if err != nil {
+				return err
+			}
+			resp, err := newSessionClient().ListTemplates(context.Background(),
+				connect.NewRequest(&sessionv1.ListTemplatesRequest{RepoPath: repo}))
+			if err != nil {
+				return fmt.Errorf("could not list templates: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			for _, warning := range resp.Msg.Warnings {
+				fmt.Fprintln(os.Stderr, "warning:", warning)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tVERSION\tSOURCE\tVARIABLES\tDESCRIPTION")
+			for _, t := range resp.Msg.Templates {
+				var vars []string
+				for _, v := range t.Variables {
+					vars = append(vars, v.Name)
+				}
+				fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", t.Name, t.Version, t.Source, strings.Join(vars, ","), t.Description)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&repo, "repo", "", "Repository whose shared templates to include (default: current directory)")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+func newTemplateShowCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:               "show <name>[@version]",
+		Short:             "Show a template",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeTemplates,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, version, err := templates.ParseRef(args[0])
+			if err != nil {
+				return err
+			}
+			repo, err := repoOrWorkingDir(repo)
+			if err != nil {
+				return err
+			}
+			resp, err := newSessionClient().GetTemplate(context.Background(),
+				connect.NewRequest(&sessionv1.GetTemplateRequest{Name: name, Version: int32(version), RepoPath: repo}))
+			if err != nil {
+				return fmt.Errorf("could not get template: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			t := resp.Msg.Template
+			fmt.Printf("%s@%d (%s)\n", t.Name, t.Version, t.Source)
+			if t.Description != "" {
+				fmt.Println(t.Description)
+			}
+			if len(resp.Msg.Versions) > 1 {
+				fmt.Printf("Versions: %v\n", resp.Msg.Versions)
+			}
+			for _, field := range [][2]string{
+				{"Program", t.Program}, {"Profile", t.Profile}, {"Session type", t.SessionType},
+				{"Branch", t.BranchPattern}, {"Tags", strings.Join(t.Tags, ", ")},
+			} {
+				if field[1] != "" {
+					fmt.Printf("%s: %s\n", field[0], field[1])
+				}
+			}
+			if len(t.Variables) > 0 {
+				fmt.Println("\nVariables:")
+				for _, v := range t.Variables {
+					line := "  " + v.Name
+					if v.Type != "" {
+						line += " (" + v.Type + ")"
+					}
+					if v.Required {
+						line += " required"
+					}
+					if v.DefaultValue != "" {
+						line += " default=" + v.DefaultValue
+					}
+					if len(v.Options) > 0 {
+						line += " one of " + strings.Join(v.Options, "|")
+					}
+					if v.Description != "" {
+						line += ": " + v.Description
+					}
+					fmt.Println(line)
+				}
+			}
+			fmt.Printf("\nPrompt:\n%s\n", t.Prompt)
+			if len(t.AcceptanceCriteria) > 0 {
+				fmt.Println("\nAcceptance criteria:")
+				for _, c := range t.AcceptanceCriteria {
+					fmt.Println("  - " + c)
+				}
+			}
+			if t.SetupScript != "" {
+				fmt.Printf("\nSetup script:\n%s\n", t.SetupScript)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&repo, "repo", "", "Repository whose shared templates to search (default: current directory)")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+func newTemplateSaveCmd() *cobra.Command {
+	var repo string
+	var shared, asJSON bool
+	cmd := &cobra.Command{
+		Use:   "save <file>",
+		Short: "Save a template from a JSON file",
+		Long: "Save the template described by a JSON file, in the same format as the files in " +
+			templates.RepoDir + ", as the next version of a personal template. --shared writes it " +
+			"to the templates directory of --repo instead, ready to be committed.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read template: %w", err)
+			}
+			var t templates.Template
+			if err := json.Unmarshal(data, &t); err != nil {
+				return fmt.Errorf("could not parse template %s: %w", args[0], err)
+			}
+			if shared {
+				if repo, err = repoOrWorkingDir(repo); err != nil {
+					return err
+				}
+			}
+			resp, err := newSessionClient().SaveTemplate(context.Background(),
+				connect.NewRequest(&sessionv1.SaveTemplateRequest{
+					Template: templateFileToProto(t),
+					Shared:   shared,
+					RepoPath: repo,
+				}))
+			if err != nil {
+				return fmt.Errorf("could not save template: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			fmt.Printf("Saved %s@%d (%s)\n", resp.Msg.Template.Name, resp.Msg.Template.Version, resp.Msg.Template.Source)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&shared, "shared", false, "Write the template to the repository's templates directory")
+	cmd.Flags().StringVar(&repo, "repo", "", "Repository to share the template in (default: current directory)")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+func newTemplateDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:               "delete <name>",
+		Short:             "Delete every version of a personal template",
+		Long:              "Delete every version of a personal template. Shared templates are removed by deleting their file from the repository.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeTemplates,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := newSessionClient().DeleteTemplate(context.Background(),
+				connect.NewRequest(&sessionv1.DeleteTemplateRequest{Name: args[0]}))
+			if err != nil {
+				return fmt.Errorf("could not delete template: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// addTemplateFlags registers --template and --var on a command that can
+// create sessions from a template.
+func addTemplateFlags(cmd *cobra.Command, template *string, vars *[]string) {
+	cmd.Flags().StringVar(template, "template", "", "Create the session from this template (name, optionally name@version)")
+	cmd.Flags().StringArrayVar(vars, "var", nil, "Template variable as key=value (repeatable)")
+	_ = cmd.RegisterFlagCompletionFunc("template", completeTemplates)
+}
+
+// parseVars turns repeated key=value flags into a variables map.
+func parseVars(vars []string) (map[string]string, error) {
+	if len(vars) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(vars))
+	for _, kv := range vars {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --var %q: expected key=value", kv)
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+// repoOrWorkingDir returns repo, defaulting to the current directory.
+func repoOrWorkingDir(repo string) (string, error) {
+	if repo != "" {
+		return repo, nil
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("could not determine current directory: %w", err)
+	}
+	return wd, nil
+}
+
+// templateFileToProto converts a template file to its API message.
+func templateFileToProto(t templates.Template) *sessionv1.SessionTemplate {
+	p := &sessionv1.SessionTemplate{
+		Name:               t.Name,
+		Version:            int32(t.Version),
+		Description:        t.Description,
+		Program:            t.Program,
+		Profile:            t.Profile,
+		SessionType:        t.SessionType,
+		BranchPattern:      t.BranchPattern,
+		Tags:               t.Tags,
+		Prompt:             t.Prompt,
+		SetupScript:        t.SetupScript,
+		AcceptanceCriteria: t.AcceptanceCriteria,
+	}
+	for _, v := range t.Variables {
+		p.Variables = append(p.Variables, &sessionv1.TemplateVariable{
+			Name:         v.Name,
+			Type:         string(v.Type),
+			Description:  v.Description,
+			Required:     v.Required,
+			DefaultValue: v.Default,
+			Options:      v.Options,
+		})
+	}
+	return p
+}
+
+// completeTemplates completes template names available in the current
+// directory, described by their description.
+func completeTemplates(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 && cmd.Flags().Lookup("template") == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	wd, _ := os.Getwd()
+	resp, err := newSessionClient().ListTemplates(context.Background(),
+		connect.NewRequest(&sessionv1.ListTemplatesRequest{RepoPath: wd}))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, t := range resp.Msg.Templates {
+		if strings.HasPrefix(t.Name, toComplete) {
+			names = append(names, t.Name+"\t"+t.Description)
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,225 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"golang.org/x/term"
+)
+
+// detachKey ends "attach" without stopping the session (Ctrl-], as in telnet).
+const detachKey = 0x1d
+
+// NewTailCmd returns the "tail" command, which prints a session's terminal.
+func NewTailCmd() *cobra.Command {
+	var (
+		lines          int32
+		follow, asJSON bool
+	)
+	cmd := &cobra.Command{
+		Use:   "tail <session>",
+		Short: "Print a session's terminal output",
+		Long: "Print the last lines of a session's terminal as plain text.\n\n" +
+			"With -f, stream the terminal's raw output instead, escape sequences included, starting " +
+			"with a redraw of the current screen. With -f and --json each chunk of output is printed " +
+			"as a JSON object on its own line.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if follow {
+				return followTerminal(args[0], asJSON)
+			}
+			resp, err := newSessionClient().GetTerminalSnapshot(context.Background(),
+				connect.NewRequest(&sessionv1.GetTerminalSnapshotRequest{SessionId: args[0], LastNLines: lines}))
+			if err != nil {
+				return fmt.Errorf("could not get terminal: %w", err)
+			}
+			if asJSON {
+				return printJSON(resp.Msg)
+			}
+			fmt.Println(strings.TrimRight(resp.Msg.Content, "\n"))
+			return nil
+		},
+	}
+	cmd.Flags().Int32VarP(&lines, "lines", "n", 50, "Number of lines to print")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Stream output until the session ends or Ctrl-C")
+	addJSONFlag(cmd, &asJSON)
+	return cmd
+}
+
+// followTerminal streams a session's output to stdout without resizing it.
+func followTerminal(sessionID string, asJSON bool) error {
+	stream, err := dialTerminalStream(apiURL(), sessionID, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	stopped := make(chan struct{})
+	go func() {
+		<-interrupt
+		close(stopped)
+		stream.Close()
+	}()
+
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			select {
+			case <-stopped:
+				return nil
+			default:
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if e := msg.GetError(); e != nil {
+			return fmt.Errorf("terminal error: %s", e.Message)
+		}
+		out := msg.GetOutput()
+		if out == nil {
+			continue
+		}
+		if asJSON {
+			chunk := struct {
+				SessionID string `json:"session_id"`
+				Output    string `json:"output"`
+			}{sessionID, strings.ToValidUTF8(string(out.Data), "�")}
+			if err := enc.Encode(chunk); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := os.Stdout.Write(out.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// NewAttachCmd returns the "attach" command, which connects the current
+// terminal to a session through the server's streaming API.
+func NewAttachCmd() *cobra.Command {
+	var readOnly bool
+	cmd := &cobra.Command{
+		Use:   "attach <session>",
+		Short: "Attach the terminal to a session",
+		Long: "Attach the current terminal to a session through the server's streaming API, the same way " +
+			"the web UI does; tmux does not need to be installed locally and the server can be on " +
+			"another machine. Press Ctrl-] to detach. The session's pane is resized to this terminal " +
+			"unless --read-only is set.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSessions,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return attachTerminal(args[0], readOnly)
+		},
+	}
+	cmd.Flags().BoolVar(&readOnly, "read-only", false, "Watch without sending input or resizing the pane")
+	return cmd
+}
+
+func attachTerminal(sessionID string, readOnly bool) error {
+	inFd, outFd := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+	if !term.IsTerminal(inFd) {
+		return fmt.Errorf("attach needs a terminal; use send and tail to script sessions")
+	}
+
+	var cols, rows int
+	if !readOnly {
+		cols, rows, _ = term.GetSize(outFd)
+	}
+	stream, err := dialTerminalStream(apiURL(), sessionID, cols, rows)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	fmt.Fprintf(os.Stderr, "Attached to %s. Press Ctrl-] to detach.\n", sessionID)
+	oldState, err := term.MakeRaw(inFd)
+	if err != nil {
+		return fmt.Errorf("could not put the terminal in raw mode: %w", err)
+	}
+	defer func() {
+		_ = term.Restore(inFd, oldState)
+		fmt.Fprintf(os.Stderr, "\nDetached from %s\n", sessionID)
+	}()
+
+	detached := make(chan struct{})
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				return
+			}
+			data := buf[:n]
+			i := bytes.IndexByte(data, detachKey)
+			if i >= 0 {
+				data = data[:i]
+			}
+			if len(data) > 0 && !readOnly {
+				if err := stream.SendInput(append([]byte(nil), data...)); err != nil {
+					return
+				}
+			}
+			if i >= 0 {
+				close(detached)
+				stream.Close()
+				return
+			}
+		}
+	}()
+
+	if !readOnly {
+		winch := make(chan os.Signal, 1)
+		notifyWinch(winch)
+		defer signal.Stop(winch)
+		go func() {
+			for range winch {
+				if c, r, err := term.GetSize(outFd); err == nil {
+					_ = stream.SendResize(c, r)
+				}
+			}
+		}()
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			select {
+			case <-detached:
+				return nil
+			default:
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if e := msg.GetError(); e != nil {
+			return fmt.Errorf("terminal error: %s", e.Message)
+		}
+		if out := msg.GetOutput(); out != nil {
+			if _, err := os.Stdout.Write(out.Data); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/gen/proto/go/session/v1/sessionv1connect"
+	"github.com/tstapler/stapler-squad/server/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// terminalStream is a client for the StreamTerminal RPC, which the server
+// serves over a WebSocket rather than plain HTTP: one text message of
+// headers each way, then ConnectRPC envelopes of TerminalData.
+type terminalStream struct {
+	conn *websocket.Conn
+
+	writeMu sync.Mutex
+}
+
+// dialTerminalStream opens a raw-mode terminal stream to a session on the
+// server whose API is at baseURL (see apiURL). With cols and rows set the
+// server resizes the pane to match; zero leaves the pane's size alone, which
+// is what a passive viewer wants.
+func dialTerminalStream(baseURL, sessionID string, cols, rows int) (*terminalStream, error) {
+	url := "ws" + strings.TrimPrefix(baseURL, "http") + sessionv1connect.SessionServiceStreamTerminalProcedure
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to %s: %w", url, err)
+	}
+	s := &terminalStream{conn: conn}
+
+	headers := "Content-Type: application/connect+proto\r\nConnect-Protocol-Version: 1\r\n\r\n"
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(headers)); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not send stream headers: %w", err)
+	}
+
+	mode := "raw"
+	pane := &sessionv1.CurrentPaneRequest{IncludeEscapes: true, StreamingMode: &mode}
+	if cols > 0 && rows > 0 {
+		c, r := int32(cols), int32(rows)
+		pane.TargetCols, pane.TargetRows = &c, &r
+	}
+	if err := s.send(&sessionv1.TerminalData{
+		SessionId: sessionID,
+		Data:      &sessionv1.TerminalData_CurrentPaneRequest{CurrentPaneRequest: pane},
+	}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// The server answers with its own headers, then an empty message.
+	_, resp, err := conn.ReadMessage()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not read stream headers: %w", err)
+	}
+	status, body, _ := strings.Cut(string(resp), "\r\n\r\n")
+	if !strings.HasPrefix(status, "Status-Code: 200") {
+		conn.Close()
+		return nil, fmt.Errorf("server refused stream: %s", strings.TrimSpace(body))
+	}
+	if _, err := s.Recv(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Recv returns the next message from the server. It returns io.EOF when the
+// server ends the stream cleanly and the server's error otherwise.
+func (s *terminalStream) Recv() (*sessionv1.TerminalData, error) {
+	for {
+		msgType, data, err := s.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+		if msgType != websocket.BinaryMessage {
+			continue
+		}
+		env, _, err := protocol.ParseEnvelope(data)
+		if err != nil {
+			return nil, err
+		}
+		if env.IsEndStream() {
+			return nil, endStreamError(env.Data)
+		}
+		var msg sessionv1.TerminalData
+		if err := proto.Unmarshal(env.Data, &msg); err != nil {
+			return nil, fmt.Errorf("could not decode terminal data: %w", err)
+		}
+		return &msg, nil
+	}
+}
+
+// endStreamError decodes the JSON end-of-stream message, returning io.EOF
+// when it carries no error.
+func endStreamError(data []byte) error {
+	var end struct {
+		Error *struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(data, &end); err != nil || end.Error == nil {
+		return io.EOF
+	}
+	return fmt.Errorf("stream ended: %s", end.Error.Message)
+}
+
+// SendInput types data into the session.
+func (s *terminalStream) SendInput(data []byte) error {
+	return s.send(&sessionv1.TerminalData{
+		Data: &sessionv1.TerminalData_Input{Input: &sessionv1.TerminalInput{Data: data}},
+	})
+}
+
+// SendResize resizes the session's pane.
+func (s *terminalStream) SendResize(cols, rows int) error {
+	return s.send(&sessionv1.TerminalData{
+		Data: &sessionv1.TerminalData_Resize{Resize: &sessionv1.TerminalResize{Cols: int32(cols), Rows: int32(rows)}},
+	})
+}
+
+func (s *terminalStream) send(msg *sessionv1.TerminalData) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode terminal data: %w", err)
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return s.conn.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(0, data))
+}
+
+// Close ends the stream from the client side and closes the connection.
+func (s *terminalStream) Close() error {
+	s.writeMu.Lock()
+	_ = s.conn.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(protocol.EndStreamFlag, nil))
+	s.writeMu.Unlock()
+	return s.conn.Close()
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/server/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeStreamServer speaks the server side of the StreamTerminal WebSocket
+// protocol: it checks the handshake, replies with the given status line and
+// messages, and reports the TerminalData it receives afterwards.
+func fakeStreamServer(t *testing.T, status string, send []*sessionv1.TerminalData, endStream string) (*httptest.Server, <-chan *sessionv1.TerminalData, <-chan *sessionv1.CurrentPaneRequest) {
+	t.Helper()
+	received := make(chan *sessionv1.TerminalData, 16)
+	handshakes := make(chan *sessionv1.CurrentPaneRequest, 1)
+	upgrader := websocket.Upgrader{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if msgType, _, err := conn.ReadMessage(); err != nil || msgType != websocket.TextMessage {
+			return
+		}
+		_, body, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		env, _, err := protocol.ParseEnvelope(body)
+		if err != nil {
+			return
+		}
+		var hello sessionv1.TerminalData
+		if proto.Unmarshal(env.Data, &hello) != nil || hello.SessionId != "my-session" {
+			return
+		}
+		handshakes <- hello.GetCurrentPaneRequest()
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(status+"\r\n\r\nno such session"))
+		if status != "Status-Code: 200" {
+			return
+		}
+		write := func(msg *sessionv1.TerminalData) {
+			data, _ := proto.Marshal(msg)
+			_ = conn.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(0, data))
+		}
+		write(&sessionv1.TerminalData{})
+		for _, msg := range send {
+			write(msg)
+		}
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			env, _, err := protocol.ParseEnvelope(data)
+			if err != nil {
+				return
+			}
+			if env.IsEndStream() {
+				break
+			}
+			var msg sessionv1.TerminalData
+			if proto.Unmarshal(env.Data, &msg) == nil {
+				received <- &msg
+			}
+		}
+		_ = conn.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(protocol.EndStreamFlag, []byte(endStream)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, received, handshakes
+}
+
+func outputMsg(s string) *sessionv1.TerminalData {
+	return &sessionv1.TerminalData{Data: &sessionv1.TerminalData_Output{Output: &sessionv1.TerminalOutput{Data: []byte(s)}}}
+}
+
+func TestTerminalStream_HandshakeAndOutput(t *testing.T) {
+	srv, _, handshakes := fakeStreamServer(t, "Status-Code: 200", []*sessionv1.TerminalData{outputMsg("hello")}, `{}`)
+
+	stream, err := dialTerminalStream(srv.URL+"/api", "my-session", 120, 40)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	pane := <-handshakes
+	assert.Equal(t, "raw", pane.GetStreamingMode())
+	assert.Equal(t, int32(120), pane.GetTargetCols())
+	assert.Equal(t, int32(40), pane.GetTargetRows())
+
+	msg, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(msg.GetOutput().GetData()))
+}
+
+func TestTerminalStream_ViewerLeavesPaneSize(t *testing.T) {
+	srv, _, handshakes := fakeStreamServer(t, "Status-Code: 200", nil, `{}`)
+
+	stream, err := dialTerminalStream(srv.URL+"/api", "my-session", 0, 0)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	pane := <-handshakes
+	assert.Nil(t, pane.TargetCols)
+	assert.Nil(t, pane.TargetRows)
+}
+
+func TestTerminalStream_InputResizeAndEnd(t *testing.T) {
+	srv, received, _ := fakeStreamServer(t, "Status-Code: 200", nil, `{}`)
+
+	stream, err := dialTerminalStream(srv.URL+"/api", "my-session", 0, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.SendInput([]byte("ls\r")))
+	require.NoError(t, stream.SendResize(100, 30))
+	assert.Equal(t, "ls\r", string((<-received).GetInput().GetData()))
+	resize := (<-received).GetResize()
+	assert.Equal(t, int32(100), resize.GetCols())
+	assert.Equal(t, int32(30), resize.GetRows())
+
+	// Ending the stream from the client gets the server's clean EndStream.
+	require.NoError(t, stream.conn.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(protocol.EndStreamFlag, nil)))
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+	stream.Close()
+}
+
+func TestTerminalStream_EndStreamError(t *testing.T) {
+	srv, _, _ := fakeStreamServer(t, "Status-Code: 200", nil, `{"error":{"code":"internal","message":"session not found: x"}}`)
+
+	stream, err := dialTerminalStream(srv.URL+"/api", "my-session", 0, 0)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.NoError(t, stream.conn.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(protocol.EndStreamFlag, nil)))
+	_, err = stream.Recv()
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, io.EOF)
+	assert.Contains(t, err.Error(), "session not found: x")
+}
+
+func TestTerminalStream_Refused(t *testing.T) {
+	srv, _, _ := fakeStreamServer(t, "Status-Code: 500", nil, "")
+
+	_, err := dialTerminalStream(srv.URL+"/api", "my-session", 0, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no such session")
+}
@@ -181,6 +181,102 @@ type NotificationPrefs struct {
 	PushEnabled bool `json:"push_enabled"`
 }
 
+// DefaultBacklogWIPLimit is the number of backlog item sessions the
+// auto-dispatcher keeps running per repository when no limit is configured.
+const DefaultBacklogWIPLimit = 1
+
+// BacklogDispatchConfig tunes the backlog auto-dispatcher. The dispatcher
+// itself is opt-in via the "backlog_autodispatch" feature flag.
+type BacklogDispatchConfig struct {
+	// WIPLimit is the maximum number of in-progress backlog items per repository.
+	// 0 uses DefaultBacklogWIPLimit.
+	WIPLimit int `json:"wip_limit,omitempty"`
+	// RepoWIPLimits overrides WIPLimit for individual repositories, keyed by repo
+	// path. A limit of 0 pauses dispatching for that repository.
+	RepoWIPLimits map[string]int `json:"repo_wip_limits,omitempty"`
+}
+
+// LimitFor returns the WIP limit that applies to repoPath.
+func (c BacklogDispatchConfig) LimitFor(repoPath string) int {
+	if limit, ok := c.RepoWIPLimits[repoPath]; ok {
+		return limit
+	}
+	if c.WIPLimit > 0 {
+		return c.WIPLimit
+	}
+	return DefaultBacklogWIPLimit
+}
+
+// SecretScanConfig tunes secret detection, which redacts terminal output and
+// scrollback, blocks commits and pushes of staged secrets, and gates backlog
+// reviews. Repositories can add a .secretsignore file for repo-specific
+// exceptions.
+type SecretScanConfig struct {
+	// DisableTerminalRedaction streams terminal output and stores scrollback
+	// without redacting detected secrets. Push blocking and the review gate
+	// are unaffected.
+	DisableTerminalRedaction bool `json:"disable_terminal_redaction,omitempty"`
+	// Allowlist holds regular expressions; detected values matching any of
+	// them are not treated as secrets, e.g. "^AKIA.*EXAMPLE$".
+	Allowlist []string `json:"allowlist,omitempty"`
+	// DisabledRules lists detection rule IDs to skip everywhere, e.g. "jwt".
+	DisabledRules []string `json:"disabled_rules,omitempty"`
+}
+
+// ReviewPanelConfig configures the backlog review gate. With no reviewers,
+// one reviewer runs with the default program and its verdict is final.
+type ReviewPanelConfig struct {
+	// Reviewers run in parallel on the same diff.
+	Reviewers []ReviewerConfig `json:"reviewers,omitempty"`
+	// Consensus decides the gate outcome from the reviewers' verdicts:
+	// "unanimous" (default), "majority" or "weighted".
+	Consensus string `json:"consensus,omitempty"`
+	// PassThreshold is the share of total reviewer weight that must PASS under
+	// the "weighted" policy. 0 means more than half.
+	PassThreshold float64 `json:"pass_threshold,omitempty"`
+}
+
+// ReviewCheckConfig is a deterministic check the backlog review gate runs in
+// the item's worktree before spawning any reviewer.
+type ReviewCheckConfig struct {
+	// Name identifies the check in verdicts and the review prompt, e.g. "build".
+	Name string `json:"name"`
+	// Kind is "command" (default), "license_header" or "forbidden_paths".
+	Kind string `json:"kind,omitempty"`
+	// Command is run with sh -c in the worktree; a non-zero exit fails the
+	// check. Used by the "command" kind, e.g. "go vet ./...".
+	Command string `json:"command,omitempty"`
+	// Paths are glob patterns. For "license_header" they select the new files
+	// that must carry Header; for "forbidden_paths" they are the files the diff
+	// may not touch. "dir/**" matches everything under dir.
+	Paths []string `json:"paths,omitempty"`
+	// Header is the text that must appear near the top of each new file
+	// matched by Paths ("license_header" only).
+	Header string `json:"header,omitempty"`
+	// Soft checks are reported to the reviewer but do not fail the gate.
+	Soft bool `json:"soft,omitempty"`
+	// TimeoutSeconds bounds a command check. 0 means 10 minutes.
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+	// Repos limits the check to these repository paths. Empty runs it for
+	// every repository.
+	Repos []string `json:"repos,omitempty"`
+}
+
+// ReviewerConfig describes one member of a review panel.
+type ReviewerConfig struct {
+	// Name identifies the reviewer in verdicts and disagreements, e.g. "security".
+	Name string `json:"name"`
+	// Program is the command to run, e.g. "codex" or "claude". Empty uses the
+	// default program.
+	Program string `json:"program,omitempty"`
+	// Model is appended to Program as --model when set.
+	Model string `json:"model,omitempty"`
+	// Focus is added to the review prompt, e.g. "Concentrate on test coverage."
+	Focus string `json:"focus,omitempty"`
+	// Weight is the reviewer's vote under the "weighted" policy. 0 means 1.
+	Weight float64 `json:"weight,omitempty"`
+}
+
 // Config represents the application configuration
 type Config struct {
 	// executor is the command executor used for shell command discovery.
@@ -210,6 +306,13 @@ type Config struct {
 	SessionDetectionInterval int `json:"session_detection_interval"`
 	// StateRefreshInterval is the interval (ms) at which the state is refreshed from disk
 	StateRefreshInterval int `json:"state_refresh_interval"`
+	// PRPollIntervalSeconds is how often the server checks the PR status of
+	// sessions. 0 uses the poller's default of 60 seconds.
+	PRPollIntervalSeconds int `json:"pr_poll_interval_seconds,omitempty"`
+	// PRFeedbackAgentLogin is the forge login agents post PR comments as,
+	// when it is not the user's own. Comments by it are never fed back to
+	// an agent as review feedback.
+	PRFeedbackAgentLogin string `json:"pr_feedback_agent_login,omitempty"`
 	// LogsEnabled is a flag to enable logging to files
 	LogsEnabled bool `json:"logs_enabled"`
 	// LogsDir is the directory where logs are stored (defaults to ~/.stapler-squad/logs)
@@ -266,6 +369,21 @@ type Config struct {
 	// Events older than this are deleted. 0 means no age limit.
 	// Default: 90.
 	AnalyticsMaxAgeDays int `json:"analytics_max_age_days,omitempty"`
+	// ForgeHosts maps self-hosted forge hostnames to their platform ("github", "gitlab" or "gitea")
+	// for hosts whose kind cannot be inferred from the name, e.g. {"git.corp.example": "gitlab"}.
+	// Hostnames containing "github", "gitlab", "gitea" or "forgejo" are detected automatically.
+	ForgeHosts map[string]string `json:"forge_hosts,omitempty"`
+	// BacklogDispatch holds the WIP limits used by the backlog auto-dispatcher.
+	BacklogDispatch BacklogDispatchConfig `json:"backlog_dispatch,omitempty"`
+	// SecretScan configures secret detection and terminal redaction.
+	SecretScan SecretScanConfig `json:"secret_scan,omitempty"`
+	// ReviewPanel configures the reviewers and consensus policy of the backlog review gate.
+	ReviewPanel ReviewPanelConfig `json:"review_panel,omitempty"`
+	// ReviewChecks run in order before the review gate spawns reviewers. A
+	// failing hard check fails the gate without an LLM review.
+	ReviewChecks []ReviewCheckConfig `json:"review_checks,omitempty"`
+	// Cgroups configures per-session resource accounting and limits.
+	Cgroups CgroupConfig `json:"cgroups,omitempty"`
 	// FeatureFlags stores the enabled/disabled state of named runtime feature flags.
 	// Keys are machine names (e.g. "backlog"); values are booleans.
 	// Absent key == disabled (false is the safe default for all flags).
@@ -311,6 +429,10 @@ type SessionDefaults struct {
 	Profiles map[string]ProfileDefaults `json:"profiles,omitempty"`
 	// DirectoryRules are path-based rules matched against the session's working directory.
 	DirectoryRules []DirectoryRule `json:"directory_rules,omitempty"`
+	// ResourceLimits cap every new session's process tree (see CgroupConfig).
+	ResourceLimits ResourceLimits `json:"resource_limits,omitempty"`
+	// Sandbox confines every new session's filesystem and network access.
+	Sandbox SandboxConfig `json:"sandbox,omitempty"`
 }
 
 // ProfileDefaults holds the configurable fields for a named profile.
@@ -322,8 +444,93 @@ type ProfileDefaults struct {
 	Tags        []string          `json:"tags,omitempty"`
 	EnvVars     map[string]string `json:"env_vars,omitempty"`
 	CLIFlags    string            `json:"cli_flags,omitempty"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
+	// ResourceLimits cap the process tree of sessions using this profile.
+	ResourceLimits ResourceLimits `json:"resource_limits,omitempty"`
+	// Sandbox confines sessions using this profile.
+	Sandbox   SandboxConfig `json:"sandbox,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// ResourceLimits caps a session's process tree through its cgroup v2 group.
+// Zero fields are unlimited.
+type ResourceLimits struct {
+	// MemoryMaxMB is the hard memory limit. Beyond it the kernel OOM-kills
+	// processes inside the session.
+	MemoryMaxMB int64 `json:"memory_max_mb,omitempty"`
+	// MemoryHighMB throttles the session and reclaims its memory above this usage.
+	MemoryHighMB int64 `json:"memory_high_mb,omitempty"`
+	// CPUPercent caps CPU time; 100 is one full core.
+	CPUPercent int `json:"cpu_percent,omitempty"`
+	// PidsMax caps the number of processes and threads; forks beyond it fail.
+	PidsMax int64 `json:"pids_max,omitempty"`
+	// IOWeight is the proportional IO weight from 1 to 10000. 0 keeps the
+	// kernel default of 100.
+	IOWeight int `json:"io_weight,omitempty"`
+}
+
+// IsZero reports whether no limit is set.
+func (l ResourceLimits) IsZero() bool {
+	return l == ResourceLimits{}
+}
+
+// Sandbox network modes.
+const (
+	// SandboxNetworkHost shares the host network (the default).
+	SandboxNetworkHost = "host"
+	// SandboxNetworkNone gives the session an isolated network namespace with
+	// only a loopback interface.
+	SandboxNetworkNone = "none"
+	// SandboxNetworkAllowlist isolates the network and routes HTTP(S) traffic
+	// through a local proxy that only connects to AllowedHosts.
+	SandboxNetworkAllowlist = "allowlist"
+)
+
+// SandboxConfig confines a session's program with Linux namespaces
+// (bubblewrap). The filesystem is read-only except the session worktree, a
+// per-session scratch dir, the agent's config dir and WritablePaths, and /tmp
+// is private to the session.
+type SandboxConfig struct {
+	// Enabled runs the session inside the sandbox. Sessions fail to start
+	// when bwrap is not installed rather than silently running unconfined.
+	Enabled bool `json:"enabled,omitempty"`
+	// Network is one of "host" (default), "none" or "allowlist".
+	Network string `json:"network,omitempty"`
+	// AllowedHosts are the hosts reachable in "allowlist" mode. Entries are
+	// "host", "host:port" or "*.domain". The squad server is always allowed.
+	AllowedHosts []string `json:"allowed_hosts,omitempty"`
+	// WritablePaths are extra paths mounted read-write (e.g. a shared cache).
+	WritablePaths []string `json:"writable_paths,omitempty"`
+}
+
+// IsZero reports whether nothing is configured.
+func (s SandboxConfig) IsZero() bool {
+	return !s.Enabled && s.Network == "" && len(s.AllowedHosts) == 0 && len(s.WritablePaths) == 0
+}
+
+// NetworkMode returns the effective network mode.
+func (s SandboxConfig) NetworkMode() string {
+	if s.Network == "" {
+		return SandboxNetworkHost
+	}
+	return s.Network
+}
+
+// CgroupConfig configures per-session cgroup v2 accounting. It takes effect
+// only on Linux with the unified cgroup hierarchy.
+type CgroupConfig struct {
+	// Disabled leaves session processes in the server's own cgroup: no
+	// per-session usage and no resource limits.
+	Disabled bool `json:"disabled,omitempty"`
+	// Root is the cgroup directory that session groups are created under, e.g.
+	// "/sys/fs/cgroup/user.slice/user-1000.slice/user@1000.service/squad". Empty
+	// uses the server's own cgroup. The root must be delegated to the server
+	// user and hold no processes but the server's, so a server started from a
+	// terminal needs a root of its own (or a systemd unit with Delegate=yes).
+	Root string `json:"root,omitempty"`
+	// PollIntervalSeconds is how often usage is sampled and limit events are
+	// checked. 0 means 5 seconds.
+	PollIntervalSeconds int `json:"poll_interval_seconds,omitempty"`
 }
 
 // DirectoryRule associates a working-directory path prefix with profile defaults.
@@ -655,7 +862,12 @@ func LoadConfigFromPath(path string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	return parseConfig(data)
+}
 
+// parseConfig decodes config.json contents and applies defaults for fields
+// missing from older config files.
+func parseConfig(data []byte) (*Config, error) {
 	var cfg Config
 	if err := json.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
@@ -14,6 +14,10 @@ type ResolvedDefaults struct {
 	Tags     []string
 	EnvVars  map[string]string
 	CLIFlags string
+	// ResourceLimits cap the session's process tree; merged field by field.
+	ResourceLimits ResourceLimits
+	// Sandbox confines the session; see mergeSandbox for merge rules.
+	Sandbox SandboxConfig
 
 	// Source tracking — which layers contributed to this result.
 	UsedGlobal       bool
@@ -36,6 +40,9 @@ type ResolvedDefaults struct {
 //   - AutoYes: true in any layer sets it true
 //   - Tags: union across all layers (duplicates removed)
 //   - EnvVars: higher-layer key overwrites lower-layer key
+//   - ResourceLimits: each non-zero limit overwrites the lower layer's
+//   - Sandbox: Enabled in any layer enables it; Network overwrites; host and
+//     path lists are unioned
 func ResolveDefaults(cfg *Config, workingDir, profileName string) ResolvedDefaults {
 	result := ResolvedDefaults{
 		EnvVars: make(map[string]string),
@@ -49,15 +56,17 @@ func ResolveDefaults(cfg *Config, workingDir, profileName string) ResolvedDefaul
 
 	// Layer 2: global SessionDefaults
 	sd := cfg.SessionDefaults
-	if sd.Program != "" || sd.AutoYes || len(sd.Tags) > 0 || len(sd.EnvVars) > 0 || sd.CLIFlags != "" {
+	if sd.Program != "" || sd.AutoYes || len(sd.Tags) > 0 || len(sd.EnvVars) > 0 || sd.CLIFlags != "" || !sd.ResourceLimits.IsZero() || !sd.Sandbox.IsZero() {
 		result.UsedGlobal = true
 	}
 	mergeProfileInto(&result, ProfileDefaults{
-		Program:  sd.Program,
-		AutoYes:  sd.AutoYes,
-		Tags:     sd.Tags,
-		EnvVars:  sd.EnvVars,
-		CLIFlags: sd.CLIFlags,
+		Program:        sd.Program,
+		AutoYes:        sd.AutoYes,
+		Tags:           sd.Tags,
+		EnvVars:        sd.EnvVars,
+		CLIFlags:       sd.CLIFlags,
+		ResourceLimits: sd.ResourceLimits,
+		Sandbox:        sd.Sandbox,
 	})
 
 	// Layer 3: directory rule (longest-prefix match)
@@ -141,6 +150,44 @@ func mergeProfileInto(result *ResolvedDefaults, src ProfileDefaults) {
 	for k, v := range src.EnvVars {
 		result.EnvVars[k] = v
 	}
+	mergeResourceLimits(&result.ResourceLimits, src.ResourceLimits)
+	mergeSandbox(&result.Sandbox, src.Sandbox)
+}
+
+// mergeSandbox applies src onto dst. A layer can enable the sandbox but not
+// disable one enabled below it, so a profile cannot escape a global policy.
+func mergeSandbox(dst *SandboxConfig, src SandboxConfig) {
+	if src.Enabled {
+		dst.Enabled = true
+	}
+	if src.Network != "" {
+		dst.Network = src.Network
+	}
+	if len(src.AllowedHosts) > 0 {
+		dst.AllowedHosts = unionTags(dst.AllowedHosts, src.AllowedHosts)
+	}
+	if len(src.WritablePaths) > 0 {
+		dst.WritablePaths = unionTags(dst.WritablePaths, src.WritablePaths)
+	}
+}
+
+// mergeResourceLimits overwrites each limit that src sets.
+func mergeResourceLimits(dst *ResourceLimits, src ResourceLimits) {
+	if src.MemoryMaxMB != 0 {
+		dst.MemoryMaxMB = src.MemoryMaxMB
+	}
+	if src.MemoryHighMB != 0 {
+		dst.MemoryHighMB = src.MemoryHighMB
+	}
+	if src.CPUPercent != 0 {
+		dst.CPUPercent = src.CPUPercent
+	}
+	if src.PidsMax != 0 {
+		dst.PidsMax = src.PidsMax
+	}
+	if src.IOWeight != 0 {
+		dst.IOWeight = src.IOWeight
+	}
 }
 
 // unionTags returns the union of two tag slices with duplicates removed.
@@ -151,6 +151,41 @@ func TestResolveDefaults_EnvVarsMerge(t *testing.T) {
 	}
 }
 
+func TestResolveDefaults_ResourceLimitsMergePerField(t *testing.T) {
+	cfg := baseConfig()
+	cfg.SessionDefaults.ResourceLimits = ResourceLimits{MemoryMaxMB: 4096, PidsMax: 1024}
+	cfg.SessionDefaults.Profiles = map[string]ProfileDefaults{
+		"Heavy": {Name: "Heavy", ResourceLimits: ResourceLimits{MemoryMaxMB: 16384, CPUPercent: 400}},
+	}
+
+	r := ResolveDefaults(cfg, "", "Heavy")
+
+	want := ResourceLimits{MemoryMaxMB: 16384, CPUPercent: 400, PidsMax: 1024}
+	if r.ResourceLimits != want {
+		t.Errorf("expected profile limits over global ones, got %+v", r.ResourceLimits)
+	}
+}
+
+func TestResolveDefaults_SandboxCannotBeDisabledByProfile(t *testing.T) {
+	cfg := baseConfig()
+	cfg.SessionDefaults.Sandbox = SandboxConfig{Enabled: true, AllowedHosts: []string{"github.com"}}
+	cfg.SessionDefaults.Profiles = map[string]ProfileDefaults{
+		"Web": {Name: "Web", Sandbox: SandboxConfig{Network: SandboxNetworkAllowlist, AllowedHosts: []string{"*.npmjs.org"}}},
+	}
+
+	r := ResolveDefaults(cfg, "", "Web")
+
+	if !r.Sandbox.Enabled {
+		t.Error("expected the global sandbox to stay enabled under a profile")
+	}
+	if r.Sandbox.NetworkMode() != SandboxNetworkAllowlist {
+		t.Errorf("expected profile network mode, got %q", r.Sandbox.NetworkMode())
+	}
+	if len(r.Sandbox.AllowedHosts) != 2 {
+		t.Errorf("expected allowed hosts to be unioned, got %v", r.Sandbox.AllowedHosts)
+	}
+}
+
 func TestResolveDefaults_NoMatchReturnsGlobal(t *testing.T) {
 	cfg := baseConfig()
 	cfg.SessionDefaults.Program = "claude"
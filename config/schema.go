@@ -0,0 +1,184 @@
+package config
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// configSchemaJSON is the published JSON Schema for config.json. Editors can
+// reference it via "$schema" for completion; the config watcher validates
+// every edit against it before applying the change.
+//
+//go:embed config.schema.json
+var configSchemaJSON []byte
+
+// Schema returns the JSON Schema document for config.json.
+func Schema() []byte {
+	return configSchemaJSON
+}
+
+// ValidationIssue is a single problem found in a config file.
+type ValidationIssue struct {
+	// Path is the dotted JSON path of the offending value, e.g.
+	// "session_defaults.directory_rules.0.path". Empty for the document root.
+	Path string
+	// Line and Column locate the offending value (1-based). Zero when unknown.
+	Line   int
+	Column int
+	// Message describes the problem.
+	Message string
+}
+
+// String formats the issue as "line:col: path: message".
+func (i ValidationIssue) String() string {
+	var b strings.Builder
+	if i.Line > 0 {
+		fmt.Fprintf(&b, "%d:%d: ", i.Line, i.Column)
+	}
+	if i.Path != "" {
+		b.WriteString(i.Path)
+		b.WriteString(": ")
+	}
+	b.WriteString(i.Message)
+	return b.String()
+}
+
+// ValidationError reports why a config file was rejected.
+type ValidationError struct {
+	File   string
+	Issues []ValidationIssue
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		parts = append(parts, issue.String())
+	}
+	name := e.File
+	if name == "" {
+		name = ConfigFileName
+	}
+	return fmt.Sprintf("invalid %s: %s", name, strings.Join(parts, "; "))
+}
+
+// ValidateConfigJSON checks raw config.json contents for syntax errors and
+// schema violations. It returns a *ValidationError listing every problem with
+// its line and column, or nil when the document is valid.
+func ValidateConfigJSON(data []byte) error {
+	var syntaxErr *json.SyntaxError
+	if err := json.Unmarshal(data, new(any)); err != nil {
+		issue := ValidationIssue{Message: err.Error()}
+		if errors.As(err, &syntaxErr) {
+			issue.Line, issue.Column = lineCol(data, max(syntaxErr.Offset-1, 0))
+		}
+		return &ValidationError{Issues: []ValidationIssue{issue}}
+	}
+
+	// Compiled per call: validation only runs when config.json is edited.
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(configSchemaJSON))
+	if err != nil {
+		return fmt.Errorf("load config schema: %w", err)
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return fmt.Errorf("validate config: %w", err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	verr := &ValidationError{}
+	for _, re := range result.Errors() {
+		// "\x00" cannot appear in a JSON key, so splitting on it is unambiguous
+		// even for keys containing dots (e.g. forge_hosts hostnames).
+		segments := strings.Split(re.Context().String("\x00"), "\x00")[1:]
+		issue := ValidationIssue{
+			Path:    strings.Join(segments, "."),
+			Message: re.Description(),
+		}
+		if offset, ok := locateJSONPath(data, segments); ok {
+			issue.Line, issue.Column = lineCol(data, offset)
+		}
+		verr.Issues = append(verr.Issues, issue)
+	}
+	return verr
+}
+
+// locateJSONPath returns the byte offset of the value addressed by path
+// (object keys and array indices) within a syntactically valid document.
+func locateJSONPath(data []byte, path []string) (int64, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	return seekJSONValue(dec, data, path)
+}
+
+func seekJSONValue(dec *json.Decoder, data []byte, path []string) (int64, bool) {
+	start := skipJSONSeparators(data, dec.InputOffset())
+	if len(path) == 0 {
+		return start, true
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, false
+	}
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return 0, false
+			}
+			if key, _ := keyTok.(string); key == path[0] {
+				return seekJSONValue(dec, data, path[1:])
+			}
+			if err := dec.Decode(new(json.RawMessage)); err != nil {
+				return 0, false
+			}
+		}
+	case json.Delim('['):
+		index, err := strconv.Atoi(path[0])
+		if err != nil {
+			return 0, false
+		}
+		for i := 0; dec.More(); i++ {
+			if i == index {
+				return seekJSONValue(dec, data, path[1:])
+			}
+			if err := dec.Decode(new(json.RawMessage)); err != nil {
+				return 0, false
+			}
+		}
+	}
+	return 0, false
+}
+
+// skipJSONSeparators advances offset past whitespace and the ':' / ','
+// separators the decoder leaves between tokens.
+func skipJSONSeparators(data []byte, offset int64) int64 {
+	for offset < int64(len(data)) {
+		switch data[offset] {
+		case ' ', '\t', '\r', '\n', ':', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// lineCol converts a byte offset into a 1-based line and column.
+func lineCol(data []byte, offset int64) (line, col int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	prefix := data[:offset]
+	line = bytes.Count(prefix, []byte("\n")) + 1
+	col = int(offset) - (bytes.LastIndexByte(prefix, '\n') + 1) + 1
+	return line, col
+}
@@ -0,0 +1,245 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// reloadDebounce coalesces the burst of events editors produce for one save
+// (truncate + write, or write temp + rename).
+const reloadDebounce = 150 * time.Millisecond
+
+// Change describes an applied config.json edit.
+type Change struct {
+	Old *Config
+	New *Config
+	// Fields lists the JSON names of the top-level settings that changed,
+	// e.g. "daemon_poll_interval" or "session_defaults", sorted.
+	Fields []string
+}
+
+// Changed reports whether the top-level setting with the given JSON name changed.
+func (c *Change) Changed(field string) bool {
+	for _, f := range c.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Watcher hot-reloads config.json. Every edit is validated against the
+// published schema before it is applied; invalid edits are rejected with
+// their error locations and the running config is kept. Subsystems register
+// OnChange callbacks (the server forwards them to the event bus) to re-apply
+// settings without a restart.
+type Watcher struct {
+	path string
+
+	mu       sync.RWMutex
+	current  *Config
+	lastData []byte // Raw contents of the last applied or rejected file
+
+	callbackMu sync.Mutex
+	onChange   []func(*Change)
+	onReject   []func(*ValidationError)
+}
+
+// NewWatcher creates a watcher for the config file at path. initial is the
+// config the process is currently running with.
+func NewWatcher(path string, initial *Config) *Watcher {
+	w := &Watcher{path: filepath.Clean(path), current: initial}
+	if data, err := os.ReadFile(w.path); err == nil {
+		w.lastData = data
+	}
+	return w
+}
+
+// Current returns the running config. Callers must not mutate it.
+func (w *Watcher) Current() *Config {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.current
+}
+
+// OnChange registers a callback invoked after a valid edit has been applied.
+func (w *Watcher) OnChange(fn func(*Change)) {
+	w.callbackMu.Lock()
+	defer w.callbackMu.Unlock()
+	w.onChange = append(w.onChange, fn)
+}
+
+// OnReject registers a callback invoked when an edit fails validation.
+func (w *Watcher) OnReject(fn func(*ValidationError)) {
+	w.callbackMu.Lock()
+	defer w.callbackMu.Unlock()
+	w.onReject = append(w.onReject, fn)
+}
+
+// Start watches the config file's directory until ctx is cancelled. The
+// directory rather than the file is watched so atomic saves (write temp file,
+// rename over config.json) are seen.
+func (w *Watcher) Start(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("create config watcher: %w", err)
+	}
+	if err := fsw.Add(filepath.Dir(w.path)); err != nil {
+		fsw.Close()
+		return fmt.Errorf("watch config directory: %w", err)
+	}
+	go w.loop(ctx, fsw)
+	return nil
+}
+
+func (w *Watcher) loop(ctx context.Context, fsw *fsnotify.Watcher) {
+	defer fsw.Close()
+
+	timer := time.NewTimer(reloadDebounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != w.path || !event.Has(fsnotify.Write|fsnotify.Create) {
+				continue
+			}
+			timer.Reset(reloadDebounce)
+		case <-timer.C:
+			if _, err := w.Reload(); err != nil {
+				var verr *ValidationError
+				if !errors.As(err, &verr) {
+					log.Warn("config reload failed", "path", w.path, "err", err)
+				}
+			}
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return
+			}
+			log.Warn("config watcher error", "err", err)
+		}
+	}
+}
+
+// Reload reads the config file and applies it if it is valid and differs from
+// the running config. It returns the applied change, or nil when nothing
+// changed. A *ValidationError is returned (and OnReject callbacks invoked)
+// when the file is invalid; the running config is left untouched.
+func (w *Watcher) Reload() (*Change, error) {
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Deleted or mid-rename: keep running with the current config.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	w.mu.Lock()
+	if bytes.Equal(data, w.lastData) {
+		w.mu.Unlock()
+		return nil, nil
+	}
+	w.lastData = data
+	w.mu.Unlock()
+
+	if err := ValidateConfigJSON(data); err != nil {
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			verr.File = w.path
+			for _, issue := range verr.Issues {
+				log.Warn("config change rejected", "path", w.path, "issue", issue.String())
+			}
+			w.notifyReject(verr)
+		}
+		return nil, err
+	}
+	next, err := parseConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	w.mu.Lock()
+	prev := w.current
+	fields := changedFields(prev, next)
+	if len(fields) > 0 {
+		w.current = next
+	}
+	w.mu.Unlock()
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	change := &Change{Old: prev, New: next, Fields: fields}
+	log.Info("config reloaded", "path", w.path, "changed", fields)
+	w.notifyChange(change)
+	return change, nil
+}
+
+func (w *Watcher) notifyChange(change *Change) {
+	w.callbackMu.Lock()
+	callbacks := append([]func(*Change){}, w.onChange...)
+	w.callbackMu.Unlock()
+	for _, fn := range callbacks {
+		fn(change)
+	}
+}
+
+func (w *Watcher) notifyReject(verr *ValidationError) {
+	w.callbackMu.Lock()
+	callbacks := append([]func(*ValidationError){}, w.onReject...)
+	w.callbackMu.Unlock()
+	for _, fn := range callbacks {
+		fn(verr)
+	}
+}
+
+// changedFields compares two configs by their JSON encoding and returns the
+// names of the top-level settings whose values differ.
+func changedFields(prev, next *Config) []string {
+	prevFields := topLevelJSON(prev)
+	nextFields := topLevelJSON(next)
+	var fields []string
+	for name, value := range nextFields {
+		if !bytes.Equal(prevFields[name], value) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range prevFields {
+		if _, ok := nextFields[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func topLevelJSON(cfg *Config) map[string]json.RawMessage {
+	fields := make(map[string]json.RawMessage)
+	if cfg == nil {
+		return fields
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
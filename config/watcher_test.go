@@ -0,0 +1,138 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validConfigJSON = `{
+  "daemon_poll_interval": 1000,
+  "terminal_streaming_mode": "raw",
+  "notifications": {"push_enabled": false}
+}`
+
+func TestValidateConfigJSON_ReportsSchemaViolationLocations(t *testing.T) {
+	data := []byte(`{
+  "daemon_poll_interval": -5,
+  "session_defaults": {
+    "directory_rules": [
+      {"path": "/work"},
+      {"path": 42}
+    ]
+  },
+  "forge_hosts": {"git.corp.example": "svn"}
+}`)
+
+	err := ValidateConfigJSON(data)
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr), "got %v", err)
+	require.Len(t, verr.Issues, 3)
+
+	byPath := make(map[string]ValidationIssue)
+	for _, issue := range verr.Issues {
+		byPath[issue.Path] = issue
+	}
+	poll := byPath["daemon_poll_interval"]
+	assert.Equal(t, 2, poll.Line)
+	assert.Equal(t, 27, poll.Column)
+
+	rule := byPath["session_defaults.directory_rules.1.path"]
+	assert.Equal(t, 6, rule.Line)
+	assert.Equal(t, 16, rule.Column)
+
+	host := byPath["forge_hosts.git.corp.example"]
+	assert.Equal(t, 9, host.Line)
+	assert.Equal(t, 39, host.Column)
+	assert.Contains(t, err.Error(), "9:39: forge_hosts.git.corp.example:")
+}
+
+func TestValidateConfigJSON_ReportsSyntaxErrorLocation(t *testing.T) {
+	err := ValidateConfigJSON([]byte("{\n  \"auto_yes\": true,\n}"))
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	require.Len(t, verr.Issues, 1)
+	assert.Equal(t, 3, verr.Issues[0].Line)
+	assert.Equal(t, 1, verr.Issues[0].Column)
+}
+
+func TestValidateConfigJSON_AcceptsDefaultConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ConfigFileName)
+	require.NoError(t, saveConfig(defaultConfigWithExecutor(&mockCommandExecutor{}), path))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NoError(t, ValidateConfigJSON(data))
+}
+
+func TestWatcher_ReloadAppliesValidAndRejectsInvalidEdits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	require.NoError(t, os.WriteFile(path, []byte(validConfigJSON), 0644))
+	initial, err := LoadConfigFromPath(path)
+	require.NoError(t, err)
+
+	w := NewWatcher(path, initial)
+	var changes []*Change
+	var rejects []*ValidationError
+	w.OnChange(func(c *Change) { changes = append(changes, c) })
+	w.OnReject(func(e *ValidationError) { rejects = append(rejects, e) })
+
+	// Unchanged file: nothing to do.
+	change, err := w.Reload()
+	require.NoError(t, err)
+	assert.Nil(t, change)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"daemon_poll_interval": 250, "terminal_streaming_mode": "raw", "notifications": {"push_enabled": true}}`), 0644))
+	change, err = w.Reload()
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, []string{"daemon_poll_interval", "notifications"}, change.Fields)
+	assert.True(t, change.Changed("notifications"))
+	assert.Equal(t, 1000, change.Old.DaemonPollInterval)
+	assert.Equal(t, 250, w.Current().DaemonPollInterval)
+	assert.Len(t, changes, 1)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"daemon_poll_interval": "fast"}`), 0644))
+	_, err = w.Reload()
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, path, verr.File)
+	assert.Equal(t, 1, verr.Issues[0].Line)
+	assert.Equal(t, 26, verr.Issues[0].Column)
+	assert.Len(t, rejects, 1)
+	assert.Equal(t, 250, w.Current().DaemonPollInterval, "running config is kept")
+	assert.Len(t, changes, 1)
+}
+
+func TestWatcher_StartPicksUpAtomicSaves(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	require.NoError(t, os.WriteFile(path, []byte(validConfigJSON), 0644))
+	initial, err := LoadConfigFromPath(path)
+	require.NoError(t, err)
+
+	w := NewWatcher(path, initial)
+	changed := make(chan *Change, 1)
+	w.OnChange(func(c *Change) { changed <- c })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, w.Start(ctx))
+
+	next := *initial
+	next.VCSPreference = "git"
+	require.NoError(t, saveConfig(&next, path))
+
+	select {
+	case c := <-changed:
+		assert.Equal(t, []string{"vcs_preference"}, c.Fields)
+		assert.Equal(t, "git", w.Current().VCSPreference)
+	case <-time.After(5 * time.Second):
+		t.Fatal("config change was not picked up")
+	}
+}
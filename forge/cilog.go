@@ -0,0 +1,96 @@
+package forge
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// failureContextLines is how many lines before the first and after the last
+// error marker are kept around the failure section.
+const failureContextLines = 5
+
+var (
+	// logTimestampPattern matches the RFC 3339 prefix GitHub Actions puts on every log line.
+	logTimestampPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?Z ?`)
+	// ansiPattern matches ANSI colour/erase sequences (GitLab traces are full of them).
+	ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+	// gitlabSectionPattern matches GitLab's collapsible section markers.
+	gitlabSectionPattern = regexp.MustCompile(`section_(start|end):\d+:[A-Za-z0-9_.-]+`)
+	// failureMarkerPattern matches lines that typically announce a CI failure.
+	failureMarkerPattern = regexp.MustCompile(`##\[error\]|^--- FAIL|^FAIL\b|^FAILED\b|^ERROR\b|^panic:|` +
+		`\b[Ee]rror(\[[A-Z0-9]+\])?:|^npm ERR!|^Traceback \(most recent call last\)|` +
+		`\bAssertionError\b|\bexit (code|status) [1-9]\d*|^ERROR: Job failed`)
+)
+
+// ExtractFailure returns the part of a CI job log that explains the failure,
+// capped at maxLines. Log noise (timestamps, ANSI escapes, group markers) is
+// removed first. The excerpt spans from a little before the first error marker
+// to a little after the last; when that is too long, its head and tail are kept
+// with an omission note in between. Logs without recognisable markers yield
+// their last maxLines lines.
+func ExtractFailure(log string, maxLines int) string {
+	if maxLines <= 0 {
+		return ""
+	}
+	lines := cleanLogLines(log)
+	if len(lines) == 0 {
+		return ""
+	}
+
+	first, last := -1, -1
+	for i, line := range lines {
+		if failureMarkerPattern.MatchString(line) {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		if len(lines) > maxLines {
+			lines = lines[len(lines)-maxLines:]
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	start := max(first-failureContextLines, 0)
+	end := min(last+failureContextLines+1, len(lines))
+	section := lines[start:end]
+	if len(section) <= maxLines {
+		return strings.Join(section, "\n")
+	}
+
+	// Keep the start of the failure (usually the root cause) and its end
+	// (usually the summary), dropping the middle.
+	head := (maxLines - 1) / 2
+	tail := maxLines - 1 - head
+	omitted := len(section) - head - tail
+	out := make([]string, 0, maxLines)
+	out = append(out, section[:head]...)
+	out = append(out, fmt.Sprintf("... (%d lines omitted) ...", omitted))
+	out = append(out, section[len(section)-tail:]...)
+	return strings.Join(out, "\n")
+}
+
+// cleanLogLines splits a raw log into lines with forge decorations removed.
+// Blank lines and GitHub group markers are dropped.
+func cleanLogLines(log string) []string {
+	raw := strings.Split(strings.ReplaceAll(log, "\r\n", "\n"), "\n")
+	lines := make([]string, 0, len(raw))
+	for _, line := range raw {
+		// GitLab rewrites lines in place with bare carriage returns; keep the final state.
+		if i := strings.LastIndex(line, "\r"); i >= 0 {
+			line = line[i+1:]
+		}
+		line = ansiPattern.ReplaceAllString(line, "")
+		line = gitlabSectionPattern.ReplaceAllString(line, "")
+		line = logTimestampPattern.ReplaceAllString(line, "")
+		line = strings.TrimRight(line, " \t")
+		if line == "" || strings.HasPrefix(line, "##[group]") || line == "##[endgroup]" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
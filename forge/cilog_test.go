@@ -0,0 +1,59 @@
+package forge
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractFailure_GitHubGoTest(t *testing.T) {
+	data, err := os.ReadFile("testdata/ci/github_go_test.log")
+	require.NoError(t, err)
+
+	excerpt := ExtractFailure(string(data), 40)
+	lines := strings.Split(excerpt, "\n")
+
+	assert.Contains(t, excerpt, "--- FAIL: TestRetryBudget (0.00s)")
+	assert.Contains(t, excerpt, "retry_test.go:41: expected 3 attempts, got 5")
+	assert.Contains(t, excerpt, "##[error]Process completed with exit code 1.")
+	assert.NotContains(t, excerpt, "2026-10-12T", "timestamps are stripped")
+	assert.NotContains(t, excerpt, "repository: acme/widgets", "setup output before the failure is dropped")
+	assert.NotContains(t, excerpt, "Removing temporary file /tmp/go-build8", "post-job cleanup beyond the context window is dropped")
+	assert.LessOrEqual(t, len(lines), 40)
+}
+
+func TestExtractFailure_GitLabTrace(t *testing.T) {
+	data, err := os.ReadFile("testdata/ci/gitlab_jest.trace")
+	require.NoError(t, err)
+
+	excerpt := ExtractFailure(string(data), 40)
+	assert.Contains(t, excerpt, "FAIL src/upload.test.js")
+	assert.Contains(t, excerpt, "ERROR: Job failed: exit code 1")
+	assert.NotContains(t, excerpt, "\x1b[")
+	assert.NotContains(t, excerpt, "section_")
+}
+
+func TestExtractFailure_TruncatesLongSections(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("error: first failure\n")
+	for i := 0; i < 200; i++ {
+		b.WriteString("noise line\n")
+	}
+	b.WriteString("error: last failure\n")
+
+	excerpt := ExtractFailure(b.String(), 11)
+	lines := strings.Split(excerpt, "\n")
+	require.Len(t, lines, 11)
+	assert.Equal(t, "error: first failure", lines[0])
+	assert.Equal(t, "... (192 lines omitted) ...", lines[5])
+	assert.Equal(t, "error: last failure", lines[10])
+}
+
+func TestExtractFailure_NoMarkersReturnsTail(t *testing.T) {
+	excerpt := ExtractFailure("one\ntwo\nthree\nfour\n", 2)
+	assert.Equal(t, "three\nfour", excerpt)
+	assert.Empty(t, ExtractFailure("", 10))
+}
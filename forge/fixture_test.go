@@ -0,0 +1,86 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// recordedRequest is a request received by a fixtureServer.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   map[string]any
+}
+
+// fixtureServer replays recorded forge API responses from testdata/<dir>.
+// Routes are keyed by "METHOD /escaped/path" (query strings are ignored);
+// a route value of "" answers 204 No Content. Unknown routes answer 404.
+type fixtureServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+func newFixtureServer(t *testing.T, dir string, routes map[string]string) *fixtureServer {
+	t.Helper()
+	fs := &fixtureServer{}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{Method: r.Method, Path: r.URL.EscapedPath(), Header: r.Header.Clone()}
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			_ = json.Unmarshal(data, &rec.Body)
+		}
+		fs.mu.Lock()
+		fs.requests = append(fs.requests, rec)
+		fs.mu.Unlock()
+
+		fixture, ok := routes[r.Method+" "+r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if fixture == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", dir, fixture))
+		if err != nil {
+			t.Errorf("read fixture %s: %v", fixture, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(fs.Close)
+	return fs
+}
+
+// options returns forge Options pointed at the fixture server with a fixed token.
+func (fs *fixtureServer) options(prefix string) Options {
+	return Options{
+		BaseURL:    fs.URL + prefix,
+		Token:      func(context.Context) string { return "test-token" },
+		HTTPClient: fs.Client(),
+	}
+}
+
+// lastRequest returns the most recent request matching method, or nil.
+func (fs *fixtureServer) lastRequest(method string) *recordedRequest {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for i := len(fs.requests) - 1; i >= 0; i-- {
+		if fs.requests[i].Method == method {
+			return &fs.requests[i]
+		}
+	}
+	return nil
+}
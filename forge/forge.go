@@ -0,0 +1,321 @@
+// Package forge abstracts the code-hosting platform ("forge") behind a
+// repository's git remote. It exposes a single Forge interface for pull/merge
+// request metadata, comments, review state, CI status, merging and issue
+// listing, with implementations for GitHub, GitLab and Gitea.
+//
+// The implementation is chosen from the remote URL (see github.ParseRemoteURL);
+// hosts whose kind cannot be inferred from their name can be registered with
+// RegisterHost, typically from config.Config.ForgeHosts at startup.
+package forge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+// PRInfo is the forge-neutral pull/merge request representation. It aliases
+// github.PRInfo so existing consumers (DerivePRPriority, the PR status poller)
+// work unchanged for every forge.
+type PRInfo = github.PRInfo
+
+// PRComment is a general or review comment on a pull/merge request.
+type PRComment = github.PRComment
+
+// ErrNoPR is returned by GetPRForBranch when no pull/merge request exists for the branch.
+var ErrNoPR = github.ErrNoPR
+
+// ErrNotFound is returned when the forge API reports that a resource does not exist.
+var ErrNotFound = errors.New("forge: resource not found")
+
+// ErrUnsupported is returned when a forge cannot perform the requested operation.
+var ErrUnsupported = errors.New("forge: operation not supported")
+
+// Review states, normalized across forges.
+const (
+	ReviewApproved         = "approved"
+	ReviewChangesRequested = "changes_requested"
+	ReviewCommented        = "commented"
+	ReviewDismissed        = "dismissed"
+	ReviewPending          = "pending"
+)
+
+// Review is a single review submitted on a pull/merge request.
+type Review struct {
+	ID          int
+	Author      string
+	State       string // One of the Review* constants
+	Body        string
+	SubmittedAt time.Time
+}
+
+// CheckRun is a single CI job or status check reported for a pull/merge request.
+type CheckRun struct {
+	ID         int
+	Name       string
+	Status     string // "queued" / "in_progress" / "completed"
+	Conclusion string // "success" / "failure" / "cancelled" / "skipped" / "neutral" / "" while running
+	DetailsURL string
+}
+
+// CIStatus is the aggregate CI state of a pull/merge request's head commit.
+type CIStatus struct {
+	Conclusion string // Same vocabulary as PRInfo.CheckConclusion
+	Status     string // Same vocabulary as PRInfo.CheckStatus
+	Checks     []CheckRun
+}
+
+// Issue is an issue tracked by the forge.
+type Issue struct {
+	Number    int
+	Title     string
+	Body      string
+	State     string // "open" / "closed"
+	Author    string
+	Labels    []string
+	HTMLURL   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IssueListOptions filters ListIssues results.
+type IssueListOptions struct {
+	// State is "open" (default), "closed" or "all".
+	State string
+	// Labels restricts results to issues carrying all of the given labels.
+	Labels []string
+	// Limit caps the number of issues returned. Zero means 50.
+	Limit int
+}
+
+// Forge is the operations stapler-squad needs from a code-hosting platform.
+// "PR" refers to GitHub/Gitea pull requests and GitLab merge requests alike;
+// owner may contain slashes for GitLab subgroups.
+type Forge interface {
+	// Kind reports which platform this forge talks to.
+	Kind() github.ForgeKind
+
+	// GetPR fetches a pull request including review and CI summary fields.
+	GetPR(ctx context.Context, owner, repo string, number int) (*PRInfo, error)
+	// GetPRForBranch finds the most recently updated pull request whose head is branch.
+	// Returns ErrNoPR when none exists.
+	GetPRForBranch(ctx context.Context, owner, repo, branch string) (*PRInfo, error)
+	// ListComments returns general and review comments, oldest first.
+	ListComments(ctx context.Context, owner, repo string, number int) ([]PRComment, error)
+	// PostComment adds a general comment to the pull request.
+	PostComment(ctx context.Context, owner, repo string, number int, body string) error
+	// ListReviews returns submitted reviews, oldest first.
+	ListReviews(ctx context.Context, owner, repo string, number int) ([]Review, error)
+	// GetCIStatus returns the CI checks for the pull request's head commit.
+	GetCIStatus(ctx context.Context, owner, repo string, number int) (*CIStatus, error)
+	// GetCheckLog returns the (tail of the) raw log for a check returned by GetCIStatus.
+	// Returns ErrUnsupported when the forge has no job log API and ErrNotFound when
+	// the check has no downloadable log (e.g. a third-party status).
+	GetCheckLog(ctx context.Context, owner, repo string, check CheckRun) (string, error)
+	// MergePR merges the pull request. method is "merge", "squash" or "rebase".
+	MergePR(ctx context.Context, owner, repo string, number int, method string) error
+	// ClosePR closes the pull request without merging.
+	ClosePR(ctx context.Context, owner, repo string, number int) error
+	// ListIssues lists repository issues (pull requests are excluded).
+	ListIssues(ctx context.Context, owner, repo string, opts IssueListOptions) ([]Issue, error)
+}
+
+// ConditionalGetter is implemented by forges that can report whether a pull
+// request changed since it was last fetched without spending rate-limit quota.
+type ConditionalGetter interface {
+	// GetPRConditional returns the PR and whether it changed since the cached
+	// copy; changed is false when the forge answered "not modified".
+	GetPRConditional(ctx context.Context, owner, repo string, number int, cache *github.ETagCache) (*PRInfo, bool, error)
+}
+
+// Options configures a forge client.
+type Options struct {
+	// BaseURL is the API root, e.g. "https://api.github.com" or
+	// "https://gitlab.example.com/api/v4". Defaults to the platform's public API.
+	BaseURL string
+	// Token returns the API token to send. A nil func or empty result sends
+	// unauthenticated requests.
+	Token func(ctx context.Context) string
+	// HTTPClient is used for all requests. Defaults to a client with a 30s timeout.
+	HTTPClient *http.Client
+}
+
+var (
+	hostKindsMu sync.RWMutex
+	hostKinds   = make(map[string]github.ForgeKind)
+)
+
+// RegisterHost records the forge kind for a self-hosted instance whose
+// hostname does not reveal it (e.g. "git.corp.example" running GitLab).
+// Registrations take precedence over github.DetectForgeKind.
+func RegisterHost(host string, kind github.ForgeKind) {
+	hostKindsMu.Lock()
+	defer hostKindsMu.Unlock()
+	hostKinds[strings.ToLower(host)] = kind
+}
+
+// UnregisterHost removes a mapping added by RegisterHost.
+func UnregisterHost(host string) {
+	hostKindsMu.Lock()
+	defer hostKindsMu.Unlock()
+	delete(hostKinds, strings.ToLower(host))
+}
+
+// kindForHost returns the registered kind for host, falling back to the
+// hostname heuristics in github.DetectForgeKind.
+func kindForHost(host string, detected github.ForgeKind) github.ForgeKind {
+	hostKindsMu.RLock()
+	defer hostKindsMu.RUnlock()
+	if kind, ok := hostKinds[strings.ToLower(host)]; ok {
+		return kind
+	}
+	return detected
+}
+
+// New constructs the forge client of the given kind for host, using the
+// platform's default API path and token source.
+func New(kind github.ForgeKind, host string) (Forge, error) {
+	switch kind {
+	case github.ForgeGitHub:
+		return NewGitHub(defaultGitHubOptions(host)), nil
+	case github.ForgeGitLab:
+		return NewGitLab(defaultGitLabOptions(host)), nil
+	case github.ForgeGitea:
+		return NewGitea(defaultGiteaOptions(host)), nil
+	default:
+		return nil, fmt.Errorf("forge: unknown forge for host %q (register it with forge_hosts in config)", host)
+	}
+}
+
+// FromRemoteURL parses a git remote URL and returns the matching forge client
+// together with the parsed owner/repo reference.
+func FromRemoteURL(remote string) (Forge, *github.RemoteRef, error) {
+	ref, err := github.ParseRemoteURL(remote)
+	if err != nil {
+		return nil, nil, err
+	}
+	ref.Kind = kindForHost(ref.Host, ref.Kind)
+
+	f, err := New(ref.Kind, ref.Host)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, ref, nil
+}
+
+// ForRepoPath resolves the forge for the repository at repoPath from its
+// "origin" remote.
+func ForRepoPath(repoPath string) (Forge, *github.RemoteRef, error) {
+	remote, err := github.GetRemoteURL(repoPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return FromRemoteURL(remote)
+}
+
+// summarizeReviews derives approved/changes-requested counts and a review
+// decision from reviews, using the latest non-comment state per reviewer.
+func summarizeReviews(reviews []Review) (decision string, approved, changesRequested int) {
+	latestState := make(map[string]string)
+	for _, r := range reviews {
+		switch r.State {
+		case ReviewDismissed:
+			delete(latestState, r.Author)
+		case ReviewApproved, ReviewChangesRequested:
+			latestState[r.Author] = r.State
+		}
+	}
+	for _, state := range latestState {
+		switch state {
+		case ReviewApproved:
+			approved++
+		case ReviewChangesRequested:
+			changesRequested++
+		}
+	}
+	switch {
+	case changesRequested > 0:
+		decision = ReviewChangesRequested
+	case approved > 0:
+		decision = ReviewApproved
+	}
+	return decision, approved, changesRequested
+}
+
+// summarizeChecks derives a single conclusion/status pair from check runs,
+// matching the vocabulary of PRInfo.CheckConclusion and PRInfo.CheckStatus.
+func summarizeChecks(checks []CheckRun) (conclusion, status string) {
+	if len(checks) == 0 {
+		return "", ""
+	}
+	hasInProgress := false
+	hasFailure := false
+	allSuccess := true
+
+	for _, check := range checks {
+		switch {
+		case check.Conclusion == "failure" || check.Conclusion == "action_required" || check.Conclusion == "timed_out":
+			hasFailure = true
+			allSuccess = false
+		case check.Conclusion == "success" || check.Conclusion == "skipped":
+			// success
+		case check.Status != "completed":
+			hasInProgress = true
+			allSuccess = false
+		default:
+			allSuccess = false
+		}
+	}
+	if hasFailure {
+		return "failure", "completed"
+	}
+	if hasInProgress {
+		return "pending", "in_progress"
+	}
+	if allSuccess {
+		return "success", "completed"
+	}
+	return "neutral", "completed"
+}
+
+// fillPRSummary populates the review and CI summary fields of pr from f.
+func fillPRSummary(ctx context.Context, f Forge, owner, repo string, pr *PRInfo) error {
+	reviews, err := f.ListReviews(ctx, owner, repo, pr.Number)
+	if err != nil {
+		return fmt.Errorf("list reviews: %w", err)
+	}
+	pr.ReviewDecision, pr.ApprovedCount, pr.ChangesRequestedCount = summarizeReviews(reviews)
+
+	ci, err := f.GetCIStatus(ctx, owner, repo, pr.Number)
+	if err != nil {
+		return fmt.Errorf("get CI status: %w", err)
+	}
+	pr.CheckConclusion, pr.CheckStatus = ci.Conclusion, ci.Status
+	return nil
+}
+
+// normalizeMergeMethod validates method, defaulting "" to "merge".
+func normalizeMergeMethod(method string) (string, error) {
+	switch method {
+	case "":
+		return "merge", nil
+	case "merge", "squash", "rebase":
+		return method, nil
+	default:
+		return "", fmt.Errorf("invalid merge method '%s': must be 'merge', 'squash', or 'rebase'", method)
+	}
+}
+
+// issueLimit returns opts.Limit or the default page size.
+func issueLimit(opts IssueListOptions) int {
+	if opts.Limit <= 0 {
+		return 50
+	}
+	return opts.Limit
+}
@@ -0,0 +1,93 @@
+package forge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+func TestFromRemoteURL(t *testing.T) {
+	tests := []struct {
+		remote    string
+		wantKind  github.ForgeKind
+		wantOwner string
+		wantErr   bool
+	}{
+		{"git@github.com:acme/widgets.git", github.ForgeGitHub, "acme", false},
+		{"https://gitlab.example.com/platform/infra/deploy.git", github.ForgeGitLab, "platform/infra", false},
+		{"https://codeberg.org/ops/tools.git", github.ForgeGitea, "ops", false},
+		{"git@git.unknown.example:team/app.git", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.remote, func(t *testing.T) {
+			f, ref, err := FromRemoteURL(tt.remote)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKind, f.Kind())
+			assert.Equal(t, tt.wantOwner, ref.Owner)
+		})
+	}
+}
+
+func TestFromRemoteURL_RegisteredHost(t *testing.T) {
+	RegisterHost("git.corp.example", github.ForgeGitLab)
+	t.Cleanup(func() {
+		hostKindsMu.Lock()
+		delete(hostKinds, "git.corp.example")
+		hostKindsMu.Unlock()
+	})
+
+	f, ref, err := FromRemoteURL("git@git.corp.example:team/app.git")
+	require.NoError(t, err)
+	assert.Equal(t, github.ForgeGitLab, f.Kind())
+	assert.Equal(t, github.ForgeGitLab, ref.Kind)
+	assert.Equal(t, "https://git.corp.example/api/v4", f.(*GitLab).api.baseURL)
+}
+
+func TestDefaultGitHubOptions_Enterprise(t *testing.T) {
+	assert.Equal(t, "", defaultGitHubOptions("github.com").BaseURL)
+	assert.Equal(t, "https://github.corp.example/api/v3", defaultGitHubOptions("github.corp.example").BaseURL)
+}
+
+func TestSummarizeChecks(t *testing.T) {
+	tests := []struct {
+		name           string
+		checks         []CheckRun
+		wantConclusion string
+		wantStatus     string
+	}{
+		{"no checks", nil, "", ""},
+		{"all green", []CheckRun{{Status: "completed", Conclusion: "success"}, {Status: "completed", Conclusion: "skipped"}}, "success", "completed"},
+		{"failure wins", []CheckRun{{Status: "in_progress"}, {Status: "completed", Conclusion: "failure"}}, "failure", "completed"},
+		{"running", []CheckRun{{Status: "completed", Conclusion: "success"}, {Status: "queued"}}, "pending", "in_progress"},
+		{"cancelled", []CheckRun{{Status: "completed", Conclusion: "cancelled"}}, "neutral", "completed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conclusion, status := summarizeChecks(tt.checks)
+			assert.Equal(t, tt.wantConclusion, conclusion)
+			assert.Equal(t, tt.wantStatus, status)
+		})
+	}
+}
+
+func TestTailBuffer_KeepsLastBytes(t *testing.T) {
+	tail := &tailBuffer{limit: 8}
+	for _, chunk := range []string{"0123", "456789", "abcdefghij", "k"} {
+		n, err := tail.Write([]byte(chunk))
+		require.NoError(t, err)
+		assert.Equal(t, len(chunk), n)
+		assert.LessOrEqual(t, len(tail.buf), 2*tail.limit)
+	}
+	assert.Equal(t, "defghijk", string(tail.Bytes()))
+
+	short := &tailBuffer{limit: 8}
+	_, _ = short.Write([]byte("abc"))
+	assert.Equal(t, "abc", string(short.Bytes()))
+}
@@ -0,0 +1,400 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+// Gitea implements Forge against the Gitea REST API (v1). Forgejo and
+// Codeberg expose the same API and are served by this client too.
+type Gitea struct {
+	api *apiClient
+}
+
+// NewGitea creates a Gitea forge client.
+func NewGitea(opts Options) *Gitea {
+	if opts.BaseURL == "" {
+		opts.BaseURL = "https://gitea.com/api/v1"
+	}
+	return &Gitea{api: newAPIClient("Gitea API", opts,
+		func(req *http.Request, token string) { req.Header.Set("Authorization", "token "+token) },
+		nil)}
+}
+
+// defaultGiteaOptions targets https://<host>/api/v1 and reads the token from
+// GITEA_TOKEN, falling back to FORGEJO_TOKEN.
+func defaultGiteaOptions(host string) Options {
+	return Options{
+		BaseURL: "https://" + host + "/api/v1",
+		Token: func(context.Context) string {
+			if tok := os.Getenv("GITEA_TOKEN"); tok != "" {
+				return tok
+			}
+			return os.Getenv("FORGEJO_TOKEN")
+		},
+	}
+}
+
+// Kind implements Forge.
+func (g *Gitea) Kind() github.ForgeKind { return github.ForgeGitea }
+
+type gtPull struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	State  string `json:"state"` // open, closed
+	Merged bool   `json:"merged"`
+	Draft  bool   `json:"draft"`
+	Head   struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+	User         ghUser    `json:"user"`
+	Labels       []ghLabel `json:"labels"`
+	HTMLURL      string    `json:"html_url"`
+	CreatedAt    string    `json:"created_at"`
+	UpdatedAt    string    `json:"updated_at"`
+	Mergeable    bool      `json:"mergeable"`
+	Additions    int       `json:"additions"`
+	Deletions    int       `json:"deletions"`
+	ChangedFiles int       `json:"changed_files"`
+}
+
+func (g *Gitea) repoPath(owner, repo string) string {
+	return fmt.Sprintf("repos/%s/%s", url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func (g *Gitea) getPull(ctx context.Context, owner, repo string, number int) (*gtPull, error) {
+	var pull gtPull
+	if err := g.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/pulls/%d", g.repoPath(owner, repo), number), nil, &pull); err != nil {
+		return nil, fmt.Errorf("failed to get PR info: %w", err)
+	}
+	return &pull, nil
+}
+
+// GetPR implements Forge.
+func (g *Gitea) GetPR(ctx context.Context, owner, repo string, number int) (*PRInfo, error) {
+	pull, err := g.getPull(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	state := pull.State
+	if pull.Merged {
+		state = "merged"
+	}
+	mergeable := "CONFLICTING"
+	if pull.Mergeable {
+		mergeable = "MERGEABLE"
+	}
+	labels := make([]string, len(pull.Labels))
+	for i, l := range pull.Labels {
+		labels[i] = l.Name
+	}
+	// Older Gitea releases have no draft flag and rely on a WIP title prefix.
+	draft := pull.Draft || strings.HasPrefix(pull.Title, "WIP:") || strings.HasPrefix(pull.Title, "[WIP]")
+
+	pr := &PRInfo{
+		Number:       pull.Number,
+		Title:        pull.Title,
+		Body:         pull.Body,
+		HeadRef:      pull.Head.Ref,
+		BaseRef:      pull.Base.Ref,
+		State:        state,
+		Author:       pull.User.Login,
+		Labels:       labels,
+		HTMLURL:      pull.HTMLURL,
+		CreatedAt:    parseTime(pull.CreatedAt),
+		UpdatedAt:    parseTime(pull.UpdatedAt),
+		IsDraft:      draft,
+		Mergeable:    mergeable,
+		Additions:    pull.Additions,
+		Deletions:    pull.Deletions,
+		ChangedFiles: pull.ChangedFiles,
+	}
+	if err := fillPRSummary(ctx, g, owner, repo, pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// GetPRForBranch implements Forge. Gitea cannot filter pulls by head branch,
+// so the most recently updated page is scanned instead.
+func (g *Gitea) GetPRForBranch(ctx context.Context, owner, repo, branch string) (*PRInfo, error) {
+	var pulls []gtPull
+	if err := g.api.do(ctx, http.MethodGet,
+		g.repoPath(owner, repo)+"/pulls?state=all&sort=recentupdate&limit=50", nil, &pulls); err != nil {
+		return nil, fmt.Errorf("PR list request failed: %w", err)
+	}
+	for _, p := range pulls {
+		if p.Head.Ref == branch {
+			return g.GetPR(ctx, owner, repo, p.Number)
+		}
+	}
+	return nil, ErrNoPR
+}
+
+type gtReview struct {
+	ID          int    `json:"id"`
+	User        ghUser `json:"user"`
+	State       string `json:"state"` // APPROVED, REQUEST_CHANGES, COMMENT, PENDING, REQUEST_REVIEW
+	Body        string `json:"body"`
+	SubmittedAt string `json:"submitted_at"`
+	Dismissed   bool   `json:"dismissed"`
+	Stale       bool   `json:"stale"`
+}
+
+func (g *Gitea) listRawReviews(ctx context.Context, owner, repo string, number int) ([]gtReview, error) {
+	var raw []gtReview
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/pulls/%d/reviews?limit=50", g.repoPath(owner, repo), number), nil, &raw); err != nil {
+		return nil, fmt.Errorf("failed to get PR reviews: %w", err)
+	}
+	return raw, nil
+}
+
+// ListComments implements Forge. Inline comments live under each review, so
+// reviews with comments are expanded one request at a time.
+func (g *Gitea) ListComments(ctx context.Context, owner, repo string, number int) ([]PRComment, error) {
+	var issueComments []struct {
+		ID        int    `json:"id"`
+		User      ghUser `json:"user"`
+		Body      string `json:"body"`
+		CreatedAt string `json:"created_at"`
+		HTMLURL   string `json:"html_url"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/issues/%d/comments", g.repoPath(owner, repo), number), nil, &issueComments); err != nil {
+		return nil, fmt.Errorf("failed to get PR comments: %w", err)
+	}
+
+	comments := make([]PRComment, 0, len(issueComments))
+	for _, c := range issueComments {
+		comments = append(comments, PRComment{
+			ID:        c.ID,
+			Author:    c.User.Login,
+			Body:      c.Body,
+			CreatedAt: parseTime(c.CreatedAt),
+			HTMLURL:   c.HTMLURL,
+		})
+	}
+
+	reviews, err := g.listRawReviews(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reviews {
+		var reviewComments []struct {
+			ID           int    `json:"id"`
+			User         ghUser `json:"user"`
+			Body         string `json:"body"`
+			Path         string `json:"path"`
+			Position     int    `json:"position"`
+			OrigPosition int    `json:"original_position"`
+			DiffHunk     string `json:"diff_hunk"`
+			CreatedAt    string `json:"created_at"`
+			HTMLURL      string `json:"html_url"`
+		}
+		if err := g.api.do(ctx, http.MethodGet,
+			fmt.Sprintf("%s/pulls/%d/reviews/%d/comments", g.repoPath(owner, repo), number, r.ID), nil, &reviewComments); err != nil {
+			return nil, fmt.Errorf("failed to get review comments: %w", err)
+		}
+		for _, c := range reviewComments {
+			line := c.Position
+			if line == 0 {
+				line = c.OrigPosition
+			}
+			comments = append(comments, PRComment{
+				ID:        c.ID,
+				Author:    c.User.Login,
+				Body:      c.Body,
+				CreatedAt: parseTime(c.CreatedAt),
+				Path:      c.Path,
+				Line:      line,
+				IsReview:  true,
+				DiffHunk:  c.DiffHunk,
+				HTMLURL:   c.HTMLURL,
+			})
+		}
+	}
+
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+	return comments, nil
+}
+
+// PostComment implements Forge.
+func (g *Gitea) PostComment(ctx context.Context, owner, repo string, number int, body string) error {
+	payload := map[string]string{"body": body}
+	if err := g.api.do(ctx, http.MethodPost,
+		fmt.Sprintf("%s/issues/%d/comments", g.repoPath(owner, repo), number), payload, nil); err != nil {
+		return fmt.Errorf("failed to post comment: %w", err)
+	}
+	return nil
+}
+
+// ListReviews implements Forge.
+func (g *Gitea) ListReviews(ctx context.Context, owner, repo string, number int) ([]Review, error) {
+	raw, err := g.listRawReviews(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]Review, 0, len(raw))
+	for _, r := range raw {
+		var state string
+		switch {
+		case r.Dismissed:
+			state = ReviewDismissed
+		case r.State == "APPROVED":
+			state = ReviewApproved
+		case r.State == "REQUEST_CHANGES":
+			state = ReviewChangesRequested
+		case r.State == "COMMENT":
+			state = ReviewCommented
+		case r.State == "PENDING":
+			state = ReviewPending
+		default:
+			continue // REQUEST_REVIEW entries are review requests, not reviews
+		}
+		reviews = append(reviews, Review{
+			ID:          r.ID,
+			Author:      r.User.Login,
+			State:       state,
+			Body:        r.Body,
+			SubmittedAt: parseTime(r.SubmittedAt),
+		})
+	}
+	return reviews, nil
+}
+
+// GetCIStatus implements Forge using the combined commit status of the PR head.
+func (g *Gitea) GetCIStatus(ctx context.Context, owner, repo string, number int) (*CIStatus, error) {
+	pull, err := g.getPull(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var combined struct {
+		Statuses []struct {
+			ID        int    `json:"id"`
+			Context   string `json:"context"`
+			Status    string `json:"status"` // pending, success, error, failure, warning
+			TargetURL string `json:"target_url"`
+		} `json:"statuses"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/commits/%s/status", g.repoPath(owner, repo), url.PathEscape(pull.Head.SHA)), nil, &combined); err != nil {
+		return nil, fmt.Errorf("failed to get commit status: %w", err)
+	}
+
+	checks := make([]CheckRun, len(combined.Statuses))
+	for i, s := range combined.Statuses {
+		check := CheckRun{ID: s.ID, Name: s.Context, Status: "completed", DetailsURL: s.TargetURL}
+		switch s.Status {
+		case "success":
+			check.Conclusion = "success"
+		case "failure", "error":
+			check.Conclusion = "failure"
+		case "warning":
+			check.Conclusion = "neutral"
+		default:
+			check.Status = "in_progress"
+		}
+		checks[i] = check
+	}
+	status := &CIStatus{Checks: checks}
+	status.Conclusion, status.Status = summarizeChecks(checks)
+	return status, nil
+}
+
+// GetCheckLog implements Forge. Gitea commit statuses carry no log API.
+func (g *Gitea) GetCheckLog(context.Context, string, string, CheckRun) (string, error) {
+	return "", ErrUnsupported
+}
+
+// MergePR implements Forge.
+func (g *Gitea) MergePR(ctx context.Context, owner, repo string, number int, method string) error {
+	method, err := normalizeMergeMethod(method)
+	if err != nil {
+		return err
+	}
+	payload := map[string]string{"Do": method}
+	if err := g.api.do(ctx, http.MethodPost,
+		fmt.Sprintf("%s/pulls/%d/merge", g.repoPath(owner, repo), number), payload, nil); err != nil {
+		return fmt.Errorf("failed to merge PR: %w", err)
+	}
+	return nil
+}
+
+// ClosePR implements Forge.
+func (g *Gitea) ClosePR(ctx context.Context, owner, repo string, number int) error {
+	payload := map[string]string{"state": "closed"}
+	if err := g.api.do(ctx, http.MethodPatch,
+		fmt.Sprintf("%s/pulls/%d", g.repoPath(owner, repo), number), payload, nil); err != nil {
+		return fmt.Errorf("failed to close PR: %w", err)
+	}
+	return nil
+}
+
+// ListIssues implements Forge.
+func (g *Gitea) ListIssues(ctx context.Context, owner, repo string, opts IssueListOptions) ([]Issue, error) {
+	state := opts.State
+	if state == "" {
+		state = "open"
+	}
+	query := url.Values{}
+	query.Set("state", state)
+	query.Set("type", "issues")
+	query.Set("limit", fmt.Sprint(issueLimit(opts)))
+	if len(opts.Labels) > 0 {
+		query.Set("labels", strings.Join(opts.Labels, ","))
+	}
+
+	var raw []struct {
+		Number    int       `json:"number"`
+		Title     string    `json:"title"`
+		Body      string    `json:"body"`
+		State     string    `json:"state"`
+		User      ghUser    `json:"user"`
+		Labels    []ghLabel `json:"labels"`
+		HTMLURL   string    `json:"html_url"`
+		CreatedAt string    `json:"created_at"`
+		UpdatedAt string    `json:"updated_at"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/issues?%s", g.repoPath(owner, repo), query.Encode()), nil, &raw); err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	issues := make([]Issue, len(raw))
+	for i, r := range raw {
+		labels := make([]string, len(r.Labels))
+		for j, l := range r.Labels {
+			labels[j] = l.Name
+		}
+		issues[i] = Issue{
+			Number:    r.Number,
+			Title:     r.Title,
+			Body:      r.Body,
+			State:     r.State,
+			Author:    r.User.Login,
+			Labels:    labels,
+			HTMLURL:   r.HTMLURL,
+			CreatedAt: parseTime(r.CreatedAt),
+			UpdatedAt: parseTime(r.UpdatedAt),
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,113 @@
+package forge
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+func newGiteaFixture(t *testing.T) (*Gitea, *fixtureServer) {
+	t.Helper()
+	const repo = "/api/v1/repos/ops/tools"
+	fs := newFixtureServer(t, "gitea", map[string]string{
+		"GET " + repo + "/pulls/3":                                                 "pull.json",
+		"GET " + repo + "/pulls/3/reviews":                                         "reviews.json",
+		"GET " + repo + "/pulls/3/reviews/11/comments":                             "empty_list.json",
+		"GET " + repo + "/pulls/3/reviews/12/comments":                             "review_12_comments.json",
+		"GET " + repo + "/pulls/3/reviews/13/comments":                             "empty_list.json",
+		"GET " + repo + "/issues/3/comments":                                       "issue_comments.json",
+		"GET " + repo + "/commits/0c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d/status": "status.json",
+		"GET " + repo + "/pulls":                                                   "pulls.json",
+		"GET " + repo + "/issues":                                                  "issues.json",
+		"POST " + repo + "/issues/3/comments":                                      "",
+		"POST " + repo + "/pulls/3/merge":                                          "",
+		"PATCH " + repo + "/pulls/3":                                               "",
+	})
+	return NewGitea(fs.options("/api/v1")), fs
+}
+
+func TestGitea_GetPR(t *testing.T) {
+	f, fs := newGiteaFixture(t)
+
+	pr, err := f.GetPR(context.Background(), "ops", "tools", 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, pr.Number)
+	assert.Equal(t, "fix/cron", pr.HeadRef)
+	assert.Equal(t, "merged", pr.State)
+	assert.Equal(t, "kim", pr.Author)
+	assert.Equal(t, []string{"bug"}, pr.Labels)
+
+	// lee's change request was dismissed and superseded by an approval.
+	assert.Equal(t, 1, pr.ApprovedCount)
+	assert.Equal(t, 0, pr.ChangesRequestedCount)
+	assert.Equal(t, "pending", pr.CheckConclusion)
+	assert.Equal(t, github.PRPriorityComplete, github.DerivePRPriority(pr))
+
+	req := fs.lastRequest(http.MethodGet)
+	require.NotNil(t, req)
+	assert.Equal(t, "token test-token", req.Header.Get("Authorization"))
+}
+
+func TestGitea_GetPRForBranch(t *testing.T) {
+	f, _ := newGiteaFixture(t)
+
+	pr, err := f.GetPRForBranch(context.Background(), "ops", "tools", "fix/cron")
+	require.NoError(t, err)
+	assert.Equal(t, 3, pr.Number)
+
+	_, err = f.GetPRForBranch(context.Background(), "ops", "tools", "no/such-branch")
+	assert.ErrorIs(t, err, ErrNoPR)
+}
+
+func TestGitea_ListComments(t *testing.T) {
+	f, _ := newGiteaFixture(t)
+
+	comments, err := f.ListComments(context.Background(), "ops", "tools", 3)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+
+	assert.True(t, comments[0].IsReview, "review comment predates the issue comment")
+	assert.Equal(t, "cron/next.go", comments[0].Path)
+	assert.Equal(t, 22, comments[0].Line)
+	assert.Contains(t, comments[0].DiffHunk, "t.Round(0)")
+	assert.Equal(t, "Ready for another look.", comments[1].Body)
+}
+
+func TestGitea_ListReviews_DropsReviewRequests(t *testing.T) {
+	f, _ := newGiteaFixture(t)
+
+	reviews, err := f.ListReviews(context.Background(), "ops", "tools", 3)
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	assert.Equal(t, ReviewDismissed, reviews[0].State)
+	assert.Equal(t, ReviewApproved, reviews[1].State)
+}
+
+func TestGitea_ListIssues(t *testing.T) {
+	f, _ := newGiteaFixture(t)
+
+	issues, err := f.ListIssues(context.Background(), "ops", "tools", IssueListOptions{State: "open"})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "Backups skip Sunday", issues[0].Title)
+}
+
+func TestGitea_Mutations(t *testing.T) {
+	f, fs := newGiteaFixture(t)
+	ctx := context.Background()
+
+	require.NoError(t, f.PostComment(ctx, "ops", "tools", 3, "Shipped."))
+	assert.Equal(t, "Shipped.", fs.lastRequest(http.MethodPost).Body["body"])
+
+	require.NoError(t, f.MergePR(ctx, "ops", "tools", 3, "rebase"))
+	assert.Equal(t, "rebase", fs.lastRequest(http.MethodPost).Body["Do"])
+
+	require.NoError(t, f.ClosePR(ctx, "ops", "tools", 3))
+	assert.Equal(t, "closed", fs.lastRequest(http.MethodPatch).Body["state"])
+}
@@ -0,0 +1,394 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+// GitHub implements Forge against the GitHub REST API (github.com or GitHub
+// Enterprise Server).
+type GitHub struct {
+	api *apiClient
+}
+
+// NewGitHub creates a GitHub forge client.
+func NewGitHub(opts Options) *GitHub {
+	if opts.BaseURL == "" {
+		opts.BaseURL = "https://api.github.com"
+	}
+	return &GitHub{api: newAPIClient("GitHub API", opts,
+		func(req *http.Request, token string) { req.Header.Set("Authorization", "Bearer "+token) },
+		map[string]string{
+			"Accept":               "application/vnd.github+json",
+			"X-GitHub-Api-Version": "2022-11-28",
+		})}
+}
+
+// defaultGitHubOptions targets api.github.com for github.com and the
+// Enterprise Server API path for any other host. Tokens come from
+// GITHUB_TOKEN / GH_TOKEN / gh auth token via github.Token.
+func defaultGitHubOptions(host string) Options {
+	opts := Options{Token: github.Token}
+	if host != "" && host != "github.com" && host != "www.github.com" {
+		opts.BaseURL = "https://" + host + "/api/v3"
+	}
+	return opts
+}
+
+// Kind implements Forge.
+func (g *GitHub) Kind() github.ForgeKind { return github.ForgeGitHub }
+
+type ghUser struct {
+	Login string `json:"login"`
+}
+
+type ghLabel struct {
+	Name string `json:"name"`
+}
+
+type ghPull struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	State  string `json:"state"`
+	Merged bool   `json:"merged"`
+	Draft  bool   `json:"draft"`
+	Head   struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+	User         ghUser    `json:"user"`
+	Labels       []ghLabel `json:"labels"`
+	HTMLURL      string    `json:"html_url"`
+	CreatedAt    string    `json:"created_at"`
+	UpdatedAt    string    `json:"updated_at"`
+	MergedAt     string    `json:"merged_at"`
+	Mergeable    *bool     `json:"mergeable"`
+	Additions    int       `json:"additions"`
+	Deletions    int       `json:"deletions"`
+	ChangedFiles int       `json:"changed_files"`
+}
+
+func (g *GitHub) repoPath(owner, repo string) string {
+	return fmt.Sprintf("repos/%s/%s", url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func (g *GitHub) getPull(ctx context.Context, owner, repo string, number int) (*ghPull, error) {
+	var pull ghPull
+	if err := g.api.do(ctx, http.MethodGet, fmt.Sprintf("%s/pulls/%d", g.repoPath(owner, repo), number), nil, &pull); err != nil {
+		return nil, fmt.Errorf("failed to get PR info: %w", err)
+	}
+	return &pull, nil
+}
+
+// GetPRConditional implements ConditionalGetter. ETag requests are only made
+// against api.github.com; Enterprise Server hosts always fetch the PR.
+func (g *GitHub) GetPRConditional(ctx context.Context, owner, repo string, number int, cache *github.ETagCache) (*PRInfo, bool, error) {
+	if g.api.baseURL != "https://api.github.com" {
+		pr, err := g.GetPR(ctx, owner, repo, number)
+		return pr, err == nil, err
+	}
+	return github.GetPRInfoConditional(ctx, owner, repo, number, cache)
+}
+
+// GetPR implements Forge.
+func (g *GitHub) GetPR(ctx context.Context, owner, repo string, number int) (*PRInfo, error) {
+	pull, err := g.getPull(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	state := strings.ToLower(pull.State)
+	if pull.Merged || pull.MergedAt != "" {
+		state = "merged"
+	}
+	mergeable := "UNKNOWN"
+	if pull.Mergeable != nil {
+		mergeable = "CONFLICTING"
+		if *pull.Mergeable {
+			mergeable = "MERGEABLE"
+		}
+	}
+	labels := make([]string, len(pull.Labels))
+	for i, l := range pull.Labels {
+		labels[i] = l.Name
+	}
+
+	pr := &PRInfo{
+		Number:       pull.Number,
+		Title:        pull.Title,
+		Body:         pull.Body,
+		HeadRef:      pull.Head.Ref,
+		BaseRef:      pull.Base.Ref,
+		State:        state,
+		Author:       pull.User.Login,
+		Labels:       labels,
+		HTMLURL:      pull.HTMLURL,
+		CreatedAt:    parseTime(pull.CreatedAt),
+		UpdatedAt:    parseTime(pull.UpdatedAt),
+		IsDraft:      pull.Draft,
+		Mergeable:    mergeable,
+		Additions:    pull.Additions,
+		Deletions:    pull.Deletions,
+		ChangedFiles: pull.ChangedFiles,
+	}
+	if err := fillPRSummary(ctx, g, owner, repo, pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// GetPRForBranch implements Forge.
+func (g *GitHub) GetPRForBranch(ctx context.Context, owner, repo, branch string) (*PRInfo, error) {
+	var pulls []ghPull
+	path := fmt.Sprintf("%s/pulls?head=%s&state=all&per_page=10",
+		g.repoPath(owner, repo), url.QueryEscape(owner+":"+branch))
+	if err := g.api.do(ctx, http.MethodGet, path, nil, &pulls); err != nil {
+		return nil, fmt.Errorf("PR list request failed: %w", err)
+	}
+	if len(pulls) == 0 {
+		return nil, ErrNoPR
+	}
+	// Use most recently updated PR when multiple PRs target the same branch.
+	sort.Slice(pulls, func(i, j int) bool {
+		return parseTime(pulls[i].UpdatedAt).After(parseTime(pulls[j].UpdatedAt))
+	})
+	return g.GetPR(ctx, owner, repo, pulls[0].Number)
+}
+
+type ghComment struct {
+	ID        int    `json:"id"`
+	User      ghUser `json:"user"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+	HTMLURL   string `json:"html_url"`
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	OrigLine  int    `json:"original_line"`
+	DiffHunk  string `json:"diff_hunk"`
+}
+
+// ListComments implements Forge. Issue comments and inline review comments are
+// merged and sorted by creation time.
+func (g *GitHub) ListComments(ctx context.Context, owner, repo string, number int) ([]PRComment, error) {
+	var issueComments, reviewComments []ghComment
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/issues/%d/comments?per_page=100", g.repoPath(owner, repo), number), nil, &issueComments); err != nil {
+		return nil, fmt.Errorf("failed to get PR comments: %w", err)
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/pulls/%d/comments?per_page=100", g.repoPath(owner, repo), number), nil, &reviewComments); err != nil {
+		return nil, fmt.Errorf("failed to get PR review comments: %w", err)
+	}
+
+	comments := make([]PRComment, 0, len(issueComments)+len(reviewComments))
+	for _, c := range issueComments {
+		comments = append(comments, PRComment{
+			ID:        c.ID,
+			Author:    c.User.Login,
+			Body:      c.Body,
+			CreatedAt: parseTime(c.CreatedAt),
+			HTMLURL:   c.HTMLURL,
+		})
+	}
+	for _, c := range reviewComments {
+		line := c.Line
+		if line == 0 {
+			line = c.OrigLine // outdated comments lose their current line
+		}
+		comments = append(comments, PRComment{
+			ID:        c.ID,
+			Author:    c.User.Login,
+			Body:      c.Body,
+			CreatedAt: parseTime(c.CreatedAt),
+			Path:      c.Path,
+			Line:      line,
+			IsReview:  true,
+			DiffHunk:  c.DiffHunk,
+			HTMLURL:   c.HTMLURL,
+		})
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+	return comments, nil
+}
+
+// PostComment implements Forge.
+func (g *GitHub) PostComment(ctx context.Context, owner, repo string, number int, body string) error {
+	payload := map[string]string{"body": body}
+	if err := g.api.do(ctx, http.MethodPost,
+		fmt.Sprintf("%s/issues/%d/comments", g.repoPath(owner, repo), number), payload, nil); err != nil {
+		return fmt.Errorf("failed to post comment: %w", err)
+	}
+	return nil
+}
+
+// ListReviews implements Forge.
+func (g *GitHub) ListReviews(ctx context.Context, owner, repo string, number int) ([]Review, error) {
+	var raw []struct {
+		ID          int    `json:"id"`
+		User        ghUser `json:"user"`
+		State       string `json:"state"` // APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED, PENDING
+		Body        string `json:"body"`
+		SubmittedAt string `json:"submitted_at"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/pulls/%d/reviews?per_page=100", g.repoPath(owner, repo), number), nil, &raw); err != nil {
+		return nil, fmt.Errorf("failed to get PR reviews: %w", err)
+	}
+
+	reviews := make([]Review, len(raw))
+	for i, r := range raw {
+		reviews[i] = Review{
+			ID:          r.ID,
+			Author:      r.User.Login,
+			State:       strings.ToLower(r.State),
+			Body:        r.Body,
+			SubmittedAt: parseTime(r.SubmittedAt),
+		}
+	}
+	return reviews, nil
+}
+
+// GetCIStatus implements Forge using the check-runs API for the PR head commit.
+func (g *GitHub) GetCIStatus(ctx context.Context, owner, repo string, number int) (*CIStatus, error) {
+	pull, err := g.getPull(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		CheckRuns []struct {
+			ID         int    `json:"id"`
+			Name       string `json:"name"`
+			Status     string `json:"status"`
+			Conclusion string `json:"conclusion"`
+			HTMLURL    string `json:"html_url"`
+			DetailsURL string `json:"details_url"`
+		} `json:"check_runs"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/commits/%s/check-runs?per_page=100", g.repoPath(owner, repo), url.PathEscape(pull.Head.SHA)), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get check runs: %w", err)
+	}
+
+	checks := make([]CheckRun, len(resp.CheckRuns))
+	for i, c := range resp.CheckRuns {
+		details := c.HTMLURL
+		if details == "" {
+			details = c.DetailsURL
+		}
+		checks[i] = CheckRun{
+			ID:         c.ID,
+			Name:       c.Name,
+			Status:     c.Status,
+			Conclusion: c.Conclusion,
+			DetailsURL: details,
+		}
+	}
+	status := &CIStatus{Checks: checks}
+	status.Conclusion, status.Status = summarizeChecks(checks)
+	return status, nil
+}
+
+// GetCheckLog implements Forge using the GitHub Actions job logs API. Check runs
+// created by Actions share their ID with the job; other check runs answer 404.
+func (g *GitHub) GetCheckLog(ctx context.Context, owner, repo string, check CheckRun) (string, error) {
+	logs, err := g.api.doText(ctx, fmt.Sprintf("%s/actions/jobs/%d/logs", g.repoPath(owner, repo), check.ID))
+	if err != nil {
+		return "", fmt.Errorf("failed to download logs for check %q: %w", check.Name, err)
+	}
+	return logs, nil
+}
+
+// MergePR implements Forge.
+func (g *GitHub) MergePR(ctx context.Context, owner, repo string, number int, method string) error {
+	method, err := normalizeMergeMethod(method)
+	if err != nil {
+		return err
+	}
+	payload := map[string]string{"merge_method": method}
+	if err := g.api.do(ctx, http.MethodPut,
+		fmt.Sprintf("%s/pulls/%d/merge", g.repoPath(owner, repo), number), payload, nil); err != nil {
+		return fmt.Errorf("failed to merge PR: %w", err)
+	}
+	return nil
+}
+
+// ClosePR implements Forge.
+func (g *GitHub) ClosePR(ctx context.Context, owner, repo string, number int) error {
+	payload := map[string]string{"state": "closed"}
+	if err := g.api.do(ctx, http.MethodPatch,
+		fmt.Sprintf("%s/pulls/%d", g.repoPath(owner, repo), number), payload, nil); err != nil {
+		return fmt.Errorf("failed to close PR: %w", err)
+	}
+	return nil
+}
+
+// ListIssues implements Forge.
+func (g *GitHub) ListIssues(ctx context.Context, owner, repo string, opts IssueListOptions) ([]Issue, error) {
+	state := opts.State
+	if state == "" {
+		state = "open"
+	}
+	limit := issueLimit(opts)
+	query := url.Values{}
+	query.Set("state", state)
+	query.Set("per_page", fmt.Sprint(min(limit, 100)))
+	if len(opts.Labels) > 0 {
+		query.Set("labels", strings.Join(opts.Labels, ","))
+	}
+
+	var raw []struct {
+		Number      int       `json:"number"`
+		Title       string    `json:"title"`
+		Body        string    `json:"body"`
+		State       string    `json:"state"`
+		User        ghUser    `json:"user"`
+		Labels      []ghLabel `json:"labels"`
+		HTMLURL     string    `json:"html_url"`
+		CreatedAt   string    `json:"created_at"`
+		UpdatedAt   string    `json:"updated_at"`
+		PullRequest *struct{} `json:"pull_request"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/issues?%s", g.repoPath(owner, repo), query.Encode()), nil, &raw); err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	issues := make([]Issue, 0, len(raw))
+	for _, r := range raw {
+		if r.PullRequest != nil {
+			continue // the issues endpoint also returns pull requests
+		}
+		labels := make([]string, len(r.Labels))
+		for i, l := range r.Labels {
+			labels[i] = l.Name
+		}
+		issues = append(issues, Issue{
+			Number:    r.Number,
+			Title:     r.Title,
+			Body:      r.Body,
+			State:     r.State,
+			Author:    r.User.Login,
+			Labels:    labels,
+			HTMLURL:   r.HTMLURL,
+			CreatedAt: parseTime(r.CreatedAt),
+			UpdatedAt: parseTime(r.UpdatedAt),
+		})
+		if len(issues) == limit {
+			break
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,135 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+const ghHeadSHA = "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+
+func newGitHubFixture(t *testing.T) (*GitHub, *fixtureServer) {
+	t.Helper()
+	fs := newFixtureServer(t, "github", map[string]string{
+		"GET /repos/acme/widgets/pulls/42":                             "pull.json",
+		"GET /repos/acme/widgets/pulls/42/reviews":                     "reviews.json",
+		"GET /repos/acme/widgets/commits/" + ghHeadSHA + "/check-runs": "check_runs.json",
+		"GET /repos/acme/widgets/issues/42/comments":                   "issue_comments.json",
+		"GET /repos/acme/widgets/pulls/42/comments":                    "review_comments.json",
+		"GET /repos/acme/widgets/pulls":                                "pulls_by_branch.json",
+		"GET /repos/acme/widgets/issues":                               "issues.json",
+		"GET /repos/acme/widgets/actions/jobs/4001/logs":               "job_logs.txt",
+		"POST /repos/acme/widgets/issues/42/comments":                  "",
+		"PUT /repos/acme/widgets/pulls/42/merge":                       "",
+		"PATCH /repos/acme/widgets/pulls/42":                           "",
+	})
+	return NewGitHub(fs.options("")), fs
+}
+
+func TestGitHub_GetPR(t *testing.T) {
+	f, fs := newGitHubFixture(t)
+
+	pr, err := f.GetPR(context.Background(), "acme", "widgets", 42)
+	require.NoError(t, err)
+
+	assert.Equal(t, 42, pr.Number)
+	assert.Equal(t, "Add retry budget to uploader", pr.Title)
+	assert.Equal(t, "feature/retry", pr.HeadRef)
+	assert.Equal(t, "main", pr.BaseRef)
+	assert.Equal(t, "open", pr.State)
+	assert.Equal(t, "octocat", pr.Author)
+	assert.Equal(t, []string{"enhancement"}, pr.Labels)
+	assert.Equal(t, "MERGEABLE", pr.Mergeable)
+	assert.Equal(t, 5, pr.ChangedFiles)
+
+	// alice's COMMENTED review is superseded by CHANGES_REQUESTED; bob approved.
+	assert.Equal(t, 1, pr.ApprovedCount)
+	assert.Equal(t, 1, pr.ChangesRequestedCount)
+	assert.Equal(t, ReviewChangesRequested, pr.ReviewDecision)
+	assert.Equal(t, "failure", pr.CheckConclusion)
+	assert.Equal(t, "completed", pr.CheckStatus)
+	assert.Equal(t, github.PRPriorityBlocking, github.DerivePRPriority(pr))
+
+	req := fs.lastRequest(http.MethodGet)
+	require.NotNil(t, req)
+	assert.Equal(t, "Bearer test-token", req.Header.Get("Authorization"))
+	assert.Equal(t, "application/vnd.github+json", req.Header.Get("Accept"))
+}
+
+func TestGitHub_GetPRForBranch_PicksMostRecent(t *testing.T) {
+	f, _ := newGitHubFixture(t)
+
+	pr, err := f.GetPRForBranch(context.Background(), "acme", "widgets", "feature/retry")
+	require.NoError(t, err)
+	assert.Equal(t, 42, pr.Number)
+}
+
+func TestGitHub_ListComments(t *testing.T) {
+	f, _ := newGitHubFixture(t)
+
+	comments, err := f.ListComments(context.Background(), "acme", "widgets", 42)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+
+	assert.Equal(t, "carol", comments[0].Author)
+	assert.False(t, comments[0].IsReview)
+
+	review := comments[1]
+	assert.True(t, review.IsReview)
+	assert.Equal(t, "uploader/retry.go", review.Path)
+	assert.Equal(t, 37, review.Line)
+	assert.Contains(t, review.DiffHunk, "@@ -30,6 +30,10 @@")
+	assert.Equal(t, "https://github.com/acme/widgets/pull/42#discussion_r2001", review.HTMLURL)
+}
+
+func TestGitHub_GetCheckLog(t *testing.T) {
+	f, fs := newGitHubFixture(t)
+
+	logs, err := f.GetCheckLog(context.Background(), "acme", "widgets", CheckRun{ID: 4001, Name: "test"})
+	require.NoError(t, err)
+	assert.Contains(t, logs, "--- FAIL: TestRetryBudget")
+	assert.Equal(t, "*/*", fs.lastRequest(http.MethodGet).Header.Get("Accept"))
+
+	_, err = f.GetCheckLog(context.Background(), "acme", "widgets", CheckRun{ID: 9999, Name: "external"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGitHub_ListIssues_SkipsPullRequests(t *testing.T) {
+	f, _ := newGitHubFixture(t)
+
+	issues, err := f.ListIssues(context.Background(), "acme", "widgets", IssueListOptions{Labels: []string{"bug"}})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, 50, issues[0].Number)
+	assert.Equal(t, []string{"bug"}, issues[0].Labels)
+}
+
+func TestGitHub_Mutations(t *testing.T) {
+	f, fs := newGitHubFixture(t)
+	ctx := context.Background()
+
+	require.NoError(t, f.PostComment(ctx, "acme", "widgets", 42, "LGTM"))
+	assert.Equal(t, "LGTM", fs.lastRequest(http.MethodPost).Body["body"])
+
+	require.NoError(t, f.MergePR(ctx, "acme", "widgets", 42, "squash"))
+	assert.Equal(t, "squash", fs.lastRequest(http.MethodPut).Body["merge_method"])
+
+	require.NoError(t, f.ClosePR(ctx, "acme", "widgets", 42))
+	assert.Equal(t, "closed", fs.lastRequest(http.MethodPatch).Body["state"])
+
+	assert.Error(t, f.MergePR(ctx, "acme", "widgets", 42, "octopus"))
+}
+
+func TestGitHub_NotFound(t *testing.T) {
+	f, _ := newGitHubFixture(t)
+
+	_, err := f.GetPR(context.Background(), "acme", "widgets", 999)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,381 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+// GitLab implements Forge against the GitLab REST API (v4), for gitlab.com
+// and self-hosted instances. Pull requests map onto merge requests, and CI
+// status onto the jobs of the merge request's latest pipeline.
+type GitLab struct {
+	api *apiClient
+}
+
+// NewGitLab creates a GitLab forge client.
+func NewGitLab(opts Options) *GitLab {
+	if opts.BaseURL == "" {
+		opts.BaseURL = "https://gitlab.com/api/v4"
+	}
+	return &GitLab{api: newAPIClient("GitLab API", opts,
+		func(req *http.Request, token string) { req.Header.Set("PRIVATE-TOKEN", token) },
+		nil)}
+}
+
+// defaultGitLabOptions targets https://<host>/api/v4 and reads the token from
+// GITLAB_TOKEN, falling back to GL_TOKEN (the glab CLI's variable).
+func defaultGitLabOptions(host string) Options {
+	return Options{
+		BaseURL: "https://" + host + "/api/v4",
+		Token: func(context.Context) string {
+			if tok := os.Getenv("GITLAB_TOKEN"); tok != "" {
+				return tok
+			}
+			return os.Getenv("GL_TOKEN")
+		},
+	}
+}
+
+// Kind implements Forge.
+func (g *GitLab) Kind() github.ForgeKind { return github.ForgeGitLab }
+
+type glUser struct {
+	Username string `json:"username"`
+}
+
+type glMergeRequest struct {
+	IID          int      `json:"iid"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	State        string   `json:"state"` // opened, closed, locked, merged
+	SourceBranch string   `json:"source_branch"`
+	TargetBranch string   `json:"target_branch"`
+	Author       glUser   `json:"author"`
+	Labels       []string `json:"labels"`
+	WebURL       string   `json:"web_url"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+	Draft        bool     `json:"draft"`
+	WIP          bool     `json:"work_in_progress"`
+	MergeStatus  string   `json:"merge_status"` // can_be_merged, cannot_be_merged, unchecked, checking
+	HasConflicts bool     `json:"has_conflicts"`
+}
+
+// projectPath returns the API path for owner/repo, URL-encoding the full
+// namespace path as GitLab requires ("group%2Fsubgroup%2Frepo").
+func (g *GitLab) projectPath(owner, repo string) string {
+	return "projects/" + url.PathEscape(owner+"/"+repo)
+}
+
+func (g *GitLab) mrPath(owner, repo string, number int) string {
+	return fmt.Sprintf("%s/merge_requests/%d", g.projectPath(owner, repo), number)
+}
+
+// GetPR implements Forge.
+func (g *GitLab) GetPR(ctx context.Context, owner, repo string, number int) (*PRInfo, error) {
+	var mr glMergeRequest
+	if err := g.api.do(ctx, http.MethodGet, g.mrPath(owner, repo, number), nil, &mr); err != nil {
+		return nil, fmt.Errorf("failed to get MR info: %w", err)
+	}
+
+	state := mr.State
+	switch state {
+	case "opened":
+		state = "open"
+	case "locked":
+		state = "closed"
+	}
+	mergeable := "UNKNOWN"
+	switch {
+	case mr.HasConflicts || mr.MergeStatus == "cannot_be_merged":
+		mergeable = "CONFLICTING"
+	case mr.MergeStatus == "can_be_merged":
+		mergeable = "MERGEABLE"
+	}
+
+	pr := &PRInfo{
+		Number:    mr.IID,
+		Title:     mr.Title,
+		Body:      mr.Description,
+		HeadRef:   mr.SourceBranch,
+		BaseRef:   mr.TargetBranch,
+		State:     state,
+		Author:    mr.Author.Username,
+		Labels:    mr.Labels,
+		HTMLURL:   mr.WebURL,
+		CreatedAt: parseTime(mr.CreatedAt),
+		UpdatedAt: parseTime(mr.UpdatedAt),
+		IsDraft:   mr.Draft || mr.WIP,
+		Mergeable: mergeable,
+	}
+	if err := fillPRSummary(ctx, g, owner, repo, pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// GetPRForBranch implements Forge.
+func (g *GitLab) GetPRForBranch(ctx context.Context, owner, repo, branch string) (*PRInfo, error) {
+	var mrs []glMergeRequest
+	path := fmt.Sprintf("%s/merge_requests?source_branch=%s&state=all&order_by=updated_at&sort=desc&per_page=10",
+		g.projectPath(owner, repo), url.QueryEscape(branch))
+	if err := g.api.do(ctx, http.MethodGet, path, nil, &mrs); err != nil {
+		return nil, fmt.Errorf("MR list request failed: %w", err)
+	}
+	if len(mrs) == 0 {
+		return nil, ErrNoPR
+	}
+	return g.GetPR(ctx, owner, repo, mrs[0].IID)
+}
+
+// ListComments implements Forge. System notes (label changes, pushes, etc.)
+// are skipped; diff notes become review comments anchored to their new line.
+func (g *GitLab) ListComments(ctx context.Context, owner, repo string, number int) ([]PRComment, error) {
+	var notes []struct {
+		ID        int    `json:"id"`
+		Body      string `json:"body"`
+		Author    glUser `json:"author"`
+		CreatedAt string `json:"created_at"`
+		System    bool   `json:"system"`
+		Position  *struct {
+			NewPath string `json:"new_path"`
+			OldPath string `json:"old_path"`
+			NewLine int    `json:"new_line"`
+			OldLine int    `json:"old_line"`
+		} `json:"position"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		g.mrPath(owner, repo, number)+"/notes?sort=asc&order_by=created_at&per_page=100", nil, &notes); err != nil {
+		return nil, fmt.Errorf("failed to get MR notes: %w", err)
+	}
+
+	comments := make([]PRComment, 0, len(notes))
+	for _, n := range notes {
+		if n.System {
+			continue
+		}
+		c := PRComment{
+			ID:        n.ID,
+			Author:    n.Author.Username,
+			Body:      n.Body,
+			CreatedAt: parseTime(n.CreatedAt),
+		}
+		if n.Position != nil {
+			c.IsReview = true
+			c.Path, c.Line = n.Position.NewPath, n.Position.NewLine
+			if c.Line == 0 {
+				c.Path, c.Line = n.Position.OldPath, n.Position.OldLine // comment on a removed line
+			}
+		}
+		comments = append(comments, c)
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+	return comments, nil
+}
+
+// PostComment implements Forge.
+func (g *GitLab) PostComment(ctx context.Context, owner, repo string, number int, body string) error {
+	payload := map[string]string{"body": body}
+	if err := g.api.do(ctx, http.MethodPost, g.mrPath(owner, repo, number)+"/notes", payload, nil); err != nil {
+		return fmt.Errorf("failed to post comment: %w", err)
+	}
+	return nil
+}
+
+// ListReviews implements Forge. GitLab has no review objects; approvals come
+// from the approvals endpoint and "changes requested" from the reviewers
+// endpoint (GitLab 16.x+). Older servers without the latter only report approvals.
+func (g *GitLab) ListReviews(ctx context.Context, owner, repo string, number int) ([]Review, error) {
+	var approvals struct {
+		ApprovedBy []struct {
+			User glUser `json:"user"`
+		} `json:"approved_by"`
+	}
+	if err := g.api.do(ctx, http.MethodGet, g.mrPath(owner, repo, number)+"/approvals", nil, &approvals); err != nil {
+		return nil, fmt.Errorf("failed to get MR approvals: %w", err)
+	}
+
+	var reviewers []struct {
+		User      glUser `json:"user"`
+		State     string `json:"state"` // unreviewed, reviewed, requested_changes, approved, unapproved
+		CreatedAt string `json:"created_at"`
+	}
+	if err := g.api.do(ctx, http.MethodGet, g.mrPath(owner, repo, number)+"/reviewers", nil, &reviewers); err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("failed to get MR reviewers: %w", err)
+	}
+
+	reviews := make([]Review, 0, len(approvals.ApprovedBy)+len(reviewers))
+	approvedBy := make(map[string]bool, len(approvals.ApprovedBy))
+	for _, a := range approvals.ApprovedBy {
+		approvedBy[a.User.Username] = true
+		reviews = append(reviews, Review{Author: a.User.Username, State: ReviewApproved})
+	}
+	for _, r := range reviewers {
+		switch r.State {
+		case "requested_changes":
+			reviews = append(reviews, Review{Author: r.User.Username, State: ReviewChangesRequested, SubmittedAt: parseTime(r.CreatedAt)})
+		case "reviewed":
+			if !approvedBy[r.User.Username] {
+				reviews = append(reviews, Review{Author: r.User.Username, State: ReviewCommented, SubmittedAt: parseTime(r.CreatedAt)})
+			}
+		}
+	}
+	return reviews, nil
+}
+
+// GetCIStatus implements Forge using the jobs of the MR's most recent pipeline.
+func (g *GitLab) GetCIStatus(ctx context.Context, owner, repo string, number int) (*CIStatus, error) {
+	var pipelines []struct {
+		ID int `json:"id"`
+	}
+	if err := g.api.do(ctx, http.MethodGet, g.mrPath(owner, repo, number)+"/pipelines?per_page=1", nil, &pipelines); err != nil {
+		return nil, fmt.Errorf("failed to get MR pipelines: %w", err)
+	}
+	if len(pipelines) == 0 {
+		return &CIStatus{}, nil
+	}
+
+	var jobs []struct {
+		ID           int    `json:"id"`
+		Name         string `json:"name"`
+		Status       string `json:"status"` // created, pending, running, success, failed, canceled, skipped, manual
+		WebURL       string `json:"web_url"`
+		AllowFailure bool   `json:"allow_failure"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/pipelines/%d/jobs?per_page=100", g.projectPath(owner, repo), pipelines[0].ID), nil, &jobs); err != nil {
+		return nil, fmt.Errorf("failed to get pipeline jobs: %w", err)
+	}
+
+	checks := make([]CheckRun, len(jobs))
+	for i, j := range jobs {
+		status, conclusion := gitlabJobState(j.Status)
+		if conclusion == "failure" && j.AllowFailure {
+			conclusion = "neutral"
+		}
+		checks[i] = CheckRun{
+			ID:         j.ID,
+			Name:       j.Name,
+			Status:     status,
+			Conclusion: conclusion,
+			DetailsURL: j.WebURL,
+		}
+	}
+	status := &CIStatus{Checks: checks}
+	status.Conclusion, status.Status = summarizeChecks(checks)
+	return status, nil
+}
+
+// gitlabJobState maps a GitLab job status onto check-run status/conclusion.
+func gitlabJobState(s string) (status, conclusion string) {
+	switch s {
+	case "success":
+		return "completed", "success"
+	case "failed":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "skipped", "manual":
+		return "completed", "skipped"
+	case "running":
+		return "in_progress", ""
+	default: // created, pending, waiting_for_resource, preparing, scheduled
+		return "queued", ""
+	}
+}
+
+// GetCheckLog implements Forge using the job trace endpoint.
+func (g *GitLab) GetCheckLog(ctx context.Context, owner, repo string, check CheckRun) (string, error) {
+	trace, err := g.api.doText(ctx, fmt.Sprintf("%s/jobs/%d/trace", g.projectPath(owner, repo), check.ID))
+	if err != nil {
+		return "", fmt.Errorf("failed to download trace for job %q: %w", check.Name, err)
+	}
+	return trace, nil
+}
+
+// MergePR implements Forge. GitLab's merge method is a project setting, so
+// "merge" and "squash" are honoured per request while "rebase" is unsupported.
+func (g *GitLab) MergePR(ctx context.Context, owner, repo string, number int, method string) error {
+	method, err := normalizeMergeMethod(method)
+	if err != nil {
+		return err
+	}
+	if method == "rebase" {
+		return fmt.Errorf("failed to merge MR: rebase merges are configured per project on GitLab: %w", ErrUnsupported)
+	}
+	payload := map[string]bool{"squash": method == "squash"}
+	if err := g.api.do(ctx, http.MethodPut, g.mrPath(owner, repo, number)+"/merge", payload, nil); err != nil {
+		return fmt.Errorf("failed to merge MR: %w", err)
+	}
+	return nil
+}
+
+// ClosePR implements Forge.
+func (g *GitLab) ClosePR(ctx context.Context, owner, repo string, number int) error {
+	payload := map[string]string{"state_event": "close"}
+	if err := g.api.do(ctx, http.MethodPut, g.mrPath(owner, repo, number), payload, nil); err != nil {
+		return fmt.Errorf("failed to close MR: %w", err)
+	}
+	return nil
+}
+
+// ListIssues implements Forge.
+func (g *GitLab) ListIssues(ctx context.Context, owner, repo string, opts IssueListOptions) ([]Issue, error) {
+	query := url.Values{}
+	switch opts.State {
+	case "", "open":
+		query.Set("state", "opened")
+	case "closed":
+		query.Set("state", "closed")
+	}
+	query.Set("per_page", fmt.Sprint(min(issueLimit(opts), 100)))
+	if len(opts.Labels) > 0 {
+		query.Set("labels", strings.Join(opts.Labels, ","))
+	}
+
+	var raw []struct {
+		IID         int      `json:"iid"`
+		Title       string   `json:"title"`
+		Description string   `json:"description"`
+		State       string   `json:"state"`
+		Author      glUser   `json:"author"`
+		Labels      []string `json:"labels"`
+		WebURL      string   `json:"web_url"`
+		CreatedAt   string   `json:"created_at"`
+		UpdatedAt   string   `json:"updated_at"`
+	}
+	if err := g.api.do(ctx, http.MethodGet,
+		fmt.Sprintf("%s/issues?%s", g.projectPath(owner, repo), query.Encode()), nil, &raw); err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	issues := make([]Issue, len(raw))
+	for i, r := range raw {
+		state := r.State
+		if state == "opened" {
+			state = "open"
+		}
+		issues[i] = Issue{
+			Number:    r.IID,
+			Title:     r.Title,
+			Body:      r.Description,
+			State:     state,
+			Author:    r.Author.Username,
+			Labels:    r.Labels,
+			HTMLURL:   r.WebURL,
+			CreatedAt: parseTime(r.CreatedAt),
+			UpdatedAt: parseTime(r.UpdatedAt),
+		}
+	}
+	return issues, nil
+}
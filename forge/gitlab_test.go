@@ -0,0 +1,128 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/github"
+)
+
+func newGitLabFixture(t *testing.T) (*GitLab, *fixtureServer) {
+	t.Helper()
+	const mr = "/api/v4/projects/platform%2Finfra%2Fdeploy/merge_requests/7"
+	fs := newFixtureServer(t, "gitlab", map[string]string{
+		"GET " + mr:                "merge_request.json",
+		"GET " + mr + "/approvals": "approvals.json",
+		"GET " + mr + "/reviewers": "reviewers.json",
+		"GET " + mr + "/notes":     "notes.json",
+		"GET " + mr + "/pipelines": "pipelines.json",
+		"GET /api/v4/projects/platform%2Finfra%2Fdeploy/pipelines/7781/jobs": "jobs.json",
+		"GET /api/v4/projects/platform%2Finfra%2Fdeploy/merge_requests":      "merge_requests_by_branch.json",
+		"GET /api/v4/projects/platform%2Finfra%2Fdeploy/issues":              "issues.json",
+		"GET /api/v4/projects/platform%2Finfra%2Fdeploy/jobs/90003/trace":    "job_trace.txt",
+		"POST " + mr + "/notes": "",
+		"PUT " + mr + "/merge":  "",
+		"PUT " + mr:             "",
+	})
+	return NewGitLab(fs.options("/api/v4")), fs
+}
+
+func TestGitLab_GetPR(t *testing.T) {
+	f, fs := newGitLabFixture(t)
+
+	pr, err := f.GetPR(context.Background(), "platform/infra", "deploy", 7)
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, pr.Number)
+	assert.Equal(t, "ci/rules", pr.HeadRef)
+	assert.Equal(t, "main", pr.BaseRef)
+	assert.Equal(t, "open", pr.State)
+	assert.Equal(t, "jdoe", pr.Author)
+	assert.Equal(t, []string{"ci", "tech-debt"}, pr.Labels)
+	assert.True(t, pr.IsDraft)
+	assert.Equal(t, "MERGEABLE", pr.Mergeable)
+
+	assert.Equal(t, 1, pr.ApprovedCount)
+	assert.Equal(t, 1, pr.ChangesRequestedCount)
+	// The allow_failure job does not fail the pipeline; "unit" is still running.
+	assert.Equal(t, "pending", pr.CheckConclusion)
+	assert.Equal(t, "in_progress", pr.CheckStatus)
+	assert.Equal(t, github.PRPriorityDraft, github.DerivePRPriority(pr))
+
+	req := fs.lastRequest(http.MethodGet)
+	require.NotNil(t, req)
+	assert.Equal(t, "test-token", req.Header.Get("PRIVATE-TOKEN"))
+}
+
+func TestGitLab_GetPRForBranch(t *testing.T) {
+	f, _ := newGitLabFixture(t)
+
+	pr, err := f.GetPRForBranch(context.Background(), "platform/infra", "deploy", "ci/rules")
+	require.NoError(t, err)
+	assert.Equal(t, 7, pr.Number)
+}
+
+func TestGitLab_ListComments_SkipsSystemNotes(t *testing.T) {
+	f, _ := newGitLabFixture(t)
+
+	comments, err := f.ListComments(context.Background(), "platform/infra", "deploy", 7)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+
+	assert.Equal(t, "Why drop the manual gate?", comments[0].Body)
+	assert.False(t, comments[0].IsReview)
+
+	assert.True(t, comments[1].IsReview)
+	assert.Equal(t, ".gitlab-ci.yml", comments[1].Path)
+	assert.Equal(t, 54, comments[1].Line)
+}
+
+func TestGitLab_GetCIStatus(t *testing.T) {
+	f, _ := newGitLabFixture(t)
+
+	ci, err := f.GetCIStatus(context.Background(), "platform/infra", "deploy", 7)
+	require.NoError(t, err)
+	require.Len(t, ci.Checks, 3)
+	assert.Equal(t, CheckRun{ID: 90002, Name: "unit", Status: "in_progress", DetailsURL: "https://gitlab.example.com/platform/infra/deploy/-/jobs/90002"}, ci.Checks[1])
+	assert.Equal(t, "neutral", ci.Checks[2].Conclusion)
+}
+
+func TestGitLab_GetCheckLog(t *testing.T) {
+	f, _ := newGitLabFixture(t)
+
+	trace, err := f.GetCheckLog(context.Background(), "platform/infra", "deploy", CheckRun{ID: 90003, Name: "lint"})
+	require.NoError(t, err)
+	assert.Contains(t, trace, "ERROR: Job failed")
+}
+
+func TestGitLab_ListIssues(t *testing.T) {
+	f, _ := newGitLabFixture(t)
+
+	issues, err := f.ListIssues(context.Background(), "platform/infra", "deploy", IssueListOptions{})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, 19, issues[0].Number)
+	assert.Equal(t, "open", issues[0].State)
+}
+
+func TestGitLab_Mutations(t *testing.T) {
+	f, fs := newGitLabFixture(t)
+	ctx := context.Background()
+
+	require.NoError(t, f.PostComment(ctx, "platform/infra", "deploy", 7, "Rebased."))
+	assert.Equal(t, "Rebased.", fs.lastRequest(http.MethodPost).Body["body"])
+
+	require.NoError(t, f.MergePR(ctx, "platform/infra", "deploy", 7, "squash"))
+	assert.Equal(t, true, fs.lastRequest(http.MethodPut).Body["squash"])
+
+	require.NoError(t, f.ClosePR(ctx, "platform/infra", "deploy", 7))
+	assert.Equal(t, "close", fs.lastRequest(http.MethodPut).Body["state_event"])
+
+	err := f.MergePR(ctx, "platform/infra", "deploy", 7, "rebase")
+	assert.True(t, errors.Is(err, ErrUnsupported))
+}
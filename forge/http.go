@@ -0,0 +1,177 @@
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultHTTPClient is shared by forge clients constructed without an explicit
+// HTTPClient. The 30-second timeout matches the github package's REST client.
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// apiClient performs JSON requests against a forge REST API.
+type apiClient struct {
+	name       string // Forge name used in error messages, e.g. "GitLab API"
+	baseURL    string
+	token      func(ctx context.Context) string
+	httpClient *http.Client
+	// authorize sets the platform's authentication header for a non-empty token.
+	authorize func(req *http.Request, token string)
+	// headers are added to every request (e.g. API version pinning).
+	headers map[string]string
+}
+
+func newAPIClient(name string, opts Options, authorize func(*http.Request, string), headers map[string]string) *apiClient {
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	return &apiClient{
+		name:       name,
+		baseURL:    strings.TrimRight(opts.BaseURL, "/"),
+		token:      opts.Token,
+		httpClient: httpClient,
+		authorize:  authorize,
+		headers:    headers,
+	}
+}
+
+// maxLogBytes bounds how much of a CI job log is kept in memory. Logs are
+// truncated from the front: failures are almost always reported at the end.
+const maxLogBytes = 2 << 20
+
+// do sends a request to path (relative to baseURL, may include a query string)
+// with an optional JSON body and decodes a JSON response into out when non-nil.
+// Status codes map onto errors whose text the PR status poller recognises
+// ("rate limit", "401") so it can back off uniformly across forges.
+func (c *apiClient) do(ctx context.Context, method, path string, body, out any) error {
+	resp, err := c.send(ctx, method, path, body, false)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil {
+		// Drain body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("parse %s response for %s: %w", c.name, path, err)
+	}
+	return nil
+}
+
+// doText fetches a plain-text resource such as a CI job log, following redirects.
+// Only the last maxLogBytes of the body are returned.
+func (c *apiClient) doText(ctx context.Context, path string) (string, error) {
+	resp, err := c.send(ctx, http.MethodGet, path, nil, true)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	tail := &tailBuffer{limit: maxLogBytes}
+	if _, err := io.Copy(tail, resp.Body); err != nil {
+		return "", fmt.Errorf("read %s response for %s: %w", c.name, path, err)
+	}
+	return string(tail.Bytes()), nil
+}
+
+// tailBuffer is an io.Writer that keeps only the last limit bytes written to
+// it. It holds at most twice the limit, compacting once that is exceeded, so
+// arbitrarily large logs are read in bounded memory.
+type tailBuffer struct {
+	buf   []byte
+	limit int
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.buf = append(t.buf, p...)
+	if len(t.buf) > 2*t.limit {
+		t.buf = append(t.buf[:0], t.buf[len(t.buf)-t.limit:]...)
+	}
+	return len(p), nil
+}
+
+// Bytes returns the retained tail.
+func (t *tailBuffer) Bytes() []byte {
+	if len(t.buf) > t.limit {
+		return t.buf[len(t.buf)-t.limit:]
+	}
+	return t.buf
+}
+
+// send performs the request and maps error status codes. raw requests accept any
+// content type instead of JSON. On success the caller owns the response body.
+func (c *apiClient) send(ctx context.Context, method, path string, body any, raw bool) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encode %s request: %w", c.name, err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/"+strings.TrimLeft(path, "/"), reader)
+	if err != nil {
+		return nil, fmt.Errorf("build %s request: %w", c.name, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	if raw {
+		req.Header.Set("Accept", "*/*")
+	}
+	if c.token != nil {
+		if tok := c.token(ctx); tok != "" {
+			c.authorize(req, tok)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s request failed: %w", c.name, err)
+	}
+
+	var statusErr error
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		statusErr = fmt.Errorf("%s: unauthorized (401) – check the API token", c.name)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		statusErr = fmt.Errorf("%s: rate limit exceeded (429)", c.name)
+	case resp.StatusCode == http.StatusForbidden:
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			statusErr = fmt.Errorf("%s: rate limit exceeded (403)", c.name)
+		} else {
+			statusErr = fmt.Errorf("%s: forbidden (403) – check token permissions", c.name)
+		}
+	case resp.StatusCode == http.StatusNotFound:
+		statusErr = fmt.Errorf("%s %s %s: %w", c.name, method, path, ErrNotFound)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		statusErr = fmt.Errorf("%s returned status %d for %s %s: %s", c.name, resp.StatusCode, method, path, strings.TrimSpace(string(msg)))
+	}
+	if statusErr != nil {
+		resp.Body.Close()
+		return nil, statusErr
+	}
+	return resp, nil
+}
+
+// parseTime parses an RFC 3339 timestamp, returning the zero time on failure.
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
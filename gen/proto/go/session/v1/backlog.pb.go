@@ -159,8 +159,10 @@ type ReviewVerdict struct {
 	OverrideReason string                 `protobuf:"bytes,9,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
 	OverrideAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=override_at,json=overrideAt,proto3" json:"override_at,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Deterministic checks the gate ran before the reviewers.
+	Checks        []*ReviewCheckResult `protobuf:"bytes,12,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReviewVerdict) Reset() {
@@ -270,6 +272,13 @@ func (x *ReviewVerdict) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ReviewVerdict) GetChecks() []*ReviewCheckResult {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
 // ItemSession records a session that was spawned or attached to a backlog item.
 type ItemSession struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
@@ -1508,10 +1517,16 @@ func (x *TransitionBacklogItemStatusResponse) GetItem() *BacklogItem {
 }
 
 type SpawnSessionFromItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ItemId string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Optional session template (name, optionally name@version). The item's
+	// context is appended to the template's prompt, and its title, description
+	// and ID fill {{item_title}}, {{item_description}} and {{item_id}} when the
+	// template declares them.
+	Template          string            `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	TemplateVariables map[string]string `protobuf:"bytes,3,rep,name=template_variables,json=templateVariables,proto3" json:"template_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SpawnSessionFromItemRequest) Reset() {
@@ -1551,6 +1566,20 @@ func (x *SpawnSessionFromItemRequest) GetItemId() string {
 	return ""
 }
 
+func (x *SpawnSessionFromItemRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *SpawnSessionFromItemRequest) GetTemplateVariables() map[string]string {
+	if x != nil {
+		return x.TemplateVariables
+	}
+	return nil
+}
+
 type SpawnSessionFromItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionUuid   string                 `protobuf:"bytes,1,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
@@ -2708,208 +2737,1498 @@ func (x *GetSyncHistoryResponse) GetEvents() []*SourceSyncEvent {
 	return nil
 }
 
-var File_session_v1_backlog_proto protoreflect.FileDescriptor
+// BacklogDependency is a "blocked by" edge: item_id cannot start until
+// blocked_by_id is done or has a passing review verdict.
+type BacklogDependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	BlockedById   string                 `protobuf:"bytes,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_session_v1_backlog_proto_rawDesc = "" +
-	"\n" +
-	"\x18session/v1/backlog.proto\x12\n" +
-	"session.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"O\n" +
-	"\vAcCriterion\x12\x14\n" +
-	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"q\n" +
-	"\x10CriterionVerdict\x12'\n" +
-	"\x0fcriterion_index\x18\x01 \x01(\x05R\x0ecriterionIndex\x12\x18\n" +
-	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x1a\n" +
-	"\bevidence\x18\x03 \x01(\tR\bevidence\"\xd5\x03\n" +
-	"\rReviewVerdict\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
-	"\x0foverall_outcome\x18\x02 \x01(\tR\x0eoverallOutcome\x12A\n" +
-	"\rper_criterion\x18\x03 \x03(\v2\x1c.session.v1.CriterionVerdictR\fperCriterion\x12\x18\n" +
-	"\asummary\x18\x04 \x01(\tR\asummary\x12\x1b\n" +
-	"\tdiff_hash\x18\x05 \x01(\tR\bdiffHash\x12(\n" +
-	"\x10diff_token_count\x18\x06 \x01(\x05R\x0ediffTokenCount\x12%\n" +
-	"\x0ediff_truncated\x18\a \x01(\bR\rdiffTruncated\x12\x1f\n" +
-	"\voverride_by\x18\b \x01(\tR\n" +
-	"overrideBy\x12'\n" +
-	"\x0foverride_reason\x18\t \x01(\tR\x0eoverrideReason\x12;\n" +
-	"\voverride_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"overrideAt\x129\n" +
-	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc6\x04\n" +
-	"\vItemSession\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
-	"\fsession_uuid\x18\x02 \x01(\tR\vsessionUuid\x12!\n" +
-	"\fsession_role\x18\x03 \x01(\tR\vsessionRole\x129\n" +
-	"\n" +
-	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
-	"\bended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12.\n" +
-	"\x13last_commit_message\x18\x06 \x01(\tR\x11lastCommitMessage\x12@\n" +
-	"\x0elast_commit_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\flastCommitAt\x127\n" +
-	"\x18commit_count_since_spawn\x18\b \x01(\x05R\x15commitCountSinceSpawn\x12G\n" +
-	"\x12last_file_touch_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0flastFileTouchAt\x129\n" +
-	"\n" +
-	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12@\n" +
-	"\x0ereview_verdict\x18\v \x01(\v2\x19.session.v1.ReviewVerdictR\rreviewVerdict\"\x9f\x06\n" +
-	"\vBacklogItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12H\n" +
-	"\x13acceptance_criteria\x18\x04 \x03(\v2\x17.session.v1.AcCriterionR\x12acceptanceCriteria\x12\x1a\n" +
-	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1b\n" +
-	"\trepo_path\x18\a \x01(\tR\brepoPath\x12(\n" +
-	"\x10skip_review_gate\x18\b \x01(\bR\x0eskipReviewGate\x12#\n" +
-	"\rskip_planning\x18\t \x01(\bR\fskipPlanning\x12#\n" +
-	"\rplan_approved\x18\n" +
-	" \x01(\bR\fplanApproved\x12D\n" +
-	"\x10plan_approved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eplanApprovedAt\x12.\n" +
-	"\x13plan_artifacts_path\x18\f \x01(\tR\x11planArtifactsPath\x12\x14\n" +
-	"\x05notes\x18\r \x01(\tR\x05notes\x12\x1f\n" +
-	"\vexternal_id\x18\x0e \x01(\tR\n" +
-	"externalId\x12;\n" +
-	"\varchived_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"archivedAt\x129\n" +
-	"\n" +
-	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
-	"\ritem_sessions\x18\x12 \x03(\v2\x17.session.v1.ItemSessionR\fitemSessions\x12\x1b\n" +
-	"\tsource_id\x18\x13 \x01(\tR\bsourceId\"\xd9\x02\n" +
-	"\n" +
-	"ItemSource\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tplugin_id\x18\x02 \x01(\tR\bpluginId\x12!\n" +
-	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x18\n" +
-	"\aenabled\x18\x04 \x01(\bR\aenabled\x12@\n" +
-	"\x0elast_synced_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncedAt\x12)\n" +
-	"\x10token_configured\x18\x06 \x01(\bR\x0ftokenConfigured\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd2\x02\n" +
-	"\x0fSourceSyncEvent\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
-	"\n" +
-	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
-	"\vfinished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"finishedAt\x12#\n" +
-	"\ritems_created\x18\x04 \x01(\x05R\fitemsCreated\x12#\n" +
-	"\ritems_updated\x18\x05 \x01(\x05R\fitemsUpdated\x12#\n" +
-	"\ritems_skipped\x18\x06 \x01(\x05R\fitemsSkipped\x12#\n" +
-	"\ritems_errored\x18\a \x01(\x05R\fitemsErrored\x12#\n" +
-	"\rerror_message\x18\b \x01(\tR\ferrorMessage\"\xba\x02\n" +
-	"\x18CreateBacklogItemRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12H\n" +
-	"\x13acceptance_criteria\x18\x03 \x03(\v2\x17.session.v1.AcCriterionR\x12acceptanceCriteria\x12\x1a\n" +
-	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12(\n" +
-	"\x10skip_review_gate\x18\x05 \x01(\bR\x0eskipReviewGate\x12#\n" +
-	"\rskip_planning\x18\x06 \x01(\bR\fskipPlanning\x12\x1b\n" +
-	"\trepo_path\x18\a \x01(\tR\brepoPath\x12\x14\n" +
-	"\x05notes\x18\b \x01(\tR\x05notes\"H\n" +
-	"\x19CreateBacklogItemResponse\x12+\n" +
-	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"0\n" +
-	"\x15GetBacklogItemRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"E\n" +
-	"\x16GetBacklogItemResponse\x12+\n" +
-	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x91\x01\n" +
-	"\x17ListBacklogItemsRequest\x12\x16\n" +
-	"\x06status\x18\x01 \x03(\tR\x06status\x12\x1a\n" +
-	"\bpriority\x18\x02 \x03(\x05R\bpriority\x12\x17\n" +
-	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12)\n" +
-	"\x10include_terminal\x18\x04 \x01(\bR\x0fincludeTerminal\"I\n" +
-	"\x18ListBacklogItemsResponse\x12-\n" +
-	"\x05items\x18\x01 \x03(\v2\x17.session.v1.BacklogItemR\x05items\"\xc8\x03\n" +
-	"\x18UpdateBacklogItemRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12H\n" +
-	"\x13acceptance_criteria\x18\x04 \x03(\v2\x17.session.v1.AcCriterionR\x12acceptanceCriteria\x12\x1a\n" +
-	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12(\n" +
-	"\x10skip_review_gate\x18\x06 \x01(\bR\x0eskipReviewGate\x12#\n" +
-	"\rskip_planning\x18\a \x01(\bR\fskipPlanning\x12\x1b\n" +
-	"\trepo_path\x18\b \x01(\tR\brepoPath\x12\x14\n" +
-	"\x05notes\x18\t \x01(\tR\x05notes\x12'\n" +
-	"\x0fexpected_status\x18\n" +
-	" \x01(\tR\x0eexpectedStatus\x12J\n" +
-	"\x13expected_updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"H\n" +
-	"\x19UpdateBacklogItemResponse\x12+\n" +
-	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"4\n" +
-	"\x19ArchiveBacklogItemRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"I\n" +
-	"\x1aArchiveBacklogItemResponse\x12+\n" +
-	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x80\x02\n" +
-	"\"TransitionBacklogItemStatusRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12#\n" +
-	"\rtarget_status\x18\x02 \x01(\tR\ftargetStatus\x12'\n" +
-	"\x0fexpected_status\x18\x03 \x01(\tR\x0eexpectedStatus\x12J\n" +
-	"\x13expected_updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\x12'\n" +
-	"\x0foverride_reason\x18\x05 \x01(\tR\x0eoverrideReason\"R\n" +
-	"#TransitionBacklogItemStatusResponse\x12+\n" +
-	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"6\n" +
-	"\x1bSpawnSessionFromItemRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"}\n" +
-	"\x1cSpawnSessionFromItemResponse\x12!\n" +
-	"\fsession_uuid\x18\x01 \x01(\tR\vsessionUuid\x12:\n" +
-	"\fitem_session\x18\x02 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"X\n" +
-	"\x1aAttachSessionToItemRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12!\n" +
-	"\fsession_uuid\x18\x02 \x01(\tR\vsessionUuid\"Y\n" +
-	"\x1bAttachSessionToItemResponse\x12:\n" +
-	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"/\n" +
-	"\x14TriggerTriageRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"S\n" +
-	"\x15TriggerTriageResponse\x12:\n" +
-	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"-\n" +
-	"\x12ApprovePlanRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"B\n" +
-	"\x13ApprovePlanResponse\x12+\n" +
-	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x18\n" +
-	"\x16SuggestNextItemRequest\"\x82\x01\n" +
-	"\x17SuggestNextItemResponse\x12:\n" +
-	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\x12+\n" +
-	"\x04item\x18\x02 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x86\x01\n" +
-	"\x16OverrideVerdictRequest\x12&\n" +
-	"\x0fitem_session_id\x18\x01 \x01(\tR\ritemSessionId\x12\x1b\n" +
-	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12'\n" +
-	"\x0foverride_reason\x18\x03 \x01(\tR\x0eoverrideReason\"F\n" +
-	"\x17OverrideVerdictResponse\x12+\n" +
-	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"1\n" +
-	"\x16TriggerReReviewRequest\x12\x17\n" +
-	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"U\n" +
-	"\x17TriggerReReviewResponse\x12:\n" +
-	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"1\n" +
-	"\x12TriggerSyncRequest\x12\x1b\n" +
-	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"\x15\n" +
-	"\x13TriggerSyncResponse\"\x90\x01\n" +
-	"\x17CreateItemSourceRequest\x12\x1b\n" +
-	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1f\n" +
-	"\vconfig_json\x18\x03 \x01(\tR\n" +
-	"configJson\x12\x14\n" +
-	"\x05token\x18\x04 \x01(\tR\x05token\"J\n" +
-	"\x18CreateItemSourceResponse\x12.\n" +
-	"\x06source\x18\x01 \x01(\v2\x16.session.v1.ItemSourceR\x06source\"\x18\n" +
-	"\x16ListItemSourcesRequest\"K\n" +
-	"\x17ListItemSourcesResponse\x120\n" +
-	"\asources\x18\x01 \x03(\v2\x16.session.v1.ItemSourceR\asources\"\x89\x01\n" +
-	"\x17UpdateItemSourceRequest\x12\x1b\n" +
-	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
-	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x14\n" +
-	"\x05token\x18\x04 \x01(\tR\x05token\"J\n" +
-	"\x18UpdateItemSourceResponse\x12.\n" +
-	"\x06source\x18\x01 \x01(\v2\x16.session.v1.ItemSourceR\x06source\"6\n" +
-	"\x17DeleteItemSourceRequest\x12\x1b\n" +
-	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"\x1a\n" +
-	"\x18DeleteItemSourceResponse\"4\n" +
-	"\x15GetSyncHistoryRequest\x12\x1b\n" +
-	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"M\n" +
-	"\x16GetSyncHistoryResponse\x123\n" +
-	"\x06events\x18\x01 \x03(\v2\x1b.session.v1.SourceSyncEventR\x06events2\xc7\x0e\n" +
+func (x *BacklogDependency) Reset() {
+	*x = BacklogDependency{}
+	mi := &file_session_v1_backlog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BacklogDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacklogDependency) ProtoMessage() {}
+
+func (x *BacklogDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacklogDependency.ProtoReflect.Descriptor instead.
+func (*BacklogDependency) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BacklogDependency) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *BacklogDependency) GetBlockedById() string {
+	if x != nil {
+		return x.BlockedById
+	}
+	return ""
+}
+
+func (x *BacklogDependency) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// BacklogGraphNode is a backlog item in the dependency graph.
+type BacklogGraphNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Item  *BacklogItem           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// Direct blockers of the item.
+	BlockedByIds []string `protobuf:"bytes,2,rep,name=blocked_by_ids,json=blockedByIds,proto3" json:"blocked_by_ids,omitempty"`
+	// Direct blockers that are not yet done or passed review.
+	PendingBlockerIds []string `protobuf:"bytes,3,rep,name=pending_blocker_ids,json=pendingBlockerIds,proto3" json:"pending_blocker_ids,omitempty"`
+	Blocked           bool     `protobuf:"varint,4,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BacklogGraphNode) Reset() {
+	*x = BacklogGraphNode{}
+	mi := &file_session_v1_backlog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BacklogGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacklogGraphNode) ProtoMessage() {}
+
+func (x *BacklogGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacklogGraphNode.ProtoReflect.Descriptor instead.
+func (*BacklogGraphNode) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BacklogGraphNode) GetItem() *BacklogItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *BacklogGraphNode) GetBlockedByIds() []string {
+	if x != nil {
+		return x.BlockedByIds
+	}
+	return nil
+}
+
+func (x *BacklogGraphNode) GetPendingBlockerIds() []string {
+	if x != nil {
+		return x.PendingBlockerIds
+	}
+	return nil
+}
+
+func (x *BacklogGraphNode) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type AddBacklogDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	BlockedById   string                 `protobuf:"bytes,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBacklogDependencyRequest) Reset() {
+	*x = AddBacklogDependencyRequest{}
+	mi := &file_session_v1_backlog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBacklogDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBacklogDependencyRequest) ProtoMessage() {}
+
+func (x *AddBacklogDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBacklogDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddBacklogDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddBacklogDependencyRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *AddBacklogDependencyRequest) GetBlockedById() string {
+	if x != nil {
+		return x.BlockedById
+	}
+	return ""
+}
+
+type AddBacklogDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dependency    *BacklogDependency     `protobuf:"bytes,1,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBacklogDependencyResponse) Reset() {
+	*x = AddBacklogDependencyResponse{}
+	mi := &file_session_v1_backlog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBacklogDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBacklogDependencyResponse) ProtoMessage() {}
+
+func (x *AddBacklogDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBacklogDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddBacklogDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddBacklogDependencyResponse) GetDependency() *BacklogDependency {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+type RemoveBacklogDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	BlockedById   string                 `protobuf:"bytes,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBacklogDependencyRequest) Reset() {
+	*x = RemoveBacklogDependencyRequest{}
+	mi := &file_session_v1_backlog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBacklogDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBacklogDependencyRequest) ProtoMessage() {}
+
+func (x *RemoveBacklogDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBacklogDependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBacklogDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveBacklogDependencyRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *RemoveBacklogDependencyRequest) GetBlockedById() string {
+	if x != nil {
+		return x.BlockedById
+	}
+	return ""
+}
+
+type RemoveBacklogDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBacklogDependencyResponse) Reset() {
+	*x = RemoveBacklogDependencyResponse{}
+	mi := &file_session_v1_backlog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBacklogDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBacklogDependencyResponse) ProtoMessage() {}
+
+func (x *RemoveBacklogDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBacklogDependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBacklogDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{50}
+}
+
+type GetBacklogGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict the graph to the item's transitive blockers and dependents.
+	// Empty returns every item that has at least one dependency edge.
+	ItemId        string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBacklogGraphRequest) Reset() {
+	*x = GetBacklogGraphRequest{}
+	mi := &file_session_v1_backlog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBacklogGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBacklogGraphRequest) ProtoMessage() {}
+
+func (x *GetBacklogGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBacklogGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetBacklogGraphRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetBacklogGraphRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type GetBacklogGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*BacklogGraphNode    `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*BacklogDependency   `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBacklogGraphResponse) Reset() {
+	*x = GetBacklogGraphResponse{}
+	mi := &file_session_v1_backlog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBacklogGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBacklogGraphResponse) ProtoMessage() {}
+
+func (x *GetBacklogGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBacklogGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetBacklogGraphResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetBacklogGraphResponse) GetNodes() []*BacklogGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetBacklogGraphResponse) GetEdges() []*BacklogDependency {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+// RubricScores rates a change from 0 to 10 on each review dimension.
+type RubricScores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Correctness   float64                `protobuf:"fixed64,1,opt,name=correctness,proto3" json:"correctness,omitempty"`
+	Tests         float64                `protobuf:"fixed64,2,opt,name=tests,proto3" json:"tests,omitempty"`
+	Style         float64                `protobuf:"fixed64,3,opt,name=style,proto3" json:"style,omitempty"`
+	Security      float64                `protobuf:"fixed64,4,opt,name=security,proto3" json:"security,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricScores) Reset() {
+	*x = RubricScores{}
+	mi := &file_session_v1_backlog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricScores) ProtoMessage() {}
+
+func (x *RubricScores) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricScores.ProtoReflect.Descriptor instead.
+func (*RubricScores) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RubricScores) GetCorrectness() float64 {
+	if x != nil {
+		return x.Correctness
+	}
+	return 0
+}
+
+func (x *RubricScores) GetTests() float64 {
+	if x != nil {
+		return x.Tests
+	}
+	return 0
+}
+
+func (x *RubricScores) GetStyle() float64 {
+	if x != nil {
+		return x.Style
+	}
+	return 0
+}
+
+func (x *RubricScores) GetSecurity() float64 {
+	if x != nil {
+		return x.Security
+	}
+	return 0
+}
+
+// PanelReviewerVerdict is one reviewer's verdict within a review panel run.
+// outcome is empty until the reviewer submits.
+type PanelReviewerVerdict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Program       string                 `protobuf:"bytes,2,opt,name=program,proto3" json:"program,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	SessionUuid   string                 `protobuf:"bytes,4,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
+	Outcome       string                 `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Summary       string                 `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	PerCriterion  []*CriterionVerdict    `protobuf:"bytes,7,rep,name=per_criterion,json=perCriterion,proto3" json:"per_criterion,omitempty"`
+	Rubric        *RubricScores          `protobuf:"bytes,8,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PanelReviewerVerdict) Reset() {
+	*x = PanelReviewerVerdict{}
+	mi := &file_session_v1_backlog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PanelReviewerVerdict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanelReviewerVerdict) ProtoMessage() {}
+
+func (x *PanelReviewerVerdict) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanelReviewerVerdict.ProtoReflect.Descriptor instead.
+func (*PanelReviewerVerdict) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PanelReviewerVerdict) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PanelReviewerVerdict) GetProgram() string {
+	if x != nil {
+		return x.Program
+	}
+	return ""
+}
+
+func (x *PanelReviewerVerdict) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PanelReviewerVerdict) GetSessionUuid() string {
+	if x != nil {
+		return x.SessionUuid
+	}
+	return ""
+}
+
+func (x *PanelReviewerVerdict) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *PanelReviewerVerdict) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *PanelReviewerVerdict) GetPerCriterion() []*CriterionVerdict {
+	if x != nil {
+		return x.PerCriterion
+	}
+	return nil
+}
+
+func (x *PanelReviewerVerdict) GetRubric() *RubricScores {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *PanelReviewerVerdict) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+// ReviewDisagreement is a point on which reviewers reached different outcomes.
+type ReviewDisagreement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// -1 for the overall outcome.
+	CriterionIndex int32 `protobuf:"varint,1,opt,name=criterion_index,json=criterionIndex,proto3" json:"criterion_index,omitempty"`
+	// Reviewer name → outcome.
+	Outcomes      map[string]string `protobuf:"bytes,2,rep,name=outcomes,proto3" json:"outcomes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewDisagreement) Reset() {
+	*x = ReviewDisagreement{}
+	mi := &file_session_v1_backlog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewDisagreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewDisagreement) ProtoMessage() {}
+
+func (x *ReviewDisagreement) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewDisagreement.ProtoReflect.Descriptor instead.
+func (*ReviewDisagreement) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReviewDisagreement) GetCriterionIndex() int32 {
+	if x != nil {
+		return x.CriterionIndex
+	}
+	return 0
+}
+
+func (x *ReviewDisagreement) GetOutcomes() map[string]string {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+// ReviewPanelRun is one review-gate evaluation of an item by a panel of
+// reviewers that all saw the same diff.
+type ReviewPanelRun struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId   string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	RepoPath string                 `protobuf:"bytes,3,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	DiffHash string                 `protobuf:"bytes,4,opt,name=diff_hash,json=diffHash,proto3" json:"diff_hash,omitempty"`
+	// "unanimous", "majority" or "weighted".
+	Consensus     string                  `protobuf:"bytes,5,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	PassThreshold float64                 `protobuf:"fixed64,6,opt,name=pass_threshold,json=passThreshold,proto3" json:"pass_threshold,omitempty"`
+	Reviewers     []*PanelReviewerVerdict `protobuf:"bytes,7,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	// Consensus outcome; empty until every reviewer has submitted.
+	Outcome       string                `protobuf:"bytes,8,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Disagreements []*ReviewDisagreement `protobuf:"bytes,9,rep,name=disagreements,proto3" json:"disagreements,omitempty"`
+	// Weighted mean of the reviewers' rubric scores.
+	Rubric    *RubricScores          `protobuf:"bytes,10,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	// Deterministic checks run before the reviewers. When a hard check fails
+	// the run is decided FAIL with no reviewers.
+	Checks        []*ReviewCheckResult `protobuf:"bytes,13,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewPanelRun) Reset() {
+	*x = ReviewPanelRun{}
+	mi := &file_session_v1_backlog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPanelRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPanelRun) ProtoMessage() {}
+
+func (x *ReviewPanelRun) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPanelRun.ProtoReflect.Descriptor instead.
+func (*ReviewPanelRun) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReviewPanelRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewPanelRun) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ReviewPanelRun) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+func (x *ReviewPanelRun) GetDiffHash() string {
+	if x != nil {
+		return x.DiffHash
+	}
+	return ""
+}
+
+func (x *ReviewPanelRun) GetConsensus() string {
+	if x != nil {
+		return x.Consensus
+	}
+	return ""
+}
+
+func (x *ReviewPanelRun) GetPassThreshold() float64 {
+	if x != nil {
+		return x.PassThreshold
+	}
+	return 0
+}
+
+func (x *ReviewPanelRun) GetReviewers() []*PanelReviewerVerdict {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *ReviewPanelRun) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ReviewPanelRun) GetDisagreements() []*ReviewDisagreement {
+	if x != nil {
+		return x.Disagreements
+	}
+	return nil
+}
+
+func (x *ReviewPanelRun) GetRubric() *RubricScores {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *ReviewPanelRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReviewPanelRun) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *ReviewPanelRun) GetChecks() []*ReviewCheckResult {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type ListReviewPanelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters; empty matches all.
+	ItemId   string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	RepoPath string `protobuf:"bytes,2,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	// Maximum runs to return, newest first. 0 uses the server default.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewPanelsRequest) Reset() {
+	*x = ListReviewPanelsRequest{}
+	mi := &file_session_v1_backlog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewPanelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewPanelsRequest) ProtoMessage() {}
+
+func (x *ListReviewPanelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewPanelsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewPanelsRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListReviewPanelsRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ListReviewPanelsRequest) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+func (x *ListReviewPanelsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReviewPanelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*ReviewPanelRun      `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewPanelsResponse) Reset() {
+	*x = ListReviewPanelsResponse{}
+	mi := &file_session_v1_backlog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewPanelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewPanelsResponse) ProtoMessage() {}
+
+func (x *ListReviewPanelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewPanelsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewPanelsResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListReviewPanelsResponse) GetRuns() []*ReviewPanelRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// RubricTrendPoint is the rubric of one decided review panel run.
+type RubricTrendPoint struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RunId             string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ItemId            string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Outcome           string                 `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Rubric            *RubricScores          `protobuf:"bytes,4,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	DecidedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	ReviewerCount     int32                  `protobuf:"varint,6,opt,name=reviewer_count,json=reviewerCount,proto3" json:"reviewer_count,omitempty"`
+	DisagreementCount int32                  `protobuf:"varint,7,opt,name=disagreement_count,json=disagreementCount,proto3" json:"disagreement_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RubricTrendPoint) Reset() {
+	*x = RubricTrendPoint{}
+	mi := &file_session_v1_backlog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricTrendPoint) ProtoMessage() {}
+
+func (x *RubricTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricTrendPoint.ProtoReflect.Descriptor instead.
+func (*RubricTrendPoint) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RubricTrendPoint) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RubricTrendPoint) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *RubricTrendPoint) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RubricTrendPoint) GetRubric() *RubricScores {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *RubricTrendPoint) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *RubricTrendPoint) GetReviewerCount() int32 {
+	if x != nil {
+		return x.ReviewerCount
+	}
+	return 0
+}
+
+func (x *RubricTrendPoint) GetDisagreementCount() int32 {
+	if x != nil {
+		return x.DisagreementCount
+	}
+	return 0
+}
+
+type GetReviewRubricTrendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to one repository; empty covers all.
+	RepoPath string `protobuf:"bytes,1,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	// Maximum points (the most recent ones). 0 uses the server default.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRubricTrendRequest) Reset() {
+	*x = GetReviewRubricTrendRequest{}
+	mi := &file_session_v1_backlog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRubricTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRubricTrendRequest) ProtoMessage() {}
+
+func (x *GetReviewRubricTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRubricTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRubricTrendRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetReviewRubricTrendRequest) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+func (x *GetReviewRubricTrendRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetReviewRubricTrendResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Points []*RubricTrendPoint    `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	// Mean of the returned points' rubric scores.
+	Average       *RubricScores `protobuf:"bytes,2,opt,name=average,proto3" json:"average,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRubricTrendResponse) Reset() {
+	*x = GetReviewRubricTrendResponse{}
+	mi := &file_session_v1_backlog_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRubricTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRubricTrendResponse) ProtoMessage() {}
+
+func (x *GetReviewRubricTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRubricTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewRubricTrendResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetReviewRubricTrendResponse) GetPoints() []*RubricTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetReviewRubricTrendResponse) GetAverage() *RubricScores {
+	if x != nil {
+		return x.Average
+	}
+	return nil
+}
+
+// ReviewCheckResult is the outcome of one deterministic review-gate check
+// (build, lint, tests, license headers, forbidden paths).
+type ReviewCheckResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "command", "license_header" or "forbidden_paths".
+	Kind   string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Passed bool   `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	// A failed hard check fails the gate without spawning reviewers.
+	Hard bool `protobuf:"varint,4,opt,name=hard,proto3" json:"hard,omitempty"`
+	// Tail of the command output, or the offending files.
+	Output        string `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
+	DurationMs    int64  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewCheckResult) Reset() {
+	*x = ReviewCheckResult{}
+	mi := &file_session_v1_backlog_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCheckResult) ProtoMessage() {}
+
+func (x *ReviewCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_backlog_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCheckResult.ProtoReflect.Descriptor instead.
+func (*ReviewCheckResult) Descriptor() ([]byte, []int) {
+	return file_session_v1_backlog_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReviewCheckResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReviewCheckResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReviewCheckResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ReviewCheckResult) GetHard() bool {
+	if x != nil {
+		return x.Hard
+	}
+	return false
+}
+
+func (x *ReviewCheckResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ReviewCheckResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+var File_session_v1_backlog_proto protoreflect.FileDescriptor
+
+const file_session_v1_backlog_proto_rawDesc = "" +
+	"\n" +
+	"\x18session/v1/backlog.proto\x12\n" +
+	"session.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"O\n" +
+	"\vAcCriterion\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"q\n" +
+	"\x10CriterionVerdict\x12'\n" +
+	"\x0fcriterion_index\x18\x01 \x01(\x05R\x0ecriterionIndex\x12\x18\n" +
+	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x1a\n" +
+	"\bevidence\x18\x03 \x01(\tR\bevidence\"\x8c\x04\n" +
+	"\rReviewVerdict\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0foverall_outcome\x18\x02 \x01(\tR\x0eoverallOutcome\x12A\n" +
+	"\rper_criterion\x18\x03 \x03(\v2\x1c.session.v1.CriterionVerdictR\fperCriterion\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x1b\n" +
+	"\tdiff_hash\x18\x05 \x01(\tR\bdiffHash\x12(\n" +
+	"\x10diff_token_count\x18\x06 \x01(\x05R\x0ediffTokenCount\x12%\n" +
+	"\x0ediff_truncated\x18\a \x01(\bR\rdiffTruncated\x12\x1f\n" +
+	"\voverride_by\x18\b \x01(\tR\n" +
+	"overrideBy\x12'\n" +
+	"\x0foverride_reason\x18\t \x01(\tR\x0eoverrideReason\x12;\n" +
+	"\voverride_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"overrideAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
+	"\x06checks\x18\f \x03(\v2\x1d.session.v1.ReviewCheckResultR\x06checks\"\xc6\x04\n" +
+	"\vItemSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fsession_uuid\x18\x02 \x01(\tR\vsessionUuid\x12!\n" +
+	"\fsession_role\x18\x03 \x01(\tR\vsessionRole\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12.\n" +
+	"\x13last_commit_message\x18\x06 \x01(\tR\x11lastCommitMessage\x12@\n" +
+	"\x0elast_commit_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\flastCommitAt\x127\n" +
+	"\x18commit_count_since_spawn\x18\b \x01(\x05R\x15commitCountSinceSpawn\x12G\n" +
+	"\x12last_file_touch_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0flastFileTouchAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12@\n" +
+	"\x0ereview_verdict\x18\v \x01(\v2\x19.session.v1.ReviewVerdictR\rreviewVerdict\"\x9f\x06\n" +
+	"\vBacklogItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12H\n" +
+	"\x13acceptance_criteria\x18\x04 \x03(\v2\x17.session.v1.AcCriterionR\x12acceptanceCriteria\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1b\n" +
+	"\trepo_path\x18\a \x01(\tR\brepoPath\x12(\n" +
+	"\x10skip_review_gate\x18\b \x01(\bR\x0eskipReviewGate\x12#\n" +
+	"\rskip_planning\x18\t \x01(\bR\fskipPlanning\x12#\n" +
+	"\rplan_approved\x18\n" +
+	" \x01(\bR\fplanApproved\x12D\n" +
+	"\x10plan_approved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eplanApprovedAt\x12.\n" +
+	"\x13plan_artifacts_path\x18\f \x01(\tR\x11planArtifactsPath\x12\x14\n" +
+	"\x05notes\x18\r \x01(\tR\x05notes\x12\x1f\n" +
+	"\vexternal_id\x18\x0e \x01(\tR\n" +
+	"externalId\x12;\n" +
+	"\varchived_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
+	"\ritem_sessions\x18\x12 \x03(\v2\x17.session.v1.ItemSessionR\fitemSessions\x12\x1b\n" +
+	"\tsource_id\x18\x13 \x01(\tR\bsourceId\"\xd9\x02\n" +
+	"\n" +
+	"ItemSource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tplugin_id\x18\x02 \x01(\tR\bpluginId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12@\n" +
+	"\x0elast_synced_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncedAt\x12)\n" +
+	"\x10token_configured\x18\x06 \x01(\bR\x0ftokenConfigured\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd2\x02\n" +
+	"\x0fSourceSyncEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12#\n" +
+	"\ritems_created\x18\x04 \x01(\x05R\fitemsCreated\x12#\n" +
+	"\ritems_updated\x18\x05 \x01(\x05R\fitemsUpdated\x12#\n" +
+	"\ritems_skipped\x18\x06 \x01(\x05R\fitemsSkipped\x12#\n" +
+	"\ritems_errored\x18\a \x01(\x05R\fitemsErrored\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\"\xba\x02\n" +
+	"\x18CreateBacklogItemRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12H\n" +
+	"\x13acceptance_criteria\x18\x03 \x03(\v2\x17.session.v1.AcCriterionR\x12acceptanceCriteria\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12(\n" +
+	"\x10skip_review_gate\x18\x05 \x01(\bR\x0eskipReviewGate\x12#\n" +
+	"\rskip_planning\x18\x06 \x01(\bR\fskipPlanning\x12\x1b\n" +
+	"\trepo_path\x18\a \x01(\tR\brepoPath\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\"H\n" +
+	"\x19CreateBacklogItemResponse\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"0\n" +
+	"\x15GetBacklogItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"E\n" +
+	"\x16GetBacklogItemResponse\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x91\x01\n" +
+	"\x17ListBacklogItemsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x03(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\x02 \x03(\x05R\bpriority\x12\x17\n" +
+	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12)\n" +
+	"\x10include_terminal\x18\x04 \x01(\bR\x0fincludeTerminal\"I\n" +
+	"\x18ListBacklogItemsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.session.v1.BacklogItemR\x05items\"\xc8\x03\n" +
+	"\x18UpdateBacklogItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12H\n" +
+	"\x13acceptance_criteria\x18\x04 \x03(\v2\x17.session.v1.AcCriterionR\x12acceptanceCriteria\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12(\n" +
+	"\x10skip_review_gate\x18\x06 \x01(\bR\x0eskipReviewGate\x12#\n" +
+	"\rskip_planning\x18\a \x01(\bR\fskipPlanning\x12\x1b\n" +
+	"\trepo_path\x18\b \x01(\tR\brepoPath\x12\x14\n" +
+	"\x05notes\x18\t \x01(\tR\x05notes\x12'\n" +
+	"\x0fexpected_status\x18\n" +
+	" \x01(\tR\x0eexpectedStatus\x12J\n" +
+	"\x13expected_updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"H\n" +
+	"\x19UpdateBacklogItemResponse\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"4\n" +
+	"\x19ArchiveBacklogItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"I\n" +
+	"\x1aArchiveBacklogItemResponse\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x80\x02\n" +
+	"\"TransitionBacklogItemStatusRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12#\n" +
+	"\rtarget_status\x18\x02 \x01(\tR\ftargetStatus\x12'\n" +
+	"\x0fexpected_status\x18\x03 \x01(\tR\x0eexpectedStatus\x12J\n" +
+	"\x13expected_updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\x12'\n" +
+	"\x0foverride_reason\x18\x05 \x01(\tR\x0eoverrideReason\"R\n" +
+	"#TransitionBacklogItemStatusResponse\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x87\x02\n" +
+	"\x1bSpawnSessionFromItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12m\n" +
+	"\x12template_variables\x18\x03 \x03(\v2>.session.v1.SpawnSessionFromItemRequest.TemplateVariablesEntryR\x11templateVariables\x1aD\n" +
+	"\x16TemplateVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"}\n" +
+	"\x1cSpawnSessionFromItemResponse\x12!\n" +
+	"\fsession_uuid\x18\x01 \x01(\tR\vsessionUuid\x12:\n" +
+	"\fitem_session\x18\x02 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"X\n" +
+	"\x1aAttachSessionToItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12!\n" +
+	"\fsession_uuid\x18\x02 \x01(\tR\vsessionUuid\"Y\n" +
+	"\x1bAttachSessionToItemResponse\x12:\n" +
+	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"/\n" +
+	"\x14TriggerTriageRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"S\n" +
+	"\x15TriggerTriageResponse\x12:\n" +
+	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"-\n" +
+	"\x12ApprovePlanRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"B\n" +
+	"\x13ApprovePlanResponse\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x18\n" +
+	"\x16SuggestNextItemRequest\"\x82\x01\n" +
+	"\x17SuggestNextItemResponse\x12:\n" +
+	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\x12+\n" +
+	"\x04item\x18\x02 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"\x86\x01\n" +
+	"\x16OverrideVerdictRequest\x12&\n" +
+	"\x0fitem_session_id\x18\x01 \x01(\tR\ritemSessionId\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12'\n" +
+	"\x0foverride_reason\x18\x03 \x01(\tR\x0eoverrideReason\"F\n" +
+	"\x17OverrideVerdictResponse\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\"1\n" +
+	"\x16TriggerReReviewRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"U\n" +
+	"\x17TriggerReReviewResponse\x12:\n" +
+	"\fitem_session\x18\x01 \x01(\v2\x17.session.v1.ItemSessionR\vitemSession\"1\n" +
+	"\x12TriggerSyncRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"\x15\n" +
+	"\x13TriggerSyncResponse\"\x90\x01\n" +
+	"\x17CreateItemSourceRequest\x12\x1b\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1f\n" +
+	"\vconfig_json\x18\x03 \x01(\tR\n" +
+	"configJson\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"J\n" +
+	"\x18CreateItemSourceResponse\x12.\n" +
+	"\x06source\x18\x01 \x01(\v2\x16.session.v1.ItemSourceR\x06source\"\x18\n" +
+	"\x16ListItemSourcesRequest\"K\n" +
+	"\x17ListItemSourcesResponse\x120\n" +
+	"\asources\x18\x01 \x03(\v2\x16.session.v1.ItemSourceR\asources\"\x89\x01\n" +
+	"\x17UpdateItemSourceRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"J\n" +
+	"\x18UpdateItemSourceResponse\x12.\n" +
+	"\x06source\x18\x01 \x01(\v2\x16.session.v1.ItemSourceR\x06source\"6\n" +
+	"\x17DeleteItemSourceRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"\x1a\n" +
+	"\x18DeleteItemSourceResponse\"4\n" +
+	"\x15GetSyncHistoryRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\"M\n" +
+	"\x16GetSyncHistoryResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.session.v1.SourceSyncEventR\x06events\"\x8b\x01\n" +
+	"\x11BacklogDependency\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\"\n" +
+	"\rblocked_by_id\x18\x02 \x01(\tR\vblockedById\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xaf\x01\n" +
+	"\x10BacklogGraphNode\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.session.v1.BacklogItemR\x04item\x12$\n" +
+	"\x0eblocked_by_ids\x18\x02 \x03(\tR\fblockedByIds\x12.\n" +
+	"\x13pending_blocker_ids\x18\x03 \x03(\tR\x11pendingBlockerIds\x12\x18\n" +
+	"\ablocked\x18\x04 \x01(\bR\ablocked\"Z\n" +
+	"\x1bAddBacklogDependencyRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\"\n" +
+	"\rblocked_by_id\x18\x02 \x01(\tR\vblockedById\"]\n" +
+	"\x1cAddBacklogDependencyResponse\x12=\n" +
+	"\n" +
+	"dependency\x18\x01 \x01(\v2\x1d.session.v1.BacklogDependencyR\n" +
+	"dependency\"]\n" +
+	"\x1eRemoveBacklogDependencyRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\"\n" +
+	"\rblocked_by_id\x18\x02 \x01(\tR\vblockedById\"!\n" +
+	"\x1fRemoveBacklogDependencyResponse\"1\n" +
+	"\x16GetBacklogGraphRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"\x82\x01\n" +
+	"\x17GetBacklogGraphResponse\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.session.v1.BacklogGraphNodeR\x05nodes\x123\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1d.session.v1.BacklogDependencyR\x05edges\"x\n" +
+	"\fRubricScores\x12 \n" +
+	"\vcorrectness\x18\x01 \x01(\x01R\vcorrectness\x12\x14\n" +
+	"\x05tests\x18\x02 \x01(\x01R\x05tests\x12\x14\n" +
+	"\x05style\x18\x03 \x01(\x01R\x05style\x12\x1a\n" +
+	"\bsecurity\x18\x04 \x01(\x01R\bsecurity\"\xe7\x02\n" +
+	"\x14PanelReviewerVerdict\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aprogram\x18\x02 \x01(\tR\aprogram\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12!\n" +
+	"\fsession_uuid\x18\x04 \x01(\tR\vsessionUuid\x12\x18\n" +
+	"\aoutcome\x18\x05 \x01(\tR\aoutcome\x12\x18\n" +
+	"\asummary\x18\x06 \x01(\tR\asummary\x12A\n" +
+	"\rper_criterion\x18\a \x03(\v2\x1c.session.v1.CriterionVerdictR\fperCriterion\x120\n" +
+	"\x06rubric\x18\b \x01(\v2\x18.session.v1.RubricScoresR\x06rubric\x12=\n" +
+	"\fsubmitted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\xc4\x01\n" +
+	"\x12ReviewDisagreement\x12'\n" +
+	"\x0fcriterion_index\x18\x01 \x01(\x05R\x0ecriterionIndex\x12H\n" +
+	"\boutcomes\x18\x02 \x03(\v2,.session.v1.ReviewDisagreement.OutcomesEntryR\boutcomes\x1a;\n" +
+	"\rOutcomesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb7\x04\n" +
+	"\x0eReviewPanelRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\trepo_path\x18\x03 \x01(\tR\brepoPath\x12\x1b\n" +
+	"\tdiff_hash\x18\x04 \x01(\tR\bdiffHash\x12\x1c\n" +
+	"\tconsensus\x18\x05 \x01(\tR\tconsensus\x12%\n" +
+	"\x0epass_threshold\x18\x06 \x01(\x01R\rpassThreshold\x12>\n" +
+	"\treviewers\x18\a \x03(\v2 .session.v1.PanelReviewerVerdictR\treviewers\x12\x18\n" +
+	"\aoutcome\x18\b \x01(\tR\aoutcome\x12D\n" +
+	"\rdisagreements\x18\t \x03(\v2\x1e.session.v1.ReviewDisagreementR\rdisagreements\x120\n" +
+	"\x06rubric\x18\n" +
+	" \x01(\v2\x18.session.v1.RubricScoresR\x06rubric\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"decided_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x125\n" +
+	"\x06checks\x18\r \x03(\v2\x1d.session.v1.ReviewCheckResultR\x06checks\"e\n" +
+	"\x17ListReviewPanelsRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\trepo_path\x18\x02 \x01(\tR\brepoPath\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n" +
+	"\x18ListReviewPanelsResponse\x12.\n" +
+	"\x04runs\x18\x01 \x03(\v2\x1a.session.v1.ReviewPanelRunR\x04runs\"\x9f\x02\n" +
+	"\x10RubricTrendPoint\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x18\n" +
+	"\aoutcome\x18\x03 \x01(\tR\aoutcome\x120\n" +
+	"\x06rubric\x18\x04 \x01(\v2\x18.session.v1.RubricScoresR\x06rubric\x129\n" +
+	"\n" +
+	"decided_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12%\n" +
+	"\x0ereviewer_count\x18\x06 \x01(\x05R\rreviewerCount\x12-\n" +
+	"\x12disagreement_count\x18\a \x01(\x05R\x11disagreementCount\"P\n" +
+	"\x1bGetReviewRubricTrendRequest\x12\x1b\n" +
+	"\trepo_path\x18\x01 \x01(\tR\brepoPath\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x88\x01\n" +
+	"\x1cGetReviewRubricTrendResponse\x124\n" +
+	"\x06points\x18\x01 \x03(\v2\x1c.session.v1.RubricTrendPointR\x06points\x122\n" +
+	"\aaverage\x18\x02 \x01(\v2\x18.session.v1.RubricScoresR\aaverage\"\xa0\x01\n" +
+	"\x11ReviewCheckResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06passed\x18\x03 \x01(\bR\x06passed\x12\x12\n" +
+	"\x04hard\x18\x04 \x01(\bR\x04hard\x12\x16\n" +
+	"\x06output\x18\x05 \x01(\tR\x06output\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x03R\n" +
+	"durationMs2\xd6\x12\n" +
 	"\x0eBacklogService\x12b\n" +
 	"\x11CreateBacklogItem\x12$.session.v1.CreateBacklogItemRequest\x1a%.session.v1.CreateBacklogItemResponse\"\x00\x12Y\n" +
 	"\x0eGetBacklogItem\x12!.session.v1.GetBacklogItemRequest\x1a\".session.v1.GetBacklogItemResponse\"\x00\x12_\n" +
@@ -2929,7 +4248,12 @@ const file_session_v1_backlog_proto_rawDesc = "" +
 	"\x0fListItemSources\x12\".session.v1.ListItemSourcesRequest\x1a#.session.v1.ListItemSourcesResponse\"\x00\x12_\n" +
 	"\x10UpdateItemSource\x12#.session.v1.UpdateItemSourceRequest\x1a$.session.v1.UpdateItemSourceResponse\"\x00\x12_\n" +
 	"\x10DeleteItemSource\x12#.session.v1.DeleteItemSourceRequest\x1a$.session.v1.DeleteItemSourceResponse\"\x00\x12Y\n" +
-	"\x0eGetSyncHistory\x12!.session.v1.GetSyncHistoryRequest\x1a\".session.v1.GetSyncHistoryResponse\"\x00B\xac\x01\n" +
+	"\x0eGetSyncHistory\x12!.session.v1.GetSyncHistoryRequest\x1a\".session.v1.GetSyncHistoryResponse\"\x00\x12k\n" +
+	"\x14AddBacklogDependency\x12'.session.v1.AddBacklogDependencyRequest\x1a(.session.v1.AddBacklogDependencyResponse\"\x00\x12t\n" +
+	"\x17RemoveBacklogDependency\x12*.session.v1.RemoveBacklogDependencyRequest\x1a+.session.v1.RemoveBacklogDependencyResponse\"\x00\x12\\\n" +
+	"\x0fGetBacklogGraph\x12\".session.v1.GetBacklogGraphRequest\x1a#.session.v1.GetBacklogGraphResponse\"\x00\x12_\n" +
+	"\x10ListReviewPanels\x12#.session.v1.ListReviewPanelsRequest\x1a$.session.v1.ListReviewPanelsResponse\"\x00\x12k\n" +
+	"\x14GetReviewRubricTrend\x12'.session.v1.GetReviewRubricTrendRequest\x1a(.session.v1.GetReviewRubricTrendResponse\"\x00B\xac\x01\n" +
 	"\x0ecom.session.v1B\fBacklogProtoP\x01ZCgithub.com/tstapler/stapler-squad/gen/proto/go/session/v1;sessionv1\xa2\x02\x03SXX\xaa\x02\n" +
 	"Session.V1\xca\x02\n" +
 	"Session\\V1\xe2\x02\x16Session\\V1\\GPBMetadata\xea\x02\vSession::V1b\x06proto3"
@@ -2946,7 +4270,7 @@ func file_session_v1_backlog_proto_rawDescGZIP() []byte {
 	return file_session_v1_backlog_proto_rawDescData
 }
 
-var file_session_v1_backlog_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_session_v1_backlog_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_session_v1_backlog_proto_goTypes = []any{
 	(*AcCriterion)(nil),                         // 0: session.v1.AcCriterion
 	(*CriterionVerdict)(nil),                    // 1: session.v1.CriterionVerdict
@@ -2993,94 +4317,146 @@ var file_session_v1_backlog_proto_goTypes = []any{
 	(*DeleteItemSourceResponse)(nil),            // 42: session.v1.DeleteItemSourceResponse
 	(*GetSyncHistoryRequest)(nil),               // 43: session.v1.GetSyncHistoryRequest
 	(*GetSyncHistoryResponse)(nil),              // 44: session.v1.GetSyncHistoryResponse
-	(*timestamppb.Timestamp)(nil),               // 45: google.protobuf.Timestamp
+	(*BacklogDependency)(nil),                   // 45: session.v1.BacklogDependency
+	(*BacklogGraphNode)(nil),                    // 46: session.v1.BacklogGraphNode
+	(*AddBacklogDependencyRequest)(nil),         // 47: session.v1.AddBacklogDependencyRequest
+	(*AddBacklogDependencyResponse)(nil),        // 48: session.v1.AddBacklogDependencyResponse
+	(*RemoveBacklogDependencyRequest)(nil),      // 49: session.v1.RemoveBacklogDependencyRequest
+	(*RemoveBacklogDependencyResponse)(nil),     // 50: session.v1.RemoveBacklogDependencyResponse
+	(*GetBacklogGraphRequest)(nil),              // 51: session.v1.GetBacklogGraphRequest
+	(*GetBacklogGraphResponse)(nil),             // 52: session.v1.GetBacklogGraphResponse
+	(*RubricScores)(nil),                        // 53: session.v1.RubricScores
+	(*PanelReviewerVerdict)(nil),                // 54: session.v1.PanelReviewerVerdict
+	(*ReviewDisagreement)(nil),                  // 55: session.v1.ReviewDisagreement
+	(*ReviewPanelRun)(nil),                      // 56: session.v1.ReviewPanelRun
+	(*ListReviewPanelsRequest)(nil),             // 57: session.v1.ListReviewPanelsRequest
+	(*ListReviewPanelsResponse)(nil),            // 58: session.v1.ListReviewPanelsResponse
+	(*RubricTrendPoint)(nil),                    // 59: session.v1.RubricTrendPoint
+	(*GetReviewRubricTrendRequest)(nil),         // 60: session.v1.GetReviewRubricTrendRequest
+	(*GetReviewRubricTrendResponse)(nil),        // 61: session.v1.GetReviewRubricTrendResponse
+	(*ReviewCheckResult)(nil),                   // 62: session.v1.ReviewCheckResult
+	nil,                                         // 63: session.v1.SpawnSessionFromItemRequest.TemplateVariablesEntry
+	nil,                                         // 64: session.v1.ReviewDisagreement.OutcomesEntry
+	(*timestamppb.Timestamp)(nil),               // 65: google.protobuf.Timestamp
 }
 var file_session_v1_backlog_proto_depIdxs = []int32{
 	1,  // 0: session.v1.ReviewVerdict.per_criterion:type_name -> session.v1.CriterionVerdict
-	45, // 1: session.v1.ReviewVerdict.override_at:type_name -> google.protobuf.Timestamp
-	45, // 2: session.v1.ReviewVerdict.created_at:type_name -> google.protobuf.Timestamp
-	45, // 3: session.v1.ItemSession.started_at:type_name -> google.protobuf.Timestamp
-	45, // 4: session.v1.ItemSession.ended_at:type_name -> google.protobuf.Timestamp
-	45, // 5: session.v1.ItemSession.last_commit_at:type_name -> google.protobuf.Timestamp
-	45, // 6: session.v1.ItemSession.last_file_touch_at:type_name -> google.protobuf.Timestamp
-	45, // 7: session.v1.ItemSession.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 8: session.v1.ItemSession.review_verdict:type_name -> session.v1.ReviewVerdict
-	0,  // 9: session.v1.BacklogItem.acceptance_criteria:type_name -> session.v1.AcCriterion
-	45, // 10: session.v1.BacklogItem.plan_approved_at:type_name -> google.protobuf.Timestamp
-	45, // 11: session.v1.BacklogItem.archived_at:type_name -> google.protobuf.Timestamp
-	45, // 12: session.v1.BacklogItem.created_at:type_name -> google.protobuf.Timestamp
-	45, // 13: session.v1.BacklogItem.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 14: session.v1.BacklogItem.item_sessions:type_name -> session.v1.ItemSession
-	45, // 15: session.v1.ItemSource.last_synced_at:type_name -> google.protobuf.Timestamp
-	45, // 16: session.v1.ItemSource.created_at:type_name -> google.protobuf.Timestamp
-	45, // 17: session.v1.ItemSource.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 18: session.v1.SourceSyncEvent.started_at:type_name -> google.protobuf.Timestamp
-	45, // 19: session.v1.SourceSyncEvent.finished_at:type_name -> google.protobuf.Timestamp
-	0,  // 20: session.v1.CreateBacklogItemRequest.acceptance_criteria:type_name -> session.v1.AcCriterion
-	4,  // 21: session.v1.CreateBacklogItemResponse.item:type_name -> session.v1.BacklogItem
-	4,  // 22: session.v1.GetBacklogItemResponse.item:type_name -> session.v1.BacklogItem
-	4,  // 23: session.v1.ListBacklogItemsResponse.items:type_name -> session.v1.BacklogItem
-	0,  // 24: session.v1.UpdateBacklogItemRequest.acceptance_criteria:type_name -> session.v1.AcCriterion
-	45, // 25: session.v1.UpdateBacklogItemRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 26: session.v1.UpdateBacklogItemResponse.item:type_name -> session.v1.BacklogItem
-	4,  // 27: session.v1.ArchiveBacklogItemResponse.item:type_name -> session.v1.BacklogItem
-	45, // 28: session.v1.TransitionBacklogItemStatusRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 29: session.v1.TransitionBacklogItemStatusResponse.item:type_name -> session.v1.BacklogItem
-	3,  // 30: session.v1.SpawnSessionFromItemResponse.item_session:type_name -> session.v1.ItemSession
-	3,  // 31: session.v1.AttachSessionToItemResponse.item_session:type_name -> session.v1.ItemSession
-	3,  // 32: session.v1.TriggerTriageResponse.item_session:type_name -> session.v1.ItemSession
-	4,  // 33: session.v1.ApprovePlanResponse.item:type_name -> session.v1.BacklogItem
-	3,  // 34: session.v1.SuggestNextItemResponse.item_session:type_name -> session.v1.ItemSession
-	4,  // 35: session.v1.SuggestNextItemResponse.item:type_name -> session.v1.BacklogItem
-	4,  // 36: session.v1.OverrideVerdictResponse.item:type_name -> session.v1.BacklogItem
-	3,  // 37: session.v1.TriggerReReviewResponse.item_session:type_name -> session.v1.ItemSession
-	5,  // 38: session.v1.CreateItemSourceResponse.source:type_name -> session.v1.ItemSource
-	5,  // 39: session.v1.ListItemSourcesResponse.sources:type_name -> session.v1.ItemSource
-	5,  // 40: session.v1.UpdateItemSourceResponse.source:type_name -> session.v1.ItemSource
-	6,  // 41: session.v1.GetSyncHistoryResponse.events:type_name -> session.v1.SourceSyncEvent
-	7,  // 42: session.v1.BacklogService.CreateBacklogItem:input_type -> session.v1.CreateBacklogItemRequest
-	9,  // 43: session.v1.BacklogService.GetBacklogItem:input_type -> session.v1.GetBacklogItemRequest
-	11, // 44: session.v1.BacklogService.ListBacklogItems:input_type -> session.v1.ListBacklogItemsRequest
-	13, // 45: session.v1.BacklogService.UpdateBacklogItem:input_type -> session.v1.UpdateBacklogItemRequest
-	15, // 46: session.v1.BacklogService.ArchiveBacklogItem:input_type -> session.v1.ArchiveBacklogItemRequest
-	17, // 47: session.v1.BacklogService.TransitionBacklogItemStatus:input_type -> session.v1.TransitionBacklogItemStatusRequest
-	19, // 48: session.v1.BacklogService.SpawnSessionFromItem:input_type -> session.v1.SpawnSessionFromItemRequest
-	21, // 49: session.v1.BacklogService.AttachSessionToItem:input_type -> session.v1.AttachSessionToItemRequest
-	23, // 50: session.v1.BacklogService.TriggerTriage:input_type -> session.v1.TriggerTriageRequest
-	25, // 51: session.v1.BacklogService.ApprovePlan:input_type -> session.v1.ApprovePlanRequest
-	27, // 52: session.v1.BacklogService.SuggestNextItem:input_type -> session.v1.SuggestNextItemRequest
-	29, // 53: session.v1.BacklogService.OverrideVerdict:input_type -> session.v1.OverrideVerdictRequest
-	31, // 54: session.v1.BacklogService.TriggerReReview:input_type -> session.v1.TriggerReReviewRequest
-	33, // 55: session.v1.BacklogService.TriggerSync:input_type -> session.v1.TriggerSyncRequest
-	35, // 56: session.v1.BacklogService.CreateItemSource:input_type -> session.v1.CreateItemSourceRequest
-	37, // 57: session.v1.BacklogService.ListItemSources:input_type -> session.v1.ListItemSourcesRequest
-	39, // 58: session.v1.BacklogService.UpdateItemSource:input_type -> session.v1.UpdateItemSourceRequest
-	41, // 59: session.v1.BacklogService.DeleteItemSource:input_type -> session.v1.DeleteItemSourceRequest
-	43, // 60: session.v1.BacklogService.GetSyncHistory:input_type -> session.v1.GetSyncHistoryRequest
-	8,  // 61: session.v1.BacklogService.CreateBacklogItem:output_type -> session.v1.CreateBacklogItemResponse
-	10, // 62: session.v1.BacklogService.GetBacklogItem:output_type -> session.v1.GetBacklogItemResponse
-	12, // 63: session.v1.BacklogService.ListBacklogItems:output_type -> session.v1.ListBacklogItemsResponse
-	14, // 64: session.v1.BacklogService.UpdateBacklogItem:output_type -> session.v1.UpdateBacklogItemResponse
-	16, // 65: session.v1.BacklogService.ArchiveBacklogItem:output_type -> session.v1.ArchiveBacklogItemResponse
-	18, // 66: session.v1.BacklogService.TransitionBacklogItemStatus:output_type -> session.v1.TransitionBacklogItemStatusResponse
-	20, // 67: session.v1.BacklogService.SpawnSessionFromItem:output_type -> session.v1.SpawnSessionFromItemResponse
-	22, // 68: session.v1.BacklogService.AttachSessionToItem:output_type -> session.v1.AttachSessionToItemResponse
-	24, // 69: session.v1.BacklogService.TriggerTriage:output_type -> session.v1.TriggerTriageResponse
-	26, // 70: session.v1.BacklogService.ApprovePlan:output_type -> session.v1.ApprovePlanResponse
-	28, // 71: session.v1.BacklogService.SuggestNextItem:output_type -> session.v1.SuggestNextItemResponse
-	30, // 72: session.v1.BacklogService.OverrideVerdict:output_type -> session.v1.OverrideVerdictResponse
-	32, // 73: session.v1.BacklogService.TriggerReReview:output_type -> session.v1.TriggerReReviewResponse
-	34, // 74: session.v1.BacklogService.TriggerSync:output_type -> session.v1.TriggerSyncResponse
-	36, // 75: session.v1.BacklogService.CreateItemSource:output_type -> session.v1.CreateItemSourceResponse
-	38, // 76: session.v1.BacklogService.ListItemSources:output_type -> session.v1.ListItemSourcesResponse
-	40, // 77: session.v1.BacklogService.UpdateItemSource:output_type -> session.v1.UpdateItemSourceResponse
-	42, // 78: session.v1.BacklogService.DeleteItemSource:output_type -> session.v1.DeleteItemSourceResponse
-	44, // 79: session.v1.BacklogService.GetSyncHistory:output_type -> session.v1.GetSyncHistoryResponse
-	61, // [61:80] is the sub-list for method output_type
-	42, // [42:61] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	65, // 1: session.v1.ReviewVerdict.override_at:type_name -> google.protobuf.Timestamp
+	65, // 2: session.v1.ReviewVerdict.created_at:type_name -> google.protobuf.Timestamp
+	62, // 3: session.v1.ReviewVerdict.checks:type_name -> session.v1.ReviewCheckResult
+	65, // 4: session.v1.ItemSession.started_at:type_name -> google.protobuf.Timestamp
+	65, // 5: session.v1.ItemSession.ended_at:type_name -> google.protobuf.Timestamp
+	65, // 6: session.v1.ItemSession.last_commit_at:type_name -> google.protobuf.Timestamp
+	65, // 7: session.v1.ItemSession.last_file_touch_at:type_name -> google.protobuf.Timestamp
+	65, // 8: session.v1.ItemSession.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 9: session.v1.ItemSession.review_verdict:type_name -> session.v1.ReviewVerdict
+	0,  // 10: session.v1.BacklogItem.acceptance_criteria:type_name -> session.v1.AcCriterion
+	65, // 11: session.v1.BacklogItem.plan_approved_at:type_name -> google.protobuf.Timestamp
+	65, // 12: session.v1.BacklogItem.archived_at:type_name -> google.protobuf.Timestamp
+	65, // 13: session.v1.BacklogItem.created_at:type_name -> google.protobuf.Timestamp
+	65, // 14: session.v1.BacklogItem.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 15: session.v1.BacklogItem.item_sessions:type_name -> session.v1.ItemSession
+	65, // 16: session.v1.ItemSource.last_synced_at:type_name -> google.protobuf.Timestamp
+	65, // 17: session.v1.ItemSource.created_at:type_name -> google.protobuf.Timestamp
+	65, // 18: session.v1.ItemSource.updated_at:type_name -> google.protobuf.Timestamp
+	65, // 19: session.v1.SourceSyncEvent.started_at:type_name -> google.protobuf.Timestamp
+	65, // 20: session.v1.SourceSyncEvent.finished_at:type_name -> google.protobuf.Timestamp
+	0,  // 21: session.v1.CreateBacklogItemRequest.acceptance_criteria:type_name -> session.v1.AcCriterion
+	4,  // 22: session.v1.CreateBacklogItemResponse.item:type_name -> session.v1.BacklogItem
+	4,  // 23: session.v1.GetBacklogItemResponse.item:type_name -> session.v1.BacklogItem
+	4,  // 24: session.v1.ListBacklogItemsResponse.items:type_name -> session.v1.BacklogItem
+	0,  // 25: session.v1.UpdateBacklogItemRequest.acceptance_criteria:type_name -> session.v1.AcCriterion
+	65, // 26: session.v1.UpdateBacklogItemRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 27: session.v1.UpdateBacklogItemResponse.item:type_name -> session.v1.BacklogItem
+	4,  // 28: session.v1.ArchiveBacklogItemResponse.item:type_name -> session.v1.BacklogItem
+	65, // 29: session.v1.TransitionBacklogItemStatusRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 30: session.v1.TransitionBacklogItemStatusResponse.item:type_name -> session.v1.BacklogItem
+	63, // 31: session.v1.SpawnSessionFromItemRequest.template_variables:type_name -> session.v1.SpawnSessionFromItemRequest.TemplateVariablesEntry
+	3,  // 32: session.v1.SpawnSessionFromItemResponse.item_session:type_name -> session.v1.ItemSession
+	3,  // 33: session.v1.AttachSessionToItemResponse.item_session:type_name -> session.v1.ItemSession
+	3,  // 34: session.v1.TriggerTriageResponse.item_session:type_name -> session.v1.ItemSession
+	4,  // 35: session.v1.ApprovePlanResponse.item:type_name -> session.v1.BacklogItem
+	3,  // 36: session.v1.SuggestNextItemResponse.item_session:type_name -> session.v1.ItemSession
+	4,  // 37: session.v1.SuggestNextItemResponse.item:type_name -> session.v1.BacklogItem
+	4,  // 38: session.v1.OverrideVerdictResponse.item:type_name -> session.v1.BacklogItem
+	3,  // 39: session.v1.TriggerReReviewResponse.item_session:type_name -> session.v1.ItemSession
+	5,  // 40: session.v1.CreateItemSourceResponse.source:type_name -> session.v1.ItemSource
+	5,  // 41: session.v1.ListItemSourcesResponse.sources:type_name -> session.v1.ItemSource
+	5,  // 42: session.v1.UpdateItemSourceResponse.source:type_name -> session.v1.ItemSource
+	6,  // 43: session.v1.GetSyncHistoryResponse.events:type_name -> session.v1.SourceSyncEvent
+	65, // 44: session.v1.BacklogDependency.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 45: session.v1.BacklogGraphNode.item:type_name -> session.v1.BacklogItem
+	45, // 46: session.v1.AddBacklogDependencyResponse.dependency:type_name -> session.v1.BacklogDependency
+	46, // 47: session.v1.GetBacklogGraphResponse.nodes:type_name -> session.v1.BacklogGraphNode
+	45, // 48: session.v1.GetBacklogGraphResponse.edges:type_name -> session.v1.BacklogDependency
+	1,  // 49: session.v1.PanelReviewerVerdict.per_criterion:type_name -> session.v1.CriterionVerdict
+	53, // 50: session.v1.PanelReviewerVerdict.rubric:type_name -> session.v1.RubricScores
+	65, // 51: session.v1.PanelReviewerVerdict.submitted_at:type_name -> google.protobuf.Timestamp
+	64, // 52: session.v1.ReviewDisagreement.outcomes:type_name -> session.v1.ReviewDisagreement.OutcomesEntry
+	54, // 53: session.v1.ReviewPanelRun.reviewers:type_name -> session.v1.PanelReviewerVerdict
+	55, // 54: session.v1.ReviewPanelRun.disagreements:type_name -> session.v1.ReviewDisagreement
+	53, // 55: session.v1.ReviewPanelRun.rubric:type_name -> session.v1.RubricScores
+	65, // 56: session.v1.ReviewPanelRun.created_at:type_name -> google.protobuf.Timestamp
+	65, // 57: session.v1.ReviewPanelRun.decided_at:type_name -> google.protobuf.Timestamp
+	62, // 58: session.v1.ReviewPanelRun.checks:type_name -> session.v1.ReviewCheckResult
+	56, // 59: session.v1.ListReviewPanelsResponse.runs:type_name -> session.v1.ReviewPanelRun
+	53, // 60: session.v1.RubricTrendPoint.rubric:type_name -> session.v1.RubricScores
+	65, // 61: session.v1.RubricTrendPoint.decided_at:type_name -> google.protobuf.Timestamp
+	59, // 62: session.v1.GetReviewRubricTrendResponse.points:type_name -> session.v1.RubricTrendPoint
+	53, // 63: session.v1.GetReviewRubricTrendResponse.average:type_name -> session.v1.RubricScores
+	7,  // 64: session.v1.BacklogService.CreateBacklogItem:input_type -> session.v1.CreateBacklogItemRequest
+	9,  // 65: session.v1.BacklogService.GetBacklogItem:input_type -> session.v1.GetBacklogItemRequest
+	11, // 66: session.v1.BacklogService.ListBacklogItems:input_type -> session.v1.ListBacklogItemsRequest
+	13, // 67: session.v1.BacklogService.UpdateBacklogItem:input_type -> session.v1.UpdateBacklogItemRequest
+	15, // 68: session.v1.BacklogService.ArchiveBacklogItem:input_type -> session.v1.ArchiveBacklogItemRequest
+	17, // 69: session.v1.BacklogService.TransitionBacklogItemStatus:input_type -> session.v1.TransitionBacklogItemStatusRequest
+	19, // 70: session.v1.BacklogService.SpawnSessionFromItem:input_type -> session.v1.SpawnSessionFromItemRequest
+	21, // 71: session.v1.BacklogService.AttachSessionToItem:input_type -> session.v1.AttachSessionToItemRequest
+	23, // 72: session.v1.BacklogService.TriggerTriage:input_type -> session.v1.TriggerTriageRequest
+	25, // 73: session.v1.BacklogService.ApprovePlan:input_type -> session.v1.ApprovePlanRequest
+	27, // 74: session.v1.BacklogService.SuggestNextItem:input_type -> session.v1.SuggestNextItemRequest
+	29, // 75: session.v1.BacklogService.OverrideVerdict:input_type -> session.v1.OverrideVerdictRequest
+	31, // 76: session.v1.BacklogService.TriggerReReview:input_type -> session.v1.TriggerReReviewRequest
+	33, // 77: session.v1.BacklogService.TriggerSync:input_type -> session.v1.TriggerSyncRequest
+	35, // 78: session.v1.BacklogService.CreateItemSource:input_type -> session.v1.CreateItemSourceRequest
+	37, // 79: session.v1.BacklogService.ListItemSources:input_type -> session.v1.ListItemSourcesRequest
+	39, // 80: session.v1.BacklogService.UpdateItemSource:input_type -> session.v1.UpdateItemSourceRequest
+	41, // 81: session.v1.BacklogService.DeleteItemSource:input_type -> session.v1.DeleteItemSourceRequest
+	43, // 82: session.v1.BacklogService.GetSyncHistory:input_type -> session.v1.GetSyncHistoryRequest
+	47, // 83: session.v1.BacklogService.AddBacklogDependency:input_type -> session.v1.AddBacklogDependencyRequest
+	49, // 84: session.v1.BacklogService.RemoveBacklogDependency:input_type -> session.v1.RemoveBacklogDependencyRequest
+	51, // 85: session.v1.BacklogService.GetBacklogGraph:input_type -> session.v1.GetBacklogGraphRequest
+	57, // 86: session.v1.BacklogService.ListReviewPanels:input_type -> session.v1.ListReviewPanelsRequest
+	60, // 87: session.v1.BacklogService.GetReviewRubricTrend:input_type -> session.v1.GetReviewRubricTrendRequest
+	8,  // 88: session.v1.BacklogService.CreateBacklogItem:output_type -> session.v1.CreateBacklogItemResponse
+	10, // 89: session.v1.BacklogService.GetBacklogItem:output_type -> session.v1.GetBacklogItemResponse
+	12, // 90: session.v1.BacklogService.ListBacklogItems:output_type -> session.v1.ListBacklogItemsResponse
+	14, // 91: session.v1.BacklogService.UpdateBacklogItem:output_type -> session.v1.UpdateBacklogItemResponse
+	16, // 92: session.v1.BacklogService.ArchiveBacklogItem:output_type -> session.v1.ArchiveBacklogItemResponse
+	18, // 93: session.v1.BacklogService.TransitionBacklogItemStatus:output_type -> session.v1.TransitionBacklogItemStatusResponse
+	20, // 94: session.v1.BacklogService.SpawnSessionFromItem:output_type -> session.v1.SpawnSessionFromItemResponse
+	22, // 95: session.v1.BacklogService.AttachSessionToItem:output_type -> session.v1.AttachSessionToItemResponse
+	24, // 96: session.v1.BacklogService.TriggerTriage:output_type -> session.v1.TriggerTriageResponse
+	26, // 97: session.v1.BacklogService.ApprovePlan:output_type -> session.v1.ApprovePlanResponse
+	28, // 98: session.v1.BacklogService.SuggestNextItem:output_type -> session.v1.SuggestNextItemResponse
+	30, // 99: session.v1.BacklogService.OverrideVerdict:output_type -> session.v1.OverrideVerdictResponse
+	32, // 100: session.v1.BacklogService.TriggerReReview:output_type -> session.v1.TriggerReReviewResponse
+	34, // 101: session.v1.BacklogService.TriggerSync:output_type -> session.v1.TriggerSyncResponse
+	36, // 102: session.v1.BacklogService.CreateItemSource:output_type -> session.v1.CreateItemSourceResponse
+	38, // 103: session.v1.BacklogService.ListItemSources:output_type -> session.v1.ListItemSourcesResponse
+	40, // 104: session.v1.BacklogService.UpdateItemSource:output_type -> session.v1.UpdateItemSourceResponse
+	42, // 105: session.v1.BacklogService.DeleteItemSource:output_type -> session.v1.DeleteItemSourceResponse
+	44, // 106: session.v1.BacklogService.GetSyncHistory:output_type -> session.v1.GetSyncHistoryResponse
+	48, // 107: session.v1.BacklogService.AddBacklogDependency:output_type -> session.v1.AddBacklogDependencyResponse
+	50, // 108: session.v1.BacklogService.RemoveBacklogDependency:output_type -> session.v1.RemoveBacklogDependencyResponse
+	52, // 109: session.v1.BacklogService.GetBacklogGraph:output_type -> session.v1.GetBacklogGraphResponse
+	58, // 110: session.v1.BacklogService.ListReviewPanels:output_type -> session.v1.ListReviewPanelsResponse
+	61, // 111: session.v1.BacklogService.GetReviewRubricTrend:output_type -> session.v1.GetReviewRubricTrendResponse
+	88, // [88:112] is the sub-list for method output_type
+	64, // [64:88] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_session_v1_backlog_proto_init() }
@@ -3094,7 +4470,7 @@ func file_session_v1_backlog_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_session_v1_backlog_proto_rawDesc), len(file_session_v1_backlog_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   45,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -598,6 +598,12 @@ type TerminalData struct {
 	//	*TerminalData_InputEcho
 	//	*TerminalData_SspNegotiation
 	//	*TerminalData_ResizeQuiescence
+	//	*TerminalData_InputControl
+	//	*TerminalData_InputControlState
+	//	*TerminalData_PresenceUpdate
+	//	*TerminalData_FrameStreamStart
+	//	*TerminalData_FrameDictionary
+	//	*TerminalData_Frame
 	Data          isTerminalData_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -782,6 +788,60 @@ func (x *TerminalData) GetResizeQuiescence() *ResizeQuiescence {
 	return nil
 }
 
+func (x *TerminalData) GetInputControl() *InputControl {
+	if x != nil {
+		if x, ok := x.Data.(*TerminalData_InputControl); ok {
+			return x.InputControl
+		}
+	}
+	return nil
+}
+
+func (x *TerminalData) GetInputControlState() *InputControlState {
+	if x != nil {
+		if x, ok := x.Data.(*TerminalData_InputControlState); ok {
+			return x.InputControlState
+		}
+	}
+	return nil
+}
+
+func (x *TerminalData) GetPresenceUpdate() *PresenceUpdate {
+	if x != nil {
+		if x, ok := x.Data.(*TerminalData_PresenceUpdate); ok {
+			return x.PresenceUpdate
+		}
+	}
+	return nil
+}
+
+func (x *TerminalData) GetFrameStreamStart() *FrameStreamStart {
+	if x != nil {
+		if x, ok := x.Data.(*TerminalData_FrameStreamStart); ok {
+			return x.FrameStreamStart
+		}
+	}
+	return nil
+}
+
+func (x *TerminalData) GetFrameDictionary() *FrameDictionary {
+	if x != nil {
+		if x, ok := x.Data.(*TerminalData_FrameDictionary); ok {
+			return x.FrameDictionary
+		}
+	}
+	return nil
+}
+
+func (x *TerminalData) GetFrame() *TerminalFrame {
+	if x != nil {
+		if x, ok := x.Data.(*TerminalData_Frame); ok {
+			return x.Frame
+		}
+	}
+	return nil
+}
+
 type isTerminalData_Data interface {
 	isTerminalData_Data()
 }
@@ -848,6 +908,33 @@ type TerminalData_ResizeQuiescence struct {
 	ResizeQuiescence *ResizeQuiescence `protobuf:"bytes,16,opt,name=resize_quiescence,json=resizeQuiescence,proto3,oneof"`
 }
 
+type TerminalData_InputControl struct {
+	// Input arbitration between clients sharing a terminal
+	InputControl *InputControl `protobuf:"bytes,17,opt,name=input_control,json=inputControl,proto3,oneof"` // Control request (client → server)
+}
+
+type TerminalData_InputControlState struct {
+	InputControlState *InputControlState `protobuf:"bytes,18,opt,name=input_control_state,json=inputControlState,proto3,oneof"` // Who may type and who is watching (server → client)
+}
+
+type TerminalData_PresenceUpdate struct {
+	PresenceUpdate *PresenceUpdate `protobuf:"bytes,19,opt,name=presence_update,json=presenceUpdate,proto3,oneof"` // Client focus/cursor report (client → server)
+}
+
+type TerminalData_FrameStreamStart struct {
+	// "frames" streaming mode: sequenced, zstd-compressed output that a
+	// reconnecting client can resume without a full redraw
+	FrameStreamStart *FrameStreamStart `protobuf:"bytes,20,opt,name=frame_stream_start,json=frameStreamStart,proto3,oneof"` // Resume outcome, sent first (server → client)
+}
+
+type TerminalData_FrameDictionary struct {
+	FrameDictionary *FrameDictionary `protobuf:"bytes,21,opt,name=frame_dictionary,json=frameDictionary,proto3,oneof"` // Compression dictionary for later frames (server → client)
+}
+
+type TerminalData_Frame struct {
+	Frame *TerminalFrame `protobuf:"bytes,22,opt,name=frame,proto3,oneof"` // Sequenced output (server → client)
+}
+
 func (*TerminalData_Output) isTerminalData_Data() {}
 
 func (*TerminalData_Input) isTerminalData_Data() {}
@@ -878,6 +965,18 @@ func (*TerminalData_SspNegotiation) isTerminalData_Data() {}
 
 func (*TerminalData_ResizeQuiescence) isTerminalData_Data() {}
 
+func (*TerminalData_InputControl) isTerminalData_Data() {}
+
+func (*TerminalData_InputControlState) isTerminalData_Data() {}
+
+func (*TerminalData_PresenceUpdate) isTerminalData_Data() {}
+
+func (*TerminalData_FrameStreamStart) isTerminalData_Data() {}
+
+func (*TerminalData_FrameDictionary) isTerminalData_Data() {}
+
+func (*TerminalData_Frame) isTerminalData_Data() {}
+
 // ResizeQuiescence signals the client that the server is waiting for tmux to
 // finish reflowing after a resize (resizing=true) or that the stable post-resize
 // snapshot has been sent (resizing=false). Enables the frontend to show/hide a
@@ -1417,11 +1516,14 @@ type CurrentPaneRequest struct {
 	TargetRows *int32 `protobuf:"varint,4,opt,name=target_rows,json=targetRows,proto3,oneof" json:"target_rows,omitempty"` // Target rows (height)
 	// Streaming mode for terminal output (optional)
 	// Options: "raw" (direct PTY bytes), "raw-compressed" (PTY bytes with LZMA),
-	//
-	//	"state" (MOSH-style state sync), "hybrid" (both raw and state)
-	//
+	// "state" (MOSH-style state sync), "hybrid" (both raw and state),
+	// "frames" (sequenced zstd frames, resumable; see TerminalFrame)
 	// Default: "raw" if not specified
 	StreamingMode *string `protobuf:"bytes,5,opt,name=streaming_mode,json=streamingMode,proto3,oneof" json:"streaming_mode,omitempty"`
+	// Where a "frames" client left off on its previous connection. When the
+	// server still holds everything after it, the stream resumes there with no
+	// redraw; otherwise the client is resynced with a snapshot.
+	ResumeCursor  *FrameCursor `protobuf:"bytes,6,opt,name=resume_cursor,json=resumeCursor,proto3,oneof" json:"resume_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1491,6 +1593,13 @@ func (x *CurrentPaneRequest) GetStreamingMode() string {
 	return ""
 }
 
+func (x *CurrentPaneRequest) GetResumeCursor() *FrameCursor {
+	if x != nil {
+		return x.ResumeCursor
+	}
+	return nil
+}
+
 // CurrentPaneResponse contains the current visible tmux pane content
 type CurrentPaneResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3617,6 +3726,650 @@ func (x *NotificationEvent) GetNotificationId() string {
 	return ""
 }
 
+// InputControl asks the server to change who may type into a shared terminal.
+// The server answers with an InputControlState (with a notice when refused).
+type InputControl struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Action: "set_mode", "take", "request", "grant", "deny" or "release".
+	// Only the session owner's clients may "set_mode" or "take" (control at once).
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// New input mode for "set_mode": "shared" (everyone types), "single_writer"
+	// (one writer, request/grant handoff) or "driver" (driver + observers)
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Client the action applies to, for "grant" (empty = oldest request) and "deny"
+	TargetClientId string `protobuf:"bytes,3,opt,name=target_client_id,json=targetClientId,proto3" json:"target_client_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InputControl) Reset() {
+	*x = InputControl{}
+	mi := &file_session_v1_events_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputControl) ProtoMessage() {}
+
+func (x *InputControl) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputControl.ProtoReflect.Descriptor instead.
+func (*InputControl) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InputControl) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *InputControl) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *InputControl) GetTargetClientId() string {
+	if x != nil {
+		return x.TargetClientId
+	}
+	return ""
+}
+
+// InputControlState tells a client who may type into the terminal and who is
+// attached to it. Sent on connect, whenever it changes, and when input or a
+// control request from this client is refused.
+type InputControlState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current input mode: "shared", "single_writer" or "driver"
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Client in control (single writer or driver); empty in shared mode or when nobody is
+	WriterClientId string `protobuf:"bytes,2,opt,name=writer_client_id,json=writerClientId,proto3" json:"writer_client_id,omitempty"`
+	// The receiving client's own ID
+	YourClientId string `protobuf:"bytes,3,opt,name=your_client_id,json=yourClientId,proto3" json:"your_client_id,omitempty"`
+	// Whether the receiving client may type right now
+	CanWrite bool `protobuf:"varint,4,opt,name=can_write,json=canWrite,proto3" json:"can_write,omitempty"`
+	// Clients waiting for control, oldest request first
+	PendingClientIds []string `protobuf:"bytes,5,rep,name=pending_client_ids,json=pendingClientIds,proto3" json:"pending_client_ids,omitempty"`
+	// Everyone attached to the terminal, longest connected first
+	Clients []*ClientPresence `protobuf:"bytes,6,rep,name=clients,proto3" json:"clients,omitempty"`
+	// Why the client's last input or request was refused, if it was
+	Notice        string `protobuf:"bytes,7,opt,name=notice,proto3" json:"notice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputControlState) Reset() {
+	*x = InputControlState{}
+	mi := &file_session_v1_events_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputControlState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputControlState) ProtoMessage() {}
+
+func (x *InputControlState) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputControlState.ProtoReflect.Descriptor instead.
+func (*InputControlState) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *InputControlState) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *InputControlState) GetWriterClientId() string {
+	if x != nil {
+		return x.WriterClientId
+	}
+	return ""
+}
+
+func (x *InputControlState) GetYourClientId() string {
+	if x != nil {
+		return x.YourClientId
+	}
+	return ""
+}
+
+func (x *InputControlState) GetCanWrite() bool {
+	if x != nil {
+		return x.CanWrite
+	}
+	return false
+}
+
+func (x *InputControlState) GetPendingClientIds() []string {
+	if x != nil {
+		return x.PendingClientIds
+	}
+	return nil
+}
+
+func (x *InputControlState) GetClients() []*ClientPresence {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+func (x *InputControlState) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+// ClientPresence describes one client attached to a terminal.
+type ClientPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                        // e.g. "IntelliJ", or the client's address
+	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"` // never allowed to type (e.g. view-only share links)
+	CanWrite      bool                   `protobuf:"varint,4,opt,name=can_write,json=canWrite,proto3" json:"can_write,omitempty"`
+	Requesting    bool                   `protobuf:"varint,5,opt,name=requesting,proto3" json:"requesting,omitempty"`                // has a pending control request
+	Focused       bool                   `protobuf:"varint,6,opt,name=focused,proto3" json:"focused,omitempty"`                      // client-reported: terminal has focus
+	CursorRow     int32                  `protobuf:"varint,7,opt,name=cursor_row,json=cursorRow,proto3" json:"cursor_row,omitempty"` // client-reported pointer position in the terminal
+	CursorCol     int32                  `protobuf:"varint,8,opt,name=cursor_col,json=cursorCol,proto3" json:"cursor_col,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	LastInputAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_input_at,json=lastInputAt,proto3,oneof" json:"last_input_at,omitempty"`
+	Owner         bool                   `protobuf:"varint,11,opt,name=owner,proto3" json:"owner,omitempty"` // belongs to the session owner, not a share link
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientPresence) Reset() {
+	*x = ClientPresence{}
+	mi := &file_session_v1_events_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientPresence) ProtoMessage() {}
+
+func (x *ClientPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientPresence.ProtoReflect.Descriptor instead.
+func (*ClientPresence) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ClientPresence) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientPresence) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ClientPresence) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ClientPresence) GetCanWrite() bool {
+	if x != nil {
+		return x.CanWrite
+	}
+	return false
+}
+
+func (x *ClientPresence) GetRequesting() bool {
+	if x != nil {
+		return x.Requesting
+	}
+	return false
+}
+
+func (x *ClientPresence) GetFocused() bool {
+	if x != nil {
+		return x.Focused
+	}
+	return false
+}
+
+func (x *ClientPresence) GetCursorRow() int32 {
+	if x != nil {
+		return x.CursorRow
+	}
+	return 0
+}
+
+func (x *ClientPresence) GetCursorCol() int32 {
+	if x != nil {
+		return x.CursorCol
+	}
+	return 0
+}
+
+func (x *ClientPresence) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *ClientPresence) GetLastInputAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastInputAt
+	}
+	return nil
+}
+
+func (x *ClientPresence) GetOwner() bool {
+	if x != nil {
+		return x.Owner
+	}
+	return false
+}
+
+// PresenceUpdate reports a client's presence details to the other clients.
+type PresenceUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Display label; empty keeps the current one
+	Label         string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Focused       bool   `protobuf:"varint,2,opt,name=focused,proto3" json:"focused,omitempty"`
+	CursorRow     int32  `protobuf:"varint,3,opt,name=cursor_row,json=cursorRow,proto3" json:"cursor_row,omitempty"`
+	CursorCol     int32  `protobuf:"varint,4,opt,name=cursor_col,json=cursorCol,proto3" json:"cursor_col,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceUpdate) Reset() {
+	*x = PresenceUpdate{}
+	mi := &file_session_v1_events_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceUpdate) ProtoMessage() {}
+
+func (x *PresenceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceUpdate.ProtoReflect.Descriptor instead.
+func (*PresenceUpdate) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PresenceUpdate) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PresenceUpdate) GetFocused() bool {
+	if x != nil {
+		return x.Focused
+	}
+	return false
+}
+
+func (x *PresenceUpdate) GetCursorRow() int32 {
+	if x != nil {
+		return x.CursorRow
+	}
+	return 0
+}
+
+func (x *PresenceUpdate) GetCursorCol() int32 {
+	if x != nil {
+		return x.CursorCol
+	}
+	return 0
+}
+
+// FrameCursor identifies the last frame a client applied.
+type FrameCursor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// stream_id from the FrameStreamStart of the stream the frame came from
+	StreamId string `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Sequence of the last frame applied
+	Sequence      uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameCursor) Reset() {
+	*x = FrameCursor{}
+	mi := &file_session_v1_events_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameCursor) ProtoMessage() {}
+
+func (x *FrameCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameCursor.ProtoReflect.Descriptor instead.
+func (*FrameCursor) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FrameCursor) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+func (x *FrameCursor) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// FrameStreamStart opens a "frames" stream and tells the client whether its
+// resume cursor was honoured.
+type FrameStreamStart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies this session's frame sequence. It changes whenever the server
+	// may have missed output (restart, output capture interrupted), so cursors
+	// from an older stream are never resumed.
+	StreamId string `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// True: frames continue right after the client's cursor and the client
+	// keeps its screen. False: the client must reset its terminal; a snapshot
+	// frame follows.
+	Resumed bool `protobuf:"varint,2,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	// Sequence the stream continues from; the next frame is sequence + 1
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Why the resume cursor could not be honoured (empty when resumed or when
+	// no cursor was sent), e.g. "evicted", "stream_changed"
+	ResyncReason  string `protobuf:"bytes,4,opt,name=resync_reason,json=resyncReason,proto3" json:"resync_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameStreamStart) Reset() {
+	*x = FrameStreamStart{}
+	mi := &file_session_v1_events_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameStreamStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameStreamStart) ProtoMessage() {}
+
+func (x *FrameStreamStart) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameStreamStart.ProtoReflect.Descriptor instead.
+func (*FrameStreamStart) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FrameStreamStart) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+func (x *FrameStreamStart) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+func (x *FrameStreamStart) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *FrameStreamStart) GetResyncReason() string {
+	if x != nil {
+		return x.ResyncReason
+	}
+	return ""
+}
+
+// FrameDictionary carries a zstd dictionary trained on the session's output.
+// Frames naming its id can only be decoded with it.
+type FrameDictionary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameDictionary) Reset() {
+	*x = FrameDictionary{}
+	mi := &file_session_v1_events_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameDictionary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameDictionary) ProtoMessage() {}
+
+func (x *FrameDictionary) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameDictionary.ProtoReflect.Descriptor instead.
+func (*FrameDictionary) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FrameDictionary) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FrameDictionary) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// TerminalFrame carries one chunk of terminal output in "frames" mode.
+type TerminalFrame struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scrollback sequence of this output. For snapshots, the sequence the
+	// snapshot is current as of.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Output bytes, zstd-compressed when compressed is set
+	Data       []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Compressed bool   `protobuf:"varint,3,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	// Dictionary the data was compressed with (0 = none)
+	DictionaryId uint32 `protobuf:"varint,4,opt,name=dictionary_id,json=dictionaryId,proto3" json:"dictionary_id,omitempty"`
+	// Uncompressed length of data
+	RawSize uint32 `protobuf:"varint,5,opt,name=raw_size,json=rawSize,proto3" json:"raw_size,omitempty"`
+	// True for a full-screen snapshot sent on resync rather than recorded
+	// output; it replaces the client's screen
+	Snapshot      bool `protobuf:"varint,6,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalFrame) Reset() {
+	*x = TerminalFrame{}
+	mi := &file_session_v1_events_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalFrame) ProtoMessage() {}
+
+func (x *TerminalFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_events_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalFrame.ProtoReflect.Descriptor instead.
+func (*TerminalFrame) Descriptor() ([]byte, []int) {
+	return file_session_v1_events_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TerminalFrame) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TerminalFrame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TerminalFrame) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+func (x *TerminalFrame) GetDictionaryId() uint32 {
+	if x != nil {
+		return x.DictionaryId
+	}
+	return 0
+}
+
+func (x *TerminalFrame) GetRawSize() uint32 {
+	if x != nil {
+		return x.RawSize
+	}
+	return 0
+}
+
+func (x *TerminalFrame) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
 var File_session_v1_events_proto protoreflect.FileDescriptor
 
 const file_session_v1_events_proto_rawDesc = "" +
@@ -3656,7 +4409,7 @@ const file_session_v1_events_proto_rawDesc = "" +
 	"\x10detected_context\x18\x05 \x01(\tH\x01R\x0fdetectedContext\x88\x01\x01\x12=\n" +
 	"\rworking_state\x18\x06 \x01(\x0e2\x18.session.v1.WorkingStateR\fworkingStateB\x12\n" +
 	"\x10_detected_statusB\x13\n" +
-	"\x11_detected_context\"\xf9\a\n" +
+	"\x11_detected_context\"\x9d\v\n" +
 	"\fTerminalData\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x124\n" +
@@ -3676,7 +4429,13 @@ const file_session_v1_events_proto_rawDesc = "" +
 	"\n" +
 	"input_echo\x18\x0e \x01(\v2\x19.session.v1.InputWithEchoH\x00R\tinputEcho\x12E\n" +
 	"\x0fssp_negotiation\x18\x0f \x01(\v2\x1a.session.v1.SSPNegotiationH\x00R\x0esspNegotiation\x12K\n" +
-	"\x11resize_quiescence\x18\x10 \x01(\v2\x1c.session.v1.ResizeQuiescenceH\x00R\x10resizeQuiescenceB\x06\n" +
+	"\x11resize_quiescence\x18\x10 \x01(\v2\x1c.session.v1.ResizeQuiescenceH\x00R\x10resizeQuiescence\x12?\n" +
+	"\rinput_control\x18\x11 \x01(\v2\x18.session.v1.InputControlH\x00R\finputControl\x12O\n" +
+	"\x13input_control_state\x18\x12 \x01(\v2\x1d.session.v1.InputControlStateH\x00R\x11inputControlState\x12E\n" +
+	"\x0fpresence_update\x18\x13 \x01(\v2\x1a.session.v1.PresenceUpdateH\x00R\x0epresenceUpdate\x12L\n" +
+	"\x12frame_stream_start\x18\x14 \x01(\v2\x1c.session.v1.FrameStreamStartH\x00R\x10frameStreamStart\x12H\n" +
+	"\x10frame_dictionary\x18\x15 \x01(\v2\x1b.session.v1.FrameDictionaryH\x00R\x0fframeDictionary\x121\n" +
+	"\x05frame\x18\x16 \x01(\v2\x19.session.v1.TerminalFrameH\x00R\x05frameB\x06\n" +
 	"\x04data\"V\n" +
 	"\x10ResizeQuiescence\x12\x1a\n" +
 	"\bresizing\x18\x01 \x01(\bR\bresizing\x12\x12\n" +
@@ -3710,7 +4469,7 @@ const file_session_v1_events_proto_rawDesc = "" +
 	"\x0fScrollbackChunk\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
 	"\bsequence\x18\x02 \x01(\x04R\bsequence\x12!\n" +
-	"\ftimestamp_ms\x18\x03 \x01(\x03R\vtimestampMs\"\xfe\x01\n" +
+	"\ftimestamp_ms\x18\x03 \x01(\x03R\vtimestampMs\"\xd3\x02\n" +
 	"\x12CurrentPaneRequest\x12\x14\n" +
 	"\x05lines\x18\x01 \x01(\x05R\x05lines\x12'\n" +
 	"\x0finclude_escapes\x18\x02 \x01(\bR\x0eincludeEscapes\x12$\n" +
@@ -3718,10 +4477,12 @@ const file_session_v1_events_proto_rawDesc = "" +
 	"targetCols\x88\x01\x01\x12$\n" +
 	"\vtarget_rows\x18\x04 \x01(\x05H\x01R\n" +
 	"targetRows\x88\x01\x01\x12*\n" +
-	"\x0estreaming_mode\x18\x05 \x01(\tH\x02R\rstreamingMode\x88\x01\x01B\x0e\n" +
+	"\x0estreaming_mode\x18\x05 \x01(\tH\x02R\rstreamingMode\x88\x01\x01\x12A\n" +
+	"\rresume_cursor\x18\x06 \x01(\v2\x17.session.v1.FrameCursorH\x03R\fresumeCursor\x88\x01\x01B\x0e\n" +
 	"\f_target_colsB\x0e\n" +
 	"\f_target_rowsB\x11\n" +
-	"\x0f_streaming_mode\"\xa5\x01\n" +
+	"\x0f_streaming_modeB\x10\n" +
+	"\x0e_resume_cursor\"\xa5\x01\n" +
 	"\x13CurrentPaneResponse\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\fR\acontent\x12\x19\n" +
 	"\bcursor_x\x18\x02 \x01(\x05R\acursorX\x12\x19\n" +
@@ -3942,7 +4703,64 @@ const file_session_v1_events_proto_rawDesc = "" +
 	"\x0fnotification_id\x18\t \x01(\tR\x0enotificationId\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\xab\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
+	"\fInputControl\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12(\n" +
+	"\x10target_client_id\x18\x03 \x01(\tR\x0etargetClientId\"\x90\x02\n" +
+	"\x11InputControlState\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12(\n" +
+	"\x10writer_client_id\x18\x02 \x01(\tR\x0ewriterClientId\x12$\n" +
+	"\x0eyour_client_id\x18\x03 \x01(\tR\fyourClientId\x12\x1b\n" +
+	"\tcan_write\x18\x04 \x01(\bR\bcanWrite\x12,\n" +
+	"\x12pending_client_ids\x18\x05 \x03(\tR\x10pendingClientIds\x124\n" +
+	"\aclients\x18\x06 \x03(\v2\x1a.session.v1.ClientPresenceR\aclients\x12\x16\n" +
+	"\x06notice\x18\a \x01(\tR\x06notice\"\x9b\x03\n" +
+	"\x0eClientPresence\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1b\n" +
+	"\tread_only\x18\x03 \x01(\bR\breadOnly\x12\x1b\n" +
+	"\tcan_write\x18\x04 \x01(\bR\bcanWrite\x12\x1e\n" +
+	"\n" +
+	"requesting\x18\x05 \x01(\bR\n" +
+	"requesting\x12\x18\n" +
+	"\afocused\x18\x06 \x01(\bR\afocused\x12\x1d\n" +
+	"\n" +
+	"cursor_row\x18\a \x01(\x05R\tcursorRow\x12\x1d\n" +
+	"\n" +
+	"cursor_col\x18\b \x01(\x05R\tcursorCol\x127\n" +
+	"\tjoined_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12C\n" +
+	"\rlast_input_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vlastInputAt\x88\x01\x01\x12\x14\n" +
+	"\x05owner\x18\v \x01(\bR\x05ownerB\x10\n" +
+	"\x0e_last_input_at\"~\n" +
+	"\x0ePresenceUpdate\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x18\n" +
+	"\afocused\x18\x02 \x01(\bR\afocused\x12\x1d\n" +
+	"\n" +
+	"cursor_row\x18\x03 \x01(\x05R\tcursorRow\x12\x1d\n" +
+	"\n" +
+	"cursor_col\x18\x04 \x01(\x05R\tcursorCol\"F\n" +
+	"\vFrameCursor\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x04R\bsequence\"\x8a\x01\n" +
+	"\x10FrameStreamStart\x12\x1b\n" +
+	"\tstream_id\x18\x01 \x01(\tR\bstreamId\x12\x18\n" +
+	"\aresumed\x18\x02 \x01(\bR\aresumed\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12#\n" +
+	"\rresync_reason\x18\x04 \x01(\tR\fresyncReason\"5\n" +
+	"\x0fFrameDictionary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\xbb\x01\n" +
+	"\rTerminalFrame\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1e\n" +
+	"\n" +
+	"compressed\x18\x03 \x01(\bR\n" +
+	"compressed\x12#\n" +
+	"\rdictionary_id\x18\x04 \x01(\rR\fdictionaryId\x12\x19\n" +
+	"\braw_size\x18\x05 \x01(\rR\arawSize\x12\x1a\n" +
+	"\bsnapshot\x18\x06 \x01(\bR\bsnapshotB\xab\x01\n" +
 	"\x0ecom.session.v1B\vEventsProtoP\x01ZCgithub.com/tstapler/stapler-squad/gen/proto/go/session/v1;sessionv1\xa2\x02\x03SXX\xaa\x02\n" +
 	"Session.V1\xca\x02\n" +
 	"Session\\V1\xe2\x02\x16Session\\V1\\GPBMetadata\xea\x02\vSession::V1b\x06proto3"
@@ -3960,7 +4778,7 @@ func file_session_v1_events_proto_rawDescGZIP() []byte {
 }
 
 var file_session_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_session_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_session_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_session_v1_events_proto_goTypes = []any{
 	(UserInteractionEvent_InteractionType)(0), // 0: session.v1.UserInteractionEvent.InteractionType
 	(*SessionEvent)(nil),                      // 1: session.v1.SessionEvent
@@ -4006,19 +4824,27 @@ var file_session_v1_events_proto_goTypes = []any{
 	(*ReviewQueueItemUpdatedEvent)(nil),       // 41: session.v1.ReviewQueueItemUpdatedEvent
 	(*ReviewQueueStatisticsEvent)(nil),        // 42: session.v1.ReviewQueueStatisticsEvent
 	(*NotificationEvent)(nil),                 // 43: session.v1.NotificationEvent
-	nil,                                       // 44: session.v1.ReviewQueueStatisticsEvent.ByPriorityEntry
-	nil,                                       // 45: session.v1.ReviewQueueStatisticsEvent.ByReasonEntry
-	nil,                                       // 46: session.v1.NotificationEvent.MetadataEntry
-	(*timestamppb.Timestamp)(nil),             // 47: google.protobuf.Timestamp
-	(*Session)(nil),                           // 48: session.v1.Session
-	(SessionStatus)(0),                        // 49: session.v1.SessionStatus
-	(WorkingState)(0),                         // 50: session.v1.WorkingState
-	(*ReviewItem)(nil),                        // 51: session.v1.ReviewItem
-	(NotificationType)(0),                     // 52: session.v1.NotificationType
-	(NotificationPriority)(0),                 // 53: session.v1.NotificationPriority
+	(*InputControl)(nil),                      // 44: session.v1.InputControl
+	(*InputControlState)(nil),                 // 45: session.v1.InputControlState
+	(*ClientPresence)(nil),                    // 46: session.v1.ClientPresence
+	(*PresenceUpdate)(nil),                    // 47: session.v1.PresenceUpdate
+	(*FrameCursor)(nil),                       // 48: session.v1.FrameCursor
+	(*FrameStreamStart)(nil),                  // 49: session.v1.FrameStreamStart
+	(*FrameDictionary)(nil),                   // 50: session.v1.FrameDictionary
+	(*TerminalFrame)(nil),                     // 51: session.v1.TerminalFrame
+	nil,                                       // 52: session.v1.ReviewQueueStatisticsEvent.ByPriorityEntry
+	nil,                                       // 53: session.v1.ReviewQueueStatisticsEvent.ByReasonEntry
+	nil,                                       // 54: session.v1.NotificationEvent.MetadataEntry
+	(*timestamppb.Timestamp)(nil),             // 55: google.protobuf.Timestamp
+	(*Session)(nil),                           // 56: session.v1.Session
+	(SessionStatus)(0),                        // 57: session.v1.SessionStatus
+	(WorkingState)(0),                         // 58: session.v1.WorkingState
+	(*ReviewItem)(nil),                        // 59: session.v1.ReviewItem
+	(NotificationType)(0),                     // 60: session.v1.NotificationType
+	(NotificationPriority)(0),                 // 61: session.v1.NotificationPriority
 }
 var file_session_v1_events_proto_depIdxs = []int32{
-	47, // 0: session.v1.SessionEvent.timestamp:type_name -> google.protobuf.Timestamp
+	55, // 0: session.v1.SessionEvent.timestamp:type_name -> google.protobuf.Timestamp
 	2,  // 1: session.v1.SessionEvent.session_created:type_name -> session.v1.SessionCreatedEvent
 	3,  // 2: session.v1.SessionEvent.session_updated:type_name -> session.v1.SessionUpdatedEvent
 	4,  // 3: session.v1.SessionEvent.session_deleted:type_name -> session.v1.SessionDeletedEvent
@@ -4027,11 +4853,11 @@ var file_session_v1_events_proto_depIdxs = []int32{
 	36, // 6: session.v1.SessionEvent.session_acknowledged:type_name -> session.v1.SessionAcknowledgedEvent
 	37, // 7: session.v1.SessionEvent.approval_response:type_name -> session.v1.ApprovalResponseEvent
 	43, // 8: session.v1.SessionEvent.notification:type_name -> session.v1.NotificationEvent
-	48, // 9: session.v1.SessionCreatedEvent.session:type_name -> session.v1.Session
-	48, // 10: session.v1.SessionUpdatedEvent.session:type_name -> session.v1.Session
-	49, // 11: session.v1.SessionStatusChangedEvent.old_status:type_name -> session.v1.SessionStatus
-	49, // 12: session.v1.SessionStatusChangedEvent.new_status:type_name -> session.v1.SessionStatus
-	50, // 13: session.v1.SessionStatusChangedEvent.working_state:type_name -> session.v1.WorkingState
+	56, // 9: session.v1.SessionCreatedEvent.session:type_name -> session.v1.Session
+	56, // 10: session.v1.SessionUpdatedEvent.session:type_name -> session.v1.Session
+	57, // 11: session.v1.SessionStatusChangedEvent.old_status:type_name -> session.v1.SessionStatus
+	57, // 12: session.v1.SessionStatusChangedEvent.new_status:type_name -> session.v1.SessionStatus
+	58, // 13: session.v1.SessionStatusChangedEvent.working_state:type_name -> session.v1.WorkingState
 	8,  // 14: session.v1.TerminalData.output:type_name -> session.v1.TerminalOutput
 	9,  // 15: session.v1.TerminalData.input:type_name -> session.v1.TerminalInput
 	10, // 16: session.v1.TerminalData.resize:type_name -> session.v1.TerminalResize
@@ -4047,44 +4873,54 @@ var file_session_v1_events_proto_depIdxs = []int32{
 	26, // 26: session.v1.TerminalData.input_echo:type_name -> session.v1.InputWithEcho
 	28, // 27: session.v1.TerminalData.ssp_negotiation:type_name -> session.v1.SSPNegotiation
 	7,  // 28: session.v1.TerminalData.resize_quiescence:type_name -> session.v1.ResizeQuiescence
-	15, // 29: session.v1.ScrollbackResponse.chunks:type_name -> session.v1.ScrollbackChunk
-	19, // 30: session.v1.TerminalDelta.lines:type_name -> session.v1.LineDelta
-	22, // 31: session.v1.TerminalDelta.cursor:type_name -> session.v1.CursorPosition
-	23, // 32: session.v1.TerminalDelta.dimensions:type_name -> session.v1.TerminalDimensions
-	20, // 33: session.v1.LineDelta.edit:type_name -> session.v1.LineEdit
-	21, // 34: session.v1.LineDelta.insert:type_name -> session.v1.InsertLine
-	25, // 35: session.v1.TerminalDiff.echo_ack:type_name -> session.v1.EchoAck
-	33, // 36: session.v1.TerminalDiff.compression:type_name -> session.v1.CompressionMetadata
-	27, // 37: session.v1.SSPNegotiation.capabilities:type_name -> session.v1.SSPCapabilities
-	27, // 38: session.v1.SSPNegotiation.negotiated:type_name -> session.v1.SSPCapabilities
-	23, // 39: session.v1.TerminalState.dimensions:type_name -> session.v1.TerminalDimensions
-	30, // 40: session.v1.TerminalState.lines:type_name -> session.v1.TerminalLine
-	22, // 41: session.v1.TerminalState.cursor:type_name -> session.v1.CursorPosition
-	32, // 42: session.v1.TerminalState.scrollback:type_name -> session.v1.ScrollbackInfo
-	33, // 43: session.v1.TerminalState.compression:type_name -> session.v1.CompressionMetadata
-	31, // 44: session.v1.TerminalLine.attributes:type_name -> session.v1.LineAttributes
-	34, // 45: session.v1.CompressionMetadata.dictionary:type_name -> session.v1.DictionaryMetadata
-	0,  // 46: session.v1.UserInteractionEvent.type:type_name -> session.v1.UserInteractionEvent.InteractionType
-	47, // 47: session.v1.SessionAcknowledgedEvent.acknowledged_at:type_name -> google.protobuf.Timestamp
-	47, // 48: session.v1.ApprovalResponseEvent.responded_at:type_name -> google.protobuf.Timestamp
-	47, // 49: session.v1.ReviewQueueEvent.timestamp:type_name -> google.protobuf.Timestamp
-	39, // 50: session.v1.ReviewQueueEvent.item_added:type_name -> session.v1.ReviewQueueItemAddedEvent
-	40, // 51: session.v1.ReviewQueueEvent.item_removed:type_name -> session.v1.ReviewQueueItemRemovedEvent
-	41, // 52: session.v1.ReviewQueueEvent.item_updated:type_name -> session.v1.ReviewQueueItemUpdatedEvent
-	42, // 53: session.v1.ReviewQueueEvent.statistics:type_name -> session.v1.ReviewQueueStatisticsEvent
-	51, // 54: session.v1.ReviewQueueItemAddedEvent.item:type_name -> session.v1.ReviewItem
-	51, // 55: session.v1.ReviewQueueItemUpdatedEvent.item:type_name -> session.v1.ReviewItem
-	44, // 56: session.v1.ReviewQueueStatisticsEvent.by_priority:type_name -> session.v1.ReviewQueueStatisticsEvent.ByPriorityEntry
-	45, // 57: session.v1.ReviewQueueStatisticsEvent.by_reason:type_name -> session.v1.ReviewQueueStatisticsEvent.ByReasonEntry
-	52, // 58: session.v1.NotificationEvent.notification_type:type_name -> session.v1.NotificationType
-	53, // 59: session.v1.NotificationEvent.priority:type_name -> session.v1.NotificationPriority
-	46, // 60: session.v1.NotificationEvent.metadata:type_name -> session.v1.NotificationEvent.MetadataEntry
-	47, // 61: session.v1.NotificationEvent.timestamp:type_name -> google.protobuf.Timestamp
-	62, // [62:62] is the sub-list for method output_type
-	62, // [62:62] is the sub-list for method input_type
-	62, // [62:62] is the sub-list for extension type_name
-	62, // [62:62] is the sub-list for extension extendee
-	0,  // [0:62] is the sub-list for field type_name
+	44, // 29: session.v1.TerminalData.input_control:type_name -> session.v1.InputControl
+	45, // 30: session.v1.TerminalData.input_control_state:type_name -> session.v1.InputControlState
+	47, // 31: session.v1.TerminalData.presence_update:type_name -> session.v1.PresenceUpdate
+	49, // 32: session.v1.TerminalData.frame_stream_start:type_name -> session.v1.FrameStreamStart
+	50, // 33: session.v1.TerminalData.frame_dictionary:type_name -> session.v1.FrameDictionary
+	51, // 34: session.v1.TerminalData.frame:type_name -> session.v1.TerminalFrame
+	15, // 35: session.v1.ScrollbackResponse.chunks:type_name -> session.v1.ScrollbackChunk
+	48, // 36: session.v1.CurrentPaneRequest.resume_cursor:type_name -> session.v1.FrameCursor
+	19, // 37: session.v1.TerminalDelta.lines:type_name -> session.v1.LineDelta
+	22, // 38: session.v1.TerminalDelta.cursor:type_name -> session.v1.CursorPosition
+	23, // 39: session.v1.TerminalDelta.dimensions:type_name -> session.v1.TerminalDimensions
+	20, // 40: session.v1.LineDelta.edit:type_name -> session.v1.LineEdit
+	21, // 41: session.v1.LineDelta.insert:type_name -> session.v1.InsertLine
+	25, // 42: session.v1.TerminalDiff.echo_ack:type_name -> session.v1.EchoAck
+	33, // 43: session.v1.TerminalDiff.compression:type_name -> session.v1.CompressionMetadata
+	27, // 44: session.v1.SSPNegotiation.capabilities:type_name -> session.v1.SSPCapabilities
+	27, // 45: session.v1.SSPNegotiation.negotiated:type_name -> session.v1.SSPCapabilities
+	23, // 46: session.v1.TerminalState.dimensions:type_name -> session.v1.TerminalDimensions
+	30, // 47: session.v1.TerminalState.lines:type_name -> session.v1.TerminalLine
+	22, // 48: session.v1.TerminalState.cursor:type_name -> session.v1.CursorPosition
+	32, // 49: session.v1.TerminalState.scrollback:type_name -> session.v1.ScrollbackInfo
+	33, // 50: session.v1.TerminalState.compression:type_name -> session.v1.CompressionMetadata
+	31, // 51: session.v1.TerminalLine.attributes:type_name -> session.v1.LineAttributes
+	34, // 52: session.v1.CompressionMetadata.dictionary:type_name -> session.v1.DictionaryMetadata
+	0,  // 53: session.v1.UserInteractionEvent.type:type_name -> session.v1.UserInteractionEvent.InteractionType
+	55, // 54: session.v1.SessionAcknowledgedEvent.acknowledged_at:type_name -> google.protobuf.Timestamp
+	55, // 55: session.v1.ApprovalResponseEvent.responded_at:type_name -> google.protobuf.Timestamp
+	55, // 56: session.v1.ReviewQueueEvent.timestamp:type_name -> google.protobuf.Timestamp
+	39, // 57: session.v1.ReviewQueueEvent.item_added:type_name -> session.v1.ReviewQueueItemAddedEvent
+	40, // 58: session.v1.ReviewQueueEvent.item_removed:type_name -> session.v1.ReviewQueueItemRemovedEvent
+	41, // 59: session.v1.ReviewQueueEvent.item_updated:type_name -> session.v1.ReviewQueueItemUpdatedEvent
+	42, // 60: session.v1.ReviewQueueEvent.statistics:type_name -> session.v1.ReviewQueueStatisticsEvent
+	59, // 61: session.v1.ReviewQueueItemAddedEvent.item:type_name -> session.v1.ReviewItem
+	59, // 62: session.v1.ReviewQueueItemUpdatedEvent.item:type_name -> session.v1.ReviewItem
+	52, // 63: session.v1.ReviewQueueStatisticsEvent.by_priority:type_name -> session.v1.ReviewQueueStatisticsEvent.ByPriorityEntry
+	53, // 64: session.v1.ReviewQueueStatisticsEvent.by_reason:type_name -> session.v1.ReviewQueueStatisticsEvent.ByReasonEntry
+	60, // 65: session.v1.NotificationEvent.notification_type:type_name -> session.v1.NotificationType
+	61, // 66: session.v1.NotificationEvent.priority:type_name -> session.v1.NotificationPriority
+	54, // 67: session.v1.NotificationEvent.metadata:type_name -> session.v1.NotificationEvent.MetadataEntry
+	55, // 68: session.v1.NotificationEvent.timestamp:type_name -> google.protobuf.Timestamp
+	46, // 69: session.v1.InputControlState.clients:type_name -> session.v1.ClientPresence
+	55, // 70: session.v1.ClientPresence.joined_at:type_name -> google.protobuf.Timestamp
+	55, // 71: session.v1.ClientPresence.last_input_at:type_name -> google.protobuf.Timestamp
+	72, // [72:72] is the sub-list for method output_type
+	72, // [72:72] is the sub-list for method input_type
+	72, // [72:72] is the sub-list for extension type_name
+	72, // [72:72] is the sub-list for extension extendee
+	0,  // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_session_v1_events_proto_init() }
@@ -4120,6 +4956,12 @@ func file_session_v1_events_proto_init() {
 		(*TerminalData_InputEcho)(nil),
 		(*TerminalData_SspNegotiation)(nil),
 		(*TerminalData_ResizeQuiescence)(nil),
+		(*TerminalData_InputControl)(nil),
+		(*TerminalData_InputControlState)(nil),
+		(*TerminalData_PresenceUpdate)(nil),
+		(*TerminalData_FrameStreamStart)(nil),
+		(*TerminalData_FrameDictionary)(nil),
+		(*TerminalData_Frame)(nil),
 	}
 	file_session_v1_events_proto_msgTypes[11].OneofWrappers = []any{}
 	file_session_v1_events_proto_msgTypes[15].OneofWrappers = []any{}
@@ -4144,13 +4986,14 @@ func file_session_v1_events_proto_init() {
 		(*ReviewQueueEvent_ItemUpdated)(nil),
 		(*ReviewQueueEvent_Statistics)(nil),
 	}
+	file_session_v1_events_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_session_v1_events_proto_rawDesc), len(file_session_v1_events_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   46,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -257,8 +257,9 @@ type CreateSessionRequest struct {
 	AutoYes bool `protobuf:"varint,8,opt,name=auto_yes,json=autoYes,proto3" json:"auto_yes,omitempty"`
 	// Optional: Reuse existing worktree at this path.
 	ExistingWorktree string `protobuf:"bytes,9,opt,name=existing_worktree,json=existingWorktree,proto3" json:"existing_worktree,omitempty"`
-	// Optional: Resume an existing Claude conversation by ID.
-	// This ID comes from ClaudeHistoryEntry.id and will use Claude's --resume flag.
+	// Optional: Resume an existing agent conversation by ID.
+	// This ID comes from ClaudeHistoryEntry.id and is passed to the program's
+	// resume flags (claude --resume, codex resume, gemini --resume).
 	ResumeId string `protobuf:"bytes,10,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
 	// Optional: Apply a named profile's defaults before creation.
 	Profile string `protobuf:"bytes,11,opt,name=profile,proto3" json:"profile,omitempty"`
@@ -280,8 +281,17 @@ type CreateSessionRequest struct {
 	// setting this to true will create the directory and initialize a git repo.
 	// The backend returns CodeNotFound when path is missing and this is false.
 	CreateIfMissing bool `protobuf:"varint,18,opt,name=create_if_missing,json=createIfMissing,proto3" json:"create_if_missing,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Optional: History provider that recorded resume_id ("claude", "codex",
+	// "gemini"; empty means claude). When the session's program is not that
+	// provider's CLI, the provider's CLI is run instead.
+	ResumeProvider string `protobuf:"bytes,19,opt,name=resume_provider,json=resumeProvider,proto3" json:"resume_provider,omitempty"`
+	// Optional: Fork the resume_id conversation and resume the copy, leaving the
+	// original conversation untouched.
+	ForkResume bool `protobuf:"varint,20,opt,name=fork_resume,json=forkResume,proto3" json:"fork_resume,omitempty"`
+	// Optional: Tags applied to the new session.
+	Tags          []string `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSessionRequest) Reset() {
@@ -440,6 +450,27 @@ func (x *CreateSessionRequest) GetCreateIfMissing() bool {
 	return false
 }
 
+func (x *CreateSessionRequest) GetResumeProvider() string {
+	if x != nil {
+		return x.ResumeProvider
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetForkResume() bool {
+	if x != nil {
+		return x.ForkResume
+	}
+	return false
+}
+
+func (x *CreateSessionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreateSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
@@ -503,6 +534,10 @@ type UpdateSessionRequest struct {
 	WorkingDir *string `protobuf:"bytes,7,opt,name=working_dir,json=workingDir,proto3,oneof" json:"working_dir,omitempty"`
 	// Update whether rate limit auto-resume is enabled for this session.
 	RateLimitEnabled *bool `protobuf:"varint,8,opt,name=rate_limit_enabled,json=rateLimitEnabled,proto3,oneof" json:"rate_limit_enabled,omitempty"`
+	// Opt in to (or out of) the PR review feedback loop for this session.
+	PrFeedbackEnabled *bool `protobuf:"varint,9,opt,name=pr_feedback_enabled,json=prFeedbackEnabled,proto3,oneof" json:"pr_feedback_enabled,omitempty"`
+	// Opt in to (or out of) automatic CI fix prompts for this session.
+	CiAutofixEnabled *bool `protobuf:"varint,10,opt,name=ci_autofix_enabled,json=ciAutofixEnabled,proto3,oneof" json:"ci_autofix_enabled,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -593,6 +628,20 @@ func (x *UpdateSessionRequest) GetRateLimitEnabled() bool {
 	return false
 }
 
+func (x *UpdateSessionRequest) GetPrFeedbackEnabled() bool {
+	if x != nil && x.PrFeedbackEnabled != nil {
+		return *x.PrFeedbackEnabled
+	}
+	return false
+}
+
+func (x *UpdateSessionRequest) GetCiAutofixEnabled() bool {
+	if x != nil && x.CiAutofixEnabled != nil {
+		return *x.CiAutofixEnabled
+	}
+	return false
+}
+
 type UpdateSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
@@ -2023,7 +2072,9 @@ type ListClaudeHistoryRequest struct {
 	// Opaque pagination token returned by a previous ListClaudeHistory call.
 	// When set, returns the page of results after the cursor position.
 	// Leave empty to start from the beginning.
-	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional history provider filter ("claude", "codex", "gemini").
+	Provider      *string `protobuf:"bytes,6,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2093,6 +2144,13 @@ func (x *ListClaudeHistoryRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListClaudeHistoryRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
 type ListClaudeHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of history entries for this page
@@ -2249,7 +2307,7 @@ func (x *GetClaudeHistoryDetailResponse) GetEntry() *ClaudeHistoryEntry {
 
 type ClaudeHistoryEntry struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique conversation identifier
+	// Unique conversation identifier, as understood by the provider's resume flag
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Conversation title/name
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -2266,7 +2324,9 @@ type ClaudeHistoryEntry struct {
 	// VCS state for the project directory — populated only by GetClaudeHistoryDetail
 	// (lazy enrichment, not included in list responses).  Null/absent means the
 	// directory is not a version-controlled repo or state was not requested.
-	VcsStatus     *VCSStatus `protobuf:"bytes,8,opt,name=vcs_status,json=vcsStatus,proto3" json:"vcs_status,omitempty"`
+	VcsStatus *VCSStatus `protobuf:"bytes,8,opt,name=vcs_status,json=vcsStatus,proto3" json:"vcs_status,omitempty"`
+	// Agent CLI that recorded the conversation ("claude", "codex", "gemini").
+	Provider      string `protobuf:"bytes,9,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2357,6 +2417,13 @@ func (x *ClaudeHistoryEntry) GetVcsStatus() *VCSStatus {
 	return nil
 }
 
+func (x *ClaudeHistoryEntry) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
 type GetClaudeHistoryMessagesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// History entry ID (session ID)
@@ -2560,7 +2627,9 @@ func (x *ClaudeMessage) GetModel() string {
 
 type SearchClaudeHistoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Search query (required). Supports natural language queries.
+	// Search query (required). Words are ANDed; also supports "phrases", OR,
+	// -exclusion, (grouping), prefix*, fuzzy~, and role:, project:, tool:,
+	// provider:, session:, after:, before: and date: filters.
 	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	// Optional project path filter.
 	Project *string `protobuf:"bytes,2,opt,name=project,proto3,oneof" json:"project,omitempty"`
@@ -2573,7 +2642,9 @@ type SearchClaudeHistoryRequest struct {
 	// Maximum number of results to return (default: 20, max: 100).
 	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Number of results to skip for pagination (default: 0).
-	Offset        int32 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Offset int32 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Attach a per-term score breakdown to each result.
+	Explain       bool `protobuf:"varint,8,opt,name=explain,proto3" json:"explain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2657,6 +2728,13 @@ func (x *SearchClaudeHistoryRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *SearchClaudeHistoryRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
 type SearchClaudeHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of search results, ranked by relevance.
@@ -2666,7 +2744,11 @@ type SearchClaudeHistoryResponse struct {
 	// Query execution time in milliseconds.
 	QueryTimeMs int64 `protobuf:"varint,3,opt,name=query_time_ms,json=queryTimeMs,proto3" json:"query_time_ms,omitempty"`
 	// Indicates if there are more results available.
-	HasMore       bool `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	HasMore bool `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// The query as parsed, showing how it was interpreted.
+	ParsedQuery string `protobuf:"bytes,5,opt,name=parsed_query,json=parsedQuery,proto3" json:"parsed_query,omitempty"`
+	// True when no message contained every query word, so results match any of them.
+	Relaxed       bool `protobuf:"varint,6,opt,name=relaxed,proto3" json:"relaxed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2729,6 +2811,20 @@ func (x *SearchClaudeHistoryResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *SearchClaudeHistoryResponse) GetParsedQuery() string {
+	if x != nil {
+		return x.ParsedQuery
+	}
+	return ""
+}
+
+func (x *SearchClaudeHistoryResponse) GetRelaxed() bool {
+	if x != nil {
+		return x.Relaxed
+	}
+	return false
+}
+
 type SearchResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The conversation/session ID containing this match.
@@ -2744,7 +2840,9 @@ type SearchResult struct {
 	// Contextual snippets showing where the query terms appear.
 	Snippets []*SearchSnippet `protobuf:"bytes,6,rep,name=snippets,proto3" json:"snippets,omitempty"`
 	// Metadata about the match source.
-	Metadata      *SearchResultMetadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Metadata *SearchResultMetadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Score breakdown, set when the request asked to explain.
+	Explanation   *ScoreExplanation `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2828,6 +2926,13 @@ func (x *SearchResult) GetMetadata() *SearchResultMetadata {
 	return nil
 }
 
+func (x *SearchResult) GetExplanation() *ScoreExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
 type SearchSnippet struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Snippet text with surrounding context.
@@ -2963,7 +3068,9 @@ type SearchResultMetadata struct {
 	// Claude model used in this conversation.
 	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
 	// Conversation creation timestamp.
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Agent CLI that recorded the conversation ("claude", "codex", "gemini").
+	Provider      string `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3026,6 +3133,13 @@ func (x *SearchResultMetadata) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SearchResultMetadata) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
 type GetPRInfoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Session identifier (must be a PR session)
@@ -6950,18 +7064,21 @@ func (x *PathEntry) GetIsDirectory() bool {
 
 // ProfileDefaultsProto holds the configurable fields for a named profile.
 type ProfileDefaultsProto struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Program       string                 `protobuf:"bytes,3,opt,name=program,proto3" json:"program,omitempty"`
-	AutoYes       bool                   `protobuf:"varint,4,opt,name=auto_yes,json=autoYes,proto3" json:"auto_yes,omitempty"`
-	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	EnvVars       map[string]string      `protobuf:"bytes,6,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CliFlags      string                 `protobuf:"bytes,7,opt,name=cli_flags,json=cliFlags,proto3" json:"cli_flags,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Program     string                 `protobuf:"bytes,3,opt,name=program,proto3" json:"program,omitempty"`
+	AutoYes     bool                   `protobuf:"varint,4,opt,name=auto_yes,json=autoYes,proto3" json:"auto_yes,omitempty"`
+	Tags        []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	EnvVars     map[string]string      `protobuf:"bytes,6,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CliFlags    string                 `protobuf:"bytes,7,opt,name=cli_flags,json=cliFlags,proto3" json:"cli_flags,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// cgroup v2 limits applied to sessions created with this profile.
+	ResourceLimits *ResourceLimitsProto `protobuf:"bytes,10,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	Sandbox        *SandboxConfigProto  `protobuf:"bytes,11,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProfileDefaultsProto) Reset() {
@@ -7057,6 +7174,20 @@ func (x *ProfileDefaultsProto) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProfileDefaultsProto) GetResourceLimits() *ResourceLimitsProto {
+	if x != nil {
+		return x.ResourceLimits
+	}
+	return nil
+}
+
+func (x *ProfileDefaultsProto) GetSandbox() *SandboxConfigProto {
+	if x != nil {
+		return x.Sandbox
+	}
+	return nil
+}
+
 // DirectoryRuleProto associates a working-directory path prefix with defaults.
 type DirectoryRuleProto struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -8359,8 +8490,12 @@ type BatchSessionRequest struct {
 	SessionType   SessionType            `protobuf:"varint,9,opt,name=session_type,json=sessionType,proto3,enum=session.v1.SessionType" json:"session_type,omitempty"`
 	ProjectId     string                 `protobuf:"bytes,10,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Create the session from this template (name, optionally name@version).
+	// Non-empty fields above override the template's.
+	Template          string            `protobuf:"bytes,12,opt,name=template,proto3" json:"template,omitempty"`
+	TemplateVariables map[string]string `protobuf:"bytes,13,rep,name=template_variables,json=templateVariables,proto3" json:"template_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *BatchSessionRequest) Reset() {
@@ -8470,6 +8605,20 @@ func (x *BatchSessionRequest) GetTags() []string {
 	return nil
 }
 
+func (x *BatchSessionRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *BatchSessionRequest) GetTemplateVariables() map[string]string {
+	if x != nil {
+		return x.TemplateVariables
+	}
+	return nil
+}
+
 type BatchCreateResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -10865,170 +11014,3161 @@ func (x *GetEscapeAnalyticsSummaryResponse) GetMangleRate() float64 {
 	return 0
 }
 
-var File_session_v1_session_proto protoreflect.FileDescriptor
+// ResourceLimitsProto caps a session's cgroup. Zero fields are unlimited.
+type ResourceLimitsProto struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MemoryMaxMb  int64                  `protobuf:"varint,1,opt,name=memory_max_mb,json=memoryMaxMb,proto3" json:"memory_max_mb,omitempty"`
+	MemoryHighMb int64                  `protobuf:"varint,2,opt,name=memory_high_mb,json=memoryHighMb,proto3" json:"memory_high_mb,omitempty"`
+	// CPU quota; 100 is one full CPU.
+	CpuPercent int32 `protobuf:"varint,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	PidsMax    int64 `protobuf:"varint,4,opt,name=pids_max,json=pidsMax,proto3" json:"pids_max,omitempty"`
+	// Relative IO weight, 1-10000.
+	IoWeight      int32 `protobuf:"varint,5,opt,name=io_weight,json=ioWeight,proto3" json:"io_weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_session_v1_session_proto_rawDesc = "" +
-	"\n" +
-	"\x18session/v1/session.proto\x12\n" +
-	"session.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16session/v1/types.proto\x1a\x17session/v1/events.proto\"\x93\x02\n" +
-	"\x13ListSessionsRequest\x126\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x19.session.v1.SessionStatusH\x00R\x06status\x88\x01\x01\x12\x1f\n" +
-	"\bcategory\x18\x02 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x1f\n" +
-	"\vhide_paused\x18\x03 \x01(\bR\n" +
-	"hidePaused\x12&\n" +
-	"\fsearch_query\x18\x04 \x01(\tH\x02R\vsearchQuery\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"project_id\x18\x05 \x01(\tH\x03R\tprojectId\x88\x01\x01B\t\n" +
-	"\a_statusB\v\n" +
-	"\t_categoryB\x0f\n" +
-	"\r_search_queryB\r\n" +
-	"\v_project_id\"G\n" +
-	"\x14ListSessionsResponse\x12/\n" +
-	"\bsessions\x18\x01 \x03(\v2\x13.session.v1.SessionR\bsessions\"#\n" +
-	"\x11GetSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
-	"\x12GetSessionResponse\x12-\n" +
-	"\asession\x18\x01 \x01(\v2\x13.session.v1.SessionR\asession\"\xcd\x04\n" +
-	"\x14CreateSessionRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1f\n" +
-	"\vworking_dir\x18\x03 \x01(\tR\n" +
-	"workingDir\x12\x16\n" +
-	"\x06branch\x18\x04 \x01(\tR\x06branch\x12\x18\n" +
-	"\aprogram\x18\x05 \x01(\tR\aprogram\x12\x1a\n" +
-	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x16\n" +
-	"\x06prompt\x18\a \x01(\tR\x06prompt\x12\x19\n" +
-	"\bauto_yes\x18\b \x01(\bR\aautoYes\x12+\n" +
-	"\x11existing_worktree\x18\t \x01(\tR\x10existingWorktree\x12\x1b\n" +
-	"\tresume_id\x18\n" +
-	" \x01(\tR\bresumeId\x12\x18\n" +
-	"\aprofile\x18\v \x01(\tR\aprofile\x12#\n" +
-	"\rskip_defaults\x18\f \x01(\bR\fskipDefaults\x12:\n" +
-	"\fsession_type\x18\r \x01(\x0e2\x17.session.v1.SessionTypeR\vsessionType\x12\x17\n" +
-	"\aone_off\x18\x0e \x01(\bR\x06oneOff\x12%\n" +
-	"\x0einitial_prompt\x18\x0f \x01(\tR\rinitialPrompt\x12\x19\n" +
-	"\bone_shot\x18\x10 \x01(\bR\aoneShot\x12\x1d\n" +
-	"\n" +
-	"project_id\x18\x11 \x01(\tR\tprojectId\x12*\n" +
-	"\x11create_if_missing\x18\x12 \x01(\bR\x0fcreateIfMissing\"F\n" +
-	"\x15CreateSessionResponse\x12-\n" +
-	"\asession\x18\x01 \x01(\v2\x13.session.v1.SessionR\asession\"\xfb\x02\n" +
-	"\x14UpdateSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x19.session.v1.SessionStatusH\x00R\x06status\x88\x01\x01\x12\x1f\n" +
-	"\bcategory\x18\x03 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x19\n" +
-	"\x05title\x18\x04 \x01(\tH\x02R\x05title\x88\x01\x01\x12\x1d\n" +
-	"\aprogram\x18\x05 \x01(\tH\x03R\aprogram\x88\x01\x01\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\x12$\n" +
-	"\vworking_dir\x18\a \x01(\tH\x04R\n" +
-	"workingDir\x88\x01\x01\x121\n" +
-	"\x12rate_limit_enabled\x18\b \x01(\bH\x05R\x10rateLimitEnabled\x88\x01\x01B\t\n" +
-	"\a_statusB\v\n" +
-	"\t_categoryB\b\n" +
-	"\x06_titleB\n" +
-	"\n" +
-	"\b_programB\x0e\n" +
-	"\f_working_dirB\x15\n" +
-	"\x13_rate_limit_enabled\"F\n" +
-	"\x15UpdateSessionResponse\x12-\n" +
-	"\asession\x18\x01 \x01(\v2\x13.session.v1.SessionR\asession\"<\n" +
-	"\x14DeleteSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05force\x18\x02 \x01(\bR\x05force\"K\n" +
-	"\x15DeleteSessionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xcc\x01\n" +
-	"\x14WatchSessionsRequest\x12,\n" +
-	"\x0fcategory_filter\x18\x01 \x01(\tH\x00R\x0ecategoryFilter\x88\x01\x01\x12C\n" +
-	"\rstatus_filter\x18\x02 \x01(\x0e2\x19.session.v1.SessionStatusH\x01R\fstatusFilter\x88\x01\x01\x12\x1b\n" +
-	"\tafter_seq\x18\x03 \x01(\x04R\bafterSeqB\x12\n" +
-	"\x10_category_filterB\x10\n" +
-	"\x0e_status_filter\"'\n" +
-	"\x15GetSessionDiffRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
-	"\x16GetSessionDiffResponse\x124\n" +
-	"\n" +
-	"diff_stats\x18\x01 \x01(\v2\x15.session.v1.DiffStatsR\tdiffStats\"%\n" +
-	"\x13GetVCSStatusRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
-	"\x14GetVCSStatusResponse\x124\n" +
-	"\n" +
-	"vcs_status\x18\x01 \x01(\v2\x15.session.v1.VCSStatusR\tvcsStatus\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xc8\x01\n" +
-	"\x15GetReviewQueueRequest\x12B\n" +
-	"\x0fpriority_filter\x18\x01 \x01(\x0e2\x14.session.v1.PriorityH\x00R\x0epriorityFilter\x88\x01\x01\x12E\n" +
-	"\rreason_filter\x18\x02 \x01(\x0e2\x1b.session.v1.AttentionReasonH\x01R\freasonFilter\x88\x01\x01B\x12\n" +
-	"\x10_priority_filterB\x10\n" +
-	"\x0e_reason_filter\"T\n" +
-	"\x16GetReviewQueueResponse\x12:\n" +
-	"\freview_queue\x18\x01 \x01(\v2\x17.session.v1.ReviewQueueR\vreviewQueue\"+\n" +
-	"\x19AcknowledgeSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
-	"\x1aAcknowledgeSessionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x9e\x03\n" +
-	"\x0eGetLogsRequest\x12&\n" +
-	"\fsearch_query\x18\x01 \x01(\tH\x00R\vsearchQuery\x88\x01\x01\x12\x19\n" +
-	"\x05level\x18\x02 \x01(\tH\x01R\x05level\x88\x01\x01\x12>\n" +
-	"\n" +
-	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tstartTime\x88\x01\x01\x12:\n" +
-	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\aendTime\x88\x01\x01\x12\x19\n" +
-	"\x05limit\x18\x05 \x01(\x05H\x04R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06offset\x18\x06 \x01(\x05H\x05R\x06offset\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"session_id\x18\a \x01(\tH\x06R\tsessionId\x88\x01\x01\x12\x16\n" +
-	"\x06levels\x18\b \x03(\tR\x06levelsB\x0f\n" +
-	"\r_search_queryB\b\n" +
-	"\x06_levelB\r\n" +
-	"\v_start_timeB\v\n" +
-	"\t_end_timeB\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offsetB\r\n" +
-	"\v_session_id\"}\n" +
-	"\x0fGetLogsResponse\x12.\n" +
-	"\aentries\x18\x01 \x03(\v2\x14.session.v1.LogEntryR\aentries\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x9c\x01\n" +
-	"\bLogEntry\x128\n" +
-	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
-	"\x05level\x18\x02 \x01(\tR\x05level\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
-	"\x06source\x18\x04 \x01(\tH\x00R\x06source\x88\x01\x01B\t\n" +
-	"\a_source\"\x95\x02\n" +
-	"\x17WatchReviewQueueRequest\x12=\n" +
-	"\x0fpriority_filter\x18\x01 \x03(\x0e2\x14.session.v1.PriorityR\x0epriorityFilter\x12@\n" +
-	"\rreason_filter\x18\x02 \x03(\x0e2\x1b.session.v1.AttentionReasonR\freasonFilter\x12-\n" +
-	"\x12include_statistics\x18\x03 \x01(\bR\x11includeStatistics\x12)\n" +
-	"\x10initial_snapshot\x18\x04 \x01(\bR\x0finitialSnapshot\x12\x1f\n" +
-	"\vsession_ids\x18\x05 \x03(\tR\n" +
-	"sessionIds\"\xa6\x03\n" +
-	"\x19LogUserInteractionRequest\x12\"\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12[\n" +
-	"\x10interaction_type\x18\x02 \x01(\x0e20.session.v1.UserInteractionEvent.InteractionTypeR\x0finteractionType\x12\x1d\n" +
-	"\acontext\x18\x03 \x01(\tH\x01R\acontext\x88\x01\x01\x12,\n" +
-	"\x0fnotification_id\x18\x04 \x01(\tH\x02R\x0enotificationId\x88\x01\x01\x12O\n" +
-	"\bmetadata\x18\x05 \x03(\v23.session.v1.LogUserInteractionRequest.MetadataEntryR\bmetadata\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
-	"\v_session_idB\n" +
-	"\n" +
-	"\b_contextB\x12\n" +
-	"\x10_notification_id\"[\n" +
-	"\x1aLogUserInteractionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
-	"\x05error\x18\x02 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"4\n" +
-	"\x16GetClaudeConfigRequest\x12\x1a\n" +
-	"\bfilename\x18\x01 \x01(\tR\bfilename\"O\n" +
-	"\x17GetClaudeConfigResponse\x124\n" +
-	"\x06config\x18\x01 \x01(\v2\x1c.session.v1.ClaudeConfigFileR\x06config\"\x1a\n" +
-	"\x18ListClaudeConfigsRequest\"S\n" +
-	"\x19ListClaudeConfigsResponse\x126\n" +
-	"\aconfigs\x18\x01 \x03(\v2\x1c.session.v1.ClaudeConfigFileR\aconfigs\"m\n" +
-	"\x19UpdateClaudeConfigRequest\x12\x1a\n" +
+func (x *ResourceLimitsProto) Reset() {
+	*x = ResourceLimitsProto{}
+	mi := &file_session_v1_session_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceLimitsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLimitsProto) ProtoMessage() {}
+
+func (x *ResourceLimitsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLimitsProto.ProtoReflect.Descriptor instead.
+func (*ResourceLimitsProto) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *ResourceLimitsProto) GetMemoryMaxMb() int64 {
+	if x != nil {
+		return x.MemoryMaxMb
+	}
+	return 0
+}
+
+func (x *ResourceLimitsProto) GetMemoryHighMb() int64 {
+	if x != nil {
+		return x.MemoryHighMb
+	}
+	return 0
+}
+
+func (x *ResourceLimitsProto) GetCpuPercent() int32 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ResourceLimitsProto) GetPidsMax() int64 {
+	if x != nil {
+		return x.PidsMax
+	}
+	return 0
+}
+
+func (x *ResourceLimitsProto) GetIoWeight() int32 {
+	if x != nil {
+		return x.IoWeight
+	}
+	return 0
+}
+
+// SandboxConfigProto confines a session's filesystem and network access.
+type SandboxConfigProto struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// "host" (default), "none" or "allowlist".
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// Hosts reachable in allowlist mode: "host", "host:port" or "*.domain".
+	AllowedHosts []string `protobuf:"bytes,3,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"`
+	// Extra paths mounted read-write.
+	WritablePaths []string `protobuf:"bytes,4,rep,name=writable_paths,json=writablePaths,proto3" json:"writable_paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SandboxConfigProto) Reset() {
+	*x = SandboxConfigProto{}
+	mi := &file_session_v1_session_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxConfigProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxConfigProto) ProtoMessage() {}
+
+func (x *SandboxConfigProto) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxConfigProto.ProtoReflect.Descriptor instead.
+func (*SandboxConfigProto) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SandboxConfigProto) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SandboxConfigProto) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SandboxConfigProto) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+func (x *SandboxConfigProto) GetWritablePaths() []string {
+	if x != nil {
+		return x.WritablePaths
+	}
+	return nil
+}
+
+type GetSessionTimelineRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Only return turns with index >= since_turn, for incremental polling.
+	SinceTurn int32 `protobuf:"varint,2,opt,name=since_turn,json=sinceTurn,proto3" json:"since_turn,omitempty"`
+	// Maximum number of turns to return, keeping the most recent. 0 = all.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionTimelineRequest) Reset() {
+	*x = GetSessionTimelineRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionTimelineRequest) ProtoMessage() {}
+
+func (x *GetSessionTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *GetSessionTimelineRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetSessionTimelineRequest) GetSinceTurn() int32 {
+	if x != nil {
+		return x.SinceTurn
+	}
+	return 0
+}
+
+func (x *GetSessionTimelineRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSessionTimelineResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Turns []*TurnDigest          `protobuf:"bytes,1,rep,name=turns,proto3" json:"turns,omitempty"`
+	// Number of turns in the whole conversation, before since_turn and limit.
+	TotalTurns int32 `protobuf:"varint,2,opt,name=total_turns,json=totalTurns,proto3" json:"total_turns,omitempty"`
+	// Conversation file the digests were extracted from.
+	ConversationPath string `protobuf:"bytes,3,opt,name=conversation_path,json=conversationPath,proto3" json:"conversation_path,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSessionTimelineResponse) Reset() {
+	*x = GetSessionTimelineResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionTimelineResponse) ProtoMessage() {}
+
+func (x *GetSessionTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *GetSessionTimelineResponse) GetTurns() []*TurnDigest {
+	if x != nil {
+		return x.Turns
+	}
+	return nil
+}
+
+func (x *GetSessionTimelineResponse) GetTotalTurns() int32 {
+	if x != nil {
+		return x.TotalTurns
+	}
+	return 0
+}
+
+func (x *GetSessionTimelineResponse) GetConversationPath() string {
+	if x != nil {
+		return x.ConversationPath
+	}
+	return ""
+}
+
+// TurnDigest summarises one conversation turn: a user prompt and the assistant
+// work that followed it, up to the next prompt.
+type TurnDigest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Zero-based position of the turn in the conversation.
+	Index     int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// First line of the prompt; empty for work before the first prompt.
+	Prompt string `protobuf:"bytes,4,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// Tools called, in order of first use.
+	Tools []*TurnToolCount `protobuf:"bytes,5,rep,name=tools,proto3" json:"tools,omitempty"`
+	// Files modified by Edit, MultiEdit, Write and NotebookEdit calls.
+	FilesTouched []string `protobuf:"bytes,6,rep,name=files_touched,json=filesTouched,proto3" json:"files_touched,omitempty"`
+	// First line of each Bash command (at most 25).
+	Commands []string `protobuf:"bytes,7,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Failed tool calls as "Tool: first line of the result" (at most 25).
+	Errors []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	// First line of the turn's last assistant text.
+	Outcome string `protobuf:"bytes,9,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// One-sentence rendering of the fields above.
+	Summary       string `protobuf:"bytes,10,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnDigest) Reset() {
+	*x = TurnDigest{}
+	mi := &file_session_v1_session_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnDigest) ProtoMessage() {}
+
+func (x *TurnDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnDigest.ProtoReflect.Descriptor instead.
+func (*TurnDigest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *TurnDigest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TurnDigest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TurnDigest) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *TurnDigest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *TurnDigest) GetTools() []*TurnToolCount {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *TurnDigest) GetFilesTouched() []string {
+	if x != nil {
+		return x.FilesTouched
+	}
+	return nil
+}
+
+func (x *TurnDigest) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *TurnDigest) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TurnDigest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *TurnDigest) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type TurnToolCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnToolCount) Reset() {
+	*x = TurnToolCount{}
+	mi := &file_session_v1_session_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnToolCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnToolCount) ProtoMessage() {}
+
+func (x *TurnToolCount) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnToolCount.ProtoReflect.Descriptor instead.
+func (*TurnToolCount) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *TurnToolCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TurnToolCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ScoreExplanation breaks a result's score into per-term BM25 contributions.
+type ScoreExplanation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sum of each term's score times its weight.
+	TotalScore float64 `protobuf:"fixed64,1,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	// BM25 term frequency saturation parameter.
+	K1 float64 `protobuf:"fixed64,2,opt,name=k1,proto3" json:"k1,omitempty"`
+	// BM25 length normalization parameter.
+	B             float64                 `protobuf:"fixed64,3,opt,name=b,proto3" json:"b,omitempty"`
+	Terms         []*TermScoreExplanation `protobuf:"bytes,4,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreExplanation) Reset() {
+	*x = ScoreExplanation{}
+	mi := &file_session_v1_session_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreExplanation) ProtoMessage() {}
+
+func (x *ScoreExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreExplanation.ProtoReflect.Descriptor instead.
+func (*ScoreExplanation) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ScoreExplanation) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *ScoreExplanation) GetK1() float64 {
+	if x != nil {
+		return x.K1
+	}
+	return 0
+}
+
+func (x *ScoreExplanation) GetB() float64 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *ScoreExplanation) GetTerms() []*TermScoreExplanation {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type TermScoreExplanation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Index term (stemmed).
+	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	// Query clause the term matched for, e.g. "go test" or auth*.
+	Clause string `protobuf:"bytes,2,opt,name=clause,proto3" json:"clause,omitempty"`
+	// Multiplier applied to the BM25 score (phrase > exact > prefix > fuzzy).
+	Weight            float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	TermFrequency     float64 `protobuf:"fixed64,4,opt,name=term_frequency,json=termFrequency,proto3" json:"term_frequency,omitempty"`
+	DocumentFrequency int32   `protobuf:"varint,5,opt,name=document_frequency,json=documentFrequency,proto3" json:"document_frequency,omitempty"`
+	Idf               float64 `protobuf:"fixed64,6,opt,name=idf,proto3" json:"idf,omitempty"`
+	// Unweighted BM25 score of the term.
+	Score             float64 `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	DocumentLength    int32   `protobuf:"varint,8,opt,name=document_length,json=documentLength,proto3" json:"document_length,omitempty"`
+	AvgDocumentLength float64 `protobuf:"fixed64,9,opt,name=avg_document_length,json=avgDocumentLength,proto3" json:"avg_document_length,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TermScoreExplanation) Reset() {
+	*x = TermScoreExplanation{}
+	mi := &file_session_v1_session_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermScoreExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermScoreExplanation) ProtoMessage() {}
+
+func (x *TermScoreExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermScoreExplanation.ProtoReflect.Descriptor instead.
+func (*TermScoreExplanation) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *TermScoreExplanation) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *TermScoreExplanation) GetClause() string {
+	if x != nil {
+		return x.Clause
+	}
+	return ""
+}
+
+func (x *TermScoreExplanation) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TermScoreExplanation) GetTermFrequency() float64 {
+	if x != nil {
+		return x.TermFrequency
+	}
+	return 0
+}
+
+func (x *TermScoreExplanation) GetDocumentFrequency() int32 {
+	if x != nil {
+		return x.DocumentFrequency
+	}
+	return 0
+}
+
+func (x *TermScoreExplanation) GetIdf() float64 {
+	if x != nil {
+		return x.Idf
+	}
+	return 0
+}
+
+func (x *TermScoreExplanation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TermScoreExplanation) GetDocumentLength() int32 {
+	if x != nil {
+		return x.DocumentLength
+	}
+	return 0
+}
+
+func (x *TermScoreExplanation) GetAvgDocumentLength() float64 {
+	if x != nil {
+		return x.AvgDocumentLength
+	}
+	return 0
+}
+
+type SetSessionRecordingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Enabled   bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Also record input sent to the terminal. Input is redacted line by line.
+	IncludeInput  bool `protobuf:"varint,3,opt,name=include_input,json=includeInput,proto3" json:"include_input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSessionRecordingRequest) Reset() {
+	*x = SetSessionRecordingRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSessionRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSessionRecordingRequest) ProtoMessage() {}
+
+func (x *SetSessionRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSessionRecordingRequest.ProtoReflect.Descriptor instead.
+func (*SetSessionRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *SetSessionRecordingRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetSessionRecordingRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetSessionRecordingRequest) GetIncludeInput() bool {
+	if x != nil {
+		return x.IncludeInput
+	}
+	return false
+}
+
+type SetSessionRecordingResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Settings      *SessionRecordingSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSessionRecordingResponse) Reset() {
+	*x = SetSessionRecordingResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSessionRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSessionRecordingResponse) ProtoMessage() {}
+
+func (x *SetSessionRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSessionRecordingResponse.ProtoReflect.Descriptor instead.
+func (*SetSessionRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *SetSessionRecordingResponse) GetSettings() *SessionRecordingSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SessionRecordingSettings struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Enabled      bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	IncludeInput bool                   `protobuf:"varint,2,opt,name=include_input,json=includeInput,proto3" json:"include_input,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Whether a recording segment is currently being written.
+	Active        bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionRecordingSettings) Reset() {
+	*x = SessionRecordingSettings{}
+	mi := &file_session_v1_session_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionRecordingSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRecordingSettings) ProtoMessage() {}
+
+func (x *SessionRecordingSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRecordingSettings.ProtoReflect.Descriptor instead.
+func (*SessionRecordingSettings) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *SessionRecordingSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SessionRecordingSettings) GetIncludeInput() bool {
+	if x != nil {
+		return x.IncludeInput
+	}
+	return false
+}
+
+func (x *SessionRecordingSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SessionRecordingSettings) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ExportRecordingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Start the export at this checkpoint (ID or label). Empty = recording start.
+	FromCheckpoint string `protobuf:"bytes,2,opt,name=from_checkpoint,json=fromCheckpoint,proto3" json:"from_checkpoint,omitempty"`
+	// End the export at this checkpoint (ID or label). Empty = now.
+	ToCheckpoint string `protobuf:"bytes,3,opt,name=to_checkpoint,json=toCheckpoint,proto3" json:"to_checkpoint,omitempty"`
+	// Include recorded input events. Off by default since input may contain
+	// secrets the redactor does not recognise.
+	IncludeInput bool `protobuf:"varint,4,opt,name=include_input,json=includeInput,proto3" json:"include_input,omitempty"`
+	// Cap pauses during playback at this many seconds. 0 = keep pauses.
+	IdleTimeLimit float64 `protobuf:"fixed64,5,opt,name=idle_time_limit,json=idleTimeLimit,proto3" json:"idle_time_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRecordingRequest) Reset() {
+	*x = ExportRecordingRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRecordingRequest) ProtoMessage() {}
+
+func (x *ExportRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRecordingRequest.ProtoReflect.Descriptor instead.
+func (*ExportRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ExportRecordingRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ExportRecordingRequest) GetFromCheckpoint() string {
+	if x != nil {
+		return x.FromCheckpoint
+	}
+	return ""
+}
+
+func (x *ExportRecordingRequest) GetToCheckpoint() string {
+	if x != nil {
+		return x.ToCheckpoint
+	}
+	return ""
+}
+
+func (x *ExportRecordingRequest) GetIncludeInput() bool {
+	if x != nil {
+		return x.IncludeInput
+	}
+	return false
+}
+
+func (x *ExportRecordingRequest) GetIdleTimeLimit() float64 {
+	if x != nil {
+		return x.IdleTimeLimit
+	}
+	return 0
+}
+
+type ExportRecordingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The asciicast v2 file.
+	Cast            []byte  `protobuf:"bytes,1,opt,name=cast,proto3" json:"cast,omitempty"`
+	EventCount      int32   `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	DurationSeconds float64 `protobuf:"fixed64,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportRecordingResponse) Reset() {
+	*x = ExportRecordingResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRecordingResponse) ProtoMessage() {}
+
+func (x *ExportRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRecordingResponse.ProtoReflect.Descriptor instead.
+func (*ExportRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *ExportRecordingResponse) GetCast() []byte {
+	if x != nil {
+		return x.Cast
+	}
+	return nil
+}
+
+func (x *ExportRecordingResponse) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *ExportRecordingResponse) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type SendSessionInputRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Text to type. Sent as-is; control characters such as "\x03" are allowed.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Press Enter after the text, submitting it as a prompt.
+	Submit        bool `protobuf:"varint,3,opt,name=submit,proto3" json:"submit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSessionInputRequest) Reset() {
+	*x = SendSessionInputRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSessionInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSessionInputRequest) ProtoMessage() {}
+
+func (x *SendSessionInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSessionInputRequest.ProtoReflect.Descriptor instead.
+func (*SendSessionInputRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *SendSessionInputRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SendSessionInputRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SendSessionInputRequest) GetSubmit() bool {
+	if x != nil {
+		return x.Submit
+	}
+	return false
+}
+
+type SendSessionInputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BytesWritten  int32                  `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSessionInputResponse) Reset() {
+	*x = SendSessionInputResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSessionInputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSessionInputResponse) ProtoMessage() {}
+
+func (x *SendSessionInputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSessionInputResponse.ProtoReflect.Descriptor instead.
+func (*SendSessionInputResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *SendSessionInputResponse) GetBytesWritten() int32 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+type SessionSchedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Five-field cron expression or a descriptor such as "@daily".
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	// IANA timezone the expression is evaluated in. Empty = server local time.
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Enabled  bool   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// "session" (default) creates a session per firing; "one_shot" runs the
+	// prompt non-interactively in target_session.
+	Kind   string `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Prompt string `protobuf:"bytes,7,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// Session schedules: profile, repository and optional per-run branch.
+	Profile  string `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+	RepoPath string `protobuf:"bytes,9,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	// Each run gets a worktree on branch_prefix plus the firing time.
+	BranchPrefix string `protobuf:"bytes,10,opt,name=branch_prefix,json=branchPrefix,proto3" json:"branch_prefix,omitempty"`
+	Program      string `protobuf:"bytes,11,opt,name=program,proto3" json:"program,omitempty"`
+	Category     string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	// One-shot schedules.
+	TargetSession  string `protobuf:"bytes,13,opt,name=target_session,json=targetSession,proto3" json:"target_session,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,14,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// What to do when a firing finds the previous run still going: "skip"
+	// (default), "queue" or "replace".
+	Overlap string `protobuf:"bytes,15,opt,name=overlap,proto3" json:"overlap,omitempty"`
+	// Which firings missed while the server was down to run on startup:
+	// "none", "latest" (default) or "all".
+	CatchUp   string                 `protobuf:"bytes,16,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Output only. Unset when the schedule is disabled.
+	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	// Output only.
+	LastRun       *ScheduleRun `protobuf:"bytes,20,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionSchedule) Reset() {
+	*x = SessionSchedule{}
+	mi := &file_session_v1_session_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSchedule) ProtoMessage() {}
+
+func (x *SessionSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSchedule.ProtoReflect.Descriptor instead.
+func (*SessionSchedule) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *SessionSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SessionSchedule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetBranchPrefix() string {
+	if x != nil {
+		return x.BranchPrefix
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetProgram() string {
+	if x != nil {
+		return x.Program
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetTargetSession() string {
+	if x != nil {
+		return x.TargetSession
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *SessionSchedule) GetOverlap() string {
+	if x != nil {
+		return x.Overlap
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetCatchUp() string {
+	if x != nil {
+		return x.CatchUp
+	}
+	return ""
+}
+
+func (x *SessionSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SessionSchedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SessionSchedule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *SessionSchedule) GetLastRun() *ScheduleRun {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+type ScheduleRun struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// "schedule", "catch_up" or "manual".
+	Trigger string `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// "queued", "running", "succeeded", "failed", "skipped" or "replaced".
+	Status       string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ScheduledFor *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// Title of the session the run spawned, or ran a one-shot in.
+	SessionId   string `protobuf:"bytes,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionUuid string `protobuf:"bytes,9,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
+	Error       string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	ExitCode    int32  `protobuf:"varint,11,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	PrUrl       string `protobuf:"bytes,12,opt,name=pr_url,json=prUrl,proto3" json:"pr_url,omitempty"`
+	// Tail of a one-shot's output.
+	Output        string `protobuf:"bytes,13,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRun) Reset() {
+	*x = ScheduleRun{}
+	mi := &file_session_v1_session_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRun) ProtoMessage() {}
+
+func (x *ScheduleRun) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRun.ProtoReflect.Descriptor instead.
+func (*ScheduleRun) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *ScheduleRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetSessionUuid() string {
+	if x != nil {
+		return x.SessionUuid
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ScheduleRun) GetPrUrl() string {
+	if x != nil {
+		return x.PrUrl
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{198}
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*SessionSchedule     `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*SessionSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type CreateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id and output-only fields are ignored.
+	Schedule      *SessionSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *CreateScheduleRequest) GetSchedule() *SessionSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type CreateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *SessionSchedule       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleResponse) Reset() {
+	*x = CreateScheduleResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleResponse) ProtoMessage() {}
+
+func (x *CreateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *CreateScheduleResponse) GetSchedule() *SessionSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedule ID or name.
+	Id             string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Cron           *string `protobuf:"bytes,3,opt,name=cron,proto3,oneof" json:"cron,omitempty"`
+	Timezone       *string `protobuf:"bytes,4,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	Enabled        *bool   `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Kind           *string `protobuf:"bytes,6,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Prompt         *string `protobuf:"bytes,7,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	Profile        *string `protobuf:"bytes,8,opt,name=profile,proto3,oneof" json:"profile,omitempty"`
+	RepoPath       *string `protobuf:"bytes,9,opt,name=repo_path,json=repoPath,proto3,oneof" json:"repo_path,omitempty"`
+	BranchPrefix   *string `protobuf:"bytes,10,opt,name=branch_prefix,json=branchPrefix,proto3,oneof" json:"branch_prefix,omitempty"`
+	Program        *string `protobuf:"bytes,11,opt,name=program,proto3,oneof" json:"program,omitempty"`
+	Category       *string `protobuf:"bytes,12,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	TargetSession  *string `protobuf:"bytes,13,opt,name=target_session,json=targetSession,proto3,oneof" json:"target_session,omitempty"`
+	TimeoutSeconds *int32  `protobuf:"varint,14,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	Overlap        *string `protobuf:"bytes,15,opt,name=overlap,proto3,oneof" json:"overlap,omitempty"`
+	CatchUp        *string `protobuf:"bytes,16,opt,name=catch_up,json=catchUp,proto3,oneof" json:"catch_up,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *UpdateScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetCron() string {
+	if x != nil && x.Cron != nil {
+		return *x.Cron
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateScheduleRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetProfile() string {
+	if x != nil && x.Profile != nil {
+		return *x.Profile
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetRepoPath() string {
+	if x != nil && x.RepoPath != nil {
+		return *x.RepoPath
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetBranchPrefix() string {
+	if x != nil && x.BranchPrefix != nil {
+		return *x.BranchPrefix
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetProgram() string {
+	if x != nil && x.Program != nil {
+		return *x.Program
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetTargetSession() string {
+	if x != nil && x.TargetSession != nil {
+		return *x.TargetSession
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetOverlap() string {
+	if x != nil && x.Overlap != nil {
+		return *x.Overlap
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetCatchUp() string {
+	if x != nil && x.CatchUp != nil {
+		return *x.CatchUp
+	}
+	return ""
+}
+
+type UpdateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *SessionSchedule       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleResponse) Reset() {
+	*x = UpdateScheduleResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *UpdateScheduleResponse) GetSchedule() *SessionSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type DeleteScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedule ID or name.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *DeleteScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{205}
+}
+
+type TriggerScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedule ID or name.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScheduleRequest) Reset() {
+	*x = TriggerScheduleRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScheduleRequest) ProtoMessage() {}
+
+func (x *TriggerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*TriggerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *TriggerScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TriggerScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *ScheduleRun           `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScheduleResponse) Reset() {
+	*x = TriggerScheduleResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScheduleResponse) ProtoMessage() {}
+
+func (x *TriggerScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScheduleResponse.ProtoReflect.Descriptor instead.
+func (*TriggerScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *TriggerScheduleResponse) GetRun() *ScheduleRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type ListScheduleRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedule ID or name. Empty = runs of every schedule.
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Max runs returned (default 20, max 100).
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsRequest) Reset() {
+	*x = ListScheduleRunsRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsRequest) ProtoMessage() {}
+
+func (x *ListScheduleRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *ListScheduleRunsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListScheduleRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListScheduleRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*ScheduleRun         `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsResponse) Reset() {
+	*x = ListScheduleRunsResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsResponse) ProtoMessage() {}
+
+func (x *ListScheduleRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *ListScheduleRunsResponse) GetRuns() []*ScheduleRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type TemplateVariable struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Referenced as {{name}} in the prompt and branch pattern.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "string" (default), "url", "int", "bool" or "enum".
+	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description  string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Required     bool   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	DefaultValue string `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Accepted values of an enum variable.
+	Options       []string `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateVariable) Reset() {
+	*x = TemplateVariable{}
+	mi := &file_session_v1_session_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVariable) ProtoMessage() {}
+
+func (x *TemplateVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVariable.ProtoReflect.Descriptor instead.
+func (*TemplateVariable) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *TemplateVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TemplateVariable) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TemplateVariable) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SessionTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only on save: personal templates count up from 1 per name.
+	Version     int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Program     string `protobuf:"bytes,4,opt,name=program,proto3" json:"program,omitempty"`
+	// Named session_defaults profile applied first.
+	Profile string `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	// "directory" or "new_worktree"; empty infers from branch_pattern.
+	SessionType string `protobuf:"bytes,6,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"`
+	// Branch name with {{variables}}, e.g. "fix/{{module}}-{{date}}". The
+	// built-ins {{date}} and {{repo}} are always available.
+	BranchPattern string              `protobuf:"bytes,7,opt,name=branch_pattern,json=branchPattern,proto3" json:"branch_pattern,omitempty"`
+	Tags          []string            `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Prompt        string              `protobuf:"bytes,9,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Variables     []*TemplateVariable `protobuf:"bytes,10,rep,name=variables,proto3" json:"variables,omitempty"`
+	// Runs with sh in the new session's working directory, inside its sandbox
+	// if it has one, before the agent starts. Variable values are passed as
+	// SSQ_VAR_<NAME> environment variables.
+	SetupScript string `protobuf:"bytes,11,opt,name=setup_script,json=setupScript,proto3" json:"setup_script,omitempty"`
+	// Appended to the prompt as a checklist.
+	AcceptanceCriteria []string `protobuf:"bytes,12,rep,name=acceptance_criteria,json=acceptanceCriteria,proto3" json:"acceptance_criteria,omitempty"`
+	// Output only: "user" or "repo".
+	Source        string                 `protobuf:"bytes,13,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionTemplate) Reset() {
+	*x = SessionTemplate{}
+	mi := &file_session_v1_session_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTemplate) ProtoMessage() {}
+
+func (x *SessionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTemplate.ProtoReflect.Descriptor instead.
+func (*SessionTemplate) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *SessionTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SessionTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetProgram() string {
+	if x != nil {
+		return x.Program
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetSessionType() string {
+	if x != nil {
+		return x.SessionType
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetBranchPattern() string {
+	if x != nil {
+		return x.BranchPattern
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetVariables() []*TemplateVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetSetupScript() string {
+	if x != nil {
+		return x.SetupScript
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetAcceptanceCriteria() []string {
+	if x != nil {
+		return x.AcceptanceCriteria
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Include the shared templates of this repository.
+	RepoPath      string `protobuf:"bytes,1,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *ListTemplatesRequest) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+type ListTemplatesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Templates []*SessionTemplate     `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	// Shared template files that could not be loaded.
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*SessionTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 0 = latest.
+	Version       int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	RepoPath      string `protobuf:"bytes,3,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *GetTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetTemplateRequest) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+type GetTemplateResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Template *SessionTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Stored versions of a personal template, newest first.
+	Versions      []int32 `protobuf:"varint,2,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *GetTemplateResponse) GetVersions() []int32 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type SaveTemplateRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Template *SessionTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Write the template to repo_path's templates directory instead of the
+	// personal store.
+	Shared        bool   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"`
+	RepoPath      string `protobuf:"bytes,3,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveTemplateRequest) Reset() {
+	*x = SaveTemplateRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveTemplateRequest) ProtoMessage() {}
+
+func (x *SaveTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *SaveTemplateRequest) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *SaveTemplateRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *SaveTemplateRequest) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+type SaveTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SessionTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveTemplateResponse) Reset() {
+	*x = SaveTemplateResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveTemplateResponse) ProtoMessage() {}
+
+func (x *SaveTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *SaveTemplateResponse) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *DeleteTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{219}
+}
+
+type CreateSessionFromTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Template name, optionally name@version.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	RepoPath string `protobuf:"bytes,2,opt,name=repo_path,json=repoPath,proto3" json:"repo_path,omitempty"`
+	// Default: the template name plus a timestamp.
+	Title     string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Variables map[string]string `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional overrides of the template's fields.
+	Branch   string   `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
+	Program  string   `protobuf:"bytes,6,opt,name=program,proto3" json:"program,omitempty"`
+	Category string   `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	AutoYes  bool     `protobuf:"varint,9,opt,name=auto_yes,json=autoYes,proto3" json:"auto_yes,omitempty"`
+	// Trust the shared template's setup script, as it is now, in this
+	// repository. Untrusted shared setup scripts fail the creation.
+	TrustSetupScript bool `protobuf:"varint,10,opt,name=trust_setup_script,json=trustSetupScript,proto3" json:"trust_setup_script,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateSessionFromTemplateRequest) Reset() {
+	*x = CreateSessionFromTemplateRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateSessionFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *CreateSessionFromTemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *CreateSessionFromTemplateRequest) GetRepoPath() string {
+	if x != nil {
+		return x.RepoPath
+	}
+	return ""
+}
+
+func (x *CreateSessionFromTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateSessionFromTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateSessionFromTemplateRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *CreateSessionFromTemplateRequest) GetProgram() string {
+	if x != nil {
+		return x.Program
+	}
+	return ""
+}
+
+func (x *CreateSessionFromTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateSessionFromTemplateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateSessionFromTemplateRequest) GetAutoYes() bool {
+	if x != nil {
+		return x.AutoYes
+	}
+	return false
+}
+
+func (x *CreateSessionFromTemplateRequest) GetTrustSetupScript() bool {
+	if x != nil {
+		return x.TrustSetupScript
+	}
+	return false
+}
+
+type CreateSessionFromTemplateResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Session *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	// The template version that was used.
+	Template *SessionTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// Tail of the setup script's output.
+	SetupOutput   string `protobuf:"bytes,3,opt,name=setup_output,json=setupOutput,proto3" json:"setup_output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionFromTemplateResponse) Reset() {
+	*x = CreateSessionFromTemplateResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateSessionFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *CreateSessionFromTemplateResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *CreateSessionFromTemplateResponse) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateSessionFromTemplateResponse) GetSetupOutput() string {
+	if x != nil {
+		return x.SetupOutput
+	}
+	return ""
+}
+
+type SendCIFixPromptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Session identifier
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCIFixPromptRequest) Reset() {
+	*x = SendCIFixPromptRequest{}
+	mi := &file_session_v1_session_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCIFixPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCIFixPromptRequest) ProtoMessage() {}
+
+func (x *SendCIFixPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCIFixPromptRequest.ProtoReflect.Descriptor instead.
+func (*SendCIFixPromptRequest) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *SendCIFixPromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SendCIFixPromptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The prompt that was queued to the agent
+	Prompt        string `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCIFixPromptResponse) Reset() {
+	*x = SendCIFixPromptResponse{}
+	mi := &file_session_v1_session_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCIFixPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCIFixPromptResponse) ProtoMessage() {}
+
+func (x *SendCIFixPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_session_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCIFixPromptResponse.ProtoReflect.Descriptor instead.
+func (*SendCIFixPromptResponse) Descriptor() ([]byte, []int) {
+	return file_session_v1_session_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *SendCIFixPromptResponse) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+var File_session_v1_session_proto protoreflect.FileDescriptor
+
+const file_session_v1_session_proto_rawDesc = "" +
+	"\n" +
+	"\x18session/v1/session.proto\x12\n" +
+	"session.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16session/v1/types.proto\x1a\x17session/v1/events.proto\"\x93\x02\n" +
+	"\x13ListSessionsRequest\x126\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.session.v1.SessionStatusH\x00R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x02 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x1f\n" +
+	"\vhide_paused\x18\x03 \x01(\bR\n" +
+	"hidePaused\x12&\n" +
+	"\fsearch_query\x18\x04 \x01(\tH\x02R\vsearchQuery\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tH\x03R\tprojectId\x88\x01\x01B\t\n" +
+	"\a_statusB\v\n" +
+	"\t_categoryB\x0f\n" +
+	"\r_search_queryB\r\n" +
+	"\v_project_id\"G\n" +
+	"\x14ListSessionsResponse\x12/\n" +
+	"\bsessions\x18\x01 \x03(\v2\x13.session.v1.SessionR\bsessions\"#\n" +
+	"\x11GetSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x12GetSessionResponse\x12-\n" +
+	"\asession\x18\x01 \x01(\v2\x13.session.v1.SessionR\asession\"\xab\x05\n" +
+	"\x14CreateSessionRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1f\n" +
+	"\vworking_dir\x18\x03 \x01(\tR\n" +
+	"workingDir\x12\x16\n" +
+	"\x06branch\x18\x04 \x01(\tR\x06branch\x12\x18\n" +
+	"\aprogram\x18\x05 \x01(\tR\aprogram\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06prompt\x18\a \x01(\tR\x06prompt\x12\x19\n" +
+	"\bauto_yes\x18\b \x01(\bR\aautoYes\x12+\n" +
+	"\x11existing_worktree\x18\t \x01(\tR\x10existingWorktree\x12\x1b\n" +
+	"\tresume_id\x18\n" +
+	" \x01(\tR\bresumeId\x12\x18\n" +
+	"\aprofile\x18\v \x01(\tR\aprofile\x12#\n" +
+	"\rskip_defaults\x18\f \x01(\bR\fskipDefaults\x12:\n" +
+	"\fsession_type\x18\r \x01(\x0e2\x17.session.v1.SessionTypeR\vsessionType\x12\x17\n" +
+	"\aone_off\x18\x0e \x01(\bR\x06oneOff\x12%\n" +
+	"\x0einitial_prompt\x18\x0f \x01(\tR\rinitialPrompt\x12\x19\n" +
+	"\bone_shot\x18\x10 \x01(\bR\aoneShot\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x11 \x01(\tR\tprojectId\x12*\n" +
+	"\x11create_if_missing\x18\x12 \x01(\bR\x0fcreateIfMissing\x12'\n" +
+	"\x0fresume_provider\x18\x13 \x01(\tR\x0eresumeProvider\x12\x1f\n" +
+	"\vfork_resume\x18\x14 \x01(\bR\n" +
+	"forkResume\x12\x12\n" +
+	"\x04tags\x18\x15 \x03(\tR\x04tags\"F\n" +
+	"\x15CreateSessionResponse\x12-\n" +
+	"\asession\x18\x01 \x01(\v2\x13.session.v1.SessionR\asession\"\x92\x04\n" +
+	"\x14UpdateSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.session.v1.SessionStatusH\x00R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x03 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x04 \x01(\tH\x02R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\aprogram\x18\x05 \x01(\tH\x03R\aprogram\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12$\n" +
+	"\vworking_dir\x18\a \x01(\tH\x04R\n" +
+	"workingDir\x88\x01\x01\x121\n" +
+	"\x12rate_limit_enabled\x18\b \x01(\bH\x05R\x10rateLimitEnabled\x88\x01\x01\x123\n" +
+	"\x13pr_feedback_enabled\x18\t \x01(\bH\x06R\x11prFeedbackEnabled\x88\x01\x01\x121\n" +
+	"\x12ci_autofix_enabled\x18\n" +
+	" \x01(\bH\aR\x10ciAutofixEnabled\x88\x01\x01B\t\n" +
+	"\a_statusB\v\n" +
+	"\t_categoryB\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_programB\x0e\n" +
+	"\f_working_dirB\x15\n" +
+	"\x13_rate_limit_enabledB\x16\n" +
+	"\x14_pr_feedback_enabledB\x15\n" +
+	"\x13_ci_autofix_enabled\"F\n" +
+	"\x15UpdateSessionResponse\x12-\n" +
+	"\asession\x18\x01 \x01(\v2\x13.session.v1.SessionR\asession\"<\n" +
+	"\x14DeleteSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"K\n" +
+	"\x15DeleteSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xcc\x01\n" +
+	"\x14WatchSessionsRequest\x12,\n" +
+	"\x0fcategory_filter\x18\x01 \x01(\tH\x00R\x0ecategoryFilter\x88\x01\x01\x12C\n" +
+	"\rstatus_filter\x18\x02 \x01(\x0e2\x19.session.v1.SessionStatusH\x01R\fstatusFilter\x88\x01\x01\x12\x1b\n" +
+	"\tafter_seq\x18\x03 \x01(\x04R\bafterSeqB\x12\n" +
+	"\x10_category_filterB\x10\n" +
+	"\x0e_status_filter\"'\n" +
+	"\x15GetSessionDiffRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x16GetSessionDiffResponse\x124\n" +
+	"\n" +
+	"diff_stats\x18\x01 \x01(\v2\x15.session.v1.DiffStatsR\tdiffStats\"%\n" +
+	"\x13GetVCSStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
+	"\x14GetVCSStatusResponse\x124\n" +
+	"\n" +
+	"vcs_status\x18\x01 \x01(\v2\x15.session.v1.VCSStatusR\tvcsStatus\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xc8\x01\n" +
+	"\x15GetReviewQueueRequest\x12B\n" +
+	"\x0fpriority_filter\x18\x01 \x01(\x0e2\x14.session.v1.PriorityH\x00R\x0epriorityFilter\x88\x01\x01\x12E\n" +
+	"\rreason_filter\x18\x02 \x01(\x0e2\x1b.session.v1.AttentionReasonH\x01R\freasonFilter\x88\x01\x01B\x12\n" +
+	"\x10_priority_filterB\x10\n" +
+	"\x0e_reason_filter\"T\n" +
+	"\x16GetReviewQueueResponse\x12:\n" +
+	"\freview_queue\x18\x01 \x01(\v2\x17.session.v1.ReviewQueueR\vreviewQueue\"+\n" +
+	"\x19AcknowledgeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x1aAcknowledgeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9e\x03\n" +
+	"\x0eGetLogsRequest\x12&\n" +
+	"\fsearch_query\x18\x01 \x01(\tH\x00R\vsearchQuery\x88\x01\x01\x12\x19\n" +
+	"\x05level\x18\x02 \x01(\tH\x01R\x05level\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\aendTime\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x05 \x01(\x05H\x04R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x06 \x01(\x05H\x05R\x06offset\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tH\x06R\tsessionId\x88\x01\x01\x12\x16\n" +
+	"\x06levels\x18\b \x03(\tR\x06levelsB\x0f\n" +
+	"\r_search_queryB\b\n" +
+	"\x06_levelB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offsetB\r\n" +
+	"\v_session_id\"}\n" +
+	"\x0fGetLogsResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.session.v1.LogEntryR\aentries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x9c\x01\n" +
+	"\bLogEntry\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
+	"\x06source\x18\x04 \x01(\tH\x00R\x06source\x88\x01\x01B\t\n" +
+	"\a_source\"\x95\x02\n" +
+	"\x17WatchReviewQueueRequest\x12=\n" +
+	"\x0fpriority_filter\x18\x01 \x03(\x0e2\x14.session.v1.PriorityR\x0epriorityFilter\x12@\n" +
+	"\rreason_filter\x18\x02 \x03(\x0e2\x1b.session.v1.AttentionReasonR\freasonFilter\x12-\n" +
+	"\x12include_statistics\x18\x03 \x01(\bR\x11includeStatistics\x12)\n" +
+	"\x10initial_snapshot\x18\x04 \x01(\bR\x0finitialSnapshot\x12\x1f\n" +
+	"\vsession_ids\x18\x05 \x03(\tR\n" +
+	"sessionIds\"\xa6\x03\n" +
+	"\x19LogUserInteractionRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12[\n" +
+	"\x10interaction_type\x18\x02 \x01(\x0e20.session.v1.UserInteractionEvent.InteractionTypeR\x0finteractionType\x12\x1d\n" +
+	"\acontext\x18\x03 \x01(\tH\x01R\acontext\x88\x01\x01\x12,\n" +
+	"\x0fnotification_id\x18\x04 \x01(\tH\x02R\x0enotificationId\x88\x01\x01\x12O\n" +
+	"\bmetadata\x18\x05 \x03(\v23.session.v1.LogUserInteractionRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_session_idB\n" +
+	"\n" +
+	"\b_contextB\x12\n" +
+	"\x10_notification_id\"[\n" +
+	"\x1aLogUserInteractionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
+	"\x05error\x18\x02 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"4\n" +
+	"\x16GetClaudeConfigRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\"O\n" +
+	"\x17GetClaudeConfigResponse\x124\n" +
+	"\x06config\x18\x01 \x01(\v2\x1c.session.v1.ClaudeConfigFileR\x06config\"\x1a\n" +
+	"\x18ListClaudeConfigsRequest\"S\n" +
+	"\x19ListClaudeConfigsResponse\x126\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x1c.session.v1.ClaudeConfigFileR\aconfigs\"m\n" +
+	"\x19UpdateClaudeConfigRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1a\n" +
 	"\bvalidate\x18\x03 \x01(\bR\bvalidate\"R\n" +
@@ -11038,17 +14178,19 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x125\n" +
-	"\bmod_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\amodTime\"\xd0\x01\n" +
+	"\bmod_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\amodTime\"\xfe\x01\n" +
 	"\x18ListClaudeHistoryRequest\x12\x1d\n" +
 	"\aproject\x18\x01 \x01(\tH\x00R\aproject\x88\x01\x01\x12&\n" +
 	"\fsearch_query\x18\x02 \x01(\tH\x01R\vsearchQuery\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x05 \x01(\tR\tpageTokenB\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\bprovider\x18\x06 \x01(\tH\x02R\bprovider\x88\x01\x01B\n" +
 	"\n" +
 	"\b_projectB\x0f\n" +
-	"\r_search_query\"\x9e\x01\n" +
+	"\r_search_queryB\v\n" +
+	"\t_provider\"\x9e\x01\n" +
 	"\x19ListClaudeHistoryResponse\x128\n" +
 	"\aentries\x18\x01 \x03(\v2\x1e.session.v1.ClaudeHistoryEntryR\aentries\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
@@ -11057,7 +14199,7 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\x1dGetClaudeHistoryDetailRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
 	"\x1eGetClaudeHistoryDetailResponse\x124\n" +
-	"\x05entry\x18\x01 \x01(\v2\x1e.session.v1.ClaudeHistoryEntryR\x05entry\"\xb9\x02\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1e.session.v1.ClaudeHistoryEntryR\x05entry\"\xd5\x02\n" +
 	"\x12ClaudeHistoryEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -11069,7 +14211,8 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\x05model\x18\x06 \x01(\tR\x05model\x12#\n" +
 	"\rmessage_count\x18\a \x01(\x05R\fmessageCount\x124\n" +
 	"\n" +
-	"vcs_status\x18\b \x01(\v2\x15.session.v1.VCSStatusR\tvcsStatus\"s\n" +
+	"vcs_status\x18\b \x01(\v2\x15.session.v1.VCSStatusR\tvcsStatus\x12\x1a\n" +
+	"\bprovider\x18\t \x01(\tR\bprovider\"s\n" +
 	"\x1fGetClaudeHistoryMessagesRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
@@ -11083,7 +14226,7 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x128\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
-	"\x05model\x18\x04 \x01(\tR\x05model\"\xc8\x02\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\"\xe2\x02\n" +
 	"\x1aSearchClaudeHistoryRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
 	"\aproject\x18\x02 \x01(\tH\x00R\aproject\x88\x01\x01\x12\x19\n" +
@@ -11092,17 +14235,20 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tstartTime\x88\x01\x01\x12:\n" +
 	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\aendTime\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\a \x01(\x05R\x06offsetB\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offset\x12\x18\n" +
+	"\aexplain\x18\b \x01(\bR\aexplainB\n" +
 	"\n" +
 	"\b_projectB\b\n" +
 	"\x06_modelB\r\n" +
 	"\v_start_timeB\v\n" +
-	"\t_end_time\"\xb5\x01\n" +
+	"\t_end_time\"\xf2\x01\n" +
 	"\x1bSearchClaudeHistoryResponse\x122\n" +
 	"\aresults\x18\x01 \x03(\v2\x18.session.v1.SearchResultR\aresults\x12#\n" +
 	"\rtotal_matches\x18\x02 \x01(\x05R\ftotalMatches\x12\"\n" +
 	"\rquery_time_ms\x18\x03 \x01(\x03R\vqueryTimeMs\x12\x19\n" +
-	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\x9a\x02\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12!\n" +
+	"\fparsed_query\x18\x05 \x01(\tR\vparsedQuery\x12\x18\n" +
+	"\arelaxed\x18\x06 \x01(\bR\arelaxed\"\xda\x02\n" +
 	"\fSearchResult\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
@@ -11111,7 +14257,8 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\rmessage_index\x18\x04 \x01(\x05R\fmessageIndex\x12\x14\n" +
 	"\x05score\x18\x05 \x01(\x02R\x05score\x125\n" +
 	"\bsnippets\x18\x06 \x03(\v2\x19.session.v1.SearchSnippetR\bsnippets\x12<\n" +
-	"\bmetadata\x18\a \x01(\v2 .session.v1.SearchResultMetadataR\bmetadata\"\xcc\x01\n" +
+	"\bmetadata\x18\a \x01(\v2 .session.v1.SearchResultMetadataR\bmetadata\x12>\n" +
+	"\vexplanation\x18\b \x01(\v2\x1c.session.v1.ScoreExplanationR\vexplanation\"\xcc\x01\n" +
 	"\rSearchSnippet\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12E\n" +
 	"\x10highlight_ranges\x18\x02 \x03(\v2\x1a.session.v1.HighlightRangeR\x0fhighlightRanges\x12!\n" +
@@ -11119,13 +14266,14 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\fmessage_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vmessageTime\"8\n" +
 	"\x0eHighlightRange\x12\x14\n" +
 	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
-	"\x03end\x18\x02 \x01(\x05R\x03end\"\xb6\x01\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"\xd2\x01\n" +
 	"\x14SearchResultMetadata\x12*\n" +
 	"\x11is_metadata_match\x18\x01 \x01(\bR\x0fisMetadataMatch\x12!\n" +
 	"\fmatch_source\x18\x02 \x01(\tR\vmatchSource\x12\x14\n" +
 	"\x05model\x18\x03 \x01(\tR\x05model\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\"\"\n" +
 	"\x10GetPRInfoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
 	"\x11GetPRInfoResponse\x12+\n" +
@@ -11413,7 +14561,7 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\tPathEntry\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
-	"\fis_directory\x18\x03 \x01(\bR\visDirectory\"\xae\x03\n" +
+	"\fis_directory\x18\x03 \x01(\bR\visDirectory\"\xb2\x04\n" +
 	"\x14ProfileDefaultsProto\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
@@ -11425,7 +14573,10 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a:\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12H\n" +
+	"\x0fresource_limits\x18\n" +
+	" \x01(\v2\x1f.session.v1.ResourceLimitsProtoR\x0eresourceLimits\x128\n" +
+	"\asandbox\x18\v \x01(\v2\x1e.session.v1.SandboxConfigProtoR\asandbox\x1a:\n" +
 	"\fEnvVarsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x82\x01\n" +
@@ -11520,7 +14671,7 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\aentries\x18\x01 \x03(\v2\x1e.session.v1.PromptHistoryEntryR\aentries\",\n" +
 	"\x1aDeletePromptHistoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
-	"\x1bDeletePromptHistoryResponse\"\xdf\x02\n" +
+	"\x1bDeletePromptHistoryResponse\"\xa8\x04\n" +
 	"\x13BatchSessionRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1f\n" +
@@ -11535,7 +14686,12 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\n" +
 	"project_id\x18\n" +
 	" \x01(\tR\tprojectId\x12\x12\n" +
-	"\x04tags\x18\v \x03(\tR\x04tags\"x\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x1a\n" +
+	"\btemplate\x18\f \x01(\tR\btemplate\x12e\n" +
+	"\x12template_variables\x18\r \x03(\v26.session.v1.BatchSessionRequest.TemplateVariablesEntryR\x11templateVariables\x1aD\n" +
+	"\x16TemplateVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"x\n" +
 	"\x11BatchCreateResult\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
 	"\n" +
@@ -11715,7 +14871,269 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\x0ftotal_sequences\x18\x02 \x01(\x03R\x0etotalSequences\x12#\n" +
 	"\rtotal_mangled\x18\x03 \x01(\x03R\ftotalMangled\x12\x1f\n" +
 	"\vmangle_rate\x18\x04 \x01(\x01R\n" +
-	"mangleRate2\xb6<\n" +
+	"mangleRate\"\xb8\x01\n" +
+	"\x13ResourceLimitsProto\x12\"\n" +
+	"\rmemory_max_mb\x18\x01 \x01(\x03R\vmemoryMaxMb\x12$\n" +
+	"\x0ememory_high_mb\x18\x02 \x01(\x03R\fmemoryHighMb\x12\x1f\n" +
+	"\vcpu_percent\x18\x03 \x01(\x05R\n" +
+	"cpuPercent\x12\x19\n" +
+	"\bpids_max\x18\x04 \x01(\x03R\apidsMax\x12\x1b\n" +
+	"\tio_weight\x18\x05 \x01(\x05R\bioWeight\"\x94\x01\n" +
+	"\x12SandboxConfigProto\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\anetwork\x18\x02 \x01(\tR\anetwork\x12#\n" +
+	"\rallowed_hosts\x18\x03 \x03(\tR\fallowedHosts\x12%\n" +
+	"\x0ewritable_paths\x18\x04 \x03(\tR\rwritablePaths\"o\n" +
+	"\x19GetSessionTimelineRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"since_turn\x18\x02 \x01(\x05R\tsinceTurn\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x98\x01\n" +
+	"\x1aGetSessionTimelineResponse\x12,\n" +
+	"\x05turns\x18\x01 \x03(\v2\x16.session.v1.TurnDigestR\x05turns\x12\x1f\n" +
+	"\vtotal_turns\x18\x02 \x01(\x05R\n" +
+	"totalTurns\x12+\n" +
+	"\x11conversation_path\x18\x03 \x01(\tR\x10conversationPath\"\xea\x02\n" +
+	"\n" +
+	"TurnDigest\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12\x16\n" +
+	"\x06prompt\x18\x04 \x01(\tR\x06prompt\x12/\n" +
+	"\x05tools\x18\x05 \x03(\v2\x19.session.v1.TurnToolCountR\x05tools\x12#\n" +
+	"\rfiles_touched\x18\x06 \x03(\tR\ffilesTouched\x12\x1a\n" +
+	"\bcommands\x18\a \x03(\tR\bcommands\x12\x16\n" +
+	"\x06errors\x18\b \x03(\tR\x06errors\x12\x18\n" +
+	"\aoutcome\x18\t \x01(\tR\aoutcome\x12\x18\n" +
+	"\asummary\x18\n" +
+	" \x01(\tR\asummary\"9\n" +
+	"\rTurnToolCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x89\x01\n" +
+	"\x10ScoreExplanation\x12\x1f\n" +
+	"\vtotal_score\x18\x01 \x01(\x01R\n" +
+	"totalScore\x12\x0e\n" +
+	"\x02k1\x18\x02 \x01(\x01R\x02k1\x12\f\n" +
+	"\x01b\x18\x03 \x01(\x01R\x01b\x126\n" +
+	"\x05terms\x18\x04 \x03(\v2 .session.v1.TermScoreExplanationR\x05terms\"\xb1\x02\n" +
+	"\x14TermScoreExplanation\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x16\n" +
+	"\x06clause\x18\x02 \x01(\tR\x06clause\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12%\n" +
+	"\x0eterm_frequency\x18\x04 \x01(\x01R\rtermFrequency\x12-\n" +
+	"\x12document_frequency\x18\x05 \x01(\x05R\x11documentFrequency\x12\x10\n" +
+	"\x03idf\x18\x06 \x01(\x01R\x03idf\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12'\n" +
+	"\x0fdocument_length\x18\b \x01(\x05R\x0edocumentLength\x12.\n" +
+	"\x13avg_document_length\x18\t \x01(\x01R\x11avgDocumentLength\"z\n" +
+	"\x1aSetSessionRecordingRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12#\n" +
+	"\rinclude_input\x18\x03 \x01(\bR\fincludeInput\"_\n" +
+	"\x1bSetSessionRecordingResponse\x12@\n" +
+	"\bsettings\x18\x01 \x01(\v2$.session.v1.SessionRecordingSettingsR\bsettings\"\xac\x01\n" +
+	"\x18SessionRecordingSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rinclude_input\x18\x02 \x01(\bR\fincludeInput\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\"\xd2\x01\n" +
+	"\x16ExportRecordingRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12'\n" +
+	"\x0ffrom_checkpoint\x18\x02 \x01(\tR\x0efromCheckpoint\x12#\n" +
+	"\rto_checkpoint\x18\x03 \x01(\tR\ftoCheckpoint\x12#\n" +
+	"\rinclude_input\x18\x04 \x01(\bR\fincludeInput\x12&\n" +
+	"\x0fidle_time_limit\x18\x05 \x01(\x01R\ridleTimeLimit\"y\n" +
+	"\x17ExportRecordingResponse\x12\x12\n" +
+	"\x04cast\x18\x01 \x01(\fR\x04cast\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x05R\n" +
+	"eventCount\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x01R\x0fdurationSeconds\"d\n" +
+	"\x17SendSessionInputRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x16\n" +
+	"\x06submit\x18\x03 \x01(\bR\x06submit\"?\n" +
+	"\x18SendSessionInputResponse\x12#\n" +
+	"\rbytes_written\x18\x01 \x01(\x05R\fbytesWritten\"\xa8\x05\n" +
+	"\x0fSessionSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06prompt\x18\a \x01(\tR\x06prompt\x12\x18\n" +
+	"\aprofile\x18\b \x01(\tR\aprofile\x12\x1b\n" +
+	"\trepo_path\x18\t \x01(\tR\brepoPath\x12#\n" +
+	"\rbranch_prefix\x18\n" +
+	" \x01(\tR\fbranchPrefix\x12\x18\n" +
+	"\aprogram\x18\v \x01(\tR\aprogram\x12\x1a\n" +
+	"\bcategory\x18\f \x01(\tR\bcategory\x12%\n" +
+	"\x0etarget_session\x18\r \x01(\tR\rtargetSession\x12'\n" +
+	"\x0ftimeout_seconds\x18\x0e \x01(\x05R\x0etimeoutSeconds\x12\x18\n" +
+	"\aoverlap\x18\x0f \x01(\tR\aoverlap\x12\x19\n" +
+	"\bcatch_up\x18\x10 \x01(\tR\acatchUp\x129\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\vnext_run_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x122\n" +
+	"\blast_run\x18\x14 \x01(\v2\x17.session.v1.ScheduleRunR\alastRun\"\xcd\x03\n" +
+	"\vScheduleRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12?\n" +
+	"\rscheduled_for\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\b \x01(\tR\tsessionId\x12!\n" +
+	"\fsession_uuid\x18\t \x01(\tR\vsessionUuid\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1b\n" +
+	"\texit_code\x18\v \x01(\x05R\bexitCode\x12\x15\n" +
+	"\x06pr_url\x18\f \x01(\tR\x05prUrl\x12\x16\n" +
+	"\x06output\x18\r \x01(\tR\x06output\"\x16\n" +
+	"\x14ListSchedulesRequest\"R\n" +
+	"\x15ListSchedulesResponse\x129\n" +
+	"\tschedules\x18\x01 \x03(\v2\x1b.session.v1.SessionScheduleR\tschedules\"P\n" +
+	"\x15CreateScheduleRequest\x127\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1b.session.v1.SessionScheduleR\bschedule\"Q\n" +
+	"\x16CreateScheduleResponse\x127\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1b.session.v1.SessionScheduleR\bschedule\"\xd7\x05\n" +
+	"\x15UpdateScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04cron\x18\x03 \x01(\tH\x01R\x04cron\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\x04 \x01(\tH\x02R\btimezone\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x03R\aenabled\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x06 \x01(\tH\x04R\x04kind\x88\x01\x01\x12\x1b\n" +
+	"\x06prompt\x18\a \x01(\tH\x05R\x06prompt\x88\x01\x01\x12\x1d\n" +
+	"\aprofile\x18\b \x01(\tH\x06R\aprofile\x88\x01\x01\x12 \n" +
+	"\trepo_path\x18\t \x01(\tH\aR\brepoPath\x88\x01\x01\x12(\n" +
+	"\rbranch_prefix\x18\n" +
+	" \x01(\tH\bR\fbranchPrefix\x88\x01\x01\x12\x1d\n" +
+	"\aprogram\x18\v \x01(\tH\tR\aprogram\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\f \x01(\tH\n" +
+	"R\bcategory\x88\x01\x01\x12*\n" +
+	"\x0etarget_session\x18\r \x01(\tH\vR\rtargetSession\x88\x01\x01\x12,\n" +
+	"\x0ftimeout_seconds\x18\x0e \x01(\x05H\fR\x0etimeoutSeconds\x88\x01\x01\x12\x1d\n" +
+	"\aoverlap\x18\x0f \x01(\tH\rR\aoverlap\x88\x01\x01\x12\x1e\n" +
+	"\bcatch_up\x18\x10 \x01(\tH\x0eR\acatchUp\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_cronB\v\n" +
+	"\t_timezoneB\n" +
+	"\n" +
+	"\b_enabledB\a\n" +
+	"\x05_kindB\t\n" +
+	"\a_promptB\n" +
+	"\n" +
+	"\b_profileB\f\n" +
+	"\n" +
+	"_repo_pathB\x10\n" +
+	"\x0e_branch_prefixB\n" +
+	"\n" +
+	"\b_programB\v\n" +
+	"\t_categoryB\x11\n" +
+	"\x0f_target_sessionB\x12\n" +
+	"\x10_timeout_secondsB\n" +
+	"\n" +
+	"\b_overlapB\v\n" +
+	"\t_catch_up\"Q\n" +
+	"\x16UpdateScheduleResponse\x127\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1b.session.v1.SessionScheduleR\bschedule\"'\n" +
+	"\x15DeleteScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteScheduleResponse\"(\n" +
+	"\x16TriggerScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x17TriggerScheduleResponse\x12)\n" +
+	"\x03run\x18\x01 \x01(\v2\x17.session.v1.ScheduleRunR\x03run\"P\n" +
+	"\x17ListScheduleRunsRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"G\n" +
+	"\x18ListScheduleRunsResponse\x12+\n" +
+	"\x04runs\x18\x01 \x03(\v2\x17.session.v1.ScheduleRunR\x04runs\"\xb7\x01\n" +
+	"\x10TemplateVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12#\n" +
+	"\rdefault_value\x18\x05 \x01(\tR\fdefaultValue\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\"\xa9\x04\n" +
+	"\x0fSessionTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aprogram\x18\x04 \x01(\tR\aprogram\x12\x18\n" +
+	"\aprofile\x18\x05 \x01(\tR\aprofile\x12!\n" +
+	"\fsession_type\x18\x06 \x01(\tR\vsessionType\x12%\n" +
+	"\x0ebranch_pattern\x18\a \x01(\tR\rbranchPattern\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x16\n" +
+	"\x06prompt\x18\t \x01(\tR\x06prompt\x12:\n" +
+	"\tvariables\x18\n" +
+	" \x03(\v2\x1c.session.v1.TemplateVariableR\tvariables\x12!\n" +
+	"\fsetup_script\x18\v \x01(\tR\vsetupScript\x12/\n" +
+	"\x13acceptance_criteria\x18\f \x03(\tR\x12acceptanceCriteria\x12\x16\n" +
+	"\x06source\x18\r \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"3\n" +
+	"\x14ListTemplatesRequest\x12\x1b\n" +
+	"\trepo_path\x18\x01 \x01(\tR\brepoPath\"n\n" +
+	"\x15ListTemplatesResponse\x129\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1b.session.v1.SessionTemplateR\ttemplates\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"_\n" +
+	"\x12GetTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1b\n" +
+	"\trepo_path\x18\x03 \x01(\tR\brepoPath\"j\n" +
+	"\x13GetTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.session.v1.SessionTemplateR\btemplate\x12\x1a\n" +
+	"\bversions\x18\x02 \x03(\x05R\bversions\"\x83\x01\n" +
+	"\x13SaveTemplateRequest\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.session.v1.SessionTemplateR\btemplate\x12\x16\n" +
+	"\x06shared\x18\x02 \x01(\bR\x06shared\x12\x1b\n" +
+	"\trepo_path\x18\x03 \x01(\tR\brepoPath\"O\n" +
+	"\x14SaveTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.session.v1.SessionTemplateR\btemplate\"+\n" +
+	"\x15DeleteTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x18\n" +
+	"\x16DeleteTemplateResponse\"\xb5\x03\n" +
+	" CreateSessionFromTemplateRequest\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12\x1b\n" +
+	"\trepo_path\x18\x02 \x01(\tR\brepoPath\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12Y\n" +
+	"\tvariables\x18\x04 \x03(\v2;.session.v1.CreateSessionFromTemplateRequest.VariablesEntryR\tvariables\x12\x16\n" +
+	"\x06branch\x18\x05 \x01(\tR\x06branch\x12\x18\n" +
+	"\aprogram\x18\x06 \x01(\tR\aprogram\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x19\n" +
+	"\bauto_yes\x18\t \x01(\bR\aautoYes\x12,\n" +
+	"\x12trust_setup_script\x18\n" +
+	" \x01(\bR\x10trustSetupScript\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xae\x01\n" +
+	"!CreateSessionFromTemplateResponse\x12-\n" +
+	"\asession\x18\x01 \x01(\v2\x13.session.v1.SessionR\asession\x127\n" +
+	"\btemplate\x18\x02 \x01(\v2\x1b.session.v1.SessionTemplateR\btemplate\x12!\n" +
+	"\fsetup_output\x18\x03 \x01(\tR\vsetupOutput\"(\n" +
+	"\x16SendCIFixPromptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x17SendCIFixPromptResponse\x12\x16\n" +
+	"\x06prompt\x18\x01 \x01(\tR\x06prompt2\xa2H\n" +
 	"\x0eSessionService\x12S\n" +
 	"\fListSessions\x12\x1f.session.v1.ListSessionsRequest\x1a .session.v1.ListSessionsResponse\"\x00\x12M\n" +
 	"\n" +
@@ -11743,7 +15161,8 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\rGetPRComments\x12 .session.v1.GetPRCommentsRequest\x1a!.session.v1.GetPRCommentsResponse\"\x00\x12V\n" +
 	"\rPostPRComment\x12 .session.v1.PostPRCommentRequest\x1a!.session.v1.PostPRCommentResponse\"\x00\x12D\n" +
 	"\aMergePR\x12\x1a.session.v1.MergePRRequest\x1a\x1b.session.v1.MergePRResponse\"\x00\x12D\n" +
-	"\aClosePR\x12\x1a.session.v1.ClosePRRequest\x1a\x1b.session.v1.ClosePRResponse\"\x00\x12_\n" +
+	"\aClosePR\x12\x1a.session.v1.ClosePRRequest\x1a\x1b.session.v1.ClosePRResponse\"\x00\x12\\\n" +
+	"\x0fSendCIFixPrompt\x12\".session.v1.SendCIFixPromptRequest\x1a#.session.v1.SendCIFixPromptResponse\"\x00\x12_\n" +
 	"\x10SendNotification\x12#.session.v1.SendNotificationRequest\x1a$.session.v1.SendNotificationResponse\"\x00\x12P\n" +
 	"\vFocusWindow\x12\x1e.session.v1.FocusWindowRequest\x1a\x1f.session.v1.FocusWindowResponse\"\x00\x12V\n" +
 	"\rRenameSession\x12 .session.v1.RenameSessionRequest\x1a!.session.v1.RenameSessionResponse\"\x00\x12Y\n" +
@@ -11800,7 +15219,22 @@ const file_session_v1_session_proto_rawDesc = "" +
 	"\x0fGetFeatureFlags\x12\".session.v1.GetFeatureFlagsRequest\x1a#.session.v1.GetFeatureFlagsResponse\"\x00\x12b\n" +
 	"\x11UpdateFeatureFlag\x12$.session.v1.UpdateFeatureFlagRequest\x1a%.session.v1.UpdateFeatureFlagResponse\"\x00\x12k\n" +
 	"\x14QueryEscapeAnalytics\x12'.session.v1.QueryEscapeAnalyticsRequest\x1a(.session.v1.QueryEscapeAnalyticsResponse\"\x00\x12z\n" +
-	"\x19GetEscapeAnalyticsSummary\x12,.session.v1.GetEscapeAnalyticsSummaryRequest\x1a-.session.v1.GetEscapeAnalyticsSummaryResponse\"\x00B\xac\x01\n" +
+	"\x19GetEscapeAnalyticsSummary\x12,.session.v1.GetEscapeAnalyticsSummaryRequest\x1a-.session.v1.GetEscapeAnalyticsSummaryResponse\"\x00\x12e\n" +
+	"\x12GetSessionTimeline\x12%.session.v1.GetSessionTimelineRequest\x1a&.session.v1.GetSessionTimelineResponse\"\x00\x12h\n" +
+	"\x13SetSessionRecording\x12&.session.v1.SetSessionRecordingRequest\x1a'.session.v1.SetSessionRecordingResponse\"\x00\x12\\\n" +
+	"\x0fExportRecording\x12\".session.v1.ExportRecordingRequest\x1a#.session.v1.ExportRecordingResponse\"\x00\x12_\n" +
+	"\x10SendSessionInput\x12#.session.v1.SendSessionInputRequest\x1a$.session.v1.SendSessionInputResponse\"\x00\x12V\n" +
+	"\rListSchedules\x12 .session.v1.ListSchedulesRequest\x1a!.session.v1.ListSchedulesResponse\"\x00\x12Y\n" +
+	"\x0eCreateSchedule\x12!.session.v1.CreateScheduleRequest\x1a\".session.v1.CreateScheduleResponse\"\x00\x12Y\n" +
+	"\x0eUpdateSchedule\x12!.session.v1.UpdateScheduleRequest\x1a\".session.v1.UpdateScheduleResponse\"\x00\x12Y\n" +
+	"\x0eDeleteSchedule\x12!.session.v1.DeleteScheduleRequest\x1a\".session.v1.DeleteScheduleResponse\"\x00\x12\\\n" +
+	"\x0fTriggerSchedule\x12\".session.v1.TriggerScheduleRequest\x1a#.session.v1.TriggerScheduleResponse\"\x00\x12_\n" +
+	"\x10ListScheduleRuns\x12#.session.v1.ListScheduleRunsRequest\x1a$.session.v1.ListScheduleRunsResponse\"\x00\x12V\n" +
+	"\rListTemplates\x12 .session.v1.ListTemplatesRequest\x1a!.session.v1.ListTemplatesResponse\"\x00\x12P\n" +
+	"\vGetTemplate\x12\x1e.session.v1.GetTemplateRequest\x1a\x1f.session.v1.GetTemplateResponse\"\x00\x12S\n" +
+	"\fSaveTemplate\x12\x1f.session.v1.SaveTemplateRequest\x1a .session.v1.SaveTemplateResponse\"\x00\x12Y\n" +
+	"\x0eDeleteTemplate\x12!.session.v1.DeleteTemplateRequest\x1a\".session.v1.DeleteTemplateResponse\"\x00\x12z\n" +
+	"\x19CreateSessionFromTemplate\x12,.session.v1.CreateSessionFromTemplateRequest\x1a-.session.v1.CreateSessionFromTemplateResponse\"\x00B\xac\x01\n" +
 	"\x0ecom.session.v1B\fSessionProtoP\x01ZCgithub.com/tstapler/stapler-squad/gen/proto/go/session/v1;sessionv1\xa2\x02\x03SXX\xaa\x02\n" +
 	"Session.V1\xca\x02\n" +
 	"Session\\V1\xe2\x02\x16Session\\V1\\GPBMetadata\xea\x02\vSession::V1b\x06proto3"
@@ -11817,7 +15251,7 @@ func file_session_v1_session_proto_rawDescGZIP() []byte {
 	return file_session_v1_session_proto_rawDescData
 }
 
-var file_session_v1_session_proto_msgTypes = make([]protoimpl.MessageInfo, 189)
+var file_session_v1_session_proto_msgTypes = make([]protoimpl.MessageInfo, 234)
 var file_session_v1_session_proto_goTypes = []any{
 	(*ListSessionsRequest)(nil),               // 0: session.v1.ListSessionsRequest
 	(*ListSessionsResponse)(nil),              // 1: session.v1.ListSessionsResponse
@@ -12000,328 +15434,439 @@ var file_session_v1_session_proto_goTypes = []any{
 	(*EscapeSequenceCount)(nil),               // 178: session.v1.EscapeSequenceCount
 	(*GetEscapeAnalyticsSummaryRequest)(nil),  // 179: session.v1.GetEscapeAnalyticsSummaryRequest
 	(*GetEscapeAnalyticsSummaryResponse)(nil), // 180: session.v1.GetEscapeAnalyticsSummaryResponse
-	nil,                           // 181: session.v1.LogUserInteractionRequest.MetadataEntry
-	nil,                           // 182: session.v1.SendNotificationRequest.MetadataEntry
-	nil,                           // 183: session.v1.NotificationHistoryRecord.MetadataEntry
-	nil,                           // 184: session.v1.ProfileDefaultsProto.EnvVarsEntry
-	nil,                           // 185: session.v1.SessionDefaultsConfig.EnvVarsEntry
-	nil,                           // 186: session.v1.SessionDefaultsConfig.ProfilesEntry
-	nil,                           // 187: session.v1.ResolveDefaultsResponse.EnvVarsEntry
-	nil,                           // 188: session.v1.UpdateGlobalDefaultsRequest.EnvVarsEntry
-	(SessionStatus)(0),            // 189: session.v1.SessionStatus
-	(*Session)(nil),               // 190: session.v1.Session
-	(SessionType)(0),              // 191: session.v1.SessionType
-	(*DiffStats)(nil),             // 192: session.v1.DiffStats
-	(*VCSStatus)(nil),             // 193: session.v1.VCSStatus
-	(Priority)(0),                 // 194: session.v1.Priority
-	(AttentionReason)(0),          // 195: session.v1.AttentionReason
-	(*ReviewQueue)(nil),           // 196: session.v1.ReviewQueue
-	(*timestamppb.Timestamp)(nil), // 197: google.protobuf.Timestamp
-	(UserInteractionEvent_InteractionType)(0), // 198: session.v1.UserInteractionEvent.InteractionType
-	(*PRInfo)(nil),                    // 199: session.v1.PRInfo
-	(*PRComment)(nil),                 // 200: session.v1.PRComment
-	(NotificationType)(0),             // 201: session.v1.NotificationType
-	(NotificationPriority)(0),         // 202: session.v1.NotificationPriority
-	(*VCSInfo)(nil),                   // 203: session.v1.VCSInfo
-	(*AvailableWorkspaceTargets)(nil), // 204: session.v1.AvailableWorkspaceTargets
-	(WorkspaceSwitchType)(0),          // 205: session.v1.WorkspaceSwitchType
-	(ChangeStrategy)(0),               // 206: session.v1.ChangeStrategy
-	(*PendingApprovalProto)(nil),      // 207: session.v1.PendingApprovalProto
-	(VCSType)(0),                      // 208: session.v1.VCSType
-	(*ApprovalRuleProto)(nil),         // 209: session.v1.ApprovalRuleProto
-	(*AnalyticsSummaryProto)(nil),     // 210: session.v1.AnalyticsSummaryProto
-	(*DailyBucketProto)(nil),          // 211: session.v1.DailyBucketProto
-	(*DatabaseInfo)(nil),              // 212: session.v1.DatabaseInfo
-	(*CheckpointProto)(nil),           // 213: session.v1.CheckpointProto
-	(*FileNode)(nil),                  // 214: session.v1.FileNode
-	(*TerminalData)(nil),              // 215: session.v1.TerminalData
-	(*SessionEvent)(nil),              // 216: session.v1.SessionEvent
-	(*ReviewQueueEvent)(nil),          // 217: session.v1.ReviewQueueEvent
+	(*ResourceLimitsProto)(nil),               // 181: session.v1.ResourceLimitsProto
+	(*SandboxConfigProto)(nil),                // 182: session.v1.SandboxConfigProto
+	(*GetSessionTimelineRequest)(nil),         // 183: session.v1.GetSessionTimelineRequest
+	(*GetSessionTimelineResponse)(nil),        // 184: session.v1.GetSessionTimelineResponse
+	(*TurnDigest)(nil),                        // 185: session.v1.TurnDigest
+	(*TurnToolCount)(nil),                     // 186: session.v1.TurnToolCount
+	(*ScoreExplanation)(nil),                  // 187: session.v1.ScoreExplanation
+	(*TermScoreExplanation)(nil),              // 188: session.v1.TermScoreExplanation
+	(*SetSessionRecordingRequest)(nil),        // 189: session.v1.SetSessionRecordingRequest
+	(*SetSessionRecordingResponse)(nil),       // 190: session.v1.SetSessionRecordingResponse
+	(*SessionRecordingSettings)(nil),          // 191: session.v1.SessionRecordingSettings
+	(*ExportRecordingRequest)(nil),            // 192: session.v1.ExportRecordingRequest
+	(*ExportRecordingResponse)(nil),           // 193: session.v1.ExportRecordingResponse
+	(*SendSessionInputRequest)(nil),           // 194: session.v1.SendSessionInputRequest
+	(*SendSessionInputResponse)(nil),          // 195: session.v1.SendSessionInputResponse
+	(*SessionSchedule)(nil),                   // 196: session.v1.SessionSchedule
+	(*ScheduleRun)(nil),                       // 197: session.v1.ScheduleRun
+	(*ListSchedulesRequest)(nil),              // 198: session.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),             // 199: session.v1.ListSchedulesResponse
+	(*CreateScheduleRequest)(nil),             // 200: session.v1.CreateScheduleRequest
+	(*CreateScheduleResponse)(nil),            // 201: session.v1.CreateScheduleResponse
+	(*UpdateScheduleRequest)(nil),             // 202: session.v1.UpdateScheduleRequest
+	(*UpdateScheduleResponse)(nil),            // 203: session.v1.UpdateScheduleResponse
+	(*DeleteScheduleRequest)(nil),             // 204: session.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),            // 205: session.v1.DeleteScheduleResponse
+	(*TriggerScheduleRequest)(nil),            // 206: session.v1.TriggerScheduleRequest
+	(*TriggerScheduleResponse)(nil),           // 207: session.v1.TriggerScheduleResponse
+	(*ListScheduleRunsRequest)(nil),           // 208: session.v1.ListScheduleRunsRequest
+	(*ListScheduleRunsResponse)(nil),          // 209: session.v1.ListScheduleRunsResponse
+	(*TemplateVariable)(nil),                  // 210: session.v1.TemplateVariable
+	(*SessionTemplate)(nil),                   // 211: session.v1.SessionTemplate
+	(*ListTemplatesRequest)(nil),              // 212: session.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),             // 213: session.v1.ListTemplatesResponse
+	(*GetTemplateRequest)(nil),                // 214: session.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),               // 215: session.v1.GetTemplateResponse
+	(*SaveTemplateRequest)(nil),               // 216: session.v1.SaveTemplateRequest
+	(*SaveTemplateResponse)(nil),              // 217: session.v1.SaveTemplateResponse
+	(*DeleteTemplateRequest)(nil),             // 218: session.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),            // 219: session.v1.DeleteTemplateResponse
+	(*CreateSessionFromTemplateRequest)(nil),  // 220: session.v1.CreateSessionFromTemplateRequest
+	(*CreateSessionFromTemplateResponse)(nil), // 221: session.v1.CreateSessionFromTemplateResponse
+	(*SendCIFixPromptRequest)(nil),            // 222: session.v1.SendCIFixPromptRequest
+	(*SendCIFixPromptResponse)(nil),           // 223: session.v1.SendCIFixPromptResponse
+	nil,                                       // 224: session.v1.LogUserInteractionRequest.MetadataEntry
+	nil,                                       // 225: session.v1.SendNotificationRequest.MetadataEntry
+	nil,                                       // 226: session.v1.NotificationHistoryRecord.MetadataEntry
+	nil,                                       // 227: session.v1.ProfileDefaultsProto.EnvVarsEntry
+	nil,                                       // 228: session.v1.SessionDefaultsConfig.EnvVarsEntry
+	nil,                                       // 229: session.v1.SessionDefaultsConfig.ProfilesEntry
+	nil,                                       // 230: session.v1.ResolveDefaultsResponse.EnvVarsEntry
+	nil,                                       // 231: session.v1.UpdateGlobalDefaultsRequest.EnvVarsEntry
+	nil,                                       // 232: session.v1.BatchSessionRequest.TemplateVariablesEntry
+	nil,                                       // 233: session.v1.CreateSessionFromTemplateRequest.VariablesEntry
+	(SessionStatus)(0),                        // 234: session.v1.SessionStatus
+	(*Session)(nil),                           // 235: session.v1.Session
+	(SessionType)(0),                          // 236: session.v1.SessionType
+	(*DiffStats)(nil),                         // 237: session.v1.DiffStats
+	(*VCSStatus)(nil),                         // 238: session.v1.VCSStatus
+	(Priority)(0),                             // 239: session.v1.Priority
+	(AttentionReason)(0),                      // 240: session.v1.AttentionReason
+	(*ReviewQueue)(nil),                       // 241: session.v1.ReviewQueue
+	(*timestamppb.Timestamp)(nil),             // 242: google.protobuf.Timestamp
+	(UserInteractionEvent_InteractionType)(0), // 243: session.v1.UserInteractionEvent.InteractionType
+	(*PRInfo)(nil),                            // 244: session.v1.PRInfo
+	(*PRComment)(nil),                         // 245: session.v1.PRComment
+	(NotificationType)(0),                     // 246: session.v1.NotificationType
+	(NotificationPriority)(0),                 // 247: session.v1.NotificationPriority
+	(*VCSInfo)(nil),                           // 248: session.v1.VCSInfo
+	(*AvailableWorkspaceTargets)(nil),         // 249: session.v1.AvailableWorkspaceTargets
+	(WorkspaceSwitchType)(0),                  // 250: session.v1.WorkspaceSwitchType
+	(ChangeStrategy)(0),                       // 251: session.v1.ChangeStrategy
+	(*PendingApprovalProto)(nil),              // 252: session.v1.PendingApprovalProto
+	(VCSType)(0),                              // 253: session.v1.VCSType
+	(*ApprovalRuleProto)(nil),                 // 254: session.v1.ApprovalRuleProto
+	(*AnalyticsSummaryProto)(nil),             // 255: session.v1.AnalyticsSummaryProto
+	(*DailyBucketProto)(nil),                  // 256: session.v1.DailyBucketProto
+	(*DatabaseInfo)(nil),                      // 257: session.v1.DatabaseInfo
+	(*CheckpointProto)(nil),                   // 258: session.v1.CheckpointProto
+	(*FileNode)(nil),                          // 259: session.v1.FileNode
+	(*TerminalData)(nil),                      // 260: session.v1.TerminalData
+	(*SessionEvent)(nil),                      // 261: session.v1.SessionEvent
+	(*ReviewQueueEvent)(nil),                  // 262: session.v1.ReviewQueueEvent
 }
 var file_session_v1_session_proto_depIdxs = []int32{
-	189, // 0: session.v1.ListSessionsRequest.status:type_name -> session.v1.SessionStatus
-	190, // 1: session.v1.ListSessionsResponse.sessions:type_name -> session.v1.Session
-	190, // 2: session.v1.GetSessionResponse.session:type_name -> session.v1.Session
-	191, // 3: session.v1.CreateSessionRequest.session_type:type_name -> session.v1.SessionType
-	190, // 4: session.v1.CreateSessionResponse.session:type_name -> session.v1.Session
-	189, // 5: session.v1.UpdateSessionRequest.status:type_name -> session.v1.SessionStatus
-	190, // 6: session.v1.UpdateSessionResponse.session:type_name -> session.v1.Session
-	189, // 7: session.v1.WatchSessionsRequest.status_filter:type_name -> session.v1.SessionStatus
-	192, // 8: session.v1.GetSessionDiffResponse.diff_stats:type_name -> session.v1.DiffStats
-	193, // 9: session.v1.GetVCSStatusResponse.vcs_status:type_name -> session.v1.VCSStatus
-	194, // 10: session.v1.GetReviewQueueRequest.priority_filter:type_name -> session.v1.Priority
-	195, // 11: session.v1.GetReviewQueueRequest.reason_filter:type_name -> session.v1.AttentionReason
-	196, // 12: session.v1.GetReviewQueueResponse.review_queue:type_name -> session.v1.ReviewQueue
-	197, // 13: session.v1.GetLogsRequest.start_time:type_name -> google.protobuf.Timestamp
-	197, // 14: session.v1.GetLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	234, // 0: session.v1.ListSessionsRequest.status:type_name -> session.v1.SessionStatus
+	235, // 1: session.v1.ListSessionsResponse.sessions:type_name -> session.v1.Session
+	235, // 2: session.v1.GetSessionResponse.session:type_name -> session.v1.Session
+	236, // 3: session.v1.CreateSessionRequest.session_type:type_name -> session.v1.SessionType
+	235, // 4: session.v1.CreateSessionResponse.session:type_name -> session.v1.Session
+	234, // 5: session.v1.UpdateSessionRequest.status:type_name -> session.v1.SessionStatus
+	235, // 6: session.v1.UpdateSessionResponse.session:type_name -> session.v1.Session
+	234, // 7: session.v1.WatchSessionsRequest.status_filter:type_name -> session.v1.SessionStatus
+	237, // 8: session.v1.GetSessionDiffResponse.diff_stats:type_name -> session.v1.DiffStats
+	238, // 9: session.v1.GetVCSStatusResponse.vcs_status:type_name -> session.v1.VCSStatus
+	239, // 10: session.v1.GetReviewQueueRequest.priority_filter:type_name -> session.v1.Priority
+	240, // 11: session.v1.GetReviewQueueRequest.reason_filter:type_name -> session.v1.AttentionReason
+	241, // 12: session.v1.GetReviewQueueResponse.review_queue:type_name -> session.v1.ReviewQueue
+	242, // 13: session.v1.GetLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	242, // 14: session.v1.GetLogsRequest.end_time:type_name -> google.protobuf.Timestamp
 	21,  // 15: session.v1.GetLogsResponse.entries:type_name -> session.v1.LogEntry
-	197, // 16: session.v1.LogEntry.timestamp:type_name -> google.protobuf.Timestamp
-	194, // 17: session.v1.WatchReviewQueueRequest.priority_filter:type_name -> session.v1.Priority
-	195, // 18: session.v1.WatchReviewQueueRequest.reason_filter:type_name -> session.v1.AttentionReason
-	198, // 19: session.v1.LogUserInteractionRequest.interaction_type:type_name -> session.v1.UserInteractionEvent.InteractionType
-	181, // 20: session.v1.LogUserInteractionRequest.metadata:type_name -> session.v1.LogUserInteractionRequest.MetadataEntry
+	242, // 16: session.v1.LogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	239, // 17: session.v1.WatchReviewQueueRequest.priority_filter:type_name -> session.v1.Priority
+	240, // 18: session.v1.WatchReviewQueueRequest.reason_filter:type_name -> session.v1.AttentionReason
+	243, // 19: session.v1.LogUserInteractionRequest.interaction_type:type_name -> session.v1.UserInteractionEvent.InteractionType
+	224, // 20: session.v1.LogUserInteractionRequest.metadata:type_name -> session.v1.LogUserInteractionRequest.MetadataEntry
 	31,  // 21: session.v1.GetClaudeConfigResponse.config:type_name -> session.v1.ClaudeConfigFile
 	31,  // 22: session.v1.ListClaudeConfigsResponse.configs:type_name -> session.v1.ClaudeConfigFile
 	31,  // 23: session.v1.UpdateClaudeConfigResponse.config:type_name -> session.v1.ClaudeConfigFile
-	197, // 24: session.v1.ClaudeConfigFile.mod_time:type_name -> google.protobuf.Timestamp
+	242, // 24: session.v1.ClaudeConfigFile.mod_time:type_name -> google.protobuf.Timestamp
 	36,  // 25: session.v1.ListClaudeHistoryResponse.entries:type_name -> session.v1.ClaudeHistoryEntry
 	36,  // 26: session.v1.GetClaudeHistoryDetailResponse.entry:type_name -> session.v1.ClaudeHistoryEntry
-	197, // 27: session.v1.ClaudeHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
-	197, // 28: session.v1.ClaudeHistoryEntry.updated_at:type_name -> google.protobuf.Timestamp
-	193, // 29: session.v1.ClaudeHistoryEntry.vcs_status:type_name -> session.v1.VCSStatus
+	242, // 27: session.v1.ClaudeHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	242, // 28: session.v1.ClaudeHistoryEntry.updated_at:type_name -> google.protobuf.Timestamp
+	238, // 29: session.v1.ClaudeHistoryEntry.vcs_status:type_name -> session.v1.VCSStatus
 	39,  // 30: session.v1.GetClaudeHistoryMessagesResponse.messages:type_name -> session.v1.ClaudeMessage
-	197, // 31: session.v1.ClaudeMessage.timestamp:type_name -> google.protobuf.Timestamp
-	197, // 32: session.v1.SearchClaudeHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
-	197, // 33: session.v1.SearchClaudeHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
+	242, // 31: session.v1.ClaudeMessage.timestamp:type_name -> google.protobuf.Timestamp
+	242, // 32: session.v1.SearchClaudeHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
+	242, // 33: session.v1.SearchClaudeHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
 	42,  // 34: session.v1.SearchClaudeHistoryResponse.results:type_name -> session.v1.SearchResult
 	43,  // 35: session.v1.SearchResult.snippets:type_name -> session.v1.SearchSnippet
 	45,  // 36: session.v1.SearchResult.metadata:type_name -> session.v1.SearchResultMetadata
-	44,  // 37: session.v1.SearchSnippet.highlight_ranges:type_name -> session.v1.HighlightRange
-	197, // 38: session.v1.SearchSnippet.message_time:type_name -> google.protobuf.Timestamp
-	197, // 39: session.v1.SearchResultMetadata.created_at:type_name -> google.protobuf.Timestamp
-	199, // 40: session.v1.GetPRInfoResponse.pr_info:type_name -> session.v1.PRInfo
-	200, // 41: session.v1.GetPRCommentsResponse.comments:type_name -> session.v1.PRComment
-	201, // 42: session.v1.SendNotificationRequest.notification_type:type_name -> session.v1.NotificationType
-	202, // 43: session.v1.SendNotificationRequest.priority:type_name -> session.v1.NotificationPriority
-	182, // 44: session.v1.SendNotificationRequest.metadata:type_name -> session.v1.SendNotificationRequest.MetadataEntry
-	190, // 45: session.v1.RenameSessionResponse.session:type_name -> session.v1.Session
-	190, // 46: session.v1.RestartSessionResponse.session:type_name -> session.v1.Session
-	203, // 47: session.v1.GetWorkspaceInfoResponse.vcs_info:type_name -> session.v1.VCSInfo
-	204, // 48: session.v1.ListWorkspaceTargetsResponse.targets:type_name -> session.v1.AvailableWorkspaceTargets
-	205, // 49: session.v1.SwitchWorkspaceRequest.switch_type:type_name -> session.v1.WorkspaceSwitchType
-	206, // 50: session.v1.SwitchWorkspaceRequest.change_strategy:type_name -> session.v1.ChangeStrategy
-	207, // 51: session.v1.ListPendingApprovalsResponse.approvals:type_name -> session.v1.PendingApprovalProto
-	208, // 52: session.v1.SwitchWorkspaceResponse.vcs_type:type_name -> session.v1.VCSType
-	190, // 53: session.v1.SwitchWorkspaceResponse.session:type_name -> session.v1.Session
-	201, // 54: session.v1.NotificationHistoryRecord.notification_type:type_name -> session.v1.NotificationType
-	202, // 55: session.v1.NotificationHistoryRecord.priority:type_name -> session.v1.NotificationPriority
-	183, // 56: session.v1.NotificationHistoryRecord.metadata:type_name -> session.v1.NotificationHistoryRecord.MetadataEntry
-	197, // 57: session.v1.NotificationHistoryRecord.created_at:type_name -> google.protobuf.Timestamp
-	197, // 58: session.v1.NotificationHistoryRecord.read_at:type_name -> google.protobuf.Timestamp
-	197, // 59: session.v1.NotificationHistoryRecord.last_occurred_at:type_name -> google.protobuf.Timestamp
-	201, // 60: session.v1.GetNotificationHistoryRequest.type_filter:type_name -> session.v1.NotificationType
-	76,  // 61: session.v1.GetNotificationHistoryResponse.notifications:type_name -> session.v1.NotificationHistoryRecord
-	209, // 62: session.v1.ListApprovalRulesResponse.rules:type_name -> session.v1.ApprovalRuleProto
-	209, // 63: session.v1.UpsertApprovalRuleRequest.rule:type_name -> session.v1.ApprovalRuleProto
-	209, // 64: session.v1.UpsertApprovalRuleResponse.rule:type_name -> session.v1.ApprovalRuleProto
-	210, // 65: session.v1.GetApprovalAnalyticsResponse.summary:type_name -> session.v1.AnalyticsSummaryProto
-	211, // 66: session.v1.GetApprovalAnalyticsResponse.daily_buckets:type_name -> session.v1.DailyBucketProto
-	212, // 67: session.v1.ListDatabasesResponse.databases:type_name -> session.v1.DatabaseInfo
-	212, // 68: session.v1.GetCurrentDatabaseResponse.database:type_name -> session.v1.DatabaseInfo
-	213, // 69: session.v1.CreateCheckpointResponse.checkpoint:type_name -> session.v1.CheckpointProto
-	213, // 70: session.v1.ListCheckpointsResponse.checkpoints:type_name -> session.v1.CheckpointProto
-	190, // 71: session.v1.ForkSessionResponse.session:type_name -> session.v1.Session
-	214, // 72: session.v1.ListFilesResponse.files:type_name -> session.v1.FileNode
-	214, // 73: session.v1.SearchFilesResponse.files:type_name -> session.v1.FileNode
-	113, // 74: session.v1.ListPathCompletionsResponse.entries:type_name -> session.v1.PathEntry
-	184, // 75: session.v1.ProfileDefaultsProto.env_vars:type_name -> session.v1.ProfileDefaultsProto.EnvVarsEntry
-	197, // 76: session.v1.ProfileDefaultsProto.created_at:type_name -> google.protobuf.Timestamp
-	197, // 77: session.v1.ProfileDefaultsProto.updated_at:type_name -> google.protobuf.Timestamp
-	114, // 78: session.v1.DirectoryRuleProto.overrides:type_name -> session.v1.ProfileDefaultsProto
-	185, // 79: session.v1.SessionDefaultsConfig.env_vars:type_name -> session.v1.SessionDefaultsConfig.EnvVarsEntry
-	186, // 80: session.v1.SessionDefaultsConfig.profiles:type_name -> session.v1.SessionDefaultsConfig.ProfilesEntry
-	115, // 81: session.v1.SessionDefaultsConfig.directory_rules:type_name -> session.v1.DirectoryRuleProto
-	116, // 82: session.v1.GetSessionDefaultsResponse.defaults:type_name -> session.v1.SessionDefaultsConfig
-	187, // 83: session.v1.ResolveDefaultsResponse.env_vars:type_name -> session.v1.ResolveDefaultsResponse.EnvVarsEntry
-	188, // 84: session.v1.UpdateGlobalDefaultsRequest.env_vars:type_name -> session.v1.UpdateGlobalDefaultsRequest.EnvVarsEntry
-	116, // 85: session.v1.UpdateGlobalDefaultsResponse.defaults:type_name -> session.v1.SessionDefaultsConfig
-	114, // 86: session.v1.UpsertProfileRequest.profile:type_name -> session.v1.ProfileDefaultsProto
-	114, // 87: session.v1.UpsertProfileResponse.profile:type_name -> session.v1.ProfileDefaultsProto
-	115, // 88: session.v1.UpsertDirectoryRuleRequest.rule:type_name -> session.v1.DirectoryRuleProto
-	115, // 89: session.v1.UpsertDirectoryRuleResponse.rule:type_name -> session.v1.DirectoryRuleProto
-	132, // 90: session.v1.ListWorktreesResponse.worktrees:type_name -> session.v1.WorktreeEntry
-	197, // 91: session.v1.PromptHistoryEntry.last_used:type_name -> google.protobuf.Timestamp
-	197, // 92: session.v1.PromptHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
-	134, // 93: session.v1.ListPromptHistoryResponse.entries:type_name -> session.v1.PromptHistoryEntry
-	191, // 94: session.v1.BatchSessionRequest.session_type:type_name -> session.v1.SessionType
-	139, // 95: session.v1.BatchCreateSessionsRequest.sessions:type_name -> session.v1.BatchSessionRequest
-	140, // 96: session.v1.BatchCreateSessionsResponse.results:type_name -> session.v1.BatchCreateResult
-	197, // 97: session.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	197, // 98: session.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	145, // 99: session.v1.CreateProjectResponse.project:type_name -> session.v1.Project
-	145, // 100: session.v1.ListProjectsResponse.projects:type_name -> session.v1.Project
-	145, // 101: session.v1.UpdateProjectResponse.project:type_name -> session.v1.Project
-	160, // 102: session.v1.LogClientEventsRequest.entries:type_name -> session.v1.ClientLogEntry
-	197, // 103: session.v1.ErrorEventRecord.first_seen:type_name -> google.protobuf.Timestamp
-	197, // 104: session.v1.ErrorEventRecord.last_seen:type_name -> google.protobuf.Timestamp
-	164, // 105: session.v1.ListErrorsResponse.errors:type_name -> session.v1.ErrorEventRecord
-	170, // 106: session.v1.GetFeatureFlagsResponse.flags:type_name -> session.v1.FeatureFlag
-	170, // 107: session.v1.UpdateFeatureFlagResponse.flag:type_name -> session.v1.FeatureFlag
-	197, // 108: session.v1.EscapeEventProto.wall_time:type_name -> google.protobuf.Timestamp
-	197, // 109: session.v1.QueryEscapeAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
-	197, // 110: session.v1.QueryEscapeAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
-	175, // 111: session.v1.QueryEscapeAnalyticsResponse.events:type_name -> session.v1.EscapeEventProto
-	197, // 112: session.v1.GetEscapeAnalyticsSummaryRequest.start_time:type_name -> google.protobuf.Timestamp
-	197, // 113: session.v1.GetEscapeAnalyticsSummaryRequest.end_time:type_name -> google.protobuf.Timestamp
-	178, // 114: session.v1.GetEscapeAnalyticsSummaryResponse.histogram:type_name -> session.v1.EscapeSequenceCount
-	114, // 115: session.v1.SessionDefaultsConfig.ProfilesEntry.value:type_name -> session.v1.ProfileDefaultsProto
-	0,   // 116: session.v1.SessionService.ListSessions:input_type -> session.v1.ListSessionsRequest
-	2,   // 117: session.v1.SessionService.GetSession:input_type -> session.v1.GetSessionRequest
-	4,   // 118: session.v1.SessionService.CreateSession:input_type -> session.v1.CreateSessionRequest
-	6,   // 119: session.v1.SessionService.UpdateSession:input_type -> session.v1.UpdateSessionRequest
-	8,   // 120: session.v1.SessionService.DeleteSession:input_type -> session.v1.DeleteSessionRequest
-	10,  // 121: session.v1.SessionService.WatchSessions:input_type -> session.v1.WatchSessionsRequest
-	215, // 122: session.v1.SessionService.StreamTerminal:input_type -> session.v1.TerminalData
-	11,  // 123: session.v1.SessionService.GetSessionDiff:input_type -> session.v1.GetSessionDiffRequest
-	13,  // 124: session.v1.SessionService.GetVCSStatus:input_type -> session.v1.GetVCSStatusRequest
-	15,  // 125: session.v1.SessionService.GetReviewQueue:input_type -> session.v1.GetReviewQueueRequest
-	17,  // 126: session.v1.SessionService.AcknowledgeSession:input_type -> session.v1.AcknowledgeSessionRequest
-	19,  // 127: session.v1.SessionService.GetLogs:input_type -> session.v1.GetLogsRequest
-	22,  // 128: session.v1.SessionService.WatchReviewQueue:input_type -> session.v1.WatchReviewQueueRequest
-	23,  // 129: session.v1.SessionService.LogUserInteraction:input_type -> session.v1.LogUserInteractionRequest
-	25,  // 130: session.v1.SessionService.GetClaudeConfig:input_type -> session.v1.GetClaudeConfigRequest
-	27,  // 131: session.v1.SessionService.ListClaudeConfigs:input_type -> session.v1.ListClaudeConfigsRequest
-	29,  // 132: session.v1.SessionService.UpdateClaudeConfig:input_type -> session.v1.UpdateClaudeConfigRequest
-	32,  // 133: session.v1.SessionService.ListClaudeHistory:input_type -> session.v1.ListClaudeHistoryRequest
-	34,  // 134: session.v1.SessionService.GetClaudeHistoryDetail:input_type -> session.v1.GetClaudeHistoryDetailRequest
-	37,  // 135: session.v1.SessionService.GetClaudeHistoryMessages:input_type -> session.v1.GetClaudeHistoryMessagesRequest
-	40,  // 136: session.v1.SessionService.SearchClaudeHistory:input_type -> session.v1.SearchClaudeHistoryRequest
-	46,  // 137: session.v1.SessionService.GetPRInfo:input_type -> session.v1.GetPRInfoRequest
-	48,  // 138: session.v1.SessionService.GetPRComments:input_type -> session.v1.GetPRCommentsRequest
-	50,  // 139: session.v1.SessionService.PostPRComment:input_type -> session.v1.PostPRCommentRequest
-	52,  // 140: session.v1.SessionService.MergePR:input_type -> session.v1.MergePRRequest
-	54,  // 141: session.v1.SessionService.ClosePR:input_type -> session.v1.ClosePRRequest
-	56,  // 142: session.v1.SessionService.SendNotification:input_type -> session.v1.SendNotificationRequest
-	58,  // 143: session.v1.SessionService.FocusWindow:input_type -> session.v1.FocusWindowRequest
-	60,  // 144: session.v1.SessionService.RenameSession:input_type -> session.v1.RenameSessionRequest
-	62,  // 145: session.v1.SessionService.RestartSession:input_type -> session.v1.RestartSessionRequest
-	64,  // 146: session.v1.SessionService.GetWorkspaceInfo:input_type -> session.v1.GetWorkspaceInfoRequest
-	66,  // 147: session.v1.SessionService.ListWorkspaceTargets:input_type -> session.v1.ListWorkspaceTargetsRequest
-	68,  // 148: session.v1.SessionService.SwitchWorkspace:input_type -> session.v1.SwitchWorkspaceRequest
-	69,  // 149: session.v1.SessionService.ResolveApproval:input_type -> session.v1.ResolveApprovalRequest
-	71,  // 150: session.v1.SessionService.ListPendingApprovals:input_type -> session.v1.ListPendingApprovalsRequest
-	74,  // 151: session.v1.SessionService.CreateDebugSnapshot:input_type -> session.v1.CreateDebugSnapshotRequest
-	77,  // 152: session.v1.SessionService.GetNotificationHistory:input_type -> session.v1.GetNotificationHistoryRequest
-	79,  // 153: session.v1.SessionService.MarkNotificationRead:input_type -> session.v1.MarkNotificationReadRequest
-	81,  // 154: session.v1.SessionService.ClearNotificationHistory:input_type -> session.v1.ClearNotificationHistoryRequest
-	83,  // 155: session.v1.SessionService.ListApprovalRules:input_type -> session.v1.ListApprovalRulesRequest
-	85,  // 156: session.v1.SessionService.UpsertApprovalRule:input_type -> session.v1.UpsertApprovalRuleRequest
-	87,  // 157: session.v1.SessionService.DeleteApprovalRule:input_type -> session.v1.DeleteApprovalRuleRequest
-	89,  // 158: session.v1.SessionService.GetApprovalAnalytics:input_type -> session.v1.GetApprovalAnalyticsRequest
-	91,  // 159: session.v1.SessionService.ListDatabases:input_type -> session.v1.ListDatabasesRequest
-	93,  // 160: session.v1.SessionService.GetCurrentDatabase:input_type -> session.v1.GetCurrentDatabaseRequest
-	95,  // 161: session.v1.SessionService.SwitchDatabase:input_type -> session.v1.SwitchDatabaseRequest
-	97,  // 162: session.v1.SessionService.MergeDatabase:input_type -> session.v1.MergeDatabaseRequest
-	99,  // 163: session.v1.SessionService.CreateCheckpoint:input_type -> session.v1.CreateCheckpointRequest
-	101, // 164: session.v1.SessionService.ListCheckpoints:input_type -> session.v1.ListCheckpointsRequest
-	103, // 165: session.v1.SessionService.ForkSession:input_type -> session.v1.ForkSessionRequest
-	168, // 166: session.v1.SessionService.ClearConversationState:input_type -> session.v1.ClearConversationStateRequest
-	105, // 167: session.v1.SessionService.ListFiles:input_type -> session.v1.ListFilesRequest
-	107, // 168: session.v1.SessionService.GetFileContent:input_type -> session.v1.GetFileContentRequest
-	109, // 169: session.v1.SessionService.SearchFiles:input_type -> session.v1.SearchFilesRequest
-	111, // 170: session.v1.SessionService.ListPathCompletions:input_type -> session.v1.ListPathCompletionsRequest
-	117, // 171: session.v1.SessionService.GetSessionDefaults:input_type -> session.v1.GetSessionDefaultsRequest
-	119, // 172: session.v1.SessionService.ResolveDefaults:input_type -> session.v1.ResolveDefaultsRequest
-	121, // 173: session.v1.SessionService.UpdateGlobalDefaults:input_type -> session.v1.UpdateGlobalDefaultsRequest
-	123, // 174: session.v1.SessionService.UpsertProfile:input_type -> session.v1.UpsertProfileRequest
-	125, // 175: session.v1.SessionService.DeleteProfile:input_type -> session.v1.DeleteProfileRequest
-	127, // 176: session.v1.SessionService.UpsertDirectoryRule:input_type -> session.v1.UpsertDirectoryRuleRequest
-	129, // 177: session.v1.SessionService.DeleteDirectoryRule:input_type -> session.v1.DeleteDirectoryRuleRequest
-	131, // 178: session.v1.SessionService.ListWorktrees:input_type -> session.v1.ListWorktreesRequest
-	135, // 179: session.v1.SessionService.ListPromptHistory:input_type -> session.v1.ListPromptHistoryRequest
-	137, // 180: session.v1.SessionService.DeletePromptHistory:input_type -> session.v1.DeletePromptHistoryRequest
-	141, // 181: session.v1.SessionService.BatchCreateSessions:input_type -> session.v1.BatchCreateSessionsRequest
-	143, // 182: session.v1.SessionService.RunOneShot:input_type -> session.v1.RunOneShotRequest
-	146, // 183: session.v1.SessionService.CreateProject:input_type -> session.v1.CreateProjectRequest
-	148, // 184: session.v1.SessionService.ListProjects:input_type -> session.v1.ListProjectsRequest
-	150, // 185: session.v1.SessionService.UpdateProject:input_type -> session.v1.UpdateProjectRequest
-	152, // 186: session.v1.SessionService.DeleteProject:input_type -> session.v1.DeleteProjectRequest
-	154, // 187: session.v1.SessionService.AssignSessionsToProject:input_type -> session.v1.AssignSessionsToProjectRequest
-	156, // 188: session.v1.SessionService.ListBranches:input_type -> session.v1.ListBranchesRequest
-	158, // 189: session.v1.SessionService.GetTerminalSnapshot:input_type -> session.v1.GetTerminalSnapshotRequest
-	161, // 190: session.v1.SessionService.LogClientEvents:input_type -> session.v1.LogClientEventsRequest
-	163, // 191: session.v1.SessionService.ListErrors:input_type -> session.v1.ListErrorsRequest
-	166, // 192: session.v1.SessionService.AcknowledgeError:input_type -> session.v1.AcknowledgeErrorRequest
-	171, // 193: session.v1.SessionService.GetFeatureFlags:input_type -> session.v1.GetFeatureFlagsRequest
-	173, // 194: session.v1.SessionService.UpdateFeatureFlag:input_type -> session.v1.UpdateFeatureFlagRequest
-	176, // 195: session.v1.SessionService.QueryEscapeAnalytics:input_type -> session.v1.QueryEscapeAnalyticsRequest
-	179, // 196: session.v1.SessionService.GetEscapeAnalyticsSummary:input_type -> session.v1.GetEscapeAnalyticsSummaryRequest
-	1,   // 197: session.v1.SessionService.ListSessions:output_type -> session.v1.ListSessionsResponse
-	3,   // 198: session.v1.SessionService.GetSession:output_type -> session.v1.GetSessionResponse
-	5,   // 199: session.v1.SessionService.CreateSession:output_type -> session.v1.CreateSessionResponse
-	7,   // 200: session.v1.SessionService.UpdateSession:output_type -> session.v1.UpdateSessionResponse
-	9,   // 201: session.v1.SessionService.DeleteSession:output_type -> session.v1.DeleteSessionResponse
-	216, // 202: session.v1.SessionService.WatchSessions:output_type -> session.v1.SessionEvent
-	215, // 203: session.v1.SessionService.StreamTerminal:output_type -> session.v1.TerminalData
-	12,  // 204: session.v1.SessionService.GetSessionDiff:output_type -> session.v1.GetSessionDiffResponse
-	14,  // 205: session.v1.SessionService.GetVCSStatus:output_type -> session.v1.GetVCSStatusResponse
-	16,  // 206: session.v1.SessionService.GetReviewQueue:output_type -> session.v1.GetReviewQueueResponse
-	18,  // 207: session.v1.SessionService.AcknowledgeSession:output_type -> session.v1.AcknowledgeSessionResponse
-	20,  // 208: session.v1.SessionService.GetLogs:output_type -> session.v1.GetLogsResponse
-	217, // 209: session.v1.SessionService.WatchReviewQueue:output_type -> session.v1.ReviewQueueEvent
-	24,  // 210: session.v1.SessionService.LogUserInteraction:output_type -> session.v1.LogUserInteractionResponse
-	26,  // 211: session.v1.SessionService.GetClaudeConfig:output_type -> session.v1.GetClaudeConfigResponse
-	28,  // 212: session.v1.SessionService.ListClaudeConfigs:output_type -> session.v1.ListClaudeConfigsResponse
-	30,  // 213: session.v1.SessionService.UpdateClaudeConfig:output_type -> session.v1.UpdateClaudeConfigResponse
-	33,  // 214: session.v1.SessionService.ListClaudeHistory:output_type -> session.v1.ListClaudeHistoryResponse
-	35,  // 215: session.v1.SessionService.GetClaudeHistoryDetail:output_type -> session.v1.GetClaudeHistoryDetailResponse
-	38,  // 216: session.v1.SessionService.GetClaudeHistoryMessages:output_type -> session.v1.GetClaudeHistoryMessagesResponse
-	41,  // 217: session.v1.SessionService.SearchClaudeHistory:output_type -> session.v1.SearchClaudeHistoryResponse
-	47,  // 218: session.v1.SessionService.GetPRInfo:output_type -> session.v1.GetPRInfoResponse
-	49,  // 219: session.v1.SessionService.GetPRComments:output_type -> session.v1.GetPRCommentsResponse
-	51,  // 220: session.v1.SessionService.PostPRComment:output_type -> session.v1.PostPRCommentResponse
-	53,  // 221: session.v1.SessionService.MergePR:output_type -> session.v1.MergePRResponse
-	55,  // 222: session.v1.SessionService.ClosePR:output_type -> session.v1.ClosePRResponse
-	57,  // 223: session.v1.SessionService.SendNotification:output_type -> session.v1.SendNotificationResponse
-	59,  // 224: session.v1.SessionService.FocusWindow:output_type -> session.v1.FocusWindowResponse
-	61,  // 225: session.v1.SessionService.RenameSession:output_type -> session.v1.RenameSessionResponse
-	63,  // 226: session.v1.SessionService.RestartSession:output_type -> session.v1.RestartSessionResponse
-	65,  // 227: session.v1.SessionService.GetWorkspaceInfo:output_type -> session.v1.GetWorkspaceInfoResponse
-	67,  // 228: session.v1.SessionService.ListWorkspaceTargets:output_type -> session.v1.ListWorkspaceTargetsResponse
-	73,  // 229: session.v1.SessionService.SwitchWorkspace:output_type -> session.v1.SwitchWorkspaceResponse
-	70,  // 230: session.v1.SessionService.ResolveApproval:output_type -> session.v1.ResolveApprovalResponse
-	72,  // 231: session.v1.SessionService.ListPendingApprovals:output_type -> session.v1.ListPendingApprovalsResponse
-	75,  // 232: session.v1.SessionService.CreateDebugSnapshot:output_type -> session.v1.CreateDebugSnapshotResponse
-	78,  // 233: session.v1.SessionService.GetNotificationHistory:output_type -> session.v1.GetNotificationHistoryResponse
-	80,  // 234: session.v1.SessionService.MarkNotificationRead:output_type -> session.v1.MarkNotificationReadResponse
-	82,  // 235: session.v1.SessionService.ClearNotificationHistory:output_type -> session.v1.ClearNotificationHistoryResponse
-	84,  // 236: session.v1.SessionService.ListApprovalRules:output_type -> session.v1.ListApprovalRulesResponse
-	86,  // 237: session.v1.SessionService.UpsertApprovalRule:output_type -> session.v1.UpsertApprovalRuleResponse
-	88,  // 238: session.v1.SessionService.DeleteApprovalRule:output_type -> session.v1.DeleteApprovalRuleResponse
-	90,  // 239: session.v1.SessionService.GetApprovalAnalytics:output_type -> session.v1.GetApprovalAnalyticsResponse
-	92,  // 240: session.v1.SessionService.ListDatabases:output_type -> session.v1.ListDatabasesResponse
-	94,  // 241: session.v1.SessionService.GetCurrentDatabase:output_type -> session.v1.GetCurrentDatabaseResponse
-	96,  // 242: session.v1.SessionService.SwitchDatabase:output_type -> session.v1.SwitchDatabaseResponse
-	98,  // 243: session.v1.SessionService.MergeDatabase:output_type -> session.v1.MergeDatabaseResponse
-	100, // 244: session.v1.SessionService.CreateCheckpoint:output_type -> session.v1.CreateCheckpointResponse
-	102, // 245: session.v1.SessionService.ListCheckpoints:output_type -> session.v1.ListCheckpointsResponse
-	104, // 246: session.v1.SessionService.ForkSession:output_type -> session.v1.ForkSessionResponse
-	169, // 247: session.v1.SessionService.ClearConversationState:output_type -> session.v1.ClearConversationStateResponse
-	106, // 248: session.v1.SessionService.ListFiles:output_type -> session.v1.ListFilesResponse
-	108, // 249: session.v1.SessionService.GetFileContent:output_type -> session.v1.GetFileContentResponse
-	110, // 250: session.v1.SessionService.SearchFiles:output_type -> session.v1.SearchFilesResponse
-	112, // 251: session.v1.SessionService.ListPathCompletions:output_type -> session.v1.ListPathCompletionsResponse
-	118, // 252: session.v1.SessionService.GetSessionDefaults:output_type -> session.v1.GetSessionDefaultsResponse
-	120, // 253: session.v1.SessionService.ResolveDefaults:output_type -> session.v1.ResolveDefaultsResponse
-	122, // 254: session.v1.SessionService.UpdateGlobalDefaults:output_type -> session.v1.UpdateGlobalDefaultsResponse
-	124, // 255: session.v1.SessionService.UpsertProfile:output_type -> session.v1.UpsertProfileResponse
-	126, // 256: session.v1.SessionService.DeleteProfile:output_type -> session.v1.DeleteProfileResponse
-	128, // 257: session.v1.SessionService.UpsertDirectoryRule:output_type -> session.v1.UpsertDirectoryRuleResponse
-	130, // 258: session.v1.SessionService.DeleteDirectoryRule:output_type -> session.v1.DeleteDirectoryRuleResponse
-	133, // 259: session.v1.SessionService.ListWorktrees:output_type -> session.v1.ListWorktreesResponse
-	136, // 260: session.v1.SessionService.ListPromptHistory:output_type -> session.v1.ListPromptHistoryResponse
-	138, // 261: session.v1.SessionService.DeletePromptHistory:output_type -> session.v1.DeletePromptHistoryResponse
-	142, // 262: session.v1.SessionService.BatchCreateSessions:output_type -> session.v1.BatchCreateSessionsResponse
-	144, // 263: session.v1.SessionService.RunOneShot:output_type -> session.v1.RunOneShotResponse
-	147, // 264: session.v1.SessionService.CreateProject:output_type -> session.v1.CreateProjectResponse
-	149, // 265: session.v1.SessionService.ListProjects:output_type -> session.v1.ListProjectsResponse
-	151, // 266: session.v1.SessionService.UpdateProject:output_type -> session.v1.UpdateProjectResponse
-	153, // 267: session.v1.SessionService.DeleteProject:output_type -> session.v1.DeleteProjectResponse
-	155, // 268: session.v1.SessionService.AssignSessionsToProject:output_type -> session.v1.AssignSessionsToProjectResponse
-	157, // 269: session.v1.SessionService.ListBranches:output_type -> session.v1.ListBranchesResponse
-	159, // 270: session.v1.SessionService.GetTerminalSnapshot:output_type -> session.v1.GetTerminalSnapshotResponse
-	162, // 271: session.v1.SessionService.LogClientEvents:output_type -> session.v1.LogClientEventsResponse
-	165, // 272: session.v1.SessionService.ListErrors:output_type -> session.v1.ListErrorsResponse
-	167, // 273: session.v1.SessionService.AcknowledgeError:output_type -> session.v1.AcknowledgeErrorResponse
-	172, // 274: session.v1.SessionService.GetFeatureFlags:output_type -> session.v1.GetFeatureFlagsResponse
-	174, // 275: session.v1.SessionService.UpdateFeatureFlag:output_type -> session.v1.UpdateFeatureFlagResponse
-	177, // 276: session.v1.SessionService.QueryEscapeAnalytics:output_type -> session.v1.QueryEscapeAnalyticsResponse
-	180, // 277: session.v1.SessionService.GetEscapeAnalyticsSummary:output_type -> session.v1.GetEscapeAnalyticsSummaryResponse
-	197, // [197:278] is the sub-list for method output_type
-	116, // [116:197] is the sub-list for method input_type
-	116, // [116:116] is the sub-list for extension type_name
-	116, // [116:116] is the sub-list for extension extendee
-	0,   // [0:116] is the sub-list for field type_name
+	187, // 37: session.v1.SearchResult.explanation:type_name -> session.v1.ScoreExplanation
+	44,  // 38: session.v1.SearchSnippet.highlight_ranges:type_name -> session.v1.HighlightRange
+	242, // 39: session.v1.SearchSnippet.message_time:type_name -> google.protobuf.Timestamp
+	242, // 40: session.v1.SearchResultMetadata.created_at:type_name -> google.protobuf.Timestamp
+	244, // 41: session.v1.GetPRInfoResponse.pr_info:type_name -> session.v1.PRInfo
+	245, // 42: session.v1.GetPRCommentsResponse.comments:type_name -> session.v1.PRComment
+	246, // 43: session.v1.SendNotificationRequest.notification_type:type_name -> session.v1.NotificationType
+	247, // 44: session.v1.SendNotificationRequest.priority:type_name -> session.v1.NotificationPriority
+	225, // 45: session.v1.SendNotificationRequest.metadata:type_name -> session.v1.SendNotificationRequest.MetadataEntry
+	235, // 46: session.v1.RenameSessionResponse.session:type_name -> session.v1.Session
+	235, // 47: session.v1.RestartSessionResponse.session:type_name -> session.v1.Session
+	248, // 48: session.v1.GetWorkspaceInfoResponse.vcs_info:type_name -> session.v1.VCSInfo
+	249, // 49: session.v1.ListWorkspaceTargetsResponse.targets:type_name -> session.v1.AvailableWorkspaceTargets
+	250, // 50: session.v1.SwitchWorkspaceRequest.switch_type:type_name -> session.v1.WorkspaceSwitchType
+	251, // 51: session.v1.SwitchWorkspaceRequest.change_strategy:type_name -> session.v1.ChangeStrategy
+	252, // 52: session.v1.ListPendingApprovalsResponse.approvals:type_name -> session.v1.PendingApprovalProto
+	253, // 53: session.v1.SwitchWorkspaceResponse.vcs_type:type_name -> session.v1.VCSType
+	235, // 54: session.v1.SwitchWorkspaceResponse.session:type_name -> session.v1.Session
+	246, // 55: session.v1.NotificationHistoryRecord.notification_type:type_name -> session.v1.NotificationType
+	247, // 56: session.v1.NotificationHistoryRecord.priority:type_name -> session.v1.NotificationPriority
+	226, // 57: session.v1.NotificationHistoryRecord.metadata:type_name -> session.v1.NotificationHistoryRecord.MetadataEntry
+	242, // 58: session.v1.NotificationHistoryRecord.created_at:type_name -> google.protobuf.Timestamp
+	242, // 59: session.v1.NotificationHistoryRecord.read_at:type_name -> google.protobuf.Timestamp
+	242, // 60: session.v1.NotificationHistoryRecord.last_occurred_at:type_name -> google.protobuf.Timestamp
+	246, // 61: session.v1.GetNotificationHistoryRequest.type_filter:type_name -> session.v1.NotificationType
+	76,  // 62: session.v1.GetNotificationHistoryResponse.notifications:type_name -> session.v1.NotificationHistoryRecord
+	254, // 63: session.v1.ListApprovalRulesResponse.rules:type_name -> session.v1.ApprovalRuleProto
+	254, // 64: session.v1.UpsertApprovalRuleRequest.rule:type_name -> session.v1.ApprovalRuleProto
+	254, // 65: session.v1.UpsertApprovalRuleResponse.rule:type_name -> session.v1.ApprovalRuleProto
+	255, // 66: session.v1.GetApprovalAnalyticsResponse.summary:type_name -> session.v1.AnalyticsSummaryProto
+	256, // 67: session.v1.GetApprovalAnalyticsResponse.daily_buckets:type_name -> session.v1.DailyBucketProto
+	257, // 68: session.v1.ListDatabasesResponse.databases:type_name -> session.v1.DatabaseInfo
+	257, // 69: session.v1.GetCurrentDatabaseResponse.database:type_name -> session.v1.DatabaseInfo
+	258, // 70: session.v1.CreateCheckpointResponse.checkpoint:type_name -> session.v1.CheckpointProto
+	258, // 71: session.v1.ListCheckpointsResponse.checkpoints:type_name -> session.v1.CheckpointProto
+	235, // 72: session.v1.ForkSessionResponse.session:type_name -> session.v1.Session
+	259, // 73: session.v1.ListFilesResponse.files:type_name -> session.v1.FileNode
+	259, // 74: session.v1.SearchFilesResponse.files:type_name -> session.v1.FileNode
+	113, // 75: session.v1.ListPathCompletionsResponse.entries:type_name -> session.v1.PathEntry
+	227, // 76: session.v1.ProfileDefaultsProto.env_vars:type_name -> session.v1.ProfileDefaultsProto.EnvVarsEntry
+	242, // 77: session.v1.ProfileDefaultsProto.created_at:type_name -> google.protobuf.Timestamp
+	242, // 78: session.v1.ProfileDefaultsProto.updated_at:type_name -> google.protobuf.Timestamp
+	181, // 79: session.v1.ProfileDefaultsProto.resource_limits:type_name -> session.v1.ResourceLimitsProto
+	182, // 80: session.v1.ProfileDefaultsProto.sandbox:type_name -> session.v1.SandboxConfigProto
+	114, // 81: session.v1.DirectoryRuleProto.overrides:type_name -> session.v1.ProfileDefaultsProto
+	228, // 82: session.v1.SessionDefaultsConfig.env_vars:type_name -> session.v1.SessionDefaultsConfig.EnvVarsEntry
+	229, // 83: session.v1.SessionDefaultsConfig.profiles:type_name -> session.v1.SessionDefaultsConfig.ProfilesEntry
+	115, // 84: session.v1.SessionDefaultsConfig.directory_rules:type_name -> session.v1.DirectoryRuleProto
+	116, // 85: session.v1.GetSessionDefaultsResponse.defaults:type_name -> session.v1.SessionDefaultsConfig
+	230, // 86: session.v1.ResolveDefaultsResponse.env_vars:type_name -> session.v1.ResolveDefaultsResponse.EnvVarsEntry
+	231, // 87: session.v1.UpdateGlobalDefaultsRequest.env_vars:type_name -> session.v1.UpdateGlobalDefaultsRequest.EnvVarsEntry
+	116, // 88: session.v1.UpdateGlobalDefaultsResponse.defaults:type_name -> session.v1.SessionDefaultsConfig
+	114, // 89: session.v1.UpsertProfileRequest.profile:type_name -> session.v1.ProfileDefaultsProto
+	114, // 90: session.v1.UpsertProfileResponse.profile:type_name -> session.v1.ProfileDefaultsProto
+	115, // 91: session.v1.UpsertDirectoryRuleRequest.rule:type_name -> session.v1.DirectoryRuleProto
+	115, // 92: session.v1.UpsertDirectoryRuleResponse.rule:type_name -> session.v1.DirectoryRuleProto
+	132, // 93: session.v1.ListWorktreesResponse.worktrees:type_name -> session.v1.WorktreeEntry
+	242, // 94: session.v1.PromptHistoryEntry.last_used:type_name -> google.protobuf.Timestamp
+	242, // 95: session.v1.PromptHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	134, // 96: session.v1.ListPromptHistoryResponse.entries:type_name -> session.v1.PromptHistoryEntry
+	236, // 97: session.v1.BatchSessionRequest.session_type:type_name -> session.v1.SessionType
+	232, // 98: session.v1.BatchSessionRequest.template_variables:type_name -> session.v1.BatchSessionRequest.TemplateVariablesEntry
+	139, // 99: session.v1.BatchCreateSessionsRequest.sessions:type_name -> session.v1.BatchSessionRequest
+	140, // 100: session.v1.BatchCreateSessionsResponse.results:type_name -> session.v1.BatchCreateResult
+	242, // 101: session.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	242, // 102: session.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	145, // 103: session.v1.CreateProjectResponse.project:type_name -> session.v1.Project
+	145, // 104: session.v1.ListProjectsResponse.projects:type_name -> session.v1.Project
+	145, // 105: session.v1.UpdateProjectResponse.project:type_name -> session.v1.Project
+	160, // 106: session.v1.LogClientEventsRequest.entries:type_name -> session.v1.ClientLogEntry
+	242, // 107: session.v1.ErrorEventRecord.first_seen:type_name -> google.protobuf.Timestamp
+	242, // 108: session.v1.ErrorEventRecord.last_seen:type_name -> google.protobuf.Timestamp
+	164, // 109: session.v1.ListErrorsResponse.errors:type_name -> session.v1.ErrorEventRecord
+	170, // 110: session.v1.GetFeatureFlagsResponse.flags:type_name -> session.v1.FeatureFlag
+	170, // 111: session.v1.UpdateFeatureFlagResponse.flag:type_name -> session.v1.FeatureFlag
+	242, // 112: session.v1.EscapeEventProto.wall_time:type_name -> google.protobuf.Timestamp
+	242, // 113: session.v1.QueryEscapeAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	242, // 114: session.v1.QueryEscapeAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	175, // 115: session.v1.QueryEscapeAnalyticsResponse.events:type_name -> session.v1.EscapeEventProto
+	242, // 116: session.v1.GetEscapeAnalyticsSummaryRequest.start_time:type_name -> google.protobuf.Timestamp
+	242, // 117: session.v1.GetEscapeAnalyticsSummaryRequest.end_time:type_name -> google.protobuf.Timestamp
+	178, // 118: session.v1.GetEscapeAnalyticsSummaryResponse.histogram:type_name -> session.v1.EscapeSequenceCount
+	185, // 119: session.v1.GetSessionTimelineResponse.turns:type_name -> session.v1.TurnDigest
+	242, // 120: session.v1.TurnDigest.started_at:type_name -> google.protobuf.Timestamp
+	242, // 121: session.v1.TurnDigest.ended_at:type_name -> google.protobuf.Timestamp
+	186, // 122: session.v1.TurnDigest.tools:type_name -> session.v1.TurnToolCount
+	188, // 123: session.v1.ScoreExplanation.terms:type_name -> session.v1.TermScoreExplanation
+	191, // 124: session.v1.SetSessionRecordingResponse.settings:type_name -> session.v1.SessionRecordingSettings
+	242, // 125: session.v1.SessionRecordingSettings.updated_at:type_name -> google.protobuf.Timestamp
+	242, // 126: session.v1.SessionSchedule.created_at:type_name -> google.protobuf.Timestamp
+	242, // 127: session.v1.SessionSchedule.updated_at:type_name -> google.protobuf.Timestamp
+	242, // 128: session.v1.SessionSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	197, // 129: session.v1.SessionSchedule.last_run:type_name -> session.v1.ScheduleRun
+	242, // 130: session.v1.ScheduleRun.scheduled_for:type_name -> google.protobuf.Timestamp
+	242, // 131: session.v1.ScheduleRun.started_at:type_name -> google.protobuf.Timestamp
+	242, // 132: session.v1.ScheduleRun.finished_at:type_name -> google.protobuf.Timestamp
+	196, // 133: session.v1.ListSchedulesResponse.schedules:type_name -> session.v1.SessionSchedule
+	196, // 134: session.v1.CreateScheduleRequest.schedule:type_name -> session.v1.SessionSchedule
+	196, // 135: session.v1.CreateScheduleResponse.schedule:type_name -> session.v1.SessionSchedule
+	196, // 136: session.v1.UpdateScheduleResponse.schedule:type_name -> session.v1.SessionSchedule
+	197, // 137: session.v1.TriggerScheduleResponse.run:type_name -> session.v1.ScheduleRun
+	197, // 138: session.v1.ListScheduleRunsResponse.runs:type_name -> session.v1.ScheduleRun
+	210, // 139: session.v1.SessionTemplate.variables:type_name -> session.v1.TemplateVariable
+	242, // 140: session.v1.SessionTemplate.created_at:type_name -> google.protobuf.Timestamp
+	242, // 141: session.v1.SessionTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	211, // 142: session.v1.ListTemplatesResponse.templates:type_name -> session.v1.SessionTemplate
+	211, // 143: session.v1.GetTemplateResponse.template:type_name -> session.v1.SessionTemplate
+	211, // 144: session.v1.SaveTemplateRequest.template:type_name -> session.v1.SessionTemplate
+	211, // 145: session.v1.SaveTemplateResponse.template:type_name -> session.v1.SessionTemplate
+	233, // 146: session.v1.CreateSessionFromTemplateRequest.variables:type_name -> session.v1.CreateSessionFromTemplateRequest.VariablesEntry
+	235, // 147: session.v1.CreateSessionFromTemplateResponse.session:type_name -> session.v1.Session
+	211, // 148: session.v1.CreateSessionFromTemplateResponse.template:type_name -> session.v1.SessionTemplate
+	114, // 149: session.v1.SessionDefaultsConfig.ProfilesEntry.value:type_name -> session.v1.ProfileDefaultsProto
+	0,   // 150: session.v1.SessionService.ListSessions:input_type -> session.v1.ListSessionsRequest
+	2,   // 151: session.v1.SessionService.GetSession:input_type -> session.v1.GetSessionRequest
+	4,   // 152: session.v1.SessionService.CreateSession:input_type -> session.v1.CreateSessionRequest
+	6,   // 153: session.v1.SessionService.UpdateSession:input_type -> session.v1.UpdateSessionRequest
+	8,   // 154: session.v1.SessionService.DeleteSession:input_type -> session.v1.DeleteSessionRequest
+	10,  // 155: session.v1.SessionService.WatchSessions:input_type -> session.v1.WatchSessionsRequest
+	260, // 156: session.v1.SessionService.StreamTerminal:input_type -> session.v1.TerminalData
+	11,  // 157: session.v1.SessionService.GetSessionDiff:input_type -> session.v1.GetSessionDiffRequest
+	13,  // 158: session.v1.SessionService.GetVCSStatus:input_type -> session.v1.GetVCSStatusRequest
+	15,  // 159: session.v1.SessionService.GetReviewQueue:input_type -> session.v1.GetReviewQueueRequest
+	17,  // 160: session.v1.SessionService.AcknowledgeSession:input_type -> session.v1.AcknowledgeSessionRequest
+	19,  // 161: session.v1.SessionService.GetLogs:input_type -> session.v1.GetLogsRequest
+	22,  // 162: session.v1.SessionService.WatchReviewQueue:input_type -> session.v1.WatchReviewQueueRequest
+	23,  // 163: session.v1.SessionService.LogUserInteraction:input_type -> session.v1.LogUserInteractionRequest
+	25,  // 164: session.v1.SessionService.GetClaudeConfig:input_type -> session.v1.GetClaudeConfigRequest
+	27,  // 165: session.v1.SessionService.ListClaudeConfigs:input_type -> session.v1.ListClaudeConfigsRequest
+	29,  // 166: session.v1.SessionService.UpdateClaudeConfig:input_type -> session.v1.UpdateClaudeConfigRequest
+	32,  // 167: session.v1.SessionService.ListClaudeHistory:input_type -> session.v1.ListClaudeHistoryRequest
+	34,  // 168: session.v1.SessionService.GetClaudeHistoryDetail:input_type -> session.v1.GetClaudeHistoryDetailRequest
+	37,  // 169: session.v1.SessionService.GetClaudeHistoryMessages:input_type -> session.v1.GetClaudeHistoryMessagesRequest
+	40,  // 170: session.v1.SessionService.SearchClaudeHistory:input_type -> session.v1.SearchClaudeHistoryRequest
+	46,  // 171: session.v1.SessionService.GetPRInfo:input_type -> session.v1.GetPRInfoRequest
+	48,  // 172: session.v1.SessionService.GetPRComments:input_type -> session.v1.GetPRCommentsRequest
+	50,  // 173: session.v1.SessionService.PostPRComment:input_type -> session.v1.PostPRCommentRequest
+	52,  // 174: session.v1.SessionService.MergePR:input_type -> session.v1.MergePRRequest
+	54,  // 175: session.v1.SessionService.ClosePR:input_type -> session.v1.ClosePRRequest
+	222, // 176: session.v1.SessionService.SendCIFixPrompt:input_type -> session.v1.SendCIFixPromptRequest
+	56,  // 177: session.v1.SessionService.SendNotification:input_type -> session.v1.SendNotificationRequest
+	58,  // 178: session.v1.SessionService.FocusWindow:input_type -> session.v1.FocusWindowRequest
+	60,  // 179: session.v1.SessionService.RenameSession:input_type -> session.v1.RenameSessionRequest
+	62,  // 180: session.v1.SessionService.RestartSession:input_type -> session.v1.RestartSessionRequest
+	64,  // 181: session.v1.SessionService.GetWorkspaceInfo:input_type -> session.v1.GetWorkspaceInfoRequest
+	66,  // 182: session.v1.SessionService.ListWorkspaceTargets:input_type -> session.v1.ListWorkspaceTargetsRequest
+	68,  // 183: session.v1.SessionService.SwitchWorkspace:input_type -> session.v1.SwitchWorkspaceRequest
+	69,  // 184: session.v1.SessionService.ResolveApproval:input_type -> session.v1.ResolveApprovalRequest
+	71,  // 185: session.v1.SessionService.ListPendingApprovals:input_type -> session.v1.ListPendingApprovalsRequest
+	74,  // 186: session.v1.SessionService.CreateDebugSnapshot:input_type -> session.v1.CreateDebugSnapshotRequest
+	77,  // 187: session.v1.SessionService.GetNotificationHistory:input_type -> session.v1.GetNotificationHistoryRequest
+	79,  // 188: session.v1.SessionService.MarkNotificationRead:input_type -> session.v1.MarkNotificationReadRequest
+	81,  // 189: session.v1.SessionService.ClearNotificationHistory:input_type -> session.v1.ClearNotificationHistoryRequest
+	83,  // 190: session.v1.SessionService.ListApprovalRules:input_type -> session.v1.ListApprovalRulesRequest
+	85,  // 191: session.v1.SessionService.UpsertApprovalRule:input_type -> session.v1.UpsertApprovalRuleRequest
+	87,  // 192: session.v1.SessionService.DeleteApprovalRule:input_type -> session.v1.DeleteApprovalRuleRequest
+	89,  // 193: session.v1.SessionService.GetApprovalAnalytics:input_type -> session.v1.GetApprovalAnalyticsRequest
+	91,  // 194: session.v1.SessionService.ListDatabases:input_type -> session.v1.ListDatabasesRequest
+	93,  // 195: session.v1.SessionService.GetCurrentDatabase:input_type -> session.v1.GetCurrentDatabaseRequest
+	95,  // 196: session.v1.SessionService.SwitchDatabase:input_type -> session.v1.SwitchDatabaseRequest
+	97,  // 197: session.v1.SessionService.MergeDatabase:input_type -> session.v1.MergeDatabaseRequest
+	99,  // 198: session.v1.SessionService.CreateCheckpoint:input_type -> session.v1.CreateCheckpointRequest
+	101, // 199: session.v1.SessionService.ListCheckpoints:input_type -> session.v1.ListCheckpointsRequest
+	103, // 200: session.v1.SessionService.ForkSession:input_type -> session.v1.ForkSessionRequest
+	168, // 201: session.v1.SessionService.ClearConversationState:input_type -> session.v1.ClearConversationStateRequest
+	105, // 202: session.v1.SessionService.ListFiles:input_type -> session.v1.ListFilesRequest
+	107, // 203: session.v1.SessionService.GetFileContent:input_type -> session.v1.GetFileContentRequest
+	109, // 204: session.v1.SessionService.SearchFiles:input_type -> session.v1.SearchFilesRequest
+	111, // 205: session.v1.SessionService.ListPathCompletions:input_type -> session.v1.ListPathCompletionsRequest
+	117, // 206: session.v1.SessionService.GetSessionDefaults:input_type -> session.v1.GetSessionDefaultsRequest
+	119, // 207: session.v1.SessionService.ResolveDefaults:input_type -> session.v1.ResolveDefaultsRequest
+	121, // 208: session.v1.SessionService.UpdateGlobalDefaults:input_type -> session.v1.UpdateGlobalDefaultsRequest
+	123, // 209: session.v1.SessionService.UpsertProfile:input_type -> session.v1.UpsertProfileRequest
+	125, // 210: session.v1.SessionService.DeleteProfile:input_type -> session.v1.DeleteProfileRequest
+	127, // 211: session.v1.SessionService.UpsertDirectoryRule:input_type -> session.v1.UpsertDirectoryRuleRequest
+	129, // 212: session.v1.SessionService.DeleteDirectoryRule:input_type -> session.v1.DeleteDirectoryRuleRequest
+	131, // 213: session.v1.SessionService.ListWorktrees:input_type -> session.v1.ListWorktreesRequest
+	135, // 214: session.v1.SessionService.ListPromptHistory:input_type -> session.v1.ListPromptHistoryRequest
+	137, // 215: session.v1.SessionService.DeletePromptHistory:input_type -> session.v1.DeletePromptHistoryRequest
+	141, // 216: session.v1.SessionService.BatchCreateSessions:input_type -> session.v1.BatchCreateSessionsRequest
+	143, // 217: session.v1.SessionService.RunOneShot:input_type -> session.v1.RunOneShotRequest
+	146, // 218: session.v1.SessionService.CreateProject:input_type -> session.v1.CreateProjectRequest
+	148, // 219: session.v1.SessionService.ListProjects:input_type -> session.v1.ListProjectsRequest
+	150, // 220: session.v1.SessionService.UpdateProject:input_type -> session.v1.UpdateProjectRequest
+	152, // 221: session.v1.SessionService.DeleteProject:input_type -> session.v1.DeleteProjectRequest
+	154, // 222: session.v1.SessionService.AssignSessionsToProject:input_type -> session.v1.AssignSessionsToProjectRequest
+	156, // 223: session.v1.SessionService.ListBranches:input_type -> session.v1.ListBranchesRequest
+	158, // 224: session.v1.SessionService.GetTerminalSnapshot:input_type -> session.v1.GetTerminalSnapshotRequest
+	161, // 225: session.v1.SessionService.LogClientEvents:input_type -> session.v1.LogClientEventsRequest
+	163, // 226: session.v1.SessionService.ListErrors:input_type -> session.v1.ListErrorsRequest
+	166, // 227: session.v1.SessionService.AcknowledgeError:input_type -> session.v1.AcknowledgeErrorRequest
+	171, // 228: session.v1.SessionService.GetFeatureFlags:input_type -> session.v1.GetFeatureFlagsRequest
+	173, // 229: session.v1.SessionService.UpdateFeatureFlag:input_type -> session.v1.UpdateFeatureFlagRequest
+	176, // 230: session.v1.SessionService.QueryEscapeAnalytics:input_type -> session.v1.QueryEscapeAnalyticsRequest
+	179, // 231: session.v1.SessionService.GetEscapeAnalyticsSummary:input_type -> session.v1.GetEscapeAnalyticsSummaryRequest
+	183, // 232: session.v1.SessionService.GetSessionTimeline:input_type -> session.v1.GetSessionTimelineRequest
+	189, // 233: session.v1.SessionService.SetSessionRecording:input_type -> session.v1.SetSessionRecordingRequest
+	192, // 234: session.v1.SessionService.ExportRecording:input_type -> session.v1.ExportRecordingRequest
+	194, // 235: session.v1.SessionService.SendSessionInput:input_type -> session.v1.SendSessionInputRequest
+	198, // 236: session.v1.SessionService.ListSchedules:input_type -> session.v1.ListSchedulesRequest
+	200, // 237: session.v1.SessionService.CreateSchedule:input_type -> session.v1.CreateScheduleRequest
+	202, // 238: session.v1.SessionService.UpdateSchedule:input_type -> session.v1.UpdateScheduleRequest
+	204, // 239: session.v1.SessionService.DeleteSchedule:input_type -> session.v1.DeleteScheduleRequest
+	206, // 240: session.v1.SessionService.TriggerSchedule:input_type -> session.v1.TriggerScheduleRequest
+	208, // 241: session.v1.SessionService.ListScheduleRuns:input_type -> session.v1.ListScheduleRunsRequest
+	212, // 242: session.v1.SessionService.ListTemplates:input_type -> session.v1.ListTemplatesRequest
+	214, // 243: session.v1.SessionService.GetTemplate:input_type -> session.v1.GetTemplateRequest
+	216, // 244: session.v1.SessionService.SaveTemplate:input_type -> session.v1.SaveTemplateRequest
+	218, // 245: session.v1.SessionService.DeleteTemplate:input_type -> session.v1.DeleteTemplateRequest
+	220, // 246: session.v1.SessionService.CreateSessionFromTemplate:input_type -> session.v1.CreateSessionFromTemplateRequest
+	1,   // 247: session.v1.SessionService.ListSessions:output_type -> session.v1.ListSessionsResponse
+	3,   // 248: session.v1.SessionService.GetSession:output_type -> session.v1.GetSessionResponse
+	5,   // 249: session.v1.SessionService.CreateSession:output_type -> session.v1.CreateSessionResponse
+	7,   // 250: session.v1.SessionService.UpdateSession:output_type -> session.v1.UpdateSessionResponse
+	9,   // 251: session.v1.SessionService.DeleteSession:output_type -> session.v1.DeleteSessionResponse
+	261, // 252: session.v1.SessionService.WatchSessions:output_type -> session.v1.SessionEvent
+	260, // 253: session.v1.SessionService.StreamTerminal:output_type -> session.v1.TerminalData
+	12,  // 254: session.v1.SessionService.GetSessionDiff:output_type -> session.v1.GetSessionDiffResponse
+	14,  // 255: session.v1.SessionService.GetVCSStatus:output_type -> session.v1.GetVCSStatusResponse
+	16,  // 256: session.v1.SessionService.GetReviewQueue:output_type -> session.v1.GetReviewQueueResponse
+	18,  // 257: session.v1.SessionService.AcknowledgeSession:output_type -> session.v1.AcknowledgeSessionResponse
+	20,  // 258: session.v1.SessionService.GetLogs:output_type -> session.v1.GetLogsResponse
+	262, // 259: session.v1.SessionService.WatchReviewQueue:output_type -> session.v1.ReviewQueueEvent
+	24,  // 260: session.v1.SessionService.LogUserInteraction:output_type -> session.v1.LogUserInteractionResponse
+	26,  // 261: session.v1.SessionService.GetClaudeConfig:output_type -> session.v1.GetClaudeConfigResponse
+	28,  // 262: session.v1.SessionService.ListClaudeConfigs:output_type -> session.v1.ListClaudeConfigsResponse
+	30,  // 263: session.v1.SessionService.UpdateClaudeConfig:output_type -> session.v1.UpdateClaudeConfigResponse
+	33,  // 264: session.v1.SessionService.ListClaudeHistory:output_type -> session.v1.ListClaudeHistoryResponse
+	35,  // 265: session.v1.SessionService.GetClaudeHistoryDetail:output_type -> session.v1.GetClaudeHistoryDetailResponse
+	38,  // 266: session.v1.SessionService.GetClaudeHistoryMessages:output_type -> session.v1.GetClaudeHistoryMessagesResponse
+	41,  // 267: session.v1.SessionService.SearchClaudeHistory:output_type -> session.v1.SearchClaudeHistoryResponse
+	47,  // 268: session.v1.SessionService.GetPRInfo:output_type -> session.v1.GetPRInfoResponse
+	49,  // 269: session.v1.SessionService.GetPRComments:output_type -> session.v1.GetPRCommentsResponse
+	51,  // 270: session.v1.SessionService.PostPRComment:output_type -> session.v1.PostPRCommentResponse
+	53,  // 271: session.v1.SessionService.MergePR:output_type -> session.v1.MergePRResponse
+	55,  // 272: session.v1.SessionService.ClosePR:output_type -> session.v1.ClosePRResponse
+	223, // 273: session.v1.SessionService.SendCIFixPrompt:output_type -> session.v1.SendCIFixPromptResponse
+	57,  // 274: session.v1.SessionService.SendNotification:output_type -> session.v1.SendNotificationResponse
+	59,  // 275: session.v1.SessionService.FocusWindow:output_type -> session.v1.FocusWindowResponse
+	61,  // 276: session.v1.SessionService.RenameSession:output_type -> session.v1.RenameSessionResponse
+	63,  // 277: session.v1.SessionService.RestartSession:output_type -> session.v1.RestartSessionResponse
+	65,  // 278: session.v1.SessionService.GetWorkspaceInfo:output_type -> session.v1.GetWorkspaceInfoResponse
+	67,  // 279: session.v1.SessionService.ListWorkspaceTargets:output_type -> session.v1.ListWorkspaceTargetsResponse
+	73,  // 280: session.v1.SessionService.SwitchWorkspace:output_type -> session.v1.SwitchWorkspaceResponse
+	70,  // 281: session.v1.SessionService.ResolveApproval:output_type -> session.v1.ResolveApprovalResponse
+	72,  // 282: session.v1.SessionService.ListPendingApprovals:output_type -> session.v1.ListPendingApprovalsResponse
+	75,  // 283: session.v1.SessionService.CreateDebugSnapshot:output_type -> session.v1.CreateDebugSnapshotResponse
+	78,  // 284: session.v1.SessionService.GetNotificationHistory:output_type -> session.v1.GetNotificationHistoryResponse
+	80,  // 285: session.v1.SessionService.MarkNotificationRead:output_type -> session.v1.MarkNotificationReadResponse
+	82,  // 286: session.v1.SessionService.ClearNotificationHistory:output_type -> session.v1.ClearNotificationHistoryResponse
+	84,  // 287: session.v1.SessionService.ListApprovalRules:output_type -> session.v1.ListApprovalRulesResponse
+	86,  // 288: session.v1.SessionService.UpsertApprovalRule:output_type -> session.v1.UpsertApprovalRuleResponse
+	88,  // 289: session.v1.SessionService.DeleteApprovalRule:output_type -> session.v1.DeleteApprovalRuleResponse
+	90,  // 290: session.v1.SessionService.GetApprovalAnalytics:output_type -> session.v1.GetApprovalAnalyticsResponse
+	92,  // 291: session.v1.SessionService.ListDatabases:output_type -> session.v1.ListDatabasesResponse
+	94,  // 292: session.v1.SessionService.GetCurrentDatabase:output_type -> session.v1.GetCurrentDatabaseResponse
+	96,  // 293: session.v1.SessionService.SwitchDatabase:output_type -> session.v1.SwitchDatabaseResponse
+	98,  // 294: session.v1.SessionService.MergeDatabase:output_type -> session.v1.MergeDatabaseResponse
+	100, // 295: session.v1.SessionService.CreateCheckpoint:output_type -> session.v1.CreateCheckpointResponse
+	102, // 296: session.v1.SessionService.ListCheckpoints:output_type -> session.v1.ListCheckpointsResponse
+	104, // 297: session.v1.SessionService.ForkSession:output_type -> session.v1.ForkSessionResponse
+	169, // 298: session.v1.SessionService.ClearConversationState:output_type -> session.v1.ClearConversationStateResponse
+	106, // 299: session.v1.SessionService.ListFiles:output_type -> session.v1.ListFilesResponse
+	108, // 300: session.v1.SessionService.GetFileContent:output_type -> session.v1.GetFileContentResponse
+	110, // 301: session.v1.SessionService.SearchFiles:output_type -> session.v1.SearchFilesResponse
+	112, // 302: session.v1.SessionService.ListPathCompletions:output_type -> session.v1.ListPathCompletionsResponse
+	118, // 303: session.v1.SessionService.GetSessionDefaults:output_type -> session.v1.GetSessionDefaultsResponse
+	120, // 304: session.v1.SessionService.ResolveDefaults:output_type -> session.v1.ResolveDefaultsResponse
+	122, // 305: session.v1.SessionService.UpdateGlobalDefaults:output_type -> session.v1.UpdateGlobalDefaultsResponse
+	124, // 306: session.v1.SessionService.UpsertProfile:output_type -> session.v1.UpsertProfileResponse
+	126, // 307: session.v1.SessionService.DeleteProfile:output_type -> session.v1.DeleteProfileResponse
+	128, // 308: session.v1.SessionService.UpsertDirectoryRule:output_type -> session.v1.UpsertDirectoryRuleResponse
+	130, // 309: session.v1.SessionService.DeleteDirectoryRule:output_type -> session.v1.DeleteDirectoryRuleResponse
+	133, // 310: session.v1.SessionService.ListWorktrees:output_type -> session.v1.ListWorktreesResponse
+	136, // 311: session.v1.SessionService.ListPromptHistory:output_type -> session.v1.ListPromptHistoryResponse
+	138, // 312: session.v1.SessionService.DeletePromptHistory:output_type -> session.v1.DeletePromptHistoryResponse
+	142, // 313: session.v1.SessionService.BatchCreateSessions:output_type -> session.v1.BatchCreateSessionsResponse
+	144, // 314: session.v1.SessionService.RunOneShot:output_type -> session.v1.RunOneShotResponse
+	147, // 315: session.v1.SessionService.CreateProject:output_type -> session.v1.CreateProjectResponse
+	149, // 316: session.v1.SessionService.ListProjects:output_type -> session.v1.ListProjectsResponse
+	151, // 317: session.v1.SessionService.UpdateProject:output_type -> session.v1.UpdateProjectResponse
+	153, // 318: session.v1.SessionService.DeleteProject:output_type -> session.v1.DeleteProjectResponse
+	155, // 319: session.v1.SessionService.AssignSessionsToProject:output_type -> session.v1.AssignSessionsToProjectResponse
+	157, // 320: session.v1.SessionService.ListBranches:output_type -> session.v1.ListBranchesResponse
+	159, // 321: session.v1.SessionService.GetTerminalSnapshot:output_type -> session.v1.GetTerminalSnapshotResponse
+	162, // 322: session.v1.SessionService.LogClientEvents:output_type -> session.v1.LogClientEventsResponse
+	165, // 323: session.v1.SessionService.ListErrors:output_type -> session.v1.ListErrorsResponse
+	167, // 324: session.v1.SessionService.AcknowledgeError:output_type -> session.v1.AcknowledgeErrorResponse
+	172, // 325: session.v1.SessionService.GetFeatureFlags:output_type -> session.v1.GetFeatureFlagsResponse
+	174, // 326: session.v1.SessionService.UpdateFeatureFlag:output_type -> session.v1.UpdateFeatureFlagResponse
+	177, // 327: session.v1.SessionService.QueryEscapeAnalytics:output_type -> session.v1.QueryEscapeAnalyticsResponse
+	180, // 328: session.v1.SessionService.GetEscapeAnalyticsSummary:output_type -> session.v1.GetEscapeAnalyticsSummaryResponse
+	184, // 329: session.v1.SessionService.GetSessionTimeline:output_type -> session.v1.GetSessionTimelineResponse
+	190, // 330: session.v1.SessionService.SetSessionRecording:output_type -> session.v1.SetSessionRecordingResponse
+	193, // 331: session.v1.SessionService.ExportRecording:output_type -> session.v1.ExportRecordingResponse
+	195, // 332: session.v1.SessionService.SendSessionInput:output_type -> session.v1.SendSessionInputResponse
+	199, // 333: session.v1.SessionService.ListSchedules:output_type -> session.v1.ListSchedulesResponse
+	201, // 334: session.v1.SessionService.CreateSchedule:output_type -> session.v1.CreateScheduleResponse
+	203, // 335: session.v1.SessionService.UpdateSchedule:output_type -> session.v1.UpdateScheduleResponse
+	205, // 336: session.v1.SessionService.DeleteSchedule:output_type -> session.v1.DeleteScheduleResponse
+	207, // 337: session.v1.SessionService.TriggerSchedule:output_type -> session.v1.TriggerScheduleResponse
+	209, // 338: session.v1.SessionService.ListScheduleRuns:output_type -> session.v1.ListScheduleRunsResponse
+	213, // 339: session.v1.SessionService.ListTemplates:output_type -> session.v1.ListTemplatesResponse
+	215, // 340: session.v1.SessionService.GetTemplate:output_type -> session.v1.GetTemplateResponse
+	217, // 341: session.v1.SessionService.SaveTemplate:output_type -> session.v1.SaveTemplateResponse
+	219, // 342: session.v1.SessionService.DeleteTemplate:output_type -> session.v1.DeleteTemplateResponse
+	221, // 343: session.v1.SessionService.CreateSessionFromTemplate:output_type -> session.v1.CreateSessionFromTemplateResponse
+	247, // [247:344] is the sub-list for method output_type
+	150, // [150:247] is the sub-list for method input_type
+	150, // [150:150] is the sub-list for extension type_name
+	150, // [150:150] is the sub-list for extension extendee
+	0,   // [0:150] is the sub-list for field type_name
 }
 
 func init() { file_session_v1_session_proto_init() }
@@ -12351,13 +15896,14 @@ func file_session_v1_session_proto_init() {
 	file_session_v1_session_proto_msgTypes[81].OneofWrappers = []any{}
 	file_session_v1_session_proto_msgTypes[83].OneofWrappers = []any{}
 	file_session_v1_session_proto_msgTypes[89].OneofWrappers = []any{}
+	file_session_v1_session_proto_msgTypes[202].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_session_v1_session_proto_rawDesc), len(file_session_v1_session_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   189,
+			NumMessages:   234,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -90,6 +90,21 @@ const (
 	// BacklogServiceGetSyncHistoryProcedure is the fully-qualified name of the BacklogService's
 	// GetSyncHistory RPC.
 	BacklogServiceGetSyncHistoryProcedure = "/session.v1.BacklogService/GetSyncHistory"
+	// BacklogServiceAddBacklogDependencyProcedure is the fully-qualified name of the BacklogService's
+	// AddBacklogDependency RPC.
+	BacklogServiceAddBacklogDependencyProcedure = "/session.v1.BacklogService/AddBacklogDependency"
+	// BacklogServiceRemoveBacklogDependencyProcedure is the fully-qualified name of the
+	// BacklogService's RemoveBacklogDependency RPC.
+	BacklogServiceRemoveBacklogDependencyProcedure = "/session.v1.BacklogService/RemoveBacklogDependency"
+	// BacklogServiceGetBacklogGraphProcedure is the fully-qualified name of the BacklogService's
+	// GetBacklogGraph RPC.
+	BacklogServiceGetBacklogGraphProcedure = "/session.v1.BacklogService/GetBacklogGraph"
+	// BacklogServiceListReviewPanelsProcedure is the fully-qualified name of the BacklogService's
+	// ListReviewPanels RPC.
+	BacklogServiceListReviewPanelsProcedure = "/session.v1.BacklogService/ListReviewPanels"
+	// BacklogServiceGetReviewRubricTrendProcedure is the fully-qualified name of the BacklogService's
+	// GetReviewRubricTrend RPC.
+	BacklogServiceGetReviewRubricTrendProcedure = "/session.v1.BacklogService/GetReviewRubricTrend"
 )
 
 // BacklogServiceClient is a client for the session.v1.BacklogService service.
@@ -132,6 +147,19 @@ type BacklogServiceClient interface {
 	DeleteItemSource(context.Context, *connect.Request[v1.DeleteItemSourceRequest]) (*connect.Response[v1.DeleteItemSourceResponse], error)
 	// GetSyncHistory returns the sync event history for an item source.
 	GetSyncHistory(context.Context, *connect.Request[v1.GetSyncHistoryRequest]) (*connect.Response[v1.GetSyncHistoryResponse], error)
+	// AddBacklogDependency records that an item is blocked by another item.
+	// Edges that would create a dependency cycle are rejected.
+	AddBacklogDependency(context.Context, *connect.Request[v1.AddBacklogDependencyRequest]) (*connect.Response[v1.AddBacklogDependencyResponse], error)
+	// RemoveBacklogDependency deletes a "blocked by" edge.
+	RemoveBacklogDependency(context.Context, *connect.Request[v1.RemoveBacklogDependencyRequest]) (*connect.Response[v1.RemoveBacklogDependencyResponse], error)
+	// GetBacklogGraph returns the dependency graph with each item's blocked state.
+	GetBacklogGraph(context.Context, *connect.Request[v1.GetBacklogGraphRequest]) (*connect.Response[v1.GetBacklogGraphResponse], error)
+	// ListReviewPanels returns review-gate panel runs with each reviewer's
+	// verdict, rubric scores and the disagreements between reviewers.
+	ListReviewPanels(context.Context, *connect.Request[v1.ListReviewPanelsRequest]) (*connect.Response[v1.ListReviewPanelsResponse], error)
+	// GetReviewRubricTrend returns the rubric scores of decided review panels
+	// over time, oldest first.
+	GetReviewRubricTrend(context.Context, *connect.Request[v1.GetReviewRubricTrendRequest]) (*connect.Response[v1.GetReviewRubricTrendResponse], error)
 }
 
 // NewBacklogServiceClient constructs a client for the session.v1.BacklogService service. By
@@ -259,6 +287,36 @@ func NewBacklogServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(backlogServiceMethods.ByName("GetSyncHistory")),
 			connect.WithClientOptions(opts...),
 		),
+		addBacklogDependency: connect.NewClient[v1.AddBacklogDependencyRequest, v1.AddBacklogDependencyResponse](
+			httpClient,
+			baseURL+BacklogServiceAddBacklogDependencyProcedure,
+			connect.WithSchema(backlogServiceMethods.ByName("AddBacklogDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		removeBacklogDependency: connect.NewClient[v1.RemoveBacklogDependencyRequest, v1.RemoveBacklogDependencyResponse](
+			httpClient,
+			baseURL+BacklogServiceRemoveBacklogDependencyProcedure,
+			connect.WithSchema(backlogServiceMethods.ByName("RemoveBacklogDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		getBacklogGraph: connect.NewClient[v1.GetBacklogGraphRequest, v1.GetBacklogGraphResponse](
+			httpClient,
+			baseURL+BacklogServiceGetBacklogGraphProcedure,
+			connect.WithSchema(backlogServiceMethods.ByName("GetBacklogGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		listReviewPanels: connect.NewClient[v1.ListReviewPanelsRequest, v1.ListReviewPanelsResponse](
+			httpClient,
+			baseURL+BacklogServiceListReviewPanelsProcedure,
+			connect.WithSchema(backlogServiceMethods.ByName("ListReviewPanels")),
+			connect.WithClientOptions(opts...),
+		),
+		getReviewRubricTrend: connect.NewClient[v1.GetReviewRubricTrendRequest, v1.GetReviewRubricTrendResponse](
+			httpClient,
+			baseURL+BacklogServiceGetReviewRubricTrendProcedure,
+			connect.WithSchema(backlogServiceMethods.ByName("GetReviewRubricTrend")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -283,6 +341,11 @@ type backlogServiceClient struct {
 	updateItemSource            *connect.Client[v1.UpdateItemSourceRequest, v1.UpdateItemSourceResponse]
 	deleteItemSource            *connect.Client[v1.DeleteItemSourceRequest, v1.DeleteItemSourceResponse]
 	getSyncHistory              *connect.Client[v1.GetSyncHistoryRequest, v1.GetSyncHistoryResponse]
+	addBacklogDependency        *connect.Client[v1.AddBacklogDependencyRequest, v1.AddBacklogDependencyResponse]
+	removeBacklogDependency     *connect.Client[v1.RemoveBacklogDependencyRequest, v1.RemoveBacklogDependencyResponse]
+	getBacklogGraph             *connect.Client[v1.GetBacklogGraphRequest, v1.GetBacklogGraphResponse]
+	listReviewPanels            *connect.Client[v1.ListReviewPanelsRequest, v1.ListReviewPanelsResponse]
+	getReviewRubricTrend        *connect.Client[v1.GetReviewRubricTrendRequest, v1.GetReviewRubricTrendResponse]
 }
 
 // CreateBacklogItem calls session.v1.BacklogService.CreateBacklogItem.
@@ -380,6 +443,31 @@ func (c *backlogServiceClient) GetSyncHistory(ctx context.Context, req *connect.
 	return c.getSyncHistory.CallUnary(ctx, req)
 }
 
+// AddBacklogDependency calls session.v1.BacklogService.AddBacklogDependency.
+func (c *backlogServiceClient) AddBacklogDependency(ctx context.Context, req *connect.Request[v1.AddBacklogDependencyRequest]) (*connect.Response[v1.AddBacklogDependencyResponse], error) {
+	return c.addBacklogDependency.CallUnary(ctx, req)
+}
+
+// RemoveBacklogDependency calls session.v1.BacklogService.RemoveBacklogDependency.
+func (c *backlogServiceClient) RemoveBacklogDependency(ctx context.Context, req *connect.Request[v1.RemoveBacklogDependencyRequest]) (*connect.Response[v1.RemoveBacklogDependencyResponse], error) {
+	return c.removeBacklogDependency.CallUnary(ctx, req)
+}
+
+// GetBacklogGraph calls session.v1.BacklogService.GetBacklogGraph.
+func (c *backlogServiceClient) GetBacklogGraph(ctx context.Context, req *connect.Request[v1.GetBacklogGraphRequest]) (*connect.Response[v1.GetBacklogGraphResponse], error) {
+	return c.getBacklogGraph.CallUnary(ctx, req)
+}
+
+// ListReviewPanels calls session.v1.BacklogService.ListReviewPanels.
+func (c *backlogServiceClient) ListReviewPanels(ctx context.Context, req *connect.Request[v1.ListReviewPanelsRequest]) (*connect.Response[v1.ListReviewPanelsResponse], error) {
+	return c.listReviewPanels.CallUnary(ctx, req)
+}
+
+// GetReviewRubricTrend calls session.v1.BacklogService.GetReviewRubricTrend.
+func (c *backlogServiceClient) GetReviewRubricTrend(ctx context.Context, req *connect.Request[v1.GetReviewRubricTrendRequest]) (*connect.Response[v1.GetReviewRubricTrendResponse], error) {
+	return c.getReviewRubricTrend.CallUnary(ctx, req)
+}
+
 // BacklogServiceHandler is an implementation of the session.v1.BacklogService service.
 type BacklogServiceHandler interface {
 	// CreateBacklogItem adds a new item to the backlog.
@@ -420,6 +508,19 @@ type BacklogServiceHandler interface {
 	DeleteItemSource(context.Context, *connect.Request[v1.DeleteItemSourceRequest]) (*connect.Response[v1.DeleteItemSourceResponse], error)
 	// GetSyncHistory returns the sync event history for an item source.
 	GetSyncHistory(context.Context, *connect.Request[v1.GetSyncHistoryRequest]) (*connect.Response[v1.GetSyncHistoryResponse], error)
+	// AddBacklogDependency records that an item is blocked by another item.
+	// Edges that would create a dependency cycle are rejected.
+	AddBacklogDependency(context.Context, *connect.Request[v1.AddBacklogDependencyRequest]) (*connect.Response[v1.AddBacklogDependencyResponse], error)
+	// RemoveBacklogDependency deletes a "blocked by" edge.
+	RemoveBacklogDependency(context.Context, *connect.Request[v1.RemoveBacklogDependencyRequest]) (*connect.Response[v1.RemoveBacklogDependencyResponse], error)
+	// GetBacklogGraph returns the dependency graph with each item's blocked state.
+	GetBacklogGraph(context.Context, *connect.Request[v1.GetBacklogGraphRequest]) (*connect.Response[v1.GetBacklogGraphResponse], error)
+	// ListReviewPanels returns review-gate panel runs with each reviewer's
+	// verdict, rubric scores and the disagreements between reviewers.
+	ListReviewPanels(context.Context, *connect.Request[v1.ListReviewPanelsRequest]) (*connect.Response[v1.ListReviewPanelsResponse], error)
+	// GetReviewRubricTrend returns the rubric scores of decided review panels
+	// over time, oldest first.
+	GetReviewRubricTrend(context.Context, *connect.Request[v1.GetReviewRubricTrendRequest]) (*connect.Response[v1.GetReviewRubricTrendResponse], error)
 }
 
 // NewBacklogServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -543,6 +644,36 @@ func NewBacklogServiceHandler(svc BacklogServiceHandler, opts ...connect.Handler
 		connect.WithSchema(backlogServiceMethods.ByName("GetSyncHistory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	backlogServiceAddBacklogDependencyHandler := connect.NewUnaryHandler(
+		BacklogServiceAddBacklogDependencyProcedure,
+		svc.AddBacklogDependency,
+		connect.WithSchema(backlogServiceMethods.ByName("AddBacklogDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backlogServiceRemoveBacklogDependencyHandler := connect.NewUnaryHandler(
+		BacklogServiceRemoveBacklogDependencyProcedure,
+		svc.RemoveBacklogDependency,
+		connect.WithSchema(backlogServiceMethods.ByName("RemoveBacklogDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backlogServiceGetBacklogGraphHandler := connect.NewUnaryHandler(
+		BacklogServiceGetBacklogGraphProcedure,
+		svc.GetBacklogGraph,
+		connect.WithSchema(backlogServiceMethods.ByName("GetBacklogGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backlogServiceListReviewPanelsHandler := connect.NewUnaryHandler(
+		BacklogServiceListReviewPanelsProcedure,
+		svc.ListReviewPanels,
+		connect.WithSchema(backlogServiceMethods.ByName("ListReviewPanels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backlogServiceGetReviewRubricTrendHandler := connect.NewUnaryHandler(
+		BacklogServiceGetReviewRubricTrendProcedure,
+		svc.GetReviewRubricTrend,
+		connect.WithSchema(backlogServiceMethods.ByName("GetReviewRubricTrend")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/session.v1.BacklogService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BacklogServiceCreateBacklogItemProcedure:
@@ -583,6 +714,16 @@ func NewBacklogServiceHandler(svc BacklogServiceHandler, opts ...connect.Handler
 			backlogServiceDeleteItemSourceHandler.ServeHTTP(w, r)
 		case BacklogServiceGetSyncHistoryProcedure:
 			backlogServiceGetSyncHistoryHandler.ServeHTTP(w, r)
+		case BacklogServiceAddBacklogDependencyProcedure:
+			backlogServiceAddBacklogDependencyHandler.ServeHTTP(w, r)
+		case BacklogServiceRemoveBacklogDependencyProcedure:
+			backlogServiceRemoveBacklogDependencyHandler.ServeHTTP(w, r)
+		case BacklogServiceGetBacklogGraphProcedure:
+			backlogServiceGetBacklogGraphHandler.ServeHTTP(w, r)
+		case BacklogServiceListReviewPanelsProcedure:
+			backlogServiceListReviewPanelsHandler.ServeHTTP(w, r)
+		case BacklogServiceGetReviewRubricTrendProcedure:
+			backlogServiceGetReviewRubricTrendHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -667,3 +808,23 @@ func (UnimplementedBacklogServiceHandler) DeleteItemSource(context.Context, *con
 func (UnimplementedBacklogServiceHandler) GetSyncHistory(context.Context, *connect.Request[v1.GetSyncHistoryRequest]) (*connect.Response[v1.GetSyncHistoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.BacklogService.GetSyncHistory is not implemented"))
 }
+
+func (UnimplementedBacklogServiceHandler) AddBacklogDependency(context.Context, *connect.Request[v1.AddBacklogDependencyRequest]) (*connect.Response[v1.AddBacklogDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.BacklogService.AddBacklogDependency is not implemented"))
+}
+
+func (UnimplementedBacklogServiceHandler) RemoveBacklogDependency(context.Context, *connect.Request[v1.RemoveBacklogDependencyRequest]) (*connect.Response[v1.RemoveBacklogDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.BacklogService.RemoveBacklogDependency is not implemented"))
+}
+
+func (UnimplementedBacklogServiceHandler) GetBacklogGraph(context.Context, *connect.Request[v1.GetBacklogGraphRequest]) (*connect.Response[v1.GetBacklogGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.BacklogService.GetBacklogGraph is not implemented"))
+}
+
+func (UnimplementedBacklogServiceHandler) ListReviewPanels(context.Context, *connect.Request[v1.ListReviewPanelsRequest]) (*connect.Response[v1.ListReviewPanelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.BacklogService.ListReviewPanels is not implemented"))
+}
+
+func (UnimplementedBacklogServiceHandler) GetReviewRubricTrend(context.Context, *connect.Request[v1.GetReviewRubricTrendRequest]) (*connect.Response[v1.GetReviewRubricTrendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.BacklogService.GetReviewRubricTrend is not implemented"))
+}
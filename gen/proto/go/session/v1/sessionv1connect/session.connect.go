@@ -108,6 +108,9 @@ const (
 	SessionServiceMergePRProcedure = "/session.v1.SessionService/MergePR"
 	// SessionServiceClosePRProcedure is the fully-qualified name of the SessionService's ClosePR RPC.
 	SessionServiceClosePRProcedure = "/session.v1.SessionService/ClosePR"
+	// SessionServiceSendCIFixPromptProcedure is the fully-qualified name of the SessionService's
+	// SendCIFixPrompt RPC.
+	SessionServiceSendCIFixPromptProcedure = "/session.v1.SessionService/SendCIFixPrompt"
 	// SessionServiceSendNotificationProcedure is the fully-qualified name of the SessionService's
 	// SendNotification RPC.
 	SessionServiceSendNotificationProcedure = "/session.v1.SessionService/SendNotification"
@@ -273,6 +276,51 @@ const (
 	// SessionServiceGetEscapeAnalyticsSummaryProcedure is the fully-qualified name of the
 	// SessionService's GetEscapeAnalyticsSummary RPC.
 	SessionServiceGetEscapeAnalyticsSummaryProcedure = "/session.v1.SessionService/GetEscapeAnalyticsSummary"
+	// SessionServiceGetSessionTimelineProcedure is the fully-qualified name of the SessionService's
+	// GetSessionTimeline RPC.
+	SessionServiceGetSessionTimelineProcedure = "/session.v1.SessionService/GetSessionTimeline"
+	// SessionServiceSetSessionRecordingProcedure is the fully-qualified name of the SessionService's
+	// SetSessionRecording RPC.
+	SessionServiceSetSessionRecordingProcedure = "/session.v1.SessionService/SetSessionRecording"
+	// SessionServiceExportRecordingProcedure is the fully-qualified name of the SessionService's
+	// ExportRecording RPC.
+	SessionServiceExportRecordingProcedure = "/session.v1.SessionService/ExportRecording"
+	// SessionServiceSendSessionInputProcedure is the fully-qualified name of the SessionService's
+	// SendSessionInput RPC.
+	SessionServiceSendSessionInputProcedure = "/session.v1.SessionService/SendSessionInput"
+	// SessionServiceListSchedulesProcedure is the fully-qualified name of the SessionService's
+	// ListSchedules RPC.
+	SessionServiceListSchedulesProcedure = "/session.v1.SessionService/ListSchedules"
+	// SessionServiceCreateScheduleProcedure is the fully-qualified name of the SessionService's
+	// CreateSchedule RPC.
+	SessionServiceCreateScheduleProcedure = "/session.v1.SessionService/CreateSchedule"
+	// SessionServiceUpdateScheduleProcedure is the fully-qualified name of the SessionService's
+	// UpdateSchedule RPC.
+	SessionServiceUpdateScheduleProcedure = "/session.v1.SessionService/UpdateSchedule"
+	// SessionServiceDeleteScheduleProcedure is the fully-qualified name of the SessionService's
+	// DeleteSchedule RPC.
+	SessionServiceDeleteScheduleProcedure = "/session.v1.SessionService/DeleteSchedule"
+	// SessionServiceTriggerScheduleProcedure is the fully-qualified name of the SessionService's
+	// TriggerSchedule RPC.
+	SessionServiceTriggerScheduleProcedure = "/session.v1.SessionService/TriggerSchedule"
+	// SessionServiceListScheduleRunsProcedure is the fully-qualified name of the SessionService's
+	// ListScheduleRuns RPC.
+	SessionServiceListScheduleRunsProcedure = "/session.v1.SessionService/ListScheduleRuns"
+	// SessionServiceListTemplatesProcedure is the fully-qualified name of the SessionService's
+	// ListTemplates RPC.
+	SessionServiceListTemplatesProcedure = "/session.v1.SessionService/ListTemplates"
+	// SessionServiceGetTemplateProcedure is the fully-qualified name of the SessionService's
+	// GetTemplate RPC.
+	SessionServiceGetTemplateProcedure = "/session.v1.SessionService/GetTemplate"
+	// SessionServiceSaveTemplateProcedure is the fully-qualified name of the SessionService's
+	// SaveTemplate RPC.
+	SessionServiceSaveTemplateProcedure = "/session.v1.SessionService/SaveTemplate"
+	// SessionServiceDeleteTemplateProcedure is the fully-qualified name of the SessionService's
+	// DeleteTemplate RPC.
+	SessionServiceDeleteTemplateProcedure = "/session.v1.SessionService/DeleteTemplate"
+	// SessionServiceCreateSessionFromTemplateProcedure is the fully-qualified name of the
+	// SessionService's CreateSessionFromTemplate RPC.
+	SessionServiceCreateSessionFromTemplateProcedure = "/session.v1.SessionService/CreateSessionFromTemplate"
 )
 
 // SessionServiceClient is a client for the session.v1.SessionService service.
@@ -332,6 +380,9 @@ type SessionServiceClient interface {
 	PostPRComment(context.Context, *connect.Request[v1.PostPRCommentRequest]) (*connect.Response[v1.PostPRCommentResponse], error)
 	MergePR(context.Context, *connect.Request[v1.MergePRRequest]) (*connect.Response[v1.MergePRResponse], error)
 	ClosePR(context.Context, *connect.Request[v1.ClosePRRequest]) (*connect.Response[v1.ClosePRResponse], error)
+	// SendCIFixPrompt queues the prompt prepared for the session's failing CI
+	// checks (job log excerpts) to its agent.
+	SendCIFixPrompt(context.Context, *connect.Request[v1.SendCIFixPromptRequest]) (*connect.Response[v1.SendCIFixPromptResponse], error)
 	// SendNotification allows tmux sessions to send notifications to the server.
 	// Notifications are broadcast to all connected clients (web UI and TUI).
 	// Requires session_id to identify the source session.
@@ -481,6 +532,46 @@ type SessionServiceClient interface {
 	QueryEscapeAnalytics(context.Context, *connect.Request[v1.QueryEscapeAnalyticsRequest]) (*connect.Response[v1.QueryEscapeAnalyticsResponse], error)
 	// GetEscapeAnalyticsSummary returns aggregate escape sequence statistics for a session.
 	GetEscapeAnalyticsSummary(context.Context, *connect.Request[v1.GetEscapeAnalyticsSummaryRequest]) (*connect.Response[v1.GetEscapeAnalyticsSummaryResponse], error)
+	// GetSessionTimeline returns a per-turn digest of a session's conversation:
+	// tools used, files edited, commands run and errors hit. Digests are extracted
+	// from the conversation file, so the session must have a linked history file.
+	GetSessionTimeline(context.Context, *connect.Request[v1.GetSessionTimelineRequest]) (*connect.Response[v1.GetSessionTimelineResponse], error)
+	// SetSessionRecording opts a session in to or out of terminal recording.
+	// Recording starts within a few seconds while the session is running.
+	SetSessionRecording(context.Context, *connect.Request[v1.SetSessionRecordingRequest]) (*connect.Response[v1.SetSessionRecordingResponse], error)
+	// ExportRecording exports a session's terminal recording as an asciicast v2
+	// file, optionally limited to the range between two checkpoints.
+	ExportRecording(context.Context, *connect.Request[v1.ExportRecordingRequest]) (*connect.Response[v1.ExportRecordingResponse], error)
+	// SendSessionInput types text into a session's terminal, optionally
+	// submitting it with Enter, for scripted clients that do not hold a
+	// StreamTerminal connection.
+	SendSessionInput(context.Context, *connect.Request[v1.SendSessionInputRequest]) (*connect.Response[v1.SendSessionInputResponse], error)
+	// Schedules run recurring chores: on a cron expression in a timezone, each
+	// firing creates a session from a profile, prompt and repository, or runs a
+	// one-shot prompt in an existing session.
+	ListSchedules(context.Context, *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error)
+	CreateSchedule(context.Context, *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error)
+	UpdateSchedule(context.Context, *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error)
+	DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error)
+	// TriggerSchedule runs a schedule now, applying its overlap policy.
+	TriggerSchedule(context.Context, *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error)
+	// ListScheduleRuns returns a schedule's run history, newest first.
+	ListScheduleRuns(context.Context, *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error)
+	// Templates bundle a program, profile, session type, branch pattern, tags,
+	// a prompt with typed {{variables}}, a setup script and acceptance criteria.
+	// Personal templates are versioned on the server and take precedence;
+	// shared ones live in a repository's .stapler-squad/templates directory.
+	ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error)
+	GetTemplate(context.Context, *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error)
+	// SaveTemplate stores a new version of a personal template, or writes it to
+	// the repository's templates directory when shared is set.
+	SaveTemplate(context.Context, *connect.Request[v1.SaveTemplateRequest]) (*connect.Response[v1.SaveTemplateResponse], error)
+	// DeleteTemplate removes every version of a personal template.
+	DeleteTemplate(context.Context, *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error)
+	// CreateSessionFromTemplate renders a template with the given variables,
+	// creates the session and runs the template's setup script in its working
+	// directory before the agent starts.
+	CreateSessionFromTemplate(context.Context, *connect.Request[v1.CreateSessionFromTemplateRequest]) (*connect.Response[v1.CreateSessionFromTemplateResponse], error)
 }
 
 // NewSessionServiceClient constructs a client for the session.v1.SessionService service. By
@@ -650,6 +741,12 @@ func NewSessionServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(sessionServiceMethods.ByName("ClosePR")),
 			connect.WithClientOptions(opts...),
 		),
+		sendCIFixPrompt: connect.NewClient[v1.SendCIFixPromptRequest, v1.SendCIFixPromptResponse](
+			httpClient,
+			baseURL+SessionServiceSendCIFixPromptProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("SendCIFixPrompt")),
+			connect.WithClientOptions(opts...),
+		),
 		sendNotification: connect.NewClient[v1.SendNotificationRequest, v1.SendNotificationResponse](
 			httpClient,
 			baseURL+SessionServiceSendNotificationProcedure,
@@ -980,6 +1077,96 @@ func NewSessionServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(sessionServiceMethods.ByName("GetEscapeAnalyticsSummary")),
 			connect.WithClientOptions(opts...),
 		),
+		getSessionTimeline: connect.NewClient[v1.GetSessionTimelineRequest, v1.GetSessionTimelineResponse](
+			httpClient,
+			baseURL+SessionServiceGetSessionTimelineProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("GetSessionTimeline")),
+			connect.WithClientOptions(opts...),
+		),
+		setSessionRecording: connect.NewClient[v1.SetSessionRecordingRequest, v1.SetSessionRecordingResponse](
+			httpClient,
+			baseURL+SessionServiceSetSessionRecordingProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("SetSessionRecording")),
+			connect.WithClientOptions(opts...),
+		),
+		exportRecording: connect.NewClient[v1.ExportRecordingRequest, v1.ExportRecordingResponse](
+			httpClient,
+			baseURL+SessionServiceExportRecordingProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ExportRecording")),
+			connect.WithClientOptions(opts...),
+		),
+		sendSessionInput: connect.NewClient[v1.SendSessionInputRequest, v1.SendSessionInputResponse](
+			httpClient,
+			baseURL+SessionServiceSendSessionInputProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("SendSessionInput")),
+			connect.WithClientOptions(opts...),
+		),
+		listSchedules: connect.NewClient[v1.ListSchedulesRequest, v1.ListSchedulesResponse](
+			httpClient,
+			baseURL+SessionServiceListSchedulesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListSchedules")),
+			connect.WithClientOptions(opts...),
+		),
+		createSchedule: connect.NewClient[v1.CreateScheduleRequest, v1.CreateScheduleResponse](
+			httpClient,
+			baseURL+SessionServiceCreateScheduleProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("CreateSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSchedule: connect.NewClient[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse](
+			httpClient,
+			baseURL+SessionServiceUpdateScheduleProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("UpdateSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSchedule: connect.NewClient[v1.DeleteScheduleRequest, v1.DeleteScheduleResponse](
+			httpClient,
+			baseURL+SessionServiceDeleteScheduleProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("DeleteSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		triggerSchedule: connect.NewClient[v1.TriggerScheduleRequest, v1.TriggerScheduleResponse](
+			httpClient,
+			baseURL+SessionServiceTriggerScheduleProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("TriggerSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		listScheduleRuns: connect.NewClient[v1.ListScheduleRunsRequest, v1.ListScheduleRunsResponse](
+			httpClient,
+			baseURL+SessionServiceListScheduleRunsProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListScheduleRuns")),
+			connect.WithClientOptions(opts...),
+		),
+		listTemplates: connect.NewClient[v1.ListTemplatesRequest, v1.ListTemplatesResponse](
+			httpClient,
+			baseURL+SessionServiceListTemplatesProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("ListTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		getTemplate: connect.NewClient[v1.GetTemplateRequest, v1.GetTemplateResponse](
+			httpClient,
+			baseURL+SessionServiceGetTemplateProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("GetTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		saveTemplate: connect.NewClient[v1.SaveTemplateRequest, v1.SaveTemplateResponse](
+			httpClient,
+			baseURL+SessionServiceSaveTemplateProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("SaveTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTemplate: connect.NewClient[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse](
+			httpClient,
+			baseURL+SessionServiceDeleteTemplateProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("DeleteTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		createSessionFromTemplate: connect.NewClient[v1.CreateSessionFromTemplateRequest, v1.CreateSessionFromTemplateResponse](
+			httpClient,
+			baseURL+SessionServiceCreateSessionFromTemplateProcedure,
+			connect.WithSchema(sessionServiceMethods.ByName("CreateSessionFromTemplate")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -1011,6 +1198,7 @@ type sessionServiceClient struct {
 	postPRComment             *connect.Client[v1.PostPRCommentRequest, v1.PostPRCommentResponse]
 	mergePR                   *connect.Client[v1.MergePRRequest, v1.MergePRResponse]
 	closePR                   *connect.Client[v1.ClosePRRequest, v1.ClosePRResponse]
+	sendCIFixPrompt           *connect.Client[v1.SendCIFixPromptRequest, v1.SendCIFixPromptResponse]
 	sendNotification          *connect.Client[v1.SendNotificationRequest, v1.SendNotificationResponse]
 	focusWindow               *connect.Client[v1.FocusWindowRequest, v1.FocusWindowResponse]
 	renameSession             *connect.Client[v1.RenameSessionRequest, v1.RenameSessionResponse]
@@ -1066,6 +1254,21 @@ type sessionServiceClient struct {
 	updateFeatureFlag         *connect.Client[v1.UpdateFeatureFlagRequest, v1.UpdateFeatureFlagResponse]
 	queryEscapeAnalytics      *connect.Client[v1.QueryEscapeAnalyticsRequest, v1.QueryEscapeAnalyticsResponse]
 	getEscapeAnalyticsSummary *connect.Client[v1.GetEscapeAnalyticsSummaryRequest, v1.GetEscapeAnalyticsSummaryResponse]
+	getSessionTimeline        *connect.Client[v1.GetSessionTimelineRequest, v1.GetSessionTimelineResponse]
+	setSessionRecording       *connect.Client[v1.SetSessionRecordingRequest, v1.SetSessionRecordingResponse]
+	exportRecording           *connect.Client[v1.ExportRecordingRequest, v1.ExportRecordingResponse]
+	sendSessionInput          *connect.Client[v1.SendSessionInputRequest, v1.SendSessionInputResponse]
+	listSchedules             *connect.Client[v1.ListSchedulesRequest, v1.ListSchedulesResponse]
+	createSchedule            *connect.Client[v1.CreateScheduleRequest, v1.CreateScheduleResponse]
+	updateSchedule            *connect.Client[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse]
+	deleteSchedule            *connect.Client[v1.DeleteScheduleRequest, v1.DeleteScheduleResponse]
+	triggerSchedule           *connect.Client[v1.TriggerScheduleRequest, v1.TriggerScheduleResponse]
+	listScheduleRuns          *connect.Client[v1.ListScheduleRunsRequest, v1.ListScheduleRunsResponse]
+	listTemplates             *connect.Client[v1.ListTemplatesRequest, v1.ListTemplatesResponse]
+	getTemplate               *connect.Client[v1.GetTemplateRequest, v1.GetTemplateResponse]
+	saveTemplate              *connect.Client[v1.SaveTemplateRequest, v1.SaveTemplateResponse]
+	deleteTemplate            *connect.Client[v1.DeleteTemplateRequest, v1.DeleteTemplateResponse]
+	createSessionFromTemplate *connect.Client[v1.CreateSessionFromTemplateRequest, v1.CreateSessionFromTemplateResponse]
 }
 
 // ListSessions calls session.v1.SessionService.ListSessions.
@@ -1198,6 +1401,11 @@ func (c *sessionServiceClient) ClosePR(ctx context.Context, req *connect.Request
 	return c.closePR.CallUnary(ctx, req)
 }
 
+// SendCIFixPrompt calls session.v1.SessionService.SendCIFixPrompt.
+func (c *sessionServiceClient) SendCIFixPrompt(ctx context.Context, req *connect.Request[v1.SendCIFixPromptRequest]) (*connect.Response[v1.SendCIFixPromptResponse], error) {
+	return c.sendCIFixPrompt.CallUnary(ctx, req)
+}
+
 // SendNotification calls session.v1.SessionService.SendNotification.
 func (c *sessionServiceClient) SendNotification(ctx context.Context, req *connect.Request[v1.SendNotificationRequest]) (*connect.Response[v1.SendNotificationResponse], error) {
 	return c.sendNotification.CallUnary(ctx, req)
@@ -1473,6 +1681,81 @@ func (c *sessionServiceClient) GetEscapeAnalyticsSummary(ctx context.Context, re
 	return c.getEscapeAnalyticsSummary.CallUnary(ctx, req)
 }
 
+// GetSessionTimeline calls session.v1.SessionService.GetSessionTimeline.
+func (c *sessionServiceClient) GetSessionTimeline(ctx context.Context, req *connect.Request[v1.GetSessionTimelineRequest]) (*connect.Response[v1.GetSessionTimelineResponse], error) {
+	return c.getSessionTimeline.CallUnary(ctx, req)
+}
+
+// SetSessionRecording calls session.v1.SessionService.SetSessionRecording.
+func (c *sessionServiceClient) SetSessionRecording(ctx context.Context, req *connect.Request[v1.SetSessionRecordingRequest]) (*connect.Response[v1.SetSessionRecordingResponse], error) {
+	return c.setSessionRecording.CallUnary(ctx, req)
+}
+
+// ExportRecording calls session.v1.SessionService.ExportRecording.
+func (c *sessionServiceClient) ExportRecording(ctx context.Context, req *connect.Request[v1.ExportRecordingRequest]) (*connect.Response[v1.ExportRecordingResponse], error) {
+	return c.exportRecording.CallUnary(ctx, req)
+}
+
+// SendSessionInput calls session.v1.SessionService.SendSessionInput.
+func (c *sessionServiceClient) SendSessionInput(ctx context.Context, req *connect.Request[v1.SendSessionInputRequest]) (*connect.Response[v1.SendSessionInputResponse], error) {
+	return c.sendSessionInput.CallUnary(ctx, req)
+}
+
+// ListSchedules calls session.v1.SessionService.ListSchedules.
+func (c *sessionServiceClient) ListSchedules(ctx context.Context, req *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	return c.listSchedules.CallUnary(ctx, req)
+}
+
+// CreateSchedule calls session.v1.SessionService.CreateSchedule.
+func (c *sessionServiceClient) CreateSchedule(ctx context.Context, req *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+	return c.createSchedule.CallUnary(ctx, req)
+}
+
+// UpdateSchedule calls session.v1.SessionService.UpdateSchedule.
+func (c *sessionServiceClient) UpdateSchedule(ctx context.Context, req *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	return c.updateSchedule.CallUnary(ctx, req)
+}
+
+// DeleteSchedule calls session.v1.SessionService.DeleteSchedule.
+func (c *sessionServiceClient) DeleteSchedule(ctx context.Context, req *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
+	return c.deleteSchedule.CallUnary(ctx, req)
+}
+
+// TriggerSchedule calls session.v1.SessionService.TriggerSchedule.
+func (c *sessionServiceClient) TriggerSchedule(ctx context.Context, req *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error) {
+	return c.triggerSchedule.CallUnary(ctx, req)
+}
+
+// ListScheduleRuns calls session.v1.SessionService.ListScheduleRuns.
+func (c *sessionServiceClient) ListScheduleRuns(ctx context.Context, req *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error) {
+	return c.listScheduleRuns.CallUnary(ctx, req)
+}
+
+// ListTemplates calls session.v1.SessionService.ListTemplates.
+func (c *sessionServiceClient) ListTemplates(ctx context.Context, req *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error) {
+	return c.listTemplates.CallUnary(ctx, req)
+}
+
+// GetTemplate calls session.v1.SessionService.GetTemplate.
+func (c *sessionServiceClient) GetTemplate(ctx context.Context, req *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error) {
+	return c.getTemplate.CallUnary(ctx, req)
+}
+
+// SaveTemplate calls session.v1.SessionService.SaveTemplate.
+func (c *sessionServiceClient) SaveTemplate(ctx context.Context, req *connect.Request[v1.SaveTemplateRequest]) (*connect.Response[v1.SaveTemplateResponse], error) {
+	return c.saveTemplate.CallUnary(ctx, req)
+}
+
+// DeleteTemplate calls session.v1.SessionService.DeleteTemplate.
+func (c *sessionServiceClient) DeleteTemplate(ctx context.Context, req *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error) {
+	return c.deleteTemplate.CallUnary(ctx, req)
+}
+
+// CreateSessionFromTemplate calls session.v1.SessionService.CreateSessionFromTemplate.
+func (c *sessionServiceClient) CreateSessionFromTemplate(ctx context.Context, req *connect.Request[v1.CreateSessionFromTemplateRequest]) (*connect.Response[v1.CreateSessionFromTemplateResponse], error) {
+	return c.createSessionFromTemplate.CallUnary(ctx, req)
+}
+
 // SessionServiceHandler is an implementation of the session.v1.SessionService service.
 type SessionServiceHandler interface {
 	// ListSessions returns all sessions with optional filtering.
@@ -1530,6 +1813,9 @@ type SessionServiceHandler interface {
 	PostPRComment(context.Context, *connect.Request[v1.PostPRCommentRequest]) (*connect.Response[v1.PostPRCommentResponse], error)
 	MergePR(context.Context, *connect.Request[v1.MergePRRequest]) (*connect.Response[v1.MergePRResponse], error)
 	ClosePR(context.Context, *connect.Request[v1.ClosePRRequest]) (*connect.Response[v1.ClosePRResponse], error)
+	// SendCIFixPrompt queues the prompt prepared for the session's failing CI
+	// checks (job log excerpts) to its agent.
+	SendCIFixPrompt(context.Context, *connect.Request[v1.SendCIFixPromptRequest]) (*connect.Response[v1.SendCIFixPromptResponse], error)
 	// SendNotification allows tmux sessions to send notifications to the server.
 	// Notifications are broadcast to all connected clients (web UI and TUI).
 	// Requires session_id to identify the source session.
@@ -1679,6 +1965,46 @@ type SessionServiceHandler interface {
 	QueryEscapeAnalytics(context.Context, *connect.Request[v1.QueryEscapeAnalyticsRequest]) (*connect.Response[v1.QueryEscapeAnalyticsResponse], error)
 	// GetEscapeAnalyticsSummary returns aggregate escape sequence statistics for a session.
 	GetEscapeAnalyticsSummary(context.Context, *connect.Request[v1.GetEscapeAnalyticsSummaryRequest]) (*connect.Response[v1.GetEscapeAnalyticsSummaryResponse], error)
+	// GetSessionTimeline returns a per-turn digest of a session's conversation:
+	// tools used, files edited, commands run and errors hit. Digests are extracted
+	// from the conversation file, so the session must have a linked history file.
+	GetSessionTimeline(context.Context, *connect.Request[v1.GetSessionTimelineRequest]) (*connect.Response[v1.GetSessionTimelineResponse], error)
+	// SetSessionRecording opts a session in to or out of terminal recording.
+	// Recording starts within a few seconds while the session is running.
+	SetSessionRecording(context.Context, *connect.Request[v1.SetSessionRecordingRequest]) (*connect.Response[v1.SetSessionRecordingResponse], error)
+	// ExportRecording exports a session's terminal recording as an asciicast v2
+	// file, optionally limited to the range between two checkpoints.
+	ExportRecording(context.Context, *connect.Request[v1.ExportRecordingRequest]) (*connect.Response[v1.ExportRecordingResponse], error)
+	// SendSessionInput types text into a session's terminal, optionally
+	// submitting it with Enter, for scripted clients that do not hold a
+	// StreamTerminal connection.
+	SendSessionInput(context.Context, *connect.Request[v1.SendSessionInputRequest]) (*connect.Response[v1.SendSessionInputResponse], error)
+	// Schedules run recurring chores: on a cron expression in a timezone, each
+	// firing creates a session from a profile, prompt and repository, or runs a
+	// one-shot prompt in an existing session.
+	ListSchedules(context.Context, *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error)
+	CreateSchedule(context.Context, *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error)
+	UpdateSchedule(context.Context, *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error)
+	DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error)
+	// TriggerSchedule runs a schedule now, applying its overlap policy.
+	TriggerSchedule(context.Context, *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error)
+	// ListScheduleRuns returns a schedule's run history, newest first.
+	ListScheduleRuns(context.Context, *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error)
+	// Templates bundle a program, profile, session type, branch pattern, tags,
+	// a prompt with typed {{variables}}, a setup script and acceptance criteria.
+	// Personal templates are versioned on the server and take precedence;
+	// shared ones live in a repository's .stapler-squad/templates directory.
+	ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error)
+	GetTemplate(context.Context, *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error)
+	// SaveTemplate stores a new version of a personal template, or writes it to
+	// the repository's templates directory when shared is set.
+	SaveTemplate(context.Context, *connect.Request[v1.SaveTemplateRequest]) (*connect.Response[v1.SaveTemplateResponse], error)
+	// DeleteTemplate removes every version of a personal template.
+	DeleteTemplate(context.Context, *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error)
+	// CreateSessionFromTemplate renders a template with the given variables,
+	// creates the session and runs the template's setup script in its working
+	// directory before the agent starts.
+	CreateSessionFromTemplate(context.Context, *connect.Request[v1.CreateSessionFromTemplateRequest]) (*connect.Response[v1.CreateSessionFromTemplateResponse], error)
 }
 
 // NewSessionServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -1844,6 +2170,12 @@ func NewSessionServiceHandler(svc SessionServiceHandler, opts ...connect.Handler
 		connect.WithSchema(sessionServiceMethods.ByName("ClosePR")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sessionServiceSendCIFixPromptHandler := connect.NewUnaryHandler(
+		SessionServiceSendCIFixPromptProcedure,
+		svc.SendCIFixPrompt,
+		connect.WithSchema(sessionServiceMethods.ByName("SendCIFixPrompt")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sessionServiceSendNotificationHandler := connect.NewUnaryHandler(
 		SessionServiceSendNotificationProcedure,
 		svc.SendNotification,
@@ -2174,6 +2506,96 @@ func NewSessionServiceHandler(svc SessionServiceHandler, opts ...connect.Handler
 		connect.WithSchema(sessionServiceMethods.ByName("GetEscapeAnalyticsSummary")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sessionServiceGetSessionTimelineHandler := connect.NewUnaryHandler(
+		SessionServiceGetSessionTimelineProcedure,
+		svc.GetSessionTimeline,
+		connect.WithSchema(sessionServiceMethods.ByName("GetSessionTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceSetSessionRecordingHandler := connect.NewUnaryHandler(
+		SessionServiceSetSessionRecordingProcedure,
+		svc.SetSessionRecording,
+		connect.WithSchema(sessionServiceMethods.ByName("SetSessionRecording")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceExportRecordingHandler := connect.NewUnaryHandler(
+		SessionServiceExportRecordingProcedure,
+		svc.ExportRecording,
+		connect.WithSchema(sessionServiceMethods.ByName("ExportRecording")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceSendSessionInputHandler := connect.NewUnaryHandler(
+		SessionServiceSendSessionInputProcedure,
+		svc.SendSessionInput,
+		connect.WithSchema(sessionServiceMethods.ByName("SendSessionInput")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListSchedulesHandler := connect.NewUnaryHandler(
+		SessionServiceListSchedulesProcedure,
+		svc.ListSchedules,
+		connect.WithSchema(sessionServiceMethods.ByName("ListSchedules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceCreateScheduleHandler := connect.NewUnaryHandler(
+		SessionServiceCreateScheduleProcedure,
+		svc.CreateSchedule,
+		connect.WithSchema(sessionServiceMethods.ByName("CreateSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceUpdateScheduleHandler := connect.NewUnaryHandler(
+		SessionServiceUpdateScheduleProcedure,
+		svc.UpdateSchedule,
+		connect.WithSchema(sessionServiceMethods.ByName("UpdateSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceDeleteScheduleHandler := connect.NewUnaryHandler(
+		SessionServiceDeleteScheduleProcedure,
+		svc.DeleteSchedule,
+		connect.WithSchema(sessionServiceMethods.ByName("DeleteSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceTriggerScheduleHandler := connect.NewUnaryHandler(
+		SessionServiceTriggerScheduleProcedure,
+		svc.TriggerSchedule,
+		connect.WithSchema(sessionServiceMethods.ByName("TriggerSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListScheduleRunsHandler := connect.NewUnaryHandler(
+		SessionServiceListScheduleRunsProcedure,
+		svc.ListScheduleRuns,
+		connect.WithSchema(sessionServiceMethods.ByName("ListScheduleRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceListTemplatesHandler := connect.NewUnaryHandler(
+		SessionServiceListTemplatesProcedure,
+		svc.ListTemplates,
+		connect.WithSchema(sessionServiceMethods.ByName("ListTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceGetTemplateHandler := connect.NewUnaryHandler(
+		SessionServiceGetTemplateProcedure,
+		svc.GetTemplate,
+		connect.WithSchema(sessionServiceMethods.ByName("GetTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceSaveTemplateHandler := connect.NewUnaryHandler(
+		SessionServiceSaveTemplateProcedure,
+		svc.SaveTemplate,
+		connect.WithSchema(sessionServiceMethods.ByName("SaveTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceDeleteTemplateHandler := connect.NewUnaryHandler(
+		SessionServiceDeleteTemplateProcedure,
+		svc.DeleteTemplate,
+		connect.WithSchema(sessionServiceMethods.ByName("DeleteTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sessionServiceCreateSessionFromTemplateHandler := connect.NewUnaryHandler(
+		SessionServiceCreateSessionFromTemplateProcedure,
+		svc.CreateSessionFromTemplate,
+		connect.WithSchema(sessionServiceMethods.ByName("CreateSessionFromTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/session.v1.SessionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SessionServiceListSessionsProcedure:
@@ -2228,6 +2650,8 @@ func NewSessionServiceHandler(svc SessionServiceHandler, opts ...connect.Handler
 			sessionServiceMergePRHandler.ServeHTTP(w, r)
 		case SessionServiceClosePRProcedure:
 			sessionServiceClosePRHandler.ServeHTTP(w, r)
+		case SessionServiceSendCIFixPromptProcedure:
+			sessionServiceSendCIFixPromptHandler.ServeHTTP(w, r)
 		case SessionServiceSendNotificationProcedure:
 			sessionServiceSendNotificationHandler.ServeHTTP(w, r)
 		case SessionServiceFocusWindowProcedure:
@@ -2338,6 +2762,36 @@ func NewSessionServiceHandler(svc SessionServiceHandler, opts ...connect.Handler
 			sessionServiceQueryEscapeAnalyticsHandler.ServeHTTP(w, r)
 		case SessionServiceGetEscapeAnalyticsSummaryProcedure:
 			sessionServiceGetEscapeAnalyticsSummaryHandler.ServeHTTP(w, r)
+		case SessionServiceGetSessionTimelineProcedure:
+			sessionServiceGetSessionTimelineHandler.ServeHTTP(w, r)
+		case SessionServiceSetSessionRecordingProcedure:
+			sessionServiceSetSessionRecordingHandler.ServeHTTP(w, r)
+		case SessionServiceExportRecordingProcedure:
+			sessionServiceExportRecordingHandler.ServeHTTP(w, r)
+		case SessionServiceSendSessionInputProcedure:
+			sessionServiceSendSessionInputHandler.ServeHTTP(w, r)
+		case SessionServiceListSchedulesProcedure:
+			sessionServiceListSchedulesHandler.ServeHTTP(w, r)
+		case SessionServiceCreateScheduleProcedure:
+			sessionServiceCreateScheduleHandler.ServeHTTP(w, r)
+		case SessionServiceUpdateScheduleProcedure:
+			sessionServiceUpdateScheduleHandler.ServeHTTP(w, r)
+		case SessionServiceDeleteScheduleProcedure:
+			sessionServiceDeleteScheduleHandler.ServeHTTP(w, r)
+		case SessionServiceTriggerScheduleProcedure:
+			sessionServiceTriggerScheduleHandler.ServeHTTP(w, r)
+		case SessionServiceListScheduleRunsProcedure:
+			sessionServiceListScheduleRunsHandler.ServeHTTP(w, r)
+		case SessionServiceListTemplatesProcedure:
+			sessionServiceListTemplatesHandler.ServeHTTP(w, r)
+		case SessionServiceGetTemplateProcedure:
+			sessionServiceGetTemplateHandler.ServeHTTP(w, r)
+		case SessionServiceSaveTemplateProcedure:
+			sessionServiceSaveTemplateHandler.ServeHTTP(w, r)
+		case SessionServiceDeleteTemplateProcedure:
+			sessionServiceDeleteTemplateHandler.ServeHTTP(w, r)
+		case SessionServiceCreateSessionFromTemplateProcedure:
+			sessionServiceCreateSessionFromTemplateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -2451,6 +2905,10 @@ func (UnimplementedSessionServiceHandler) ClosePR(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.ClosePR is not implemented"))
 }
 
+func (UnimplementedSessionServiceHandler) SendCIFixPrompt(context.Context, *connect.Request[v1.SendCIFixPromptRequest]) (*connect.Response[v1.SendCIFixPromptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.SendCIFixPrompt is not implemented"))
+}
+
 func (UnimplementedSessionServiceHandler) SendNotification(context.Context, *connect.Request[v1.SendNotificationRequest]) (*connect.Response[v1.SendNotificationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.SendNotification is not implemented"))
 }
@@ -2670,3 +3128,63 @@ func (UnimplementedSessionServiceHandler) QueryEscapeAnalytics(context.Context,
 func (UnimplementedSessionServiceHandler) GetEscapeAnalyticsSummary(context.Context, *connect.Request[v1.GetEscapeAnalyticsSummaryRequest]) (*connect.Response[v1.GetEscapeAnalyticsSummaryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.GetEscapeAnalyticsSummary is not implemented"))
 }
+
+func (UnimplementedSessionServiceHandler) GetSessionTimeline(context.Context, *connect.Request[v1.GetSessionTimelineRequest]) (*connect.Response[v1.GetSessionTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.GetSessionTimeline is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) SetSessionRecording(context.Context, *connect.Request[v1.SetSessionRecordingRequest]) (*connect.Response[v1.SetSessionRecordingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.SetSessionRecording is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ExportRecording(context.Context, *connect.Request[v1.ExportRecordingRequest]) (*connect.Response[v1.ExportRecordingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.ExportRecording is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) SendSessionInput(context.Context, *connect.Request[v1.SendSessionInputRequest]) (*connect.Response[v1.SendSessionInputResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.SendSessionInput is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListSchedules(context.Context, *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.ListSchedules is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) CreateSchedule(context.Context, *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.CreateSchedule is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) UpdateSchedule(context.Context, *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.UpdateSchedule is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.DeleteSchedule is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) TriggerSchedule(context.Context, *connect.Request[v1.TriggerScheduleRequest]) (*connect.Response[v1.TriggerScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.TriggerSchedule is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListScheduleRuns(context.Context, *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.ListScheduleRuns is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) ListTemplates(context.Context, *connect.Request[v1.ListTemplatesRequest]) (*connect.Response[v1.ListTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.ListTemplates is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) GetTemplate(context.Context, *connect.Request[v1.GetTemplateRequest]) (*connect.Response[v1.GetTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.GetTemplate is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) SaveTemplate(context.Context, *connect.Request[v1.SaveTemplateRequest]) (*connect.Response[v1.SaveTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.SaveTemplate is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) DeleteTemplate(context.Context, *connect.Request[v1.DeleteTemplateRequest]) (*connect.Response[v1.DeleteTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.DeleteTemplate is not implemented"))
+}
+
+func (UnimplementedSessionServiceHandler) CreateSessionFromTemplate(context.Context, *connect.Request[v1.CreateSessionFromTemplateRequest]) (*connect.Response[v1.CreateSessionFromTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("session.v1.SessionService.CreateSessionFromTemplate is not implemented"))
+}
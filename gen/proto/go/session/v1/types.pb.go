@@ -418,6 +418,10 @@ const (
 	AttentionReason_ATTENTION_REASON_WAITING_FOR_USER AttentionReason = 9
 	// Session has failing tests that need attention.
 	AttentionReason_ATTENTION_REASON_TESTS_FAILING AttentionReason = 10
+	// CI checks are failing on the session's pull request.
+	AttentionReason_ATTENTION_REASON_CI_FAILING AttentionReason = 11
+	// The session's cgroup hit a memory or PID limit, or the OOM killer fired.
+	AttentionReason_ATTENTION_REASON_RESOURCE_LIMIT AttentionReason = 12
 )
 
 // Enum value maps for AttentionReason.
@@ -434,6 +438,8 @@ var (
 		8:  "ATTENTION_REASON_STALE",
 		9:  "ATTENTION_REASON_WAITING_FOR_USER",
 		10: "ATTENTION_REASON_TESTS_FAILING",
+		11: "ATTENTION_REASON_CI_FAILING",
+		12: "ATTENTION_REASON_RESOURCE_LIMIT",
 	}
 	AttentionReason_value = map[string]int32{
 		"ATTENTION_REASON_UNSPECIFIED":         0,
@@ -447,6 +453,8 @@ var (
 		"ATTENTION_REASON_STALE":               8,
 		"ATTENTION_REASON_WAITING_FOR_USER":    9,
 		"ATTENTION_REASON_TESTS_FAILING":       10,
+		"ATTENTION_REASON_CI_FAILING":          11,
+		"ATTENTION_REASON_RESOURCE_LIMIT":      12,
 	}
 )
 
@@ -1073,9 +1081,18 @@ type Session struct {
 	// (e.g. --resume <id>, --mcp-server ..., -y, initial prompt). Empty for external sessions.
 	LaunchCommand string `protobuf:"bytes,45,opt,name=launch_command,json=launchCommand,proto3" json:"launch_command,omitempty"`
 	// Active-work state for review queue filtering. Populated from IdleDetector state.
-	WorkingState  WorkingState `protobuf:"varint,50,opt,name=working_state,json=workingState,proto3,enum=session.v1.WorkingState" json:"working_state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	WorkingState WorkingState `protobuf:"varint,50,opt,name=working_state,json=workingState,proto3,enum=session.v1.WorkingState" json:"working_state,omitempty"`
+	// Whether new PR review comments are automatically queued to the agent.
+	PrFeedbackEnabled bool `protobuf:"varint,51,opt,name=pr_feedback_enabled,json=prFeedbackEnabled,proto3" json:"pr_feedback_enabled,omitempty"`
+	// Live resource usage of the session's cgroup. Unset when cgroups are
+	// unavailable or the session has not been sampled yet.
+	ResourceUsage *ResourceUsage `protobuf:"bytes,52,opt,name=resource_usage,json=resourceUsage,proto3" json:"resource_usage,omitempty"`
+	// Sandbox the session runs in. Unset when the session is not sandboxed.
+	Sandbox *SandboxStatus `protobuf:"bytes,53,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	// Whether failing CI logs on the session's PR are automatically queued to the agent.
+	CiAutofixEnabled bool `protobuf:"varint,54,opt,name=ci_autofix_enabled,json=ciAutofixEnabled,proto3" json:"ci_autofix_enabled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
@@ -1444,6 +1461,34 @@ func (x *Session) GetWorkingState() WorkingState {
 	return WorkingState_WORKING_STATE_UNSPECIFIED
 }
 
+func (x *Session) GetPrFeedbackEnabled() bool {
+	if x != nil {
+		return x.PrFeedbackEnabled
+	}
+	return false
+}
+
+func (x *Session) GetResourceUsage() *ResourceUsage {
+	if x != nil {
+		return x.ResourceUsage
+	}
+	return nil
+}
+
+func (x *Session) GetSandbox() *SandboxStatus {
+	if x != nil {
+		return x.Sandbox
+	}
+	return nil
+}
+
+func (x *Session) GetCiAutofixEnabled() bool {
+	if x != nil {
+		return x.CiAutofixEnabled
+	}
+	return false
+}
+
 // ExternalInstanceMetadata contains metadata for externally discovered sessions.
 type ExternalInstanceMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -4102,13 +4147,15 @@ func (x *SubcommandStatProto) GetCount() int32 {
 type DailyBucketProto struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Calendar date in "YYYY-MM-DD" format (local time).
-	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	AutoAllow     int32  `protobuf:"varint,2,opt,name=auto_allow,json=autoAllow,proto3" json:"auto_allow,omitempty"`
-	AutoDeny      int32  `protobuf:"varint,3,opt,name=auto_deny,json=autoDeny,proto3" json:"auto_deny,omitempty"`
-	Escalate      int32  `protobuf:"varint,4,opt,name=escalate,proto3" json:"escalate,omitempty"`
-	ManualAllow   int32  `protobuf:"varint,5,opt,name=manual_allow,json=manualAllow,proto3" json:"manual_allow,omitempty"`
-	ManualDeny    int32  `protobuf:"varint,6,opt,name=manual_deny,json=manualDeny,proto3" json:"manual_deny,omitempty"`
-	Total         int32  `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Date        string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	AutoAllow   int32  `protobuf:"varint,2,opt,name=auto_allow,json=autoAllow,proto3" json:"auto_allow,omitempty"`
+	AutoDeny    int32  `protobuf:"varint,3,opt,name=auto_deny,json=autoDeny,proto3" json:"auto_deny,omitempty"`
+	Escalate    int32  `protobuf:"varint,4,opt,name=escalate,proto3" json:"escalate,omitempty"`
+	ManualAllow int32  `protobuf:"varint,5,opt,name=manual_allow,json=manualAllow,proto3" json:"manual_allow,omitempty"`
+	ManualDeny  int32  `protobuf:"varint,6,opt,name=manual_deny,json=manualDeny,proto3" json:"manual_deny,omitempty"`
+	Total       int32  `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	// Network requests blocked by session sandboxes.
+	SandboxDeny   int32 `protobuf:"varint,8,opt,name=sandbox_deny,json=sandboxDeny,proto3" json:"sandbox_deny,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4192,6 +4239,13 @@ func (x *DailyBucketProto) GetTotal() int32 {
 	return 0
 }
 
+func (x *DailyBucketProto) GetSandboxDeny() int32 {
+	if x != nil {
+		return x.SandboxDeny
+	}
+	return 0
+}
+
 // DatabaseInfo contains display information about a workspace database.
 // Used by the workspace switcher UI in the header.
 type DatabaseInfo struct {
@@ -4795,12 +4849,239 @@ func (x *UnfinishedWorkConfig) GetPinnedRepos() []string {
 	return nil
 }
 
+// ResourceUsage is a sample of a session's cgroup v2 resource accounting.
+// Limit fields are 0 when the resource is unlimited.
+type ResourceUsage struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CgroupPath string                 `protobuf:"bytes,1,opt,name=cgroup_path,json=cgroupPath,proto3" json:"cgroup_path,omitempty"`
+	// CPU usage over the last poll interval; 100 is one full CPU.
+	CpuPercent float64 `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	// Cumulative CPU time in milliseconds.
+	CpuUsageMs       int64 `protobuf:"varint,3,opt,name=cpu_usage_ms,json=cpuUsageMs,proto3" json:"cpu_usage_ms,omitempty"`
+	CpuLimitPercent  int32 `protobuf:"varint,4,opt,name=cpu_limit_percent,json=cpuLimitPercent,proto3" json:"cpu_limit_percent,omitempty"`
+	MemoryBytes      int64 `protobuf:"varint,5,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	MemoryPeakBytes  int64 `protobuf:"varint,6,opt,name=memory_peak_bytes,json=memoryPeakBytes,proto3" json:"memory_peak_bytes,omitempty"`
+	MemoryLimitBytes int64 `protobuf:"varint,7,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+	IoReadBytes      int64 `protobuf:"varint,8,opt,name=io_read_bytes,json=ioReadBytes,proto3" json:"io_read_bytes,omitempty"`
+	IoWriteBytes     int64 `protobuf:"varint,9,opt,name=io_write_bytes,json=ioWriteBytes,proto3" json:"io_write_bytes,omitempty"`
+	Pids             int64 `protobuf:"varint,10,opt,name=pids,proto3" json:"pids,omitempty"`
+	PidsLimit        int64 `protobuf:"varint,11,opt,name=pids_limit,json=pidsLimit,proto3" json:"pids_limit,omitempty"`
+	// Processes killed by the OOM killer since the group was created.
+	OomKills int64 `protobuf:"varint,12,opt,name=oom_kills,json=oomKills,proto3" json:"oom_kills,omitempty"`
+	// Times the memory.max or pids.max limit was hit.
+	LimitEvents   int64                  `protobuf:"varint,13,opt,name=limit_events,json=limitEvents,proto3" json:"limit_events,omitempty"`
+	SampledAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=sampled_at,json=sampledAt,proto3" json:"sampled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_session_v1_types_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_types_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_session_v1_types_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResourceUsage) GetCgroupPath() string {
+	if x != nil {
+		return x.CgroupPath
+	}
+	return ""
+}
+
+func (x *ResourceUsage) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetCpuUsageMs() int64 {
+	if x != nil {
+		return x.CpuUsageMs
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetCpuLimitPercent() int32 {
+	if x != nil {
+		return x.CpuLimitPercent
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetMemoryPeakBytes() int64 {
+	if x != nil {
+		return x.MemoryPeakBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetMemoryLimitBytes() int64 {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetIoReadBytes() int64 {
+	if x != nil {
+		return x.IoReadBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetIoWriteBytes() int64 {
+	if x != nil {
+		return x.IoWriteBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetPids() int64 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetPidsLimit() int64 {
+	if x != nil {
+		return x.PidsLimit
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetOomKills() int64 {
+	if x != nil {
+		return x.OomKills
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetLimitEvents() int64 {
+	if x != nil {
+		return x.LimitEvents
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetSampledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SampledAt
+	}
+	return nil
+}
+
+// SandboxStatus describes the namespace sandbox confining a session.
+type SandboxStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "host", "none" or "allowlist".
+	NetworkMode string `protobuf:"bytes,1,opt,name=network_mode,json=networkMode,proto3" json:"network_mode,omitempty"`
+	// Hosts reachable in allowlist mode.
+	AllowedHosts []string `protobuf:"bytes,2,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"`
+	// Paths mounted read-write; the rest of the filesystem is read-only.
+	WritablePaths []string `protobuf:"bytes,3,rep,name=writable_paths,json=writablePaths,proto3" json:"writable_paths,omitempty"`
+	// Network requests blocked since the server started.
+	DeniedCount   int32 `protobuf:"varint,4,opt,name=denied_count,json=deniedCount,proto3" json:"denied_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SandboxStatus) Reset() {
+	*x = SandboxStatus{}
+	mi := &file_session_v1_types_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxStatus) ProtoMessage() {}
+
+func (x *SandboxStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_session_v1_types_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxStatus.ProtoReflect.Descriptor instead.
+func (*SandboxStatus) Descriptor() ([]byte, []int) {
+	return file_session_v1_types_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SandboxStatus) GetNetworkMode() string {
+	if x != nil {
+		return x.NetworkMode
+	}
+	return ""
+}
+
+func (x *SandboxStatus) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+func (x *SandboxStatus) GetWritablePaths() []string {
+	if x != nil {
+		return x.WritablePaths
+	}
+	return nil
+}
+
+func (x *SandboxStatus) GetDeniedCount() int32 {
+	if x != nil {
+		return x.DeniedCount
+	}
+	return 0
+}
+
 var File_session_v1_types_proto protoreflect.FileDescriptor
 
 const file_session_v1_types_proto_rawDesc = "" +
 	"\n" +
 	"\x16session/v1/types.proto\x12\n" +
-	"session.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x11\n" +
+	"session.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x12\n" +
 	"\aSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -4858,7 +5139,11 @@ const file_session_v1_types_proto_rawDesc = "" +
 	"project_id\x18+ \x01(\tR\tprojectId\x12%\n" +
 	"\x0einitial_prompt\x18, \x01(\tR\rinitialPrompt\x12%\n" +
 	"\x0elaunch_command\x18- \x01(\tR\rlaunchCommand\x12=\n" +
-	"\rworking_state\x182 \x01(\x0e2\x18.session.v1.WorkingStateR\fworkingState\"\xf6\x02\n" +
+	"\rworking_state\x182 \x01(\x0e2\x18.session.v1.WorkingStateR\fworkingState\x12.\n" +
+	"\x13pr_feedback_enabled\x183 \x01(\bR\x11prFeedbackEnabled\x12@\n" +
+	"\x0eresource_usage\x184 \x01(\v2\x19.session.v1.ResourceUsageR\rresourceUsage\x123\n" +
+	"\asandbox\x185 \x01(\v2\x19.session.v1.SandboxStatusR\asandbox\x12,\n" +
+	"\x12ci_autofix_enabled\x186 \x01(\bR\x10ciAutofixEnabled\"\xf6\x02\n" +
 	"\x18ExternalInstanceMetadata\x12\x1f\n" +
 	"\vtmux_socket\x18\x01 \x01(\tR\n" +
 	"tmuxSocket\x12*\n" +
@@ -5134,7 +5419,7 @@ const file_session_v1_types_proto_rawDesc = "" +
 	"subcommand\x18\x02 \x01(\tR\n" +
 	"subcommand\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\x05R\x05count\"\xd8\x01\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"\xfb\x01\n" +
 	"\x10DailyBucketProto\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
 	"\n" +
@@ -5144,7 +5429,8 @@ const file_session_v1_types_proto_rawDesc = "" +
 	"\fmanual_allow\x18\x05 \x01(\x05R\vmanualAllow\x12\x1f\n" +
 	"\vmanual_deny\x18\x06 \x01(\x05R\n" +
 	"manualDeny\x12\x14\n" +
-	"\x05total\x18\a \x01(\x05R\x05total\"\x87\x02\n" +
+	"\x05total\x18\a \x01(\x05R\x05total\x12!\n" +
+	"\fsandbox_deny\x18\b \x01(\x05R\vsandboxDeny\"\x87\x02\n" +
 	"\fDatabaseInfo\x12!\n" +
 	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x10\n" +
@@ -5209,7 +5495,33 @@ const file_session_v1_types_proto_rawDesc = "" +
 	"\x14auto_spider_sessions\x18\x01 \x01(\bR\x12autoSpiderSessions\x12\x1d\n" +
 	"\n" +
 	"watch_dirs\x18\x02 \x03(\tR\twatchDirs\x12!\n" +
-	"\fpinned_repos\x18\x03 \x03(\tR\vpinnedRepos*\xf8\x01\n" +
+	"\fpinned_repos\x18\x03 \x03(\tR\vpinnedRepos\"\x94\x04\n" +
+	"\rResourceUsage\x12\x1f\n" +
+	"\vcgroup_path\x18\x01 \x01(\tR\n" +
+	"cgroupPath\x12\x1f\n" +
+	"\vcpu_percent\x18\x02 \x01(\x01R\n" +
+	"cpuPercent\x12 \n" +
+	"\fcpu_usage_ms\x18\x03 \x01(\x03R\n" +
+	"cpuUsageMs\x12*\n" +
+	"\x11cpu_limit_percent\x18\x04 \x01(\x05R\x0fcpuLimitPercent\x12!\n" +
+	"\fmemory_bytes\x18\x05 \x01(\x03R\vmemoryBytes\x12*\n" +
+	"\x11memory_peak_bytes\x18\x06 \x01(\x03R\x0fmemoryPeakBytes\x12,\n" +
+	"\x12memory_limit_bytes\x18\a \x01(\x03R\x10memoryLimitBytes\x12\"\n" +
+	"\rio_read_bytes\x18\b \x01(\x03R\vioReadBytes\x12$\n" +
+	"\x0eio_write_bytes\x18\t \x01(\x03R\fioWriteBytes\x12\x12\n" +
+	"\x04pids\x18\n" +
+	" \x01(\x03R\x04pids\x12\x1d\n" +
+	"\n" +
+	"pids_limit\x18\v \x01(\x03R\tpidsLimit\x12\x1b\n" +
+	"\toom_kills\x18\f \x01(\x03R\boomKills\x12!\n" +
+	"\flimit_events\x18\r \x01(\x03R\vlimitEvents\x129\n" +
+	"\n" +
+	"sampled_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tsampledAt\"\xa1\x01\n" +
+	"\rSandboxStatus\x12!\n" +
+	"\fnetwork_mode\x18\x01 \x01(\tR\vnetworkMode\x12#\n" +
+	"\rallowed_hosts\x18\x02 \x03(\tR\fallowedHosts\x12%\n" +
+	"\x0ewritable_paths\x18\x03 \x03(\tR\rwritablePaths\x12!\n" +
+	"\fdenied_count\x18\x04 \x01(\x05R\vdeniedCount*\xf8\x01\n" +
 	"\rSessionStatus\x12\x1e\n" +
 	"\x1aSESSION_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16SESSION_STATUS_RUNNING\x10\x01\x12\x18\n" +
@@ -5247,7 +5559,7 @@ const file_session_v1_types_proto_rawDesc = "" +
 	"\x0fPRIORITY_URGENT\x10\x01\x12\x11\n" +
 	"\rPRIORITY_HIGH\x10\x02\x12\x13\n" +
 	"\x0fPRIORITY_MEDIUM\x10\x03\x12\x10\n" +
-	"\fPRIORITY_LOW\x10\x04*\x94\x03\n" +
+	"\fPRIORITY_LOW\x10\x04*\xda\x03\n" +
 	"\x0fAttentionReason\x12 \n" +
 	"\x1cATTENTION_REASON_UNSPECIFIED\x10\x00\x12%\n" +
 	"!ATTENTION_REASON_APPROVAL_PENDING\x10\x01\x12#\n" +
@@ -5260,7 +5572,9 @@ const file_session_v1_types_proto_rawDesc = "" +
 	"\x16ATTENTION_REASON_STALE\x10\b\x12%\n" +
 	"!ATTENTION_REASON_WAITING_FOR_USER\x10\t\x12\"\n" +
 	"\x1eATTENTION_REASON_TESTS_FAILING\x10\n" +
-	"*\x9d\x04\n" +
+	"\x12\x1f\n" +
+	"\x1bATTENTION_REASON_CI_FAILING\x10\v\x12#\n" +
+	"\x1fATTENTION_REASON_RESOURCE_LIMIT\x10\f*\x9d\x04\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
 	"!NOTIFICATION_TYPE_APPROVAL_NEEDED\x10\x01\x12$\n" +
@@ -5339,7 +5653,7 @@ func file_session_v1_types_proto_rawDescGZIP() []byte {
 }
 
 var file_session_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
-var file_session_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_session_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_session_v1_types_proto_goTypes = []any{
 	(SessionStatus)(0),                // 0: session.v1.SessionStatus
 	(SessionType)(0),                  // 1: session.v1.SessionType
@@ -5389,92 +5703,97 @@ var file_session_v1_types_proto_goTypes = []any{
 	(*CheckpointProto)(nil),           // 45: session.v1.CheckpointProto
 	(*UnfinishedWorktree)(nil),        // 46: session.v1.UnfinishedWorktree
 	(*UnfinishedWorkConfig)(nil),      // 47: session.v1.UnfinishedWorkConfig
-	nil,                               // 48: session.v1.ClaudeSession.MetadataEntry
-	nil,                               // 49: session.v1.ReviewItem.MetadataEntry
-	nil,                               // 50: session.v1.ReviewQueue.ByPriorityEntry
-	nil,                               // 51: session.v1.ReviewQueue.ByReasonEntry
-	nil,                               // 52: session.v1.Notification.MetadataEntry
-	nil,                               // 53: session.v1.PendingApprovalProto.ToolInputEntry
-	nil,                               // 54: session.v1.AnalyticsSummaryProto.DecisionCountsEntry
-	(*timestamppb.Timestamp)(nil),     // 55: google.protobuf.Timestamp
+	(*ResourceUsage)(nil),             // 48: session.v1.ResourceUsage
+	(*SandboxStatus)(nil),             // 49: session.v1.SandboxStatus
+	nil,                               // 50: session.v1.ClaudeSession.MetadataEntry
+	nil,                               // 51: session.v1.ReviewItem.MetadataEntry
+	nil,                               // 52: session.v1.ReviewQueue.ByPriorityEntry
+	nil,                               // 53: session.v1.ReviewQueue.ByReasonEntry
+	nil,                               // 54: session.v1.Notification.MetadataEntry
+	nil,                               // 55: session.v1.PendingApprovalProto.ToolInputEntry
+	nil,                               // 56: session.v1.AnalyticsSummaryProto.DecisionCountsEntry
+	(*timestamppb.Timestamp)(nil),     // 57: google.protobuf.Timestamp
 }
 var file_session_v1_types_proto_depIdxs = []int32{
 	0,  // 0: session.v1.Session.status:type_name -> session.v1.SessionStatus
-	55, // 1: session.v1.Session.created_at:type_name -> google.protobuf.Timestamp
-	55, // 2: session.v1.Session.updated_at:type_name -> google.protobuf.Timestamp
-	55, // 3: session.v1.Session.last_terminal_update:type_name -> google.protobuf.Timestamp
-	55, // 4: session.v1.Session.last_meaningful_output:type_name -> google.protobuf.Timestamp
+	57, // 1: session.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	57, // 2: session.v1.Session.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 3: session.v1.Session.last_terminal_update:type_name -> google.protobuf.Timestamp
+	57, // 4: session.v1.Session.last_meaningful_output:type_name -> google.protobuf.Timestamp
 	1,  // 5: session.v1.Session.session_type:type_name -> session.v1.SessionType
 	17, // 6: session.v1.Session.diff_stats:type_name -> session.v1.DiffStats
 	18, // 7: session.v1.Session.git_worktree:type_name -> session.v1.GitWorktree
 	19, // 8: session.v1.Session.claude_session:type_name -> session.v1.ClaudeSession
 	2,  // 9: session.v1.Session.instance_type:type_name -> session.v1.InstanceType
 	16, // 10: session.v1.Session.external_metadata:type_name -> session.v1.ExternalInstanceMetadata
-	55, // 11: session.v1.Session.last_pr_status_check:type_name -> google.protobuf.Timestamp
+	57, // 11: session.v1.Session.last_pr_status_check:type_name -> google.protobuf.Timestamp
 	4,  // 12: session.v1.Session.rate_limit_state:type_name -> session.v1.RateLimitState
-	55, // 13: session.v1.Session.rate_limit_reset_time:type_name -> google.protobuf.Timestamp
+	57, // 13: session.v1.Session.rate_limit_reset_time:type_name -> google.protobuf.Timestamp
 	3,  // 14: session.v1.Session.working_state:type_name -> session.v1.WorkingState
-	55, // 15: session.v1.ExternalInstanceMetadata.discovered_at:type_name -> google.protobuf.Timestamp
-	55, // 16: session.v1.ExternalInstanceMetadata.last_seen:type_name -> google.protobuf.Timestamp
-	55, // 17: session.v1.ClaudeSession.last_attached:type_name -> google.protobuf.Timestamp
-	20, // 18: session.v1.ClaudeSession.settings:type_name -> session.v1.ClaudeSettings
-	48, // 19: session.v1.ClaudeSession.metadata:type_name -> session.v1.ClaudeSession.MetadataEntry
-	6,  // 20: session.v1.ReviewItem.reason:type_name -> session.v1.AttentionReason
-	5,  // 21: session.v1.ReviewItem.priority:type_name -> session.v1.Priority
-	55, // 22: session.v1.ReviewItem.detected_at:type_name -> google.protobuf.Timestamp
-	49, // 23: session.v1.ReviewItem.metadata:type_name -> session.v1.ReviewItem.MetadataEntry
-	0,  // 24: session.v1.ReviewItem.status:type_name -> session.v1.SessionStatus
-	17, // 25: session.v1.ReviewItem.diff_stats:type_name -> session.v1.DiffStats
-	55, // 26: session.v1.ReviewItem.last_activity:type_name -> google.protobuf.Timestamp
-	3,  // 27: session.v1.ReviewItem.working_state:type_name -> session.v1.WorkingState
-	55, // 28: session.v1.PRInfo.created_at:type_name -> google.protobuf.Timestamp
-	55, // 29: session.v1.PRInfo.updated_at:type_name -> google.protobuf.Timestamp
-	55, // 30: session.v1.PRComment.created_at:type_name -> google.protobuf.Timestamp
-	21, // 31: session.v1.ReviewQueue.items:type_name -> session.v1.ReviewItem
-	50, // 32: session.v1.ReviewQueue.by_priority:type_name -> session.v1.ReviewQueue.ByPriorityEntry
-	51, // 33: session.v1.ReviewQueue.by_reason:type_name -> session.v1.ReviewQueue.ByReasonEntry
-	7,  // 34: session.v1.Notification.notification_type:type_name -> session.v1.NotificationType
-	8,  // 35: session.v1.Notification.priority:type_name -> session.v1.NotificationPriority
-	55, // 36: session.v1.Notification.timestamp:type_name -> google.protobuf.Timestamp
-	52, // 37: session.v1.Notification.metadata:type_name -> session.v1.Notification.MetadataEntry
-	10, // 38: session.v1.FileChange.status:type_name -> session.v1.FileStatus
-	9,  // 39: session.v1.VCSStatus.type:type_name -> session.v1.VCSType
-	26, // 40: session.v1.VCSStatus.staged_files:type_name -> session.v1.FileChange
-	26, // 41: session.v1.VCSStatus.unstaged_files:type_name -> session.v1.FileChange
-	26, // 42: session.v1.VCSStatus.untracked_files:type_name -> session.v1.FileChange
-	26, // 43: session.v1.VCSStatus.conflict_files:type_name -> session.v1.FileChange
-	55, // 44: session.v1.RevisionTarget.timestamp:type_name -> google.protobuf.Timestamp
-	9,  // 45: session.v1.AvailableWorkspaceTargets.vcs_type:type_name -> session.v1.VCSType
-	28, // 46: session.v1.AvailableWorkspaceTargets.bookmarks:type_name -> session.v1.BookmarkTarget
-	29, // 47: session.v1.AvailableWorkspaceTargets.recent_revisions:type_name -> session.v1.RevisionTarget
-	30, // 48: session.v1.AvailableWorkspaceTargets.worktrees:type_name -> session.v1.WorktreeTarget
-	9,  // 49: session.v1.VCSInfo.vcs_type:type_name -> session.v1.VCSType
-	53, // 50: session.v1.PendingApprovalProto.tool_input:type_name -> session.v1.PendingApprovalProto.ToolInputEntry
-	55, // 51: session.v1.PendingApprovalProto.created_at:type_name -> google.protobuf.Timestamp
-	55, // 52: session.v1.PendingApprovalProto.expires_at:type_name -> google.protobuf.Timestamp
-	13, // 53: session.v1.ApprovalRuleProto.decision:type_name -> session.v1.AutoDecision
-	55, // 54: session.v1.ApprovalRuleProto.created_at:type_name -> google.protobuf.Timestamp
-	54, // 55: session.v1.AnalyticsSummaryProto.decision_counts:type_name -> session.v1.AnalyticsSummaryProto.DecisionCountsEntry
-	36, // 56: session.v1.AnalyticsSummaryProto.top_tools:type_name -> session.v1.ToolStatProto
-	37, // 57: session.v1.AnalyticsSummaryProto.top_denied_commands:type_name -> session.v1.CommandStatProto
-	38, // 58: session.v1.AnalyticsSummaryProto.top_triggered_rules:type_name -> session.v1.RuleStatProto
-	55, // 59: session.v1.AnalyticsSummaryProto.window_start:type_name -> google.protobuf.Timestamp
-	55, // 60: session.v1.AnalyticsSummaryProto.window_end:type_name -> google.protobuf.Timestamp
-	39, // 61: session.v1.AnalyticsSummaryProto.top_command_programs:type_name -> session.v1.ProgramStatProto
-	40, // 62: session.v1.AnalyticsSummaryProto.top_python_imports:type_name -> session.v1.ImportStatProto
-	36, // 63: session.v1.AnalyticsSummaryProto.top_uncovered_tools:type_name -> session.v1.ToolStatProto
-	39, // 64: session.v1.AnalyticsSummaryProto.top_uncovered_programs:type_name -> session.v1.ProgramStatProto
-	41, // 65: session.v1.AnalyticsSummaryProto.command_subcommand_stats:type_name -> session.v1.SubcommandStatProto
-	55, // 66: session.v1.DatabaseInfo.last_used:type_name -> google.protobuf.Timestamp
-	55, // 67: session.v1.CheckpointProto.timestamp:type_name -> google.protobuf.Timestamp
-	55, // 68: session.v1.UnfinishedWorktree.last_modified:type_name -> google.protobuf.Timestamp
-	55, // 69: session.v1.UnfinishedWorktree.scan_time:type_name -> google.protobuf.Timestamp
-	14, // 70: session.v1.UnfinishedWorktree.scan_status:type_name -> session.v1.ScanStatus
-	71, // [71:71] is the sub-list for method output_type
-	71, // [71:71] is the sub-list for method input_type
-	71, // [71:71] is the sub-list for extension type_name
-	71, // [71:71] is the sub-list for extension extendee
-	0,  // [0:71] is the sub-list for field type_name
+	48, // 15: session.v1.Session.resource_usage:type_name -> session.v1.ResourceUsage
+	49, // 16: session.v1.Session.sandbox:type_name -> session.v1.SandboxStatus
+	57, // 17: session.v1.ExternalInstanceMetadata.discovered_at:type_name -> google.protobuf.Timestamp
+	57, // 18: session.v1.ExternalInstanceMetadata.last_seen:type_name -> google.protobuf.Timestamp
+	57, // 19: session.v1.ClaudeSession.last_attached:type_name -> google.protobuf.Timestamp
+	20, // 20: session.v1.ClaudeSession.settings:type_name -> session.v1.ClaudeSettings
+	50, // 21: session.v1.ClaudeSession.metadata:type_name -> session.v1.ClaudeSession.MetadataEntry
+	6,  // 22: session.v1.ReviewItem.reason:type_name -> session.v1.AttentionReason
+	5,  // 23: session.v1.ReviewItem.priority:type_name -> session.v1.Priority
+	57, // 24: session.v1.ReviewItem.detected_at:type_name -> google.protobuf.Timestamp
+	51, // 25: session.v1.ReviewItem.metadata:type_name -> session.v1.ReviewItem.MetadataEntry
+	0,  // 26: session.v1.ReviewItem.status:type_name -> session.v1.SessionStatus
+	17, // 27: session.v1.ReviewItem.diff_stats:type_name -> session.v1.DiffStats
+	57, // 28: session.v1.ReviewItem.last_activity:type_name -> google.protobuf.Timestamp
+	3,  // 29: session.v1.ReviewItem.working_state:type_name -> session.v1.WorkingState
+	57, // 30: session.v1.PRInfo.created_at:type_name -> google.protobuf.Timestamp
+	57, // 31: session.v1.PRInfo.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 32: session.v1.PRComment.created_at:type_name -> google.protobuf.Timestamp
+	21, // 33: session.v1.ReviewQueue.items:type_name -> session.v1.ReviewItem
+	52, // 34: session.v1.ReviewQueue.by_priority:type_name -> session.v1.ReviewQueue.ByPriorityEntry
+	53, // 35: session.v1.ReviewQueue.by_reason:type_name -> session.v1.ReviewQueue.ByReasonEntry
+	7,  // 36: session.v1.Notification.notification_type:type_name -> session.v1.NotificationType
+	8,  // 37: session.v1.Notification.priority:type_name -> session.v1.NotificationPriority
+	57, // 38: session.v1.Notification.timestamp:type_name -> google.protobuf.Timestamp
+	54, // 39: session.v1.Notification.metadata:type_name -> session.v1.Notification.MetadataEntry
+	10, // 40: session.v1.FileChange.status:type_name -> session.v1.FileStatus
+	9,  // 41: session.v1.VCSStatus.type:type_name -> session.v1.VCSType
+	26, // 42: session.v1.VCSStatus.staged_files:type_name -> session.v1.FileChange
+	26, // 43: session.v1.VCSStatus.unstaged_files:type_name -> session.v1.FileChange
+	26, // 44: session.v1.VCSStatus.untracked_files:type_name -> session.v1.FileChange
+	26, // 45: session.v1.VCSStatus.conflict_files:type_name -> session.v1.FileChange
+	57, // 46: session.v1.RevisionTarget.timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 47: session.v1.AvailableWorkspaceTargets.vcs_type:type_name -> session.v1.VCSType
+	28, // 48: session.v1.AvailableWorkspaceTargets.bookmarks:type_name -> session.v1.BookmarkTarget
+	29, // 49: session.v1.AvailableWorkspaceTargets.recent_revisions:type_name -> session.v1.RevisionTarget
+	30, // 50: session.v1.AvailableWorkspaceTargets.worktrees:type_name -> session.v1.WorktreeTarget
+	9,  // 51: session.v1.VCSInfo.vcs_type:type_name -> session.v1.VCSType
+	55, // 52: session.v1.PendingApprovalProto.tool_input:type_name -> session.v1.PendingApprovalProto.ToolInputEntry
+	57, // 53: session.v1.PendingApprovalProto.created_at:type_name -> google.protobuf.Timestamp
+	57, // 54: session.v1.PendingApprovalProto.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 55: session.v1.ApprovalRuleProto.decision:type_name -> session.v1.AutoDecision
+	57, // 56: session.v1.ApprovalRuleProto.created_at:type_name -> google.protobuf.Timestamp
+	56, // 57: session.v1.AnalyticsSummaryProto.decision_counts:type_name -> session.v1.AnalyticsSummaryProto.DecisionCountsEntry
+	36, // 58: session.v1.AnalyticsSummaryProto.top_tools:type_name -> session.v1.ToolStatProto
+	37, // 59: session.v1.AnalyticsSummaryProto.top_denied_commands:type_name -> session.v1.CommandStatProto
+	38, // 60: session.v1.AnalyticsSummaryProto.top_triggered_rules:type_name -> session.v1.RuleStatProto
+	57, // 61: session.v1.AnalyticsSummaryProto.window_start:type_name -> google.protobuf.Timestamp
+	57, // 62: session.v1.AnalyticsSummaryProto.window_end:type_name -> google.protobuf.Timestamp
+	39, // 63: session.v1.AnalyticsSummaryProto.top_command_programs:type_name -> session.v1.ProgramStatProto
+	40, // 64: session.v1.AnalyticsSummaryProto.top_python_imports:type_name -> session.v1.ImportStatProto
+	36, // 65: session.v1.AnalyticsSummaryProto.top_uncovered_tools:type_name -> session.v1.ToolStatProto
+	39, // 66: session.v1.AnalyticsSummaryProto.top_uncovered_programs:type_name -> session.v1.ProgramStatProto
+	41, // 67: session.v1.AnalyticsSummaryProto.command_subcommand_stats:type_name -> session.v1.SubcommandStatProto
+	57, // 68: session.v1.DatabaseInfo.last_used:type_name -> google.protobuf.Timestamp
+	57, // 69: session.v1.CheckpointProto.timestamp:type_name -> google.protobuf.Timestamp
+	57, // 70: session.v1.UnfinishedWorktree.last_modified:type_name -> google.protobuf.Timestamp
+	57, // 71: session.v1.UnfinishedWorktree.scan_time:type_name -> google.protobuf.Timestamp
+	14, // 72: session.v1.UnfinishedWorktree.scan_status:type_name -> session.v1.ScanStatus
+	57, // 73: session.v1.ResourceUsage.sampled_at:type_name -> google.protobuf.Timestamp
+	74, // [74:74] is the sub-list for method output_type
+	74, // [74:74] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_session_v1_types_proto_init() }
@@ -5489,7 +5808,7 @@ func file_session_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_session_v1_types_proto_rawDesc), len(file_session_v1_types_proto_rawDesc)),
 			NumEnums:      15,
-			NumMessages:   40,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
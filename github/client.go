@@ -71,6 +71,8 @@ type PRComment struct {
 	Path      string    `json:"path,omitempty"`     // For review comments
 	Line      int       `json:"line,omitempty"`     // For review comments
 	IsReview  bool      `json:"isReview,omitempty"` // True if this is a review comment
+	DiffHunk  string    `json:"diffHunk,omitempty"` // For review comments: the diff context the comment is anchored to
+	HTMLURL   string    `json:"url,omitempty"`
 }
 
 // ghPRResponse represents the JSON response from gh pr view --json
@@ -62,6 +62,12 @@ func getGHToken(ctx context.Context) string {
 	return res.(string)
 }
 
+// Token returns the GitHub token used for native HTTP calls, resolved with the
+// same precedence as the internal REST helpers. Returns "" when none is available.
+func Token(ctx context.Context) string {
+	return getGHToken(ctx)
+}
+
 // newGHRequest creates an authenticated GET request to the GitHub REST API.
 func newGHRequest(ctx context.Context, path string) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.github.com/"+path, nil)
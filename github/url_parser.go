@@ -459,3 +459,114 @@ func isValidGitHubName(name string) bool {
 	}
 	return true
 }
+
+// ForgeKind identifies the code-hosting platform behind a git remote.
+type ForgeKind string
+
+const (
+	ForgeUnknown ForgeKind = ""
+	ForgeGitHub  ForgeKind = "github"
+	ForgeGitLab  ForgeKind = "gitlab"
+	ForgeGitea   ForgeKind = "gitea"
+)
+
+// RemoteRef is a git remote URL parsed into forge-neutral parts.
+// Unlike ParsedGitHubRef it accepts any host, and Owner may contain slashes
+// for GitLab subgroups (e.g. "group/subgroup").
+type RemoteRef struct {
+	Kind        ForgeKind
+	Host        string // Hostname including any non-default port, e.g. "gitlab.example.com:8443"
+	Owner       string // Namespace path (user, organization or group/subgroup)
+	Repo        string // Repository name without the .git suffix
+	OriginalURL string
+}
+
+// FullName returns "owner/repo" format
+func (r *RemoteRef) FullName() string {
+	return r.Owner + "/" + r.Repo
+}
+
+// WebURL returns the HTTPS URL of the repository's web page
+func (r *RemoteRef) WebURL() string {
+	return fmt.Sprintf("https://%s/%s", r.Host, r.FullName())
+}
+
+var (
+	// scp-like SSH syntax: [user@]host:path/to/repo.git
+	scpRemotePattern = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+	// URL syntax: scheme://[user@]host[:port]/path/to/repo.git
+	urlRemotePattern = regexp.MustCompile(`^(?:https?|ssh|git)://(?:[^@/]+@)?([^/]+)/(.+)$`)
+)
+
+// ParseRemoteURL parses a git remote URL for any forge and infers its kind
+// from the hostname via DetectForgeKind.
+// Supported formats:
+//   - https://host/owner/repo(.git)
+//   - https://host/group/subgroup/repo(.git)
+//   - ssh://git@host:2222/owner/repo(.git)
+//   - git@host:owner/repo(.git)
+func ParseRemoteURL(remote string) (*RemoteRef, error) {
+	remote = strings.TrimSpace(remote)
+	if remote == "" {
+		return nil, fmt.Errorf("empty remote URL")
+	}
+
+	var host, path string
+	if matches := urlRemotePattern.FindStringSubmatch(remote); matches != nil {
+		host, path = matches[1], matches[2]
+		// SSH ports are irrelevant to the forge's HTTP API; HTTP(S) ports are kept.
+		if strings.HasPrefix(remote, "ssh://") || strings.HasPrefix(remote, "git://") {
+			if idx := strings.LastIndex(host, ":"); idx != -1 {
+				host = host[:idx]
+			}
+		}
+	} else if matches := scpRemotePattern.FindStringSubmatch(remote); matches != nil {
+		host, path = matches[1], matches[2]
+	} else {
+		return nil, fmt.Errorf("unrecognized git remote URL format: %s", remote)
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	idx := strings.LastIndex(path, "/")
+	if idx <= 0 || idx == len(path)-1 {
+		return nil, fmt.Errorf("git remote URL has no owner/repo path: %s", remote)
+	}
+
+	return &RemoteRef{
+		Kind:        DetectForgeKind(host),
+		Host:        strings.ToLower(host),
+		Owner:       path[:idx],
+		Repo:        path[idx+1:],
+		OriginalURL: remote,
+	}, nil
+}
+
+// DetectForgeKind guesses the forge from a hostname. Well-known public hosts
+// are matched exactly; self-hosted instances are matched by a product name in
+// the hostname (e.g. "gitlab.corp.example"). Returns ForgeUnknown otherwise.
+func DetectForgeKind(host string) ForgeKind {
+	host = strings.ToLower(host)
+	if idx := strings.LastIndex(host, ":"); idx != -1 {
+		host = host[:idx]
+	}
+
+	switch host {
+	case "github.com", "www.github.com":
+		return ForgeGitHub
+	case "gitlab.com", "www.gitlab.com":
+		return ForgeGitLab
+	case "gitea.com", "codeberg.org":
+		return ForgeGitea
+	}
+
+	switch {
+	case strings.Contains(host, "github"):
+		return ForgeGitHub
+	case strings.Contains(host, "gitlab"):
+		return ForgeGitLab
+	case strings.Contains(host, "gitea"), strings.Contains(host, "forgejo"):
+		return ForgeGitea
+	}
+	return ForgeUnknown
+}
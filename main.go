@@ -66,11 +66,11 @@ var (
 				mcpserver.InitMCPLogging()
 				cfg := config.LoadConfig()
 				_ = cfg // config loaded for side effects (e.g. workspace detection)
-				store, svc, sbMgr, storage, mcpErr := buildMCPDeps()
+				store, svc, sbMgr, storage, panels, mcpErr := buildMCPDeps()
 				if mcpErr != nil {
 					return fmt.Errorf("mcp: init deps: %w", mcpErr)
 				}
-				return mcpserver.RunServer(ctx, store, svc, sbMgr, storage)
+				return mcpserver.RunServer(ctx, store, svc, sbMgr, storage, panels)
 			}
 
 			// Enable test mode if flag is set
@@ -649,6 +649,23 @@ func init() {
 	rootCmd.AddCommand(listSessionsCmd)
 	rootCmd.AddCommand(printQRCodesCmd)
 	rootCmd.AddCommand(commands.GetSessionCmd)
+	rootCmd.AddCommand(commands.NewBackupCmd(version))
+	rootCmd.AddCommand(commands.NewRestoreCmd())
+	rootCmd.AddCommand(commands.NewSandboxExecCmd())
+	rootCmd.AddCommand(commands.NewRecordCmd())
+	rootCmd.AddCommand(commands.NewExportCastCmd())
+	rootCmd.AddCommand(commands.NewNewCmd())
+	rootCmd.AddCommand(commands.NewSendCmd())
+	rootCmd.AddCommand(commands.NewTailCmd())
+	rootCmd.AddCommand(commands.NewAttachCmd())
+	rootCmd.AddCommand(commands.NewApproveCmd())
+	rootCmd.AddCommand(commands.NewDenyCmd())
+	rootCmd.AddCommand(commands.NewQueueCmd())
+	rootCmd.AddCommand(commands.NewBacklogCmd())
+	rootCmd.AddCommand(commands.NewTemplateCmd())
+	rootCmd.AddCommand(commands.NewDiffCmd())
+	rootCmd.AddCommand(commands.NewCheckpointCmd())
+	rootCmd.AddCommand(commands.NewForkCmd())
 }
 
 // resolveLANHostnames returns a list of domain names suitable for use as a WebAuthn rpID
@@ -850,16 +867,18 @@ func startRemoteAccess(ctx context.Context, srv *server.Server, localAddr string
 
 	setupMgr := serverauth.NewSetupManager()
 	inviteMgr := serverauth.NewInviteManager()
+	shareMgr := serverauth.NewShareManager(filepath.Join(configDir, "share-links.json"))
+	srv.SetShareManager(shareMgr)
 
 	// Watch the setup token file for tokens generated by print-qr-codes.
 	setupTokenPath := filepath.Join(configDir, serverauth.SetupTokenFile)
 	go setupMgr.WatchFile(ctx, setupTokenPath)
 
 	// Register auth routes on the shared mux (accessible via both servers).
-	serverauth.RegisterRoutes(srv.Mux(), waHandler, sessions, store, setupMgr, inviteMgr, tlsPaths.CAFile, displayHost, remotePort)
+	serverauth.RegisterRoutes(srv.Mux(), waHandler, sessions, store, setupMgr, inviteMgr, shareMgr, tlsPaths.CAFile, displayHost, remotePort)
 
 	// Start the remote HTTPS server with auth middleware applied.
-	if err := srv.StartRemote(ctx, remoteAddr, tlsCfg, middleware.Auth(sessions)); err != nil {
+	if err := srv.StartRemote(ctx, remoteAddr, tlsCfg, middleware.Auth(sessions, shareMgr)); err != nil {
 		return fmt.Errorf("start remote server: %w", err)
 	}
 
@@ -929,19 +948,20 @@ func buildLogConfig(daemon bool, cfg *config.Config, consoleEnabled bool) *log.L
 // Uses Phase 1+2 only (no tmux startup, no HTTP listener, no background pollers).
 // The ScrollbackManager is read-only in MCP mode — it reads from the same storage
 // path written by the HTTP server process.
-func buildMCPDeps() (session.InstanceStore, *services.SessionService, *scrollback.ScrollbackManager, *session.Storage, error) {
+func buildMCPDeps() (session.InstanceStore, *services.SessionService, *scrollback.ScrollbackManager, *session.Storage, *session.ReviewPanelStore, error) {
 	core, err := server.BuildCoreDeps()
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, err
 	}
 
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("cannot determine home directory for scrollback storage: %w", err)
+		return nil, nil, nil, nil, nil, fmt.Errorf("cannot determine home directory for scrollback storage: %w", err)
 	}
 	sbConfig := scrollback.DefaultScrollbackConfig()
 	sbConfig.StoragePath = filepath.Join(homeDir, ".stapler-squad", "sessions")
 	sbMgr := scrollback.NewScrollbackManager(sbConfig)
+	sbMgr.SetRedactor(session.RedactTerminalOutput)
 
-	return core.Storage, core.SessionService, sbMgr, core.Storage, nil
+	return core.Storage, core.SessionService, sbMgr, core.Storage, core.ReviewPanels, nil
 }
@@ -28,6 +28,7 @@ type EventBus struct {
 	bufferSize  int
 
 	nextSeq atomic.Uint64 // monotonically increasing; zero is reserved (unpublished)
+	dropped atomic.Uint64 // deliveries skipped because a subscriber's buffer was full
 
 	bufMu sync.Mutex
 	buf   []bufferedEvent // ordered by Seq ascending; pruned on each Publish
@@ -89,6 +90,7 @@ func (eb *EventBus) Publish(event *Event) {
 		default:
 			// Subscriber is slow; drop to prevent blocking others.
 			// Client can recover via EventsSince on reconnect.
+			eb.dropped.Add(1)
 		}
 	}
 }
@@ -161,6 +163,12 @@ func (eb *EventBus) SubscriberCount() int {
 	return len(eb.subscribers)
 }
 
+// Dropped returns how many event deliveries have been skipped because a
+// subscriber's buffer was full.
+func (eb *EventBus) Dropped() uint64 {
+	return eb.dropped.Load()
+}
+
 // Close unsubscribes all subscribers and closes their channels.
 // Should be called during graceful shutdown.
 func (eb *EventBus) Close() {
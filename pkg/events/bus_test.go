@@ -242,6 +242,9 @@ drainLoop:
 	if received > bufferSize+1 { // +1 for race condition tolerance
 		t.Errorf("Expected at most %d events (buffer size), got %d", bufferSize, received)
 	}
+	if dropped := bus.Dropped(); dropped != uint64(bufferSize) {
+		t.Errorf("Expected %d dropped deliveries, got %d", bufferSize, dropped)
+	}
 }
 
 // TestEventBusClose tests graceful shutdown.
@@ -1,7 +1,9 @@
 package events
 
 import (
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/activity"
 	"time"
 )
 
@@ -25,6 +27,12 @@ const (
 	EventApprovalResponse EventType = "session.approval_response"
 	// EventNotification is emitted when a session sends a notification
 	EventNotification EventType = "session.notification"
+	// EventConfigChanged is emitted when a valid config.json edit has been applied
+	EventConfigChanged EventType = "config.changed"
+	// EventConfigRejected is emitted when a config.json edit fails validation
+	EventConfigRejected EventType = "config.rejected"
+	// EventAgentActivity is emitted for each agent hook recorded in a session's activity stream
+	EventAgentActivity EventType = "session.agent_activity"
 )
 
 // Event represents a session state change event.
@@ -65,6 +73,12 @@ type Event struct {
 	NotificationTitle    string
 	NotificationMessage  string
 	NotificationMetadata map[string]string
+	// ConfigChange carries the old and new config for config changed events
+	ConfigChange *config.Change
+	// ConfigError lists the validation issues for config rejected events
+	ConfigError *config.ValidationError
+	// Activity is the recorded entry for agent activity events
+	Activity *activity.Entry
 }
 
 // NewSessionCreatedEvent creates an event for session creation.
@@ -163,3 +177,35 @@ func NewNotificationEvent(
 		NotificationMetadata: metadata,
 	}
 }
+
+// NewConfigChangedEvent creates an event for an applied config.json edit.
+// UpdatedFields lists the changed top-level settings.
+func NewConfigChangedEvent(change *config.Change) *Event {
+	return &Event{
+		Type:          EventConfigChanged,
+		Timestamp:     time.Now(),
+		UpdatedFields: change.Fields,
+		ConfigChange:  change,
+	}
+}
+
+// NewConfigRejectedEvent creates an event for a config.json edit that failed validation.
+func NewConfigRejectedEvent(verr *config.ValidationError) *Event {
+	return &Event{
+		Type:        EventConfigRejected,
+		Timestamp:   time.Now(),
+		Context:     verr.Error(),
+		ConfigError: verr,
+	}
+}
+
+// NewAgentActivityEvent creates an event for a recorded agent activity entry.
+func NewAgentActivityEvent(entry activity.Entry) *Event {
+	return &Event{
+		Type:      EventAgentActivity,
+		Timestamp: entry.Time,
+		SessionID: entry.SessionID,
+		Context:   string(entry.Kind),
+		Activity:  &entry,
+	}
+}
@@ -0,0 +1,247 @@
+// Package metrics is a thin layer over the Prometheus client library that
+// keeps instrumentation at call sites small.
+//
+// Instrumented code holds *Counter, *Gauge and *Histogram values obtained from
+// a Registry; all of their methods are no-ops on a nil receiver, so optional
+// instrumentation needs no nil checks at the call site. Label values that do
+// not match the registered label names are logged and dropped rather than
+// panicking. State owned by other components (queue depth, circuit breakers)
+// is read at scrape time through collector functions instead of being
+// mirrored into gauges.
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// Emit records one sample from a collector. Samples emitted with the same
+// label values during one scrape are summed, so collectors can emit once per
+// item rather than pre-aggregating.
+type Emit func(value float64, labelValues ...string)
+
+// CollectFunc produces a family's samples at scrape time.
+type CollectFunc func(emit Emit)
+
+// Registry holds metric families and serves them on scrape.
+type Registry struct {
+	reg     *prometheus.Registry
+	handler http.Handler
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	reg := prometheus.NewRegistry()
+	return &Registry{
+		reg:     reg,
+		handler: promhttp.HandlerFor(reg, promhttp.HandlerOpts{EnableOpenMetrics: true}),
+	}
+}
+
+// DefaultBuckets returns histogram buckets in seconds suited to request
+// latencies, from 5ms to 10s.
+func DefaultBuckets() []float64 {
+	return []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+}
+
+// Counter registers a monotonically increasing counter. The name must end in
+// "_total".
+func (r *Registry) Counter(name, help string, labels ...string) *Counter {
+	mustBeCounterName(name)
+	vec := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)
+	r.reg.MustRegister(vec)
+	return &Counter{name: name, vec: vec}
+}
+
+// Gauge registers a gauge.
+func (r *Registry) Gauge(name, help string, labels ...string) *Gauge {
+	vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)
+	r.reg.MustRegister(vec)
+	return &Gauge{name: name, vec: vec}
+}
+
+// Histogram registers a histogram with the given upper bounds, which must be
+// sorted ascending. The +Inf bucket is implicit.
+func (r *Registry) Histogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	if !sort.Float64sAreSorted(buckets) {
+		panic(fmt.Sprintf("metrics: %s: buckets are not sorted", name))
+	}
+	vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)
+	r.reg.MustRegister(vec)
+	return &Histogram{name: name, vec: vec}
+}
+
+// CollectCounter registers a counter whose samples are produced by fn on each
+// scrape. The name must end in "_total".
+func (r *Registry) CollectCounter(name, help string, fn CollectFunc, labels ...string) {
+	mustBeCounterName(name)
+	r.reg.MustRegister(newCollector(name, help, prometheus.CounterValue, labels, fn))
+}
+
+// CollectGauge registers a gauge whose samples are produced by fn on each
+// scrape.
+func (r *Registry) CollectGauge(name, help string, fn CollectFunc, labels ...string) {
+	r.reg.MustRegister(newCollector(name, help, prometheus.GaugeValue, labels, fn))
+}
+
+// ServeHTTP serves a scrape, choosing OpenMetrics when the scraper asks for it.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.handler.ServeHTTP(w, req)
+}
+
+// mustBeCounterName panics, like registration itself, on a counter name that
+// would not follow the naming conventions.
+func mustBeCounterName(name string) {
+	if !strings.HasSuffix(name, "_total") {
+		panic(fmt.Sprintf("metrics: counter %q must end in _total", name))
+	}
+}
+
+// collector adapts a CollectFunc to prometheus.Collector.
+type collector struct {
+	name      string
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	labels    int
+	fn        CollectFunc
+}
+
+func newCollector(name, help string, valueType prometheus.ValueType, labels []string, fn CollectFunc) *collector {
+	return &collector{
+		name:      name,
+		desc:      prometheus.NewDesc(name, help, labels, nil),
+		valueType: valueType,
+		labels:    len(labels),
+		fn:        fn,
+	}
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) { ch <- c.desc }
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	type sample struct {
+		labelValues []string
+		value       float64
+	}
+	samples := make(map[string]*sample)
+	var order []string
+	c.fn(func(value float64, labelValues ...string) {
+		if !labelCountOK(c.name, c.labels, labelValues) {
+			return
+		}
+		key := strings.Join(labelValues, "\xff")
+		s, ok := samples[key]
+		if !ok {
+			s = &sample{labelValues: append([]string(nil), labelValues...)}
+			samples[key] = s
+			order = append(order, key)
+		}
+		s.value += value
+	})
+	for _, key := range order {
+		s := samples[key]
+		m, err := prometheus.NewConstMetric(c.desc, c.valueType, s.value, s.labelValues...)
+		if err != nil {
+			log.Warn("metrics: dropping collected sample", "metric", c.name, "err", err)
+			continue
+		}
+		ch <- m
+	}
+}
+
+// labelCountOK logs and reports false when labelValues does not match the
+// number of registered label names.
+func labelCountOK(name string, want int, labelValues []string) bool {
+	if len(labelValues) != want {
+		log.Warn("metrics: wrong number of label values", "metric", name, "want", want, "got", len(labelValues))
+		return false
+	}
+	return true
+}
+
+// Counter is a monotonically increasing value per label combination.
+type Counter struct {
+	name string
+	vec  *prometheus.CounterVec
+}
+
+// Inc adds one to the series for labelValues.
+func (c *Counter) Inc(labelValues ...string) { c.Add(1, labelValues...) }
+
+// Add adds v, which must not be negative, to the series for labelValues.
+func (c *Counter) Add(v float64, labelValues ...string) {
+	if c == nil || v < 0 {
+		return
+	}
+	m, err := c.vec.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		log.Warn("metrics: wrong label values", "metric", c.name, "err", err)
+		return
+	}
+	m.Add(v)
+}
+
+// Gauge is a value that can go up and down per label combination.
+type Gauge struct {
+	name string
+	vec  *prometheus.GaugeVec
+}
+
+// Set sets the series for labelValues to v.
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	if m := g.series(labelValues); m != nil {
+		m.Set(v)
+	}
+}
+
+// Add adds v (which may be negative) to the series for labelValues.
+func (g *Gauge) Add(v float64, labelValues ...string) {
+	if m := g.series(labelValues); m != nil {
+		m.Add(v)
+	}
+}
+
+// Inc adds one to the series for labelValues.
+func (g *Gauge) Inc(labelValues ...string) { g.Add(1, labelValues...) }
+
+// Dec subtracts one from the series for labelValues.
+func (g *Gauge) Dec(labelValues ...string) { g.Add(-1, labelValues...) }
+
+func (g *Gauge) series(labelValues []string) prometheus.Gauge {
+	if g == nil {
+		return nil
+	}
+	m, err := g.vec.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		log.Warn("metrics: wrong label values", "metric", g.name, "err", err)
+		return nil
+	}
+	return m
+}
+
+// Histogram counts observations into buckets per label combination.
+type Histogram struct {
+	name string
+	vec  *prometheus.HistogramVec
+}
+
+// Observe records v in the series for labelValues.
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	if h == nil || math.IsNaN(v) {
+		return
+	}
+	m, err := h.vec.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		log.Warn("metrics: wrong label values", "metric", h.name, "err", err)
+		return
+	}
+	m.Observe(v)
+}
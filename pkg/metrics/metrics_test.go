@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// scrape serves one text-format scrape of r.
+func scrape(r *Registry) string {
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	return rec.Body.String()
+}
+
+func TestServeHTTP_Text(t *testing.T) {
+	r := NewRegistry()
+	c := r.Counter("app_requests_total", "Requests served.", "code")
+	c.Inc("200")
+	c.Add(2, "200")
+	c.Inc("500")
+	g := r.Gauge("app_clients", "Connected clients.")
+	g.Inc()
+	g.Inc()
+	g.Dec()
+	h := r.Histogram("app_latency_seconds", "Latency.", []float64{0.1, 1})
+	h.Observe(0.05)
+	h.Observe(0.1)
+	h.Observe(3)
+	r.CollectGauge("app_items", "Items by kind.", func(emit Emit) {
+		emit(1, `a"b`)
+		emit(1, `a"b`)
+		emit(1, "c\nd")
+	}, "kind")
+
+	got := scrape(r)
+	want := `# HELP app_clients Connected clients.
+# TYPE app_clients gauge
+app_clients 1
+# HELP app_items Items by kind.
+# TYPE app_items gauge
+app_items{kind="a\"b"} 2
+app_items{kind="c\nd"} 1
+# HELP app_latency_seconds Latency.
+# TYPE app_latency_seconds histogram
+app_latency_seconds_bucket{le="0.1"} 2
+app_latency_seconds_bucket{le="1"} 2
+app_latency_seconds_bucket{le="+Inf"} 3
+app_latency_seconds_sum 3.15
+app_latency_seconds_count 3
+# HELP app_requests_total Requests served.
+# TYPE app_requests_total counter
+app_requests_total{code="200"} 3
+app_requests_total{code="500"} 1
+`
+	if got != want {
+		t.Errorf("scrape =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestServeHTTP_OpenMetrics(t *testing.T) {
+	r := NewRegistry()
+	r.Counter("app_requests_total", "Requests served.").Inc()
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/openmetrics-text") {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	body := rec.Body.String()
+	for _, line := range []string{"# TYPE app_requests counter\n", "app_requests_total 1.0\n"} {
+		if !strings.Contains(body, line) {
+			t.Errorf("body missing %q:\n%s", line, body)
+		}
+	}
+	if !strings.HasSuffix(body, "# EOF\n") {
+		t.Errorf("body does not end with # EOF:\n%s", body)
+	}
+}
+
+func TestNilMetricsAreNoOps(t *testing.T) {
+	var c *Counter
+	var g *Gauge
+	var h *Histogram
+	c.Inc()
+	g.Set(1)
+	h.Observe(1)
+}
+
+func TestWrongLabelCountIsDropped(t *testing.T) {
+	r := NewRegistry()
+	c := r.Counter("app_requests_total", "Requests served.", "code")
+	c.Inc()
+	c.Inc("200", "extra")
+
+	if body := scrape(r); strings.Contains(body, "\napp_requests_total") {
+		t.Errorf("mislabelled samples were recorded:\n%s", body)
+	}
+}
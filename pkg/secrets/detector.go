@@ -0,0 +1,354 @@
+// Package secrets detects credentials in text: commands, diffs and terminal
+// output. A single Detector backs every place stapler-squad looks for secrets
+// — command approval, terminal and scrollback redaction, the commit/push guard
+// and the backlog review gate — so a rule added here is enforced everywhere.
+//
+// Detection combines a table of provider-specific token formats with generic
+// key/value rules filtered by Shannon entropy. False positives are controlled
+// with allowlists (configured globally) and per-repository ignore files.
+package secrets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Options configures a Detector.
+type Options struct {
+	// Rules replaces the built-in rule set when non-nil.
+	Rules []Rule
+	// Allowlist holds regular expressions; findings whose secret value matches
+	// any of them are discarded.
+	Allowlist []string
+	// DisabledRules lists rule IDs to skip.
+	DisabledRules []string
+}
+
+// Finding is one detected secret. It deliberately does not expose the secret
+// value: findings end up in logs, error messages and RPC responses.
+type Finding struct {
+	RuleID      string
+	Description string
+	// Start and End are byte offsets of the secret value in the scanned text
+	// (for ScanDiff, in the diff).
+	Start, End int
+	// Line is the 1-based line of the secret in the scanned text, or in the
+	// new version of File for ScanDiff.
+	Line int
+	// File is the path the secret was added to. Only set by ScanDiff.
+	File string
+	// Fingerprint identifies the secret value without revealing it. Adding it
+	// to an ignore file suppresses this specific value.
+	Fingerprint string
+}
+
+// String formats the finding for error messages.
+func (f Finding) String() string {
+	loc := fmt.Sprintf("line %d", f.Line)
+	if f.File != "" {
+		loc = fmt.Sprintf("%s:%d", f.File, f.Line)
+	}
+	return fmt.Sprintf("%s at %s (fingerprint %s)", f.Description, loc, f.Fingerprint)
+}
+
+// Detector scans text for secrets. It is immutable and safe for concurrent
+// use.
+type Detector struct {
+	rules        []Rule
+	placeholders []*regexp.Regexp // defaultAllowlist, compiled once
+	allowlist    []*regexp.Regexp
+	ignore       *Ignore
+}
+
+// NewDetector builds a detector from opts. It fails if an allowlist entry is
+// not a valid regular expression.
+func NewDetector(opts Options) (*Detector, error) {
+	rules := opts.Rules
+	if rules == nil {
+		rules = DefaultRules()
+	}
+	disabled := make(map[string]bool, len(opts.DisabledRules))
+	for _, id := range opts.DisabledRules {
+		disabled[id] = true
+	}
+	d := &Detector{placeholders: defaultAllowlist()}
+	for _, r := range rules {
+		if !disabled[r.ID] {
+			d.rules = append(d.rules, r)
+		}
+	}
+	for _, pattern := range opts.Allowlist {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret allowlist pattern %q: %w", pattern, err)
+		}
+		d.allowlist = append(d.allowlist, re)
+	}
+	return d, nil
+}
+
+// NewDefaultDetector returns a detector with the built-in rules and no
+// additional allowlist.
+func NewDefaultDetector() *Detector {
+	d, _ := NewDetector(Options{})
+	return d
+}
+
+// WithIgnore returns a detector that additionally applies a repository's
+// ignore file. A nil ignore returns d unchanged.
+func (d *Detector) WithIgnore(ig *Ignore) *Detector {
+	if ig == nil {
+		return d
+	}
+	derived := *d
+	derived.ignore = ig
+	derived.rules = nil
+	for _, r := range d.rules {
+		if !ig.rules[r.ID] {
+			derived.rules = append(derived.rules, r)
+		}
+	}
+	return &derived
+}
+
+// candidate is a rule match before overlap resolution.
+type candidate struct {
+	rule       int
+	start, end int // secret value
+	mStart     int // whole match, for overlap resolution
+	mEnd       int
+	secret     string
+}
+
+// candidates returns every allowed match of every rule, in rule order.
+func (d *Detector) candidates(text string) []candidate {
+	var out []candidate
+	for i, r := range d.rules {
+		for _, loc := range r.Regex.FindAllStringSubmatchIndex(text, -1) {
+			c := candidate{rule: i, start: loc[0], end: loc[1], mStart: loc[0], mEnd: loc[1]}
+			if len(loc) >= 4 && loc[2] >= 0 {
+				c.start, c.end = loc[2], loc[3]
+			}
+			c.secret = text[c.start:c.end]
+			if d.allowed(r, c.secret, text[c.end:]) {
+				continue
+			}
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// allowed reports whether a match should be discarded.
+func (d *Detector) allowed(r Rule, secret, rest string) bool {
+	if r.MinEntropy > 0 && Entropy(strings.Trim(secret, `"'`)) < r.MinEntropy {
+		return true
+	}
+	if r.Regex.NumSubexp() > 0 {
+		// Keyword rules capture whatever follows "password=". Skip values
+		// that are placeholders or code rather than literals, including a
+		// function call such as password=getenv("X").
+		if strings.HasPrefix(rest, "(") {
+			return true
+		}
+		for _, re := range d.placeholders {
+			if re.MatchString(secret) {
+				return true
+			}
+		}
+	}
+	for _, re := range d.allowlist {
+		if re.MatchString(secret) {
+			return true
+		}
+	}
+	return d.ignore != nil && d.ignore.allowsValue(secret)
+}
+
+// Scan returns every secret in text, ordered by position. Where matches of
+// different rules overlap, only the earliest rule's match is reported.
+func (d *Detector) Scan(text string) []Finding {
+	cands := d.candidates(text)
+	var kept []candidate
+	for _, c := range cands {
+		overlaps := false
+		for _, k := range kept {
+			if c.mStart < k.mEnd && k.mStart < c.mEnd {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			kept = append(kept, c)
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].start < kept[j].start })
+
+	findings := make([]Finding, 0, len(kept))
+	for _, c := range kept {
+		findings = append(findings, d.finding(c, text))
+	}
+	return findings
+}
+
+// Match returns the finding of the highest-priority rule that matches text.
+// It is cheaper than Scan when only a yes/no answer is needed.
+func (d *Detector) Match(text string) (Finding, bool) {
+	for i, r := range d.rules {
+		for _, loc := range r.Regex.FindAllStringSubmatchIndex(text, -1) {
+			c := candidate{rule: i, start: loc[0], end: loc[1]}
+			if len(loc) >= 4 && loc[2] >= 0 {
+				c.start, c.end = loc[2], loc[3]
+			}
+			c.secret = text[c.start:c.end]
+			if !d.allowed(r, c.secret, text[c.end:]) {
+				return d.finding(c, text), true
+			}
+		}
+	}
+	return Finding{}, false
+}
+
+func (d *Detector) finding(c candidate, text string) Finding {
+	r := d.rules[c.rule]
+	return Finding{
+		RuleID:      r.ID,
+		Description: r.Description,
+		Start:       c.start,
+		End:         c.end,
+		Line:        strings.Count(text[:c.start], "\n") + 1,
+		Fingerprint: Fingerprint(c.secret),
+	}
+}
+
+// Redact replaces every secret value in text with a placeholder naming the
+// rule, e.g. "[REDACTED:github-pat]", and returns the findings.
+func (d *Detector) Redact(text string) (string, []Finding) {
+	findings := d.Scan(text)
+	if len(findings) == 0 {
+		return text, nil
+	}
+	var sb strings.Builder
+	sb.Grow(len(text))
+	prev := 0
+	for _, f := range findings {
+		sb.WriteString(text[prev:f.Start])
+		sb.WriteString(Placeholder(f.RuleID))
+		prev = f.End
+	}
+	sb.WriteString(text[prev:])
+	return sb.String(), findings
+}
+
+// RedactBytes is Redact for byte slices. It returns data itself when nothing
+// was redacted.
+func (d *Detector) RedactBytes(data []byte) []byte {
+	if len(data) == 0 {
+		return data
+	}
+	redacted, findings := d.Redact(string(data))
+	if len(findings) == 0 {
+		return data
+	}
+	return []byte(redacted)
+}
+
+// ScanDiff returns the secrets on added lines of a unified diff. Line and
+// File refer to the new version of each file. Files matched by the ignore
+// file's path patterns are skipped.
+func (d *Detector) ScanDiff(diff string) []Finding {
+	var findings []Finding
+	file := ""
+	newLine := 0
+	offset := 0
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		lineStart := offset
+		offset += len(line)
+		content := strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(content, "+++ "):
+			file = strings.TrimPrefix(strings.TrimPrefix(content, "+++ "), "b/")
+			if file == "/dev/null" {
+				file = ""
+			}
+		case strings.HasPrefix(content, "@@"):
+			newLine = hunkNewStart(content)
+		case strings.HasPrefix(content, "+"):
+			if d.ignore == nil || !d.ignore.skipsPath(file) {
+				for _, f := range d.Scan(content[1:]) {
+					f.File = file
+					f.Line = newLine
+					f.Start += lineStart + 1
+					f.End += lineStart + 1
+					findings = append(findings, f)
+				}
+			}
+			newLine++
+		case strings.HasPrefix(content, " "):
+			newLine++
+		}
+	}
+	return findings
+}
+
+// hunkNewStart parses the new-file start line from "@@ -a,b +c,d @@".
+func hunkNewStart(header string) int {
+	plus := strings.Index(header, " +")
+	if plus < 0 {
+		return 0
+	}
+	var start int
+	fmt.Sscanf(header[plus+2:], "%d", &start)
+	return start
+}
+
+// Placeholder is the text substituted for a redacted secret.
+func Placeholder(ruleID string) string {
+	return "[REDACTED:" + ruleID + "]"
+}
+
+// Fingerprint returns a short, stable identifier for a secret value that is
+// safe to show and to commit to an ignore file.
+func Fingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Entropy returns the Shannon entropy of s in bits per character.
+func Entropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		h -= p * math.Log2(p)
+	}
+	return h
+}
+
+// Summarize formats findings as a short multi-line list for error messages,
+// capped at limit entries.
+func Summarize(findings []Finding, limit int) string {
+	var sb strings.Builder
+	for i, f := range findings {
+		if i == limit {
+			fmt.Fprintf(&sb, "\n  … and %d more", len(findings)-limit)
+			break
+		}
+		sb.WriteString("\n  - ")
+		sb.WriteString(f.String())
+	}
+	return sb.String()
+}
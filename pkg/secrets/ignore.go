@@ -0,0 +1,113 @@
+package secrets
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// IgnoreFileName is the per-repository ignore file, read from the repository
+// (worktree) root. Each non-blank, non-comment line is one of:
+//
+//	rule:<rule-id>      disable a rule in this repository
+//	path:<glob>         ignore secrets added to matching files (diff scans only)
+//	regex:<expression>  ignore secret values matching the expression
+//	<fingerprint>       ignore one specific value, using the fingerprint shown
+//	                    in the error message so the value itself is never
+//	                    committed
+const IgnoreFileName = ".secretsignore"
+
+// Ignore holds the parsed contents of an ignore file.
+type Ignore struct {
+	rules        map[string]bool
+	paths        []string
+	values       []*regexp.Regexp
+	fingerprints map[string]bool
+}
+
+// LoadIgnore reads IgnoreFileName from dir. A missing file yields an empty
+// Ignore.
+func LoadIgnore(dir string) (*Ignore, error) {
+	f, err := os.Open(filepath.Join(dir, IgnoreFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Ignore{}, nil
+		}
+		return nil, fmt.Errorf("open %s: %w", IgnoreFileName, err)
+	}
+	defer f.Close()
+	return ParseIgnore(f)
+}
+
+// ParseIgnore parses ignore file contents.
+func ParseIgnore(r io.Reader) (*Ignore, error) {
+	ig := &Ignore{rules: make(map[string]bool), fingerprints: make(map[string]bool)}
+	sc := bufio.NewScanner(r)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, "rule:"):
+			ig.rules[strings.TrimSpace(strings.TrimPrefix(line, "rule:"))] = true
+		case strings.HasPrefix(line, "path:"):
+			ig.paths = append(ig.paths, strings.TrimSpace(strings.TrimPrefix(line, "path:")))
+		case strings.HasPrefix(line, "regex:"):
+			re, err := regexp.Compile(strings.TrimSpace(strings.TrimPrefix(line, "regex:")))
+			if err != nil {
+				return nil, fmt.Errorf("%s line %d: %w", IgnoreFileName, lineNo, err)
+			}
+			ig.values = append(ig.values, re)
+		default:
+			ig.fingerprints[line] = true
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", IgnoreFileName, err)
+	}
+	return ig, nil
+}
+
+func (ig *Ignore) allowsValue(secret string) bool {
+	if ig.fingerprints[Fingerprint(secret)] {
+		return true
+	}
+	for _, re := range ig.values {
+		if re.MatchString(secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipsPath reports whether secrets in file are ignored. A pattern matches
+// the full slash-separated path, the base name, or, when it ends in "/", any
+// path below that directory.
+func (ig *Ignore) skipsPath(file string) bool {
+	if file == "" {
+		return false
+	}
+	for _, p := range ig.paths {
+		if strings.HasSuffix(p, "/") {
+			if strings.HasPrefix(file, p) {
+				return true
+			}
+			continue
+		}
+		if ok, _ := path.Match(p, file); ok {
+			return true
+		}
+		if ok, _ := path.Match(p, path.Base(file)); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package secrets
+
+import "regexp"
+
+// Rule describes one secret format.
+type Rule struct {
+	// ID is a stable kebab-case identifier used in redaction placeholders,
+	// ignore files and config.
+	ID string
+	// Description is the human-readable name shown in error messages.
+	Description string
+	// Regex matches the secret. When it has a capture group, group 1 is the
+	// secret value and the rest of the match is context (a key name, a flag)
+	// that is kept when redacting.
+	Regex *regexp.Regexp
+	// MinEntropy, when non-zero, discards matches whose secret value has a
+	// Shannon entropy below this many bits per character. Used by the generic
+	// rules to tell random tokens from ordinary words.
+	MinEntropy float64
+}
+
+// DefaultRules returns the built-in rule set, most specific first. When two
+// rules match overlapping text the earlier rule wins, so provider-specific
+// token formats must precede the generic keyword rules.
+func DefaultRules() []Rule {
+	return []Rule{
+		// Private keys.
+		{ID: "private-key", Description: "PEM private key",
+			Regex: regexp.MustCompile(`-----BEGIN[A-Z ]{0,30}PRIVATE KEY(?: BLOCK)?-----(?s:.*?)-----END[A-Z ]{0,30}PRIVATE KEY(?: BLOCK)?-----`)},
+		{ID: "private-key-header", Description: "PEM private key",
+			Regex: regexp.MustCompile(`-----BEGIN[A-Z ]{0,30}PRIVATE KEY(?: BLOCK)?-----`)},
+		{ID: "putty-private-key", Description: "PuTTY private key",
+			Regex: regexp.MustCompile(`PuTTY-User-Key-File-\d: `)},
+
+		// Source forges.
+		{ID: "github-pat", Description: "GitHub personal access token",
+			Regex: regexp.MustCompile(`\bghp_[A-Za-z0-9]{36}\b`)},
+		{ID: "github-fine-grained-pat", Description: "GitHub fine-grained personal access token",
+			Regex: regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{82}\b`)},
+		{ID: "github-oauth", Description: "GitHub OAuth token",
+			Regex: regexp.MustCompile(`\bgho_[A-Za-z0-9]{36}\b`)},
+		{ID: "github-app-token", Description: "GitHub Actions token",
+			Regex: regexp.MustCompile(`\bgh[su]_[A-Za-z0-9]{36}\b`)},
+		{ID: "github-refresh-token", Description: "GitHub refresh token",
+			Regex: regexp.MustCompile(`\bghr_[A-Za-z0-9]{36,76}\b`)},
+		{ID: "gitlab-pat", Description: "GitLab personal access token",
+			Regex: regexp.MustCompile(`\bglpat-[A-Za-z0-9_\-]{20,}\b`)},
+		{ID: "gitlab-runner-token", Description: "GitLab runner token",
+			Regex: regexp.MustCompile(`\bglrt-[A-Za-z0-9_\-]{20,}\b`)},
+
+		// Cloud providers.
+		{ID: "aws-access-key-id", Description: "AWS access key ID",
+			Regex: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b`)},
+		{ID: "aws-secret-access-key", Description: "AWS secret access key",
+			Regex: regexp.MustCompile(`(?i)aws_?secret_?(?:access_?)?key["']?\s*(?::|=|:=|=>)\s*["']?([A-Za-z0-9/+=]{40})\b`)},
+		{ID: "gcp-api-key", Description: "Google API key",
+			Regex: regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`)},
+		{ID: "gcp-service-account", Description: "Google service account key",
+			Regex: regexp.MustCompile(`"private_key_id"\s*:\s*"([a-f0-9]{40})"`)},
+		{ID: "azure-storage-key", Description: "Azure storage account key",
+			Regex: regexp.MustCompile(`AccountKey=([A-Za-z0-9+/]{86}==)`)},
+		{ID: "digitalocean-token", Description: "DigitalOcean token",
+			Regex: regexp.MustCompile(`\bdo[opr]_v1_[a-f0-9]{64}\b`)},
+		{ID: "databricks-token", Description: "Databricks token",
+			Regex: regexp.MustCompile(`\bdapi[a-f0-9]{32}(?:-\d)?\b`)},
+		{ID: "vault-token", Description: "HashiCorp Vault token",
+			Regex: regexp.MustCompile(`\bhv[sb]\.[A-Za-z0-9_\-]{24,}\b`)},
+
+		// AI providers.
+		{ID: "anthropic-api-key", Description: "Anthropic API key",
+			Regex: regexp.MustCompile(`\bsk-ant-[A-Za-z0-9\-_]{20,}\b`)},
+		{ID: "openai-project-key", Description: "OpenAI API key",
+			Regex: regexp.MustCompile(`\bsk-(?:proj|svcacct|admin)-[A-Za-z0-9_\-]{40,}\b`)},
+		{ID: "openai-api-key", Description: "OpenAI API key",
+			Regex: regexp.MustCompile(`\bsk-[A-Za-z0-9]{32,48}\b`)},
+		{ID: "huggingface-token", Description: "Hugging Face token",
+			Regex: regexp.MustCompile(`\bhf_[A-Za-z0-9]{34}\b`)},
+
+		// SaaS and package registries.
+		{ID: "slack-token", Description: "Slack token",
+			Regex: regexp.MustCompile(`\bxox[baposr]-[0-9A-Za-z\-]{10,}\b`)},
+		{ID: "slack-webhook", Description: "Slack webhook URL",
+			Regex: regexp.MustCompile(`https://hooks\.slack\.com/(?:services|workflows)/[A-Za-z0-9+/]{40,}`)},
+		{ID: "stripe-key", Description: "Stripe API key",
+			Regex: regexp.MustCompile(`\b(?:sk|rk)_(?:live|test)_[0-9A-Za-z]{24,}\b`)},
+		{ID: "twilio-api-key", Description: "Twilio API key",
+			Regex: regexp.MustCompile(`\bSK[0-9a-f]{32}\b`)},
+		{ID: "sendgrid-api-key", Description: "SendGrid API key",
+			Regex: regexp.MustCompile(`\bSG\.[A-Za-z0-9_\-]{22}\.[A-Za-z0-9_\-]{43}\b`)},
+		{ID: "mailgun-api-key", Description: "Mailgun API key",
+			Regex: regexp.MustCompile(`\bkey-[0-9a-f]{32}\b`)},
+		{ID: "shopify-token", Description: "Shopify token",
+			Regex: regexp.MustCompile(`\bshp(?:at|ca|pa|ss)_[a-fA-F0-9]{32}\b`)},
+		{ID: "square-token", Description: "Square access token",
+			Regex: regexp.MustCompile(`\b(?:EAAA|sq0atp-)[0-9A-Za-z_\-]{22,60}\b`)},
+		{ID: "linear-api-key", Description: "Linear API key",
+			Regex: regexp.MustCompile(`\blin_api_[A-Za-z0-9]{40}\b`)},
+		{ID: "telegram-bot-token", Description: "Telegram bot token",
+			Regex: regexp.MustCompile(`\b[0-9]{8,10}:AA[0-9A-Za-z_\-]{33}\b`)},
+		{ID: "npm-token", Description: "npm access token",
+			Regex: regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`)},
+		{ID: "pypi-token", Description: "PyPI upload token",
+			Regex: regexp.MustCompile(`\bpypi-AgEIcHlwaS5vcmc[A-Za-z0-9_\-]{50,}`)},
+		{ID: "dockerhub-pat", Description: "Docker Hub personal access token",
+			Regex: regexp.MustCompile(`\bdckr_pat_[A-Za-z0-9_\-]{27,}`)},
+
+		// Structured tokens.
+		{ID: "jwt", Description: "JWT token",
+			Regex: regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}`)},
+		{ID: "url-credentials", Description: "Credentials in URL",
+			Regex: regexp.MustCompile(`\b[A-Za-z][A-Za-z0-9+.\-]{1,20}://[^\s:/@$]+:([^\s:/@$]{3,})@`)},
+
+		// Generic assignments. These run last so the provider rules above get
+		// first claim on any token they recognize.
+		//
+		// The \b word boundary is intentionally omitted so that env vars like
+		// PGPASSWORD or MYSQL_ROOT_PASSWORD (where the keyword is a suffix) are
+		// also caught. Shell variable references ($VAR, "$VAR", '${VAR}') are
+		// excluded: a command like -Pflyway.password="$RDS_TOKEN" does not
+		// contain a plaintext secret.
+		{ID: "inline-secret-env", Description: "Inline secret env var",
+			Regex: regexp.MustCompile(`(?i)(?:password|passwd|secret|api_?key|auth_?token|access_?token|private_?key)\s*=\s*("[^"$][^"]{6,}"|'[^'$][^']{6,}'|[^\s$"'][^\s]{7,})`)},
+		// Well-known database / service credential env vars with the keyword as
+		// a suffix (PGPASSWORD, MYSQL_PWD, …), which allow shorter values.
+		{ID: "database-credential-env", Description: "Database credential env var",
+			Regex: regexp.MustCompile(`(?i)(?:pg|mysql|mariadb|postgres|redis|mongo(?:db)?|db|database|rabbitmq|smtp|mail)(?:_root)?(?:_?password|_?passwd|_?pwd|_pass)\s*=\s*("[^"$][^"]{2,}"|'[^'$][^']{2,}'|[^\s$"'][^\s]{3,})`)},
+		// CLI flags: --password=value --token=value etc.
+		{ID: "inline-secret-flag", Description: "Inline secret CLI flag",
+			Regex: regexp.MustCompile(`(?i)--(?:password|passwd|secret|api-?key|auth-?token|access-?token|private-?key)\s*(?:=|\s+)("[^"$][^"]{6,}"|'[^'$][^']{6,}'|[^\s$"'-][^\s]{7,})`)},
+		// Any key/value pair whose key names a credential and whose value
+		// looks random: config files, JSON, YAML and .env files.
+		{ID: "generic-secret", Description: "High-entropy secret assignment",
+			Regex:      regexp.MustCompile(`(?i)[A-Za-z0-9_.\-]*(?:secret|token|api_?key|apikey|passwd|password|credential|auth)[A-Za-z0-9_.\-]*["']?\s*(?::|=|:=|=>)\s*["']?([A-Za-z0-9+/_.~=\-]{16,})`),
+			MinEntropy: 3.5},
+	}
+}
+
+// defaultAllowlist matches secret values that are placeholders rather than
+// credentials: templated references, masked values and stock example text.
+func defaultAllowlist() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(`(?i)^["']?(?:x{4,}|\*{3,}|\.{3,}|-{3,}|0{8,}|changeme|change_me|redacted|placeholder|dummy|sample|example|none|null|undefined|true|false)["']?$`),
+		regexp.MustCompile(`(?i)^["']?(?:your|my|insert|replace|enter)[_\-][a-z_\-]+["']?$`),
+		regexp.MustCompile(`^["']?(?:<[^>]*>|\{\{.*\}\}|%\(?[A-Za-z_]+\)?s?|\$\{?[A-Za-z_][A-Za-z0-9_]*\}?)["']?$`),
+		// Dotted identifiers and function calls are references
+		// (config.password, os.getenv("TOKEN")), not literals.
+		regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)+$`),
+		regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*\(.*\)[;,]?$`),
+	}
+}
@@ -0,0 +1,146 @@
+package secrets
+
+import (
+	"bytes"
+	"strings"
+)
+
+const (
+	// streamHoldMin is the shortest trailing token held back for the next
+	// chunk. Shorter tails are emitted immediately so keystroke echo is never
+	// delayed; no rule matches fewer than this many characters of token.
+	streamHoldMin = 8
+	// streamHoldMax bounds how much output is held back. A longer unbroken
+	// run is emitted (redacted as far as it can be) rather than buffered.
+	streamHoldMax = 1024
+	// streamContext is how much already-emitted output is kept as scan
+	// context, so "PASSWORD=" in one chunk still qualifies a value in the next.
+	streamContext = 128
+)
+
+// StreamRedactor redacts a stream of terminal output chunk by chunk. Secrets
+// split across chunks are caught by holding back a trailing partial token
+// until the next chunk (or Flush) arrives. Multi-line PEM private keys are
+// redacted line by line between their BEGIN and END markers.
+//
+// A StreamRedactor is not safe for concurrent use; create one per stream.
+type StreamRedactor struct {
+	d       *Detector
+	pending []byte
+	context string
+	inKey   bool
+}
+
+// NewStreamRedactor returns a redactor for one output stream.
+func (d *Detector) NewStreamRedactor() *StreamRedactor {
+	return &StreamRedactor{d: d}
+}
+
+// Write consumes chunk and returns the output that is safe to emit now, with
+// secrets redacted. The returned slice may be empty when the whole chunk is
+// held back.
+func (r *StreamRedactor) Write(chunk []byte) []byte {
+	buf := append(r.pending, chunk...)
+	r.pending = nil
+
+	split := lastBoundary(buf) + 1
+	if tail := len(buf) - split; tail >= streamHoldMin && tail <= streamHoldMax {
+		r.pending = append([]byte(nil), buf[split:]...)
+		buf = buf[:split]
+	}
+	return r.redact(buf)
+}
+
+// Pending reports whether output is being held back. Callers should Flush
+// after a short idle period so held output is not delayed indefinitely.
+func (r *StreamRedactor) Pending() bool {
+	return len(r.pending) > 0
+}
+
+// Flush returns any held-back output, redacted.
+func (r *StreamRedactor) Flush() []byte {
+	buf := r.pending
+	r.pending = nil
+	return r.redact(buf)
+}
+
+func (r *StreamRedactor) redact(buf []byte) []byte {
+	if len(buf) == 0 {
+		return nil
+	}
+	text := string(buf)
+	var out strings.Builder
+	for text != "" {
+		if r.inKey {
+			end := strings.Index(text, "-----END")
+			if end < 0 {
+				out.WriteString(maskLines(text))
+				return []byte(out.String())
+			}
+			out.WriteString(maskLines(text[:end]))
+			text = text[end:]
+			r.inKey = false
+			r.context = ""
+			continue
+		}
+
+		scan := r.context + text
+		offset := len(r.context)
+		findings := r.d.Scan(scan)
+		prev := offset
+		for _, f := range findings {
+			if f.End <= offset {
+				continue // entirely in already-emitted context
+			}
+			start := f.Start
+			if start < offset {
+				start = offset
+			}
+			out.WriteString(scan[prev:start])
+			out.WriteString(Placeholder(f.RuleID))
+			prev = f.End
+			if f.RuleID == "private-key-header" {
+				// The key body follows on later lines (possibly in later
+				// chunks); mask it until the END marker.
+				r.inKey = true
+				break
+			}
+		}
+		if r.inKey {
+			text = scan[prev:]
+			continue
+		}
+		out.WriteString(scan[prev:])
+		r.context = tailString(scan, streamContext)
+		return []byte(out.String())
+	}
+	return []byte(out.String())
+}
+
+// lastBoundary returns the index of the last byte that cannot be part of a
+// secret token (whitespace and terminal control characters), or -1.
+func lastBoundary(b []byte) int {
+	return bytes.LastIndexFunc(b, func(c rune) bool {
+		return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == 0x1b || c == 0x07
+	})
+}
+
+// maskLines replaces the content of every line in s with a placeholder,
+// keeping line endings so the terminal layout is preserved.
+func maskLines(s string) string {
+	lines := strings.SplitAfter(s, "\n")
+	for i, line := range lines {
+		body := strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(body) != "" {
+			lines[i] = Placeholder("private-key") + line[len(body):]
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+func tailString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[len(s)-n:]
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/tstapler/stapler-squad/session"
 	"github.com/tstapler/stapler-squad/session/detection"
 	"github.com/tstapler/stapler-squad/session/detection/ratelimit"
+	"github.com/tstapler/stapler-squad/session/sandbox"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -95,10 +96,50 @@ func InstanceToProto(inst *session.Instance) *sessionv1.Session {
 		protoSession.RateLimitResetTime = timestamppb.New(t)
 	}
 	protoSession.RateLimitEnabled = inst.IsRateLimitEnabled()
+	protoSession.PrFeedbackEnabled = inst.IsPRFeedbackEnabled()
+	protoSession.CiAutofixEnabled = inst.IsCIAutoFixEnabled()
+	protoSession.ResourceUsage = resourceUsageToProto(inst.ResourceUsage())
+	protoSession.Sandbox = sandboxStatusToProto(inst.SandboxStatus())
 
 	return protoSession
 }
 
+// resourceUsageToProto converts a cgroup usage sample; nil stays nil.
+func resourceUsageToProto(u *session.ResourceUsage) *sessionv1.ResourceUsage {
+	if u == nil {
+		return nil
+	}
+	return &sessionv1.ResourceUsage{
+		CgroupPath:       u.CgroupPath,
+		CpuPercent:       u.CPUPercent,
+		CpuUsageMs:       u.CPUUsage.Milliseconds(),
+		CpuLimitPercent:  int32(u.CPULimitPercent),
+		MemoryBytes:      int64(u.MemoryBytes),
+		MemoryPeakBytes:  int64(u.MemoryPeakBytes),
+		MemoryLimitBytes: int64(u.MemoryLimitBytes),
+		IoReadBytes:      int64(u.IOReadBytes),
+		IoWriteBytes:     int64(u.IOWriteBytes),
+		Pids:             int64(u.PIDs),
+		PidsLimit:        int64(u.PIDsLimit),
+		OomKills:         int64(u.OOMKills),
+		LimitEvents:      int64(u.LimitEvents),
+		SampledAt:        timestamppb.New(u.SampledAt),
+	}
+}
+
+// sandboxStatusToProto converts a session's sandbox; nil stays nil.
+func sandboxStatusToProto(st *sandbox.Status) *sessionv1.SandboxStatus {
+	if st == nil {
+		return nil
+	}
+	return &sessionv1.SandboxStatus{
+		NetworkMode:   st.Network,
+		AllowedHosts:  st.AllowedHosts,
+		WritablePaths: st.WritablePaths,
+		DeniedCount:   int32(st.Denied),
+	}
+}
+
 // rateLimitStateToProto converts a ratelimit.RateLimitState to proto RateLimitState enum.
 func rateLimitStateToProto(state ratelimit.RateLimitState) sessionv1.RateLimitState {
 	switch state {
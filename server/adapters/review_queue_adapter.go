@@ -161,6 +161,12 @@ func attentionReasonToProto(reason session.AttentionReason) sessionv1.AttentionR
 		return sessionv1.AttentionReason_ATTENTION_REASON_STALE
 	case session.ReasonWaitingForUser:
 		return sessionv1.AttentionReason_ATTENTION_REASON_WAITING_FOR_USER
+	case session.ReasonTestsFailing:
+		return sessionv1.AttentionReason_ATTENTION_REASON_TESTS_FAILING
+	case session.ReasonCIFailing:
+		return sessionv1.AttentionReason_ATTENTION_REASON_CI_FAILING
+	case session.ReasonResourceLimit:
+		return sessionv1.AttentionReason_ATTENTION_REASON_RESOURCE_LIMIT
 	default:
 		return sessionv1.AttentionReason_ATTENTION_REASON_UNSPECIFIED
 	}
@@ -203,6 +209,12 @@ func ProtoToAttentionReason(reason sessionv1.AttentionReason) session.AttentionR
 		return session.ReasonStale
 	case sessionv1.AttentionReason_ATTENTION_REASON_WAITING_FOR_USER:
 		return session.ReasonWaitingForUser
+	case sessionv1.AttentionReason_ATTENTION_REASON_TESTS_FAILING:
+		return session.ReasonTestsFailing
+	case sessionv1.AttentionReason_ATTENTION_REASON_CI_FAILING:
+		return session.ReasonCIFailing
+	case sessionv1.AttentionReason_ATTENTION_REASON_RESOURCE_LIMIT:
+		return session.ReasonResourceLimit
 	default:
 		return session.ReasonInputRequired // Default to input required
 	}
@@ -19,13 +19,14 @@ import (
 // primaryDomain is the hostname used in the CA download filename so clients
 // know which server issued the cert (e.g. "myhost.local").
 // remotePort is the HTTPS port used when building invite URLs.
-func RegisterRoutes(mux *http.ServeMux, waHandler *Handler, sessions *SessionManager, store *CredentialStore, setup *SetupManager, invites *InviteManager, tlsCAPath, primaryDomain string, remotePort int) {
+func RegisterRoutes(mux *http.ServeMux, waHandler *Handler, sessions *SessionManager, store *CredentialStore, setup *SetupManager, invites *InviteManager, shares *ShareManager, tlsCAPath, primaryDomain string, remotePort int) {
 	h := &httpHandlers{
 		wa:            waHandler,
 		sessions:      sessions,
 		store:         store,
 		setup:         setup,
 		invites:       invites,
+		shares:        shares,
 		caPath:        tlsCAPath,
 		primaryDomain: primaryDomain,
 		remotePort:    remotePort,
@@ -41,6 +42,10 @@ func RegisterRoutes(mux *http.ServeMux, waHandler *Handler, sessions *SessionMan
 	mux.HandleFunc("POST /auth/invite/generate", h.generateInvite)
 	mux.HandleFunc("GET /auth/credentials", h.listCredentials)
 	mux.HandleFunc("POST /auth/credentials/{id}/revoke", h.revokeCredential)
+	mux.HandleFunc("POST /auth/shares", h.createShare)
+	mux.HandleFunc("GET /auth/shares", h.listShares)
+	mux.HandleFunc("POST /auth/shares/{id}/revoke", h.revokeShare)
+	mux.HandleFunc("POST /auth/share/redeem", h.redeemShare)
 
 	log.Info("auth: registered /auth/* routes")
 }
@@ -51,6 +56,7 @@ type httpHandlers struct {
 	store         *CredentialStore
 	setup         *SetupManager
 	invites       *InviteManager
+	shares        *ShareManager
 	caPath        string
 	primaryDomain string
 	remotePort    int
@@ -381,21 +387,9 @@ func (h *httpHandlers) generateInvite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Defense-in-depth: verify Origin matches the expected HTTPS origin.
-	// The primary CSRF defence is SameSite=Strict on the session cookie; this
-	// Origin check is a secondary layer for non-browser clients.
-	//
-	// Browsers omit the port for the default HTTPS port (443), so both forms
-	// are accepted. When primaryDomain is empty (e.g., localhost-only mode)
-	// we skip the check entirely — SameSite=Strict remains in effect.
-	if origin := r.Header.Get("Origin"); origin != "" && h.primaryDomain != "" {
-		domain := h.primaryDomain
-		withPort := fmt.Sprintf("https://%s:%d", domain, h.remotePort)
-		withoutPort := fmt.Sprintf("https://%s", domain)
-		if !strings.EqualFold(origin, withPort) && !strings.EqualFold(origin, withoutPort) {
-			http.Error(w, "forbidden: origin mismatch", http.StatusForbidden)
-			return
-		}
+	if !h.originAllowed(r) {
+		http.Error(w, "forbidden: origin mismatch", http.StatusForbidden)
+		return
 	}
 
 	var body struct {
@@ -446,6 +440,24 @@ func (h *httpHandlers) generateInvite(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// originAllowed verifies a state-changing request's Origin matches the
+// expected HTTPS origin. This is defense-in-depth: the primary CSRF defence
+// is SameSite=Strict on the session cookie; this Origin check is a secondary
+// layer for non-browser clients.
+//
+// Browsers omit the port for the default HTTPS port (443), so both forms
+// are accepted. Requests without an Origin, and localhost-only mode (empty
+// primaryDomain), are allowed — SameSite=Strict remains in effect.
+func (h *httpHandlers) originAllowed(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || h.primaryDomain == "" {
+		return true
+	}
+	withPort := fmt.Sprintf("https://%s:%d", h.primaryDomain, h.remotePort)
+	withoutPort := fmt.Sprintf("https://%s", h.primaryDomain)
+	return strings.EqualFold(origin, withPort) || strings.EqualFold(origin, withoutPort)
+}
+
 // listCredentials returns all registered passkeys for the authenticated user.
 func (h *httpHandlers) listCredentials(w http.ResponseWriter, r *http.Request) {
 	if !h.isAuthorisedBySession(r) {
@@ -498,6 +510,156 @@ func (h *httpHandlers) revokeCredential(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// canManageShares reports whether the caller may create, list and revoke
+// share links: the local UI, or a remote client with a full auth session.
+// A share link never authorizes managing share links.
+func (h *httpHandlers) canManageShares(r *http.Request) bool {
+	return isLocalhostRequest(r) || h.isAuthorisedBySession(r)
+}
+
+// shareJSON renders a share link for API responses, without its token hash.
+func shareJSON(st ShareLinkStatus) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          st.ID,
+		"session_id":  st.SessionID,
+		"label":       st.Label,
+		"allow_input": st.AllowInput,
+		"max_viewers": st.MaxViewers,
+		"created_at":  st.CreatedAt.UTC().Format(time.RFC3339),
+		"expires_at":  st.ExpiresAt.UTC().Format(time.RFC3339),
+		"viewers":     st.Viewers,
+	}
+}
+
+// createShare issues a share link for one session. The response carries the
+// link's URL with the token in the fragment, so it never reaches server logs.
+func (h *httpHandlers) createShare(w http.ResponseWriter, r *http.Request) {
+	if h.shares == nil {
+		http.Error(w, "share links not configured", http.StatusServiceUnavailable)
+		return
+	}
+	if !h.canManageShares(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if !h.originAllowed(r) {
+		http.Error(w, "forbidden: origin mismatch", http.StatusForbidden)
+		return
+	}
+
+	var body struct {
+		SessionID  string `json:"session_id"`
+		Label      string `json:"label"`
+		AllowInput bool   `json:"allow_input"`
+		TTLSeconds int    `json:"ttl_seconds"`
+		MaxViewers int    `json:"max_viewers"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if body.SessionID == "" {
+		http.Error(w, "session_id is required", http.StatusBadRequest)
+		return
+	}
+
+	link, token, err := h.shares.Create(body.SessionID, body.Label, body.AllowInput,
+		time.Duration(body.TTLSeconds)*time.Second, body.MaxViewers)
+	if err != nil {
+		log.Error("auth: create share link", "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	domain := h.primaryDomain
+	if domain == "" {
+		domain = "localhost"
+	}
+	jsonResponse(w, map[string]interface{}{
+		"share": shareJSON(ShareLinkStatus{ShareLink: link, Viewers: []ShareViewer{}}),
+		"token": token,
+		"url":   fmt.Sprintf("https://%s:%d/share/#token=%s", domain, h.remotePort, token),
+	})
+}
+
+// listShares returns live share links and their viewers, for one session
+// when session_id is given.
+func (h *httpHandlers) listShares(w http.ResponseWriter, r *http.Request) {
+	if h.shares == nil {
+		http.Error(w, "share links not configured", http.StatusServiceUnavailable)
+		return
+	}
+	if !h.canManageShares(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	links := h.shares.List(r.URL.Query().Get("session_id"))
+	out := make([]map[string]interface{}, 0, len(links))
+	for _, st := range links {
+		out = append(out, shareJSON(st))
+	}
+	jsonResponse(w, map[string]interface{}{"shares": out})
+}
+
+// revokeShare ends a share link and disconnects its viewers.
+func (h *httpHandlers) revokeShare(w http.ResponseWriter, r *http.Request) {
+	if h.shares == nil {
+		http.Error(w, "share links not configured", http.StatusServiceUnavailable)
+		return
+	}
+	if !h.canManageShares(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if !h.originAllowed(r) {
+		http.Error(w, "forbidden: origin mismatch", http.StatusForbidden)
+		return
+	}
+	if !h.shares.Revoke(r.PathValue("id")) {
+		http.Error(w, "share link not found", http.StatusNotFound)
+		return
+	}
+	jsonResponse(w, map[string]interface{}{"ok": true})
+}
+
+// redeemShare exchanges a share-link token for a cookie scoped to the
+// terminal stream path, and tells the viewer page which session to open.
+func (h *httpHandlers) redeemShare(w http.ResponseWriter, r *http.Request) {
+	if h.shares == nil {
+		http.Error(w, "share links not configured", http.StatusServiceUnavailable)
+		return
+	}
+	var body struct {
+		Token string `json:"token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	link, ok := h.shares.Lookup(body.Token)
+	if !ok {
+		http.Error(w, ErrShareNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     ShareCookieName,
+		Value:    body.Token,
+		Path:     shareStreamPath,
+		Expires:  link.ExpiresAt,
+		MaxAge:   int(time.Until(link.ExpiresAt).Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+	jsonResponse(w, map[string]interface{}{
+		"session_id":  link.SessionID,
+		"label":       link.Label,
+		"allow_input": link.AllowInput,
+		"expires_at":  link.ExpiresAt.UTC().Format(time.RFC3339),
+	})
+}
+
 func jsonResponse(w http.ResponseWriter, v interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(v); err != nil {
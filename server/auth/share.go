@@ -0,0 +1,320 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tstapler/stapler-squad/gen/proto/go/session/v1/sessionv1connect"
+	"github.com/tstapler/stapler-squad/log"
+)
+
+const (
+	// ShareCookieName is the cookie holding a redeemed share-link token.
+	ShareCookieName = "cs_share"
+
+	// shareTokenLength is the number of random bytes in a share-link token.
+	shareTokenLength = 24
+
+	// DefaultShareTTL is how long a share link lasts when no expiry is given.
+	DefaultShareTTL = time.Hour
+
+	// MaxShareTTL caps share-link lifetimes; share links are for watching a
+	// session now, not standing access.
+	MaxShareTTL = 7 * 24 * time.Hour
+)
+
+// shareStreamPath is the only API path a share link grants access to: the
+// terminal streaming WebSocket.
+var shareStreamPath = "/api" + sessionv1connect.SessionServiceStreamTerminalProcedure
+
+// Errors returned when joining a share link.
+var (
+	ErrShareNotFound     = errors.New("share link not found or expired")
+	ErrShareViewerLimit  = errors.New("share link viewer limit reached")
+	ErrShareWrongSession = errors.New("share link is for a different session")
+)
+
+// ShareLink lets someone without a passkey watch one session's terminal.
+// Only a hash of the token is kept, so a leaked share-links file cannot be
+// turned back into working links.
+type ShareLink struct {
+	ID         string    `json:"id"`
+	SessionID  string    `json:"session_id"`
+	Label      string    `json:"label,omitempty"`
+	AllowInput bool      `json:"allow_input"`
+	MaxViewers int       `json:"max_viewers"` // 0 = unlimited
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	TokenHash  string    `json:"token_hash"`
+}
+
+// ShareViewer is a client currently watching through a share link.
+type ShareViewer struct {
+	ID       string    `json:"id"`
+	Addr     string    `json:"addr"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// ShareLinkStatus is a share link with its current viewers.
+type ShareLinkStatus struct {
+	ShareLink
+	Viewers []ShareViewer `json:"viewers"`
+}
+
+// shareState is a live share link.
+type shareState struct {
+	link    ShareLink
+	viewers map[string]ShareViewer
+	ended   chan struct{} // closed when the link is revoked or expires
+	expiry  *time.Timer
+}
+
+// ShareManager issues, validates and revokes session share links and tracks
+// who is watching through them. Links are persisted so they survive server
+// restarts; viewers are not.
+type ShareManager struct {
+	mu    sync.Mutex
+	path  string // file path for persistence; empty = in-memory only
+	links map[string]*shareState
+}
+
+// NewShareManager creates a ShareManager, loading unexpired links from path
+// when it is non-empty.
+func NewShareManager(path string) *ShareManager {
+	m := &ShareManager{path: path, links: make(map[string]*shareState)}
+	if path != "" {
+		m.load()
+	}
+	return m
+}
+
+type persistedShares struct {
+	Links []ShareLink `json:"links"`
+}
+
+func (m *ShareManager) load() {
+	data, err := os.ReadFile(m.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("auth: failed to read share links file", "err", err)
+		}
+		return
+	}
+	var p persistedShares
+	if err := json.Unmarshal(data, &p); err != nil {
+		log.Warn("auth: failed to parse share links file", "err", err)
+		return
+	}
+	now := time.Now()
+	for _, l := range p.Links {
+		if now.Before(l.ExpiresAt) {
+			m.addLocked(l)
+		}
+	}
+}
+
+// saveLocked writes all live links to disk. m.mu must be held.
+func (m *ShareManager) saveLocked() {
+	if m.path == "" {
+		return
+	}
+	p := persistedShares{Links: make([]ShareLink, 0, len(m.links))}
+	for _, s := range m.links {
+		p.Links = append(p.Links, s.link)
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		log.Warn("auth: failed to marshal share links", "err", err)
+		return
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		log.Warn("auth: failed to write share links file", "err", err)
+		return
+	}
+	if err := os.Rename(tmp, m.path); err != nil {
+		log.Warn("auth: failed to replace share links file", "err", err)
+	}
+}
+
+// addLocked starts tracking a link and schedules its expiry. m.mu must be held.
+func (m *ShareManager) addLocked(l ShareLink) {
+	s := &shareState{link: l, viewers: make(map[string]ShareViewer), ended: make(chan struct{})}
+	s.expiry = time.AfterFunc(time.Until(l.ExpiresAt), func() { m.end(l.ID, "expired") })
+	m.links[l.ID] = s
+}
+
+// end removes a link and disconnects its viewers.
+func (m *ShareManager) end(id, reason string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.links[id]
+	if !ok {
+		return false
+	}
+	delete(m.links, id)
+	s.expiry.Stop()
+	close(s.ended)
+	m.saveLocked()
+	log.Info("auth: share link ended", "id", id, "session", s.link.SessionID, "reason", reason, "viewers", len(s.viewers))
+	return true
+}
+
+// Create issues a share link for sessionID and returns it with its token,
+// which is only available now. ttl is clamped to (0, MaxShareTTL]; zero
+// means DefaultShareTTL. maxViewers <= 0 means unlimited.
+func (m *ShareManager) Create(sessionID, label string, allowInput bool, ttl time.Duration, maxViewers int) (ShareLink, string, error) {
+	if sessionID == "" {
+		return ShareLink{}, "", errors.New("session id is required")
+	}
+	if ttl <= 0 {
+		ttl = DefaultShareTTL
+	}
+	if ttl > MaxShareTTL {
+		ttl = MaxShareTTL
+	}
+	if maxViewers < 0 {
+		maxViewers = 0
+	}
+	token, err := randomHex(shareTokenLength)
+	if err != nil {
+		return ShareLink{}, "", err
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return ShareLink{}, "", err
+	}
+	now := time.Now()
+	l := ShareLink{
+		ID:         id,
+		SessionID:  sessionID,
+		Label:      label,
+		AllowInput: allowInput,
+		MaxViewers: maxViewers,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(ttl),
+		TokenHash:  hashShareToken(token),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.addLocked(l)
+	m.saveLocked()
+	log.Info("auth: share link created", "id", id, "session", sessionID, "allow_input", allowInput, "expires_in", ttl.String())
+	return l, token, nil
+}
+
+// Revoke ends a share link, disconnecting anyone watching through it.
+func (m *ShareManager) Revoke(id string) bool {
+	return m.end(id, "revoked")
+}
+
+// List returns the live share links for sessionID (all sessions when empty),
+// oldest first.
+func (m *ShareManager) List(sessionID string) []ShareLinkStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]ShareLinkStatus, 0)
+	for _, s := range m.links {
+		if sessionID != "" && s.link.SessionID != sessionID {
+			continue
+		}
+		st := ShareLinkStatus{ShareLink: s.link, Viewers: make([]ShareViewer, 0, len(s.viewers))}
+		for _, v := range s.viewers {
+			st.Viewers = append(st.Viewers, v)
+		}
+		sort.Slice(st.Viewers, func(i, j int) bool { return st.Viewers[i].JoinedAt.Before(st.Viewers[j].JoinedAt) })
+		out = append(out, st)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+// Lookup returns the live share link whose token is token.
+func (m *ShareManager) Lookup(token string) (ShareLink, bool) {
+	if token == "" {
+		return ShareLink{}, false
+	}
+	hash := hashShareToken(token)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.links {
+		if s.link.TokenHash == hash && time.Now().Before(s.link.ExpiresAt) {
+			return s.link, true
+		}
+	}
+	return ShareLink{}, false
+}
+
+// Join registers a viewer on a link. ended is closed when the link is
+// revoked or expires; the caller must disconnect the viewer then, and call
+// leave when the viewer goes away.
+func (m *ShareManager) Join(linkID, addr string) (viewer ShareViewer, ended <-chan struct{}, leave func(), err error) {
+	viewerID, err := randomHex(8)
+	if err != nil {
+		return ShareViewer{}, nil, nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.links[linkID]
+	if !ok {
+		return ShareViewer{}, nil, nil, ErrShareNotFound
+	}
+	if s.link.MaxViewers > 0 && len(s.viewers) >= s.link.MaxViewers {
+		return ShareViewer{}, nil, nil, ErrShareViewerLimit
+	}
+	viewer = ShareViewer{ID: viewerID, Addr: addr, JoinedAt: time.Now()}
+	s.viewers[viewerID] = viewer
+	log.Info("auth: share viewer joined", "link", linkID, "session", s.link.SessionID, "addr", addr, "viewers", len(s.viewers))
+
+	var once sync.Once
+	leave = func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			delete(s.viewers, viewerID)
+			log.Info("auth: share viewer left", "link", linkID, "session", s.link.SessionID, "addr", addr)
+		})
+	}
+	return viewer, s.ended, leave, nil
+}
+
+// AuthorizeShare allows a request that carries a valid share cookie but no
+// auth session, for the terminal stream path only. The returned request's
+// context carries the share link; see ShareFromContext.
+func (m *ShareManager) AuthorizeShare(r *http.Request) (*http.Request, bool) {
+	if r.URL.Path != shareStreamPath {
+		return nil, false
+	}
+	cookie, err := r.Cookie(ShareCookieName)
+	if err != nil {
+		return nil, false
+	}
+	link, ok := m.Lookup(cookie.Value)
+	if !ok {
+		return nil, false
+	}
+	return r.WithContext(context.WithValue(r.Context(), shareContextKey{}, link)), true
+}
+
+type shareContextKey struct{}
+
+// ShareFromContext returns the share link a request was authorized by, if
+// it was authorized by one rather than a full auth session.
+func ShareFromContext(ctx context.Context) (ShareLink, bool) {
+	l, ok := ctx.Value(shareContextKey{}).(ShareLink)
+	return l, ok
+}
+
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestShareLinkLifecycle(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "share-links.json")
+	m := NewShareManager(path)
+
+	link, token, err := m.Create("my-session", "pairing", false, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := link.ExpiresAt.Sub(link.CreatedAt); got != DefaultShareTTL {
+		t.Errorf("ttl = %v, want default %v", got, DefaultShareTTL)
+	}
+	if link.TokenHash == token || link.TokenHash == "" {
+		t.Errorf("token stored in the clear: hash %q", link.TokenHash)
+	}
+	if got, ok := m.Lookup(token); !ok || got.ID != link.ID {
+		t.Fatalf("Lookup(token) = %+v, %v", got, ok)
+	}
+	if _, ok := m.Lookup("wrong"); ok {
+		t.Error("Lookup accepted a wrong token")
+	}
+
+	// Links survive a restart.
+	if _, ok := NewShareManager(path).Lookup(token); !ok {
+		t.Error("link not persisted")
+	}
+
+	_, ended, leave, err := m.Join(link.ID, "10.0.0.2:5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := m.Join(link.ID, "10.0.0.3:5000"); err != ErrShareViewerLimit {
+		t.Errorf("second viewer: err = %v, want ErrShareViewerLimit", err)
+	}
+	if st := m.List("my-session"); len(st) != 1 || len(st[0].Viewers) != 1 {
+		t.Errorf("List = %+v, want one link with one viewer", st)
+	}
+	leave()
+	leave() // idempotent
+	if _, _, leave2, err := m.Join(link.ID, "10.0.0.3:5000"); err != nil {
+		t.Errorf("join after leave: %v", err)
+	} else {
+		leave2()
+	}
+
+	if !m.Revoke(link.ID) {
+		t.Fatal("Revoke returned false")
+	}
+	select {
+	case <-ended:
+	default:
+		t.Error("revoking did not end the viewer's stream")
+	}
+	if _, ok := m.Lookup(token); ok {
+		t.Error("revoked link still valid")
+	}
+	if _, ok := NewShareManager(path).Lookup(token); ok {
+		t.Error("revoked link still persisted")
+	}
+}
+
+func TestShareLinkExpires(t *testing.T) {
+	m := NewShareManager("")
+	link, token, err := m.Create("s", "", false, 20*time.Millisecond, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ended, leave, err := m.Join(link.ID, "addr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer leave()
+	select {
+	case <-ended:
+	case <-time.After(2 * time.Second):
+		t.Fatal("link did not expire")
+	}
+	if _, ok := m.Lookup(token); ok {
+		t.Error("expired link still valid")
+	}
+}
+
+func TestAuthorizeShareOnlyStreamPath(t *testing.T) {
+	m := NewShareManager("")
+	link, token, err := m.Create("s", "", false, time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cookie := &http.Cookie{Name: ShareCookieName, Value: token}
+
+	r := httptest.NewRequest(http.MethodGet, shareStreamPath, nil)
+	r.AddCookie(cookie)
+	shared, ok := m.AuthorizeShare(r)
+	if !ok {
+		t.Fatal("stream request with share cookie refused")
+	}
+	if got, ok := ShareFromContext(shared.Context()); !ok || got.ID != link.ID {
+		t.Errorf("ShareFromContext = %+v, %v", got, ok)
+	}
+
+	other := httptest.NewRequest(http.MethodPost, "/api/session.v1.SessionService/DeleteSession", nil)
+	other.AddCookie(cookie)
+	if _, ok := m.AuthorizeShare(other); ok {
+		t.Error("share cookie authorized a non-stream path")
+	}
+
+	if _, ok := m.AuthorizeShare(httptest.NewRequest(http.MethodGet, shareStreamPath, nil)); ok {
+		t.Error("stream request without a cookie authorized")
+	}
+}
@@ -0,0 +1,129 @@
+package compression
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// ZstdFrameThreshold is the smallest frame worth compressing. Below it the
+// zstd frame header outweighs any saving, so frames are sent as-is.
+const ZstdFrameThreshold = 32
+
+// zstdDictHistoryBytes caps the raw history a trained dictionary carries.
+// Terminal output repeats mostly short escape sequences and prompts, so a
+// small history captures most of the gain while keeping the dictionary cheap
+// to send to every client.
+const zstdDictHistoryBytes = 16 * 1024
+
+// zstdWindowSize bounds the memory a client needs to decode a frame.
+const zstdWindowSize = 1 << 20 // 1MB
+
+// TrainZstdDictionary builds a zstd dictionary with the given ID from samples
+// of a session's output. The most recent samples become the dictionary's
+// history; all of them shape its entropy tables. The result is compatible
+// with the reference zstd decoder, so browsers can use it too.
+func TrainZstdDictionary(id uint32, samples [][]byte) ([]byte, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("dictionary ID must be non-zero")
+	}
+
+	// History is the tail of the concatenated samples.
+	var history []byte
+	for i := len(samples) - 1; i >= 0 && len(history) < zstdDictHistoryBytes; i-- {
+		history = append(append([]byte(nil), samples[i]...), history...)
+	}
+	if len(history) > zstdDictHistoryBytes {
+		history = history[len(history)-zstdDictHistoryBytes:]
+	}
+
+	dict, err := zstd.BuildDict(zstd.BuildDictOptions{
+		ID:         id,
+		Contents:   samples,
+		History:    history,
+		Offsets:    [3]int{1, 4, 8},
+		CompatV155: true,
+		Level:      zstd.SpeedFastest,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build zstd dictionary: %w", err)
+	}
+	return dict, nil
+}
+
+// ZstdCodec compresses terminal output into independent zstd frames,
+// optionally with a dictionary, and decompresses them. Safe for concurrent use.
+type ZstdCodec struct {
+	dictID uint32
+	enc    *zstd.Encoder
+	dec    *zstd.Decoder
+}
+
+// NewZstdCodec creates a codec using dict, which may be nil for no dictionary.
+func NewZstdCodec(dict []byte) (*ZstdCodec, error) {
+	encOpts := []zstd.EOption{
+		zstd.WithEncoderLevel(zstd.SpeedFastest),
+		zstd.WithEncoderCRC(false), // the transport already checks integrity
+		zstd.WithWindowSize(zstdWindowSize),
+	}
+	decOpts := []zstd.DOption{
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(64 * zstdWindowSize),
+	}
+
+	var dictID uint32
+	if len(dict) > 0 {
+		info, err := zstd.InspectDictionary(dict)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zstd dictionary: %w", err)
+		}
+		dictID = info.ID()
+		encOpts = append(encOpts, zstd.WithEncoderDict(dict))
+		decOpts = append(decOpts, zstd.WithDecoderDicts(dict))
+	}
+
+	enc, err := zstd.NewWriter(nil, encOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create zstd encoder: %w", err)
+	}
+	dec, err := zstd.NewReader(nil, decOpts...)
+	if err != nil {
+		enc.Close()
+		return nil, fmt.Errorf("failed to create zstd decoder: %w", err)
+	}
+
+	return &ZstdCodec{dictID: dictID, enc: enc, dec: dec}, nil
+}
+
+// DictionaryID returns the ID of the codec's dictionary, or 0 without one.
+func (c *ZstdCodec) DictionaryID() uint32 {
+	return c.dictID
+}
+
+// Compress compresses data into a single zstd frame. It returns the data
+// unchanged and false when it is below ZstdFrameThreshold or would not shrink.
+func (c *ZstdCodec) Compress(data []byte) ([]byte, bool) {
+	if len(data) < ZstdFrameThreshold {
+		return data, false
+	}
+	compressed := c.enc.EncodeAll(data, make([]byte, 0, len(data)/2))
+	if len(compressed) >= len(data) {
+		return data, false
+	}
+	return compressed, true
+}
+
+// Decompress decodes a frame produced by Compress with the same dictionary.
+func (c *ZstdCodec) Decompress(data []byte) ([]byte, error) {
+	out, err := c.dec.DecodeAll(data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress zstd frame: %w", err)
+	}
+	return out, nil
+}
+
+// Close releases the codec's encoder and decoder.
+func (c *ZstdCodec) Close() {
+	c.enc.Close()
+	c.dec.Close()
+}
@@ -0,0 +1,83 @@
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// terminalSamples returns output resembling a TUI repainting its status lines.
+func terminalSamples(n int) [][]byte {
+	samples := make([][]byte, n)
+	for i := range samples {
+		samples[i] = []byte(fmt.Sprintf(
+			"\x1b[2K\x1b[1G\x1b[38;5;245m│\x1b[0m \x1b[1mRunning tests\x1b[0m (%d/%d) \x1b[32m✓ passed\x1b[0m\r\n"+
+				"\x1b[?25l\x1b[%d;1H\x1b[48;5;236m  esc to interrupt  \x1b[0m\x1b[?25h", i, n, i%40+1))
+	}
+	return samples
+}
+
+// TestZstdCodecSmallFrameSkipsCompression verifies tiny frames, such as a
+// single echoed keystroke, are passed through untouched.
+func TestZstdCodecSmallFrameSkipsCompression(t *testing.T) {
+	codec, err := NewZstdCodec(nil)
+	if err != nil {
+		t.Fatalf("NewZstdCodec: %v", err)
+	}
+	defer codec.Close()
+
+	out, compressed := codec.Compress([]byte("a"))
+	if compressed || string(out) != "a" {
+		t.Errorf("expected small frame returned as-is, got %q compressed=%v", out, compressed)
+	}
+}
+
+// TestZstdCodecDictionaryRoundTrip verifies frames compressed with a trained
+// dictionary decode back exactly and compress better than without it.
+func TestZstdCodecDictionaryRoundTrip(t *testing.T) {
+	samples := terminalSamples(200)
+	dict, err := TrainZstdDictionary(0x8000_1234, samples)
+	if err != nil {
+		t.Fatalf("TrainZstdDictionary: %v", err)
+	}
+
+	withDict, err := NewZstdCodec(dict)
+	if err != nil {
+		t.Fatalf("NewZstdCodec(dict): %v", err)
+	}
+	defer withDict.Close()
+	if withDict.DictionaryID() != 0x8000_1234 {
+		t.Errorf("DictionaryID = %#x, want %#x", withDict.DictionaryID(), 0x8000_1234)
+	}
+
+	plain, err := NewZstdCodec(nil)
+	if err != nil {
+		t.Fatalf("NewZstdCodec(nil): %v", err)
+	}
+	defer plain.Close()
+
+	frame := terminalSamples(201)[200]
+	small, ok := withDict.Compress(frame)
+	if !ok {
+		t.Fatalf("expected frame of %d bytes to compress with dictionary", len(frame))
+	}
+	if big, ok := plain.Compress(frame); ok && len(small) >= len(big) {
+		t.Errorf("dictionary did not help: %d bytes with, %d without", len(small), len(big))
+	}
+
+	got, err := withDict.Decompress(small)
+	if err != nil {
+		t.Fatalf("Decompress: %v", err)
+	}
+	if !bytes.Equal(got, frame) {
+		t.Errorf("round-trip mismatch: got %q, want %q", got, frame)
+	}
+}
+
+// TestTrainZstdDictionaryRejectsZeroID verifies ID 0, which means "no
+// dictionary" on the wire, cannot be assigned.
+func TestTrainZstdDictionaryRejectsZeroID(t *testing.T) {
+	if _, err := TrainZstdDictionary(0, terminalSamples(10)); err == nil {
+		t.Error("expected an error for dictionary ID 0")
+	}
+}
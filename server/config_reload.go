@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/forge"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/github"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/server/events"
+	"github.com/tstapler/stapler-squad/server/push"
+	"github.com/tstapler/stapler-squad/server/services"
+	"github.com/tstapler/stapler-squad/session"
+)
+
+// configSchemaPath serves the published JSON Schema for config.json.
+const configSchemaPath = "/api/config/schema.json"
+
+// configTargets are the running components that hold settings read from
+// config.json at startup. Nil targets are skipped.
+type configTargets struct {
+	prPoller        *session.PRStatusPoller
+	resourceMonitor *session.ResourceMonitor
+	pushNotifier    *push.WebPushNotifier
+	terminal        *services.ConnectRPCWebSocketHandler
+	sessions        *services.SessionService
+}
+
+// startConfigWatcher hot-reloads config.json for the lifetime of ctx. Applied
+// edits are published as EventConfigChanged and rejected edits as
+// EventConfigRejected on the bus; applyConfigEvents re-applies the settings
+// held by targets. Failure to start the watcher is non-fatal: config changes
+// then take effect on the next restart, as before.
+func startConfigWatcher(ctx context.Context, mux *http.ServeMux, bus *events.EventBus, cfg *config.Config, targets configTargets) {
+	mux.HandleFunc(configSchemaPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/schema+json")
+		_, _ = w.Write(config.Schema())
+	})
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		log.Warn("config hot-reload disabled: no config directory", "err", err)
+		return
+	}
+	watcher := config.NewWatcher(filepath.Join(configDir, config.ConfigFileName), cfg)
+	watcher.OnChange(func(change *config.Change) {
+		bus.Publish(events.NewConfigChangedEvent(change))
+	})
+	watcher.OnReject(func(verr *config.ValidationError) {
+		bus.Publish(events.NewConfigRejectedEvent(verr))
+	})
+
+	go applyConfigEvents(ctx, bus, targets)
+	if err := watcher.Start(ctx); err != nil {
+		log.Warn("config hot-reload disabled", "err", err)
+		return
+	}
+	// From here on sessions use the validated config instead of re-reading
+	// config.json, which may hold an edit the watcher rejected.
+	if targets.sessions != nil {
+		targets.sessions.ApplyConfig(watcher.Current())
+	}
+	log.Info("Config hot-reload enabled", "path", filepath.Join(configDir, config.ConfigFileName))
+}
+
+// applyConfigEvents re-applies settings the server caches at startup and
+// surfaces rejected edits as notifications so they are visible in the web UI.
+// Settings read through config.LoadConfig on each use need no handling here.
+func applyConfigEvents(ctx context.Context, bus *events.EventBus, targets configTargets) {
+	ch, _ := bus.Subscribe(ctx)
+	for event := range ch {
+		switch event.Type {
+		case events.EventConfigChanged:
+			applyConfigChange(event.ConfigChange, targets)
+		case events.EventConfigRejected:
+			bus.Publish(events.NewNotificationEvent(
+				"config",
+				"System",
+				uuid.New().String(),
+				int32(sessionv1.NotificationType_NOTIFICATION_TYPE_WARNING),
+				int32(sessionv1.NotificationPriority_NOTIFICATION_PRIORITY_MEDIUM),
+				"Config change rejected",
+				event.ConfigError.Error()+". The previous configuration is still active.",
+				nil,
+			))
+		}
+	}
+}
+
+// applyConfigChange re-applies every changed setting to its target.
+func applyConfigChange(change *config.Change, targets configTargets) {
+	if change.Changed("forge_hosts") {
+		applyForgeHosts(change)
+	}
+	if change.Changed("secret_scan") {
+		session.ConfigureSecretDetection(change.New.SecretScan)
+		log.Info("Applied secret_scan from reloaded config")
+	}
+	if change.Changed("pr_poll_interval_seconds") && targets.prPoller != nil {
+		interval := time.Duration(change.New.PRPollIntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = session.DefaultPRStatusPollerConfig().PollInterval
+		}
+		targets.prPoller.SetPollInterval(interval)
+		log.Info("Applied pr_poll_interval_seconds from reloaded config", "interval", interval)
+	}
+	if change.Changed("cgroups") && targets.resourceMonitor != nil {
+		interval := time.Duration(change.New.Cgroups.PollIntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = session.DefaultResourceMonitorConfig().PollInterval
+		}
+		targets.resourceMonitor.SetPollInterval(interval)
+		log.Info("Applied cgroups poll interval from reloaded config", "interval", interval)
+	}
+	if change.Changed("notifications") && targets.pushNotifier != nil {
+		targets.pushNotifier.SetEnabled(change.New.Notifications.PushEnabled)
+		log.Info("Applied notifications from reloaded config", "push_enabled", change.New.Notifications.PushEnabled)
+	}
+	if change.Changed("terminal_streaming_mode") && targets.terminal != nil {
+		targets.terminal.SetStreamingMode(change.New.TerminalStreamingMode)
+		log.Info("Applied terminal_streaming_mode from reloaded config", "mode", change.New.TerminalStreamingMode)
+	}
+	if targets.sessions != nil {
+		// Session defaults, profiles and base directories are resolved from
+		// the applied config on every CreateSession.
+		targets.sessions.ApplyConfig(change.New)
+		if change.Changed("session_defaults") {
+			log.Info("Applied session_defaults from reloaded config")
+		}
+	}
+}
+
+// applyForgeHosts syncs the forge host registry with the new forge_hosts map.
+func applyForgeHosts(change *config.Change) {
+	for host := range change.Old.ForgeHosts {
+		if _, ok := change.New.ForgeHosts[host]; !ok {
+			forge.UnregisterHost(host)
+		}
+	}
+	for host, kind := range change.New.ForgeHosts {
+		forge.RegisterHost(host, github.ForgeKind(kind))
+	}
+	log.Info("Applied forge_hosts from reloaded config", "hosts", len(change.New.ForgeHosts))
+}
@@ -5,16 +5,22 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
 	"github.com/tstapler/stapler-squad/log"
 	warren "github.com/tstapler/stapler-squad/pkg/warren"
 	"github.com/tstapler/stapler-squad/server/analytics"
 	"github.com/tstapler/stapler-squad/server/events"
 	"github.com/tstapler/stapler-squad/server/services"
 	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/activity"
+	"github.com/tstapler/stapler-squad/session/cgroup"
 	"github.com/tstapler/stapler-squad/session/ent"
+	"github.com/tstapler/stapler-squad/session/sandbox"
 	"github.com/tstapler/stapler-squad/session/scrollback"
 	"github.com/tstapler/stapler-squad/session/tmux"
 	"github.com/tstapler/stapler-squad/session/tokens"
@@ -33,6 +39,9 @@ type ServerDependencies struct {
 	ReviewQueue             *session.ReviewQueue
 	ReviewQueuePoller       *session.ReviewQueuePoller
 	PRStatusPoller          *session.PRStatusPoller
+	ResourceMonitor         *session.ResourceMonitor
+	ActivityTracker         *activity.Tracker
+	SandboxWatcher          *sandbox.Watcher
 	ReactiveQueueMgr        *ReactiveQueueManager
 	ScrollbackManager       *scrollback.ScrollbackManager
 	TmuxStreamerManager     *session.ExternalTmuxStreamerManager
@@ -51,6 +60,7 @@ type ServerDependencies struct {
 
 	BacklogService *services.BacklogService
 	SyncLoop       *session.SyncLoop
+	ReviewPanels   *session.ReviewPanelStore
 
 	// Analytics storage. Nil when the analytics DB failed to open (LogAnalyticsProvider
 	// is used as a fallback in that case).
@@ -69,6 +79,9 @@ func (rt *RuntimeDeps) ToServerDeps() *ServerDependencies {
 		ReviewQueue:             rt.ReviewQueue,
 		ReviewQueuePoller:       rt.ReviewQueuePoller,
 		PRStatusPoller:          rt.PRStatusPoller,
+		ResourceMonitor:         rt.ResourceMonitor,
+		ActivityTracker:         rt.ActivityTracker,
+		SandboxWatcher:          rt.SandboxWatcher,
 		ReactiveQueueMgr:        rt.ReactiveQueueMgr,
 		ScrollbackManager:       rt.ScrollbackManager,
 		TmuxStreamerManager:     rt.TmuxStreamerManager,
@@ -82,6 +95,7 @@ func (rt *RuntimeDeps) ToServerDeps() *ServerDependencies {
 		InsightsService:         rt.InsightsService,
 		BacklogService:          rt.BacklogService,
 		SyncLoop:                rt.SyncLoop,
+		ReviewPanels:            rt.ReviewPanels,
 		AnalyticsEntClient:      rt.AnalyticsEntClient,
 	}
 }
@@ -222,6 +236,9 @@ type CoreDeps struct {
 	ReviewQueue    *session.ReviewQueue
 	ApprovalStore  *services.ApprovalStore
 	ErrorRegistry  *services.ErrorRegistry
+	// ReviewPanels is shared by the review gate, the MCP verdict tool and the
+	// backlog API.
+	ReviewPanels *session.ReviewPanelStore
 }
 
 // BuildOptions carries optional overrides for BuildCoreDepsWithOptions.
@@ -235,6 +252,10 @@ type BuildOptions struct {
 // BuildCoreDepsWithOptions constructs Phase 1 dependencies with optional overrides.
 // Use BuildOptions to inject a pre-built EntClient (for tests).
 func BuildCoreDepsWithOptions(opts BuildOptions) (*CoreDeps, error) {
+	// Secret detection backs command approval, terminal redaction and the
+	// review gate; configure it before anything can scan.
+	session.ConfigureSecretDetection(config.LoadConfig().SecretScan)
+
 	var sessionService *services.SessionService
 	var err error
 	if opts.EntClient != nil {
@@ -266,6 +287,7 @@ func BuildCoreDepsWithOptions(opts BuildOptions) (*CoreDeps, error) {
 		ReviewQueue:    sessionService.GetReviewQueueInstance(),
 		ApprovalStore:  sessionService.GetApprovalStore(),
 		ErrorRegistry:  errorRegistry,
+		ReviewPanels:   newReviewPanelStore(),
 	}, nil
 }
 
@@ -281,6 +303,9 @@ type ServiceDeps struct {
 	StatusManager     *session.InstanceStatusManager
 	ReviewQueuePoller *session.ReviewQueuePoller
 	PRStatusPoller    *session.PRStatusPoller
+	PRFeedback        *session.PRFeedbackWatcher
+	ResourceMonitor   *session.ResourceMonitor
+	ActivityTracker   *activity.Tracker
 }
 
 // BuildServiceDeps constructs Phase 2 dependencies using Phase 1 outputs.
@@ -298,12 +323,29 @@ func BuildServiceDeps(core *CoreDeps) (*ServiceDeps, error) {
 	reviewQueuePoller := session.NewReviewQueuePoller(
 		core.ReviewQueue, statusManager, core.Storage,
 	)
+	cfg := config.LoadConfig()
 	prStatusPoller := session.NewPRStatusPoller(core.Storage)
+	prStatusPoller.SetPollInterval(time.Duration(cfg.PRPollIntervalSeconds) * time.Second)
+	prFeedbackStore := newPRFeedbackStore()
+	prFeedback := session.NewPRFeedbackWatcher(prFeedbackStore)
+	prFeedback.SetAgentLogin(cfg.PRFeedbackAgentLogin)
+	ciWatcher := session.NewCIFailureWatcher(prFeedbackStore, session.DefaultCIFailureWatcherConfig())
+	resourceMonitor := newResourceMonitor(cfg.Cgroups)
+	activityTracker := newActivityTracker()
+	activityTracker.OnRecord(func(e activity.Entry) {
+		core.EventBus.Publish(events.NewAgentActivityEvent(e))
+	})
 
 	w := warren.NewWire("ServiceDeps")
 	warren.Set(w, "ApprovalProvider", reviewQueuePoller.SetApprovalProvider, session.ApprovalMetadataProvider(core.ApprovalStore))
 	warren.Set(w, "StatusManager", core.SessionService.SetStatusManager, statusManager)
 	warren.Set(w, "ReviewQueuePoller", core.SessionService.SetReviewQueuePoller, reviewQueuePoller)
+	warren.Set(w, "PRStatusPoller.FeedbackWatcher", prStatusPoller.SetFeedbackWatcher, prFeedback)
+	warren.Set(w, "PRStatusPoller.CIWatcher", prStatusPoller.SetCIWatcher, ciWatcher)
+	warren.Set(w, "PRFeedbackWatcher", core.SessionService.SetPRFeedbackWatcher, prFeedback)
+	warren.Set(w, "CIFailureWatcher", core.SessionService.SetCIFailureWatcher, ciWatcher)
+	warren.Set(w, "ResourceMonitor", core.SessionService.SetResourceMonitor, resourceMonitor)
+	warren.Set(w, "ActivitySource", statusManager.SetActivitySource, session.ActivitySource(activityTracker))
 	if err := w.Validate(); err != nil {
 		return nil, err
 	}
@@ -313,9 +355,156 @@ func BuildServiceDeps(core *CoreDeps) (*ServiceDeps, error) {
 		StatusManager:     statusManager,
 		ReviewQueuePoller: reviewQueuePoller,
 		PRStatusPoller:    prStatusPoller,
+		PRFeedback:        prFeedback,
+		ResourceMonitor:   resourceMonitor,
+		ActivityTracker:   activityTracker,
 	}, nil
 }
 
+// newResourceMonitor sets up per-session cgroup accounting. Without a usable
+// cgroup v2 hierarchy the monitor is created inert so sessions still start.
+func newResourceMonitor(cfg config.CgroupConfig) *session.ResourceMonitor {
+	monitorCfg := session.DefaultResourceMonitorConfig()
+	if cfg.PollIntervalSeconds > 0 {
+		monitorCfg.PollInterval = time.Duration(cfg.PollIntervalSeconds) * time.Second
+	}
+	store := newResourceLimitStore()
+	if cfg.Disabled {
+		log.Info("session resource accounting disabled by config")
+		return session.NewResourceMonitor(nil, store, monitorCfg)
+	}
+	manager, err := cgroup.NewManager(cfg.Root)
+	if err != nil {
+		log.Warn("session resource accounting unavailable", "err", err)
+		return session.NewResourceMonitor(nil, store, monitorCfg)
+	}
+	return session.NewResourceMonitor(manager, store, monitorCfg)
+}
+
+// newActivityTracker opens the per-session agent activity streams in the
+// config dir, falling back to in-memory streams so hooks are still tracked.
+func newActivityTracker() *activity.Tracker {
+	redact := func(s string) string {
+		out, _ := session.SecretDetector().Redact(s)
+		return out
+	}
+	if configDir, err := config.GetConfigDir(); err == nil {
+		tracker, err := activity.NewTracker(filepath.Join(configDir, "activity"), redact)
+		if err == nil {
+			return tracker
+		}
+		log.Warn("agent activity persistence unavailable", "err", err)
+	}
+	tracker, _ := activity.NewTracker("", redact)
+	return tracker
+}
+
+// newSandboxWatcher drains sandbox denial logs into approval analytics and
+// the sessions' denial counts. Nil when the config dir cannot be resolved.
+func newSandboxWatcher(svc *services.SessionService, bus *events.EventBus) *sandbox.Watcher {
+	root, err := sandbox.Root()
+	if err != nil {
+		log.Warn("sandbox denial tracking unavailable", "err", err)
+		return nil
+	}
+	return sandbox.NewWatcher(root, sandbox.DefaultWatchInterval, func(sessionID string, denials []sandbox.Denial) {
+		log.Info("sandbox blocked network requests", "session", sessionID, "count", len(denials))
+		if analytics := svc.GetAnalyticsStore(); analytics != nil {
+			for _, d := range denials {
+				analytics.RecordSandboxDenial(sessionID, d)
+			}
+		}
+		if inst := svc.FindLiveInstance(sessionID); inst != nil {
+			inst.AddSandboxDenials(len(denials))
+			bus.Publish(events.NewSessionUpdatedEvent(inst, []string{"sandbox"}))
+		}
+	})
+}
+
+// resourceEventNotification builds the notification for OOM kills and limit
+// hits in a session's cgroup. OOM kills are reported as errors because a
+// process the agent relied on is gone.
+func resourceEventNotification(inst *session.Instance, evs []session.ResourceEvent) *events.Event {
+	notifType := sessionv1.NotificationType_NOTIFICATION_TYPE_WARNING
+	msgs := make([]string, 0, len(evs))
+	for _, ev := range evs {
+		if ev.Kind == session.ResourceEventOOMKill {
+			notifType = sessionv1.NotificationType_NOTIFICATION_TYPE_ERROR
+		}
+		msgs = append(msgs, ev.Message)
+	}
+	return events.NewNotificationEvent(
+		inst.GetStableID(),
+		inst.Title,
+		uuid.New().String(),
+		int32(notifType),
+		int32(sessionv1.NotificationPriority_NOTIFICATION_PRIORITY_HIGH),
+		fmt.Sprintf("Resource limit: %s", inst.Title),
+		strings.Join(msgs, "; "),
+		map[string]string{"reason": string(session.ReasonResourceLimit)},
+	)
+}
+
+// newResourceLimitStore opens the per-session resource limits in the config dir,
+// falling back to an in-memory store so the feature degrades instead of failing startup.
+func newResourceLimitStore() *session.ResourceLimitStore {
+	if configDir, err := config.GetConfigDir(); err == nil {
+		path := filepath.Join(configDir, "resource_limits.json")
+		store, storeErr := session.NewResourceLimitStore(path)
+		if storeErr == nil {
+			return store
+		}
+		log.Warn("could not load session resource limits, using in-memory store", "path", path, "err", storeErr)
+	}
+	store, _ := session.NewResourceLimitStore("")
+	return store
+}
+
+// newPRFeedbackStore opens the PR review feedback state file in the config dir,
+// falling back to an in-memory store so the feature degrades instead of failing startup.
+func newPRFeedbackStore() *session.PRFeedbackStore {
+	if configDir, err := config.GetConfigDir(); err == nil {
+		path := filepath.Join(configDir, "pr_feedback.json")
+		store, storeErr := session.NewPRFeedbackStore(path)
+		if storeErr == nil {
+			return store
+		}
+		log.Warn("could not load PR feedback state, using in-memory store", "path", path, "err", storeErr)
+	}
+	store, _ := session.NewPRFeedbackStore("")
+	return store
+}
+
+// newReviewPanelStore opens the review panel history in the config dir,
+// falling back to an in-memory store so the feature degrades instead of failing startup.
+func newReviewPanelStore() *session.ReviewPanelStore {
+	if configDir, err := config.GetConfigDir(); err == nil {
+		path := filepath.Join(configDir, "review_panels.json")
+		store, storeErr := session.NewReviewPanelStore(path)
+		if storeErr == nil {
+			return store
+		}
+		log.Warn("could not load review panels, using in-memory store", "path", path, "err", storeErr)
+	}
+	store, _ := session.NewReviewPanelStore("")
+	return store
+}
+
+// newBacklogDependencyStore opens the backlog dependency graph in the config dir,
+// falling back to an in-memory store so the feature degrades instead of failing startup.
+func newBacklogDependencyStore() *session.BacklogDependencyStore {
+	if configDir, err := config.GetConfigDir(); err == nil {
+		path := filepath.Join(configDir, "backlog_dependencies.json")
+		store, storeErr := session.NewBacklogDependencyStore(path)
+		if storeErr == nil {
+			return store
+		}
+		log.Warn("could not load backlog dependencies, using in-memory store", "path", path, "err", storeErr)
+	}
+	store, _ := session.NewBacklogDependencyStore("")
+	return store
+}
+
 // RuntimeDeps holds Phase 3 dependencies: runtime components that involve
 // process creation, filesystem I/O, and callback wiring.
 type RuntimeDeps struct {
@@ -329,6 +518,7 @@ type RuntimeDeps struct {
 	PRStatusPoller          *session.PRStatusPoller
 	HistoryLinker           *session.HistoryLinker
 	ErrorRegistry           *services.ErrorRegistry
+	SandboxWatcher          *sandbox.Watcher
 
 	// Unfinished work scanning.
 	UnfinishedScanner     *unfinished.Scanner
@@ -386,6 +576,7 @@ func BuildRuntimeDeps(_ tmux.TmuxServerReady, svc *ServiceDeps, cfg *config.Conf
 
 	// Backlog lifecycle listener — always created, enabled state set from config below.
 	backlogLifecycleListener := session.NewBacklogLifecycleListenerWithSpawner(storage, sessionService)
+	backlogLifecycleListener.SetReviewPanelStore(svc.ReviewPanels)
 
 	// Step 5 (continued): wire dependencies to each instance
 	// inst.SetReviewQueue and inst.SetStatusManager are called per-instance in a loop;
@@ -395,6 +586,7 @@ func BuildRuntimeDeps(_ tmux.TmuxServerReady, svc *ServiceDeps, cfg *config.Conf
 		inst.SetStatusManager(statusManager)
 		backlogLifecycleListener.WireToInstance(inst)
 	}
+	svc.PRFeedback.Restore(instances)
 
 	// Wire instances to pollers.
 	// SetInstances accepts a slice (non-comparable) so use SetAlways (skips nil check).
@@ -404,6 +596,11 @@ func BuildRuntimeDeps(_ tmux.TmuxServerReady, svc *ServiceDeps, cfg *config.Conf
 	warren.SetAlways(w2, "PRStatusPoller.OnUpdated", svc.PRStatusPoller.SetOnUpdated, func(inst *session.Instance) {
 		eventBus.Publish(events.NewSessionUpdatedEvent(inst, []string{"github_pr_priority", "github_pr_state"}))
 	})
+	warren.SetAlways(w2, "ResourceMonitor.Instances", svc.ResourceMonitor.SetInstances, instances)
+	warren.SetAlways(w2, "ResourceMonitor.OnEvent", svc.ResourceMonitor.SetOnEvent, func(inst *session.Instance, evs []session.ResourceEvent) {
+		eventBus.Publish(resourceEventNotification(inst, evs))
+		eventBus.Publish(events.NewSessionUpdatedEvent(inst, []string{"resource_usage"}))
+	})
 	if err := w2.Validate(); err != nil {
 		return nil, err
 	}
@@ -498,6 +695,7 @@ func BuildRuntimeDeps(_ tmux.TmuxServerReady, svc *ServiceDeps, cfg *config.Conf
 	scrollbackConfig := scrollback.DefaultScrollbackConfig()
 	scrollbackConfig.StoragePath = scrollbackPath
 	scrollbackManager := scrollback.NewScrollbackManager(scrollbackConfig)
+	scrollbackManager.SetRedactor(session.RedactTerminalOutput)
 	log.Info("initialized ScrollbackManager", "path", scrollbackPath, "compression", scrollbackConfig.StoragePath, "maxLines", scrollbackConfig.MaxLines)
 
 	// Step 10: TmuxStreamerManager (independent)
@@ -650,9 +848,24 @@ func BuildRuntimeDeps(_ tmux.TmuxServerReady, svc *ServiceDeps, cfg *config.Conf
 	}
 
 	backlogSvc := services.NewBacklogService(storage, sessionService, cfg)
+	backlogSvc.SetDependencyStore(newBacklogDependencyStore())
+	backlogSvc.SetReviewPanelStore(svc.ReviewPanels)
+	backlogDispatcher := services.NewBacklogDispatcher(backlogSvc, func() config.BacklogDispatchConfig {
+		return config.LoadConfig().BacklogDispatch
+	})
+	backlogSvc.SetDispatcher(backlogDispatcher)
+	if cfg.GetFeatureFlag("backlog_autodispatch") {
+		if err := backlogDispatcher.Enable(context.Background()); err != nil {
+			log.Warn("failed to enable backlog auto-dispatch on startup", "err", err)
+		}
+	}
+	sessionService.SetFeatureController("backlog_autodispatch", backlogDispatcher)
 	sessionService.SetBacklogLifecycleListener(backlogLifecycleListener)
 	sessionService.SetFeatureController("backlog", backlogCtrl)
 
+	// Keep the history search index current as conversations are written.
+	historyLinker.RegisterFileCallback(sessionService.OnHistoryFileChanged)
+
 	// Initialize TokenStore and InsightsService for token usage analytics.
 	var insightsSvc *services.InsightsService
 	if homeDir, homeDirErr := os.UserHomeDir(); homeDirErr == nil {
@@ -679,6 +892,7 @@ func BuildRuntimeDeps(_ tmux.TmuxServerReady, svc *ServiceDeps, cfg *config.Conf
 		PRStatusPoller:          svc.PRStatusPoller,
 		HistoryLinker:           historyLinker,
 		ErrorRegistry:           svc.ErrorRegistry,
+		SandboxWatcher:          newSandboxWatcher(sessionService, eventBus),
 		UnfinishedScanner:       unfinishedScanner,
 		UnfinishedStateStore:    unfinishedStateStore,
 		UnfinishedWorkService:   unfinishedWorkSvc,
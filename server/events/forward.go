@@ -18,6 +18,9 @@ const (
 	EventSessionAcknowledged  = pkgevents.EventSessionAcknowledged
 	EventApprovalResponse     = pkgevents.EventApprovalResponse
 	EventNotification         = pkgevents.EventNotification
+	EventConfigChanged        = pkgevents.EventConfigChanged
+	EventConfigRejected       = pkgevents.EventConfigRejected
+	EventAgentActivity        = pkgevents.EventAgentActivity
 )
 
 // Constructor functions (var allows assignment but is callable with identical syntax)
@@ -32,4 +35,7 @@ var (
 	NewSessionAcknowledgedEvent  = pkgevents.NewSessionAcknowledgedEvent
 	NewApprovalResponseEvent     = pkgevents.NewApprovalResponseEvent
 	NewNotificationEvent         = pkgevents.NewNotificationEvent
+	NewConfigChangedEvent        = pkgevents.NewConfigChangedEvent
+	NewConfigRejectedEvent       = pkgevents.NewConfigRejectedEvent
+	NewAgentActivityEvent        = pkgevents.NewAgentActivityEvent
 )
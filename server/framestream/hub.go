@@ -0,0 +1,211 @@
+// Package framestream turns a session's terminal output into sequenced,
+// zstd-compressed frames for the "frames" streaming mode.
+//
+// Every chunk of output is recorded in the session's scrollback before it is
+// sent, and a frame's sequence is its scrollback sequence. A client that
+// reconnects presents the last sequence it applied and is sent exactly the
+// frames it missed, with no redraw. It is resynced with a snapshot only when
+// those frames are no longer held, or the output capture behind them was
+// interrupted.
+package framestream
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+	"github.com/tstapler/stapler-squad/session/scrollback"
+)
+
+// Source is a session's live terminal output. *session.Instance satisfies it.
+type Source interface {
+	StartControlMode() error
+	StopControlMode() error
+	SubscribeControlModeUpdates() (string, <-chan []byte)
+	UnsubscribeControlModeUpdates(id string)
+}
+
+// Config tunes a Hub.
+type Config struct {
+	// Linger is how long a session's stream keeps recording output after its
+	// last client leaves, so a client that reconnects within it resumes.
+	Linger time.Duration
+
+	// DictionarySampleBytes is how much output a stream records before it
+	// trains its compression dictionary. Zero disables dictionaries.
+	DictionarySampleBytes int
+
+	// MaxBacklogBytes bounds the output replayed on resume. A client further
+	// behind is resynced instead, since a snapshot is smaller.
+	MaxBacklogBytes int
+
+	// SubscriberBuffer is how many events a client may fall behind before
+	// its subscription is dropped.
+	SubscriberBuffer int
+
+	// NewRedactor, if set, returns a redactor applied to output before it is
+	// recorded, so secrets are neither sent nor replayed.
+	NewRedactor func() *secrets.StreamRedactor
+
+	// RedactFlushDelay is how long output held back by the redactor waits
+	// for more output before it is released.
+	RedactFlushDelay time.Duration
+}
+
+// DefaultConfig returns settings suited to interactive sessions on slow,
+// flaky links.
+func DefaultConfig() Config {
+	return Config{
+		Linger:                2 * time.Minute,
+		DictionarySampleBytes: 64 * 1024,
+		MaxBacklogBytes:       2 * 1024 * 1024,
+		SubscriberBuffer:      256,
+		RedactFlushDelay:      50 * time.Millisecond,
+	}
+}
+
+// Resync reasons reported when a client's cursor cannot be resumed.
+const (
+	// ReasonStreamChanged: the cursor is from a stream that has since ended.
+	ReasonStreamChanged = "stream_changed"
+	// ReasonEvicted: frames after the cursor are no longer held.
+	ReasonEvicted = "evicted"
+	// ReasonTooFarBehind: replaying the missed frames would cost more than a snapshot.
+	ReasonTooFarBehind = "too_far_behind"
+)
+
+// errStreamEnded is returned when subscribing to a stream that ended
+// after it was looked up.
+var errStreamEnded = errors.New("frame stream ended")
+
+// Cursor is where a client left off: the last frame it applied.
+type Cursor struct {
+	StreamID string
+	Sequence uint64
+}
+
+// Frame is one chunk of output ready to send.
+type Frame struct {
+	Sequence     uint64
+	Data         []byte // compressed when Compressed is set
+	Compressed   bool
+	DictionaryID uint32
+	RawSize      int
+	Raw          []byte // the uncompressed output
+	Snapshot     bool
+}
+
+// Dictionary is a compression dictionary a client needs to decode frames
+// naming its ID.
+type Dictionary struct {
+	ID   uint32
+	Data []byte
+}
+
+// Event is one item of a subscription's live feed: a frame, or a new
+// dictionary used by the frames after it.
+type Event struct {
+	Frame      *Frame
+	Dictionary *Dictionary
+}
+
+// Subscription is one client's view of a session's frame stream.
+type Subscription struct {
+	// StreamID identifies the stream; clients echo it back in their cursor.
+	StreamID string
+
+	// Resumed is set when the client's cursor was honoured: Backlog holds
+	// the frames after it and the client keeps its screen.
+	Resumed bool
+
+	// ResyncReason says why a cursor was not honoured; empty when Resumed or
+	// when no cursor was given.
+	ResyncReason string
+
+	// Sequence is where the stream continues from; live frames follow it.
+	Sequence uint64
+
+	// Dictionary decodes Snapshot, Backlog and live frames until an Event
+	// replaces it. Nil until the stream has trained one.
+	Dictionary *Dictionary
+
+	// Snapshot is the current screen, tagged with Sequence, when not Resumed.
+	Snapshot *Frame
+
+	// Backlog holds the frames missed since the cursor, when Resumed.
+	Backlog []Frame
+
+	// Events carries live frames and dictionary changes. It is closed when
+	// the stream ends or the client falls SubscriberBuffer events behind.
+	Events <-chan Event
+
+	close func()
+}
+
+// Close detaches the subscription from its stream. Safe to call more than once.
+func (s *Subscription) Close() {
+	s.close()
+}
+
+// Hub runs one frame stream per session, shared by all of that session's
+// "frames" clients. A stream owns the session's output capture while it
+// runs and outlives its last client by Config.Linger.
+type Hub struct {
+	log    *scrollback.ScrollbackManager
+	config Config
+
+	mu      sync.Mutex
+	streams map[string]*stream
+}
+
+// NewHub creates a Hub that records output in log.
+func NewHub(log *scrollback.ScrollbackManager, config Config) *Hub {
+	return &Hub{
+		log:     log,
+		config:  config,
+		streams: make(map[string]*stream),
+	}
+}
+
+// Attach subscribes a client to sessionID's frames, starting the session's
+// stream on src if none is running. When cursor (which may be nil) can be
+// resumed, the subscription carries the frames after it. Otherwise snapshot
+// is called, with output held, for the current screen, which becomes the
+// subscription's Snapshot.
+func (h *Hub) Attach(sessionID string, src Source, cursor *Cursor, snapshot func() []byte) (*Subscription, error) {
+	for {
+		h.mu.Lock()
+		st, ok := h.streams[sessionID]
+		if !ok {
+			var err error
+			st, err = h.startStream(sessionID, src)
+			if err != nil {
+				h.mu.Unlock()
+				return nil, err
+			}
+			h.streams[sessionID] = st
+		}
+		h.mu.Unlock()
+
+		sub, err := st.subscribe(cursor, snapshot)
+		if errors.Is(err, errStreamEnded) {
+			continue // ended between lookup and subscribe; start a fresh one
+		}
+		return sub, err
+	}
+}
+
+// Close ends every stream, stopping their output capture.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	streams := make([]*stream, 0, len(h.streams))
+	for _, st := range h.streams {
+		streams = append(streams, st)
+	}
+	h.mu.Unlock()
+
+	for _, st := range streams {
+		st.end(true)
+	}
+}
@@ -0,0 +1,355 @@
+package framestream
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tstapler/stapler-squad/session/scrollback"
+)
+
+// fakeSource is a Source fed by the test.
+type fakeSource struct {
+	mu      sync.Mutex
+	subs    map[string]chan []byte
+	next    int
+	started int
+	stopped int
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{subs: make(map[string]chan []byte)}
+}
+
+func (f *fakeSource) StartControlMode() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.started++
+	return nil
+}
+
+func (f *fakeSource) StopControlMode() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stopped++
+	for id, ch := range f.subs {
+		close(ch)
+		delete(f.subs, id)
+	}
+	return nil
+}
+
+func (f *fakeSource) SubscribeControlModeUpdates() (string, <-chan []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next++
+	id := fmt.Sprintf("sub-%d", f.next)
+	ch := make(chan []byte, 16)
+	f.subs[id] = ch
+	return id, ch
+}
+
+func (f *fakeSource) UnsubscribeControlModeUpdates(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if ch, ok := f.subs[id]; ok {
+		close(ch)
+		delete(f.subs, id)
+	}
+}
+
+// emit sends output to every subscriber.
+func (f *fakeSource) emit(data string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, ch := range f.subs {
+		ch <- []byte(data)
+	}
+}
+
+// interrupt closes every subscriber, as when the output capture dies.
+func (f *fakeSource) interrupt() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for id, ch := range f.subs {
+		close(ch)
+		delete(f.subs, id)
+	}
+}
+
+func newTestHub(t *testing.T, config Config) *Hub {
+	t.Helper()
+	cfg := scrollback.DefaultScrollbackConfig()
+	cfg.StoragePath = t.TempDir()
+	cfg.FlushInterval = time.Hour
+	cfg.MaxLines = 8
+	log := scrollback.NewScrollbackManager(cfg)
+	t.Cleanup(func() { _ = log.Close() })
+
+	hub := NewHub(log, config)
+	t.Cleanup(hub.Close)
+	return hub
+}
+
+func testConfig() Config {
+	cfg := DefaultConfig()
+	cfg.DictionarySampleBytes = 0
+	return cfg
+}
+
+func snapshotOf(s string) func() []byte {
+	return func() []byte { return []byte(s) }
+}
+
+// nextFrame waits for the next live frame on sub.
+func nextFrame(t *testing.T, sub *Subscription) Frame {
+	t.Helper()
+	for {
+		select {
+		case ev, ok := <-sub.Events:
+			if !ok {
+				t.Fatal("subscription closed while waiting for a frame")
+			}
+			if ev.Frame != nil {
+				return *ev.Frame
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for a frame")
+		}
+	}
+}
+
+// TestAttachResumesFromCursor verifies a client that reconnects gets exactly
+// the frames it missed and no snapshot.
+func TestAttachResumesFromCursor(t *testing.T) {
+	hub := newTestHub(t, testConfig())
+	src := newFakeSource()
+
+	first, err := hub.Attach("s1", src, nil, snapshotOf("screen"))
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	if first.Snapshot == nil || string(first.Snapshot.Raw) != "screen" {
+		t.Fatalf("expected a snapshot for a fresh client, got %+v", first.Snapshot)
+	}
+
+	src.emit("one")
+	f1 := nextFrame(t, first)
+	if string(f1.Raw) != "one" || f1.Sequence != first.Sequence+1 {
+		t.Fatalf("unexpected first frame %+v after sequence %d", f1, first.Sequence)
+	}
+	first.Close()
+
+	// Output produced while disconnected is still recorded.
+	src.emit("two")
+	waitForSequence(t, hub, "s1", f1.Sequence+1)
+	src.emit("three")
+	waitForSequence(t, hub, "s1", f1.Sequence+2)
+
+	second, err := hub.Attach("s1", src, &Cursor{StreamID: first.StreamID, Sequence: f1.Sequence}, snapshotOf("screen"))
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	defer second.Close()
+
+	if !second.Resumed || second.Snapshot != nil {
+		t.Fatalf("expected resume without snapshot, got resumed=%v reason=%q", second.Resumed, second.ResyncReason)
+	}
+	var got string
+	for _, f := range second.Backlog {
+		got += string(f.Raw)
+	}
+	if got != "twothree" {
+		t.Errorf("backlog = %q, want %q", got, "twothree")
+	}
+	src.mu.Lock()
+	defer src.mu.Unlock()
+	if src.started != 1 {
+		t.Errorf("output capture started %d times, want 1", src.started)
+	}
+}
+
+// TestAttachResyncs verifies cursors that cannot be resumed get a snapshot
+// and the reason why.
+func TestAttachResyncs(t *testing.T) {
+	t.Run("stream changed", func(t *testing.T) {
+		hub := newTestHub(t, testConfig())
+		sub, err := hub.Attach("s1", newFakeSource(), &Cursor{StreamID: "gone", Sequence: 0}, snapshotOf("screen"))
+		if err != nil {
+			t.Fatalf("Attach: %v", err)
+		}
+		defer sub.Close()
+		if sub.Resumed || sub.ResyncReason != ReasonStreamChanged || sub.Snapshot == nil {
+			t.Errorf("got resumed=%v reason=%q snapshot=%v", sub.Resumed, sub.ResyncReason, sub.Snapshot != nil)
+		}
+	})
+
+	t.Run("evicted", func(t *testing.T) {
+		hub := newTestHub(t, testConfig())
+		src := newFakeSource()
+		sub, err := hub.Attach("s1", src, nil, nil)
+		if err != nil {
+			t.Fatalf("Attach: %v", err)
+		}
+		defer sub.Close()
+		cursor := Cursor{StreamID: sub.StreamID, Sequence: sub.Sequence}
+
+		// The test scrollback holds 8 entries; outrun it.
+		for i := 0; i < 12; i++ {
+			src.emit(fmt.Sprintf("line %d", i))
+			nextFrame(t, sub)
+		}
+
+		again, err := hub.Attach("s1", src, &cursor, snapshotOf("screen"))
+		if err != nil {
+			t.Fatalf("Attach: %v", err)
+		}
+		defer again.Close()
+		if again.Resumed || again.ResyncReason != ReasonEvicted {
+			t.Errorf("got resumed=%v reason=%q, want %q", again.Resumed, again.ResyncReason, ReasonEvicted)
+		}
+	})
+
+	t.Run("too far behind", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.MaxBacklogBytes = 4
+		hub := newTestHub(t, cfg)
+		src := newFakeSource()
+		sub, err := hub.Attach("s1", src, nil, nil)
+		if err != nil {
+			t.Fatalf("Attach: %v", err)
+		}
+		defer sub.Close()
+		cursor := Cursor{StreamID: sub.StreamID, Sequence: sub.Sequence}
+
+		src.emit("more than four bytes")
+		nextFrame(t, sub)
+
+		again, err := hub.Attach("s1", src, &cursor, snapshotOf("screen"))
+		if err != nil {
+			t.Fatalf("Attach: %v", err)
+		}
+		defer again.Close()
+		if again.Resumed || again.ResyncReason != ReasonTooFarBehind {
+			t.Errorf("got resumed=%v reason=%q, want %q", again.Resumed, again.ResyncReason, ReasonTooFarBehind)
+		}
+	})
+}
+
+// TestInterruptedCaptureStartsNewStream verifies a cursor from before the
+// output capture was interrupted is not resumed, since output may be missing.
+func TestInterruptedCaptureStartsNewStream(t *testing.T) {
+	hub := newTestHub(t, testConfig())
+	src := newFakeSource()
+
+	sub, err := hub.Attach("s1", src, nil, nil)
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	cursor := Cursor{StreamID: sub.StreamID, Sequence: sub.Sequence}
+
+	src.interrupt()
+	select {
+	case _, ok := <-sub.Events:
+		if ok {
+			t.Fatal("expected the subscription to close")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the subscription to close")
+	}
+	sub.Close()
+
+	again, err := hub.Attach("s1", src, &cursor, snapshotOf("screen"))
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	defer again.Close()
+	if again.StreamID == cursor.StreamID || again.ResyncReason != ReasonStreamChanged {
+		t.Errorf("got stream %q reason=%q, want a new stream", again.StreamID, again.ResyncReason)
+	}
+}
+
+// TestLingerStopsCapture verifies the output capture is stopped once the
+// last client has been gone for Config.Linger.
+func TestLingerStopsCapture(t *testing.T) {
+	cfg := testConfig()
+	cfg.Linger = 10 * time.Millisecond
+	hub := newTestHub(t, cfg)
+	src := newFakeSource()
+
+	sub, err := hub.Attach("s1", src, nil, nil)
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	sub.Close()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		src.mu.Lock()
+		stopped := src.stopped
+		src.mu.Unlock()
+		if stopped == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("output capture was not stopped after linger")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// TestDictionaryIsAnnounced verifies a trained dictionary is sent to live
+// clients and used for the frames after it.
+func TestDictionaryIsAnnounced(t *testing.T) {
+	cfg := testConfig()
+	cfg.DictionarySampleBytes = 2048
+	hub := newTestHub(t, cfg)
+	src := newFakeSource()
+
+	sub, err := hub.Attach("s1", src, nil, nil)
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	defer sub.Close()
+
+	line := "\x1b[2K\x1b[1G\x1b[1mRunning tests\x1b[0m \x1b[32m✓ passed\x1b[0m\r\n"
+	var dict *Dictionary
+	deadline := time.After(5 * time.Second)
+	for i := 0; dict == nil; i++ {
+		src.emit(fmt.Sprintf("%s(%d)", line, i))
+		select {
+		case ev, ok := <-sub.Events:
+			if !ok {
+				t.Fatal("subscription closed")
+			}
+			dict = ev.Dictionary
+		case <-deadline:
+			t.Fatal("timed out waiting for a dictionary")
+		}
+	}
+
+	for {
+		src.emit(line + line)
+		f := nextFrame(t, sub)
+		if f.Compressed && f.DictionaryID == dict.ID {
+			return
+		}
+		if f.Compressed && f.DictionaryID == 0 {
+			continue // encoded before the switch
+		}
+		t.Fatalf("frame after dictionary used ID %d, want %d", f.DictionaryID, dict.ID)
+	}
+}
+
+// waitForSequence waits until sessionID's scrollback reaches seq.
+func waitForSequence(t *testing.T, hub *Hub, sessionID string, seq uint64) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for hub.log.CurrentSequence(sessionID) < seq {
+		if time.Now().After(deadline) {
+			t.Fatalf("scrollback did not reach sequence %d", seq)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,375 @@
+package framestream
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+	"github.com/tstapler/stapler-squad/server/compression"
+)
+
+// stream records one session's output and fans it out as frames.
+type stream struct {
+	hub       *Hub
+	sessionID string
+	id        string
+	src       Source
+	tapID     string
+
+	// startSeq is the scrollback sequence when the stream started; every
+	// entry after it was recorded by this stream, without gaps.
+	startSeq uint64
+
+	mu       sync.Mutex
+	ended    bool
+	codec    *compression.ZstdCodec
+	dict     *Dictionary
+	codecs   []*compression.ZstdCodec // every codec used, closed when the stream ends
+	samples  [][]byte                 // output collected for dictionary training
+	sampled  int
+	training bool
+	subs     map[*subscriber]struct{}
+	linger   *time.Timer
+}
+
+// subscriber is one client's live feed.
+type subscriber struct {
+	ch chan Event
+}
+
+// startStream starts capturing sessionID's output from src. Called with h.mu held.
+func (h *Hub) startStream(sessionID string, src Source) (*stream, error) {
+	if err := src.StartControlMode(); err != nil {
+		return nil, fmt.Errorf("failed to start output capture: %w", err)
+	}
+	codec, err := compression.NewZstdCodec(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &stream{
+		hub:       h,
+		sessionID: sessionID,
+		id:        uuid.NewString(),
+		src:       src,
+		startSeq:  h.log.CurrentSequence(sessionID),
+		codec:     codec,
+		codecs:    []*compression.ZstdCodec{codec},
+		subs:      make(map[*subscriber]struct{}),
+	}
+	tapID, ch := src.SubscribeControlModeUpdates()
+	st.tapID = tapID
+	go st.run(ch)
+
+	log.Info("[framestream] stream started", "session", sessionID, "stream", st.id, "start_seq", st.startSeq)
+	return st, nil
+}
+
+// run records output from ch until it closes, then ends the stream.
+func (st *stream) run(ch <-chan []byte) {
+	cfg := st.hub.config
+
+	var redactor *secrets.StreamRedactor
+	if cfg.NewRedactor != nil {
+		redactor = cfg.NewRedactor()
+	}
+	var flushC <-chan time.Time
+
+	for {
+		select {
+		case <-flushC:
+			flushC = nil
+			st.record(redactor.Flush())
+		case data, ok := <-ch:
+			if !ok {
+				if redactor != nil {
+					st.record(redactor.Flush())
+				}
+				st.end(false)
+				return
+			}
+
+			// Coalesce whatever else is already queued into one frame.
+			// data is shared with other subscribers, so copy before appending.
+			buf := append([]byte(nil), data...)
+		coalesce:
+			for {
+				select {
+				case more, ok := <-ch:
+					if !ok {
+						break coalesce
+					}
+					buf = append(buf, more...)
+				default:
+					break coalesce
+				}
+			}
+
+			if redactor != nil {
+				buf = redactor.Write(buf)
+				flushC = nil
+				if redactor.Pending() {
+					flushC = time.After(cfg.RedactFlushDelay)
+				}
+			}
+			st.record(buf)
+		}
+	}
+}
+
+// record appends output to the session's scrollback and sends it to every
+// subscriber as a frame.
+func (st *stream) record(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.ended {
+		return
+	}
+
+	entry, err := st.hub.log.Append(st.sessionID, data)
+	if err != nil || entry.Sequence == 0 {
+		log.Warn("[framestream] failed to record output", "session", st.sessionID, "err", err)
+		return
+	}
+
+	frame := st.encode(entry.Sequence, entry.Data, false)
+	st.broadcast(Event{Frame: &frame})
+	st.sample(entry.Data)
+}
+
+// encode builds a frame with the current codec. Called with st.mu held.
+func (st *stream) encode(seq uint64, raw []byte, snapshot bool) Frame {
+	data, compressed := st.codec.Compress(raw)
+	frame := Frame{
+		Sequence:   seq,
+		Data:       data,
+		Compressed: compressed,
+		RawSize:    len(raw),
+		Raw:        raw,
+		Snapshot:   snapshot,
+	}
+	if compressed {
+		frame.DictionaryID = st.codec.DictionaryID()
+	}
+	return frame
+}
+
+// broadcast sends ev to every subscriber, dropping any that have fallen
+// too far behind; they reconnect and resume from their cursor. Called with
+// st.mu held.
+func (st *stream) broadcast(ev Event) {
+	for sub := range st.subs {
+		select {
+		case sub.ch <- ev:
+		default:
+			log.Warn("[framestream] subscriber fell behind, dropping it", "session", st.sessionID, "stream", st.id)
+			close(sub.ch)
+			delete(st.subs, sub)
+		}
+	}
+	if len(st.subs) == 0 {
+		st.startLinger()
+	}
+}
+
+// sample collects output for dictionary training and starts training once
+// enough has been seen. Called with st.mu held.
+func (st *stream) sample(data []byte) {
+	limit := st.hub.config.DictionarySampleBytes
+	if limit <= 0 || st.dict != nil || st.training {
+		return
+	}
+	st.samples = append(st.samples, data)
+	st.sampled += len(data)
+	if st.sampled < limit {
+		return
+	}
+
+	samples := st.samples
+	st.samples = nil
+	st.training = true
+	go st.train(samples)
+}
+
+// train builds the stream's dictionary from samples and switches later
+// frames to it.
+func (st *stream) train(samples [][]byte) {
+	// IDs below 32768 are reserved by the zstd format.
+	id := 32768 + rand.Uint32N(1<<31-32768)
+	dictData, err := compression.TrainZstdDictionary(id, samples)
+	var codec *compression.ZstdCodec
+	if err == nil {
+		codec, err = compression.NewZstdCodec(dictData)
+	}
+	if err != nil {
+		log.Warn("[framestream] dictionary training failed, continuing without", "session", st.sessionID, "err", err)
+		return
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.ended {
+		codec.Close()
+		return
+	}
+	st.codec = codec
+	st.codecs = append(st.codecs, codec)
+	st.dict = &Dictionary{ID: id, Data: dictData}
+	st.broadcast(Event{Dictionary: st.dict})
+
+	log.Info("[framestream] trained dictionary", "session", st.sessionID, "stream", st.id, "bytes", len(dictData))
+}
+
+// subscribe adds a client, resuming it from cursor when possible.
+func (st *stream) subscribe(cursor *Cursor, snapshot func() []byte) (*Subscription, error) {
+	cfg := st.hub.config
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.ended {
+		return nil, errStreamEnded
+	}
+
+	sub := &Subscription{
+		StreamID:   st.id,
+		Sequence:   st.hub.log.CurrentSequence(st.sessionID),
+		Dictionary: st.dict,
+	}
+
+	if cursor != nil {
+		var backlog []Frame
+		reason := ReasonStreamChanged
+		if cursor.StreamID == st.id && cursor.Sequence >= st.startSeq {
+			backlog, reason = st.backlog(cursor.Sequence, cfg.MaxBacklogBytes)
+		}
+		if reason == "" {
+			sub.Resumed = true
+			sub.Sequence = cursor.Sequence
+			sub.Backlog = backlog
+		}
+		sub.ResyncReason = reason
+	}
+
+	if !sub.Resumed && snapshot != nil {
+		if data := snapshot(); len(data) > 0 {
+			frame := st.encode(sub.Sequence, data, true)
+			sub.Snapshot = &frame
+		}
+	}
+
+	s := &subscriber{ch: make(chan Event, max(cfg.SubscriberBuffer, 1))}
+	st.subs[s] = struct{}{}
+	if st.linger != nil {
+		st.linger.Stop()
+		st.linger = nil
+	}
+	sub.Events = s.ch
+
+	var once sync.Once
+	sub.close = func() {
+		once.Do(func() { st.unsubscribe(s) })
+	}
+	return sub, nil
+}
+
+// backlog returns the frames after seq, or the reason they cannot be
+// replayed. Called with st.mu held.
+func (st *stream) backlog(seq uint64, maxBytes int) ([]Frame, string) {
+	entries, ok := st.hub.log.EntriesAfter(st.sessionID, seq)
+	if !ok {
+		return nil, ReasonEvicted
+	}
+
+	total := 0
+	for _, e := range entries {
+		total += len(e.Data)
+	}
+	if maxBytes > 0 && total > maxBytes {
+		return nil, ReasonTooFarBehind
+	}
+
+	frames := make([]Frame, 0, len(entries))
+	for _, e := range entries {
+		frames = append(frames, st.encode(e.Sequence, e.Data, false))
+	}
+	return frames, ""
+}
+
+// unsubscribe removes a client and starts the linger countdown if it was
+// the last one.
+func (st *stream) unsubscribe(s *subscriber) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if _, ok := st.subs[s]; !ok {
+		return // already dropped or the stream ended
+	}
+	delete(st.subs, s)
+	close(s.ch)
+	if len(st.subs) == 0 && !st.ended {
+		st.startLinger()
+	}
+}
+
+// startLinger ends the stream after Config.Linger unless a client attaches
+// first. Called with st.mu held.
+func (st *stream) startLinger() {
+	if st.linger != nil {
+		return
+	}
+	st.linger = time.AfterFunc(st.hub.config.Linger, func() {
+		st.endIf(true, func() bool { return len(st.subs) == 0 })
+	})
+}
+
+// end stops the stream, closing every subscription. stopSource also stops
+// the session's output capture, which the stream owned.
+func (st *stream) end(stopSource bool) {
+	st.endIf(stopSource, func() bool { return true })
+}
+
+// endIf ends the stream if cond, checked with st.mu held, still holds.
+func (st *stream) endIf(stopSource bool, cond func() bool) {
+	st.mu.Lock()
+	if st.ended || !cond() {
+		st.mu.Unlock()
+		return
+	}
+	st.ended = true
+	for sub := range st.subs {
+		close(sub.ch)
+		delete(st.subs, sub)
+	}
+	if st.linger != nil {
+		st.linger.Stop()
+		st.linger = nil
+	}
+	codecs := st.codecs
+	st.codecs = nil
+	st.mu.Unlock()
+
+	st.hub.mu.Lock()
+	if st.hub.streams[st.sessionID] == st {
+		delete(st.hub.streams, st.sessionID)
+	}
+	st.hub.mu.Unlock()
+
+	st.src.UnsubscribeControlModeUpdates(st.tapID)
+	if stopSource {
+		if err := st.src.StopControlMode(); err != nil {
+			log.Warn("[framestream] failed to stop output capture", "session", st.sessionID, "err", err)
+		}
+	}
+	for _, c := range codecs {
+		c.Close()
+	}
+
+	log.Info("[framestream] stream ended", "session", st.sessionID, "stream", st.id)
+}
@@ -16,8 +16,9 @@ import (
 
 // NewCore creates an MCPServer with all tools registered.
 // Shared by the stdio path (RunServer) and the HTTP path (NewHTTPHandler).
-// storage is optional — when nil, backlog tools are not registered.
-func NewCore(store session.InstanceStore, svc *services.SessionService, sbMgr *scrollback.ScrollbackManager, storage *session.Storage) *mcpserver.MCPServer {
+// storage is optional — when nil, backlog tools are not registered. panels may
+// be nil, in which case every review verdict is final on its own.
+func NewCore(store session.InstanceStore, svc *services.SessionService, sbMgr *scrollback.ScrollbackManager, storage *session.Storage, panels *session.ReviewPanelStore) *mcpserver.MCPServer {
 	s := mcpserver.NewMCPServer(
 		"stapler-squad",
 		"1.0.0",
@@ -26,14 +27,24 @@ func NewCore(store session.InstanceStore, svc *services.SessionService, sbMgr *s
 
 	registerDiscoveryTools(s, &discoveryHandlers{store: store})
 	registerLifecycleTools(s, &lifecycleHandlers{store: store, svc: svc})
-	registerTerminalTools(s, &terminalHandlers{
+	th := &terminalHandlers{
 		store:      store,
 		scrollback: sbMgr,
 		writeLim:   newTokenBucket(writeRateLimitPerSec, writeRateLimitPerSec),
-	})
+	}
+	if svc != nil {
+		th.input = svc
+	}
+	registerTerminalTools(s, th)
 	registerVCSTools(s, &vcsHandlers{store: store})
+	if svc != nil {
+		registerTimelineTools(s, &timelineHandlers{timelines: svc})
+		if svc.Schedules() != nil {
+			registerScheduleTools(s, &scheduleHandlers{backend: svc})
+		}
+	}
 	if storage != nil {
-		registerBacklogTools(s, &backlogHandlers{storage: storage, store: store})
+		registerBacklogTools(s, &backlogHandlers{storage: storage, store: store, panels: panels})
 	}
 	return s
 }
@@ -42,16 +53,17 @@ func NewCore(store session.InstanceStore, svc *services.SessionService, sbMgr *s
 // Streamable HTTP (the MCP 2025-03-26 transport). Mount it at /mcp on the
 // existing HTTP server so Claude sessions can connect without spawning a
 // subprocess.
-func NewHTTPHandler(store session.InstanceStore, svc *services.SessionService, sbMgr *scrollback.ScrollbackManager, storage *session.Storage) *mcpserver.StreamableHTTPServer {
-	return mcpserver.NewStreamableHTTPServer(NewCore(store, svc, sbMgr, storage))
+func NewHTTPHandler(store session.InstanceStore, svc *services.SessionService, sbMgr *scrollback.ScrollbackManager, storage *session.Storage, panels *session.ReviewPanelStore) *mcpserver.StreamableHTTPServer {
+	return mcpserver.NewStreamableHTTPServer(NewCore(store, svc, sbMgr, storage, panels))
 }
 
 // RunServer initializes and starts the MCP stdio server.
 // It blocks until the context is cancelled or stdin is closed.
 // store is used for read-only discovery tools. svc provides lifecycle operations.
 // sbMgr provides read access to terminal scrollback data persisted on disk.
-// storage is used for backlog tools (optional; pass nil to disable).
-func RunServer(ctx context.Context, store session.InstanceStore, svc *services.SessionService, sbMgr *scrollback.ScrollbackManager, storage *session.Storage) error {
+// storage is used for backlog tools (optional; pass nil to disable), and panels
+// records review panel verdicts.
+func RunServer(ctx context.Context, store session.InstanceStore, svc *services.SessionService, sbMgr *scrollback.ScrollbackManager, storage *session.Storage, panels *session.ReviewPanelStore) error {
 	log.Info("mcp server starting on stdio transport")
 
 	// Inject session UUID from environment into the root context so that
@@ -61,7 +73,7 @@ func RunServer(ctx context.Context, store session.InstanceStore, svc *services.S
 		log.InfoLog.Printf("[mcp] session UUID injected from environment: %s", uuid)
 	}
 
-	stdio := mcpserver.NewStdioServer(NewCore(store, svc, sbMgr, storage))
+	stdio := mcpserver.NewStdioServer(NewCore(store, svc, sbMgr, storage, panels))
 	return stdio.Listen(ctx, os.Stdin, os.Stdout)
 }
 
@@ -7,13 +7,14 @@ import (
 	"context"
 	"encoding/json"
 	"os/exec"
+	"slices"
 	"testing"
 	"time"
 )
 
 // TestMCPHandshakeSubprocess builds the binary and verifies that a full
-// MCP handshake (initialize + tools/list) over stdio returns exactly 20
-// registered tools (I-1.1, I-1.4).
+// MCP handshake (initialize + tools/list) over stdio returns exactly 27
+// registered tools, including get_session_timeline (I-1.1, I-1.4).
 func TestMCPHandshakeSubprocess(t *testing.T) {
 	binaryPath := t.TempDir() + "/stapler-squad-test"
 	build := exec.Command("go", "build", "-o", binaryPath, ".")
@@ -78,11 +79,14 @@ func TestMCPHandshakeSubprocess(t *testing.T) {
 	if !ok {
 		t.Fatal("tools field is not an array")
 	}
-	if len(tools) != 20 {
-		names := make([]string, len(tools))
-		for i, tool := range tools {
-			names[i] = tool.(map[string]interface{})["name"].(string)
-		}
-		t.Errorf("expected 20 tools, got %d: %v", len(tools), names)
+	names := make([]string, len(tools))
+	for i, tool := range tools {
+		names[i] = tool.(map[string]interface{})["name"].(string)
+	}
+	if len(tools) != 27 {
+		t.Errorf("expected 27 tools, got %d: %v", len(tools), names)
+	}
+	if !slices.Contains(names, "get_session_timeline") {
+		t.Errorf("get_session_timeline is not registered: %v", names)
 	}
 }
@@ -10,6 +10,7 @@ import (
 
 	mcpgo "github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/session"
 )
@@ -60,6 +61,9 @@ const (
 type backlogHandlers struct {
 	storage *session.Storage
 	store   session.InstanceStore
+	// panels groups review sessions into consensus panels; nil makes every
+	// verdict final on its own.
+	panels *session.ReviewPanelStore
 }
 
 // --- get_backlog_item ---
@@ -316,40 +320,96 @@ func (h *backlogHandlers) submitReviewVerdict(ctx context.Context, req mcpgo.Cal
 		}
 	}
 
-	overallOutcome := session.AggregateOutcome(cvs)
-
-	// Serialize per-criterion verdicts to JSON.
-	perCriterionJSON, jsonErr := json.Marshal(cvs)
-	if jsonErr != nil {
-		return errResult(ErrInternalError, fmt.Sprintf("serialize verdicts: %v", jsonErr), ""), nil
+	var rubric *session.RubricScores
+	if raw, present := args["rubric"]; present && raw != nil {
+		b, marshalErr := json.Marshal(raw)
+		if marshalErr != nil {
+			return errResult(ErrInvalidArgument, fmt.Sprintf("rubric: cannot marshal: %v", marshalErr), ""), nil
+		}
+		var r session.RubricScores
+		if err := json.Unmarshal(b, &r); err != nil {
+			return errResult(ErrInvalidArgument, fmt.Sprintf("rubric: invalid shape: %v", err), ""), nil
+		}
+		if err := r.Validate(); err != nil {
+			return errResult(ErrInvalidArgument, err.Error(), ""), nil
+		}
+		rubric = &r
 	}
 
-	verdictData := session.ReviewVerdictData{
-		ItemSessionID:  itemSession.ID.String(),
-		OverallOutcome: overallOutcome,
-		PerCriterion:   string(perCriterionJSON),
-		Summary:        summary,
+	overallOutcome := session.AggregateOutcome(cvs)
+	verdict := session.PanelVerdict{
+		Outcome:      overallOutcome,
+		PerCriterion: cvs,
+		Summary:      summary,
+		Rubric:       rubric,
 	}
 
-	if _, saveErr := h.storage.SaveReviewVerdict(ctx, itemSession.ID.String(), verdictData); saveErr != nil {
-		return errResult(ErrInternalError, fmt.Sprintf("save review verdict: %v", saveErr), ""), nil
+	run, decided, recordErr := h.recordPanelVerdict(ctx, callerUUID, itemID, verdict)
+	if errors.Is(recordErr, session.ErrPanelDecided) {
+		return errResult(ErrInvalidArgument, fmt.Sprintf("the review panel for item %s has already decided (%s)", itemID, run.Outcome), ""), nil
+	}
+	if recordErr != nil {
+		return errResult(ErrInternalError, fmt.Sprintf("record review verdict: %v", recordErr), ""), nil
 	}
 
-	// If PASS, transition item to done (only from review status).
-	if overallOutcome == session.ReviewVerdictPass {
-		precondition := &session.BacklogItemPrecondition{ExpectedStatus: string(session.BacklogStatusReview)}
-		if _, transErr := h.storage.TransitionBacklogItemStatus(ctx, itemID, session.BacklogStatusDone, precondition); transErr != nil {
-			log.InfoLog.Printf("[mcp:submit_review_verdict] PASS but transition to done failed: %v", transErr)
-			// Non-fatal — verdict is saved, status transition is best-effort.
+	if !decided {
+		waiting := 0
+		for _, rv := range run.Reviewers {
+			if rv.Verdict == nil {
+				waiting++
+			}
 		}
+		return mcpgo.NewToolResultText(fmt.Sprintf(
+			"Review verdict recorded for item %s. Your outcome: %s\n\nThe %s review panel is waiting for %d of %d reviewers before deciding.",
+			itemID, overallOutcome, run.Consensus, waiting, len(run.Reviewers),
+		)), nil
+	}
+
+	// The completing reviewer's ItemSession carries the gating verdict; if PASS,
+	// the item moves to done.
+	if err := session.SaveReviewPanelDecision(ctx, h.storage, run, itemSession.ID.String()); err != nil {
+		return errResult(ErrInternalError, err.Error(), ""), nil
 	}
 
+	if len(run.Reviewers) == 1 {
+		return mcpgo.NewToolResultText(fmt.Sprintf(
+			"Review verdict submitted for item %s. Overall outcome: %s\n\nSummary: %s",
+			itemID, run.Outcome, summary,
+		)), nil
+	}
 	return mcpgo.NewToolResultText(fmt.Sprintf(
-		"Review verdict submitted for item %s. Overall outcome: %s\n\nSummary: %s",
-		itemID, overallOutcome, summary,
+		"Review verdict submitted for item %s. Your outcome: %s\n\n%s",
+		itemID, overallOutcome, run.Summary(),
 	)), nil
 }
 
+// recordPanelVerdict records the caller's verdict on its review panel. A
+// review session started outside the review gate (e.g. a manual re-review)
+// has no panel and is recorded as a panel of one.
+func (h *backlogHandlers) recordPanelVerdict(ctx context.Context, sessionUUID, itemID string, v session.PanelVerdict) (*session.ReviewPanelRun, bool, error) {
+	panels := h.panels
+	if panels == nil {
+		panels, _ = session.NewReviewPanelStore("")
+	}
+	run, decided, err := panels.RecordVerdict(sessionUUID, v)
+	if !errors.Is(err, session.ErrNotFound) {
+		return run, decided, err
+	}
+
+	repoPath := ""
+	if item, itemErr := h.storage.GetBacklogItem(ctx, itemID); itemErr == nil {
+		repoPath = item.RepoPath
+	}
+	solo := session.NewReviewPanelRun(itemID, repoPath, "", config.ReviewPanelConfig{})
+	if err := panels.Create(solo); err != nil {
+		return nil, false, err
+	}
+	if err := panels.BindReviewer(solo.ID, 0, sessionUUID); err != nil {
+		return nil, false, err
+	}
+	return panels.RecordVerdict(sessionUUID, v)
+}
+
 // --- submit_triage_result ---
 
 // triageSuggestion is a single suggestion entry for submit_triage_result.
@@ -502,7 +562,7 @@ func registerBacklogTools(s *mcpserver.MCPServer, h *backlogHandlers) {
 
 	s.AddTool(
 		mcpgo.NewTool("submit_review_verdict",
-			mcpgo.WithDescription("Submit per-criterion review verdicts for a backlog item. Only sessions with role='review' may call this. If overall outcome is PASS, the item is automatically transitioned to done."),
+			mcpgo.WithDescription("Submit per-criterion review verdicts and rubric scores for a backlog item. Only sessions with role='review' may call this. When the review panel has a consensus (immediately for a single reviewer) and it is PASS, the item is automatically transitioned to done."),
 			mcpgo.WithString("item_id",
 				mcpgo.Description("UUID of the backlog item"),
 				mcpgo.Required(),
@@ -524,6 +584,15 @@ func registerBacklogTools(s *mcpserver.MCPServer, h *backlogHandlers) {
 				mcpgo.Description("Overall review summary explaining the verdict"),
 				mcpgo.Required(),
 			),
+			mcpgo.WithObject("rubric",
+				mcpgo.Description("Scores from 0 to 10 for correctness, tests, style and security"),
+				mcpgo.Properties(map[string]any{
+					"correctness": map[string]any{"type": "number", "minimum": 0, "maximum": session.RubricMaxScore},
+					"tests":       map[string]any{"type": "number", "minimum": 0, "maximum": session.RubricMaxScore},
+					"style":       map[string]any{"type": "number", "minimum": 0, "maximum": session.RubricMaxScore},
+					"security":    map[string]any{"type": "number", "minimum": 0, "maximum": session.RubricMaxScore},
+				}),
+			),
 		),
 		h.submitReviewVerdict,
 	)
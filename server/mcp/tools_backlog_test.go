@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/session"
 )
 
@@ -339,3 +340,76 @@ func TestReportProgress_MapsStatusValues(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "done", criteria[0].Status, "pass should be mapped to done")
 }
+
+// TestSubmitReviewVerdict_PanelDecidesOnLastReviewer verifies that panel
+// members record their verdicts without gating the item, and that the last
+// submission saves the consensus verdict and completes the item on PASS.
+func TestSubmitReviewVerdict_PanelDecidesOnLastReviewer(t *testing.T) {
+	storage := newTestBacklogStorage(t)
+	ctx := context.Background()
+
+	item, err := storage.CreateBacklogItem(ctx, session.BacklogItemData{
+		Title:              "Panel review",
+		AcceptanceCriteria: `[{"index":0,"text":"Criterion","status":"done"}]`,
+		Priority:           1,
+		Status:             string(session.BacklogStatusReview),
+	})
+	require.NoError(t, err)
+
+	panels, err := session.NewReviewPanelStore("")
+	require.NoError(t, err)
+	run := session.NewReviewPanelRun(item.ID, "", "hash", config.ReviewPanelConfig{
+		Reviewers: []config.ReviewerConfig{{Name: "a"}, {Name: "b"}},
+	})
+	require.NoError(t, panels.Create(run))
+
+	reviewers := []string{uuid.New().String(), uuid.New().String()}
+	for i, sessionUUID := range reviewers {
+		require.NoError(t, panels.BindReviewer(run.ID, i, sessionUUID))
+		_, err := storage.CreateItemSession(ctx, session.ItemSessionData{
+			ItemID:      item.ID,
+			SessionUUID: sessionUUID,
+			SessionRole: session.SessionRoleReview,
+		})
+		require.NoError(t, err)
+	}
+
+	handler := &backlogHandlers{storage: storage, panels: panels}
+	submit := func(sessionUUID string, rubric map[string]interface{}) *mcpgo.CallToolResult {
+		t.Helper()
+		result, err := handler.submitReviewVerdict(WithSessionUUID(ctx, sessionUUID), makeToolReq(map[string]interface{}{
+			"item_id": item.ID,
+			"summary": "criterion met",
+			"verdicts": []interface{}{
+				map[string]interface{}{"criterion_index": float64(0), "outcome": "PASS", "evidence": "handler added"},
+			},
+			"rubric": rubric,
+		}))
+		require.NoError(t, err)
+		return result
+	}
+
+	bad := submit(reviewers[0], map[string]interface{}{"correctness": float64(11)})
+	require.True(t, bad.IsError || !parseResult(t, bad)["success"].(bool), "out-of-range rubric scores are rejected")
+
+	submit(reviewers[0], map[string]interface{}{"correctness": float64(9), "tests": float64(8), "style": float64(8), "security": float64(10)})
+	outcome, err := storage.GetMostRecentReviewVerdictForItem(ctx, item.ID)
+	require.NoError(t, err)
+	require.Empty(t, outcome, "a single panel member does not gate the item")
+	got, err := storage.GetBacklogItem(ctx, item.ID)
+	require.NoError(t, err)
+	require.Equal(t, string(session.BacklogStatusReview), got.Status)
+
+	submit(reviewers[1], map[string]interface{}{"correctness": float64(7), "tests": float64(6), "style": float64(8), "security": float64(10)})
+	outcome, err = storage.GetMostRecentReviewVerdictForItem(ctx, item.ID)
+	require.NoError(t, err)
+	require.Equal(t, session.ReviewVerdictPass, outcome)
+	got, err = storage.GetBacklogItem(ctx, item.ID)
+	require.NoError(t, err)
+	require.Equal(t, string(session.BacklogStatusDone), got.Status)
+
+	runs := panels.List(item.ID, "", true, 0)
+	require.Len(t, runs, 1)
+	require.NotNil(t, runs[0].Rubric)
+	require.InDelta(t, 8.0, runs[0].Rubric.Correctness, 0.001)
+}
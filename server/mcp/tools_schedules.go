@@ -0,0 +1,303 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/tstapler/stapler-squad/server/services"
+	"github.com/tstapler/stapler-squad/session/schedule"
+)
+
+// ErrScheduleNotFound is returned for unknown schedule IDs and names.
+const ErrScheduleNotFound = "SCHEDULE_NOT_FOUND"
+
+// scheduleBackend stores schedules and triggers runs. Satisfied by
+// *services.SessionService.
+type scheduleBackend interface {
+	Schedules() *schedule.Store
+	ScheduleChanged()
+	TriggerScheduleNow(ctx context.Context, idOrName string) (schedule.Run, error)
+}
+
+type scheduleHandlers struct {
+	backend scheduleBackend
+}
+
+// ScheduleSummary is a schedule as returned by the schedule tools.
+type ScheduleSummary struct {
+	schedule.Schedule
+	NextRunAt string        `json:"next_run_at,omitempty"`
+	LastRun   *schedule.Run `json:"last_run,omitempty"`
+}
+
+// ListSchedulesResult is the response for list_schedules.
+type ListSchedulesResult struct {
+	MCPResult
+	Schedules []ScheduleSummary `json:"schedules"`
+}
+
+// ScheduleResult is the response for create_schedule and update_schedule.
+type ScheduleResult struct {
+	MCPResult
+	Schedule ScheduleSummary `json:"schedule"`
+}
+
+// ScheduleRunsResult is the response for list_schedule_runs.
+type ScheduleRunsResult struct {
+	MCPResult
+	Runs []schedule.Run `json:"runs"`
+}
+
+// ScheduleRunResult is the response for trigger_schedule.
+type ScheduleRunResult struct {
+	MCPResult
+	Run schedule.Run `json:"run"`
+}
+
+// scheduleFields are the settable schedule arguments shared by
+// create_schedule and update_schedule.
+func scheduleFields() []mcpgo.ToolOption {
+	return []mcpgo.ToolOption{
+		mcpgo.WithString("cron", mcpgo.Description("Five-field cron expression (minute hour day-of-month month day-of-week) or a descriptor like @daily, @weekly")),
+		mcpgo.WithString("timezone", mcpgo.Description("IANA timezone the cron expression is evaluated in, e.g. America/New_York (default: server local time)")),
+		mcpgo.WithBoolean("enabled", mcpgo.Description("Whether the schedule fires (default true on create)")),
+		mcpgo.WithString("kind",
+			mcpgo.Description("session: create a new session per firing from profile, prompt and repo_path. one_shot: run the prompt non-interactively in target_session"),
+			mcpgo.Enum(string(schedule.KindSession), string(schedule.KindOneShot)),
+		),
+		mcpgo.WithString("prompt", mcpgo.Description("Prompt sent to the new session, or run by the one-shot")),
+		mcpgo.WithString("profile", mcpgo.Description("Session defaults profile for session schedules")),
+		mcpgo.WithString("repo_path", mcpgo.Description("Repository path or GitHub URL for session schedules")),
+		mcpgo.WithString("branch_prefix", mcpgo.Description("Give each run its own worktree on this prefix plus the firing time, e.g. chore/deps-")),
+		mcpgo.WithString("program", mcpgo.Description("Program override for session schedules")),
+		mcpgo.WithString("category", mcpgo.Description("Category for spawned sessions")),
+		mcpgo.WithString("target_session", mcpgo.Description("Session ID (title) a one-shot schedule runs in")),
+		mcpgo.WithNumber("timeout_seconds", mcpgo.Description("One-shot timeout (default 120, max 300)"), mcpgo.Min(0), mcpgo.Max(300)),
+		mcpgo.WithString("overlap",
+			mcpgo.Description("When the previous run is still going: skip the firing (default), queue it, or replace the previous run"),
+			mcpgo.Enum(string(schedule.OverlapSkip), string(schedule.OverlapQueue), string(schedule.OverlapReplace)),
+		),
+		mcpgo.WithString("catch_up",
+			mcpgo.Description("Firings missed while the server was down: none, latest (default, run once) or all (up to 10)"),
+			mcpgo.Enum(string(schedule.CatchUpNone), string(schedule.CatchUpLatest), string(schedule.CatchUpAll)),
+		),
+	}
+}
+
+func registerScheduleTools(s *mcpserver.MCPServer, h *scheduleHandlers) {
+	s.AddTool(
+		mcpgo.NewTool("list_schedules",
+			mcpgo.WithDescription("List recurring schedules with their next firing and latest run."),
+		),
+		h.listSchedules,
+	)
+
+	createOpts := append([]mcpgo.ToolOption{
+		mcpgo.WithDescription("Create a recurring schedule that, on a cron expression, spawns a session from a profile, prompt and repository or runs a one-shot prompt in an existing session. Use for chores like nightly dependency updates or a morning PR summary."),
+		mcpgo.WithString("name", mcpgo.Description("Unique schedule name; spawned sessions are titled name-YYYYMMDD-HHMM"), mcpgo.Required()),
+	}, scheduleFields()...)
+	s.AddTool(mcpgo.NewTool("create_schedule", createOpts...), h.createSchedule)
+
+	updateOpts := append([]mcpgo.ToolOption{
+		mcpgo.WithDescription("Update a schedule. Only the arguments given are changed. Changing cron or timezone, or re-enabling, does not replay past firings."),
+		mcpgo.WithString("schedule_id", mcpgo.Description("Schedule ID or name"), mcpgo.Required()),
+		mcpgo.WithString("name", mcpgo.Description("New name")),
+	}, scheduleFields()...)
+	s.AddTool(mcpgo.NewTool("update_schedule", updateOpts...), h.updateSchedule)
+
+	s.AddTool(
+		mcpgo.NewTool("delete_schedule",
+			mcpgo.WithDescription("Delete a schedule and its run history. Sessions it spawned are kept. Requires confirm=true."),
+			mcpgo.WithString("schedule_id", mcpgo.Description("Schedule ID or name"), mcpgo.Required()),
+			mcpgo.WithBoolean("confirm", mcpgo.Description("Must be true to confirm deletion"), mcpgo.Required()),
+		),
+		h.deleteSchedule,
+	)
+
+	s.AddTool(
+		mcpgo.NewTool("trigger_schedule",
+			mcpgo.WithDescription("Run a schedule now, even if disabled, applying its overlap policy. Only available when the Stapler Squad server is running."),
+			mcpgo.WithString("schedule_id", mcpgo.Description("Schedule ID or name"), mcpgo.Required()),
+		),
+		h.triggerSchedule,
+	)
+
+	s.AddTool(
+		mcpgo.NewTool("list_schedule_runs",
+			mcpgo.WithDescription("List a schedule's run history, newest first, with the sessions each run spawned, one-shot exit codes, PR URLs and output tails."),
+			mcpgo.WithString("schedule_id", mcpgo.Description("Schedule ID or name (default: all schedules)")),
+			mcpgo.WithNumber("limit",
+				mcpgo.Description("Max runs returned (default 20, max 100)"),
+				mcpgo.DefaultNumber(20),
+				mcpgo.Min(1),
+				mcpgo.Max(100),
+			),
+		),
+		h.listScheduleRuns,
+	)
+}
+
+func (h *scheduleHandlers) summarize(sc schedule.Schedule) ScheduleSummary {
+	out := ScheduleSummary{Schedule: sc}
+	if sc.Enabled {
+		if next, err := sc.Next(time.Now()); err == nil {
+			out.NextRunAt = next.UTC().Format(time.RFC3339)
+		}
+	}
+	if runs, err := h.backend.Schedules().Runs(sc.ID, 1); err == nil && len(runs) == 1 {
+		out.LastRun = &runs[0]
+	}
+	return out
+}
+
+// scheduleErrResult converts a schedule store or trigger error.
+func scheduleErrResult(id string, err error) *mcpgo.CallToolResult {
+	switch {
+	case errors.Is(err, schedule.ErrNotFound):
+		return errResult(ErrScheduleNotFound, fmt.Sprintf("schedule %q not found", id),
+			"Use list_schedules to find valid schedule IDs and names.")
+	case errors.Is(err, schedule.ErrInvalid):
+		return errResult(ErrInvalidArgument, err.Error(), "")
+	case errors.Is(err, services.ErrSchedulerNotRunning):
+		return errResult(ErrInternalError, err.Error(),
+			"Start the Stapler Squad server; it runs schedules and manual triggers.")
+	default:
+		return errResult(ErrInternalError, err.Error(), "")
+	}
+}
+
+// applyScheduleArgs copies the schedule arguments present in args onto sc.
+func applyScheduleArgs(sc *schedule.Schedule, args map[string]interface{}) {
+	for key, dst := range map[string]*string{
+		"name":           &sc.Name,
+		"cron":           &sc.Cron,
+		"timezone":       &sc.Timezone,
+		"prompt":         &sc.Prompt,
+		"profile":        &sc.Profile,
+		"repo_path":      &sc.RepoPath,
+		"branch_prefix":  &sc.BranchPrefix,
+		"program":        &sc.Program,
+		"category":       &sc.Category,
+		"target_session": &sc.TargetSession,
+	} {
+		if v, ok := args[key].(string); ok {
+			*dst = v
+		}
+	}
+	if v, ok := args["kind"].(string); ok {
+		sc.Kind = schedule.Kind(v)
+	}
+	if v, ok := args["overlap"].(string); ok {
+		sc.Overlap = schedule.OverlapPolicy(v)
+	}
+	if v, ok := args["catch_up"].(string); ok {
+		sc.CatchUp = schedule.CatchUpPolicy(v)
+	}
+	if v, ok := args["enabled"].(bool); ok {
+		sc.Enabled = v
+	}
+	if v, ok := args["timeout_seconds"].(float64); ok {
+		sc.TimeoutSeconds = int(v)
+	}
+}
+
+func (h *scheduleHandlers) listSchedules(_ context.Context, _ mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	schedules, err := h.backend.Schedules().List()
+	if err != nil {
+		return scheduleErrResult("", err), nil
+	}
+	out := make([]ScheduleSummary, 0, len(schedules))
+	for _, sc := range schedules {
+		out = append(out, h.summarize(sc))
+	}
+	return okResult(ListSchedulesResult{MCPResult: MCPResult{Success: true}, Schedules: out}), nil
+}
+
+func (h *scheduleHandlers) createSchedule(_ context.Context, req mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	sc := schedule.Schedule{Enabled: true}
+	applyScheduleArgs(&sc, req.GetArguments())
+	created, err := h.backend.Schedules().Create(sc)
+	if err != nil {
+		return scheduleErrResult(sc.Name, err), nil
+	}
+	h.backend.ScheduleChanged()
+	return okResult(ScheduleResult{MCPResult: MCPResult{Success: true}, Schedule: h.summarize(created)}), nil
+}
+
+func (h *scheduleHandlers) updateSchedule(_ context.Context, req mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	args := req.GetArguments()
+	id, _ := args["schedule_id"].(string)
+	if id == "" {
+		return errResult(ErrInvalidArgument, "schedule_id is required", ""), nil
+	}
+	updated, err := h.backend.Schedules().Update(id, func(sc *schedule.Schedule) error {
+		applyScheduleArgs(sc, args)
+		return nil
+	})
+	if err != nil {
+		return scheduleErrResult(id, err), nil
+	}
+	h.backend.ScheduleChanged()
+	return okResult(ScheduleResult{MCPResult: MCPResult{Success: true}, Schedule: h.summarize(updated)}), nil
+}
+
+func (h *scheduleHandlers) deleteSchedule(_ context.Context, req mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	args := req.GetArguments()
+	id, _ := args["schedule_id"].(string)
+	if id == "" {
+		return errResult(ErrInvalidArgument, "schedule_id is required", ""), nil
+	}
+	if confirm, _ := args["confirm"].(bool); !confirm {
+		return errResult(ErrConfirmationRequired,
+			"Deleting a schedule also deletes its run history. Pass confirm=true to proceed.",
+			"Call delete_schedule again with confirm=true to confirm."), nil
+	}
+	if err := h.backend.Schedules().Delete(id); err != nil {
+		return scheduleErrResult(id, err), nil
+	}
+	h.backend.ScheduleChanged()
+	return okResult(MCPResult{Success: true}), nil
+}
+
+func (h *scheduleHandlers) triggerSchedule(ctx context.Context, req mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	id, _ := req.GetArguments()["schedule_id"].(string)
+	if id == "" {
+		return errResult(ErrInvalidArgument, "schedule_id is required", ""), nil
+	}
+	run, err := h.backend.TriggerScheduleNow(ctx, id)
+	if err != nil {
+		return scheduleErrResult(id, err), nil
+	}
+	return okResult(ScheduleRunResult{MCPResult: MCPResult{Success: true}, Run: run}), nil
+}
+
+func (h *scheduleHandlers) listScheduleRuns(_ context.Context, req mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	args := req.GetArguments()
+	limit := 20
+	if l, ok := args["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	scheduleID := ""
+	if id, _ := args["schedule_id"].(string); id != "" {
+		sc, err := h.backend.Schedules().Get(id)
+		if err != nil {
+			return scheduleErrResult(id, err), nil
+		}
+		scheduleID = sc.ID
+	}
+	runs, err := h.backend.Schedules().Runs(scheduleID, limit)
+	if err != nil {
+		return scheduleErrResult(scheduleID, err), nil
+	}
+	if runs == nil {
+		runs = []schedule.Run{}
+	}
+	return okResult(ScheduleRunsResult{MCPResult: MCPResult{Success: true}, Runs: runs}), nil
+}
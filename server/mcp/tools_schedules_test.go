@@ -0,0 +1,95 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tstapler/stapler-squad/server/services"
+	"github.com/tstapler/stapler-squad/session/schedule"
+)
+
+// stubSchedules is a schedule backend without a running scheduler, like the
+// stdio MCP server.
+type stubSchedules struct {
+	store   *schedule.Store
+	changed int
+}
+
+func (s *stubSchedules) Schedules() *schedule.Store { return s.store }
+func (s *stubSchedules) ScheduleChanged()           { s.changed++ }
+func (s *stubSchedules) TriggerScheduleNow(context.Context, string) (schedule.Run, error) {
+	return schedule.Run{}, services.ErrSchedulerNotRunning
+}
+
+func TestScheduleTools(t *testing.T) {
+	backend := &stubSchedules{store: schedule.NewStore("")}
+	h := &scheduleHandlers{backend: backend}
+	ctx := context.Background()
+
+	res, _ := h.createSchedule(ctx, makeToolReq(map[string]interface{}{
+		"name":      "deps",
+		"cron":      "0 3 * * *",
+		"timezone":  "UTC",
+		"prompt":    "update dependencies and open a PR",
+		"repo_path": "/repo",
+		"overlap":   "replace",
+	}))
+	m := parseResult(t, res)
+	if m["success"] != true {
+		t.Fatalf("expected success, got %v", m)
+	}
+	sc := m["schedule"].(map[string]interface{})
+	if sc["enabled"] != true || sc["overlap"] != "replace" || sc["catch_up"] != "latest" {
+		t.Errorf("unexpected schedule: %v", sc)
+	}
+	if sc["next_run_at"] == nil {
+		t.Errorf("enabled schedule should have next_run_at, got %v", sc)
+	}
+	if backend.changed != 1 {
+		t.Errorf("expected the scheduler to be woken, got %d", backend.changed)
+	}
+
+	res, _ = h.createSchedule(ctx, makeToolReq(map[string]interface{}{
+		"name": "bad", "cron": "61 * * * *", "prompt": "p", "repo_path": "/repo",
+	}))
+	if errField, _ := parseResult(t, res)["error"].(map[string]interface{}); errField["code"] != ErrInvalidArgument {
+		t.Errorf("expected INVALID_ARGUMENT for a bad cron, got %v", errField)
+	}
+
+	res, _ = h.updateSchedule(ctx, makeToolReq(map[string]interface{}{
+		"schedule_id": "deps",
+		"enabled":     false,
+	}))
+	sc = parseResult(t, res)["schedule"].(map[string]interface{})
+	if sc["enabled"] != false || sc["prompt"] != "update dependencies and open a PR" {
+		t.Errorf("update should only change enabled, got %v", sc)
+	}
+
+	res, _ = h.listSchedules(ctx, makeToolReq(nil))
+	if schedules, _ := parseResult(t, res)["schedules"].([]interface{}); len(schedules) != 1 {
+		t.Errorf("expected 1 schedule, got %v", schedules)
+	}
+
+	res, _ = h.listScheduleRuns(ctx, makeToolReq(map[string]interface{}{"schedule_id": "deps"}))
+	if runs, ok := parseResult(t, res)["runs"].([]interface{}); !ok || len(runs) != 0 {
+		t.Errorf("expected an empty run list, got %v", runs)
+	}
+
+	res, _ = h.triggerSchedule(ctx, makeToolReq(map[string]interface{}{"schedule_id": "deps"}))
+	if parseResult(t, res)["success"] != false {
+		t.Errorf("trigger without a scheduler should fail")
+	}
+
+	res, _ = h.deleteSchedule(ctx, makeToolReq(map[string]interface{}{"schedule_id": "deps"}))
+	if errField, _ := parseResult(t, res)["error"].(map[string]interface{}); errField["code"] != ErrConfirmationRequired {
+		t.Errorf("expected CONFIRMATION_REQUIRED, got %v", errField)
+	}
+	res, _ = h.deleteSchedule(ctx, makeToolReq(map[string]interface{}{"schedule_id": "deps", "confirm": true}))
+	if parseResult(t, res)["success"] != true {
+		t.Errorf("expected delete to succeed")
+	}
+	res, _ = h.updateSchedule(ctx, makeToolReq(map[string]interface{}{"schedule_id": "deps"}))
+	if errField, _ := parseResult(t, res)["error"].(map[string]interface{}); errField["code"] != ErrScheduleNotFound {
+		t.Errorf("expected SCHEDULE_NOT_FOUND, got %v", errField)
+	}
+}
@@ -17,6 +17,13 @@ const (
 	maxOutputBytes = 10 * 1024 // 10 KB
 	maxInputBytes  = 4096
 
+	// Recorded output is replayed through a terminal emulator so redraws,
+	// cursor movement and clears read as the screen showed them. The
+	// emulator takes the pane's size; these are the fallback.
+	replayWindowBytes = 256 * 1024
+	replayRows        = 50
+	replayCols        = 200
+
 	writeRateLimitPerSec = 1.0
 )
 
@@ -24,7 +31,14 @@ const (
 type terminalHandlers struct {
 	store      session.InstanceStore
 	scrollback *scrollback.ScrollbackManager
-	writeLim   *tokenBucket // per-session rate limiter for write_to_session
+	writeLim   *tokenBucket    // per-session rate limiter for write_to_session
+	input      inputAuthorizer // nil: writes are not arbitrated
+}
+
+// inputAuthorizer admits a write to a session's terminal through the input
+// arbitration of the clients streaming it.
+type inputAuthorizer interface {
+	AuthorizeOneShotInput(title, label string) (done func(), err error)
 }
 
 // ReadSessionOutputResult is the response type for read_session_output.
@@ -164,31 +178,15 @@ func (th *terminalHandlers) readSessionOutput(_ context.Context, req mcpgo.CallT
 	}
 
 	// Verify session exists.
-	instances, err := th.store.LoadInstances()
-	if err != nil {
-		return errResult(ErrInternalError, "failed to load sessions", ""), nil
-	}
-	found := false
-	for _, inst := range instances {
-		if inst.MatchesID(sessionID) {
-			found = true
-			break
-		}
-	}
-	if !found {
-		return errResult(ErrSessionNotFound, fmt.Sprintf("session %q not found", sessionID), "Use list_sessions to find available sessions"), nil
+	inst, errResult_ := th.findInstance(sessionID)
+	if errResult_ != nil {
+		return errResult_, nil
 	}
 
-	raw, err := th.scrollback.GetRecentBytes(sessionID, maxOutputBytes)
+	allLines, err := th.screenLines(inst, sessionID, !stripANSI)
 	if err != nil {
 		return errResult(ErrInternalError, fmt.Sprintf("failed to read scrollback: %v", err), ""), nil
 	}
-
-	if stripANSI {
-		raw = stripANSI_(raw)
-	}
-
-	allLines := splitLines(raw)
 	totalLines := len(allLines)
 	truncated := false
 
@@ -197,7 +195,7 @@ func (th *terminalHandlers) readSessionOutput(_ context.Context, req mcpgo.CallT
 		truncated = true
 	}
 
-	output := strings.Join(toStringSlice(allLines), "\n")
+	output := strings.Join(allLines, "\n")
 	if truncated {
 		output = fmt.Sprintf("[... %d lines omitted. Call read_session_output with lines=200 to see earlier output ...]\n", totalLines-lines) + output
 	}
@@ -252,6 +250,12 @@ func (th *terminalHandlers) writeToSession(ctx context.Context, req mcpgo.CallTo
 		text += "\n"
 	}
 
+	done, denied := th.authorizeWrite(inst)
+	if denied != nil {
+		return denied, nil
+	}
+	defer done()
+
 	// Wrap SendKeys in a goroutine with a 5-second timeout to prevent PTY write deadlock.
 	// Use the request context so caller cancellation propagates; add a hard 5s cap.
 	errCh := make(chan error, 1)
@@ -319,6 +323,12 @@ func (th *terminalHandlers) sendControl(_ context.Context, req mcpgo.CallToolReq
 		return errResult_, nil
 	}
 
+	done, denied := th.authorizeWrite(inst)
+	if denied != nil {
+		return denied, nil
+	}
+	defer done()
+
 	errCh := make(chan error, 1)
 	go func() { errCh <- inst.SendKeys(char) }()
 
@@ -372,19 +382,9 @@ func (th *terminalHandlers) waitForOutput(_ context.Context, req mcpgo.CallToolR
 	}
 
 	// Verify session exists.
-	instances, err := th.store.LoadInstances()
-	if err != nil {
-		return errResult(ErrInternalError, "failed to load sessions", ""), nil
-	}
-	found := false
-	for _, inst := range instances {
-		if inst.MatchesID(sessionID) {
-			found = true
-			break
-		}
-	}
-	if !found {
-		return errResult(ErrSessionNotFound, fmt.Sprintf("session %q not found", sessionID), "Use list_sessions to find available sessions"), nil
+	inst, errResult_ := th.findInstance(sessionID)
+	if errResult_ != nil {
+		return errResult_, nil
 	}
 
 	deadline := time.Now().Add(time.Duration(timeoutSecs) * time.Second)
@@ -394,11 +394,8 @@ func (th *terminalHandlers) waitForOutput(_ context.Context, req mcpgo.CallToolR
 	patternLower := strings.ToLower(pattern)
 
 	for {
-		raw, readErr := th.scrollback.GetRecentBytes(sessionID, maxOutputBytes)
+		allLines, readErr := th.screenLines(inst, sessionID, false)
 		if readErr == nil {
-			stripped := stripANSI_(raw)
-			allLines := splitLines(stripped)
-
 			displayLines := allLines
 			truncated := false
 			if len(allLines) > 50 {
@@ -409,16 +406,16 @@ func (th *terminalHandlers) waitForOutput(_ context.Context, req mcpgo.CallToolR
 			// Search for pattern (case-insensitive substring match).
 			var matchedLine string
 			matched := false
-			for _, lineBytes := range allLines {
-				if strings.Contains(strings.ToLower(string(lineBytes)), patternLower) {
-					matchedLine = string(lineBytes)
+			for _, line := range allLines {
+				if strings.Contains(strings.ToLower(line), patternLower) {
+					matchedLine = line
 					matched = true
 					break
 				}
 			}
 
 			if matched {
-				output := strings.Join(toStringSlice(displayLines), "\n")
+				output := strings.Join(displayLines, "\n")
 				if truncated {
 					output = "[... earlier output omitted ...]\n" + output
 				}
@@ -434,16 +431,14 @@ func (th *terminalHandlers) waitForOutput(_ context.Context, req mcpgo.CallToolR
 
 		if time.Now().After(deadline) {
 			// Return timeout result with last-seen output.
-			raw, _ := th.scrollback.GetRecentBytes(sessionID, maxOutputBytes)
-			stripped := stripANSI_(raw)
-			allLines := splitLines(stripped)
+			allLines, _ := th.screenLines(inst, sessionID, false)
 			displayLines := allLines
 			truncated := false
 			if len(allLines) > 50 {
 				displayLines = allLines[len(allLines)-50:]
 				truncated = true
 			}
-			output := strings.Join(toStringSlice(displayLines), "\n")
+			output := strings.Join(displayLines, "\n")
 			return okResult(WaitForOutputResult{
 				MCPResult: MCPResult{Success: true, Error: &MCPError{
 					Code:    "WAIT_TIMEOUT",
@@ -506,7 +501,12 @@ func (th *terminalHandlers) runCommand(ctx context.Context, req mcpgo.CallToolRe
 		return errResult_, nil
 	}
 
-	// Send the command.
+	// Send the command. Control is held only for the write, not while
+	// waiting for output.
+	done, denied := th.authorizeWrite(inst)
+	if denied != nil {
+		return denied, nil
+	}
 	sendErrCh := make(chan error, 1)
 	go func() { sendErrCh <- inst.SendKeys(command + "\n") }()
 
@@ -515,10 +515,12 @@ func (th *terminalHandlers) runCommand(ctx context.Context, req mcpgo.CallToolRe
 
 	select {
 	case err := <-sendErrCh:
+		done()
 		if err != nil {
 			return errResult(ErrInternalError, fmt.Sprintf("send command failed: %v", err), "Check that the session is running and not paused"), nil
 		}
 	case <-sendCtx.Done():
+		done()
 		return errResult("PTY_WRITE_TIMEOUT", "timed out writing command to session PTY", ""), nil
 	}
 
@@ -554,9 +556,7 @@ func (th *terminalHandlers) runCommand(ctx context.Context, req mcpgo.CallToolRe
 	}
 
 	// Read final output.
-	raw, _ := th.scrollback.GetRecentBytes(sessionID, maxOutputBytes)
-	stripped := stripANSI_(raw)
-	allLines := splitLines(stripped)
+	allLines, _ := th.screenLines(inst, sessionID, false)
 	totalLines := len(allLines)
 	truncated := false
 
@@ -571,7 +571,7 @@ func (th *terminalHandlers) runCommand(ctx context.Context, req mcpgo.CallToolRe
 		lastSeq = entries[len(entries)-1].Sequence
 	}
 
-	output := strings.Join(toStringSlice(allLines), "\n")
+	output := strings.Join(allLines, "\n")
 	if truncated {
 		output = fmt.Sprintf("[... %d lines omitted ...]\n", totalLines-lines) + output
 	}
@@ -602,17 +602,45 @@ func (th *terminalHandlers) findInstance(sessionID string) (*session.Instance, *
 	return nil, errResult(ErrSessionNotFound, fmt.Sprintf("session %q not found", sessionID), "Use list_sessions to find available sessions")
 }
 
-// stripANSI_ is an alias so we can call stripANSI without shadowing the function
-// name inside the package (strip is defined in ansi.go).
-func stripANSI_(b []byte) []byte { return stripANSI(b) }
+// authorizeWrite admits one write to inst's terminal, refusing it while
+// another client has control. Call done once the write has finished.
+func (th *terminalHandlers) authorizeWrite(inst *session.Instance) (done func(), denied *mcpgo.CallToolResult) {
+	if th.input == nil {
+		return func() {}, nil
+	}
+	done, err := th.input.AuthorizeOneShotInput(inst.Title, "MCP")
+	if err != nil {
+		return nil, errResult(ErrInputDenied, fmt.Sprintf("cannot write to session %q: %v", inst.Title, err), "A client watching the session has control of its input; retry once it is handed over or the input mode is shared")
+	}
+	return done, nil
+}
 
-// toStringSlice converts a slice of byte slices to a slice of strings.
-func toStringSlice(lines [][]byte) []string {
-	out := make([]string, len(lines))
-	for i, l := range lines {
-		out[i] = string(l)
+// screenLines replays the session's recent recorded output through a
+// terminal emulator the size of inst's pane and returns the resulting history
+// and screen lines, without trailing blank lines. styled keeps SGR styling.
+func (th *terminalHandlers) screenLines(inst *session.Instance, sessionID string, styled bool) ([]string, error) {
+	raw, err := th.scrollback.GetRecentBytes(sessionID, replayWindowBytes)
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := replaySize(inst)
+	lines := session.ReplayTerminalOutput(raw, rows, cols, 0, styled)
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines, nil
+}
+
+// replaySize returns the rows and columns of inst's pane, which cursor
+// addressing in its output assumes, or replayRows x replayCols when the pane
+// size cannot be read.
+func replaySize(inst *session.Instance) (rows, cols int) {
+	if inst != nil && inst.Started() {
+		if w, h, err := inst.GetPaneDimensions(); err == nil && w > 0 && h > 0 {
+			return h, w
+		}
 	}
-	return out
+	return replayRows, replayCols
 }
 
 // bytesChecksum returns a simple FNV-like checksum used for output-stability detection.
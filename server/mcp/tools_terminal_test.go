@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
 	"github.com/tstapler/stapler-squad/session"
 )
 
@@ -110,6 +111,75 @@ func TestReadOutputSessionNotFound(t *testing.T) {
 	}
 }
 
+// TestReadOutputReplaysRepaints verifies that read_session_output returns
+// the screen as drawn, not the raw stream: carriage-return progress updates
+// and a cleared screen leave only their final state.
+func TestReadOutputReplaysRepaints(t *testing.T) {
+	mgr := makeScrollbackMgr(t)
+	sessionID := "repaint"
+	raw := "Building 10%\rBuilding 55%\rBuilding 100%\r\n" +
+		"\x1b[2J\x1b[Hstale frame\x1b[H\x1b[2Jfresh frame\r\n"
+	if err := mgr.AppendOutput(sessionID, []byte(raw)); err != nil {
+		t.Fatalf("AppendOutput: %v", err)
+	}
+
+	th := &terminalHandlers{
+		store:      &stubStore{instances: []*session.Instance{{Title: sessionID}}},
+		scrollback: mgr,
+		writeLim:   newTokenBucket(10, 10),
+	}
+	result, err := th.readSessionOutput(context.Background(), makeToolReq(map[string]interface{}{
+		"session_id": sessionID,
+	}))
+	if err != nil {
+		t.Fatalf("readSessionOutput returned unexpected Go error: %v", err)
+	}
+	m := parseResult(t, result)
+	output, _ := m["output"].(string)
+	if !strings.Contains(output, "fresh frame") {
+		t.Errorf("expected the final frame in output, got %q", output)
+	}
+	for _, gone := range []string{"Building 10%", "Building 55%", "stale frame"} {
+		if strings.Contains(output, gone) {
+			t.Errorf("output still contains repainted text %q: %q", gone, output)
+		}
+	}
+}
+
+// TestWaitForOutputMatchesRepaintedLine verifies that wait_for_output
+// matches against the repainted screen and reports the line as shown.
+func TestWaitForOutputMatchesRepaintedLine(t *testing.T) {
+	mgr := makeScrollbackMgr(t)
+	sessionID := "repaint"
+	if err := mgr.AppendOutput(sessionID, []byte("Status: PENDING\rStatus: DONE   \r\n")); err != nil {
+		t.Fatalf("AppendOutput: %v", err)
+	}
+
+	th := &terminalHandlers{
+		store:      &stubStore{instances: []*session.Instance{{Title: sessionID}}},
+		scrollback: mgr,
+		writeLim:   newTokenBucket(10, 10),
+	}
+	result, err := th.waitForOutput(context.Background(), makeToolReq(map[string]interface{}{
+		"session_id":      sessionID,
+		"pattern":         "done",
+		"timeout_seconds": float64(2),
+	}))
+	if err != nil {
+		t.Fatalf("waitForOutput returned unexpected Go error: %v", err)
+	}
+	m := parseResult(t, result)
+	if matched, _ := m["matched"].(bool); !matched {
+		t.Fatalf("expected matched=true, got %v", m)
+	}
+	if line, _ := m["matched_line"].(string); strings.TrimSpace(line) != "Status: DONE" {
+		t.Errorf("matched_line = %q, want the repainted line", line)
+	}
+	if output, _ := m["output"].(string); strings.Contains(output, "PENDING") {
+		t.Errorf("output still contains overwritten text: %q", output)
+	}
+}
+
 // TestWriteInputLengthCap verifies that writeToSession rejects inputs longer
 // than maxInputBytes with an INPUT_TOO_LONG error.  (U-4.9)
 func TestWriteInputLengthCap(t *testing.T) {
@@ -246,3 +316,46 @@ func TestWaitForOutputTimeout(t *testing.T) {
 		t.Error("expected non-empty output on timeout")
 	}
 }
+
+// denyInput refuses every write, as when another client has control.
+type denyInput struct{ asked []string }
+
+func (d *denyInput) AuthorizeOneShotInput(title, label string) (func(), error) {
+	d.asked = append(d.asked, title+"/"+label)
+	return nil, fmt.Errorf("another client has control of the terminal")
+}
+
+// TestWritesRespectInputArbitration verifies that every tool typing into a
+// session asks the input arbitration first and stops when it is refused.
+func TestWritesRespectInputArbitration(t *testing.T) {
+	input := &denyInput{}
+	th := &terminalHandlers{
+		store:      &stubStore{instances: []*session.Instance{{Title: "shared"}}},
+		scrollback: makeScrollbackMgr(t),
+		writeLim:   newTokenBucket(10, 10),
+		input:      input,
+	}
+	calls := []struct {
+		name string
+		call func(context.Context, mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error)
+		args map[string]interface{}
+	}{
+		{"write_to_session", th.writeToSession, map[string]interface{}{"session_id": "shared", "input": "ls"}},
+		{"send_control", th.sendControl, map[string]interface{}{"session_id": "shared", "key": "C"}},
+		{"run_command", th.runCommand, map[string]interface{}{"session_id": "shared", "command": "ls"}},
+	}
+	for _, c := range calls {
+		result, err := c.call(context.Background(), makeToolReq(c.args))
+		if err != nil {
+			t.Fatalf("%s returned unexpected Go error: %v", c.name, err)
+		}
+		m := parseResult(t, result)
+		errObj, _ := m["error"].(map[string]interface{})
+		if code, _ := errObj["code"].(string); code != ErrInputDenied {
+			t.Errorf("%s: expected error code %q, got %v", c.name, ErrInputDenied, m)
+		}
+	}
+	if len(input.asked) != len(calls) || input.asked[0] != "shared/MCP" {
+		t.Errorf("authorizer asked %v, want one shared/MCP per tool", input.asked)
+	}
+}
@@ -0,0 +1,139 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/tstapler/stapler-squad/server/services"
+	"github.com/tstapler/stapler-squad/session/digest"
+)
+
+// timelineSource yields a session's conversation digest. Satisfied by
+// *services.SessionService.
+type timelineSource interface {
+	SessionTimeline(id string) (*digest.Timeline, error)
+}
+
+type timelineHandlers struct {
+	timelines timelineSource
+}
+
+// TurnSummary is one conversation turn in get_session_timeline output.
+type TurnSummary struct {
+	Index        int            `json:"index"`
+	StartedAt    string         `json:"started_at,omitempty"`
+	EndedAt      string         `json:"ended_at,omitempty"`
+	Prompt       string         `json:"prompt,omitempty"`
+	Summary      string         `json:"summary"`
+	Outcome      string         `json:"outcome,omitempty"`
+	Tools        map[string]int `json:"tools,omitempty"`
+	FilesTouched []string       `json:"files_touched,omitempty"`
+	Commands     []string       `json:"commands,omitempty"`
+	Errors       []string       `json:"errors,omitempty"`
+}
+
+// GetSessionTimelineResult is the response for get_session_timeline.
+type GetSessionTimelineResult struct {
+	MCPResult
+	Turns      []TurnSummary `json:"turns"`
+	TotalTurns int           `json:"total_turns"`
+}
+
+func registerTimelineTools(s *mcpserver.MCPServer, th *timelineHandlers) {
+	s.AddTool(
+		mcpgo.NewTool("get_session_timeline",
+			mcpgo.WithDescription("Get a per-turn digest of what a session's agent has done: for each prompt, the tools it used, files it edited, commands it ran and errors it hit. Much cheaper than reading terminal output when catching up on a long-running session. Returns the most recent turns; use since_turn to poll for new ones."),
+			mcpgo.WithString("session_id",
+				mcpgo.Description("Session ID (title or UUID) of the session"),
+				mcpgo.Required(),
+			),
+			mcpgo.WithNumber("since_turn",
+				mcpgo.Description("Only return turns with index >= since_turn (e.g. the previous total_turns)"),
+				mcpgo.Min(0),
+			),
+			mcpgo.WithNumber("limit",
+				mcpgo.Description("Max turns returned, most recent kept (default 20, max 200)"),
+				mcpgo.DefaultNumber(20),
+				mcpgo.Min(1),
+				mcpgo.Max(200),
+			),
+			mcpgo.WithBoolean("details",
+				mcpgo.Description("Include tool counts, files, commands and errors for each turn, not just the summary (default true)"),
+				mcpgo.DefaultBool(true),
+			),
+		),
+		th.getSessionTimeline,
+	)
+}
+
+func (th *timelineHandlers) getSessionTimeline(_ context.Context, req mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	args := req.GetArguments()
+	sessionID, ok := args["session_id"].(string)
+	if !ok || sessionID == "" {
+		return errResult(ErrInvalidArgument, "session_id is required", ""), nil
+	}
+	sinceF, _ := args["since_turn"].(float64)
+	limitF, _ := args["limit"].(float64)
+	limit := 20
+	if limitF > 0 {
+		limit = int(limitF)
+	}
+	if limit > 200 {
+		limit = 200
+	}
+	details := true
+	if d, ok := args["details"].(bool); ok {
+		details = d
+	}
+
+	timeline, err := th.timelines.SessionTimeline(sessionID)
+	switch {
+	case errors.Is(err, services.ErrSessionNotFound):
+		return errResult(ErrSessionNotFound, fmt.Sprintf("session %q not found", sessionID),
+			"Use list_sessions or search_sessions to find valid session IDs."), nil
+	case errors.Is(err, services.ErrNoConversation):
+		return errResult(ErrNoConversation, fmt.Sprintf("session %q has no conversation history yet", sessionID),
+			"The conversation file is linked once the agent has received its first prompt; retry later."), nil
+	case err != nil:
+		return errResult(ErrInternalError, fmt.Sprintf("build timeline: %v", err), ""), nil
+	}
+
+	turns := services.SelectTurns(timeline.Turns, int(sinceF), limit)
+	out := make([]TurnSummary, 0, len(turns))
+	for _, t := range turns {
+		ts := TurnSummary{
+			Index:   t.Index,
+			Prompt:  t.Prompt,
+			Summary: t.Summary,
+			Outcome: t.Outcome,
+		}
+		if !t.StartedAt.IsZero() {
+			ts.StartedAt = t.StartedAt.UTC().Format(time.RFC3339)
+		}
+		if !t.EndedAt.IsZero() {
+			ts.EndedAt = t.EndedAt.UTC().Format(time.RFC3339)
+		}
+		if details {
+			if len(t.Tools) > 0 {
+				ts.Tools = make(map[string]int, len(t.Tools))
+				for _, tc := range t.Tools {
+					ts.Tools[tc.Name] = tc.Count
+				}
+			}
+			ts.FilesTouched = t.FilesTouched
+			ts.Commands = t.Commands
+			ts.Errors = t.Errors
+		}
+		out = append(out, ts)
+	}
+
+	return okResult(GetSessionTimelineResult{
+		MCPResult:  MCPResult{Success: true},
+		Turns:      out,
+		TotalTurns: len(timeline.Turns),
+	}), nil
+}
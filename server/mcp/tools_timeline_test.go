@@ -0,0 +1,75 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tstapler/stapler-squad/server/services"
+	"github.com/tstapler/stapler-squad/session/digest"
+)
+
+type stubTimelines map[string]*digest.Timeline
+
+func (s stubTimelines) SessionTimeline(id string) (*digest.Timeline, error) {
+	if id == "fresh" {
+		return nil, services.ErrNoConversation
+	}
+	t, ok := s[id]
+	if !ok {
+		return nil, services.ErrSessionNotFound
+	}
+	return t, nil
+}
+
+func TestGetSessionTimeline(t *testing.T) {
+	th := &timelineHandlers{timelines: stubTimelines{
+		"worker": {Turns: []digest.TurnDigest{
+			{Index: 0, Prompt: "add tests", Summary: "first"},
+			{Index: 1, Prompt: "fix lint", Summary: "second", Tools: []digest.ToolCount{{Name: "Bash", Count: 2}}, Commands: []string{"make lint"}},
+			{Index: 2, Prompt: "ship it", Summary: "third"},
+		}},
+	}}
+
+	res, err := th.getSessionTimeline(context.Background(), makeToolReq(map[string]interface{}{
+		"session_id": "worker",
+		"since_turn": float64(1),
+		"limit":      float64(1),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := parseResult(t, res)
+	if m["success"] != true {
+		t.Fatalf("expected success, got %v", m)
+	}
+	if m["total_turns"] != float64(3) {
+		t.Errorf("expected total_turns=3, got %v", m["total_turns"])
+	}
+	turns, _ := m["turns"].([]interface{})
+	if len(turns) != 1 {
+		t.Fatalf("expected the most recent turn only, got %v", turns)
+	}
+	if turn := turns[0].(map[string]interface{}); turn["summary"] != "third" {
+		t.Errorf("expected the last turn, got %v", turn)
+	}
+
+	res, _ = th.getSessionTimeline(context.Background(), makeToolReq(map[string]interface{}{
+		"session_id": "worker",
+		"details":    false,
+	}))
+	turns, _ = parseResult(t, res)["turns"].([]interface{})
+	if len(turns) != 3 {
+		t.Fatalf("expected all turns, got %d", len(turns))
+	}
+	if _, ok := turns[1].(map[string]interface{})["commands"]; ok {
+		t.Errorf("details=false should omit commands, got %v", turns[1])
+	}
+
+	for id, code := range map[string]string{"missing": ErrSessionNotFound, "fresh": ErrNoConversation, "": ErrInvalidArgument} {
+		res, _ := th.getSessionTimeline(context.Background(), makeToolReq(map[string]interface{}{"session_id": id}))
+		errField, _ := parseResult(t, res)["error"].(map[string]interface{})
+		if errField["code"] != code {
+			t.Errorf("session %q: expected code %q, got %v", id, code, errField["code"])
+		}
+	}
+}
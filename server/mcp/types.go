@@ -66,7 +66,9 @@ const (
 	ErrInvalidStatusTrans    = "INVALID_STATUS_TRANSITION"
 	ErrSessionNotRunning     = "SESSION_NOT_RUNNING"
 	ErrRateLimitExceeded     = "RATE_LIMIT_EXCEEDED"
+	ErrInputDenied           = "INPUT_DENIED"
 	ErrSessionStartupTimeout = "SESSION_STARTUP_TIMEOUT"
 	ErrInvalidPath           = "INVALID_PATH"
 	ErrPTYWriteTimeout       = "PTY_WRITE_TIMEOUT"
+	ErrNoConversation        = "NO_CONVERSATION"
 )
@@ -11,9 +11,17 @@ type AuthValidator interface {
 	ValidateAuthSession(token string) bool
 }
 
+// ShareAuthorizer grants narrow access to requests that carry a session share
+// link instead of an auth session. AuthorizeShare returns the request to serve,
+// with the share recorded in its context, when r is allowed by a share link.
+type ShareAuthorizer interface {
+	AuthorizeShare(r *http.Request) (*http.Request, bool)
+}
+
 // Auth returns middleware that enforces authentication on all non-exempt paths.
 // When auth is nil (auth disabled), the middleware is a no-op pass-through.
-func Auth(validator AuthValidator) func(http.Handler) http.Handler {
+// Requests without an auth session may still be allowed by shares, if non-nil.
+func Auth(validator AuthValidator, shares ShareAuthorizer) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		if validator == nil {
 			return next
@@ -26,6 +34,12 @@ func Auth(validator AuthValidator) func(http.Handler) http.Handler {
 			}
 
 			if !isAuthenticated(r, validator) {
+				if shares != nil {
+					if shared, ok := shares.AuthorizeShare(r); ok {
+						next.ServeHTTP(w, shared)
+						return
+					}
+				}
 				// API call → 401 JSON
 				if isAPIPath(r.URL.Path) {
 					w.Header().Set("Content-Type", "application/json")
@@ -50,6 +64,7 @@ var exemptPrefixes = []string{
 	"/health",  // health check
 	"/_next/",  // Next.js build assets
 	"/favicon", // browser tab icon
+	"/share/",  // share-link viewer page; the stream itself needs the share cookie
 }
 
 func isExempt(path string) bool {
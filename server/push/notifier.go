@@ -2,6 +2,7 @@ package push
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/server/services"
@@ -29,17 +30,29 @@ type Notifier interface {
 
 // WebPushNotifier delivers notifications via the Web Push protocol.
 type WebPushNotifier struct {
-	svc *services.PushService
+	svc      *services.PushService
+	disabled atomic.Bool
 }
 
 // NewWebPushNotifier creates a WebPushNotifier backed by the provided PushService.
+// It starts enabled; see SetEnabled.
 func NewWebPushNotifier(svc *services.PushService) *WebPushNotifier {
 	return &WebPushNotifier{svc: svc}
 }
 
+// SetEnabled turns delivery on or off, following the push_enabled
+// notification preference. A disabled notifier drops notifications.
+func (n *WebPushNotifier) SetEnabled(enabled bool) {
+	n.disabled.Store(!enabled)
+}
+
 func (n *WebPushNotifier) Name() string { return "web-push" }
 
 func (n *WebPushNotifier) Send(_ context.Context, dn DeliveryNotification) error {
+	if n.disabled.Load() {
+		log.Debug("WebPushNotifier push disabled, dropping notification", "title", dn.Title)
+		return nil
+	}
 	pn := services.PushNotification{
 		Title:              dn.Title,
 		Body:               dn.Body,
@@ -125,6 +125,16 @@ func TestStartDeliverySubscriberEmptyNotifiers(t *testing.T) {
 	})
 }
 
+// BV-3b — A disabled web push notifier drops notifications without touching
+// the push service (push_enabled=false).
+func TestWebPushNotifierDisabledDrops(t *testing.T) {
+	n := NewWebPushNotifier(nil)
+	n.SetEnabled(false)
+	assert.NotPanics(t, func() {
+		assert.NoError(t, n.Send(context.Background(), DeliveryNotification{Title: "t"}))
+	})
+}
+
 // BV-4 — Deduplication window: same tag within 2s suppressed
 func TestDeduplicationWindow(t *testing.T) {
 	bus := events.NewEventBus(10)
@@ -278,7 +278,9 @@ func (rqm *ReactiveQueueManager) OnItemAdded(item *session.ReviewItem) {
 	// broadcasts a richer notification (with the actual command preview and approval UUID)
 	// when the HTTP hook fires. Publishing again here would create a duplicate card in the
 	// notification panel because APPROVAL_NEEDED records are never deduplicated server-side.
-	if rqm.eventBus != nil && item.Reason != session.ReasonApprovalPending {
+	// RESOURCE_LIMIT items are skipped for the same reason: the ResourceMonitor callback
+	// notifies as soon as the OOM kill or limit hit is observed.
+	if rqm.eventBus != nil && item.Reason != session.ReasonApprovalPending && item.Reason != session.ReasonResourceLimit {
 		notifType, notifPriority := rqm.mapReviewItemToNotification(item)
 		notifID := fmt.Sprintf("review-queue-%s-%d", item.SessionID, item.DetectedAt.UnixMilli())
 		title := fmt.Sprintf("%s: %s", item.Reason.String(), item.SessionName)
@@ -616,6 +618,10 @@ func (rqm *ReactiveQueueManager) reasonToProto(r session.AttentionReason) sessio
 		return sessionv1.AttentionReason_ATTENTION_REASON_WAITING_FOR_USER
 	case session.ReasonTestsFailing:
 		return sessionv1.AttentionReason_ATTENTION_REASON_TESTS_FAILING
+	case session.ReasonCIFailing:
+		return sessionv1.AttentionReason_ATTENTION_REASON_CI_FAILING
+	case session.ReasonResourceLimit:
+		return sessionv1.AttentionReason_ATTENTION_REASON_RESOURCE_LIMIT
 	default:
 		return sessionv1.AttentionReason_ATTENTION_REASON_UNSPECIFIED
 	}
@@ -648,7 +654,7 @@ func (rqm *ReactiveQueueManager) mapReviewItemToNotification(item *session.Revie
 		notifType = int32(sessionv1.NotificationType_NOTIFICATION_TYPE_INPUT_REQUIRED)
 	case session.ReasonErrorState:
 		notifType = int32(sessionv1.NotificationType_NOTIFICATION_TYPE_ERROR)
-	case session.ReasonTestsFailing:
+	case session.ReasonTestsFailing, session.ReasonCIFailing, session.ReasonResourceLimit:
 		notifType = int32(sessionv1.NotificationType_NOTIFICATION_TYPE_FAILURE)
 	case session.ReasonTaskComplete:
 		notifType = int32(sessionv1.NotificationType_NOTIFICATION_TYPE_TASK_COMPLETE)
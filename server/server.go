@@ -7,11 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/forge"
 	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
 	"github.com/tstapler/stapler-squad/gen/proto/go/session/v1/sessionv1connect"
+	"github.com/tstapler/stapler-squad/github"
 	"github.com/tstapler/stapler-squad/log"
 	pkganalytics "github.com/tstapler/stapler-squad/pkg/analytics"
+	"github.com/tstapler/stapler-squad/pkg/metrics"
 	"github.com/tstapler/stapler-squad/server/analytics"
+	"github.com/tstapler/stapler-squad/server/auth"
 	"github.com/tstapler/stapler-squad/server/events"
 	"github.com/tstapler/stapler-squad/server/handlers"
 	"github.com/tstapler/stapler-squad/server/interceptors"
@@ -40,13 +44,14 @@ type Server struct {
 	addr           string
 	httpServer     *http.Server
 	mux            *http.ServeMux
-	tlsConfig      *tls.Config                     // non-nil when TLS is enabled
-	authMiddleware func(http.Handler) http.Handler // nil when auth is disabled
-	httpsURL       string                          // set when remote access is enabled
-	hostnames      []string                        // detected LAN hostnames
-	origins        []string                        // allowed CORS origins
-	shutdownHooks  []func()                        // called before HTTP server stops
-	connCtxCancel  context.CancelFunc              // cancels BaseContext → closes active streams on shutdown
+	tlsConfig      *tls.Config                          // non-nil when TLS is enabled
+	authMiddleware func(http.Handler) http.Handler      // nil when auth is disabled
+	httpsURL       string                               // set when remote access is enabled
+	hostnames      []string                             // detected LAN hostnames
+	origins        []string                             // allowed CORS origins
+	shutdownHooks  []func()                             // called before HTTP server stops
+	connCtxCancel  context.CancelFunc                   // cancels BaseContext → closes active streams on shutdown
+	wsHandler      *services.ConnectRPCWebSocketHandler // nil when dependencies failed to build
 }
 
 // newServerBase creates the base Server struct and returns it alongside the
@@ -126,6 +131,8 @@ func NewServerWithDeps(addr string, deps *ServerDependencies) *Server {
 // serverCtx (== connCtx from newServerBase) is cancelled by Shutdown() to signal
 // active streaming connections to close.
 func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context.Context) {
+	cfg := config.LoadConfig()
+
 	// Start background components
 	go deps.ReactiveQueueMgr.Start(serverCtx)
 	log.Info("ReactiveQueueManager started")
@@ -133,11 +140,27 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 	deps.PRStatusPoller.Start(serverCtx)
 	log.Info("PRStatusPoller started")
 
+	// Start cgroup resource sampling (no-op without cgroup v2).
+	if deps.ResourceMonitor != nil {
+		deps.ResourceMonitor.Start(serverCtx)
+	}
+
+	// Drain sandbox network denials into approval analytics.
+	if deps.SandboxWatcher != nil {
+		deps.SandboxWatcher.Start(serverCtx)
+	}
+
 	// Start HistoryLinker: detects Claude JSONL files and links conversation
 	// UUIDs to sessions so cold restore can use --resume on restart.
 	go deps.HistoryLinker.Start(serverCtx)
 	log.Info("HistoryLinker started")
 
+	// Record the terminals of sessions that opted in to recording.
+	go deps.SessionService.RunRecordings(serverCtx)
+
+	// Fire recurring schedules, catching up on firings missed while down.
+	go deps.SessionService.RunScheduler(serverCtx)
+
 	// Start UnfinishedWork scanner.
 	if deps.UnfinishedScanner != nil {
 		deps.UnfinishedScanner.Start(serverCtx)
@@ -195,12 +218,15 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 	}
 
 	// Initialize push notification service.
+	var pushNotifier *push.WebPushNotifier
 	if configErr == nil {
 		pushService := services.NewPushService(configDir)
 		pushHandler := services.NewPushHandler(pushService)
 		pushHandler.RegisterRoutes(srv.mux)
-		push.StartPushSubscriber(serverCtx, deps.EventBus, pushService)
-		log.Info("Push notification service initialized")
+		pushNotifier = push.NewWebPushNotifier(pushService)
+		pushNotifier.SetEnabled(cfg.Notifications.PushEnabled)
+		push.StartDeliverySubscriber(serverCtx, deps.EventBus, []push.Notifier{pushNotifier})
+		log.Info("Push notification service initialized", "push_enabled", cfg.Notifications.PushEnabled)
 	}
 
 	// Wire fork pressure monitor → push notification + emergency reconcile.
@@ -284,10 +310,16 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 	deps.ExternalApprovalMonitor.Start()
 	deps.ExternalApprovalMonitor.IntegrateWithDiscoveryTmux(deps.ExternalDiscovery, deps.TmuxStreamerManager)
 
+	// Metrics registry served at /metrics; handlers below register their instruments on it.
+	metricsRegistry := metrics.NewRegistry()
+
 	// Register ConnectRPC WebSocket handler (must come before unary handler)
 	wsHandler := services.NewConnectRPCWebSocketHandler(
-		deps.SessionService, deps.ScrollbackManager, deps.TmuxStreamerManager, "raw-compressed",
+		deps.SessionService, deps.ScrollbackManager, deps.TmuxStreamerManager, cfg.TerminalStreamingMode,
 	)
+	wsHandler.SetMetrics(metricsRegistry)
+	deps.SessionService.SetInputCoordinators(wsHandler.Coordinators())
+	srv.wsHandler = wsHandler
 	wsPath := "/api" + sessionv1connect.SessionServiceStreamTerminalProcedure
 	srv.mux.HandleFunc(wsPath, wsHandler.HandleWebSocket)
 	log.Info("Registered ConnectRPC WebSocket handler", "path", wsPath)
@@ -332,7 +364,6 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 		log.InfoLog.Printf("Registered BacklogService handler at %s", blAPIPath)
 	}
 
-
 	// Wire external session support into the unified WebSocket handler
 	wsHandler.SetExternalSessionSupport(deps.ExternalDiscovery)
 	log.Info("Unified WebSocket handler configured for external session support")
@@ -366,11 +397,14 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 		approvalHandler.SetNotificationStamper(notifStore)
 		approvalHandler.SetAutoApprovalLogger(notifStore)
 	}
+	approvalHandler.SetActivityRecorder(deps.ActivityTracker)
+	approvalHandler.SetMetrics(metricsRegistry)
 	srv.mux.HandleFunc("/api/hooks/permission-request", approvalHandler.HandlePermissionRequest)
 	log.Info("Registered Claude Code hook approval handler at /api/hooks/permission-request")
 
 	// Register non-approval hook receivers (stop, pre/post-tool-use, prompt-submit)
-	hookReceiver := services.NewHookReceiver()
+	hookReceiver := services.NewHookReceiver(deps.ActivityTracker)
+	hookReceiver.SetQueueChecker(deps.ReviewQueuePoller)
 	hookReceiver.RegisterRoutes(srv.mux)
 	log.Info("Registered Claude Code hook receivers at /api/hooks/{stop,pre-tool-use,post-tool-use,prompt-submit}")
 
@@ -382,7 +416,7 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 	// Register MCP HTTP transport at /mcp so Claude sessions can connect
 	// without spawning a subprocess. The URL is passed via --mcp-server to
 	// claude when creating new sessions (no settings-file injection needed).
-	mcpHTTPHandler := servermcp.NewHTTPHandler(deps.Storage, deps.SessionService, deps.ScrollbackManager, deps.Storage)
+	mcpHTTPHandler := servermcp.NewHTTPHandler(deps.Storage, deps.SessionService, deps.ScrollbackManager, deps.Storage, deps.ReviewPanels)
 	// Wrap with middleware that injects session UUID from X-Stapler-Session-UUID header.
 	mcpWithUUID := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if uuid := r.Header.Get("X-Stapler-Session-UUID"); uuid != "" {
@@ -414,6 +448,16 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 	cbHandler.RegisterRoutes(srv.mux)
 	log.Info("Registered Circuit Breaker debug handler at /api/debug/circuit-breakers")
 
+	// Register the Prometheus/OpenMetrics scrape endpoint
+	services.RegisterDaemonCollectors(metricsRegistry, services.DaemonMetricSources{
+		Sessions:    deps.ReviewQueuePoller,
+		ReviewQueue: deps.ReviewQueue,
+		EventBus:    deps.EventBus,
+		Insights:    deps.InsightsService,
+	})
+	services.NewMetricsHandler(metricsRegistry).RegisterRoutes(srv.mux)
+	log.Info("Registered metrics handler at /metrics")
+
 	// Wire analytics provider: SQLite when DB client is available, log-only fallback otherwise.
 	var analyticsProvider analytics.AnalyticsProvider
 	if deps.AnalyticsEntClient != nil {
@@ -424,8 +468,22 @@ func wireDepsIntoServer(srv *Server, deps *ServerDependencies, serverCtx context
 		log.Info("Analytics: using LogAnalyticsProvider (fallback)")
 	}
 
+	// Register self-hosted forge hosts so PR tracking can pick GitLab/Gitea clients
+	// for remotes whose hostname does not reveal the platform.
+	for host, kind := range cfg.ForgeHosts {
+		forge.RegisterHost(host, github.ForgeKind(kind))
+	}
+
+	// Hot-reload config.json: validated edits are published on the EventBus.
+	startConfigWatcher(serverCtx, srv.mux, deps.EventBus, cfg, configTargets{
+		prPoller:        deps.PRStatusPoller,
+		resourceMonitor: deps.ResourceMonitor,
+		pushNotifier:    pushNotifier,
+		terminal:        wsHandler,
+		sessions:        deps.SessionService,
+	})
+
 	// Start analytics retention enforcer (hourly; exits when serverCtx is cancelled).
-	cfg := config.LoadConfig()
 	if deps.AnalyticsEntClient != nil {
 		analytics.StartRetentionEnforcer(serverCtx, deps.AnalyticsEntClient,
 			cfg.AnalyticsMaxRowsOrDefault(), cfg.AnalyticsMaxAgeDaysOrDefault(), cfg.EscapeAnalyticsRetentionDays)
@@ -619,6 +677,14 @@ func (s *Server) Mux() *http.ServeMux {
 	return s.mux
 }
 
+// SetShareManager lets share-link viewers join terminal streams. Call this
+// before StartRemote, alongside the auth middleware that admits them.
+func (s *Server) SetShareManager(shares *auth.ShareManager) {
+	if s.wsHandler != nil {
+		s.wsHandler.SetShareManager(shares)
+	}
+}
+
 // SetHTTPSURL records the public HTTPS URL for this server (used by /api/server-info).
 // Call this after remote access is configured in main.go.
 func (s *Server) SetHTTPSURL(url string) {
@@ -11,6 +11,7 @@ import (
 	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/pkg/classifier"
 	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/sandbox"
 )
 
 // AnalyticsEntry records a single classification decision.
@@ -21,7 +22,7 @@ type AnalyticsEntry struct {
 	ToolName       string    `json:"tool_name"`
 	CommandPreview string    `json:"command_preview"` // first 200 chars
 	Cwd            string    `json:"cwd"`
-	// Decision: "auto_allow" | "auto_deny" | "escalate" | "manual_allow" | "manual_deny"
+	// Decision: "auto_allow" | "auto_deny" | "escalate" | "manual_allow" | "manual_deny" | "sandbox_deny"
 	Decision    string `json:"decision"`
 	RiskLevel   string `json:"risk_level"`
 	RuleID      string `json:"rule_id,omitempty"`
@@ -209,6 +210,19 @@ func (s *AnalyticsStore) RecordManualDecision(approvalID, sessionID, toolName, c
 	})
 }
 
+// RecordSandboxDenial records a network request blocked by a session's
+// sandbox. Its tool name is "Sandbox" and its preview "METHOD host:port".
+func (s *AnalyticsStore) RecordSandboxDenial(sessionID string, d sandbox.Denial) {
+	s.Record(AnalyticsEntry{
+		Timestamp:      d.Time,
+		SessionID:      sessionID,
+		ToolName:       "Sandbox",
+		CommandPreview: d.Method + " " + d.Host,
+		Decision:       "sandbox_deny",
+		Reason:         d.Reason,
+	})
+}
+
 // DroppedCount returns the number of entries dropped due to buffer overflow.
 func (s *AnalyticsStore) DroppedCount() int64 {
 	return atomic.LoadInt64(&s.dropped)
@@ -323,7 +337,7 @@ func ComputeSummary(entries []AnalyticsEntry) AnalyticsSummary {
 			summary.WindowEnd = e.Timestamp
 		}
 
-		if e.Decision == "auto_deny" || e.Decision == "manual_deny" {
+		if e.Decision == "auto_deny" || e.Decision == "manual_deny" || e.Decision == "sandbox_deny" {
 			key := e.ToolName + ":" + e.CommandPreview
 			stat := deniedCmds[key]
 			stat.Preview = e.CommandPreview
@@ -420,6 +434,7 @@ type DailyBucket struct {
 	Escalate    int    `json:"escalate"`
 	ManualAllow int    `json:"manual_allow"`
 	ManualDeny  int    `json:"manual_deny"`
+	SandboxDeny int    `json:"sandbox_deny"`
 	Total       int    `json:"total"`
 }
 
@@ -454,6 +469,8 @@ func ComputeDailyBuckets(entries []AnalyticsEntry) []DailyBucket {
 			b.ManualAllow++
 		case "manual_deny":
 			b.ManualDeny++
+		case "sandbox_deny":
+			b.SandboxDeny++
 		}
 	}
 
@@ -14,8 +14,10 @@ import (
 	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
 	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/pkg/classifier"
+	"github.com/tstapler/stapler-squad/pkg/metrics"
 	"github.com/tstapler/stapler-squad/server/events"
 	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/activity"
 
 	"github.com/google/uuid"
 )
@@ -67,6 +69,8 @@ type ApprovalHandler struct {
 	domainChecker       *DomainAgeChecker           // optional: escalate requests to newly-registered domains
 	notificationStamper approvalNotificationStamper // optional: stamps approval outcomes on notification records
 	autoApprovalLog     autoApprovalLogger          // optional: writes silent records for auto-approved/denied ops
+	activity            activityRecorder            // optional: records escalated requests in the activity stream
+	metrics             approvalMetrics             // zero value records nothing; see SetMetrics
 	timeout             time.Duration               // default 4m; overridable in tests
 }
 
@@ -120,6 +124,18 @@ func (h *ApprovalHandler) SetAutoApprovalLogger(l autoApprovalLogger) {
 	h.autoApprovalLog = l
 }
 
+// SetActivityRecorder injects the activity recorder. Requests escalated to the
+// user are recorded so the session reads as waiting until the user decides,
+// and the decision is recorded so it reads as working again.
+func (h *ApprovalHandler) SetActivityRecorder(r activityRecorder) {
+	h.activity = r
+}
+
+// SetMetrics registers the handler's decision, latency and classifier metrics on reg.
+func (h *ApprovalHandler) SetMetrics(reg *metrics.Registry) {
+	h.metrics = newApprovalMetrics(reg)
+}
+
 // HandlePermissionRequest handles POST /api/hooks/permission-request.
 // This endpoint is configured as an HTTP hook in Claude Code's settings.
 // It blocks until the user approves/denies or the context is canceled.
@@ -162,11 +178,15 @@ func (h *ApprovalHandler) HandlePermissionRequest(w http.ResponseWriter, r *http
 					Reason:    msg,
 				}, sessionID, "", 0)
 			}
+			h.metrics.decisions.Inc("deny", "secret-scan", approvalSourceBuiltin)
 			h.writeDecision(w, "deny", msg)
 			return
 		}
 	}
 
+	// The rule and source that escalated the request to the user, if any.
+	var escalatedRule, escalatedSource string
+
 	// Domain age check: if a Bash command is contacting a newly-registered domain,
 	// escalate immediately regardless of other rules.
 	if h.domainChecker != nil {
@@ -191,6 +211,8 @@ func (h *ApprovalHandler) HandlePermissionRequest(w http.ResponseWriter, r *http
 							Reason:    reason,
 						}, sessionID, "", 0)
 					}
+					h.metrics.decisions.Inc("escalate", "new-domain-check", approvalSourceBuiltin)
+					escalatedRule, escalatedSource = "new-domain-check", approvalSourceBuiltin
 					// Fall through to manual review queue (do NOT return here).
 					// The domain reason will appear in the pending approval context.
 					_ = reason // will be surfaced when the approval is shown in review queue
@@ -216,7 +238,11 @@ func (h *ApprovalHandler) HandlePermissionRequest(w http.ResponseWriter, r *http
 		start := time.Now()
 		classCtx := h.classifier.BuildContext(payload.Cwd)
 		result := h.classifier.Classify(payload, classCtx)
-		durationMs := time.Since(start).Milliseconds()
+		elapsed := time.Since(start)
+		durationMs := elapsed.Milliseconds()
+		h.metrics.classifierDuration.Observe(elapsed.Seconds())
+		h.metrics.decisions.Inc(classifierDecisionLabel(result.Decision), result.RuleID, result.Source)
+		escalatedRule, escalatedSource = result.RuleID, result.Source
 
 		if h.analyticsStore != nil {
 			h.analyticsStore.RecordFromResult(payload, result, sessionID, "", durationMs)
@@ -270,6 +296,15 @@ createApproval:
 		return
 	}
 
+	if h.activity != nil && sessionID != "unknown" {
+		h.activity.Record(h.resolveSessionName(sessionID), activity.Entry{
+			Kind:           activity.KindPermission,
+			AgentSessionID: payload.SessionID,
+			Tool:           payload.ToolName,
+			Input:          activity.SummarizeInput(payload.ToolName, payload.ToolInput),
+		})
+	}
+
 	// Notify all web UI clients about the pending approval
 	h.broadcastApprovalNotification(sessionID, approval)
 
@@ -291,6 +326,16 @@ createApproval:
 	case decision = <-approval.decisionCh:
 		// User responded via ResolveApproval RPC
 		log.ForSession(sessionID).Info("[ApprovalHandler] approval resolved", "approval_id", approvalID, "behavior", decision.Behavior)
+		h.metrics.observeManual(decision.Behavior, escalatedRule, escalatedSource, approval.CreatedAt)
+		if h.activity != nil && sessionID != "unknown" {
+			h.activity.Record(h.resolveSessionName(sessionID), activity.Entry{
+				Kind:           activity.KindPermissionResolved,
+				AgentSessionID: payload.SessionID,
+				Tool:           payload.ToolName,
+				Input:          activity.SummarizeInput(payload.ToolName, payload.ToolInput),
+				Decision:       decision.Behavior,
+			})
+		}
 	case <-time.After(h.approvalTimeout()):
 		// Server-side timeout (before the hook's 5-minute timeout).
 		// Return an empty HTTP response so the hook script gets no hookSpecificOutput
@@ -298,6 +343,7 @@ createApproval:
 		// This lets the user still approve/deny in the terminal rather than being
 		// silently allowed or denied.
 		h.store.Remove(approvalID)
+		h.metrics.observeManual("timeout", escalatedRule, escalatedSource, approval.CreatedAt)
 		// Stamp the notification so the panel shows a "timed out" badge instead of
 		// live Approve/Deny buttons after page refresh.
 		if h.notificationStamper != nil {
@@ -311,6 +357,7 @@ createApproval:
 	case <-r.Context().Done():
 		// Claude Code disconnected (e.g., stapler-squad restarted, network issue)
 		h.store.Remove(approvalID)
+		h.metrics.observeManual("canceled", escalatedRule, escalatedSource, approval.CreatedAt)
 		decision = ApprovalDecision{Behavior: "allow", Message: ""}
 		log.ForSession(sessionID).Info("[ApprovalHandler] approval context canceled", "approval_id", approvalID)
 		return // Don't write to disconnected client
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tstapler/stapler-squad/server/events"
 	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/activity"
 	"github.com/tstapler/stapler-squad/testutil"
 )
 
@@ -124,6 +125,34 @@ func TestApprovalFlow_Deny(t *testing.T) {
 	}
 }
 
+// TestApprovalFlow_RecordsResolution verifies that the user's decision is
+// recorded in the activity stream, moving the session from waiting back to
+// working.
+func TestApprovalFlow_RecordsResolution(t *testing.T) {
+	h, store := newTestHandler(5 * time.Second)
+	tracker, err := activity.NewTracker("", nil)
+	require.NoError(t, err)
+	h.SetActivityRecorder(tracker)
+
+	go func() {
+		_ = testutil.WaitForCondition(func() bool {
+			return tracker.State("test-session").Phase == activity.PhaseWaiting
+		}, testutil.FastWaitConfig())
+		for _, a := range store.ListAll() {
+			_ = store.Resolve(a.ID, ApprovalDecision{Behavior: "allow"})
+		}
+	}()
+
+	resp, _ := postPermissionRequest(t, h, "test-session", "Bash")
+	require.Equal(t, "allow", resp.HookSpecificOutput.Decision.Behavior)
+
+	entries := tracker.Recent("test-session", 0)
+	require.Len(t, entries, 2)
+	assert.Equal(t, activity.KindPermissionResolved, entries[1].Kind)
+	assert.Equal(t, "allow", entries[1].Decision)
+	assert.Equal(t, activity.PhaseWorking, tracker.State("test-session").Phase)
+}
+
 // TestApprovalFlow_Timeout verifies that when no decision arrives the handler
 // times out and returns a 200 with an empty body (native dialog fallback).
 // The empty body signals to the hook script that Claude Code should fall back
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/ent"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetDependencyStore wires the backlog dependency graph. Without it, items
+// are never considered blocked and the dependency RPCs return CodeUnavailable.
+// Edges naming archived or missing items are dropped on wiring.
+func (s *BacklogService) SetDependencyStore(deps *session.BacklogDependencyStore) {
+	s.deps = deps
+	s.pruneDependencies(context.Background())
+}
+
+// pruneDependencies removes every edge that names an archived or deleted
+// item. ArchiveBacklogItem removes an item's edges as it archives it; this
+// catches items that disappeared some other way, such as a restore.
+func (s *BacklogService) pruneDependencies(ctx context.Context) {
+	if s.deps == nil || s.storage == nil {
+		return
+	}
+	gone := make(map[string]bool)
+	for _, e := range s.deps.Edges() {
+		for _, id := range []string{e.ItemID, e.BlockedByID} {
+			if _, checked := gone[id]; checked {
+				continue
+			}
+			item, err := s.storage.GetBacklogItem(ctx, id)
+			switch {
+			case err == nil:
+				gone[id] = item.ArchivedAt != nil
+			case ent.IsNotFound(err) || errors.Is(err, session.ErrNotFound):
+				gone[id] = true
+			default:
+				log.Warn("backlog: failed to load item while pruning dependencies", "item", id, "err", err)
+				gone[id] = false
+			}
+		}
+	}
+	for id, isGone := range gone {
+		if !isGone {
+			continue
+		}
+		if err := s.deps.RemoveItem(id); err != nil {
+			log.Warn("backlog: failed to prune dependencies", "item", id, "err", err)
+		}
+	}
+}
+
+// SetDispatcher wires the auto-dispatcher so that status changes which may
+// unblock items trigger an immediate dispatch pass instead of waiting for the
+// next tick.
+func (s *BacklogService) SetDispatcher(d *BacklogDispatcher) {
+	s.dispatcher = d
+}
+
+// kickDispatcher nudges the auto-dispatcher, if wired.
+func (s *BacklogService) kickDispatcher() {
+	if s.dispatcher != nil {
+		s.dispatcher.Kick()
+	}
+}
+
+// pendingBlockers returns the IDs of itemID's blockers that are neither done
+// nor passed review. known caches items already loaded by the caller; blockers
+// that no longer exist do not block.
+func (s *BacklogService) pendingBlockers(ctx context.Context, itemID string, known map[string]*session.BacklogItemData) []string {
+	if s.deps == nil {
+		return nil
+	}
+	var pending []string
+	for _, blockerID := range s.deps.BlockedBy(itemID) {
+		blocker, ok := known[blockerID]
+		if !ok {
+			loaded, err := s.storage.GetBacklogItem(ctx, blockerID)
+			if err != nil {
+				if !ent.IsNotFound(err) && !errors.Is(err, session.ErrNotFound) {
+					log.Warn("backlog: failed to load blocker; treating as pending", "item", itemID, "blocker", blockerID, "err", err)
+					pending = append(pending, blockerID)
+				}
+				continue
+			}
+			blocker = loaded
+		}
+		if session.BacklogStatus(blocker.Status) == session.BacklogStatusDone {
+			continue
+		}
+		outcome, err := s.storage.GetMostRecentReviewVerdictForItem(ctx, blockerID)
+		if err != nil {
+			log.Warn("backlog: failed to load blocker verdict", "blocker", blockerID, "err", err)
+		}
+		if !session.BlockerSatisfied(blocker.Status, outcome) {
+			pending = append(pending, blockerID)
+		}
+	}
+	return pending
+}
+
+func backlogDependencyToProto(d session.BacklogDependency) *sessionv1.BacklogDependency {
+	return &sessionv1.BacklogDependency{
+		ItemId:      d.ItemID,
+		BlockedById: d.BlockedByID,
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+	}
+}
+
+// AddBacklogDependency records that an item is blocked by another item.
+// +api: backlog:add-dependency
+func (s *BacklogService) AddBacklogDependency(
+	ctx context.Context,
+	req *connect.Request[sessionv1.AddBacklogDependencyRequest],
+) (*connect.Response[sessionv1.AddBacklogDependencyResponse], error) {
+	if s.storage == nil || s.deps == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("backlog dependencies not available"))
+	}
+	for _, id := range []string{req.Msg.ItemId, req.Msg.BlockedById} {
+		if _, err := s.storage.GetBacklogItem(ctx, id); err != nil {
+			if ent.IsNotFound(err) || errors.Is(err, session.ErrNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("backlog item %q not found", id))
+			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get backlog item: %w", err))
+		}
+	}
+
+	if err := s.deps.AddDependency(req.Msg.ItemId, req.Msg.BlockedById); err != nil {
+		if errors.Is(err, session.ErrDependencyCycle) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add dependency: %w", err))
+	}
+
+	dep := session.BacklogDependency{ItemID: req.Msg.ItemId, BlockedByID: req.Msg.BlockedById, CreatedAt: time.Now()}
+	for _, e := range s.deps.Edges() {
+		if e.ItemID == dep.ItemID && e.BlockedByID == dep.BlockedByID {
+			dep = e
+			break
+		}
+	}
+	return connect.NewResponse(&sessionv1.AddBacklogDependencyResponse{
+		Dependency: backlogDependencyToProto(dep),
+	}), nil
+}
+
+// RemoveBacklogDependency deletes a "blocked by" edge.
+// +api: backlog:remove-dependency
+func (s *BacklogService) RemoveBacklogDependency(
+	ctx context.Context,
+	req *connect.Request[sessionv1.RemoveBacklogDependencyRequest],
+) (*connect.Response[sessionv1.RemoveBacklogDependencyResponse], error) {
+	if s.deps == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("backlog dependencies not available"))
+	}
+	if err := s.deps.RemoveDependency(req.Msg.ItemId, req.Msg.BlockedById); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove dependency: %w", err))
+	}
+	s.kickDispatcher()
+	return connect.NewResponse(&sessionv1.RemoveBacklogDependencyResponse{}), nil
+}
+
+// GetBacklogGraph returns the dependency graph with each item's blocked state.
+// +api: backlog:graph
+func (s *BacklogService) GetBacklogGraph(
+	ctx context.Context,
+	req *connect.Request[sessionv1.GetBacklogGraphRequest],
+) (*connect.Response[sessionv1.GetBacklogGraphResponse], error) {
+	if s.storage == nil || s.deps == nil {
+		return connect.NewResponse(&sessionv1.GetBacklogGraphResponse{}), nil
+	}
+
+	edges := s.deps.Edges()
+	members := make(map[string]bool)
+	if root := req.Msg.ItemId; root != "" {
+		members = connectedItems(root, edges)
+	} else {
+		for _, e := range edges {
+			members[e.ItemID] = true
+			members[e.BlockedByID] = true
+		}
+	}
+
+	items, err := s.storage.ListBacklogItems(ctx, session.BacklogItemFilter{SortBy: "priority"})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list backlog items: %w", err))
+	}
+	known := make(map[string]*session.BacklogItemData, len(items))
+	for i := range items {
+		known[items[i].ID] = &items[i]
+	}
+
+	resp := &sessionv1.GetBacklogGraphResponse{}
+	for i := range items {
+		item := &items[i]
+		if !members[item.ID] {
+			continue
+		}
+		pending := s.pendingBlockers(ctx, item.ID, known)
+		resp.Nodes = append(resp.Nodes, &sessionv1.BacklogGraphNode{
+			Item:              backlogItemToProto(item),
+			BlockedByIds:      s.deps.BlockedBy(item.ID),
+			PendingBlockerIds: pending,
+			Blocked:           len(pending) > 0,
+		})
+	}
+	for _, e := range edges {
+		if members[e.ItemID] && members[e.BlockedByID] {
+			resp.Edges = append(resp.Edges, backlogDependencyToProto(e))
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// connectedItems returns root plus every item reachable from it through
+// dependency edges in either direction.
+func connectedItems(root string, edges []session.BacklogDependency) map[string]bool {
+	adjacent := make(map[string][]string)
+	for _, e := range edges {
+		adjacent[e.ItemID] = append(adjacent[e.ItemID], e.BlockedByID)
+		adjacent[e.BlockedByID] = append(adjacent[e.BlockedByID], e.ItemID)
+	}
+	seen := map[string]bool{root: true}
+	queue := []string{root}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, n := range adjacent[id] {
+			if !seen[n] {
+				seen[n] = true
+				queue = append(queue, n)
+			}
+		}
+	}
+	return seen
+}
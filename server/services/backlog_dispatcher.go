@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session"
+)
+
+const (
+	// backlogDispatchInterval is how often the dispatcher re-evaluates the
+	// backlog when nothing kicks it.
+	backlogDispatchInterval = 30 * time.Second
+	// backlogDispatchRetryBackoff keeps an item whose spawn failed out of
+	// dispatch for a while so a broken item cannot hot-loop all night.
+	backlogDispatchRetryBackoff = 10 * time.Minute
+)
+
+// BacklogDispatcher drains the backlog automatically: whenever a repository
+// has fewer in-progress items than its WIP limit, it spawns work sessions for
+// the highest-priority ready items that are not blocked by unfinished items.
+// Dependents become eligible as soon as their blockers are done or pass review.
+//
+// It implements FeatureController for the "backlog_autodispatch" flag and is
+// idle until enabled.
+type BacklogDispatcher struct {
+	backlog  *BacklogService
+	settings func() config.BacklogDispatchConfig
+
+	enabled atomic.Bool
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	kick    chan struct{}
+
+	// passMu serializes dispatch passes so a kick during a tick cannot spawn
+	// the same item twice.
+	passMu     sync.Mutex
+	retryAfter map[string]time.Time
+}
+
+// NewBacklogDispatcher creates a disabled dispatcher. settings is consulted on
+// every pass so WIP limit edits apply without a restart.
+func NewBacklogDispatcher(backlog *BacklogService, settings func() config.BacklogDispatchConfig) *BacklogDispatcher {
+	return &BacklogDispatcher{
+		backlog:    backlog,
+		settings:   settings,
+		kick:       make(chan struct{}, 1),
+		retryAfter: make(map[string]time.Time),
+	}
+}
+
+// Enable starts the dispatch loop. Idempotent.
+func (d *BacklogDispatcher) Enable(_ context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cancel != nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.enabled.Store(true)
+	go d.run(ctx)
+	log.Info("backlog auto-dispatch enabled")
+	return nil
+}
+
+// Disable stops the dispatch loop. Sessions already spawned keep running.
+// Idempotent.
+func (d *BacklogDispatcher) Disable() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+	d.cancel = nil
+	d.enabled.Store(false)
+	log.Info("backlog auto-dispatch disabled")
+	return nil
+}
+
+// IsEnabled reports whether the dispatch loop is running.
+func (d *BacklogDispatcher) IsEnabled() bool {
+	return d.enabled.Load()
+}
+
+// Kick requests a dispatch pass as soon as possible. Never blocks.
+func (d *BacklogDispatcher) Kick() {
+	select {
+	case d.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (d *BacklogDispatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(backlogDispatchInterval)
+	defer ticker.Stop()
+	for {
+		d.DispatchOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.kick:
+		}
+	}
+}
+
+// DispatchOnce runs a single dispatch pass and returns the IDs of the items it
+// spawned sessions for.
+func (d *BacklogDispatcher) DispatchOnce(ctx context.Context) []string {
+	d.passMu.Lock()
+	defer d.passMu.Unlock()
+
+	storage := d.backlog.storage
+	if storage == nil {
+		return nil
+	}
+	items, err := storage.ListBacklogItems(ctx, session.BacklogItemFilter{
+		Statuses: []string{string(session.BacklogStatusReady), string(session.BacklogStatusInProgress)},
+		SortBy:   "priority",
+	})
+	if err != nil {
+		log.Warn("backlog dispatch: failed to list items", "err", err)
+		return nil
+	}
+
+	known := make(map[string]*session.BacklogItemData, len(items))
+	running := make(map[string]int)
+	for i := range items {
+		known[items[i].ID] = &items[i]
+		if session.BacklogStatus(items[i].Status) == session.BacklogStatusInProgress {
+			running[items[i].RepoPath]++
+		}
+	}
+
+	limits := d.settings()
+	now := time.Now()
+	var spawned []string
+	for i := range items {
+		item := &items[i]
+		if session.BacklogStatus(item.Status) != session.BacklogStatusReady {
+			continue
+		}
+		// Items SpawnSessionFromItem would reject are skipped rather than
+		// retried: they need a human (repo path, plan approval).
+		if item.RepoPath == "" || (!item.SkipPlanning && !item.PlanApproved) {
+			continue
+		}
+		if running[item.RepoPath] >= limits.LimitFor(item.RepoPath) {
+			continue
+		}
+		if retry, ok := d.retryAfter[item.ID]; ok && now.Before(retry) {
+			continue
+		}
+		if len(d.backlog.pendingBlockers(ctx, item.ID, known)) > 0 {
+			continue
+		}
+
+		resp, err := d.backlog.SpawnSessionFromItem(ctx, connect.NewRequest(&sessionv1.SpawnSessionFromItemRequest{
+			ItemId: item.ID,
+		}))
+		if err != nil {
+			d.retryAfter[item.ID] = now.Add(backlogDispatchRetryBackoff)
+			log.Warn("backlog dispatch: spawn failed; will retry later",
+				"item", item.ID, "title", item.Title, "retry_in", backlogDispatchRetryBackoff, "err", err)
+			continue
+		}
+		delete(d.retryAfter, item.ID)
+		running[item.RepoPath]++
+		spawned = append(spawned, item.ID)
+		log.Info("backlog dispatch: started item",
+			"item", item.ID, "title", item.Title, "repo", item.RepoPath,
+			"session", resp.Msg.SessionUuid, "wip", running[item.RepoPath])
+	}
+	return spawned
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/session"
+)
+
+// fakeSessionCreator records spawned sessions without starting any processes.
+type fakeSessionCreator struct {
+	mu     sync.Mutex
+	titles []string
+	dir    string
+}
+
+func (f *fakeSessionCreator) CreateDirectorySession(_ context.Context, title, _, _ string, _ []string, _ bool) (*session.Instance, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.titles = append(f.titles, title)
+	return &session.Instance{Title: title, UUID: fmt.Sprintf("uuid-%d", len(f.titles)), Path: f.dir}, nil
+}
+
+// newDispatchableItem creates a ready item in repo that can be spawned without planning.
+func newDispatchableItem(t *testing.T, svc *BacklogService, title, repo string, priority int32) string {
+	t.Helper()
+	resp, err := svc.CreateBacklogItem(t.Context(), connect.NewRequest(&sessionv1.CreateBacklogItemRequest{
+		Title:              title,
+		Priority:           priority,
+		RepoPath:           repo,
+		SkipPlanning:       true,
+		AcceptanceCriteria: []*sessionv1.AcCriterion{{Index: 0, Text: "works", Status: "pending"}},
+	}))
+	require.NoError(t, err)
+	_, err = svc.TransitionBacklogItemStatus(t.Context(), connect.NewRequest(&sessionv1.TransitionBacklogItemStatusRequest{
+		ItemId:       resp.Msg.Item.Id,
+		TargetStatus: string(session.BacklogStatusReady),
+	}))
+	require.NoError(t, err)
+	return resp.Msg.Item.Id
+}
+
+func TestBacklogDispatcher_RespectsWIPLimitsAndDependencies(t *testing.T) {
+	creator := &fakeSessionCreator{dir: t.TempDir()}
+	svc := NewBacklogService(createTestStorage(t), creator, nil)
+	deps, err := session.NewBacklogDependencyStore("")
+	require.NoError(t, err)
+	svc.SetDependencyStore(deps)
+
+	limits := config.BacklogDispatchConfig{WIPLimit: 1, RepoWIPLimits: map[string]int{"/repo/b": 2}}
+	d := NewBacklogDispatcher(svc, func() config.BacklogDispatchConfig { return limits })
+
+	first := newDispatchableItem(t, svc, "first", "/repo/a", 1)
+	second := newDispatchableItem(t, svc, "second", "/repo/a", 2)
+	blocked := newDispatchableItem(t, svc, "blocked", "/repo/b", 1)
+	free := newDispatchableItem(t, svc, "free", "/repo/b", 3)
+	_, err = svc.AddBacklogDependency(t.Context(), connect.NewRequest(&sessionv1.AddBacklogDependencyRequest{
+		ItemId: blocked, BlockedById: first,
+	}))
+	require.NoError(t, err)
+
+	// /repo/a takes one item; /repo/b's blocked item is skipped in favour of
+	// the lower-priority free one.
+	assert.ElementsMatch(t, []string{first, free}, d.DispatchOnce(t.Context()))
+	assert.Empty(t, d.DispatchOnce(t.Context()), "WIP limits are full or items blocked")
+
+	next, err := svc.SuggestNextItem(t.Context(), connect.NewRequest(&sessionv1.SuggestNextItemRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, second, next.Msg.Item.Id, "blocked items are never suggested")
+
+	// Finishing the blocker frees /repo/a and unblocks its dependent.
+	storage := svc.storage
+	_, err = storage.TransitionBacklogItemStatus(t.Context(), first, session.BacklogStatusReview, nil)
+	require.NoError(t, err)
+	_, err = storage.TransitionBacklogItemStatus(t.Context(), first, session.BacklogStatusDone, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{second, blocked}, d.DispatchOnce(t.Context()))
+	assert.Len(t, creator.titles, 4)
+}
+
+func TestBacklogDependencyRPCs(t *testing.T) {
+	svc := NewBacklogService(createTestStorage(t), nil, nil)
+	deps, err := session.NewBacklogDependencyStore("")
+	require.NoError(t, err)
+	svc.SetDependencyStore(deps)
+
+	a := newDispatchableItem(t, svc, "a", "/repo", 1)
+	b := newDispatchableItem(t, svc, "b", "/repo", 1)
+	c := newDispatchableItem(t, svc, "c", "/repo", 1)
+	unrelated := newDispatchableItem(t, svc, "unrelated", "/repo", 1)
+
+	add := func(item, blocker string) error {
+		_, err := svc.AddBacklogDependency(t.Context(), connect.NewRequest(&sessionv1.AddBacklogDependencyRequest{
+			ItemId: item, BlockedById: blocker,
+		}))
+		return err
+	}
+	require.NoError(t, add(b, a))
+	require.NoError(t, add(c, b))
+
+	err = add(a, c)
+	var connErr *connect.Error
+	require.ErrorAs(t, err, &connErr)
+	assert.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+
+	err = add(a, "00000000-0000-0000-0000-000000000000")
+	require.ErrorAs(t, err, &connErr)
+	assert.Equal(t, connect.CodeNotFound, connErr.Code())
+
+	graph, err := svc.GetBacklogGraph(t.Context(), connect.NewRequest(&sessionv1.GetBacklogGraphRequest{ItemId: c}))
+	require.NoError(t, err)
+	assert.Len(t, graph.Msg.Edges, 2)
+	blockedByID := make(map[string]bool)
+	for _, n := range graph.Msg.Nodes {
+		assert.NotEqual(t, unrelated, n.Item.Id)
+		blockedByID[n.Item.Id] = n.Blocked
+	}
+	assert.Equal(t, map[string]bool{a: false, b: true, c: true}, blockedByID)
+
+	_, err = svc.RemoveBacklogDependency(t.Context(), connect.NewRequest(&sessionv1.RemoveBacklogDependencyRequest{
+		ItemId: c, BlockedById: b,
+	}))
+	require.NoError(t, err)
+	graph, err = svc.GetBacklogGraph(t.Context(), connect.NewRequest(&sessionv1.GetBacklogGraphRequest{}))
+	require.NoError(t, err)
+	assert.Len(t, graph.Msg.Edges, 1)
+	assert.Len(t, graph.Msg.Nodes, 2)
+}
+
+func TestBacklogDependencies_RemovedWithArchivedItems(t *testing.T) {
+	svc := NewBacklogService(createTestStorage(t), &fakeSessionCreator{dir: t.TempDir()}, nil)
+	deps, err := session.NewBacklogDependencyStore("")
+	require.NoError(t, err)
+	svc.SetDependencyStore(deps)
+
+	a := newDispatchableItem(t, svc, "a", "/repo", 1)
+	b := newDispatchableItem(t, svc, "b", "/repo", 2)
+	c := newDispatchableItem(t, svc, "c", "/repo", 3)
+	require.NoError(t, deps.AddDependency(b, a))
+	require.NoError(t, deps.AddDependency(c, b))
+
+	_, err = svc.ArchiveBacklogItem(t.Context(), connect.NewRequest(&sessionv1.ArchiveBacklogItemRequest{ItemId: b}))
+	require.NoError(t, err)
+	assert.Empty(t, deps.Edges(), "both edges named the archived item")
+	assert.Empty(t, svc.pendingBlockers(t.Context(), c, nil))
+
+	// Edges left behind by items that vanished are pruned when the store is wired.
+	stale, err := session.NewBacklogDependencyStore("")
+	require.NoError(t, err)
+	require.NoError(t, stale.AddDependency(c, a))
+	require.NoError(t, stale.AddDependency(a, "00000000-0000-0000-0000-000000000000"))
+	require.NoError(t, stale.AddDependency(c, b))
+	svc.SetDependencyStore(stale)
+	assert.Equal(t, []string{a}, stale.BlockedBy(c))
+	assert.Empty(t, stale.BlockedBy(a))
+}
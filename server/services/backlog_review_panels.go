@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/session"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultReviewPanelLimit bounds ListReviewPanels and GetReviewRubricTrend
+// when the request does not.
+const defaultReviewPanelLimit = 200
+
+// SetReviewPanelStore wires the review panel history. Without it the panel
+// RPCs return empty results.
+func (s *BacklogService) SetReviewPanelStore(panels *session.ReviewPanelStore) {
+	s.panels = panels
+}
+
+// ListReviewPanels returns review-gate panel runs, newest first.
+// +api: backlog:review-panels
+func (s *BacklogService) ListReviewPanels(
+	ctx context.Context,
+	req *connect.Request[sessionv1.ListReviewPanelsRequest],
+) (*connect.Response[sessionv1.ListReviewPanelsResponse], error) {
+	resp := &sessionv1.ListReviewPanelsResponse{}
+	if s.panels == nil {
+		return connect.NewResponse(resp), nil
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultReviewPanelLimit
+	}
+	for _, run := range s.panels.List(req.Msg.ItemId, req.Msg.RepoPath, false, limit) {
+		resp.Runs = append(resp.Runs, reviewPanelRunToProto(run))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetReviewRubricTrend returns the rubric scores of the most recent decided
+// panel runs, oldest first, for trending on the dashboard.
+// +api: backlog:review-rubric-trend
+func (s *BacklogService) GetReviewRubricTrend(
+	ctx context.Context,
+	req *connect.Request[sessionv1.GetReviewRubricTrendRequest],
+) (*connect.Response[sessionv1.GetReviewRubricTrendResponse], error) {
+	resp := &sessionv1.GetReviewRubricTrendResponse{}
+	if s.panels == nil {
+		return connect.NewResponse(resp), nil
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultReviewPanelLimit
+	}
+
+	var scored []*session.ReviewPanelRun
+	for _, run := range s.panels.List("", req.Msg.RepoPath, true, 0) {
+		if run.Rubric != nil {
+			scored = append(scored, run)
+			if len(scored) == limit {
+				break
+			}
+		}
+	}
+
+	var sum session.RubricScores
+	for i := len(scored) - 1; i >= 0; i-- {
+		run := scored[i]
+		resp.Points = append(resp.Points, &sessionv1.RubricTrendPoint{
+			RunId:             run.ID,
+			ItemId:            run.ItemID,
+			Outcome:           run.Outcome,
+			Rubric:            rubricToProto(run.Rubric),
+			DecidedAt:         timestamppb.New(*run.DecidedAt),
+			ReviewerCount:     int32(len(run.Reviewers)),
+			DisagreementCount: int32(len(run.Disagreements)),
+		})
+		sum.Correctness += run.Rubric.Correctness
+		sum.Tests += run.Rubric.Tests
+		sum.Style += run.Rubric.Style
+		sum.Security += run.Rubric.Security
+	}
+	if n := float64(len(scored)); n > 0 {
+		resp.Average = rubricToProto(&session.RubricScores{
+			Correctness: sum.Correctness / n,
+			Tests:       sum.Tests / n,
+			Style:       sum.Style / n,
+			Security:    sum.Security / n,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// attachReviewChecks fills each review verdict's deterministic check results
+// from the panel run its review session belongs to.
+func (s *BacklogService) attachReviewChecks(item *sessionv1.BacklogItem) {
+	if s.panels == nil {
+		return
+	}
+	for _, is := range item.ItemSessions {
+		if is.ReviewVerdict == nil {
+			continue
+		}
+		if run, err := s.panels.RunForSession(is.SessionUuid); err == nil {
+			is.ReviewVerdict.Checks = reviewChecksToProto(run.Checks)
+		}
+	}
+}
+
+func reviewChecksToProto(results []session.ReviewCheckResult) []*sessionv1.ReviewCheckResult {
+	out := make([]*sessionv1.ReviewCheckResult, len(results))
+	for i, r := range results {
+		out[i] = &sessionv1.ReviewCheckResult{
+			Name:       r.Name,
+			Kind:       r.Kind,
+			Passed:     r.Passed,
+			Hard:       r.Hard,
+			Output:     r.Output,
+			DurationMs: r.Duration.Milliseconds(),
+		}
+	}
+	return out
+}
+
+func rubricToProto(r *session.RubricScores) *sessionv1.RubricScores {
+	if r == nil {
+		return nil
+	}
+	return &sessionv1.RubricScores{
+		Correctness: r.Correctness,
+		Tests:       r.Tests,
+		Style:       r.Style,
+		Security:    r.Security,
+	}
+}
+
+func criterionVerdictsToProto(cvs []session.CriterionVerdict) []*sessionv1.CriterionVerdict {
+	out := make([]*sessionv1.CriterionVerdict, len(cvs))
+	for i, cv := range cvs {
+		out[i] = &sessionv1.CriterionVerdict{
+			CriterionIndex: int32(cv.CriterionIndex),
+			Outcome:        cv.Outcome,
+			Evidence:       cv.Evidence,
+		}
+	}
+	return out
+}
+
+func reviewPanelRunToProto(run *session.ReviewPanelRun) *sessionv1.ReviewPanelRun {
+	p := &sessionv1.ReviewPanelRun{
+		Id:            run.ID,
+		ItemId:        run.ItemID,
+		RepoPath:      run.RepoPath,
+		DiffHash:      run.DiffHash,
+		Consensus:     string(run.Consensus),
+		PassThreshold: run.PassThreshold,
+		Outcome:       run.Outcome,
+		Rubric:        rubricToProto(run.Rubric),
+		Checks:        reviewChecksToProto(run.Checks),
+		CreatedAt:     timestamppb.New(run.CreatedAt),
+	}
+	if run.DecidedAt != nil {
+		p.DecidedAt = timestamppb.New(*run.DecidedAt)
+	}
+	for _, rv := range run.Reviewers {
+		pv := &sessionv1.PanelReviewerVerdict{
+			Name:        rv.Name,
+			Program:     rv.Program,
+			Weight:      rv.Weight,
+			SessionUuid: rv.SessionUUID,
+		}
+		if v := rv.Verdict; v != nil {
+			pv.Outcome = v.Outcome
+			pv.Summary = v.Summary
+			pv.PerCriterion = criterionVerdictsToProto(v.PerCriterion)
+			pv.Rubric = rubricToProto(v.Rubric)
+			pv.SubmittedAt = timestamppb.New(v.SubmittedAt)
+		}
+		p.Reviewers = append(p.Reviewers, pv)
+	}
+	for _, d := range run.Disagreements {
+		p.Disagreements = append(p.Disagreements, &sessionv1.ReviewDisagreement{
+			CriterionIndex: int32(d.CriterionIndex),
+			Outcomes:       d.Outcomes,
+		})
+	}
+	return p
+}
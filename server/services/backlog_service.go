@@ -26,6 +26,13 @@ type SessionCreator interface {
 	CreateDirectorySession(ctx context.Context, title, path, appendSystemPrompt string, tags []string, oneShot bool) (*session.Instance, error)
 }
 
+// TemplateSessionCreator is implemented by SessionCreators that can also
+// create sessions from templates. SpawnSessionFromItem needs it when a
+// template is requested.
+type TemplateSessionCreator interface {
+	CreateTemplateSession(ctx context.Context, spawn TemplateSpawn) (*TemplateSession, error)
+}
+
 // itemSourceBackend is a narrow interface for item source persistence; satisfied by *session.Storage.
 type itemSourceBackend interface {
 	CreateItemSource(ctx context.Context, data session.ItemSourceData) (*session.ItemSourceData, error)
@@ -42,6 +49,12 @@ type BacklogService struct {
 	// concurrent SpawnSessionFromItem / AttachSessionToItem calls cannot produce
 	// a partially-written .claude/backlog-context.md.
 	worktreeMu sync.Mutex
+	// deps is the "blocked by" graph between items; nil disables dependencies.
+	deps *session.BacklogDependencyStore
+	// dispatcher is kicked when an item may have been unblocked; may be nil.
+	dispatcher *BacklogDispatcher
+	// panels holds review-gate panel runs and rubric scores; may be nil.
+	panels *session.ReviewPanelStore
 }
 
 // NewBacklogService creates a BacklogService with all optional dependencies.
@@ -342,9 +355,9 @@ func (s *BacklogService) GetBacklogItem(
 		item.ItemSessions = isSessions
 	}
 
-	return connect.NewResponse(&sessionv1.GetBacklogItemResponse{
-		Item: backlogItemToProto(item),
-	}), nil
+	resp := &sessionv1.GetBacklogItemResponse{Item: backlogItemToProto(item)}
+	s.attachReviewChecks(resp.Item)
+	return connect.NewResponse(resp), nil
 }
 
 // --- ListBacklogItems ---
@@ -482,6 +495,13 @@ func (s *BacklogService) ArchiveBacklogItem(
 		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to archive backlog item: %w", err))
 	}
+	if s.deps != nil {
+		if err := s.deps.RemoveItem(archived.ID); err != nil {
+			log.Warn("backlog: failed to remove dependencies of archived item", "item", archived.ID, "err", err)
+		}
+		// Items it blocked may be ready now.
+		s.kickDispatcher()
+	}
 
 	return connect.NewResponse(&sessionv1.ArchiveBacklogItemResponse{
 		Item: backlogItemToProto(archived),
@@ -564,6 +584,10 @@ func (s *BacklogService) TransitionBacklogItemStatus(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to transition backlog item: %w", err))
 	}
 
+	if to == session.BacklogStatusDone {
+		s.kickDispatcher()
+	}
+
 	return connect.NewResponse(&sessionv1.TransitionBacklogItemStatusResponse{
 		Item: backlogItemToProto(updated),
 	}), nil
@@ -769,6 +793,12 @@ func (s *BacklogService) SpawnSessionFromItem(
 			fmt.Errorf("run TriggerTriage and approve the plan before spawning; set skip_planning=true to bypass"))
 	}
 
+	// 3a. Dependency gate.
+	if pending := s.pendingBlockers(ctx, item.ID, nil); len(pending) > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("item is blocked by unfinished items %s", strings.Join(pending, ", ")))
+	}
+
 	// 4. Repo path required.
 	if item.RepoPath == "" {
 		return nil, connect.NewError(connect.CodeFailedPrecondition,
@@ -816,16 +846,44 @@ func (s *BacklogService) SpawnSessionFromItem(
 	title := "backlog:" + slugify(item.Title)
 
 	// 10. Spawn session first so we have the real UUID before creating the ItemSession record.
-	inst, err := s.sessionCreator.CreateDirectorySession(ctx, title, item.RepoPath, prompt,
-		[]string{"backlog:work"}, false)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to spawn session: %w", err))
+	// With a template, the item context follows the template's prompt.
+	var sessionUUID, worktreePath string
+	if req.Msg.Template != "" {
+		creator, ok := s.sessionCreator.(TemplateSessionCreator)
+		if !ok {
+			return nil, connect.NewError(connect.CodeUnimplemented,
+				fmt.Errorf("session templates are not available"))
+		}
+		created, err := creator.CreateTemplateSession(ctx, TemplateSpawn{
+			Template:  req.Msg.Template,
+			RepoPath:  item.RepoPath,
+			Title:     title,
+			Variables: req.Msg.TemplateVariables,
+			Defaults: map[string]string{
+				"item_id":          item.ID,
+				"item_title":       item.Title,
+				"item_description": item.Description,
+			},
+			Tags:         []string{"backlog:work"},
+			PromptSuffix: "\n\n" + prompt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sessionUUID, worktreePath = created.Session.Id, created.Session.Path
+	} else {
+		inst, err := s.sessionCreator.CreateDirectorySession(ctx, title, item.RepoPath, prompt,
+			[]string{"backlog:work"}, false)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to spawn session: %w", err))
+		}
+		sessionUUID, worktreePath = inst.UUID, inst.Path
 	}
 
 	// 11. Create ItemSession with the real session UUID (avoids "<pending>" orphan records on failure).
 	is, err := s.storage.CreateItemSession(ctx, session.ItemSessionData{
 		ItemID:      item.ID,
-		SessionUUID: inst.UUID,
+		SessionUUID: sessionUUID,
 		SessionRole: session.SessionRoleWork,
 		AcSnapshot:  acSnapshot,
 	})
@@ -835,7 +893,6 @@ func (s *BacklogService) SpawnSessionFromItem(
 
 	// 12. Write slash commands and context file synchronously under a mutex so
 	// concurrent spawn calls cannot interleave writes to the same worktree path.
-	worktreePath := inst.Path
 	s.worktreeMu.Lock()
 	if wErr := session.WriteSlashCommands(entItem, worktreePath); wErr != nil {
 		s.worktreeMu.Unlock()
@@ -853,7 +910,7 @@ func (s *BacklogService) SpawnSessionFromItem(
 	}
 
 	return connect.NewResponse(&sessionv1.SpawnSessionFromItemResponse{
-		SessionUuid: inst.UUID,
+		SessionUuid: sessionUUID,
 		ItemSession: itemSessionToProto(is),
 	}), nil
 }
@@ -1111,15 +1168,17 @@ func (s *BacklogService) SuggestNextItem(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list backlog items: %w", err))
 	}
 
-	if len(items) == 0 {
-		// No ready items — return empty response.
-		return connect.NewResponse(&sessionv1.SuggestNextItemResponse{}), nil
+	// Skip items still waiting on their blockers.
+	for i := range items {
+		if len(s.pendingBlockers(ctx, items[i].ID, nil)) == 0 {
+			return connect.NewResponse(&sessionv1.SuggestNextItemResponse{
+				Item: backlogItemToProto(&items[i]),
+			}), nil
+		}
 	}
 
-	top := &items[0]
-	return connect.NewResponse(&sessionv1.SuggestNextItemResponse{
-		Item: backlogItemToProto(top),
-	}), nil
+	// No ready, unblocked items — return empty response.
+	return connect.NewResponse(&sessionv1.SuggestNextItemResponse{}), nil
 }
 
 // OverrideVerdict manually overrides a review verdict for an item session.
@@ -1174,6 +1233,10 @@ func (s *BacklogService) OverrideVerdict(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save review verdict: %w", verdictErr))
 	}
 
+	if outcome == session.ReviewVerdictPass {
+		s.kickDispatcher()
+	}
+
 	// 5. Transition item to target status if valid (validate via state machine).
 	var updatedItem *session.BacklogItemData
 	if req.Msg.ToStatus != "" {
@@ -13,12 +13,18 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/tstapler/stapler-squad/executor/safeexec"
 	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
 	"github.com/tstapler/stapler-squad/gen/proto/go/session/v1/sessionv1connect"
 	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/pkg/metrics"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+	"github.com/tstapler/stapler-squad/server/auth"
+	"github.com/tstapler/stapler-squad/server/framestream"
 	"github.com/tstapler/stapler-squad/server/protocol"
+	"github.com/tstapler/stapler-squad/server/ssp"
 	"github.com/tstapler/stapler-squad/session"
 	"github.com/tstapler/stapler-squad/session/scrollback"
 	"google.golang.org/protobuf/proto"
@@ -96,6 +102,11 @@ const (
 	ansiSnapshotPrefix = ansiDECSTR + ansiEraseScreen + ansiCursorHome
 )
 
+// streamRedactFlushDelay is how long the secret redactor may hold back the
+// tail of a frame waiting for the rest of a possibly split secret. Output that
+// is followed by more output within this window is never delayed.
+const streamRedactFlushDelay = 50 * time.Millisecond
+
 // sanitizeInitialContent removes cursor-positioning and screen-control escape sequences
 // from tmux capture-pane output before it is sent as the initial terminal snapshot.
 // Without this, the captured content's absolute cursor positions conflict with the
@@ -113,8 +124,10 @@ func sanitizeInitialContent(content string) string {
 // is enabled. Since LNM state is uncertain (DECSTR in ansiSnapshotPrefix resets it
 // to OFF), we normalize every \n to \r\n so rows always start at column 0
 // regardless of terminal mode state.
+//
+// Secrets in the snapshot are redacted (see session.RedactTerminalOutput).
 func prepareSnapshotContent(content string) string {
-	sanitized := sanitizeInitialContent(content)
+	sanitized := sanitizeInitialContent(string(session.RedactTerminalOutput([]byte(content))))
 	// Avoid creating \r\r\n from any pre-existing \r\n pairs.
 	sanitized = strings.ReplaceAll(sanitized, "\r\n", "\n")
 	return strings.ReplaceAll(sanitized, "\n", "\r\n")
@@ -151,7 +164,8 @@ type sessionSnapshot struct {
 type ConnectRPCWebSocketHandler struct {
 	sessionService    *SessionService
 	scrollbackManager *scrollback.ScrollbackManager
-	streamingMode     string // "raw", "state", or "hybrid"
+	streamingMode     string // "raw", "state", or "hybrid"; guarded by streamingModeMu
+	streamingModeMu   sync.RWMutex
 
 	// External session support (for unified WebSocket streaming)
 	externalDiscovery   *session.ExternalSessionDiscovery
@@ -160,23 +174,87 @@ type ConnectRPCWebSocketHandler struct {
 	// Snapshot cache for cold-start terminal content
 	snapshotCache   map[string]sessionSnapshot
 	snapshotCacheMu sync.RWMutex
+
+	metrics wsMetrics // zero value records nothing; see SetMetrics
+
+	shares *auth.ShareManager // nil when share links are unavailable; see SetShareManager
+
+	// One SSP coordinator per streamed session; arbitrates input between its clients
+	coordinators *ssp.Registry
+
+	// Joins claude-mux sessions' own terminals to their coordinators
+	localInput localInputGates
+
+	// Per-session frame streams for clients negotiating the "frames" mode;
+	// nil without a scrollback manager, which holds the frames for resume
+	frames *framestream.Hub
 }
 
 // NewConnectRPCWebSocketHandler creates a new ConnectRPC WebSocket handler
 // tmuxStreamerManager is required for ALL sessions (managed and external) since they all use tmux capture-pane polling
 func NewConnectRPCWebSocketHandler(sessionService *SessionService, scrollbackManager *scrollback.ScrollbackManager, tmuxStreamerManager *session.ExternalTmuxStreamerManager, streamingMode string) *ConnectRPCWebSocketHandler {
-	// Default to raw-compressed if not specified or invalid
-	if streamingMode != "raw" && streamingMode != "raw-compressed" && streamingMode != "state" && streamingMode != "hybrid" {
-		streamingMode = "raw-compressed"
-	}
-
-	return &ConnectRPCWebSocketHandler{
+	h := &ConnectRPCWebSocketHandler{
 		sessionService:      sessionService,
 		scrollbackManager:   scrollbackManager,
 		tmuxStreamerManager: tmuxStreamerManager,
-		streamingMode:       streamingMode,
+		streamingMode:       normalizeStreamingMode(streamingMode),
 		snapshotCache:       make(map[string]sessionSnapshot),
+		coordinators:        ssp.NewRegistry(ssp.DefaultConfig()),
+	}
+
+	if scrollbackManager != nil {
+		cfg := framestream.DefaultConfig()
+		cfg.RedactFlushDelay = streamRedactFlushDelay
+		cfg.NewRedactor = func() *secrets.StreamRedactor {
+			if !session.TerminalRedactionEnabled() {
+				return nil
+			}
+			return session.SecretDetector().NewStreamRedactor()
+		}
+		h.frames = framestream.NewHub(scrollbackManager, cfg)
+	}
+
+	return h
+}
+
+// Coordinators returns the per-session coordinators that arbitrate input
+// between clients, so other terminal transports can share them.
+func (h *ConnectRPCWebSocketHandler) Coordinators() *ssp.Registry {
+	return h.coordinators
+}
+
+// SetMetrics registers the handler's client and byte metrics on reg.
+func (h *ConnectRPCWebSocketHandler) SetMetrics(reg *metrics.Registry) {
+	h.metrics = newWSMetrics(reg)
+}
+
+// SetShareManager lets clients authorized by a share link stream the linked
+// session. Without it such clients are refused.
+func (h *ConnectRPCWebSocketHandler) SetShareManager(shares *auth.ShareManager) {
+	h.shares = shares
+}
+
+// SetStreamingMode changes the default streaming mode for streams opened from
+// now on. Streams already running keep their mode.
+func (h *ConnectRPCWebSocketHandler) SetStreamingMode(mode string) {
+	h.streamingModeMu.Lock()
+	defer h.streamingModeMu.Unlock()
+	h.streamingMode = normalizeStreamingMode(mode)
+}
+
+func (h *ConnectRPCWebSocketHandler) defaultStreamingMode() string {
+	h.streamingModeMu.RLock()
+	defer h.streamingModeMu.RUnlock()
+	return h.streamingMode
+}
+
+// normalizeStreamingMode defaults an empty or unknown mode to raw-compressed.
+func normalizeStreamingMode(mode string) string {
+	switch mode {
+	case "raw", "raw-compressed", "state", "hybrid":
+		return mode
 	}
+	return "raw-compressed"
 }
 
 // waitForQuiescence waits until no updates arrive for quietFor duration, or timeout elapses.
@@ -312,6 +390,8 @@ func (h *ConnectRPCWebSocketHandler) HandleWebSocket(w http.ResponseWriter, r *h
 		return
 	}
 	defer conn.Close()
+	h.metrics.clients.Inc()
+	defer h.metrics.clients.Dec()
 
 	log.Info("ConnectRPC WebSocket connection established")
 
@@ -353,6 +433,33 @@ func (h *ConnectRPCWebSocketHandler) HandleWebSocket(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Clients admitted by a share link count against its viewer cap and are
+	// disconnected as soon as the link is revoked or expires.
+	var share *auth.ShareLink
+	if link, ok := auth.ShareFromContext(r.Context()); ok {
+		if h.shares == nil {
+			sendErrorResponse(conn, auth.ErrShareNotFound.Error())
+			return
+		}
+		_, ended, leave, err := h.shares.Join(link.ID, r.RemoteAddr)
+		if err != nil {
+			log.Warn("share viewer refused", "link", link.ID, "addr", r.RemoteAddr, "err", err)
+			sendErrorResponse(conn, err.Error())
+			return
+		}
+		defer leave()
+		streamDone := make(chan struct{})
+		defer close(streamDone)
+		go func() {
+			select {
+			case <-ended:
+				conn.Close()
+			case <-streamDone:
+			}
+		}()
+		share = &link
+	}
+
 	// Send response headers (text format with Status-Code header)
 	responseHeaders := "Status-Code: 200\r\nContent-Type: application/proto\r\n\r\n"
 	if err := conn.WriteMessage(websocket.TextMessage, []byte(responseHeaders)); err != nil {
@@ -385,6 +492,15 @@ func (h *ConnectRPCWebSocketHandler) HandleWebSocket(w http.ResponseWriter, r *h
 	stream := &connectWebSocketStream{
 		conn:       conn,
 		requestMsg: envelope.Data,
+		sent:       h.metrics.sent,
+		share:      share,
+		label:      r.RemoteAddr,
+	}
+	if share != nil {
+		stream.label = "share: " + share.Label
+		if share.Label == "" {
+			stream.label = "share link " + share.ID
+		}
 	}
 
 	// Call StreamTerminal, then send EndStream while the WebSocket is still open.
@@ -402,14 +518,37 @@ func (h *ConnectRPCWebSocketHandler) HandleWebSocket(w http.ResponseWriter, r *h
 type connectWebSocketStream struct {
 	conn       *websocket.Conn
 	requestMsg []byte
-	writeMutex sync.Mutex // Protects concurrent writes to WebSocket
+	writeMutex sync.Mutex       // Protects concurrent writes to WebSocket
+	sent       *metrics.Counter // optional: counts bytes written
+	share      *auth.ShareLink  // non-nil when the client was admitted by a share link
+
+	// Input arbitration: set by streamTerminal once the session is resolved
+	label    string           // shown to the session's other clients
+	clientID string           // this client's ID in coord
+	coord    *ssp.Coordinator // nil before the session is resolved
+}
+
+// canInput reports whether the client may type into the session. Share-link
+// viewers may only when the link allows it.
+func (s *connectWebSocketStream) canInput() bool {
+	return s.share == nil || s.share.AllowInput
+}
+
+// canResize reports whether the client may resize the session's terminal.
+// Share-link viewers never may: the owner's layout wins.
+func (s *connectWebSocketStream) canResize() bool {
+	return s.share == nil
 }
 
 // WriteMessage safely writes a message to the WebSocket with mutex protection
 func (s *connectWebSocketStream) WriteMessage(messageType int, data []byte) error {
 	s.writeMutex.Lock()
 	defer s.writeMutex.Unlock()
-	return s.conn.WriteMessage(messageType, data)
+	if err := s.conn.WriteMessage(messageType, data); err != nil {
+		return err
+	}
+	s.sent.Add(float64(len(data)))
+	return nil
 }
 
 // streamTerminal handles the StreamTerminal RPC method
@@ -424,7 +563,7 @@ func (h *ConnectRPCWebSocketHandler) streamTerminal(stream *connectWebSocketStre
 	log.Info("StreamTerminal called", "session", sessionID)
 
 	// Extract streaming mode from initial request (will be overridden by CurrentPaneRequest if provided)
-	streamingMode := h.streamingMode // Use handler's default
+	streamingMode := h.defaultStreamingMode()
 	log.Info("initial streaming mode", "session", sessionID, "mode", streamingMode)
 
 	// Resolve session using unified resolution strategy
@@ -433,6 +572,28 @@ func (h *ConnectRPCWebSocketHandler) streamTerminal(stream *connectWebSocketStre
 	if instance == nil {
 		return fmt.Errorf("session not found: %s", sessionID)
 	}
+	if stream.share != nil && !instance.MatchesID(stream.share.SessionID) {
+		return auth.ErrShareWrongSession
+	}
+
+	// Every client of a session shares its coordinator, which decides who may
+	// type and tells each client who else is attached.
+	coord, release := h.coordinators.Acquire(instance.Title)
+	defer release()
+	stream.clientID = uuid.NewString()
+	stream.coord = coord
+	_, changed := coord.RegisterClient(stream.clientID, nil, ssp.ClientInfo{
+		Label:    stream.label,
+		ReadOnly: !stream.canInput(),
+		Owner:    stream.share == nil,
+	})
+	defer coord.UnregisterClient(stream.clientID)
+	if meta := instance.ExternalMetadata; meta != nil && meta.MuxSocketPath != "" {
+		defer h.localInput.attach(instance.Title, meta.MuxSocketPath, coord)()
+	}
+	stopInputControl := make(chan struct{})
+	defer close(stopInputControl)
+	go streamInputControlUpdates(stream, sessionID, changed, stopInputControl)
 
 	// Check for control mode feature flag (real-time streaming) - DEFAULT TO ENABLED
 	// Control mode uses tmux's native -C flag for structured real-time notifications
@@ -523,14 +684,23 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 		}
 	}
 
+	// In "frames" mode the session's frame stream owns control mode: it keeps
+	// recording output after this client leaves so a reconnect can resume.
+	useFrames := currentPaneReq.GetStreamingMode() == "frames" && h.frames != nil
+	if currentPaneReq.GetStreamingMode() == "frames" && !useFrames {
+		log.Warn("[streamViaControlMode] frames mode unavailable without scrollback, falling back to raw", "session", sessionID)
+	}
+
 	if err := streamer.StartControlMode(); err != nil {
 		return fmt.Errorf("failed to start control mode: %w", err)
 	}
-	defer func() {
-		if err := streamer.StopControlMode(); err != nil {
-			log.Warn("[streamViaControlMode] StopControlMode error", "err", err)
-		}
-	}()
+	if !useFrames {
+		defer func() {
+			if err := streamer.StopControlMode(); err != nil {
+				log.Warn("[streamViaControlMode] StopControlMode error", "err", err)
+			}
+		}()
+	}
 
 	// Subscribe for quiescence detection (separate subscription from the streaming one below)
 	quiescenceSubID, quiescenceUpdateChan := streamer.SubscribeControlModeUpdates()
@@ -544,7 +714,9 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 		}
 	}()
 
-	if currentPaneReq.TargetCols != nil && currentPaneReq.TargetRows != nil {
+	if !stream.canResize() {
+		log.Info("[streamViaControlMode] share-link viewer, keeping current pane size", "session", sessionID)
+	} else if currentPaneReq.TargetCols != nil && currentPaneReq.TargetRows != nil {
 		targetCols := int(*currentPaneReq.TargetCols)
 		targetRows := int(*currentPaneReq.TargetRows)
 
@@ -580,14 +752,34 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 	// If capture fails (session died), proceed with empty content rather than trying
 	// to restart — automatic restarts can create reconnection loops when the session
 	// exits immediately (e.g. no API proxy running).
-	initialContent, err := h.getOrRefreshSnapshot(sessionID, func() (string, error) {
-		return instance.CapturePaneContentRaw()
-	})
-	if err != nil {
-		log.Info("[streamViaControlMode] capture-pane failed, sending stopped notice", "session", sessionID, "err", err)
-		// Send a visible notice instead of leaving the terminal blank so the user
-		// knows why there is no output (session stopped, not a connection failure).
-		initialContent = "\r\n\x1b[33m[session stopped — no terminal content available]\x1b[0m\r\n"
+	captureInitialContent := func() string {
+		content, err := h.getOrRefreshSnapshot(sessionID, func() (string, error) {
+			return instance.CapturePaneContentRaw()
+		})
+		if err != nil {
+			log.Info("[streamViaControlMode] capture-pane failed, sending stopped notice", "session", sessionID, "err", err)
+			// Send a visible notice instead of leaving the terminal blank so the user
+			// knows why there is no output (session stopped, not a connection failure).
+			content = "\r\n\x1b[33m[session stopped — no terminal content available]\x1b[0m\r\n"
+		}
+		return content
+	}
+
+	// A resuming "frames" client is sent only the output it missed; otherwise
+	// the snapshot travels as the stream's first frame.
+	var frameSub *framestream.Subscription
+	if useFrames {
+		var err error
+		frameSub, err = h.attachFrames(stream, instance, currentPaneReq, captureInitialContent)
+		if err != nil {
+			return err
+		}
+		defer frameSub.Close()
+	}
+
+	var initialContent string
+	if frameSub == nil {
+		initialContent = captureInitialContent()
 	}
 
 	if initialContent != "" {
@@ -624,8 +816,9 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 	}
 
 	// Send initial ScrollbackResponse with the most recent history so the client
-	// can populate its scrollback buffer immediately on connect (R2.2).
-	if h.scrollbackManager != nil {
+	// can populate its scrollback buffer immediately on connect (R2.2). A
+	// resumed "frames" client already has it.
+	if h.scrollbackManager != nil && (frameSub == nil || !frameSub.Resumed) {
 		const initialScrollbackLines = 500
 		sbData, sbErr := h.scrollbackManager.GetRecentLines(sessionID, initialScrollbackLines)
 		if sbErr != nil {
@@ -666,11 +859,16 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 		}
 	}
 
-	// Subscribe to control mode updates for streaming
-	subscriberID, updateChan := streamer.SubscribeControlModeUpdates()
-	defer streamer.UnsubscribeControlModeUpdates(subscriberID)
+	// Subscribe to control mode updates for streaming; in "frames" mode the
+	// frame stream is subscribed instead and records output for us.
+	var updateChan <-chan []byte
+	if frameSub == nil {
+		var subscriberID string
+		subscriberID, updateChan = streamer.SubscribeControlModeUpdates()
+		defer streamer.UnsubscribeControlModeUpdates(subscriberID)
 
-	log.Info("[streamViaControlMode] subscribed to control mode", "subscriber_id", subscriberID, "session", sessionID)
+		log.Info("[streamViaControlMode] subscribed to control mode", "subscriber_id", subscriberID, "session", sessionID)
+	}
 
 	// Create channels for goroutine coordination
 	errChan := make(chan error, 2)
@@ -684,6 +882,14 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 
 		log.Info("[streamViaControlMode] output goroutine started", "session", sessionID)
 
+		if frameSub != nil {
+			if err := h.forwardFrames(stream, instance, frameSub); err != nil {
+				log.Error("[streamViaControlMode] failed to send frame", "err", err)
+				errChan <- err
+			}
+			return
+		}
+
 		// sendData marshals and writes a terminal output message, using a pooled proto.
 		sendData := func(data []byte) error {
 			msg := terminalDataPool.Get().(*sessionv1.TerminalData)
@@ -703,25 +909,38 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 		// escapeParser is fetched once; may be nil if no controller is running.
 		escapeParser := instance.GetEscapeParser()
 
+		// redactor masks secrets in the live stream. It may hold back the tail
+		// of a frame that could be the start of a secret; flushC releases it
+		// if no further output arrives shortly.
+		var redactor *secrets.StreamRedactor
+		if session.TerminalRedactionEnabled() {
+			redactor = session.SecretDetector().NewStreamRedactor()
+		}
+		var flushC <-chan time.Time
+
 		for {
 			select {
 			case <-doneChan:
 				return
+			case <-flushC:
+				flushC = nil
+				if held := redactor.Flush(); len(held) > 0 {
+					if err := sendData(held); err != nil {
+						log.Error("[streamViaControlMode] failed to send output", "err", err)
+						errChan <- fmt.Errorf("failed to send output: %w", err)
+						return
+					}
+				}
 			case data, ok := <-updateChan:
 				if !ok {
-					// Session exited. Send any captured exit content so the user sees
-					// the error instead of a blank terminal.
-					if exitContent := instance.GetExitContent(); len(exitContent) > 0 {
-						exitData := &sessionv1.TerminalData{
-							SessionId: sessionID,
-							Data: &sessionv1.TerminalData_Output{
-								Output: &sessionv1.TerminalOutput{Data: exitContent},
-							},
-						}
-						if exitBytes, merr := proto.Marshal(exitData); merr == nil {
-							_ = stream.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(0, exitBytes))
+					if redactor != nil {
+						if held := redactor.Flush(); len(held) > 0 {
+							_ = sendData(held)
 						}
 					}
+					// Session exited. Send any captured exit content so the user sees
+					// the error instead of a blank terminal.
+					sendExitContent(stream, instance)
 					return
 				}
 
@@ -745,6 +964,17 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 					}
 				}
 
+				if redactor != nil {
+					buf = redactor.Write(buf)
+					flushC = nil
+					if redactor.Pending() {
+						flushC = time.After(streamRedactFlushDelay)
+					}
+					if len(buf) == 0 {
+						continue
+					}
+				}
+
 				// Stage 2 escape analytics tap: observe the coalesced transport frame.
 				// Re-fetch parser lazily if it was nil at goroutine start (controller may
 				// have started after the WebSocket connection was established).
@@ -897,6 +1127,11 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 					continue
 				}
 
+				// Input control requests and presence updates go to the coordinator
+				if handleInputControlMessage(stream, sessionID, &incomingData) {
+					continue
+				}
+
 				// Handle input - send to tmux via send-keys
 				if input := incomingData.GetInput(); input != nil {
 					// Check send permission
@@ -904,6 +1139,10 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 						log.Warn("[streamViaControlMode] send permission denied", "session", sessionID)
 						continue
 					}
+					if err := stream.authorizeInput(); err != nil {
+						sendInputControlState(stream, sessionID, err.Error())
+						continue
+					}
 
 					// Update timestamps for user interaction
 					instance.UpdateTerminalTimestamps(string(input.Data), true)
@@ -926,7 +1165,7 @@ func (h *ConnectRPCWebSocketHandler) streamViaControlMode(stream *connectWebSock
 
 				// Handle resize — send to coalescing worker so rapid window-drag events
 				// never stall input reading and don't pile up unbounded goroutines.
-				if resize := incomingData.GetResize(); resize != nil {
+				if resize := incomingData.GetResize(); resize != nil && stream.canResize() {
 					req := resizeReq{int(resize.Cols), int(resize.Rows)}
 					select {
 					case resizeCh <- req:
@@ -1048,7 +1287,7 @@ func (h *ConnectRPCWebSocketHandler) streamViaTmuxCapturePane(stream *connectWeb
 
 	// For managed sessions: parse handshake dimensions and force a TUI redraw via ±1 nudge
 	// so the initial capture-pane snapshot reflects a freshly-drawn terminal state.
-	if instance.IsManaged {
+	if instance.IsManaged && stream.canResize() {
 		var handshakeCaptureData sessionv1.TerminalData
 		if parseErr := proto.Unmarshal(stream.requestMsg, &handshakeCaptureData); parseErr == nil {
 			if paneReq := handshakeCaptureData.GetCurrentPaneRequest(); paneReq != nil &&
@@ -1150,7 +1389,7 @@ func (h *ConnectRPCWebSocketHandler) streamViaTmuxCapturePane(stream *connectWeb
 			case content := <-outputChan:
 				// Send full terminal content with clear screen prefix
 				// Since tmux capture-pane returns full snapshots, we need to clear first
-				fullContent := clearAndHome + content
+				fullContent := clearAndHome + string(session.RedactTerminalOutput([]byte(content)))
 
 				terminalData := &sessionv1.TerminalData{
 					SessionId: sessionID,
@@ -1224,6 +1463,11 @@ func (h *ConnectRPCWebSocketHandler) streamViaTmuxCapturePane(stream *connectWeb
 					continue
 				}
 
+				// Input control requests and presence updates go to the coordinator
+				if handleInputControlMessage(stream, sessionID, &incomingData) {
+					continue
+				}
+
 				// Handle input - send to tmux via send-keys
 				if input := incomingData.GetInput(); input != nil {
 					// Check send permission
@@ -1231,6 +1475,10 @@ func (h *ConnectRPCWebSocketHandler) streamViaTmuxCapturePane(stream *connectWeb
 						log.Warn("[streamViaTmuxCapture] send permission denied", "session", sessionID)
 						continue
 					}
+					if err := stream.authorizeInput(); err != nil {
+						sendInputControlState(stream, sessionID, err.Error())
+						continue
+					}
 
 					// Update timestamps for user interaction
 					instance.UpdateTerminalTimestamps(string(input.Data), true)
@@ -1242,7 +1490,7 @@ func (h *ConnectRPCWebSocketHandler) streamViaTmuxCapturePane(stream *connectWeb
 				}
 
 				// Handle resize - use appropriate method based on session type
-				if resize := incomingData.GetResize(); resize != nil {
+				if resize := incomingData.GetResize(); resize != nil && stream.canResize() {
 					targetCols := int(resize.Cols)
 					targetRows := int(resize.Rows)
 					log.ForSession(sessionID).Debug("resize request", "cols", targetCols, "rows", targetRows)
@@ -1303,7 +1551,7 @@ func (h *ConnectRPCWebSocketHandler) streamViaTmuxCapturePane(stream *connectWeb
 
 					// CRITICAL: Resize tmux BEFORE capturing content to prevent wrapping issues
 					// If target dimensions are provided, resize the tmux pane first
-					if currentPaneReq.TargetCols != nil && currentPaneReq.TargetRows != nil && *currentPaneReq.TargetCols > 0 && *currentPaneReq.TargetRows > 0 {
+					if stream.canResize() && currentPaneReq.TargetCols != nil && currentPaneReq.TargetRows != nil && *currentPaneReq.TargetCols > 0 && *currentPaneReq.TargetRows > 0 {
 						targetCols := int(*currentPaneReq.TargetCols)
 						targetRows := int(*currentPaneReq.TargetRows)
 
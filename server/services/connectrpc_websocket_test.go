@@ -94,6 +94,21 @@ func TestNewHandlerDefaultsInvalidModeToRawCompressed(t *testing.T) {
 	}
 }
 
+// TestSetStreamingModeReplacesDefault verifies that a reloaded
+// terminal_streaming_mode replaces the handler default, normalizing unknown
+// values like the constructor does.
+func TestSetStreamingModeReplacesDefault(t *testing.T) {
+	h := NewConnectRPCWebSocketHandler(nil, nil, nil, "raw")
+	h.SetStreamingMode("hybrid")
+	if got := h.defaultStreamingMode(); got != "hybrid" {
+		t.Errorf("expected mode %q after SetStreamingMode, got %q", "hybrid", got)
+	}
+	h.SetStreamingMode("bogus")
+	if got := h.defaultStreamingMode(); got != "raw-compressed" {
+		t.Errorf("expected invalid mode to default to %q, got %q", "raw-compressed", got)
+	}
+}
+
 // TestSendEndStreamSuccess verifies that sendEndStreamSuccess writes a message
 // with the EndStream flag set (regression: streamViaControlMode was missing this call).
 func TestSendEndStreamSuccess(t *testing.T) {
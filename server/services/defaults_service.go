@@ -14,13 +14,27 @@ import (
 )
 
 // DefaultsService handles session defaults RPC methods.
-type DefaultsService struct{}
+type DefaultsService struct {
+	// onSaved, when set, receives the config after every successful save.
+	onSaved func(*config.Config)
+}
 
 // NewDefaultsService creates a DefaultsService.
 func NewDefaultsService() *DefaultsService {
 	return &DefaultsService{}
 }
 
+// save persists cfg and hands it to onSaved.
+func (d *DefaultsService) save(cfg *config.Config) error {
+	if err := config.SaveConfig(cfg); err != nil {
+		return err
+	}
+	if d.onSaved != nil {
+		d.onSaved(cfg)
+	}
+	return nil
+}
+
 // GetSessionDefaults returns the full session defaults configuration.
 func (d *DefaultsService) GetSessionDefaults(
 	ctx context.Context,
@@ -76,7 +90,7 @@ func (d *DefaultsService) UpdateGlobalDefaults(
 		cfg.SessionDefaults.EnvVars = make(map[string]string)
 	}
 
-	if err := config.SaveConfig(cfg); err != nil {
+	if err := d.save(cfg); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save config: %w", err))
 	}
 
@@ -110,6 +124,14 @@ func (d *DefaultsService) UpsertProfile(
 		EnvVars:     req.Msg.Profile.EnvVars,
 		CLIFlags:    req.Msg.Profile.CliFlags,
 		UpdatedAt:   now,
+
+		ResourceLimits: protoToResourceLimits(req.Msg.Profile.ResourceLimits),
+		Sandbox:        protoToSandboxConfig(req.Msg.Profile.Sandbox),
+	}
+	switch p.Sandbox.Network {
+	case "", config.SandboxNetworkHost, config.SandboxNetworkNone, config.SandboxNetworkAllowlist:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown sandbox network mode %q", p.Sandbox.Network))
 	}
 	if req.Msg.Profile.EnvVars == nil {
 		p.EnvVars = make(map[string]string)
@@ -125,9 +147,12 @@ func (d *DefaultsService) UpsertProfile(
 		p.CreatedAt = now
 	}
 
+	if cfg.SessionDefaults.Profiles == nil {
+		cfg.SessionDefaults.Profiles = make(map[string]config.ProfileDefaults)
+	}
 	cfg.SessionDefaults.Profiles[p.Name] = p
 
-	if err := config.SaveConfig(cfg); err != nil {
+	if err := d.save(cfg); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save config: %w", err))
 	}
 
@@ -153,7 +178,7 @@ func (d *DefaultsService) DeleteProfile(
 	}
 	delete(cfg.SessionDefaults.Profiles, req.Msg.Name)
 
-	if err := config.SaveConfig(cfg); err != nil {
+	if err := d.save(cfg); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save config: %w", err))
 	}
 
@@ -196,7 +221,7 @@ func (d *DefaultsService) UpsertDirectoryRule(
 		cfg.SessionDefaults.DirectoryRules = append(cfg.SessionDefaults.DirectoryRules, rule)
 	}
 
-	if err := config.SaveConfig(cfg); err != nil {
+	if err := d.save(cfg); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save config: %w", err))
 	}
 
@@ -232,7 +257,7 @@ func (d *DefaultsService) DeleteDirectoryRule(
 	}
 	cfg.SessionDefaults.DirectoryRules = newRules
 
-	if err := config.SaveConfig(cfg); err != nil {
+	if err := d.save(cfg); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save config: %w", err))
 	}
 
@@ -290,6 +315,23 @@ func profileDefaultsToProto(p config.ProfileDefaults) *sessionv1.ProfileDefaults
 	if proto.Tags == nil {
 		proto.Tags = []string{}
 	}
+	if !p.ResourceLimits.IsZero() {
+		proto.ResourceLimits = &sessionv1.ResourceLimitsProto{
+			MemoryMaxMb:  p.ResourceLimits.MemoryMaxMB,
+			MemoryHighMb: p.ResourceLimits.MemoryHighMB,
+			CpuPercent:   int32(p.ResourceLimits.CPUPercent),
+			PidsMax:      p.ResourceLimits.PidsMax,
+			IoWeight:     int32(p.ResourceLimits.IOWeight),
+		}
+	}
+	if !p.Sandbox.IsZero() {
+		proto.Sandbox = &sessionv1.SandboxConfigProto{
+			Enabled:       p.Sandbox.Enabled,
+			Network:       p.Sandbox.Network,
+			AllowedHosts:  p.Sandbox.AllowedHosts,
+			WritablePaths: p.Sandbox.WritablePaths,
+		}
+	}
 	return proto
 }
 
@@ -312,6 +354,8 @@ func protoToProfileDefaults(p *sessionv1.ProfileDefaultsProto) config.ProfileDef
 	if p.UpdatedAt != nil {
 		pd.UpdatedAt = p.UpdatedAt.AsTime()
 	}
+	pd.ResourceLimits = protoToResourceLimits(p.ResourceLimits)
+	pd.Sandbox = protoToSandboxConfig(p.Sandbox)
 	if pd.EnvVars == nil {
 		pd.EnvVars = make(map[string]string)
 	}
@@ -321,6 +365,31 @@ func protoToProfileDefaults(p *sessionv1.ProfileDefaultsProto) config.ProfileDef
 	return pd
 }
 
+func protoToResourceLimits(rl *sessionv1.ResourceLimitsProto) config.ResourceLimits {
+	if rl == nil {
+		return config.ResourceLimits{}
+	}
+	return config.ResourceLimits{
+		MemoryMaxMB:  rl.MemoryMaxMb,
+		MemoryHighMB: rl.MemoryHighMb,
+		CPUPercent:   int(rl.CpuPercent),
+		PidsMax:      rl.PidsMax,
+		IOWeight:     int(rl.IoWeight),
+	}
+}
+
+func protoToSandboxConfig(sb *sessionv1.SandboxConfigProto) config.SandboxConfig {
+	if sb == nil {
+		return config.SandboxConfig{}
+	}
+	return config.SandboxConfig{
+		Enabled:       sb.Enabled,
+		Network:       sb.Network,
+		AllowedHosts:  sb.AllowedHosts,
+		WritablePaths: sb.WritablePaths,
+	}
+}
+
 func directoryRuleToProto(r config.DirectoryRule) *sessionv1.DirectoryRuleProto {
 	proto := &sessionv1.DirectoryRuleProto{
 		Path:      r.Path,
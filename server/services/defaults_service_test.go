@@ -111,6 +111,51 @@ func TestUpsertProfile_CreatesProfile(t *testing.T) {
 	assert.Equal(t, "claude", resp.Msg.Profile.Program)
 }
 
+// TestUpsertProfile_ResourceLimitsRoundTrip verifies that cgroup limits on a
+// profile survive the proto conversion in both directions.
+func TestUpsertProfile_ResourceLimitsRoundTrip(t *testing.T) {
+	svc := NewDefaultsService()
+
+	limits := &sessionv1.ResourceLimitsProto{MemoryMaxMb: 8192, CpuPercent: 200, PidsMax: 512}
+	req := connect.NewRequest(&sessionv1.UpsertProfileRequest{
+		Profile: &sessionv1.ProfileDefaultsProto{
+			Name:           "heavy",
+			Program:        "claude",
+			ResourceLimits: limits,
+		},
+	})
+	resp, err := svc.UpsertProfile(context.Background(), req)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Msg.Profile.ResourceLimits)
+	assert.Equal(t, int64(8192), resp.Msg.Profile.ResourceLimits.MemoryMaxMb)
+	assert.Equal(t, int32(200), resp.Msg.Profile.ResourceLimits.CpuPercent)
+	assert.Equal(t, int64(512), resp.Msg.Profile.ResourceLimits.PidsMax)
+}
+
+func TestUpsertProfile_Sandbox(t *testing.T) {
+	svc := NewDefaultsService()
+
+	resp, err := svc.UpsertProfile(context.Background(), connect.NewRequest(&sessionv1.UpsertProfileRequest{
+		Profile: &sessionv1.ProfileDefaultsProto{
+			Name:    "boxed",
+			Sandbox: &sessionv1.SandboxConfigProto{Enabled: true, Network: "allowlist", AllowedHosts: []string{"github.com"}},
+		},
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, resp.Msg.Profile.Sandbox)
+	assert.True(t, resp.Msg.Profile.Sandbox.Enabled)
+	assert.Equal(t, []string{"github.com"}, resp.Msg.Profile.Sandbox.AllowedHosts)
+
+	_, err = svc.UpsertProfile(context.Background(), connect.NewRequest(&sessionv1.UpsertProfileRequest{
+		Profile: &sessionv1.ProfileDefaultsProto{
+			Name:    "bad",
+			Sandbox: &sessionv1.SandboxConfigProto{Enabled: true, Network: "vpn"},
+		},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
 // TestDeleteProfile_NotFound verifies that deleting a non-existent profile returns
 // CodeNotFound.
 func TestDeleteProfile_NotFound(t *testing.T) {
@@ -5,17 +5,40 @@ import (
 	"net/http"
 
 	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/activity"
 )
 
 const sessionIDHeader = "X-CS-Session-ID"
 
+// maxHookBody bounds how much of a hook payload is read. PostToolUse payloads
+// carry the tool's full output, which can be large.
+const maxHookBody = 1 << 20
+
+// activityRecorder is the narrow interface hook handlers use to record agent
+// activity. Implemented by *activity.Tracker.
+type activityRecorder interface {
+	Record(sessionID string, e activity.Entry) activity.Entry
+}
+
 // HookReceiver handles inbound Claude Code hook callbacks for non-approval events.
 // These are fire-and-forget: Claude does not block on the response.
-type HookReceiver struct{}
+// Each payload is parsed into the session's activity stream; the raw body is
+// never stored or logged, since it may contain secrets.
+type HookReceiver struct {
+	recorder     activityRecorder   // optional: nil only logs receipt
+	queueChecker ReviewQueueChecker // optional: re-checks the review queue when a turn starts or ends
+}
 
-// NewHookReceiver creates a HookReceiver.
-func NewHookReceiver() *HookReceiver {
-	return &HookReceiver{}
+// NewHookReceiver creates a HookReceiver recording into recorder, which may be nil.
+func NewHookReceiver(recorder activityRecorder) *HookReceiver {
+	return &HookReceiver{recorder: recorder}
+}
+
+// SetQueueChecker injects a ReviewQueueChecker used to resolve the session
+// named in the hook header and to update the review queue immediately when
+// the agent's turn starts or ends, instead of waiting for the next poll.
+func (h *HookReceiver) SetQueueChecker(checker ReviewQueueChecker) {
+	h.queueChecker = checker
 }
 
 // RegisterRoutes registers the four non-approval hook endpoints on mux.
@@ -28,41 +51,72 @@ func (h *HookReceiver) RegisterRoutes(mux *http.ServeMux) {
 
 // HandleStop receives the Claude Code Stop hook.
 func (h *HookReceiver) HandleStop(w http.ResponseWriter, r *http.Request) {
-	sessionID := r.Header.Get(sessionIDHeader)
-	size := h.drainBody(r)
-	log.Info("[hook/stop]", "session", sessionID, "bytes", size)
-	w.WriteHeader(http.StatusOK)
+	h.handle(w, r, activity.KindStop)
 }
 
 // HandlePreToolUse receives the Claude Code PreToolUse hook.
 func (h *HookReceiver) HandlePreToolUse(w http.ResponseWriter, r *http.Request) {
-	sessionID := r.Header.Get(sessionIDHeader)
-	tool := r.Header.Get("X-CS-Tool-Name")
-	size := h.drainBody(r)
-	log.Info("[hook/pre-tool-use]", "session", sessionID, "tool", tool, "bytes", size)
-	w.WriteHeader(http.StatusOK)
+	h.handle(w, r, activity.KindToolStart)
 }
 
 // HandlePostToolUse receives the Claude Code PostToolUse hook.
 func (h *HookReceiver) HandlePostToolUse(w http.ResponseWriter, r *http.Request) {
-	sessionID := r.Header.Get(sessionIDHeader)
-	tool := r.Header.Get("X-CS-Tool-Name")
-	size := h.drainBody(r)
-	log.Info("[hook/post-tool-use]", "session", sessionID, "tool", tool, "bytes", size)
-	w.WriteHeader(http.StatusOK)
+	h.handle(w, r, activity.KindToolEnd)
 }
 
 // HandlePromptSubmit receives the Claude Code UserPromptSubmit hook.
 func (h *HookReceiver) HandlePromptSubmit(w http.ResponseWriter, r *http.Request) {
-	sessionID := r.Header.Get(sessionIDHeader)
-	size := h.drainBody(r)
-	log.Info("[hook/prompt-submit]", "session", sessionID, "bytes", size)
-	w.WriteHeader(http.StatusOK)
+	h.handle(w, r, activity.KindPromptSubmit)
+}
+
+// handle parses one hook payload into an activity entry and records it. The
+// response is always 200 so a malformed payload never disturbs the agent.
+func (h *HookReceiver) handle(w http.ResponseWriter, r *http.Request, kind activity.Kind) {
+	defer w.WriteHeader(http.StatusOK)
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxHookBody))
+	if err != nil {
+		log.Warn("[hook] failed to read payload", "kind", kind, "err", err)
+		return
+	}
+	sessionID := h.resolveSession(r.Header.Get(sessionIDHeader))
+
+	entry, err := activity.ParseHook(kind, body)
+	if err != nil {
+		// Keep the event: a truncated payload still tells us the phase.
+		log.Warn("[hook] unparseable payload", "session", sessionID, "kind", kind, "bytes", len(body), "err", err)
+		entry = activity.Entry{Kind: kind, Tool: r.Header.Get("X-CS-Tool-Name")}
+	}
+	if h.recorder == nil {
+		log.Info("[hook]", "session", sessionID, "kind", kind, "tool", entry.Tool, "bytes", len(body))
+		return
+	}
+	entry = h.recorder.Record(sessionID, entry)
+	log.Debug("[hook] recorded activity", "session", sessionID, "kind", kind, "tool", entry.Tool, "seq", entry.Seq)
+
+	if kind == activity.KindStop || kind == activity.KindPromptSubmit {
+		h.recheckQueue(sessionID)
+	}
+}
+
+// resolveSession maps the hook header (a session title or tmux session name)
+// to the session title that status tracking is keyed by.
+func (h *HookReceiver) resolveSession(headerVal string) string {
+	if h.queueChecker != nil && headerVal != "" {
+		if inst := h.queueChecker.FindInstance(headerVal); inst != nil {
+			return inst.Title
+		}
+	}
+	return headerVal
 }
 
-// drainBody discards the request body and returns the number of bytes read.
-// Payloads may contain secrets; we log only the size for observability.
-func (h *HookReceiver) drainBody(r *http.Request) int64 {
-	n, _ := io.Copy(io.Discard, io.LimitReader(r.Body, 64*1024))
-	return n
+// recheckQueue re-evaluates the session's review queue entry in the
+// background so the hook returns immediately.
+func (h *HookReceiver) recheckQueue(sessionID string) {
+	if h.queueChecker == nil || sessionID == "" {
+		return
+	}
+	if inst := h.queueChecker.FindInstance(sessionID); inst != nil {
+		go h.queueChecker.CheckSession(inst)
+	}
 }
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tstapler/stapler-squad/session/activity"
+)
+
+func postHook(t *testing.T, mux *http.ServeMux, path, body string, headers map[string]string) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST %s: status %d, want 200", path, rec.Code)
+	}
+}
+
+func TestHookReceiver_RecordsActivity(t *testing.T) {
+	tracker, err := activity.NewTracker("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHookReceiver(tracker)
+	mux := http.NewServeMux()
+	h.RegisterRoutes(mux)
+	hdr := map[string]string{sessionIDHeader: "my-session"}
+
+	postHook(t, mux, "/api/hooks/prompt-submit", `{"prompt":"run the tests"}`, hdr)
+	postHook(t, mux, "/api/hooks/pre-tool-use", `{"tool_name":"Bash","tool_use_id":"t1","tool_input":{"command":"go test ./..."}}`, hdr)
+	postHook(t, mux, "/api/hooks/post-tool-use", `{"tool_name":"Bash","tool_use_id":"t1","tool_response":{"exit_code":1,"stderr":"FAIL"}}`, hdr)
+	// A truncated payload still records the event from the headers.
+	postHook(t, mux, "/api/hooks/stop", `{"stop_hook_`, hdr)
+
+	entries := tracker.Recent("my-session", 0)
+	if len(entries) != 4 {
+		t.Fatalf("recorded %d entries, want 4: %+v", len(entries), entries)
+	}
+	end := entries[2]
+	if end.Kind != activity.KindToolEnd || end.Input != "go test ./..." || end.ExitStatus != activity.ExitError {
+		t.Errorf("tool end entry = %+v", end)
+	}
+	if state := tracker.State("my-session"); state.Phase != activity.PhaseDone {
+		t.Errorf("phase = %v, want done", state.Phase)
+	}
+}
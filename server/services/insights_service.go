@@ -454,6 +454,51 @@ func (s *InsightsService) WatchInsights(
 	}
 }
 
+// TokenSpend is the token usage and estimated cost of all conversations that
+// share a session and model family.
+type TokenSpend struct {
+	SessionID     string // empty for conversations not linked to a session
+	Model         string // normalized model family
+	Input         int64
+	Output        int64
+	CacheCreation int64
+	CacheRead     int64
+	CostUSD       float64
+}
+
+// TokenSpend aggregates every parsed conversation by session and model family.
+func (s *InsightsService) TokenSpend() []TokenSpend {
+	type key struct{ session, model string }
+	byKey := make(map[key]*TokenSpend)
+	for _, r := range s.store.GetAll() {
+		if r == nil {
+			continue
+		}
+		sessionID := ""
+		if s.associator != nil {
+			if id, isOrphan := s.associator.Associate(r); !isOrphan {
+				sessionID = id
+			}
+		}
+		k := key{sessionID, tokens.NormalizeModelFamily(r.PrimaryModel)}
+		spend, ok := byKey[k]
+		if !ok {
+			spend = &TokenSpend{SessionID: k.session, Model: k.model}
+			byKey[k] = spend
+		}
+		spend.Input += r.TotalInput
+		spend.Output += r.TotalOutput
+		spend.CacheCreation += r.CacheCreation
+		spend.CacheRead += r.CacheRead
+		spend.CostUSD += s.pricing.EstimateCost(r)
+	}
+	out := make([]TokenSpend, 0, len(byKey))
+	for _, spend := range byKey {
+		out = append(out, *spend)
+	}
+	return out
+}
+
 // ---------- helpers ----------
 
 // sessionTimestamps returns the first and last message timestamps from a ParseResult.
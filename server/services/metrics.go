@@ -0,0 +1,181 @@
+package services
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tstapler/stapler-squad/executor"
+	"github.com/tstapler/stapler-squad/pkg/classifier"
+	"github.com/tstapler/stapler-squad/pkg/metrics"
+	"github.com/tstapler/stapler-squad/server/events"
+	"github.com/tstapler/stapler-squad/session"
+)
+
+// metricPrefix namespaces every metric the daemon exports.
+const metricPrefix = "stapler_squad_"
+
+// approvalSourceBuiltin labels decisions made by the handler's own checks
+// (secret scan, new-domain check) rather than a classifier rule.
+const approvalSourceBuiltin = "builtin"
+
+// approvalMetrics instruments ApprovalHandler. The zero value records nothing.
+type approvalMetrics struct {
+	decisions          *metrics.Counter
+	wait               *metrics.Histogram
+	classifierDuration *metrics.Histogram
+}
+
+func newApprovalMetrics(reg *metrics.Registry) approvalMetrics {
+	return approvalMetrics{
+		decisions: reg.Counter(metricPrefix+"approval_decisions_total",
+			"Permission request decisions. Automatic decisions carry the matching rule and its source; decisions made by the user have source \"user\".",
+			"decision", "rule", "source"),
+		wait: reg.Histogram(metricPrefix+"approval_wait_seconds",
+			"Time escalated permission requests waited for the user, by outcome and the rule and source that escalated them. Requests no rule matched have empty rule and source labels.",
+			[]float64{1, 5, 15, 30, 60, 120, 240}, "outcome", "rule", "source"),
+		classifierDuration: reg.Histogram(metricPrefix+"classifier_duration_seconds",
+			"Time spent classifying a permission request.",
+			[]float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5}),
+	}
+}
+
+// observeManual records the outcome of an escalated request: the user's
+// behavior ("allow"/"deny"), "timeout" or "canceled". rule and source name
+// what escalated the request.
+func (m approvalMetrics) observeManual(outcome, rule, source string, createdAt time.Time) {
+	m.decisions.Inc(outcome, "", "user")
+	m.wait.Observe(time.Since(createdAt).Seconds(), outcome, rule, source)
+}
+
+// classifierDecisionLabel names a classifier decision for metric labels.
+func classifierDecisionLabel(d classifier.ClassificationDecision) string {
+	switch d {
+	case classifier.AutoAllow:
+		return "allow"
+	case classifier.AutoDeny:
+		return "deny"
+	default:
+		return "escalate"
+	}
+}
+
+// wsMetrics instruments the terminal streaming WebSocket. The zero value
+// records nothing.
+type wsMetrics struct {
+	clients *metrics.Gauge
+	sent    *metrics.Counter
+}
+
+func newWSMetrics(reg *metrics.Registry) wsMetrics {
+	return wsMetrics{
+		clients: reg.Gauge(metricPrefix+"websocket_clients",
+			"Open terminal streaming WebSocket connections."),
+		sent: reg.Counter(metricPrefix+"websocket_sent_bytes_total",
+			"Bytes written to terminal streaming WebSocket clients."),
+	}
+}
+
+// DaemonMetricSources are the components whose state is read on each scrape.
+// Nil fields are skipped.
+type DaemonMetricSources struct {
+	Sessions    *session.ReviewQueuePoller
+	ReviewQueue *session.ReviewQueue
+	EventBus    *events.EventBus
+	Insights    *InsightsService
+}
+
+// RegisterDaemonCollectors registers scrape-time metrics for sessions, the
+// review queue, circuit breakers, the event bus and token spend on reg.
+func RegisterDaemonCollectors(reg *metrics.Registry, src DaemonMetricSources) {
+	if src.Sessions != nil {
+		reg.CollectGauge(metricPrefix+"sessions", "Sessions by status and program.", func(emit metrics.Emit) {
+			for _, inst := range src.Sessions.GetInstances() {
+				emit(1, strings.ToLower(inst.Status.String()), programLabel(inst.Program))
+			}
+		}, "status", "program")
+	}
+
+	if src.ReviewQueue != nil {
+		reg.CollectGauge(metricPrefix+"review_queue_items", "Sessions in the review queue by reason and priority.", func(emit metrics.Emit) {
+			for _, item := range src.ReviewQueue.List() {
+				emit(1, string(item.Reason), strings.ToLower(item.Priority.String()))
+			}
+		}, "reason", "priority")
+	}
+
+	breakers := executor.GetGlobalRegistry()
+	reg.CollectGauge(metricPrefix+"circuit_breaker_state", "Circuit breaker state; 1 for the breaker's current state, 0 otherwise.", func(emit metrics.Emit) {
+		for key, snap := range breakers.AllBreakers() {
+			for _, state := range []executor.CircuitState{executor.CircuitClosed, executor.CircuitOpen, executor.CircuitHalfOpen} {
+				v := 0.0
+				if snap.State == state {
+					v = 1
+				}
+				emit(v, key, circuitStateLabel(state))
+			}
+		}
+	}, "breaker", "state")
+	reg.CollectGauge(metricPrefix+"circuit_breaker_consecutive_failures", "Consecutive failures recorded by each circuit breaker.", func(emit metrics.Emit) {
+		for key, snap := range breakers.AllBreakers() {
+			emit(float64(snap.ConsecutiveFailures), key)
+		}
+	}, "breaker")
+
+	if src.EventBus != nil {
+		reg.CollectGauge(metricPrefix+"event_bus_subscribers", "Active event bus subscribers.", func(emit metrics.Emit) {
+			emit(float64(src.EventBus.SubscriberCount()))
+		})
+		reg.CollectCounter(metricPrefix+"event_bus_dropped_total", "Event deliveries dropped because a subscriber's buffer was full.", func(emit metrics.Emit) {
+			emit(float64(src.EventBus.Dropped()))
+		})
+	}
+
+	if src.Insights != nil {
+		reg.CollectCounter(metricPrefix+"tokens_total", "Tokens used by Claude conversations, by session, model family and token type. Conversations not linked to a session have an empty session label.", func(emit metrics.Emit) {
+			for _, spend := range src.Insights.TokenSpend() {
+				emit(float64(spend.Input), spend.SessionID, spend.Model, "input")
+				emit(float64(spend.Output), spend.SessionID, spend.Model, "output")
+				emit(float64(spend.CacheCreation), spend.SessionID, spend.Model, "cache_creation")
+				emit(float64(spend.CacheRead), spend.SessionID, spend.Model, "cache_read")
+			}
+		}, "session", "model", "type")
+		reg.CollectCounter(metricPrefix+"token_cost_usd_total", "Estimated cost of Claude conversations in US dollars, by session and model family.", func(emit metrics.Emit) {
+			for _, spend := range src.Insights.TokenSpend() {
+				emit(spend.CostUSD, spend.SessionID, spend.Model)
+			}
+		}, "session", "model")
+	}
+}
+
+// programLabel reduces a session's program command line to the executable's
+// base name so arguments don't multiply series.
+func programLabel(program string) string {
+	fields := strings.Fields(program)
+	if len(fields) == 0 {
+		return ""
+	}
+	return filepath.Base(fields[0])
+}
+
+// circuitStateLabel names a circuit breaker state for metric labels.
+func circuitStateLabel(s executor.CircuitState) string {
+	return strings.ReplaceAll(strings.ToLower(s.String()), "-", "_")
+}
+
+// MetricsHandler serves the daemon's metrics registry at /metrics in the
+// Prometheus text format, or OpenMetrics when the scraper asks for it.
+type MetricsHandler struct {
+	registry *metrics.Registry
+}
+
+// NewMetricsHandler creates a MetricsHandler for reg.
+func NewMetricsHandler(reg *metrics.Registry) *MetricsHandler {
+	return &MetricsHandler{registry: reg}
+}
+
+// RegisterRoutes registers GET /metrics on mux.
+func (h *MetricsHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /metrics", h.registry)
+}
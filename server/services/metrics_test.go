@@ -0,0 +1,90 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tstapler/stapler-squad/pkg/metrics"
+	"github.com/tstapler/stapler-squad/server/events"
+	"github.com/tstapler/stapler-squad/session"
+)
+
+func scrape(t *testing.T, reg *metrics.Registry) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	NewMetricsHandler(reg).RegisterRoutes(mux)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /metrics: status %d", rec.Code)
+	}
+	return rec.Body.String()
+}
+
+func assertSamples(t *testing.T, body string, lines ...string) {
+	t.Helper()
+	for _, line := range lines {
+		if !strings.Contains(body, "\n"+line+"\n") {
+			t.Errorf("scrape missing %q:\n%s", line, body)
+		}
+	}
+}
+
+func TestApprovalHandler_Metrics(t *testing.T) {
+	reg := metrics.NewRegistry()
+	h, _ := newTestHandler(50 * time.Millisecond)
+	h.SetMetrics(reg)
+
+	body := `{"tool_name":"Bash","tool_input":{"command":"ls"},"cwd":"/tmp"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/hooks/permission-request", bytes.NewReader([]byte(body)))
+	req.Header.Set("X-CS-Session-ID", "test-session")
+	h.HandlePermissionRequest(httptest.NewRecorder(), req)
+
+	assertSamples(t, scrape(t, reg),
+		`stapler_squad_approval_decisions_total{decision="timeout",rule="",source="user"} 1`,
+		`stapler_squad_approval_wait_seconds_count{outcome="timeout",rule="",source=""} 1`,
+	)
+}
+
+func TestRegisterDaemonCollectors(t *testing.T) {
+	queue := session.NewReviewQueue()
+	queue.Add(&session.ReviewItem{SessionID: "a", Reason: session.ReasonApprovalPending, Priority: session.PriorityHigh})
+	queue.Add(&session.ReviewItem{SessionID: "b", Reason: session.ReasonApprovalPending, Priority: session.PriorityHigh})
+	queue.Add(&session.ReviewItem{SessionID: "c", Reason: session.ReasonTaskComplete, Priority: session.PriorityLow})
+
+	bus := events.NewEventBus(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus.Subscribe(ctx)
+	bus.Publish(events.NewSessionDeletedEvent("a"))
+	bus.Publish(events.NewSessionDeletedEvent("b"))
+
+	reg := metrics.NewRegistry()
+	RegisterDaemonCollectors(reg, DaemonMetricSources{ReviewQueue: queue, EventBus: bus})
+
+	assertSamples(t, scrape(t, reg),
+		`stapler_squad_review_queue_items{priority="high",reason="approval_pending"} 2`,
+		`stapler_squad_review_queue_items{priority="low",reason="task_complete"} 1`,
+		`stapler_squad_event_bus_subscribers 1`,
+		`stapler_squad_event_bus_dropped_total 1`,
+	)
+}
+
+func TestProgramLabel(t *testing.T) {
+	tests := map[string]string{
+		"claude":                              "claude",
+		"/usr/local/bin/claude --resume":      "claude",
+		"aider --model ollama_chat/gemma3:1b": "aider",
+		"":                                    "",
+	}
+	for program, want := range tests {
+		if got := programLabel(program); got != want {
+			t.Errorf("programLabel(%q) = %q, want %q", program, got, want)
+		}
+	}
+}
@@ -165,6 +165,7 @@ func (rs *RulesService) GetApprovalAnalytics(
 			Escalate:    int32(b.Escalate),
 			ManualAllow: int32(b.ManualAllow),
 			ManualDeny:  int32(b.ManualDeny),
+			SandboxDeny: int32(b.SandboxDeny),
 			Total:       int32(b.Total),
 		})
 	}
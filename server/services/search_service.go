@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,7 +55,8 @@ func decodeHistoryCursor(token string) (historyCursor, bool) {
 	return c, true
 }
 
-// SearchService handles all Claude history and full-text search RPC methods.
+// SearchService handles all agent history and full-text search RPC methods.
+// History covers every session.HistoryProvider (Claude, Codex, Gemini).
 //
 // It owns the history cache and search engine state that were previously
 // scattered across SessionService.
@@ -65,11 +69,20 @@ type SearchService struct {
 	snippetGenerator *search.SnippetGenerator
 
 	historyCacheMu   sync.RWMutex
-	historyCache     *session.ClaudeSessionHistory
+	historyCache     *session.SessionHistory
 	historyCacheTime time.Time
 	historyCacheTTL  time.Duration
+
+	// refreshTimer debounces index refreshes triggered by history file writes,
+	// which arrive in bursts while an agent is streaming a reply.
+	refreshMu    sync.Mutex
+	refreshTimer *time.Timer
 }
 
+// historyRefreshDelay is how long history file writes must settle before the
+// search index is brought up to date.
+const historyRefreshDelay = 2 * time.Second
+
 // NewSearchService creates a SearchService with the given search components.
 func NewSearchService(
 	searchEngine *search.SearchEngine,
@@ -83,8 +96,50 @@ func NewSearchService(
 	}
 }
 
+// OnHistoryFileChanged is registered with the history watcher. It marks the
+// conversation stale and schedules a debounced index refresh, so the index
+// stays current without waiting for the next search to sync it.
+func (ss *SearchService) OnHistoryFileChanged(filePath string) {
+	if !strings.HasSuffix(filePath, ".jsonl") {
+		return
+	}
+	base := filepath.Base(filePath)
+	if strings.HasPrefix(base, "agent-") {
+		return
+	}
+	ss.searchEngine.MarkSessionStale(strings.TrimSuffix(base, ".jsonl"))
+
+	ss.refreshMu.Lock()
+	defer ss.refreshMu.Unlock()
+	if ss.refreshTimer != nil {
+		ss.refreshTimer.Stop()
+	}
+	ss.refreshTimer = time.AfterFunc(historyRefreshDelay, ss.refreshIndex)
+}
+
+// refreshIndex reloads history from disk and syncs the index with it.
+func (ss *SearchService) refreshIndex() {
+	ss.historyCacheMu.Lock()
+	ss.historyCache = nil
+	ss.historyCacheMu.Unlock()
+
+	hist, err := ss.getOrRefreshHistoryCache(context.Background())
+	if err != nil {
+		log.Warn("search index refresh: failed to load history", "err", err)
+		return
+	}
+	result, err := ss.searchEngine.IncrementalSync(hist)
+	if err != nil {
+		log.Warn("search index refresh failed", "err", err)
+		return
+	}
+	if result.HasChanges() || result.WasFullRebuild {
+		log.Info("search index refreshed", "result", result.String())
+	}
+}
+
 // getOrRefreshHistoryCache returns the cached history or refreshes it if stale.
-func (ss *SearchService) getOrRefreshHistoryCache(ctx context.Context) (*session.ClaudeSessionHistory, error) {
+func (ss *SearchService) getOrRefreshHistoryCache(ctx context.Context) (*session.SessionHistory, error) {
 	ctx, span := telemetry.StartSpan(ctx, "SearchService.getOrRefreshHistoryCache")
 	defer span.End()
 
@@ -118,7 +173,7 @@ func (ss *SearchService) getOrRefreshHistoryCache(ctx context.Context) (*session
 	_, loadSpan := telemetry.StartSpan(ctx, "SearchService.loadHistoryFromDisk")
 	loadStart := time.Now()
 
-	hist, err := session.NewClaudeSessionHistoryFromClaudeDir()
+	hist, err := session.NewSessionHistoryFromHome()
 
 	loadDuration := time.Since(loadStart)
 	loadSpan.SetAttributes(attribute.Int64("load.duration_ms", loadDuration.Milliseconds()))
@@ -142,7 +197,7 @@ func (ss *SearchService) getOrRefreshHistoryCache(ctx context.Context) (*session
 	return hist, nil
 }
 
-// ListClaudeHistory returns Claude session history entries with optional filtering
+// ListClaudeHistory returns agent session history entries with optional filtering
 // and cursor-based pagination.
 //
 // Pagination rules:
@@ -152,7 +207,7 @@ func (ss *SearchService) getOrRefreshHistoryCache(ctx context.Context) (*session
 //   - next_page_token in the response is non-empty when more pages exist; pass it
 //     as page_token in the next request.
 //   - The legacy limit field is honoured when page_size is zero.
-//   - Filters (project, search_query) must be identical across all pages of a
+//   - Filters (project, search_query, provider) must be identical across all pages of a
 //     paginated sequence.
 func (ss *SearchService) ListClaudeHistory(
 	ctx context.Context,
@@ -173,6 +228,16 @@ func (ss *SearchService) ListClaudeHistory(
 		entries = hist.GetAll()
 	}
 
+	if req.Msg.Provider != nil && *req.Msg.Provider != "" {
+		filtered := entries[:0:0]
+		for _, e := range entries {
+			if e.Provider == *req.Msg.Provider {
+				filtered = append(filtered, e)
+			}
+		}
+		entries = filtered
+	}
+
 	totalCount := len(entries)
 
 	// --- Cursor pagination ---------------------------------------------------
@@ -224,15 +289,7 @@ func (ss *SearchService) ListClaudeHistory(
 
 	protoEntries := make([]*sessionv1.ClaudeHistoryEntry, 0, len(entries))
 	for _, entry := range entries {
-		protoEntries = append(protoEntries, &sessionv1.ClaudeHistoryEntry{
-			Id:           entry.ID,
-			Name:         entry.Name,
-			Project:      entry.Project,
-			CreatedAt:    timestamppb.New(entry.CreatedAt),
-			UpdatedAt:    timestamppb.New(entry.UpdatedAt),
-			Model:        entry.Model,
-			MessageCount: int32(entry.MessageCount),
-		})
+		protoEntries = append(protoEntries, historyEntryToProto(entry))
 	}
 
 	return connect.NewResponse(&sessionv1.ListClaudeHistoryResponse{
@@ -261,15 +318,7 @@ func (ss *SearchService) GetClaudeHistoryDetail(
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	protoEntry := &sessionv1.ClaudeHistoryEntry{
-		Id:           entry.ID,
-		Name:         entry.Name,
-		Project:      entry.Project,
-		CreatedAt:    timestamppb.New(entry.CreatedAt),
-		UpdatedAt:    timestamppb.New(entry.UpdatedAt),
-		Model:        entry.Model,
-		MessageCount: int32(entry.MessageCount),
-	}
+	protoEntry := historyEntryToProto(*entry)
 
 	// Lazily enrich with VCS status. Reuse the existing vc.VCSProvider so the
 	// git/jj logic is not duplicated. Errors are non-fatal — the UI should
@@ -285,6 +334,20 @@ func (ss *SearchService) GetClaudeHistoryDetail(
 	}), nil
 }
 
+// historyEntryToProto converts a history entry to its proto form (without VCS status).
+func historyEntryToProto(entry session.ClaudeHistoryEntry) *sessionv1.ClaudeHistoryEntry {
+	return &sessionv1.ClaudeHistoryEntry{
+		Id:           entry.ID,
+		Name:         entry.Name,
+		Project:      entry.Project,
+		CreatedAt:    timestamppb.New(entry.CreatedAt),
+		UpdatedAt:    timestamppb.New(entry.UpdatedAt),
+		Model:        entry.Model,
+		MessageCount: int32(entry.MessageCount),
+		Provider:     entry.Provider,
+	}
+}
+
 // newHistoryVCSProvider returns a VCS provider for the given project path,
 // preferring Git and falling back to Jujutsu.
 func newHistoryVCSProvider(projectPath string) (vc.VCSProvider, error) {
@@ -321,7 +384,7 @@ func (ss *SearchService) GetClaudeHistoryMessages(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load history: %w", err))
 	}
 
-	_, err = hist.GetByID(req.Msg.Id)
+	entry, err := hist.GetByID(req.Msg.Id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("session not found: %w", err))
 	}
@@ -333,7 +396,7 @@ func (ss *SearchService) GetClaudeHistoryMessages(
 	if req.Msg.Tail && req.Msg.Limit > 0 && req.Msg.Offset == 0 {
 		fileLimit = int(req.Msg.Limit)
 	}
-	messages, err := hist.GetMessagesFromConversationFile(req.Msg.Id, fileLimit)
+	messages, err := hist.GetMessages(*entry, fileLimit)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load messages: %w", err))
 	}
@@ -422,8 +485,9 @@ func (ss *SearchService) SearchClaudeHistory(
 	}
 
 	searchOpts := search.SearchOptions{
-		Limit:  limit,
-		Offset: offset,
+		Limit:   limit,
+		Offset:  offset,
+		Explain: req.Msg.Explain,
 	}
 
 	_, searchSpan := telemetry.StartSpan(ctx, "SearchEngine.Search")
@@ -437,6 +501,10 @@ func (ss *SearchService) SearchClaudeHistory(
 	if err != nil {
 		searchSpan.RecordError(err)
 		searchSpan.End()
+		var syntaxErr *search.QuerySyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("search failed: %w", err))
 	}
 	searchSpan.SetAttributes(
@@ -445,15 +513,12 @@ func (ss *SearchService) SearchClaudeHistory(
 	)
 	searchSpan.End()
 
-	tokenizer := ss.searchEngine.GetTokenizer()
-	queryTokens := tokenizer.Tokenize(req.Msg.Query)
-
 	protoResults := make([]*sessionv1.SearchResult, 0, len(searchResults.Results))
 	for _, result := range searchResults.Results {
 		entry, _ := hist.GetByID(result.SessionID)
 
 		doc := ss.searchEngine.GetDocument(result.DocID)
-		snippets := ss.snippetGenerator.GenerateFromSearchResult(doc, queryTokens)
+		snippets := ss.snippetGenerator.GenerateFromSearchResult(doc, result.MatchedTerms)
 
 		protoSnippets := make([]*sessionv1.SearchSnippet, 0, len(snippets))
 		for _, snippet := range snippets {
@@ -475,11 +540,13 @@ func (ss *SearchService) SearchClaudeHistory(
 		sessionName := result.SessionID
 		project := ""
 		model := ""
+		provider := ""
 		var createdAt time.Time
 		if entry != nil {
 			sessionName = entry.Name
 			project = entry.Project
 			model = entry.Model
+			provider = entry.Provider
 			createdAt = entry.CreatedAt
 		}
 
@@ -495,7 +562,9 @@ func (ss *SearchService) SearchClaudeHistory(
 				MatchSource:     "message_content",
 				Model:           model,
 				CreatedAt:       timestamppb.New(createdAt),
+				Provider:        provider,
 			},
+			Explanation: scoreExplanationToProto(result.Explanation),
 		})
 	}
 
@@ -504,5 +573,28 @@ func (ss *SearchService) SearchClaudeHistory(
 		TotalMatches: int32(searchResults.TotalMatches),
 		QueryTimeMs:  searchResults.QueryTime.Milliseconds(),
 		HasMore:      searchResults.TotalMatches > offset+len(protoResults),
+		ParsedQuery:  searchResults.ParsedQuery,
+		Relaxed:      searchResults.Relaxed,
 	}), nil
 }
+
+func scoreExplanationToProto(e *search.QueryExplanation) *sessionv1.ScoreExplanation {
+	if e == nil {
+		return nil
+	}
+	p := &sessionv1.ScoreExplanation{TotalScore: e.TotalScore, K1: e.K1, B: e.B}
+	for _, t := range e.Terms {
+		p.Terms = append(p.Terms, &sessionv1.TermScoreExplanation{
+			Term:              t.Term,
+			Clause:            t.Clause,
+			Weight:            t.Weight,
+			TermFrequency:     t.TermFrequency,
+			DocumentFrequency: int32(t.DocumentFrequency),
+			Idf:               t.IDF,
+			Score:             t.Score,
+			DocumentLength:    int32(t.DocumentLength),
+			AvgDocumentLength: t.AvgDocLength,
+		})
+	}
+	return p
+}
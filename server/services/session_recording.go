@@ -0,0 +1,212 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/recording"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recordingSyncInterval is how often recording is reconciled with the live
+// sessions: new opt-ins start, restarted panes are re-piped, and stopped
+// sessions finish their segment.
+const recordingSyncInterval = 2 * time.Second
+
+// newRecordingManager creates the terminal recording manager under
+// ~/.stapler-squad/recordings. Without a config dir recording is unavailable.
+func newRecordingManager() *recording.Manager {
+	opts := recording.Options{
+		NewOutputRedactor: func() *secrets.StreamRedactor {
+			if !session.TerminalRedactionEnabled() {
+				return nil
+			}
+			return session.SecretDetector().NewStreamRedactor()
+		},
+		// Input is always redacted: typed secrets are the point of redaction.
+		RedactInput: func(b []byte) []byte { return session.SecretDetector().RedactBytes(b) },
+	}
+	dir, err := config.GetConfigDir()
+	if err == nil {
+		var m *recording.Manager
+		if m, err = recording.NewManager(filepath.Join(dir, "recordings"), opts); err == nil {
+			return m
+		}
+	}
+	log.Warn("[Recording] recording storage unavailable, terminal recording disabled", "err", err)
+	m, _ := recording.NewManager("", opts)
+	return m
+}
+
+// instancePane adapts a session instance to recording.Pane.
+type instancePane struct {
+	inst *session.Instance
+}
+
+func (p instancePane) PanePiped() (bool, error)        { return p.inst.PanePiped() }
+func (p instancePane) PipePaneOutput(cmd string) error { return p.inst.PipePaneOutput(cmd) }
+func (p instancePane) PaneSize() (int, int, error)     { return p.inst.GetPaneDimensions() }
+func (p instancePane) PaneSnapshot() (string, error)   { return p.inst.CapturePaneContentRaw() }
+
+// RunRecordings records the terminals of sessions that opted in until ctx is
+// cancelled. It must be called after SetReviewQueuePoller.
+func (s *SessionService) RunRecordings(ctx context.Context) {
+	if s.recordings == nil || s.reviewQueuePoller == nil {
+		return
+	}
+	session.ObserveTerminalInput(s.recordings.Input)
+	defer session.ObserveTerminalInput(nil)
+	s.recordings.Run(ctx, recordingSyncInterval, func() map[string]recording.Pane {
+		panes := make(map[string]recording.Pane)
+		for _, inst := range s.reviewQueuePoller.GetInstances() {
+			if inst.Started() && !inst.Paused() {
+				panes[inst.Title] = instancePane{inst: inst}
+			}
+		}
+		return panes
+	})
+}
+
+// recordingSubject resolves a session ID (title or UUID) to its title, which
+// keys recordings, and its checkpoints.
+func (s *SessionService) recordingSubject(id string) (string, session.CheckpointList, error) {
+	if inst := s.FindLiveInstance(id); inst != nil {
+		return inst.Title, inst.GetCheckpoints(), nil
+	}
+	dataSlice, err := s.storage.ListInstanceData()
+	if err != nil {
+		return "", nil, fmt.Errorf("list instances: %w", err)
+	}
+	for _, d := range dataSlice {
+		if d.Title == id || d.UUID == id {
+			return d.Title, d.Checkpoints, nil
+		}
+	}
+	return "", nil, ErrSessionNotFound
+}
+
+// checkpointTime finds a checkpoint by ID or label.
+func checkpointTime(cps session.CheckpointList, ref string) (time.Time, error) {
+	cp := cps.FindByID(ref)
+	if cp == nil {
+		cp = cps.FindByLabel(ref)
+	}
+	if cp == nil {
+		return time.Time{}, fmt.Errorf("checkpoint not found: %s", ref)
+	}
+	return cp.Timestamp, nil
+}
+
+// SetSessionRecording opts a session in to or out of terminal recording.
+// +api: session:set-recording
+func (s *SessionService) SetSessionRecording(
+	ctx context.Context,
+	req *connect.Request[sessionv1.SetSessionRecordingRequest],
+) (*connect.Response[sessionv1.SetSessionRecordingResponse], error) {
+	if req.Msg.SessionId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("session_id is required"))
+	}
+	title, _, err := s.recordingSubject(req.Msg.SessionId)
+	switch {
+	case errors.Is(err, ErrSessionNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("session not found: %s", req.Msg.SessionId))
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	settings, err := s.recordings.SetSettings(title, recording.Settings{
+		Enabled:      req.Msg.Enabled,
+		IncludeInput: req.Msg.IncludeInput,
+	})
+	if errors.Is(err, recording.ErrNoStorage) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	log.Info("[Recording] settings changed", "session", title, "enabled", settings.Enabled, "include_input", settings.IncludeInput)
+
+	return connect.NewResponse(&sessionv1.SetSessionRecordingResponse{
+		Settings: &sessionv1.SessionRecordingSettings{
+			Enabled:      settings.Enabled,
+			IncludeInput: settings.IncludeInput,
+			UpdatedAt:    timestamppb.New(settings.UpdatedAt),
+			Active:       s.recordings.Recording(title),
+		},
+	}), nil
+}
+
+// ExportRecording exports a session's terminal recording as an asciicast v2
+// file, optionally limited to the range between two checkpoints. Checkpoints
+// in the range are added as markers.
+// +api: session:export-recording
+func (s *SessionService) ExportRecording(
+	ctx context.Context,
+	req *connect.Request[sessionv1.ExportRecordingRequest],
+) (*connect.Response[sessionv1.ExportRecordingResponse], error) {
+	if req.Msg.SessionId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("session_id is required"))
+	}
+	if req.Msg.IdleTimeLimit < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("idle_time_limit must not be negative"))
+	}
+	title, cps, err := s.recordingSubject(req.Msg.SessionId)
+	switch {
+	case errors.Is(err, ErrSessionNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("session not found: %s", req.Msg.SessionId))
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	opts := recording.ExportOptions{
+		IncludeInput:  req.Msg.IncludeInput,
+		IdleTimeLimit: req.Msg.IdleTimeLimit,
+	}
+	if ref := req.Msg.FromCheckpoint; ref != "" {
+		if opts.From, err = checkpointTime(cps, ref); err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+	}
+	if ref := req.Msg.ToCheckpoint; ref != "" {
+		if opts.To, err = checkpointTime(cps, ref); err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+	}
+	if !opts.From.IsZero() && !opts.To.IsZero() && !opts.To.After(opts.From) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("checkpoint %q is not after %q", req.Msg.ToCheckpoint, req.Msg.FromCheckpoint))
+	}
+	for _, cp := range cps {
+		label := cp.Label
+		if label == "" {
+			label = cp.ID
+		}
+		opts.Markers = append(opts.Markers, recording.Marker{Time: cp.Timestamp, Label: label})
+	}
+
+	var buf bytes.Buffer
+	stats, err := s.recordings.Export(&buf, title, opts)
+	switch {
+	case errors.Is(err, recording.ErrNoRecording):
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no recording for session %s in the requested range", title))
+	case errors.Is(err, recording.ErrNoStorage):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&sessionv1.ExportRecordingResponse{
+		Cast:            buf.Bytes(),
+		EventCount:      int32(stats.Events),
+		DurationSeconds: stats.Duration.Seconds(),
+	}), nil
+}
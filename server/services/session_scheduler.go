@@ -0,0 +1,489 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/activity"
+	"github.com/tstapler/stapler-squad/session/schedule"
+)
+
+const (
+	// schedulerMaxSleep is the longest the scheduler sleeps between passes.
+	// Passes also notice schedules edited by another process (the stdio MCP
+	// server) and sessions that ended, so it stays well under MissedAfter.
+	schedulerMaxSleep = 30 * time.Second
+	// maxQueuedRuns caps the runs OverlapQueue holds behind a running one;
+	// later firings are recorded as skipped.
+	maxQueuedRuns = 5
+	// maxRunOutputBytes is how much of a one-shot's output a run keeps.
+	maxRunOutputBytes = 4 * 1024
+)
+
+// scheduleRunner starts the work a schedule fires. Satisfied by
+// *SessionService.
+type scheduleRunner interface {
+	CreateSession(context.Context, *connect.Request[sessionv1.CreateSessionRequest]) (*connect.Response[sessionv1.CreateSessionResponse], error)
+	RunOneShot(context.Context, *connect.Request[sessionv1.RunOneShotRequest]) (*connect.Response[sessionv1.RunOneShotResponse], error)
+	UpdateSession(context.Context, *connect.Request[sessionv1.UpdateSessionRequest]) (*connect.Response[sessionv1.UpdateSessionResponse], error)
+	FindLiveInstance(id string) *session.Instance
+}
+
+// SessionScheduler runs recurring schedules: each pass it fires the firings
+// that are due, applies the schedule's overlap policy when the previous run
+// is still going, starts queued runs whose predecessor finished, and closes
+// session runs whose session finished its task, was paused or was deleted.
+//
+// A session run lasts until its agent ends the turn it was started with, so
+// "still going" means the session spawned by the previous firing is still
+// working on its prompt.
+type SessionScheduler struct {
+	store  *schedule.Store
+	runner scheduleRunner
+	now    func() time.Time
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	kick   chan struct{}
+
+	// passMu serializes passes and manual triggers so one firing cannot start
+	// two runs.
+	passMu sync.Mutex
+	// inflight holds runs whose CreateSession or RunOneShot call has not
+	// returned yet, by run ID.
+	inflight map[string]*inflightRun
+	wg       sync.WaitGroup
+}
+
+type inflightRun struct {
+	// cancel stops a one-shot; nil for session runs.
+	cancel context.CancelFunc
+}
+
+// NewSessionScheduler creates a stopped scheduler.
+func NewSessionScheduler(store *schedule.Store, runner scheduleRunner) *SessionScheduler {
+	return &SessionScheduler{
+		store:    store,
+		runner:   runner,
+		now:      time.Now,
+		kick:     make(chan struct{}, 1),
+		inflight: make(map[string]*inflightRun),
+	}
+}
+
+// Start begins the scheduling loop, first failing the runs a previous daemon
+// left mid-call. Idempotent.
+func (s *SessionScheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return
+	}
+	s.failInterrupted()
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.run(ctx)
+	log.Info("session scheduler started")
+}
+
+// Stop ends the scheduling loop. Runs already started keep going. Idempotent.
+func (s *SessionScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.cancel = nil
+}
+
+// Kick requests a pass as soon as possible, e.g. after a schedule changed.
+// Never blocks.
+func (s *SessionScheduler) Kick() {
+	select {
+	case s.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (s *SessionScheduler) run(ctx context.Context) {
+	for {
+		timer := time.NewTimer(s.Tick(ctx))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		case <-s.kick:
+			timer.Stop()
+		}
+	}
+}
+
+// Tick runs one scheduling pass and returns how long to sleep before the next.
+func (s *SessionScheduler) Tick(ctx context.Context) time.Duration {
+	s.passMu.Lock()
+	defer s.passMu.Unlock()
+
+	schedules, err := s.store.List()
+	if err != nil {
+		log.Warn("scheduler: failed to list schedules", "err", err)
+		return schedulerMaxSleep
+	}
+	now := s.now()
+	sleep := schedulerMaxSleep
+	for i := range schedules {
+		sc := &schedules[i]
+		busy := s.reconcile(ctx, sc)
+		if sc.Enabled {
+			firings, err := sc.Due(now)
+			if err != nil {
+				log.Warn("scheduler: cannot evaluate schedule", "schedule", sc.Name, "err", err)
+				continue
+			}
+			for _, f := range firings {
+				trigger := schedule.TriggerSchedule
+				if f.CatchUp {
+					trigger = schedule.TriggerCatchUp
+				}
+				s.fire(ctx, sc, trigger, f.At, busy)
+				busy = true
+			}
+			if err := s.store.MarkEvaluated(sc.ID, now); err != nil {
+				log.Warn("scheduler: failed to record evaluation", "schedule", sc.Name, "err", err)
+			}
+			if next, err := sc.Next(now); err == nil && next.Sub(now) < sleep {
+				sleep = next.Sub(now)
+			}
+		}
+	}
+	return sleep
+}
+
+// Trigger runs a schedule now, whether or not it is enabled, applying its
+// overlap policy like any other firing.
+func (s *SessionScheduler) Trigger(ctx context.Context, idOrName string) (schedule.Run, error) {
+	s.passMu.Lock()
+	defer s.passMu.Unlock()
+
+	sc, err := s.store.Get(idOrName)
+	if err != nil {
+		return schedule.Run{}, err
+	}
+	busy := s.reconcile(ctx, &sc)
+	return s.fire(ctx, &sc, schedule.TriggerManual, s.now(), busy), nil
+}
+
+// reconcile closes sc's running session run if its session ended, starts
+// the oldest queued run if nothing is running, and reports whether a run is
+// still going. Caller must hold passMu.
+func (s *SessionScheduler) reconcile(ctx context.Context, sc *schedule.Schedule) bool {
+	runs, err := s.store.Runs(sc.ID, 0)
+	if err != nil {
+		log.Warn("scheduler: failed to load runs", "schedule", sc.Name, "err", err)
+		return true
+	}
+	busy := false
+	var queued *schedule.Run
+	for i := range runs {
+		r := &runs[i]
+		switch r.Status {
+		case schedule.RunQueued:
+			queued = r // runs are newest first, so this ends on the oldest
+		case schedule.RunRunning:
+			if s.sessionEnded(r) {
+				s.finish(r.ID, schedule.RunSucceeded, "")
+				continue
+			}
+			busy = true
+		}
+	}
+	if !busy && queued != nil {
+		started, err := s.store.UpdateRun(queued.ID, func(r *schedule.Run) {
+			r.Status = schedule.RunRunning
+			r.StartedAt = s.now()
+		})
+		if err == nil {
+			s.launch(*sc, started)
+			busy = true
+		}
+	}
+	return busy
+}
+
+// sessionEnded reports whether a running session run's session finished its
+// task, was paused, stopped or deleted. Runs still creating their session have
+// not ended.
+func (s *SessionScheduler) sessionEnded(r *schedule.Run) bool {
+	s.mu.Lock()
+	_, inflight := s.inflight[r.ID]
+	s.mu.Unlock()
+	if inflight || r.SessionID == "" {
+		return false
+	}
+	id := r.SessionUUID
+	if id == "" {
+		id = r.SessionID
+	}
+	inst := s.runner.FindLiveInstance(id)
+	return inst == nil || inst.Paused() || inst.Status == session.Stopped || taskComplete(inst)
+}
+
+// taskComplete reports whether inst's agent finished its turn, as reported by
+// its hooks or, for agents without hooks, by the review queue.
+func taskComplete(inst *session.Instance) bool {
+	if sm := inst.GetStatusManager(); sm != nil {
+		if info := sm.GetStatus(inst); info.HasActivity && info.Activity.Phase == activity.PhaseDone {
+			return true
+		}
+	}
+	item, ok := inst.GetReviewItem()
+	return ok && item.Reason == session.ReasonTaskComplete
+}
+
+// fire records a firing and starts, queues, skips or replaces according to
+// sc's overlap policy. Caller must hold passMu.
+func (s *SessionScheduler) fire(ctx context.Context, sc *schedule.Schedule, trigger schedule.Trigger, at time.Time, busy bool) schedule.Run {
+	run := schedule.Run{ScheduleID: sc.ID, Trigger: trigger, ScheduledFor: at}
+	if busy {
+		switch sc.Overlap {
+		case schedule.OverlapQueue:
+			if s.queuedCount(sc.ID) < maxQueuedRuns {
+				run.Status = schedule.RunQueued
+				return s.record(sc, run)
+			}
+			run.Error = fmt.Sprintf("%d runs already queued", maxQueuedRuns)
+		case schedule.OverlapReplace:
+			if err := s.replaceRunning(ctx, sc); err != nil {
+				run.Error = err.Error()
+				break
+			}
+			busy = false
+		default:
+			run.Error = "previous run still going"
+		}
+	}
+	if busy {
+		run.Status = schedule.RunSkipped
+		run.FinishedAt = s.now()
+		log.Info("scheduler: skipped firing", "schedule", sc.Name, "at", at, "reason", run.Error)
+		return s.record(sc, run)
+	}
+	run.Status = schedule.RunRunning
+	run.StartedAt = s.now()
+	run = s.record(sc, run)
+	if run.ID != "" {
+		s.launch(*sc, run)
+	}
+	return run
+}
+
+func (s *SessionScheduler) record(sc *schedule.Schedule, run schedule.Run) schedule.Run {
+	saved, err := s.store.AddRun(run)
+	if err != nil {
+		log.Warn("scheduler: failed to record run", "schedule", sc.Name, "err", err)
+		return run
+	}
+	return saved
+}
+
+func (s *SessionScheduler) queuedCount(scheduleID string) int {
+	runs, _ := s.store.Runs(scheduleID, 0)
+	n := 0
+	for _, r := range runs {
+		if r.Status == schedule.RunQueued {
+			n++
+		}
+	}
+	return n
+}
+
+// replaceRunning stops sc's running runs: one-shots are cancelled and
+// sessions paused. A run still creating its session cannot be replaced yet.
+func (s *SessionScheduler) replaceRunning(ctx context.Context, sc *schedule.Schedule) error {
+	runs, err := s.store.Runs(sc.ID, 0)
+	if err != nil {
+		return err
+	}
+	for _, r := range runs {
+		if r.Status != schedule.RunRunning {
+			continue
+		}
+		s.mu.Lock()
+		in, inflight := s.inflight[r.ID]
+		s.mu.Unlock()
+		switch {
+		case inflight && in.cancel != nil:
+			in.cancel()
+		case inflight:
+			return errors.New("previous run is still creating its session")
+		default:
+			id := r.SessionUUID
+			if id == "" {
+				id = r.SessionID
+			}
+			paused := sessionv1.SessionStatus_SESSION_STATUS_PAUSED
+			if _, err := s.runner.UpdateSession(ctx, connect.NewRequest(&sessionv1.UpdateSessionRequest{
+				Id:     id,
+				Status: &paused,
+			})); err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+				return fmt.Errorf("pause previous session %s: %w", r.SessionID, err)
+			}
+		}
+		s.finish(r.ID, schedule.RunReplaced, "")
+		log.Info("scheduler: replaced previous run", "schedule", sc.Name, "run", r.ID, "session", r.SessionID)
+	}
+	return nil
+}
+
+// launch starts a running run's work in the background.
+func (s *SessionScheduler) launch(sc schedule.Schedule, run schedule.Run) {
+	// Runs outlive the pass that started them, so they do not inherit its
+	// context.
+	runCtx, cancel := context.WithCancel(context.Background())
+	in := &inflightRun{}
+	if sc.Kind == schedule.KindOneShot {
+		in.cancel = cancel
+	}
+	s.mu.Lock()
+	s.inflight[run.ID] = in
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		defer func() {
+			s.mu.Lock()
+			delete(s.inflight, run.ID)
+			s.mu.Unlock()
+			// The next queued run may start now.
+			s.Kick()
+		}()
+		if sc.Kind == schedule.KindOneShot {
+			s.runOneShot(runCtx, sc, run)
+		} else {
+			s.createSession(runCtx, sc, run)
+		}
+	}()
+}
+
+func (s *SessionScheduler) createSession(ctx context.Context, sc schedule.Schedule, run schedule.Run) {
+	stamp := run.ScheduledFor.Format("20060102-1504")
+	if run.Trigger == schedule.TriggerManual {
+		stamp = run.ScheduledFor.Format("20060102-150405")
+	}
+	req := &sessionv1.CreateSessionRequest{
+		Title:    sc.Name + "-" + stamp,
+		Path:     sc.RepoPath,
+		Program:  sc.Program,
+		Category: sc.Category,
+		Prompt:   sc.Prompt,
+		Profile:  sc.Profile,
+	}
+	if sc.BranchPrefix != "" {
+		req.Branch = sc.BranchPrefix + stamp
+	}
+	resp, err := s.runner.CreateSession(ctx, connect.NewRequest(req))
+	if err != nil {
+		log.Warn("scheduler: failed to create session", "schedule", sc.Name, "title", req.Title, "err", err)
+		s.finish(run.ID, schedule.RunFailed, err.Error())
+		return
+	}
+	created := resp.Msg.GetSession()
+	s.updateRunning(run.ID, func(r *schedule.Run) {
+		r.SessionID = created.GetTitle()
+		r.SessionUUID = created.GetId()
+	})
+	log.Info("scheduler: started session", "schedule", sc.Name, "session", created.GetTitle(), "trigger", run.Trigger)
+}
+
+func (s *SessionScheduler) runOneShot(ctx context.Context, sc schedule.Schedule, run schedule.Run) {
+	s.updateRunning(run.ID, func(r *schedule.Run) { r.SessionID = sc.TargetSession })
+	resp, err := s.runner.RunOneShot(ctx, connect.NewRequest(&sessionv1.RunOneShotRequest{
+		SessionId:      sc.TargetSession,
+		Prompt:         sc.Prompt,
+		TimeoutSeconds: int32(sc.TimeoutSeconds),
+	}))
+	if err != nil {
+		s.finish(run.ID, schedule.RunFailed, err.Error())
+		return
+	}
+	out := resp.Msg
+	status := schedule.RunSucceeded
+	if out.GetExitCode() != 0 || out.GetError() != "" {
+		status = schedule.RunFailed
+	}
+	s.updateRunning(run.ID, func(r *schedule.Run) {
+		r.Status = status
+		r.FinishedAt = s.now()
+		r.Error = out.GetError()
+		r.ExitCode = int(out.GetExitCode())
+		r.PRURL = out.GetPrUrl()
+		r.Output = tailString(out.GetOutput(), maxRunOutputBytes)
+	})
+	log.Info("scheduler: one-shot finished", "schedule", sc.Name, "session", sc.TargetSession, "status", status)
+}
+
+// finish moves a running or queued run to a final status.
+func (s *SessionScheduler) finish(runID string, status schedule.RunStatus, errMsg string) {
+	s.updateRunning(runID, func(r *schedule.Run) {
+		r.Status = status
+		r.FinishedAt = s.now()
+		if errMsg != "" {
+			r.Error = errMsg
+		}
+	})
+}
+
+// updateRunning applies fn to a run unless it has already finished, e.g.
+// because a newer run replaced it while its one-shot was being cancelled.
+func (s *SessionScheduler) updateRunning(runID string, fn func(*schedule.Run)) {
+	_, err := s.store.UpdateRun(runID, func(r *schedule.Run) {
+		if !r.Status.Finished() {
+			fn(r)
+		}
+	})
+	if err != nil && !errors.Is(err, schedule.ErrNotFound) {
+		log.Warn("scheduler: failed to update run", "run", runID, "err", err)
+	}
+}
+
+// failInterrupted marks runs a previous daemon left mid-call as failed: its
+// one-shots died with it, as did session creations that had not returned.
+// Session runs that got their session survive restarts with it.
+func (s *SessionScheduler) failInterrupted() {
+	schedules, err := s.store.List()
+	if err != nil {
+		return
+	}
+	for _, sc := range schedules {
+		runs, _ := s.store.Runs(sc.ID, 0)
+		for _, r := range runs {
+			if r.Status == schedule.RunRunning && (sc.Kind == schedule.KindOneShot || r.SessionID == "") {
+				s.finish(r.ID, schedule.RunFailed, "interrupted by a server restart")
+			}
+		}
+	}
+}
+
+// tailString returns at most the last max bytes of s, starting on a rune
+// boundary.
+func tailString(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	start := len(s) - max
+	for start < len(s) && !utf8.RuneStart(s[start]) {
+		start++
+	}
+	return s[start:]
+}
@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/activity"
+	"github.com/tstapler/stapler-squad/session/schedule"
+)
+
+// fakeScheduleRunner records what the scheduler asks for. Created sessions
+// are live and running until paused.
+type fakeScheduleRunner struct {
+	mu       sync.Mutex
+	created  []*sessionv1.CreateSessionRequest
+	paused   []string
+	live     map[string]*session.Instance
+	oneShots []*sessionv1.RunOneShotRequest
+	// block, when set, holds one-shots until closed or cancelled.
+	block chan struct{}
+}
+
+func newFakeScheduleRunner() *fakeScheduleRunner {
+	return &fakeScheduleRunner{live: make(map[string]*session.Instance)}
+}
+
+func (f *fakeScheduleRunner) CreateSession(_ context.Context, req *connect.Request[sessionv1.CreateSessionRequest]) (*connect.Response[sessionv1.CreateSessionResponse], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.created = append(f.created, req.Msg)
+	f.live[req.Msg.Title] = &session.Instance{Title: req.Msg.Title, Status: session.Running}
+	return connect.NewResponse(&sessionv1.CreateSessionResponse{
+		Session: &sessionv1.Session{Id: req.Msg.Title, Title: req.Msg.Title},
+	}), nil
+}
+
+func (f *fakeScheduleRunner) RunOneShot(ctx context.Context, req *connect.Request[sessionv1.RunOneShotRequest]) (*connect.Response[sessionv1.RunOneShotResponse], error) {
+	f.mu.Lock()
+	f.oneShots = append(f.oneShots, req.Msg)
+	block := f.block
+	f.mu.Unlock()
+	if block != nil {
+		select {
+		case <-block:
+		case <-ctx.Done():
+			return nil, connect.NewError(connect.CodeCanceled, ctx.Err())
+		}
+	}
+	return connect.NewResponse(&sessionv1.RunOneShotResponse{
+		Output: "opened https://github.com/o/r/pull/7",
+		PrUrl:  "https://github.com/o/r/pull/7",
+	}), nil
+}
+
+func (f *fakeScheduleRunner) UpdateSession(_ context.Context, req *connect.Request[sessionv1.UpdateSessionRequest]) (*connect.Response[sessionv1.UpdateSessionResponse], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.paused = append(f.paused, req.Msg.Id)
+	if inst, ok := f.live[req.Msg.Id]; ok {
+		inst.Status = session.Paused
+	}
+	return connect.NewResponse(&sessionv1.UpdateSessionResponse{}), nil
+}
+
+func (f *fakeScheduleRunner) FindLiveInstance(id string) *session.Instance {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.live[id]
+}
+
+func (f *fakeScheduleRunner) pause(title string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.live[title].Status = session.Paused
+}
+
+// schedulerAt returns a scheduler whose clock reads the value *now points to.
+func schedulerAt(store *schedule.Store, runner scheduleRunner, now *time.Time) *SessionScheduler {
+	s := NewSessionScheduler(store, runner)
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func hourlySchedule(t *testing.T, store *schedule.Store, overlap schedule.OverlapPolicy, evaluated time.Time) schedule.Schedule {
+	t.Helper()
+	sc, err := store.Create(schedule.Schedule{
+		Name:         "triage",
+		Cron:         "0 * * * *",
+		Timezone:     "UTC",
+		Enabled:      true,
+		Prompt:       "triage flaky tests",
+		RepoPath:     "/repo",
+		BranchPrefix: "triage/",
+		Profile:      "claude",
+		Overlap:      overlap,
+		CatchUp:      schedule.CatchUpLatest,
+	})
+	require.NoError(t, err)
+	// Pretend the daemon last looked at the schedule at evaluated.
+	sc, err = store.Update(sc.ID, func(s *schedule.Schedule) error {
+		s.EvaluatedAt = evaluated
+		return nil
+	})
+	require.NoError(t, err)
+	return sc
+}
+
+func statuses(t *testing.T, store *schedule.Store) []schedule.RunStatus {
+	t.Helper()
+	runs, err := store.Runs("", 0)
+	require.NoError(t, err)
+	out := make([]schedule.RunStatus, len(runs))
+	for i, r := range runs {
+		out[len(runs)-1-i] = r.Status // oldest first
+	}
+	return out
+}
+
+func TestSessionScheduler_FiresSessionRun(t *testing.T) {
+	store := schedule.NewStore("")
+	runner := newFakeScheduleRunner()
+	now := time.Date(2026, 10, 18, 9, 0, 30, 0, time.UTC)
+	hourlySchedule(t, store, schedule.OverlapSkip, now.Add(-time.Minute))
+	s := schedulerAt(store, runner, &now)
+
+	sleep := s.Tick(context.Background())
+	s.wg.Wait()
+	assert.LessOrEqual(t, sleep, schedulerMaxSleep)
+
+	require.Len(t, runner.created, 1)
+	req := runner.created[0]
+	assert.Equal(t, "triage-20261018-0900", req.Title)
+	assert.Equal(t, "triage/20261018-0900", req.Branch)
+	assert.Equal(t, "/repo", req.Path)
+	assert.Equal(t, "claude", req.Profile)
+	assert.Equal(t, "triage flaky tests", req.Prompt)
+
+	runs, err := store.Runs("", 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, schedule.RunRunning, runs[0].Status)
+	assert.Equal(t, "triage-20261018-0900", runs[0].SessionID)
+
+	// The run lasts until its session is paused or its task completes.
+	now = now.Add(time.Minute)
+	s.Tick(context.Background())
+	assert.Equal(t, []schedule.RunStatus{schedule.RunRunning}, statuses(t, store))
+	runner.pause("triage-20261018-0900")
+	s.Tick(context.Background())
+	assert.Equal(t, []schedule.RunStatus{schedule.RunSucceeded}, statuses(t, store))
+}
+
+func TestSessionScheduler_SessionRunEndsWhenTaskCompletes(t *testing.T) {
+	tests := []struct {
+		name   string
+		finish func(t *testing.T, inst *session.Instance)
+	}{
+		{
+			name: "agent hooks report the turn ended",
+			finish: func(t *testing.T, inst *session.Instance) {
+				tracker, err := activity.NewTracker("", nil)
+				require.NoError(t, err)
+				tracker.Record(inst.Title, activity.Entry{Kind: activity.KindPromptSubmit})
+				tracker.Record(inst.Title, activity.Entry{Kind: activity.KindStop})
+				sm := session.NewInstanceStatusManager()
+				sm.SetActivitySource(tracker)
+				inst.SetStatusManager(sm)
+			},
+		},
+		{
+			name: "review queue holds the session as complete",
+			finish: func(t *testing.T, inst *session.Instance) {
+				queue := session.NewReviewQueue()
+				queue.Add(&session.ReviewItem{SessionID: inst.Title, Reason: session.ReasonTaskComplete})
+				inst.SetReviewQueue(queue)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := schedule.NewStore("")
+			runner := newFakeScheduleRunner()
+			now := time.Date(2026, 10, 18, 9, 0, 30, 0, time.UTC)
+			hourlySchedule(t, store, schedule.OverlapSkip, now.Add(-time.Minute))
+			s := schedulerAt(store, runner, &now)
+
+			s.Tick(context.Background())
+			s.wg.Wait()
+			now = now.Add(time.Minute)
+			s.Tick(context.Background())
+			require.Equal(t, []schedule.RunStatus{schedule.RunRunning}, statuses(t, store))
+
+			tt.finish(t, runner.FindLiveInstance("triage-20261018-0900"))
+			s.Tick(context.Background())
+			assert.Equal(t, []schedule.RunStatus{schedule.RunSucceeded}, statuses(t, store))
+			assert.Empty(t, runner.paused, "a finished session is left for review")
+		})
+	}
+}
+
+func TestSessionScheduler_OverlapPolicies(t *testing.T) {
+	tests := []struct {
+		overlap schedule.OverlapPolicy
+		want    []schedule.RunStatus
+		created int
+	}{
+		{schedule.OverlapSkip, []schedule.RunStatus{schedule.RunRunning, schedule.RunSkipped}, 1},
+		{schedule.OverlapQueue, []schedule.RunStatus{schedule.RunRunning, schedule.RunQueued}, 1},
+		{schedule.OverlapReplace, []schedule.RunStatus{schedule.RunReplaced, schedule.RunRunning}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.overlap), func(t *testing.T) {
+			store := schedule.NewStore("")
+			runner := newFakeScheduleRunner()
+			now := time.Date(2026, 10, 18, 9, 0, 30, 0, time.UTC)
+			hourlySchedule(t, store, tt.overlap, now.Add(-time.Minute))
+			s := schedulerAt(store, runner, &now)
+
+			s.Tick(context.Background())
+			s.wg.Wait()
+			now = now.Add(time.Hour)
+			s.Tick(context.Background())
+			s.wg.Wait()
+
+			assert.Equal(t, tt.want, statuses(t, store))
+			assert.Len(t, runner.created, tt.created)
+			if tt.overlap == schedule.OverlapReplace {
+				assert.Equal(t, []string{"triage-20261018-0900"}, runner.paused)
+			}
+		})
+	}
+}
+
+func TestSessionScheduler_QueuedRunStartsWhenPreviousEnds(t *testing.T) {
+	store := schedule.NewStore("")
+	runner := newFakeScheduleRunner()
+	now := time.Date(2026, 10, 18, 9, 0, 30, 0, time.UTC)
+	hourlySchedule(t, store, schedule.OverlapQueue, now.Add(-time.Minute))
+	s := schedulerAt(store, runner, &now)
+
+	s.Tick(context.Background())
+	s.wg.Wait()
+	now = now.Add(time.Hour)
+	s.Tick(context.Background())
+	s.wg.Wait()
+	require.Len(t, runner.created, 1)
+
+	runner.pause("triage-20261018-0900")
+	now = now.Add(time.Minute)
+	s.Tick(context.Background())
+	s.wg.Wait()
+
+	assert.Equal(t, []schedule.RunStatus{schedule.RunSucceeded, schedule.RunRunning}, statuses(t, store))
+	require.Len(t, runner.created, 2)
+	assert.Equal(t, "triage-20261018-1000", runner.created[1].Title, "a queued run keeps its firing time")
+}
+
+func TestSessionScheduler_CatchUp(t *testing.T) {
+	store := schedule.NewStore("")
+	runner := newFakeScheduleRunner()
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	// The daemon was down from 04:30: the latest missed firing runs once.
+	hourlySchedule(t, store, schedule.OverlapSkip, now.Add(-5*time.Hour))
+	s := schedulerAt(store, runner, &now)
+
+	s.Tick(context.Background())
+	s.wg.Wait()
+
+	runs, err := store.Runs("", 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, schedule.TriggerCatchUp, runs[0].Trigger)
+	assert.Equal(t, "triage-20261018-0900", runner.created[0].Title)
+
+	// Evaluated: nothing more fires until 10:00.
+	s.Tick(context.Background())
+	assert.Len(t, runner.created, 1)
+}
+
+func TestSessionScheduler_OneShot(t *testing.T) {
+	store := schedule.NewStore("")
+	runner := newFakeScheduleRunner()
+	now := time.Date(2026, 10, 18, 9, 0, 30, 0, time.UTC)
+	sc, err := store.Create(schedule.Schedule{
+		Name:           "deps",
+		Cron:           "@hourly",
+		Enabled:        true,
+		Kind:           schedule.KindOneShot,
+		Prompt:         "update dependencies",
+		TargetSession:  "deps-worker",
+		TimeoutSeconds: 60,
+		Overlap:        schedule.OverlapReplace,
+	})
+	require.NoError(t, err)
+	s := schedulerAt(store, runner, &now)
+
+	run, err := s.Trigger(context.Background(), "deps")
+	require.NoError(t, err)
+	assert.Equal(t, schedule.TriggerManual, run.Trigger)
+	s.wg.Wait()
+
+	runs, err := store.Runs(sc.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, schedule.RunSucceeded, runs[0].Status)
+	assert.Equal(t, "deps-worker", runs[0].SessionID)
+	assert.Equal(t, "https://github.com/o/r/pull/7", runs[0].PRURL)
+	require.Len(t, runner.oneShots, 1)
+	assert.Equal(t, int32(60), runner.oneShots[0].TimeoutSeconds)
+
+	// Replacing a one-shot cancels it.
+	runner.block = make(chan struct{})
+	_, err = s.Trigger(context.Background(), "deps")
+	require.NoError(t, err)
+	_, err = s.Trigger(context.Background(), "deps")
+	require.NoError(t, err)
+	close(runner.block)
+	s.wg.Wait()
+	assert.Equal(t, []schedule.RunStatus{schedule.RunSucceeded, schedule.RunReplaced, schedule.RunSucceeded}, statuses(t, store))
+}
+
+func TestSessionScheduler_StartFailsInterruptedRuns(t *testing.T) {
+	store := schedule.NewStore("")
+	sc, err := store.Create(schedule.Schedule{
+		Name: "deps", Cron: "@daily", Kind: schedule.KindOneShot, Prompt: "p", TargetSession: "w",
+	})
+	require.NoError(t, err)
+	_, err = store.AddRun(schedule.Run{ScheduleID: sc.ID, Status: schedule.RunRunning, SessionID: "w"})
+	require.NoError(t, err)
+
+	s := NewSessionScheduler(store, newFakeScheduleRunner())
+	s.Start()
+	defer s.Stop()
+
+	runs, err := store.Runs(sc.ID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, schedule.RunFailed, runs[0].Status)
+	assert.Contains(t, runs[0].Error, "restart")
+}
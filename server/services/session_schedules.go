@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/schedule"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrSchedulerNotRunning is returned when a schedule is triggered in a
+// process that does not run the scheduler, such as the stdio MCP server.
+var ErrSchedulerNotRunning = errors.New("the scheduler runs in the stapler-squad server, which is not running in this process")
+
+// newScheduleStore opens ~/.stapler-squad/schedules.json. The file is shared
+// with the stdio MCP server, so schedules created there run in the daemon.
+func newScheduleStore() *schedule.Store {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		log.Warn("[Scheduler] failed to get config dir, keeping schedules in memory", "err", err)
+		return schedule.NewStore("")
+	}
+	return schedule.NewStore(filepath.Join(dir, "schedules.json"))
+}
+
+// RunScheduler fires schedules until ctx is cancelled. It must be called after
+// SetReviewQueuePoller, which the scheduler uses to see whether a run's
+// session is still going.
+func (s *SessionService) RunScheduler(ctx context.Context) {
+	scheduler := NewSessionScheduler(s.schedules, s)
+	s.schedulerMu.Lock()
+	s.scheduler = scheduler
+	s.schedulerMu.Unlock()
+
+	scheduler.Start()
+	<-ctx.Done()
+	scheduler.Stop()
+}
+
+// Schedules returns the schedule store shared by the RPCs and MCP tools.
+func (s *SessionService) Schedules() *schedule.Store {
+	return s.schedules
+}
+
+// ScheduleChanged wakes the scheduler so an edited schedule's next firing is
+// picked up immediately. A no-op where the scheduler is not running.
+func (s *SessionService) ScheduleChanged() {
+	if sc := s.runningScheduler(); sc != nil {
+		sc.Kick()
+	}
+}
+
+// TriggerScheduleNow runs a schedule immediately, applying its overlap policy.
+func (s *SessionService) TriggerScheduleNow(ctx context.Context, idOrName string) (schedule.Run, error) {
+	sc := s.runningScheduler()
+	if sc == nil {
+		return schedule.Run{}, ErrSchedulerNotRunning
+	}
+	return sc.Trigger(ctx, idOrName)
+}
+
+func (s *SessionService) runningScheduler() *SessionScheduler {
+	s.schedulerMu.Lock()
+	defer s.schedulerMu.Unlock()
+	return s.scheduler
+}
+
+// scheduleError maps schedule store errors to connect codes.
+func scheduleError(err error) error {
+	switch {
+	case errors.Is(err, schedule.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, schedule.ErrInvalid):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrSchedulerNotRunning):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// ListSchedules returns every schedule with its next firing and latest run.
+// +api: session:list-schedules
+func (s *SessionService) ListSchedules(
+	ctx context.Context,
+	req *connect.Request[sessionv1.ListSchedulesRequest],
+) (*connect.Response[sessionv1.ListSchedulesResponse], error) {
+	schedules, err := s.schedules.List()
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	out := make([]*sessionv1.SessionSchedule, 0, len(schedules))
+	for i := range schedules {
+		out = append(out, s.scheduleToProto(&schedules[i]))
+	}
+	return connect.NewResponse(&sessionv1.ListSchedulesResponse{Schedules: out}), nil
+}
+
+// CreateSchedule adds a schedule. Firings before its creation never run.
+// +api: session:create-schedule
+func (s *SessionService) CreateSchedule(
+	ctx context.Context,
+	req *connect.Request[sessionv1.CreateScheduleRequest],
+) (*connect.Response[sessionv1.CreateScheduleResponse], error) {
+	if req.Msg.Schedule == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("schedule is required"))
+	}
+	created, err := s.schedules.Create(scheduleFromProto(req.Msg.Schedule))
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	log.Info("[Scheduler] schedule created", "schedule", created.Name, "cron", created.Cron, "timezone", created.Timezone)
+	s.ScheduleChanged()
+	return connect.NewResponse(&sessionv1.CreateScheduleResponse{Schedule: s.scheduleToProto(&created)}), nil
+}
+
+// UpdateSchedule changes the fields set in the request.
+// +api: session:update-schedule
+func (s *SessionService) UpdateSchedule(
+	ctx context.Context,
+	req *connect.Request[sessionv1.UpdateScheduleRequest],
+) (*connect.Response[sessionv1.UpdateScheduleResponse], error) {
+	m := req.Msg
+	if m.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	updated, err := s.schedules.Update(m.Id, func(sc *schedule.Schedule) error {
+		setIf(&sc.Name, m.Name)
+		setIf(&sc.Cron, m.Cron)
+		setIf(&sc.Timezone, m.Timezone)
+		setIf(&sc.Enabled, m.Enabled)
+		if m.Kind != nil {
+			sc.Kind = schedule.Kind(*m.Kind)
+		}
+		setIf(&sc.Prompt, m.Prompt)
+		setIf(&sc.Profile, m.Profile)
+		setIf(&sc.RepoPath, m.RepoPath)
+		setIf(&sc.BranchPrefix, m.BranchPrefix)
+		setIf(&sc.Program, m.Program)
+		setIf(&sc.Category, m.Category)
+		setIf(&sc.TargetSession, m.TargetSession)
+		if m.TimeoutSeconds != nil {
+			sc.TimeoutSeconds = int(*m.TimeoutSeconds)
+		}
+		if m.Overlap != nil {
+			sc.Overlap = schedule.OverlapPolicy(*m.Overlap)
+		}
+		if m.CatchUp != nil {
+			sc.CatchUp = schedule.CatchUpPolicy(*m.CatchUp)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	s.ScheduleChanged()
+	return connect.NewResponse(&sessionv1.UpdateScheduleResponse{Schedule: s.scheduleToProto(&updated)}), nil
+}
+
+// setIf assigns *v to *dst when v is set.
+func setIf[T any](dst *T, v *T) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+// DeleteSchedule removes a schedule and its run history. Sessions it spawned
+// are left alone.
+// +api: session:delete-schedule
+func (s *SessionService) DeleteSchedule(
+	ctx context.Context,
+	req *connect.Request[sessionv1.DeleteScheduleRequest],
+) (*connect.Response[sessionv1.DeleteScheduleResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	if err := s.schedules.Delete(req.Msg.Id); err != nil {
+		return nil, scheduleError(err)
+	}
+	s.ScheduleChanged()
+	return connect.NewResponse(&sessionv1.DeleteScheduleResponse{}), nil
+}
+
+// TriggerSchedule runs a schedule now, even if it is disabled.
+// +api: session:trigger-schedule
+func (s *SessionService) TriggerSchedule(
+	ctx context.Context,
+	req *connect.Request[sessionv1.TriggerScheduleRequest],
+) (*connect.Response[sessionv1.TriggerScheduleResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	run, err := s.TriggerScheduleNow(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	return connect.NewResponse(&sessionv1.TriggerScheduleResponse{Run: scheduleRunToProto(&run)}), nil
+}
+
+// ListScheduleRuns returns run history, newest first.
+// +api: session:list-schedule-runs
+func (s *SessionService) ListScheduleRuns(
+	ctx context.Context,
+	req *connect.Request[sessionv1.ListScheduleRunsRequest],
+) (*connect.Response[sessionv1.ListScheduleRunsResponse], error) {
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	scheduleID := ""
+	if req.Msg.ScheduleId != "" {
+		sc, err := s.schedules.Get(req.Msg.ScheduleId)
+		if err != nil {
+			return nil, scheduleError(err)
+		}
+		scheduleID = sc.ID
+	}
+	runs, err := s.schedules.Runs(scheduleID, limit)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	out := make([]*sessionv1.ScheduleRun, 0, len(runs))
+	for i := range runs {
+		out = append(out, scheduleRunToProto(&runs[i]))
+	}
+	return connect.NewResponse(&sessionv1.ListScheduleRunsResponse{Runs: out}), nil
+}
+
+func scheduleFromProto(p *sessionv1.SessionSchedule) schedule.Schedule {
+	return schedule.Schedule{
+		Name:           p.Name,
+		Cron:           p.Cron,
+		Timezone:       p.Timezone,
+		Enabled:        p.Enabled,
+		Kind:           schedule.Kind(p.Kind),
+		Prompt:         p.Prompt,
+		Profile:        p.Profile,
+		RepoPath:       p.RepoPath,
+		BranchPrefix:   p.BranchPrefix,
+		Program:        p.Program,
+		Category:       p.Category,
+		TargetSession:  p.TargetSession,
+		TimeoutSeconds: int(p.TimeoutSeconds),
+		Overlap:        schedule.OverlapPolicy(p.Overlap),
+		CatchUp:        schedule.CatchUpPolicy(p.CatchUp),
+	}
+}
+
+func (s *SessionService) scheduleToProto(sc *schedule.Schedule) *sessionv1.SessionSchedule {
+	p := &sessionv1.SessionSchedule{
+		Id:             sc.ID,
+		Name:           sc.Name,
+		Cron:           sc.Cron,
+		Timezone:       sc.Timezone,
+		Enabled:        sc.Enabled,
+		Kind:           string(sc.Kind),
+		Prompt:         sc.Prompt,
+		Profile:        sc.Profile,
+		RepoPath:       sc.RepoPath,
+		BranchPrefix:   sc.BranchPrefix,
+		Program:        sc.Program,
+		Category:       sc.Category,
+		TargetSession:  sc.TargetSession,
+		TimeoutSeconds: int32(sc.TimeoutSeconds),
+		Overlap:        string(sc.Overlap),
+		CatchUp:        string(sc.CatchUp),
+		CreatedAt:      optionalTimestamp(sc.CreatedAt),
+		UpdatedAt:      optionalTimestamp(sc.UpdatedAt),
+	}
+	if sc.Enabled {
+		if next, err := sc.Next(time.Now()); err == nil {
+			p.NextRunAt = optionalTimestamp(next)
+		}
+	}
+	if runs, err := s.schedules.Runs(sc.ID, 1); err == nil && len(runs) == 1 {
+		p.LastRun = scheduleRunToProto(&runs[0])
+	}
+	return p
+}
+
+func scheduleRunToProto(r *schedule.Run) *sessionv1.ScheduleRun {
+	return &sessionv1.ScheduleRun{
+		Id:           r.ID,
+		ScheduleId:   r.ScheduleID,
+		Trigger:      string(r.Trigger),
+		Status:       string(r.Status),
+		ScheduledFor: optionalTimestamp(r.ScheduledFor),
+		StartedAt:    optionalTimestamp(r.StartedAt),
+		FinishedAt:   optionalTimestamp(r.FinishedAt),
+		SessionId:    r.SessionID,
+		SessionUuid:  r.SessionUUID,
+		Error:        r.Error,
+		ExitCode:     int32(r.ExitCode),
+		PrUrl:        r.PRURL,
+		Output:       r.Output,
+	}
+}
+
+// optionalTimestamp converts t, leaving zero times unset.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
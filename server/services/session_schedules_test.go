@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/session/schedule"
+)
+
+func TestScheduleRPCs(t *testing.T) {
+	svc := &SessionService{schedules: schedule.NewStore("")}
+	ctx := context.Background()
+
+	_, err := svc.CreateSchedule(ctx, connect.NewRequest(&sessionv1.CreateScheduleRequest{
+		Schedule: &sessionv1.SessionSchedule{Name: "prs", Cron: "not cron", Prompt: "p", RepoPath: "/repo"},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	created, err := svc.CreateSchedule(ctx, connect.NewRequest(&sessionv1.CreateScheduleRequest{
+		Schedule: &sessionv1.SessionSchedule{
+			Name:     "prs",
+			Cron:     "0 8 * * 1-5",
+			Timezone: "Europe/Berlin",
+			Enabled:  true,
+			Prompt:   "summarize open PRs",
+			RepoPath: "/repo",
+		},
+	}))
+	require.NoError(t, err)
+	sc := created.Msg.Schedule
+	assert.NotEmpty(t, sc.Id)
+	assert.Equal(t, "session", sc.Kind)
+	assert.Equal(t, "skip", sc.Overlap)
+	assert.Equal(t, "latest", sc.CatchUp)
+	require.NotNil(t, sc.NextRunAt)
+
+	disabled := false
+	overlap := "queue"
+	updated, err := svc.UpdateSchedule(ctx, connect.NewRequest(&sessionv1.UpdateScheduleRequest{
+		Id:      "prs",
+		Enabled: &disabled,
+		Overlap: &overlap,
+	}))
+	require.NoError(t, err)
+	assert.False(t, updated.Msg.Schedule.Enabled)
+	assert.Nil(t, updated.Msg.Schedule.NextRunAt, "disabled schedules have no next run")
+	assert.Equal(t, "queue", updated.Msg.Schedule.Overlap)
+	assert.Equal(t, "summarize open PRs", updated.Msg.Schedule.Prompt, "unset fields are kept")
+
+	_, err = svc.TriggerSchedule(ctx, connect.NewRequest(&sessionv1.TriggerScheduleRequest{Id: "prs"}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "no scheduler in this process")
+
+	_, err = svc.Schedules().AddRun(schedule.Run{ScheduleID: sc.Id, Status: schedule.RunSkipped})
+	require.NoError(t, err)
+	list, err := svc.ListSchedules(ctx, connect.NewRequest(&sessionv1.ListSchedulesRequest{}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.Schedules, 1)
+	require.NotNil(t, list.Msg.Schedules[0].LastRun)
+	assert.Equal(t, "skipped", list.Msg.Schedules[0].LastRun.Status)
+
+	runs, err := svc.ListScheduleRuns(ctx, connect.NewRequest(&sessionv1.ListScheduleRunsRequest{ScheduleId: "prs"}))
+	require.NoError(t, err)
+	assert.Len(t, runs.Msg.Runs, 1)
+
+	_, err = svc.DeleteSchedule(ctx, connect.NewRequest(&sessionv1.DeleteScheduleRequest{Id: "prs"}))
+	require.NoError(t, err)
+	_, err = svc.DeleteSchedule(ctx, connect.NewRequest(&sessionv1.DeleteScheduleRequest{Id: "prs"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
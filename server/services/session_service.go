@@ -5,12 +5,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/tstapler/stapler-squad/config"
@@ -22,14 +24,20 @@ import (
 	"github.com/tstapler/stapler-squad/server/adapters"
 	"github.com/tstapler/stapler-squad/server/events"
 	"github.com/tstapler/stapler-squad/server/notifications"
+	"github.com/tstapler/stapler-squad/server/ssp"
 	"github.com/tstapler/stapler-squad/session"
 	"github.com/tstapler/stapler-squad/session/detection"
+	"github.com/tstapler/stapler-squad/session/digest"
 	"github.com/tstapler/stapler-squad/session/ent"
 	"github.com/tstapler/stapler-squad/session/namegen"
 	"github.com/tstapler/stapler-squad/session/prompts"
+	"github.com/tstapler/stapler-squad/session/recording"
+	"github.com/tstapler/stapler-squad/session/schedule"
 	"github.com/tstapler/stapler-squad/session/search"
+	"github.com/tstapler/stapler-squad/session/templates"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -81,6 +89,15 @@ type SessionService struct {
 	// approvalStore holds pending Claude Code hook approval requests.
 	approvalStore *ApprovalStore
 
+	// prFeedback persists per-session opt-in to the PR review feedback loop.
+	prFeedback *session.PRFeedbackWatcher
+	// ciWatcher persists per-session opt-in to automatic CI fix prompts and
+	// sends the prepared prompt on demand.
+	ciWatcher *session.CIFailureWatcher
+
+	// resourceMonitor places new sessions in cgroups with their resolved limits.
+	resourceMonitor *session.ResourceMonitor
+
 	// databaseSvc handles workspace/database switcher RPCs.
 	databaseSvc *DatabaseService
 
@@ -99,6 +116,22 @@ type SessionService struct {
 	// promptStore persists prompt history for the "initial prompt" dropdown.
 	promptStore *prompts.PromptStore
 
+	// digests caches per-turn conversation digests for GetSessionTimeline.
+	digests *digest.Store
+
+	// recordings records opted-in session terminals for ExportRecording.
+	recordings *recording.Manager
+
+	// schedules holds recurring schedules and their run history. scheduler
+	// fires them and is only set in the daemon, by RunScheduler.
+	schedules   *schedule.Store
+	schedulerMu sync.Mutex
+	scheduler   *SessionScheduler
+
+	// templates holds personal session templates and resolves shared ones
+	// from a repository's templates directory.
+	templates *templates.Store
+
 	// scrollbackMgr provides access to per-session scrollback sequence numbers
 	// for checkpoint creation. May be nil if not wired (seq defaults to 0).
 	scrollbackMgr ScrollbackSequencer
@@ -107,6 +140,10 @@ type SessionService struct {
 	// When non-empty, passed to new sessions via InstanceOptions.MCPServerURL.
 	mcpServerURL string
 
+	// inputCoordinators arbitrates input between the terminal clients of each
+	// session. API input must pass it too. May be nil (no arbitration).
+	inputCoordinators *ssp.Registry
+
 	// branchCache caches git branch lists per repo path. ADR-002.
 	branchCache sync.Map // map[string]branchCacheEntry
 
@@ -126,6 +163,10 @@ type SessionService struct {
 	// analyticsClient is the ent client for the analytics database (escape events, etc.).
 	// May be nil when escape analytics is disabled or in tests that don't need it.
 	analyticsClient *ent.Client
+
+	// liveConfig is the last validated config.json, kept current by the config
+	// watcher through ApplyConfig. Nil when no watcher runs; see sessionConfig.
+	liveConfig atomic.Pointer[config.Config]
 }
 
 // ScrollbackSequencer is the minimal interface SessionService needs from ScrollbackManager.
@@ -155,12 +196,29 @@ func NewSessionService(storage session.InstanceStore, eventBus *events.EventBus)
 		log.Warn("failed to create index store, using in-memory search", "err", err)
 		searchEngine = search.NewSearchEngine()
 	} else {
-		searchEngine = search.NewSearchEngineWithPersistence(indexStore)
-		if loadErr := searchEngine.LoadIndex(); loadErr != nil {
-			log.Warn("failed to load persisted search index", "err", loadErr)
-		} else if searchEngine.GetSyncMetadata() != nil {
-			meta := searchEngine.GetSyncMetadata()
-			log.Info("loaded persisted search index", "sessions", meta.TotalSessions, "documents", meta.TotalDocuments)
+		// The index is kept as append-only segments; the single-snapshot files
+		// written by older versions are superseded and rebuilt on first search.
+		if indexStore.Exists() {
+			if delErr := indexStore.Delete(); delErr != nil {
+				log.Warn("failed to remove legacy search index", "err", delErr)
+			}
+		}
+		segments, segErr := search.NewSegmentStore(filepath.Join(indexStore.GetIndexDir(), "segments"))
+		if segErr != nil {
+			log.Warn("failed to create search segment store, using in-memory search", "err", segErr)
+			searchEngine = search.NewSearchEngine()
+		} else {
+			searchEngine = search.NewSearchEngineWithSegments(segments)
+			// Load in the background so startup never waits on index size.
+			loaded := searchEngine.LoadIndexAsync()
+			go func() {
+				if loadErr := <-loaded; loadErr != nil {
+					log.Warn("failed to load persisted search index", "err", loadErr)
+				} else if meta := searchEngine.GetSyncMetadata(); meta != nil {
+					log.Info("loaded persisted search index", "sessions", meta.TotalSessions, "documents", meta.TotalDocuments,
+						"segments", len(segments.Segments()))
+				}
+			}()
 		}
 	}
 
@@ -198,7 +256,7 @@ func NewSessionService(storage session.InstanceStore, eventBus *events.EventBus)
 
 	workspaceSvc := NewWorkspaceService(concStorage, eventBus)
 
-	return &SessionService{
+	s := &SessionService{
 		storage:           storage,
 		eventBus:          eventBus,
 		reviewQueueSvc:    reviewQueueSvc,
@@ -217,9 +275,42 @@ func NewSessionService(storage session.InstanceStore, eventBus *events.EventBus)
 		defaultsSvc:       NewDefaultsService(),
 		projectSvc:        NewProjectService(concStorage),
 		promptStore:       newPromptStore(),
+		digests:           newDigestStore(),
+		recordings:        newRecordingManager(),
+		schedules:         newScheduleStore(),
+		templates:         newTemplateStore(),
+	}
+	// Defaults saved through the API apply at once rather than after the
+	// watcher notices the write.
+	s.defaultsSvc.onSaved = s.refreshConfig
+	return s
+}
+
+// ApplyConfig makes cfg the configuration new sessions resolve their defaults
+// from. The config watcher calls it with every validated reload, so an edit
+// to config.json that fails validation never reaches session creation.
+func (s *SessionService) ApplyConfig(cfg *config.Config) {
+	s.liveConfig.Store(cfg)
+}
+
+// refreshConfig replaces the applied configuration after the server itself
+// saved config.json. Without a config watcher nothing is applied and
+// sessionConfig keeps reading the file.
+func (s *SessionService) refreshConfig(cfg *config.Config) {
+	if s.liveConfig.Load() != nil {
+		s.liveConfig.Store(cfg)
 	}
 }
 
+// sessionConfig returns the configuration applied by ApplyConfig, reading
+// config.json when none has been applied.
+func (s *SessionService) sessionConfig() *config.Config {
+	if cfg := s.liveConfig.Load(); cfg != nil {
+		return cfg
+	}
+	return config.LoadConfig()
+}
+
 // newPromptStore creates a PromptStore backed by ~/.stapler-squad/prompts.json.
 func newPromptStore() *prompts.PromptStore {
 	dir, err := config.GetConfigDir()
@@ -247,6 +338,12 @@ func (s *SessionService) loadInstancesWithWiring() ([]*session.Instance, error)
 		s.wireRateLimitCallbacks(inst)
 		s.wireStatusChangeCallback(inst)
 	}
+	if s.prFeedback != nil {
+		s.prFeedback.Restore(instances)
+	}
+	if s.ciWatcher != nil {
+		s.ciWatcher.Restore(instances)
+	}
 
 	return instances, nil
 }
@@ -410,6 +507,11 @@ func maybeAutoMigrateToEnt(repo *session.EntRepository) error {
 	return nil
 }
 
+// OnHistoryFileChanged forwards history file writes to the search index.
+func (s *SessionService) OnHistoryFileChanged(filePath string) {
+	s.searchSvc.OnHistoryFileChanged(filePath)
+}
+
 // GetEventBus returns the event bus instance for wiring up reactive components.
 func (s *SessionService) GetEventBus() *events.EventBus {
 	return s.eventBus
@@ -432,6 +534,12 @@ func (s *SessionService) SetMCPServerURL(url string) {
 	s.mcpServerURL = url
 }
 
+// SetInputCoordinators makes SendSessionInput respect the input arbitration
+// of the terminal clients streaming a session.
+func (s *SessionService) SetInputCoordinators(r *ssp.Registry) {
+	s.inputCoordinators = r
+}
+
 // SetBacklogLifecycleListener wires the listener to all sessions created via
 // CreateDirectorySession so that backlog state transitions fire on session exit.
 func (s *SessionService) SetBacklogLifecycleListener(l *session.BacklogLifecycleListener) {
@@ -441,8 +549,11 @@ func (s *SessionService) SetBacklogLifecycleListener(l *session.BacklogLifecycle
 // SpawnReviewSession satisfies the session.ReviewGateSpawner interface so that
 // BacklogLifecycleListener can spawn one-shot review sessions automatically when
 // a work session exits. The session is tagged "backlog:review" and runs one-shot.
-func (s *SessionService) SpawnReviewSession(ctx context.Context, item *ent.BacklogItem, itemSessionID string, prompt string) (*session.Instance, error) {
-	return s.CreateDirectorySession(ctx, "review:"+item.ID.String()[:8], item.RepoPath, prompt, []string{"backlog:review"}, true)
+// A non-empty program (from the review_panel config) replaces the default program.
+func (s *SessionService) SpawnReviewSession(ctx context.Context, item *ent.BacklogItem, itemSessionID string, prompt string, program string) (*session.Instance, error) {
+	opts := s.directorySessionOptions("review:"+item.ID.String()[:8], item.RepoPath, prompt, []string{"backlog:review"}, true)
+	opts.Program = program
+	return s.startDirectorySession(opts)
 }
 
 // CreateDirectorySession satisfies the services.SessionCreator interface so that
@@ -450,7 +561,11 @@ func (s *SessionService) SpawnReviewSession(ctx context.Context, item *ent.Backl
 // It creates a directory-type session with the given title, path, system prompt,
 // tags, and oneShot flag, wires it into the live poller, and returns the Instance.
 func (s *SessionService) CreateDirectorySession(ctx context.Context, title, path, appendSystemPrompt string, tags []string, oneShot bool) (*session.Instance, error) {
-	opts := session.InstanceOptions{
+	return s.startDirectorySession(s.directorySessionOptions(title, path, appendSystemPrompt, tags, oneShot))
+}
+
+func (s *SessionService) directorySessionOptions(title, path, appendSystemPrompt string, tags []string, oneShot bool) session.InstanceOptions {
+	return session.InstanceOptions{
 		Title:              title,
 		Path:               path,
 		SessionType:        session.SessionTypeDirectory,
@@ -460,6 +575,13 @@ func (s *SessionService) CreateDirectorySession(ctx context.Context, title, path
 		MCPServerURL:       s.mcpServerURL,
 		CreateIfMissing:    true,
 	}
+}
+
+// startDirectorySession creates and starts the instance, wires it into the
+// live poller and persists it.
+func (s *SessionService) startDirectorySession(opts session.InstanceOptions) (*session.Instance, error) {
+	resolved := config.ResolveDefaults(s.sessionConfig(), opts.Path, "")
+	opts.Sandbox = resolved.Sandbox
 	instance, err := session.NewInstance(opts)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDirectorySession: %w", err)
@@ -469,6 +591,7 @@ func (s *SessionService) CreateDirectorySession(ctx context.Context, title, path
 	}
 	s.wireRateLimitCallbacks(instance)
 	s.wireStatusChangeCallback(instance)
+	s.attachResources(instance, resolved.ResourceLimits)
 	if err := s.storage.AddInstance(instance); err != nil {
 		_ = instance.Destroy()
 		return nil, fmt.Errorf("CreateDirectorySession save: %w", err)
@@ -499,6 +622,35 @@ func (s *SessionService) SetReviewQueuePoller(poller *session.ReviewQueuePoller)
 	s.utilitySvc.SetReviewQueuePoller(poller)
 }
 
+// SetPRFeedbackWatcher wires the PR review feedback loop so UpdateSession can
+// persist per-session opt-in changes.
+func (s *SessionService) SetPRFeedbackWatcher(w *session.PRFeedbackWatcher) {
+	s.prFeedback = w
+}
+
+// SetCIFailureWatcher wires the CI failure loop so UpdateSession can persist
+// per-session auto-fix opt-in and SendCIFixPrompt can deliver the prepared prompt.
+func (s *SessionService) SetCIFailureWatcher(w *session.CIFailureWatcher) {
+	s.ciWatcher = w
+}
+
+// SetResourceMonitor wires cgroup accounting so created sessions get their
+// resolved resource limits and deleted sessions release their cgroup.
+func (s *SessionService) SetResourceMonitor(m *session.ResourceMonitor) {
+	s.resourceMonitor = m
+}
+
+// attachResources places a freshly started session in its cgroup. Failures
+// are logged; the session keeps running unaccounted.
+func (s *SessionService) attachResources(instance *session.Instance, limits config.ResourceLimits) {
+	if s.resourceMonitor == nil {
+		return
+	}
+	if err := s.resourceMonitor.Attach(instance, limits); err != nil {
+		log.Warn("failed to place session in cgroup", "session", instance.Title, "err", err)
+	}
+}
+
 // SetStatusManager wires the InstanceStatusManager so that instances loaded via
 // loadInstancesWithWiring (e.g., fallback path in ListSessions) receive status tracking.
 // Must be called during server startup.
@@ -653,6 +805,16 @@ func (s *SessionService) GetSession(
 func (s *SessionService) CreateSession(
 	ctx context.Context,
 	req *connect.Request[sessionv1.CreateSessionRequest],
+) (*connect.Response[sessionv1.CreateSessionResponse], error) {
+	return s.createSession(ctx, req, nil)
+}
+
+// createSession implements CreateSession. setup, when set, runs in the new
+// session's working directory before its program starts.
+func (s *SessionService) createSession(
+	ctx context.Context,
+	req *connect.Request[sessionv1.CreateSessionRequest],
+	setup session.SetupFunc,
 ) (*connect.Response[sessionv1.CreateSessionResponse], error) {
 	// Validate required fields
 	if req.Msg.Title == "" {
@@ -703,7 +865,7 @@ func (s *SessionService) CreateSession(
 
 	// One-off session: generate a fresh directory and override resolvedPath.
 	if req.Msg.OneOff {
-		cfg := config.LoadConfig()
+		cfg := s.sessionConfig()
 		baseDir, err := cfg.OneOffBaseDirOrDefault()
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve one_off_base_dir: %w", err))
@@ -719,8 +881,10 @@ func (s *SessionService) CreateSession(
 	// skip_defaults bypasses this for scripted or explicit-empty sessions.
 	program := req.Msg.Program
 	autoYes := req.Msg.AutoYes
+	var resourceLimits config.ResourceLimits
+	var sandboxCfg config.SandboxConfig
 	if !req.Msg.SkipDefaults {
-		cfg := config.LoadConfig()
+		cfg := s.sessionConfig()
 		workingDir := req.Msg.WorkingDir
 		if workingDir == "" {
 			workingDir = resolvedPath
@@ -733,6 +897,25 @@ func (s *SessionService) CreateSession(
 		if !autoYes && resolved.AutoYes {
 			autoYes = true
 		}
+		resourceLimits = resolved.ResourceLimits
+		sandboxCfg = resolved.Sandbox
+	}
+
+	// Resuming another agent's conversation needs that agent's CLI, so swap
+	// the executable and keep the configured arguments; forking resumes a
+	// copy so the original conversation stays untouched.
+	resumeID := req.Msg.ResumeId
+	if resumeID != "" && req.Msg.ResumeProvider != "" &&
+		session.HistoryProviderForProgram(program) != req.Msg.ResumeProvider {
+		program = session.ProgramForProvider(program, req.Msg.ResumeProvider)
+	}
+	if resumeID != "" && req.Msg.ForkResume {
+		forkedID, err := session.ForkHistoryConversation(req.Msg.ResumeProvider, resumeID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to fork conversation: %w", err))
+		}
+		log.Info("[CreateSession] forked conversation for resume", "provider", req.Msg.ResumeProvider, "from", resumeID, "to", forkedID)
+		resumeID = forkedID
 	}
 
 	// Determine session type - use explicit session_type if provided, otherwise infer from fields
@@ -763,11 +946,14 @@ func (s *SessionService) CreateSession(
 		Category:         req.Msg.Category,
 		SessionType:      sessionType,
 		TmuxPrefix:       "", // Use default from config
-		ResumeId:         req.Msg.ResumeId,
+		ResumeId:         resumeID,
 		OneShot:          req.Msg.OneShot,
 		ProjectID:        req.Msg.ProjectId,
 		MCPServerURL:     s.mcpServerURL,
 		CreateIfMissing:  req.Msg.CreateIfMissing,
+		Sandbox:          sandboxCfg,
+		Tags:             req.Msg.Tags,
+		Setup:            setup,
 	}
 
 	// Add GitHub metadata if this was a GitHub URL
@@ -797,6 +983,7 @@ func (s *SessionService) CreateSession(
 	// Wire rate limit event callbacks so detection/recovery fire server-level notifications.
 	s.wireRateLimitCallbacks(instance)
 	s.wireStatusChangeCallback(instance)
+	s.attachResources(instance, resourceLimits)
 
 	// Inject Claude Code HTTP hook config for remote approval from the web UI.
 	// Non-fatal: session is fully functional even without this config.
@@ -882,6 +1069,12 @@ func (s *SessionService) UpdateSession(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load instances: %w", err))
 	}
+	if s.prFeedback != nil {
+		s.prFeedback.Restore(instances)
+	}
+	if s.ciWatcher != nil {
+		s.ciWatcher.Restore(instances)
+	}
 
 	// Find the instance to update
 	var instance *session.Instance
@@ -967,6 +1160,41 @@ func (s *SessionService) UpdateSession(
 		updatedFields = append(updatedFields, "rate_limit_enabled")
 	}
 
+	// Handle PR review feedback loop toggle. The watcher persists the opt-in in its
+	// own store; the live poller instance is updated so the next PR poll sees it.
+	if req.Msg.PrFeedbackEnabled != nil {
+		enabled := *req.Msg.PrFeedbackEnabled
+		if s.prFeedback != nil {
+			if err := s.prFeedback.SetEnabled(ctx, instance, enabled); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to persist PR feedback setting: %w", err))
+			}
+		}
+		instance.SetPRFeedbackEnabled(enabled)
+		if s.reviewQueuePoller != nil {
+			if liveInst := s.reviewQueuePoller.FindInstance(req.Msg.Id); liveInst != nil {
+				liveInst.SetPRFeedbackEnabled(enabled)
+			}
+		}
+		updatedFields = append(updatedFields, "pr_feedback_enabled")
+	}
+
+	// Handle automatic CI fix toggle, persisted by the CI failure watcher.
+	if req.Msg.CiAutofixEnabled != nil {
+		enabled := *req.Msg.CiAutofixEnabled
+		if s.ciWatcher != nil {
+			if err := s.ciWatcher.SetEnabled(instance, enabled); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to persist CI auto-fix setting: %w", err))
+			}
+		}
+		instance.SetCIAutoFixEnabled(enabled)
+		if s.reviewQueuePoller != nil {
+			if liveInst := s.reviewQueuePoller.FindInstance(req.Msg.Id); liveInst != nil {
+				liveInst.SetCIAutoFixEnabled(enabled)
+			}
+		}
+		updatedFields = append(updatedFields, "ci_autofix_enabled")
+	}
+
 	// Handle status change (pause/resume) LAST - after all metadata updates.
 	// This ensures that if Resume() fails, no partial metadata changes are persisted
 	// (save only happens after all changes succeed).
@@ -1005,10 +1233,10 @@ func (s *SessionService) UpdateSession(
 		// Check if status changed specifically
 		if oldStatus != instance.Status && oldStatus != 0 {
 			statusEvent := events.NewSessionStatusChangedEvent(instance, oldStatus, instance.Status)
-			// Augment with terminal-detected status when a controller is active for this session
+			// Augment with hook-reported or terminal-detected status when either is available
 			if s.statusManager != nil {
 				statusInfo := s.statusManager.GetStatus(instance)
-				if statusInfo.IsControllerActive {
+				if statusInfo.IsControllerActive || statusInfo.HasActivity {
 					statusEvent.DetectedStatus = statusInfo.ClaudeStatus.String()
 					statusEvent.DetectedContext = statusInfo.StatusContext
 				}
@@ -1077,6 +1305,17 @@ func (s *SessionService) DeleteSession(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete instance from storage: %w", err))
 	}
 
+	if s.digests != nil {
+		if err := s.digests.Delete(sessionUUID); err != nil {
+			log.Warn("failed to delete session timeline", "session", req.Msg.Id, "err", err)
+		}
+	}
+	if s.recordings != nil {
+		if err := s.recordings.Delete(sessionTitle); err != nil {
+			log.Warn("failed to delete session recording", "session", req.Msg.Id, "err", err)
+		}
+	}
+
 	// Publish SessionDeleted event to all watchers. Use UUID so the frontend
 	// entity adapter (keyed by UUID) matches and tombstones the correct entry.
 	s.eventBus.Publish(events.NewSessionDeletedEvent(sessionUUID))
@@ -1102,6 +1341,9 @@ func (s *SessionService) removeFromAllPollers(id string) {
 	if s.historyLinker != nil {
 		s.historyLinker.RemoveInstance(id)
 	}
+	if s.resourceMonitor != nil {
+		s.resourceMonitor.RemoveInstance(id)
+	}
 }
 
 // RemoveFromAllPollers is the exported version for use by MCP tools and other
@@ -1271,6 +1513,7 @@ func (s *SessionService) StreamTerminal(
 	// Initialize terminal state for MOSH-style state synchronization (default 80x25)
 	// Will be resized when client sends first resize message
 	terminalState := session.NewTerminalState(25, 80)
+	terminalState.SetNewlineMode(false) // raw PTY output already carries CRLF
 
 	// Flow control state for backpressure management
 	// Reference: https://xtermjs.org/docs/guides/flowcontrol/
@@ -1682,6 +1925,36 @@ func (s *SessionService) ClosePR(
 	return s.githubSvc.ClosePR(ctx, req)
 }
 
+// SendCIFixPrompt queues the prompt prepared for the session's failing CI
+// checks to its agent.
+func (s *SessionService) SendCIFixPrompt(
+	ctx context.Context,
+	req *connect.Request[sessionv1.SendCIFixPromptRequest],
+) (*connect.Response[sessionv1.SendCIFixPromptResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("session id is required"))
+	}
+	if s.ciWatcher == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("CI failure watcher is not configured"))
+	}
+	var instance *session.Instance
+	if s.reviewQueuePoller != nil {
+		instance = s.reviewQueuePoller.FindInstance(req.Msg.Id)
+	}
+	if instance == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("session not found or not running: %s", req.Msg.Id))
+	}
+
+	prompt, err := s.ciWatcher.SendPrompt(instance)
+	if err != nil {
+		if errors.Is(err, session.ErrNoCIFailure) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&sessionv1.SendCIFixPromptResponse{Prompt: prompt}), nil
+}
+
 // SendNotification allows tmux sessions and external Claude processes to send notifications.
 func (s *SessionService) SendNotification(
 	ctx context.Context,
@@ -2503,19 +2776,46 @@ func (s *SessionService) BatchCreateSessions(
 			repoLock.Lock()
 			defer repoLock.Unlock()
 
-			createReq := connect.NewRequest(&sessionv1.CreateSessionRequest{
-				Title:       batchReq.Title,
-				Path:        batchReq.Path,
-				WorkingDir:  batchReq.WorkingDir,
-				Branch:      batchReq.Branch,
-				Program:     batchReq.Program,
-				Category:    batchReq.Category,
-				AutoYes:     batchReq.AutoYes,
-				SessionType: batchReq.SessionType,
-				ProjectId:   batchReq.ProjectId,
-			})
-
-			resp, createErr := s.CreateSession(ctx, createReq)
+			var created *sessionv1.Session
+			var createErr error
+			if batchReq.Template != "" {
+				var ts *TemplateSession
+				ts, createErr = s.CreateTemplateSession(ctx, TemplateSpawn{
+					Template:    batchReq.Template,
+					RepoPath:    batchReq.Path,
+					Title:       batchReq.Title,
+					Variables:   batchReq.TemplateVariables,
+					Branch:      batchReq.Branch,
+					Program:     batchReq.Program,
+					Category:    batchReq.Category,
+					WorkingDir:  batchReq.WorkingDir,
+					ProjectID:   batchReq.ProjectId,
+					SessionType: batchReq.SessionType,
+					AutoYes:     batchReq.AutoYes,
+					Tags:        batchReq.Tags,
+				})
+				if createErr == nil {
+					created = ts.Session
+				}
+			} else {
+				var resp *connect.Response[sessionv1.CreateSessionResponse]
+				resp, createErr = s.CreateSession(ctx, connect.NewRequest(&sessionv1.CreateSessionRequest{
+					Title:       batchReq.Title,
+					Path:        batchReq.Path,
+					WorkingDir:  batchReq.WorkingDir,
+					Branch:      batchReq.Branch,
+					Program:     batchReq.Program,
+					Category:    batchReq.Category,
+					AutoYes:     batchReq.AutoYes,
+					SessionType: batchReq.SessionType,
+					ProjectId:   batchReq.ProjectId,
+					Tags:        batchReq.Tags,
+				}))
+				if createErr == nil {
+					created = resp.Msg.Session
+				}
+			}
+
 			// Each goroutine writes to a distinct index, so no mutex needed.
 			if createErr != nil {
 				results[i] = &sessionv1.BatchCreateResult{
@@ -2527,7 +2827,7 @@ func (s *SessionService) BatchCreateSessions(
 				results[i] = &sessionv1.BatchCreateResult{
 					Success:   true,
 					Title:     batchReq.Title,
-					SessionId: resp.Msg.Session.Id,
+					SessionId: created.Id,
 				}
 			}
 		}(idx, sess)
@@ -2781,6 +3081,92 @@ func (s *SessionService) GetTerminalSnapshot(
 	}), nil
 }
 
+// maxSessionInputBytes caps a single SendSessionInput call; prompts longer
+// than this belong in a file the agent is told to read.
+const maxSessionInputBytes = 64 * 1024
+
+// +api: session:send-input
+// SendSessionInput types text into a session's terminal. With submit set the
+// text is sent as a prompt followed by Enter.
+func (s *SessionService) SendSessionInput(
+	ctx context.Context,
+	req *connect.Request[sessionv1.SendSessionInputRequest],
+) (*connect.Response[sessionv1.SendSessionInputResponse], error) {
+	if req.Msg.SessionId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("session_id is required"))
+	}
+	if req.Msg.Text == "" && !req.Msg.Submit {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("text is required"))
+	}
+	if len(req.Msg.Text) > maxSessionInputBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("text exceeds %d bytes", maxSessionInputBytes))
+	}
+
+	inst := s.findInstance(req.Msg.SessionId)
+	if inst == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("session not found: %s", req.Msg.SessionId))
+	}
+	if !inst.Permissions.CanSendCommand {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("session %s does not accept input", inst.Title))
+	}
+	done, err := s.AuthorizeOneShotInput(inst.Title, "API")
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot send input to %s: %w", inst.Title, err))
+	}
+	defer done()
+
+	// A wedged pane can block tmux send-keys; don't hold the request forever.
+	errCh := make(chan error, 1)
+	go func() {
+		if req.Msg.Submit {
+			errCh <- inst.SendPrompt(req.Msg.Text)
+		} else {
+			errCh <- inst.SendKeys(req.Msg.Text)
+		}
+	}()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to send input: %w", err))
+		}
+	case <-ctx.Done():
+		return nil, connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("timed out sending input to %s", inst.Title))
+	}
+
+	inst.UpdateTerminalTimestamps(req.Msg.Text, true)
+	inst.MarkUserResponded()
+
+	written := len(req.Msg.Text)
+	if req.Msg.Submit {
+		written++
+	}
+	return connect.NewResponse(&sessionv1.SendSessionInputResponse{BytesWritten: int32(written)}), nil
+}
+
+// AuthorizeOneShotInput admits one write to a session's terminal from
+// outside its terminal clients, such as the API or an MCP tool. The writer
+// takes part in the input arbitration of the clients streaming the session,
+// like a client that types and disconnects, and is shown to them as label.
+// Call done once the write has finished.
+func (s *SessionService) AuthorizeOneShotInput(title, label string) (done func(), err error) {
+	if s.inputCoordinators == nil {
+		return func() {}, nil
+	}
+	coord, ok := s.inputCoordinators.Lookup(title)
+	if !ok {
+		return func() {}, nil
+	}
+	clientID := strings.ToLower(label) + "-" + uuid.NewString()
+	coord.Input().Join(clientID, ssp.ClientInfo{Label: label})
+	if err := coord.AuthorizeInput(clientID); err != nil {
+		coord.Input().Leave(clientID)
+		return nil, err
+	}
+	return func() { coord.Input().Leave(clientID) }, nil
+}
+
 // +api: session:log-client-events
 // LogClientEvents receives batched browser console log entries from the web UI.
 // Used for remote debugging of mobile browser sessions where DevTools are unavailable.
@@ -2950,6 +3336,10 @@ var knownFeatureFlags = []struct {
 		name:        "backlog",
 		description: "Backlog management with external sync sources and AI-driven triage",
 	},
+	{
+		name:        "backlog_autodispatch",
+		description: "Automatically start sessions for ready, unblocked backlog items up to each repository's WIP limit (backlog_dispatch in config.json)",
+	},
 }
 
 // +api: feature-flags:list
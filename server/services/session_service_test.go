@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
 	"github.com/tstapler/stapler-squad/server/events"
+	"github.com/tstapler/stapler-squad/server/ssp"
 	"github.com/tstapler/stapler-squad/session"
 )
 
@@ -893,3 +895,100 @@ func TestCreateSession_TitleAlreadyExists(t *testing.T) {
 	require.ErrorAs(t, err, &connectErr)
 	assert.Equal(t, connect.CodeAlreadyExists, connectErr.Code())
 }
+
+// --------------------------------------------------------------------------
+// SendSessionInput
+// --------------------------------------------------------------------------
+
+// TestSendSessionInput_Validation verifies that SendSessionInput rejects
+// requests without a session or text before looking anything up.
+func TestSendSessionInput_Validation(t *testing.T) {
+	fix := setupForkTestFixture(t)
+	t.Cleanup(fix.cleanup)
+
+	cases := map[string]*sessionv1.SendSessionInputRequest{
+		"missing session": {Text: "hello"},
+		"missing text":    {SessionId: "s"},
+		"text too long":   {SessionId: "s", Text: strings.Repeat("x", maxSessionInputBytes+1)},
+	}
+	for name, req := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := fix.svc.SendSessionInput(context.Background(), connect.NewRequest(req))
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+		})
+	}
+}
+
+// TestSendSessionInput_NotFound verifies that input for an unknown session
+// returns CodeNotFound.
+func TestSendSessionInput_NotFound(t *testing.T) {
+	fix := setupForkTestFixture(t)
+	t.Cleanup(fix.cleanup)
+
+	_, err := fix.svc.SendSessionInput(context.Background(), connect.NewRequest(&sessionv1.SendSessionInputRequest{
+		SessionId: "no-such-session",
+		Text:      "hello",
+	}))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, connect.CodeNotFound, connectErr.Code())
+}
+
+// TestSendSessionInput_RespectsInputControl verifies that API input is
+// refused while another terminal client holds single-writer control.
+func TestSendSessionInput_RespectsInputControl(t *testing.T) {
+	fix := setupForkTestFixture(t)
+	t.Cleanup(fix.cleanup)
+
+	fix.poller.AddInstance(&session.Instance{
+		Title:       "shared-session",
+		Status:      session.Running,
+		Program:     "claude",
+		Path:        "/tmp/test",
+		Permissions: session.GetManagedPermissions(),
+	})
+	cfg := ssp.DefaultConfig()
+	cfg.InputMode = ssp.InputModeSingleWriter
+	registry := ssp.NewRegistry(cfg)
+	fix.svc.SetInputCoordinators(registry)
+	coord, release := registry.Acquire("shared-session")
+	defer release()
+	coord.Input().Join("writer", ssp.ClientInfo{Label: "browser"})
+	require.NoError(t, coord.AuthorizeInput("writer"))
+
+	_, err := fix.svc.SendSessionInput(context.Background(), connect.NewRequest(&sessionv1.SendSessionInputRequest{
+		SessionId: "shared-session",
+		Text:      "hello",
+	}))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, connect.CodePermissionDenied, connectErr.Code())
+	assert.Equal(t, "writer", coord.Input().State().Writer, "control stays with the writer")
+	assert.Len(t, coord.Input().State().Clients, 1, "the API client leaves after the write")
+}
+
+// TestSendSessionInput_PermissionDenied verifies that sessions which may not
+// receive commands, such as unattached external sessions, refuse input.
+func TestSendSessionInput_PermissionDenied(t *testing.T) {
+	fix := setupForkTestFixture(t)
+	t.Cleanup(fix.cleanup)
+
+	fix.poller.AddInstance(&session.Instance{
+		Title:       "external-session",
+		Status:      session.Running,
+		Program:     "claude",
+		Path:        "/tmp/test",
+		Permissions: session.GetExternalPermissions(false),
+	})
+
+	_, err := fix.svc.SendSessionInput(context.Background(), connect.NewRequest(&sessionv1.SendSessionInputRequest{
+		SessionId: "external-session",
+		Text:      "hello",
+		Submit:    true,
+	}))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, connect.CodePermissionDenied, connectErr.Code())
+}
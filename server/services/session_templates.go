@@ -0,0 +1,380 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/templates"
+)
+
+// newTemplateStore opens ~/.stapler-squad/templates.json.
+func newTemplateStore() *templates.Store {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		log.Warn("[Templates] failed to get config dir", "err", err)
+		return templates.NewStore(filepath.Join(os.TempDir(), "stapler-squad-templates.json"))
+	}
+	return templates.NewStore(filepath.Join(dir, "templates.json"))
+}
+
+// TemplateSpawn describes a session to create from a template. Empty
+// override fields keep the template's values.
+type TemplateSpawn struct {
+	// Template is a name, optionally name@version.
+	Template  string
+	RepoPath  string
+	Title     string
+	Variables map[string]string
+	// Defaults fill variables the template declares but Variables leaves
+	// unset. Names the template does not declare are ignored.
+	Defaults map[string]string
+
+	Branch      string
+	Program     string
+	Category    string
+	WorkingDir  string
+	ProjectID   string
+	SessionType sessionv1.SessionType
+	AutoYes     bool
+	// Tags are added to the template's.
+	Tags []string
+	// PromptSuffix is appended to the rendered prompt.
+	PromptSuffix string
+	// TrustSetup trusts a shared template's setup script, as it is now, in
+	// RepoPath. Without it an untrusted script fails the creation.
+	TrustSetup bool
+}
+
+// TemplateSession is the result of CreateTemplateSession.
+type TemplateSession struct {
+	Session     *sessionv1.Session
+	Template    templates.Template
+	SetupOutput string
+}
+
+// CreateTemplateSession renders a template and creates the session through
+// CreateSession, running the template's setup script in the new session's
+// working directory, inside its sandbox if it has one, before the agent
+// starts. A shared template's setup script runs only once the user trusted
+// it. Errors are connect errors.
+func (s *SessionService) CreateTemplateSession(ctx context.Context, spawn TemplateSpawn) (*TemplateSession, error) {
+	name, version, err := templates.ParseRef(spawn.Template)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template is required"))
+	}
+	if spawn.RepoPath == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("repo_path is required"))
+	}
+	tpl, err := s.templates.Get(spawn.RepoPath, name, version)
+	if err != nil {
+		return nil, templateError(err)
+	}
+	trusted, err := s.templates.Trusted(spawn.RepoPath, tpl)
+	if err != nil {
+		return nil, templateError(err)
+	}
+	if !trusted {
+		if !spawn.TrustSetup {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf(
+				"shared template %s has a setup script that has not been trusted in this repository; review %s and create the session with trust_setup_script (ssq new --trust-setup) to run it",
+				tpl.Name, filepath.Join(templates.RepoDir, tpl.Name+".json")))
+		}
+		if err := s.templates.Trust(spawn.RepoPath, tpl); err != nil {
+			return nil, templateError(err)
+		}
+		log.Info("[Templates] setup script trusted", "template", tpl.Name, "repo", spawn.RepoPath)
+	}
+	values := make(map[string]string, len(spawn.Variables))
+	for _, v := range tpl.Variables {
+		if d, ok := spawn.Defaults[v.Name]; ok && d != "" {
+			values[v.Name] = d
+		}
+	}
+	for name, value := range spawn.Variables {
+		if value != "" || values[name] == "" {
+			values[name] = value
+		}
+	}
+	rendered, err := tpl.Render(values, spawn.RepoPath, time.Now())
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	title := spawn.Title
+	if title == "" {
+		title = tpl.Name + "-" + time.Now().Format("20060102-150405")
+	}
+	req := &sessionv1.CreateSessionRequest{
+		Title:       title,
+		Path:        spawn.RepoPath,
+		WorkingDir:  spawn.WorkingDir,
+		Program:     firstNonEmpty(spawn.Program, rendered.Program),
+		Profile:     rendered.Profile,
+		Category:    spawn.Category,
+		Prompt:      rendered.Prompt + spawn.PromptSuffix,
+		AutoYes:     spawn.AutoYes,
+		ProjectId:   spawn.ProjectID,
+		SessionType: spawn.SessionType,
+		Tags:        mergeTags(rendered.Tags, spawn.Tags),
+	}
+	if req.SessionType == sessionv1.SessionType_SESSION_TYPE_UNSPECIFIED {
+		req.SessionType = sessionv1.SessionType_SESSION_TYPE_DIRECTORY
+		if rendered.SessionType == templates.SessionTypeNewWorktree {
+			req.SessionType = sessionv1.SessionType_SESSION_TYPE_NEW_WORKTREE
+		}
+	}
+	if req.SessionType == sessionv1.SessionType_SESSION_TYPE_NEW_WORKTREE {
+		req.Branch = firstNonEmpty(spawn.Branch, rendered.Branch)
+	}
+
+	var output string
+	var setupErr error
+	setup := func(dir string, wrap func(argv []string) []string) error {
+		output, setupErr = templates.RunSetup(ctx, rendered, dir, wrap)
+		return setupErr
+	}
+	resp, err := s.createSession(ctx, connect.NewRequest(req), setup)
+	if setupErr != nil {
+		err = setupErr
+		if output != "" {
+			err = fmt.Errorf("%w:\n%s", err, output)
+		}
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	created := resp.Msg.Session
+	// Backlog items spawned from a template move on when their session ends,
+	// like those spawned by CreateDirectorySession.
+	if inst := s.FindLiveInstance(created.GetId()); inst != nil && s.backlogLifecycleListener != nil {
+		s.backlogLifecycleListener.WireToInstance(inst)
+	}
+	log.Info("[Templates] session created from template", "template", tpl.Name, "version", tpl.Version, "source", tpl.Source, "session", created.GetTitle())
+	return &TemplateSession{Session: created, Template: tpl, SetupOutput: output}, nil
+}
+
+// firstNonEmpty returns the first non-empty string.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// mergeTags returns the union of both tag lists, in order.
+func mergeTags(a, b []string) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, t := range append(append([]string(nil), a...), b...) {
+		if t != "" && !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// templateError maps template errors to connect codes.
+func templateError(err error) error {
+	switch {
+	case errors.Is(err, templates.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, templates.ErrInvalid):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// ListTemplates returns the latest version of every template available for a
+// repository: personal templates first, then the shared ones they do not
+// shadow.
+// +api: session:list-templates
+func (s *SessionService) ListTemplates(
+	ctx context.Context,
+	req *connect.Request[sessionv1.ListTemplatesRequest],
+) (*connect.Response[sessionv1.ListTemplatesResponse], error) {
+	list, err := s.templates.List(req.Msg.RepoPath)
+	resp := &sessionv1.ListTemplatesResponse{}
+	if err != nil {
+		if list == nil {
+			return nil, templateError(err)
+		}
+		// Broken shared template files do not hide the others.
+		resp.Warnings = strings.Split(err.Error(), "\n")
+	}
+	for i := range list {
+		resp.Templates = append(resp.Templates, templateToProto(&list[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetTemplate returns one version of a template, the latest by default.
+// +api: session:get-template
+func (s *SessionService) GetTemplate(
+	ctx context.Context,
+	req *connect.Request[sessionv1.GetTemplateRequest],
+) (*connect.Response[sessionv1.GetTemplateResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	tpl, err := s.templates.Get(req.Msg.RepoPath, req.Msg.Name, int(req.Msg.Version))
+	if err != nil {
+		return nil, templateError(err)
+	}
+	resp := &sessionv1.GetTemplateResponse{Template: templateToProto(&tpl)}
+	if tpl.Source == templates.SourceRepo {
+		resp.Versions = []int32{int32(tpl.Version)}
+	} else {
+		versions, err := s.templates.Versions(tpl.Name)
+		if err != nil {
+			return nil, templateError(err)
+		}
+		for _, v := range versions {
+			resp.Versions = append(resp.Versions, int32(v))
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SaveTemplate stores the next version of a personal template, or shares it
+// by writing it into the repository's templates directory.
+// +api: session:save-template
+func (s *SessionService) SaveTemplate(
+	ctx context.Context,
+	req *connect.Request[sessionv1.SaveTemplateRequest],
+) (*connect.Response[sessionv1.SaveTemplateResponse], error) {
+	if req.Msg.Template == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template is required"))
+	}
+	tpl := templateFromProto(req.Msg.Template)
+	var saved templates.Template
+	var err error
+	if req.Msg.Shared {
+		saved, err = templates.Share(req.Msg.RepoPath, tpl)
+	} else {
+		saved, err = s.templates.Save(tpl)
+	}
+	if err != nil {
+		return nil, templateError(err)
+	}
+	log.Info("[Templates] template saved", "template", saved.Name, "version", saved.Version, "source", saved.Source)
+	return connect.NewResponse(&sessionv1.SaveTemplateResponse{Template: templateToProto(&saved)}), nil
+}
+
+// DeleteTemplate removes every version of a personal template. Shared
+// templates are deleted by removing their file from the repository.
+// +api: session:delete-template
+func (s *SessionService) DeleteTemplate(
+	ctx context.Context,
+	req *connect.Request[sessionv1.DeleteTemplateRequest],
+) (*connect.Response[sessionv1.DeleteTemplateResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	if err := s.templates.Delete(req.Msg.Name); err != nil {
+		return nil, templateError(err)
+	}
+	return connect.NewResponse(&sessionv1.DeleteTemplateResponse{}), nil
+}
+
+// CreateSessionFromTemplate creates a session from a template.
+// +api: session:create-from-template
+func (s *SessionService) CreateSessionFromTemplate(
+	ctx context.Context,
+	req *connect.Request[sessionv1.CreateSessionFromTemplateRequest],
+) (*connect.Response[sessionv1.CreateSessionFromTemplateResponse], error) {
+	m := req.Msg
+	created, err := s.CreateTemplateSession(ctx, TemplateSpawn{
+		Template:   m.Template,
+		RepoPath:   m.RepoPath,
+		Title:      m.Title,
+		Variables:  m.Variables,
+		Branch:     m.Branch,
+		Program:    m.Program,
+		Category:   m.Category,
+		Tags:       m.Tags,
+		AutoYes:    m.AutoYes,
+		TrustSetup: m.TrustSetupScript,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&sessionv1.CreateSessionFromTemplateResponse{
+		Session:     created.Session,
+		Template:    templateToProto(&created.Template),
+		SetupOutput: created.SetupOutput,
+	}), nil
+}
+
+func templateFromProto(p *sessionv1.SessionTemplate) templates.Template {
+	t := templates.Template{
+		Name:               p.Name,
+		Version:            int(p.Version),
+		Description:        p.Description,
+		Program:            p.Program,
+		Profile:            p.Profile,
+		SessionType:        p.SessionType,
+		BranchPattern:      p.BranchPattern,
+		Tags:               p.Tags,
+		Prompt:             p.Prompt,
+		SetupScript:        p.SetupScript,
+		AcceptanceCriteria: p.AcceptanceCriteria,
+	}
+	for _, v := range p.Variables {
+		t.Variables = append(t.Variables, templates.Variable{
+			Name:        v.Name,
+			Type:        templates.VarType(v.Type),
+			Description: v.Description,
+			Required:    v.Required,
+			Default:     v.DefaultValue,
+			Options:     v.Options,
+		})
+	}
+	return t
+}
+
+func templateToProto(t *templates.Template) *sessionv1.SessionTemplate {
+	p := &sessionv1.SessionTemplate{
+		Name:               t.Name,
+		Version:            int32(t.Version),
+		Description:        t.Description,
+		Program:            t.Program,
+		Profile:            t.Profile,
+		SessionType:        t.SessionType,
+		BranchPattern:      t.BranchPattern,
+		Tags:               t.Tags,
+		Prompt:             t.Prompt,
+		SetupScript:        t.SetupScript,
+		AcceptanceCriteria: t.AcceptanceCriteria,
+		Source:             t.Source,
+		CreatedAt:          optionalTimestamp(t.CreatedAt),
+		UpdatedAt:          optionalTimestamp(t.UpdatedAt),
+	}
+	for _, v := range t.Variables {
+		p.Variables = append(p.Variables, &sessionv1.TemplateVariable{
+			Name:         v.Name,
+			Type:         string(v.Type),
+			Description:  v.Description,
+			Required:     v.Required,
+			DefaultValue: v.Default,
+			Options:      v.Options,
+		})
+	}
+	return p
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tstapler/stapler-squad/executor/safeexec"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/session/templates"
+)
+
+func TestTemplateRPCs(t *testing.T) {
+	svc := &SessionService{
+		storage:   &fakeInstanceStore{},
+		templates: templates.NewStore(filepath.Join(t.TempDir(), "templates.json")),
+	}
+	ctx := context.Background()
+	repo := t.TempDir()
+	// Setup scripts run in the session's directory, which must be a repository.
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.email=test@example.com", "-c", "user.name=Test", "commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		require.NoError(t, safeexec.CommandContext(ctx, "git", append([]string{"-C", repo}, args...)...).Run())
+	}
+
+	marker := filepath.Join(t.TempDir(), "setup.out")
+	tpl := &sessionv1.SessionTemplate{
+		Name:          "fix-issue",
+		BranchPattern: "fix/{{module}}",
+		Prompt:        "Fix {{issue_url}} in {{module}}.",
+		Variables: []*sessionv1.TemplateVariable{
+			{Name: "issue_url", Type: "url", Required: true},
+			{Name: "module", Required: true},
+		},
+		SetupScript: `echo "$SSQ_VAR_MODULE $PWD" > "` + marker + `"; echo "setup failed" >&2; exit 1`,
+	}
+	_, err := svc.SaveTemplate(ctx, connect.NewRequest(&sessionv1.SaveTemplateRequest{Template: tpl}))
+	require.NoError(t, err)
+	tpl.Description = "second"
+	saved, err := svc.SaveTemplate(ctx, connect.NewRequest(&sessionv1.SaveTemplateRequest{Template: tpl}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), saved.Msg.Template.Version)
+	assert.Equal(t, "user", saved.Msg.Template.Source)
+
+	got, err := svc.GetTemplate(ctx, connect.NewRequest(&sessionv1.GetTemplateRequest{Name: "fix-issue", Version: 1}))
+	require.NoError(t, err)
+	assert.Empty(t, got.Msg.Template.Description)
+	assert.Equal(t, []int32{2, 1}, got.Msg.Versions)
+
+	bad := &sessionv1.SessionTemplate{Name: "bad", Prompt: "{{undeclared}}"}
+	_, err = svc.SaveTemplate(ctx, connect.NewRequest(&sessionv1.SaveTemplateRequest{Template: bad}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	shared := &sessionv1.SessionTemplate{Name: "review", Prompt: "Review the {{repo}} changes."}
+	sharedResp, err := svc.SaveTemplate(ctx, connect.NewRequest(&sessionv1.SaveTemplateRequest{
+		Template: shared, Shared: true, RepoPath: repo,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "repo", sharedResp.Msg.Template.Source)
+	assert.FileExists(t, filepath.Join(repo, templates.RepoDir, "review.json"))
+
+	// A shared template cannot replace a personal one of the same name.
+	clash := &sessionv1.SessionTemplate{Name: "fix-issue", Description: "from the repo", Prompt: "Do something else."}
+	_, err = svc.SaveTemplate(ctx, connect.NewRequest(&sessionv1.SaveTemplateRequest{
+		Template: clash, Shared: true, RepoPath: repo,
+	}))
+	require.NoError(t, err)
+	got, err = svc.GetTemplate(ctx, connect.NewRequest(&sessionv1.GetTemplateRequest{Name: "fix-issue", RepoPath: repo}))
+	require.NoError(t, err)
+	assert.Equal(t, "user", got.Msg.Template.Source)
+	assert.Equal(t, "second", got.Msg.Template.Description)
+
+	list, err := svc.ListTemplates(ctx, connect.NewRequest(&sessionv1.ListTemplatesRequest{RepoPath: repo}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.Templates, 2)
+	assert.Equal(t, "fix-issue", list.Msg.Templates[0].Name)
+	assert.Equal(t, "user", list.Msg.Templates[0].Source)
+	assert.Equal(t, "review", list.Msg.Templates[1].Name)
+
+	_, err = svc.CreateSessionFromTemplate(ctx, connect.NewRequest(&sessionv1.CreateSessionFromTemplateRequest{
+		Template:  "fix-issue",
+		RepoPath:  repo,
+		Variables: map[string]string{"module": "billing"},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "issue_url is required")
+
+	_, err = svc.CreateSessionFromTemplate(ctx, connect.NewRequest(&sessionv1.CreateSessionFromTemplateRequest{
+		Template:  "fix-issue@3",
+		RepoPath:  repo,
+		Variables: map[string]string{"issue_url": "https://example.com/1", "module": "billing"},
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.CreateSessionFromTemplate(ctx, connect.NewRequest(&sessionv1.CreateSessionFromTemplateRequest{
+		Template:  "fix-issue@1",
+		RepoPath:  repo,
+		Variables: map[string]string{"issue_url": "https://example.com/1", "module": "billing"},
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "a failing setup script aborts")
+	assert.Contains(t, err.Error(), "setup failed")
+	out, readErr := os.ReadFile(marker)
+	require.NoError(t, readErr)
+	module, dir, _ := strings.Cut(strings.TrimSpace(string(out)), " ")
+	assert.Equal(t, "billing", module)
+	assert.NotEqual(t, repo, dir, "setup runs in the session's worktree, not the main checkout")
+	assert.Contains(t, dir, "worktrees")
+
+	// A shared setup script runs only once the user trusts it, and the trust
+	// is remembered for later sessions.
+	bootstrap := &sessionv1.SessionTemplate{
+		Name:        "bootstrap",
+		Prompt:      "Get started.",
+		SetupScript: `echo ran >> bootstrap.out; echo "bootstrap failed" >&2; exit 1`,
+	}
+	_, err = svc.SaveTemplate(ctx, connect.NewRequest(&sessionv1.SaveTemplateRequest{
+		Template: bootstrap, Shared: true, RepoPath: repo,
+	}))
+	require.NoError(t, err)
+	fromShared := &sessionv1.CreateSessionFromTemplateRequest{Template: "bootstrap", RepoPath: repo}
+	_, err = svc.CreateSessionFromTemplate(ctx, connect.NewRequest(fromShared))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	assert.Contains(t, err.Error(), "trust")
+	assert.NoFileExists(t, filepath.Join(repo, "bootstrap.out"), "an untrusted script does not run")
+
+	fromShared.TrustSetupScript = true
+	_, err = svc.CreateSessionFromTemplate(ctx, connect.NewRequest(fromShared))
+	assert.Contains(t, err.Error(), "bootstrap failed")
+	fromShared.TrustSetupScript = false
+	_, err = svc.CreateSessionFromTemplate(ctx, connect.NewRequest(fromShared))
+	assert.Contains(t, err.Error(), "bootstrap failed")
+	out, readErr = os.ReadFile(filepath.Join(repo, "bootstrap.out"))
+	require.NoError(t, readErr)
+	assert.Equal(t, "ran\nran\n", string(out))
+
+	_, err = svc.DeleteTemplate(ctx, connect.NewRequest(&sessionv1.DeleteTemplateRequest{Name: "fix-issue"}))
+	require.NoError(t, err)
+	got, err = svc.GetTemplate(ctx, connect.NewRequest(&sessionv1.GetTemplateRequest{Name: "fix-issue"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+// fakeTemplateCreator records template spawns without starting any processes.
+type fakeTemplateCreator struct {
+	fakeSessionCreator
+	spawns []TemplateSpawn
+}
+
+func (f *fakeTemplateCreator) CreateTemplateSession(_ context.Context, spawn TemplateSpawn) (*TemplateSession, error) {
+	f.spawns = append(f.spawns, spawn)
+	return &TemplateSession{
+		Session:  &sessionv1.Session{Id: "uuid-template", Title: spawn.Title, Path: f.dir},
+		Template: templates.Template{Name: spawn.Template, Version: 1},
+	}, nil
+}
+
+func TestSpawnSessionFromItem_Template(t *testing.T) {
+	creator := &fakeTemplateCreator{fakeSessionCreator: fakeSessionCreator{dir: t.TempDir()}}
+	svc := NewBacklogService(createTestStorage(t), creator, nil)
+	item := newDispatchableItem(t, svc, "Fix login", "/repo", 1)
+
+	resp, err := svc.SpawnSessionFromItem(t.Context(), connect.NewRequest(&sessionv1.SpawnSessionFromItemRequest{
+		ItemId:            item,
+		Template:          "fix-issue@2",
+		TemplateVariables: map[string]string{"module": "auth"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "uuid-template", resp.Msg.SessionUuid)
+	assert.Empty(t, creator.titles, "the directory session path is not used")
+
+	require.Len(t, creator.spawns, 1)
+	spawn := creator.spawns[0]
+	assert.Equal(t, "fix-issue@2", spawn.Template)
+	assert.Equal(t, "/repo", spawn.RepoPath)
+	assert.Equal(t, map[string]string{"module": "auth"}, spawn.Variables)
+	assert.Equal(t, "Fix login", spawn.Defaults["item_title"])
+	assert.Equal(t, item, spawn.Defaults["item_id"])
+	assert.Contains(t, spawn.PromptSuffix, "Fix login")
+
+	// Without template support the request is refused rather than ignored.
+	plain := NewBacklogService(createTestStorage(t), &fakeSessionCreator{dir: t.TempDir()}, nil)
+	other := newDispatchableItem(t, plain, "Other", "/repo", 1)
+	_, err = plain.SpawnSessionFromItem(t.Context(), connect.NewRequest(&sessionv1.SpawnSessionFromItemRequest{
+		ItemId: other, Template: "fix-issue",
+	}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	"github.com/tstapler/stapler-squad/config"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/digest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Errors returned by SessionTimeline.
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrNoConversation means the session has no linked conversation file to
+	// digest yet.
+	ErrNoConversation = errors.New("session has no linked conversation file")
+)
+
+// newDigestStore creates the per-session timeline store under
+// ~/.stapler-squad/digests, falling back to memory when the config dir is unknown.
+func newDigestStore() *digest.Store {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		log.Warn("[DigestStore] failed to get config dir, keeping timelines in memory", "err", err)
+		return digest.NewStore("")
+	}
+	return digest.NewStore(filepath.Join(dir, "digests"))
+}
+
+// SessionTimeline returns the per-turn digest of a session's conversation, found
+// by title or UUID. It returns ErrSessionNotFound or ErrNoConversation when there
+// is nothing to digest. Shared by the GetSessionTimeline RPC and the MCP tool.
+func (s *SessionService) SessionTimeline(id string) (*digest.Timeline, error) {
+	key, path := "", ""
+	if inst := s.FindLiveInstance(id); inst != nil {
+		key, path = inst.UUID, inst.HistoryFilePath
+		if key == "" {
+			key = inst.Title
+		}
+	} else {
+		dataSlice, err := s.storage.ListInstanceData()
+		if err != nil {
+			return nil, fmt.Errorf("list instances: %w", err)
+		}
+		for _, d := range dataSlice {
+			if d.Title == id || d.UUID == id {
+				key, path = d.UUID, d.HistoryFilePath
+				if key == "" {
+					key = d.Title
+				}
+				break
+			}
+		}
+	}
+	if key == "" {
+		return nil, ErrSessionNotFound
+	}
+	if path == "" {
+		return nil, ErrNoConversation
+	}
+
+	timeline, err := s.digests.Timeline(key, path)
+	if err != nil && timeline == nil {
+		return nil, err
+	}
+	if err != nil {
+		log.Warn("[SessionTimeline] failed to persist timeline", "session", id, "err", err)
+	}
+	return timeline, nil
+}
+
+// GetSessionTimeline returns a per-turn digest of a session's conversation.
+// +api: session:get-timeline
+func (s *SessionService) GetSessionTimeline(
+	ctx context.Context,
+	req *connect.Request[sessionv1.GetSessionTimelineRequest],
+) (*connect.Response[sessionv1.GetSessionTimelineResponse], error) {
+	if req.Msg.SessionId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("session_id is required"))
+	}
+
+	timeline, err := s.SessionTimeline(req.Msg.SessionId)
+	switch {
+	case errors.Is(err, ErrSessionNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("session not found: %s", req.Msg.SessionId))
+	case errors.Is(err, ErrNoConversation):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	turns := SelectTurns(timeline.Turns, int(req.Msg.SinceTurn), int(req.Msg.Limit))
+	resp := &sessionv1.GetSessionTimelineResponse{
+		Turns:            make([]*sessionv1.TurnDigest, 0, len(turns)),
+		TotalTurns:       int32(len(timeline.Turns)),
+		ConversationPath: timeline.SourcePath,
+	}
+	for _, t := range turns {
+		resp.Turns = append(resp.Turns, turnDigestToProto(t))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SelectTurns drops turns before sinceTurn, then keeps the last limit turns
+// (all of them when limit <= 0).
+func SelectTurns(turns []digest.TurnDigest, sinceTurn, limit int) []digest.TurnDigest {
+	if sinceTurn > 0 {
+		if sinceTurn >= len(turns) {
+			return nil
+		}
+		turns = turns[sinceTurn:]
+	}
+	if limit > 0 && len(turns) > limit {
+		turns = turns[len(turns)-limit:]
+	}
+	return turns
+}
+
+func turnDigestToProto(t digest.TurnDigest) *sessionv1.TurnDigest {
+	p := &sessionv1.TurnDigest{
+		Index:        int32(t.Index),
+		Prompt:       t.Prompt,
+		FilesTouched: t.FilesTouched,
+		Commands:     t.Commands,
+		Errors:       t.Errors,
+		Outcome:      t.Outcome,
+		Summary:      t.Summary,
+	}
+	if !t.StartedAt.IsZero() {
+		p.StartedAt = timestamppb.New(t.StartedAt)
+	}
+	if !t.EndedAt.IsZero() {
+		p.EndedAt = timestamppb.New(t.EndedAt)
+	}
+	for _, tc := range t.Tools {
+		p.Tools = append(p.Tools, &sessionv1.TurnToolCount{Name: tc.Name, Count: int32(tc.Count)})
+	}
+	return p
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/gorilla/websocket"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/server/framestream"
+	"github.com/tstapler/stapler-squad/server/protocol"
+	"github.com/tstapler/stapler-squad/session"
+	"google.golang.org/protobuf/proto"
+)
+
+// attachFrames subscribes a "frames" client to its session's frame stream and
+// sends the stream header, the current dictionary, and either the frames the
+// client missed since its resume cursor or a snapshot of the screen.
+// capture returns the current pane content and is only called on resync.
+func (h *ConnectRPCWebSocketHandler) attachFrames(stream *connectWebSocketStream, instance *session.Instance, req *sessionv1.CurrentPaneRequest, capture func() string) (*framestream.Subscription, error) {
+	sessionID := instance.Title
+
+	var cursor *framestream.Cursor
+	if rc := req.GetResumeCursor(); rc != nil && rc.StreamId != "" {
+		cursor = &framestream.Cursor{StreamID: rc.StreamId, Sequence: rc.Sequence}
+	}
+
+	sub, err := h.frames.Attach(sessionID, instance, cursor, func() []byte {
+		return []byte(withCursorSync(ansiSnapshotPrefix+prepareSnapshotContent(capture()), instance))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach frame stream: %w", err)
+	}
+
+	if err := h.sendFrameHandshake(stream, sessionID, sub); err != nil {
+		sub.Close()
+		return nil, err
+	}
+	if sub.Snapshot != nil {
+		instance.UpdateTerminalTimestamps(string(sub.Snapshot.Raw), true)
+	}
+
+	log.Info("[streamViaControlMode] attached frame stream", "session", sessionID, "stream", sub.StreamID,
+		"resumed", sub.Resumed, "resync_reason", sub.ResyncReason, "sequence", sub.Sequence, "backlog_frames", len(sub.Backlog))
+	return sub, nil
+}
+
+// sendFrameHandshake sends everything a client needs before live frames.
+func (h *ConnectRPCWebSocketHandler) sendFrameHandshake(stream *connectWebSocketStream, sessionID string, sub *framestream.Subscription) error {
+	start := &sessionv1.TerminalData{
+		SessionId: sessionID,
+		Data: &sessionv1.TerminalData_FrameStreamStart{
+			FrameStreamStart: &sessionv1.FrameStreamStart{
+				StreamId:     sub.StreamID,
+				Resumed:      sub.Resumed,
+				Sequence:     sub.Sequence,
+				ResyncReason: sub.ResyncReason,
+			},
+		},
+	}
+	if err := sendTerminalData(stream, start); err != nil {
+		return fmt.Errorf("failed to send frame stream start: %w", err)
+	}
+
+	if sub.Dictionary != nil {
+		if err := sendFrameDictionary(stream, sessionID, sub.Dictionary); err != nil {
+			return err
+		}
+	}
+	if sub.Snapshot != nil {
+		if err := sendFrame(stream, sessionID, sub.Snapshot); err != nil {
+			return err
+		}
+	}
+	for i := range sub.Backlog {
+		if err := sendFrame(stream, sessionID, &sub.Backlog[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forwardFrames sends a subscription's live frames and dictionary changes
+// until the stream ends. A closed subscription also covers a client that fell
+// too far behind; it reconnects and resumes from its cursor.
+func (h *ConnectRPCWebSocketHandler) forwardFrames(stream *connectWebSocketStream, instance *session.Instance, sub *framestream.Subscription) error {
+	sessionID := instance.Title
+
+	// escapeParser is fetched lazily; nil until a controller is running.
+	escapeParser := instance.GetEscapeParser()
+
+	for ev := range sub.Events {
+		if ev.Dictionary != nil {
+			if err := sendFrameDictionary(stream, sessionID, ev.Dictionary); err != nil {
+				return err
+			}
+			continue
+		}
+
+		frame := ev.Frame
+		h.markSnapshotDirty(sessionID)
+
+		if escapeParser == nil {
+			escapeParser = instance.GetEscapeParser()
+		}
+		if escapeParser != nil && escapeParser.IsEnabled() {
+			escapeParser.ParseStage2(frame.Raw, instance.GetTotalBytesWritten())
+		}
+
+		if err := sendFrame(stream, sessionID, frame); err != nil {
+			return err
+		}
+	}
+
+	sendExitContent(stream, instance)
+	return nil
+}
+
+// sendFrame sends one frame of terminal output.
+func sendFrame(stream *connectWebSocketStream, sessionID string, frame *framestream.Frame) error {
+	msg := &sessionv1.TerminalData{
+		SessionId: sessionID,
+		Data: &sessionv1.TerminalData_Frame{
+			Frame: &sessionv1.TerminalFrame{
+				Sequence:     frame.Sequence,
+				Data:         frame.Data,
+				Compressed:   frame.Compressed,
+				DictionaryId: frame.DictionaryID,
+				RawSize:      uint32(frame.RawSize),
+				Snapshot:     frame.Snapshot,
+			},
+		},
+	}
+	if err := sendTerminalData(stream, msg); err != nil {
+		return fmt.Errorf("failed to send frame %d: %w", frame.Sequence, err)
+	}
+	return nil
+}
+
+// sendFrameDictionary sends a dictionary the frames after it are compressed with.
+func sendFrameDictionary(stream *connectWebSocketStream, sessionID string, dict *framestream.Dictionary) error {
+	msg := &sessionv1.TerminalData{
+		SessionId: sessionID,
+		Data: &sessionv1.TerminalData_FrameDictionary{
+			FrameDictionary: &sessionv1.FrameDictionary{Id: dict.ID, Data: dict.Data},
+		},
+	}
+	if err := sendTerminalData(stream, msg); err != nil {
+		return fmt.Errorf("failed to send frame dictionary: %w", err)
+	}
+	return nil
+}
+
+// sendTerminalData marshals msg and writes it as a single envelope.
+func sendTerminalData(stream *connectWebSocketStream, msg *sessionv1.TerminalData) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal terminal data: %w", err)
+	}
+	return stream.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(0, data))
+}
+
+// sendExitContent sends whatever the session printed as it exited, so the
+// user sees the error instead of a blank terminal.
+func sendExitContent(stream *connectWebSocketStream, instance *session.Instance) {
+	exitContent := instance.GetExitContent()
+	if len(exitContent) == 0 {
+		return
+	}
+	exitData := &sessionv1.TerminalData{
+		SessionId: instance.Title,
+		Data: &sessionv1.TerminalData_Output{
+			Output: &sessionv1.TerminalOutput{Data: session.RedactTerminalOutput(exitContent)},
+		},
+	}
+	_ = sendTerminalData(stream, exitData)
+}
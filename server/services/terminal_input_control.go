@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/server/protocol"
+	"github.com/tstapler/stapler-squad/server/ssp"
+	"github.com/tstapler/stapler-squad/session/mux"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// authorizeInput checks that the stream's client may write to the PTY now.
+// Input that fails it must be dropped.
+func (s *connectWebSocketStream) authorizeInput() error {
+	if !s.canInput() {
+		return ssp.ErrReadOnly
+	}
+	if s.coord == nil {
+		return nil
+	}
+	return s.coord.AuthorizeInput(s.clientID)
+}
+
+// inputControlState converts an arbitration snapshot into the message sent to
+// the client identified by clientID.
+func inputControlState(st ssp.InputControlState, clientID, notice string) *sessionv1.InputControlState {
+	msg := &sessionv1.InputControlState{
+		Mode:             string(st.Mode),
+		WriterClientId:   st.Writer,
+		YourClientId:     clientID,
+		CanWrite:         st.CanWrite(clientID),
+		PendingClientIds: st.Pending,
+		Notice:           notice,
+	}
+	for _, c := range st.Clients {
+		p := &sessionv1.ClientPresence{
+			ClientId:   c.ClientID,
+			Label:      c.Label,
+			ReadOnly:   c.ReadOnly,
+			Owner:      c.Owner,
+			CanWrite:   c.CanWrite,
+			Requesting: c.Requesting,
+			Focused:    c.Focused,
+			CursorRow:  int32(c.CursorRow),
+			CursorCol:  int32(c.CursorCol),
+			JoinedAt:   timestamppb.New(c.JoinedAt),
+		}
+		if !c.LastInputAt.IsZero() {
+			p.LastInputAt = timestamppb.New(c.LastInputAt)
+		}
+		msg.Clients = append(msg.Clients, p)
+	}
+	return msg
+}
+
+// sendInputControlState sends the client the current input control state,
+// with notice explaining a refused input or request when non-empty.
+func sendInputControlState(stream *connectWebSocketStream, sessionID, notice string) {
+	if stream.coord == nil {
+		return
+	}
+	msg := &sessionv1.TerminalData{
+		SessionId: sessionID,
+		Data: &sessionv1.TerminalData_InputControlState{
+			InputControlState: inputControlState(stream.coord.Input().State(), stream.clientID, notice),
+		},
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		log.Error("failed to marshal InputControlState", "session", sessionID, "err", err)
+		return
+	}
+	_ = stream.WriteMessage(websocket.BinaryMessage, protocol.CreateEnvelope(0, data))
+}
+
+// streamInputControlUpdates sends the client a fresh input control state
+// whenever it changes, until stop is closed.
+func streamInputControlUpdates(stream *connectWebSocketStream, sessionID string, changed <-chan struct{}, stop <-chan struct{}) {
+	for {
+		select {
+		case <-changed:
+			sendInputControlState(stream, sessionID, "")
+		case <-stop:
+			return
+		}
+	}
+}
+
+// handleInputControlMessage applies a client's input control request or
+// presence update. It reports whether msg was one of those.
+func handleInputControlMessage(stream *connectWebSocketStream, sessionID string, msg *sessionv1.TerminalData) bool {
+	if stream.coord == nil {
+		return false
+	}
+	arbiter := stream.coord.Input()
+
+	if p := msg.GetPresenceUpdate(); p != nil {
+		arbiter.UpdatePresence(stream.clientID, p.Label, p.Focused, int(p.CursorRow), int(p.CursorCol))
+		return true
+	}
+
+	ctl := msg.GetInputControl()
+	if ctl == nil {
+		return false
+	}
+	var err error
+	switch ctl.Action {
+	case "set_mode":
+		var mode ssp.InputMode
+		if mode, err = ssp.ParseInputMode(ctl.Mode); err == nil {
+			err = arbiter.SetMode(stream.clientID, mode)
+		}
+	case "request":
+		err = arbiter.RequestControl(stream.clientID)
+	case "take":
+		err = arbiter.TakeControl(stream.clientID)
+	case "grant":
+		err = arbiter.GrantControl(stream.clientID, ctl.TargetClientId)
+	case "deny":
+		err = arbiter.DenyControl(stream.clientID, ctl.TargetClientId)
+	case "release":
+		err = arbiter.ReleaseControl(stream.clientID)
+	default:
+		log.Warn("unknown input control action", "session", sessionID, "action", ctl.Action)
+		return true
+	}
+	if err != nil {
+		log.Debug("input control request refused", "session", sessionID, "client", stream.clientID, "action", ctl.Action, "err", err)
+		sendInputControlState(stream, sessionID, err.Error())
+		return true
+	}
+	log.Info("input control changed", "session", sessionID, "client", stream.clientID, "action", ctl.Action, "mode", arbiter.Mode())
+	return true
+}
+
+// localTerminalClientID is the input arbitration client standing for the
+// terminal a claude-mux session was started in.
+const localTerminalClientID = "local-terminal"
+
+// localInputGates arbitrates the input typed into claude-mux sessions' own
+// terminals. While any client streams such a session, one gate per session
+// joins its coordinator as the owner's local terminal.
+type localInputGates struct {
+	mu    sync.Mutex
+	gates map[string]*localInputGate
+}
+
+type localInputGate struct {
+	refs   int
+	cancel context.CancelFunc
+}
+
+// attach starts the gate for a claude-mux session unless one is running,
+// and returns the function a streaming client calls when it leaves.
+func (g *localInputGates) attach(sessionID, socketPath string, coord *ssp.Coordinator) (detach func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.gates == nil {
+		g.gates = make(map[string]*localInputGate)
+	}
+	gate, ok := g.gates[sessionID]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		gate = &localInputGate{cancel: cancel}
+		g.gates[sessionID] = gate
+		go func() {
+			conn, err := net.DialTimeout("unix", socketPath, 2*time.Second)
+			if err != nil {
+				log.Warn("cannot arbitrate local terminal input", "session", sessionID, "socket", socketPath, "err", err)
+				return
+			}
+			runLocalInputGate(ctx, conn, coord)
+		}()
+	}
+	gate.refs++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			gate.refs--
+			if gate.refs > 0 {
+				return
+			}
+			gate.cancel()
+			if g.gates[sessionID] == gate {
+				delete(g.gates, sessionID)
+			}
+		})
+	}
+}
+
+// runLocalInputGate joins coord as the local terminal of the claude-mux
+// session behind conn. It closes the mux's local input whenever that client
+// may not type, and records local typing so single_writer handoff sees it.
+// It returns when ctx is done or the mux goes away.
+func runLocalInputGate(ctx context.Context, conn net.Conn, coord *ssp.Coordinator) {
+	defer conn.Close()
+	defer context.AfterFunc(ctx, func() { conn.Close() })()
+	arbiter := coord.Input()
+	changed := arbiter.Join(localTerminalClientID, ssp.ClientInfo{Label: "Local terminal", Owner: true})
+	defer arbiter.Leave(localTerminalClientID)
+
+	var writeMu sync.Mutex
+	sendGate := func() {
+		open := arbiter.State().CanWrite(localTerminalClientID)
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		_ = mux.WriteMessage(conn, mux.NewInputGateMessage(open))
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-changed:
+				sendGate()
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	for {
+		msg, err := mux.DecodeMessage(conn)
+		if err != nil {
+			return
+		}
+		switch msg.Type {
+		case mux.MessageTypeLocalInput:
+			if err := coord.AuthorizeInput(localTerminalClientID); err != nil {
+				// The keystroke raced a change of control; make sure the
+				// mux has closed its input now.
+				sendGate()
+			}
+		case mux.MessageTypePing:
+			writeMu.Lock()
+			_ = mux.WriteMessage(conn, mux.NewPongMessage())
+			writeMu.Unlock()
+		}
+	}
+}
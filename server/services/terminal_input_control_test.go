@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tstapler/stapler-squad/server/ssp"
+	"github.com/tstapler/stapler-squad/session/mux"
+)
+
+// TestLocalInputGate verifies that a claude-mux session's own terminal takes
+// part in input arbitration: its input is closed while another client
+// drives, and the owner at the local terminal can take control back.
+func TestLocalInputGate(t *testing.T) {
+	cfg := ssp.DefaultConfig()
+	cfg.InputMode = ssp.InputModeDriver
+	coord := ssp.NewCoordinator("external", cfg)
+	coord.Input().Join("browser", ssp.ClientInfo{Label: "browser", Owner: true})
+
+	muxSide, serverSide := net.Pipe()
+	defer muxSide.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		runLocalInputGate(ctx, serverSide, coord)
+		close(done)
+	}()
+
+	readGate := func() bool {
+		t.Helper()
+		require.NoError(t, muxSide.SetReadDeadline(time.Now().Add(2*time.Second)))
+		msg, err := mux.DecodeMessage(muxSide)
+		require.NoError(t, err)
+		open, err := mux.ParseInputGateMessage(msg)
+		require.NoError(t, err)
+		return open
+	}
+
+	assert.False(t, readGate(), "local input must close while the browser drives")
+
+	require.NoError(t, coord.Input().TakeControl(localTerminalClientID))
+	assert.True(t, readGate(), "local input must open once the local terminal has control")
+
+	// Local typing counts as the local terminal's input.
+	require.NoError(t, mux.WriteMessage(muxSide, mux.NewLocalInputMessage()))
+	require.Eventually(t, func() bool {
+		for _, c := range coord.Input().State().Clients {
+			if c.ClientID == localTerminalClientID {
+				return !c.LastInputAt.IsZero()
+			}
+		}
+		return false
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+	assert.Len(t, coord.Input().State().Clients, 1, "the local terminal leaves with the gate")
+}
@@ -9,12 +9,14 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/executor/safeexec"
 	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
 	"github.com/tstapler/stapler-squad/gen/proto/go/session/v1/sessionv1connect"
 	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/server/events"
 	"github.com/tstapler/stapler-squad/session"
+	"github.com/tstapler/stapler-squad/session/git"
 	"github.com/tstapler/stapler-squad/session/unfinished"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -359,6 +361,15 @@ func (s *UnfinishedWorkService) QuickCommitPush(
 		}), nil
 	}
 
+	// Refuse to commit secrets. The changes stay staged so they can be fixed.
+	detector := git.NewSecretDetector(config.LoadConfig().SecretScan, worktreePath)
+	if err := git.CheckStagedSecrets(addCtx, worktreePath, detector); err != nil {
+		return connect.NewResponse(&sessionv1.QuickCommitPushResponse{
+			Success:      false,
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
 	// git commit -m <message>
 	commitCtx, commitCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer commitCancel()
@@ -5,6 +5,7 @@
 //   - Predictive echo for low-latency typing experience
 //   - RTT-based adaptive frame rate throttling
 //   - Automatic resync on sequence mismatch
+//   - Input arbitration between clients sharing a terminal
 //
 // The Coordinator manages the server-side SSP state for a single session,
 // tracking per-client state and generating optimized diffs.
@@ -36,6 +37,9 @@ type Coordinator struct {
 	// Echo tracking for predictive typing
 	echoHistory *EchoHistory
 
+	// Decides which clients may write to the PTY
+	input *InputArbiter
+
 	// Frame rate control
 	lastFrameTime    time.Time
 	minFrameInterval time.Duration
@@ -57,6 +61,13 @@ type CoordinatorConfig struct {
 
 	// EchoHistorySize is the number of echo entries to track (default: 1000)
 	EchoHistorySize int
+
+	// InputMode is the initial input arbitration mode (default: shared)
+	InputMode InputMode
+
+	// IdleHandoffMs is how long a single writer may be idle before a pending
+	// requester can take control (default: 10s)
+	IdleHandoffMs int
 }
 
 // DefaultConfig returns the default coordinator configuration.
@@ -66,6 +77,8 @@ func DefaultConfig() CoordinatorConfig {
 		MaxDiffSize:        65536, // 64KB
 		EchoTimeoutMs:      50,    // Mosh default
 		EchoHistorySize:    1000,
+		InputMode:          InputModeShared,
+		IdleHandoffMs:      int(DefaultIdleHandoff / time.Millisecond),
 	}
 }
 
@@ -90,26 +103,29 @@ func NewCoordinator(sessionID string, config CoordinatorConfig) *Coordinator {
 		diffGenerator:    framebuffer.NewDiffGenerator(),
 		clients:          make(map[string]*ClientState),
 		echoHistory:      NewEchoHistory(config.EchoHistorySize),
+		input:            NewInputArbiter(config.InputMode, time.Duration(config.IdleHandoffMs)*time.Millisecond),
 		minFrameInterval: time.Duration(config.MinFrameIntervalMs) * time.Millisecond,
 		config:           config,
 	}
 }
 
-// RegisterClient adds a new client for SSP updates.
-// Returns the current framebuffer state for initial sync.
-func (c *Coordinator) RegisterClient(clientID string, capabilities *ClientCapabilities) *framebuffer.DiffResult {
+// RegisterClient adds a new client for SSP updates and input arbitration.
+// Returns the current framebuffer state for initial sync, and a channel that
+// receives a value whenever the input control state changes.
+func (c *Coordinator) RegisterClient(clientID string, capabilities *ClientCapabilities, info ClientInfo) (*framebuffer.DiffResult, <-chan struct{}) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	state := NewClientState(clientID, capabilities)
 	c.clients[clientID] = state
+	changed := c.input.Join(clientID, info)
 
 	// Generate full redraw for initial sync
 	if c.framebuffer != nil {
-		return c.diffGenerator.GenerateDiff(nil, c.framebuffer)
+		return c.diffGenerator.GenerateDiff(nil, c.framebuffer), changed
 	}
 
-	return nil
+	return nil, changed
 }
 
 // UnregisterClient removes a client from SSP tracking.
@@ -117,6 +133,7 @@ func (c *Coordinator) UnregisterClient(clientID string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	delete(c.clients, clientID)
+	c.input.Leave(clientID)
 }
 
 // ProcessPTYOutput handles new PTY output by updating the framebuffer
@@ -130,7 +147,7 @@ func (c *Coordinator) ProcessPTYOutput(data []byte) map[string]*framebuffer.Diff
 
 	// Initialize framebuffer if this is the first output
 	if c.framebuffer == nil {
-		c.framebuffer = session.NewTerminalState(24, 80) // Default size
+		c.framebuffer = newFramebuffer(24, 80) // Default size
 	}
 
 	// Store old state for diffing
@@ -187,23 +204,50 @@ func (c *Coordinator) ProcessResize(rows, cols int) {
 	defer c.mu.Unlock()
 
 	if c.framebuffer == nil {
-		c.framebuffer = session.NewTerminalState(rows, cols)
+		c.framebuffer = newFramebuffer(rows, cols)
 		return
 	}
 
-	// Resize triggers a full redraw for all clients
-	c.framebuffer = session.NewTerminalState(rows, cols)
+	// Resize keeps the screen content; the dimension change makes the next
+	// diff a full redraw for all clients
+	c.framebuffer.Resize(rows, cols)
 	c.lastFrameTime = time.Time{} // Force next frame to be sent
 }
 
+// newFramebuffer creates the emulated screen fed by raw PTY output, where
+// the tty driver has already turned line feeds into CRLF.
+func newFramebuffer(rows, cols int) *session.TerminalState {
+	fb := session.NewTerminalState(rows, cols)
+	fb.SetNewlineMode(false)
+	return fb
+}
+
 // ProcessInput handles user input with echo tracking.
-// Returns the echo number for predictive echo acknowledgment.
-func (c *Coordinator) ProcessInput(clientID string, data []byte, echoNum uint64, clientTimestampMs int64) {
+// Returns an error, and records nothing, when the client may not write;
+// the caller must then drop the input rather than forward it to the PTY.
+func (c *Coordinator) ProcessInput(clientID string, data []byte, echoNum uint64, clientTimestampMs int64) error {
+	if err := c.input.AuthorizeInput(clientID); err != nil {
+		return err
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Record input for echo acknowledgment
 	c.echoHistory.Record(clientID, echoNum, clientTimestampMs, data)
+	return nil
+}
+
+// AuthorizeInput checks that clientID may write to the PTY now. Paths that
+// forward input without echo tracking must call it before every write.
+func (c *Coordinator) AuthorizeInput(clientID string) error {
+	return c.input.AuthorizeInput(clientID)
+}
+
+// Input returns the coordinator's input arbiter, for control requests and
+// presence updates.
+func (c *Coordinator) Input() *InputArbiter {
+	return c.input
 }
 
 // checkEchoAck checks if there are echo acknowledgments ready for a client.
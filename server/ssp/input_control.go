@@ -0,0 +1,512 @@
+package ssp
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InputMode selects how keystrokes from several clients attached to the same
+// terminal are arbitrated.
+type InputMode string
+
+const (
+	// InputModeShared lets every client type; keystrokes interleave. This is
+	// the default, and how terminals behaved before arbitration existed.
+	InputModeShared InputMode = "shared"
+
+	// InputModeSingleWriter lets one client type at a time. Other clients
+	// request control and the writer grants it. A requester takes control
+	// on its own once the writer has left or been idle for the handoff
+	// timeout, so a walked-away writer cannot lock everyone out.
+	InputModeSingleWriter InputMode = "single_writer"
+
+	// InputModeDriver makes one client the driver and every other client an
+	// observer. Observers may ask for control, but only the driver hands it
+	// over; idleness never moves it. When the driver leaves, the longest
+	// connected client becomes the driver.
+	InputModeDriver InputMode = "driver"
+)
+
+// ParseInputMode parses an input mode name. The empty string is shared.
+func ParseInputMode(s string) (InputMode, error) {
+	switch InputMode(s) {
+	case "", InputModeShared:
+		return InputModeShared, nil
+	case InputModeSingleWriter, InputModeDriver:
+		return InputMode(s), nil
+	}
+	return "", fmt.Errorf("unknown input mode %q (want shared, single_writer or driver)", s)
+}
+
+// Errors returned by InputArbiter.
+var (
+	ErrInputDenied   = errors.New("another client has control of the terminal")
+	ErrReadOnly      = errors.New("client is read-only")
+	ErrNotWriter     = errors.New("only the client in control can do that")
+	ErrNotOwner      = errors.New("only the session owner can do that")
+	ErrUnknownClient = errors.New("unknown client")
+	ErrNoRequest     = errors.New("no pending control request")
+)
+
+// DefaultIdleHandoff is how long a single writer may be idle before a
+// pending requester can take control.
+const DefaultIdleHandoff = 10 * time.Second
+
+// ClientInfo describes a client joining a terminal.
+type ClientInfo struct {
+	// Label identifies the client to the others, e.g. "IntelliJ" or an address.
+	Label string
+	// ReadOnly clients can never write, whatever the mode.
+	ReadOnly bool
+	// Owner clients belong to the session's owner rather than to someone
+	// the session was shared with. Only owners change the input mode or
+	// take control without asking.
+	Owner bool
+}
+
+// Presence is what other clients see about one client.
+type Presence struct {
+	ClientID   string
+	Label      string
+	ReadOnly   bool
+	Owner      bool
+	CanWrite   bool
+	Requesting bool
+	// Focused and the cursor position are reported by the client: whether
+	// its terminal has focus and where it is pointing.
+	Focused     bool
+	CursorRow   int
+	CursorCol   int
+	JoinedAt    time.Time
+	LastInputAt time.Time // zero until the client types
+}
+
+// InputControlState is a snapshot of a terminal's input arbitration.
+type InputControlState struct {
+	Mode InputMode
+	// Writer holds control in single_writer mode and is the driver in
+	// driver mode. Empty in shared mode, or when nobody holds control.
+	Writer string
+	// Pending lists clients waiting for control, oldest request first.
+	Pending []string
+	// Clients lists everyone attached, longest connected first.
+	Clients []Presence
+}
+
+// CanWrite reports whether clientID may currently type.
+func (s InputControlState) CanWrite(clientID string) bool {
+	for _, c := range s.Clients {
+		if c.ClientID == clientID {
+			return c.CanWrite
+		}
+	}
+	return false
+}
+
+type participant struct {
+	info      ClientInfo
+	joinedAt  time.Time
+	lastInput time.Time
+	focused   bool
+	row, col  int
+	changed   chan struct{}
+}
+
+// InputArbiter decides which of a terminal's clients may write to it. Every
+// write must pass AuthorizeInput; clients that fail it never reach the PTY.
+type InputArbiter struct {
+	mu          sync.Mutex
+	mode        InputMode
+	writer      string
+	pending     []string
+	clients     map[string]*participant
+	idleHandoff time.Duration
+	now         func() time.Time
+}
+
+// NewInputArbiter creates an arbiter in mode. idleHandoff <= 0 means
+// DefaultIdleHandoff.
+func NewInputArbiter(mode InputMode, idleHandoff time.Duration) *InputArbiter {
+	if mode == "" {
+		mode = InputModeShared
+	}
+	if idleHandoff <= 0 {
+		idleHandoff = DefaultIdleHandoff
+	}
+	return &InputArbiter{
+		mode:        mode,
+		clients:     make(map[string]*participant),
+		idleHandoff: idleHandoff,
+		now:         time.Now,
+	}
+}
+
+// Join attaches a client. The returned channel receives a value whenever the
+// arbitration state changes, so the client can be sent a fresh snapshot.
+func (a *InputArbiter) Join(clientID string, info ClientInfo) <-chan struct{} {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p := &participant{info: info, joinedAt: a.now(), changed: make(chan struct{}, 1)}
+	a.clients[clientID] = p
+	if a.mode == InputModeDriver && a.writer == "" && !info.ReadOnly {
+		a.writer = clientID
+	}
+	a.changedLocked()
+	return p.changed
+}
+
+// Leave detaches a client, passing control on if it held it.
+func (a *InputArbiter) Leave(clientID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.clients[clientID]; !ok {
+		return
+	}
+	delete(a.clients, clientID)
+	a.removePendingLocked(clientID)
+	if a.writer == clientID {
+		a.writer = a.successorLocked()
+	}
+	a.changedLocked()
+}
+
+// Mode returns the current input mode.
+func (a *InputArbiter) Mode() InputMode {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.mode
+}
+
+// SetMode switches the input mode. Only owner clients may switch, so a
+// client the session was shared with cannot lock the owner out. The client
+// switching to single_writer or driver takes control.
+func (a *InputArbiter) SetMode(by string, mode InputMode) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, ok := a.clients[by]
+	if !ok {
+		return ErrUnknownClient
+	}
+	if p.info.ReadOnly {
+		return ErrReadOnly
+	}
+	if !p.info.Owner {
+		return ErrNotOwner
+	}
+	a.mode = mode
+	a.pending = nil
+	if mode == InputModeShared {
+		a.writer = ""
+	} else {
+		a.writer = by
+	}
+	a.changedLocked()
+	return nil
+}
+
+// AuthorizeInput reports whether clientID may write now, and records the
+// write when it may. It returns ErrInputDenied when another client has
+// control and ErrReadOnly for read-only clients.
+func (a *InputArbiter) AuthorizeInput(clientID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, ok := a.clients[clientID]
+	if !ok {
+		return ErrUnknownClient
+	}
+	if p.info.ReadOnly {
+		return ErrReadOnly
+	}
+	now := a.now()
+	switch a.mode {
+	case InputModeSingleWriter:
+		switch {
+		case a.writer == clientID:
+		case a.writer == "":
+			// Nobody holds control: the first to type takes it.
+			a.writer = clientID
+			a.removePendingLocked(clientID)
+			a.changedLocked()
+		case a.requestingLocked(clientID) && a.writerIdleLocked(now):
+			a.writer = clientID
+			a.removePendingLocked(clientID)
+			a.changedLocked()
+		default:
+			return ErrInputDenied
+		}
+	case InputModeDriver:
+		if a.writer == "" {
+			a.writer = clientID
+			a.changedLocked()
+		} else if a.writer != clientID {
+			return ErrInputDenied
+		}
+	}
+	p.lastInput = now
+	return nil
+}
+
+// RequestControl asks for control. In single_writer mode the request is
+// granted at once when nobody holds control or the writer is idle.
+func (a *InputArbiter) RequestControl(clientID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, ok := a.clients[clientID]
+	if !ok {
+		return ErrUnknownClient
+	}
+	if p.info.ReadOnly {
+		return ErrReadOnly
+	}
+	if a.mode == InputModeShared || a.writer == clientID {
+		return nil
+	}
+	if a.mode == InputModeSingleWriter && (a.writer == "" || a.writerIdleLocked(a.now())) {
+		a.writer = clientID
+		a.removePendingLocked(clientID)
+		a.changedLocked()
+		return nil
+	}
+	if !a.requestingLocked(clientID) {
+		a.pending = append(a.pending, clientID)
+		a.changedLocked()
+	}
+	return nil
+}
+
+// TakeControl gives an owner client control at once, without asking the
+// client that holds it. In shared mode everyone already has control.
+func (a *InputArbiter) TakeControl(by string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, ok := a.clients[by]
+	if !ok {
+		return ErrUnknownClient
+	}
+	if p.info.ReadOnly {
+		return ErrReadOnly
+	}
+	if !p.info.Owner {
+		return ErrNotOwner
+	}
+	if a.mode == InputModeShared || a.writer == by {
+		return nil
+	}
+	a.writer = by
+	a.removePendingLocked(by)
+	a.changedLocked()
+	return nil
+}
+
+// GrantControl hands control from the writer to a client; an empty to
+// grants the oldest pending request.
+func (a *InputArbiter) GrantControl(by, to string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.mode == InputModeShared {
+		return nil
+	}
+	if a.writer != by {
+		return ErrNotWriter
+	}
+	if to == "" {
+		if len(a.pending) == 0 {
+			return ErrNoRequest
+		}
+		to = a.pending[0]
+	}
+	p, ok := a.clients[to]
+	if !ok {
+		return ErrUnknownClient
+	}
+	if p.info.ReadOnly {
+		return ErrReadOnly
+	}
+	a.writer = to
+	a.removePendingLocked(to)
+	a.changedLocked()
+	return nil
+}
+
+// DenyControl drops a client's pending request; only the writer may.
+func (a *InputArbiter) DenyControl(by, to string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.writer != by {
+		return ErrNotWriter
+	}
+	if !a.requestingLocked(to) {
+		return ErrNoRequest
+	}
+	a.removePendingLocked(to)
+	a.changedLocked()
+	return nil
+}
+
+// ReleaseControl gives up control, to the oldest pending request. In
+// single_writer mode with no request nobody holds control afterwards; in
+// driver mode the driver keeps it, since someone must drive.
+func (a *InputArbiter) ReleaseControl(by string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.mode == InputModeShared {
+		return nil
+	}
+	if a.writer != by {
+		return ErrNotWriter
+	}
+	if len(a.pending) > 0 {
+		a.writer = a.pending[0]
+		a.pending = a.pending[1:]
+	} else if a.mode == InputModeDriver {
+		return ErrNoRequest
+	} else {
+		a.writer = ""
+	}
+	a.changedLocked()
+	return nil
+}
+
+// UpdatePresence records what a client reports about itself. An empty
+// label leaves the label unchanged.
+func (a *InputArbiter) UpdatePresence(clientID, label string, focused bool, row, col int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, ok := a.clients[clientID]
+	if !ok {
+		return
+	}
+	if label != "" {
+		p.info.Label = label
+	}
+	p.focused, p.row, p.col = focused, row, col
+	a.changedLocked()
+}
+
+// State returns a snapshot of the arbitration state.
+func (a *InputArbiter) State() InputControlState {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	st := InputControlState{
+		Mode:    a.mode,
+		Writer:  a.writer,
+		Pending: append([]string(nil), a.pending...),
+		Clients: make([]Presence, 0, len(a.clients)),
+	}
+	for id, p := range a.clients {
+		st.Clients = append(st.Clients, Presence{
+			ClientID:    id,
+			Label:       p.info.Label,
+			ReadOnly:    p.info.ReadOnly,
+			Owner:       p.info.Owner,
+			CanWrite:    a.canWriteLocked(id, p),
+			Requesting:  a.requestingLocked(id),
+			Focused:     p.focused,
+			CursorRow:   p.row,
+			CursorCol:   p.col,
+			JoinedAt:    p.joinedAt,
+			LastInputAt: p.lastInput,
+		})
+	}
+	sort.Slice(st.Clients, func(i, j int) bool {
+		if !st.Clients[i].JoinedAt.Equal(st.Clients[j].JoinedAt) {
+			return st.Clients[i].JoinedAt.Before(st.Clients[j].JoinedAt)
+		}
+		return st.Clients[i].ClientID < st.Clients[j].ClientID
+	})
+	return st
+}
+
+// ClientCount returns the number of attached clients.
+func (a *InputArbiter) ClientCount() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.clients)
+}
+
+// canWriteLocked reports whether a client could type right now without
+// first requesting control. a.mu must be held.
+func (a *InputArbiter) canWriteLocked(id string, p *participant) bool {
+	if p.info.ReadOnly {
+		return false
+	}
+	switch a.mode {
+	case InputModeShared:
+		return true
+	case InputModeSingleWriter:
+		return a.writer == id || a.writer == ""
+	default:
+		return a.writer == id
+	}
+}
+
+// writerIdleLocked reports whether the single writer has been idle long
+// enough to lose control to a requester. a.mu must be held.
+func (a *InputArbiter) writerIdleLocked(now time.Time) bool {
+	p, ok := a.clients[a.writer]
+	if !ok {
+		return true
+	}
+	last := p.lastInput
+	if last.IsZero() {
+		last = p.joinedAt
+	}
+	return now.Sub(last) >= a.idleHandoff
+}
+
+// successorLocked picks who takes control when the writer leaves: the oldest
+// request, or in driver mode the longest connected writable client.
+// a.mu must be held.
+func (a *InputArbiter) successorLocked() string {
+	if len(a.pending) > 0 {
+		next := a.pending[0]
+		a.pending = a.pending[1:]
+		return next
+	}
+	if a.mode != InputModeDriver {
+		return ""
+	}
+	var next string
+	var joined time.Time
+	for id, p := range a.clients {
+		if p.info.ReadOnly {
+			continue
+		}
+		if next == "" || p.joinedAt.Before(joined) || (p.joinedAt.Equal(joined) && id < next) {
+			next, joined = id, p.joinedAt
+		}
+	}
+	return next
+}
+
+func (a *InputArbiter) requestingLocked(clientID string) bool {
+	for _, id := range a.pending {
+		if id == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *InputArbiter) removePendingLocked(clientID string) {
+	kept := a.pending[:0]
+	for _, id := range a.pending {
+		if id != clientID {
+			kept = append(kept, id)
+		}
+	}
+	a.pending = kept
+}
+
+// changedLocked notifies every client that the state changed. a.mu must be held.
+func (a *InputArbiter) changedLocked() {
+	for _, p := range a.clients {
+		select {
+		case p.changed <- struct{}{}:
+		default:
+		}
+	}
+}
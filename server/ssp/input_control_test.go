@@ -0,0 +1,215 @@
+package ssp
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock lets tests move the arbiter's idea of now.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestArbiter(mode InputMode) (*InputArbiter, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	a := NewInputArbiter(mode, 10*time.Second)
+	a.now = clock.now
+	return a, clock
+}
+
+func TestSharedModeEveryoneWritesExceptReadOnly(t *testing.T) {
+	a, _ := newTestArbiter(InputModeShared)
+	a.Join("a", ClientInfo{})
+	a.Join("b", ClientInfo{})
+	a.Join("viewer", ClientInfo{ReadOnly: true})
+
+	for _, id := range []string{"a", "b"} {
+		if err := a.AuthorizeInput(id); err != nil {
+			t.Errorf("AuthorizeInput(%s) = %v, want nil", id, err)
+		}
+	}
+	if err := a.AuthorizeInput("viewer"); err != ErrReadOnly {
+		t.Errorf("read-only client: err = %v, want ErrReadOnly", err)
+	}
+	if err := a.AuthorizeInput("stranger"); err != ErrUnknownClient {
+		t.Errorf("unknown client: err = %v, want ErrUnknownClient", err)
+	}
+}
+
+func TestSingleWriterHandoff(t *testing.T) {
+	a, clock := newTestArbiter(InputModeSingleWriter)
+	a.Join("a", ClientInfo{})
+	a.Join("b", ClientInfo{})
+
+	// Nobody holds control: the first to type takes it.
+	if err := a.AuthorizeInput("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.AuthorizeInput("b"); err != ErrInputDenied {
+		t.Fatalf("b typing while a writes: err = %v, want ErrInputDenied", err)
+	}
+
+	// b asks; only the writer can grant.
+	if err := a.RequestControl("b"); err != nil {
+		t.Fatal(err)
+	}
+	if st := a.State(); len(st.Pending) != 1 || st.Pending[0] != "b" {
+		t.Errorf("pending = %v, want [b]", st.Pending)
+	}
+	if err := a.GrantControl("b", "b"); err != ErrNotWriter {
+		t.Errorf("requester granting itself: err = %v, want ErrNotWriter", err)
+	}
+	if err := a.GrantControl("a", ""); err != nil {
+		t.Fatal(err)
+	}
+	if st := a.State(); st.Writer != "b" || len(st.Pending) != 0 {
+		t.Errorf("after grant: writer %q pending %v", st.Writer, st.Pending)
+	}
+
+	// A requester takes control from an idle writer by typing.
+	if err := a.AuthorizeInput("b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.RequestControl("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.AuthorizeInput("a"); err != ErrInputDenied {
+		t.Errorf("requester typing while writer active: err = %v, want ErrInputDenied", err)
+	}
+	clock.advance(11 * time.Second)
+	if err := a.AuthorizeInput("a"); err != nil {
+		t.Errorf("requester typing after writer idle: err = %v, want nil", err)
+	}
+	if a.State().Writer != "a" {
+		t.Error("idle writer kept control")
+	}
+
+	// The writer leaving frees control.
+	a.Leave("a")
+	if w := a.State().Writer; w != "" {
+		t.Errorf("writer after leave = %q, want none", w)
+	}
+}
+
+func TestDriverModeObserversCannotWrite(t *testing.T) {
+	a, clock := newTestArbiter(InputModeDriver)
+	a.Join("viewer", ClientInfo{ReadOnly: true})
+	a.Join("driver", ClientInfo{})
+	clock.advance(time.Second)
+	a.Join("observer", ClientInfo{})
+
+	if w := a.State().Writer; w != "driver" {
+		t.Fatalf("driver = %q, want first writable client", w)
+	}
+	if err := a.AuthorizeInput("observer"); err != ErrInputDenied {
+		t.Errorf("observer typing: err = %v, want ErrInputDenied", err)
+	}
+
+	// Idleness never moves control in driver mode.
+	if err := a.RequestControl("observer"); err != nil {
+		t.Fatal(err)
+	}
+	clock.advance(time.Hour)
+	if err := a.AuthorizeInput("observer"); err != ErrInputDenied {
+		t.Errorf("observer typing after driver idle: err = %v, want ErrInputDenied", err)
+	}
+	if err := a.DenyControl("driver", "observer"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.ReleaseControl("driver"); err != ErrNoRequest {
+		t.Errorf("driver releasing with nobody waiting: err = %v, want ErrNoRequest", err)
+	}
+
+	// The driver leaving passes control to the longest connected writable client.
+	a.Leave("driver")
+	if w := a.State().Writer; w != "observer" {
+		t.Errorf("driver after leave = %q, want observer", w)
+	}
+	if err := a.SetMode("viewer", InputModeShared); err != ErrReadOnly {
+		t.Errorf("read-only client switching mode: err = %v, want ErrReadOnly", err)
+	}
+}
+
+func TestOnlyOwnerSetsModeAndTakesControl(t *testing.T) {
+	a, _ := newTestArbiter(InputModeShared)
+	a.Join("owner", ClientInfo{Owner: true})
+	a.Join("guest", ClientInfo{Label: "share link"})
+
+	// A shared-with client may type but not lock the owner out.
+	if err := a.SetMode("guest", InputModeDriver); err != ErrNotOwner {
+		t.Fatalf("guest switching mode: err = %v, want ErrNotOwner", err)
+	}
+	if err := a.TakeControl("guest"); err != ErrNotOwner {
+		t.Errorf("guest taking control: err = %v, want ErrNotOwner", err)
+	}
+
+	if err := a.SetMode("owner", InputModeDriver); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.GrantControl("owner", "guest"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.AuthorizeInput("owner"); err != ErrInputDenied {
+		t.Fatalf("owner typing after granting: err = %v, want ErrInputDenied", err)
+	}
+
+	// The owner takes control back without asking.
+	if err := a.TakeControl("owner"); err != nil {
+		t.Fatal(err)
+	}
+	if w := a.State().Writer; w != "owner" {
+		t.Errorf("writer after take = %q, want owner", w)
+	}
+	if err := a.AuthorizeInput("guest"); err != ErrInputDenied {
+		t.Errorf("guest typing after owner took control: err = %v, want ErrInputDenied", err)
+	}
+}
+
+func TestStateChangesNotifyClients(t *testing.T) {
+	a, _ := newTestArbiter(InputModeShared)
+	changed := a.Join("a", ClientInfo{Label: "browser"})
+	<-changed // own join
+
+	a.Join("b", ClientInfo{})
+	select {
+	case <-changed:
+	default:
+		t.Fatal("no notification when another client joined")
+	}
+
+	a.UpdatePresence("a", "", true, 3, 7)
+	<-changed
+	st := a.State()
+	if len(st.Clients) != 2 || st.Clients[0].ClientID != "a" {
+		t.Fatalf("clients = %+v", st.Clients)
+	}
+	if c := st.Clients[0]; c.Label != "browser" || !c.Focused || c.CursorRow != 3 || c.CursorCol != 7 {
+		t.Errorf("presence = %+v", c)
+	}
+}
+
+func TestRegistryRemembersMode(t *testing.T) {
+	r := NewRegistry(DefaultConfig())
+	c1, release1 := r.Acquire("s")
+	c2, release2 := r.Acquire("s")
+	if c1 != c2 {
+		t.Fatal("clients of one session got different coordinators")
+	}
+	c1.RegisterClient("a", nil, ClientInfo{Owner: true})
+	if err := c1.Input().SetMode("a", InputModeDriver); err != nil {
+		t.Fatal(err)
+	}
+	c1.UnregisterClient("a")
+	release1()
+	release2()
+	if _, ok := r.Lookup("s"); ok {
+		t.Error("coordinator kept after its last client left")
+	}
+
+	c3, release3 := r.Acquire("s")
+	defer release3()
+	if got := c3.Input().Mode(); got != InputModeDriver {
+		t.Errorf("mode after reconnect = %q, want driver", got)
+	}
+}
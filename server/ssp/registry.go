@@ -0,0 +1,74 @@
+package ssp
+
+import "sync"
+
+// Registry hands out one Coordinator per session, shared by every client
+// streaming that session, so input arbitration spans all of them.
+// Coordinators are dropped when their last client leaves; the session's
+// input mode is remembered for the next one.
+type Registry struct {
+	mu     sync.Mutex
+	config CoordinatorConfig
+	live   map[string]*registryEntry
+	modes  map[string]InputMode
+}
+
+type registryEntry struct {
+	coord *Coordinator
+	refs  int
+}
+
+// NewRegistry creates a Registry whose coordinators use config.
+func NewRegistry(config CoordinatorConfig) *Registry {
+	return &Registry{
+		config: config,
+		live:   make(map[string]*registryEntry),
+		modes:  make(map[string]InputMode),
+	}
+}
+
+// Acquire returns the coordinator for sessionID, creating it if needed.
+// Callers must call release when their client disconnects.
+func (r *Registry) Acquire(sessionID string) (coord *Coordinator, release func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.live[sessionID]
+	if !ok {
+		cfg := r.config
+		if mode, ok := r.modes[sessionID]; ok {
+			cfg.InputMode = mode
+		}
+		e = &registryEntry{coord: NewCoordinator(sessionID, cfg)}
+		r.live[sessionID] = e
+	}
+	e.refs++
+
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			e.refs--
+			if e.refs > 0 {
+				return
+			}
+			r.modes[sessionID] = e.coord.Input().Mode()
+			if r.live[sessionID] == e {
+				delete(r.live, sessionID)
+			}
+		})
+	}
+	return e.coord, release
+}
+
+// Lookup returns the live coordinator for sessionID, if any client is
+// streaming it.
+func (r *Registry) Lookup(sessionID string) (*Coordinator, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.live[sessionID]
+	if !ok {
+		return nil, false
+	}
+	return e.coord, true
+}
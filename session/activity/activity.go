@@ -0,0 +1,184 @@
+// Package activity turns the Claude Code hook callbacks (UserPromptSubmit,
+// PreToolUse, PostToolUse, Stop and permission requests) into a typed,
+// per-session stream of agent activity, and derives from it whether the
+// agent is working, waiting on the user, or done with its turn.
+//
+// Hook payloads can contain secrets (commands, prompts, tool output), so only
+// short redacted summaries are kept; raw payloads are never stored.
+package activity
+
+import (
+	"time"
+)
+
+// Kind identifies the hook an Entry was recorded from.
+type Kind string
+
+const (
+	// KindPromptSubmit is a user prompt starting a new agent turn.
+	KindPromptSubmit Kind = "prompt_submit"
+	// KindToolStart is a tool call about to run (PreToolUse).
+	KindToolStart Kind = "tool_start"
+	// KindToolEnd is a finished tool call (PostToolUse).
+	KindToolEnd Kind = "tool_end"
+	// KindPermission is a tool call escalated to the user for approval.
+	KindPermission Kind = "permission_request"
+	// KindPermissionResolved is the user allowing or denying a KindPermission.
+	KindPermissionResolved Kind = "permission_resolved"
+	// KindStop is the agent ending its turn.
+	KindStop Kind = "stop"
+)
+
+// ExitStatus is the outcome of a finished tool call.
+type ExitStatus string
+
+const (
+	// ExitUnknown means the tool response carried no usable outcome.
+	ExitUnknown ExitStatus = ""
+	ExitOK      ExitStatus = "ok"
+	ExitError   ExitStatus = "error"
+	// ExitInterrupted means the user interrupted the tool.
+	ExitInterrupted ExitStatus = "interrupted"
+)
+
+// Entry is one event in a session's activity stream.
+type Entry struct {
+	// Seq increases by one per entry within a session.
+	Seq       uint64    `json:"seq"`
+	SessionID string    `json:"session_id"`
+	Kind      Kind      `json:"kind"`
+	Time      time.Time `json:"time"`
+	// AgentSessionID is the agent's own conversation ID, when reported.
+	AgentSessionID string `json:"agent_session_id,omitempty"`
+	Tool           string `json:"tool,omitempty"`
+	ToolUseID      string `json:"tool_use_id,omitempty"`
+	// Input is a redacted one-line summary of the tool input, or of the
+	// prompt for KindPromptSubmit.
+	Input string `json:"input,omitempty"`
+	// Duration is the time since the matching KindToolStart, for KindToolEnd.
+	Duration   time.Duration `json:"duration,omitempty"`
+	ExitStatus ExitStatus    `json:"exit_status,omitempty"`
+	ExitCode   *int          `json:"exit_code,omitempty"`
+	// Error is a redacted summary of the tool's error output.
+	Error      string `json:"error,omitempty"`
+	StopReason string `json:"stop_reason,omitempty"`
+	// Decision is "allow" or "deny", for KindPermissionResolved.
+	Decision string `json:"decision,omitempty"`
+}
+
+// Phase is what the agent is doing, as reported by its hooks.
+type Phase int
+
+const (
+	// PhaseUnknown means no hooks have been seen, or they are too old to trust.
+	PhaseUnknown Phase = iota
+	// PhaseWorking means a turn is in progress.
+	PhaseWorking
+	// PhaseWaiting means the agent is blocked on the user: a permission
+	// request or a question.
+	PhaseWaiting
+	// PhaseDone means the agent ended its turn and waits for the next prompt.
+	PhaseDone
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseWorking:
+		return "working"
+	case PhaseWaiting:
+		return "waiting"
+	case PhaseDone:
+		return "done"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// workingTTL bounds how long a working phase is trusted without further
+	// hooks. A crashed or killed agent never sends Stop.
+	workingTTL = 10 * time.Minute
+	// waitingTTL matches the hook timeout, after which Claude Code falls back
+	// to its own terminal dialog.
+	waitingTTL = 5 * time.Minute
+)
+
+// questionTool is the tool Claude Code uses to ask the user a question.
+const questionTool = "AskUserQuestion"
+
+// State is a session's agent phase derived from its activity stream.
+type State struct {
+	Phase Phase
+	// Since is when the session entered Phase.
+	Since time.Time
+	// LastEvent is the time of the most recent entry.
+	LastEvent time.Time
+	// Tool is the tool running or awaiting approval, if any.
+	Tool string
+	// Input is the redacted input summary of Tool.
+	Input      string
+	StopReason string
+	// sawPrompt records that prompt hooks are delivered, without which the
+	// done phase could never end.
+	sawPrompt bool
+}
+
+// Current reports whether the state is recent and complete enough to be
+// trusted over terminal output detection.
+func (s State) Current(now time.Time) bool {
+	switch s.Phase {
+	case PhaseWorking:
+		return now.Sub(s.LastEvent) < workingTTL
+	case PhaseWaiting:
+		return now.Sub(s.LastEvent) < waitingTTL
+	case PhaseDone:
+		return s.sawPrompt
+	default:
+		return false
+	}
+}
+
+// WaitingForAnswer reports whether the agent is waiting on a question rather
+// than a permission request.
+func (s State) WaitingForAnswer() bool {
+	return s.Phase == PhaseWaiting && s.Tool == questionTool
+}
+
+// apply advances the state by one entry.
+func (s *State) apply(e Entry) {
+	next := s.Phase
+	switch e.Kind {
+	case KindPromptSubmit:
+		next = PhaseWorking
+		s.sawPrompt = true
+		s.Tool, s.Input, s.StopReason = "", "", ""
+	case KindToolStart:
+		next = PhaseWorking
+		if e.Tool == questionTool {
+			next = PhaseWaiting
+		}
+		s.Tool, s.Input = e.Tool, e.Input
+	case KindPermission:
+		next = PhaseWaiting
+		s.Tool, s.Input = e.Tool, e.Input
+	case KindPermissionResolved:
+		// An allowed tool now runs; a denied one hands control back to the
+		// agent. Either way the turn goes on.
+		next = PhaseWorking
+		if e.Decision != "allow" {
+			s.Tool, s.Input = "", ""
+		}
+	case KindToolEnd:
+		next = PhaseWorking
+		s.Tool, s.Input = "", ""
+	case KindStop:
+		next = PhaseDone
+		s.Tool, s.Input = "", ""
+		s.StopReason = e.StopReason
+	}
+	if next != s.Phase {
+		s.Phase = next
+		s.Since = e.Time
+	}
+	s.LastEvent = e.Time
+}
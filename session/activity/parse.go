@@ -0,0 +1,152 @@
+package activity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// hookPayload is the subset of the Claude Code hook payload that activity
+// entries are built from. Each hook event fills a different part of it.
+type hookPayload struct {
+	SessionID      string                 `json:"session_id"`
+	ToolName       string                 `json:"tool_name"`
+	ToolUseID      string                 `json:"tool_use_id"`
+	ToolInput      map[string]interface{} `json:"tool_input"`
+	ToolResponse   json.RawMessage        `json:"tool_response"`
+	Prompt         string                 `json:"prompt"`
+	StopHookActive bool                   `json:"stop_hook_active"`
+	Reason         string                 `json:"reason"`
+}
+
+// ParseHook builds an unrecorded Entry of the given kind from a raw hook
+// payload. Summaries are not yet redacted or truncated; Tracker.Record does
+// both before anything is stored.
+func ParseHook(kind Kind, body []byte) (Entry, error) {
+	e := Entry{Kind: kind}
+	var p hookPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return e, fmt.Errorf("invalid %s hook payload: %w", kind, err)
+	}
+	e.AgentSessionID = p.SessionID
+
+	switch kind {
+	case KindPromptSubmit:
+		e.Input = p.Prompt
+	case KindToolStart, KindPermission:
+		e.Tool = p.ToolName
+		e.ToolUseID = p.ToolUseID
+		e.Input = SummarizeInput(p.ToolName, p.ToolInput)
+	case KindToolEnd:
+		e.Tool = p.ToolName
+		e.ToolUseID = p.ToolUseID
+		e.Input = SummarizeInput(p.ToolName, p.ToolInput)
+		e.ExitStatus, e.ExitCode, e.Error = toolOutcome(p.ToolResponse)
+	case KindStop:
+		e.StopReason = stopReason(p)
+	}
+	return e, nil
+}
+
+// SummarizeInput returns a one-line description of a tool call's input: its
+// most identifying field, or the whole input as compact JSON.
+func SummarizeInput(tool string, input map[string]interface{}) string {
+	if len(input) == 0 {
+		return ""
+	}
+	// The fields that identify what a call does, in order of preference.
+	summaryKeys := []string{"command", "file_path", "notebook_path", "url", "pattern", "query", "description", "prompt", "path"}
+	for _, key := range summaryKeys {
+		if s, ok := input[key].(string); ok && s != "" {
+			return oneLine(s)
+		}
+	}
+	if tool == questionTool {
+		if qs, ok := input["questions"].([]interface{}); ok && len(qs) > 0 {
+			if q, ok := qs[0].(map[string]interface{}); ok {
+				if s, ok := q["question"].(string); ok {
+					return oneLine(s)
+				}
+			}
+		}
+	}
+	// encoding/json sorts map keys, so the summary is stable.
+	data, err := json.Marshal(input)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// toolOutcome extracts the exit status from a PostToolUse tool_response. The
+// shape differs per tool: Bash reports stdout/stderr/interrupted, MCP tools
+// report isError, others report an error string or nothing at all.
+func toolOutcome(raw json.RawMessage) (ExitStatus, *int, string) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ExitUnknown, nil, ""
+	}
+	var resp map[string]interface{}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		// Plain string or array responses carry no status.
+		return ExitOK, nil, ""
+	}
+
+	if b, _ := resp["interrupted"].(bool); b {
+		return ExitInterrupted, nil, ""
+	}
+	errText := firstString(resp, "error", "stderr")
+
+	var code *int
+	for _, key := range []string{"exit_code", "exitCode", "returnCode", "return_code"} {
+		if f, ok := resp[key].(float64); ok {
+			c := int(f)
+			code = &c
+			break
+		}
+	}
+	failed := code != nil && *code != 0
+	for _, key := range []string{"is_error", "isError"} {
+		if b, ok := resp[key].(bool); ok && b {
+			failed = true
+		}
+	}
+	if ok, present := resp["success"].(bool); present && !ok {
+		failed = true
+	}
+	if s, _ := resp["error"].(string); strings.TrimSpace(s) != "" {
+		failed = true
+	}
+
+	if failed {
+		return ExitError, code, oneLine(errText)
+	}
+	return ExitOK, code, ""
+}
+
+// stopReason names why the agent ended its turn. Claude Code only reports
+// whether a Stop hook already forced the agent to continue once.
+func stopReason(p hookPayload) string {
+	switch {
+	case p.Reason != "":
+		return p.Reason
+	case p.StopHookActive:
+		return "stop_hook"
+	default:
+		return "end_turn"
+	}
+}
+
+// firstString returns the first non-empty string value among keys.
+func firstString(m map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := m[key].(string); ok && strings.TrimSpace(s) != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// oneLine collapses runs of whitespace, including newlines, to single spaces.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,280 @@
+package activity
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+)
+
+const (
+	// maxRecent is how many entries per session are kept in memory.
+	maxRecent = 500
+	// maxFileEntries is the session file length at which it is rewritten
+	// down to the in-memory entries.
+	maxFileEntries = 2000
+	// maxSummaryLen bounds stored input and error summaries, in runes.
+	maxSummaryLen = 200
+)
+
+// Redactor masks secrets in text before it is stored.
+type Redactor func(string) string
+
+// Tracker records each session's activity stream, keeps the recent part in
+// memory, persists it as one JSONL file per session and derives the session's
+// State. It is safe for concurrent use.
+type Tracker struct {
+	dir    string
+	redact Redactor
+	now    func() time.Time
+
+	mu        sync.Mutex
+	sessions  map[string]*sessionLog
+	listeners []func(Entry)
+}
+
+// sessionLog is the in-memory part of one session's stream.
+type sessionLog struct {
+	entries []Entry
+	// pending holds unfinished tool calls, oldest first, by pairKey.
+	pending     map[string][]Entry
+	state       State
+	seq         uint64
+	fileEntries int
+}
+
+// NewTracker creates a tracker persisting to dir. An empty dir keeps the
+// streams in memory only. A nil redact uses the built-in secret rules.
+func NewTracker(dir string, redact Redactor) (*Tracker, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, fmt.Errorf("failed to create activity directory: %w", err)
+		}
+	}
+	if redact == nil {
+		detector := secrets.NewDefaultDetector()
+		redact = func(s string) string {
+			out, _ := detector.Redact(s)
+			return out
+		}
+	}
+	return &Tracker{
+		dir:      dir,
+		redact:   redact,
+		now:      time.Now,
+		sessions: make(map[string]*sessionLog),
+	}, nil
+}
+
+// OnRecord registers fn to be called with every recorded entry, after it is
+// stored. fn must not call back into the tracker synchronously.
+func (t *Tracker) OnRecord(fn func(Entry)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.listeners = append(t.listeners, fn)
+}
+
+// Record redacts e, assigns its sequence number and time, pairs tool ends
+// with their starts, stores it and notifies listeners. It returns the stored
+// entry.
+func (t *Tracker) Record(sessionID string, e Entry) Entry {
+	t.mu.Lock()
+	sl := t.sessionLocked(sessionID)
+
+	e.SessionID = sessionID
+	if e.Time.IsZero() {
+		e.Time = t.now()
+	}
+	e.Input = t.summarize(e.Input)
+	e.Error = t.summarize(e.Error)
+	sl.seq++
+	e.Seq = sl.seq
+	sl.add(&e)
+
+	if err := t.appendLocked(sessionID, sl, e); err != nil {
+		log.Warn("failed to persist agent activity", "session", sessionID, "err", err)
+	}
+	listeners := slices.Clone(t.listeners)
+	t.mu.Unlock()
+
+	for _, fn := range listeners {
+		fn(e)
+	}
+	return e
+}
+
+// State returns the session's derived agent state.
+func (t *Tracker) State(sessionID string) State {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.sessionLocked(sessionID).state
+}
+
+// Recent returns up to limit of the session's latest entries, oldest first.
+// A limit of zero or less returns all entries held in memory.
+func (t *Tracker) Recent(sessionID string, limit int) []Entry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entries := t.sessionLocked(sessionID).entries
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return append([]Entry(nil), entries...)
+}
+
+// summarize reduces text to a redacted single line of bounded length.
+// Redaction runs first so truncation cannot leave half a secret unmatched.
+func (t *Tracker) summarize(text string) string {
+	if text == "" {
+		return ""
+	}
+	text = oneLine(t.redact(text))
+	if r := []rune(text); len(r) > maxSummaryLen {
+		text = string(r[:maxSummaryLen-1]) + "…"
+	}
+	return text
+}
+
+// sessionLocked returns the session's log, loading it from disk on first
+// use. Must be called with t.mu held.
+func (t *Tracker) sessionLocked(sessionID string) *sessionLog {
+	if sl, ok := t.sessions[sessionID]; ok {
+		return sl
+	}
+	sl := &sessionLog{pending: make(map[string][]Entry)}
+	if t.dir != "" {
+		if err := t.load(sessionID, sl); err != nil {
+			log.Warn("failed to load agent activity", "session", sessionID, "err", err)
+		}
+	}
+	t.sessions[sessionID] = sl
+	return sl
+}
+
+// add pairs e with pending tool calls, appends it and advances the state.
+func (sl *sessionLog) add(e *Entry) {
+	switch e.Kind {
+	case KindToolStart:
+		key := pairKey(*e)
+		sl.pending[key] = append(sl.pending[key], *e)
+	case KindToolEnd:
+		key := pairKey(*e)
+		if starts := sl.pending[key]; len(starts) > 0 {
+			start := starts[0]
+			if len(starts) == 1 {
+				delete(sl.pending, key)
+			} else {
+				sl.pending[key] = starts[1:]
+			}
+			e.Duration = e.Time.Sub(start.Time)
+			if e.Input == "" {
+				e.Input = start.Input
+			}
+		}
+	case KindPromptSubmit, KindStop:
+		// A turn boundary: any unfinished call was interrupted.
+		clear(sl.pending)
+	}
+
+	sl.entries = append(sl.entries, *e)
+	if len(sl.entries) > maxRecent {
+		sl.entries = append([]Entry(nil), sl.entries[len(sl.entries)-maxRecent:]...)
+	}
+	sl.state.apply(*e)
+}
+
+// pairKey matches a tool end to its start: by tool use ID when the agent
+// reports one, otherwise by tool name in call order.
+func pairKey(e Entry) string {
+	if e.ToolUseID != "" {
+		return "id:" + e.ToolUseID
+	}
+	return "tool:" + e.Tool
+}
+
+func (t *Tracker) path(sessionID string) string {
+	return filepath.Join(t.dir, url.PathEscape(sessionID)+".jsonl")
+}
+
+// load replays the session's file into sl. Unreadable lines, such as one
+// cut short by a crash, are skipped.
+func (t *Tracker) load(sessionID string, sl *sessionLog) error {
+	data, err := os.ReadFile(t.path(sessionID))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		sl.fileEntries++
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		sl.add(&e)
+		sl.seq = max(sl.seq, e.Seq)
+	}
+	return scanner.Err()
+}
+
+// appendLocked appends e to the session's file, rewriting the file from the
+// in-memory entries once it grows past maxFileEntries. Must be called with
+// t.mu held.
+func (t *Tracker) appendLocked(sessionID string, sl *sessionLog, e Entry) error {
+	if t.dir == "" {
+		return nil
+	}
+	if sl.fileEntries >= maxFileEntries {
+		return t.rewriteLocked(sessionID, sl)
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(t.path(sessionID), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	sl.fileEntries++
+	return f.Close()
+}
+
+// rewriteLocked atomically replaces the session's file with its in-memory
+// entries. Must be called with t.mu held.
+func (t *Tracker) rewriteLocked(sessionID string, sl *sessionLog) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, e := range sl.entries {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	path := t.path(sessionID)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	sl.fileEntries = len(sl.entries)
+	return nil
+}
@@ -0,0 +1,228 @@
+package activity
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// clockTracker returns a tracker whose clock advances by one second per entry.
+func clockTracker(t *testing.T, dir string) *Tracker {
+	t.Helper()
+	tr, err := NewTracker(dir, nil)
+	if err != nil {
+		t.Fatalf("NewTracker failed: %v", err)
+	}
+	now := time.Now()
+	tr.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return tr
+}
+
+func mustParse(t *testing.T, kind Kind, body string) Entry {
+	t.Helper()
+	e, err := ParseHook(kind, []byte(body))
+	if err != nil {
+		t.Fatalf("ParseHook(%s) failed: %v", kind, err)
+	}
+	return e
+}
+
+func TestParseHook(t *testing.T) {
+	tests := []struct {
+		name string
+		kind Kind
+		body string
+		want Entry
+	}{
+		{
+			name: "prompt",
+			kind: KindPromptSubmit,
+			body: `{"session_id":"abc","hook_event_name":"UserPromptSubmit","prompt":"fix the tests"}`,
+			want: Entry{Kind: KindPromptSubmit, AgentSessionID: "abc", Input: "fix the tests"},
+		},
+		{
+			name: "bash start",
+			kind: KindToolStart,
+			body: `{"tool_name":"Bash","tool_use_id":"t1","tool_input":{"command":"go test\n  ./...","description":"Run tests"}}`,
+			want: Entry{Kind: KindToolStart, Tool: "Bash", ToolUseID: "t1", Input: "go test ./..."},
+		},
+		{
+			name: "bash success",
+			kind: KindToolEnd,
+			body: `{"tool_name":"Bash","tool_input":{"command":"ls"},"tool_response":{"stdout":"a","stderr":"","interrupted":false}}`,
+			want: Entry{Kind: KindToolEnd, Tool: "Bash", Input: "ls", ExitStatus: ExitOK},
+		},
+		{
+			name: "bash interrupted",
+			kind: KindToolEnd,
+			body: `{"tool_name":"Bash","tool_input":{"command":"sleep 100"},"tool_response":{"interrupted":true}}`,
+			want: Entry{Kind: KindToolEnd, Tool: "Bash", Input: "sleep 100", ExitStatus: ExitInterrupted},
+		},
+		{
+			name: "mcp error",
+			kind: KindToolEnd,
+			body: `{"tool_name":"mcp__github__create_pr","tool_input":{"title":"x"},"tool_response":{"isError":true,"error":"rate limited"}}`,
+			want: Entry{Kind: KindToolEnd, Tool: "mcp__github__create_pr", Input: `{"title":"x"}`, ExitStatus: ExitError, Error: "rate limited"},
+		},
+		{
+			name: "stop",
+			kind: KindStop,
+			body: `{"stop_hook_active":false}`,
+			want: Entry{Kind: KindStop, StopReason: "end_turn"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mustParse(t, tt.kind, tt.body)
+			if got.ExitCode != nil {
+				t.Errorf("ExitCode = %d, want nil", *got.ExitCode)
+			}
+			got.ExitCode = nil
+			if got != tt.want {
+				t.Errorf("ParseHook = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHook_ExitCode(t *testing.T) {
+	e := mustParse(t, KindToolEnd, `{"tool_name":"Bash","tool_response":{"exit_code":2,"stderr":"no such file\nmore"}}`)
+	if e.ExitStatus != ExitError || e.ExitCode == nil || *e.ExitCode != 2 {
+		t.Errorf("got status %q code %v, want error with code 2", e.ExitStatus, e.ExitCode)
+	}
+	if e.Error != "no such file more" {
+		t.Errorf("Error = %q, want stderr on one line", e.Error)
+	}
+}
+
+func TestTracker_PairsToolCalls(t *testing.T) {
+	tr := clockTracker(t, "")
+
+	tr.Record("s", mustParse(t, KindPromptSubmit, `{"prompt":"go"}`))
+	tr.Record("s", mustParse(t, KindToolStart, `{"tool_name":"Read","tool_input":{"file_path":"a.go"}}`))
+	tr.Record("s", mustParse(t, KindToolStart, `{"tool_name":"Read","tool_input":{"file_path":"b.go"}}`))
+	first := tr.Record("s", mustParse(t, KindToolEnd, `{"tool_name":"Read"}`))
+
+	if first.Duration != 2*time.Second {
+		t.Errorf("Duration = %v, want 2s from the oldest unfinished Read", first.Duration)
+	}
+	if first.Input != "a.go" {
+		t.Errorf("Input = %q, want the start's input", first.Input)
+	}
+	if first.Seq != 4 {
+		t.Errorf("Seq = %d, want 4", first.Seq)
+	}
+	if got := tr.State("s"); got.Phase != PhaseWorking || got.Tool != "" {
+		t.Errorf("State = %+v, want working with no tool", got)
+	}
+}
+
+func TestTracker_State(t *testing.T) {
+	tr := clockTracker(t, "")
+	now := func() time.Time { return tr.now() }
+
+	if tr.State("s").Current(now()) {
+		t.Error("empty stream is current")
+	}
+
+	tr.Record("s", Entry{Kind: KindPromptSubmit})
+	tr.Record("s", Entry{Kind: KindToolStart, Tool: "Bash", Input: "make"})
+	if s := tr.State("s"); s.Phase != PhaseWorking || s.Tool != "Bash" {
+		t.Errorf("after tool start: %+v", s)
+	}
+	tr.Record("s", Entry{Kind: KindPermission, Tool: "Bash", Input: "rm -rf build"})
+	if s := tr.State("s"); s.Phase != PhaseWaiting || s.WaitingForAnswer() {
+		t.Errorf("after permission request: %+v", s)
+	}
+	tr.Record("s", Entry{Kind: KindPermissionResolved, Tool: "Bash", Decision: "deny"})
+	if s := tr.State("s"); s.Phase != PhaseWorking || s.Tool != "" {
+		t.Errorf("after permission denied: %+v", s)
+	}
+	tr.Record("s", Entry{Kind: KindToolStart, Tool: questionTool})
+	if s := tr.State("s"); !s.WaitingForAnswer() {
+		t.Errorf("after question: %+v", s)
+	}
+	tr.Record("s", Entry{Kind: KindStop, StopReason: "end_turn"})
+	s := tr.State("s")
+	if s.Phase != PhaseDone || s.StopReason != "end_turn" {
+		t.Errorf("after stop: %+v", s)
+	}
+	if !s.Current(now().Add(time.Hour)) {
+		t.Error("done state expired; it should last until the next prompt")
+	}
+
+	// Without prompt hooks the next turn can't be seen, so done is not trusted.
+	tr.Record("other", Entry{Kind: KindStop})
+	if tr.State("other").Current(now()) {
+		t.Error("done state trusted without prompt hooks")
+	}
+
+	tr.Record("s", Entry{Kind: KindPromptSubmit})
+	if tr.State("s").Current(now().Add(workingTTL)) {
+		t.Error("working state trusted after workingTTL without hooks")
+	}
+}
+
+func TestTracker_RedactsAndTruncates(t *testing.T) {
+	tr := clockTracker(t, "")
+	secret := "ghp_" + strings.Repeat("a1B2", 9)
+
+	e := tr.Record("s", Entry{Kind: KindToolStart, Tool: "Bash", Input: "curl -H 'Authorization: token " + secret + "' " + strings.Repeat("x", 300)})
+	if strings.Contains(e.Input, secret) {
+		t.Errorf("secret stored: %q", e.Input)
+	}
+	if n := len([]rune(e.Input)); n > maxSummaryLen {
+		t.Errorf("input length = %d, want at most %d", n, maxSummaryLen)
+	}
+}
+
+func TestTracker_Persistence(t *testing.T) {
+	dir := t.TempDir()
+	tr := clockTracker(t, dir)
+
+	var published []Entry
+	tr.OnRecord(func(e Entry) { published = append(published, e) })
+	tr.Record("team/api", Entry{Kind: KindPromptSubmit, Input: "hi"})
+	tr.Record("team/api", Entry{Kind: KindToolStart, Tool: "Bash", Input: "make"})
+	if len(published) != 2 {
+		t.Errorf("published %d entries, want 2", len(published))
+	}
+
+	reopened := clockTracker(t, dir)
+	recent := reopened.Recent("team/api", 0)
+	if len(recent) != 2 || recent[1].Tool != "Bash" {
+		t.Fatalf("reloaded entries = %+v", recent)
+	}
+	if s := reopened.State("team/api"); s.Phase != PhaseWorking || s.Tool != "Bash" {
+		t.Errorf("reloaded state = %+v", s)
+	}
+
+	// The reloaded stream continues the sequence and pairs the open call.
+	reopened.now = func() time.Time { return recent[1].Time.Add(5 * time.Second) }
+	end := reopened.Record("team/api", Entry{Kind: KindToolEnd, Tool: "Bash"})
+	if end.Seq != 3 || end.Duration != 5*time.Second {
+		t.Errorf("entry after reload: seq %d duration %v", end.Seq, end.Duration)
+	}
+}
+
+func TestTracker_RewritesLongFiles(t *testing.T) {
+	tr := clockTracker(t, t.TempDir())
+	for i := 0; i < maxFileEntries+10; i++ {
+		tr.Record("s", Entry{Kind: KindToolEnd, Tool: "Read"})
+	}
+
+	reopened := clockTracker(t, tr.dir)
+	recent := reopened.Recent("s", 0)
+	if len(recent) != maxRecent {
+		t.Errorf("reloaded %d entries, want %d", len(recent), maxRecent)
+	}
+	reopened.mu.Lock()
+	lines := reopened.sessions["s"].fileEntries
+	reopened.mu.Unlock()
+	if lines >= maxFileEntries {
+		t.Errorf("file has %d entries, want it rewritten below %d", lines, maxFileEntries)
+	}
+}
@@ -0,0 +1,208 @@
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkdata/deadlock"
+)
+
+// ErrDependencyCycle is returned by BacklogDependencyStore.AddDependency when
+// the new edge would make an item (transitively) block itself.
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+// BacklogDependency is a "blocked by" edge: ItemID cannot start until
+// BlockedByID is finished.
+type BacklogDependency struct {
+	ItemID      string    `json:"item_id"`
+	BlockedByID string    `json:"blocked_by_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// BacklogDependencyStore persists the dependency graph between backlog items.
+// The graph is kept acyclic: AddDependency rejects edges that would close a
+// cycle. All public methods are thread-safe.
+type BacklogDependencyStore struct {
+	mu    deadlock.RWMutex
+	path  string
+	edges []BacklogDependency
+}
+
+// NewBacklogDependencyStore loads (or creates) the dependency file at the given
+// path. An empty path yields an in-memory store.
+func NewBacklogDependencyStore(path string) (*BacklogDependencyStore, error) {
+	s := &BacklogDependencyStore{path: path}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("read backlog dependencies: %w", err)
+	}
+	if err := json.Unmarshal(data, &s.edges); err != nil {
+		return nil, fmt.Errorf("unmarshal backlog dependencies: %w", err)
+	}
+	return s, nil
+}
+
+// AddDependency records that itemID is blocked by blockedByID. Adding an
+// existing edge is a no-op. It returns an error wrapping ErrDependencyCycle,
+// naming the cycle, when blockedByID already depends on itemID.
+func (s *BacklogDependencyStore) AddDependency(itemID, blockedByID string) error {
+	if itemID == "" || blockedByID == "" {
+		return fmt.Errorf("item and blocker IDs are required")
+	}
+	if itemID == blockedByID {
+		return fmt.Errorf("%w: item %s cannot block itself", ErrDependencyCycle, itemID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.edges {
+		if e.ItemID == itemID && e.BlockedByID == blockedByID {
+			return nil
+		}
+	}
+	if path := s.blockerPath(blockedByID, itemID); path != nil {
+		cycle := append([]string{itemID}, path...)
+		return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(cycle, " → "))
+	}
+	s.edges = append(s.edges, BacklogDependency{ItemID: itemID, BlockedByID: blockedByID, CreatedAt: time.Now()})
+	return s.save()
+}
+
+// RemoveDependency deletes the edge if present.
+func (s *BacklogDependencyStore) RemoveDependency(itemID, blockedByID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.edges[:0]
+	for _, e := range s.edges {
+		if e.ItemID != itemID || e.BlockedByID != blockedByID {
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) == len(s.edges) {
+		return nil
+	}
+	s.edges = kept
+	return s.save()
+}
+
+// RemoveItem deletes every edge that names itemID, as blocked item or as
+// blocker. Called when the item is archived so the graph never references
+// items that are gone; its dependents are no longer held up by it.
+func (s *BacklogDependencyStore) RemoveItem(itemID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.edges[:0]
+	for _, e := range s.edges {
+		if e.ItemID != itemID && e.BlockedByID != itemID {
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) == len(s.edges) {
+		return nil
+	}
+	s.edges = kept
+	return s.save()
+}
+
+// BlockedBy returns the IDs of the items that directly block itemID, sorted.
+func (s *BacklogDependencyStore) BlockedBy(itemID string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var ids []string
+	for _, e := range s.edges {
+		if e.ItemID == itemID {
+			ids = append(ids, e.BlockedByID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Dependents returns the IDs of the items directly blocked by itemID, sorted.
+func (s *BacklogDependencyStore) Dependents(itemID string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var ids []string
+	for _, e := range s.edges {
+		if e.BlockedByID == itemID {
+			ids = append(ids, e.ItemID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Edges returns a copy of every dependency edge.
+func (s *BacklogDependencyStore) Edges() []BacklogDependency {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]BacklogDependency(nil), s.edges...)
+}
+
+// blockerPath returns the chain from → … → to following "blocked by" edges,
+// or nil when to is not reachable. Must be called with mu held.
+func (s *BacklogDependencyStore) blockerPath(from, to string) []string {
+	blockers := make(map[string][]string)
+	for _, e := range s.edges {
+		blockers[e.ItemID] = append(blockers[e.ItemID], e.BlockedByID)
+	}
+	visited := make(map[string]bool)
+	var walk func(id string) []string
+	walk = func(id string) []string {
+		if id == to {
+			return []string{id}
+		}
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+		for _, next := range blockers[id] {
+			if path := walk(next); path != nil {
+				return append([]string{id}, path...)
+			}
+		}
+		return nil
+	}
+	return walk(from)
+}
+
+// save writes state atomically via temp file + rename. Must be called with mu held (write).
+func (s *BacklogDependencyStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s.edges, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal backlog dependencies: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("create backlog dependencies dir: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("write temp backlog dependencies: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("rename backlog dependencies: %w", err)
+	}
+	return nil
+}
+
+// BlockerSatisfied reports whether a blocking item no longer holds up its
+// dependents: it is done, or its most recent review verdict passed.
+func BlockerSatisfied(status, overallOutcome string) bool {
+	return BacklogStatus(status) == BacklogStatusDone || overallOutcome == ReviewVerdictPass
+}
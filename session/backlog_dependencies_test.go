@@ -0,0 +1,53 @@
+package session
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBacklogDependencyStore_RejectsCycles(t *testing.T) {
+	s, err := NewBacklogDependencyStore("")
+	require.NoError(t, err)
+
+	require.NoError(t, s.AddDependency("b", "a"))
+	require.NoError(t, s.AddDependency("c", "b"))
+	require.NoError(t, s.AddDependency("c", "b"), "duplicate edges are a no-op")
+	assert.Len(t, s.Edges(), 2)
+
+	err = s.AddDependency("a", "c")
+	require.True(t, errors.Is(err, ErrDependencyCycle))
+	assert.Contains(t, err.Error(), "a → c → b → a")
+
+	err = s.AddDependency("a", "a")
+	assert.True(t, errors.Is(err, ErrDependencyCycle))
+
+	// A diamond is not a cycle.
+	require.NoError(t, s.AddDependency("c", "a"))
+	assert.Equal(t, []string{"a", "b"}, s.BlockedBy("c"))
+	assert.Equal(t, []string{"b", "c"}, s.Dependents("a"))
+}
+
+func TestBacklogDependencyStore_Persists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backlog_dependencies.json")
+	s, err := NewBacklogDependencyStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s.AddDependency("b", "a"))
+	require.NoError(t, s.AddDependency("c", "a"))
+	require.NoError(t, s.RemoveDependency("c", "a"))
+
+	reloaded, err := NewBacklogDependencyStore(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, reloaded.BlockedBy("b"))
+	assert.Empty(t, reloaded.BlockedBy("c"))
+}
+
+func TestBlockerSatisfied(t *testing.T) {
+	assert.True(t, BlockerSatisfied(string(BacklogStatusDone), ""))
+	assert.True(t, BlockerSatisfied(string(BacklogStatusReview), ReviewVerdictPass))
+	assert.False(t, BlockerSatisfied(string(BacklogStatusReview), ReviewVerdictFail))
+	assert.False(t, BlockerSatisfied(string(BacklogStatusInProgress), ""))
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/session/ent"
 )
@@ -14,8 +16,9 @@ import (
 // ReviewGateSpawner can create a short-lived review session for a backlog item.
 type ReviewGateSpawner interface {
 	// SpawnReviewSession creates a one-shot review session for item using prompt.
-	// itemSessionID is the UUID of the work ItemSession being reviewed.
-	SpawnReviewSession(ctx context.Context, item *ent.BacklogItem, itemSessionID string, prompt string) (*Instance, error)
+	// itemSessionID is the UUID of the work ItemSession being reviewed. program
+	// overrides the default program when non-empty.
+	SpawnReviewSession(ctx context.Context, item *ent.BacklogItem, itemSessionID string, prompt string, program string) (*Instance, error)
 }
 
 // BacklogLifecycleListener drives backlog item state transitions in response to
@@ -26,6 +29,7 @@ type ReviewGateSpawner interface {
 type BacklogLifecycleListener struct {
 	storage        *Storage
 	sessionCreator ReviewGateSpawner
+	panels         *ReviewPanelStore
 	enabled        atomic.Bool
 }
 
@@ -36,13 +40,21 @@ func (l *BacklogLifecycleListener) SetEnabled(v bool) { l.enabled.Store(v) }
 // NewBacklogLifecycleListener creates a listener backed by the given storage.
 // The review gate is disabled (sessionCreator=nil).
 func NewBacklogLifecycleListener(storage *Storage) *BacklogLifecycleListener {
-	return &BacklogLifecycleListener{storage: storage}
+	panels, _ := NewReviewPanelStore("")
+	return &BacklogLifecycleListener{storage: storage, panels: panels}
 }
 
 // NewBacklogLifecycleListenerWithSpawner creates a listener that will spawn a
 // review gate session when a work session exits and SkipReviewGate is false.
 func NewBacklogLifecycleListenerWithSpawner(storage *Storage, spawner ReviewGateSpawner) *BacklogLifecycleListener {
-	return &BacklogLifecycleListener{storage: storage, sessionCreator: spawner}
+	panels, _ := NewReviewPanelStore("")
+	return &BacklogLifecycleListener{storage: storage, sessionCreator: spawner, panels: panels}
+}
+
+// SetReviewPanelStore replaces the in-memory review panel store. Call during
+// startup, before any review gate runs.
+func (l *BacklogLifecycleListener) SetReviewPanelStore(panels *ReviewPanelStore) {
+	l.panels = panels
 }
 
 // instanceBacklogListener is a per-instance shim that binds the instance UUID into
@@ -102,6 +114,12 @@ func (l *BacklogLifecycleListener) onSessionExited(sessionUUID string) {
 		return
 	}
 
+	// A panel reviewer that exits without a verdict must not stall its panel.
+	if is.SessionRole == SessionRoleReview {
+		l.onReviewerExited(ctx, is, sessionUUID)
+		return
+	}
+
 	// Recursion guard: only drive transitions for work sessions.
 	if is.SessionRole != SessionRoleWork {
 		return
@@ -167,7 +185,7 @@ func (l *BacklogLifecycleListener) spawnReviewGate(item *ent.BacklogItem, is *en
 	}
 
 	// Security check — block if secrets detected.
-	if secErr := RunPreGateSecurityCheck(diff); secErr != nil {
+	if secErr := RunPreGateSecurityCheck(worktreePath, diff); secErr != nil {
 		log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate security check blocked item=%s: %v", item.ID, secErr)
 		// Record a failed review ItemSession with a FAIL verdict so the gate verdict
 		// is visible in the UI and operators can act (override or re-review).
@@ -198,11 +216,88 @@ func (l *BacklogLifecycleListener) spawnReviewGate(item *ent.BacklogItem, is *en
 		acSnapshot, _ = ParseAcCriteria(item.AcceptanceCriteria)
 	}
 
-	prompt := BuildReviewPrompt(item, acSnapshot, diff, truncated, is.ID.String())
+	// Every reviewer on the panel sees the same diff, identified by its hash.
+	cfg := config.LoadConfig()
+	run := NewReviewPanelRun(item.ID.String(), item.RepoPath, ReviewDiffHash(diff), cfg.ReviewPanel)
+
+	// Deterministic checks run before any reviewer is paid for.
+	if checks := ReviewChecksFor(cfg.ReviewChecks, item.RepoPath); len(checks) > 0 {
+		// Without the changed files the path checks cannot pass; they fail
+		// closed inside RunReviewChecks. LastCommitSha is the session's
+		// latest commit, not where it started, so the files are listed
+		// against the default branch.
+		changes, err := GetChangedFiles(ctx, worktreePath, "")
+		if err != nil {
+			log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate GetChangedFiles item=%s: %v", item.ID, err)
+		}
+		run.Checks = RunReviewChecks(ctx, worktreePath, changes, err, checks)
+		if failed := HardReviewCheckFailures(run.Checks); len(failed) > 0 {
+			l.failReviewChecks(ctx, run)
+			return
+		}
+	}
+
+	prompt := BuildReviewPrompt(item, acSnapshot, diff, truncated, is.ID.String()) + ReviewChecksPromptSection(run.Checks)
+
+	if err := l.panels.Create(run); err != nil {
+		log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate create review panel item=%s: %v", item.ID, err)
+		return
+	}
 
-	reviewInst, spawnErr := l.sessionCreator.SpawnReviewSession(ctx, item, is.ID.String(), prompt)
+	var wg sync.WaitGroup
+	for i := range run.Reviewers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			l.spawnPanelReviewer(ctx, item, is, run, i, prompt)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// failReviewChecks decides run FAIL without reviewers because a hard check
+// failed, and records the verdict on a placeholder review ItemSession.
+func (l *BacklogLifecycleListener) failReviewChecks(ctx context.Context, run *ReviewPanelRun) {
+	now := time.Now()
+	run.Reviewers = nil
+	run.Outcome = ReviewVerdictFail
+	run.DecidedAt = &now
+	if err := l.panels.Create(run); err != nil {
+		log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate create review panel (checks failed) item=%s: %v", run.ItemID, err)
+		return
+	}
+	placeholder, err := l.storage.CreateItemSession(ctx, ItemSessionData{
+		ItemID:      run.ItemID,
+		SessionUUID: ReviewPanelGateSessionUUID(run.ID),
+		SessionRole: SessionRoleReview,
+	})
+	if err != nil {
+		log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate CreateItemSession (checks failed) item=%s: %v", run.ItemID, err)
+		return
+	}
+	if err := SaveReviewPanelDecision(ctx, l.storage, run, placeholder.ID.String()); err != nil {
+		log.ErrorLog.Printf("[BacklogLifecycle] SaveReviewPanelDecision (checks failed) item=%s: %v", run.ItemID, err)
+		return
+	}
+	log.InfoLog.Printf("[BacklogLifecycle] spawnReviewGate hard checks failed for item %s — FAIL verdict recorded, no reviewer spawned", run.ItemID)
+}
+
+// spawnPanelReviewer starts reviewer i of run. A reviewer that fails to start
+// is recorded as UNVERIFIABLE so the rest of the panel can still decide.
+func (l *BacklogLifecycleListener) spawnPanelReviewer(ctx context.Context, item *ent.BacklogItem, is *ent.ItemSession, run *ReviewPanelRun, i int, prompt string) {
+	rv := run.Reviewers[i]
+	prompt += PanelReviewerInstructions(run, i)
+
+	reviewInst, spawnErr := l.sessionCreator.SpawnReviewSession(ctx, item, is.ID.String(), prompt, rv.Program)
 	if spawnErr != nil {
-		log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate SpawnReviewSession item=%s: %v", item.ID, spawnErr)
+		log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate SpawnReviewSession item=%s reviewer=%s: %v", item.ID, rv.Name, spawnErr)
+		l.markReviewerAbsent(ctx, run, i, fmt.Sprintf("reviewer failed to start: %v", spawnErr))
+		return
+	}
+
+	// Bind before linking so a verdict can never arrive for an unbound reviewer.
+	if err := l.panels.BindReviewer(run.ID, i, reviewInst.UUID); err != nil {
+		log.ErrorLog.Printf("[BacklogLifecycle] spawnReviewGate BindReviewer item=%s reviewer=%s: %v", item.ID, rv.Name, err)
 		return
 	}
 
@@ -217,7 +312,60 @@ func (l *BacklogLifecycleListener) spawnReviewGate(item *ent.BacklogItem, is *en
 		return
 	}
 
-	log.InfoLog.Printf("[BacklogLifecycle] spawnReviewGate spawned review session %s for item %s", reviewInst.UUID, item.ID)
+	log.InfoLog.Printf("[BacklogLifecycle] spawnReviewGate spawned review session %s (%s) for item %s", reviewInst.UUID, rv.Name, item.ID)
+}
+
+// markReviewerAbsent records a reviewer that never started and, if that
+// completes the panel, saves the decision on another reviewer's ItemSession
+// (or a placeholder when none started).
+func (l *BacklogLifecycleListener) markReviewerAbsent(ctx context.Context, run *ReviewPanelRun, i int, reason string) {
+	decidedRun, decided, err := l.panels.MarkReviewerAbsent(run.ID, i, reason)
+	if err != nil || !decided {
+		if err != nil {
+			log.ErrorLog.Printf("[BacklogLifecycle] MarkReviewerAbsent panel=%s: %v", run.ID, err)
+		}
+		return
+	}
+	itemSessionID := ""
+	for _, rv := range decidedRun.Reviewers {
+		if rv.SessionUUID == "" {
+			continue
+		}
+		if linked, err := l.storage.GetItemSessionBySessionAndItem(ctx, rv.SessionUUID, decidedRun.ItemID); err == nil {
+			itemSessionID = linked.ID.String()
+			break
+		}
+	}
+	if itemSessionID == "" {
+		placeholder, err := l.storage.CreateItemSession(ctx, ItemSessionData{
+			ItemID:      decidedRun.ItemID,
+			SessionUUID: ReviewPanelGateSessionUUID(decidedRun.ID),
+			SessionRole: SessionRoleReview,
+		})
+		if err != nil {
+			log.ErrorLog.Printf("[BacklogLifecycle] CreateItemSession (review panel placeholder) item=%s: %v", decidedRun.ItemID, err)
+			return
+		}
+		itemSessionID = placeholder.ID.String()
+	}
+	if err := SaveReviewPanelDecision(ctx, l.storage, decidedRun, itemSessionID); err != nil {
+		log.ErrorLog.Printf("[BacklogLifecycle] SaveReviewPanelDecision item=%s: %v", decidedRun.ItemID, err)
+	}
+}
+
+// onReviewerExited records an UNVERIFIABLE verdict for a panel reviewer that
+// exited without submitting one, deciding the panel if it was the last.
+func (l *BacklogLifecycleListener) onReviewerExited(ctx context.Context, is *ent.ItemSession, sessionUUID string) {
+	run, decided, err := l.panels.MarkSessionAbsent(sessionUUID, "reviewer exited without submitting a verdict")
+	if err != nil || !decided {
+		if err != nil && !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrPanelDecided) {
+			log.ErrorLog.Printf("[BacklogLifecycle] MarkSessionAbsent(%s) error: %v", sessionUUID, err)
+		}
+		return
+	}
+	if err := SaveReviewPanelDecision(ctx, l.storage, run, is.ID.String()); err != nil {
+		log.ErrorLog.Printf("[BacklogLifecycle] SaveReviewPanelDecision item=%s: %v", run.ItemID, err)
+	}
 }
 
 // ReconcileStuck calls ReconcileStuckItems and logs the result.
@@ -426,7 +426,7 @@ type mockReviewGateSpawner struct {
 	lastItem    *ent.BacklogItem
 }
 
-func (m *mockReviewGateSpawner) SpawnReviewSession(ctx context.Context, item *ent.BacklogItem, itemSessionID string, prompt string) (*Instance, error) {
+func (m *mockReviewGateSpawner) SpawnReviewSession(ctx context.Context, item *ent.BacklogItem, itemSessionID string, prompt string, program string) (*Instance, error) {
 	m.spawnCalled = true
 	m.lastItem = item
 	return &Instance{}, nil
@@ -3,38 +3,26 @@ package session
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/executor/safeexec"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
 	"github.com/tstapler/stapler-squad/session/ent"
+	"github.com/tstapler/stapler-squad/session/git"
 )
 
 // maxDiffSize is the maximum number of bytes included in a review prompt diff.
 const maxDiffSize = 40000
 
-// secretPatterns lists compiled regexes for obvious secret patterns.
-// The pattern name is used in the error message (not the matched value).
-var secretPatterns = []struct {
-	name string
-	re   *regexp.Regexp
-}{
-	{"aws_access_key_id", regexp.MustCompile(`(?i)aws_access_key_id`)},
-	{"AKIA_key", regexp.MustCompile(`AKIA[0-9A-Z]{16}`)},
-	{"private_key_pem", regexp.MustCompile(`-----BEGIN .{0,30}PRIVATE KEY-----`)},
-	{"github_pat", regexp.MustCompile(`ghp_[a-zA-Z0-9]{36}`)},
-	{"openai_key", regexp.MustCompile(`sk-[a-zA-Z0-9]{48}`)},
-}
-
-// RunPreGateSecurityCheck scans a git diff for obvious secret patterns before
-// sending to the review LLM. Returns a non-nil error if any pattern matches,
-// blocking the review gate from spawning. This is a best-effort check — it does
-// not replace a full secret scanner.
-func RunPreGateSecurityCheck(diff string) error {
-	for _, p := range secretPatterns {
-		if p.re.MatchString(diff) {
-			return fmt.Errorf("secret pattern detected: %s", p.name)
-		}
+// RunPreGateSecurityCheck scans the lines a git diff adds for secrets before
+// the diff is sent to the review LLM. Returns a non-nil error naming each
+// finding (never the secret value) if any are found, blocking the review gate
+// from spawning. The repository's .secretsignore file, if any, is honored.
+func RunPreGateSecurityCheck(repoPath, diff string) error {
+	d := git.NewSecretDetector(config.LoadConfig().SecretScan, repoPath)
+	if findings := d.ScanDiff(diff); len(findings) > 0 {
+		return fmt.Errorf("secrets detected in diff:%s", secrets.Summarize(findings, 5))
 	}
 	return nil
 }
@@ -94,6 +82,29 @@ func BuildReviewPrompt(item *ent.BacklogItem, acSnapshot []AcCriterion, diff str
 	return sb.String()
 }
 
+// PanelReviewerInstructions returns the prompt section appended to
+// BuildReviewPrompt for reviewer i of run: the rubric to score and, on a
+// multi-reviewer panel, the reviewer's name and focus.
+func PanelReviewerInstructions(run *ReviewPanelRun, i int) string {
+	var sb strings.Builder
+	sb.WriteString("\n## Rubric\n")
+	fmt.Fprintf(&sb, "Also pass rubric: {correctness, tests, style, security}, each a score from 0 to %d:\n", RubricMaxScore)
+	sb.WriteString("  - correctness: does the change do what the criteria ask, without regressions\n")
+	sb.WriteString("  - tests: are the changes covered by meaningful tests\n")
+	sb.WriteString("  - style: does the code follow the surrounding conventions\n")
+	sb.WriteString("  - security: is the change free of vulnerabilities and leaked secrets\n")
+	if len(run.Reviewers) > 1 {
+		fmt.Fprintf(&sb, "\n## Review Panel\nYou are reviewer %q on a panel of %d. The other reviewers evaluate the same diff independently; do not try to coordinate with them.\n",
+			run.Reviewers[i].Name, len(run.Reviewers))
+	}
+	if focus := run.Reviewers[i].Focus; focus != "" {
+		sb.WriteString("\n## Focus\n")
+		sb.WriteString(sanitizeField(focus, 1000))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
 // GetGitDiff returns the diff of changes in worktreePath relative to baseSHA
 // (or HEAD~1 if baseSHA is empty). If the diff exceeds maxDiffSize bytes it is
 // truncated and truncated=true is returned.
@@ -0,0 +1,399 @@
+// Package cgroup places session process trees in cgroup v2 groups so their
+// CPU, memory, IO and process usage can be measured and limited. It works on
+// the files of the unified hierarchy directly; no systemd or cgroup library
+// is involved.
+package cgroup
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrUnsupported is returned by NewManager when cgroup v2 is not available.
+var ErrUnsupported = errors.New("cgroup v2 is not available")
+
+// ErrNotDelegated is returned by NewManager when the root cgroup holds
+// processes other than the server and its children, such as the shell of
+// the terminal the server was started from. Moving those would change the
+// resource accounting of processes the server does not own.
+var ErrNotDelegated = errors.New("cgroup holds processes the server does not own; run the server in a cgroup of its own (e.g. a systemd unit with Delegate=yes) or set cgroups.root")
+
+// controllers are enabled for session groups when the parent offers them.
+var controllers = []string{"cpu", "memory", "io", "pids"}
+
+const (
+	// serverLeaf holds the processes that lived in the root before its
+	// controllers were delegated to children ("no internal processes" rule).
+	serverLeaf = "squad-server"
+	// cpuPeriod is the cpu.max period in microseconds.
+	cpuPeriod = 100000
+)
+
+// Limits caps a group's resource usage. Zero fields are unlimited.
+type Limits struct {
+	MemoryMax  int64 // bytes
+	MemoryHigh int64 // bytes
+	CPUPercent int   // 100 is one full CPU
+	PidsMax    int64
+	IOWeight   int // 1-10000; 0 keeps the kernel default
+}
+
+// Events are the cumulative limit counters of a group.
+type Events struct {
+	MemoryHigh uint64 // times usage exceeded memory.high and was throttled
+	MemoryMax  uint64 // times usage hit memory.max
+	OOM        uint64 // times the OOM killer was invoked
+	OOMKill    uint64 // processes killed by the OOM killer
+	PidsMax    uint64 // forks that failed at pids.max
+}
+
+// Stats is a point-in-time usage sample of a group.
+type Stats struct {
+	CPUUsage      time.Duration
+	CPUThrottled  time.Duration
+	MemoryCurrent uint64
+	MemoryPeak    uint64 // 0 on kernels without memory.peak
+	MemoryMax     uint64 // 0 when unlimited
+	IOReadBytes   uint64
+	IOWriteBytes  uint64
+	PIDs          uint64
+	PidsMax       uint64 // 0 when unlimited
+	Events        Events
+}
+
+// Manager creates session groups under a root cgroup directory.
+type Manager struct {
+	root string
+}
+
+// NewManager prepares root for session groups: it moves the server's
+// processes in root into a leaf and enables the cpu, memory, io and pids
+// controllers for its children. An empty root uses the calling process's own
+// cgroup. Either way root must be delegated to the server: writable by the
+// server user and holding no processes but the server's own, or NewManager
+// fails with ErrNotDelegated.
+func NewManager(root string) (*Manager, error) {
+	if root == "" {
+		var err error
+		if root, err = detectRoot(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		return nil, fmt.Errorf("%w: %s is not a cgroup v2 directory", ErrUnsupported, root)
+	}
+	if err := delegate(root); err != nil {
+		return nil, fmt.Errorf("prepare cgroup %s: %w", root, err)
+	}
+	return &Manager{root: root}, nil
+}
+
+// Root returns the directory session groups are created in.
+func (m *Manager) Root() string { return m.root }
+
+// Group returns the group called name without creating it.
+func (m *Manager) Group(name string) *Group {
+	return &Group{path: filepath.Join(m.root, name)}
+}
+
+// Create creates (or reuses) the group called name and applies limits.
+func (m *Manager) Create(name string, limits Limits) (*Group, error) {
+	g := m.Group(name)
+	if err := os.Mkdir(g.path, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("create cgroup %s: %w", g.path, err)
+	}
+	if err := g.SetLimits(limits); err != nil {
+		return g, err
+	}
+	return g, nil
+}
+
+// delegate enables the available controllers for root's children. Because a
+// cgroup with controllers enabled for its children may not contain processes
+// itself, root's processes are first moved to the serverLeaf child. Only the
+// server's own processes are moved; a root shared with anything else is
+// refused. The hierarchy's top-level cgroup is exempt from that rule.
+func delegate(root string) error {
+	available, err := readFields(filepath.Join(root, "cgroup.controllers"))
+	if err != nil {
+		return err
+	}
+	enabled, err := readFields(filepath.Join(root, "cgroup.subtree_control"))
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, c := range controllers {
+		if contains(available, c) && !contains(enabled, c) {
+			missing = append(missing, "+"+c)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if !isHierarchyRoot(root) {
+		procs, err := readFields(filepath.Join(root, "cgroup.procs"))
+		if err != nil {
+			return err
+		}
+		owned := serverProcesses()
+		for _, pid := range procs {
+			if n, err := strconv.Atoi(pid); err != nil || !owned[n] {
+				return fmt.Errorf("%w (process %s)", ErrNotDelegated, pid)
+			}
+		}
+		if len(procs) > 0 {
+			leaf := filepath.Join(root, serverLeaf)
+			if err := os.Mkdir(leaf, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+				return err
+			}
+			for _, pid := range procs {
+				// Processes may exit while they are being moved.
+				if err := writeFile(filepath.Join(leaf, "cgroup.procs"), pid); err != nil && !errors.Is(err, syscall.ESRCH) {
+					return fmt.Errorf("move process %s to %s: %w", pid, leaf, err)
+				}
+			}
+		}
+	}
+	return writeFile(filepath.Join(root, "cgroup.subtree_control"), strings.Join(missing, " "))
+}
+
+// serverProcesses returns the PIDs of the server and its descendants, the
+// processes delegate may move. Overridable in tests.
+var serverProcesses = func() map[int]bool {
+	self := os.Getpid()
+	owned := map[int]bool{self: true}
+	for _, pid := range descendants(self) {
+		owned[pid] = true
+	}
+	return owned
+}
+
+// isHierarchyRoot reports whether dir is the top of a cgroup v2 mount.
+func isHierarchyRoot(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "cgroup.type"))
+	return errors.Is(err, os.ErrNotExist)
+}
+
+// Group is one session's cgroup.
+type Group struct {
+	path string
+}
+
+// Path returns the group's directory.
+func (g *Group) Path() string { return g.path }
+
+// AddProcess moves pid into the group. Its future children are created in
+// the group too.
+func (g *Group) AddProcess(pid int) error {
+	if err := writeFile(filepath.Join(g.path, "cgroup.procs"), strconv.Itoa(pid)); err != nil {
+		return fmt.Errorf("add process %d to %s: %w", pid, g.path, err)
+	}
+	return nil
+}
+
+// maxTreePasses bounds how often AddProcessTree re-scans for processes forked
+// while the tree was being moved.
+const maxTreePasses = 3
+
+// AddProcessTree moves pid and every process descended from it into the
+// group. The root moves first so that children forked meanwhile start in the
+// group; descendants are re-scanned until no new ones appear. Descendants
+// that exit before they are moved are skipped.
+func (g *Group) AddProcessTree(pid int) error {
+	if err := g.AddProcess(pid); err != nil {
+		return err
+	}
+	moved := map[int]bool{pid: true}
+	for pass := 0; pass < maxTreePasses; pass++ {
+		found := false
+		for _, child := range descendants(pid) {
+			if moved[child] {
+				continue
+			}
+			moved[child] = true
+			found = true
+			if err := g.AddProcess(child); err != nil && processExists(child) {
+				return err
+			}
+		}
+		if !found {
+			break
+		}
+	}
+	return nil
+}
+
+// SetLimits writes limits to the group. Zero limits are reset to unlimited;
+// limits of controllers that are not enabled are skipped unless set.
+func (g *Group) SetLimits(l Limits) error {
+	cpu := "max " + strconv.Itoa(cpuPeriod)
+	if l.CPUPercent > 0 {
+		cpu = fmt.Sprintf("%d %d", l.CPUPercent*cpuPeriod/100, cpuPeriod)
+	}
+	type limitWrite struct {
+		file  string
+		value string
+		set   bool
+	}
+	writes := []limitWrite{
+		{"memory.max", maxOrValue(l.MemoryMax), l.MemoryMax > 0},
+		{"memory.high", maxOrValue(l.MemoryHigh), l.MemoryHigh > 0},
+		{"cpu.max", cpu, l.CPUPercent > 0},
+		{"pids.max", maxOrValue(l.PidsMax), l.PidsMax > 0},
+	}
+	if l.IOWeight > 0 {
+		writes = append(writes, limitWrite{"io.weight", "default " + strconv.Itoa(l.IOWeight), true})
+	}
+	var errs []error
+	for _, w := range writes {
+		err := writeFile(filepath.Join(g.path, w.file), w.value)
+		if err != nil && (w.set || !errors.Is(err, os.ErrNotExist)) {
+			errs = append(errs, fmt.Errorf("set %s: %w", w.file, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Stats samples the group's usage. Files of controllers that are not enabled
+// read as zero.
+func (g *Group) Stats() (Stats, error) {
+	if _, err := os.Stat(g.path); err != nil {
+		return Stats{}, err
+	}
+	var s Stats
+	cpu := readKeyed(filepath.Join(g.path, "cpu.stat"))
+	s.CPUUsage = time.Duration(cpu["usage_usec"]) * time.Microsecond
+	s.CPUThrottled = time.Duration(cpu["throttled_usec"]) * time.Microsecond
+	s.MemoryCurrent = readUint(filepath.Join(g.path, "memory.current"))
+	s.MemoryPeak = readUint(filepath.Join(g.path, "memory.peak"))
+	s.MemoryMax = readUint(filepath.Join(g.path, "memory.max"))
+	s.IOReadBytes, s.IOWriteBytes = readIOStat(filepath.Join(g.path, "io.stat"))
+	s.PIDs = readUint(filepath.Join(g.path, "pids.current"))
+	s.PidsMax = readUint(filepath.Join(g.path, "pids.max"))
+
+	mem := readKeyed(filepath.Join(g.path, "memory.events"))
+	s.Events = Events{
+		MemoryHigh: mem["high"],
+		MemoryMax:  mem["max"],
+		OOM:        mem["oom"],
+		OOMKill:    mem["oom_kill"],
+		PidsMax:    readKeyed(filepath.Join(g.path, "pids.events"))["max"],
+	}
+	return s, nil
+}
+
+// Remove kills any processes left in the group and deletes it.
+func (g *Group) Remove() error {
+	_ = writeFile(filepath.Join(g.path, "cgroup.kill"), "1")
+	var err error
+	for attempt := 0; attempt < 10; attempt++ {
+		if err = os.Remove(g.path); err == nil || errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return fmt.Errorf("remove cgroup %s: %w", g.path, err)
+}
+
+func maxOrValue(v int64) string {
+	if v <= 0 {
+		return "max"
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+func writeFile(name, value string) error {
+	f, err := os.OpenFile(name, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	_, werr := f.WriteString(value)
+	cerr := f.Close()
+	if werr != nil {
+		return werr
+	}
+	return cerr
+}
+
+func readFields(name string) ([]string, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(data)), nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// readUint reads a single-value file. "max" and missing files read as 0.
+func readUint(name string) uint64 {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return 0
+	}
+	v, _ := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return v
+}
+
+// readKeyed reads a flat keyed file of "key value" lines.
+func readKeyed(name string) map[string]uint64 {
+	out := map[string]uint64{}
+	f, err := os.Open(name)
+	if err != nil {
+		return out
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			out[fields[0]] = v
+		}
+	}
+	return out
+}
+
+// readIOStat sums rbytes and wbytes over all devices in io.stat.
+func readIOStat(name string) (read, write uint64) {
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, 0
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		for _, field := range strings.Fields(sc.Text()) {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			n, _ := strconv.ParseUint(value, 10, 64)
+			switch key {
+			case "rbytes":
+				read += n
+			case "wbytes":
+				write += n
+			}
+		}
+	}
+	return read, write
+}
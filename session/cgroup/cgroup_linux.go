@@ -0,0 +1,91 @@
+//go:build linux
+
+package cgroup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// mountPoint is where the unified cgroup v2 hierarchy is mounted.
+const mountPoint = "/sys/fs/cgroup"
+
+// procDir is the proc filesystem processes are enumerated from.
+const procDir = "/proc"
+
+// detectRoot returns the calling process's own cgroup directory from
+// /proc/self/cgroup ("0::/path" on a unified hierarchy).
+func detectRoot() (string, error) {
+	if _, err := os.Stat(filepath.Join(mountPoint, "cgroup.controllers")); err != nil {
+		return "", fmt.Errorf("%w: no unified hierarchy at %s", ErrUnsupported, mountPoint)
+	}
+	data, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if rel, ok := strings.CutPrefix(line, "0::"); ok {
+			return filepath.Join(mountPoint, filepath.Clean("/"+rel)), nil
+		}
+	}
+	return "", fmt.Errorf("%w: process is not in a cgroup v2 hierarchy", ErrUnsupported)
+}
+
+// descendants returns the PIDs of every live process below pid, found by
+// reading each process's parent from /proc/<pid>/stat.
+func descendants(pid int) []int {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return nil
+	}
+	children := make(map[int][]int)
+	for _, e := range entries {
+		child, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+		if ppid, ok := parentPID(child); ok {
+			children[ppid] = append(children[ppid], child)
+		}
+	}
+	var out []int
+	queue := []int{pid}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, c := range children[next] {
+			out = append(out, c)
+			queue = append(queue, c)
+		}
+	}
+	return out
+}
+
+// parentPID reads the parent PID from /proc/<pid>/stat. The command name in
+// field 2 may contain spaces and parentheses, so fields are counted from the
+// last ')'.
+func parentPID(pid int) (int, bool) {
+	data, err := os.ReadFile(filepath.Join(procDir, strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return 0, false
+	}
+	i := strings.LastIndexByte(string(data), ')')
+	if i < 0 {
+		return 0, false
+	}
+	fields := strings.Fields(string(data[i+1:]))
+	if len(fields) < 2 {
+		return 0, false
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	return ppid, err == nil
+}
+
+// processExists reports whether pid is still running.
+func processExists(pid int) bool {
+	_, err := os.Stat(filepath.Join(procDir, strconv.Itoa(pid)))
+	return err == nil
+}
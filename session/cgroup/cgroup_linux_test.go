@@ -0,0 +1,48 @@
+//go:build linux
+
+package cgroup
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescendants_FindsGrandchildren(t *testing.T) {
+	// sh → (sh → sleep) and sh → sleep: one child and two grandchildren deep.
+	cmd := exec.Command("sh", "-c", "(sleep 30 & wait) & sleep 30 & wait")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		for _, pid := range descendants(cmd.Process.Pid) {
+			_ = exec.Command("kill", strconv.Itoa(pid)).Run()
+		}
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	var found []int
+	require.Eventually(t, func() bool {
+		found = descendants(cmd.Process.Pid)
+		return len(found) >= 3
+	}, 5*time.Second, 20*time.Millisecond)
+
+	sleeps := 0
+	for _, pid := range found {
+		ppid, ok := parentPID(pid)
+		require.True(t, ok)
+		assert.NotZero(t, ppid)
+		if comm, err := os.ReadFile(filepath.Join(procDir, strconv.Itoa(pid), "comm")); err == nil &&
+			strings.TrimSpace(string(comm)) == "sleep" {
+			sleeps++
+		}
+	}
+	assert.Equal(t, 2, sleeps, "both sleeps, including the grandchild, are descendants")
+	assert.Empty(t, descendants(found[len(found)-1]), "a sleep has no children")
+}
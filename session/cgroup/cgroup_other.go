@@ -0,0 +1,14 @@
+//go:build !linux
+
+package cgroup
+
+// detectRoot always fails: cgroups are Linux-only.
+func detectRoot() (string, error) {
+	return "", ErrUnsupported
+}
+
+// descendants finds no processes: cgroups are Linux-only.
+func descendants(int) []int { return nil }
+
+// processExists assumes pid is running.
+func processExists(int) bool { return true }
@@ -0,0 +1,142 @@
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFiles creates dir and the given files, mimicking cgroupfs entries.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+}
+
+func readFile(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestNewManager_DelegatesControllersAndMovesProcesses(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"cgroup.controllers":     "cpuset cpu io memory pids\n",
+		"cgroup.subtree_control": "",
+		"cgroup.type":            "domain\n",
+		"cgroup.procs":           "4242\n",
+	})
+	// The leaf's procs file exists in a real cgroupfs as soon as it is created.
+	writeFiles(t, filepath.Join(root, serverLeaf), map[string]string{"cgroup.procs": ""})
+	ownProcesses(t, 4242)
+
+	m, err := NewManager(root)
+	require.NoError(t, err)
+	assert.Equal(t, root, m.Root())
+	assert.Equal(t, "4242", readFile(t, filepath.Join(root, serverLeaf, "cgroup.procs")))
+	assert.Equal(t, "+cpu +memory +io +pids", readFile(t, filepath.Join(root, "cgroup.subtree_control")))
+}
+
+func TestNewManager_RefusesSharedCgroup(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"cgroup.controllers":     "cpu memory\n",
+		"cgroup.subtree_control": "",
+		"cgroup.type":            "domain\n",
+		// The server shares its terminal's cgroup with the shell (777).
+		"cgroup.procs": "4242\n777\n",
+	})
+	writeFiles(t, filepath.Join(root, serverLeaf), map[string]string{"cgroup.procs": ""})
+	ownProcesses(t, 4242)
+
+	_, err := NewManager(root)
+	assert.ErrorIs(t, err, ErrNotDelegated)
+	assert.Empty(t, readFile(t, filepath.Join(root, serverLeaf, "cgroup.procs")), "no process may be moved")
+	assert.Empty(t, readFile(t, filepath.Join(root, "cgroup.subtree_control")))
+}
+
+// ownProcesses makes delegate treat pids as the server's own processes.
+func ownProcesses(t *testing.T, pids ...int) {
+	t.Helper()
+	prev := serverProcesses
+	t.Cleanup(func() { serverProcesses = prev })
+	serverProcesses = func() map[int]bool {
+		owned := make(map[int]bool)
+		for _, pid := range pids {
+			owned[pid] = true
+		}
+		return owned
+	}
+}
+
+func TestNewManager_RejectsNonCgroupDir(t *testing.T) {
+	_, err := NewManager(t.TempDir())
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestGroup_SetLimits(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"memory.max":  "",
+		"memory.high": "",
+		"cpu.max":     "",
+		"pids.max":    "",
+		"io.weight":   "",
+	})
+	g := &Group{path: dir}
+
+	require.NoError(t, g.SetLimits(Limits{MemoryMax: 512 << 20, CPUPercent: 150, PidsMax: 256, IOWeight: 50}))
+	assert.Equal(t, "536870912", readFile(t, filepath.Join(dir, "memory.max")))
+	assert.Equal(t, "max", readFile(t, filepath.Join(dir, "memory.high")))
+	assert.Equal(t, "150000 100000", readFile(t, filepath.Join(dir, "cpu.max")))
+	assert.Equal(t, "256", readFile(t, filepath.Join(dir, "pids.max")))
+	assert.Equal(t, "default 50", readFile(t, filepath.Join(dir, "io.weight")))
+}
+
+func TestGroup_SetLimitsMissingController(t *testing.T) {
+	g := &Group{path: t.TempDir()}
+	// Unset limits of absent controllers are fine; set ones are errors.
+	assert.NoError(t, g.SetLimits(Limits{}))
+	assert.Error(t, g.SetLimits(Limits{PidsMax: 10}))
+}
+
+func TestGroup_Stats(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"cpu.stat":       "usage_usec 2500000\nuser_usec 2000000\nsystem_usec 500000\nthrottled_usec 1000\n",
+		"memory.current": "104857600\n",
+		"memory.peak":    "209715200\n",
+		"memory.max":     "max\n",
+		"io.stat":        "8:0 rbytes=1024 wbytes=2048 rios=1 wios=2\n259:0 rbytes=1 wbytes=2\n",
+		"pids.current":   "12\n",
+		"pids.max":       "256\n",
+		"memory.events":  "low 0\nhigh 3\nmax 2\noom 1\noom_kill 1\n",
+		"pids.events":    "max 4\n",
+	})
+	s, err := (&Group{path: dir}).Stats()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2500*time.Millisecond, s.CPUUsage)
+	assert.Equal(t, time.Millisecond, s.CPUThrottled)
+	assert.Equal(t, uint64(100<<20), s.MemoryCurrent)
+	assert.Equal(t, uint64(200<<20), s.MemoryPeak)
+	assert.Zero(t, s.MemoryMax, "max is unlimited")
+	assert.Equal(t, uint64(1025), s.IOReadBytes)
+	assert.Equal(t, uint64(2050), s.IOWriteBytes)
+	assert.Equal(t, uint64(12), s.PIDs)
+	assert.Equal(t, uint64(256), s.PidsMax)
+	assert.Equal(t, Events{MemoryHigh: 3, MemoryMax: 2, OOM: 1, OOMKill: 1, PidsMax: 4}, s.Events)
+}
+
+func TestGroup_StatsMissingGroup(t *testing.T) {
+	_, err := (&Group{path: filepath.Join(t.TempDir(), "gone")}).Stats()
+	assert.Error(t, err)
+}
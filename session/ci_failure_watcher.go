@@ -0,0 +1,263 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tstapler/stapler-squad/forge"
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// CIFailureWatcherConfig configures the CI failure loop.
+type CIFailureWatcherConfig struct {
+	// MaxAttempts is the retry budget: how many automatic fix prompts are sent
+	// while CI keeps failing. It is restored once CI passes again.
+	MaxAttempts int
+	// LogLines caps the log excerpt included per failing check.
+	LogLines int
+	// MaxChecks caps how many failing checks have their logs downloaded per prompt.
+	MaxChecks int
+}
+
+// DefaultCIFailureWatcherConfig returns sensible defaults.
+func DefaultCIFailureWatcherConfig() CIFailureWatcherConfig {
+	return CIFailureWatcherConfig{
+		MaxAttempts: 3,
+		LogLines:    80,
+		MaxChecks:   3,
+	}
+}
+
+// CIFailedCheck is a failing check together with the relevant part of its log.
+type CIFailedCheck struct {
+	Check   forge.CheckRun
+	Excerpt string // Empty when the forge has no log for the check
+}
+
+// CIFailure is a newly observed CI failure on a session's PR.
+type CIFailure struct {
+	Owner   string
+	Repo    string
+	Number  int
+	Checks  []CIFailedCheck
+	Attempt int  // Automatic fix attempt number (1-based); 0 when no prompt was sent
+	Sent    bool // True when the fix prompt was queued to the agent
+}
+
+// CIFailureWatcher notices failing CI checks on a session's PR, downloads the
+// failing jobs' log tails through the forge, and extracts the error section.
+// The failure is always surfaced as a review-queue attention reason
+// (ReasonCIFailing via Instance.CIFailure) and a "CI failed, here is the log"
+// prompt is prepared, which SendPrompt delivers on demand. Sessions opted into
+// automatic CI fixes (Instance.CIAutoFixEnabled) get the prompt queued to their
+// agent right away, limited by a retry budget so a fix that never lands cannot
+// loop forever.
+type CIFailureWatcher struct {
+	store  *PRFeedbackStore
+	config CIFailureWatcherConfig
+
+	// forgeFor resolves the forge client for a session. Overridable in tests.
+	forgeFor func(*Instance) (forge.Forge, string, string, error)
+	// send queues a prompt to the session's agent. Overridable in tests.
+	send func(*Instance, string) error
+}
+
+// NewCIFailureWatcher creates a watcher that shares the PR feedback store.
+func NewCIFailureWatcher(store *PRFeedbackStore, config CIFailureWatcherConfig) *CIFailureWatcher {
+	return &CIFailureWatcher{
+		store:    store,
+		config:   config,
+		forgeFor: (*Instance).resolveForge,
+		send:     sendPRFeedbackCommand,
+	}
+}
+
+// ErrNoCIFailure is returned by SendPrompt when the session has no prepared
+// CI fix prompt, i.e. its PR's checks are not failing.
+var ErrNoCIFailure = errors.New("no failing CI checks to report")
+
+// Restore applies persisted opt-in flags to freshly loaded instances.
+func (w *CIFailureWatcher) Restore(instances []*Instance) {
+	for _, inst := range instances {
+		if w.store.IsCIAutoFixEnabled(prFeedbackKey(inst)) {
+			inst.SetCIAutoFixEnabled(true)
+		}
+	}
+}
+
+// SetEnabled opts a session in or out of automatic CI fix prompts and persists the choice.
+func (w *CIFailureWatcher) SetEnabled(inst *Instance, enabled bool) error {
+	inst.SetCIAutoFixEnabled(enabled)
+	return w.store.SetCIAutoFix(prFeedbackKey(inst), enabled)
+}
+
+// SendPrompt queues the fix prompt prepared for the session's latest CI
+// failure to its agent and returns it. Prompts sent on demand do not consume
+// the automatic retry budget.
+func (w *CIFailureWatcher) SendPrompt(inst *Instance) (string, error) {
+	prompt := w.store.CIPrompt(prFeedbackKey(inst))
+	if prompt == "" {
+		return "", ErrNoCIFailure
+	}
+	if err := w.send(inst, prompt); err != nil {
+		return "", fmt.Errorf("queue CI failure for session '%s': %w", inst.Title, err)
+	}
+	log.Info("CI failure watcher: fix prompt sent on demand", "session", inst.Title)
+	return prompt, nil
+}
+
+// Check reconciles the session's CI failure state with its PR's current check
+// conclusion. It returns the failure when new failing checks were reported on
+// this call, nil otherwise.
+func (w *CIFailureWatcher) Check(ctx context.Context, inst *Instance) (*CIFailure, error) {
+	key := prFeedbackKey(inst)
+
+	inst.stateMutex.RLock()
+	prNumber := inst.GitHubPRNumber
+	conclusion := inst.GitHubCheckConclusion
+	terminal := inst.GitHubPRStatusTerminal
+	inst.stateMutex.RUnlock()
+
+	if prNumber == 0 || terminal || conclusion != "failure" {
+		w.clear(inst, key)
+		return nil, nil
+	}
+
+	f, owner, repo, err := w.forgeFor(inst)
+	if err != nil {
+		return nil, err
+	}
+	status, err := f.GetCIStatus(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, fmt.Errorf("get CI status: %w", err)
+	}
+
+	var failing []forge.CheckRun
+	for _, c := range status.Checks {
+		if c.Conclusion == "failure" || c.Conclusion == "timed_out" {
+			failing = append(failing, c)
+		}
+	}
+	if len(failing) == 0 {
+		w.clear(inst, key)
+		return nil, nil
+	}
+
+	reported, attempts := w.store.CIState(key)
+	var fresh []forge.CheckRun
+	for _, c := range failing {
+		if !reported[c.ID] {
+			fresh = append(fresh, c)
+		}
+	}
+	if len(fresh) == 0 {
+		// Already reported (possibly before a restart); make sure the queue still shows it.
+		if inst.CIFailure() == "" {
+			inst.SetCIFailure(w.summary(failing, attempts, inst.IsCIAutoFixEnabled()))
+		}
+		return nil, nil
+	}
+
+	failure := &CIFailure{Owner: owner, Repo: repo, Number: prNumber}
+	for n, c := range fresh {
+		entry := CIFailedCheck{Check: c}
+		if n < w.config.MaxChecks {
+			entry.Excerpt = w.fetchExcerpt(ctx, f, owner, repo, c)
+		}
+		failure.Checks = append(failure.Checks, entry)
+	}
+
+	// The prepared prompt is kept for SendPrompt; automatic sends add the attempt count.
+	prepared := w.buildPrompt(failure)
+	if inst.IsCIAutoFixEnabled() && attempts < w.config.MaxAttempts {
+		failure.Attempt = attempts + 1
+		if err := w.send(inst, w.buildPrompt(failure)); err != nil {
+			// Leave the checks unreported so the next tick retries delivery.
+			inst.SetCIFailure(w.summary(failing, attempts, false))
+			return nil, fmt.Errorf("queue CI failure for session '%s': %w", inst.Title, err)
+		}
+		failure.Sent = true
+		attempts++
+	}
+
+	ids := make([]int, 0, len(fresh))
+	for _, c := range fresh {
+		ids = append(ids, c.ID)
+	}
+	if err := w.store.RecordCIFailure(key, ids, failure.Sent, prepared); err != nil {
+		log.Warn("CI failure watcher: failed to persist CI state", "session", inst.Title, "err", err)
+	}
+	inst.SetCIFailure(w.summary(failing, attempts, inst.IsCIAutoFixEnabled()))
+
+	log.Info("CI failure watcher: failing checks reported", "session", inst.Title, "pr", prNumber,
+		"checks", len(fresh), "sent", failure.Sent, "attempts", attempts)
+	return failure, nil
+}
+
+// clear drops CI failure state once CI is no longer failing.
+func (w *CIFailureWatcher) clear(inst *Instance, key string) {
+	inst.SetCIFailure("")
+	if err := w.store.ResetCI(key); err != nil {
+		log.Warn("CI failure watcher: failed to reset CI state", "session", inst.Title, "err", err)
+	}
+}
+
+// fetchExcerpt downloads a check's log and extracts its failure section.
+// Missing logs are not errors: third-party statuses and some forges have none.
+func (w *CIFailureWatcher) fetchExcerpt(ctx context.Context, f forge.Forge, owner, repo string, check forge.CheckRun) string {
+	logs, err := f.GetCheckLog(ctx, owner, repo, check)
+	if err != nil {
+		if !errors.Is(err, forge.ErrUnsupported) && !errors.Is(err, forge.ErrNotFound) {
+			log.Warn("CI failure watcher: failed to download check log", "check", check.Name, "err", err)
+		}
+		return ""
+	}
+	return forge.ExtractFailure(logs, w.config.LogLines)
+}
+
+// summary is the review-queue context line for a failing PR.
+func (w *CIFailureWatcher) summary(failing []forge.CheckRun, attempts int, autoFix bool) string {
+	names := make([]string, 0, len(failing))
+	for _, c := range failing {
+		names = append(names, c.Name)
+	}
+	s := "CI failing: " + strings.Join(names, ", ")
+	switch {
+	case !autoFix || attempts == 0:
+		return s
+	case attempts >= w.config.MaxAttempts:
+		return fmt.Sprintf("%s (automatic fix budget exhausted after %d attempts)", s, attempts)
+	default:
+		return fmt.Sprintf("%s (automatic fix attempt %d of %d sent)", s, attempts, w.config.MaxAttempts)
+	}
+}
+
+// buildPrompt renders the "CI failed, here is the log" prompt for the agent.
+// The attempt count is included for automatic fix attempts (Attempt > 0).
+func (w *CIFailureWatcher) buildPrompt(failure *CIFailure) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CI failed on PR #%d (%s/%s). The relevant part of each failing job's log is below.\n",
+		failure.Number, failure.Owner, failure.Repo)
+	b.WriteString("Find the root cause, fix it, run the failing check locally if you can, then commit and push.")
+	if failure.Attempt > 0 {
+		fmt.Fprintf(&b, " This is automatic fix attempt %d of %d.", failure.Attempt, w.config.MaxAttempts)
+	}
+	b.WriteString("\n")
+
+	for _, fc := range failure.Checks {
+		fmt.Fprintf(&b, "\n## %s (%s)\n", fc.Check.Name, fc.Check.Conclusion)
+		if fc.Check.DetailsURL != "" {
+			fmt.Fprintf(&b, "Details: %s\n", fc.Check.DetailsURL)
+		}
+		if fc.Excerpt == "" {
+			b.WriteString("(log not available; inspect the check details)\n")
+			continue
+		}
+		b.WriteString("```\n")
+		b.WriteString(fc.Excerpt)
+		b.WriteString("\n```\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package session
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/forge"
+)
+
+// ciForge serves canned check runs and logs; other Forge methods are unused.
+type ciForge struct {
+	forge.Forge
+	checks []forge.CheckRun
+	logs   map[int]string
+}
+
+func (f *ciForge) GetCIStatus(context.Context, string, string, int) (*forge.CIStatus, error) {
+	return &forge.CIStatus{Conclusion: "failure", Checks: f.checks}, nil
+}
+
+func (f *ciForge) GetCheckLog(_ context.Context, _, _ string, check forge.CheckRun) (string, error) {
+	if log, ok := f.logs[check.ID]; ok {
+		return log, nil
+	}
+	return "", forge.ErrNotFound
+}
+
+func newCIWatcherForTest(t *testing.T, f *ciForge) (*CIFailureWatcher, *[]string) {
+	t.Helper()
+	store, err := NewPRFeedbackStore(filepath.Join(t.TempDir(), "pr_feedback.json"))
+	require.NoError(t, err)
+	w := NewCIFailureWatcher(store, CIFailureWatcherConfig{MaxAttempts: 2, LogLines: 20, MaxChecks: 3})
+	w.forgeFor = func(*Instance) (forge.Forge, string, string, error) { return f, "acme", "widgets", nil }
+	var sent []string
+	w.send = func(_ *Instance, prompt string) error {
+		sent = append(sent, prompt)
+		return nil
+	}
+	return w, &sent
+}
+
+func TestCIFailureWatcher_SendsLogExcerptWithinBudget(t *testing.T) {
+	f := &ciForge{
+		checks: []forge.CheckRun{
+			{ID: 1, Name: "lint", Conclusion: "success"},
+			{ID: 2, Name: "test", Conclusion: "failure", DetailsURL: "https://ci.example/2"},
+		},
+		logs: map[int]string{2: "go test ./...\n--- FAIL: TestRetry (0.01s)\n    retry_test.go:12: want 3, got 5\nFAIL\n"},
+	}
+	w, sent := newCIWatcherForTest(t, f)
+	inst := &Instance{Title: "retry", UUID: "u-1", GitHubPRNumber: 42, GitHubCheckConclusion: "failure"}
+	require.NoError(t, w.SetEnabled(inst, true))
+
+	failure, err := w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, failure)
+	assert.True(t, failure.Sent)
+	assert.Equal(t, 1, failure.Attempt)
+	require.Len(t, *sent, 1)
+	assert.Contains(t, (*sent)[0], "CI failed on PR #42 (acme/widgets)")
+	assert.Contains(t, (*sent)[0], "attempt 1 of 2")
+	assert.Contains(t, (*sent)[0], "--- FAIL: TestRetry")
+	assert.Equal(t, "CI failing: test (automatic fix attempt 1 of 2 sent)", inst.CIFailure())
+
+	// The same failing run is reported only once.
+	failure, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	assert.Nil(t, failure)
+	assert.Len(t, *sent, 1)
+
+	// A re-run that fails again consumes the rest of the budget...
+	f.checks[1].ID = 3
+	_, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.Len(t, *sent, 2)
+	assert.Contains(t, (*sent)[1], "(log not available; inspect the check details)")
+
+	// ...after which failures are only surfaced in the review queue.
+	f.checks[1].ID = 4
+	failure, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, failure)
+	assert.False(t, failure.Sent)
+	assert.Len(t, *sent, 2)
+	assert.Contains(t, inst.CIFailure(), "budget exhausted after 2 attempts")
+
+	// Passing CI clears the attention state and restores the budget.
+	inst.GitHubCheckConclusion = "success"
+	_, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	assert.Empty(t, inst.CIFailure())
+	_, attempts := w.store.CIState(prFeedbackKey(inst))
+	assert.Zero(t, attempts)
+}
+
+func TestCIFailureWatcher_OnlyFlagsSessionsWithoutOptIn(t *testing.T) {
+	f := &ciForge{checks: []forge.CheckRun{{ID: 2, Name: "test", Conclusion: "timed_out"}}}
+	w, sent := newCIWatcherForTest(t, f)
+	inst := &Instance{Title: "retry", UUID: "u-1", GitHubPRNumber: 42, GitHubCheckConclusion: "failure"}
+
+	failure, err := w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, failure)
+	assert.False(t, failure.Sent)
+	assert.Empty(t, *sent)
+	assert.Equal(t, "CI failing: test", inst.CIFailure())
+
+	// The PR review feedback opt-in does not enable automatic CI fixes.
+	inst.SetPRFeedbackEnabled(true)
+	f.checks[0].ID = 3
+	failure, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, failure)
+	assert.False(t, failure.Sent)
+	assert.Empty(t, *sent)
+}
+
+func TestCIFailureWatcher_SendPromptOnDemand(t *testing.T) {
+	f := &ciForge{
+		checks: []forge.CheckRun{{ID: 2, Name: "test", Conclusion: "failure"}},
+		logs:   map[int]string{2: "--- FAIL: TestRetry (0.01s)\nFAIL\n"},
+	}
+	w, sent := newCIWatcherForTest(t, f)
+	inst := &Instance{Title: "retry", UUID: "u-1", GitHubPRNumber: 42, GitHubCheckConclusion: "failure"}
+
+	_, err := w.SendPrompt(inst)
+	assert.ErrorIs(t, err, ErrNoCIFailure)
+
+	_, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.Empty(t, *sent)
+
+	prompt, err := w.SendPrompt(inst)
+	require.NoError(t, err)
+	require.Len(t, *sent, 1)
+	assert.Equal(t, prompt, (*sent)[0])
+	assert.Contains(t, prompt, "--- FAIL: TestRetry")
+	assert.NotContains(t, prompt, "automatic fix attempt")
+	_, attempts := w.store.CIState(prFeedbackKey(inst))
+	assert.Zero(t, attempts, "on-demand sends do not use the retry budget")
+
+	// Passing CI discards the prepared prompt.
+	inst.GitHubCheckConclusion = "success"
+	_, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	_, err = w.SendPrompt(inst)
+	assert.ErrorIs(t, err, ErrNoCIFailure)
+}
@@ -645,6 +645,19 @@ func (cc *ClaudeController) GetRecentOutput(bytes int) []byte {
 	return buffer[len(buffer)-bytes:]
 }
 
+// GetScreenContent returns the emulated terminal's last lines of scrollback
+// and screen, with SGR styling, in the same shape as `tmux capture-pane -e -J`.
+func (cc *ClaudeController) GetScreenContent(lines int) string {
+	cc.mu.RLock()
+	defer cc.mu.RUnlock()
+
+	if cc.ptyAccess == nil {
+		return ""
+	}
+
+	return strings.Join(cc.ptyAccess.Screen().HistoryLines(lines, true), "\n")
+}
+
 // IsStarted returns whether the controller is currently started.
 func (cc *ClaudeController) IsStarted() bool {
 	cc.mu.RLock()
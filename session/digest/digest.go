@@ -0,0 +1,249 @@
+// Package digest condenses agent conversations into per-turn digests: the tools
+// a turn used, the files it edited, the commands it ran and the errors it hit.
+//
+// Digests are extractive and deterministic — they are built only from what the
+// conversation file records, so the same conversation always yields the same
+// timeline and no model or network access is needed.
+package digest
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tstapler/stapler-squad/session"
+)
+
+const (
+	// maxItems caps the commands and errors kept per turn; the tool counts
+	// still reflect every call.
+	maxItems = 25
+	// maxLineLen caps prompts, commands, errors and outcomes.
+	maxLineLen = 200
+)
+
+// ToolCount is how often a turn called one tool.
+type ToolCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// TurnDigest summarises one turn: a user prompt and all the assistant work up
+// to the next prompt.
+type TurnDigest struct {
+	// Index is the zero-based position of the turn in the conversation.
+	Index     int       `json:"index"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	// Prompt is the first line of the user message that opened the turn. It is
+	// empty for work recorded before the first prompt (e.g. a resumed session).
+	Prompt string `json:"prompt,omitempty"`
+	// Tools lists each tool called, in order of first use.
+	Tools []ToolCount `json:"tools,omitempty"`
+	// FilesTouched lists the files written by Edit, MultiEdit, Write and
+	// NotebookEdit calls, in order of first change.
+	FilesTouched []string `json:"files_touched,omitempty"`
+	// Commands lists the first line of each Bash command, in order.
+	Commands []string `json:"commands,omitempty"`
+	// Errors lists failed tool calls as "Tool: first line of the result".
+	Errors []string `json:"errors,omitempty"`
+	// Outcome is the first line of the turn's last assistant text.
+	Outcome string `json:"outcome,omitempty"`
+	// Summary is a one-sentence rendering of the fields above.
+	Summary string `json:"summary"`
+}
+
+// ToolCalls returns the total number of tool calls in the turn.
+func (t TurnDigest) ToolCalls() int {
+	n := 0
+	for _, tc := range t.Tools {
+		n += tc.Count
+	}
+	return n
+}
+
+// fileInputKey returns the input field naming the file a tool modifies, or ""
+// for tools that do not modify files.
+func fileInputKey(tool string) string {
+	switch tool {
+	case "Edit", "MultiEdit", "Write":
+		return "file_path"
+	case "NotebookEdit":
+		return "notebook_path"
+	}
+	return ""
+}
+
+// Summarize slices messages into turns and digests each one. A turn starts at
+// every user message carrying text; user messages that only return tool results
+// belong to the turn in progress.
+func Summarize(messages []session.ClaudeConversationMessage) []TurnDigest {
+	var turns []TurnDigest
+	var b *turnBuilder
+	for _, msg := range messages {
+		if msg.Role == "user" && len(msg.ToolResults) == 0 && strings.TrimSpace(msg.Content) != "" {
+			if b != nil {
+				turns = append(turns, b.finish())
+			}
+			b = newTurnBuilder(len(turns), msg.Timestamp)
+			b.turn.Prompt = firstLine(strings.TrimLeft(strings.TrimSpace(msg.Content), "/"))
+			continue
+		}
+		if b == nil {
+			b = newTurnBuilder(len(turns), msg.Timestamp)
+		}
+		b.add(msg)
+	}
+	if b != nil {
+		turns = append(turns, b.finish())
+	}
+	return turns
+}
+
+// turnBuilder accumulates one turn's digest.
+type turnBuilder struct {
+	turn      TurnDigest
+	toolIndex map[string]int    // tool name -> index in turn.Tools
+	files     map[string]bool   // files already in turn.FilesTouched
+	toolNames map[string]string // tool_use ID -> tool name, for attributing errors
+}
+
+func newTurnBuilder(index int, startedAt time.Time) *turnBuilder {
+	return &turnBuilder{
+		turn:      TurnDigest{Index: index, StartedAt: startedAt, EndedAt: startedAt},
+		toolIndex: make(map[string]int),
+		files:     make(map[string]bool),
+		toolNames: make(map[string]string),
+	}
+}
+
+func (b *turnBuilder) add(msg session.ClaudeConversationMessage) {
+	if msg.Timestamp.After(b.turn.EndedAt) {
+		b.turn.EndedAt = msg.Timestamp
+	}
+	if msg.Role == "assistant" {
+		if text := strings.TrimSpace(msg.Content); text != "" {
+			b.turn.Outcome = firstLine(text)
+		}
+	}
+	for _, use := range msg.ToolUses {
+		b.addToolUse(use)
+	}
+	for _, result := range msg.ToolResults {
+		if !result.IsError || len(b.turn.Errors) >= maxItems {
+			continue
+		}
+		name := b.toolNames[result.ToolUseID]
+		if name == "" {
+			name = "tool"
+		}
+		b.turn.Errors = append(b.turn.Errors, truncate(name+": "+firstLine(result.Content)))
+	}
+}
+
+func (b *turnBuilder) addToolUse(use session.ConversationToolUse) {
+	if use.Name == "" {
+		return
+	}
+	b.toolNames[use.ID] = use.Name
+	if i, ok := b.toolIndex[use.Name]; ok {
+		b.turn.Tools[i].Count++
+	} else {
+		b.toolIndex[use.Name] = len(b.turn.Tools)
+		b.turn.Tools = append(b.turn.Tools, ToolCount{Name: use.Name, Count: 1})
+	}
+
+	var input map[string]interface{}
+	if len(use.Input) > 0 {
+		_ = json.Unmarshal(use.Input, &input)
+	}
+	if key := fileInputKey(use.Name); key != "" {
+		if path, _ := input[key].(string); path != "" && !b.files[path] {
+			b.files[path] = true
+			b.turn.FilesTouched = append(b.turn.FilesTouched, path)
+		}
+	}
+	if use.Name == "Bash" && len(b.turn.Commands) < maxItems {
+		if cmd, _ := input["command"].(string); strings.TrimSpace(cmd) != "" {
+			b.turn.Commands = append(b.turn.Commands, firstLine(cmd))
+		}
+	}
+}
+
+func (b *turnBuilder) finish() TurnDigest {
+	b.turn.Summary = summarize(b.turn)
+	return b.turn
+}
+
+// summarize renders a turn as one sentence, e.g.
+// `"fix login" — 7 tool calls (Edit×3, Bash×2, Read×2); edited auth.go, login.go; ran 2 commands; 1 error.`
+func summarize(t TurnDigest) string {
+	var parts []string
+	if n := t.ToolCalls(); n > 0 {
+		tools := make([]string, 0, len(t.Tools))
+		for _, tc := range t.Tools {
+			tools = append(tools, fmt.Sprintf("%s×%d", tc.Name, tc.Count))
+		}
+		parts = append(parts, fmt.Sprintf("%s (%s)", plural(n, "tool call"), strings.Join(tools, ", ")))
+	} else {
+		parts = append(parts, "no tool calls")
+	}
+	if len(t.FilesTouched) > 0 {
+		const shown = 3
+		names := make([]string, 0, shown)
+		for i, f := range t.FilesTouched {
+			if i == shown {
+				break
+			}
+			names = append(names, filepath.Base(f))
+		}
+		edited := "edited " + strings.Join(names, ", ")
+		if extra := len(t.FilesTouched) - len(names); extra > 0 {
+			edited += fmt.Sprintf(" and %d more", extra)
+		}
+		parts = append(parts, edited)
+	}
+	if len(t.Commands) > 0 {
+		parts = append(parts, "ran "+plural(len(t.Commands), "command"))
+	}
+	if len(t.Errors) > 0 {
+		parts = append(parts, plural(len(t.Errors), "error"))
+	}
+
+	summary := strings.Join(parts, "; ") + "."
+	if t.Prompt != "" {
+		summary = fmt.Sprintf("%q — %s", t.Prompt, summary)
+	}
+	return summary
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// firstLine returns the first non-blank line of s, truncated.
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return truncate(line)
+		}
+	}
+	return ""
+}
+
+func truncate(s string) string {
+	if len(s) <= maxLineLen {
+		return s
+	}
+	cut := maxLineLen - 3
+	// Back up to a rune boundary so the result stays valid UTF-8.
+	for cut > 0 && s[cut]&0xC0 == 0x80 {
+		cut--
+	}
+	return s[:cut] + "..."
+}
@@ -0,0 +1,114 @@
+package digest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tstapler/stapler-squad/session"
+)
+
+// conversation is a Claude JSONL log with two turns: one that edits files, runs
+// commands and hits an error, and one that only answers.
+var conversation = strings.Join([]string{
+	`{"type":"user","timestamp":"2026-10-17T09:00:00Z","message":{"role":"user","content":"fix the login bug\nit 500s on empty passwords"}}`,
+	`{"type":"assistant","timestamp":"2026-10-17T09:00:05Z","message":{"role":"assistant","content":[{"type":"text","text":"Looking."},{"type":"tool_use","id":"t1","name":"Read","input":{"file_path":"/repo/auth.go"}}]}}`,
+	`{"type":"user","timestamp":"2026-10-17T09:00:06Z","message":{"role":"user","content":[{"type":"tool_result","tool_use_id":"t1","content":"package auth"}]}}`,
+	`{"type":"assistant","timestamp":"2026-10-17T09:01:00Z","message":{"role":"assistant","content":[{"type":"tool_use","id":"t2","name":"Edit","input":{"file_path":"/repo/auth.go","old_string":"a","new_string":"b"}},{"type":"tool_use","id":"t3","name":"Bash","input":{"command":"go test ./...\necho done"}}]}}`,
+	`{"type":"user","timestamp":"2026-10-17T09:01:30Z","message":{"role":"user","content":[{"type":"tool_result","tool_use_id":"t2","content":"ok"},{"type":"tool_result","tool_use_id":"t3","is_error":true,"content":[{"type":"text","text":"FAIL auth_test.go:12\nexit status 1"}]}]}}`,
+	`{"type":"assistant","timestamp":"2026-10-17T09:02:00Z","message":{"role":"assistant","content":[{"type":"tool_use","id":"t4","name":"Write","input":{"file_path":"/repo/auth_test.go","content":"x"}},{"type":"tool_use","id":"t5","name":"Edit","input":{"file_path":"/repo/auth.go"}},{"type":"tool_use","id":"t6","name":"Bash","input":{"command":"go test ./auth"}}]}}`,
+	`{"type":"summary","summary":"Login fix"}`,
+	`{"type":"assistant","timestamp":"2026-10-17T09:03:00Z","message":{"role":"assistant","content":[{"type":"text","text":"Fixed: empty passwords now return 400.\nTests pass."}]}}`,
+	`{"type":"user","timestamp":"2026-10-17T10:00:00Z","message":{"role":"user","content":"/explain what you changed"}}`,
+	`{"type":"assistant","timestamp":"2026-10-17T10:00:10Z","message":{"role":"assistant","content":[{"type":"text","text":"I validated the password."}]}}`,
+	``,
+}, "\n")
+
+func writeConversation(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "conv.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestSummarize(t *testing.T) {
+	messages, err := session.ReadConversationFile(writeConversation(t, conversation))
+	require.NoError(t, err)
+
+	turns := Summarize(messages)
+	require.Len(t, turns, 2)
+
+	first := turns[0]
+	assert.Equal(t, 0, first.Index)
+	assert.Equal(t, "fix the login bug", first.Prompt)
+	assert.Equal(t, "2026-10-17T09:00:00Z", first.StartedAt.UTC().Format(time.RFC3339))
+	assert.Equal(t, "2026-10-17T09:03:00Z", first.EndedAt.UTC().Format(time.RFC3339))
+	assert.Equal(t, []ToolCount{{"Read", 1}, {"Edit", 2}, {"Bash", 2}, {"Write", 1}}, first.Tools)
+	assert.Equal(t, 6, first.ToolCalls())
+	assert.Equal(t, []string{"/repo/auth.go", "/repo/auth_test.go"}, first.FilesTouched)
+	assert.Equal(t, []string{"go test ./...", "go test ./auth"}, first.Commands)
+	assert.Equal(t, []string{"Bash: FAIL auth_test.go:12"}, first.Errors)
+	assert.Equal(t, "Fixed: empty passwords now return 400.", first.Outcome)
+	assert.Equal(t, `"fix the login bug" — 6 tool calls (Read×1, Edit×2, Bash×2, Write×1); edited auth.go, auth_test.go; ran 2 commands; 1 error.`, first.Summary)
+
+	second := turns[1]
+	assert.Equal(t, 1, second.Index)
+	assert.Equal(t, "explain what you changed", second.Prompt)
+	assert.Empty(t, second.Tools)
+	assert.Equal(t, `"explain what you changed" — no tool calls.`, second.Summary)
+
+	assert.Equal(t, turns, Summarize(messages), "digests are deterministic")
+}
+
+func TestSummarize_WorkBeforeFirstPrompt(t *testing.T) {
+	turns := Summarize([]session.ClaudeConversationMessage{
+		{Role: "assistant", ToolUses: []session.ConversationToolUse{{ID: "a", Name: "Bash", Input: []byte(`{"command":"ls"}`)}}},
+		{Role: "user", ToolResults: []session.ConversationToolResult{{ToolUseID: "a", IsError: true}}},
+	})
+	require.Len(t, turns, 1)
+	assert.Empty(t, turns[0].Prompt)
+	assert.Equal(t, []string{"Bash: "}, turns[0].Errors)
+	assert.Equal(t, "1 tool call (Bash×1); ran 1 command; 1 error.", turns[0].Summary)
+}
+
+func TestStore_RebuildsOnlyWhenConversationChanges(t *testing.T) {
+	dir := t.TempDir()
+	path := writeConversation(t, conversation)
+
+	store := NewStore(dir)
+	first, err := store.Timeline("sess-1", path)
+	require.NoError(t, err)
+	require.Len(t, first.Turns, 2)
+
+	again, err := store.Timeline("sess-1", path)
+	require.NoError(t, err)
+	assert.Same(t, first, again, "unchanged conversations are served from cache")
+
+	// A new store picks the timeline up from disk.
+	reloaded, err := NewStore(dir).Timeline("sess-1", path)
+	require.NoError(t, err)
+	assert.Equal(t, first.Turns[0].Summary, reloaded.Turns[0].Summary)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"type":"user","timestamp":"2026-10-17T11:00:00Z","message":{"role":"user","content":"thanks"}}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	updated, err := store.Timeline("sess-1", path)
+	require.NoError(t, err)
+	require.Len(t, updated.Turns, 3)
+	assert.Equal(t, "thanks", updated.Turns[2].Prompt)
+
+	require.NoError(t, store.Delete("sess-1"))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = store.Timeline("sess-1", filepath.Join(dir, "missing.jsonl"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package digest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/linkdata/deadlock"
+	"github.com/tstapler/stapler-squad/session"
+)
+
+// formatVersion is bumped whenever digest extraction changes, so timelines
+// persisted by an older build are rebuilt rather than served stale.
+const formatVersion = 1
+
+// Timeline is the stored digest of one session's conversation.
+type Timeline struct {
+	Version int `json:"version"`
+	// SourcePath, SourceSize and SourceModTime identify the conversation file
+	// state the turns were built from.
+	SourcePath    string       `json:"source_path"`
+	SourceSize    int64        `json:"source_size"`
+	SourceModTime time.Time    `json:"source_mod_time"`
+	Turns         []TurnDigest `json:"turns"`
+}
+
+// fresh reports whether t was built from the file described by info at path.
+func (t *Timeline) fresh(path string, info os.FileInfo) bool {
+	return t.Version == formatVersion &&
+		t.SourcePath == path &&
+		t.SourceSize == info.Size() &&
+		t.SourceModTime.Equal(info.ModTime())
+}
+
+// Store keeps one timeline per session, rebuilding it only when the session's
+// conversation file has changed since it was last digested. Timelines are
+// persisted as one JSON file per session under dir; an empty dir keeps them in
+// memory only. All methods are thread-safe.
+type Store struct {
+	mu    deadlock.Mutex
+	dir   string
+	cache map[string]*Timeline
+}
+
+// NewStore creates a store persisting timelines under dir.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir, cache: make(map[string]*Timeline)}
+}
+
+// Timeline returns the digest of the conversation file at path for the session
+// identified by key, rebuilding and persisting it when the file has changed.
+func (s *Store) Timeline(key, path string) (*Timeline, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("digest: stat conversation: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t, ok := s.cache[key]; ok && t.fresh(path, info) {
+		return t, nil
+	}
+	if t, err := s.load(key); err == nil && t.fresh(path, info) {
+		s.cache[key] = t
+		return t, nil
+	}
+
+	messages, err := session.ReadConversationFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("digest: %w", err)
+	}
+	t := &Timeline{
+		Version:       formatVersion,
+		SourcePath:    path,
+		SourceSize:    info.Size(),
+		SourceModTime: info.ModTime(),
+		Turns:         Summarize(messages),
+	}
+	s.cache[key] = t
+	if err := s.save(key, t); err != nil {
+		// The timeline is still correct; it will be rebuilt after a restart.
+		return t, err
+	}
+	return t, nil
+}
+
+// Delete forgets a session's timeline.
+func (s *Store) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cache, key)
+	if s.dir == "" {
+		return nil
+	}
+	if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("digest: remove timeline: %w", err)
+	}
+	return nil
+}
+
+// path returns the file holding key's timeline. Keys are hashed because
+// session titles may contain path separators.
+func (s *Store) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:8])+".json")
+}
+
+// load reads key's persisted timeline. Caller must hold s.mu.
+func (s *Store) load(key string) (*Timeline, error) {
+	if s.dir == "" {
+		return nil, os.ErrNotExist
+	}
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return nil, err
+	}
+	var t Timeline
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("digest: unmarshal timeline: %w", err)
+	}
+	return &t, nil
+}
+
+// save writes key's timeline atomically. Caller must hold s.mu.
+func (s *Store) save(key string, t *Timeline) error {
+	if s.dir == "" {
+		return nil
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("digest: marshal timeline: %w", err)
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("digest: create dir: %w", err)
+	}
+	dst := s.path(key)
+	tmp := dst + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("digest: write timeline: %w", err)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("digest: rename timeline: %w", err)
+	}
+	return nil
+}
@@ -55,9 +55,17 @@ type DiffGenerator struct {
 	cursorRow int
 	cursorCol int
 
+	// cursorUnknown is set after writing the last column, where terminals
+	// differ on whether the cursor stays put or wraps; the next move must be
+	// absolute.
+	cursorUnknown bool
+
 	// currentStyle tracks the current SGR rendition state
 	currentStyle session.CellStyle
 
+	// cols is the width of the state being drawn
+	cols int
+
 	// output buffer for building the diff
 	output bytes.Buffer
 }
@@ -82,6 +90,7 @@ func NewDiffGenerator() *DiffGenerator {
 //  5. Position cursor at final location and set visibility
 func (g *DiffGenerator) GenerateDiff(old, new *session.TerminalState) *DiffResult {
 	g.reset()
+	g.cols = new.Cols
 
 	result := &DiffResult{
 		FromSequence: 0,
@@ -101,6 +110,10 @@ func (g *DiffGenerator) GenerateDiff(old, new *session.TerminalState) *DiffResul
 		return result
 	}
 
+	// The client's cursor is where the previous diff left it
+	g.cursorRow = old.CursorRow
+	g.cursorCol = old.CursorCol
+
 	// Compare row by row and generate minimal diff
 	for row := 0; row < new.Rows; row++ {
 		// Fast path: check if rows are identical using generation counter or pointer
@@ -118,15 +131,7 @@ func (g *DiffGenerator) GenerateDiff(old, new *session.TerminalState) *DiffResul
 
 			// Write changed cells with style transitions
 			for col := seg.StartCol; col <= seg.EndCol; col++ {
-				cell := new.Grid[row][col]
-
-				// Emit style change if needed
-				if !g.stylesEqual(cell.Style, g.currentStyle) {
-					g.emitStyleTransition(cell.Style)
-				}
-
-				// Emit character
-				g.emitChar(cell.Char)
+				g.emitCell(new.Grid[row][col])
 				result.ChangedCells++
 			}
 		}
@@ -135,7 +140,9 @@ func (g *DiffGenerator) GenerateDiff(old, new *session.TerminalState) *DiffResul
 		result.UnchangedCells += new.Cols - g.countChangedCellsInRow(old.Grid[row], new.Grid[row])
 	}
 
-	// Position cursor at final location
+	// Leave the default rendition for the next diff, then position cursor
+	// at final location
+	g.resetStyle()
 	g.moveCursorTo(new.CursorRow, new.CursorCol)
 
 	// Handle cursor visibility changes
@@ -156,11 +163,14 @@ func (g *DiffGenerator) reset() {
 	g.output.Reset()
 	g.cursorRow = 0
 	g.cursorCol = 0
+	g.cursorUnknown = false
 	g.currentStyle = session.DefaultStyle()
 }
 
 // generateFullRedraw generates ANSI sequences to draw the entire screen
 func (g *DiffGenerator) generateFullRedraw(state *session.TerminalState) {
+	g.cols = state.Cols
+
 	// Hide cursor during redraw to prevent flicker
 	g.output.WriteString("\x1b[?25l")
 
@@ -180,6 +190,7 @@ func (g *DiffGenerator) generateFullRedraw(state *session.TerminalState) {
 			g.output.WriteString("\r\n")
 			g.cursorRow = row
 			g.cursorCol = 0
+			g.cursorUnknown = false
 		}
 
 		// Find the last non-space character to avoid trailing spaces
@@ -193,18 +204,12 @@ func (g *DiffGenerator) generateFullRedraw(state *session.TerminalState) {
 
 		// Draw cells up to lastNonSpace
 		for col := 0; col <= lastNonSpace; col++ {
-			cell := state.Grid[row][col]
-
-			// Emit style change if needed
-			if !g.stylesEqual(cell.Style, g.currentStyle) {
-				g.emitStyleTransition(cell.Style)
-			}
-
-			g.emitChar(cell.Char)
+			g.emitCell(state.Grid[row][col])
 		}
 	}
 
 	// Position cursor at final location
+	g.resetStyle()
 	g.moveCursorTo(state.CursorRow, state.CursorCol)
 
 	// Show cursor if visible
@@ -228,10 +233,7 @@ func (g *DiffGenerator) rowsEqual(old, new *session.TerminalState, row int) bool
 
 	// Cell-by-cell comparison
 	for col := 0; col < len(oldRow); col++ {
-		if oldRow[col].Char != newRow[col].Char {
-			return false
-		}
-		if !g.stylesEqual(oldRow[col].Style, newRow[col].Style) {
+		if !g.cellsEqual(oldRow[col], newRow[col]) {
 			return false
 		}
 	}
@@ -250,10 +252,7 @@ func (g *DiffGenerator) findChangedSegments(oldRow, newRow []session.Cell) []Seg
 	}
 
 	for col := 0; col < cols; col++ {
-		changed := oldRow[col].Char != newRow[col].Char ||
-			!g.stylesEqual(oldRow[col].Style, newRow[col].Style)
-
-		if changed {
+		if !g.cellsEqual(oldRow[col], newRow[col]) {
 			if currentSegment == nil {
 				currentSegment = &Segment{StartCol: col}
 			}
@@ -279,7 +278,29 @@ func (g *DiffGenerator) findChangedSegments(oldRow, newRow []session.Cell) []Seg
 		segments = append(segments, *currentSegment)
 	}
 
-	return segments
+	return widenForWideChars(segments, newRow)
+}
+
+// widenForWideChars grows segments so no double-width character is split:
+// a segment starting on the second half of one must redraw the character,
+// and one ending on its first half must cover the second. Segments that
+// then touch are merged.
+func widenForWideChars(segments []Segment, row []session.Cell) []Segment {
+	merged := segments[:0]
+	for _, seg := range segments {
+		if seg.StartCol > 0 && row[seg.StartCol].Spacer {
+			seg.StartCol--
+		}
+		if seg.EndCol+1 < len(row) && row[seg.EndCol+1].Spacer {
+			seg.EndCol++
+		}
+		if n := len(merged); n > 0 && seg.StartCol <= merged[n-1].EndCol+1 {
+			merged[n-1].EndCol = max(merged[n-1].EndCol, seg.EndCol)
+			continue
+		}
+		merged = append(merged, seg)
+	}
+	return merged
 }
 
 // countChangedCellsInRow counts the number of changed cells between two rows
@@ -291,8 +312,7 @@ func (g *DiffGenerator) countChangedCellsInRow(oldRow, newRow []session.Cell) in
 	}
 
 	for col := 0; col < cols; col++ {
-		if oldRow[col].Char != newRow[col].Char ||
-			!g.stylesEqual(oldRow[col].Style, newRow[col].Style) {
+		if !g.cellsEqual(oldRow[col], newRow[col]) {
 			count++
 		}
 	}
@@ -307,6 +327,13 @@ func (g *DiffGenerator) countChangedCellsInRow(oldRow, newRow []session.Cell) in
 
 // moveCursorTo emits the most efficient cursor movement sequence
 func (g *DiffGenerator) moveCursorTo(row, col int) {
+	if g.cursorUnknown {
+		fmt.Fprintf(&g.output, "\x1b[%d;%dH", row+1, col+1)
+		g.cursorRow = row
+		g.cursorCol = col
+		g.cursorUnknown = false
+		return
+	}
 	if g.cursorRow == row && g.cursorCol == col {
 		return // Already at target position
 	}
@@ -386,46 +413,36 @@ func (g *DiffGenerator) emitStyleTransition(target session.CellStyle) {
 	// Build list of SGR codes needed
 	var codes []string
 
-	// Check if we need to reset first
-	needsReset := g.currentStyle.Bold && !target.Bold
-	if g.currentStyle.Italic && !target.Italic {
-		needsReset = true
+	cur := g.currentStyle
+	attrs := []struct {
+		code      string
+		from, set bool
+	}{
+		{"1", cur.Bold, target.Bold},
+		{"2", cur.Dim, target.Dim},
+		{"3", cur.Italic, target.Italic},
+		{"4", cur.Underline, target.Underline},
+		{"7", cur.Reverse, target.Reverse},
+		{"8", cur.Hidden, target.Hidden},
+		{"9", cur.Strikethrough, target.Strikethrough},
 	}
-	if g.currentStyle.Underline && !target.Underline {
-		needsReset = true
-	}
-	if g.currentStyle.Reverse && !target.Reverse {
-		needsReset = true
+
+	// Check if we need to reset first
+	needsReset := false
+	for _, a := range attrs {
+		if a.from && !a.set {
+			needsReset = true
+		}
 	}
 
 	if needsReset {
 		codes = append(codes, "0")
-		// After reset, need to re-apply all target attributes
-		if target.Bold {
-			codes = append(codes, "1")
-		}
-		if target.Italic {
-			codes = append(codes, "3")
-		}
-		if target.Underline {
-			codes = append(codes, "4")
-		}
-		if target.Reverse {
-			codes = append(codes, "7")
-		}
-	} else {
-		// Just add what's new
-		if target.Bold && !g.currentStyle.Bold {
-			codes = append(codes, "1")
-		}
-		if target.Italic && !g.currentStyle.Italic {
-			codes = append(codes, "3")
-		}
-		if target.Underline && !g.currentStyle.Underline {
-			codes = append(codes, "4")
-		}
-		if target.Reverse && !g.currentStyle.Reverse {
-			codes = append(codes, "7")
+	}
+	// After a reset all target attributes are re-applied; otherwise just
+	// add what's new
+	for _, a := range attrs {
+		if a.set && (needsReset || !a.from) {
+			codes = append(codes, a.code)
 		}
 	}
 
@@ -454,6 +471,14 @@ func (g *DiffGenerator) emitStyleTransition(target session.CellStyle) {
 	g.currentStyle = target
 }
 
+// resetStyle returns the output to the default rendition if needed
+func (g *DiffGenerator) resetStyle() {
+	if !g.isDefaultStyle(g.currentStyle) {
+		g.output.WriteString("\x1b[0m")
+		g.currentStyle = session.DefaultStyle()
+	}
+}
+
 // colorToSGR converts a color string to SGR code
 func (g *DiffGenerator) colorToSGR(color string, foreground bool) string {
 	if color == "" {
@@ -517,33 +542,54 @@ func (g *DiffGenerator) colorToSGR(color string, foreground bool) string {
 	return ""
 }
 
+// emitCell writes a cell with any style change it needs. The second half
+// of a double-width character was drawn with the first, so only the cursor
+// advances.
+func (g *DiffGenerator) emitCell(cell session.Cell) {
+	if cell.Spacer {
+		g.advanceCursor()
+		return
+	}
+
+	// Emit style change if needed
+	if !g.stylesEqual(cell.Style, g.currentStyle) {
+		g.emitStyleTransition(cell.Style)
+	}
+
+	g.emitChar(cell.Char)
+}
+
 // emitChar writes a character and updates cursor position
 func (g *DiffGenerator) emitChar(ch rune) {
 	if ch == 0 {
 		ch = ' ' // Treat null as space
 	}
 	g.output.WriteRune(ch)
+	g.advanceCursor()
+}
+
+// advanceCursor moves the tracked cursor one column right.
+func (g *DiffGenerator) advanceCursor() {
 	g.cursorCol++
+	if g.cols > 0 && g.cursorCol >= g.cols {
+		g.cursorCol = g.cols - 1
+		g.cursorUnknown = true
+	}
+}
+
+// cellsEqual compares two cells' content and style
+func (g *DiffGenerator) cellsEqual(a, b session.Cell) bool {
+	return a.Char == b.Char && a.Spacer == b.Spacer && g.stylesEqual(a.Style, b.Style)
 }
 
 // stylesEqual compares two cell styles for equality
 func (g *DiffGenerator) stylesEqual(a, b session.CellStyle) bool {
-	return a.FgColor == b.FgColor &&
-		a.BgColor == b.BgColor &&
-		a.Bold == b.Bold &&
-		a.Italic == b.Italic &&
-		a.Underline == b.Underline &&
-		a.Reverse == b.Reverse
+	return a == b
 }
 
 // isDefaultStyle checks if a style is the default (no formatting)
 func (g *DiffGenerator) isDefaultStyle(s session.CellStyle) bool {
-	return s.FgColor == "" &&
-		s.BgColor == "" &&
-		!s.Bold &&
-		!s.Italic &&
-		!s.Underline &&
-		!s.Reverse
+	return s == session.DefaultStyle()
 }
 
 // abs returns the absolute value of an integer
@@ -531,3 +531,49 @@ func makeRow(s string) []session.Cell {
 	}
 	return row
 }
+
+// TestDiffGenerator_WideCharacters verifies a changed double-width character
+// is redrawn whole and the cursor tracking stays aligned after it
+func TestDiffGenerator_WideCharacters(t *testing.T) {
+	g := NewDiffGenerator()
+
+	oldState := session.NewTerminalState(2, 10)
+	oldState.ProcessOutput([]byte("a漢b"))
+
+	newState := oldState.Clone()
+	newState.ProcessOutput([]byte("\x1b[1;2H字c"))
+
+	result := g.GenerateDiff(oldState, newState)
+	diffStr := string(result.DiffBytes)
+
+	if !strings.Contains(diffStr, "字c") {
+		t.Errorf("Diff should redraw the wide character and the cell after it, got %q", diffStr)
+	}
+
+	// Applying the diff to the old screen must reproduce the new one
+	replayed := oldState.Clone()
+	replayed.ProcessOutput(result.DiffBytes)
+	if got, want := replayed.ScreenLines(false)[0], newState.ScreenLines(false)[0]; got != want {
+		t.Errorf("Replayed row = %q, want %q", got, want)
+	}
+}
+
+// TestDiffGenerator_LastColumn verifies cursor positioning after a write to
+// the last column, where the terminal defers the wrap
+func TestDiffGenerator_LastColumn(t *testing.T) {
+	g := NewDiffGenerator()
+
+	oldState := session.NewTerminalState(3, 5)
+	newState := oldState.Clone()
+	newState.ProcessOutput([]byte("\x1b[1;5HX\x1b[2;5HY"))
+
+	result := g.GenerateDiff(oldState, newState)
+
+	replayed := oldState.Clone()
+	replayed.ProcessOutput(result.DiffBytes)
+	for row := 0; row < 3; row++ {
+		if got, want := replayed.ScreenLines(false)[row], newState.ScreenLines(false)[row]; got != want {
+			t.Errorf("Row %d: replayed %q, want %q", row, got, want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/executor/safeexec"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+)
+
+// ErrSecretsStaged is returned when a commit or push is refused because the
+// staged changes contain secrets.
+var ErrSecretsStaged = errors.New("staged changes contain secrets")
+
+// ErrSecretsUnpushed is returned when a push is refused because a commit it
+// would publish contains secrets.
+var ErrSecretsUnpushed = errors.New("commits to be pushed contain secrets")
+
+// NewSecretDetector builds a secret detector from the secret_scan config and
+// the .secretsignore file in repoDir (if any). An invalid allowlist pattern or
+// ignore file is logged and skipped rather than disabling detection.
+func NewSecretDetector(cfg config.SecretScanConfig, repoDir string) *secrets.Detector {
+	d, err := secrets.NewDetector(secrets.Options{Allowlist: cfg.Allowlist, DisabledRules: cfg.DisabledRules})
+	if err != nil {
+		log.Warn("secret_scan config is invalid; using the built-in rules", "err", err)
+		d = secrets.NewDefaultDetector()
+	}
+	if repoDir == "" {
+		return d
+	}
+	ig, err := secrets.LoadIgnore(repoDir)
+	if err != nil {
+		log.Warn("ignoring unreadable secrets ignore file", "repo", repoDir, "err", err)
+		return d
+	}
+	return d.WithIgnore(ig)
+}
+
+// CheckStagedSecrets scans the lines added by the staged changes in
+// worktreePath. It returns an error wrapping ErrSecretsStaged that lists each
+// finding's rule, file, line and fingerprint (never the value) when any are
+// found.
+func CheckStagedSecrets(ctx context.Context, worktreePath string, d *secrets.Detector) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	cmd := safeexec.CommandContext(ctx, "git", "-C", worktreePath, "diff", "--cached", "--no-color", "--no-ext-diff", "-U0")
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to read staged changes: %w", err)
+	}
+	findings := d.ScanDiff(string(out))
+	if len(findings) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w:%s\nRemove them, or add the fingerprint to %s if this is a false positive",
+		ErrSecretsStaged, secrets.Summarize(findings, 10), secrets.IgnoreFileName)
+}
+
+// CheckUnpushedSecrets scans the lines added by each commit a push of HEAD
+// would publish: the commits after the upstream branch or, when there is
+// none yet, after base. With neither, every commit reachable from HEAD is
+// scanned. Commits are scanned one by one, so a secret added and removed
+// again before the push is still found. Errors wrap ErrSecretsUnpushed and
+// list findings like CheckStagedSecrets.
+func CheckUnpushedSecrets(ctx context.Context, worktreePath, base string, d *secrets.Detector) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	revs := "HEAD"
+	upstream := safeexec.CommandContext(ctx, "git", "-C", worktreePath, "rev-parse", "--verify", "--quiet", "@{upstream}")
+	if err := upstream.Run(); err == nil {
+		revs = "@{upstream}..HEAD"
+	} else if base != "" {
+		revs = base + "..HEAD"
+	}
+	cmd := safeexec.CommandContext(ctx, "git", "-C", worktreePath, "log", "-p", "--format=", "--no-color", "--no-ext-diff", "-U0", revs)
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to read commits to push (%s): %w", revs, err)
+	}
+	findings := d.ScanDiff(string(out))
+	if len(findings) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w:%s\nRewrite those commits without them, or add the fingerprint to %s if this is a false positive",
+		ErrSecretsUnpushed, secrets.Summarize(findings, 10), secrets.IgnoreFileName)
+}
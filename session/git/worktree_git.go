@@ -3,6 +3,7 @@ package git
 import (
 	"context"
 	"fmt"
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/executor/safeexec"
 	"github.com/tstapler/stapler-squad/log"
 	"os/exec"
@@ -44,6 +45,10 @@ func (g *GitWorktree) PushChanges(commitMessage string, open bool) error {
 		return fmt.Errorf("failed to check for changes: %w", err)
 	}
 
+	// Refuse to publish secrets. The commit skips hooks (--no-verify), so
+	// these checks are all that stands between the agent's output and the
+	// remote.
+	detector := NewSecretDetector(config.LoadConfig().SecretScan, g.worktreePath)
 	if isDirty {
 		// Stage all changes
 		if _, err := g.runGitCommand(g.worktreePath, "add", "."); err != nil {
@@ -51,6 +56,10 @@ func (g *GitWorktree) PushChanges(commitMessage string, open bool) error {
 			return fmt.Errorf("failed to stage changes: %w", err)
 		}
 
+		if err := CheckStagedSecrets(context.Background(), g.worktreePath, detector); err != nil {
+			return err
+		}
+
 		// Create commit
 		if _, err := g.runGitCommand(g.worktreePath, "commit", "-m", commitMessage, "--no-verify"); err != nil {
 			log.Error("failed to commit changes", "err", err)
@@ -59,6 +68,16 @@ func (g *GitWorktree) PushChanges(commitMessage string, open bool) error {
 		g.InvalidateDirtyCache()
 	}
 
+	// The agent may have committed on its own; scan every commit the push
+	// would publish, not just the one made above.
+	base := g.GetBaseCommitSHA()
+	if base == "" {
+		base = g.resolveBaseCommitSHA()
+	}
+	if err := CheckUnpushedSecrets(context.Background(), g.worktreePath, base, detector); err != nil {
+		return err
+	}
+
 	// First push the branch to remote to ensure it exists
 	pushCtx, pushCancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer pushCancel()
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -14,7 +15,8 @@ import (
 	"time"
 )
 
-// ClaudeHistoryEntry represents a single entry from Claude's history.jsonl file
+// ClaudeHistoryEntry represents a single conversation from an agent CLI's history.
+// Despite the name it is produced by every HistoryProvider; Provider records which.
 type ClaudeHistoryEntry struct {
 	// ID is the unique identifier for this conversation
 	ID string `json:"id"`
@@ -26,16 +28,21 @@ type ClaudeHistoryEntry struct {
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt is when the conversation was last updated
 	UpdatedAt time.Time `json:"updated_at"`
-	// Model is the Claude model used (e.g., "claude-sonnet-4")
+	// Model is the model used (e.g., "claude-sonnet-4", "gpt-5-codex")
 	Model string `json:"model"`
 	// MessageCount is the number of messages in the conversation
 	MessageCount int `json:"message_count"`
+	// Provider names the agent CLI that wrote the conversation ("claude", "codex", "gemini")
+	Provider string `json:"provider"`
+	// Path is the conversation log file, when the provider knows it at load time
+	Path string `json:"path,omitempty"`
 }
 
-// ClaudeSessionHistory manages access to Claude session history
-type ClaudeSessionHistory struct {
-	// historyPath is the path to history.jsonl
-	historyPath string
+// SessionHistory aggregates conversation history from one or more HistoryProviders
+// into a single list sorted by UpdatedAt descending.
+type SessionHistory struct {
+	// providers are consulted in order on every Reload
+	providers []HistoryProvider
 	// entries caches all parsed history entries
 	entries []ClaudeHistoryEntry
 	// projectIndex maps project paths to their entries for fast lookup
@@ -46,36 +53,49 @@ type ClaudeSessionHistory struct {
 	lastLoad time.Time
 }
 
-// NewClaudeSessionHistory creates a new ClaudeSessionHistory instance
-func NewClaudeSessionHistory(historyPath string) (*ClaudeSessionHistory, error) {
-	sh := &ClaudeSessionHistory{
-		historyPath:  historyPath,
+// NewSessionHistory creates a SessionHistory over the given providers and loads it.
+func NewSessionHistory(providers ...HistoryProvider) (*SessionHistory, error) {
+	sh := &SessionHistory{
+		providers:    providers,
 		entries:      make([]ClaudeHistoryEntry, 0),
 		projectIndex: make(map[string][]int),
 	}
 
-	// Load initial data
 	if err := sh.Reload(); err != nil {
-		// If file doesn't exist, that's okay - we'll start with empty history
-		if !os.IsNotExist(err) {
-			return nil, fmt.Errorf("failed to load history: %w", err)
-		}
+		return nil, fmt.Errorf("failed to load history: %w", err)
 	}
 
 	return sh, nil
 }
 
-// NewClaudeSessionHistoryFromClaudeDir creates a ClaudeSessionHistory from ~/.claude directory
-func NewClaudeSessionHistoryFromClaudeDir() (*ClaudeSessionHistory, error) {
-	home, err := os.UserHomeDir()
+// NewClaudeSessionHistory creates a Claude-only SessionHistory reading the given
+// history.jsonl. Conversation files are looked up under ~/.claude/projects.
+func NewClaudeSessionHistory(historyPath string) (*SessionHistory, error) {
+	return NewSessionHistory(&ClaudeHistoryProvider{HistoryPath: historyPath})
+}
+
+// NewSessionHistoryFromHome creates a SessionHistory over every supported agent
+// CLI's default log location (see DefaultHistoryProviders).
+func NewSessionHistoryFromHome() (*SessionHistory, error) {
+	providers, err := DefaultHistoryProviders()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		return nil, err
 	}
+	return NewSessionHistory(providers...)
+}
 
-	historyPath := filepath.Join(home, ".claude", "history.jsonl")
-	return NewClaudeSessionHistory(historyPath)
+// ClaudeHistoryProvider reads Claude Code's ~/.claude/history.jsonl index and the
+// per-conversation JSONL files under ~/.claude/projects.
+type ClaudeHistoryProvider struct {
+	// HistoryPath is the path to history.jsonl
+	HistoryPath string
+	// ProjectsDir holds the per-conversation files; empty means ~/.claude/projects
+	ProjectsDir string
 }
 
+// Name implements HistoryProvider.
+func (p *ClaudeHistoryProvider) Name() string { return HistoryProviderClaude }
+
 // conversationMessage represents a single message in a Claude conversation file (per-session JSONL).
 type conversationMessage struct {
 	Type      string `json:"type"`
@@ -99,23 +119,59 @@ type historyJSONLEntry struct {
 	SessionID string `json:"sessionId"` // conversation UUID
 }
 
-// Reload loads history from ~/.claude/history.jsonl, which Claude maintains as a
-// compact index of all conversations. Each line is one user message; we aggregate
-// by sessionId to reconstruct per-session metadata (name, timestamps, message count).
-func (sh *ClaudeSessionHistory) Reload() error {
+// Reload reloads every provider and rebuilds the merged, sorted entry list.
+// A failing provider does not hide the others' entries; its error is returned
+// alongside them.
+func (sh *SessionHistory) Reload() error {
+	var (
+		entries []ClaudeHistoryEntry
+		errs    []error
+	)
+	for _, p := range sh.providers {
+		if hinter, ok := p.(projectHinter); ok {
+			hinter.hintProjects(entries)
+		}
+		loaded, err := p.Load()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s history: %w", p.Name(), err))
+			continue
+		}
+		entries = append(entries, loaded...)
+	}
+
+	// Sort before indexing so projectIndex holds post-sort positions.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].UpdatedAt.After(entries[j].UpdatedAt)
+	})
+
+	projectIndex := make(map[string][]int)
+	for idx, entry := range entries {
+		if entry.Project != "" {
+			projectIndex[entry.Project] = append(projectIndex[entry.Project], idx)
+		}
+	}
+
 	sh.mu.Lock()
 	defer sh.mu.Unlock()
+	if entries == nil {
+		entries = make([]ClaudeHistoryEntry, 0)
+	}
+	sh.entries = entries
+	sh.projectIndex = projectIndex
+	sh.lastLoad = time.Now()
+	return errors.Join(errs...)
+}
 
-	sh.entries = make([]ClaudeHistoryEntry, 0)
-	sh.projectIndex = make(map[string][]int)
-
-	histFile, err := os.Open(sh.historyPath)
+// Load reads ~/.claude/history.jsonl, which Claude maintains as a compact index of
+// all conversations. Each line is one user message; we aggregate by sessionId to
+// reconstruct per-session metadata (name, timestamps, message count).
+func (p *ClaudeHistoryProvider) Load() ([]ClaudeHistoryEntry, error) {
+	histFile, err := os.Open(p.HistoryPath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			sh.lastLoad = time.Now()
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("failed to open history file: %w", err)
+		return nil, fmt.Errorf("failed to open history file: %w", err)
 	}
 	defer histFile.Close()
 
@@ -174,42 +230,36 @@ func (sh *ClaudeSessionHistory) Reload() error {
 	}
 
 	if err := scanner.Err(); err != nil {
-		return fmt.Errorf("error reading history file: %w", err)
+		return nil, fmt.Errorf("error reading history file: %w", err)
 	}
 
+	entries := make([]ClaudeHistoryEntry, 0, len(sessionOrder))
 	for _, sessionID := range sessionOrder {
 		agg := sessionMap[sessionID]
-		name := cleanDisplayName(agg.firstDisplay)
-		if name == "" {
-			name = filepath.Base(agg.project)
-		}
-		if name == "" || name == "." {
-			name = "Unknown"
-		}
-
-		sh.entries = append(sh.entries, ClaudeHistoryEntry{
+		entries = append(entries, ClaudeHistoryEntry{
 			ID:           sessionID,
-			Name:         name,
+			Name:         historyEntryName(agg.firstDisplay, agg.project),
 			Project:      agg.project,
 			CreatedAt:    time.UnixMilli(agg.firstTs),
 			UpdatedAt:    time.UnixMilli(agg.lastTs),
 			MessageCount: agg.msgCount,
+			Provider:     HistoryProviderClaude,
 		})
 	}
+	return entries, nil
+}
 
-	// Sort before indexing so projectIndex holds post-sort positions.
-	sort.Slice(sh.entries, func(i, j int) bool {
-		return sh.entries[i].UpdatedAt.After(sh.entries[j].UpdatedAt)
-	})
-
-	for idx, entry := range sh.entries {
-		if entry.Project != "" {
-			sh.projectIndex[entry.Project] = append(sh.projectIndex[entry.Project], idx)
-		}
+// historyEntryName picks a display name for a conversation: its first user
+// message, else the project directory's base name, else "Unknown".
+func historyEntryName(firstMessage, project string) string {
+	name := cleanDisplayName(firstMessage)
+	if name == "" && project != "" {
+		name = filepath.Base(project)
 	}
-
-	sh.lastLoad = time.Now()
-	return nil
+	if name == "" || name == "." {
+		name = "Unknown"
+	}
+	return name
 }
 
 // cleanDisplayName truncates a raw user message for use as a session name.
@@ -224,7 +274,7 @@ func cleanDisplayName(display string) string {
 }
 
 // GetAll returns all history entries, sorted by UpdatedAt descending
-func (sh *ClaudeSessionHistory) GetAll() []ClaudeHistoryEntry {
+func (sh *SessionHistory) GetAll() []ClaudeHistoryEntry {
 	sh.mu.RLock()
 	defer sh.mu.RUnlock()
 
@@ -235,7 +285,7 @@ func (sh *ClaudeSessionHistory) GetAll() []ClaudeHistoryEntry {
 }
 
 // GetByProject returns all history entries for a specific project path
-func (sh *ClaudeSessionHistory) GetByProject(projectPath string) []ClaudeHistoryEntry {
+func (sh *SessionHistory) GetByProject(projectPath string) []ClaudeHistoryEntry {
 	sh.mu.RLock()
 	defer sh.mu.RUnlock()
 
@@ -258,7 +308,7 @@ func (sh *ClaudeSessionHistory) GetByProject(projectPath string) []ClaudeHistory
 }
 
 // GetByID returns a specific history entry by ID
-func (sh *ClaudeSessionHistory) GetByID(id string) (*ClaudeHistoryEntry, error) {
+func (sh *SessionHistory) GetByID(id string) (*ClaudeHistoryEntry, error) {
 	sh.mu.RLock()
 	defer sh.mu.RUnlock()
 
@@ -274,7 +324,7 @@ func (sh *ClaudeSessionHistory) GetByID(id string) (*ClaudeHistoryEntry, error)
 }
 
 // Search searches history entries by name or project path
-func (sh *ClaudeSessionHistory) Search(query string) []ClaudeHistoryEntry {
+func (sh *SessionHistory) Search(query string) []ClaudeHistoryEntry {
 	sh.mu.RLock()
 	defer sh.mu.RUnlock()
 
@@ -293,7 +343,7 @@ func (sh *ClaudeSessionHistory) Search(query string) []ClaudeHistoryEntry {
 }
 
 // GetProjects returns a list of unique project paths from history
-func (sh *ClaudeSessionHistory) GetProjects() []string {
+func (sh *SessionHistory) GetProjects() []string {
 	sh.mu.RLock()
 	defer sh.mu.RUnlock()
 
@@ -307,7 +357,7 @@ func (sh *ClaudeSessionHistory) GetProjects() []string {
 }
 
 // Count returns the total number of history entries
-func (sh *ClaudeSessionHistory) Count() int {
+func (sh *SessionHistory) Count() int {
 	sh.mu.RLock()
 	defer sh.mu.RUnlock()
 
@@ -315,7 +365,7 @@ func (sh *ClaudeSessionHistory) Count() int {
 }
 
 // LastLoadTime returns when the history was last loaded from disk
-func (sh *ClaudeSessionHistory) LastLoadTime() time.Time {
+func (sh *SessionHistory) LastLoadTime() time.Time {
 	sh.mu.RLock()
 	defer sh.mu.RUnlock()
 
@@ -328,21 +378,59 @@ type ClaudeConversationMessage struct {
 	Content   string
 	Timestamp time.Time
 	Model     string
+	// ToolUses and ToolResults carry the tool_use blocks of an assistant message
+	// and the tool_result blocks of a user message. Only Claude conversations
+	// record them; Content holds the text blocks alone.
+	ToolUses    []ConversationToolUse
+	ToolResults []ConversationToolResult
+}
+
+// ConversationToolUse is a tool call made by the assistant.
+type ConversationToolUse struct {
+	ID    string
+	Name  string
+	Input json.RawMessage
 }
 
+// ConversationToolResult is the outcome of a tool call, reported back in the
+// following user message.
+type ConversationToolResult struct {
+	ToolUseID string
+	IsError   bool
+	// Content is the result text, truncated to maxToolResultContent bytes.
+	Content string
+}
+
+// maxToolResultContent bounds how much of each tool result is kept in memory;
+// results such as file reads can be megabytes long.
+const maxToolResultContent = 2048
+
 // findConversationFilePath searches ~/.claude/projects/ for the JSONL file that
-// contains the given sessionID. It checks the first 5 lines of each file for a
-// reference to the sessionID, then stops the walk as soon as it finds a match.
+// contains the given sessionID.
 func findConversationFilePath(sessionID string) (string, error) {
+	projectsDir, err := claudeProjectsDir()
+	if err != nil {
+		return "", err
+	}
+	return findConversationFilePathIn(projectsDir, sessionID)
+}
+
+// claudeProjectsDir returns ~/.claude/projects.
+func claudeProjectsDir() (string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get user home directory: %w", err)
 	}
+	return filepath.Join(home, ".claude", "projects"), nil
+}
 
-	projectsDir := filepath.Join(home, ".claude", "projects")
+// findConversationFilePathIn searches projectsDir for the JSONL file that
+// contains the given sessionID. It checks the first 5 lines of each file for a
+// reference to the sessionID, then stops the walk as soon as it finds a match.
+func findConversationFilePathIn(projectsDir, sessionID string) (string, error) {
 	var conversationFile string
 
-	err = filepath.Walk(projectsDir, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(projectsDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return nil // skip unreadable entries
 		}
@@ -385,17 +473,36 @@ func extractMsgContent(raw conversationMessage) (ClaudeConversationMessage, bool
 	}
 
 	var content string
+	var toolUses []ConversationToolUse
+	var toolResults []ConversationToolResult
 	switch v := raw.Message.Content.(type) {
 	case string:
 		content = v
 	case []interface{}:
 		for _, item := range v {
-			if itemMap, ok := item.(map[string]interface{}); ok {
-				if itemType, ok := itemMap["type"].(string); ok && itemType == "text" {
-					if text, ok := itemMap["text"].(string); ok {
-						content += text + "\n"
-					}
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch itemMap["type"] {
+			case "text":
+				if text, ok := itemMap["text"].(string); ok {
+					content += text + "\n"
+				}
+			case "tool_use":
+				use := ConversationToolUse{}
+				use.ID, _ = itemMap["id"].(string)
+				use.Name, _ = itemMap["name"].(string)
+				if input, ok := itemMap["input"]; ok {
+					use.Input, _ = json.Marshal(input)
 				}
+				toolUses = append(toolUses, use)
+			case "tool_result":
+				result := ConversationToolResult{}
+				result.ToolUseID, _ = itemMap["tool_use_id"].(string)
+				result.IsError, _ = itemMap["is_error"].(bool)
+				result.Content = toolResultText(itemMap["content"])
+				toolResults = append(toolResults, result)
 			}
 		}
 	default:
@@ -405,13 +512,45 @@ func extractMsgContent(raw conversationMessage) (ClaudeConversationMessage, bool
 
 	ts, _ := time.Parse(time.RFC3339, raw.Timestamp)
 	return ClaudeConversationMessage{
-		Role:      raw.Message.Role,
-		Content:   content,
-		Timestamp: ts,
-		Model:     raw.Message.Model,
+		Role:        raw.Message.Role,
+		Content:     content,
+		Timestamp:   ts,
+		Model:       raw.Message.Model,
+		ToolUses:    toolUses,
+		ToolResults: toolResults,
 	}, true
 }
 
+// toolResultText flattens a tool_result content value, which is either a string
+// or a list of text blocks, and truncates it to maxToolResultContent bytes.
+func toolResultText(v interface{}) string {
+	var text string
+	switch c := v.(type) {
+	case string:
+		text = c
+	case []interface{}:
+		var parts []string
+		for _, item := range c {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				if t, ok := itemMap["text"].(string); ok {
+					parts = append(parts, t)
+				}
+			}
+		}
+		text = strings.Join(parts, "\n")
+	}
+	if len(text) > maxToolResultContent {
+		text = text[:maxToolResultContent]
+	}
+	return text
+}
+
+// ReadConversationFile reads every user/assistant message, including tool calls
+// and their results, from the Claude conversation JSONL file at path, oldest first.
+func ReadConversationFile(path string) ([]ClaudeConversationMessage, error) {
+	return readAllMessagesFromFile(path)
+}
+
 // readAllMessagesFromFile reads every user/assistant message from the JSONL file
 // at path in chronological order (oldest first).
 func readAllMessagesFromFile(path string) ([]ClaudeConversationMessage, error) {
@@ -543,14 +682,43 @@ func isEOFErr(err error) bool {
 	return err == io.EOF || err == io.ErrUnexpectedEOF
 }
 
-// GetMessagesFromConversationFile reads messages from the conversation file for
-// the given sessionID. When limit > 0 only the last limit messages are returned
-// (using an efficient reverse-read that avoids loading the full file). When
-// limit == 0 all messages are returned.
+// GetMessagesFromConversationFile reads messages from the conversation with the
+// given ID, delegating to the provider that recorded it. When limit > 0 only the
+// last limit messages are returned; when limit == 0 all messages are returned.
 //
 // Results are always in chronological order (oldest first).
-func (sh *ClaudeSessionHistory) GetMessagesFromConversationFile(sessionID string, limit int) ([]ClaudeConversationMessage, error) {
-	conversationFile, err := findConversationFilePath(sessionID)
+func (sh *SessionHistory) GetMessagesFromConversationFile(sessionID string, limit int) ([]ClaudeConversationMessage, error) {
+	entry := ClaudeHistoryEntry{ID: sessionID}
+	if found, err := sh.GetByID(sessionID); err == nil {
+		entry = *found
+	}
+	return sh.GetMessages(entry, limit)
+}
+
+// GetMessages reads messages for an entry already obtained from this history,
+// skipping the ID lookup GetMessagesFromConversationFile performs.
+func (sh *SessionHistory) GetMessages(entry ClaudeHistoryEntry, limit int) ([]ClaudeConversationMessage, error) {
+	provider := sh.provider(entry.Provider)
+	if provider == nil {
+		return nil, fmt.Errorf("no %s history provider for conversation %s", entry.Provider, entry.ID)
+	}
+	return provider.Messages(entry, limit)
+}
+
+// provider returns the provider with the given name. An empty name selects the
+// first provider, which is how entries predating Provider were resolved.
+func (sh *SessionHistory) provider(name string) HistoryProvider {
+	for _, p := range sh.providers {
+		if name == "" || p.Name() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Messages implements HistoryProvider.
+func (p *ClaudeHistoryProvider) Messages(entry ClaudeHistoryEntry, limit int) ([]ClaudeConversationMessage, error) {
+	conversationFile, err := p.conversationPath(entry)
 	if err != nil {
 		return nil, err
 	}
@@ -560,3 +728,29 @@ func (sh *ClaudeSessionHistory) GetMessagesFromConversationFile(sessionID string
 	}
 	return readAllMessagesFromFile(conversationFile)
 }
+
+// Fork implements HistoryProvider by copying the whole conversation next to the
+// original under a fresh UUID (see ForkClaudeConversation).
+func (p *ClaudeHistoryProvider) Fork(entry ClaudeHistoryEntry) (string, error) {
+	conversationFile, err := p.conversationPath(entry)
+	if err != nil {
+		return "", err
+	}
+	return ForkClaudeConversation(conversationFile, ^uint64(0), filepath.Dir(conversationFile))
+}
+
+// conversationPath locates the per-conversation JSONL file for entry.
+func (p *ClaudeHistoryProvider) conversationPath(entry ClaudeHistoryEntry) (string, error) {
+	if entry.Path != "" {
+		return entry.Path, nil
+	}
+	projectsDir := p.ProjectsDir
+	if projectsDir == "" {
+		dir, err := claudeProjectsDir()
+		if err != nil {
+			return "", err
+		}
+		projectsDir = dir
+	}
+	return findConversationFilePathIn(projectsDir, entry.ID)
+}
@@ -0,0 +1,444 @@
+package session
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CodexHistoryProvider reads the Codex CLI's rollout files, one JSONL file per
+// conversation under ~/.codex/sessions/YYYY/MM/DD/rollout-<time>-<uuid>.jsonl.
+//
+// Two layouts exist in the wild: current rollouts wrap every line in a
+// {"timestamp","type","payload"} envelope, older ones start with a bare session
+// header followed by bare response items. Both are understood.
+type CodexHistoryProvider struct {
+	sessionsDir string
+
+	// cache keeps parsed entries keyed by file path so a reload only re-reads
+	// rollouts whose size or modification time changed.
+	mu    sync.Mutex
+	cache map[string]cachedHistoryEntry
+}
+
+// cachedHistoryEntry is a parsed entry together with the file stat it came from.
+type cachedHistoryEntry struct {
+	modTime time.Time
+	size    int64
+	entry   ClaudeHistoryEntry
+	ok      bool
+}
+
+// NewCodexHistoryProvider creates a provider reading rollouts under sessionsDir.
+func NewCodexHistoryProvider(sessionsDir string) *CodexHistoryProvider {
+	return &CodexHistoryProvider{
+		sessionsDir: sessionsDir,
+		cache:       make(map[string]cachedHistoryEntry),
+	}
+}
+
+// Name implements HistoryProvider.
+func (p *CodexHistoryProvider) Name() string { return HistoryProviderCodex }
+
+// codexRolloutName matches rollout file names and captures the conversation UUID.
+var codexRolloutName = regexp.MustCompile(`^rollout-.*-([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})\.jsonl$`)
+
+// codexEnvelope is one line of a current-format rollout.
+type codexEnvelope struct {
+	Timestamp string          `json:"timestamp"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// codexItem is the union of the payload fields we read: session_meta (id, cwd,
+// timestamp), turn_context (cwd, model) and response_item messages (role, content).
+type codexItem struct {
+	Type      string         `json:"type"`
+	ID        string         `json:"id"`
+	Timestamp string         `json:"timestamp"`
+	Cwd       string         `json:"cwd"`
+	Model     string         `json:"model"`
+	Role      string         `json:"role"`
+	Content   []codexContent `json:"content"`
+}
+
+// codexContent is one content block of a Codex message.
+type codexContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// codexLine is a decoded rollout line with the envelope resolved.
+type codexLine struct {
+	kind      string // session_meta, turn_context, response_item, ...
+	timestamp time.Time
+	item      codexItem
+}
+
+// parseCodexLine decodes a rollout line in either layout. The first line of a
+// legacy rollout is its session header; first tells the parser which line that is.
+func parseCodexLine(line []byte, first bool) (codexLine, bool) {
+	var env codexEnvelope
+	if err := json.Unmarshal(line, &env); err != nil {
+		return codexLine{}, false
+	}
+	var out codexLine
+	if len(env.Payload) > 0 && env.Type != "" {
+		if err := json.Unmarshal(env.Payload, &out.item); err != nil {
+			return codexLine{}, false
+		}
+		out.kind = env.Type
+		out.timestamp = parseHistoryTime(env.Timestamp)
+		return out, true
+	}
+
+	// Legacy layout: the line is the item itself.
+	if err := json.Unmarshal(line, &out.item); err != nil {
+		return codexLine{}, false
+	}
+	switch {
+	case first && out.item.ID != "" && out.item.Type == "":
+		out.kind = "session_meta"
+	case out.item.Type == "message":
+		out.kind = "response_item"
+	default:
+		out.kind = out.item.Type
+	}
+	out.timestamp = parseHistoryTime(out.item.Timestamp)
+	return out, true
+}
+
+// message converts a response_item message into a conversation turn. Codex
+// injects environment and instruction blocks as user messages; those are not
+// part of the conversation and are dropped.
+func (l codexLine) message() (ClaudeConversationMessage, bool) {
+	if l.kind != "response_item" || l.item.Type != "message" {
+		return ClaudeConversationMessage{}, false
+	}
+	if l.item.Role != "user" && l.item.Role != "assistant" {
+		return ClaudeConversationMessage{}, false
+	}
+	var text strings.Builder
+	for _, c := range l.item.Content {
+		if c.Text == "" {
+			continue
+		}
+		if text.Len() > 0 {
+			text.WriteString("\n")
+		}
+		text.WriteString(c.Text)
+	}
+	content := text.String()
+	if l.item.Role == "user" && isCodexContextBlock(content) {
+		return ClaudeConversationMessage{}, false
+	}
+	return ClaudeConversationMessage{
+		Role:      l.item.Role,
+		Content:   content,
+		Timestamp: l.timestamp,
+	}, true
+}
+
+// isCodexContextBlock reports whether a user message is Codex's injected context.
+func isCodexContextBlock(content string) bool {
+	trimmed := strings.TrimSpace(content)
+	return strings.HasPrefix(trimmed, "<environment_context>") ||
+		strings.HasPrefix(trimmed, "<user_instructions>") ||
+		strings.HasPrefix(trimmed, "# AGENTS.md instructions")
+}
+
+// parseHistoryTime parses an RFC 3339 timestamp, returning the zero time on failure.
+func parseHistoryTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// Load implements HistoryProvider.
+func (p *CodexHistoryProvider) Load() ([]ClaudeHistoryEntry, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var entries []ClaudeHistoryEntry
+	err := filepath.WalkDir(p.sessionsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == p.sessionsDir && os.IsNotExist(err) {
+				return fs.SkipAll
+			}
+			return nil // skip unreadable entries
+		}
+		if d.IsDir() || !codexRolloutName.MatchString(d.Name()) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		seen[path] = true
+		cached, hit := p.cache[path]
+		if !hit || !cached.modTime.Equal(info.ModTime()) || cached.size != info.Size() {
+			entry, ok := readCodexRollout(path, info.ModTime())
+			cached = cachedHistoryEntry{modTime: info.ModTime(), size: info.Size(), entry: entry, ok: ok}
+			p.cache[path] = cached
+		}
+		if cached.ok {
+			entries = append(entries, cached.entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan codex sessions: %w", err)
+	}
+	for path := range p.cache {
+		if !seen[path] {
+			delete(p.cache, path)
+		}
+	}
+	return entries, nil
+}
+
+// readCodexRollout summarises one rollout file. It returns false for files that
+// hold no conversation turns (e.g. a session that was opened and closed).
+func readCodexRollout(path string, modTime time.Time) (ClaudeHistoryEntry, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ClaudeHistoryEntry{}, false
+	}
+	defer f.Close() //nolint:errcheck
+
+	entry := ClaudeHistoryEntry{Provider: HistoryProviderCodex, Path: path}
+	var firstUser string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	first := true
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		parsed, ok := parseCodexLine(line, first)
+		first = false
+		if !ok {
+			continue
+		}
+		if !parsed.timestamp.IsZero() {
+			if entry.CreatedAt.IsZero() {
+				entry.CreatedAt = parsed.timestamp
+			}
+			entry.UpdatedAt = parsed.timestamp
+		}
+		switch parsed.kind {
+		case "session_meta":
+			if entry.ID == "" {
+				entry.ID = parsed.item.ID
+			}
+			if parsed.item.Cwd != "" {
+				entry.Project = parsed.item.Cwd
+			}
+			if ts := parseHistoryTime(parsed.item.Timestamp); !ts.IsZero() {
+				entry.CreatedAt = ts
+			}
+		case "turn_context":
+			if parsed.item.Cwd != "" && entry.Project == "" {
+				entry.Project = parsed.item.Cwd
+			}
+			if parsed.item.Model != "" {
+				entry.Model = parsed.item.Model
+			}
+		}
+		if msg, ok := parsed.message(); ok {
+			entry.MessageCount++
+			if firstUser == "" && msg.Role == "user" {
+				firstUser = msg.Content
+			}
+		}
+	}
+	if entry.MessageCount == 0 {
+		return ClaudeHistoryEntry{}, false
+	}
+	if entry.ID == "" {
+		if m := codexRolloutName.FindStringSubmatch(filepath.Base(path)); m != nil {
+			entry.ID = m[1]
+		}
+	}
+	if entry.UpdatedAt.IsZero() {
+		entry.UpdatedAt = modTime
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = entry.UpdatedAt
+	}
+	entry.Name = historyEntryName(firstUser, entry.Project)
+	return entry, true
+}
+
+// Messages implements HistoryProvider.
+func (p *CodexHistoryProvider) Messages(entry ClaudeHistoryEntry, limit int) ([]ClaudeConversationMessage, error) {
+	path, err := p.rolloutPath(entry)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open codex rollout: %w", err)
+	}
+	defer f.Close() //nolint:errcheck
+
+	var messages []ClaudeConversationMessage
+	model := ""
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	first := true
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		parsed, ok := parseCodexLine(line, first)
+		first = false
+		if !ok {
+			continue
+		}
+		if parsed.kind == "turn_context" && parsed.item.Model != "" {
+			model = parsed.item.Model
+		}
+		if msg, ok := parsed.message(); ok {
+			if msg.Role == "assistant" {
+				msg.Model = model
+			}
+			messages = append(messages, msg)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading codex rollout: %w", err)
+	}
+	if limit > 0 && len(messages) > limit {
+		messages = messages[len(messages)-limit:]
+	}
+	return messages, nil
+}
+
+// Fork implements HistoryProvider. The copy is written to today's rollout
+// directory under a new UUID, with the session header rewritten to match, so
+// `codex resume <id>` picks it up as a separate conversation.
+func (p *CodexHistoryProvider) Fork(entry ClaudeHistoryEntry) (string, error) {
+	src, err := p.rolloutPath(entry)
+	if err != nil {
+		return "", err
+	}
+	newID := uuid.New().String()
+	now := time.Now()
+	dstDir := filepath.Join(p.sessionsDir, now.Format("2006"), now.Format("01"), now.Format("02"))
+	dst := filepath.Join(dstDir, fmt.Sprintf("rollout-%s-%s.jsonl", now.Format("2006-01-02T15-04-05"), newID))
+
+	first := true
+	err = rewriteJSONLines(src, dst, func(line map[string]any) {
+		defer func() { first = false }()
+		if line["type"] == "session_meta" {
+			if payload, ok := line["payload"].(map[string]any); ok {
+				payload["id"] = newID
+			}
+			return
+		}
+		if _, hasType := line["type"]; first && !hasType {
+			if _, hasID := line["id"]; hasID {
+				line["id"] = newID
+			}
+		}
+	})
+	if err != nil {
+		return "", fmt.Errorf("fork codex conversation: %w", err)
+	}
+	return newID, nil
+}
+
+// rolloutPath locates the rollout file for entry, searching by the UUID in the
+// file name when the entry was not produced by Load.
+func (p *CodexHistoryProvider) rolloutPath(entry ClaudeHistoryEntry) (string, error) {
+	if entry.Path != "" {
+		return entry.Path, nil
+	}
+	var found string
+	_ = filepath.WalkDir(p.sessionsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if m := codexRolloutName.FindStringSubmatch(d.Name()); m != nil && strings.EqualFold(m[1], entry.ID) {
+			found = path
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if found == "" {
+		return "", fmt.Errorf("codex rollout not found for session ID: %s", entry.ID)
+	}
+	return found, nil
+}
+
+// rewriteJSONLines copies the JSONL file at src to dst, passing every valid
+// object line through edit. Malformed lines are dropped. The write is atomic.
+func rewriteJSONLines(src, dst string, edit func(map[string]any)) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open src: %w", err)
+	}
+	defer in.Close() //nolint:errcheck
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("create dst dir: %w", err)
+	}
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("create tmp: %w", err)
+	}
+	w := bufio.NewWriter(out)
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var obj map[string]any
+		dec := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		dec.UseNumber() // keep integers exact on the way back out
+		if err := dec.Decode(&obj); err != nil {
+			continue
+		}
+		edit(obj)
+		b, err := json.Marshal(obj)
+		if err != nil {
+			continue
+		}
+		_, _ = w.Write(b)
+		_ = w.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("scan src: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("write tmp: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("close tmp: %w", err)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("rename tmp: %w", err)
+	}
+	return nil
+}
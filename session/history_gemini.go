@@ -0,0 +1,313 @@
+package session
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GeminiHistoryProvider reads the Gemini CLI's saved chats, one JSON document
+// per conversation under ~/.gemini/tmp/<project-hash>/chats/session-*.json.
+//
+// Gemini keys its per-project directories by the SHA-256 of the project root
+// rather than recording the path, so project paths are recovered by hashing the
+// paths other providers already know about (see hintProjects).
+type GeminiHistoryProvider struct {
+	tmpDir string
+
+	mu       sync.Mutex
+	cache    map[string]cachedHistoryEntry
+	projects map[string]string // project hash -> project path
+}
+
+// NewGeminiHistoryProvider creates a provider reading chats under tmpDir.
+func NewGeminiHistoryProvider(tmpDir string) *GeminiHistoryProvider {
+	return &GeminiHistoryProvider{
+		tmpDir:   tmpDir,
+		cache:    make(map[string]cachedHistoryEntry),
+		projects: make(map[string]string),
+	}
+}
+
+// Name implements HistoryProvider.
+func (p *GeminiHistoryProvider) Name() string { return HistoryProviderGemini }
+
+// geminiConversation is the on-disk shape of a saved Gemini chat.
+type geminiConversation struct {
+	SessionID   string          `json:"sessionId"`
+	ProjectHash string          `json:"projectHash"`
+	StartTime   string          `json:"startTime"`
+	LastUpdated string          `json:"lastUpdated"`
+	Messages    []geminiMessage `json:"messages"`
+}
+
+// geminiMessage is one record of a Gemini chat. Content is either a string or a
+// list of parts, depending on the CLI version.
+type geminiMessage struct {
+	Timestamp string          `json:"timestamp"`
+	Type      string          `json:"type"` // user, gemini, info, error
+	Content   json.RawMessage `json:"content"`
+	Model     string          `json:"model"`
+}
+
+// toConversationMessage converts a user or model record into a conversation turn.
+func (m geminiMessage) toConversationMessage() (ClaudeConversationMessage, bool) {
+	var role string
+	switch m.Type {
+	case "user":
+		role = "user"
+	case "gemini":
+		role = "assistant"
+	default:
+		return ClaudeConversationMessage{}, false
+	}
+	return ClaudeConversationMessage{
+		Role:      role,
+		Content:   geminiContentText(m.Content),
+		Timestamp: parseHistoryTime(m.Timestamp),
+		Model:     m.Model,
+	}, true
+}
+
+// geminiContentText flattens a string or part-list content value to text.
+func geminiContentText(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var parts []struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return ""
+	}
+	texts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part.Text != "" {
+			texts = append(texts, part.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// geminiProjectHash returns the key Gemini uses for a project root.
+func geminiProjectHash(project string) string {
+	sum := sha256.Sum256([]byte(project))
+	return hex.EncodeToString(sum[:])
+}
+
+// hintProjects implements projectHinter.
+func (p *GeminiHistoryProvider) hintProjects(entries []ClaudeHistoryEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range entries {
+		if e.Project != "" {
+			p.projects[geminiProjectHash(e.Project)] = e.Project
+		}
+	}
+}
+
+// chatFiles lists every saved chat under tmpDir.
+func (p *GeminiHistoryProvider) chatFiles() ([]string, error) {
+	if _, err := os.Stat(p.tmpDir); os.IsNotExist(err) {
+		return nil, nil
+	}
+	files, err := filepath.Glob(filepath.Join(p.tmpDir, "*", "chats", "session-*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan gemini chats: %w", err)
+	}
+	return files, nil
+}
+
+// Load implements HistoryProvider.
+func (p *GeminiHistoryProvider) Load() ([]ClaudeHistoryEntry, error) {
+	files, err := p.chatFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	seen := make(map[string]bool, len(files))
+	entries := make([]ClaudeHistoryEntry, 0, len(files))
+	for _, path := range files {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		seen[path] = true
+		cached, hit := p.cache[path]
+		if !hit || !cached.modTime.Equal(info.ModTime()) || cached.size != info.Size() {
+			entry, ok := readGeminiChat(path, info.ModTime())
+			cached = cachedHistoryEntry{modTime: info.ModTime(), size: info.Size(), entry: entry, ok: ok}
+			p.cache[path] = cached
+		}
+		if !cached.ok {
+			continue
+		}
+		entry := cached.entry
+		if project, ok := p.projects[filepath.Base(filepath.Dir(filepath.Dir(path)))]; ok {
+			entry.Project = project
+		}
+		entry.Name = historyEntryName(entry.Name, entry.Project)
+		entries = append(entries, entry)
+	}
+	for path := range p.cache {
+		if !seen[path] {
+			delete(p.cache, path)
+		}
+	}
+	return entries, nil
+}
+
+// readGeminiChat summarises one saved chat. It returns false for unreadable
+// chats and chats without any user or model turns.
+func readGeminiChat(path string, modTime time.Time) (ClaudeHistoryEntry, bool) {
+	conv, err := loadGeminiChat(path)
+	if err != nil || conv.SessionID == "" {
+		return ClaudeHistoryEntry{}, false
+	}
+	entry := ClaudeHistoryEntry{
+		ID:        conv.SessionID,
+		CreatedAt: parseHistoryTime(conv.StartTime),
+		UpdatedAt: parseHistoryTime(conv.LastUpdated),
+		Provider:  HistoryProviderGemini,
+		Path:      path,
+	}
+	var firstUser string
+	for _, m := range conv.Messages {
+		msg, ok := m.toConversationMessage()
+		if !ok {
+			continue
+		}
+		entry.MessageCount++
+		if firstUser == "" && msg.Role == "user" {
+			firstUser = msg.Content
+		}
+		if msg.Model != "" {
+			entry.Model = msg.Model
+		}
+	}
+	if entry.MessageCount == 0 {
+		return ClaudeHistoryEntry{}, false
+	}
+	if entry.UpdatedAt.IsZero() {
+		entry.UpdatedAt = modTime
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = entry.UpdatedAt
+	}
+	// Name holds the cleaned first message; Load falls back to the project once known.
+	entry.Name = cleanDisplayName(firstUser)
+	return entry, true
+}
+
+// loadGeminiChat decodes the chat document at path.
+func loadGeminiChat(path string) (*geminiConversation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gemini chat: %w", err)
+	}
+	var conv geminiConversation
+	if err := json.Unmarshal(data, &conv); err != nil {
+		return nil, fmt.Errorf("failed to parse gemini chat: %w", err)
+	}
+	return &conv, nil
+}
+
+// Messages implements HistoryProvider.
+func (p *GeminiHistoryProvider) Messages(entry ClaudeHistoryEntry, limit int) ([]ClaudeConversationMessage, error) {
+	path, err := p.chatPath(entry)
+	if err != nil {
+		return nil, err
+	}
+	conv, err := loadGeminiChat(path)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]ClaudeConversationMessage, 0, len(conv.Messages))
+	for _, m := range conv.Messages {
+		if msg, ok := m.toConversationMessage(); ok {
+			messages = append(messages, msg)
+		}
+	}
+	if limit > 0 && len(messages) > limit {
+		messages = messages[len(messages)-limit:]
+	}
+	return messages, nil
+}
+
+// Fork implements HistoryProvider. The chat is copied into the same project's
+// chats directory under a new session ID, named the way the CLI names its own
+// saves, so `gemini --resume <id>` run from the project finds it.
+func (p *GeminiHistoryProvider) Fork(entry ClaudeHistoryEntry) (string, error) {
+	src, err := p.chatPath(entry)
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return "", fmt.Errorf("fork gemini conversation: read src: %w", err)
+	}
+	var doc map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return "", fmt.Errorf("fork gemini conversation: parse src: %w", err)
+	}
+
+	newID := uuid.New().String()
+	now := time.Now().UTC()
+	doc["sessionId"] = newID
+	doc["lastUpdated"] = now.Format(time.RFC3339Nano)
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("fork gemini conversation: encode: %w", err)
+	}
+
+	dst := filepath.Join(filepath.Dir(src), fmt.Sprintf("session-%s-%s.json", now.Format("2006-01-02T15-04"), newID[:8]))
+	tmp := dst + ".tmp"
+	if err := os.WriteFile(tmp, out, 0600); err != nil {
+		return "", fmt.Errorf("fork gemini conversation: write tmp: %w", err)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		_ = os.Remove(tmp)
+		return "", fmt.Errorf("fork gemini conversation: rename tmp: %w", err)
+	}
+	return newID, nil
+}
+
+// chatPath locates the saved chat for entry. Gemini names chat files after the
+// first eight characters of the session ID, which narrows the search.
+func (p *GeminiHistoryProvider) chatPath(entry ClaudeHistoryEntry) (string, error) {
+	if entry.Path != "" {
+		return entry.Path, nil
+	}
+	files, err := p.chatFiles()
+	if err != nil {
+		return "", err
+	}
+	prefix := entry.ID
+	if len(prefix) > 8 {
+		prefix = prefix[:8]
+	}
+	for _, path := range files {
+		if !strings.HasSuffix(path, "-"+prefix+".json") {
+			continue
+		}
+		if conv, err := loadGeminiChat(path); err == nil && conv.SessionID == entry.ID {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("gemini chat not found for session ID: %s", entry.ID)
+}
@@ -0,0 +1,135 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// History provider names, as reported in ClaudeHistoryEntry.Provider.
+const (
+	HistoryProviderClaude = "claude"
+	HistoryProviderCodex  = "codex"
+	HistoryProviderGemini = "gemini"
+)
+
+// HistoryProvider reads one agent CLI's on-disk conversation logs.
+type HistoryProvider interface {
+	// Name identifies the provider; it doubles as the agent CLI's program name.
+	Name() string
+	// Load returns one entry per conversation. A missing log directory is not an
+	// error and yields no entries.
+	Load() ([]ClaudeHistoryEntry, error)
+	// Messages returns the user/assistant turns of a conversation, oldest first.
+	// When limit > 0 only the last limit turns are returned.
+	Messages(entry ClaudeHistoryEntry, limit int) ([]ClaudeConversationMessage, error)
+	// Fork copies a conversation under a new ID that the CLI can resume
+	// independently of the original, and returns that ID.
+	Fork(entry ClaudeHistoryEntry) (string, error)
+}
+
+// projectHinter is implemented by providers whose logs do not record the project
+// path directly. SessionHistory.Reload passes them the entries loaded so far so
+// they can match their own project keys against known paths.
+type projectHinter interface {
+	hintProjects(entries []ClaudeHistoryEntry)
+}
+
+// DefaultHistoryProviders returns the Claude, Codex and Gemini providers at their
+// default locations under the user's home directory. CODEX_HOME is honoured the
+// same way the Codex CLI honours it.
+func DefaultHistoryProviders() ([]HistoryProvider, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user home directory: %w", err)
+	}
+
+	codexHome := os.Getenv("CODEX_HOME")
+	if codexHome == "" {
+		codexHome = filepath.Join(home, ".codex")
+	}
+
+	return []HistoryProvider{
+		&ClaudeHistoryProvider{
+			HistoryPath: filepath.Join(home, ".claude", "history.jsonl"),
+			ProjectsDir: filepath.Join(home, ".claude", "projects"),
+		},
+		NewCodexHistoryProvider(filepath.Join(codexHome, "sessions")),
+		NewGeminiHistoryProvider(filepath.Join(home, ".gemini", "tmp")),
+	}, nil
+}
+
+// ForkHistoryConversation forks conversation id recorded by the named provider at
+// its default location, returning the ID to resume. An empty provider means Claude.
+func ForkHistoryConversation(provider, id string) (string, error) {
+	if provider == "" {
+		provider = HistoryProviderClaude
+	}
+	providers, err := DefaultHistoryProviders()
+	if err != nil {
+		return "", err
+	}
+	for _, p := range providers {
+		if p.Name() == provider {
+			return p.Fork(ClaudeHistoryEntry{ID: id, Provider: provider})
+		}
+	}
+	return "", fmt.Errorf("unknown history provider %q", provider)
+}
+
+// HistoryProviderForProgram returns the provider whose conversations program can
+// resume, or "" when program is not a supported agent CLI. Wrappers such as
+// "proxy-claude" count as the agent they wrap.
+func HistoryProviderForProgram(program string) string {
+	fields := strings.Fields(program)
+	if len(fields) == 0 {
+		return ""
+	}
+	base := strings.ToLower(filepath.Base(fields[0]))
+	for _, name := range []string{HistoryProviderClaude, HistoryProviderCodex, HistoryProviderGemini} {
+		if strings.Contains(base, name) {
+			return name
+		}
+	}
+	// Fall back to the whole command line for launchers like "npx @anthropic-ai/claude-code".
+	lower := strings.ToLower(program)
+	for _, name := range []string{HistoryProviderClaude, HistoryProviderCodex, HistoryProviderGemini} {
+		if strings.Contains(lower, name) {
+			return name
+		}
+	}
+	return ""
+}
+
+// ProgramForProvider returns program with its executable replaced by the named
+// provider's CLI, keeping leading environment assignments and the arguments.
+// An empty program is just the provider's CLI.
+func ProgramForProvider(program, provider string) string {
+	fields := strings.Fields(program)
+	for i, f := range fields {
+		if strings.Contains(f, "=") && !strings.HasPrefix(f, "-") {
+			continue
+		}
+		fields[i] = provider
+		return strings.Join(fields, " ")
+	}
+	return strings.Join(append(fields, provider), " ")
+}
+
+// ResumeCommand returns program extended with the flags its CLI uses to resume
+// conversation id. Programs that are not a known agent CLI are returned unchanged.
+func ResumeCommand(program, id string) string {
+	if id == "" {
+		return program
+	}
+	switch HistoryProviderForProgram(program) {
+	case HistoryProviderClaude:
+		return fmt.Sprintf("%s --resume %s", program, id)
+	case HistoryProviderCodex:
+		return fmt.Sprintf("%s resume %s", program, id)
+	case HistoryProviderGemini:
+		return fmt.Sprintf("%s --resume %s", program, id)
+	}
+	return program
+}
@@ -0,0 +1,188 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const codexID = "0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b"
+
+func writeHistoryFile(t *testing.T, path string, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func writeCodexRollout(t *testing.T, sessionsDir string) string {
+	t.Helper()
+	path := filepath.Join(sessionsDir, "2026", "10", "17", "rollout-2026-10-17T09-00-00-"+codexID+".jsonl")
+	writeHistoryFile(t, path, strings.Join([]string{
+		`{"timestamp":"2026-10-17T09:00:00Z","type":"session_meta","payload":{"id":"` + codexID + `","timestamp":"2026-10-17T09:00:00Z","cwd":"/work/api"}}`,
+		`{"timestamp":"2026-10-17T09:00:01Z","type":"response_item","payload":{"type":"message","role":"user","content":[{"type":"input_text","text":"<environment_context>cwd</environment_context>"}]}}`,
+		`{"timestamp":"2026-10-17T09:00:02Z","type":"turn_context","payload":{"cwd":"/work/api","model":"gpt-5-codex"}}`,
+		`{"timestamp":"2026-10-17T09:00:03Z","type":"response_item","payload":{"type":"message","role":"user","content":[{"type":"input_text","text":"add rate limiting"}]}}`,
+		`{"timestamp":"2026-10-17T09:00:04Z","type":"response_item","payload":{"type":"reasoning","summary":[]}}`,
+		`{"timestamp":"2026-10-17T09:05:00Z","type":"response_item","payload":{"type":"message","role":"assistant","content":[{"type":"output_text","text":"Added a token bucket."}]}}`,
+		``,
+	}, "\n"))
+	return path
+}
+
+func TestCodexHistoryProvider_LoadMessagesFork(t *testing.T) {
+	dir := t.TempDir()
+	writeCodexRollout(t, dir)
+	// Rollouts without any turns are not listed.
+	writeHistoryFile(t, filepath.Join(dir, "2026", "10", "17", "rollout-2026-10-17T10-00-00-11111111-2222-3333-4444-555555555555.jsonl"),
+		`{"timestamp":"2026-10-17T10:00:00Z","type":"session_meta","payload":{"id":"11111111-2222-3333-4444-555555555555","cwd":"/work/api"}}`+"\n")
+
+	p := NewCodexHistoryProvider(dir)
+	entries, err := p.Load()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	e := entries[0]
+	assert.Equal(t, codexID, e.ID)
+	assert.Equal(t, HistoryProviderCodex, e.Provider)
+	assert.Equal(t, "/work/api", e.Project)
+	assert.Equal(t, "gpt-5-codex", e.Model)
+	assert.Equal(t, "add rate limiting", e.Name)
+	assert.Equal(t, 2, e.MessageCount)
+	assert.Equal(t, "2026-10-17T09:05:00Z", e.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z"))
+
+	msgs, err := p.Messages(ClaudeHistoryEntry{ID: codexID}, 0)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "user", msgs[0].Role)
+	assert.Equal(t, "Added a token bucket.", msgs[1].Content)
+	assert.Equal(t, "gpt-5-codex", msgs[1].Model)
+
+	last, err := p.Messages(e, 1)
+	require.NoError(t, err)
+	require.Len(t, last, 1)
+	assert.Equal(t, "assistant", last[0].Role)
+
+	forkID, err := p.Fork(e)
+	require.NoError(t, err)
+	assert.NotEqual(t, codexID, forkID)
+	entries, err = p.Load()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	forked, err := p.Messages(ClaudeHistoryEntry{ID: forkID}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, msgs, forked)
+}
+
+func TestCodexHistoryProvider_LegacyLayout(t *testing.T) {
+	dir := t.TempDir()
+	writeHistoryFile(t, filepath.Join(dir, "2025", "06", "01", "rollout-2025-06-01T08-00-00-"+codexID+".jsonl"), strings.Join([]string{
+		`{"id":"` + codexID + `","timestamp":"2025-06-01T08:00:00Z","instructions":null}`,
+		`{"type":"message","role":"user","content":[{"type":"input_text","text":"explain main.go"}]}`,
+		`{"type":"message","role":"assistant","content":[{"type":"output_text","text":"It starts the server."}]}`,
+	}, "\n"))
+
+	entries, err := NewCodexHistoryProvider(dir).Load()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, codexID, entries[0].ID)
+	assert.Equal(t, "explain main.go", entries[0].Name)
+	assert.Equal(t, 2, entries[0].MessageCount)
+}
+
+func TestGeminiHistoryProvider_ProjectHintsAndFork(t *testing.T) {
+	dir := t.TempDir()
+	project := "/work/web"
+	const id = "3b44bc68-1111-2222-3333-444444444444"
+	chat := filepath.Join(dir, geminiProjectHash(project), "chats", "session-2026-10-17T09-00-3b44bc68.json")
+	writeHistoryFile(t, chat, `{
+  "sessionId": "`+id+`",
+  "projectHash": "`+geminiProjectHash(project)+`",
+  "startTime": "2026-10-17T09:00:00Z",
+  "lastUpdated": "2026-10-17T09:10:00Z",
+  "messages": [
+    {"id": "1", "timestamp": "2026-10-17T09:00:00Z", "type": "user", "content": "/fix the navbar"},
+    {"id": "2", "timestamp": "2026-10-17T09:01:00Z", "type": "info", "content": "Authenticated"},
+    {"id": "3", "timestamp": "2026-10-17T09:02:00Z", "type": "gemini", "content": [{"text": "Fixed."}], "model": "gemini-2.5-pro"}
+  ]
+}`)
+
+	p := NewGeminiHistoryProvider(dir)
+	entries, err := p.Load()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, id, entries[0].ID)
+	assert.Empty(t, entries[0].Project, "the project is unknown until another provider names it")
+	assert.Equal(t, "fix the navbar", entries[0].Name)
+	assert.Equal(t, "gemini-2.5-pro", entries[0].Model)
+	assert.Equal(t, 2, entries[0].MessageCount)
+
+	p.hintProjects([]ClaudeHistoryEntry{{Project: project}})
+	entries, err = p.Load()
+	require.NoError(t, err)
+	assert.Equal(t, project, entries[0].Project)
+
+	msgs, err := p.Messages(ClaudeHistoryEntry{ID: id}, 0)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "assistant", msgs[1].Role)
+	assert.Equal(t, "Fixed.", msgs[1].Content)
+
+	forkID, err := p.Fork(entries[0])
+	require.NoError(t, err)
+	forked, err := p.Messages(ClaudeHistoryEntry{ID: forkID}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, msgs, forked)
+}
+
+func TestSessionHistory_MergesProviders(t *testing.T) {
+	home := t.TempDir()
+	historyPath := filepath.Join(home, "history.jsonl")
+	writeHistoryJSONL(t, historyPath, []string{
+		historyLine("claude work", "/work/web", "c-1", 1760000000000),
+	})
+	codexDir := filepath.Join(home, "codex")
+	writeCodexRollout(t, codexDir)
+	geminiDir := filepath.Join(home, "gemini")
+	writeHistoryFile(t, filepath.Join(geminiDir, geminiProjectHash("/work/web"), "chats", "session-2026-10-17T09-00-aaaaaaaa.json"),
+		`{"sessionId":"aaaaaaaa-0000-0000-0000-000000000000","lastUpdated":"2026-10-18T00:00:00Z","messages":[{"type":"user","content":"hi"}]}`)
+
+	sh, err := NewSessionHistory(
+		&ClaudeHistoryProvider{HistoryPath: historyPath, ProjectsDir: filepath.Join(home, "projects")},
+		NewCodexHistoryProvider(codexDir),
+		NewGeminiHistoryProvider(geminiDir),
+	)
+	require.NoError(t, err)
+	entries := sh.GetAll()
+	require.Len(t, entries, 3)
+	assert.Equal(t, HistoryProviderGemini, entries[0].Provider, "newest first")
+	assert.Equal(t, "/work/web", entries[0].Project, "resolved from the Claude entry's project")
+	assert.Equal(t, HistoryProviderCodex, entries[1].Provider)
+	assert.Equal(t, HistoryProviderClaude, entries[2].Provider)
+	assert.Len(t, sh.GetByProject("/work/web"), 2)
+
+	msgs, err := sh.GetMessagesFromConversationFile(codexID, 0)
+	require.NoError(t, err)
+	assert.Len(t, msgs, 2)
+}
+
+func TestResumeCommand(t *testing.T) {
+	const id = "0199a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b"
+	assert.Equal(t, "claude --resume "+id, ResumeCommand("claude", id))
+	assert.Equal(t, "proxy-claude --resume "+id, ResumeCommand("proxy-claude", id))
+	assert.Equal(t, "codex -m o3 resume "+id, ResumeCommand("codex -m o3", id))
+	assert.Equal(t, "/usr/local/bin/gemini --resume "+id, ResumeCommand("/usr/local/bin/gemini", id))
+	assert.Equal(t, "aider", ResumeCommand("aider", id))
+	assert.Equal(t, "claude", ResumeCommand("claude", ""))
+
+	assert.Equal(t, HistoryProviderClaude, HistoryProviderForProgram("npx @anthropic-ai/claude-code"))
+	assert.Equal(t, "", HistoryProviderForProgram(""))
+}
+
+func TestProgramForProvider(t *testing.T) {
+	assert.Equal(t, "codex --model o3", ProgramForProvider("claude --model o3", HistoryProviderCodex))
+	assert.Equal(t, "FOO=1 gemini --yolo", ProgramForProvider("FOO=1 /usr/bin/claude --yolo", HistoryProviderGemini))
+	assert.Equal(t, "claude", ProgramForProvider("", HistoryProviderClaude))
+}
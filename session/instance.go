@@ -12,8 +12,10 @@ import (
 	"github.com/atotto/clipboard"
 	"github.com/google/uuid"
 	"github.com/linkdata/deadlock"
+	"github.com/tstapler/stapler-squad/config"
 	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/session/detection"
+	"github.com/tstapler/stapler-squad/session/sandbox"
 	"github.com/tstapler/stapler-squad/session/tmux"
 )
 
@@ -139,6 +141,34 @@ type Instance struct {
 	// When true, the Fixer will inject correction prompts without user confirmation.
 	// When false (default), the session runs in supervised mode.
 	AutonomousMode bool `json:"autonomous_mode,omitempty"`
+	// PRFeedbackEnabled opts the session into the PR review feedback loop:
+	// new review comments on its PR are queued to the agent automatically.
+	// Guarded by stateMutex; persisted by PRFeedbackStore.
+	PRFeedbackEnabled bool `json:"pr_feedback_enabled,omitempty"`
+	// CIAutoFixEnabled opts the session into automatic CI fix prompts: when
+	// checks on its PR fail, the failing job logs are queued to the agent.
+	// Guarded by stateMutex; persisted by PRFeedbackStore.
+	CIAutoFixEnabled bool `json:"ci_autofix_enabled,omitempty"`
+	// ciFailure summarizes failing CI checks on the session's PR for the review
+	// queue. Set by CIFailureWatcher; empty when CI is not failing. Guarded by stateMutex.
+	ciFailure string
+	// resourceUsage is the latest cgroup usage sample of the session's process
+	// tree and resourceAlert the review-queue summary of a recent OOM kill or
+	// limit hit. Both are set by ResourceMonitor. Guarded by stateMutex.
+	resourceUsage *ResourceUsage
+	resourceAlert string
+	// sandboxConfig is the sandbox the session is launched in, sandboxPolicy
+	// the policy of its latest launch and sandboxDenied the network requests
+	// the sandbox blocked. The sandbox itself persists as a policy file in
+	// the session's sandbox dir. sandboxChecked records that the policy file
+	// was looked for. Guarded by stateMutex.
+	sandboxConfig  config.SandboxConfig
+	sandboxPolicy  *sandbox.Policy
+	sandboxDenied  int
+	sandboxChecked bool
+	// setup runs once before the program first starts; nil once it ran or
+	// for sessions loaded from storage. Only touched under startMu.
+	setup SetupFunc
 
 	// GitHub integration fields for PR/URL-based session creation
 	// GitHubPRNumber is the PR number if this session was created from a PR URL
@@ -350,8 +380,8 @@ type InstanceOptions struct {
 	GitHubRepo      string // Repository name
 	GitHubSourceRef string // Original URL/reference used to create session
 	ClonedRepoPath  string // Path where repo was cloned (if cloned)
-	// ResumeId is the Claude conversation ID to resume (from history browser).
-	// When set, the session will start with --resume <id> flag.
+	// ResumeId is the agent conversation ID to resume (from history browser).
+	// When set, the session starts with the program's resume flags (see ResumeCommand).
 	ResumeId string
 
 	// OneShot runs claude in -p mode; the session exits after the task completes.
@@ -373,6 +403,13 @@ type InstanceOptions struct {
 	// CreateIfMissing: when SessionTypeDirectory, create the directory and run git init
 	// if the path does not exist. Only set when the user has confirmed the action.
 	CreateIfMissing bool
+
+	// Sandbox confines the session's program with Linux namespaces.
+	Sandbox config.SandboxConfig
+
+	// Setup prepares the session's working directory before its program
+	// first starts. A failed setup fails the start.
+	Setup SetupFunc
 }
 
 func NewInstance(opts InstanceOptions) (*Instance, error) {
@@ -454,6 +491,8 @@ func NewInstance(opts InstanceOptions) (*Instance, error) {
 		AppendSystemPrompt: opts.AppendSystemPrompt,
 		// Directory creation on missing path (R2 confirmation flow)
 		CreateIfMissing: opts.CreateIfMissing,
+		sandboxConfig:   opts.Sandbox,
+		setup:           opts.Setup,
 	}
 
 	// Initialize TagManager backed by the Instance.Tags slice
@@ -594,7 +633,7 @@ func (i *Instance) start(firstTimeSetup bool, setupCleanup bool, cleanup *tmux.C
 				// Dead tmux, no UUID — start a fresh session without --resume.
 				log.Warn("cold start: tmux dead, no conversation UUID, starting fresh", "session", i.Title, "path", startPath)
 			}
-			if err := i.tmuxManager.Start(startPath); err != nil {
+			if err := i.startTmux(startPath); err != nil {
 				setupErr = fmt.Errorf("cold restore Start failed for '%s': %w", i.Title, err)
 				return setupErr
 			}
@@ -638,7 +677,7 @@ func (i *Instance) start(firstTimeSetup bool, setupCleanup bool, cleanup *tmux.C
 			basePath = i.gitManager.GetWorktreePath()
 		}
 		startPath := i.resolveStartPath(basePath)
-		if err := i.tmuxManager.Start(startPath); err != nil {
+		if err := i.startTmux(startPath); err != nil {
 			if i.gitManager.HasWorktree() {
 				if cleanupErr := i.gitManager.Cleanup(); cleanupErr != nil {
 					err = fmt.Errorf("%v (cleanup error: %v)", err, cleanupErr)
@@ -724,6 +763,8 @@ func (i *Instance) Destroy() error {
 		errs = append(errs, err)
 	}
 
+	i.removeSandbox()
+
 	return i.combineErrors(errs)
 }
 
@@ -841,7 +882,7 @@ func (i *Instance) Resume() error {
 		if err := i.tmuxManager.RestoreWithWorkDir(worktreePath); err != nil {
 			log.Error("restore failed, falling back to new session", "err", err)
 			// If restore fails, fall back to creating new session
-			if err := i.tmuxManager.Start(worktreePath); err != nil {
+			if err := i.startTmux(worktreePath); err != nil {
 				log.Error("failed to start new session after restore failure", "err", err)
 				// Cleanup git worktree if tmux session creation fails
 				if i.gitManager.HasWorktree() {
@@ -855,7 +896,7 @@ func (i *Instance) Resume() error {
 		}
 	} else {
 		// Create new tmux session
-		if err := i.tmuxManager.Start(worktreePath); err != nil {
+		if err := i.startTmux(worktreePath); err != nil {
 			log.Error("failed to start new tmux session on resume", "err", err)
 			// Cleanup git worktree if tmux session creation fails
 			if i.gitManager.HasWorktree() {
@@ -974,7 +1015,7 @@ func (i *Instance) Restart(preserveOutput bool) error {
 	}
 
 	// Start the new session
-	if err := i.tmuxManager.Start(worktreePath); err != nil {
+	if err := i.startTmux(worktreePath); err != nil {
 		return fmt.Errorf("failed to start new tmux session: %w", err)
 	}
 
@@ -0,0 +1,60 @@
+package session
+
+import "time"
+
+// ResourceUsage is a usage sample of a session's process tree, taken from its
+// cgroup by ResourceMonitor.
+type ResourceUsage struct {
+	// CgroupPath is the session's cgroup directory.
+	CgroupPath string
+	// CPUPercent is the CPU use since the previous sample; 100 is one full core.
+	CPUPercent float64
+	CPUUsage   time.Duration
+	// CPULimitPercent is the configured CPU cap; 0 when unlimited.
+	CPULimitPercent int
+	MemoryBytes     uint64
+	MemoryPeakBytes uint64
+	// MemoryLimitBytes is memory.max; 0 when unlimited.
+	MemoryLimitBytes uint64
+	IOReadBytes      uint64
+	IOWriteBytes     uint64
+	PIDs             uint64
+	// PIDsLimit is pids.max; 0 when unlimited.
+	PIDsLimit uint64
+	// OOMKills counts processes the kernel OOM-killed in the session.
+	OOMKills uint64
+	// LimitEvents counts memory.max and pids.max hits.
+	LimitEvents uint64
+	SampledAt   time.Time
+}
+
+// SetResourceUsage records the session's latest resource usage sample.
+func (i *Instance) SetResourceUsage(u *ResourceUsage) {
+	i.stateMutex.Lock()
+	defer i.stateMutex.Unlock()
+	i.resourceUsage = u
+}
+
+// ResourceUsage returns the latest resource usage sample, or nil when the
+// session is not accounted (no cgroup v2, or not sampled yet).
+func (i *Instance) ResourceUsage() *ResourceUsage {
+	i.stateMutex.RLock()
+	defer i.stateMutex.RUnlock()
+	return i.resourceUsage
+}
+
+// SetResourceAlert records (or, with an empty summary, clears) a recent OOM
+// kill or resource limit hit for the review queue.
+func (i *Instance) SetResourceAlert(summary string) {
+	i.stateMutex.Lock()
+	defer i.stateMutex.Unlock()
+	i.resourceAlert = summary
+}
+
+// ResourceAlert returns the resource limit summary shown in the review queue,
+// or "" when no limit was hit recently.
+func (i *Instance) ResourceAlert() string {
+	i.stateMutex.RLock()
+	defer i.stateMutex.RUnlock()
+	return i.resourceAlert
+}
@@ -0,0 +1,197 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/sandbox"
+)
+
+// sandboxDir returns the session's sandbox dir, or "" when the config dir
+// cannot be resolved.
+func (i *Instance) sandboxDir() string {
+	root, err := sandbox.Root()
+	if err != nil {
+		return ""
+	}
+	return sandbox.SessionDir(root, i.GetStableID())
+}
+
+// sandboxEnabled reports whether the session runs sandboxed: either it was
+// created with a sandbox, or a policy from an earlier launch exists (sessions
+// restored from storage keep their sandbox).
+func (i *Instance) sandboxEnabled() bool {
+	i.stateMutex.RLock()
+	enabled := i.sandboxConfig.Enabled
+	i.stateMutex.RUnlock()
+	if enabled {
+		return true
+	}
+	dir := i.sandboxDir()
+	if dir == "" {
+		return false
+	}
+	_, err := os.Stat(sandbox.PolicyPath(dir))
+	return err == nil
+}
+
+// wrapSandbox prefixes program with the sandbox-exec launcher when the
+// session is sandboxed. The policy it names is written by prepareSandbox just
+// before the program starts.
+func (i *Instance) wrapSandbox(program string) string {
+	if !i.sandboxEnabled() {
+		return program
+	}
+	self, err := os.Executable()
+	if err != nil {
+		// prepareSandbox fails the launch with the same error.
+		return program
+	}
+	return fmt.Sprintf("%s sandbox-exec --policy %s -- %s",
+		shellQuote(self), shellQuote(sandbox.PolicyPath(i.sandboxDir())), program)
+}
+
+// prepareSandbox writes the session's sandbox policy for a launch in the
+// current worktree. A session that asks for a sandbox fails to start rather
+// than running unconfined.
+func (i *Instance) prepareSandbox() error {
+	if !i.sandboxEnabled() {
+		return nil
+	}
+	if err := sandbox.Available(); err != nil {
+		return err
+	}
+	if _, err := os.Executable(); err != nil {
+		return fmt.Errorf("locate squad executable for sandbox: %w", err)
+	}
+	dir := i.sandboxDir()
+	if dir == "" {
+		return errors.New("cannot resolve sandbox dir")
+	}
+
+	i.stateMutex.RLock()
+	cfg := i.sandboxConfig
+	i.stateMutex.RUnlock()
+	if !cfg.Enabled {
+		prev, err := sandbox.LoadPolicy(sandbox.PolicyPath(dir))
+		if err != nil {
+			return fmt.Errorf("load sandbox policy: %w", err)
+		}
+		cfg = prev.Config
+		cfg.Enabled = true
+	}
+
+	var serverHost string
+	if u, err := url.Parse(i.MCPServerURL); err == nil {
+		serverHost = u.Host
+	}
+	policy, err := sandbox.NewPolicy(dir, i.GetStableID(), i.GetEffectiveRootDir(), i.Program, serverHost, cfg)
+	if err != nil {
+		return err
+	}
+	if err := policy.Save(); err != nil {
+		return err
+	}
+
+	i.stateMutex.Lock()
+	i.sandboxConfig = cfg
+	i.sandboxPolicy = policy
+	i.stateMutex.Unlock()
+	log.Info("session sandboxed", "session", i.Title, "network", policy.Network, "writable", len(policy.WritablePaths))
+	return nil
+}
+
+// SetupFunc prepares a new session's working directory dir. wrap turns a
+// command line into one that runs where the session's program runs: inside
+// its sandbox when it has one.
+type SetupFunc func(dir string, wrap func(argv []string) []string) error
+
+// startTmux prepares the sandbox, if any, runs the pending setup and starts
+// the tmux session in workDir.
+func (i *Instance) startTmux(workDir string) error {
+	if err := i.prepareSandbox(); err != nil {
+		return fmt.Errorf("sandbox: %w", err)
+	}
+	if setup := i.setup; setup != nil {
+		i.setup = nil
+		if err := setup(workDir, i.sandboxArgv); err != nil {
+			return fmt.Errorf("setup: %w", err)
+		}
+	}
+	return i.tmuxManager.Start(workDir)
+}
+
+// sandboxArgv prefixes argv with the sandbox-exec launcher when the session
+// is sandboxed, like wrapSandbox does for the program.
+func (i *Instance) sandboxArgv(argv []string) []string {
+	if !i.sandboxEnabled() {
+		return argv
+	}
+	self, err := os.Executable()
+	if err != nil {
+		// prepareSandbox already failed the launch with the same error.
+		return argv
+	}
+	return append([]string{self, "sandbox-exec", "--policy", sandbox.PolicyPath(i.sandboxDir()), "--"}, argv...)
+}
+
+// removeSandbox deletes the session's sandbox dir.
+func (i *Instance) removeSandbox() {
+	dir := i.sandboxDir()
+	if dir == "" {
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		log.Warn("failed to remove sandbox dir", "session", i.Title, "dir", dir, "err", err)
+	}
+}
+
+// SandboxStatus returns the session's sandbox, or nil when it is not
+// sandboxed. Sessions re-attached after a server restart load their policy
+// from disk on first use.
+func (i *Instance) SandboxStatus() *sandbox.Status {
+	i.stateMutex.Lock()
+	defer i.stateMutex.Unlock()
+	if i.sandboxPolicy == nil && !i.sandboxChecked {
+		i.sandboxChecked = true
+		if dir := i.sandboxDir(); dir != "" {
+			if loaded, err := sandbox.LoadPolicy(sandbox.PolicyPath(dir)); err == nil {
+				i.sandboxPolicy = loaded
+			}
+		}
+	}
+	if i.sandboxPolicy == nil {
+		return nil
+	}
+	return &sandbox.Status{
+		Network:       i.sandboxPolicy.Network,
+		AllowedHosts:  i.sandboxPolicy.AllowedHosts,
+		WritablePaths: i.sandboxPolicy.WritablePaths,
+		Denied:        i.sandboxDenied,
+	}
+}
+
+// SandboxDenialLog returns the path of the session's sandbox denial log.
+func (i *Instance) SandboxDenialLog() string {
+	dir := i.sandboxDir()
+	if dir == "" {
+		return ""
+	}
+	return sandbox.DenialLogPath(dir)
+}
+
+// AddSandboxDenials counts network requests the sandbox blocked.
+func (i *Instance) AddSandboxDenials(n int) {
+	i.stateMutex.Lock()
+	defer i.stateMutex.Unlock()
+	i.sandboxDenied += n
+}
+
+// shellQuote single-quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,46 @@
+package session
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/session/sandbox"
+)
+
+func TestInstanceSandbox_LaunchCommandAndStatus(t *testing.T) {
+	t.Setenv("STAPLER_SQUAD_TEST_DIR", t.TempDir())
+	inst := &Instance{Title: "boxed", UUID: "u-1", Program: "claude", Path: t.TempDir()}
+	assert.Equal(t, "claude", inst.buildLaunchCommand(""))
+	assert.Nil(t, inst.SandboxStatus())
+
+	inst = &Instance{Title: "boxed", UUID: "u-2", Program: "claude", Path: t.TempDir(),
+		sandboxConfig: config.SandboxConfig{Enabled: true, Network: config.SandboxNetworkNone}}
+	cmd := inst.buildLaunchCommand("")
+	assert.Contains(t, cmd, " sandbox-exec --policy ")
+	assert.True(t, strings.HasSuffix(cmd, " -- claude"), cmd)
+
+	err := inst.prepareSandbox()
+	if _, lookErr := exec.LookPath("bwrap"); lookErr != nil {
+		// Without bwrap the session must not start unconfined.
+		assert.ErrorIs(t, err, sandbox.ErrUnavailable)
+		return
+	}
+	require.NoError(t, err)
+	st := inst.SandboxStatus()
+	require.NotNil(t, st)
+	assert.Equal(t, config.SandboxNetworkNone, st.Network)
+	assert.Contains(t, st.WritablePaths, inst.Path)
+
+	inst.AddSandboxDenials(2)
+	assert.Equal(t, 2, inst.SandboxStatus().Denied)
+}
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, `'/opt/my app/squad'`, shellQuote("/opt/my app/squad"))
+	assert.Equal(t, `'it'\''s'`, shellQuote("it's"))
+}
@@ -68,7 +68,9 @@ func (i *Instance) ToInstanceData() InstanceData {
 		GitHubPRStatusTerminal: i.GitHubPRStatusTerminal,
 		LastPRStatusCheck:      i.LastPRStatusCheck,
 		// Crew autonomy mode
-		AutonomousMode: i.AutonomousMode,
+		AutonomousMode:    i.AutonomousMode,
+		PRFeedbackEnabled: i.PRFeedbackEnabled,
+		CIAutoFixEnabled:  i.CIAutoFixEnabled,
 		// Checkpoint metadata
 		Checkpoints:      i.Checkpoints,
 		ActiveCheckpoint: i.ActiveCheckpoint,
@@ -210,7 +212,9 @@ func FromInstanceData(data InstanceData) (*Instance, error) {
 		MainRepoPath: data.MainRepoPath,
 		IsWorktree:   data.IsWorktree,
 		// Crew autonomy mode
-		AutonomousMode: data.AutonomousMode,
+		AutonomousMode:    data.AutonomousMode,
+		PRFeedbackEnabled: data.PRFeedbackEnabled,
+		CIAutoFixEnabled:  data.CIAutoFixEnabled,
 		// Checkpoint metadata
 		Checkpoints:      data.Checkpoints,
 		ActiveCheckpoint: data.ActiveCheckpoint,
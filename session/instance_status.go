@@ -4,7 +4,10 @@ import "github.com/linkdata/deadlock"
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/activity"
 	"github.com/tstapler/stapler-squad/session/detection"
 )
 
@@ -18,11 +21,19 @@ type InstanceStatusInfo struct {
 	LastCommandStatus  string                   // Status of last command
 	IsControllerActive bool                     // Whether ClaudeController is running
 	IdleState          detection.IdleStateInfo  // NEW: Idle state information
+	Activity           activity.State           // Agent state reported by hooks
+	HasActivity        bool                     // Whether Activity is current enough to trust
+}
+
+// ActivitySource reports a session's agent state as derived from its hooks.
+type ActivitySource interface {
+	State(sessionTitle string) activity.State
 }
 
 // InstanceStatusManager manages status information for instances.
 type InstanceStatusManager struct {
 	controllers map[string]*ClaudeController // Map of instance title to controller
+	activity    ActivitySource               // Optional: hook-reported agent state
 	mu          deadlock.RWMutex
 }
 
@@ -48,6 +59,15 @@ func (ism *InstanceStatusManager) UnregisterController(instanceTitle string) {
 	delete(ism.controllers, instanceTitle)
 }
 
+// SetActivitySource sets the source of hook-reported agent state. When it has
+// current state for a session, that state takes precedence over terminal
+// pattern detection.
+func (ism *InstanceStatusManager) SetActivitySource(src ActivitySource) {
+	ism.mu.Lock()
+	defer ism.mu.Unlock()
+	ism.activity = src
+}
+
 // GetController retrieves a controller for an instance.
 func (ism *InstanceStatusManager) GetController(instanceTitle string) (*ClaudeController, bool) {
 	ism.mu.RLock()
@@ -73,6 +93,7 @@ func (ism *InstanceStatusManager) GetAllControllers() map[string]*ClaudeControll
 func (ism *InstanceStatusManager) GetStatus(instance *Instance) InstanceStatusInfo {
 	ism.mu.RLock()
 	controller, exists := ism.controllers[instance.Title]
+	activitySource := ism.activity
 	ism.mu.RUnlock()
 
 	info := InstanceStatusInfo{
@@ -100,9 +121,38 @@ func (ism *InstanceStatusManager) GetStatus(instance *Instance) InstanceStatusIn
 		info.IdleState = controller.GetIdleStateInfo()
 	}
 
+	if activitySource != nil {
+		info.Activity = activitySource.State(instance.Title)
+		info.HasActivity = info.Activity.Current(time.Now())
+		if info.HasActivity {
+			info.ClaudeStatus, info.StatusContext = activityStatus(info.Activity)
+		}
+	}
+
 	return info
 }
 
+// activityStatus maps hook-reported agent state onto the detected status
+// vocabulary used by the rest of status handling.
+func activityStatus(s activity.State) (detection.DetectedStatus, string) {
+	switch s.Phase {
+	case activity.PhaseWorking:
+		if s.Tool != "" {
+			return detection.StatusActive, fmt.Sprintf("Running %s: %s", s.Tool, s.Input)
+		}
+		return detection.StatusProcessing, "Working"
+	case activity.PhaseWaiting:
+		if s.WaitingForAnswer() {
+			return detection.StatusInputRequired, effectiveCtx(s.Input, "Agent asked a question")
+		}
+		return detection.StatusNeedsApproval, fmt.Sprintf("Waiting for approval: %s %s", s.Tool, s.Input)
+	case activity.PhaseDone:
+		return detection.StatusReady, fmt.Sprintf("Agent finished its turn (%s)", s.StopReason)
+	default:
+		return detection.StatusUnknown, ""
+	}
+}
+
 // GetStatusIcon returns an icon representing the instance status.
 func (info InstanceStatusInfo) GetStatusIcon() string {
 	if !info.IsControllerActive {
@@ -100,17 +100,21 @@ func (i *Instance) combineErrors(errs []error) error {
 	return fmt.Errorf("%s", errMsg)
 }
 
+// previewLines is how many lines of the emulated screen and its scrollback
+// Preview returns, enough context for status detection and snapshots.
+const previewLines = 200
+
 // Preview returns the current visible terminal content.
-// Prefers the in-memory PTY buffer from ClaudeController; falls back to capture-pane.
+// Prefers the ClaudeController's emulated screen; falls back to capture-pane.
 func (i *Instance) Preview() (string, error) {
 	if !i.started || i.Status == Paused || i.Status == Stopped {
 		return "", nil
 	}
 
-	// Prefer the in-memory PTY buffer from ClaudeController (no subprocess).
+	// Prefer the emulated screen fed by the PTY (no subprocess). Unlike the
+	// raw output buffer it reflects redraws, cursor movement and clears.
 	if ctrl := i.GetController(); ctrl != nil {
-		raw := ctrl.GetRecentOutput(0)
-		return string(raw), nil
+		return ctrl.GetScreenContent(previewLines), nil
 	}
 
 	// Fallback for external/attached sessions: use capture-pane subprocess.
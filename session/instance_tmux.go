@@ -22,11 +22,11 @@ func (i *Instance) GetTmuxSessionName() string {
 }
 
 // buildLaunchCommand constructs the final command string used to launch the program
-// in tmux, incorporating Claude session resume flags, MCP server URL, and prompt.
+// in tmux, incorporating the agent CLI's resume flags, MCP server URL, and prompt.
 func (i *Instance) buildLaunchCommand(claudeSessionID string) string {
 	program := i.Program
-	if claudeSessionID != "" && strings.Contains(program, "claude") {
-		program = fmt.Sprintf("%s --resume %s", program, claudeSessionID)
+	if claudeSessionID != "" {
+		program = ResumeCommand(program, claudeSessionID)
 	}
 	if i.MCPServerURL != "" && strings.Contains(program, "claude") {
 		mcpFlag := fmt.Sprintf(`--mcp-config '{"mcpServers":{"stapler-squad":{"type":"http","url":%q}}}'`, i.MCPServerURL)
@@ -41,7 +41,7 @@ func (i *Instance) buildLaunchCommand(claudeSessionID string) string {
 	if i.Prompt != "" && claudeSessionID == "" && strings.Contains(program, "claude") {
 		program = fmt.Sprintf("%s %q", program, i.Prompt)
 	}
-	return program
+	return i.wrapSandbox(program)
 }
 
 // initTmuxSession creates (or reuses) the tmux.TmuxSession object without starting it.
@@ -227,7 +227,11 @@ func (i *Instance) WriteToPTY(data []byte) (int, error) {
 	if !i.started {
 		return 0, fmt.Errorf("session not started")
 	}
-	return i.tmuxManager.SendKeys(string(data))
+	n, err := i.tmuxManager.SendKeys(string(data))
+	if err == nil {
+		i.notifyTerminalInput(data)
+	}
+	return n, err
 }
 
 // ResizePTY resizes the terminal dimensions.
@@ -314,7 +318,11 @@ func (i *Instance) SendPrompt(prompt string) error {
 	if !i.started {
 		return fmt.Errorf("instance not started")
 	}
-	return i.tmuxManager.SendPromptWithEnter(prompt)
+	if err := i.tmuxManager.SendPromptWithEnter(prompt); err != nil {
+		return err
+	}
+	i.notifyTerminalInput([]byte(prompt + "\r"))
+	return nil
 }
 
 // GetTmuxSession returns the underlying tmux session for direct access.
@@ -378,6 +386,9 @@ func (i *Instance) SendKeys(keys string) error {
 		return fmt.Errorf("cannot send keys to instance that has not been started or is paused")
 	}
 	_, err := i.tmuxManager.SendKeys(keys)
+	if err == nil {
+		i.notifyTerminalInput([]byte(keys))
+	}
 	return err
 }
 
@@ -387,7 +398,11 @@ func (i *Instance) SendInputViaControlMode(ctx context.Context, data []byte) err
 	if !i.started || i.Status == Paused {
 		return fmt.Errorf("cannot send input to instance that has not been started or is paused")
 	}
-	return i.tmuxManager.SendInputViaControlMode(ctx, data)
+	if err := i.tmuxManager.SendInputViaControlMode(ctx, data); err != nil {
+		return err
+	}
+	i.notifyTerminalInput(data)
+	return nil
 }
 
 // GetPanePID returns the PID of the foreground process in the tmux pane.
@@ -397,3 +412,21 @@ func (i *Instance) GetPanePID() (int32, error) {
 	}
 	return i.tmuxManager.GetPanePID()
 }
+
+// PanePiped reports whether the tmux pane's output is being piped, which is
+// how session recording captures it.
+func (i *Instance) PanePiped() (bool, error) {
+	if !i.tmuxManager.DoesSessionExist() {
+		return false, fmt.Errorf("tmux session not alive for '%s'", i.Title)
+	}
+	return i.tmuxManager.PanePiped()
+}
+
+// PipePaneOutput pipes the tmux pane's output to shellCommand, replacing any
+// open pipe. An empty shellCommand closes the pipe.
+func (i *Instance) PipePaneOutput(shellCommand string) error {
+	if !i.tmuxManager.DoesSessionExist() {
+		return fmt.Errorf("tmux session not alive for '%s'", i.Title)
+	}
+	return i.tmuxManager.PipePaneOutput(shellCommand)
+}
@@ -57,6 +57,11 @@ type Multiplexer struct {
 	clients   map[net.Conn]struct{}
 	clientsMu sync.RWMutex
 
+	// localInputGate is the client that closed the local terminal's input
+	// because someone else has control; nil while local typing reaches the PTY
+	localInputGate net.Conn
+	gateMu         sync.Mutex
+
 	// Synchronization
 	ctx      context.Context
 	cancel   context.CancelFunc
@@ -478,6 +483,8 @@ func (m *Multiplexer) handleClient(conn net.Conn) {
 		m.clientsMu.Lock()
 		delete(m.clients, conn)
 		m.clientsMu.Unlock()
+		// A client that is gone cannot keep the local terminal locked out.
+		m.setLocalInputGate(conn, true)
 		conn.Close()
 	}()
 
@@ -551,6 +558,11 @@ func (m *Multiplexer) handleClient(conn net.Conn) {
 				_ = WriteMessage(conn, NewSnapshotReplyMessage(content))
 			}
 
+		case MessageTypeInputGate:
+			if open, err := ParseInputGateMessage(msg); err == nil {
+				m.setLocalInputGate(conn, open)
+			}
+
 		case MessageTypeClose:
 			// Client wants to disconnect
 			return
@@ -558,6 +570,19 @@ func (m *Multiplexer) handleClient(conn net.Conn) {
 	}
 }
 
+// setLocalInputGate opens or closes the local terminal's input on behalf of
+// conn. Only the client that closed it can open it again.
+func (m *Multiplexer) setLocalInputGate(conn net.Conn, open bool) {
+	m.gateMu.Lock()
+	defer m.gateMu.Unlock()
+	switch {
+	case !open:
+		m.localInputGate = conn
+	case m.localInputGate == conn:
+		m.localInputGate = nil
+	}
+}
+
 // forwardPTYOutput reads from PTY and sends to stdout + all clients.
 func (m *Multiplexer) forwardPTYOutput() {
 	defer m.wg.Done()
@@ -606,11 +631,26 @@ func (m *Multiplexer) forwardStdinToPTY() {
 		}
 
 		if n > 0 {
-			_, _ = m.ptmx.Write(buf[:n])
+			m.writeLocalInput(buf[:n])
 		}
 	}
 }
 
+// writeLocalInput sends what was typed in the local terminal to the PTY and
+// tells the clients, which arbitrate input between the local terminal and
+// everyone else attached. Typing is dropped while a client has closed the
+// local input.
+func (m *Multiplexer) writeLocalInput(data []byte) {
+	m.gateMu.Lock()
+	closed := m.localInputGate != nil
+	m.gateMu.Unlock()
+	if closed {
+		return
+	}
+	_, _ = m.ptmx.Write(data)
+	m.broadcastToClients(NewLocalInputMessage())
+}
+
 // startSessionMonitor starts the appropriate session monitor.
 // If a PaneExitSubscriber is configured it uses the channel-based path (no polling).
 // Otherwise it falls back to the ticker-based monitorTmuxSessionPolling goroutine.
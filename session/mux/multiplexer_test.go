@@ -295,3 +295,50 @@ func TestMultiplexer_InputIsolation(t *testing.T) {
 		"read from client 2 should time out since no data was broadcast")
 	c2c.Close()
 }
+
+// TestMultiplexer_LocalInputGate verifies that a client can close the local
+// terminal's input while someone else has control, and that the input opens
+// again once that client disconnects.
+func TestMultiplexer_LocalInputGate(t *testing.T) {
+	m := &Multiplexer{
+		clients: make(map[net.Conn]struct{}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.ctx = ctx
+	m.cancel = cancel
+	defer cancel()
+
+	ptyR, ptyW, err := os.Pipe()
+	require.NoError(t, err)
+	defer ptyR.Close()
+	m.ptmx = ptyW
+
+	server, client := net.Pipe()
+	defer client.Close()
+	m.wg.Add(1)
+	done := make(chan struct{})
+	go func() {
+		m.handleClient(server)
+		close(done)
+	}()
+
+	client.SetDeadline(time.Now().Add(2 * time.Second))
+	_, err = DecodeMessage(client) // metadata
+	require.NoError(t, err)
+	require.NoError(t, WriteMessage(client, NewInputGateMessage(false)))
+	// The pong proves the gate message was handled.
+	require.NoError(t, WriteMessage(client, NewPingMessage()))
+	_, err = DecodeMessage(client)
+	require.NoError(t, err)
+
+	m.writeLocalInput([]byte("while gated "))
+
+	require.NoError(t, WriteMessage(client, NewCloseMessage()))
+	<-done
+	m.writeLocalInput([]byte("after client left"))
+
+	ptyW.Close()
+	ptyData, _ := io.ReadAll(ptyR)
+	assert.Equal(t, "after client left", string(ptyData),
+		"local input must be dropped while gated and reach the PTY once the gating client left")
+}
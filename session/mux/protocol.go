@@ -32,6 +32,11 @@ const (
 	MessageTypeSnapshot MessageType = 0x08
 	// MessageTypeSnapshotReply contains the clean screen snapshot
 	MessageTypeSnapshotReply MessageType = 0x09
+	// MessageTypeInputGate opens (1) or closes (0) the local terminal's input,
+	// while another client has control of the session (clients -> mux)
+	MessageTypeInputGate MessageType = 0x0A
+	// MessageTypeLocalInput reports that the local terminal typed into the PTY (mux -> clients)
+	MessageTypeLocalInput MessageType = 0x0B
 )
 
 // Message represents a single message in the mux protocol.
@@ -220,3 +225,36 @@ func NewSnapshotReplyMessage(content []byte) *Message {
 		Data: content,
 	}
 }
+
+// NewInputGateMessage creates a message opening or closing the local
+// terminal's input.
+func NewInputGateMessage(open bool) *Message {
+	data := []byte{0}
+	if open {
+		data[0] = 1
+	}
+	return &Message{
+		Type: MessageTypeInputGate,
+		Data: data,
+	}
+}
+
+// ParseInputGateMessage reports whether an input gate message opens the
+// local terminal's input.
+func ParseInputGateMessage(msg *Message) (bool, error) {
+	if msg.Type != MessageTypeInputGate {
+		return false, fmt.Errorf("not an input gate message: type %d", msg.Type)
+	}
+	if len(msg.Data) != 1 {
+		return false, fmt.Errorf("invalid input gate data length: %d", len(msg.Data))
+	}
+	return msg.Data[0] != 0, nil
+}
+
+// NewLocalInputMessage creates a message reporting local typing.
+func NewLocalInputMessage() *Message {
+	return &Message{
+		Type: MessageTypeLocalInput,
+		Data: nil,
+	}
+}
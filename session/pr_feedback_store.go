@@ -0,0 +1,233 @@
+package session
+
+import "github.com/linkdata/deadlock"
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// prFeedbackEntry is the persisted feedback-loop state for one session.
+type prFeedbackEntry struct {
+	Enabled          bool      `json:"enabled"`
+	HandledComments  []int     `json:"handled_comments,omitempty"`
+	HandledReviews   []int     `json:"handled_reviews,omitempty"`
+	LastDeliveredAt  time.Time `json:"last_delivered_at,omitempty"`
+	DeliveredBatches int       `json:"delivered_batches,omitempty"`
+
+	// CI failure loop state (see CIFailureWatcher). CIAutoFix is the session's
+	// opt-in to automatic fix prompts; CIReportedChecks holds the failing check
+	// IDs already reported; CIAttempts counts automatic fix prompts sent since
+	// CI last passed; CIPrompt is the fix prompt prepared for the latest failure,
+	// kept so it can be sent on demand.
+	CIAutoFix        bool   `json:"ci_autofix,omitempty"`
+	CIReportedChecks []int  `json:"ci_reported_checks,omitempty"`
+	CIAttempts       int    `json:"ci_attempts,omitempty"`
+	CIPrompt         string `json:"ci_prompt,omitempty"`
+}
+
+// PRFeedbackStore persists which sessions opted into the PR review feedback loop
+// and which review comments have already been delivered to each agent.
+// Entries are keyed by session UUID (title for legacy sessions without one).
+// All public methods are thread-safe.
+type PRFeedbackStore struct {
+	mu      deadlock.RWMutex
+	path    string
+	entries map[string]*prFeedbackEntry
+}
+
+// NewPRFeedbackStore loads (or creates) the feedback state file at the given path.
+// An empty path yields an in-memory store.
+func NewPRFeedbackStore(path string) (*PRFeedbackStore, error) {
+	s := &PRFeedbackStore{
+		path:    path,
+		entries: make(map[string]*prFeedbackEntry),
+	}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("read PR feedback state: %w", err)
+	}
+	if err := json.Unmarshal(data, &s.entries); err != nil {
+		return nil, fmt.Errorf("unmarshal PR feedback state: %w", err)
+	}
+	return s, nil
+}
+
+// prFeedbackKey returns the store key for an instance.
+func prFeedbackKey(inst *Instance) string {
+	if inst.UUID != "" {
+		return inst.UUID
+	}
+	return inst.Title
+}
+
+// IsEnabled reports whether the session opted into the feedback loop.
+func (s *PRFeedbackStore) IsEnabled(key string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.entries[key]
+	return ok && e.Enabled
+}
+
+// SetEnabled opts a session in or out. Disabling keeps the handled set so
+// re-enabling does not redeliver comments the agent already saw.
+func (s *PRFeedbackStore) SetEnabled(key string, enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entry(key).Enabled = enabled
+	return s.save()
+}
+
+// Handled returns the sets of comment and review IDs already delivered for the session.
+func (s *PRFeedbackStore) Handled(key string) (comments, reviews map[int]bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	comments = make(map[int]bool)
+	reviews = make(map[int]bool)
+	if e, ok := s.entries[key]; ok {
+		for _, id := range e.HandledComments {
+			comments[id] = true
+		}
+		for _, id := range e.HandledReviews {
+			reviews[id] = true
+		}
+	}
+	return comments, reviews
+}
+
+// MarkHandled records comment and review IDs as delivered to the agent.
+func (s *PRFeedbackStore) MarkHandled(key string, commentIDs, reviewIDs []int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.entry(key)
+	e.HandledComments = append(e.HandledComments, commentIDs...)
+	e.HandledReviews = append(e.HandledReviews, reviewIDs...)
+	e.LastDeliveredAt = time.Now()
+	e.DeliveredBatches++
+	return s.save()
+}
+
+// MarkSeen records comment and review IDs that predate the session's opt-in so
+// they are never delivered. Unlike MarkHandled it does not count a delivery.
+func (s *PRFeedbackStore) MarkSeen(key string, commentIDs, reviewIDs []int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.entry(key)
+	e.HandledComments = append(e.HandledComments, commentIDs...)
+	e.HandledReviews = append(e.HandledReviews, reviewIDs...)
+	return s.save()
+}
+
+// IsCIAutoFixEnabled reports whether the session opted into automatic CI fix prompts.
+func (s *PRFeedbackStore) IsCIAutoFixEnabled(key string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.entries[key]
+	return ok && e.CIAutoFix
+}
+
+// SetCIAutoFix opts a session in or out of automatic CI fix prompts.
+func (s *PRFeedbackStore) SetCIAutoFix(key string, enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entry(key).CIAutoFix = enabled
+	return s.save()
+}
+
+// CIPrompt returns the fix prompt prepared for the session's latest CI failure,
+// or "" when CI is not failing.
+func (s *PRFeedbackStore) CIPrompt(key string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if e, ok := s.entries[key]; ok {
+		return e.CIPrompt
+	}
+	return ""
+}
+
+// CIState returns the failing check IDs already reported for the session and the
+// number of automatic fix attempts made since CI last passed.
+func (s *PRFeedbackStore) CIState(key string) (reported map[int]bool, attempts int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	reported = make(map[int]bool)
+	if e, ok := s.entries[key]; ok {
+		for _, id := range e.CIReportedChecks {
+			reported[id] = true
+		}
+		attempts = e.CIAttempts
+	}
+	return reported, attempts
+}
+
+// RecordCIFailure marks failing checks as reported, keeps the fix prompt
+// prepared for them and, when it was sent, consumes one attempt from the
+// retry budget.
+func (s *PRFeedbackStore) RecordCIFailure(key string, checkIDs []int, attempted bool, prompt string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.entry(key)
+	e.CIReportedChecks = append(e.CIReportedChecks, checkIDs...)
+	e.CIPrompt = prompt
+	if attempted {
+		e.CIAttempts++
+	}
+	return s.save()
+}
+
+// ResetCI clears CI failure state once the session's checks pass again,
+// restoring the full retry budget.
+func (s *PRFeedbackStore) ResetCI(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || (len(e.CIReportedChecks) == 0 && e.CIAttempts == 0 && e.CIPrompt == "") {
+		return nil
+	}
+	e.CIReportedChecks = nil
+	e.CIAttempts = 0
+	e.CIPrompt = ""
+	return s.save()
+}
+
+// entry returns the entry for key, creating it if needed. Must be called with mu held (write).
+func (s *PRFeedbackStore) entry(key string) *prFeedbackEntry {
+	e, ok := s.entries[key]
+	if !ok {
+		e = &prFeedbackEntry{}
+		s.entries[key] = e
+	}
+	return e
+}
+
+// save writes state atomically via temp file + rename. Must be called with mu held (write).
+func (s *PRFeedbackStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal PR feedback state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("create PR feedback state dir: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("write temp PR feedback state: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("rename PR feedback state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tstapler/stapler-squad/forge"
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// prFeedbackCommandPriority is the queue priority for review-feedback prompts.
+// Lower than approval automation (100) so pending approvals are answered first.
+const prFeedbackCommandPriority = 50
+
+// prFeedbackAgentSignature marks PR comments written by an agent. The
+// feedback prompt asks agents to end every comment with it, so their replies
+// are told apart from the user's own comments when both post from the same
+// account. It is an HTML comment and does not render.
+const prFeedbackAgentSignature = "<!-- stapler-squad:agent -->"
+
+// prFeedbackHunkLines caps how many trailing diff-hunk lines are quoted per comment.
+// Forge hunks end at the commented line, so the tail carries the relevant context.
+const prFeedbackHunkLines = 12
+
+// PRFeedback is a batch of unhandled review feedback for one pull request.
+type PRFeedback struct {
+	Owner    string
+	Repo     string
+	Number   int
+	Reviews  []forge.Review
+	Comments []forge.PRComment
+}
+
+// Empty reports whether the batch has nothing to deliver.
+func (f *PRFeedback) Empty() bool {
+	return len(f.Reviews) == 0 && len(f.Comments) == 0
+}
+
+// PRFeedbackWatcher feeds new code review feedback on a session's PR back to its
+// agent. Sessions opt in individually (Instance.PRFeedbackEnabled); the
+// PRStatusPoller calls Check on each tick for opted-in sessions with an open PR.
+// New inline review comments and "changes requested" reviews are batched into a
+// single prompt, queued through ClaudeController.SendCommand, and then marked
+// handled in the PRFeedbackStore so they are never delivered twice.
+type PRFeedbackWatcher struct {
+	store *PRFeedbackStore
+
+	// agentLogin is the forge login agents post as, when it is not the
+	// user's; empty when agents post as the user.
+	agentLogin string
+
+	// forgeFor resolves the forge client for a session. Overridable in tests.
+	forgeFor func(*Instance) (forge.Forge, string, string, error)
+	// send queues a prompt to the session's agent. Overridable in tests.
+	send func(*Instance, string) error
+}
+
+// NewPRFeedbackWatcher creates a watcher backed by the given store.
+func NewPRFeedbackWatcher(store *PRFeedbackStore) *PRFeedbackWatcher {
+	return &PRFeedbackWatcher{
+		store:    store,
+		forgeFor: (*Instance).resolveForge,
+		send:     sendPRFeedbackCommand,
+	}
+}
+
+// Restore applies persisted opt-in flags to freshly loaded instances.
+func (w *PRFeedbackWatcher) Restore(instances []*Instance) {
+	for _, inst := range instances {
+		if w.store.IsEnabled(prFeedbackKey(inst)) {
+			inst.SetPRFeedbackEnabled(true)
+		}
+	}
+}
+
+// SetEnabled opts a session in or out of the feedback loop and persists the choice.
+// Opting in baselines the PR's existing reviews and comments as seen, so only
+// feedback that arrives afterwards is delivered to the agent.
+func (w *PRFeedbackWatcher) SetEnabled(ctx context.Context, inst *Instance, enabled bool) error {
+	if enabled && !inst.IsPRFeedbackEnabled() {
+		if err := w.baseline(ctx, inst); err != nil {
+			return fmt.Errorf("baseline PR feedback for session '%s': %w", inst.Title, err)
+		}
+	}
+	inst.SetPRFeedbackEnabled(enabled)
+	return w.store.SetEnabled(prFeedbackKey(inst), enabled)
+}
+
+// baseline marks every review and comment currently on the session's PR as
+// seen. Sessions without a known PR have nothing to baseline.
+func (w *PRFeedbackWatcher) baseline(ctx context.Context, inst *Instance) error {
+	inst.stateMutex.RLock()
+	prNumber := inst.GitHubPRNumber
+	inst.stateMutex.RUnlock()
+	if prNumber == 0 {
+		return nil
+	}
+
+	f, owner, repo, err := w.forgeFor(inst)
+	if err != nil {
+		return err
+	}
+	reviews, err := f.ListReviews(ctx, owner, repo, prNumber)
+	if err != nil {
+		return fmt.Errorf("list reviews: %w", err)
+	}
+	comments, err := f.ListComments(ctx, owner, repo, prNumber)
+	if err != nil {
+		return fmt.Errorf("list comments: %w", err)
+	}
+	reviewIDs := make([]int, 0, len(reviews))
+	for _, r := range reviews {
+		reviewIDs = append(reviewIDs, r.ID)
+	}
+	commentIDs := make([]int, 0, len(comments))
+	for _, c := range comments {
+		commentIDs = append(commentIDs, c.ID)
+	}
+	return w.store.MarkSeen(prFeedbackKey(inst), commentIDs, reviewIDs)
+}
+
+// SetAgentLogin records the forge login agents post PR comments as, so their
+// comments are skipped by author as well as by signature.
+func (w *PRFeedbackWatcher) SetAgentLogin(login string) {
+	w.agentLogin = strings.TrimPrefix(strings.TrimSpace(login), "@")
+}
+
+// Check collects unhandled feedback for the session's PR and, if there is any,
+// delivers it to the agent. Returns the delivered batch (nil when nothing was sent).
+func (w *PRFeedbackWatcher) Check(ctx context.Context, inst *Instance) (*PRFeedback, error) {
+	if !inst.IsPRFeedbackEnabled() {
+		return nil, nil
+	}
+	inst.stateMutex.RLock()
+	prNumber := inst.GitHubPRNumber
+	inst.stateMutex.RUnlock()
+	if prNumber == 0 {
+		return nil, nil
+	}
+
+	f, owner, repo, err := w.forgeFor(inst)
+	if err != nil {
+		return nil, err
+	}
+	feedback, err := w.collect(ctx, inst, f, owner, repo, prNumber)
+	if err != nil || feedback.Empty() {
+		return nil, err
+	}
+
+	if err := w.send(inst, BuildPRFeedbackPrompt(feedback)); err != nil {
+		return nil, fmt.Errorf("queue PR feedback for session '%s': %w", inst.Title, err)
+	}
+
+	commentIDs := make([]int, 0, len(feedback.Comments))
+	for _, c := range feedback.Comments {
+		commentIDs = append(commentIDs, c.ID)
+	}
+	reviewIDs := make([]int, 0, len(feedback.Reviews))
+	for _, r := range feedback.Reviews {
+		reviewIDs = append(reviewIDs, r.ID)
+	}
+	if err := w.store.MarkHandled(prFeedbackKey(inst), commentIDs, reviewIDs); err != nil {
+		log.Warn("PR feedback: failed to mark feedback handled", "session", inst.Title, "err", err)
+	}
+
+	log.Info("PR feedback: queued review feedback for agent", "session", inst.Title, "pr", prNumber,
+		"reviews", len(reviewIDs), "comments", len(commentIDs))
+	return feedback, nil
+}
+
+// collect fetches reviews and comments and keeps the ones not yet delivered.
+// Only "changes requested" reviews and inline review comments are considered;
+// the agent's own replies are skipped (see isAgentComment).
+func (w *PRFeedbackWatcher) collect(ctx context.Context, inst *Instance, f forge.Forge, owner, repo string, number int) (*PRFeedback, error) {
+	handledComments, handledReviews := w.store.Handled(prFeedbackKey(inst))
+
+	reviews, err := f.ListReviews(ctx, owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("list reviews: %w", err)
+	}
+	comments, err := f.ListComments(ctx, owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("list comments: %w", err)
+	}
+
+	feedback := &PRFeedback{Owner: owner, Repo: repo, Number: number}
+	for _, r := range reviews {
+		if r.State != forge.ReviewChangesRequested || handledReviews[r.ID] {
+			continue
+		}
+		feedback.Reviews = append(feedback.Reviews, r)
+	}
+	for _, c := range comments {
+		if !c.IsReview || handledComments[c.ID] || w.isAgentComment(c) {
+			continue
+		}
+		feedback.Comments = append(feedback.Comments, c)
+	}
+	sort.SliceStable(feedback.Comments, func(a, b int) bool {
+		ca, cb := feedback.Comments[a], feedback.Comments[b]
+		if ca.Path != cb.Path {
+			return ca.Path < cb.Path
+		}
+		return ca.Line < cb.Line
+	})
+	return feedback, nil
+}
+
+// isAgentComment reports whether an agent wrote c: it carries the agent
+// signature or comes from the configured agent login. The PR author is not
+// a signal, since in a single-account setup the user reviewing the PR is
+// its author too.
+func (w *PRFeedbackWatcher) isAgentComment(c forge.PRComment) bool {
+	if strings.Contains(c.Body, prFeedbackAgentSignature) {
+		return true
+	}
+	return w.agentLogin != "" && strings.EqualFold(c.Author, w.agentLogin)
+}
+
+// BuildPRFeedbackPrompt renders a feedback batch as an agent prompt: each
+// "changes requested" review body, then each inline comment with its file/line
+// and the tail of the diff hunk it is anchored to.
+func BuildPRFeedbackPrompt(f *PRFeedback) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "New code review feedback arrived on PR #%d (%s/%s).\n", f.Number, f.Owner, f.Repo)
+	b.WriteString("Address each item below, commit the fixes, and push the branch. ")
+	b.WriteString("If you disagree with a comment, explain why instead of changing the code.\n")
+	fmt.Fprintf(&b, "End every comment or reply you post on the PR with %s so it is not mistaken for review feedback.\n", prFeedbackAgentSignature)
+
+	for _, r := range f.Reviews {
+		fmt.Fprintf(&b, "\n## Changes requested by @%s\n", r.Author)
+		if body := strings.TrimSpace(r.Body); body != "" {
+			b.WriteString(body)
+			b.WriteString("\n")
+		}
+	}
+
+	for _, c := range f.Comments {
+		location := c.Path
+		if c.Line > 0 {
+			location = fmt.Sprintf("%s:%d", c.Path, c.Line)
+		}
+		fmt.Fprintf(&b, "\n## %s (@%s)\n", location, c.Author)
+		if hunk := tailLines(strings.TrimRight(c.DiffHunk, "\n"), prFeedbackHunkLines); hunk != "" {
+			b.WriteString("```diff\n")
+			b.WriteString(hunk)
+			b.WriteString("\n```\n")
+		}
+		b.WriteString(strings.TrimSpace(c.Body))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// tailLines returns the last n lines of s.
+func tailLines(s string, n int) string {
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sendPRFeedbackCommand queues the prompt on the session's Claude controller.
+func sendPRFeedbackCommand(inst *Instance, prompt string) error {
+	ctrl := inst.GetController()
+	if ctrl == nil {
+		return fmt.Errorf("session has no running controller")
+	}
+	_, err := ctrl.SendCommand(prompt, prFeedbackCommandPriority)
+	return err
+}
@@ -0,0 +1,167 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/forge"
+)
+
+// feedbackForge serves canned reviews and comments; other Forge methods are unused.
+type feedbackForge struct {
+	forge.Forge
+	reviews  []forge.Review
+	comments []forge.PRComment
+}
+
+func (f *feedbackForge) ListReviews(context.Context, string, string, int) ([]forge.Review, error) {
+	return f.reviews, nil
+}
+
+func (f *feedbackForge) ListComments(context.Context, string, string, int) ([]forge.PRComment, error) {
+	return f.comments, nil
+}
+
+func newFeedbackWatcherForTest(t *testing.T, f *feedbackForge) (*PRFeedbackWatcher, *[]string) {
+	t.Helper()
+	store, err := NewPRFeedbackStore(filepath.Join(t.TempDir(), "pr_feedback.json"))
+	require.NoError(t, err)
+	w := NewPRFeedbackWatcher(store)
+	w.forgeFor = func(*Instance) (forge.Forge, string, string, error) { return f, "acme", "widgets", nil }
+	var sent []string
+	w.send = func(_ *Instance, prompt string) error {
+		sent = append(sent, prompt)
+		return nil
+	}
+	return w, &sent
+}
+
+func TestPRFeedbackWatcher_BatchesAndMarksHandled(t *testing.T) {
+	f := &feedbackForge{}
+	w, sent := newFeedbackWatcherForTest(t, f)
+	inst := &Instance{Title: "retry", UUID: "u-1", GitHubPRNumber: 42}
+	w.SetAgentLogin("@squad-bot")
+	f.reviews = []forge.Review{
+		{ID: 1, Author: "bob", State: forge.ReviewApproved},
+		{ID: 2, Author: "alice", State: forge.ReviewChangesRequested, Body: "Retry loop needs a cap."},
+	}
+	f.comments = []forge.PRComment{
+		{ID: 10, Author: "carol", Body: "Nice work overall."},
+		{ID: 11, Author: "alice", Body: "Use a constant here.", Path: "uploader/retry.go", Line: 37, IsReview: true,
+			DiffHunk: "@@ -30,6 +30,10 @@\n+\tfor i := 0; i < 5; i++ {"},
+		{ID: 12, Author: "octocat", Body: "Done.\n\n" + prFeedbackAgentSignature, Path: "uploader/retry.go", Line: 37, IsReview: true},
+		{ID: 14, Author: "Squad-Bot", Body: "Capped at 5.", Path: "uploader/retry.go", Line: 37, IsReview: true},
+		// The PR author reviewing their own PR, as in a single-account setup.
+		{ID: 15, Author: "octocat", Body: "Log the attempt number.", Path: "uploader/retry.go", Line: 40, IsReview: true},
+	}
+
+	batch, err := w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	assert.Nil(t, batch, "sessions that have not opted in are ignored")
+	assert.Empty(t, *sent)
+
+	// Opt in before the feedback arrived so it is not baselined away.
+	reviews, comments := f.reviews, f.comments
+	f.reviews, f.comments = nil, nil
+	require.NoError(t, w.SetEnabled(context.Background(), inst, true))
+	f.reviews, f.comments = reviews, comments
+	batch, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Reviews, 1)
+	require.Len(t, batch.Comments, 2, "issue comments and the agent's replies are skipped")
+	assert.Equal(t, 11, batch.Comments[0].ID)
+	assert.Equal(t, 15, batch.Comments[1].ID, "the PR author's own review comments are feedback")
+
+	require.Len(t, *sent, 1)
+	prompt := (*sent)[0]
+	assert.Contains(t, prompt, "PR #42 (acme/widgets)")
+	assert.Contains(t, prompt, "## Changes requested by @alice\nRetry loop needs a cap.")
+	assert.Contains(t, prompt, "## uploader/retry.go:37 (@alice)\n```diff\n@@ -30,6 +30,10 @@")
+	assert.Contains(t, prompt, prFeedbackAgentSignature, "the prompt asks the agent to sign its replies")
+
+	// Everything was marked handled, so the next tick sends nothing.
+	batch, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+	assert.Len(t, *sent, 1)
+
+	// New comments are delivered on their own.
+	f.comments = append(f.comments, forge.PRComment{ID: 13, Author: "alice", Body: "Typo.", Path: "README.md", Line: 3, IsReview: true})
+	batch, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	assert.Empty(t, batch.Reviews)
+	require.Len(t, batch.Comments, 1)
+	assert.Equal(t, 13, batch.Comments[0].ID)
+}
+
+func TestPRFeedbackWatcher_SendFailureKeepsFeedbackPending(t *testing.T) {
+	f := &feedbackForge{}
+	w, sent := newFeedbackWatcherForTest(t, f)
+	inst := &Instance{Title: "retry", UUID: "u-1", GitHubPRNumber: 42}
+	require.NoError(t, w.SetEnabled(context.Background(), inst, true))
+	f.reviews = []forge.Review{{ID: 2, Author: "alice", State: forge.ReviewChangesRequested}}
+
+	w.send = func(*Instance, string) error { return errors.New("controller not started") }
+	_, err := w.Check(context.Background(), inst)
+	require.Error(t, err)
+
+	w.send = func(_ *Instance, prompt string) error {
+		*sent = append(*sent, prompt)
+		return nil
+	}
+	batch, err := w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	assert.Len(t, *sent, 1)
+}
+
+func TestPRFeedbackWatcher_OptInBaselinesExistingFeedback(t *testing.T) {
+	f := &feedbackForge{
+		reviews:  []forge.Review{{ID: 2, Author: "alice", State: forge.ReviewChangesRequested, Body: "Old review."}},
+		comments: []forge.PRComment{{ID: 11, Author: "alice", Body: "Old comment.", Path: "a.go", Line: 1, IsReview: true}},
+	}
+	w, sent := newFeedbackWatcherForTest(t, f)
+	inst := &Instance{Title: "retry", UUID: "u-1", GitHubPRNumber: 42}
+	require.NoError(t, w.SetEnabled(context.Background(), inst, true))
+	assert.True(t, inst.IsPRFeedbackEnabled())
+
+	batch, err := w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	assert.Nil(t, batch, "feedback from before the opt-in is not delivered")
+	assert.Empty(t, *sent)
+
+	f.comments = append(f.comments, forge.PRComment{ID: 12, Author: "alice", Body: "New comment.", Path: "a.go", Line: 2, IsReview: true})
+	batch, err = w.Check(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	assert.Empty(t, batch.Reviews)
+	require.Len(t, batch.Comments, 1)
+	assert.Equal(t, 12, batch.Comments[0].ID)
+}
+
+func TestPRFeedbackStore_PersistsAcrossReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pr_feedback.json")
+	store, err := NewPRFeedbackStore(path)
+	require.NoError(t, err)
+	require.NoError(t, store.SetEnabled("u-1", true))
+	require.NoError(t, store.MarkHandled("u-1", []int{11}, []int{2}))
+
+	reloaded, err := NewPRFeedbackStore(path)
+	require.NoError(t, err)
+	assert.True(t, reloaded.IsEnabled("u-1"))
+	comments, reviews := reloaded.Handled("u-1")
+	assert.True(t, comments[11])
+	assert.True(t, reviews[2])
+
+	w := NewPRFeedbackWatcher(reloaded)
+	inst := &Instance{Title: "retry", UUID: "u-1"}
+	w.Restore([]*Instance{inst})
+	assert.True(t, inst.IsPRFeedbackEnabled())
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/tstapler/stapler-squad/forge"
 	"github.com/tstapler/stapler-squad/github"
 	"github.com/tstapler/stapler-squad/log"
 )
@@ -17,11 +18,11 @@ import (
 type PRStatusPollerConfig struct {
 	// PollInterval controls how often all sessions are checked.
 	PollInterval time.Duration
-	// ConcurrentFetches limits simultaneous gh CLI calls (respects secondary rate limits).
+	// ConcurrentFetches limits simultaneous forge API calls (respects secondary rate limits).
 	ConcurrentFetches int
-	// CallTimeout is the maximum time for a single gh API call.
+	// CallTimeout is the maximum time for a single forge API call.
 	CallTimeout time.Duration
-	// AuthCacheDuration controls how long a successful auth check is cached.
+	// AuthCacheDuration is how long a forge is skipped after it rejected our credentials.
 	AuthCacheDuration time.Duration
 	// NoPRBackoff is how long to wait before re-checking a session after ErrNoPR.
 	// Zero disables the backoff (always re-check).
@@ -39,9 +40,10 @@ func DefaultPRStatusPollerConfig() PRStatusPollerConfig {
 	}
 }
 
-// PRStatusPoller polls GitHub PR status for all sessions at a shared interval.
-// Uses a single workspace-level ticker (not per-session goroutines) and an ETag
-// cache so unchanged PRs return HTTP 304 and cost zero rate-limit quota.
+// PRStatusPoller polls PR status for all sessions at a shared interval through
+// each session's forge (GitHub, GitLab or Gitea). Uses a single workspace-level
+// ticker (not per-session goroutines) and, on github.com, an ETag cache so
+// unchanged PRs return HTTP 304 and cost zero rate-limit quota.
 type PRStatusPoller struct {
 	instances []*Instance
 	storage   *Storage
@@ -52,13 +54,24 @@ type PRStatusPoller struct {
 	// Intended for EventBus notification; injected from the server layer.
 	onUpdated func(*Instance)
 
-	// Cached auth check state.
-	authOK        bool
-	authCheckedAt time.Time
+	// feedback delivers new review comments to opted-in sessions. Optional.
+	feedback *PRFeedbackWatcher
+	// ciWatcher reports failing CI checks and their logs. Optional.
+	ciWatcher *CIFailureWatcher
+
+	// forgeFor resolves the forge client for a session. Overridable in tests.
+	forgeFor func(*Instance) (forge.Forge, string, string, error)
+
+	// authFailedUntil pauses polling of a forge kind after it returned an
+	// auth error, so a missing token is not retried on every tick.
+	authFailedUntil map[github.ForgeKind]time.Time
 
 	// Pause polling when rate limited.
 	rateLimitedUntil time.Time
 
+	// intervalChanged wakes pollLoop to reset its ticker after SetPollInterval.
+	intervalChanged chan struct{}
+
 	// noPRPollAfter tracks the earliest time at which we should re-check a
 	// session that had no PR on the previous poll. Keyed by session title.
 	// Guarded by mu.
@@ -78,11 +91,14 @@ func NewPRStatusPoller(storage *Storage) *PRStatusPoller {
 // NewPRStatusPollerWithConfig creates a poller with custom configuration.
 func NewPRStatusPollerWithConfig(storage *Storage, config PRStatusPollerConfig) *PRStatusPoller {
 	return &PRStatusPoller{
-		instances:     make([]*Instance, 0),
-		storage:       storage,
-		config:        config,
-		etagCache:     github.NewETagCache(),
-		noPRPollAfter: make(map[string]time.Time),
+		instances:       make([]*Instance, 0),
+		storage:         storage,
+		config:          config,
+		etagCache:       github.NewETagCache(),
+		forgeFor:        (*Instance).resolveForge,
+		authFailedUntil: make(map[github.ForgeKind]time.Time),
+		noPRPollAfter:   make(map[string]time.Time),
+		intervalChanged: make(chan struct{}, 1),
 	}
 }
 
@@ -122,6 +138,42 @@ func (p *PRStatusPoller) SetOnUpdated(fn func(*Instance)) {
 	p.onUpdated = fn
 }
 
+// SetFeedbackWatcher enables the review-comment feedback loop for opted-in sessions.
+func (p *PRStatusPoller) SetFeedbackWatcher(w *PRFeedbackWatcher) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.feedback = w
+}
+
+// SetCIWatcher enables the CI failure watcher for sessions with an open PR.
+func (p *PRStatusPoller) SetCIWatcher(w *CIFailureWatcher) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ciWatcher = w
+}
+
+// SetPollInterval changes how often all sessions are checked. A running
+// poller restarts its ticker with the new interval. Non-positive values are
+// ignored.
+func (p *PRStatusPoller) SetPollInterval(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	p.mu.Lock()
+	p.config.PollInterval = d
+	p.mu.Unlock()
+	select {
+	case p.intervalChanged <- struct{}{}:
+	default:
+	}
+}
+
+func (p *PRStatusPoller) pollInterval() time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.config.PollInterval
+}
+
 // Start begins the polling loop. Safe to call multiple times; subsequent calls are no-ops.
 func (p *PRStatusPoller) Start(ctx context.Context) {
 	p.mu.Lock()
@@ -130,11 +182,12 @@ func (p *PRStatusPoller) Start(ctx context.Context) {
 		return
 	}
 	p.ctx, p.cancel = context.WithCancel(ctx)
+	interval := p.config.PollInterval
 	p.mu.Unlock()
 
 	p.wg.Add(1)
 	go p.pollLoop()
-	log.Info("PR status poller started", "interval", p.config.PollInterval, "concurrency", p.config.ConcurrentFetches)
+	log.Info("PR status poller started", "interval", interval, "concurrency", p.config.ConcurrentFetches)
 }
 
 // Stop gracefully shuts down the poller and waits for in-flight requests.
@@ -151,7 +204,7 @@ func (p *PRStatusPoller) Stop() {
 // pollLoop runs the main ticker loop.
 func (p *PRStatusPoller) pollLoop() {
 	defer p.wg.Done()
-	ticker := time.NewTicker(p.config.PollInterval)
+	ticker := time.NewTicker(p.pollInterval())
 	defer ticker.Stop()
 
 	// Run an immediate check so sessions show real status without waiting a
@@ -162,6 +215,8 @@ func (p *PRStatusPoller) pollLoop() {
 		select {
 		case <-p.ctx.Done():
 			return
+		case <-p.intervalChanged:
+			ticker.Reset(p.pollInterval())
 		case <-ticker.C:
 			p.checkAllSessions()
 		}
@@ -181,10 +236,6 @@ func (p *PRStatusPoller) checkAllSessions() {
 		return
 	}
 
-	if !p.isAuthOK() {
-		return
-	}
-
 	sem := make(chan struct{}, p.config.ConcurrentFetches)
 	var wg sync.WaitGroup
 
@@ -197,10 +248,6 @@ func (p *PRStatusPoller) checkAllSessions() {
 	p.mu.RUnlock()
 
 	for _, inst := range instances {
-		if inst.GitHubOwner == "" || inst.GitHubRepo == "" {
-			continue // no GitHub info for this session
-		}
-
 		inst.stateMutex.RLock()
 		isTerminal := inst.GitHubPRStatusTerminal
 		isFork := inst.GitHubIsFork
@@ -231,41 +278,34 @@ func (p *PRStatusPoller) checkAllSessions() {
 	wg.Wait()
 }
 
-// isAuthOK returns true if gh auth check passes, using a time-based cache.
-func (p *PRStatusPoller) isAuthOK() bool {
+// authBackedOff reports whether kind is still paused after an auth error.
+func (p *PRStatusPoller) authBackedOff(kind github.ForgeKind) bool {
 	p.mu.RLock()
-	cached := p.authOK && time.Since(p.authCheckedAt) < p.config.AuthCacheDuration
-	p.mu.RUnlock()
-	if cached {
-		return true
-	}
-
-	if err := github.CheckGHAuth(); err != nil {
-		log.Warn("PR status poller: github auth unavailable", "err", err)
-		p.mu.Lock()
-		p.authOK = false
-		p.authCheckedAt = time.Now()
-		p.mu.Unlock()
-		return false
-	}
-
-	p.mu.Lock()
-	p.authOK = true
-	p.authCheckedAt = time.Now()
-	p.mu.Unlock()
-	return true
+	defer p.mu.RUnlock()
+	return time.Now().Before(p.authFailedUntil[kind])
 }
 
 // fetchAndUpdatePRStatus fetches fresh PR status for one instance and applies it.
 func (p *PRStatusPoller) fetchAndUpdatePRStatus(inst *Instance) {
+	f, owner, repo, err := p.forgeFor(inst)
+	if err != nil {
+		// Not a git repository or no recognised remote; back off like a
+		// branch without a PR so the remote is not re-resolved every tick.
+		log.Debug("PR status poller: no forge for session", "session", inst.Title, "err", err)
+		p.applyNoPRBackoff(inst)
+		return
+	}
+	kind := f.Kind()
+	if p.authBackedOff(kind) {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(p.ctx, p.config.CallTimeout)
 	defer cancel()
 
 	inst.stateMutex.RLock()
 	prNumber := inst.GitHubPRNumber
 	branch := inst.Branch
-	owner := inst.GitHubOwner
-	repo := inst.GitHubRepo
 	inst.stateMutex.RUnlock()
 
 	// Auto-discovery: find PR for branch when PR number not yet known
@@ -273,17 +313,17 @@ func (p *PRStatusPoller) fetchAndUpdatePRStatus(inst *Instance) {
 		if branch == "" {
 			return
 		}
-		prInfo, err := github.GetPRForBranch(ctx, owner, repo, branch)
+		prInfo, err := f.GetPRForBranch(ctx, owner, repo, branch)
 		if err != nil {
-			if errors.Is(err, github.ErrNoPR) {
+			if errors.Is(err, forge.ErrNoPR) {
 				// No PR exists yet for this branch
 				p.applyNoPR(inst)
 				return
 			}
-			if p.handleFetchError(err) {
+			if p.handleFetchError(kind, err) {
 				return // rate limit or auth error handled
 			}
-			log.Warn("PR status poller: PR discovery failed", "session", inst.Title, "owner", owner, "repo", repo, "branch", branch, "err", err)
+			log.Warn("PR status poller: PR discovery failed", "session", inst.Title, "forge", kind, "owner", owner, "repo", repo, "branch", branch, "err", err)
 			return
 		}
 		// Persist discovered PR number and clear no-PR backoff.
@@ -299,16 +339,24 @@ func (p *PRStatusPoller) fetchAndUpdatePRStatus(inst *Instance) {
 			}
 		}
 		p.applyPRUpdate(inst, prInfo)
+		p.checkFeedback(kind, inst)
+		p.checkCI(kind, inst)
 		return
 	}
 
-	// Conditional fetch using ETag cache (304 = no change)
-	prInfo, changed, err := github.GetPRInfoConditional(ctx, owner, repo, prNumber, p.etagCache)
+	// Conditional fetch using ETag cache where the forge supports it (304 = no change)
+	var prInfo *forge.PRInfo
+	changed := true
+	if cg, ok := f.(forge.ConditionalGetter); ok {
+		prInfo, changed, err = cg.GetPRConditional(ctx, owner, repo, prNumber, p.etagCache)
+	} else {
+		prInfo, err = f.GetPR(ctx, owner, repo, prNumber)
+	}
 	if err != nil {
-		if p.handleFetchError(err) {
+		if p.handleFetchError(kind, err) {
 			return
 		}
-		log.Warn("PR status poller: failed to fetch PR", "pr", prNumber, "session", inst.Title, "err", err)
+		log.Warn("PR status poller: failed to fetch PR", "pr", prNumber, "session", inst.Title, "forge", kind, "err", err)
 		return
 	}
 
@@ -317,28 +365,80 @@ func (p *PRStatusPoller) fetchAndUpdatePRStatus(inst *Instance) {
 		inst.stateMutex.Lock()
 		inst.LastPRStatusCheck = time.Now()
 		inst.stateMutex.Unlock()
+		// Review comments do not always bump the PR's ETag, so still look for feedback.
+		p.checkFeedback(kind, inst)
+		p.checkCI(kind, inst)
 		return
 	}
 
 	p.applyPRUpdate(inst, prInfo)
+	p.checkFeedback(kind, inst)
+	p.checkCI(kind, inst)
 }
 
-// handleFetchError inspects an error and updates poller state for rate limits / auth failures.
-// Returns true if the error requires aborting the current session fetch.
-func (p *PRStatusPoller) handleFetchError(err error) bool {
+// checkFeedback runs the review-comment feedback loop for an opted-in session
+// whose PR is still open.
+func (p *PRStatusPoller) checkFeedback(kind github.ForgeKind, inst *Instance) {
+	p.mu.RLock()
+	feedback := p.feedback
+	p.mu.RUnlock()
+	if feedback == nil || !inst.IsPRFeedbackEnabled() {
+		return
+	}
+	inst.stateMutex.RLock()
+	terminal := inst.GitHubPRStatusTerminal
+	inst.stateMutex.RUnlock()
+	if terminal {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(p.ctx, p.config.CallTimeout)
+	defer cancel()
+	if _, err := feedback.Check(ctx, inst); err != nil {
+		if p.handleFetchError(kind, err) {
+			return
+		}
+		log.Warn("PR status poller: review feedback check failed", "session", inst.Title, "err", err)
+	}
+}
+
+// checkCI reconciles the session's CI failure state after its PR status was
+// refreshed. Terminal PRs have their failure state cleared by the watcher.
+func (p *PRStatusPoller) checkCI(kind github.ForgeKind, inst *Instance) {
+	p.mu.RLock()
+	watcher := p.ciWatcher
+	p.mu.RUnlock()
+	if watcher == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(p.ctx, p.config.CallTimeout)
+	defer cancel()
+	if _, err := watcher.Check(ctx, inst); err != nil {
+		if p.handleFetchError(kind, err) {
+			return
+		}
+		log.Warn("PR status poller: CI failure check failed", "session", inst.Title, "err", err)
+	}
+}
+
+// handleFetchError inspects an error from a kind forge and updates poller state
+// for rate limits / auth failures. Returns true if the error requires aborting
+// the current session fetch.
+func (p *PRStatusPoller) handleFetchError(kind github.ForgeKind, err error) bool {
 	msg := err.Error()
 	if strings.Contains(msg, "rate limit") || strings.Contains(msg, "429") {
-		log.Warn("PR status poller: github rate limit hit, pausing for 60s")
+		log.Warn("PR status poller: rate limit hit, pausing for 60s", "forge", kind)
 		p.mu.Lock()
 		p.rateLimitedUntil = time.Now().Add(60 * time.Second)
 		p.mu.Unlock()
 		return true
 	}
-	if strings.Contains(msg, "401") || strings.Contains(msg, "Unauthorized") {
-		log.Warn("PR status poller: github auth error, invalidating auth cache")
+	if strings.Contains(msg, "401") || strings.Contains(msg, "Unauthorized") ||
+		strings.Contains(msg, "auth error") {
+		log.Warn("PR status poller: auth error, pausing forge", "forge", kind, "for", p.config.AuthCacheDuration)
 		p.mu.Lock()
-		p.authOK = false
-		p.authCheckedAt = time.Now()
+		p.authFailedUntil[kind] = time.Now().Add(p.config.AuthCacheDuration)
 		p.mu.Unlock()
 		return true
 	}
@@ -348,16 +448,21 @@ func (p *PRStatusPoller) handleFetchError(err error) bool {
 // applyNoPR sets the session to no_pr state (branch has no PR yet) and
 // schedules a backoff so the branch is not re-queried every poll tick.
 func (p *PRStatusPoller) applyNoPR(inst *Instance) {
+	p.applyNoPRBackoff(inst)
+	p.applyPRUpdate(inst, nil)
+}
+
+// applyNoPRBackoff delays the next poll of inst by NoPRBackoff.
+func (p *PRStatusPoller) applyNoPRBackoff(inst *Instance) {
 	if p.config.NoPRBackoff > 0 {
 		p.mu.Lock()
 		p.noPRPollAfter[inst.Title] = time.Now().Add(p.config.NoPRBackoff)
 		p.mu.Unlock()
 	}
-	p.applyPRUpdate(inst, nil)
 }
 
 // applyPRUpdate derives priority from prInfo (nil = no_pr) and persists the result.
-func (p *PRStatusPoller) applyPRUpdate(inst *Instance, prInfo *github.PRInfo) {
+func (p *PRStatusPoller) applyPRUpdate(inst *Instance, prInfo *forge.PRInfo) {
 	priority := string(github.DerivePRPriority(prInfo))
 	terminal := github.IsTerminal(github.PRPriority(priority))
 
@@ -0,0 +1,114 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/forge"
+	"github.com/tstapler/stapler-squad/github"
+)
+
+// pollerForge serves a canned PR for GitLab-style polling; other Forge methods are unused.
+type pollerForge struct {
+	forge.Forge
+	kind     github.ForgeKind
+	pr       *forge.PRInfo
+	err      error
+	branches []string
+	gets     int
+}
+
+func (f *pollerForge) Kind() github.ForgeKind { return f.kind }
+
+func (f *pollerForge) GetPRForBranch(_ context.Context, _, _, branch string) (*forge.PRInfo, error) {
+	f.branches = append(f.branches, branch)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.pr == nil {
+		return nil, forge.ErrNoPR
+	}
+	return f.pr, nil
+}
+
+func (f *pollerForge) GetPR(context.Context, string, string, int) (*forge.PRInfo, error) {
+	f.gets++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.pr, nil
+}
+
+func newPollerForTest(forges map[string]*pollerForge) *PRStatusPoller {
+	p := NewPRStatusPoller(nil)
+	p.ctx = context.Background()
+	p.forgeFor = func(inst *Instance) (forge.Forge, string, string, error) {
+		f, ok := forges[inst.Title]
+		if !ok {
+			return nil, "", "", errors.New("no remote")
+		}
+		return f, "platform/infra", "deploy", nil
+	}
+	return p
+}
+
+func TestPRStatusPoller_PollsNonGitHubForges(t *testing.T) {
+	gitlab := &pollerForge{kind: github.ForgeGitLab, pr: &forge.PRInfo{Number: 7, State: "OPEN", CheckConclusion: "FAILURE"}}
+	p := newPollerForTest(map[string]*pollerForge{"mr": gitlab})
+	// No GitHubOwner/GitHubRepo: the session's origin is a GitLab remote.
+	inst := &Instance{Title: "mr", Branch: "fix-deploy"}
+	p.SetInstances([]*Instance{inst, {Title: "scratch", Branch: "main"}})
+
+	p.checkAllSessions()
+	assert.Equal(t, []string{"fix-deploy"}, gitlab.branches)
+	assert.Equal(t, 7, inst.GitHubPRNumber)
+	assert.Equal(t, "OPEN", inst.GitHubPRState)
+	assert.Contains(t, p.noPRPollAfter, "scratch", "a session without a forge is backed off")
+
+	// Once the PR number is known the forge's GetPR is used.
+	p.checkAllSessions()
+	assert.Equal(t, 1, gitlab.gets)
+}
+
+func TestPRStatusPoller_AuthErrorPausesOnlyThatForge(t *testing.T) {
+	gitlab := &pollerForge{kind: github.ForgeGitLab, err: errors.New("GitLab API: unauthorized (401) – check the API token")}
+	gitea := &pollerForge{kind: github.ForgeGitea, pr: &forge.PRInfo{Number: 3, State: "OPEN"}}
+	p := newPollerForTest(map[string]*pollerForge{"a": gitlab, "b": gitea})
+	p.SetInstances([]*Instance{
+		{Title: "a", Branch: "x", GitHubPRNumber: 1},
+		{Title: "b", Branch: "y", GitHubPRNumber: 3},
+	})
+
+	p.checkAllSessions()
+	require.True(t, p.authBackedOff(github.ForgeGitLab))
+	assert.False(t, p.authBackedOff(github.ForgeGitea))
+
+	p.checkAllSessions()
+	assert.Equal(t, 1, gitlab.gets, "GitLab is paused after its auth error")
+	assert.Equal(t, 2, gitea.gets)
+}
+
+func TestPRStatusPoller_SetPollIntervalResetsRunningTicker(t *testing.T) {
+	p := NewPRStatusPollerWithConfig(nil, PRStatusPollerConfig{PollInterval: time.Hour, ConcurrentFetches: 1, CallTimeout: time.Second})
+	polls := make(chan struct{}, 16)
+	p.forgeFor = func(*Instance) (forge.Forge, string, string, error) {
+		polls <- struct{}{}
+		return nil, "", "", errors.New("no remote")
+	}
+	p.SetInstances([]*Instance{{Title: "s", Branch: "main"}})
+	p.Start(context.Background())
+	defer p.Stop()
+
+	<-polls // immediate check on start
+	p.SetPollInterval(10 * time.Millisecond)
+	select {
+	case <-polls:
+	case <-time.After(5 * time.Second):
+		t.Fatal("poller kept the old interval after SetPollInterval")
+	}
+}
@@ -1,24 +1,74 @@
 package session
 
 import (
+	"context"
 	"fmt"
+	"time"
+
+	"github.com/tstapler/stapler-squad/forge"
 	"github.com/tstapler/stapler-squad/github"
 	"github.com/tstapler/stapler-squad/log"
 )
 
 // PR Tracking Methods for Instance
-// These methods provide GitHub PR lifecycle management for sessions created from PR URLs
+// These methods provide PR lifecycle management for sessions created from PR URLs.
+// The forge (GitHub, GitLab or Gitea) is resolved from the repository's origin remote.
+
+// prCallTimeout bounds a single forge API call made on behalf of a session.
+const prCallTimeout = 60 * time.Second
+
+// resolveForge returns the forge client and owner/repo for this session's repository.
+// The forge platform and host come from the origin remote, but the owner/repo
+// recorded when the session was created wins over origin's: for a session
+// checked out from a fork, origin names the fork while the PR lives upstream.
+// Sessions whose origin remote cannot be resolved fall back to GitHub using the
+// recorded owner/repo.
+func (i *Instance) resolveForge() (forge.Forge, string, string, error) {
+	repoPath := i.Path
+	if i.gitManager.HasWorktree() {
+		if worktreePath := i.gitManager.GetWorktreePath(); worktreePath != "" {
+			repoPath = worktreePath
+		}
+	}
 
-// RefreshPRInfo fetches the latest PR information from GitHub
-// Returns an error if this is not a PR session or if the GitHub API call fails
-func (i *Instance) RefreshPRInfo() (*github.PRInfo, error) {
+	i.stateMutex.RLock()
+	owner, repo := i.GitHubOwner, i.GitHubRepo
+	i.stateMutex.RUnlock()
+
+	f, ref, err := forge.ForRepoPath(repoPath)
+	if err == nil {
+		if owner != "" && repo != "" {
+			return f, owner, repo, nil
+		}
+		return f, ref.Owner, ref.Repo, nil
+	}
+	if owner != "" && repo != "" {
+		f, ghErr := forge.New(github.ForgeGitHub, "github.com")
+		if ghErr != nil {
+			return nil, "", "", ghErr
+		}
+		return f, owner, repo, nil
+	}
+	return nil, "", "", fmt.Errorf("failed to resolve forge for instance '%s': %w", i.Title, err)
+}
+
+// RefreshPRInfo fetches the latest PR information from the session's forge
+// Returns an error if this is not a PR session or if the forge API call fails
+func (i *Instance) RefreshPRInfo() (*forge.PRInfo, error) {
 	if !i.IsPRSession() {
 		return nil, fmt.Errorf("instance '%s' is not a PR session", i.Title)
 	}
 
 	log.Info("refreshing PR info", "session", i.Title, "pr", i.GitHubPRNumber, "owner", i.GitHubOwner, "repo", i.GitHubRepo)
 
-	prInfo, err := github.GetPRInfo(i.GitHubOwner, i.GitHubRepo, i.GitHubPRNumber)
+	f, owner, repo, err := i.resolveForge()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), prCallTimeout)
+	defer cancel()
+
+	prInfo, err := f.GetPR(ctx, owner, repo, i.GitHubPRNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch PR info for instance '%s': %w", i.Title, err)
 	}
@@ -29,15 +79,22 @@ func (i *Instance) RefreshPRInfo() (*github.PRInfo, error) {
 }
 
 // GetPRComments fetches all comments on the PR
-// Returns an error if this is not a PR session or if the GitHub API call fails
-func (i *Instance) GetPRComments() ([]github.PRComment, error) {
+// Returns an error if this is not a PR session or if the forge API call fails
+func (i *Instance) GetPRComments() ([]forge.PRComment, error) {
 	if !i.IsPRSession() {
 		return nil, fmt.Errorf("instance '%s' is not a PR session", i.Title)
 	}
 
 	log.Info("fetching PR comments", "session", i.Title, "pr", i.GitHubPRNumber, "owner", i.GitHubOwner, "repo", i.GitHubRepo)
 
-	comments, err := github.GetPRComments(i.GitHubOwner, i.GitHubRepo, i.GitHubPRNumber)
+	f, owner, repo, err := i.resolveForge()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), prCallTimeout)
+	defer cancel()
+
+	comments, err := f.ListComments(ctx, owner, repo, i.GitHubPRNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch PR comments for instance '%s': %w", i.Title, err)
 	}
@@ -47,7 +104,7 @@ func (i *Instance) GetPRComments() ([]github.PRComment, error) {
 	return comments, nil
 }
 
-// GetPRDiff fetches the diff for the PR
+// GetPRDiff fetches the diff for the PR (GitHub only; uses the gh CLI)
 // Returns an error if this is not a PR session or if the GitHub API call fails
 func (i *Instance) GetPRDiff() (string, error) {
 	if !i.IsPRSession() {
@@ -67,7 +124,7 @@ func (i *Instance) GetPRDiff() (string, error) {
 }
 
 // PostComment posts a comment to the PR
-// Returns an error if this is not a PR session or if the GitHub API call fails
+// Returns an error if this is not a PR session or if the forge API call fails
 func (i *Instance) PostComment(body string) error {
 	if !i.IsPRSession() {
 		return fmt.Errorf("instance '%s' is not a PR session", i.Title)
@@ -79,7 +136,14 @@ func (i *Instance) PostComment(body string) error {
 
 	log.Info("posting comment to PR", "pr", i.GitHubPRNumber, "session", i.Title)
 
-	if err := github.PostPRComment(i.GitHubOwner, i.GitHubRepo, i.GitHubPRNumber, body); err != nil {
+	f, owner, repo, err := i.resolveForge()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), prCallTimeout)
+	defer cancel()
+
+	if err := f.PostComment(ctx, owner, repo, i.GitHubPRNumber, body); err != nil {
 		return fmt.Errorf("failed to post comment to PR for instance '%s': %w", i.Title, err)
 	}
 
@@ -90,7 +154,7 @@ func (i *Instance) PostComment(body string) error {
 
 // MergePR merges the PR using the specified merge method
 // method can be: "merge", "squash", or "rebase"
-// Returns an error if this is not a PR session or if the GitHub API call fails
+// Returns an error if this is not a PR session or if the forge API call fails
 func (i *Instance) MergePR(method string) error {
 	if !i.IsPRSession() {
 		return fmt.Errorf("instance '%s' is not a PR session", i.Title)
@@ -108,7 +172,14 @@ func (i *Instance) MergePR(method string) error {
 
 	log.Info("merging PR", "pr", i.GitHubPRNumber, "session", i.Title, "method", method)
 
-	if err := github.MergePR(i.GitHubOwner, i.GitHubRepo, i.GitHubPRNumber, method); err != nil {
+	f, owner, repo, err := i.resolveForge()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), prCallTimeout)
+	defer cancel()
+
+	if err := f.MergePR(ctx, owner, repo, i.GitHubPRNumber, method); err != nil {
 		return fmt.Errorf("failed to merge PR for instance '%s': %w", i.Title, err)
 	}
 
@@ -118,7 +189,7 @@ func (i *Instance) MergePR(method string) error {
 }
 
 // ClosePR closes the PR without merging
-// Returns an error if this is not a PR session or if the GitHub API call fails
+// Returns an error if this is not a PR session or if the forge API call fails
 func (i *Instance) ClosePR() error {
 	if !i.IsPRSession() {
 		return fmt.Errorf("instance '%s' is not a PR session", i.Title)
@@ -126,7 +197,14 @@ func (i *Instance) ClosePR() error {
 
 	log.Info("closing PR without merging", "pr", i.GitHubPRNumber, "session", i.Title)
 
-	if err := github.ClosePR(i.GitHubOwner, i.GitHubRepo, i.GitHubPRNumber); err != nil {
+	f, owner, repo, err := i.resolveForge()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), prCallTimeout)
+	defer cancel()
+
+	if err := f.ClosePR(ctx, owner, repo, i.GitHubPRNumber); err != nil {
 		return fmt.Errorf("failed to close PR for instance '%s': %w", i.Title, err)
 	}
 
@@ -137,7 +215,7 @@ func (i *Instance) ClosePR() error {
 
 // GeneratePRContextPrompt generates a context prompt for Claude based on PR information
 // This can be used to initialize a Claude Code session with comprehensive PR context
-// Returns an error if this is not a PR session or if the GitHub API call fails
+// Returns an error if this is not a PR session or if the forge API call fails
 func (i *Instance) GeneratePRContextPrompt() (string, error) {
 	if !i.IsPRSession() {
 		return "", fmt.Errorf("instance '%s' is not a PR session", i.Title)
@@ -158,3 +236,47 @@ func (i *Instance) GeneratePRContextPrompt() (string, error) {
 
 	return prompt, nil
 }
+
+// SetPRFeedbackEnabled opts the session in or out of the PR review feedback loop.
+// Persistence is handled by PRFeedbackWatcher.SetEnabled.
+func (i *Instance) SetPRFeedbackEnabled(enabled bool) {
+	i.stateMutex.Lock()
+	defer i.stateMutex.Unlock()
+	i.PRFeedbackEnabled = enabled
+}
+
+// IsPRFeedbackEnabled reports whether new PR review comments are fed to the agent.
+func (i *Instance) IsPRFeedbackEnabled() bool {
+	i.stateMutex.RLock()
+	defer i.stateMutex.RUnlock()
+	return i.PRFeedbackEnabled
+}
+
+// SetCIAutoFixEnabled opts the session in or out of automatic CI fix prompts.
+// Persistence is handled by CIFailureWatcher.SetEnabled.
+func (i *Instance) SetCIAutoFixEnabled(enabled bool) {
+	i.stateMutex.Lock()
+	defer i.stateMutex.Unlock()
+	i.CIAutoFixEnabled = enabled
+}
+
+// IsCIAutoFixEnabled reports whether failing CI logs are fed to the agent automatically.
+func (i *Instance) IsCIAutoFixEnabled() bool {
+	i.stateMutex.RLock()
+	defer i.stateMutex.RUnlock()
+	return i.CIAutoFixEnabled
+}
+
+// SetCIFailure records (or, with an empty summary, clears) failing CI on the session's PR.
+func (i *Instance) SetCIFailure(summary string) {
+	i.stateMutex.Lock()
+	defer i.stateMutex.Unlock()
+	i.ciFailure = summary
+}
+
+// CIFailure returns the failing-CI summary shown in the review queue, or "" when CI is not failing.
+func (i *Instance) CIFailure() string {
+	i.stateMutex.RLock()
+	defer i.stateMutex.RUnlock()
+	return i.ciFailure
+}
@@ -14,16 +14,21 @@ type PTYAccess struct {
 	sessionName string
 	pty         *os.File
 	buffer      *CircularBuffer
+	screen      *TerminalState
 	closed      bool
 }
 
 // NewPTYAccess creates a new PTYAccess wrapper for a PTY file descriptor.
 // The buffer parameter specifies the circular buffer for storing PTY output history.
 func NewPTYAccess(sessionName string, pty *os.File, buffer *CircularBuffer) *PTYAccess {
+	screen := NewTerminalState(24, 80)
+	screen.SetNewlineMode(false)
+	screen.SetScrollbackLimit(DefaultScreenScrollback)
 	return &PTYAccess{
 		sessionName: sessionName,
 		pty:         pty,
 		buffer:      buffer,
+		screen:      screen,
 		closed:      false,
 	}
 }
@@ -90,6 +95,29 @@ func (p *PTYAccess) GetRecentOutput(n int) []byte {
 	return p.buffer.GetRecent(n)
 }
 
+// Screen returns the emulated terminal screen fed by the PTY output. It is
+// the source of truth for what the session currently shows.
+func (p *PTYAccess) Screen() *TerminalState {
+	return p.screen
+}
+
+// recordOutput feeds PTY output into the emulated screen, first matching
+// the screen to the PTY's current window size.
+func (p *PTYAccess) recordOutput(data []byte) {
+	p.mu.RLock()
+	f := p.pty
+	p.mu.RUnlock()
+
+	if f != nil {
+		if rows, cols, err := ptySize(f); err == nil && rows > 0 && cols > 0 {
+			if rows != p.screen.Rows || cols != p.screen.Cols {
+				p.screen.Resize(rows, cols)
+			}
+		}
+	}
+	_ = p.screen.ProcessOutput(data)
+}
+
 // UpdatePTY updates the underlying PTY file descriptor.
 // This is used when the PTY needs to be refreshed (e.g., after detach/reattach).
 func (p *PTYAccess) UpdatePTY(pty *os.File) error {
@@ -0,0 +1,30 @@
+//go:build !windows
+
+package session
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// ptySize returns the PTY's window size. It goes through SyscallConn rather
+// than Fd, which would switch the file to blocking mode and stall reads that
+// rely on the poller (and Close with them).
+func ptySize(f *os.File) (rows, cols int, err error) {
+	conn, err := f.SyscallConn()
+	if err != nil {
+		return 0, 0, err
+	}
+	var ws *unix.Winsize
+	var ioctlErr error
+	if err := conn.Control(func(fd uintptr) {
+		ws, ioctlErr = unix.IoctlGetWinsize(int(fd), unix.TIOCGWINSZ)
+	}); err != nil {
+		return 0, 0, err
+	}
+	if ioctlErr != nil {
+		return 0, 0, ioctlErr
+	}
+	return int(ws.Row), int(ws.Col), nil
+}
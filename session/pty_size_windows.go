@@ -0,0 +1,13 @@
+//go:build windows
+
+package session
+
+import (
+	"errors"
+	"os"
+)
+
+// ptySize is not supported on Windows, where sessions have no Unix PTY.
+func ptySize(f *os.File) (rows, cols int, err error) {
+	return 0, 0, errors.New("pty size is not supported on windows")
+}
@@ -24,6 +24,8 @@ const (
 	ReasonIdle               AttentionReason = "idle"                // Session idle, ready for next task (short idle, expected)
 	ReasonStale              AttentionReason = "stale"               // No output for extended period (may be stuck)
 	ReasonWaitingForUser     AttentionReason = "waiting_for_user"    // Explicitly waiting for user input (detected prompt)
+	ReasonCIFailing          AttentionReason = "ci_failing"          // CI checks failing on the session's PR
+	ReasonResourceLimit      AttentionReason = "resource_limit"      // OOM kill or cgroup resource limit hit
 )
 
 // String returns a human-readable description of the attention reason.
@@ -43,6 +45,10 @@ func (r AttentionReason) String() string {
 		return "Task Complete"
 	case ReasonUncommittedChanges:
 		return "Uncommitted Changes"
+	case ReasonCIFailing:
+		return "CI Failing"
+	case ReasonResourceLimit:
+		return "Resource Limit"
 	default:
 		return string(r)
 	}
@@ -563,7 +569,7 @@ func ReasonToPriority(reason AttentionReason) Priority {
 	switch reason {
 	case ReasonErrorState:
 		return PriorityUrgent
-	case ReasonApprovalPending, ReasonTestsFailing:
+	case ReasonApprovalPending, ReasonTestsFailing, ReasonCIFailing, ReasonResourceLimit:
 		return PriorityHigh
 	case ReasonInputRequired:
 		return PriorityMedium
@@ -0,0 +1,167 @@
+// Package recording records session terminals as asciicast v2 files and
+// exports time ranges of them as shareable clips.
+//
+// Output is captured with tmux pipe-pane into an append-only raw file that the
+// recorder tails, so recording neither attaches a tmux client (which would
+// affect window sizing) nor depends on a browser streaming the session. Each
+// attach starts a segment: a self-contained .cast file whose event times are
+// relative to its header timestamp. Export merges segments into one cast.
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// castVersion is the asciicast format version written and read.
+const castVersion = 2
+
+// Header is the first line of an asciicast v2 file.
+type Header struct {
+	Version       int               `json:"version"`
+	Width         int               `json:"width"`
+	Height        int               `json:"height"`
+	Timestamp     int64             `json:"timestamp,omitempty"`
+	IdleTimeLimit float64           `json:"idle_time_limit,omitempty"`
+	Title         string            `json:"title,omitempty"`
+	Env           map[string]string `json:"env,omitempty"`
+}
+
+// Code identifies the type of an asciicast event.
+type Code string
+
+// Event codes defined by asciicast v2.
+const (
+	CodeOutput Code = "o"
+	CodeInput  Code = "i"
+	CodeResize Code = "r"
+	CodeMarker Code = "m"
+)
+
+// Event is one asciicast event. Time is in seconds since the header timestamp.
+type Event struct {
+	Time float64
+	Code Code
+	Data string
+}
+
+// MarshalJSON encodes the event as asciicast's [time, code, data] array.
+func (e Event) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode([3]interface{}{e.Time, e.Code, e.Data}); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// UnmarshalJSON decodes an asciicast [time, code, data] array.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	var raw [3]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[0], &e.Time); err != nil {
+		return fmt.Errorf("event time: %w", err)
+	}
+	if err := json.Unmarshal(raw[1], &e.Code); err != nil {
+		return fmt.Errorf("event code: %w", err)
+	}
+	if err := json.Unmarshal(raw[2], &e.Data); err != nil {
+		return fmt.Errorf("event data: %w", err)
+	}
+	return nil
+}
+
+// resizeData formats a resize event's data as "COLSxROWS".
+func resizeData(cols, rows int) string {
+	return fmt.Sprintf("%dx%d", cols, rows)
+}
+
+// parseResize parses a resize event's "COLSxROWS" data.
+func parseResize(data string) (cols, rows int, ok bool) {
+	_, err := fmt.Sscanf(data, "%dx%d", &cols, &rows)
+	return cols, rows, err == nil && cols > 0 && rows > 0
+}
+
+// seconds converts d to asciicast's fractional seconds at microsecond precision.
+func seconds(d time.Duration) float64 {
+	return math.Round(d.Seconds()*1e6) / 1e6
+}
+
+// castEncoder writes asciicast lines without HTML escaping, which would only
+// bloat terminal output full of '<' and '&'.
+type castEncoder struct {
+	enc *json.Encoder
+}
+
+func newCastEncoder(w io.Writer) castEncoder {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return castEncoder{enc: enc}
+}
+
+func (c castEncoder) header(h Header) error { return c.enc.Encode(h) }
+func (c castEncoder) event(e Event) error   { return c.enc.Encode(e) }
+
+// WriteCast writes a complete asciicast v2 file.
+func WriteCast(w io.Writer, h Header, events []Event) error {
+	h.Version = castVersion
+	enc := newCastEncoder(w)
+	if err := enc.header(h); err != nil {
+		return err
+	}
+	for _, e := range events {
+		if err := enc.event(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadCast reads an asciicast v2 file. A malformed final line, left by a
+// write interrupted mid-event, is ignored; malformed lines elsewhere are errors.
+func ReadCast(r io.Reader) (Header, []Event, error) {
+	var h Header
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	if !sc.Scan() {
+		if err := sc.Err(); err != nil {
+			return h, nil, err
+		}
+		return h, nil, errors.New("empty cast file")
+	}
+	if err := json.Unmarshal(sc.Bytes(), &h); err != nil {
+		return h, nil, fmt.Errorf("cast header: %w", err)
+	}
+	if h.Version != castVersion {
+		return h, nil, fmt.Errorf("unsupported asciicast version %d", h.Version)
+	}
+
+	var events []Event
+	var badLine error
+	line := 1
+	for sc.Scan() {
+		line++
+		if badLine != nil {
+			return h, nil, badLine
+		}
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			badLine = fmt.Errorf("cast line %d: %w", line, err)
+			continue
+		}
+		events = append(events, e)
+	}
+	return h, events, sc.Err()
+}
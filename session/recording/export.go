@@ -0,0 +1,203 @@
+package recording
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNoRecording is returned when exporting a session with no recorded
+// segments in the requested range.
+var ErrNoRecording = errors.New("no recording for session")
+
+// Marker is a named point in time, such as a checkpoint, added to an export.
+type Marker struct {
+	Time  time.Time
+	Label string
+}
+
+// ExportOptions selects what an export contains.
+type ExportOptions struct {
+	// From and To bound the exported range; zero values leave it open.
+	From, To time.Time
+	// IncludeInput keeps recorded input events; they are dropped by default.
+	IncludeInput bool
+	// IdleTimeLimit caps pauses during playback, in seconds. Zero leaves
+	// pauses as recorded.
+	IdleTimeLimit float64
+	// Markers inside the range are added as marker events.
+	Markers []Marker
+}
+
+// ExportStats describes an exported cast.
+type ExportStats struct {
+	Events   int
+	Duration time.Duration
+}
+
+// timedEvent is an event at an absolute time, used while merging segments.
+type timedEvent struct {
+	at time.Time
+	e  Event
+}
+
+// Export writes the session's recording, merged across segments and limited
+// to the options' range, to w as one asciicast v2 file. Output recorded before
+// From is dropped, so a clip starting mid-segment begins on a partially drawn
+// screen until the program redraws.
+func (m *Manager) Export(w io.Writer, id string, opts ExportOptions) (ExportStats, error) {
+	if m.dir == "" {
+		return ExportStats{}, ErrNoStorage
+	}
+	if !opts.From.IsZero() && !opts.To.IsZero() && !opts.To.After(opts.From) {
+		return ExportStats{}, fmt.Errorf("export range end %s is not after start %s", opts.To.Format(time.RFC3339), opts.From.Format(time.RFC3339))
+	}
+
+	// Flush the active segment so the export includes its latest events.
+	m.mu.Lock()
+	seg := m.active[id]
+	m.mu.Unlock()
+	if seg != nil {
+		seg.rec.Tick(m.now())
+	}
+
+	casts, err := filepath.Glob(filepath.Join(m.sessionDir(id), "*"+castExt))
+	if err != nil {
+		return ExportStats{}, err
+	}
+	sort.Strings(casts)
+
+	var (
+		events     []timedEvent
+		origin     time.Time
+		cols, rows int
+		found      bool
+	)
+	for _, path := range casts {
+		h, segEvents, err := readCastFile(path)
+		if err != nil {
+			return ExportStats{}, fmt.Errorf("read %s: %w", filepath.Base(path), err)
+		}
+		start := time.Unix(h.Timestamp, 0)
+		if !opts.To.IsZero() && !start.Before(opts.To) {
+			break
+		}
+		size := resizeData(h.Width, h.Height)
+		segEvents = append([]Event{{Code: CodeResize, Data: size}}, segEvents...)
+		for _, e := range segEvents {
+			at := start.Add(time.Duration(e.Time * float64(time.Second)))
+			if !opts.To.IsZero() && at.After(opts.To) {
+				break
+			}
+			if at.Before(opts.From) {
+				if c, r, ok := parseResize(e.Data); ok && e.Code == CodeResize {
+					cols, rows = c, r
+				}
+				continue
+			}
+			if !found {
+				found = true
+				origin = at
+				if !opts.From.IsZero() {
+					origin = opts.From
+				}
+				if e.Code == CodeResize {
+					cols, rows, _ = parseResize(e.Data)
+					continue
+				}
+			}
+			if e.Code == CodeInput && !opts.IncludeInput {
+				continue
+			}
+			if _, _, ok := parseResize(e.Data); e.Code == CodeResize && !ok {
+				continue
+			}
+			events = append(events, timedEvent{at: at, e: e})
+		}
+	}
+	if !found {
+		return ExportStats{}, ErrNoRecording
+	}
+	events = dedupeResizes(events, cols, rows)
+
+	for _, mk := range opts.Markers {
+		if mk.Time.Before(origin) || (!opts.To.IsZero() && mk.Time.After(opts.To)) {
+			continue
+		}
+		events = append(events, timedEvent{at: mk.Time, e: Event{Code: CodeMarker, Data: mk.Label}})
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].at.Before(events[j].at) })
+
+	out := make([]Event, len(events))
+	var duration time.Duration
+	for i, te := range events {
+		d := te.at.Sub(origin)
+		out[i] = Event{Time: seconds(d), Code: te.e.Code, Data: te.e.Data}
+		duration = d
+	}
+	h := Header{
+		Width:         cols,
+		Height:        rows,
+		Timestamp:     origin.Unix(),
+		IdleTimeLimit: opts.IdleTimeLimit,
+		Title:         id,
+		Env:           map[string]string{"TERM": "xterm-256color"},
+	}
+	if err := WriteCast(w, h, out); err != nil {
+		return ExportStats{}, err
+	}
+	return ExportStats{Events: len(out), Duration: duration}, nil
+}
+
+// dedupeResizes drops resize events that do not change the size, starting
+// from the header's cols x rows.
+func dedupeResizes(events []timedEvent, cols, rows int) []timedEvent {
+	kept := events[:0]
+	for _, te := range events {
+		if te.e.Code == CodeResize {
+			c, r, _ := parseResize(te.e.Data)
+			if c == cols && r == rows {
+				continue
+			}
+			cols, rows = c, r
+		}
+		kept = append(kept, te)
+	}
+	return kept
+}
+
+// readCastFile reads one segment's cast file.
+func readCastFile(path string) (Header, []Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Header{}, nil, err
+	}
+	defer f.Close()
+	return ReadCast(f)
+}
+
+// Segments returns the start times of a session's recorded segments, oldest
+// first.
+func (m *Manager) Segments(id string) ([]time.Time, error) {
+	if m.dir == "" {
+		return nil, ErrNoStorage
+	}
+	casts, err := filepath.Glob(filepath.Join(m.sessionDir(id), "*"+castExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(casts)
+	starts := make([]time.Time, 0, len(casts))
+	for _, c := range casts {
+		var ns int64
+		if _, err := fmt.Sscanf(strings.TrimSuffix(filepath.Base(c), castExt), "%d", &ns); err == nil {
+			starts = append(starts, time.Unix(0, ns))
+		}
+	}
+	return starts, nil
+}
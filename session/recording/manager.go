@@ -0,0 +1,530 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+)
+
+const (
+	// pollInterval is how often a segment's raw file is tailed. It bounds the
+	// timing precision of recorded output.
+	pollInterval = 100 * time.Millisecond
+	// sizeCheckInterval throttles pane size checks while output is flowing.
+	sizeCheckInterval = time.Second
+	// maxRawBytes is the raw file size at which the pipe is moved to a fresh
+	// file so consumed output does not accumulate on disk.
+	maxRawBytes = 8 << 20
+	// readChunk bounds how much raw output becomes a single event.
+	readChunk = 64 << 10
+
+	settingsFile = "settings.json"
+	castExt      = ".cast"
+	rawExt       = ".raw"
+	// offsetExt names the file next to a raw file holding how much of it has
+	// been recorded, so leftover output is recovered without duplicating it.
+	offsetExt = ".offset"
+)
+
+// ErrNoStorage is returned when recording is requested but the manager has
+// no directory to record into.
+var ErrNoStorage = errors.New("recording storage is not configured")
+
+// Pane is a live terminal whose output can be piped into a recording.
+type Pane interface {
+	// PanePiped reports whether the pane's output is already being piped.
+	PanePiped() (bool, error)
+	// PipePaneOutput pipes the pane's output into shellCommand, replacing any
+	// open pipe. An empty command closes the pipe.
+	PipePaneOutput(shellCommand string) error
+	// PaneSize returns the pane's width and height.
+	PaneSize() (cols, rows int, err error)
+	// PaneSnapshot returns the visible screen with escape codes, used to
+	// start a segment from the current screen rather than a blank one.
+	PaneSnapshot() (string, error)
+}
+
+// Settings is a session's recording opt-in.
+type Settings struct {
+	Enabled      bool      `json:"enabled"`
+	IncludeInput bool      `json:"include_input"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// Options configures redaction. Nil functions store data as-is.
+type Options struct {
+	// NewOutputRedactor returns a stream redactor for one segment's output.
+	NewOutputRedactor func() *secrets.StreamRedactor
+	// RedactInput redacts one line of input.
+	RedactInput func([]byte) []byte
+}
+
+// Manager owns every session's recording settings and active segment.
+// Sessions are keyed by title.
+type Manager struct {
+	dir  string
+	opts Options
+	now  func() time.Time
+
+	mu       sync.Mutex
+	settings map[string]Settings
+	active   map[string]*segment
+}
+
+// NewManager creates a manager recording under dir and loads saved settings.
+// With an empty dir, settings are kept in memory and recording is refused.
+func NewManager(dir string, opts Options) (*Manager, error) {
+	m := &Manager{
+		dir:      dir,
+		opts:     opts,
+		now:      time.Now,
+		settings: make(map[string]Settings),
+		active:   make(map[string]*segment),
+	}
+	if dir == "" {
+		return m, nil
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create recording dir: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read recording dir: %w", err)
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		id, err := url.PathUnescape(e.Name())
+		if err != nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name(), settingsFile))
+		if err != nil {
+			continue
+		}
+		var s Settings
+		if err := json.Unmarshal(data, &s); err != nil {
+			log.Warn("[recording] ignoring unreadable settings", "session", id, "err", err)
+			continue
+		}
+		m.settings[id] = s
+	}
+	return m, nil
+}
+
+// sessionDir returns the directory holding a session's settings and segments.
+func (m *Manager) sessionDir(id string) string {
+	return filepath.Join(m.dir, url.PathEscape(id))
+}
+
+// Settings returns a session's recording settings.
+func (m *Manager) Settings(id string) Settings {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.settings[id]
+}
+
+// Recording reports whether a segment is currently being recorded for id.
+func (m *Manager) Recording(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.active[id] != nil
+}
+
+// SetSettings saves a session's recording settings. Disabling recording stops
+// the active segment; enabling takes effect on the next Sync or Attach.
+func (m *Manager) SetSettings(id string, s Settings) (Settings, error) {
+	if s.Enabled && m.dir == "" {
+		return Settings{}, ErrNoStorage
+	}
+	s.UpdatedAt = m.now()
+	if m.dir != "" {
+		dir := m.sessionDir(id)
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return Settings{}, fmt.Errorf("create session recording dir: %w", err)
+		}
+		data, err := json.Marshal(s)
+		if err != nil {
+			return Settings{}, err
+		}
+		if err := writeFileAtomic(filepath.Join(dir, settingsFile), data); err != nil {
+			return Settings{}, err
+		}
+	}
+
+	m.mu.Lock()
+	m.settings[id] = s
+	seg := m.active[id]
+	if !s.Enabled {
+		delete(m.active, id)
+	}
+	m.mu.Unlock()
+
+	if seg != nil {
+		if s.Enabled {
+			seg.includeInput.Store(s.IncludeInput)
+		} else {
+			seg.stop(true)
+		}
+	}
+	return s, nil
+}
+
+// Attach makes sure an enabled session's pane is being recorded, starting a
+// new segment if it is not attached yet or its pipe was lost (for example
+// because the tmux session was restarted).
+func (m *Manager) Attach(id string, pane Pane) error {
+	m.mu.Lock()
+	s := m.settings[id]
+	seg := m.active[id]
+	m.mu.Unlock()
+	if !s.Enabled {
+		return nil
+	}
+	if m.dir == "" {
+		return ErrNoStorage
+	}
+
+	if seg != nil {
+		piped, err := pane.PanePiped()
+		if err != nil {
+			return fmt.Errorf("check pane pipe: %w", err)
+		}
+		if piped && !seg.stopped() {
+			return nil
+		}
+		m.detach(id, seg, false)
+	}
+
+	seg, err := m.startSegment(id, pane, s)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	m.active[id] = seg
+	m.mu.Unlock()
+	log.Info("[recording] started segment", "session", id, "file", filepath.Base(seg.castPath))
+	return nil
+}
+
+// Detach stops recording a session, closing its pane pipe. Settings are
+// kept, so the next Attach resumes recording.
+func (m *Manager) Detach(id string) {
+	m.mu.Lock()
+	seg := m.active[id]
+	m.mu.Unlock()
+	if seg != nil {
+		m.detach(id, seg, true)
+	}
+}
+
+func (m *Manager) detach(id string, seg *segment, closePipe bool) {
+	m.mu.Lock()
+	if m.active[id] == seg {
+		delete(m.active, id)
+	}
+	m.mu.Unlock()
+	seg.stop(closePipe)
+}
+
+// Sync attaches every enabled session present in panes and detaches enabled
+// sessions that are no longer live.
+func (m *Manager) Sync(panes map[string]Pane) {
+	m.mu.Lock()
+	var enabled, attached []string
+	for id, s := range m.settings {
+		if s.Enabled {
+			enabled = append(enabled, id)
+		}
+	}
+	for id := range m.active {
+		attached = append(attached, id)
+	}
+	m.mu.Unlock()
+
+	for _, id := range enabled {
+		if pane, ok := panes[id]; ok {
+			if err := m.Attach(id, pane); err != nil {
+				log.Warn("[recording] attach failed", "session", id, "err", err)
+			}
+		}
+	}
+	for _, id := range attached {
+		if _, ok := panes[id]; !ok {
+			m.Detach(id)
+		}
+	}
+}
+
+// Run calls Sync with the current panes every interval until ctx is done,
+// then stops all recording.
+func (m *Manager) Run(ctx context.Context, interval time.Duration, panes func() map[string]Pane) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	m.Sync(panes())
+	for {
+		select {
+		case <-ctx.Done():
+			m.Close()
+			return
+		case <-ticker.C:
+			m.Sync(panes())
+		}
+	}
+}
+
+// Input records input sent to a session's terminal when the session records
+// input.
+func (m *Manager) Input(id string, data []byte) {
+	m.mu.Lock()
+	seg := m.active[id]
+	m.mu.Unlock()
+	if seg != nil && seg.includeInput.Load() {
+		seg.rec.Input(m.now(), data)
+	}
+}
+
+// Delete stops recording a session and removes its settings and recordings.
+func (m *Manager) Delete(id string) error {
+	m.Detach(id)
+	m.mu.Lock()
+	delete(m.settings, id)
+	m.mu.Unlock()
+	if m.dir == "" {
+		return nil
+	}
+	return os.RemoveAll(m.sessionDir(id))
+}
+
+// Close stops every active segment and closes their pane pipes.
+func (m *Manager) Close() {
+	m.mu.Lock()
+	segs := make([]*segment, 0, len(m.active))
+	for id, seg := range m.active {
+		segs = append(segs, seg)
+		delete(m.active, id)
+	}
+	m.mu.Unlock()
+	for _, seg := range segs {
+		seg.stop(true)
+	}
+}
+
+// startSegment creates a new cast and raw file, pipes the pane into the raw
+// file and starts tailing it.
+func (m *Manager) startSegment(id string, pane Pane, s Settings) (*segment, error) {
+	dir := m.sessionDir(id)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create session recording dir: %w", err)
+	}
+	start := m.now()
+	base := filepath.Join(dir, strconv.FormatInt(start.UnixNano(), 10))
+	castPath, rawPath := base+castExt, base+rawExt
+
+	cols, rows, err := pane.PaneSize()
+	if err != nil {
+		return nil, fmt.Errorf("read pane size: %w", err)
+	}
+	var out *secrets.StreamRedactor
+	if m.opts.NewOutputRedactor != nil {
+		out = m.opts.NewOutputRedactor()
+	}
+	rec, err := createRecorder(castPath, start, cols, rows, id, out, m.opts.RedactInput)
+	if err != nil {
+		return nil, fmt.Errorf("create cast file: %w", err)
+	}
+	raw, err := os.OpenFile(rawPath, os.O_RDONLY|os.O_CREATE, 0o600)
+	if err != nil {
+		rec.Close(start)
+		return nil, fmt.Errorf("create raw file: %w", err)
+	}
+	if err := pane.PipePaneOutput(appendCommand(rawPath)); err != nil {
+		raw.Close()
+		rec.Close(start)
+		os.Remove(rawPath)
+		return nil, fmt.Errorf("pipe pane output: %w", err)
+	}
+
+	// Start from the current screen so the clip is readable from its first frame.
+	if snap, err := pane.PaneSnapshot(); err == nil && snap != "" {
+		rec.Output(start, []byte("\x1b[H\x1b[2J"+strings.ReplaceAll(strings.TrimRight(snap, "\n"), "\n", "\r\n")))
+	}
+
+	// Output written while no segment was reading it (the daemon was down)
+	// belongs to earlier segments.
+	m.recoverRaw(dir, rawPath)
+
+	seg := &segment{
+		m:        m,
+		id:       id,
+		pane:     pane,
+		rec:      rec,
+		castPath: castPath,
+		rawPath:  rawPath,
+		raw:      raw,
+		quit:     make(chan bool, 1),
+		done:     make(chan struct{}),
+	}
+	seg.includeInput.Store(s.IncludeInput)
+	go seg.run()
+	return seg, nil
+}
+
+// recoverRaw appends raw output left behind by a previous run to the cast it
+// belongs to, as one output event at the raw file's last modification time,
+// then deletes it. Only output past the recorded offset is appended, redacted
+// like live output. current is the raw file now in use and is skipped.
+func (m *Manager) recoverRaw(dir, current string) {
+	raws, _ := filepath.Glob(filepath.Join(dir, "*"+rawExt))
+	for _, raw := range raws {
+		if raw == current {
+			continue
+		}
+		var out *secrets.StreamRedactor
+		if m.opts.NewOutputRedactor != nil {
+			out = m.opts.NewOutputRedactor()
+		}
+		if err := appendLeftover(raw, strings.TrimSuffix(raw, rawExt)+castExt, readOffset(raw), out); err != nil {
+			log.Warn("[recording] failed to recover leftover output", "file", raw, "err", err)
+			continue
+		}
+		removeRaw(raw)
+	}
+}
+
+// appendLeftover appends the content of rawPath from offset on to castPath,
+// passing it through out when non-nil. Raw files from pipe rotation belong to
+// the cast of the segment that created them, whose name is the raw file's
+// own only for the first one; later ones are matched to the newest cast that
+// started before them.
+func appendLeftover(rawPath, castPath string, offset int64, out *secrets.StreamRedactor) error {
+	info, err := os.Stat(rawPath)
+	if err != nil {
+		return err
+	}
+	if info.Size() <= offset {
+		return nil
+	}
+	if _, err := os.Stat(castPath); err != nil {
+		castPath = castBefore(filepath.Dir(rawPath), filepath.Base(rawPath))
+		if castPath == "" {
+			return errors.New("no cast file for leftover output")
+		}
+	}
+	raw, err := os.Open(rawPath)
+	if err != nil {
+		return err
+	}
+	defer raw.Close()
+	if _, err := raw.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	data, err := io.ReadAll(raw)
+	if err != nil {
+		return err
+	}
+	if out != nil {
+		data = append(out.Write(data), out.Flush()...)
+	}
+	f, err := os.Open(castPath)
+	if err != nil {
+		return err
+	}
+	h, _, err := ReadCast(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	cast, err := os.OpenFile(castPath, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	defer cast.Close()
+	at := seconds(info.ModTime().Sub(time.Unix(h.Timestamp, 0)))
+	complete, _ := splitIncompleteUTF8(data)
+	return newCastEncoder(cast).event(Event{Time: at, Code: CodeOutput, Data: string(complete)})
+}
+
+// readOffset returns how much of rawPath has been recorded, or 0 when no
+// offset was saved.
+func readOffset(rawPath string) int64 {
+	data, err := os.ReadFile(rawPath + offsetExt)
+	if err != nil {
+		return 0
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// writeOffset saves how much of rawPath has been recorded.
+func writeOffset(rawPath string, offset int64) error {
+	return writeFileAtomic(rawPath+offsetExt, []byte(strconv.FormatInt(offset, 10)))
+}
+
+// removeRaw deletes a raw file and its saved offset.
+func removeRaw(rawPath string) {
+	os.Remove(rawPath)
+	os.Remove(rawPath + offsetExt)
+}
+
+// castBefore returns the newest cast in dir whose name sorts before name.
+func castBefore(dir, name string) string {
+	casts, _ := filepath.Glob(filepath.Join(dir, "*"+castExt))
+	sort.Strings(casts)
+	prefix := strings.TrimSuffix(name, rawExt)
+	best := ""
+	for _, c := range casts {
+		if strings.TrimSuffix(filepath.Base(c), castExt) <= prefix {
+			best = c
+		}
+	}
+	return best
+}
+
+// appendCommand is the pipe-pane shell command appending output to path.
+func appendCommand(path string) string {
+	return "cat >> '" + strings.ReplaceAll(path, "'", `'\''`) + "'"
+}
+
+// writeFileAtomic writes data to path via a temp file and rename.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// readAll reads everything currently in f, in chunks of at most readChunk.
+func readAll(f *os.File, each func([]byte)) error {
+	buf := make([]byte, readChunk)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			each(buf[:n])
+		}
+		if err == io.EOF || n == 0 {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
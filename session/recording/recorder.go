@@ -0,0 +1,225 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+)
+
+const (
+	// inputIdleFlush is how long partial input (no Enter yet) is held before
+	// it is redacted and written anyway.
+	inputIdleFlush = time.Second
+	// maxInputHold bounds how much unterminated input is held.
+	maxInputHold = 1024
+	// outputHoldFlush is how long output held back by the stream redactor
+	// waits for the next chunk before it is released.
+	outputHoldFlush = 100 * time.Millisecond
+)
+
+// recorder appends the events of one segment to its .cast file. It is safe
+// for concurrent use: output arrives from the segment's tail loop and input
+// from whichever goroutine forwarded it to the terminal.
+type recorder struct {
+	mu    sync.Mutex
+	f     *os.File
+	w     *bufio.Writer
+	enc   castEncoder
+	start time.Time // header timestamp; event times are relative to it
+	err   error     // first write error; later writes are dropped
+
+	out         *secrets.StreamRedactor // nil: output stored as-is
+	outHeldAt   time.Time               // when out started holding output back
+	outCarry    []byte                  // incomplete trailing UTF-8 sequence
+	redactInput func([]byte) []byte     // nil: input stored as-is
+	input       []byte                  // unterminated input awaiting redaction
+	inputAt     time.Time               // when the held input was typed
+	cols, rows  int
+}
+
+// createRecorder creates the cast file at path and writes its header.
+func createRecorder(path string, start time.Time, cols, rows int, title string, out *secrets.StreamRedactor, redactInput func([]byte) []byte) (*recorder, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	r := &recorder{
+		f:           f,
+		w:           bufio.NewWriter(f),
+		start:       time.Unix(start.Unix(), 0),
+		out:         out,
+		redactInput: redactInput,
+		cols:        cols,
+		rows:        rows,
+	}
+	r.enc = newCastEncoder(r.w)
+	r.err = r.enc.header(Header{
+		Version:   castVersion,
+		Width:     cols,
+		Height:    rows,
+		Timestamp: r.start.Unix(),
+		Title:     title,
+		Env:       map[string]string{"TERM": "xterm-256color"},
+	})
+	if r.err == nil {
+		r.err = r.w.Flush()
+	}
+	if r.err != nil {
+		f.Close()
+		os.Remove(path)
+		return nil, r.err
+	}
+	return r, nil
+}
+
+// emitLocked writes one event. r.mu must be held.
+func (r *recorder) emitLocked(at time.Time, code Code, data string) {
+	if r.err != nil || data == "" {
+		return
+	}
+	r.err = r.enc.event(Event{Time: seconds(at.Sub(r.start)), Code: code, Data: data})
+}
+
+// Output records terminal output.
+func (r *recorder) Output(at time.Time, data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.out != nil {
+		if !r.out.Pending() {
+			r.outHeldAt = at
+		}
+		data = r.out.Write(data)
+	}
+	r.writeOutputLocked(at, data)
+}
+
+// writeOutputLocked writes output, carrying an incomplete trailing UTF-8
+// sequence over to the next chunk so multi-byte characters split across
+// reads are not mangled by JSON encoding. r.mu must be held.
+func (r *recorder) writeOutputLocked(at time.Time, data []byte) {
+	if len(r.outCarry) > 0 {
+		data = append(r.outCarry, data...)
+		r.outCarry = nil
+	}
+	complete, rest := splitIncompleteUTF8(data)
+	if len(rest) > 0 {
+		r.outCarry = append([]byte(nil), rest...)
+	}
+	r.emitLocked(at, CodeOutput, string(complete))
+}
+
+// Resize records a terminal size change; repeated sizes are ignored.
+func (r *recorder) Resize(at time.Time, cols, rows int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cols <= 0 || rows <= 0 || (cols == r.cols && rows == r.rows) {
+		return
+	}
+	r.cols, r.rows = cols, rows
+	r.emitLocked(at, CodeResize, resizeData(cols, rows))
+}
+
+// Size returns the last recorded terminal size.
+func (r *recorder) Size() (cols, rows int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cols, r.rows
+}
+
+// Input records input sent to the terminal. Input is held until a line ends
+// so that a secret typed one keystroke at a time is redacted as a whole.
+func (r *recorder) Input(at time.Time, data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.input) == 0 {
+		r.inputAt = at
+	}
+	r.input = append(r.input, data...)
+	if i := bytes.LastIndexAny(r.input, "\r\n"); i >= 0 {
+		r.flushInputLocked(r.input[:i+1])
+		r.input = append([]byte(nil), r.input[i+1:]...)
+		r.inputAt = at
+	}
+	if len(r.input) >= maxInputHold {
+		r.flushInputLocked(r.input)
+		r.input = nil
+	}
+}
+
+// flushInputLocked redacts and writes held input. r.mu must be held.
+func (r *recorder) flushInputLocked(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	if r.redactInput != nil {
+		data = r.redactInput(data)
+	}
+	r.emitLocked(r.inputAt, CodeInput, string(bytes.ToValidUTF8(data, []byte("�"))))
+}
+
+// Tick releases input and output that have been held for long enough, then
+// flushes buffered events to disk.
+func (r *recorder) Tick(at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.input) > 0 && at.Sub(r.inputAt) >= inputIdleFlush {
+		r.flushInputLocked(r.input)
+		r.input = nil
+	}
+	if r.out != nil && r.out.Pending() && at.Sub(r.outHeldAt) >= outputHoldFlush {
+		r.writeOutputLocked(at, r.out.Flush())
+	}
+	if r.err == nil {
+		r.err = r.w.Flush()
+	}
+	return r.err
+}
+
+// Marker records a named point in the recording.
+func (r *recorder) Marker(at time.Time, label string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.emitLocked(at, CodeMarker, label)
+}
+
+// Close releases everything held and closes the file.
+func (r *recorder) Close(at time.Time) error {
+	r.mu.Lock()
+	r.flushInputLocked(r.input)
+	r.input = nil
+	if r.out != nil {
+		r.writeOutputLocked(at, r.out.Flush())
+	}
+	if len(r.outCarry) > 0 {
+		r.emitLocked(at, CodeOutput, string(bytes.ToValidUTF8(r.outCarry, []byte("�"))))
+		r.outCarry = nil
+	}
+	if r.err == nil {
+		r.err = r.w.Flush()
+	}
+	err := r.err
+	r.mu.Unlock()
+	if cerr := r.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// splitIncompleteUTF8 splits data before a trailing multi-byte UTF-8
+// sequence that is not yet complete.
+func splitIncompleteUTF8(data []byte) (complete, rest []byte) {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return data[:i], data[i:]
+			}
+			break
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,151 @@
+package recording
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// segment is one continuous recording of a pane: the pane pipes into rawPath
+// and run tails it into the cast file.
+type segment struct {
+	m        *Manager
+	id       string
+	pane     Pane
+	rec      *recorder
+	castPath string
+	rawPath  string
+	raw      *os.File
+	// savedOffset is the raw file offset last written with writeOffset.
+	savedOffset int64
+
+	includeInput atomic.Bool
+	quit         chan bool // closePipe
+	done         chan struct{}
+	stopOnce     sync.Once
+}
+
+// run tails the raw file until the segment is stopped or writing fails.
+func (s *segment) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	var lastSizeCheck time.Time
+	for {
+		select {
+		case closePipe := <-s.quit:
+			s.finish(closePipe)
+			return
+		case <-ticker.C:
+		}
+
+		now := s.m.now()
+		got := s.drain(now)
+		if got && now.Sub(lastSizeCheck) >= sizeCheckInterval {
+			lastSizeCheck = now
+			if cols, rows, err := s.pane.PaneSize(); err == nil {
+				s.rec.Resize(now, cols, rows)
+			}
+		}
+		if err := s.rec.Tick(now); err != nil {
+			log.Error("[recording] write failed, stopping segment", "session", s.id, "err", err)
+			s.finish(true)
+			return
+		}
+		s.saveOffset()
+		if err := s.rotateIfLarge(); err != nil {
+			log.Warn("[recording] raw file rotation failed", "session", s.id, "err", err)
+		}
+	}
+}
+
+// drain records everything appended to the raw file since the last read and
+// reports whether there was any.
+func (s *segment) drain(at time.Time) bool {
+	got := false
+	if err := readAll(s.raw, func(b []byte) {
+		got = true
+		s.rec.Output(at, b)
+	}); err != nil {
+		log.Warn("[recording] raw read failed", "session", s.id, "err", err)
+	}
+	return got
+}
+
+// saveOffset records how much of the raw file has been flushed to the cast,
+// so a restart recovers only the output after it.
+func (s *segment) saveOffset() {
+	off, err := s.raw.Seek(0, io.SeekCurrent)
+	if err != nil || off == s.savedOffset {
+		return
+	}
+	if err := writeOffset(s.rawPath, off); err != nil {
+		log.Warn("[recording] saving raw offset failed", "session", s.id, "err", err)
+		return
+	}
+	s.savedOffset = off
+}
+
+// rotateIfLarge moves the pipe to a fresh raw file once the current one has
+// grown past maxRawBytes, then drains and removes the old one.
+func (s *segment) rotateIfLarge() error {
+	off, err := s.raw.Seek(0, io.SeekCurrent)
+	if err != nil || off < maxRawBytes {
+		return err
+	}
+	next := filepath.Join(filepath.Dir(s.rawPath), strconv.FormatInt(s.m.now().UnixNano(), 10)+rawExt)
+	f, err := os.OpenFile(next, os.O_RDONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := s.pane.PipePaneOutput(appendCommand(next)); err != nil {
+		f.Close()
+		os.Remove(next)
+		return err
+	}
+	s.drain(s.m.now())
+	s.raw.Close()
+	removeRaw(s.rawPath)
+	s.raw, s.rawPath, s.savedOffset = f, next, 0
+	return nil
+}
+
+// finish closes the pipe if asked, records the remaining output and closes
+// the segment's files.
+func (s *segment) finish(closePipe bool) {
+	if closePipe {
+		if err := s.pane.PipePaneOutput(""); err != nil {
+			log.Debug("[recording] closing pane pipe failed", "session", s.id, "err", err)
+		}
+	}
+	now := s.m.now()
+	s.drain(now)
+	if err := s.rec.Close(now); err != nil {
+		log.Warn("[recording] closing cast file failed", "session", s.id, "err", err)
+	}
+	s.raw.Close()
+	removeRaw(s.rawPath)
+}
+
+// stop ends the segment and waits for it to finish. It is safe to call more
+// than once.
+func (s *segment) stop(closePipe bool) {
+	s.stopOnce.Do(func() { s.quit <- closePipe })
+	<-s.done
+}
+
+// stopped reports whether the segment has ended on its own.
+func (s *segment) stopped() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
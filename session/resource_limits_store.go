@@ -0,0 +1,96 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/linkdata/deadlock"
+	"github.com/tstapler/stapler-squad/config"
+)
+
+// ResourceLimitStore persists the resource limits each session was created
+// with, so they are re-applied when the server restarts or the session's
+// program is restarted in a fresh cgroup. Entries are keyed by session UUID
+// (title for legacy sessions without one). All public methods are thread-safe.
+type ResourceLimitStore struct {
+	mu      deadlock.RWMutex
+	path    string
+	entries map[string]config.ResourceLimits
+}
+
+// NewResourceLimitStore loads (or creates) the limits file at the given path.
+// An empty path yields an in-memory store.
+func NewResourceLimitStore(path string) (*ResourceLimitStore, error) {
+	s := &ResourceLimitStore{
+		path:    path,
+		entries: make(map[string]config.ResourceLimits),
+	}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("read resource limits: %w", err)
+	}
+	if err := json.Unmarshal(data, &s.entries); err != nil {
+		return nil, fmt.Errorf("unmarshal resource limits: %w", err)
+	}
+	return s, nil
+}
+
+// Get returns the limits recorded for a session.
+func (s *ResourceLimitStore) Get(key string) (config.ResourceLimits, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	l, ok := s.entries[key]
+	return l, ok
+}
+
+// Set records a session's limits.
+func (s *ResourceLimitStore) Set(key string, limits config.ResourceLimits) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cur, ok := s.entries[key]; ok && cur == limits {
+		return nil
+	}
+	s.entries[key] = limits
+	return s.save()
+}
+
+// Delete forgets a session's limits.
+func (s *ResourceLimitStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; !ok {
+		return nil
+	}
+	delete(s.entries, key)
+	return s.save()
+}
+
+// save writes the store atomically. Caller must hold s.mu.
+func (s *ResourceLimitStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal resource limits: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("create resource limits dir: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write resource limits: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("rename resource limits: %w", err)
+	}
+	return nil
+}
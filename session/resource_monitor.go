@@ -0,0 +1,429 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkdata/deadlock"
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/cgroup"
+)
+
+// ResourceEventKind classifies a resource limit event.
+type ResourceEventKind string
+
+const (
+	// ResourceEventOOMKill means the kernel OOM-killed processes in the session.
+	ResourceEventOOMKill ResourceEventKind = "oom_kill"
+	// ResourceEventMemoryMax means the session hit its hard memory limit.
+	ResourceEventMemoryMax ResourceEventKind = "memory_max"
+	// ResourceEventPidsMax means forks failed at the session's process limit.
+	ResourceEventPidsMax ResourceEventKind = "pids_max"
+)
+
+// ResourceEvent is a limit event observed in a session's cgroup since the
+// previous sample.
+type ResourceEvent struct {
+	Kind    ResourceEventKind
+	Count   uint64
+	Message string
+}
+
+// ResourceMonitorConfig configures the resource sampling loop.
+type ResourceMonitorConfig struct {
+	// PollInterval is how often usage is sampled and events are checked.
+	PollInterval time.Duration
+	// AlertHold is how long a limit event keeps the session in the review
+	// queue after the last event.
+	AlertHold time.Duration
+}
+
+// DefaultResourceMonitorConfig returns sensible defaults.
+func DefaultResourceMonitorConfig() ResourceMonitorConfig {
+	return ResourceMonitorConfig{
+		PollInterval: 5 * time.Second,
+		AlertHold:    15 * time.Minute,
+	}
+}
+
+// errNotAttached reports a pane process that already failed to move into its
+// group. The failure was logged when it happened.
+var errNotAttached = errors.New("session process is not in its cgroup")
+
+// sessionGroup is the cgroup state the monitor keeps per session.
+type sessionGroup struct {
+	group     *cgroup.Group
+	limits    config.ResourceLimits
+	pid       int
+	failedPID int // pane PID that could not be moved; not retried
+	last      cgroup.Stats
+	sampledAt time.Time
+	alertAt   time.Time
+}
+
+// ResourceMonitor puts each session's process tree into its own cgroup v2
+// group, applies the session's resource limits, samples usage onto the
+// Instance (see Instance.ResourceUsage) and reports OOM kills and limit hits
+// as a review-queue reason (ReasonResourceLimit via Instance.ResourceAlert)
+// and through the OnEvent callback.
+//
+// The tmux pane's process tree is moved into the group, so everything already
+// running in the pane and everything the agent spawns afterwards is
+// accounted to the session. Without cgroup v2 support
+// the monitor is inert.
+type ResourceMonitor struct {
+	cgroups *cgroup.Manager
+	limits  *ResourceLimitStore
+	config  ResourceMonitorConfig
+
+	instances []*Instance
+	groups    map[string]*sessionGroup
+	onEvent   func(*Instance, []ResourceEvent)
+
+	// intervalChanged wakes pollLoop to reset its ticker after SetPollInterval.
+	intervalChanged chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	mu     deadlock.Mutex
+}
+
+// NewResourceMonitor creates a monitor. A nil manager disables accounting.
+func NewResourceMonitor(cgroups *cgroup.Manager, limits *ResourceLimitStore, cfg ResourceMonitorConfig) *ResourceMonitor {
+	if limits == nil {
+		limits, _ = NewResourceLimitStore("")
+	}
+	return &ResourceMonitor{
+		cgroups: cgroups,
+		limits:  limits,
+		config:  cfg,
+		groups:  make(map[string]*sessionGroup),
+
+		intervalChanged: make(chan struct{}, 1),
+	}
+}
+
+// Enabled reports whether sessions are placed in cgroups.
+func (m *ResourceMonitor) Enabled() bool {
+	return m != nil && m.cgroups != nil
+}
+
+// SetInstances replaces the full list of monitored instances.
+func (m *ResourceMonitor) SetInstances(instances []*Instance) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.instances = instances
+}
+
+// AddInstance adds a single instance to monitor.
+func (m *ResourceMonitor) AddInstance(inst *Instance) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.instances = append(m.instances, inst)
+}
+
+// RemoveInstance stops monitoring a deleted session, removes its cgroup and
+// forgets its limits.
+func (m *ResourceMonitor) RemoveInstance(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	filtered := make([]*Instance, 0, len(m.instances))
+	for _, inst := range m.instances {
+		if !inst.MatchesID(id) {
+			filtered = append(filtered, inst)
+			continue
+		}
+		key := inst.GetStableID()
+		if g, ok := m.groups[key]; ok {
+			if err := g.group.Remove(); err != nil {
+				log.Warn("resource monitor: failed to remove session cgroup", "session", inst.Title, "err", err)
+			}
+			delete(m.groups, key)
+		}
+		if err := m.limits.Delete(key); err != nil {
+			log.Warn("resource monitor: failed to forget session limits", "session", inst.Title, "err", err)
+		}
+	}
+	m.instances = filtered
+}
+
+// SetOnEvent registers a callback for new limit events. It is invoked from the
+// sampling goroutine and must not block.
+func (m *ResourceMonitor) SetOnEvent(fn func(*Instance, []ResourceEvent)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onEvent = fn
+}
+
+// Attach records a new session's limits and moves its process tree into its
+// cgroup right away, so processes it spawns from now on are accounted. The
+// instance is also added to the monitored set.
+func (m *ResourceMonitor) Attach(inst *Instance, limits config.ResourceLimits) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.instances = append(m.instances, inst)
+	key := inst.GetStableID()
+	if err := m.limits.Set(key, limits); err != nil {
+		log.Warn("resource monitor: failed to persist session limits", "session", inst.Title, "err", err)
+	}
+	if m.cgroups == nil {
+		return nil
+	}
+	_, err := m.attachLocked(inst, key)
+	return err
+}
+
+// attachLocked creates the session's group on first use and moves the pane
+// process and its descendants into it whenever the pane changed (e.g. after
+// a restart). Caller must hold m.mu.
+func (m *ResourceMonitor) attachLocked(inst *Instance, key string) (*sessionGroup, error) {
+	g, ok := m.groups[key]
+	if !ok {
+		limits, recorded := m.limits.Get(key)
+		if !recorded {
+			limits = config.ResolveDefaults(config.LoadConfig(), inst.GetWorkingDirectory(), "").ResourceLimits
+		}
+		group, err := m.cgroups.Create(resourceGroupName(key), cgroupLimits(limits))
+		if group == nil {
+			return nil, err
+		}
+		if err != nil {
+			log.Warn("resource monitor: failed to apply session limits", "session", inst.Title, "err", err)
+		}
+		g = &sessionGroup{group: group, limits: limits}
+		m.groups[key] = g
+	}
+
+	pid, err := inst.GetPanePID()
+	if err != nil {
+		return g, nil
+	}
+	if int(pid) == g.failedPID {
+		return nil, errNotAttached
+	}
+	if int(pid) != g.pid {
+		// The pane may already run the agent and its tools (e.g. after a
+		// server restart), so the whole tree moves, not just the pane.
+		if err := g.group.AddProcessTree(int(pid)); err != nil {
+			g.failedPID = int(pid)
+			return nil, err
+		}
+		g.pid = int(pid)
+		log.Info("resource monitor: session placed in cgroup", "session", inst.Title, "pid", pid, "cgroup", g.group.Path())
+	}
+	return g, nil
+}
+
+// Start begins the sampling loop. Safe to call multiple times; subsequent
+// calls are no-ops. Does nothing when accounting is disabled.
+func (m *ResourceMonitor) Start(ctx context.Context) {
+	m.mu.Lock()
+	if m.ctx != nil || m.cgroups == nil {
+		m.mu.Unlock()
+		return
+	}
+	m.ctx, m.cancel = context.WithCancel(ctx)
+	interval := m.config.PollInterval
+	m.mu.Unlock()
+
+	m.wg.Add(1)
+	go m.pollLoop()
+	log.Info("resource monitor started", "interval", interval, "cgroup_root", m.cgroups.Root())
+}
+
+// SetPollInterval changes how often usage is sampled. A running monitor
+// restarts its ticker with the new interval. Non-positive values are ignored.
+func (m *ResourceMonitor) SetPollInterval(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	m.mu.Lock()
+	m.config.PollInterval = d
+	m.mu.Unlock()
+	select {
+	case m.intervalChanged <- struct{}{}:
+	default:
+	}
+}
+
+func (m *ResourceMonitor) pollInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.config.PollInterval
+}
+
+// Stop shuts down the sampling loop.
+func (m *ResourceMonitor) Stop() {
+	m.mu.Lock()
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.mu.Unlock()
+	m.wg.Wait()
+}
+
+func (m *ResourceMonitor) pollLoop() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(m.pollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-m.intervalChanged:
+			ticker.Reset(m.pollInterval())
+		case <-ticker.C:
+			m.sampleAll(time.Now())
+		}
+	}
+}
+
+// sampleAll samples every running managed session.
+func (m *ResourceMonitor) sampleAll(now time.Time) {
+	m.mu.Lock()
+	instances := make([]*Instance, len(m.instances))
+	copy(instances, m.instances)
+	m.mu.Unlock()
+
+	for _, inst := range instances {
+		// External sessions belong to the user's own terminal; only
+		// squad-managed process trees are moved into cgroups.
+		if !inst.Started() || inst.Paused() || inst.InstanceType == InstanceTypeExternal {
+			continue
+		}
+		m.sample(inst, now)
+	}
+}
+
+// sample records one usage sample for inst and reports new limit events.
+func (m *ResourceMonitor) sample(inst *Instance, now time.Time) {
+	m.mu.Lock()
+	key := inst.GetStableID()
+	g, err := m.attachLocked(inst, key)
+	if err != nil && !errors.Is(err, errNotAttached) {
+		log.Warn("resource monitor: failed to place session in cgroup", "session", inst.Title, "err", err)
+	}
+	if g == nil {
+		m.mu.Unlock()
+		return
+	}
+	stats, err := g.group.Stats()
+	if err != nil {
+		m.mu.Unlock()
+		log.Warn("resource monitor: failed to read session cgroup", "session", inst.Title, "err", err)
+		return
+	}
+
+	usage := &ResourceUsage{
+		CgroupPath:       g.group.Path(),
+		CPUUsage:         stats.CPUUsage,
+		CPULimitPercent:  g.limits.CPUPercent,
+		MemoryBytes:      stats.MemoryCurrent,
+		MemoryPeakBytes:  stats.MemoryPeak,
+		MemoryLimitBytes: stats.MemoryMax,
+		IOReadBytes:      stats.IOReadBytes,
+		IOWriteBytes:     stats.IOWriteBytes,
+		PIDs:             stats.PIDs,
+		PIDsLimit:        stats.PidsMax,
+		OOMKills:         stats.Events.OOMKill,
+		LimitEvents:      stats.Events.MemoryMax + stats.Events.PidsMax,
+		SampledAt:        now,
+	}
+	var events []ResourceEvent
+	if !g.sampledAt.IsZero() {
+		if elapsed := now.Sub(g.sampledAt); elapsed > 0 && stats.CPUUsage >= g.last.CPUUsage {
+			usage.CPUPercent = float64(stats.CPUUsage-g.last.CPUUsage) / float64(elapsed) * 100
+		}
+		// The first sample is the baseline: counters from before a server
+		// restart were already reported.
+		events = resourceEvents(g.last, stats)
+	}
+	g.last = stats
+	g.sampledAt = now
+
+	alert := ""
+	clearAlert := false
+	if len(events) > 0 {
+		g.alertAt = now
+		msgs := make([]string, len(events))
+		for i, e := range events {
+			msgs[i] = e.Message
+		}
+		alert = strings.Join(msgs, "; ")
+	} else if !g.alertAt.IsZero() && now.Sub(g.alertAt) > m.config.AlertHold {
+		g.alertAt = time.Time{}
+		clearAlert = true
+	}
+	onEvent := m.onEvent
+	m.mu.Unlock()
+
+	inst.SetResourceUsage(usage)
+	switch {
+	case alert != "":
+		inst.SetResourceAlert(alert)
+		log.Warn("resource monitor: session hit a resource limit", "session", inst.Title, "events", alert)
+		if onEvent != nil {
+			onEvent(inst, events)
+		}
+	case clearAlert:
+		inst.SetResourceAlert("")
+	}
+}
+
+// resourceEvents compares two samples' limit counters.
+func resourceEvents(prev, cur cgroup.Stats) []ResourceEvent {
+	var events []ResourceEvent
+	if n := cur.Events.OOMKill - prev.Events.OOMKill; cur.Events.OOMKill > prev.Events.OOMKill {
+		events = append(events, ResourceEvent{
+			Kind:    ResourceEventOOMKill,
+			Count:   n,
+			Message: fmt.Sprintf("OOM killer ended %d process(es) at the %s memory limit", n, formatMemoryLimit(cur.MemoryMax)),
+		})
+	} else if n := cur.Events.MemoryMax - prev.Events.MemoryMax; cur.Events.MemoryMax > prev.Events.MemoryMax {
+		events = append(events, ResourceEvent{
+			Kind:    ResourceEventMemoryMax,
+			Count:   n,
+			Message: fmt.Sprintf("memory limit of %s reached", formatMemoryLimit(cur.MemoryMax)),
+		})
+	}
+	if n := cur.Events.PidsMax - prev.Events.PidsMax; cur.Events.PidsMax > prev.Events.PidsMax {
+		events = append(events, ResourceEvent{
+			Kind:    ResourceEventPidsMax,
+			Count:   n,
+			Message: fmt.Sprintf("process limit of %d reached; %d fork(s) failed", cur.PidsMax, n),
+		})
+	}
+	return events
+}
+
+func formatMemoryLimit(bytes uint64) string {
+	if bytes == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d MiB", bytes>>20)
+}
+
+// cgroupLimits converts configured limits to cgroup limits.
+func cgroupLimits(l config.ResourceLimits) cgroup.Limits {
+	return cgroup.Limits{
+		MemoryMax:  l.MemoryMaxMB << 20,
+		MemoryHigh: l.MemoryHighMB << 20,
+		CPUPercent: l.CPUPercent,
+		PidsMax:    l.PidsMax,
+		IOWeight:   l.IOWeight,
+	}
+}
+
+var unsafeGroupChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// resourceGroupName is the cgroup directory name for a session key.
+func resourceGroupName(key string) string {
+	return "session-" + unsafeGroupChars.ReplaceAllString(key, "_")
+}
@@ -0,0 +1,146 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/session/cgroup"
+)
+
+// newFakeCgroupRoot creates a directory that passes for a cgroup v2
+// hierarchy root with all controllers already delegated.
+func newFakeCgroupRoot(t *testing.T) *cgroup.Manager {
+	t.Helper()
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "cgroup.controllers"), []byte("cpu io memory pids\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte("cpu io memory pids\n"), 0o644))
+	m, err := cgroup.NewManager(root)
+	require.NoError(t, err)
+	return m
+}
+
+func writeCgroupFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func TestResourceEvents(t *testing.T) {
+	prev := cgroup.Stats{MemoryMax: 512 << 20, Events: cgroup.Events{MemoryMax: 1}}
+
+	cur := prev
+	cur.Events.MemoryMax = 3
+	cur.Events.OOMKill = 2
+	events := resourceEvents(prev, cur)
+	require.Len(t, events, 1, "an OOM kill supersedes the memory.max hits that led to it")
+	assert.Equal(t, ResourceEventOOMKill, events[0].Kind)
+	assert.Equal(t, uint64(2), events[0].Count)
+	assert.Contains(t, events[0].Message, "512 MiB")
+
+	cur = prev
+	cur.PidsMax = 64
+	cur.Events.PidsMax = 5
+	events = resourceEvents(prev, cur)
+	require.Len(t, events, 1)
+	assert.Equal(t, ResourceEventPidsMax, events[0].Kind)
+	assert.Contains(t, events[0].Message, "64")
+
+	assert.Empty(t, resourceEvents(prev, prev))
+}
+
+func TestResourceMonitor_SampleReportsUsageAndAlerts(t *testing.T) {
+	manager := newFakeCgroupRoot(t)
+	monitor := NewResourceMonitor(manager, nil, ResourceMonitorConfig{PollInterval: time.Second, AlertHold: time.Minute})
+	inst := &Instance{Title: "builder", UUID: "u-1"}
+	require.NoError(t, monitor.Attach(inst, config.ResourceLimits{CPUPercent: 200}))
+
+	var notified []ResourceEvent
+	monitor.SetOnEvent(func(_ *Instance, evs []ResourceEvent) { notified = append(notified, evs...) })
+
+	dir := manager.Group(resourceGroupName("u-1")).Path()
+	writeCgroupFile(t, dir, "cpu.stat", "usage_usec 1000000\n")
+	writeCgroupFile(t, dir, "memory.current", "1048576\n")
+	writeCgroupFile(t, dir, "memory.events", "max 0\noom_kill 0\n")
+
+	start := time.Now()
+	monitor.sample(inst, start)
+	usage := inst.ResourceUsage()
+	require.NotNil(t, usage)
+	assert.Equal(t, dir, usage.CgroupPath)
+	assert.Equal(t, uint64(1<<20), usage.MemoryBytes)
+	assert.Equal(t, 200, usage.CPULimitPercent)
+	assert.Zero(t, usage.CPUPercent, "the first sample is only a baseline")
+
+	// One more CPU second over two seconds is 50%; an OOM kill raises the alert.
+	writeCgroupFile(t, dir, "cpu.stat", "usage_usec 2000000\n")
+	writeCgroupFile(t, dir, "memory.events", "max 4\noom_kill 1\n")
+	monitor.sample(inst, start.Add(2*time.Second))
+	assert.InDelta(t, 50, inst.ResourceUsage().CPUPercent, 0.01)
+	assert.Equal(t, uint64(1), inst.ResourceUsage().OOMKills)
+	assert.Contains(t, inst.ResourceAlert(), "OOM killer")
+	require.Len(t, notified, 1)
+	assert.Equal(t, ResourceEventOOMKill, notified[0].Kind)
+
+	// The alert is held, then cleared once no new events arrive.
+	monitor.sample(inst, start.Add(30*time.Second))
+	assert.NotEmpty(t, inst.ResourceAlert())
+	monitor.sample(inst, start.Add(3*time.Minute))
+	assert.Empty(t, inst.ResourceAlert())
+	assert.Len(t, notified, 1)
+}
+
+func TestResourceMonitor_RemoveInstanceDeletesGroup(t *testing.T) {
+	manager := newFakeCgroupRoot(t)
+	store, err := NewResourceLimitStore("")
+	require.NoError(t, err)
+	monitor := NewResourceMonitor(manager, store, DefaultResourceMonitorConfig())
+	inst := &Instance{Title: "builder", UUID: "u-1"}
+	require.NoError(t, monitor.Attach(inst, config.ResourceLimits{MemoryMaxMB: 256}))
+
+	dir := manager.Group(resourceGroupName("u-1")).Path()
+	assert.DirExists(t, dir)
+	_, ok := store.Get("u-1")
+	assert.True(t, ok)
+
+	monitor.RemoveInstance("builder")
+	assert.NoDirExists(t, dir)
+	_, ok = store.Get("u-1")
+	assert.False(t, ok)
+}
+
+func TestResourceMonitor_DisabledIsInert(t *testing.T) {
+	monitor := NewResourceMonitor(nil, nil, DefaultResourceMonitorConfig())
+	assert.False(t, monitor.Enabled())
+	inst := &Instance{Title: "builder"}
+	assert.NoError(t, monitor.Attach(inst, config.ResourceLimits{MemoryMaxMB: 256}))
+	monitor.sampleAll(time.Now())
+	assert.Nil(t, inst.ResourceUsage())
+}
+
+func TestResourceLimitStore_Persists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resource_limits.json")
+	store, err := NewResourceLimitStore(path)
+	require.NoError(t, err)
+	limits := config.ResourceLimits{MemoryMaxMB: 2048, PidsMax: 512}
+	require.NoError(t, store.Set("u-1", limits))
+
+	reloaded, err := NewResourceLimitStore(path)
+	require.NoError(t, err)
+	got, ok := reloaded.Get("u-1")
+	require.True(t, ok)
+	assert.Equal(t, limits, got)
+
+	require.NoError(t, reloaded.Delete("u-1"))
+	_, ok = reloaded.Get("u-1")
+	assert.False(t, ok)
+}
+
+func TestResourceGroupName(t *testing.T) {
+	assert.Equal(t, "session-my_session_1", resourceGroupName("my session/1"))
+	assert.Equal(t, "session-3f2a-9c", resourceGroupName("3f2a-9c"))
+}
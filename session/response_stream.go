@@ -283,6 +283,7 @@ func (rs *ResponseStream) streamLoop() {
 				if rs.ptyAccess.buffer != nil {
 					rs.ptyAccess.buffer.Write(chunk.Data)
 				}
+				rs.ptyAccess.recordOutput(chunk.Data)
 
 				rs.broadcast(chunk)
 			}
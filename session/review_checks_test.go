@@ -0,0 +1,191 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/session/ent"
+)
+
+func TestRunReviewChecks(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "licensed.go"), []byte("// Copyright Example Corp\npackage pkg\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "bare.go"), []byte("package pkg\n"), 0o644))
+	changes := []ChangedFile{
+		{Path: "pkg/licensed.go", Added: true},
+		{Path: "pkg/bare.go", Added: true},
+		{Path: "README.md"},
+		{Path: "vendor/lib/lib.go"},
+	}
+
+	results := RunReviewChecks(context.Background(), dir, changes, nil, []config.ReviewCheckConfig{
+		{Name: "build", Command: "test -f pkg/licensed.go"},
+		{Name: "lint", Command: "echo 'lint: unused variable x'; exit 1", Soft: true},
+		{Name: "license", Kind: ReviewCheckKindLicenseHeader, Paths: []string{"*.go"}, Header: "Copyright Example Corp"},
+		{Name: "vendor", Kind: ReviewCheckKindForbiddenPaths, Paths: []string{"vendor/**", "go.sum"}},
+		{Name: "slow", Command: "sleep 5", TimeoutSeconds: 1},
+	})
+	require.Len(t, results, 5)
+
+	assert.True(t, results[0].Passed)
+	assert.Equal(t, ReviewCheckKindCommand, results[0].Kind)
+
+	assert.False(t, results[1].Passed)
+	assert.False(t, results[1].Hard)
+	assert.Contains(t, results[1].Output, "unused variable x")
+
+	assert.False(t, results[2].Passed)
+	assert.Contains(t, results[2].Output, "pkg/bare.go")
+	assert.NotContains(t, results[2].Output, "pkg/licensed.go")
+
+	assert.False(t, results[3].Passed)
+	assert.Contains(t, results[3].Output, "vendor/lib/lib.go")
+
+	assert.False(t, results[4].Passed)
+	assert.Contains(t, results[4].Output, "timed out")
+
+	failed := HardReviewCheckFailures(results)
+	require.Len(t, failed, 3, "the soft lint failure does not fail the gate")
+	assert.Contains(t, ReviewChecksFailureSummary(results), "Review gate checks failed: license, vendor, slow.")
+
+	section := ReviewChecksPromptSection(results)
+	assert.Contains(t, section, "- build (command): PASS")
+	assert.Contains(t, section, "- lint (command): FAIL (soft)")
+}
+
+func TestRunReviewChecks_PathChecksFailClosed(t *testing.T) {
+	listErr := errors.New("fatal: bad revision 'abc123..HEAD'")
+	results := RunReviewChecks(context.Background(), t.TempDir(), nil, listErr, []config.ReviewCheckConfig{
+		{Name: "build", Command: "true"},
+		{Name: "license", Kind: ReviewCheckKindLicenseHeader, Header: "Copyright Example Corp"},
+		{Name: "vendor", Kind: ReviewCheckKindForbiddenPaths, Paths: []string{"vendor/**"}},
+	})
+	require.Len(t, results, 3)
+	assert.True(t, results[0].Passed, "command checks do not need the changed files")
+	for _, r := range results[1:] {
+		assert.False(t, r.Passed, r.Name)
+		assert.Contains(t, r.Output, "bad revision", r.Name)
+	}
+	assert.Len(t, HardReviewCheckFailures(results), 2)
+}
+
+func TestMatchAnyGlob(t *testing.T) {
+	assert.True(t, matchAnyGlob([]string{"vendor/**"}, "vendor/a/b.go"))
+	assert.False(t, matchAnyGlob([]string{"vendor/**"}, "vendored/a.go"))
+	assert.True(t, matchAnyGlob([]string{"*.pem"}, "certs/dev/key.pem"), "a pattern without a slash matches the base name")
+	assert.True(t, matchAnyGlob([]string{".github/workflows/*.yml"}, ".github/workflows/ci.yml"))
+	assert.False(t, matchAnyGlob([]string{".github/workflows/*.yml"}, "docs/ci.yml"))
+}
+
+func TestParseNameStatus(t *testing.T) {
+	files := parseNameStatus([]byte("A\x00new file.go\x00M\x00main.go\x00D\x00old.go\x00"))
+	assert.Equal(t, []ChangedFile{
+		{Path: "new file.go", Added: true},
+		{Path: "main.go"},
+		{Path: "old.go"},
+	}, files)
+}
+
+// TestGetChangedFiles_DefaultsToMergeBase verifies that without a base the
+// changed files cover every commit since the branch left the default branch,
+// and that a HEAD on the default branch itself is an error.
+func TestGetChangedFiles_DefaultsToMergeBase(t *testing.T) {
+	repoPath := t.TempDir()
+	require.NoError(t, runGitCommand(repoPath, "init", "-b", "main"))
+	require.NoError(t, runGitCommand(repoPath, "config", "user.name", "Test User"))
+	require.NoError(t, runGitCommand(repoPath, "config", "user.email", "test@example.com"))
+	commit := func(name string) {
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, name), []byte("package main\n"), 0o644))
+		require.NoError(t, runGitCommand(repoPath, "add", "."))
+		require.NoError(t, runGitCommand(repoPath, "commit", "-m", "add "+name))
+	}
+	commit("main.go")
+	ctx := context.Background()
+
+	_, err := GetChangedFiles(ctx, repoPath, "")
+	assert.Error(t, err, "HEAD on the default branch has no session changes to list")
+
+	require.NoError(t, runGitCommand(repoPath, "checkout", "-b", "feature"))
+	commit("a.go")
+	commit("b.go")
+	files, err := GetChangedFiles(ctx, repoPath, "")
+	require.NoError(t, err)
+	assert.Equal(t, []ChangedFile{
+		{Path: "a.go", Added: true},
+		{Path: "b.go", Added: true},
+	}, files)
+}
+
+func TestReviewChecksFor_FiltersByRepo(t *testing.T) {
+	checks := []config.ReviewCheckConfig{
+		{Name: "all"},
+		{Name: "only-api", Repos: []string{"/src/api/"}},
+	}
+	assert.Len(t, ReviewChecksFor(checks, "/src/api"), 2)
+	assert.Len(t, ReviewChecksFor(checks, "/src/web"), 1)
+}
+
+// TestSpawnReviewGate_HardCheckFailureSkipsReviewers verifies that a failing
+// hard check records a FAIL verdict and never spawns a reviewer.
+func TestSpawnReviewGate_HardCheckFailureSkipsReviewers(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("STAPLER_SQUAD_TEST_DIR", configDir)
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, config.ConfigFileName),
+		[]byte(`{"review_checks":[{"name":"build","command":"echo 'undefined: Foo'; exit 2"}]}`), 0o644))
+
+	repoPath := t.TempDir()
+	require.NoError(t, runGitCommand(repoPath, "init"))
+	require.NoError(t, runGitCommand(repoPath, "config", "user.name", "Test User"))
+	require.NoError(t, runGitCommand(repoPath, "config", "user.email", "test@example.com"))
+	for _, name := range []string{"a.go", "b.go"} {
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, name), []byte("package main\n"), 0o644))
+		require.NoError(t, runGitCommand(repoPath, "add", "."))
+		require.NoError(t, runGitCommand(repoPath, "commit", "-m", "add "+name))
+	}
+
+	storage, cleanup := createTestStorage(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	created, err := storage.CreateBacklogItem(ctx, BacklogItemData{
+		Title:              "Checked item",
+		AcceptanceCriteria: `[]`,
+		Status:             string(BacklogStatusReview),
+		RepoPath:           repoPath,
+	})
+	require.NoError(t, err)
+	workIS, err := storage.CreateItemSession(ctx, ItemSessionData{
+		ItemID:      created.ID,
+		SessionUUID: uuid.New().String(),
+		SessionRole: SessionRoleWork,
+	})
+	require.NoError(t, err)
+
+	spawner := &mockReviewGateSpawner{}
+	listener := NewBacklogLifecycleListenerWithSpawner(storage, spawner)
+	listener.spawnReviewGate(&ent.BacklogItem{ID: uuid.MustParse(created.ID), RepoPath: repoPath}, workIS)
+
+	assert.False(t, spawner.spawnCalled, "no reviewer is spawned when a hard check fails")
+	outcome, err := storage.GetMostRecentReviewVerdictForItem(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ReviewVerdictFail, outcome)
+
+	runs := listener.panels.List(created.ID, "", true, 0)
+	require.Len(t, runs, 1)
+	assert.Empty(t, runs[0].Reviewers)
+	require.Len(t, runs[0].Checks, 1)
+	assert.Contains(t, runs[0].Checks[0].Output, "undefined: Foo")
+	assert.Contains(t, runs[0].Summary(), "Review gate checks failed: build.")
+
+	byGate, err := listener.panels.RunForSession(ReviewPanelGateSessionUUID(runs[0].ID))
+	require.NoError(t, err)
+	assert.Equal(t, runs[0].ID, byGate.ID)
+}
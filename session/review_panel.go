@@ -0,0 +1,744 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/google/uuid"
+	"github.com/linkdata/deadlock"
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// ConsensusPolicy decides a review panel's outcome from its reviewers' verdicts.
+type ConsensusPolicy string
+
+const (
+	// ConsensusUnanimous passes only when every reviewer passes; otherwise the
+	// most severe reviewer outcome wins.
+	ConsensusUnanimous ConsensusPolicy = "unanimous"
+	// ConsensusMajority passes when more than half of the reviewers pass.
+	ConsensusMajority ConsensusPolicy = "majority"
+	// ConsensusWeighted passes when the passing reviewers hold enough of the
+	// total weight (see ReviewPanelRun.PassThreshold).
+	ConsensusWeighted ConsensusPolicy = "weighted"
+)
+
+// ErrPanelDecided is returned when a verdict arrives for a panel run that has
+// already reached its decision.
+var ErrPanelDecided = errors.New("review panel already decided")
+
+// maxReviewPanelRuns caps the persisted history; the oldest decided runs are
+// dropped first.
+const maxReviewPanelRuns = 2000
+
+// RubricMaxScore is the highest score a rubric dimension can take.
+const RubricMaxScore = 10
+
+// RubricScores rates a change on a 0–RubricMaxScore scale per dimension.
+type RubricScores struct {
+	Correctness float64 `json:"correctness"`
+	Tests       float64 `json:"tests"`
+	Style       float64 `json:"style"`
+	Security    float64 `json:"security"`
+}
+
+// Validate reports an error if any score is outside 0–RubricMaxScore.
+func (r RubricScores) Validate() error {
+	for _, d := range []struct {
+		name  string
+		score float64
+	}{{"correctness", r.Correctness}, {"tests", r.Tests}, {"style", r.Style}, {"security", r.Security}} {
+		if d.score < 0 || d.score > RubricMaxScore {
+			return fmt.Errorf("rubric %s score %.1f is outside 0-%d", d.name, d.score, RubricMaxScore)
+		}
+	}
+	return nil
+}
+
+// Mean returns the unweighted average of the four dimensions.
+func (r RubricScores) Mean() float64 {
+	return (r.Correctness + r.Tests + r.Style + r.Security) / 4
+}
+
+// PanelVerdict is one reviewer's submission.
+type PanelVerdict struct {
+	Outcome      string             `json:"outcome"`
+	PerCriterion []CriterionVerdict `json:"per_criterion,omitempty"`
+	Summary      string             `json:"summary"`
+	Rubric       *RubricScores      `json:"rubric,omitempty"`
+	SubmittedAt  time.Time          `json:"submitted_at"`
+}
+
+// PanelReviewer is one member of a review panel run.
+type PanelReviewer struct {
+	Name        string        `json:"name"`
+	Program     string        `json:"program,omitempty"`
+	Focus       string        `json:"focus,omitempty"`
+	Weight      float64       `json:"weight"`
+	SessionUUID string        `json:"session_uuid,omitempty"`
+	Verdict     *PanelVerdict `json:"verdict,omitempty"`
+}
+
+// ReviewDisagreement records a point on which reviewers reached different
+// outcomes. CriterionIndex is -1 for the overall outcome.
+type ReviewDisagreement struct {
+	CriterionIndex int               `json:"criterion_index"`
+	Outcomes       map[string]string `json:"outcomes"` // reviewer name → outcome
+}
+
+// ReviewPanelRun is one review-gate evaluation of a backlog item: every
+// reviewer sees the same diff (identified by DiffHash), and the run is decided
+// once all of them have submitted.
+type ReviewPanelRun struct {
+	ID            string               `json:"id"`
+	ItemID        string               `json:"item_id"`
+	RepoPath      string               `json:"repo_path,omitempty"`
+	DiffHash      string               `json:"diff_hash,omitempty"`
+	Consensus     ConsensusPolicy      `json:"consensus"`
+	PassThreshold float64              `json:"pass_threshold,omitempty"`
+	Reviewers     []PanelReviewer      `json:"reviewers"`
+	Outcome       string               `json:"outcome,omitempty"`
+	PerCriterion  []CriterionVerdict   `json:"per_criterion,omitempty"`
+	Disagreements []ReviewDisagreement `json:"disagreements,omitempty"`
+	Rubric        *RubricScores        `json:"rubric,omitempty"`
+	// Checks are the deterministic checks run before the reviewers. A run whose
+	// hard checks failed is decided FAIL with no reviewers.
+	Checks    []ReviewCheckResult `json:"checks,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	DecidedAt *time.Time          `json:"decided_at,omitempty"`
+}
+
+// Decided reports whether the run has reached its consensus outcome.
+func (r *ReviewPanelRun) Decided() bool { return r.DecidedAt != nil }
+
+// NewReviewPanelRun builds an undecided run for itemID from the review_panel
+// config. With no configured reviewers the run has a single default reviewer.
+func NewReviewPanelRun(itemID, repoPath, diffHash string, cfg config.ReviewPanelConfig) *ReviewPanelRun {
+	run := &ReviewPanelRun{
+		ItemID:        itemID,
+		RepoPath:      repoPath,
+		DiffHash:      diffHash,
+		Consensus:     ParseConsensusPolicy(cfg.Consensus),
+		PassThreshold: cfg.PassThreshold,
+	}
+	for i, rc := range cfg.Reviewers {
+		name := rc.Name
+		if name == "" {
+			name = fmt.Sprintf("reviewer-%d", i+1)
+		}
+		run.Reviewers = append(run.Reviewers, PanelReviewer{
+			Name:    name,
+			Program: ReviewerProgram(rc),
+			Focus:   rc.Focus,
+			Weight:  rc.Weight,
+		})
+	}
+	if len(run.Reviewers) == 0 {
+		run.Reviewers = []PanelReviewer{{Name: "reviewer"}}
+	}
+	for i := range run.Reviewers {
+		if run.Reviewers[i].Weight <= 0 {
+			run.Reviewers[i].Weight = 1
+		}
+	}
+	return run
+}
+
+// ParseConsensusPolicy maps a config value to a policy, defaulting to unanimous.
+func ParseConsensusPolicy(s string) ConsensusPolicy {
+	switch p := ConsensusPolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case ConsensusMajority, ConsensusWeighted:
+		return p
+	default:
+		return ConsensusUnanimous
+	}
+}
+
+// ReviewerProgram returns the command line for a configured reviewer, or ""
+// to use the default program.
+func ReviewerProgram(rc config.ReviewerConfig) string {
+	program := strings.TrimSpace(rc.Program)
+	if rc.Model == "" {
+		return program
+	}
+	if program == "" {
+		program = "claude"
+	}
+	return program + " --model " + rc.Model
+}
+
+// ReviewDiffHash identifies the diff a review was run against.
+func ReviewDiffHash(diff string) string {
+	sum := sha256.Sum256([]byte(diff))
+	return hex.EncodeToString(sum[:])
+}
+
+// outcomeSeverity orders outcomes so ties resolve to the more cautious one.
+func outcomeSeverity(outcome string) int {
+	switch outcome {
+	case ReviewVerdictFail:
+		return 3
+	case ReviewVerdictPartial:
+		return 2
+	case ReviewVerdictUnverifiable:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ReviewVote is one reviewer's outcome and voting weight.
+type ReviewVote struct {
+	Outcome string
+	Weight  float64
+}
+
+// ConsensusOutcome combines votes under policy. For the weighted policy,
+// threshold is the share of total weight that must PASS (0 means more than
+// half). A panel that does not pass takes the non-PASS outcome with the most
+// weight, ties going to the more severe outcome. No votes is a FAIL.
+func ConsensusOutcome(policy ConsensusPolicy, threshold float64, votes []ReviewVote) string {
+	if len(votes) == 0 {
+		return ReviewVerdictFail
+	}
+	if policy == ConsensusUnanimous {
+		cvs := make([]CriterionVerdict, len(votes))
+		for i, v := range votes {
+			cvs[i] = CriterionVerdict{Outcome: v.Outcome}
+		}
+		return AggregateOutcome(cvs)
+	}
+
+	var total, pass float64
+	against := make(map[string]float64)
+	for _, v := range votes {
+		w := v.Weight
+		if policy == ConsensusMajority || w <= 0 {
+			w = 1
+		}
+		total += w
+		if v.Outcome == ReviewVerdictPass {
+			pass += w
+		} else {
+			against[v.Outcome] += w
+		}
+	}
+	passes := pass*2 > total
+	if policy == ConsensusWeighted && threshold > 0 {
+		passes = pass >= threshold*total
+	}
+	if passes {
+		return ReviewVerdictPass
+	}
+
+	best, bestWeight := ReviewVerdictFail, -1.0
+	for outcome, w := range against {
+		if w > bestWeight || (w == bestWeight && outcomeSeverity(outcome) > outcomeSeverity(best)) {
+			best, bestWeight = outcome, w
+		}
+	}
+	return best
+}
+
+// decide computes the consensus outcome, merged per-criterion verdicts,
+// disagreements and mean rubric once every reviewer has submitted.
+func (r *ReviewPanelRun) decide(now time.Time) {
+	overall := make([]ReviewVote, 0, len(r.Reviewers))
+	overallByName := make(map[string]string, len(r.Reviewers))
+	byCriterion := make(map[int][]ReviewVote)
+	criterionOutcomes := make(map[int]map[string]string)
+	evidence := make(map[int][]string)
+
+	var rubric RubricScores
+	var rubricWeight float64
+	for _, rv := range r.Reviewers {
+		v := rv.Verdict
+		overall = append(overall, ReviewVote{Outcome: v.Outcome, Weight: rv.Weight})
+		overallByName[rv.Name] = v.Outcome
+		for _, cv := range v.PerCriterion {
+			byCriterion[cv.CriterionIndex] = append(byCriterion[cv.CriterionIndex], ReviewVote{Outcome: cv.Outcome, Weight: rv.Weight})
+			if criterionOutcomes[cv.CriterionIndex] == nil {
+				criterionOutcomes[cv.CriterionIndex] = make(map[string]string)
+			}
+			criterionOutcomes[cv.CriterionIndex][rv.Name] = cv.Outcome
+			if cv.Evidence != "" {
+				e := cv.Evidence
+				if len(r.Reviewers) > 1 {
+					e = fmt.Sprintf("[%s] %s", rv.Name, e)
+				}
+				evidence[cv.CriterionIndex] = append(evidence[cv.CriterionIndex], e)
+			}
+		}
+		if v.Rubric != nil {
+			rubric.Correctness += v.Rubric.Correctness * rv.Weight
+			rubric.Tests += v.Rubric.Tests * rv.Weight
+			rubric.Style += v.Rubric.Style * rv.Weight
+			rubric.Security += v.Rubric.Security * rv.Weight
+			rubricWeight += rv.Weight
+		}
+	}
+
+	r.Outcome = ConsensusOutcome(r.Consensus, r.PassThreshold, overall)
+	r.Disagreements = nil
+	if !allSame(overallByName) {
+		r.Disagreements = append(r.Disagreements, ReviewDisagreement{CriterionIndex: -1, Outcomes: overallByName})
+	}
+
+	indices := make([]int, 0, len(byCriterion))
+	for idx := range byCriterion {
+		indices = append(indices, idx)
+	}
+	sort.Ints(indices)
+	r.PerCriterion = make([]CriterionVerdict, 0, len(indices))
+	for _, idx := range indices {
+		r.PerCriterion = append(r.PerCriterion, CriterionVerdict{
+			CriterionIndex: idx,
+			Outcome:        ConsensusOutcome(r.Consensus, r.PassThreshold, byCriterion[idx]),
+			Evidence:       strings.Join(evidence[idx], "\n"),
+		})
+		if !allSame(criterionOutcomes[idx]) {
+			r.Disagreements = append(r.Disagreements, ReviewDisagreement{CriterionIndex: idx, Outcomes: criterionOutcomes[idx]})
+		}
+	}
+
+	r.Rubric = nil
+	if rubricWeight > 0 {
+		r.Rubric = &RubricScores{
+			Correctness: rubric.Correctness / rubricWeight,
+			Tests:       rubric.Tests / rubricWeight,
+			Style:       rubric.Style / rubricWeight,
+			Security:    rubric.Security / rubricWeight,
+		}
+	}
+	r.DecidedAt = &now
+}
+
+func allSame(outcomes map[string]string) bool {
+	first := ""
+	for _, o := range outcomes {
+		if first == "" {
+			first = o
+		} else if o != first {
+			return false
+		}
+	}
+	return true
+}
+
+// Summary renders the decision for the item-level ReviewVerdict: the policy
+// and tally, each reviewer's outcome and summary, and any disagreements.
+func (r *ReviewPanelRun) Summary() string {
+	if len(r.Reviewers) == 0 {
+		return ReviewChecksFailureSummary(r.Checks)
+	}
+	if len(r.Reviewers) == 1 && r.Reviewers[0].Verdict != nil {
+		return r.Reviewers[0].Verdict.Summary
+	}
+	passed := 0
+	for _, rv := range r.Reviewers {
+		if rv.Verdict != nil && rv.Verdict.Outcome == ReviewVerdictPass {
+			passed++
+		}
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Review panel (%s): %d of %d reviewers passed.\n", r.Consensus, passed, len(r.Reviewers))
+	for _, rv := range r.Reviewers {
+		if rv.Verdict == nil {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n[%s] %s: %s", rv.Name, rv.Verdict.Outcome, rv.Verdict.Summary)
+	}
+	if len(r.Disagreements) > 0 {
+		sb.WriteString("\n\nDisagreements:")
+		for _, d := range r.Disagreements {
+			names := make([]string, 0, len(d.Outcomes))
+			for name := range d.Outcomes {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			parts := make([]string, len(names))
+			for i, name := range names {
+				parts[i] = name + "=" + d.Outcomes[name]
+			}
+			what := "overall"
+			if d.CriterionIndex >= 0 {
+				what = fmt.Sprintf("criterion %d", d.CriterionIndex)
+			}
+			fmt.Fprintf(&sb, "\n- %s: %s", what, strings.Join(parts, ", "))
+		}
+	}
+	return sb.String()
+}
+
+// ReviewPanelStore persists review panel runs: which review sessions belong
+// to which run, their individual verdicts and rubric scores, and the decided
+// consensus. The stdio MCP server records verdicts from a separate process,
+// so every operation re-reads the file under an flock (shared for reads,
+// exclusive for updates) and writes go through a unique temp file. All public
+// methods are thread-safe.
+type ReviewPanelStore struct {
+	mu   deadlock.Mutex
+	path string
+	runs []*ReviewPanelRun // current state; reloaded by view and update
+}
+
+// NewReviewPanelStore loads (or creates) the panel file at the given path.
+// An empty path yields an in-memory store.
+func NewReviewPanelStore(path string) (*ReviewPanelStore, error) {
+	s := &ReviewPanelStore{path: path}
+	if err := s.view(func() error { return nil }); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Create assigns the run an ID and stores it.
+func (s *ReviewPanelStore) Create(run *ReviewPanelRun) error {
+	return s.update(func() error {
+		run.ID = uuid.NewString()
+		run.CreatedAt = time.Now()
+		s.runs = append(s.runs, cloneRun(run))
+		s.prune()
+		return nil
+	})
+}
+
+// BindReviewer links the reviewer at index to its review session. Bind before
+// the session's ItemSession is created so its verdict cannot arrive unbound.
+func (s *ReviewPanelStore) BindReviewer(runID string, index int, sessionUUID string) error {
+	return s.update(func() error {
+		run := s.find(runID)
+		if run == nil {
+			return ErrNotFound
+		}
+		if index < 0 || index >= len(run.Reviewers) {
+			return fmt.Errorf("review panel %s has no reviewer %d", runID, index)
+		}
+		run.Reviewers[index].SessionUUID = sessionUUID
+		return nil
+	})
+}
+
+// RecordVerdict stores the verdict of the reviewer running sessionUUID,
+// replacing any earlier submission. When that completes the panel, the run is
+// decided and decided is true; the returned run is a snapshot. It returns
+// ErrNotFound when the session is not on any panel and ErrPanelDecided when
+// its panel has already decided.
+func (s *ReviewPanelStore) RecordVerdict(sessionUUID string, v PanelVerdict) (run *ReviewPanelRun, decided bool, err error) {
+	return s.record(func(r *ReviewPanelRun) int { return r.reviewerIndex(sessionUUID) }, v, true)
+}
+
+// MarkSessionAbsent records an UNVERIFIABLE verdict for the reviewer running
+// sessionUUID if it exited without submitting one, so the panel can still
+// decide. Results are as for RecordVerdict.
+func (s *ReviewPanelStore) MarkSessionAbsent(sessionUUID, reason string) (run *ReviewPanelRun, decided bool, err error) {
+	return s.record(func(r *ReviewPanelRun) int { return r.reviewerIndex(sessionUUID) }, absentVerdict(reason), false)
+}
+
+// MarkReviewerAbsent is MarkSessionAbsent for a reviewer whose session never
+// started.
+func (s *ReviewPanelStore) MarkReviewerAbsent(runID string, index int, reason string) (run *ReviewPanelRun, decided bool, err error) {
+	return s.record(func(r *ReviewPanelRun) int {
+		if r.ID != runID || index >= len(r.Reviewers) {
+			return -1
+		}
+		return index
+	}, absentVerdict(reason), false)
+}
+
+func absentVerdict(reason string) PanelVerdict {
+	return PanelVerdict{Outcome: ReviewVerdictUnverifiable, Summary: reason}
+}
+
+func (r *ReviewPanelRun) reviewerIndex(sessionUUID string) int {
+	if sessionUUID == "" {
+		return -1
+	}
+	for i, rv := range r.Reviewers {
+		if rv.SessionUUID == sessionUUID {
+			return i
+		}
+	}
+	return -1
+}
+
+// errNoChange aborts an update without saving; update reports success.
+var errNoChange = errors.New("no change")
+
+// record stores v for the first reviewer that match selects. With replace
+// false an existing verdict is kept.
+func (s *ReviewPanelStore) record(match func(*ReviewPanelRun) int, v PanelVerdict, replace bool) (*ReviewPanelRun, bool, error) {
+	var snapshot *ReviewPanelRun
+	var complete bool
+	err := s.update(func() error {
+		for _, r := range s.runs {
+			i := match(r)
+			if i < 0 {
+				continue
+			}
+			if r.Decided() {
+				snapshot = cloneRun(r)
+				return ErrPanelDecided
+			}
+			if r.Reviewers[i].Verdict != nil && !replace {
+				snapshot = cloneRun(r)
+				return errNoChange
+			}
+			if v.SubmittedAt.IsZero() {
+				v.SubmittedAt = time.Now()
+			}
+			r.Reviewers[i].Verdict = &v
+			complete = true
+			for _, rv := range r.Reviewers {
+				complete = complete && rv.Verdict != nil
+			}
+			if complete {
+				r.decide(time.Now())
+			}
+			snapshot = cloneRun(r)
+			return nil
+		}
+		return ErrNotFound
+	})
+	switch {
+	case errors.Is(err, ErrPanelDecided):
+		return snapshot, false, err
+	case err != nil:
+		return nil, false, err
+	}
+	return snapshot, complete, nil
+}
+
+// ReviewPanelGateSessionUUID is the placeholder session UUID that carries a
+// run's item-level verdict when no reviewer session could.
+func ReviewPanelGateSessionUUID(runID string) string {
+	return "review-panel-" + runID
+}
+
+// RunForSession returns the run that sessionUUID reviewed, or whose verdict it
+// carries as the gate placeholder.
+func (s *ReviewPanelStore) RunForSession(sessionUUID string) (*ReviewPanelRun, error) {
+	var run *ReviewPanelRun
+	err := s.view(func() error {
+		for i := len(s.runs) - 1; i >= 0; i-- {
+			r := s.runs[i]
+			if r.reviewerIndex(sessionUUID) >= 0 || ReviewPanelGateSessionUUID(r.ID) == sessionUUID {
+				run = cloneRun(r)
+				return nil
+			}
+		}
+		return ErrNotFound
+	})
+	return run, err
+}
+
+// Get returns a snapshot of the run with the given ID.
+func (s *ReviewPanelStore) Get(runID string) (*ReviewPanelRun, error) {
+	var run *ReviewPanelRun
+	err := s.view(func() error {
+		r := s.find(runID)
+		if r == nil {
+			return ErrNotFound
+		}
+		run = cloneRun(r)
+		return nil
+	})
+	return run, err
+}
+
+// List returns snapshots of the runs matching itemID and repoPath (empty
+// matches all), newest first, at most limit (0 = no limit). When the file
+// cannot be read the runs last read are listed.
+func (s *ReviewPanelStore) List(itemID, repoPath string, decidedOnly bool, limit int) []*ReviewPanelRun {
+	var out []*ReviewPanelRun
+	collect := func() error {
+		for i := len(s.runs) - 1; i >= 0; i-- {
+			r := s.runs[i]
+			if (itemID != "" && r.ItemID != itemID) || (repoPath != "" && r.RepoPath != repoPath) || (decidedOnly && !r.Decided()) {
+				continue
+			}
+			out = append(out, cloneRun(r))
+			if limit > 0 && len(out) == limit {
+				break
+			}
+		}
+		return nil
+	}
+	if err := s.view(collect); err != nil {
+		log.Warn("review panels: reload failed, listing cached runs", "err", err)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		out = nil
+		_ = collect()
+	}
+	return out
+}
+
+func (s *ReviewPanelStore) find(runID string) *ReviewPanelRun {
+	for _, r := range s.runs {
+		if r.ID == runID {
+			return r
+		}
+	}
+	return nil
+}
+
+// prune drops the oldest decided runs beyond maxReviewPanelRuns.
+func (s *ReviewPanelStore) prune() {
+	excess := len(s.runs) - maxReviewPanelRuns
+	if excess <= 0 {
+		return
+	}
+	kept := s.runs[:0]
+	for _, r := range s.runs {
+		if excess > 0 && r.Decided() {
+			excess--
+			continue
+		}
+		kept = append(kept, r)
+	}
+	s.runs = kept
+}
+
+// view reloads the runs under a shared file lock and runs fn on them
+// without saving.
+func (s *ReviewPanelStore) view(fn func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.path == "" {
+		return fn()
+	}
+	lock := flock.New(s.path + ".lock")
+	if err := lock.RLock(); err != nil {
+		return fmt.Errorf("review panels: acquire read lock: %w", err)
+	}
+	defer lock.Unlock() //nolint:errcheck
+	if err := s.load(); err != nil {
+		return err
+	}
+	return fn()
+}
+
+// update reloads the runs under an exclusive file lock, runs fn and saves
+// the result if fn succeeds. errNoChange from fn skips the save and is not
+// reported.
+func (s *ReviewPanelStore) update(fn func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.path == "" {
+		if err := fn(); err != nil && !errors.Is(err, errNoChange) {
+			return err
+		}
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("create review panels dir: %w", err)
+	}
+	lock := flock.New(s.path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("review panels: acquire write lock: %w", err)
+	}
+	defer lock.Unlock() //nolint:errcheck
+	if err := s.load(); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		if errors.Is(err, errNoChange) {
+			return nil
+		}
+		return err
+	}
+	return s.save()
+}
+
+// load reads the runs file. Caller must hold s.mu and the file lock.
+func (s *ReviewPanelStore) load() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.runs = nil
+			return nil
+		}
+		return fmt.Errorf("read review panels: %w", err)
+	}
+	var runs []*ReviewPanelRun
+	if err := json.Unmarshal(data, &runs); err != nil {
+		return fmt.Errorf("unmarshal review panels: %w", err)
+	}
+	s.runs = runs
+	return nil
+}
+
+// save writes the runs file atomically through a temp file unique to this
+// write. Caller must hold s.mu and the write lock.
+func (s *ReviewPanelStore) save() error {
+	data, err := json.MarshalIndent(s.runs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal review panels: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temp review panels: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write temp review panels: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("close temp review panels: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("rename review panels: %w", err)
+	}
+	return nil
+}
+
+// cloneRun deep-copies a run via JSON so callers cannot mutate stored state.
+func cloneRun(r *ReviewPanelRun) *ReviewPanelRun {
+	data, _ := json.Marshal(r)
+	var out ReviewPanelRun
+	_ = json.Unmarshal(data, &out)
+	return &out
+}
+
+// SaveReviewPanelDecision records a decided run's consensus as the
+// item-level ReviewVerdict on itemSessionID (the review session that completed
+// the panel) and moves the item from review to done on PASS. Individual
+// reviewer verdicts stay in the panel store so they never gate on their own.
+func SaveReviewPanelDecision(ctx context.Context, storage *Storage, run *ReviewPanelRun, itemSessionID string) error {
+	perCriterionJSON, err := json.Marshal(run.PerCriterion)
+	if err != nil {
+		return fmt.Errorf("serialize panel verdicts: %w", err)
+	}
+	if _, err := storage.SaveReviewVerdict(ctx, itemSessionID, ReviewVerdictData{
+		ItemSessionID:  itemSessionID,
+		OverallOutcome: run.Outcome,
+		PerCriterion:   string(perCriterionJSON),
+		Summary:        run.Summary(),
+		DiffHash:       run.DiffHash,
+	}); err != nil {
+		return fmt.Errorf("save review verdict: %w", err)
+	}
+	if run.Outcome == ReviewVerdictPass {
+		precondition := &BacklogItemPrecondition{ExpectedStatus: string(BacklogStatusReview)}
+		if _, err := storage.TransitionBacklogItemStatus(ctx, run.ItemID, BacklogStatusDone, precondition); err != nil {
+			// Non-fatal — the verdict is saved, the status transition is best-effort.
+			log.InfoLog.Printf("[ReviewPanel] PASS but transition of item %s to done failed: %v", run.ItemID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package session
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tstapler/stapler-squad/config"
+)
+
+func TestConsensusOutcome(t *testing.T) {
+	pass := ReviewVote{Outcome: ReviewVerdictPass, Weight: 1}
+	fail := ReviewVote{Outcome: ReviewVerdictFail, Weight: 1}
+	partial := ReviewVote{Outcome: ReviewVerdictPartial, Weight: 1}
+
+	tests := []struct {
+		name      string
+		policy    ConsensusPolicy
+		threshold float64
+		votes     []ReviewVote
+		want      string
+	}{
+		{"unanimous all pass", ConsensusUnanimous, 0, []ReviewVote{pass, pass}, ReviewVerdictPass},
+		{"unanimous one partial", ConsensusUnanimous, 0, []ReviewVote{pass, partial, pass}, ReviewVerdictPartial},
+		{"majority passes", ConsensusMajority, 0, []ReviewVote{pass, pass, fail}, ReviewVerdictPass},
+		{"majority tie does not pass", ConsensusMajority, 0, []ReviewVote{pass, fail}, ReviewVerdictFail},
+		{"majority ignores weight", ConsensusMajority, 0, []ReviewVote{{Outcome: ReviewVerdictPass, Weight: 5}, fail, fail}, ReviewVerdictFail},
+		{"majority non-pass tie goes to severity", ConsensusMajority, 0, []ReviewVote{pass, fail, partial}, ReviewVerdictFail},
+		{"weighted heavy pass", ConsensusWeighted, 0, []ReviewVote{{Outcome: ReviewVerdictPass, Weight: 3}, fail, fail}, ReviewVerdictPass},
+		{"weighted threshold not met", ConsensusWeighted, 0.75, []ReviewVote{{Outcome: ReviewVerdictPass, Weight: 2}, partial}, ReviewVerdictPartial},
+		{"weighted threshold met", ConsensusWeighted, 0.6, []ReviewVote{{Outcome: ReviewVerdictPass, Weight: 2}, partial}, ReviewVerdictPass},
+		{"no votes", ConsensusMajority, 0, nil, ReviewVerdictFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ConsensusOutcome(tt.policy, tt.threshold, tt.votes))
+		})
+	}
+}
+
+func TestNewReviewPanelRun_FromConfig(t *testing.T) {
+	run := NewReviewPanelRun("item", "/repo", "hash", config.ReviewPanelConfig{})
+	require.Len(t, run.Reviewers, 1, "no configured reviewers keeps the single default reviewer")
+	assert.Equal(t, ConsensusUnanimous, run.Consensus)
+	assert.Equal(t, 1.0, run.Reviewers[0].Weight)
+
+	run = NewReviewPanelRun("item", "/repo", "hash", config.ReviewPanelConfig{
+		Consensus: "Weighted",
+		Reviewers: []config.ReviewerConfig{
+			{Name: "opus", Model: "opus", Weight: 2},
+			{Program: "codex", Focus: "tests"},
+		},
+	})
+	assert.Equal(t, ConsensusWeighted, run.Consensus)
+	require.Len(t, run.Reviewers, 2)
+	assert.Equal(t, "claude --model opus", run.Reviewers[0].Program)
+	assert.Equal(t, 2.0, run.Reviewers[0].Weight)
+	assert.Equal(t, "reviewer-2", run.Reviewers[1].Name)
+	assert.Equal(t, "codex", run.Reviewers[1].Program)
+	assert.Equal(t, "tests", run.Reviewers[1].Focus)
+}
+
+func TestReviewPanelStore_DecidesWhenAllReviewersSubmit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "review_panels.json")
+	store, err := NewReviewPanelStore(path)
+	require.NoError(t, err)
+
+	run := NewReviewPanelRun("item-1", "/repo", "hash", config.ReviewPanelConfig{
+		Consensus: "majority",
+		Reviewers: []config.ReviewerConfig{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+	})
+	require.NoError(t, store.Create(run))
+	require.NoError(t, store.BindReviewer(run.ID, 0, "sess-a"))
+	require.NoError(t, store.BindReviewer(run.ID, 1, "sess-b"))
+
+	_, _, err = store.RecordVerdict("unknown", PanelVerdict{Outcome: ReviewVerdictPass})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	got, decided, err := store.RecordVerdict("sess-a", PanelVerdict{
+		Outcome:      ReviewVerdictPass,
+		PerCriterion: []CriterionVerdict{{CriterionIndex: 0, Outcome: ReviewVerdictPass, Evidence: "adds handler"}},
+		Summary:      "looks good",
+		Rubric:       &RubricScores{Correctness: 9, Tests: 8, Style: 7, Security: 10},
+	})
+	require.NoError(t, err)
+	assert.False(t, decided)
+	assert.Empty(t, got.Outcome)
+
+	_, decided, err = store.RecordVerdict("sess-b", PanelVerdict{
+		Outcome:      ReviewVerdictFail,
+		PerCriterion: []CriterionVerdict{{CriterionIndex: 0, Outcome: ReviewVerdictFail, Evidence: "no tests"}},
+		Summary:      "missing tests",
+		Rubric:       &RubricScores{Correctness: 7, Tests: 2, Style: 7, Security: 10},
+	})
+	require.NoError(t, err)
+	assert.False(t, decided)
+
+	// The third reviewer never started; recording it decides the panel.
+	got, decided, err = store.MarkReviewerAbsent(run.ID, 2, "reviewer failed to start")
+	require.NoError(t, err)
+	require.True(t, decided)
+	assert.Equal(t, ReviewVerdictFail, got.Outcome, "1 of 3 passing is not a majority")
+	assert.Equal(t, ReviewVerdictUnverifiable, got.Reviewers[2].Verdict.Outcome)
+
+	require.NotNil(t, got.Rubric, "rubric averages only the reviewers that scored")
+	assert.InDelta(t, 5.0, got.Rubric.Tests, 0.001)
+	assert.InDelta(t, 8.0, got.Rubric.Correctness, 0.001)
+
+	require.Len(t, got.PerCriterion, 1)
+	assert.Contains(t, got.PerCriterion[0].Evidence, "[a] adds handler")
+	assert.Contains(t, got.PerCriterion[0].Evidence, "[b] no tests")
+
+	require.Len(t, got.Disagreements, 2)
+	assert.Equal(t, -1, got.Disagreements[0].CriterionIndex)
+	assert.Equal(t, map[string]string{"a": ReviewVerdictPass, "b": ReviewVerdictFail}, got.Disagreements[1].Outcomes)
+	assert.Contains(t, got.Summary(), "Review panel (majority): 1 of 3 reviewers passed.")
+	assert.Contains(t, got.Summary(), "criterion 0: a=PASS, b=FAIL")
+
+	// Late submissions and exits do not reopen a decided panel.
+	_, _, err = store.RecordVerdict("sess-a", PanelVerdict{Outcome: ReviewVerdictFail})
+	assert.ErrorIs(t, err, ErrPanelDecided)
+
+	// The history survives a reload and is listed newest first.
+	reloaded, err := NewReviewPanelStore(path)
+	require.NoError(t, err)
+	runs := reloaded.List("item-1", "", true, 0)
+	require.Len(t, runs, 1)
+	assert.Equal(t, ReviewVerdictFail, runs[0].Outcome)
+	assert.Empty(t, reloaded.List("other-item", "", false, 0))
+}
+
+// A second store on the same file stands in for the stdio MCP server, which
+// records verdicts from another process.
+func TestReviewPanelStore_ReadsSeeOtherProcessWrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "review_panels.json")
+	server, err := NewReviewPanelStore(path)
+	require.NoError(t, err)
+	mcp, err := NewReviewPanelStore(path)
+	require.NoError(t, err)
+
+	run := NewReviewPanelRun("item-1", "/repo", "hash", config.ReviewPanelConfig{})
+	require.NoError(t, server.Create(run))
+	require.NoError(t, server.BindReviewer(run.ID, 0, "sess-a"))
+
+	_, decided, err := mcp.RecordVerdict("sess-a", PanelVerdict{Outcome: ReviewVerdictPass, Summary: "ok"})
+	require.NoError(t, err)
+	require.True(t, decided)
+
+	got, err := server.Get(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ReviewVerdictPass, got.Outcome)
+	bySession, err := server.RunForSession("sess-a")
+	require.NoError(t, err)
+	assert.True(t, bySession.Decided())
+	assert.Len(t, server.List("item-1", "", true, 0), 1)
+
+	// Neither store left a temp file behind.
+	leftovers, err := filepath.Glob(path + ".*.tmp")
+	require.NoError(t, err)
+	assert.Empty(t, leftovers)
+}
+
+func TestReviewPanelStore_MarkSessionAbsentKeepsSubmittedVerdict(t *testing.T) {
+	store, err := NewReviewPanelStore("")
+	require.NoError(t, err)
+
+	run := NewReviewPanelRun("item-1", "", "", config.ReviewPanelConfig{
+		Reviewers: []config.ReviewerConfig{{Name: "a"}, {Name: "b"}},
+	})
+	require.NoError(t, store.Create(run))
+	require.NoError(t, store.BindReviewer(run.ID, 0, "sess-a"))
+	require.NoError(t, store.BindReviewer(run.ID, 1, "sess-b"))
+
+	_, _, err = store.RecordVerdict("sess-a", PanelVerdict{Outcome: ReviewVerdictPass})
+	require.NoError(t, err)
+	got, decided, err := store.MarkSessionAbsent("sess-a", "exited")
+	require.NoError(t, err)
+	assert.False(t, decided)
+	assert.Equal(t, ReviewVerdictPass, got.Reviewers[0].Verdict.Outcome, "a normal exit after submitting keeps the verdict")
+
+	got, decided, err = store.MarkSessionAbsent("sess-b", "exited")
+	require.NoError(t, err)
+	require.True(t, decided)
+	assert.Equal(t, ReviewVerdictUnverifiable, got.Outcome)
+}
+
+func TestRubricScores_Validate(t *testing.T) {
+	assert.NoError(t, RubricScores{Correctness: 10, Tests: 0, Style: 5.5, Security: 7}.Validate())
+	assert.Error(t, RubricScores{Correctness: 11}.Validate())
+	assert.Error(t, RubricScores{Security: -1}.Validate())
+}
@@ -22,6 +22,8 @@ const (
 	ReasonIdle               = queue.ReasonIdle
 	ReasonStale              = queue.ReasonStale
 	ReasonWaitingForUser     = queue.ReasonWaitingForUser
+	ReasonCIFailing          = queue.ReasonCIFailing
+	ReasonResourceLimit      = queue.ReasonResourceLimit
 )
 
 // Priority re-export
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/tstapler/stapler-squad/log"
+	"github.com/tstapler/stapler-squad/session/activity"
 	"github.com/tstapler/stapler-squad/session/detection"
 )
 
@@ -82,7 +83,38 @@ func (d *DefaultStatusDeterminer) Determine(
 	var ctx string
 	cleanWorktree := false
 
-	if statusInfo.IsControllerActive {
+	if statusInfo.HasActivity {
+		// Hook-reported agent state is ground truth. Terminal pattern detection
+		// below is the fallback for agents that do not report hooks.
+		switch statusInfo.Activity.Phase {
+		case activity.PhaseWorking:
+			return DetectionResult{Action: DetectionActionRemove, ClaudeStatus: claudeStatus}
+		case activity.PhaseWaiting:
+			if statusInfo.Activity.WaitingForAnswer() {
+				reason = ReasonInputRequired
+				priority = PriorityMedium
+			} else {
+				reason = ReasonApprovalPending
+				priority = PriorityHigh
+			}
+			shouldAdd = true
+			ctx = statusInfo.StatusContext
+		case activity.PhaseDone:
+			reason = ReasonTaskComplete
+			priority = PriorityLow
+			shouldAdd = true
+			ctx = statusInfo.StatusContext
+		}
+
+		if priority == PriorityLow && inst.HasGitWorktree() {
+			dirty, clean := worktreeDirty(inst)
+			if dirty {
+				reason = ReasonUncommittedChanges
+				ctx = "Uncommitted changes ready to commit"
+			}
+			cleanWorktree = clean
+		}
+	} else if statusInfo.IsControllerActive {
 		// Use statusInfo.IdleState.State — already populated by GetStatus() via controller.GetIdleStateInfo().
 		// This avoids a redundant GetController()+GetIdleState() call.
 		idleState := statusInfo.IdleState.State
@@ -242,6 +274,24 @@ func (d *DefaultStatusDeterminer) Determine(
 		}
 	}
 
+	// Failing CI on the session's PR (reported by CIFailureWatcher) outranks idle,
+	// completion and uncommitted-change reasons.
+	if ciFailure := inst.CIFailure(); ciFailure != "" && (!shouldAdd || priority.IsLowerThan(PriorityHigh)) {
+		reason = ReasonCIFailing
+		priority = PriorityHigh
+		shouldAdd = true
+		ctx = ciFailure
+	}
+
+	// An OOM kill or resource limit hit (reported by ResourceMonitor) likewise
+	// outranks idle and completion reasons; the agent's work was likely cut short.
+	if alert := inst.ResourceAlert(); alert != "" && (!shouldAdd || priority.IsLowerThan(PriorityHigh)) {
+		reason = ReasonResourceLimit
+		priority = PriorityHigh
+		shouldAdd = true
+		ctx = alert
+	}
+
 	// Check for terminal staleness (no meaningful output for configured threshold)
 	// IMPORTANT: Respect acknowledgment - don't flag as stale if user already acknowledged
 	timeSinceOutput := inst.GetTimeSinceLastMeaningfulOutput()
@@ -290,3 +340,22 @@ func (d *DefaultStatusDeterminer) Determine(
 		CleanWorktree: cleanWorktree,
 	}
 }
+
+// worktreeDirty reports whether the session's worktree has uncommitted
+// changes, and whether it was inspected and found clean. Both are false when
+// the worktree could not be inspected.
+func worktreeDirty(inst *Instance) (dirty, clean bool) {
+	worktree, err := inst.GetGitWorktree()
+	if err != nil || worktree == nil {
+		if err != nil {
+			log.Warn("failed to get git worktree", "session", inst.Title, "err", err)
+		}
+		return false, false
+	}
+	isDirty, err := worktree.IsDirty()
+	if err != nil {
+		log.Warn("failed to check git status", "session", inst.Title, "err", err)
+		return false, false
+	}
+	return isDirty, !isDirty
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/tstapler/stapler-squad/session/activity"
 	"github.com/tstapler/stapler-squad/session/detection"
 )
 
@@ -208,6 +209,52 @@ func TestDefaultStatusDeterminer_Determine(t *testing.T) {
 			wantAction:  DetectionActionSkip,
 		},
 
+		// --- Hook-reported activity path ---
+		{
+			// Hooks say the agent is working; the approval regex match is ignored.
+			name:    "hook_working_overrides_terminal_detection",
+			content: "Yes, allow reading /etc/hosts\nYes, allow once",
+			statusInfo: InstanceStatusInfo{
+				HasActivity: true,
+				Activity:    activity.State{Phase: activity.PhaseWorking, Tool: "Bash"},
+			},
+			checkAction: true,
+			wantAction:  DetectionActionRemove,
+		},
+		{
+			name: "hook_waiting_for_permission",
+			statusInfo: InstanceStatusInfo{
+				HasActivity: true,
+				Activity:    activity.State{Phase: activity.PhaseWaiting, Tool: "Bash"},
+			},
+			checkAction:  true,
+			wantAction:   DetectionActionAdd,
+			wantReason:   ReasonApprovalPending,
+			wantPriority: PriorityHigh,
+		},
+		{
+			name: "hook_waiting_for_answer",
+			statusInfo: InstanceStatusInfo{
+				HasActivity: true,
+				Activity:    activity.State{Phase: activity.PhaseWaiting, Tool: "AskUserQuestion"},
+			},
+			checkAction:  true,
+			wantAction:   DetectionActionAdd,
+			wantReason:   ReasonInputRequired,
+			wantPriority: PriorityMedium,
+		},
+		{
+			name: "hook_turn_done",
+			statusInfo: InstanceStatusInfo{
+				HasActivity: true,
+				Activity:    activity.State{Phase: activity.PhaseDone, StopReason: "end_turn"},
+			},
+			checkAction:  true,
+			wantAction:   DetectionActionAdd,
+			wantReason:   ReasonTaskComplete,
+			wantPriority: PriorityLow,
+		},
+
 		// --- Staleness path ---
 		{
 			name:    "stale_session_adds_stale_reason",
@@ -0,0 +1,117 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/executor/safeexec"
+)
+
+// InnerProxyAddr is where the forwarder listens inside the sandbox's network
+// namespace; the agent's proxy environment points at it.
+const InnerProxyAddr = "127.0.0.1:3128"
+
+// Run executes command under the policy and returns its exit code. self is
+// the squad executable, re-run inside the sandbox as the proxy forwarder in
+// allowlist mode.
+func Run(p *Policy, self string, command []string) (int, error) {
+	if err := Available(); err != nil {
+		return 1, err
+	}
+	if len(command) == 0 {
+		return 1, errors.New("no command to run")
+	}
+	cwd, _ := os.Getwd()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if p.Network == config.SandboxNetworkAllowlist {
+		socket := ProxySocketPath(p.Dir)
+		_ = os.Remove(socket)
+		l, err := net.Listen("unix", socket)
+		if err != nil {
+			return 1, fmt.Errorf("listen on proxy socket: %w", err)
+		}
+		defer os.Remove(socket)
+		srv := &http.Server{Handler: NewProxy(NewAllowlist(p.AllowedHosts), NewDenialLog(DenialLogPath(p.Dir)))}
+		go func() { _ = srv.Serve(l) }()
+		defer srv.Close()
+		command = append([]string{self, "sandbox-exec", "--inner", "--proxy-socket", socket, "--"}, command...)
+	}
+
+	return runChild(ctx, "bwrap", p.BwrapArgs(cwd, command))
+}
+
+// RunInner runs inside the sandbox in allowlist mode: it relays the agent's
+// proxy traffic to the proxy socket and runs command with the proxy
+// environment set.
+func RunInner(socket string, command []string) (int, error) {
+	if len(command) == 0 {
+		return 1, errors.New("no command to run")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	l, err := net.Listen("tcp", InnerProxyAddr)
+	if err != nil {
+		return 1, fmt.Errorf("listen for proxy clients: %w", err)
+	}
+	go func() { _ = Forward(ctx, l, socket) }()
+
+	proxyURL := "http://" + InnerProxyAddr
+	for _, key := range []string{"HTTP_PROXY", "HTTPS_PROXY", "ALL_PROXY"} {
+		os.Setenv(key, proxyURL)
+		os.Setenv(strings.ToLower(key), proxyURL)
+	}
+	// Loopback inside the namespace is empty, so nothing may bypass the proxy.
+	os.Unsetenv("NO_PROXY")
+	os.Unsetenv("no_proxy")
+
+	return runChild(ctx, command[0], command[1:])
+}
+
+// runChild runs a child on the caller's terminal and returns its exit code.
+// SIGINT reaches the child directly through the terminal's process group, so
+// it is only swallowed here; SIGTERM and SIGHUP are forwarded.
+func runChild(ctx context.Context, name string, args []string) (int, error) {
+	cmd := safeexec.CommandContext(ctx, name, args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+	signals := make(chan os.Signal, 4)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	if err := cmd.Start(); err != nil {
+		return 1, fmt.Errorf("start %s: %w", name, err)
+	}
+	go func() {
+		for sig := range signals {
+			if sig != syscall.SIGINT {
+				_ = cmd.Process.Signal(sig)
+			}
+		}
+	}()
+
+	err := cmd.Wait()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			return 128 + int(status.Signal()), nil
+		}
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
@@ -0,0 +1,307 @@
+package sandbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Allowlist matches "host:port" destinations against entries of the form
+// "host", "host:port" or "*.domain" (any subdomain of domain).
+type Allowlist struct {
+	entries []allowEntry
+}
+
+type allowEntry struct {
+	host     string
+	port     string
+	wildcard bool
+}
+
+// NewAllowlist parses allowlist entries; blank entries are ignored.
+func NewAllowlist(hosts []string) *Allowlist {
+	a := &Allowlist{}
+	for _, h := range hosts {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if h == "" {
+			continue
+		}
+		var e allowEntry
+		if host, port, err := net.SplitHostPort(h); err == nil {
+			e.host, e.port = host, port
+		} else {
+			e.host = strings.Trim(h, "[]")
+		}
+		if rest, ok := strings.CutPrefix(e.host, "*."); ok {
+			e.host, e.wildcard = rest, true
+		}
+		e.host = strings.TrimSuffix(e.host, ".")
+		a.entries = append(a.entries, e)
+	}
+	return a
+}
+
+// Allows reports whether hostport may be connected to.
+func (a *Allowlist) Allows(hostport string) bool {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return false
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, e := range a.entries {
+		if e.port != "" && e.port != port {
+			continue
+		}
+		if e.wildcard {
+			if strings.HasSuffix(host, "."+e.host) {
+				return true
+			}
+		} else if host == e.host {
+			return true
+		}
+	}
+	return false
+}
+
+// Denial is a blocked network request, one JSON line in the denial log.
+type Denial struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	Host   string    `json:"host"`
+	Reason string    `json:"reason"`
+}
+
+// DenialLog appends denials to a JSONL file. The file is reopened for every
+// write so DrainDenials can move it away at any time.
+type DenialLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewDenialLog returns a log writing to path.
+func NewDenialLog(path string) *DenialLog {
+	return &DenialLog{path: path}
+}
+
+// Append records a denial.
+func (l *DenialLog) Append(d Denial) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// DrainDenials returns and removes the denials recorded at path. The log is
+// renamed before it is read so concurrent appends start a new file.
+func DrainDenials(path string) ([]Denial, error) {
+	draining := path + ".draining"
+	if err := os.Rename(path, draining); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	f, err := os.Open(draining)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(draining)
+	defer f.Close()
+
+	var denials []Denial
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var d Denial
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			continue // a line torn by a crash; skip it
+		}
+		denials = append(denials, d)
+	}
+	return denials, scanner.Err()
+}
+
+// hopHeaders are connection-scoped and not forwarded (RFC 9110 7.6.1).
+var hopHeaders = []string{
+	"Connection", "Proxy-Connection", "Keep-Alive", "Proxy-Authenticate",
+	"Proxy-Authorization", "Te", "Trailer", "Transfer-Encoding", "Upgrade",
+}
+
+// Proxy is an HTTP proxy that only connects to allowlisted hosts. It serves
+// CONNECT tunnels for HTTPS and forwards plain HTTP requests.
+type Proxy struct {
+	allow     *Allowlist
+	denials   *DenialLog
+	dialer    net.Dialer
+	transport *http.Transport
+}
+
+// NewProxy returns a proxy enforcing allow and recording denials to log
+// (which may be nil).
+func NewProxy(allow *Allowlist, log *DenialLog) *Proxy {
+	p := &Proxy{allow: allow, denials: log, dialer: net.Dialer{Timeout: 30 * time.Second}}
+	p.transport = &http.Transport{
+		Proxy:       nil,
+		DialContext: p.dialer.DialContext,
+	}
+	return p
+}
+
+// ServeHTTP implements http.Handler.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		p.serveConnect(w, r)
+		return
+	}
+	if r.URL.Host == "" {
+		http.Error(w, "proxy requests need an absolute URL", http.StatusBadRequest)
+		return
+	}
+	target := r.URL.Host
+	if r.URL.Port() == "" {
+		port := "80"
+		if r.URL.Scheme == "https" {
+			port = "443"
+		}
+		target = net.JoinHostPort(r.URL.Hostname(), port)
+	}
+	if !p.check(w, r.Method, target) {
+		return
+	}
+
+	out := r.Clone(r.Context())
+	out.RequestURI = ""
+	for _, h := range hopHeaders {
+		out.Header.Del(h)
+	}
+	resp, err := p.transport.RoundTrip(out)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for _, h := range hopHeaders {
+		resp.Header.Del(h)
+	}
+	for k, vs := range resp.Header {
+		for _, v := range vs {
+			w.Header().Add(k, v)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+func (p *Proxy) serveConnect(w http.ResponseWriter, r *http.Request) {
+	if !p.check(w, r.Method, r.Host) {
+		return
+	}
+	upstream, err := p.dialer.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		upstream.Close()
+		http.Error(w, "tunneling not supported", http.StatusInternalServerError)
+		return
+	}
+	client, buf, err := hijacker.Hijack()
+	if err != nil {
+		upstream.Close()
+		return
+	}
+	if _, err := client.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n")); err != nil {
+		client.Close()
+		upstream.Close()
+		return
+	}
+	// Bytes the client sent after the CONNECT request are already buffered.
+	if n := buf.Reader.Buffered(); n > 0 {
+		pending, _ := buf.Reader.Peek(n)
+		if _, err := upstream.Write(pending); err != nil {
+			client.Close()
+			upstream.Close()
+			return
+		}
+	}
+	pipe(client, upstream)
+}
+
+// check denies and records requests to hosts outside the allowlist.
+func (p *Proxy) check(w http.ResponseWriter, method, hostport string) bool {
+	if p.allow.Allows(hostport) {
+		return true
+	}
+	if p.denials != nil {
+		_ = p.denials.Append(Denial{
+			Time:   time.Now(),
+			Method: method,
+			Host:   hostport,
+			Reason: "host not in sandbox allowlist",
+		})
+	}
+	http.Error(w, fmt.Sprintf("%s is not in the sandbox network allowlist", hostport), http.StatusForbidden)
+	return false
+}
+
+// Forward accepts connections on l and relays each to the unix socket until
+// ctx is done. It runs inside the sandbox, where the proxy socket is the only
+// way out of the isolated network namespace.
+func Forward(ctx context.Context, l net.Listener, socket string) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+	var d net.Dialer
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			upstream, err := d.DialContext(ctx, "unix", socket)
+			if err != nil {
+				conn.Close()
+				return
+			}
+			pipe(conn, upstream)
+		}()
+	}
+}
+
+// pipe copies between a and b until either side is done, then closes both.
+func pipe(a, b net.Conn) {
+	done := make(chan struct{}, 2)
+	cp := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go cp(a, b)
+	go cp(b, a)
+	<-done
+	a.Close()
+	b.Close()
+	<-done
+}
@@ -0,0 +1,150 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowlist(t *testing.T) {
+	a := NewAllowlist([]string{"github.com", "*.npmjs.org", "localhost:8543", " ", "Example.COM."})
+
+	assert.True(t, a.Allows("github.com:443"))
+	assert.True(t, a.Allows("GitHub.com.:80"))
+	assert.False(t, a.Allows("api.github.com:443"), "plain entries do not cover subdomains")
+	assert.True(t, a.Allows("registry.npmjs.org:443"))
+	assert.False(t, a.Allows("npmjs.org:443"), "wildcards cover subdomains only")
+	assert.True(t, a.Allows("localhost:8543"))
+	assert.False(t, a.Allows("localhost:22"), "a port-qualified entry only allows that port")
+	assert.True(t, a.Allows("example.com:443"))
+	assert.False(t, a.Allows("github.com"), "a destination without a port is malformed")
+}
+
+func TestDenialLog_Drain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denials.jsonl")
+	denials, err := DrainDenials(path)
+	require.NoError(t, err)
+	assert.Empty(t, denials)
+
+	log := NewDenialLog(path)
+	require.NoError(t, log.Append(Denial{Method: "CONNECT", Host: "evil.test:443"}))
+	require.NoError(t, log.Append(Denial{Method: "GET", Host: "evil.test:80"}))
+
+	denials, err = DrainDenials(path)
+	require.NoError(t, err)
+	require.Len(t, denials, 2)
+	assert.Equal(t, "evil.test:443", denials[0].Host)
+	assert.NoFileExists(t, path)
+
+	// Appends after a drain start a fresh log.
+	require.NoError(t, log.Append(Denial{Method: "CONNECT", Host: "again.test:443"}))
+	denials, err = DrainDenials(path)
+	require.NoError(t, err)
+	assert.Len(t, denials, 1)
+}
+
+func proxyClient(t *testing.T, proxy *httptest.Server) *http.Client {
+	t.Helper()
+	u, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	return &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(u)}, Timeout: 5 * time.Second}
+}
+
+func TestProxy_ForwardsAllowedAndRecordsDenied(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "hello")
+	}))
+	defer upstream.Close()
+	upstreamHost := upstream.Listener.Addr().String()
+
+	logPath := filepath.Join(t.TempDir(), "denials.jsonl")
+	proxy := httptest.NewServer(NewProxy(NewAllowlist([]string{upstreamHost}), NewDenialLog(logPath)))
+	defer proxy.Close()
+	client := proxyClient(t, proxy)
+
+	resp, err := client.Get(upstream.URL)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "hello", string(body))
+
+	resp, err = client.Get("http://blocked.test/")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	denials, err := DrainDenials(logPath)
+	require.NoError(t, err)
+	require.Len(t, denials, 1)
+	assert.Equal(t, "blocked.test:80", denials[0].Host)
+	assert.Equal(t, http.MethodGet, denials[0].Method)
+}
+
+func TestProxy_ConnectTunnel(t *testing.T) {
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "secure")
+	}))
+	defer upstream.Close()
+
+	logPath := filepath.Join(t.TempDir(), "denials.jsonl")
+	proxy := httptest.NewServer(NewProxy(NewAllowlist([]string{upstream.Listener.Addr().String()}), NewDenialLog(logPath)))
+	defer proxy.Close()
+
+	client := proxyClient(t, proxy)
+	client.Transport.(*http.Transport).TLSClientConfig = upstream.Client().Transport.(*http.Transport).TLSClientConfig
+
+	resp, err := client.Get(upstream.URL)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "secure", string(body))
+
+	_, err = client.Get("https://blocked.test/")
+	assert.Error(t, err, "a denied CONNECT fails the TLS request")
+	denials, err := DrainDenials(logPath)
+	require.NoError(t, err)
+	require.Len(t, denials, 1)
+	assert.Equal(t, http.MethodConnect, denials[0].Method)
+}
+
+func TestForward(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "p.sock")
+	ul, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer ul.Close()
+	go func() {
+		conn, err := ul.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = io.Copy(conn, conn) // echo
+		conn.Close()
+	}()
+
+	tl, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = Forward(ctx, tl, socket) }()
+
+	conn, err := net.Dial("tcp", tl.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+}
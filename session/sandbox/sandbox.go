@@ -0,0 +1,351 @@
+// Package sandbox confines a session's agent with Linux namespaces through
+// bubblewrap (bwrap). The root filesystem is mounted read-only except the
+// session worktree (not its git config and hooks), a per-session scratch dir
+// and the agent's config dir, /tmp
+// is a private tmpfs, and the network is either shared, removed, or limited to
+// an allowlist of hosts reached through a proxy that runs outside the sandbox.
+//
+// A sandboxed session's tmux program is "squad sandbox-exec --policy <file>
+// -- <program>". The policy file lives in the session's sandbox dir together
+// with the proxy's denial log, which the server drains into approval
+// analytics. Only network denials are observable: a write to a read-only
+// path fails with EROFS inside the agent and is not reported.
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/tstapler/stapler-squad/config"
+)
+
+// ErrUnavailable is returned when bwrap is not installed. Sessions that ask
+// for a sandbox fail to start instead of running unconfined.
+var ErrUnavailable = errors.New("sandbox requires bubblewrap (bwrap) on Linux")
+
+const (
+	policyFile  = "policy.json"
+	denialsFile = "denials.jsonl"
+	scratchDir  = "scratch"
+	proxySocket = "proxy.sock"
+	// maxSocketPath is the sun_path limit for unix sockets on Linux.
+	maxSocketPath = 107
+)
+
+// Policy is what a sandboxed session may touch. It is written by the server
+// when the session starts and read by "squad sandbox-exec".
+type Policy struct {
+	SessionID string `json:"session_id"`
+	// Dir is the session's sandbox dir holding this policy, the denial log,
+	// the proxy socket and the scratch dir.
+	Dir string `json:"dir"`
+	// WritablePaths are bind-mounted read-write; everything else is read-only.
+	WritablePaths []string `json:"writable_paths"`
+	// ReadOnlyPaths are put back to read-only inside the writable paths, e.g.
+	// the worktree's .git. Writable paths nested in them are bound again on
+	// top.
+	ReadOnlyPaths []string `json:"read_only_paths,omitempty"`
+	// Network is config.SandboxNetworkHost, None or Allowlist.
+	Network string `json:"network"`
+	// AllowedHosts are reachable through the proxy in allowlist mode.
+	AllowedHosts []string `json:"allowed_hosts,omitempty"`
+	// Config is the configuration the policy was built from, kept so the
+	// policy can be rebuilt when a restored session is relaunched.
+	Config config.SandboxConfig `json:"config"`
+}
+
+// Status summarizes a session's sandbox for display.
+type Status struct {
+	Network       string
+	AllowedHosts  []string
+	WritablePaths []string
+	// Denied counts network requests blocked since the server started.
+	Denied int
+}
+
+// Root is the directory holding all session sandbox dirs.
+func Root() (string, error) {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "sandbox"), nil
+}
+
+// SessionDir returns the sandbox dir of a session.
+func SessionDir(root, sessionID string) string {
+	return filepath.Join(root, sanitize(sessionID))
+}
+
+// PolicyPath returns the policy file of a session sandbox dir.
+func PolicyPath(dir string) string { return filepath.Join(dir, policyFile) }
+
+// DenialLogPath returns the denial log of a session sandbox dir.
+func DenialLogPath(dir string) string { return filepath.Join(dir, denialsFile) }
+
+// ScratchDir returns the writable scratch dir of a session sandbox dir.
+func ScratchDir(dir string) string { return filepath.Join(dir, scratchDir) }
+
+// ProxySocketPath returns where the allowlist proxy listens.
+func ProxySocketPath(dir string) string { return filepath.Join(dir, proxySocket) }
+
+// Available reports whether sessions can be sandboxed on this host.
+func Available() error {
+	if _, err := exec.LookPath("bwrap"); err != nil {
+		return ErrUnavailable
+	}
+	return nil
+}
+
+// NewPolicy builds the policy for a session running program in worktree.
+// The worktree's .git is read-only apart from the parts commits update (see
+// GitWritablePaths), so the agent can neither edit the repository's config
+// and hooks nor repoint a linked worktree's .git file: the server runs git in
+// the worktree outside the sandbox, and would run whatever they name.
+func NewPolicy(dir, sessionID, worktree, program, serverHost string, cfg config.SandboxConfig) (*Policy, error) {
+	if socket := ProxySocketPath(dir); len(socket) > maxSocketPath {
+		return nil, fmt.Errorf("sandbox dir %q is too long for the proxy socket", dir)
+	}
+	p := &Policy{
+		SessionID: sessionID,
+		Dir:       dir,
+		Network:   cfg.NetworkMode(),
+		Config:    cfg,
+	}
+	switch p.Network {
+	case config.SandboxNetworkHost, config.SandboxNetworkNone, config.SandboxNetworkAllowlist:
+	default:
+		return nil, fmt.Errorf("unknown sandbox network mode %q", cfg.Network)
+	}
+
+	writable := []string{worktree, ScratchDir(dir)}
+	if _, err := os.Lstat(filepath.Join(worktree, ".git")); err == nil {
+		p.ReadOnlyPaths = []string{filepath.Join(worktree, ".git")}
+	}
+	writable = append(writable, GitWritablePaths(worktree)...)
+	if home, err := os.UserHomeDir(); err == nil {
+		writable = append(writable, AgentConfigPaths(program, home)...)
+	}
+	for _, path := range cfg.WritablePaths {
+		if abs, err := filepath.Abs(expandHome(path)); err == nil {
+			writable = append(writable, abs)
+		}
+	}
+	p.WritablePaths = dedupe(writable)
+
+	if p.Network == config.SandboxNetworkAllowlist {
+		hosts := append([]string(nil), cfg.AllowedHosts...)
+		if serverHost != "" {
+			hosts = append(hosts, serverHost)
+		}
+		p.AllowedHosts = dedupe(hosts)
+	}
+	return p, nil
+}
+
+// Save writes the policy to its sandbox dir, creating the scratch dir.
+func (p *Policy) Save() error {
+	if err := os.MkdirAll(ScratchDir(p.Dir), 0o700); err != nil {
+		return fmt.Errorf("create sandbox dir: %w", err)
+	}
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal sandbox policy: %w", err)
+	}
+	path := PolicyPath(p.Dir)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write sandbox policy: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename sandbox policy: %w", err)
+	}
+	return nil
+}
+
+// LoadPolicy reads a policy file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p Policy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("unmarshal sandbox policy: %w", err)
+	}
+	return &p, nil
+}
+
+// BwrapArgs returns the bwrap arguments that run command under the policy
+// with cwd as the working directory.
+func (p *Policy) BwrapArgs(cwd string, command []string) []string {
+	args := []string{
+		"--ro-bind", "/", "/",
+		"--dev", "/dev",
+		"--proc", "/proc",
+		"--tmpfs", "/tmp",
+		// Re-expose the sandbox dir in case it lives under /tmp (test mode);
+		// the proxy socket must stay reachable.
+		"--ro-bind", p.Dir, p.Dir,
+	}
+	// Later mounts win, so read-only paths go over the writable ones and
+	// writable paths inside them go last.
+	var nested []string
+	for _, path := range p.WritablePaths {
+		if p.insideReadOnly(path) {
+			nested = append(nested, path)
+			continue
+		}
+		args = append(args, "--bind-try", path, path)
+	}
+	for _, path := range p.ReadOnlyPaths {
+		args = append(args, "--ro-bind-try", path, path)
+	}
+	for _, path := range nested {
+		args = append(args, "--bind-try", path, path)
+	}
+	args = append(args, "--unshare-pid", "--die-with-parent")
+	if p.Network != config.SandboxNetworkHost {
+		args = append(args, "--unshare-net")
+	}
+	args = append(args,
+		"--setenv", "STAPLER_SQUAD_SANDBOX", p.Network,
+		"--setenv", "STAPLER_SQUAD_SCRATCH", ScratchDir(p.Dir),
+	)
+	if cwd != "" {
+		args = append(args, "--chdir", cwd)
+	}
+	args = append(args, "--")
+	return append(args, command...)
+}
+
+// insideReadOnly reports whether path lies within one of the read-only paths.
+func (p *Policy) insideReadOnly(path string) bool {
+	for _, ro := range p.ReadOnlyPaths {
+		if rel, err := filepath.Rel(ro, path); err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// AgentConfigPaths are the files and dirs an agent program writes its own
+// state to, which must stay writable for it to run.
+func AgentConfigPaths(program, home string) []string {
+	switch {
+	case strings.Contains(program, "claude"):
+		return []string{filepath.Join(home, ".claude"), filepath.Join(home, ".claude.json"), filepath.Join(home, ".config", "claude")}
+	case strings.Contains(program, "codex"):
+		return []string{filepath.Join(home, ".codex")}
+	case strings.Contains(program, "gemini"):
+		return []string{filepath.Join(home, ".gemini")}
+	case strings.Contains(program, "aider"):
+		return []string{filepath.Join(home, ".aider")}
+	}
+	return nil
+}
+
+// GitCommonDir returns the repository's shared .git dir when worktree is a
+// linked worktree, or "" otherwise (a regular checkout's .git is inside it).
+func GitCommonDir(worktree string) string {
+	gitdir := worktreeGitDir(worktree)
+	if gitdir == "" {
+		return ""
+	}
+	common, err := os.ReadFile(filepath.Join(gitdir, "commondir"))
+	if err != nil {
+		return ""
+	}
+	dir := strings.TrimSpace(string(common))
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(gitdir, dir)
+	}
+	return filepath.Clean(dir)
+}
+
+// GitWritablePaths returns the git paths that committing, fetching and
+// branching write: the object store, refs, reflogs and packed-refs, plus the
+// index and HEAD of a regular checkout's .git or a linked worktree's own
+// admin dir. Config, hooks and the .git entry itself are not among them. It
+// returns nil when worktree is not a git checkout.
+func GitWritablePaths(worktree string) []string {
+	common := GitCommonDir(worktree)
+	if common == "" {
+		gitDir := filepath.Join(worktree, ".git")
+		if info, err := os.Stat(gitDir); err != nil || !info.IsDir() {
+			return nil
+		}
+		return []string{
+			filepath.Join(gitDir, "objects"),
+			filepath.Join(gitDir, "refs"),
+			filepath.Join(gitDir, "logs"),
+			filepath.Join(gitDir, "packed-refs"),
+			filepath.Join(gitDir, "index"),
+			filepath.Join(gitDir, "HEAD"),
+		}
+	}
+	return []string{
+		filepath.Join(common, "objects"),
+		filepath.Join(common, "refs"),
+		filepath.Join(common, "logs"),
+		filepath.Join(common, "packed-refs"),
+		worktreeGitDir(worktree),
+	}
+}
+
+// worktreeGitDir returns the admin dir named by a linked worktree's .git
+// file (<common>/worktrees/<name>), or "" when .git is not such a file.
+func worktreeGitDir(worktree string) string {
+	data, err := os.ReadFile(filepath.Join(worktree, ".git"))
+	if err != nil {
+		return ""
+	}
+	gitdir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir: ")
+	if !ok {
+		return ""
+	}
+	if !filepath.IsAbs(gitdir) {
+		gitdir = filepath.Join(worktree, gitdir)
+	}
+	return filepath.Clean(gitdir)
+}
+
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
+
+// sanitize makes a session ID safe as a single path element.
+func sanitize(id string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == 0 {
+			return '_'
+		}
+		return r
+	}, id)
+}
+
+func dedupe(items []string) []string {
+	seen := make(map[string]struct{}, len(items))
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if item == "" {
+			continue
+		}
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		out = append(out, item)
+	}
+	return out
+}
@@ -0,0 +1,168 @@
+package sandbox
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tstapler/stapler-squad/config"
+)
+
+// argPairs returns the values following each occurrence of flag in args.
+func argPairs(args []string, flag string) []string {
+	var out []string
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == flag {
+			out = append(out, args[i+1])
+		}
+	}
+	return out
+}
+
+func TestNewPolicy_WritablePaths(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	dir := filepath.Join(t.TempDir(), "sess")
+	worktree := t.TempDir()
+
+	p, err := NewPolicy(dir, "u-1", worktree, "claude --model opus", "localhost:8543", config.SandboxConfig{
+		Enabled:       true,
+		WritablePaths: []string{"~/cache", worktree},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, config.SandboxNetworkHost, p.Network)
+	assert.Equal(t, []string{
+		worktree,
+		ScratchDir(dir),
+		filepath.Join(home, ".claude"),
+		filepath.Join(home, ".claude.json"),
+		filepath.Join(home, ".config", "claude"),
+		filepath.Join(home, "cache"),
+	}, p.WritablePaths)
+	assert.Empty(t, p.AllowedHosts, "hosts only apply to allowlist mode")
+}
+
+func TestNewPolicy_AllowlistIncludesServer(t *testing.T) {
+	p, err := NewPolicy(t.TempDir(), "u-1", t.TempDir(), "codex", "localhost:8543", config.SandboxConfig{
+		Enabled:      true,
+		Network:      config.SandboxNetworkAllowlist,
+		AllowedHosts: []string{"github.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"github.com", "localhost:8543"}, p.AllowedHosts)
+}
+
+func TestNewPolicy_RejectsUnknownNetwork(t *testing.T) {
+	_, err := NewPolicy(t.TempDir(), "u-1", t.TempDir(), "claude", "", config.SandboxConfig{Enabled: true, Network: "vpn"})
+	assert.Error(t, err)
+}
+
+func TestPolicy_SaveLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "sess")
+	p, err := NewPolicy(dir, "u-1", t.TempDir(), "claude", "", config.SandboxConfig{Enabled: true, Network: config.SandboxNetworkNone})
+	require.NoError(t, err)
+	require.NoError(t, p.Save())
+	assert.DirExists(t, ScratchDir(dir))
+
+	loaded, err := LoadPolicy(PolicyPath(dir))
+	require.NoError(t, err)
+	assert.Equal(t, p, loaded)
+}
+
+func TestPolicy_BwrapArgs(t *testing.T) {
+	p := &Policy{Dir: "/state/sess", WritablePaths: []string{"/work", "/state/sess/scratch"}, Network: config.SandboxNetworkNone}
+	args := p.BwrapArgs("/work/sub", []string{"claude", "--resume", "x"})
+
+	assert.Equal(t, []string{"/", "/state/sess"}, argPairs(args, "--ro-bind"))
+	assert.Equal(t, []string{"/work", "/state/sess/scratch"}, argPairs(args, "--bind-try"))
+	assert.Equal(t, []string{"/tmp"}, argPairs(args, "--tmpfs"))
+	assert.Equal(t, []string{"/work/sub"}, argPairs(args, "--chdir"))
+	assert.Contains(t, args, "--unshare-net")
+	assert.Contains(t, args, "--die-with-parent")
+	assert.Equal(t, "-- claude --resume x", strings.Join(args[len(args)-4:], " "))
+
+	// The tmpfs must be mounted before the binds that may live under /tmp.
+	assert.Less(t, indexOf(args, "--tmpfs"), indexOf(args, "--bind-try"))
+
+	p.Network = config.SandboxNetworkHost
+	assert.NotContains(t, p.BwrapArgs("", []string{"sh"}), "--unshare-net")
+}
+
+func TestGitCommonDir(t *testing.T) {
+	repo := t.TempDir()
+	gitdir := filepath.Join(repo, ".git", "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(gitdir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(gitdir, "commondir"), []byte("../..\n"), 0o644))
+	worktree := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: "+gitdir+"\n"), 0o644))
+
+	assert.Equal(t, filepath.Join(repo, ".git"), GitCommonDir(worktree))
+	assert.Empty(t, GitCommonDir(repo), "a regular checkout has no separate common dir")
+}
+
+func TestNewPolicy_GitPathsWritableConfigAndHooksNot(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	repo := t.TempDir()
+	common := filepath.Join(repo, ".git")
+	gitdir := filepath.Join(common, "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(gitdir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(gitdir, "commondir"), []byte("../..\n"), 0o644))
+	worktree := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: "+gitdir+"\n"), 0o644))
+
+	p, err := NewPolicy(filepath.Join(t.TempDir(), "sess"), "u-1", worktree, "bash", "", config.SandboxConfig{Enabled: true})
+	require.NoError(t, err)
+	for _, path := range []string{"objects", "refs", "logs", "packed-refs", filepath.Join("worktrees", "feature")} {
+		assert.Contains(t, p.WritablePaths, filepath.Join(common, path))
+	}
+	for _, path := range p.WritablePaths {
+		assert.NotEqual(t, common, path, "the whole common dir must not be writable")
+		assert.NotContains(t, path, "config")
+		assert.NotContains(t, path, "hooks")
+	}
+	assert.Equal(t, []string{filepath.Join(worktree, ".git")}, p.ReadOnlyPaths,
+		"the .git file must not be repointed at a gitdir the agent controls")
+}
+
+func TestNewPolicy_RegularCheckoutGitReadOnly(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	worktree := t.TempDir()
+	gitDir := filepath.Join(worktree, ".git")
+	require.NoError(t, os.MkdirAll(filepath.Join(gitDir, "hooks"), 0o755))
+
+	p, err := NewPolicy(filepath.Join(t.TempDir(), "sess"), "u-1", worktree, "bash", "", config.SandboxConfig{Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{gitDir}, p.ReadOnlyPaths)
+	for _, path := range []string{"objects", "refs", "logs", "packed-refs", "index", "HEAD"} {
+		assert.Contains(t, p.WritablePaths, filepath.Join(gitDir, path))
+	}
+	assert.NotContains(t, p.WritablePaths, gitDir)
+
+	// The worktree is bound first, .git goes back to read-only over it and
+	// only then are the git paths inside it made writable again.
+	args := p.BwrapArgs(worktree, []string{"sh"})
+	roAt := indexOf(args, gitDir)
+	require.Equal(t, "--ro-bind-try", args[roAt-1])
+	assert.Less(t, indexOf(args, worktree), roAt)
+	assert.Less(t, roAt, indexOf(args, filepath.Join(gitDir, "objects")))
+	assert.Less(t, roAt, indexOf(args, filepath.Join(gitDir, "index")))
+}
+
+func TestAgentConfigPaths(t *testing.T) {
+	assert.Equal(t, []string{"/h/.codex"}, AgentConfigPaths("codex --full-auto", "/h"))
+	assert.Equal(t, []string{"/h/.gemini"}, AgentConfigPaths("gemini", "/h"))
+	assert.Empty(t, AgentConfigPaths("bash", "/h"))
+}
+
+func indexOf(items []string, s string) int {
+	for i, item := range items {
+		if item == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,66 @@
+package sandbox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tstapler/stapler-squad/log"
+)
+
+// DefaultWatchInterval is how often denial logs are drained.
+const DefaultWatchInterval = 5 * time.Second
+
+// Watcher drains the denial logs of all session sandbox dirs under a root
+// and hands the denials to a callback, keyed by session ID.
+type Watcher struct {
+	root      string
+	interval  time.Duration
+	onDenials func(sessionID string, denials []Denial)
+}
+
+// NewWatcher returns a watcher over the sandbox dirs under root.
+func NewWatcher(root string, interval time.Duration, onDenials func(sessionID string, denials []Denial)) *Watcher {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	return &Watcher{root: root, interval: interval, onDenials: onDenials}
+}
+
+// Start drains denial logs every interval until ctx is done.
+func (w *Watcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.Poll()
+			}
+		}
+	}()
+}
+
+// Poll drains every session's denial log once.
+func (w *Watcher) Poll() {
+	entries, err := os.ReadDir(w.root)
+	if err != nil {
+		return // no sandboxed session has run yet
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		denials, err := DrainDenials(DenialLogPath(filepath.Join(w.root, e.Name())))
+		if err != nil {
+			log.Warn("failed to read sandbox denials", "session", e.Name(), "err", err)
+			continue
+		}
+		if len(denials) > 0 {
+			w.onDenials(e.Name(), denials)
+		}
+	}
+}
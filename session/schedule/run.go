@@ -0,0 +1,59 @@
+package schedule
+
+import "time"
+
+// RunStatus is where a run is in its lifecycle.
+type RunStatus string
+
+const (
+	// RunQueued waits for the schedule's previous run (OverlapQueue).
+	RunQueued RunStatus = "queued"
+	// RunRunning is executing. A session run stays running until its
+	// session is paused or deleted.
+	RunRunning RunStatus = "running"
+	// RunSucceeded finished: the one-shot exited zero, or the session ended.
+	RunSucceeded RunStatus = "succeeded"
+	// RunFailed could not start, or the one-shot failed.
+	RunFailed RunStatus = "failed"
+	// RunSkipped was not started because the previous run was still going.
+	RunSkipped RunStatus = "skipped"
+	// RunReplaced was stopped by a newer run (OverlapReplace).
+	RunReplaced RunStatus = "replaced"
+)
+
+// Finished reports whether the run will not change again.
+func (s RunStatus) Finished() bool {
+	return s != RunQueued && s != RunRunning
+}
+
+// Trigger is why a run happened.
+type Trigger string
+
+const (
+	TriggerSchedule Trigger = "schedule"
+	TriggerCatchUp  Trigger = "catch_up"
+	TriggerManual   Trigger = "manual"
+)
+
+// Run is one firing of a schedule and what came of it.
+type Run struct {
+	ID         string    `json:"id"`
+	ScheduleID string    `json:"schedule_id"`
+	Trigger    Trigger   `json:"trigger"`
+	Status     RunStatus `json:"status"`
+	// ScheduledFor is the firing time; for manual runs, the trigger time.
+	ScheduledFor time.Time `json:"scheduled_for"`
+	StartedAt    time.Time `json:"started_at,omitempty"`
+	FinishedAt   time.Time `json:"finished_at,omitempty"`
+
+	// SessionID is the title of the session the run spawned or, for
+	// one-shots, ran in; SessionUUID identifies it across renames.
+	SessionID   string `json:"session_id,omitempty"`
+	SessionUUID string `json:"session_uuid,omitempty"`
+
+	Error    string `json:"error,omitempty"`
+	ExitCode int    `json:"exit_code,omitempty"`
+	PRURL    string `json:"pr_url,omitempty"`
+	// Output is the tail of a one-shot's output.
+	Output string `json:"output,omitempty"`
+}
@@ -0,0 +1,266 @@
+// Package schedule defines recurring session schedules: a cron expression in
+// a timezone, what to run when it fires, and what to do when a run is still
+// going or firings were missed while the daemon was down. The daemon's
+// scheduler (server/services) executes them; this package decides when.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Kind is what a schedule does when it fires.
+type Kind string
+
+const (
+	// KindSession creates a new session from the schedule's profile, prompt
+	// and repository.
+	KindSession Kind = "session"
+	// KindOneShot runs the prompt non-interactively in an existing session's
+	// worktree and records the output.
+	KindOneShot Kind = "one_shot"
+)
+
+// OverlapPolicy decides what happens when a schedule fires while its
+// previous run is still going.
+type OverlapPolicy string
+
+const (
+	// OverlapSkip records the firing as skipped.
+	OverlapSkip OverlapPolicy = "skip"
+	// OverlapQueue starts the run once the previous one finishes.
+	OverlapQueue OverlapPolicy = "queue"
+	// OverlapReplace stops the previous run and starts a new one.
+	OverlapReplace OverlapPolicy = "replace"
+)
+
+// CatchUpPolicy decides which firings missed while the daemon was down are
+// run when it starts again.
+type CatchUpPolicy string
+
+const (
+	// CatchUpNone drops missed firings.
+	CatchUpNone CatchUpPolicy = "none"
+	// CatchUpLatest runs the most recent missed firing once.
+	CatchUpLatest CatchUpPolicy = "latest"
+	// CatchUpAll runs every missed firing, up to MaxCatchUpRuns.
+	CatchUpAll CatchUpPolicy = "all"
+)
+
+const (
+	// MaxCatchUpRuns caps how many missed firings CatchUpAll replays, so a
+	// minutely schedule does not flood the machine after a week offline.
+	MaxCatchUpRuns = 10
+	// MissedAfter is how late a firing may be handled and still count as on
+	// time. The scheduler wakes at least every 30 seconds, so anything older
+	// was missed while the daemon was down.
+	MissedAfter = 2 * time.Minute
+	// maxFiringScan bounds the walk over firings since the last evaluation.
+	maxFiringScan = 100_000
+)
+
+// ErrInvalid is wrapped by every validation error.
+var ErrInvalid = errors.New("invalid schedule")
+
+// cronParser accepts standard five-field expressions and descriptors such as
+// @daily. Timezones come from Schedule.Timezone, not CRON_TZ prefixes.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Schedule is a recurring job.
+type Schedule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Cron is a five-field cron expression or a descriptor like @daily.
+	Cron string `json:"cron"`
+	// Timezone is an IANA zone name the expression is evaluated in; empty
+	// means the daemon's local time.
+	Timezone string `json:"timezone,omitempty"`
+	Enabled  bool   `json:"enabled"`
+	Kind     Kind   `json:"kind"`
+
+	// Prompt is sent to the new session, or run by a one-shot.
+	Prompt string `json:"prompt"`
+
+	// KindSession settings.
+	Profile  string `json:"profile,omitempty"`
+	RepoPath string `json:"repo_path,omitempty"`
+	// BranchPrefix gives each run its own worktree on a branch named
+	// BranchPrefix plus the firing time; empty runs in RepoPath directly.
+	BranchPrefix string `json:"branch_prefix,omitempty"`
+	Program      string `json:"program,omitempty"`
+	Category     string `json:"category,omitempty"`
+
+	// KindOneShot settings.
+	TargetSession  string `json:"target_session,omitempty"`
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty"`
+
+	Overlap OverlapPolicy `json:"overlap"`
+	CatchUp CatchUpPolicy `json:"catch_up"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// EvaluatedAt is the time up to which firings have been handled. Firings
+	// after it are due.
+	EvaluatedAt time.Time `json:"evaluated_at"`
+}
+
+// Normalize fills in defaults for unset policies and kind.
+func (s *Schedule) Normalize() {
+	s.Name = strings.TrimSpace(s.Name)
+	s.Cron = strings.TrimSpace(s.Cron)
+	if s.Kind == "" {
+		s.Kind = KindSession
+	}
+	if s.Overlap == "" {
+		s.Overlap = OverlapSkip
+	}
+	if s.CatchUp == "" {
+		s.CatchUp = CatchUpLatest
+	}
+}
+
+// Validate reports the first problem with s.
+func (s *Schedule) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	if _, err := s.spec(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(s.Prompt) == "" {
+		return fmt.Errorf("%w: prompt is required", ErrInvalid)
+	}
+	switch s.Kind {
+	case KindSession:
+		if s.RepoPath == "" {
+			return fmt.Errorf("%w: repo_path is required for session schedules", ErrInvalid)
+		}
+	case KindOneShot:
+		if s.TargetSession == "" {
+			return fmt.Errorf("%w: target_session is required for one-shot schedules", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %q (want session or one_shot)", ErrInvalid, s.Kind)
+	}
+	switch s.Overlap {
+	case OverlapSkip, OverlapQueue, OverlapReplace:
+	default:
+		return fmt.Errorf("%w: unknown overlap policy %q (want skip, queue or replace)", ErrInvalid, s.Overlap)
+	}
+	switch s.CatchUp {
+	case CatchUpNone, CatchUpLatest, CatchUpAll:
+	default:
+		return fmt.Errorf("%w: unknown catch-up policy %q (want none, latest or all)", ErrInvalid, s.CatchUp)
+	}
+	if s.TimeoutSeconds < 0 {
+		return fmt.Errorf("%w: timeout_seconds must not be negative", ErrInvalid)
+	}
+	return nil
+}
+
+// Location returns the timezone the schedule is evaluated in.
+func (s *Schedule) Location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalid, s.Timezone)
+	}
+	return loc, nil
+}
+
+// spec parses the cron expression, returning a schedule in s's timezone.
+func (s *Schedule) spec() (cron.Schedule, error) {
+	if strings.HasPrefix(s.Cron, "CRON_TZ=") || strings.HasPrefix(s.Cron, "TZ=") {
+		return nil, fmt.Errorf("%w: set the timezone field instead of a CRON_TZ prefix", ErrInvalid)
+	}
+	spec, err := cronParser.Parse(s.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cron %q: %v", ErrInvalid, s.Cron, err)
+	}
+	loc, err := s.Location()
+	if err != nil {
+		return nil, err
+	}
+	if ss, ok := spec.(*cron.SpecSchedule); ok {
+		ss.Location = loc
+	}
+	return spec, nil
+}
+
+// Next returns the first firing strictly after t.
+func (s *Schedule) Next(t time.Time) (time.Time, error) {
+	spec, err := s.spec()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return spec.Next(t), nil
+}
+
+// Firing is one time a schedule is due to run.
+type Firing struct {
+	At time.Time
+	// CatchUp is set for firings missed while the daemon was down.
+	CatchUp bool
+}
+
+// Due returns the firings after EvaluatedAt up to now, oldest first, with
+// missed firings filtered by the catch-up policy. A schedule that has never
+// been evaluated counts from its creation.
+func (s *Schedule) Due(now time.Time) ([]Firing, error) {
+	spec, err := s.spec()
+	if err != nil {
+		return nil, err
+	}
+	from := s.EvaluatedAt
+	if from.IsZero() {
+		from = s.CreatedAt
+	}
+	if from.IsZero() || !from.Before(now) {
+		return nil, nil
+	}
+
+	missedBefore := now.Add(-MissedAfter)
+	var missed, onTime []time.Time
+	t := from
+	for i := 0; i < maxFiringScan; i++ {
+		t = spec.Next(t)
+		if t.IsZero() || t.After(now) {
+			break
+		}
+		if t.Before(missedBefore) {
+			missed = append(missed, t)
+			if len(missed) > MaxCatchUpRuns {
+				missed = missed[1:]
+			}
+			continue
+		}
+		onTime = append(onTime, t)
+	}
+
+	switch s.CatchUp {
+	case CatchUpNone:
+		missed = nil
+	case CatchUpLatest:
+		// A firing that is on time already covers the missed work.
+		if len(onTime) > 0 || len(missed) == 0 {
+			missed = nil
+		} else {
+			missed = missed[len(missed)-1:]
+		}
+	}
+
+	firings := make([]Firing, 0, len(missed)+len(onTime))
+	for _, at := range missed {
+		firings = append(firings, Firing{At: at, CatchUp: true})
+	}
+	for _, at := range onTime {
+		firings = append(firings, Firing{At: at})
+	}
+	return firings, nil
+}
@@ -0,0 +1,228 @@
+package schedule
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func nightly() Schedule {
+	return Schedule{
+		Name:     "deps",
+		Cron:     "0 2 * * *",
+		Timezone: "America/New_York",
+		Enabled:  true,
+		Prompt:   "update dependencies",
+		RepoPath: "/repo",
+	}
+}
+
+func TestNext_Timezone(t *testing.T) {
+	sc := nightly()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	next, err := sc.Next(now)
+	require.NoError(t, err)
+	// 02:00 in New York is 06:00 UTC during daylight saving time.
+	assert.Equal(t, time.Date(2026, 10, 19, 6, 0, 0, 0, time.UTC), next.UTC())
+
+	sc.Timezone = "UTC"
+	next, err = sc.Next(now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 19, 2, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Schedule)
+		want   string
+	}{
+		{"valid", func(*Schedule) {}, ""},
+		{"descriptor", func(s *Schedule) { s.Cron = "@weekly" }, ""},
+		{"no name", func(s *Schedule) { s.Name = " " }, "name is required"},
+		{"bad cron", func(s *Schedule) { s.Cron = "every day" }, "cron"},
+		{"seconds field", func(s *Schedule) { s.Cron = "0 0 2 * * *" }, "cron"},
+		{"cron tz prefix", func(s *Schedule) { s.Cron = "CRON_TZ=UTC 0 2 * * *" }, "timezone field"},
+		{"bad timezone", func(s *Schedule) { s.Timezone = "Mars/Olympus" }, "unknown timezone"},
+		{"no prompt", func(s *Schedule) { s.Prompt = "" }, "prompt is required"},
+		{"no repo", func(s *Schedule) { s.RepoPath = "" }, "repo_path is required"},
+		{"one-shot without target", func(s *Schedule) { s.Kind = KindOneShot }, "target_session is required"},
+		{"bad overlap", func(s *Schedule) { s.Overlap = "parallel" }, "overlap policy"},
+		{"bad catch-up", func(s *Schedule) { s.CatchUp = "some" }, "catch-up policy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := nightly()
+			tt.modify(&sc)
+			sc.Normalize()
+			err := sc.Validate()
+			if tt.want == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalid)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestDue(t *testing.T) {
+	hourly := func(policy CatchUpPolicy, evaluated time.Time) Schedule {
+		sc := nightly()
+		sc.Cron = "0 * * * *"
+		sc.Timezone = "UTC"
+		sc.CatchUp = policy
+		sc.EvaluatedAt = evaluated
+		return sc
+	}
+	at := func(h, m int) time.Time { return time.Date(2026, 10, 18, h, m, 0, 0, time.UTC) }
+
+	t.Run("on time", func(t *testing.T) {
+		sc := hourly(CatchUpNone, at(9, 59))
+		firings, err := sc.Due(at(10, 0).Add(30 * time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, []Firing{{At: at(10, 0)}}, firings)
+	})
+
+	t.Run("nothing due", func(t *testing.T) {
+		sc := hourly(CatchUpAll, at(10, 0))
+		firings, err := sc.Due(at(10, 59))
+		require.NoError(t, err)
+		assert.Empty(t, firings)
+	})
+
+	t.Run("none drops missed firings", func(t *testing.T) {
+		sc := hourly(CatchUpNone, at(4, 30))
+		firings, err := sc.Due(at(9, 30))
+		require.NoError(t, err)
+		assert.Empty(t, firings)
+	})
+
+	t.Run("latest runs the last missed firing", func(t *testing.T) {
+		sc := hourly(CatchUpLatest, at(4, 30))
+		firings, err := sc.Due(at(9, 30))
+		require.NoError(t, err)
+		assert.Equal(t, []Firing{{At: at(9, 0), CatchUp: true}}, firings)
+	})
+
+	t.Run("latest defers to an on-time firing", func(t *testing.T) {
+		sc := hourly(CatchUpLatest, at(4, 30))
+		firings, err := sc.Due(at(10, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []Firing{{At: at(10, 0)}}, firings)
+	})
+
+	t.Run("all runs every missed firing oldest first", func(t *testing.T) {
+		sc := hourly(CatchUpAll, at(6, 30))
+		firings, err := sc.Due(at(10, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []Firing{
+			{At: at(7, 0), CatchUp: true},
+			{At: at(8, 0), CatchUp: true},
+			{At: at(9, 0), CatchUp: true},
+			{At: at(10, 0)},
+		}, firings)
+	})
+
+	t.Run("all is capped", func(t *testing.T) {
+		sc := hourly(CatchUpAll, at(0, 0).AddDate(0, 0, -7))
+		firings, err := sc.Due(at(9, 30))
+		require.NoError(t, err)
+		require.Len(t, firings, MaxCatchUpRuns)
+		assert.Equal(t, at(9, 0), firings[len(firings)-1].At)
+	})
+}
+
+func TestStore_CRUD(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "schedules.json"))
+
+	created, err := store.Create(nightly())
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, OverlapSkip, created.Overlap)
+	assert.Equal(t, CatchUpLatest, created.CatchUp)
+	assert.False(t, created.EvaluatedAt.IsZero())
+
+	_, err = store.Create(nightly())
+	assert.ErrorIs(t, err, ErrInvalid, "names are unique")
+
+	got, err := store.Get("deps")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+
+	_, err = store.Update(created.ID, func(s *Schedule) error {
+		s.Cron = "not a cron"
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	updated, err := store.Update(created.ID, func(s *Schedule) error {
+		s.Overlap = OverlapQueue
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, OverlapQueue, updated.Overlap)
+	assert.Equal(t, created.CreatedAt.Unix(), updated.CreatedAt.Unix())
+
+	_, err = store.AddRun(Run{ScheduleID: created.ID, Status: RunRunning})
+	require.NoError(t, err)
+	_, err = store.AddRun(Run{ScheduleID: "missing"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Delete("deps"))
+	_, err = store.Get(created.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	runs, err := store.Runs("", 0)
+	require.NoError(t, err)
+	assert.Empty(t, runs, "deleting a schedule drops its history")
+}
+
+func TestStore_RunHistory(t *testing.T) {
+	store := NewStore("")
+	sc, err := store.Create(nightly())
+	require.NoError(t, err)
+
+	running, err := store.AddRun(Run{ScheduleID: sc.ID, Status: RunRunning})
+	require.NoError(t, err)
+	for i := 0; i < maxRunsPerSchedule+5; i++ {
+		_, err := store.AddRun(Run{ScheduleID: sc.ID, Status: RunSkipped, ExitCode: i})
+		require.NoError(t, err)
+	}
+
+	runs, err := store.Runs(sc.ID, 0)
+	require.NoError(t, err)
+	assert.Len(t, runs, maxRunsPerSchedule)
+	assert.Equal(t, maxRunsPerSchedule+4, runs[0].ExitCode, "newest first")
+	assert.Equal(t, running.ID, runs[len(runs)-1].ID, "unfinished runs are never trimmed")
+
+	runs, err = store.Runs(sc.ID, 3)
+	require.NoError(t, err)
+	assert.Len(t, runs, 3)
+
+	updated, err := store.UpdateRun(running.ID, func(r *Run) { r.Status = RunSucceeded })
+	require.NoError(t, err)
+	assert.Equal(t, RunSucceeded, updated.Status)
+}
+
+func TestStore_SharedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schedules.json")
+	daemon, mcp := NewStore(path), NewStore(path)
+
+	created, err := mcp.Create(nightly())
+	require.NoError(t, err)
+
+	list, err := daemon.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, created.ID, list[0].ID)
+
+	evaluated := time.Now().Add(time.Hour)
+	require.NoError(t, daemon.MarkEvaluated(created.ID, evaluated))
+	got, err := mcp.Get(created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.EvaluatedAt.Equal(evaluated))
+}
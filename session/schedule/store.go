@@ -0,0 +1,327 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/google/uuid"
+	"github.com/linkdata/deadlock"
+)
+
+// maxRunsPerSchedule bounds the run history kept for each schedule.
+const maxRunsPerSchedule = 100
+
+// ErrNotFound is returned for unknown schedule and run IDs.
+var ErrNotFound = errors.New("schedule not found")
+
+// state is the persisted form of the store.
+type state struct {
+	Schedules []Schedule `json:"schedules"`
+	Runs      []Run      `json:"runs"`
+}
+
+// Store persists schedules and their run history as one JSON file. Every
+// operation re-reads the file under a file lock, so the daemon and a stdio
+// MCP server in another process see each other's changes. An empty path
+// keeps everything in memory. All methods are thread-safe.
+type Store struct {
+	mu   deadlock.Mutex
+	path string
+	mem  state
+}
+
+// NewStore returns a store backed by the file at path.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// List returns every schedule, ordered by name.
+func (s *Store) List() ([]Schedule, error) {
+	var out []Schedule
+	err := s.view(func(st *state) error {
+		out = append(out, st.Schedules...)
+		return nil
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, err
+}
+
+// Get returns the schedule with the given ID or name.
+func (s *Store) Get(idOrName string) (Schedule, error) {
+	var out Schedule
+	err := s.view(func(st *state) error {
+		i := st.find(idOrName)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrNotFound, idOrName)
+		}
+		out = st.Schedules[i]
+		return nil
+	})
+	return out, err
+}
+
+// Create validates sc, assigns it an ID and stores it. Firings before its
+// creation are never run.
+func (s *Store) Create(sc Schedule) (Schedule, error) {
+	sc.Normalize()
+	if err := sc.Validate(); err != nil {
+		return Schedule{}, err
+	}
+	now := time.Now()
+	sc.ID = uuid.NewString()
+	sc.CreatedAt, sc.UpdatedAt, sc.EvaluatedAt = now, now, now
+	err := s.update(func(st *state) error {
+		if st.find(sc.Name) >= 0 {
+			return fmt.Errorf("%w: a schedule named %q already exists", ErrInvalid, sc.Name)
+		}
+		st.Schedules = append(st.Schedules, sc)
+		return nil
+	})
+	return sc, err
+}
+
+// Update applies fn to the schedule with the given ID or name and stores the
+// result if it is still valid. Re-enabling a schedule or changing when it
+// fires does not replay firings from before the change.
+func (s *Store) Update(idOrName string, fn func(*Schedule) error) (Schedule, error) {
+	var out Schedule
+	err := s.update(func(st *state) error {
+		i := st.find(idOrName)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrNotFound, idOrName)
+		}
+		prev := st.Schedules[i]
+		sc := prev
+		if err := fn(&sc); err != nil {
+			return err
+		}
+		sc.Normalize()
+		if err := sc.Validate(); err != nil {
+			return err
+		}
+		if j := st.find(sc.Name); j >= 0 && j != i {
+			return fmt.Errorf("%w: a schedule named %q already exists", ErrInvalid, sc.Name)
+		}
+		now := time.Now()
+		if (sc.Enabled && !prev.Enabled) || sc.Cron != prev.Cron || sc.Timezone != prev.Timezone {
+			sc.EvaluatedAt = now
+		}
+		sc.ID, sc.CreatedAt, sc.UpdatedAt = prev.ID, prev.CreatedAt, now
+		st.Schedules[i] = sc
+		out = sc
+		return nil
+	})
+	return out, err
+}
+
+// MarkEvaluated records that firings up to t have been handled.
+func (s *Store) MarkEvaluated(id string, t time.Time) error {
+	return s.update(func(st *state) error {
+		i := st.find(id)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+		if t.After(st.Schedules[i].EvaluatedAt) {
+			st.Schedules[i].EvaluatedAt = t
+		}
+		return nil
+	})
+}
+
+// Delete removes a schedule and its run history.
+func (s *Store) Delete(idOrName string) error {
+	return s.update(func(st *state) error {
+		i := st.find(idOrName)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrNotFound, idOrName)
+		}
+		id := st.Schedules[i].ID
+		st.Schedules = append(st.Schedules[:i], st.Schedules[i+1:]...)
+		runs := st.Runs[:0]
+		for _, r := range st.Runs {
+			if r.ScheduleID != id {
+				runs = append(runs, r)
+			}
+		}
+		st.Runs = runs
+		return nil
+	})
+}
+
+// Runs returns a schedule's runs, newest first; an empty scheduleID returns
+// the runs of every schedule. limit <= 0 returns all of them.
+func (s *Store) Runs(scheduleID string, limit int) ([]Run, error) {
+	var out []Run
+	err := s.view(func(st *state) error {
+		for i := len(st.Runs) - 1; i >= 0; i-- {
+			if scheduleID == "" || st.Runs[i].ScheduleID == scheduleID {
+				out = append(out, st.Runs[i])
+				if limit > 0 && len(out) == limit {
+					break
+				}
+			}
+		}
+		return nil
+	})
+	return out, err
+}
+
+// AddRun assigns r an ID and appends it to its schedule's history, dropping
+// the schedule's oldest finished runs beyond the history limit.
+func (s *Store) AddRun(r Run) (Run, error) {
+	r.ID = uuid.NewString()
+	err := s.update(func(st *state) error {
+		if st.find(r.ScheduleID) < 0 {
+			return fmt.Errorf("%w: %s", ErrNotFound, r.ScheduleID)
+		}
+		st.Runs = append(st.Runs, r)
+		st.trimRuns(r.ScheduleID)
+		return nil
+	})
+	return r, err
+}
+
+// UpdateRun applies fn to the run with the given ID.
+func (s *Store) UpdateRun(id string, fn func(*Run)) (Run, error) {
+	var out Run
+	err := s.update(func(st *state) error {
+		for i := range st.Runs {
+			if st.Runs[i].ID == id {
+				fn(&st.Runs[i])
+				out = st.Runs[i]
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: run %s", ErrNotFound, id)
+	})
+	return out, err
+}
+
+// find returns the index of the schedule with the given ID or name, or -1.
+func (st *state) find(idOrName string) int {
+	for i := range st.Schedules {
+		if st.Schedules[i].ID == idOrName {
+			return i
+		}
+	}
+	for i := range st.Schedules {
+		if st.Schedules[i].Name == idOrName {
+			return i
+		}
+	}
+	return -1
+}
+
+// trimRuns drops scheduleID's oldest finished runs beyond maxRunsPerSchedule.
+func (st *state) trimRuns(scheduleID string) {
+	count := 0
+	for _, r := range st.Runs {
+		if r.ScheduleID == scheduleID {
+			count++
+		}
+	}
+	excess := count - maxRunsPerSchedule
+	if excess <= 0 {
+		return
+	}
+	runs := st.Runs[:0]
+	for _, r := range st.Runs {
+		if excess > 0 && r.ScheduleID == scheduleID && r.Status.Finished() {
+			excess--
+			continue
+		}
+		runs = append(runs, r)
+	}
+	st.Runs = runs
+}
+
+// view runs fn on the current state without saving it.
+func (s *Store) view(fn func(*state) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.path == "" {
+		return fn(&s.mem)
+	}
+	lock := flock.New(s.path + ".lock")
+	if err := lock.RLock(); err != nil {
+		return fmt.Errorf("schedule: acquire read lock: %w", err)
+	}
+	defer lock.Unlock() //nolint:errcheck
+	st, err := s.load()
+	if err != nil {
+		return err
+	}
+	return fn(st)
+}
+
+// update runs fn on the current state and saves the result if fn succeeds.
+func (s *Store) update(fn func(*state) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.path == "" {
+		next := s.mem
+		next.Schedules = append([]Schedule(nil), s.mem.Schedules...)
+		next.Runs = append([]Run(nil), s.mem.Runs...)
+		if err := fn(&next); err != nil {
+			return err
+		}
+		s.mem = next
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("schedule: create dir: %w", err)
+	}
+	lock := flock.New(s.path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("schedule: acquire write lock: %w", err)
+	}
+	defer lock.Unlock() //nolint:errcheck
+	st, err := s.load()
+	if err != nil {
+		return err
+	}
+	if err := fn(st); err != nil {
+		return err
+	}
+	return s.save(st)
+}
+
+// load reads the state file. Caller must hold s.mu and the file lock.
+func (s *Store) load() (*state, error) {
+	var st state
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return &st, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("schedule: read %s: %w", s.path, err)
+	}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("schedule: unmarshal %s: %w", s.path, err)
+	}
+	return &st, nil
+}
+
+// save writes the state file atomically. Caller must hold s.mu and the
+// write lock.
+func (s *Store) save(st *state) error {
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("schedule: marshal: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("schedule: write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("schedule: rename %s: %w", tmp, err)
+	}
+	return nil
+}
@@ -92,6 +92,36 @@ func (b *CircularBuffer) GetRange(fromSeq uint64, limit int) []ScrollbackEntry {
 	return result
 }
 
+// EntriesAfter returns the entries with a sequence greater than afterSeq,
+// oldest first. ok is false when afterSeq is ahead of the newest sequence or
+// the entry right after it has been evicted.
+func (b *CircularBuffer) EntriesAfter(afterSeq uint64) (entries []ScrollbackEntry, ok bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if afterSeq > b.sequence {
+		return nil, false
+	}
+	if afterSeq == b.sequence {
+		return nil, true
+	}
+	// Sequences are consecutive, so the entries after afterSeq are the
+	// newest b.sequence-afterSeq ones.
+	count := b.sequence - afterSeq
+	if count > uint64(b.size) {
+		return nil, false
+	}
+
+	result := make([]ScrollbackEntry, 0, count)
+	idx := (b.tail + b.size - int(count)) % b.maxSize
+	for i := 0; i < int(count); i++ {
+		result = append(result, b.entries[idx])
+		idx = (idx + 1) % b.maxSize
+	}
+
+	return result, true
+}
+
 // GetLastN retrieves the last N entries from the buffer.
 // Returns entries in chronological order (oldest first).
 func (b *CircularBuffer) GetLastN(n int) []ScrollbackEntry {
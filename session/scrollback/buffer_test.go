@@ -281,6 +281,38 @@ func TestCircularBufferSequenceNumbers(t *testing.T) {
 	}
 }
 
+func TestCircularBufferEntriesAfter(t *testing.T) {
+	buffer := NewCircularBuffer(3)
+
+	if entries, ok := buffer.EntriesAfter(0); !ok || len(entries) != 0 {
+		t.Errorf("Empty buffer: expected ok with no entries, got %d entries, ok=%v", len(entries), ok)
+	}
+
+	for _, s := range []string{"one", "two", "three", "four"} {
+		buffer.Append([]byte(s))
+	}
+
+	// Sequence 1 was evicted, so resuming after 0 would leave a gap
+	if _, ok := buffer.EntriesAfter(0); ok {
+		t.Error("Expected not ok after an evicted entry")
+	}
+
+	entries, ok := buffer.EntriesAfter(1)
+	if !ok || len(entries) != 3 {
+		t.Fatalf("Expected 3 entries after 1, got %d, ok=%v", len(entries), ok)
+	}
+	if string(entries[0].Data) != "two" || entries[2].Sequence != 4 {
+		t.Errorf("Unexpected entries: %q .. seq %d", entries[0].Data, entries[2].Sequence)
+	}
+
+	if entries, ok := buffer.EntriesAfter(4); !ok || len(entries) != 0 {
+		t.Errorf("Expected ok with no entries after newest, got %d, ok=%v", len(entries), ok)
+	}
+	if _, ok := buffer.EntriesAfter(5); ok {
+		t.Error("Expected not ok for a sequence ahead of the buffer")
+	}
+}
+
 func TestCircularBufferDataIsolation(t *testing.T) {
 	buffer := NewCircularBuffer(5)
 
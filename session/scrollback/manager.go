@@ -37,6 +37,7 @@ type ScrollbackManager struct {
 	stopChan    chan struct{}
 	stopOnce    sync.Once
 	flushTicker *time.Ticker
+	redact      func([]byte) []byte
 }
 
 // NewScrollbackManager creates a new scrollback manager.
@@ -58,11 +59,47 @@ func NewScrollbackManager(config ScrollbackConfig) *ScrollbackManager {
 	return manager
 }
 
+// SetRedactor installs a filter applied to output before it is stored and to
+// scrollback read back from storage, so secrets are neither persisted nor
+// replayed from files written before redaction was enabled. Must be called
+// before the manager is used.
+func (m *ScrollbackManager) SetRedactor(redact func([]byte) []byte) {
+	m.redact = redact
+}
+
+// redactBytes applies the redactor, if any.
+func (m *ScrollbackManager) redactBytes(data []byte) []byte {
+	if m.redact == nil || len(data) == 0 {
+		return data
+	}
+	return m.redact(data)
+}
+
+// redactEntries applies the redactor to each entry's data.
+func (m *ScrollbackManager) redactEntries(entries []ScrollbackEntry) []ScrollbackEntry {
+	if m.redact == nil {
+		return entries
+	}
+	for i := range entries {
+		entries[i].Data = m.redact(entries[i].Data)
+	}
+	return entries
+}
+
 // AppendOutput adds terminal output to the session's scrollback.
 func (m *ScrollbackManager) AppendOutput(sessionID string, data []byte) error {
+	_, err := m.Append(sessionID, data)
+	return err
+}
+
+// Append adds terminal output to the session's scrollback and returns the
+// stored entry: the output as redacted for storage and its sequence number.
+// Empty output stores nothing and returns a zero entry.
+func (m *ScrollbackManager) Append(sessionID string, data []byte) (ScrollbackEntry, error) {
 	if len(data) == 0 {
-		return nil
+		return ScrollbackEntry{}, nil
 	}
+	data = m.redactBytes(data)
 
 	m.mutex.Lock()
 	buffer, exists := m.buffers[sessionID]
@@ -73,9 +110,23 @@ func (m *ScrollbackManager) AppendOutput(sessionID string, data []byte) error {
 	m.mutex.Unlock()
 
 	// Append to circular buffer
-	buffer.Append(data)
+	entry, _ := buffer.Append(data)
 
-	return nil
+	return entry, nil
+}
+
+// EntriesAfter returns the in-memory entries with a sequence greater than
+// afterSeq, oldest first. ok is false when entries after afterSeq have
+// already been evicted from memory, so the result would have a gap.
+func (m *ScrollbackManager) EntriesAfter(sessionID string, afterSeq uint64) (entries []ScrollbackEntry, ok bool) {
+	m.mutex.RLock()
+	buffer, exists := m.buffers[sessionID]
+	m.mutex.RUnlock()
+
+	if !exists {
+		return nil, afterSeq == 0
+	}
+	return buffer.EntriesAfter(afterSeq)
 }
 
 // GetScrollback retrieves scrollback entries starting from the specified sequence.
@@ -97,6 +148,7 @@ func (m *ScrollbackManager) GetScrollback(sessionID string, fromSeq uint64, limi
 	if err != nil {
 		return memoryEntries, fmt.Errorf("failed to read from storage: %w", err)
 	}
+	storageEntries = m.redactEntries(storageEntries)
 
 	// Merge entries (storage entries are older)
 	result := make([]ScrollbackEntry, 0, len(storageEntries)+len(memoryEntries))
@@ -118,12 +170,12 @@ func (m *ScrollbackManager) GetRecentLines(sessionID string, lines int) ([]byte,
 		if err != nil {
 			return nil, fmt.Errorf("failed to read from storage: %w", err)
 		}
-		return m.entriesToBytes(entries), nil
+		return m.redactBytes(m.entriesToBytes(entries)), nil
 	}
 
 	// Get from memory buffer
 	entries := buffer.GetLastN(lines)
-	return m.entriesToBytes(entries), nil
+	return m.redactBytes(m.entriesToBytes(entries)), nil
 }
 
 // GetRecentBytes retrieves the last N bytes from scrollback.
@@ -152,6 +204,8 @@ func (m *ScrollbackManager) GetRecentBytes(sessionID string, bytes int64) ([]byt
 		}
 	}
 
+	data = m.redactBytes(data)
+
 	// Truncate to requested size if needed
 	if int64(len(data)) > bytes {
 		data = data[int64(len(data))-bytes:]
@@ -2,6 +2,7 @@ package scrollback
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -151,3 +152,35 @@ func TestGetScrollbackBefore_LimitLargerThanAvailable(t *testing.T) {
 		t.Fatalf("expected 3 entries (all), got %d", len(entries))
 	}
 }
+
+// ---- TestSetRedactor ----
+
+func TestSetRedactor_RedactsStoredAndReplayedOutput(t *testing.T) {
+	m := newTestManager(t)
+	m.SetRedactor(func(b []byte) []byte {
+		return []byte(strings.ReplaceAll(string(b), "hunter2", "[REDACTED]"))
+	})
+
+	if err := m.AppendOutput("sess1", []byte("password: hunter2\n")); err != nil {
+		t.Fatalf("AppendOutput: %v", err)
+	}
+	if err := m.FlushSession("sess1"); err != nil {
+		t.Fatalf("FlushSession: %v", err)
+	}
+	entries, err := m.GetScrollback("sess1", 0, 10)
+	if err != nil {
+		t.Fatalf("GetScrollback: %v", err)
+	}
+	for _, e := range entries {
+		if strings.Contains(string(e.Data), "hunter2") {
+			t.Errorf("entry %d contains unredacted secret: %q", e.Sequence, e.Data)
+		}
+	}
+	recent, err := m.GetRecentLines("sess1", 10)
+	if err != nil {
+		t.Fatalf("GetRecentLines: %v", err)
+	}
+	if string(recent) != "password: [REDACTED]\n" {
+		t.Errorf("GetRecentLines = %q", recent)
+	}
+}
@@ -209,13 +209,18 @@ func (s *BM25Scorer) SetParams(k1, b float64) {
 // CalculateTermScore calculates the BM25 score contribution from a single term.
 // Useful for debugging or understanding score breakdown.
 func (s *BM25Scorer) CalculateTermScore(term string, docID int32) TermScore {
+	return s.calculateTermScoreWithFrequency(term, docID, s.getTermFrequency(term, docID))
+}
+
+// calculateTermScoreWithFrequency is CalculateTermScore for callers that have
+// already looked up the term frequency in the document's posting.
+func (s *BM25Scorer) calculateTermScoreWithFrequency(term string, docID int32, tf float64) TermScore {
 	docLen := float64(s.index.GetDocLength(docID))
 	avgDocLen := s.avgDocLen
 	if avgDocLen == 0 {
 		avgDocLen = docLen
 	}
 
-	tf := s.getTermFrequency(term, docID)
 	idf := s.calculateIDF(term)
 	df := s.index.GetDocumentFrequency(term)
 
@@ -13,8 +13,15 @@ type Document struct {
 	MessageIndex int
 	// MessageRole is the role of the message sender (user, assistant, system)
 	MessageRole string
-	// Content is the full text content of the message
+	// Content is the full text content of the message, followed by one
+	// "[Tool] input" line per tool call so commands and paths are searchable
 	Content string
+	// Project is the project path of the conversation
+	Project string
+	// Provider is the agent CLI that recorded the conversation (claude, codex, gemini)
+	Provider string
+	// Tools lists the tools called in the message, in call order
+	Tools []string
 	// WordCount is the number of tokens in the message
 	WordCount int
 	// Timestamp is when the message was created
@@ -96,6 +103,18 @@ func (ds *DocumentStore) GetBySession(sessionID string) []*Document {
 	return docs
 }
 
+// GetAllDocIDs returns the IDs of all stored documents, in no particular order.
+func (ds *DocumentStore) GetAllDocIDs() []int32 {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	ids := make([]int32, 0, len(ds.Docs))
+	for id := range ds.Docs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 // GetDocIDsBySession returns all document IDs belonging to a session.
 func (ds *DocumentStore) GetDocIDsBySession(sessionID string) []int32 {
 	ds.mu.RLock()
@@ -1,13 +1,21 @@
 package search
 
 import (
+	"encoding/json"
+	"errors"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/tstapler/stapler-squad/log"
 	"github.com/tstapler/stapler-squad/session"
 )
 
-// SearchEngine is the main interface for full-text search over Claude history.
+// ErrNoMatch is returned by ExplainScore for a document the query does not match.
+var ErrNoMatch = errors.New("document does not match query")
+
+// SearchEngine is the main interface for full-text search over agent conversation
+// history (Claude, Codex and Gemini alike, via session.SessionHistory).
 // It combines tokenization, indexing, scoring, and snippet generation.
 type SearchEngine struct {
 	index        *InvertedIndex
@@ -15,8 +23,22 @@ type SearchEngine struct {
 	tokenizer    *Tokenizer
 	scorer       *BM25Scorer
 	indexStore   *IndexStore
+	segments     *SegmentStore
 	syncMetadata *IndexSyncMetadata
-	mu           sync.RWMutex
+	// pending collects changes not yet committed to segments
+	pending segmentBatch
+	mu      sync.RWMutex
+
+	// stale holds conversations whose files changed since they were indexed;
+	// it has its own lock so file watcher callbacks never wait for a sync
+	staleMu sync.Mutex
+	stale   map[string]bool
+}
+
+// segmentBatch is the set of changes the next segment commit writes.
+type segmentBatch struct {
+	docs    []segmentDoc
+	deleted []string
 }
 
 // SearchOptions configures search behavior.
@@ -27,6 +49,8 @@ type SearchOptions struct {
 	Offset int
 	// SessionID filters results to a specific session (empty = all sessions)
 	SessionID string
+	// Explain attaches a score breakdown to each result
+	Explain bool
 }
 
 // SearchResults contains the results of a search query.
@@ -37,6 +61,11 @@ type SearchResults struct {
 	TotalMatches int
 	// QueryTime is the duration of the search operation
 	QueryTime time.Duration
+	// ParsedQuery is the normalised query that was run (see Query.String)
+	ParsedQuery string
+	// Relaxed reports that no message contained every query word, so results
+	// match any of them instead
+	Relaxed bool
 }
 
 // SearchResult represents a single search result.
@@ -55,6 +84,10 @@ type SearchResult struct {
 	Content string
 	// Timestamp is when the message was created
 	Timestamp time.Time
+	// MatchedTerms are the index terms that matched, for highlighting
+	MatchedTerms []string
+	// Explanation is the score breakdown, set when SearchOptions.Explain is
+	Explanation *QueryExplanation
 }
 
 // NewSearchEngine creates a new search engine instance.
@@ -79,71 +112,21 @@ func NewSearchEngineWithPersistence(indexStore *IndexStore) *SearchEngine {
 	return engine
 }
 
+// NewSearchEngineWithSegments creates a search engine persisting to segments:
+// each sync writes only what changed, and loading needs no re-indexing.
+func NewSearchEngineWithSegments(segments *SegmentStore) *SearchEngine {
+	engine := NewSearchEngine()
+	engine.segments = segments
+	return engine
+}
+
 // BuildIndex indexes all messages from the provided history.
 // This replaces any existing index.
-func (e *SearchEngine) BuildIndex(history *session.ClaudeSessionHistory) error {
+func (e *SearchEngine) BuildIndex(history *session.SessionHistory) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	// Clear existing index
-	e.index.Clear()
-	e.docStore.Clear()
-
-	// Get all history entries
-	entries := history.GetAll()
-
-	for _, entry := range entries {
-		// Load messages for this conversation
-		messages, err := history.GetMessagesFromConversationFile(entry.ID, 0)
-		if err != nil {
-			// Log and continue - don't fail entire index build
-			continue
-		}
-
-		// Index each message
-		for msgIdx, msg := range messages {
-			// Tokenize content
-			tokens := e.tokenizer.Tokenize(msg.Content)
-			if len(tokens) == 0 {
-				continue
-			}
-
-			// Create document
-			doc := &Document{
-				SessionID:    entry.ID,
-				MessageIndex: msgIdx,
-				MessageRole:  msg.Role,
-				Content:      msg.Content,
-				WordCount:    len(tokens),
-				Timestamp:    msg.Timestamp,
-			}
-
-			// Add to document store
-			docID := e.docStore.Add(doc)
-
-			// Get positions for index
-			tokenPositions := e.tokenizer.TokenizeWithPositions(msg.Content)
-			positions := make(map[string][]int32)
-			for _, tp := range tokenPositions {
-				positions[tp.Token] = append(positions[tp.Token], int32(tp.Start))
-			}
-
-			// Add to inverted index
-			e.index.AddDocument(docID, tokens, positions)
-		}
-	}
-
-	// Update scorer with new average document length
-	e.scorer.UpdateAvgDocLength()
-
-	// Persist index if store is configured
-	if e.indexStore != nil {
-		if err := e.indexStore.Save(e.index, e.docStore); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return e.buildIndexLocked(history)
 }
 
 // IndexMessage adds a single message to the index.
@@ -152,115 +135,223 @@ func (e *SearchEngine) IndexMessage(sessionID string, msgIdx int, role, content
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	// Tokenize content
-	tokens := e.tokenizer.Tokenize(content)
-	if len(tokens) == 0 {
-		return nil // Nothing to index
-	}
-
-	// Create document
-	doc := &Document{
+	if !e.indexDocumentLocked(&Document{
 		SessionID:    sessionID,
 		MessageIndex: msgIdx,
 		MessageRole:  role,
 		Content:      content,
-		WordCount:    len(tokens),
 		Timestamp:    timestamp,
+	}) {
+		return nil // Nothing to index
 	}
 
-	// Add to document store
-	docID := e.docStore.Add(doc)
+	// Update scorer
+	e.scorer.UpdateAvgDocLength()
 
-	// Get positions for index
-	tokenPositions := e.tokenizer.TokenizeWithPositions(content)
-	positions := make(map[string][]int32)
-	for _, tp := range tokenPositions {
-		positions[tp.Token] = append(positions[tp.Token], int32(tp.Start))
+	return nil
+}
+
+// newMessageDocument builds the document for one conversation message. Tool
+// calls are appended to the content as "[Tool] input" lines.
+func newMessageDocument(entry session.ClaudeHistoryEntry, msgIdx int, msg session.ClaudeConversationMessage) *Document {
+	doc := &Document{
+		SessionID:    entry.ID,
+		MessageIndex: msgIdx,
+		MessageRole:  msg.Role,
+		Content:      msg.Content,
+		Project:      entry.Project,
+		Provider:     entry.Provider,
+		Timestamp:    msg.Timestamp,
+	}
+	if len(msg.ToolUses) == 0 {
+		return doc
+	}
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(msg.Content, "\n"))
+	for _, use := range msg.ToolUses {
+		doc.Tools = append(doc.Tools, use.Name)
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString("[" + use.Name + "]")
+		if input := toolInputText(use.Input); input != "" {
+			b.WriteString(" " + input)
+		}
 	}
+	doc.Content = b.String()
+	return doc
+}
 
-	// Add to inverted index
-	e.index.AddDocument(docID, tokens, positions)
+// maxToolInputText bounds the tool input indexed per call.
+const maxToolInputText = 500
 
-	// Update scorer
-	e.scorer.UpdateAvgDocLength()
+// toolInputText returns the searchable part of a tool call's input: the command,
+// path or pattern for the common tools, and the raw JSON otherwise.
+func toolInputText(input json.RawMessage) string {
+	if len(input) == 0 {
+		return ""
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(input, &fields); err != nil {
+		return ""
+	}
+	text := string(input)
+	for _, key := range []string{"command", "file_path", "notebook_path", "pattern", "url", "query"} {
+		if v, ok := fields[key].(string); ok && v != "" {
+			text = v
+			break
+		}
+	}
+	if len(text) > maxToolInputText {
+		text = text[:maxToolInputText]
+	}
+	return text
+}
 
-	return nil
+// indexDocumentLocked adds doc to the document store and inverted index, and
+// reports whether it had anything to index. With segment persistence the
+// analysed document is also queued for the next commit. Must be called with
+// lock held.
+func (e *SearchEngine) indexDocumentLocked(doc *Document) bool {
+	tokens := e.tokenizer.Tokenize(doc.Content)
+	if len(tokens) == 0 {
+		return false
+	}
+	doc.WordCount = len(tokens)
+
+	positions := make(map[string][]int32)
+	for _, to := range e.tokenizer.TermOrdinals(doc.Content) {
+		positions[to.Term] = append(positions[to.Term], to.Ordinal)
+	}
+	e.addAnalyzedLocked(doc, tokens, positions)
+	if e.segments != nil {
+		e.pending.docs = append(e.pending.docs, segmentDoc{Doc: *doc, Tokens: tokens, Positions: positions})
+	}
+	return true
 }
 
-// Search performs a full-text search on the indexed messages.
+// addAnalyzedLocked adds an already tokenized document. Must be called with
+// lock held.
+func (e *SearchEngine) addAnalyzedLocked(doc *Document, tokens []string, positions map[string][]int32) {
+	docID := e.docStore.Add(doc)
+	e.index.AddDocument(docID, tokens, positions)
+}
+
+// Search performs a full-text search on the indexed messages. The query
+// syntax is described in query.go; a malformed query returns a
+// *QuerySyntaxError.
 func (e *SearchEngine) Search(query string, opts SearchOptions) (*SearchResults, error) {
 	startTime := time.Now()
 
+	q, err := ParseQuery(query, e.tokenizer)
+	if err != nil {
+		return nil, err
+	}
+
 	e.mu.RLock()
 	defer e.mu.RUnlock()
 
-	// Tokenize query
-	queryTokens := e.tokenizer.Tokenize(query)
-	if len(queryTokens) == 0 {
-		return &SearchResults{
-			Results:      []SearchResult{},
-			TotalMatches: 0,
-			QueryTime:    time.Since(startTime),
-		}, nil
-	}
-
-	// Get scored documents
-	scoredDocs := e.scorer.ScoreAll(queryTokens)
-
-	// Filter by session if specified
-	if opts.SessionID != "" {
-		filtered := make([]ScoredDocument, 0)
-		for _, sd := range scoredDocs {
-			doc := e.docStore.Get(sd.DocID)
-			if doc != nil && doc.SessionID == opts.SessionID {
-				filtered = append(filtered, sd)
-			}
+	ctx := newEvalContext(e.index, e.docStore, e.scorer)
+	matches := q.run(ctx, opts.SessionID, opts.Explain)
+	relaxed := false
+	if len(matches) == 0 {
+		// Nothing contains every word; fall back to any word rather than
+		// returning nothing for a loosely phrased question.
+		if rq := q.relaxed(); rq != nil {
+			q, relaxed = rq, true
+			matches = q.run(ctx, opts.SessionID, opts.Explain)
 		}
-		scoredDocs = filtered
 	}
 
-	totalMatches := len(scoredDocs)
+	totalMatches := len(matches)
 
 	// Apply pagination
-	if opts.Offset > 0 && opts.Offset < len(scoredDocs) {
-		scoredDocs = scoredDocs[opts.Offset:]
-	} else if opts.Offset >= len(scoredDocs) {
-		scoredDocs = nil
+	if opts.Offset > 0 && opts.Offset < len(matches) {
+		matches = matches[opts.Offset:]
+	} else if opts.Offset >= len(matches) {
+		matches = nil
 	}
 
-	if opts.Limit > 0 && opts.Limit < len(scoredDocs) {
-		scoredDocs = scoredDocs[:opts.Limit]
+	if opts.Limit > 0 && opts.Limit < len(matches) {
+		matches = matches[:opts.Limit]
 	}
 
 	// Build search results
-	results := make([]SearchResult, 0, len(scoredDocs))
-	for _, sd := range scoredDocs {
-		doc := e.docStore.Get(sd.DocID)
-		if doc == nil {
-			continue
+	results := make([]SearchResult, 0, len(matches))
+	for _, m := range matches {
+		r := SearchResult{
+			DocID:        m.docID,
+			SessionID:    m.doc.SessionID,
+			MessageIndex: m.doc.MessageIndex,
+			MessageRole:  m.doc.MessageRole,
+			Score:        m.score,
+			Content:      m.doc.Content,
+			Timestamp:    m.doc.Timestamp,
+			MatchedTerms: m.matched,
 		}
-
-		results = append(results, SearchResult{
-			DocID:        sd.DocID,
-			SessionID:    doc.SessionID,
-			MessageIndex: doc.MessageIndex,
-			MessageRole:  doc.MessageRole,
-			Score:        sd.Score,
-			Content:      doc.Content,
-			Timestamp:    doc.Timestamp,
-		})
+		if opts.Explain {
+			r.Explanation = q.explanation(ctx, m)
+		}
+		results = append(results, r)
 	}
 
 	return &SearchResults{
 		Results:      results,
 		TotalMatches: totalMatches,
 		QueryTime:    time.Since(startTime),
+		ParsedQuery:  q.String(),
+		Relaxed:      relaxed,
 	}, nil
 }
 
+// ExplainScore returns how docID scores against query, or ErrNoMatch when the
+// document does not match it.
+func (e *SearchEngine) ExplainScore(query string, docID int32) (*QueryExplanation, error) {
+	q, err := ParseQuery(query, e.tokenizer)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	ctx := newEvalContext(e.index, e.docStore, e.scorer)
+	for _, m := range q.run(ctx, "", true) {
+		if m.docID == docID {
+			return q.explanation(ctx, m), nil
+		}
+	}
+	return nil, ErrNoMatch
+}
+
 // LoadIndex loads a previously persisted index from disk.
 // Also loads sync metadata if available.
 func (e *SearchEngine) LoadIndex() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.loadIndexLocked()
+}
+
+// LoadIndexAsync is LoadIndex without blocking the caller. The engine lock is
+// taken before it returns, so searches and syncs issued afterwards wait for
+// the load rather than seeing an empty index. The channel yields the result.
+func (e *SearchEngine) LoadIndexAsync() <-chan error {
+	done := make(chan error, 1)
+	e.mu.Lock()
+	go func() {
+		defer e.mu.Unlock()
+		done <- e.loadIndexLocked()
+	}()
+	return done
+}
+
+// loadIndexLocked loads from whichever store is configured. Must be called
+// with lock held.
+func (e *SearchEngine) loadIndexLocked() error {
+	if e.segments != nil {
+		return e.loadSegmentsLocked()
+	}
 	if e.indexStore == nil {
 		return nil
 	}
@@ -269,9 +360,6 @@ func (e *SearchEngine) LoadIndex() error {
 		return nil
 	}
 
-	e.mu.Lock()
-	defer e.mu.Unlock()
-
 	loadedIndex, loadedDocStore, err := e.indexStore.Load()
 	if err != nil {
 		return err
@@ -292,8 +380,39 @@ func (e *SearchEngine) LoadIndex() error {
 	return nil
 }
 
-// SaveIndex persists the current index to disk.
+// loadSegmentsLocked replaces the in-memory index with the committed
+// segments. Must be called with lock held.
+func (e *SearchEngine) loadSegmentsLocked() error {
+	index := NewInvertedIndex()
+	docStore := NewDocumentStore()
+	result, err := e.segments.Load(func(sd *segmentDoc) {
+		doc := sd.Doc
+		index.AddDocument(docStore.Add(&doc), sd.Tokens, sd.Positions)
+	})
+	if err != nil {
+		return err
+	}
+	if result.Dropped > 0 {
+		log.Warn("search index: dropped unreadable segments; their conversations will be re-indexed",
+			"dropped", result.Dropped, "dir", e.segments.Dir())
+	}
+
+	e.index = index
+	e.docStore = docStore
+	e.scorer = NewBM25Scorer(index)
+	e.syncMetadata = result.Sync
+	e.pending = segmentBatch{}
+	return nil
+}
+
+// SaveIndex persists the current index to disk. With segment persistence it
+// commits the changes made since the last sync.
 func (e *SearchEngine) SaveIndex() error {
+	if e.segments != nil {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		return e.commitSegmentsLocked(false)
+	}
 	if e.indexStore == nil {
 		return nil
 	}
@@ -304,6 +423,48 @@ func (e *SearchEngine) SaveIndex() error {
 	return e.indexStore.Save(e.index, e.docStore)
 }
 
+// persistLocked writes the index and sync metadata to the configured store.
+// replaceAll marks a full rebuild. Must be called with lock held.
+func (e *SearchEngine) persistLocked(replaceAll bool) error {
+	if e.segments != nil {
+		return e.commitSegmentsLocked(replaceAll)
+	}
+	if e.indexStore == nil {
+		return nil
+	}
+	if err := e.indexStore.Save(e.index, e.docStore); err != nil {
+		return err
+	}
+	return e.indexStore.SaveSyncMetadata(e.syncMetadata)
+}
+
+// commitSegmentsLocked commits pending changes as a new segment and starts a
+// background merge if the segments need one. Must be called with lock held.
+func (e *SearchEngine) commitSegmentsLocked(replaceAll bool) error {
+	if err := e.segments.Commit(e.pending.docs, e.pending.deleted, e.syncMetadata, replaceAll); err != nil {
+		return err
+	}
+	e.pending = segmentBatch{}
+	if e.segments.NeedsCompaction() {
+		go e.compactSegments()
+	}
+	return nil
+}
+
+// compactSegments merges segments in the background. Searches are unaffected:
+// merging only rewrites files, never the in-memory index.
+func (e *SearchEngine) compactSegments() {
+	start := time.Now()
+	merged, err := e.segments.Compact()
+	if err != nil {
+		log.Warn("search index: segment merge failed", "err", err)
+		return
+	}
+	if merged {
+		log.Info("search index: merged segments", "segments", len(e.segments.Segments()), "duration", time.Since(start))
+	}
+}
+
 // GetStats returns statistics about the search engine.
 func (e *SearchEngine) GetStats() SearchEngineStats {
 	e.mu.RLock()
@@ -347,16 +508,7 @@ func (e *SearchEngine) RemoveSession(sessionID string) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	// Get all doc IDs for this session
-	docIDs := e.docStore.GetDocIDsBySession(sessionID)
-
-	// Remove from index
-	for _, docID := range docIDs {
-		e.index.RemoveDocument(docID)
-	}
-
-	// Remove from document store
-	e.docStore.RemoveBySession(sessionID)
+	e.removeSessionLocked(sessionID)
 
 	// Update scorer
 	e.scorer.UpdateAvgDocLength()
@@ -396,7 +548,7 @@ func (e *SearchEngine) GetTokenizer() *Tokenizer {
 // IncrementalSync synchronizes the index with current history state.
 // It only indexes new/modified sessions and removes deleted ones.
 // On first run or when metadata is missing, falls back to full rebuild.
-func (e *SearchEngine) IncrementalSync(history *session.ClaudeSessionHistory) (*SyncResult, error) {
+func (e *SearchEngine) IncrementalSync(history *session.SessionHistory) (*SyncResult, error) {
 	startTime := time.Now()
 	result := &SyncResult{}
 
@@ -482,13 +634,8 @@ func (e *SearchEngine) IncrementalSync(history *session.ClaudeSessionHistory) (*
 	e.scorer.UpdateAvgDocLength()
 
 	// Persist index and metadata
-	if e.indexStore != nil {
-		if err := e.indexStore.Save(e.index, e.docStore); err != nil {
-			return result, err
-		}
-		if err := e.indexStore.SaveSyncMetadata(e.syncMetadata); err != nil {
-			return result, err
-		}
+	if err := e.persistLocked(false); err != nil {
+		return result, err
 	}
 
 	result.SyncDuration = time.Since(startTime)
@@ -497,12 +644,13 @@ func (e *SearchEngine) IncrementalSync(history *session.ClaudeSessionHistory) (*
 
 // computeChangesLocked analyzes history vs index state to determine what changed.
 // Must be called with lock held.
-func (e *SearchEngine) computeChangesLocked(history *session.ClaudeSessionHistory) (
+func (e *SearchEngine) computeChangesLocked(history *session.SessionHistory) (
 	added []session.ClaudeHistoryEntry,
 	updated []session.ClaudeHistoryEntry,
 	removed []string,
 ) {
 	entries := history.GetAll()
+	stale := e.takeStale()
 
 	// Build map of current history
 	historyMap := make(map[string]session.ClaudeHistoryEntry, len(entries))
@@ -516,7 +664,7 @@ func (e *SearchEngine) computeChangesLocked(history *session.ClaudeSessionHistor
 		if !exists {
 			// New session
 			added = append(added, entry)
-		} else if entry.UpdatedAt.After(meta.UpdatedAt) || entry.MessageCount != meta.MessageCount {
+		} else if entry.UpdatedAt.After(meta.UpdatedAt) || entry.MessageCount != meta.MessageCount || stale[entry.ID] {
 			// Modified session
 			updated = append(updated, entry)
 		}
@@ -534,51 +682,65 @@ func (e *SearchEngine) computeChangesLocked(history *session.ClaudeSessionHistor
 
 // indexSessionLocked indexes all messages from a single session.
 // Must be called with lock held.
-func (e *SearchEngine) indexSessionLocked(history *session.ClaudeSessionHistory, entry session.ClaudeHistoryEntry) (int, error) {
-	messages, err := history.GetMessagesFromConversationFile(entry.ID, 0)
+func (e *SearchEngine) indexSessionLocked(history *session.SessionHistory, entry session.ClaudeHistoryEntry) (int, error) {
+	messages, err := history.GetMessages(entry, 0)
 	if err != nil {
 		return 0, err
 	}
 
 	docCount := 0
 	for msgIdx, msg := range messages {
-		tokens := e.tokenizer.Tokenize(msg.Content)
-		if len(tokens) == 0 {
-			continue
-		}
-
-		doc := &Document{
-			SessionID:    entry.ID,
-			MessageIndex: msgIdx,
-			MessageRole:  msg.Role,
-			Content:      msg.Content,
-			WordCount:    len(tokens),
-			Timestamp:    msg.Timestamp,
+		if e.indexDocumentLocked(newMessageDocument(entry, msgIdx, msg)) {
+			docCount++
 		}
-
-		docID := e.docStore.Add(doc)
-
-		tokenPositions := e.tokenizer.TokenizeWithPositions(msg.Content)
-		positions := make(map[string][]int32)
-		for _, tp := range tokenPositions {
-			positions[tp.Token] = append(positions[tp.Token], int32(tp.Start))
-		}
-
-		e.index.AddDocument(docID, tokens, positions)
-		docCount++
 	}
 
 	return docCount, nil
 }
 
-// removeSessionLocked removes all documents from a session.
-// Must be called with lock held.
+// removeSessionLocked removes all documents from a session. With segment
+// persistence the session is tombstoned at the next commit, and any of its
+// documents not yet committed are dropped. Must be called with lock held.
 func (e *SearchEngine) removeSessionLocked(sessionID string) {
 	docIDs := e.docStore.GetDocIDsBySession(sessionID)
 	for _, docID := range docIDs {
 		e.index.RemoveDocument(docID)
 	}
 	e.docStore.RemoveBySession(sessionID)
+
+	if e.segments != nil {
+		docs := e.pending.docs[:0]
+		for _, d := range e.pending.docs {
+			if d.Doc.SessionID != sessionID {
+				docs = append(docs, d)
+			}
+		}
+		e.pending.docs = docs
+		e.pending.deleted = append(e.pending.deleted, sessionID)
+	}
+}
+
+// MarkSessionStale records that a conversation's file changed, so the next
+// IncrementalSync re-indexes it even if the history listing looks unchanged
+// (the listing only tracks user prompts, not assistant replies).
+func (e *SearchEngine) MarkSessionStale(sessionID string) {
+	e.staleMu.Lock()
+	defer e.staleMu.Unlock()
+
+	if e.stale == nil {
+		e.stale = make(map[string]bool)
+	}
+	e.stale[sessionID] = true
+}
+
+// takeStale returns and clears the stale conversation set.
+func (e *SearchEngine) takeStale() map[string]bool {
+	e.staleMu.Lock()
+	defer e.staleMu.Unlock()
+
+	stale := e.stale
+	e.stale = nil
+	return stale
 }
 
 // ShouldRebuild returns true if a full rebuild is recommended.
@@ -604,10 +766,11 @@ func (e *SearchEngine) ShouldRebuildLocked() bool {
 
 // buildIndexLocked performs a full index build and initializes sync metadata.
 // Must be called with lock held.
-func (e *SearchEngine) buildIndexLocked(history *session.ClaudeSessionHistory) error {
+func (e *SearchEngine) buildIndexLocked(history *session.SessionHistory) error {
 	// Clear existing index
 	e.index.Clear()
 	e.docStore.Clear()
+	e.pending = segmentBatch{}
 
 	// Initialize fresh sync metadata
 	e.syncMetadata = NewIndexSyncMetadata()
@@ -644,16 +807,7 @@ func (e *SearchEngine) buildIndexLocked(history *session.ClaudeSessionHistory) e
 	e.scorer.UpdateAvgDocLength()
 
 	// Persist if store is configured
-	if e.indexStore != nil {
-		if err := e.indexStore.Save(e.index, e.docStore); err != nil {
-			return err
-		}
-		if err := e.indexStore.SaveSyncMetadata(e.syncMetadata); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return e.persistLocked(true)
 }
 
 // LoadSyncMetadata loads persisted sync metadata from the index store.
@@ -293,9 +293,12 @@ func TestSearchEngine_MultiWordQuery(t *testing.T) {
 		t.Fatalf("Search failed: %v", err)
 	}
 
-	// All 3 messages contain at least one term, but doc with both should rank higher
-	if results.TotalMatches == 0 {
-		t.Error("Expected some matches for multi-word query")
+	// Words are ANDed: only the first message contains both terms
+	if results.TotalMatches != 1 {
+		t.Errorf("TotalMatches = %d, want 1", results.TotalMatches)
+	}
+	if results.Relaxed {
+		t.Error("Relaxed = true, want false when a message contains every term")
 	}
 
 	// First result should contain both terms (higher score)
@@ -27,8 +27,9 @@ type IndexVersion struct {
 }
 
 const (
-	// CurrentIndexVersion is the schema version for the index format
-	CurrentIndexVersion = 1
+	// CurrentIndexVersion is the schema version for the index format.
+	// Version 2 stores word ordinals as positions and indexes tool calls.
+	CurrentIndexVersion = 2
 
 	// Index file names
 	invertedIndexFile = "inverted_index.gob"
@@ -112,6 +113,7 @@ func (s *IndexStore) Load() (*InvertedIndex, *DocumentStore, error) {
 	if err := s.loadGob(invertedIndexFile, &index); err != nil {
 		return nil, nil, fmt.Errorf("failed to load inverted index: %w", err)
 	}
+	index.recomputeTotalLength()
 
 	// Load document store
 	var docStore DocumentStore
@@ -9,7 +9,8 @@ import (
 type PostingsList struct {
 	// DocIDs contains the list of document IDs containing this term
 	DocIDs []int32
-	// Positions contains the positions of the term within each document
+	// Positions contains the word ordinals of the term within each document
+	// (see Tokenizer.TermOrdinals), used to match phrase queries.
 	// Positions[i] corresponds to DocIDs[i]
 	Positions [][]int32
 	// Frequency contains the term frequency in each document
@@ -30,6 +31,9 @@ type InvertedIndex struct {
 	DocLengths map[int32]int
 	// AvgDocLength is the average document length for BM25 scoring
 	AvgDocLength float64
+	// totalLength is the sum of DocLengths, kept so adding or removing a
+	// document updates AvgDocLength in constant time
+	totalLength int
 	// mu protects concurrent access to the index
 	mu sync.RWMutex
 }
@@ -62,13 +66,8 @@ func (idx *InvertedIndex) AddDocument(docID int32, tokens []string, positions ma
 	// Update document count and length
 	idx.TotalDocs++
 	idx.DocLengths[docID] = len(tokens)
-
-	// Recalculate average document length
-	totalLength := 0
-	for _, length := range idx.DocLengths {
-		totalLength += length
-	}
-	idx.AvgDocLength = float64(totalLength) / float64(idx.TotalDocs)
+	idx.totalLength += len(tokens)
+	idx.AvgDocLength = float64(idx.totalLength) / float64(idx.TotalDocs)
 
 	// Track which terms are new to this document (for DocFrequency)
 	seenTerms := make(map[string]bool)
@@ -235,21 +234,29 @@ func (idx *InvertedIndex) RemoveDocument(docID int32) {
 	}
 
 	// Update document count and length tracking
+	idx.totalLength -= idx.DocLengths[docID]
 	delete(idx.DocLengths, docID)
 	idx.TotalDocs--
 
-	// Recalculate average document length
 	if idx.TotalDocs > 0 {
-		totalLength := 0
-		for _, length := range idx.DocLengths {
-			totalLength += length
-		}
-		idx.AvgDocLength = float64(totalLength) / float64(idx.TotalDocs)
+		idx.AvgDocLength = float64(idx.totalLength) / float64(idx.TotalDocs)
 	} else {
 		idx.AvgDocLength = 0
 	}
 }
 
+// recomputeTotalLength rebuilds totalLength after the exported fields were
+// decoded from a snapshot.
+func (idx *InvertedIndex) recomputeTotalLength() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.totalLength = 0
+	for _, length := range idx.DocLengths {
+		idx.totalLength += length
+	}
+}
+
 // Clear removes all documents and terms from the index.
 func (idx *InvertedIndex) Clear() {
 	idx.mu.Lock()
@@ -260,6 +267,7 @@ func (idx *InvertedIndex) Clear() {
 	idx.DocLengths = make(map[int32]int)
 	idx.TotalDocs = 0
 	idx.AvgDocLength = 0
+	idx.totalLength = 0
 }
 
 // GetAllTerms returns all terms in the index.
@@ -0,0 +1,498 @@
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Query syntax
+//
+// A query is a list of clauses that must all match (implicit AND):
+//
+//	docker build             both words, in any order
+//	"go test -race"          a phrase: the words adjacent and in order
+//	auth*                    any word starting with "auth"
+//	authentcation~           words within two typos of "authentcation" (~1 for one)
+//	role:assistant           field filters: role, project, tool, provider, session
+//	project:"my app"         quoted field values may contain spaces
+//	tool:Bash tool:mcp__*    the message called the tool (trailing * matches a prefix)
+//	after:2026-01-01         date filters: after, before (RFC 3339 date or time, or a
+//	before:7d                relative age such as 12h, 7d or 2w), and date:DAY or
+//	date:2026-01-01..2026-01-31  date:FROM..TO (inclusive days)
+//	docker OR podman         either clause
+//	-race  NOT race          exclude messages matching the clause
+//	(a OR b) c               parentheses group clauses
+//
+// Plain words that appear nowhere in the index are matched with typo tolerance.
+// A query of plain words only falls back to matching any of them when no
+// message contains them all.
+
+// QuerySyntaxError reports a query that cannot be parsed.
+type QuerySyntaxError struct {
+	Query string
+	Msg   string
+}
+
+func (e *QuerySyntaxError) Error() string {
+	return fmt.Sprintf("invalid search query %q: %s", e.Query, e.Msg)
+}
+
+const (
+	// maxExpansions caps the index terms a prefix or fuzzy clause expands to.
+	maxExpansions = 64
+	// phraseWeight boosts terms matched as part of a phrase.
+	phraseWeight = 1.5
+	// prefixWeight discounts terms matched by a prefix rather than exactly.
+	prefixWeight = 0.9
+	// fuzzyPenalty is subtracted from a fuzzy term's weight per edit.
+	fuzzyPenalty = 0.2
+)
+
+// Query is a parsed search query. Parse with ParseQuery.
+type Query struct {
+	raw  string
+	root queryNode
+	// plain reports a query made only of bare words, which Search relaxes from
+	// "all words" to "any word" when nothing matches all of them.
+	plain bool
+}
+
+// ParseQuery parses a query in the syntax described above. Relative dates are
+// resolved against the current time.
+func ParseQuery(input string, tokenizer *Tokenizer) (*Query, error) {
+	return parseQuery(input, tokenizer, time.Now())
+}
+
+func parseQuery(input string, tokenizer *Tokenizer, now time.Time) (*Query, error) {
+	p := &queryParser{
+		input:     input,
+		toks:      lexQuery(input),
+		tokenizer: tokenizer,
+		now:       now,
+		plain:     true,
+	}
+	var parts []queryNode
+	for {
+		if n := p.parseOr(); n != nil {
+			parts = append(parts, n)
+		}
+		if p.pos >= len(p.toks) {
+			break
+		}
+		p.pos++ // skip an unbalanced ')'
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &Query{raw: input, root: newAnd(parts), plain: p.plain}, nil
+}
+
+// Empty reports whether the query has no searchable clauses, e.g. only stop words.
+func (q *Query) Empty() bool { return q.root == nil }
+
+// String returns the normalised form of the query, showing how it was parsed.
+func (q *Query) String() string {
+	if q.root == nil {
+		return ""
+	}
+	return q.root.String()
+}
+
+// relaxed returns the "any word" form of a plain multi-word query, or nil.
+func (q *Query) relaxed() *Query {
+	and, ok := q.root.(*andNode)
+	if !q.plain || !ok {
+		return nil
+	}
+	return &Query{raw: q.raw, root: &orNode{children: and.children}}
+}
+
+// ---- lexer ----
+
+type lexKind int
+
+const (
+	lexWord lexKind = iota
+	lexPhrase
+	lexLParen
+	lexRParen
+	lexAnd
+	lexOr
+	lexNot
+	lexRequire
+)
+
+type lexToken struct {
+	kind  lexKind
+	text  string
+	field string
+}
+
+// isQueryField reports whether name is a field that may prefix a clause.
+func isQueryField(name string) bool {
+	switch name {
+	case "role", "project", "tool", "provider", "session", "after", "before", "date":
+		return true
+	}
+	return false
+}
+
+func lexQuery(input string) []lexToken {
+	var toks []lexToken
+	rs := []rune(input)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			toks = append(toks, lexToken{kind: lexLParen})
+			i++
+		case r == ')':
+			toks = append(toks, lexToken{kind: lexRParen})
+			i++
+		case r == '"':
+			text, next := readQuoted(rs, i)
+			toks = append(toks, lexToken{kind: lexPhrase, text: text})
+			i = next
+		case (r == '-' || r == '+') && i+1 < len(rs) && !unicode.IsSpace(rs[i+1]):
+			if r == '-' {
+				toks = append(toks, lexToken{kind: lexNot})
+			} else {
+				toks = append(toks, lexToken{kind: lexRequire})
+			}
+			i++
+		default:
+			var tok lexToken
+			tok, i = readWord(rs, i)
+			toks = append(toks, tok)
+		}
+	}
+	return toks
+}
+
+// readQuoted reads a quoted string starting at the quote at rs[start]. An
+// unterminated quote runs to the end of the input.
+func readQuoted(rs []rune, start int) (string, int) {
+	end := start + 1
+	for end < len(rs) && rs[end] != '"' {
+		end++
+	}
+	text := string(rs[start+1 : end])
+	if end < len(rs) {
+		end++ // closing quote
+	}
+	return text, end
+}
+
+// readWord reads a bare word, keyword or field clause starting at rs[start].
+func readWord(rs []rune, start int) (lexToken, int) {
+	i := start
+	for i < len(rs) && !unicode.IsSpace(rs[i]) && rs[i] != '(' && rs[i] != ')' {
+		if rs[i] == ':' && i+1 < len(rs) && rs[i+1] == '"' && isQueryField(strings.ToLower(string(rs[start:i]))) {
+			text, next := readQuoted(rs, i+1)
+			return lexToken{kind: lexPhrase, text: text, field: strings.ToLower(string(rs[start:i]))}, next
+		}
+		i++
+	}
+	word := string(rs[start:i])
+	switch word {
+	case "AND", "&&":
+		return lexToken{kind: lexAnd}, i
+	case "OR", "||":
+		return lexToken{kind: lexOr}, i
+	case "NOT":
+		return lexToken{kind: lexNot}, i
+	}
+	if colon := strings.IndexByte(word, ':'); colon > 0 && colon < len(word)-1 {
+		if field := strings.ToLower(word[:colon]); isQueryField(field) {
+			return lexToken{kind: lexWord, text: word[colon+1:], field: field}, i
+		}
+	}
+	return lexToken{kind: lexWord, text: word}, i
+}
+
+// ---- parser ----
+
+type queryParser struct {
+	input     string
+	toks      []lexToken
+	pos       int
+	tokenizer *Tokenizer
+	now       time.Time
+	plain     bool
+	err       error // first syntax error; parsing continues past it
+}
+
+func (p *queryParser) peek(kind lexKind) bool {
+	return p.pos < len(p.toks) && p.toks[p.pos].kind == kind
+}
+
+func (p *queryParser) parseOr() queryNode {
+	var children []queryNode
+	for {
+		if n := p.parseAnd(); n != nil {
+			children = append(children, n)
+		}
+		if !p.peek(lexOr) {
+			break
+		}
+		p.pos++
+		p.plain = false
+	}
+	switch len(children) {
+	case 0:
+		return nil
+	case 1:
+		return children[0]
+	}
+	return &orNode{children: children}
+}
+
+func (p *queryParser) parseAnd() queryNode {
+	var children []queryNode
+	for p.pos < len(p.toks) {
+		switch p.toks[p.pos].kind {
+		case lexOr, lexRParen:
+			return newAnd(children)
+		case lexAnd:
+			p.pos++
+			p.plain = false
+			continue
+		}
+		if n := p.parseUnary(); n != nil {
+			children = append(children, n)
+		}
+	}
+	return newAnd(children)
+}
+
+func (p *queryParser) parseUnary() queryNode {
+	tok := p.toks[p.pos]
+	p.pos++
+	switch tok.kind {
+	case lexNot:
+		p.plain = false
+		if p.pos >= len(p.toks) {
+			return nil
+		}
+		child := p.parseUnary()
+		if child == nil {
+			return nil
+		}
+		return &notNode{child: child}
+	case lexRequire:
+		p.plain = false
+		if p.pos >= len(p.toks) {
+			return nil
+		}
+		return p.parseUnary()
+	case lexLParen:
+		p.plain = false
+		n := p.parseOr()
+		if p.peek(lexRParen) {
+			p.pos++
+		}
+		return n
+	case lexPhrase:
+		p.plain = false
+		if tok.field != "" {
+			return p.fieldClause(tok.field, tok.text)
+		}
+		return p.textClause(tok.text)
+	case lexWord:
+		if tok.field != "" {
+			p.plain = false
+			return p.fieldClause(tok.field, tok.text)
+		}
+		return p.wordClause(tok.text)
+	}
+	return nil
+}
+
+// wordClause parses a bare word, which may carry a prefix or fuzzy operator.
+func (p *queryParser) wordClause(word string) queryNode {
+	if base := strings.TrimRight(word, "*"); base != word && isSingleWord(base) {
+		p.plain = false
+		prefix := strings.ToLower(base)
+		return &prefixNode{prefix: prefix, stem: porterStem(prefix)}
+	}
+	if i := strings.LastIndexByte(word, '~'); i > 0 && isSingleWord(word[:i]) {
+		edits := -1
+		if suffix := word[i+1:]; suffix != "" {
+			n, err := strconv.Atoi(suffix)
+			if err != nil {
+				return p.textClause(word)
+			}
+			edits = min(max(n, 0), 2)
+		}
+		p.plain = false
+		base := strings.ToLower(word[:i])
+		if edits < 0 {
+			edits = max(autoEdits(base), 1)
+		}
+		return &fuzzyNode{raw: base, term: porterStem(base), edits: edits}
+	}
+	n := p.textClause(word)
+	if _, isTerm := n.(*termNode); n != nil && !isTerm {
+		p.plain = false // "foo-bar" is a phrase
+	}
+	return n
+}
+
+// textClause turns free text into a term or, for several words, a phrase.
+// Text made only of stop words yields nil.
+func (p *queryParser) textClause(text string) queryNode {
+	terms := p.tokenizer.TermOrdinals(text)
+	switch len(terms) {
+	case 0:
+		return nil
+	case 1:
+		raw := strings.TrimFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsNumber(r) })
+		return &termNode{raw: raw, term: terms[0].Term}
+	}
+	n := &phraseNode{raw: text}
+	for _, t := range terms {
+		n.terms = append(n.terms, t.Term)
+		n.offsets = append(n.offsets, t.Ordinal-terms[0].Ordinal)
+	}
+	return n
+}
+
+func isSingleWord(s string) bool {
+	if len(s) < 2 {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *queryParser) fieldClause(field, value string) queryNode {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	switch field {
+	case "after":
+		return &dateNode{from: p.parseTime(value)}
+	case "before":
+		return &dateNode{to: p.parseTime(value)}
+	case "date":
+		from, to, isRange := strings.Cut(value, "..")
+		n := &dateNode{}
+		if from != "" {
+			n.from = startOfDay(p.parseTime(from))
+			if !isRange {
+				n.to = n.from.AddDate(0, 0, 1)
+			}
+		}
+		if to != "" {
+			n.to = startOfDay(p.parseTime(to)).AddDate(0, 0, 1)
+		}
+		return n
+	}
+	return &fieldNode{field: field, value: strings.ToLower(value)}
+}
+
+// parseTime accepts an RFC 3339 date or time, "today", "yesterday", or an age
+// such as 30m, 12h, 7d or 2w. An unparseable value records a syntax error.
+func (p *queryParser) parseTime(value string) time.Time {
+	switch strings.ToLower(value) {
+	case "today":
+		return startOfDay(p.now)
+	case "yesterday":
+		return startOfDay(p.now).AddDate(0, 0, -1)
+	}
+	if n := len(value); n >= 2 {
+		if count, err := strconv.Atoi(value[:n-1]); err == nil && count >= 0 {
+			switch value[n-1] {
+			case 'm':
+				return p.now.Add(-time.Duration(count) * time.Minute)
+			case 'h':
+				return p.now.Add(-time.Duration(count) * time.Hour)
+			case 'd':
+				return p.now.AddDate(0, 0, -count)
+			case 'w':
+				return p.now.AddDate(0, 0, -7*count)
+			}
+		}
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, p.now.Location()); err == nil {
+			return t
+		}
+	}
+	if p.err == nil {
+		p.err = &QuerySyntaxError{Query: p.input, Msg: fmt.Sprintf("cannot parse date %q (use 2006-01-02, an RFC 3339 time, or an age like 7d)", value)}
+	}
+	return time.Time{}
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func newAnd(children []queryNode) queryNode {
+	switch len(children) {
+	case 0:
+		return nil
+	case 1:
+		return children[0]
+	}
+	return &andNode{children: children}
+}
+
+// autoEdits is the typo tolerance for a term: none for short terms, where a
+// single edit changes the meaning, and up to two for long ones.
+func autoEdits(term string) int {
+	switch n := len(term); {
+	case n < 4:
+		return 0
+	case n <= 6:
+		return 1
+	}
+	return 2
+}
+
+// editDistance returns the optimal string alignment distance between a and b
+// (insertions, deletions, substitutions and adjacent transpositions), or
+// limit+1 when it exceeds limit.
+func editDistance(a, b string, limit int) int {
+	if d := len(a) - len(b); d > limit || -d > limit {
+		return limit + 1
+	}
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
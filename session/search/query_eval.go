@@ -0,0 +1,585 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// queryNode is a clause of a parsed query.
+type queryNode interface {
+	// resolve expands the clause against the index (prefix and fuzzy terms).
+	// It is called once before any other method.
+	resolve(c *evalContext)
+	// candidates returns the documents that can match, or false when the
+	// clause cannot enumerate them (filters and negations).
+	candidates(c *evalContext) (map[int32]struct{}, bool)
+	// matches reports whether the document satisfies the clause.
+	matches(c *evalContext, docID int32, doc *Document) bool
+	// scoringTerms appends the index terms that contribute to the score.
+	scoringTerms(dst []weightedTerm) []weightedTerm
+	String() string
+}
+
+// weightedTerm is an index term scored for a clause.
+type weightedTerm struct {
+	term   string
+	weight float64
+	clause string
+}
+
+// evalContext gives query clauses cached access to the index during one search.
+type evalContext struct {
+	index    *InvertedIndex
+	docs     *DocumentStore
+	scorer   *BM25Scorer
+	postings map[string]map[int32]int // term -> docID -> index into its PostingsList
+	lists    map[string]*PostingsList
+	vocab    []string
+}
+
+func newEvalContext(index *InvertedIndex, docs *DocumentStore, scorer *BM25Scorer) *evalContext {
+	return &evalContext{
+		index:    index,
+		docs:     docs,
+		scorer:   scorer,
+		postings: make(map[string]map[int32]int),
+		lists:    make(map[string]*PostingsList),
+	}
+}
+
+// posting returns the term's postings and the position of docID within them.
+func (c *evalContext) posting(term string, docID int32) (*PostingsList, int, bool) {
+	byDoc, ok := c.postings[term]
+	if !ok {
+		list := c.index.Search(term)
+		byDoc = make(map[int32]int)
+		if list != nil {
+			for i, id := range list.DocIDs {
+				byDoc[id] = i
+			}
+		}
+		c.postings[term] = byDoc
+		c.lists[term] = list
+	}
+	i, ok := byDoc[docID]
+	return c.lists[term], i, ok
+}
+
+func (c *evalContext) has(term string, docID int32) bool {
+	_, _, ok := c.posting(term, docID)
+	return ok
+}
+
+func (c *evalContext) frequency(term string, docID int32) float64 {
+	list, i, ok := c.posting(term, docID)
+	if !ok {
+		return 0
+	}
+	return float64(list.Frequency[i])
+}
+
+func (c *evalContext) positions(term string, docID int32) []int32 {
+	list, i, ok := c.posting(term, docID)
+	if !ok || i >= len(list.Positions) {
+		return nil
+	}
+	return list.Positions[i]
+}
+
+// docsWith returns the documents containing term.
+func (c *evalContext) docsWith(term string) []int32 {
+	if list := c.index.Search(term); list != nil {
+		return list.DocIDs
+	}
+	return nil
+}
+
+// vocabulary returns every indexed term, sorted.
+func (c *evalContext) vocabulary() []string {
+	if c.vocab == nil {
+		c.vocab = c.index.GetAllTerms()
+		sort.Strings(c.vocab)
+	}
+	return c.vocab
+}
+
+// fuzzyTerms returns the index terms within edits of term, closest first,
+// weighted down by distance.
+func (c *evalContext) fuzzyTerms(term string, edits int, clause string) []weightedTerm {
+	type candidate struct {
+		term string
+		dist int
+	}
+	var found []candidate
+	for _, t := range c.vocabulary() {
+		if d := editDistance(term, t, edits); d <= edits {
+			found = append(found, candidate{t, d})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].dist < found[j].dist })
+	if len(found) > maxExpansions {
+		found = found[:maxExpansions]
+	}
+	out := make([]weightedTerm, 0, len(found))
+	for _, f := range found {
+		out = append(out, weightedTerm{term: f.term, weight: 1 - fuzzyPenalty*float64(f.dist), clause: clause})
+	}
+	return out
+}
+
+// altTerms is a clause matching any of a set of index terms.
+type altTerms struct {
+	alts []weightedTerm
+}
+
+func (a *altTerms) candidates(c *evalContext) (map[int32]struct{}, bool) {
+	set := make(map[int32]struct{})
+	for _, t := range a.alts {
+		for _, id := range c.docsWith(t.term) {
+			set[id] = struct{}{}
+		}
+	}
+	return set, true
+}
+
+func (a *altTerms) matches(c *evalContext, docID int32, _ *Document) bool {
+	for _, t := range a.alts {
+		if c.has(t.term, docID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *altTerms) scoringTerms(dst []weightedTerm) []weightedTerm {
+	return append(dst, a.alts...)
+}
+
+// termNode matches one word. A word that appears nowhere in the index is
+// matched with typo tolerance instead.
+type termNode struct {
+	altTerms
+	raw  string
+	term string
+}
+
+func (n *termNode) resolve(c *evalContext) {
+	if c.index.GetDocumentFrequency(n.term) == 0 {
+		if edits := autoEdits(n.term); edits > 0 {
+			n.alts = c.fuzzyTerms(n.term, edits, n.String())
+			return
+		}
+	}
+	n.alts = []weightedTerm{{term: n.term, weight: 1, clause: n.String()}}
+}
+
+func (n *termNode) String() string { return n.raw }
+
+// prefixNode matches words starting with a prefix.
+type prefixNode struct {
+	altTerms
+	prefix string
+	stem   string
+}
+
+func (n *prefixNode) resolve(c *evalContext) {
+	vocab := c.vocabulary()
+	seen := make(map[string]bool)
+	for _, p := range []string{n.prefix, n.stem} {
+		for i := sort.SearchStrings(vocab, p); i < len(vocab) && strings.HasPrefix(vocab[i], p); i++ {
+			if t := vocab[i]; !seen[t] && len(n.alts) < maxExpansions {
+				seen[t] = true
+				weight := prefixWeight
+				if t == n.prefix || t == n.stem {
+					weight = 1
+				}
+				n.alts = append(n.alts, weightedTerm{term: t, weight: weight, clause: n.String()})
+			}
+		}
+	}
+}
+
+func (n *prefixNode) String() string { return n.prefix + "*" }
+
+// fuzzyNode matches words within an edit distance.
+type fuzzyNode struct {
+	altTerms
+	raw   string
+	term  string
+	edits int
+}
+
+func (n *fuzzyNode) resolve(c *evalContext) {
+	n.alts = c.fuzzyTerms(n.term, n.edits, n.String())
+}
+
+func (n *fuzzyNode) String() string { return n.raw + "~" + strconv.Itoa(n.edits) }
+
+// phraseNode matches words adjacent and in order. offsets holds each term's
+// word distance from the first, so stop words inside the phrase still count.
+type phraseNode struct {
+	raw     string
+	terms   []string
+	offsets []int32
+}
+
+func (n *phraseNode) resolve(*evalContext) {}
+
+func (n *phraseNode) candidates(c *evalContext) (map[int32]struct{}, bool) {
+	var rarest []int32
+	for i, t := range n.terms {
+		ids := c.docsWith(t)
+		if i == 0 || len(ids) < len(rarest) {
+			rarest = ids
+		}
+	}
+	set := make(map[int32]struct{}, len(rarest))
+	for _, id := range rarest {
+		set[id] = struct{}{}
+	}
+	return set, true
+}
+
+func (n *phraseNode) matches(c *evalContext, docID int32, _ *Document) bool {
+	later := make([]map[int32]bool, len(n.terms))
+	for i := 1; i < len(n.terms); i++ {
+		ps := c.positions(n.terms[i], docID)
+		if len(ps) == 0 {
+			return false
+		}
+		later[i] = make(map[int32]bool, len(ps))
+		for _, p := range ps {
+			later[i][p] = true
+		}
+	}
+	for _, start := range c.positions(n.terms[0], docID) {
+		ok := true
+		for i := 1; i < len(n.terms) && ok; i++ {
+			ok = later[i][start+n.offsets[i]]
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *phraseNode) scoringTerms(dst []weightedTerm) []weightedTerm {
+	for _, t := range n.terms {
+		dst = append(dst, weightedTerm{term: t, weight: phraseWeight, clause: n.String()})
+	}
+	return dst
+}
+
+func (n *phraseNode) String() string { return strconv.Quote(n.raw) }
+
+// fieldNode filters on document metadata.
+type fieldNode struct {
+	field string
+	value string // lower-cased
+}
+
+func (n *fieldNode) resolve(*evalContext) {}
+
+func (n *fieldNode) candidates(*evalContext) (map[int32]struct{}, bool) { return nil, false }
+
+func (n *fieldNode) matches(_ *evalContext, _ int32, doc *Document) bool {
+	switch n.field {
+	case "role":
+		return strings.EqualFold(doc.MessageRole, n.value)
+	case "project":
+		return strings.Contains(strings.ToLower(doc.Project), n.value)
+	case "provider":
+		return strings.EqualFold(doc.Provider, n.value)
+	case "session":
+		return strings.HasPrefix(strings.ToLower(doc.SessionID), n.value)
+	case "tool":
+		prefix, isPrefix := strings.CutSuffix(n.value, "*")
+		for _, tool := range doc.Tools {
+			if isPrefix && strings.HasPrefix(strings.ToLower(tool), prefix) ||
+				!isPrefix && strings.EqualFold(tool, n.value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (n *fieldNode) scoringTerms(dst []weightedTerm) []weightedTerm { return dst }
+
+func (n *fieldNode) String() string {
+	if strings.ContainsAny(n.value, " \t\"()") {
+		return n.field + ":" + strconv.Quote(n.value)
+	}
+	return n.field + ":" + n.value
+}
+
+// dateNode keeps documents with from <= timestamp < to; a zero bound is open.
+type dateNode struct {
+	from, to time.Time
+}
+
+func (n *dateNode) resolve(*evalContext) {}
+
+func (n *dateNode) candidates(*evalContext) (map[int32]struct{}, bool) { return nil, false }
+
+func (n *dateNode) matches(_ *evalContext, _ int32, doc *Document) bool {
+	if doc.Timestamp.IsZero() {
+		return false
+	}
+	if !n.from.IsZero() && doc.Timestamp.Before(n.from) {
+		return false
+	}
+	return n.to.IsZero() || doc.Timestamp.Before(n.to)
+}
+
+func (n *dateNode) scoringTerms(dst []weightedTerm) []weightedTerm { return dst }
+
+func (n *dateNode) String() string {
+	switch {
+	case n.to.IsZero():
+		return "after:" + n.from.Format(time.RFC3339)
+	case n.from.IsZero():
+		return "before:" + n.to.Format(time.RFC3339)
+	}
+	return fmt.Sprintf("date:%s..%s", n.from.Format(time.RFC3339), n.to.Format(time.RFC3339))
+}
+
+// notNode excludes documents matching its child. Terms under a negation never
+// contribute to the score.
+type notNode struct {
+	child queryNode
+}
+
+func (n *notNode) resolve(c *evalContext) { n.child.resolve(c) }
+
+func (n *notNode) candidates(*evalContext) (map[int32]struct{}, bool) { return nil, false }
+
+func (n *notNode) matches(c *evalContext, docID int32, doc *Document) bool {
+	return !n.child.matches(c, docID, doc)
+}
+
+func (n *notNode) scoringTerms(dst []weightedTerm) []weightedTerm { return dst }
+
+func (n *notNode) String() string { return "-" + n.child.String() }
+
+type andNode struct {
+	children []queryNode
+}
+
+func (n *andNode) resolve(c *evalContext) {
+	for _, ch := range n.children {
+		ch.resolve(c)
+	}
+}
+
+// candidates intersects the children that can enumerate their documents; the
+// rest are checked by matches.
+func (n *andNode) candidates(c *evalContext) (map[int32]struct{}, bool) {
+	var set map[int32]struct{}
+	for _, ch := range n.children {
+		cs, ok := ch.candidates(c)
+		if !ok {
+			continue
+		}
+		if set == nil {
+			set = cs
+			continue
+		}
+		for id := range set {
+			if _, keep := cs[id]; !keep {
+				delete(set, id)
+			}
+		}
+	}
+	return set, set != nil
+}
+
+func (n *andNode) matches(c *evalContext, docID int32, doc *Document) bool {
+	for _, ch := range n.children {
+		if !ch.matches(c, docID, doc) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *andNode) scoringTerms(dst []weightedTerm) []weightedTerm {
+	for _, ch := range n.children {
+		dst = ch.scoringTerms(dst)
+	}
+	return dst
+}
+
+func (n *andNode) String() string { return joinNodes(n.children, " ") }
+
+type orNode struct {
+	children []queryNode
+}
+
+func (n *orNode) resolve(c *evalContext) {
+	for _, ch := range n.children {
+		ch.resolve(c)
+	}
+}
+
+func (n *orNode) candidates(c *evalContext) (map[int32]struct{}, bool) {
+	set := make(map[int32]struct{})
+	for _, ch := range n.children {
+		cs, ok := ch.candidates(c)
+		if !ok {
+			return nil, false
+		}
+		for id := range cs {
+			set[id] = struct{}{}
+		}
+	}
+	return set, true
+}
+
+func (n *orNode) matches(c *evalContext, docID int32, doc *Document) bool {
+	for _, ch := range n.children {
+		if ch.matches(c, docID, doc) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *orNode) scoringTerms(dst []weightedTerm) []weightedTerm {
+	for _, ch := range n.children {
+		dst = ch.scoringTerms(dst)
+	}
+	return dst
+}
+
+func (n *orNode) String() string { return "(" + joinNodes(n.children, " OR ") + ")" }
+
+func joinNodes(nodes []queryNode, sep string) string {
+	parts := make([]string, len(nodes))
+	for i, n := range nodes {
+		parts[i] = n.String()
+	}
+	return strings.Join(parts, sep)
+}
+
+// queryMatch is a document matching a query, with its score.
+type queryMatch struct {
+	docID     int32
+	doc       *Document
+	score     float64
+	matched   []string
+	breakdown []TermExplanation
+}
+
+// TermExplanation is one term's contribution to a result's score.
+type TermExplanation struct {
+	TermScore
+	// Clause is the query clause the term was matched for, e.g. `"go test"` or `auth*`.
+	Clause string
+	// Weight scales the BM25 term score: lower for prefix and fuzzy matches,
+	// higher for phrase matches.
+	Weight float64
+}
+
+// QueryExplanation breaks down how a result's score was computed.
+type QueryExplanation struct {
+	// Query is the normalised query (see Query.String).
+	Query      string
+	DocID      int32
+	TotalScore float64
+	K1         float64
+	B          float64
+	Terms      []TermExplanation
+}
+
+// dedupeTerms keeps the highest weight for each term so a term named by
+// several clauses is scored once.
+func dedupeTerms(terms []weightedTerm) []weightedTerm {
+	best := make(map[string]int, len(terms))
+	out := make([]weightedTerm, 0, len(terms))
+	for _, t := range terms {
+		if i, ok := best[t.term]; ok {
+			if t.weight > out[i].weight {
+				out[i] = t
+			}
+			continue
+		}
+		best[t.term] = len(out)
+		out = append(out, t)
+	}
+	return out
+}
+
+// run evaluates the query, returning matches ordered by score, then newest
+// first. Documents matched only by filters or negations score zero.
+func (q *Query) run(c *evalContext, sessionID string, explain bool) []queryMatch {
+	if q.root == nil {
+		return nil
+	}
+	q.root.resolve(c)
+	scoring := dedupeTerms(q.root.scoringTerms(nil))
+
+	var ids []int32
+	if set, ok := q.root.candidates(c); ok {
+		ids = make([]int32, 0, len(set))
+		for id := range set {
+			ids = append(ids, id)
+		}
+	} else {
+		ids = c.docs.GetAllDocIDs()
+	}
+
+	var out []queryMatch
+	for _, id := range ids {
+		doc := c.docs.Get(id)
+		if doc == nil || sessionID != "" && doc.SessionID != sessionID {
+			continue
+		}
+		if !q.root.matches(c, id, doc) {
+			continue
+		}
+		m := queryMatch{docID: id, doc: doc}
+		for _, wt := range scoring {
+			tf := c.frequency(wt.term, id)
+			if tf == 0 {
+				continue
+			}
+			ts := c.scorer.calculateTermScoreWithFrequency(wt.term, id, tf)
+			m.score += wt.weight * ts.Score
+			m.matched = append(m.matched, wt.term)
+			if explain {
+				m.breakdown = append(m.breakdown, TermExplanation{TermScore: ts, Clause: wt.clause, Weight: wt.weight})
+			}
+		}
+		out = append(out, m)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if !a.doc.Timestamp.Equal(b.doc.Timestamp) {
+			return a.doc.Timestamp.After(b.doc.Timestamp)
+		}
+		return a.docID < b.docID
+	})
+	return out
+}
+
+// explanation renders a match's score breakdown.
+func (q *Query) explanation(c *evalContext, m queryMatch) *QueryExplanation {
+	k1, b := c.scorer.GetParams()
+	return &QueryExplanation{
+		Query:      q.String(),
+		DocID:      m.docID,
+		TotalScore: m.score,
+		K1:         k1,
+		B:          b,
+		Terms:      m.breakdown,
+	}
+}
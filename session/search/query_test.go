@@ -0,0 +1,205 @@
+package search
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// queryTestEngine indexes a small conversation covering the query features.
+func queryTestEngine(t *testing.T) *SearchEngine {
+	t.Helper()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.Local) }
+	docs := []*Document{
+		{SessionID: "s1", MessageIndex: 0, MessageRole: "user", Content: "please run go test -race on the package", Project: "/src/api", Provider: "claude", Timestamp: day(1)},
+		{SessionID: "s1", MessageIndex: 1, MessageRole: "assistant", Content: "Running the tests.\n[Bash] go test -race ./...", Project: "/src/api", Provider: "claude", Tools: []string{"Bash"}, Timestamp: day(2)},
+		{SessionID: "s1", MessageIndex: 2, MessageRole: "assistant", Content: "the race detector found nothing; go test passed", Project: "/src/api", Provider: "claude", Timestamp: day(3)},
+		{SessionID: "s2", MessageIndex: 0, MessageRole: "user", Content: "fix the authentication middleware", Project: "/src/web", Provider: "codex", Timestamp: day(10)},
+		{SessionID: "s2", MessageIndex: 1, MessageRole: "assistant", Content: "Updated the authorization check.\n[Edit] auth/middleware.go", Project: "/src/web", Provider: "codex", Tools: []string{"Edit", "mcp__github__create_pr"}, Timestamp: day(11)},
+		{SessionID: "s3", MessageIndex: 0, MessageRole: "user", Content: "build the docker image", Project: "/src/ops", Provider: "gemini", Timestamp: day(20)},
+		{SessionID: "s3", MessageIndex: 1, MessageRole: "assistant", Content: "podman works as well as docker here", Project: "/src/ops", Provider: "gemini", Timestamp: day(21)},
+	}
+	e := NewSearchEngine()
+	e.mu.Lock()
+	for _, d := range docs {
+		e.indexDocumentLocked(d)
+	}
+	e.scorer.UpdateAvgDocLength()
+	e.mu.Unlock()
+	return e
+}
+
+// hits returns "session/index" for each result, in rank order.
+func hits(t *testing.T, e *SearchEngine, query string) []string {
+	t.Helper()
+	results, err := e.Search(query, SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search(%q) failed: %v", query, err)
+	}
+	out := make([]string, 0, len(results.Results))
+	for _, r := range results.Results {
+		out = append(out, r.SessionID+"/"+string(rune('0'+r.MessageIndex)))
+	}
+	return out
+}
+
+func sameSet(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	seen := make(map[string]int)
+	for _, g := range got {
+		seen[g]++
+	}
+	for _, w := range want {
+		if seen[w] == 0 {
+			return false
+		}
+		seen[w]--
+	}
+	return true
+}
+
+func TestSearch_QuerySyntax(t *testing.T) {
+	e := queryTestEngine(t)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"implicit AND", "race detector", []string{"s1/2"}},
+		{"phrase", `"go test -race"`, []string{"s1/0", "s1/1"}},
+		{"phrase requires order", `"race test"`, nil},
+		{"phrase counts stop words", `"run go test"`, []string{"s1/0"}},
+		{"phrase with exclusion", `"go test" -race`, nil},
+		{"NOT keyword", `"go test" NOT detector`, []string{"s1/0", "s1/1"}},
+		{"hyphenated word is a phrase", "authentication-middleware", []string{"s2/0"}},
+		{"OR", "podman OR authentication", []string{"s2/0", "s3/1"}},
+		{"parentheses", "(podman OR image) docker", []string{"s3/0", "s3/1"}},
+		{"role field", "docker role:assistant", []string{"s3/1"}},
+		{"tool field", "tool:bash", []string{"s1/1"}},
+		{"tool prefix", "tool:mcp__github*", []string{"s2/1"}},
+		{"project field", `project:"src/web"`, []string{"s2/0", "s2/1"}},
+		{"provider field", "provider:gemini image", []string{"s3/0"}},
+		{"session field", "session:s2 role:user", []string{"s2/0"}},
+		{"date day", "date:2026-03-02", []string{"s1/1"}},
+		{"date range", "date:2026-03-10..2026-03-11", []string{"s2/0", "s2/1"}},
+		{"after and before", "after:2026-03-03 before:2026-03-11", []string{"s1/2", "s2/0"}},
+		{"prefix", "auth*", []string{"s2/0", "s2/1"}},
+		{"explicit fuzzy", "podmen~1", []string{"s3/1"}},
+		{"automatic typo tolerance", "authentcation", []string{"s2/0"}},
+		{"path is a phrase", "auth/middleware.go", []string{"s2/1"}},
+		{"unknown field is text", "auth:middleware", []string{"s2/1"}},
+		{"only stop words", "the of", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hits(t, e, tt.query); !sameSet(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearch_RelaxesPlainQueries(t *testing.T) {
+	e := queryTestEngine(t)
+
+	results, err := e.Search("podman authentication", SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if !results.Relaxed {
+		t.Error("Relaxed = false, want true when no message contains every word")
+	}
+	if results.TotalMatches != 2 {
+		t.Errorf("TotalMatches = %d, want 2", results.TotalMatches)
+	}
+
+	// Operators make the query explicit, so it is never relaxed.
+	results, err = e.Search("podman AND authentication", SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if results.Relaxed || results.TotalMatches != 0 {
+		t.Errorf("Relaxed = %v, TotalMatches = %d; want false, 0", results.Relaxed, results.TotalMatches)
+	}
+}
+
+func TestSearch_InvalidDate(t *testing.T) {
+	e := queryTestEngine(t)
+
+	_, err := e.Search("after:last-tuesday", SearchOptions{})
+	var syntaxErr *QuerySyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("Search error = %v, want *QuerySyntaxError", err)
+	}
+}
+
+func TestSearch_Explain(t *testing.T) {
+	e := queryTestEngine(t)
+
+	results, err := e.Search(`"go test" race`, SearchOptions{Explain: true})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results.Results) == 0 {
+		t.Fatal("expected results")
+	}
+	top := results.Results[0]
+	exp := top.Explanation
+	if exp == nil {
+		t.Fatal("Explanation is nil with Explain set")
+	}
+	if exp.TotalScore != top.Score {
+		t.Errorf("TotalScore = %f, want result score %f", exp.TotalScore, top.Score)
+	}
+	sum := 0.0
+	for _, term := range exp.Terms {
+		sum += term.Weight * term.Score
+	}
+	if diff := sum - exp.TotalScore; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("sum of weighted term scores = %f, want %f", sum, exp.TotalScore)
+	}
+	if len(top.MatchedTerms) != len(exp.Terms) {
+		t.Errorf("MatchedTerms = %v, want one per explained term (%d)", top.MatchedTerms, len(exp.Terms))
+	}
+
+	direct, err := e.ExplainScore(`"go test" race`, top.DocID)
+	if err != nil {
+		t.Fatalf("ExplainScore failed: %v", err)
+	}
+	if direct.TotalScore != exp.TotalScore {
+		t.Errorf("ExplainScore total = %f, want %f", direct.TotalScore, exp.TotalScore)
+	}
+	if _, err := e.ExplainScore("podman", top.DocID); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("ExplainScore for non-matching doc error = %v, want ErrNoMatch", err)
+	}
+
+	plain, err := e.Search(`"go test" race`, SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if plain.Results[0].Explanation != nil {
+		t.Error("Explanation set without Explain")
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		limit int
+		want  int
+	}{
+		{"docker", "docker", 2, 0},
+		{"docker", "dcoker", 2, 1}, // transposition
+		{"docker", "docke", 2, 1},
+		{"docker", "podman", 2, 3}, // over the limit
+		{"ab", "abcdef", 2, 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, tt.limit); got != tt.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.limit, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,571 @@
+package search
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SegmentStore persists the index as a log-structured set of immutable segment
+// files plus a manifest naming the live ones:
+//
+//	manifest.json     live segments, their tombstones, and the sync metadata
+//	seg-00000001.gob  documents with the postings computed when they were indexed
+//
+// Each sync appends one segment holding the conversations it (re)indexed and
+// tombstones the conversations it replaced or removed in older segments, so
+// nothing already on disk is rewritten and loading needs no tokenization. A
+// background merge (Compact) folds small segments together and drops
+// tombstoned documents.
+//
+// Every file is written under a temporary name, fsynced and renamed, and
+// renaming the manifest is the commit point: after a crash the store holds
+// either the old or the new manifest, and segment files neither names are
+// deleted on the next Load.
+type SegmentStore struct {
+	dir      string
+	mu       sync.Mutex
+	manifest *segmentManifest
+	merging  bool
+}
+
+// SegmentInfo describes one segment file in the manifest.
+type SegmentInfo struct {
+	Name string `json:"name"`
+	Seq  uint64 `json:"seq"`
+	// Docs is the number of documents in the file, including tombstoned ones
+	Docs     int    `json:"docs"`
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+	// Sessions counts the file's documents per conversation
+	Sessions map[string]int `json:"sessions"`
+	// Deleted holds the conversations tombstoned in this segment
+	Deleted   map[string]bool `json:"deleted,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// LiveDocs returns the number of documents not tombstoned.
+func (si *SegmentInfo) LiveDocs() int {
+	n := si.Docs
+	for id := range si.Deleted {
+		n -= si.Sessions[id]
+	}
+	return n
+}
+
+func (si *SegmentInfo) clone() *SegmentInfo {
+	c := *si
+	c.Deleted = make(map[string]bool, len(si.Deleted))
+	for id := range si.Deleted {
+		c.Deleted[id] = true
+	}
+	return &c
+}
+
+type segmentManifest struct {
+	// Version is CurrentIndexVersion when the segments were written; any other
+	// value discards the index
+	Version  int                `json:"version"`
+	NextSeq  uint64             `json:"next_seq"`
+	Segments []*SegmentInfo     `json:"segments"`
+	Sync     *IndexSyncMetadata `json:"sync,omitempty"`
+}
+
+func (m *segmentManifest) clone() *segmentManifest {
+	c := *m
+	c.Segments = make([]*SegmentInfo, len(m.Segments))
+	for i, seg := range m.Segments {
+		c.Segments[i] = seg.clone()
+	}
+	return &c
+}
+
+// segmentDoc is a document with its analysed terms, so loading a segment only
+// has to insert postings.
+type segmentDoc struct {
+	Doc       Document
+	Tokens    []string
+	Positions map[string][]int32
+}
+
+type segmentFile struct {
+	Version int
+	Docs    []segmentDoc
+}
+
+// SegmentLoadResult reports what Load read.
+type SegmentLoadResult struct {
+	// Sync is the metadata committed with the segments, nil for a fresh store
+	Sync *IndexSyncMetadata
+	// Segments and Docs count what was loaded
+	Segments int
+	Docs     int
+	// Dropped counts segments discarded because they failed verification;
+	// their conversations are removed from Sync so the next sync re-indexes them
+	Dropped int
+}
+
+const (
+	manifestFile = "manifest.json"
+	// segmentFormatVersion is the encoding of segment files.
+	segmentFormatVersion = 1
+	// maxSegments is the segment count above which Compact merges the
+	// smallest segments.
+	maxSegments = 8
+	// compactDeletedRatio is the tombstoned fraction at which Compact
+	// rewrites a segment to reclaim space.
+	compactDeletedRatio = 0.5
+)
+
+// NewSegmentStore creates a segment store in dir, creating it if needed.
+func NewSegmentStore(dir string) (*SegmentStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create segment directory: %w", err)
+	}
+	return &SegmentStore{dir: dir}, nil
+}
+
+// Dir returns the directory holding the segments.
+func (s *SegmentStore) Dir() string {
+	return s.dir
+}
+
+// Segments returns a snapshot of the live segments, oldest first.
+func (s *SegmentStore) Segments() []SegmentInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.manifest == nil {
+		return nil
+	}
+	out := make([]SegmentInfo, 0, len(s.manifest.Segments))
+	for _, seg := range s.manifest.Segments {
+		out = append(out, *seg.clone())
+	}
+	return out
+}
+
+// Load reads the manifest and passes every live document to fn, oldest
+// segment first. A manifest from an older index version is discarded.
+// Segments that fail verification are dropped rather than failing the load,
+// and files the manifest does not name are removed.
+func (s *SegmentStore) Load(fn func(*segmentDoc)) (SegmentLoadResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result SegmentLoadResult
+	m, err := s.readManifest()
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		m = newSegmentManifest()
+	case err != nil:
+		return result, err
+	case m.Version != CurrentIndexVersion:
+		m = newSegmentManifest()
+	}
+
+	kept := m.Segments[:0]
+	var dropped []*SegmentInfo
+	for _, seg := range m.Segments {
+		sf, err := s.readSegment(seg)
+		if err != nil {
+			dropped = append(dropped, seg)
+			continue
+		}
+		kept = append(kept, seg)
+		for i := range sf.Docs {
+			d := &sf.Docs[i]
+			if seg.Deleted[d.Doc.SessionID] {
+				continue
+			}
+			fn(d)
+			result.Docs++
+		}
+	}
+	m.Segments = kept
+
+	if len(dropped) > 0 {
+		if m.Sync != nil {
+			for _, seg := range dropped {
+				for id := range seg.Sessions {
+					delete(m.Sync.Sessions, id)
+				}
+			}
+		}
+		if err := s.writeManifest(m); err != nil {
+			return result, err
+		}
+	}
+
+	s.manifest = m
+	s.sweepLocked()
+
+	result.Sync = cloneSyncMetadata(m.Sync)
+	result.Segments = len(kept)
+	result.Dropped = len(dropped)
+	return result, nil
+}
+
+// Commit durably applies one sync: docs become a new segment, the sessions in
+// deleted are tombstoned in every existing segment, and sync becomes the
+// committed sync metadata. With replaceAll the new segment replaces every
+// existing one instead (a full rebuild).
+func (s *SegmentStore) Commit(docs []segmentDoc, deleted []string, sync *IndexSyncMetadata, replaceAll bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.manifest == nil {
+		m, err := s.readManifest()
+		if err != nil || m.Version != CurrentIndexVersion {
+			m = newSegmentManifest()
+		}
+		s.manifest = m
+	}
+
+	next := s.manifest.clone()
+	next.Sync = cloneSyncMetadata(sync)
+
+	var info *SegmentInfo
+	if len(docs) > 0 {
+		var err error
+		if info, err = s.writeSegment(next.NextSeq, docs); err != nil {
+			return err
+		}
+		next.NextSeq++
+	}
+
+	var obsolete []*SegmentInfo
+	if replaceAll {
+		obsolete = next.Segments
+		next.Segments = nil
+	} else {
+		live := next.Segments[:0]
+		for _, seg := range next.Segments {
+			for _, id := range deleted {
+				if seg.Sessions[id] > 0 {
+					seg.Deleted[id] = true
+				}
+			}
+			if seg.LiveDocs() == 0 {
+				obsolete = append(obsolete, seg)
+				continue
+			}
+			live = append(live, seg)
+		}
+		next.Segments = live
+	}
+	if info != nil {
+		next.Segments = append(next.Segments, info)
+	}
+
+	if err := s.writeManifest(next); err != nil {
+		if info != nil {
+			_ = os.Remove(filepath.Join(s.dir, info.Name))
+		}
+		return err
+	}
+	s.manifest = next
+	s.removeSegments(obsolete)
+	return nil
+}
+
+// NeedsCompaction reports whether Compact has work to do.
+func (s *SegmentStore) NeedsCompaction() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.merging && len(s.pickCompactionLocked()) > 0
+}
+
+// Compact merges the smallest segments when there are more than maxSegments,
+// and rewrites segments that are mostly tombstones, into one new segment. The
+// files are read and written without holding the store lock, so commits
+// continue meanwhile; tombstones they add to merged segments are carried over
+// when the manifest is swapped. It reports whether a merge was committed.
+func (s *SegmentStore) Compact() (bool, error) {
+	s.mu.Lock()
+	if s.merging || s.manifest == nil {
+		s.mu.Unlock()
+		return false, nil
+	}
+	pick := s.pickCompactionLocked()
+	if len(pick) == 0 {
+		s.mu.Unlock()
+		return false, nil
+	}
+	// Snapshot the inputs: Commit mutates only clones, so these stay as they
+	// were when the merge started.
+	seq := s.manifest.NextSeq
+	s.manifest.NextSeq++
+	s.merging = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.merging = false
+		s.mu.Unlock()
+	}()
+
+	var docs []segmentDoc
+	for _, seg := range pick {
+		sf, err := s.readSegment(seg)
+		if err != nil {
+			return false, err
+		}
+		for _, d := range sf.Docs {
+			if !seg.Deleted[d.Doc.SessionID] {
+				docs = append(docs, d)
+			}
+		}
+	}
+	var info *SegmentInfo
+	if len(docs) > 0 {
+		var err error
+		if info, err = s.writeSegment(seq, docs); err != nil {
+			return false, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := s.manifest.clone()
+	picked := make(map[string]*SegmentInfo, len(pick))
+	for _, seg := range pick {
+		picked[seg.Name] = seg
+	}
+	kept := next.Segments[:0]
+	found := 0
+	for _, seg := range next.Segments {
+		before, ok := picked[seg.Name]
+		if !ok {
+			kept = append(kept, seg)
+			continue
+		}
+		found++
+		for id := range seg.Deleted {
+			if !before.Deleted[id] && info != nil && info.Sessions[id] > 0 {
+				info.Deleted[id] = true
+			}
+		}
+	}
+	if found != len(pick) {
+		// A full rebuild replaced the inputs while we merged them.
+		if info != nil {
+			_ = os.Remove(filepath.Join(s.dir, info.Name))
+		}
+		return false, nil
+	}
+	if info != nil && info.LiveDocs() > 0 {
+		kept = append(kept, info)
+	} else if info != nil {
+		_ = os.Remove(filepath.Join(s.dir, info.Name))
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Seq < kept[j].Seq })
+	next.Segments = kept
+	if next.NextSeq <= seq {
+		next.NextSeq = seq + 1
+	}
+
+	if err := s.writeManifest(next); err != nil {
+		if info != nil {
+			_ = os.Remove(filepath.Join(s.dir, info.Name))
+		}
+		return false, err
+	}
+	s.manifest = next
+	s.removeSegments(pick)
+	return true, nil
+}
+
+// pickCompactionLocked chooses the segments to merge: any that are mostly
+// tombstones, plus the smallest ones while there are more than maxSegments.
+// Caller must hold s.mu.
+func (s *SegmentStore) pickCompactionLocked() []*SegmentInfo {
+	if s.manifest == nil {
+		return nil
+	}
+	segs := append([]*SegmentInfo(nil), s.manifest.Segments...)
+	sort.SliceStable(segs, func(i, j int) bool { return segs[i].LiveDocs() < segs[j].LiveDocs() })
+
+	var pick []*SegmentInfo
+	rest := segs[:0:0]
+	for _, seg := range segs {
+		if seg.Docs > 0 && float64(seg.Docs-seg.LiveDocs())/float64(seg.Docs) >= compactDeletedRatio {
+			pick = append(pick, seg)
+		} else {
+			rest = append(rest, seg)
+		}
+	}
+	if len(segs) > maxSegments {
+		// Merge down to half the limit so compaction runs every few syncs,
+		// not after each one.
+		n := min(len(segs)-maxSegments/2, len(rest))
+		pick = append(pick, rest[:n]...)
+	}
+	return pick
+}
+
+// writeSegment writes docs as segment seq and returns its manifest entry.
+func (s *SegmentStore) writeSegment(seq uint64, docs []segmentDoc) (*SegmentInfo, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(segmentFile{Version: segmentFormatVersion, Docs: docs}); err != nil {
+		return nil, fmt.Errorf("failed to encode segment: %w", err)
+	}
+	data := buf.Bytes()
+
+	info := &SegmentInfo{
+		Name:      fmt.Sprintf("seg-%08d.gob", seq),
+		Seq:       seq,
+		Docs:      len(docs),
+		Size:      int64(len(data)),
+		Checksum:  crc32.ChecksumIEEE(data),
+		Sessions:  make(map[string]int),
+		Deleted:   make(map[string]bool),
+		CreatedAt: time.Now(),
+	}
+	for _, d := range docs {
+		info.Sessions[d.Doc.SessionID]++
+	}
+	if err := writeFileSync(filepath.Join(s.dir, info.Name), data); err != nil {
+		return nil, fmt.Errorf("failed to write segment %s: %w", info.Name, err)
+	}
+	return info, nil
+}
+
+// readSegment reads and verifies a segment file.
+func (s *SegmentStore) readSegment(info *SegmentInfo) (*segmentFile, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, info.Name))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != info.Size || crc32.ChecksumIEEE(data) != info.Checksum {
+		return nil, fmt.Errorf("segment %s is corrupt", info.Name)
+	}
+	var sf segmentFile
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&sf); err != nil {
+		return nil, fmt.Errorf("failed to decode segment %s: %w", info.Name, err)
+	}
+	if sf.Version != segmentFormatVersion {
+		return nil, fmt.Errorf("segment %s has format version %d, want %d", info.Name, sf.Version, segmentFormatVersion)
+	}
+	return &sf, nil
+}
+
+func (s *SegmentStore) readManifest() (*segmentManifest, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, manifestFile))
+	if err != nil {
+		return nil, err
+	}
+	var m segmentManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal segment manifest: %w", err)
+	}
+	for _, seg := range m.Segments {
+		if seg.Deleted == nil {
+			seg.Deleted = make(map[string]bool)
+		}
+	}
+	return &m, nil
+}
+
+func (s *SegmentStore) writeManifest(m *segmentManifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal segment manifest: %w", err)
+	}
+	if err := writeFileSync(filepath.Join(s.dir, manifestFile), data); err != nil {
+		return fmt.Errorf("failed to write segment manifest: %w", err)
+	}
+	return nil
+}
+
+// sweepLocked removes segment and temp files the manifest does not name,
+// left behind by a crash between writing a segment and committing it.
+// Caller must hold s.mu.
+func (s *SegmentStore) sweepLocked() {
+	if s.merging {
+		return
+	}
+	live := make(map[string]bool, len(s.manifest.Segments))
+	for _, seg := range s.manifest.Segments {
+		live[seg.Name] = true
+	}
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		name := e.Name()
+		orphan := strings.HasPrefix(name, "seg-") && strings.HasSuffix(name, ".gob") && !live[name]
+		if orphan || strings.HasSuffix(name, ".tmp") {
+			_ = os.Remove(filepath.Join(s.dir, name))
+		}
+	}
+}
+
+func (s *SegmentStore) removeSegments(segs []*SegmentInfo) {
+	for _, seg := range segs {
+		_ = os.Remove(filepath.Join(s.dir, seg.Name))
+	}
+}
+
+func newSegmentManifest() *segmentManifest {
+	return &segmentManifest{Version: CurrentIndexVersion, NextSeq: 1}
+}
+
+// cloneSyncMetadata deep-copies meta so the manifest is not affected by later
+// changes the engine makes to its own copy.
+func cloneSyncMetadata(meta *IndexSyncMetadata) *IndexSyncMetadata {
+	if meta == nil {
+		return nil
+	}
+	c := *meta
+	c.Sessions = make(map[string]*SessionIndexMetadata, len(meta.Sessions))
+	for id, sm := range meta.Sessions {
+		smCopy := *sm
+		c.Sessions[id] = &smCopy
+	}
+	return &c
+}
+
+// writeFileSync writes data to path atomically and durably: temp file, fsync,
+// rename, then fsync of the directory so the rename itself survives a crash.
+func writeFileSync(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if dir, err := os.Open(filepath.Dir(path)); err == nil {
+		_ = dir.Sync()
+		dir.Close()
+	}
+	return nil
+}
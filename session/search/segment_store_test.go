@@ -0,0 +1,257 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+// segDocs returns n analysed documents for sessionID.
+func segDocs(sessionID string, n int) []segmentDoc {
+	docs := make([]segmentDoc, n)
+	for i := range docs {
+		docs[i] = segmentDoc{
+			Doc:       Document{SessionID: sessionID, MessageIndex: i, Content: "hello world"},
+			Tokens:    []string{"hello", "world"},
+			Positions: map[string][]int32{"hello": {0}, "world": {1}},
+		}
+	}
+	return docs
+}
+
+func syncFor(sessions ...string) *IndexSyncMetadata {
+	meta := NewIndexSyncMetadata()
+	for _, id := range sessions {
+		meta.Sessions[id] = &SessionIndexMetadata{SessionID: id, LastIndexedAt: time.Now()}
+	}
+	return meta
+}
+
+// loadSessions opens a fresh store on dir and returns the session of every
+// loaded document, sorted.
+func loadSessions(t *testing.T, dir string) ([]string, SegmentLoadResult) {
+	t.Helper()
+	store, err := NewSegmentStore(dir)
+	if err != nil {
+		t.Fatalf("NewSegmentStore failed: %v", err)
+	}
+	var got []string
+	result, err := store.Load(func(d *segmentDoc) { got = append(got, d.Doc.SessionID) })
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	sort.Strings(got)
+	return got, result
+}
+
+func TestSegmentStore_CommitAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewSegmentStore(dir)
+	if err != nil {
+		t.Fatalf("NewSegmentStore failed: %v", err)
+	}
+
+	docs := append(segDocs("s1", 2), segDocs("s2", 1)...)
+	if err := store.Commit(docs, nil, syncFor("s1", "s2"), false); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	// s1 is re-indexed: tombstoned in the first segment, rewritten in the second.
+	if err := store.Commit(append(segDocs("s1", 1), segDocs("s3", 1)...), []string{"s1"}, syncFor("s1", "s2", "s3"), false); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	got, result := loadSessions(t, dir)
+	want := []string{"s1", "s2", "s3"}
+	if len(got) != len(want) {
+		t.Fatalf("loaded sessions = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("loaded sessions = %v, want %v", got, want)
+			break
+		}
+	}
+	if result.Segments != 2 {
+		t.Errorf("Segments = %d, want 2", result.Segments)
+	}
+	if result.Sync == nil || len(result.Sync.Sessions) != 3 {
+		t.Errorf("Sync = %+v, want 3 sessions", result.Sync)
+	}
+
+	// Removing the only live session of a segment drops the segment.
+	if err := store.Commit(nil, []string{"s2"}, syncFor("s1", "s3"), false); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	if n := len(store.Segments()); n != 1 {
+		t.Errorf("segments after removing s2 = %d, want 1", n)
+	}
+	if got, _ := loadSessions(t, dir); len(got) != 2 {
+		t.Errorf("loaded sessions = %v, want [s1 s3]", got)
+	}
+}
+
+func TestSegmentStore_ReplaceAll(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewSegmentStore(dir)
+	_ = store.Commit(segDocs("old", 3), nil, syncFor("old"), false)
+	if err := store.Commit(segDocs("new", 1), nil, syncFor("new"), true); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	got, result := loadSessions(t, dir)
+	if len(got) != 1 || got[0] != "new" {
+		t.Errorf("loaded sessions = %v, want [new]", got)
+	}
+	if result.Segments != 1 {
+		t.Errorf("Segments = %d, want 1", result.Segments)
+	}
+}
+
+func TestSegmentStore_SweepsUncommittedFiles(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewSegmentStore(dir)
+	if err := store.Commit(segDocs("s1", 1), nil, syncFor("s1"), false); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	// A crash between writing a segment and swapping the manifest leaves
+	// these behind; they must not be loaded.
+	orphan := filepath.Join(dir, "seg-99999999.gob")
+	tmp := filepath.Join(dir, manifestFile+".tmp")
+	for _, path := range []string{orphan, tmp} {
+		if err := os.WriteFile(path, []byte("partial"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, result := loadSessions(t, dir)
+	if len(got) != 1 || result.Dropped != 0 {
+		t.Errorf("loaded %v with %d dropped, want [s1] with none dropped", got, result.Dropped)
+	}
+	for _, path := range []string{orphan, tmp} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("%s was not removed", filepath.Base(path))
+		}
+	}
+}
+
+func TestSegmentStore_DropsCorruptSegment(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewSegmentStore(dir)
+	_ = store.Commit(segDocs("s1", 2), nil, syncFor("s1"), false)
+	_ = store.Commit(segDocs("s2", 1), nil, syncFor("s1", "s2"), false)
+
+	first := store.Segments()[0]
+	path := filepath.Join(dir, first.Name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)/2] ^= 0xff
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, result := loadSessions(t, dir)
+	if len(got) != 1 || got[0] != "s2" {
+		t.Errorf("loaded sessions = %v, want [s2]", got)
+	}
+	if result.Dropped != 1 {
+		t.Errorf("Dropped = %d, want 1", result.Dropped)
+	}
+	if _, ok := result.Sync.Sessions["s1"]; ok {
+		t.Error("s1 still in sync metadata; it would never be re-indexed")
+	}
+	if _, ok := result.Sync.Sessions["s2"]; !ok {
+		t.Error("s2 missing from sync metadata")
+	}
+}
+
+func TestSegmentStore_Compact(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewSegmentStore(dir)
+
+	var sessions []string
+	for i := 0; i < maxSegments+3; i++ {
+		id := string(rune('a' + i))
+		sessions = append(sessions, id)
+		if err := store.Commit(segDocs(id, 2), nil, syncFor(sessions...), false); err != nil {
+			t.Fatalf("Commit failed: %v", err)
+		}
+	}
+	if !store.NeedsCompaction() {
+		t.Fatal("NeedsCompaction = false with too many segments")
+	}
+
+	merged, err := store.Compact()
+	if err != nil {
+		t.Fatalf("Compact failed: %v", err)
+	}
+	if !merged {
+		t.Error("Compact reported no merge")
+	}
+	if n := len(store.Segments()); n > maxSegments {
+		t.Errorf("segments after Compact = %d, want at most %d", n, maxSegments)
+	}
+	if store.NeedsCompaction() {
+		t.Error("NeedsCompaction = true after Compact")
+	}
+
+	got, result := loadSessions(t, dir)
+	if len(got) != 2*len(sessions) {
+		t.Errorf("loaded %d docs after Compact, want %d", len(got), 2*len(sessions))
+	}
+	if len(result.Sync.Sessions) != len(sessions) {
+		t.Errorf("sync sessions = %d, want %d", len(result.Sync.Sessions), len(sessions))
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != result.Segments+1 {
+		t.Errorf("%d files in segment dir, want %d segments plus manifest", len(entries), result.Segments)
+	}
+}
+
+func TestSearchEngine_SegmentPersistence(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewSegmentStore(dir)
+	e := NewSearchEngineWithSegments(store)
+
+	e.mu.Lock()
+	e.indexDocumentLocked(&Document{SessionID: "s1", Content: "kubernetes deployment rollout"})
+	e.indexDocumentLocked(&Document{SessionID: "s2", Content: "docker image build"})
+	e.syncMetadata = syncFor("s1", "s2")
+	if err := e.persistLocked(false); err != nil {
+		t.Fatalf("persist failed: %v", err)
+	}
+	e.removeSessionLocked("s2")
+	delete(e.syncMetadata.Sessions, "s2")
+	if err := e.persistLocked(false); err != nil {
+		t.Fatalf("persist failed: %v", err)
+	}
+	e.mu.Unlock()
+
+	reopened, _ := NewSegmentStore(dir)
+	loaded := NewSearchEngineWithSegments(reopened)
+	if err := <-loaded.LoadIndexAsync(); err != nil {
+		t.Fatalf("LoadIndexAsync failed: %v", err)
+	}
+
+	results, err := loaded.Search("kubernetes", SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if results.TotalMatches != 1 {
+		t.Errorf("kubernetes matches = %d, want 1", results.TotalMatches)
+	}
+	results, err = loaded.Search("docker", SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if results.TotalMatches != 0 {
+		t.Errorf("docker matches = %d after removal, want 0", results.TotalMatches)
+	}
+	if meta := loaded.GetSyncMetadata(); meta == nil || len(meta.Sessions) != 1 {
+		t.Errorf("sync metadata = %+v, want 1 session", meta)
+	}
+}
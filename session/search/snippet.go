@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 // Snippet represents a highlighted text snippet showing where a search term appears.
@@ -343,11 +344,27 @@ func (g *SnippetGenerator) findHighlightsInSnippet(snippet string, queryTerms []
 
 // GenerateFromSearchResult generates snippets for a search result.
 // This is a convenience method that uses the document content and query tokens.
+// When no token appears in the content (a result matched only by field
+// filters), it returns the start of the message unhighlighted.
 func (g *SnippetGenerator) GenerateFromSearchResult(doc *Document, queryTokens []string) []Snippet {
 	if doc == nil {
 		return nil
 	}
 
 	query := strings.Join(queryTokens, " ")
-	return g.Generate(doc.Content, query, doc.MessageRole, doc.Timestamp)
+	if snippets := g.Generate(doc.Content, query, doc.MessageRole, doc.Timestamp); len(snippets) > 0 {
+		return snippets
+	}
+	text := strings.TrimSpace(doc.Content)
+	if text == "" {
+		return nil
+	}
+	if len(text) > g.maxSnippetLength {
+		cut := g.maxSnippetLength
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		text = text[:cut] + "..."
+	}
+	return []Snippet{{Text: text, MessageRole: doc.MessageRole, MessageTime: doc.Timestamp}}
 }
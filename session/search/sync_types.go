@@ -4,7 +4,7 @@ import "time"
 
 // CurrentSyncMetadataVersion is the schema version for sync metadata.
 // Increment this when making breaking changes to the metadata format.
-const CurrentSyncMetadataVersion = 1
+const CurrentSyncMetadataVersion = 2
 
 // SessionIndexMetadata tracks the indexing state for a single conversation session.
 // Used to detect changes since last index build.
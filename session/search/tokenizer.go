@@ -145,6 +145,31 @@ func (t *Tokenizer) TokenizeWithPositions(text string) []TokenPosition {
 	return positions
 }
 
+// TermOrdinals returns each indexable token of text with its word ordinal: the
+// position of the word among all words of the text, counting the stop words and
+// short words that Tokenize drops. Unlike Tokenize it keeps repeated tokens.
+// Ordinals let phrase queries require words to be adjacent ("go test" must not
+// match "go and test") while still ignoring stop words inside a phrase.
+func (t *Tokenizer) TermOrdinals(text string) []TermOrdinal {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	terms := make([]TermOrdinal, 0, len(words))
+	for i, word := range words {
+		if len(word) < 2 || t.stopWords[word] {
+			continue
+		}
+		terms = append(terms, TermOrdinal{Term: porterStem(word), Ordinal: int32(i)})
+	}
+	return terms
+}
+
+// TermOrdinal is a stemmed token and the ordinal of the word it came from.
+type TermOrdinal struct {
+	Term    string
+	Ordinal int32
+}
+
 // TokenPosition represents a token with its position in the original text.
 type TokenPosition struct {
 	Token string // The stemmed token
@@ -0,0 +1,62 @@
+package session
+
+import (
+	"sync/atomic"
+
+	"github.com/tstapler/stapler-squad/config"
+	"github.com/tstapler/stapler-squad/pkg/secrets"
+	"github.com/tstapler/stapler-squad/session/git"
+)
+
+// secretDetection holds the process-wide detector used on hot paths
+// (terminal output, scrollback), where rebuilding it from config.json on
+// every chunk would be too slow. ConfigureSecretDetection replaces it when
+// the secret_scan config section changes.
+type secretDetection struct {
+	detector *secrets.Detector
+	redact   bool
+}
+
+var currentSecretDetection atomic.Pointer[secretDetection]
+
+// ConfigureSecretDetection (re)builds the process-wide secret detector from
+// the secret_scan config section.
+func ConfigureSecretDetection(cfg config.SecretScanConfig) {
+	currentSecretDetection.Store(&secretDetection{
+		detector: git.NewSecretDetector(cfg, ""),
+		redact:   !cfg.DisableTerminalRedaction,
+	})
+}
+
+func loadSecretDetection() *secretDetection {
+	if sd := currentSecretDetection.Load(); sd != nil {
+		return sd
+	}
+	sd := &secretDetection{detector: secrets.NewDefaultDetector(), redact: true}
+	currentSecretDetection.CompareAndSwap(nil, sd)
+	return currentSecretDetection.Load()
+}
+
+// SecretDetector returns the process-wide secret detector. Before
+// ConfigureSecretDetection is called it uses the built-in rules.
+func SecretDetector() *secrets.Detector {
+	return loadSecretDetection().detector
+}
+
+// TerminalRedactionEnabled reports whether terminal output and scrollback
+// should have secrets redacted.
+func TerminalRedactionEnabled() bool {
+	return loadSecretDetection().redact
+}
+
+// RedactTerminalOutput redacts secrets from a self-contained piece of terminal
+// output (a snapshot or a stored scrollback chunk), unless terminal redaction
+// is disabled. Streams should use a secrets.StreamRedactor instead so that
+// secrets split across chunks are caught.
+func RedactTerminalOutput(data []byte) []byte {
+	sd := loadSecretDetection()
+	if !sd.redact {
+		return data
+	}
+	return sd.detector.RedactBytes(data)
+}
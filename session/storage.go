@@ -60,6 +60,10 @@ type InstanceData struct {
 	LastPRStatusCheck      time.Time `json:"last_pr_status_check,omitempty"`
 	// Crew autonomy mode — when true, the Fixer injects correction prompts without user confirmation.
 	AutonomousMode bool `json:"autonomous_mode,omitempty"`
+	// PR review feedback loop opt-in (see PRFeedbackWatcher).
+	PRFeedbackEnabled bool `json:"pr_feedback_enabled,omitempty"`
+	// CI failure auto-fix opt-in (see CIFailureWatcher).
+	CIAutoFixEnabled bool `json:"ci_autofix_enabled,omitempty"`
 
 	// Claude Code session persistence
 	ClaudeSession ClaudeSessionData `json:"claude_session,omitempty"`
@@ -0,0 +1,87 @@
+package templates
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tstapler/stapler-squad/executor/safeexec"
+)
+
+const (
+	// SetupTimeout bounds a setup script's run time.
+	SetupTimeout = 10 * time.Minute
+	// maxSetupOutput is how much of a script's output is kept, from the end.
+	maxSetupOutput = 8 * 1024
+)
+
+// RunSetup runs the rendered template's setup script with sh in dir and
+// returns the tail of its combined output. wrap, when set, turns the command
+// line into the one actually run, e.g. inside the session's sandbox.
+// Variable values are passed as SSQ_VAR_<NAME> environment variables rather
+// than substituted into the script, so values never need shell quoting. A
+// script without content is a no-op.
+func RunSetup(ctx context.Context, r Rendered, dir string, wrap func(argv []string) []string) (string, error) {
+	if strings.TrimSpace(r.SetupScript) == "" {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, SetupTimeout)
+	defer cancel()
+
+	argv := []string{"sh", "-c", r.SetupScript}
+	if wrap != nil {
+		argv = wrap(argv)
+	}
+	cmd := safeexec.CommandContextPG(ctx, argv[0], argv[1:]...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), SetupEnv(r)...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	err := cmd.Run()
+	output := tail(out.String(), maxSetupOutput)
+	if ctx.Err() == context.DeadlineExceeded {
+		return output, fmt.Errorf("setup script for %s timed out after %s", r.Name, SetupTimeout)
+	}
+	if err != nil {
+		return output, fmt.Errorf("setup script for %s failed: %w", r.Name, err)
+	}
+	return output, nil
+}
+
+// SetupEnv returns the environment a setup script sees on top of the
+// daemon's: the template name and version, the branch and one SSQ_VAR_
+// variable per template variable.
+func SetupEnv(r Rendered) []string {
+	env := []string{
+		"SSQ_TEMPLATE=" + r.Name,
+		fmt.Sprintf("SSQ_TEMPLATE_VERSION=%d", r.Version),
+		"SSQ_BRANCH=" + r.Branch,
+	}
+	names := make([]string, 0, len(r.Values))
+	for name := range r.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		env = append(env, "SSQ_VAR_"+strings.ToUpper(name)+"="+r.Values[name])
+	}
+	return env
+}
+
+// tail returns the last max bytes of s, starting on a rune boundary.
+func tail(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	s = s[len(s)-max:]
+	for len(s) > 0 && !utf8.RuneStart(s[0]) {
+		s = s[1:]
+	}
+	return s
+}
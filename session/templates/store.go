@@ -0,0 +1,370 @@
+package templates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkdata/deadlock"
+)
+
+// RepoDir is where a repository keeps its shared templates, one
+// <name>.json file per template, relative to the repository root.
+const RepoDir = ".stapler-squad/templates"
+
+// maxVersions bounds the history kept for each personal template.
+const maxVersions = 20
+
+// ErrNotFound is returned for unknown template names and versions.
+var ErrNotFound = errors.New("template not found")
+
+// Store persists personal templates, every saved version of each, as one
+// JSON file and resolves references against it and a repository's shared
+// templates. All methods are thread-safe.
+type Store struct {
+	mu       deadlock.Mutex
+	filePath string
+}
+
+// NewStore returns a store backed by the file at filePath.
+func NewStore(filePath string) *Store {
+	return &Store{filePath: filePath}
+}
+
+// List returns the latest version of every template available for repoPath:
+// personal templates followed by the repository's shared templates they do
+// not shadow, each group ordered by name. An empty repoPath lists personal
+// templates only. Unreadable shared template files are reported in the
+// error alongside the templates that did load.
+func (s *Store) List(repoPath string) ([]Template, error) {
+	shared, repoErr := LoadRepo(repoPath)
+	s.mu.Lock()
+	all, err := s.load()
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	out := latest(all)
+	seen := make(map[string]bool, len(out))
+	for _, t := range out {
+		seen[t.Name] = true
+	}
+	for _, t := range shared {
+		if !seen[t.Name] {
+			out = append(out, t)
+		}
+	}
+	return out, repoErr
+}
+
+// Get resolves a template by name for repoPath. A personal template takes
+// precedence over a repository's shared one of the same name, so a cloned
+// repository cannot replace a template the user relies on; shared templates
+// keep only their current version. Version 0 means the latest.
+func (s *Store) Get(repoPath, name string, version int) (Template, error) {
+	s.mu.Lock()
+	all, err := s.load()
+	s.mu.Unlock()
+	if err != nil {
+		return Template{}, err
+	}
+	var found *Template
+	personal := false
+	for i := range all {
+		t := &all[i]
+		if t.Name != name {
+			continue
+		}
+		personal = true
+		if version != 0 && t.Version != version {
+			continue
+		}
+		if found == nil || t.Version > found.Version {
+			found = t
+		}
+	}
+	if found != nil {
+		return *found, nil
+	}
+	if personal {
+		return Template{}, fmt.Errorf("%w: %s@%d", ErrNotFound, name, version)
+	}
+
+	if repoPath != "" {
+		t, err := LoadRepoTemplate(repoPath, name)
+		if err == nil {
+			if version != 0 && version != t.Version {
+				return Template{}, fmt.Errorf("%w: %s is shared at version %d; older versions are in the repository's history", ErrNotFound, name, t.Version)
+			}
+			return t, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return Template{}, err
+		}
+	}
+	return Template{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Versions returns the version numbers of a personal template, newest first.
+func (s *Store) Versions(name string) ([]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var versions []int
+	for _, t := range all {
+		if t.Name == name {
+			versions = append(versions, t.Version)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+	return versions, nil
+}
+
+// Save validates t and stores it as the next version of the personal
+// template with its name, keeping the newest maxVersions versions.
+func (s *Store) Save(t Template) (Template, error) {
+	t.Normalize()
+	if err := t.Validate(); err != nil {
+		return Template{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.load()
+	if err != nil {
+		return Template{}, err
+	}
+
+	now := time.Now().UTC()
+	t.Version, t.CreatedAt, t.UpdatedAt = 1, now, now
+	var kept []Template
+	var versions []Template
+	for _, e := range all {
+		if e.Name != t.Name {
+			kept = append(kept, e)
+			continue
+		}
+		versions = append(versions, e)
+		if e.Version >= t.Version {
+			t.Version = e.Version + 1
+		}
+		if e.CreatedAt.Before(t.CreatedAt) {
+			t.CreatedAt = e.CreatedAt
+		}
+	}
+	versions = append(versions, t)
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version > versions[j].Version })
+	if len(versions) > maxVersions {
+		versions = versions[:maxVersions]
+	}
+	if err := s.save(append(kept, versions...)); err != nil {
+		return Template{}, err
+	}
+	t.Source = SourceUser
+	return t, nil
+}
+
+// Delete removes every version of the personal template name.
+func (s *Store) Delete(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.load()
+	if err != nil {
+		return err
+	}
+	kept := all[:0]
+	for _, t := range all {
+		if t.Name != name {
+			kept = append(kept, t)
+		}
+	}
+	if len(kept) == len(all) {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return s.save(kept)
+}
+
+// load reads every stored version. A missing file is an empty store.
+func (s *Store) load() ([]Template, error) {
+	data, err := os.ReadFile(s.filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("templates: read %s: %w", s.filePath, err)
+	}
+	var all []Template
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("templates: unmarshal %s: %w", s.filePath, err)
+	}
+	for i := range all {
+		all[i].Source = SourceUser
+	}
+	return all, nil
+}
+
+// save atomically replaces the store file.
+func (s *Store) save(all []Template) error {
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].Name != all[j].Name {
+			return all[i].Name < all[j].Name
+		}
+		return all[i].Version > all[j].Version
+	})
+	return writeJSON(s.filePath, all)
+}
+
+// latest returns the newest version of each template, ordered by name.
+func latest(all []Template) []Template {
+	newest := map[string]Template{}
+	for _, t := range all {
+		if cur, ok := newest[t.Name]; !ok || t.Version > cur.Version {
+			newest[t.Name] = t
+		}
+	}
+	out := make([]Template, 0, len(newest))
+	for _, t := range newest {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// LoadRepo reads the shared templates in repoPath's RepoDir, ordered by
+// name. A repository without the directory has no shared templates. Files
+// that fail to parse or validate are skipped and reported in the error.
+func LoadRepo(repoPath string) ([]Template, error) {
+	if repoPath == "" {
+		return nil, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(repoPath, RepoDir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("templates: list %s: %w", RepoDir, err)
+	}
+	sort.Strings(paths)
+	var out []Template
+	var errs []error
+	for _, p := range paths {
+		t, err := readRepoFile(p)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		out = append(out, t)
+	}
+	return out, errors.Join(errs...)
+}
+
+// LoadRepoTemplate reads the shared template name from repoPath.
+func LoadRepoTemplate(repoPath, name string) (Template, error) {
+	if !namePattern.MatchString(name) {
+		return Template{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	t, err := readRepoFile(filepath.Join(repoPath, RepoDir, name+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Template{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return t, err
+}
+
+// Share writes t to repoPath's RepoDir so it can be committed and used by
+// everyone working in the repository. The shared version is one past both
+// t's version and the version already shared under the same name.
+func Share(repoPath string, t Template) (Template, error) {
+	if repoPath == "" {
+		return Template{}, fmt.Errorf("%w: sharing needs a repository path", ErrInvalid)
+	}
+	t.Normalize()
+	if err := t.Validate(); err != nil {
+		return Template{}, err
+	}
+	now := time.Now().UTC()
+	version := t.Version
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = now
+	}
+	// A file that no longer parses is simply replaced.
+	if cur, err := LoadRepoTemplate(repoPath, t.Name); err == nil {
+		if cur.Version > version {
+			version = cur.Version
+		}
+		t.CreatedAt = cur.CreatedAt
+	}
+	t.Version = version + 1
+	t.UpdatedAt = now
+	if err := writeJSON(filepath.Join(repoPath, RepoDir, t.Name+".json"), t); err != nil {
+		return Template{}, err
+	}
+	t.Source = SourceRepo
+	return t, nil
+}
+
+// readRepoFile parses and validates one shared template file. The name
+// defaults to the file name and the version to 1.
+func readRepoFile(path string) (Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Template{}, fmt.Errorf("templates: read %s: %w", path, err)
+	}
+	var t Template
+	if err := json.Unmarshal(data, &t); err != nil {
+		return Template{}, fmt.Errorf("templates: parse %s: %w", path, err)
+	}
+	base := strings.TrimSuffix(filepath.Base(path), ".json")
+	if t.Name == "" {
+		t.Name = base
+	}
+	if t.Name != base {
+		return Template{}, fmt.Errorf("templates: %s: name %q does not match the file name", path, t.Name)
+	}
+	if t.Version < 1 {
+		t.Version = 1
+	}
+	t.Normalize()
+	if err := t.Validate(); err != nil {
+		return Template{}, fmt.Errorf("templates: %s: %w", path, err)
+	}
+	t.Source = SourceRepo
+	return t, nil
+}
+
+// writeJSON atomically writes v as indented JSON to path.
+func writeJSON(path string, v any) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("templates: mkdir %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("templates: marshal: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".templates-*.tmp")
+	if err != nil {
+		return fmt.Errorf("templates: create temp file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return fmt.Errorf("templates: write temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("templates: close temp file: %w", err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("templates: rename temp file: %w", err)
+	}
+	return nil
+}
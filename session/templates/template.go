@@ -0,0 +1,376 @@
+// Package templates defines versioned session templates: a reusable bundle of
+// program, profile, session type, branch naming pattern, tags, a prompt with
+// typed variables, an optional setup script and acceptance criteria. Personal
+// templates live in the config directory; shared ones are JSON files in a
+// repository's .stapler-squad/templates directory.
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// VarType is the type a template variable's value must have.
+type VarType string
+
+const (
+	// VarString accepts any value.
+	VarString VarType = "string"
+	// VarURL accepts absolute http and https URLs.
+	VarURL VarType = "url"
+	// VarInt accepts base-10 integers.
+	VarInt VarType = "int"
+	// VarBool accepts true and false.
+	VarBool VarType = "bool"
+	// VarEnum accepts one of the variable's Options.
+	VarEnum VarType = "enum"
+)
+
+// Session types a template may ask for. Empty picks new_worktree when the
+// template has a branch pattern and directory otherwise.
+const (
+	SessionTypeDirectory   = "directory"
+	SessionTypeNewWorktree = "new_worktree"
+)
+
+// Built-in variables every template can reference without declaring them.
+const (
+	// BuiltinDate is the instantiation date as YYYYMMDD.
+	BuiltinDate = "date"
+	// BuiltinRepo is the base name of the repository path.
+	BuiltinRepo = "repo"
+)
+
+// ErrInvalid is wrapped by every validation error, including bad variable
+// values passed to Render.
+var ErrInvalid = errors.New("invalid template")
+
+var (
+	namePattern     = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	varNamePattern  = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	placeholder     = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+	branchUnsafe    = regexp.MustCompile(`[^a-z0-9._/-]+`)
+	branchDashes    = regexp.MustCompile(`-{2,}`)
+	builtinVarNames = map[string]bool{BuiltinDate: true, BuiltinRepo: true}
+)
+
+// Variable is a typed placeholder in a template's prompt or branch pattern,
+// written {{name}}.
+type Variable struct {
+	Name        string  `json:"name"`
+	Type        VarType `json:"type,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	// Default is used when no value is given. It must have the variable's type.
+	Default string `json:"default,omitempty"`
+	// Options lists the accepted values of an enum variable.
+	Options []string `json:"options,omitempty"`
+}
+
+// Template is one version of a session template.
+type Template struct {
+	Name        string `json:"name"`
+	Version     int    `json:"version"`
+	Description string `json:"description,omitempty"`
+	Program     string `json:"program,omitempty"`
+	// Profile names a session_defaults profile applied before the
+	// template's own fields.
+	Profile     string `json:"profile,omitempty"`
+	SessionType string `json:"session_type,omitempty"`
+	// BranchPattern names the session's branch, e.g. "fix/{{module}}-{{date}}".
+	// Substituted values are made branch-safe.
+	BranchPattern string     `json:"branch_pattern,omitempty"`
+	Tags          []string   `json:"tags,omitempty"`
+	Prompt        string     `json:"prompt"`
+	Variables     []Variable `json:"variables,omitempty"`
+	// SetupScript runs with sh in the new session's working directory,
+	// inside its sandbox if it has one, before the agent starts; a non-zero
+	// exit aborts the instantiation. Shared templates' scripts run only once
+	// the user trusts them.
+	SetupScript string `json:"setup_script,omitempty"`
+	// AcceptanceCriteria are appended to the prompt as a checklist.
+	AcceptanceCriteria []string  `json:"acceptance_criteria,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+
+	// Source is SourceUser or SourceRepo; set when loaded, never stored.
+	Source string `json:"-"`
+}
+
+// Where a template was loaded from.
+const (
+	SourceUser = "user"
+	SourceRepo = "repo"
+)
+
+// Normalize fills defaults and trims whitespace in place.
+func (t *Template) Normalize() {
+	t.Name = strings.TrimSpace(t.Name)
+	t.SessionType = strings.TrimSpace(t.SessionType)
+	t.BranchPattern = strings.TrimSpace(t.BranchPattern)
+	for i := range t.Variables {
+		v := &t.Variables[i]
+		v.Name = strings.TrimSpace(v.Name)
+		if v.Type == "" {
+			v.Type = VarString
+		}
+	}
+	t.Tags = compact(t.Tags)
+	t.AcceptanceCriteria = compact(t.AcceptanceCriteria)
+}
+
+// Validate reports the first problem with the template, wrapping ErrInvalid.
+func (t *Template) Validate() error {
+	if !namePattern.MatchString(t.Name) {
+		return fmt.Errorf("%w: name %q must be letters, digits, '.', '_' or '-'", ErrInvalid, t.Name)
+	}
+	if strings.TrimSpace(t.Prompt) == "" {
+		return fmt.Errorf("%w: prompt is required", ErrInvalid)
+	}
+	switch t.SessionType {
+	case "", SessionTypeDirectory, SessionTypeNewWorktree:
+	default:
+		return fmt.Errorf("%w: session_type must be %s or %s, got %q", ErrInvalid, SessionTypeDirectory, SessionTypeNewWorktree, t.SessionType)
+	}
+	if t.SessionType == SessionTypeNewWorktree && t.BranchPattern == "" {
+		return fmt.Errorf("%w: a new_worktree template needs a branch_pattern", ErrInvalid)
+	}
+
+	declared := make(map[string]bool, len(t.Variables))
+	for _, v := range t.Variables {
+		if !varNamePattern.MatchString(v.Name) {
+			return fmt.Errorf("%w: variable name %q must be lower_snake_case", ErrInvalid, v.Name)
+		}
+		if builtinVarNames[v.Name] {
+			return fmt.Errorf("%w: variable %q is built in", ErrInvalid, v.Name)
+		}
+		if declared[v.Name] {
+			return fmt.Errorf("%w: variable %q is declared twice", ErrInvalid, v.Name)
+		}
+		declared[v.Name] = true
+		switch v.Type {
+		case VarString, VarURL, VarInt, VarBool:
+		case VarEnum:
+			if len(v.Options) == 0 {
+				return fmt.Errorf("%w: enum variable %q needs options", ErrInvalid, v.Name)
+			}
+		default:
+			return fmt.Errorf("%w: variable %q has unknown type %q", ErrInvalid, v.Name, v.Type)
+		}
+		if v.Default != "" {
+			if err := v.check(v.Default); err != nil {
+				return fmt.Errorf("%w: default of %s", ErrInvalid, err)
+			}
+		}
+	}
+	for _, text := range []string{t.Prompt, t.BranchPattern} {
+		for _, name := range Placeholders(text) {
+			if !declared[name] && !builtinVarNames[name] {
+				return fmt.Errorf("%w: {{%s}} is not a declared variable", ErrInvalid, name)
+			}
+		}
+	}
+	return nil
+}
+
+// Placeholders returns the distinct variable names referenced in text, in
+// order of first use.
+func Placeholders(text string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, m := range placeholder.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+// check reports whether value has the variable's type.
+func (v Variable) check(value string) error {
+	switch v.Type {
+	case VarURL:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s: %q is not an http(s) URL", v.Name, value)
+		}
+	case VarInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%s: %q is not an integer", v.Name, value)
+		}
+	case VarBool:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("%s: %q is not true or false", v.Name, value)
+		}
+	case VarEnum:
+		for _, o := range v.Options {
+			if o == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %q is not one of %s", v.Name, value, strings.Join(v.Options, ", "))
+	}
+	return nil
+}
+
+// Rendered is a template instantiated with concrete variable values.
+type Rendered struct {
+	Name    string
+	Version int
+	// Prompt has every placeholder substituted and the acceptance criteria
+	// appended.
+	Prompt      string
+	Branch      string
+	SessionType string
+	Program     string
+	Profile     string
+	Tags        []string
+	SetupScript string
+	// Values holds every variable's value, built-ins included.
+	Values map[string]string
+}
+
+// Render substitutes values into the template. Unknown names, missing
+// required variables and values of the wrong type are errors wrapping
+// ErrInvalid. repoPath and now feed the built-in variables.
+func (t Template) Render(values map[string]string, repoPath string, now time.Time) (Rendered, error) {
+	resolved := map[string]string{
+		BuiltinDate: now.Format("20060102"),
+		BuiltinRepo: filepath.Base(filepath.Clean(repoPath)),
+	}
+	byName := make(map[string]Variable, len(t.Variables))
+	for _, v := range t.Variables {
+		byName[v.Name] = v
+	}
+	var unknown []string
+	for name := range values {
+		if _, ok := byName[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return Rendered{}, fmt.Errorf("%w: %s has no variable %s", ErrInvalid, t.Name, strings.Join(unknown, ", "))
+	}
+
+	var missing []string
+	for _, v := range t.Variables {
+		value := strings.TrimSpace(values[v.Name])
+		if value == "" {
+			value = v.Default
+		}
+		if value == "" {
+			if v.Required {
+				missing = append(missing, v.Name)
+			}
+			resolved[v.Name] = ""
+			continue
+		}
+		if err := v.check(value); err != nil {
+			return Rendered{}, fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+		resolved[v.Name] = value
+	}
+	if len(missing) > 0 {
+		return Rendered{}, fmt.Errorf("%w: missing required variable %s", ErrInvalid, strings.Join(missing, ", "))
+	}
+
+	prompt := substitute(t.Prompt, resolved, nil)
+	if len(t.AcceptanceCriteria) > 0 {
+		var b strings.Builder
+		b.WriteString(strings.TrimRight(prompt, "\n"))
+		b.WriteString("\n\nAcceptance criteria:\n")
+		for _, c := range t.AcceptanceCriteria {
+			b.WriteString("- [ ] ")
+			b.WriteString(substitute(c, resolved, nil))
+			b.WriteString("\n")
+		}
+		prompt = b.String()
+	}
+
+	sessionType := t.SessionType
+	if sessionType == "" {
+		sessionType = SessionTypeDirectory
+		if t.BranchPattern != "" {
+			sessionType = SessionTypeNewWorktree
+		}
+	}
+	var branch string
+	if t.BranchPattern != "" {
+		branch = strings.Trim(branchDashes.ReplaceAllString(substitute(t.BranchPattern, resolved, branchSafe), "-"), "-/")
+		if branch == "" {
+			return Rendered{}, fmt.Errorf("%w: branch pattern %q rendered empty", ErrInvalid, t.BranchPattern)
+		}
+	}
+
+	return Rendered{
+		Name:        t.Name,
+		Version:     t.Version,
+		Prompt:      prompt,
+		Branch:      branch,
+		SessionType: sessionType,
+		Program:     t.Program,
+		Profile:     t.Profile,
+		Tags:        append([]string(nil), t.Tags...),
+		SetupScript: t.SetupScript,
+		Values:      resolved,
+	}, nil
+}
+
+// substitute replaces {{name}} placeholders, passing each value through
+// escape when it is non-nil.
+func substitute(text string, values map[string]string, escape func(string) string) string {
+	return placeholder.ReplaceAllStringFunc(text, func(m string) string {
+		value := values[placeholder.FindStringSubmatch(m)[1]]
+		if escape != nil {
+			value = escape(value)
+		}
+		return value
+	})
+}
+
+// branchSafe reduces a value to characters that are valid in a branch name.
+func branchSafe(value string) string {
+	if u, err := url.Parse(value); err == nil && u.Host != "" {
+		// An issue URL in a branch name only needs its trailing path.
+		value = strings.Trim(u.Path, "/")
+		if i := strings.LastIndex(value, "/"); i >= 0 {
+			value = value[i+1:]
+		}
+	}
+	value = branchUnsafe.ReplaceAllString(strings.ToLower(value), "-")
+	return strings.Trim(strings.ReplaceAll(value, "..", "."), "-./")
+}
+
+// ParseRef splits a "name" or "name@version" reference. Version 0 means the
+// latest version.
+func ParseRef(ref string) (string, int, error) {
+	name, version, found := strings.Cut(strings.TrimSpace(ref), "@")
+	if !found {
+		return name, 0, nil
+	}
+	n, err := strconv.Atoi(version)
+	if err != nil || n < 1 {
+		return "", 0, fmt.Errorf("%w: bad version in %q", ErrInvalid, ref)
+	}
+	return name, n, nil
+}
+
+// compact trims entries and drops empty ones.
+func compact(in []string) []string {
+	var out []string
+	for _, s := range in {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
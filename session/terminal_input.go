@@ -0,0 +1,27 @@
+package session
+
+import "sync/atomic"
+
+// TerminalInputObserver is told about input sent to a session's terminal,
+// keyed by session title.
+type TerminalInputObserver func(title string, data []byte)
+
+var terminalInputObserver atomic.Pointer[TerminalInputObserver]
+
+// ObserveTerminalInput registers the process-wide observer of terminal input,
+// used by session recording to capture input events. nil removes it.
+func ObserveTerminalInput(fn TerminalInputObserver) {
+	if fn == nil {
+		terminalInputObserver.Store(nil)
+		return
+	}
+	terminalInputObserver.Store(&fn)
+}
+
+// notifyTerminalInput passes input sent to the instance's terminal to the
+// registered observer, if any.
+func (i *Instance) notifyTerminalInput(data []byte) {
+	if fn := terminalInputObserver.Load(); fn != nil && len(data) > 0 {
+		(*fn)(i.Title, data)
+	}
+}
@@ -0,0 +1,862 @@
+package session
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// vtState is a state of the escape sequence parser, after Paul Williams'
+// DEC-compatible parser (https://vt100.net/emu/dec_ansi_parser).
+type vtState uint8
+
+const (
+	vtGround vtState = iota
+	vtEscape
+	vtEscapeIntermediate
+	vtCSI
+	vtCSIIgnore
+	vtOSC          // OSC string, ends at BEL or ST
+	vtString       // DCS/SOS/PM/APC string, ignored until ST
+	vtStringEscape // saw ESC inside a string; '\' completes ST
+)
+
+// maxCSIParamBytes bounds a CSI parameter string; longer ones are ignored.
+const maxCSIParamBytes = 64
+
+// vtParser holds the parser's position within an escape sequence or UTF-8
+// character, so sequences split across ProcessOutput calls are handled.
+type vtParser struct {
+	state         vtState
+	params        []byte // CSI parameter bytes (digits, ';', ':', private marker)
+	intermediates []byte
+	utf8Buf       [utf8.UTFMax]byte
+	utf8Len       int // bytes collected in utf8Buf
+	utf8Need      int // total bytes of the character being collected
+}
+
+func (p vtParser) clone() vtParser {
+	p.params = append([]byte(nil), p.params...)
+	p.intermediates = append([]byte(nil), p.intermediates...)
+	return p
+}
+
+func (p *vtParser) clear() {
+	p.params = p.params[:0]
+	p.intermediates = p.intermediates[:0]
+}
+
+// ProcessOutput processes terminal output and updates state
+func (ts *TerminalState) ProcessOutput(data []byte) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	for _, b := range data {
+		ts.advance(b)
+	}
+
+	// Increment version after processing output
+	ts.Version++
+
+	return nil
+}
+
+// advance feeds one byte through the parser.
+func (ts *TerminalState) advance(b byte) {
+	p := &ts.parser
+
+	// CAN and SUB abort any sequence; ESC starts a new one from anywhere
+	// but inside a string, where it may begin the string terminator.
+	switch {
+	case b == 0x18 || b == 0x1a:
+		p.state = vtGround
+		p.utf8Len = 0
+		return
+	case b == 0x1b && p.state != vtOSC && p.state != vtString:
+		p.utf8Len = 0
+		p.clear()
+		p.state = vtEscape
+		return
+	}
+
+	switch p.state {
+	case vtGround:
+		ts.ground(b)
+
+	case vtEscape:
+		switch {
+		case b < 0x20:
+			ts.execute(b)
+		case b == '[':
+			p.clear()
+			p.state = vtCSI
+		case b == ']':
+			p.state = vtOSC
+		case b == 'P' || b == 'X' || b == '^' || b == '_':
+			p.state = vtString
+		case b <= 0x2f:
+			p.intermediates = append(p.intermediates, b)
+			p.state = vtEscapeIntermediate
+		case b < 0x7f:
+			p.state = vtGround
+			ts.escDispatch(b)
+		}
+
+	case vtEscapeIntermediate:
+		switch {
+		case b < 0x20:
+			ts.execute(b)
+		case b <= 0x2f:
+			p.intermediates = append(p.intermediates, b)
+		case b < 0x7f:
+			p.state = vtGround
+			ts.escDispatch(b)
+		}
+
+	case vtCSI:
+		switch {
+		case b < 0x20:
+			ts.execute(b)
+		case b <= 0x2f:
+			p.intermediates = append(p.intermediates, b)
+		case b <= 0x3f:
+			if len(p.intermediates) > 0 || len(p.params) >= maxCSIParamBytes {
+				p.state = vtCSIIgnore
+				return
+			}
+			p.params = append(p.params, b)
+		case b < 0x7f:
+			p.state = vtGround
+			ts.csiDispatch(b)
+		}
+
+	case vtCSIIgnore:
+		switch {
+		case b < 0x20:
+			ts.execute(b)
+		case b >= 0x40 && b < 0x7f:
+			p.state = vtGround
+		}
+
+	case vtOSC:
+		// Window titles and other OSC strings don't affect the screen.
+		switch b {
+		case 0x07:
+			p.state = vtGround
+		case 0x1b:
+			p.state = vtStringEscape
+		}
+
+	case vtString:
+		if b == 0x1b {
+			p.state = vtStringEscape
+		}
+
+	case vtStringEscape:
+		if b == '\\' {
+			p.state = vtGround
+			return
+		}
+		// Not a terminator: the ESC starts a new sequence.
+		p.clear()
+		p.state = vtEscape
+		ts.advance(b)
+	}
+}
+
+// ground handles a byte outside any escape sequence: C0 controls, printable
+// ASCII, and UTF-8 characters possibly split across calls.
+func (ts *TerminalState) ground(b byte) {
+	p := &ts.parser
+
+	if p.utf8Len > 0 {
+		if b&0xc0 == 0x80 {
+			p.utf8Buf[p.utf8Len] = b
+			p.utf8Len++
+			if p.utf8Len == p.utf8Need {
+				r, _ := utf8.DecodeRune(p.utf8Buf[:p.utf8Len])
+				p.utf8Len = 0
+				ts.print(r)
+			}
+			return
+		}
+		// Truncated character.
+		p.utf8Len = 0
+		ts.print(utf8.RuneError)
+	}
+
+	switch {
+	case b < 0x20 || b == 0x7f:
+		ts.execute(b)
+	case b < 0x80:
+		ts.print(rune(b))
+	default:
+		need := 0
+		switch {
+		case b&0xe0 == 0xc0:
+			need = 2
+		case b&0xf0 == 0xe0:
+			need = 3
+		case b&0xf8 == 0xf0:
+			need = 4
+		}
+		if need == 0 {
+			ts.print(utf8.RuneError)
+			return
+		}
+		p.utf8Buf[0] = b
+		p.utf8Len = 1
+		p.utf8Need = need
+	}
+}
+
+// execute performs a C0 control function.
+func (ts *TerminalState) execute(b byte) {
+	switch b {
+	case '\r': // Carriage return
+		ts.CursorCol = 0
+		ts.wrapPending = false
+	case '\n', '\v', '\f': // Line feed (VT and FF are treated as LF)
+		ts.lineFeed()
+		if ts.NewlineMode {
+			ts.CursorCol = 0
+		}
+	case '\b': // Backspace
+		if ts.CursorCol > 0 {
+			ts.CursorCol--
+		}
+		ts.wrapPending = false
+	case '\t': // Tab (advance to next tab stop)
+		ts.tabForward(1)
+	case 0x0e: // SO: invoke G1
+		ts.charset = 1
+	case 0x0f: // SI: invoke G0
+		ts.charset = 0
+	}
+}
+
+// lineFeed moves the cursor down one row, scrolling the region when the
+// cursor is on its bottom margin.
+func (ts *TerminalState) lineFeed() {
+	ts.wrapPending = false
+	switch {
+	case ts.CursorRow == ts.ScrollBottom:
+		ts.scrollRegionUp(ts.ScrollTop, ts.ScrollBottom, 1)
+	case ts.CursorRow < ts.Rows-1:
+		ts.CursorRow++
+	}
+}
+
+// reverseIndex moves the cursor up one row, scrolling the region down when
+// the cursor is on its top margin.
+func (ts *TerminalState) reverseIndex() {
+	ts.wrapPending = false
+	switch {
+	case ts.CursorRow == ts.ScrollTop:
+		ts.scrollRegionDown(ts.ScrollTop, ts.ScrollBottom, 1)
+	case ts.CursorRow > 0:
+		ts.CursorRow--
+	}
+}
+
+func (ts *TerminalState) tabForward(n int) {
+	ts.wrapPending = false
+	for ; n > 0; n-- {
+		found := false
+		for i := ts.CursorCol + 1; i < ts.Cols; i++ {
+			if ts.TabStops[i] {
+				ts.CursorCol = i
+				found = true
+				break
+			}
+		}
+		if !found {
+			ts.CursorCol = ts.Cols - 1
+			return
+		}
+	}
+}
+
+func (ts *TerminalState) tabBackward(n int) {
+	ts.wrapPending = false
+	for ; n > 0 && ts.CursorCol > 0; n-- {
+		ts.CursorCol--
+		for ts.CursorCol > 0 && !ts.TabStops[ts.CursorCol] {
+			ts.CursorCol--
+		}
+	}
+}
+
+// decGraphics maps the DEC special graphics character set (ESC ( 0) onto
+// the Unicode box-drawing characters xterm.js shows for it.
+var decGraphics = map[rune]rune{
+	'`': '◆', 'a': '▒', 'b': '␉', 'c': '␌', 'd': '␍', 'e': '␊', 'f': '°', 'g': '±',
+	'h': '␤', 'i': '␋', 'j': '┘', 'k': '┐', 'l': '┌', 'm': '└', 'n': '┼', 'o': '⎺',
+	'p': '⎻', 'q': '─', 'r': '⎼', 's': '⎽', 't': '├', 'u': '┤', 'v': '┴', 'w': '┬',
+	'x': '│', 'y': '≤', 'z': '≥', '{': 'π', '|': '≠', '}': '£', '~': '·',
+}
+
+// runeIsWide reports whether r occupies two columns.
+func runeIsWide(r rune) bool {
+	return runewidth.RuneWidth(r) == 2
+}
+
+// print writes a printable character at the cursor and advances it.
+func (ts *TerminalState) print(r rune) {
+	if ts.charsets[ts.charset] == '0' {
+		if g, ok := decGraphics[r]; ok {
+			r = g
+		}
+	}
+
+	width := runewidth.RuneWidth(r)
+	if width == 0 {
+		// Combining marks and zero-width joiners have no cell of their own.
+		return
+	}
+
+	if ts.wrapPending && ts.AutoWrap {
+		ts.wrapped[ts.CursorRow] = true
+		ts.CursorCol = 0
+		ts.lineFeed()
+	}
+	ts.wrapPending = false
+
+	// A wide character doesn't fit in the last column: wrap it whole.
+	if width == 2 && ts.CursorCol == ts.Cols-1 {
+		if !ts.AutoWrap || ts.Cols < 2 {
+			return
+		}
+		ts.eraseCells(ts.CursorRow, ts.CursorCol, ts.Cols)
+		ts.wrapped[ts.CursorRow] = true
+		ts.CursorCol = 0
+		ts.lineFeed()
+	}
+
+	if ts.InsertMode {
+		ts.insertChars(width)
+	}
+
+	row := ts.Grid[ts.CursorRow]
+	col := ts.CursorCol
+	// Overwriting half of a wide character blanks the other half.
+	if row[col].Spacer && col > 0 {
+		row[col-1] = ts.blankCell()
+	}
+	if end := col + width; end < ts.Cols && row[end].Spacer {
+		row[end] = ts.blankCell()
+	}
+
+	row[col] = Cell{Char: r, Style: ts.CurrentStyle}
+	if width == 2 {
+		row[col+1] = Cell{Style: ts.CurrentStyle, Spacer: true}
+	}
+	ts.lastChar = r
+
+	ts.CursorCol += width
+	if ts.CursorCol >= ts.Cols {
+		ts.CursorCol = ts.Cols - 1
+		ts.wrapPending = ts.AutoWrap
+	}
+}
+
+// escDispatch performs an escape sequence (ESC [intermediates] final).
+func (ts *TerminalState) escDispatch(final byte) {
+	inter := ts.parser.intermediates
+	if len(inter) > 0 {
+		switch inter[0] {
+		case '(': // Designate G0
+			ts.charsets[0] = final
+		case ')': // Designate G1
+			ts.charsets[1] = final
+		case '#':
+			if final == '8' { // DECALN: fill the screen with E
+				for i := range ts.Grid {
+					for j := range ts.Grid[i] {
+						ts.Grid[i][j] = Cell{Char: 'E', Style: DefaultStyle()}
+					}
+				}
+			}
+		}
+		return
+	}
+	ts.handleSimpleEscape(string(final))
+}
+
+// handleSimpleEscape handles simple escape sequences
+func (ts *TerminalState) handleSimpleEscape(command string) {
+	switch command {
+	case "7": // Save cursor position
+		ts.saveCursor()
+	case "8": // Restore cursor position
+		ts.restoreCursor()
+	case "D": // Line feed
+		ts.lineFeed()
+	case "E": // Next line (CR + LF)
+		ts.CursorCol = 0
+		ts.lineFeed()
+	case "H": // Set tab stop
+		ts.TabStops[ts.CursorCol] = true
+	case "M": // Reverse line feed
+		ts.reverseIndex()
+	case "c": // Full reset
+		ts.reset()
+	}
+}
+
+// saveCursor implements DECSC.
+func (ts *TerminalState) saveCursor() {
+	ts.SavedCursorRow = ts.CursorRow
+	ts.SavedCursorCol = ts.CursorCol
+	ts.saved = savedCursor{
+		style:      ts.CurrentStyle,
+		originMode: ts.OriginMode,
+		charsets:   ts.charsets,
+		charset:    ts.charset,
+	}
+}
+
+// restoreCursor implements DECRC, clamping the position in case of resize.
+func (ts *TerminalState) restoreCursor() {
+	ts.CursorRow = clamp(ts.SavedCursorRow, 0, ts.Rows-1)
+	ts.CursorCol = clamp(ts.SavedCursorCol, 0, ts.Cols-1)
+	ts.CurrentStyle = ts.saved.style
+	ts.OriginMode = ts.saved.originMode
+	ts.charsets = ts.saved.charsets
+	ts.charset = ts.saved.charset
+	ts.wrapPending = false
+}
+
+// reset implements RIS: back to the power-on state, keeping the size and
+// scrollback.
+func (ts *TerminalState) reset() {
+	if ts.AltScreen {
+		ts.leaveAltScreen()
+	}
+	fresh := NewTerminalState(ts.Rows, ts.Cols)
+	ts.Grid = fresh.Grid
+	ts.wrapped = fresh.wrapped
+	ts.CursorRow, ts.CursorCol = 0, 0
+	ts.CursorVisible = true
+	ts.SavedCursorRow, ts.SavedCursorCol = 0, 0
+	ts.CurrentStyle = DefaultStyle()
+	ts.TabStops = fresh.TabStops
+	ts.ScrollTop, ts.ScrollBottom = 0, ts.Rows-1
+	ts.AutoWrap = true
+	ts.OriginMode = false
+	ts.InsertMode = false
+	ts.wrapPending = false
+	ts.saved = fresh.saved
+	ts.charsets = fresh.charsets
+	ts.charset = 0
+}
+
+// csiDispatch performs a control sequence (CSI params intermediates final).
+func (ts *TerminalState) csiDispatch(final byte) {
+	params := string(ts.parser.params)
+	inter := string(ts.parser.intermediates)
+
+	var private byte
+	if params != "" && params[0] >= '<' && params[0] <= '?' {
+		private = params[0]
+		params = params[1:]
+	}
+
+	switch {
+	case inter == "!" && final == 'p': // DECSTR: soft reset
+		ts.CursorVisible = true
+		ts.InsertMode = false
+		ts.OriginMode = false
+		ts.AutoWrap = true
+		ts.CurrentStyle = DefaultStyle()
+		ts.ScrollTop, ts.ScrollBottom = 0, ts.Rows-1
+		ts.charsets = [2]byte{'B', 'B'}
+		ts.charset = 0
+		ts.saved = savedCursor{style: DefaultStyle(), charsets: ts.charsets}
+		return
+	case inter != "":
+		// Cursor style (SP q) and other intermediates don't affect the screen.
+		return
+	case private == '?':
+		switch final {
+		case 'h':
+			ts.setPrivateModes(params, true)
+		case 'l':
+			ts.setPrivateModes(params, false)
+		}
+		return
+	case private != 0:
+		// CSI > / = / < sequences (key modifiers, device attributes) are
+		// requests and settings with no effect on the screen.
+		return
+	}
+
+	ts.handleCSI(params, string(final))
+}
+
+// handleCSI handles CSI escape sequences (ESC [ params letter)
+func (ts *TerminalState) handleCSI(params string, command string) {
+	// Private-mode sequences reach here only from older callers.
+	if strings.HasPrefix(params, "?") {
+		switch command {
+		case "h":
+			ts.setPrivateModes(params[1:], true)
+		case "l":
+			ts.setPrivateModes(params[1:], false)
+		}
+		return
+	}
+
+	args := strings.Split(params, ";")
+	arg := func(i, def int) int {
+		if i >= len(args) {
+			return def
+		}
+		// Sub-parameters (':') only matter for SGR.
+		v := parseIntParam(strings.SplitN(args[i], ":", 2)[0], def)
+		if v == 0 && def > 0 {
+			return def
+		}
+		return v
+	}
+	n := arg(0, 1)
+
+	if command != "b" {
+		ts.wrapPending = false
+	}
+
+	switch command {
+	case "@": // Insert characters
+		ts.insertChars(n)
+	case "A": // Cursor up
+		ts.cursorUp(n)
+	case "B", "e": // Cursor down / vertical position relative
+		ts.cursorDown(n)
+	case "C", "a": // Cursor forward / horizontal position relative
+		ts.CursorCol = clamp(ts.CursorCol+n, 0, ts.Cols-1)
+	case "D": // Cursor backward
+		ts.CursorCol = clamp(ts.CursorCol-n, 0, ts.Cols-1)
+	case "E": // Cursor next line
+		ts.cursorDown(n)
+		ts.CursorCol = 0
+	case "F": // Cursor previous line
+		ts.cursorUp(n)
+		ts.CursorCol = 0
+	case "G", "`": // Cursor horizontal absolute
+		ts.CursorCol = clamp(n-1, 0, ts.Cols-1)
+	case "H", "f": // Cursor position (row;col)
+		ts.cursorTo(arg(0, 1)-1, arg(1, 1)-1)
+	case "I": // Cursor forward tabulation
+		ts.tabForward(n)
+	case "J": // Erase in display
+		switch arg(0, 0) {
+		case 0: // Clear from cursor to end of screen
+			ts.clearFromCursor(true)
+		case 1: // Clear from beginning to cursor
+			ts.clearToCursor(true)
+		case 2: // Clear entire screen
+			ts.clearScreen()
+		case 3: // Clear scrollback (xterm)
+			ts.scrollback = nil
+		}
+	case "K": // Erase in line
+		switch arg(0, 0) {
+		case 0: // Clear from cursor to end of line
+			ts.clearFromCursor(false)
+		case 1: // Clear from beginning of line to cursor
+			ts.clearToCursor(false)
+		case 2: // Clear entire line
+			ts.clearLine(ts.CursorRow)
+		}
+	case "L": // Insert lines
+		ts.insertLines(n)
+	case "M": // Delete lines
+		ts.deleteLines(n)
+	case "P": // Delete characters
+		ts.deleteChars(n)
+	case "S": // Scroll up
+		ts.scrollRegionUp(ts.ScrollTop, ts.ScrollBottom, n)
+	case "T": // Scroll down
+		ts.scrollRegionDown(ts.ScrollTop, ts.ScrollBottom, n)
+	case "X": // Erase characters
+		ts.eraseCells(ts.CursorRow, ts.CursorCol, ts.CursorCol+n)
+	case "Z": // Cursor backward tabulation
+		ts.tabBackward(n)
+	case "b": // Repeat the preceding character
+		if ts.lastChar != 0 {
+			for i := 0; i < n && i < ts.Rows*ts.Cols; i++ {
+				ts.print(ts.lastChar)
+			}
+		}
+	case "d": // Line position absolute
+		row := n - 1
+		if ts.OriginMode {
+			row += ts.ScrollTop
+		}
+		ts.CursorRow = clamp(row, 0, ts.Rows-1)
+	case "g": // Clear tab stops
+		switch arg(0, 0) {
+		case 0: // Clear tab stop at current column
+			delete(ts.TabStops, ts.CursorCol)
+		case 3: // Clear all tab stops
+			ts.TabStops = make(map[int]bool)
+		}
+	case "h", "l": // Set / reset mode
+		on := command == "h"
+		for i := range args {
+			switch arg(i, 0) {
+			case 4:
+				ts.InsertMode = on
+			case 20:
+				ts.NewlineMode = on
+			}
+		}
+	case "m": // SGR (Select Graphic Rendition) - text styling
+		ts.handleSGR(params)
+	case "r": // Set scroll region
+		top, bottom := arg(0, 1)-1, arg(1, ts.Rows)-1
+		if bottom >= ts.Rows {
+			bottom = ts.Rows - 1
+		}
+		if top < bottom {
+			ts.ScrollTop, ts.ScrollBottom = top, bottom
+			ts.cursorTo(0, 0)
+		}
+	case "s": // Save cursor (SCOSC)
+		if params == "" {
+			ts.saveCursor()
+		}
+	case "u": // Restore cursor (SCORC)
+		if params == "" {
+			ts.restoreCursor()
+		}
+	}
+}
+
+// setPrivateModes applies DEC private modes (CSI ? Pm h / l).
+func (ts *TerminalState) setPrivateModes(params string, on bool) {
+	for _, p := range strings.Split(params, ";") {
+		switch parseIntParam(p, 0) {
+		case 6: // DECOM
+			ts.OriginMode = on
+			ts.cursorTo(0, 0)
+		case 7: // DECAWM
+			ts.AutoWrap = on
+			if !on {
+				ts.wrapPending = false
+			}
+		case 25: // DECTCEM
+			ts.CursorVisible = on
+		case 47, 1047: // Alternate screen
+			if on {
+				ts.enterAltScreen()
+			} else {
+				ts.leaveAltScreen()
+			}
+		case 1048: // Save / restore cursor
+			if on {
+				ts.saveCursor()
+			} else {
+				ts.restoreCursor()
+			}
+		case 1049: // Save cursor and switch to a cleared alternate screen
+			if on {
+				ts.saveCursor()
+				ts.enterAltScreen()
+			} else {
+				ts.leaveAltScreen()
+				ts.restoreCursor()
+			}
+		}
+	}
+}
+
+// enterAltScreen switches to a blank alternate screen, setting the main
+// screen aside.
+func (ts *TerminalState) enterAltScreen() {
+	if ts.AltScreen {
+		return
+	}
+	ts.mainGrid, ts.mainWrapped = ts.Grid, ts.wrapped
+	ts.Grid = make([][]Cell, ts.Rows)
+	for i := range ts.Grid {
+		ts.Grid[i] = blankRow(ts.Cols, ts.CurrentStyle)
+	}
+	ts.wrapped = make([]bool, ts.Rows)
+	ts.AltScreen = true
+}
+
+// leaveAltScreen discards the alternate screen and restores the main one.
+func (ts *TerminalState) leaveAltScreen() {
+	if !ts.AltScreen {
+		return
+	}
+	ts.Grid, ts.wrapped = ts.mainGrid, ts.mainWrapped
+	ts.mainGrid, ts.mainWrapped = nil, nil
+	ts.AltScreen = false
+	ts.wrapPending = false
+}
+
+// cursorTo moves the cursor to row, col (0-based), relative to the scroll
+// region in origin mode.
+func (ts *TerminalState) cursorTo(row, col int) {
+	if ts.OriginMode {
+		ts.CursorRow = clamp(row+ts.ScrollTop, ts.ScrollTop, ts.ScrollBottom)
+	} else {
+		ts.CursorRow = clamp(row, 0, ts.Rows-1)
+	}
+	ts.CursorCol = clamp(col, 0, ts.Cols-1)
+	ts.wrapPending = false
+}
+
+// cursorUp moves the cursor up n rows, stopping at the top margin when the
+// cursor starts inside the scroll region.
+func (ts *TerminalState) cursorUp(n int) {
+	top := 0
+	if ts.CursorRow >= ts.ScrollTop {
+		top = ts.ScrollTop
+	}
+	ts.CursorRow = clamp(ts.CursorRow-n, top, ts.Rows-1)
+}
+
+// cursorDown moves the cursor down n rows, stopping at the bottom margin
+// when the cursor starts inside the scroll region.
+func (ts *TerminalState) cursorDown(n int) {
+	bottom := ts.Rows - 1
+	if ts.CursorRow <= ts.ScrollBottom {
+		bottom = ts.ScrollBottom
+	}
+	ts.CursorRow = clamp(ts.CursorRow+n, 0, bottom)
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// handleSGR handles SGR (Select Graphic Rendition) escape sequences.
+// Extended colours are accepted in both the ';' (38;5;n) and ':' (38:5:n,
+// 38:2::r:g:b) forms.
+func (ts *TerminalState) handleSGR(params string) {
+	if params == "" || params == "0" {
+		// Reset all attributes
+		ts.CurrentStyle = DefaultStyle()
+		return
+	}
+
+	parts := strings.Split(params, ";")
+	for i := 0; i < len(parts); i++ {
+		sub := strings.Split(parts[i], ":")
+		code := parseIntParam(sub[0], 0)
+		switch code {
+		case 0: // Reset
+			ts.CurrentStyle = DefaultStyle()
+		case 1: // Bold
+			ts.CurrentStyle.Bold = true
+		case 2: // Dim
+			ts.CurrentStyle.Dim = true
+		case 3: // Italic
+			ts.CurrentStyle.Italic = true
+		case 4: // Underline (4:0 turns it off)
+			ts.CurrentStyle.Underline = len(sub) < 2 || sub[1] != "0"
+		case 7: // Reverse
+			ts.CurrentStyle.Reverse = true
+		case 8: // Hidden
+			ts.CurrentStyle.Hidden = true
+		case 9: // Strikethrough
+			ts.CurrentStyle.Strikethrough = true
+		case 21: // Double underline
+			ts.CurrentStyle.Underline = true
+		case 22: // Normal intensity (not bold)
+			ts.CurrentStyle.Bold = false
+			ts.CurrentStyle.Dim = false
+		case 23: // Not italic
+			ts.CurrentStyle.Italic = false
+		case 24: // Not underlined
+			ts.CurrentStyle.Underline = false
+		case 27: // Not reversed
+			ts.CurrentStyle.Reverse = false
+		case 28: // Not hidden
+			ts.CurrentStyle.Hidden = false
+		case 29: // Not struck through
+			ts.CurrentStyle.Strikethrough = false
+		case 30, 31, 32, 33, 34, 35, 36, 37: // Foreground colors
+			ts.CurrentStyle.FgColor = fmt.Sprintf("color%d", code-30)
+		case 39: // Default foreground color
+			ts.CurrentStyle.FgColor = ""
+		case 40, 41, 42, 43, 44, 45, 46, 47: // Background colors
+			ts.CurrentStyle.BgColor = fmt.Sprintf("color%d", code-40)
+		case 49: // Default background color
+			ts.CurrentStyle.BgColor = ""
+		case 90, 91, 92, 93, 94, 95, 96, 97: // Bright foreground colors
+			ts.CurrentStyle.FgColor = fmt.Sprintf("bright-color%d", code-90)
+		case 100, 101, 102, 103, 104, 105, 106, 107: // Bright background colors
+			ts.CurrentStyle.BgColor = fmt.Sprintf("bright-color%d", code-100)
+		case 38, 48, 58: // Extended colors (38 = fg, 48 = bg, 58 = underline)
+			var color string
+			if len(sub) > 1 {
+				color = extendedColor(sub[1:], true)
+			} else {
+				var used int
+				color, used = extendedColorArgs(parts[i+1:])
+				i += used
+			}
+			switch code {
+			case 38:
+				ts.CurrentStyle.FgColor = color
+			case 48:
+				ts.CurrentStyle.BgColor = color
+			}
+		}
+	}
+}
+
+// extendedColorArgs parses the ';'-separated arguments following 38/48/58:
+// 5;n (256 colors) or 2;r;g;b (RGB). It returns the colour and how many
+// arguments it consumed.
+func extendedColorArgs(args []string) (string, int) {
+	if len(args) == 0 {
+		return "", 0
+	}
+	switch args[0] {
+	case "5":
+		if len(args) < 2 {
+			return "", len(args)
+		}
+		return extendedColor(args[:2], false), 2
+	case "2":
+		if len(args) < 4 {
+			return "", len(args)
+		}
+		return extendedColor(args[:4], false), 4
+	}
+	return "", 1
+}
+
+// extendedColor converts 5;n or 2;r;g;b into the internal colour string.
+// The ':' form of RGB may carry a colour space id before r, g and b.
+func extendedColor(args []string, colonForm bool) string {
+	switch args[0] {
+	case "5":
+		if len(args) >= 2 {
+			return "color-" + strconv.Itoa(parseIntParam(args[1], 0))
+		}
+	case "2":
+		rgb := args[1:]
+		if colonForm && len(rgb) >= 4 {
+			rgb = rgb[1:]
+		}
+		if len(rgb) >= 3 {
+			return fmt.Sprintf("rgb(%d,%d,%d)", parseIntParam(rgb[0], 0), parseIntParam(rgb[1], 0), parseIntParam(rgb[2], 0))
+		}
+	}
+	return ""
+}
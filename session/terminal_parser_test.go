@@ -0,0 +1,245 @@
+package session
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// newPTYScreen returns a screen fed as from a raw PTY, without newline mode.
+func newPTYScreen(rows, cols int) *TerminalState {
+	state := NewTerminalState(rows, cols)
+	state.SetNewlineMode(false)
+	return state
+}
+
+func feed(t *testing.T, state *TerminalState, chunks ...string) {
+	t.Helper()
+	for _, c := range chunks {
+		if err := state.ProcessOutput([]byte(c)); err != nil {
+			t.Fatalf("ProcessOutput(%q): %v", c, err)
+		}
+	}
+}
+
+func screenText(state *TerminalState) string {
+	return strings.Join(state.ScreenLines(false), "\n")
+}
+
+func TestParser_AltScreenRestoresMainScreen(t *testing.T) {
+	state := newPTYScreen(3, 10)
+	feed(t, state, "shell$ ", "\x1b[?1049h", "\x1b[2J\x1b[Hvim buffer")
+
+	if !state.AltScreen {
+		t.Fatal("expected alternate screen to be active")
+	}
+	if got := state.ScreenLines(false)[0]; got != "vim buffer" {
+		t.Errorf("alt screen row 0 = %q, want %q", got, "vim buffer")
+	}
+
+	feed(t, state, "\x1b[?1049l")
+	if state.AltScreen {
+		t.Error("expected main screen after 1049l")
+	}
+	if got := state.ScreenLines(false)[0]; got != "shell$" {
+		t.Errorf("main screen row 0 = %q, want %q", got, "shell$")
+	}
+	if state.CursorRow != 0 || state.CursorCol != 7 {
+		t.Errorf("cursor = (%d,%d), want restored (0,7)", state.CursorRow, state.CursorCol)
+	}
+}
+
+func TestParser_ScrollRegion(t *testing.T) {
+	state := newPTYScreen(5, 10)
+	feed(t, state, "header\r\n1\r\n2\r\n3\r\nfooter")
+
+	// Scroll only rows 2-4 (1-based), as a status-line TUI does.
+	feed(t, state, "\x1b[2;4r", "\x1b[4;1H", "\n", "4")
+
+	want := []string{"header", "2", "3", "4", "footer"}
+	got := state.ScreenLines(false)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+	if len(state.HistoryLines(0, false)) != 5 {
+		t.Errorf("scrolling inside a region must not add scrollback: %q", state.HistoryLines(0, false))
+	}
+}
+
+func TestParser_DeferredWrap(t *testing.T) {
+	state := newPTYScreen(3, 5)
+	feed(t, state, "abcde")
+
+	if state.CursorRow != 0 || state.CursorCol != 4 {
+		t.Errorf("cursor after filling the row = (%d,%d), want (0,4)", state.CursorRow, state.CursorCol)
+	}
+
+	// A CR/LF after the last column must not produce a blank line.
+	feed(t, state, "\r\nx")
+	if got := screenText(state); got != "abcde\nx\n" {
+		t.Errorf("screen = %q", got)
+	}
+
+	feed(t, state, "\r\n123456")
+	if got := state.HistoryLines(0, false); got[len(got)-1] != "123456" {
+		t.Errorf("wrapped line not joined in history: %q", got)
+	}
+}
+
+func TestParser_NewlineModeOff(t *testing.T) {
+	state := newPTYScreen(3, 10)
+	feed(t, state, "ab\ncd")
+
+	if got := state.ScreenLines(false)[1]; got != "  cd" {
+		t.Errorf("bare LF must keep the column: row 1 = %q", got)
+	}
+}
+
+func TestParser_WideCharacters(t *testing.T) {
+	state := newPTYScreen(2, 6)
+	feed(t, state, "a漢b")
+
+	if state.CursorCol != 4 {
+		t.Errorf("cursor col = %d, want 4", state.CursorCol)
+	}
+	if !state.Grid[0][2].Spacer {
+		t.Error("expected spacer cell after wide character")
+	}
+	if got := state.ScreenLines(false)[0]; got != "a漢b" {
+		t.Errorf("row 0 = %q", got)
+	}
+
+	// Overwriting the spacer blanks the wide character's first half.
+	feed(t, state, "\x1b[1;3Hx")
+	if got := state.ScreenLines(false)[0]; got != "a xb" {
+		t.Errorf("after overwrite row 0 = %q, want %q", got, "a xb")
+	}
+
+	// A wide character that doesn't fit in the last column wraps whole.
+	feed(t, state, "\x1b[1;6H字")
+	if state.Grid[1][0].Char != '字' || state.Grid[0][5].Char != ' ' {
+		t.Errorf("wide char at last column not wrapped: %q", state.ScreenLines(false))
+	}
+}
+
+func TestParser_SequencesSplitAcrossChunks(t *testing.T) {
+	state := newPTYScreen(2, 20)
+	utf := []byte("é")
+	feed(t, state, "\x1b[", "1;3", "1mred", "\x1b", "[0m ", string(utf[:1]), string(utf[1:]))
+
+	if got := state.ScreenLines(false)[0]; got != "red é" {
+		t.Errorf("row 0 = %q, want %q", got, "red é")
+	}
+	if !state.Grid[0][0].Style.Bold || state.Grid[0][0].Style.FgColor != "color1" {
+		t.Errorf("style from split SGR = %+v", state.Grid[0][0].Style)
+	}
+	if state.Grid[0][3].Style != DefaultStyle() {
+		t.Errorf("reset from split SGR not applied: %+v", state.Grid[0][3].Style)
+	}
+}
+
+func TestParser_EraseInsertDeleteChars(t *testing.T) {
+	state := newPTYScreen(1, 10)
+	feed(t, state, "abcdefgh")
+
+	feed(t, state, "\x1b[1;3H\x1b[2X")
+	if got := state.ScreenLines(false)[0]; got != "ab  efgh" {
+		t.Errorf("after ECH = %q", got)
+	}
+	feed(t, state, "\x1b[2P")
+	if got := state.ScreenLines(false)[0]; got != "abefgh" {
+		t.Errorf("after DCH = %q", got)
+	}
+	feed(t, state, "\x1b[3@")
+	if got := state.ScreenLines(false)[0]; got != "ab   efgh" {
+		t.Errorf("after ICH = %q", got)
+	}
+}
+
+func TestParser_ScrollbackHistory(t *testing.T) {
+	state := newPTYScreen(3, 10)
+	state.SetScrollbackLimit(2)
+	feed(t, state, "1\r\n2\r\n3\r\n4\r\n5\r\n6")
+
+	got := state.HistoryLines(0, false)
+	want := []string{"2", "3", "4", "5", "6"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("history = %q, want %q", got, want)
+	}
+	if got := state.HistoryLines(2, false); strings.Join(got, ",") != "5,6" {
+		t.Errorf("last 2 lines = %q", got)
+	}
+
+	feed(t, state, "\x1b[3J")
+	if got := state.HistoryLines(0, false); len(got) != 3 {
+		t.Errorf("history after clearing scrollback = %q", got)
+	}
+}
+
+func TestParser_CarriageReturnRedraw(t *testing.T) {
+	lines := ReplayTerminalOutput([]byte("progress 10%\rprogress 100%\ndone\n"), 5, 40, 0, false)
+	if len(lines) < 2 || lines[0] != "progress 100%" || lines[1] != "done" {
+		t.Errorf("replayed lines = %q", lines)
+	}
+}
+
+func TestParser_DECSpecialGraphics(t *testing.T) {
+	state := newPTYScreen(1, 10)
+	feed(t, state, "\x1b(0lqk\x1b(Bx")
+
+	if got := state.ScreenLines(false)[0]; got != "┌─┐x" {
+		t.Errorf("row 0 = %q, want %q", got, "┌─┐x")
+	}
+}
+
+func TestParser_PrivateSGRIgnored(t *testing.T) {
+	state := newPTYScreen(1, 10)
+	// CSI > 4 ; 2 m sets xterm key modifiers; it is not SGR 4 (underline).
+	feed(t, state, "\x1b[>4;2mok")
+
+	if state.Grid[0][0].Style.Underline {
+		t.Error("CSI > m was treated as SGR")
+	}
+	if got := state.ScreenLines(false)[0]; got != "ok" {
+		t.Errorf("row 0 = %q", got)
+	}
+}
+
+func TestParser_ExtendedColors(t *testing.T) {
+	state := newPTYScreen(1, 10)
+	feed(t, state, "\x1b[38;5;208ma\x1b[48:2::1:2:3mb\x1b[58;5;1;4mc")
+
+	if got := state.Grid[0][0].Style.FgColor; got != "color-208" {
+		t.Errorf("256-colour fg = %q", got)
+	}
+	if got := state.Grid[0][1].Style.BgColor; got != "rgb(1,2,3)" {
+		t.Errorf("colon RGB bg = %q", got)
+	}
+	// The underline colour's arguments are consumed, not read as SGR codes.
+	if st := state.Grid[0][2].Style; !st.Underline || st.Bold {
+		t.Errorf("style after SGR 58 = %+v", st)
+	}
+}
+
+func TestParser_NeverPanics(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	alphabet := []byte("\x1b[]();?>!:0123456789mHJKr@PLMXhlb\r\n\t\b\x07\x0e\x0f\xe6\xbc\xa2\xff ")
+	for i := 0; i < 500; i++ {
+		state := newPTYScreen(1+rng.Intn(6), 1+rng.Intn(12))
+		state.SetScrollbackLimit(rng.Intn(4))
+		buf := make([]byte, rng.Intn(256))
+		for j := range buf {
+			buf[j] = alphabet[rng.Intn(len(alphabet))]
+		}
+		feed(t, state, string(buf[:len(buf)/2]), string(buf[len(buf)/2:]))
+		state.Resize(1+rng.Intn(6), 1+rng.Intn(12))
+		for _, l := range state.HistoryLines(0, true) {
+			if !utf8.ValidString(l) {
+				t.Fatalf("iteration %d: invalid UTF-8 line %q", i, l)
+			}
+		}
+	}
+}
@@ -3,7 +3,6 @@ package session
 import (
 	"fmt"
 	sessionv1 "github.com/tstapler/stapler-squad/gen/proto/go/session/v1"
-	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -13,16 +12,22 @@ import (
 type Cell struct {
 	Char  rune
 	Style CellStyle
+	// Spacer marks the second column of a double-width character. It has no
+	// glyph of its own and must be skipped when rendering.
+	Spacer bool
 }
 
 // CellStyle represents text styling attributes
 type CellStyle struct {
-	FgColor   string
-	BgColor   string
-	Bold      bool
-	Italic    bool
-	Underline bool
-	Reverse   bool
+	FgColor       string
+	BgColor       string
+	Bold          bool
+	Dim           bool
+	Italic        bool
+	Underline     bool
+	Reverse       bool
+	Hidden        bool
+	Strikethrough bool
 }
 
 // DefaultStyle returns a default cell style
@@ -33,7 +38,29 @@ func DefaultStyle() CellStyle {
 	}
 }
 
-// TerminalState maintains the current terminal screen state
+// DefaultScreenScrollback is the number of lines scrolled off the top of the
+// screen that a live session screen keeps for history reads.
+const DefaultScreenScrollback = 2000
+
+// scrollbackLine is a row that scrolled off the top of the main screen.
+// Its cells are never modified after it is pushed, so clones share them.
+type scrollbackLine struct {
+	cells   []Cell
+	wrapped bool
+}
+
+// savedCursor is the state saved by DECSC (ESC 7) and restored by DECRC (ESC 8).
+type savedCursor struct {
+	style      CellStyle
+	originMode bool
+	charsets   [2]byte
+	charset    int
+}
+
+// TerminalState is a VT100/xterm emulator that maintains the current terminal
+// screen: a cell grid with attributes, cursor, scroll region, alternate screen
+// and (optionally) the lines scrolled off the top. It is the source of truth
+// for snapshots and SSP diffs of a session's terminal.
 type TerminalState struct {
 	mu sync.RWMutex
 
@@ -61,10 +88,59 @@ type TerminalState struct {
 
 	// State version for delta tracking
 	Version uint64
+
+	// Scroll region (inclusive, 0-based), set by DECSTBM
+	ScrollTop    int
+	ScrollBottom int
+
+	// Modes
+	AutoWrap    bool // DECAWM: printing past the last column wraps to the next line
+	OriginMode  bool // DECOM: cursor addressing is relative to the scroll region
+	InsertMode  bool // IRM: printed characters shift the rest of the line right
+	NewlineMode bool // LNM: line feed also returns the carriage
+	AltScreen   bool // The alternate screen buffer is active
+
+	// wrapped[row] is set when the row's text continues on the next row
+	// because it was autowrapped rather than ended by a line feed.
+	wrapped []bool
+
+	// A character was printed in the last column; the next printable
+	// character wraps first (xterm's deferred wrap).
+	wrapPending bool
+
+	// Main screen, kept aside while the alternate screen is active.
+	mainGrid    [][]Cell
+	mainWrapped []bool
+
+	saved savedCursor
+
+	// G0/G1 character sets ('B' = ASCII, '0' = DEC special graphics) and
+	// which one is invoked into GL.
+	charsets [2]byte
+	charset  int
+
+	// Last printed character, repeated by REP.
+	lastChar rune
+
+	scrollback      []scrollbackLine
+	scrollbackLimit int
+
+	parser vtParser
 }
 
-// NewTerminalState creates a new terminal state with given dimensions
+// NewTerminalState creates a new terminal state with given dimensions.
+//
+// The state starts in newline mode (LNM), so a bare line feed also returns
+// the carriage: content captured from tmux is LF-separated. Screens fed from
+// a raw PTY stream, where the tty driver already emits CRLF, should call
+// SetNewlineMode(false) so cursor-addressed line feeds behave as in xterm.
 func NewTerminalState(rows, cols int) *TerminalState {
+	if rows < 1 {
+		rows = 1
+	}
+	if cols < 1 {
+		cols = 1
+	}
 	state := &TerminalState{
 		Rows:           rows,
 		Cols:           cols,
@@ -77,7 +153,14 @@ func NewTerminalState(rows, cols int) *TerminalState {
 		CurrentStyle:   DefaultStyle(),
 		TabStops:       make(map[int]bool),
 		Version:        0,
+		ScrollTop:      0,
+		ScrollBottom:   rows - 1,
+		AutoWrap:       true,
+		NewlineMode:    true,
+		wrapped:        make([]bool, rows),
+		charsets:       [2]byte{'B', 'B'},
 	}
+	state.saved = savedCursor{style: DefaultStyle(), charsets: state.charsets}
 
 	// Initialize default tab stops (every 8 columns)
 	for i := 8; i < cols; i += 8 {
@@ -86,373 +169,132 @@ func NewTerminalState(rows, cols int) *TerminalState {
 
 	// Initialize grid with empty cells
 	for i := 0; i < rows; i++ {
-		state.Grid[i] = make([]Cell, cols)
-		for j := 0; j < cols; j++ {
-			state.Grid[i][j] = Cell{
-				Char:  ' ',
-				Style: DefaultStyle(),
-			}
-		}
+		state.Grid[i] = blankRow(cols, DefaultStyle())
 	}
 
 	return state
 }
 
-// ANSI escape sequence patterns
-var (
-	// CSI (Control Sequence Introducer) pattern: ESC [ params letter
-	// Allows '?' for DEC private modes
-	csiPattern = regexp.MustCompile(`\x1b\[(\??[0-9;]*)([A-Za-z])`)
-
-	// OSC (Operating System Command) pattern: ESC ] params ST
-	oscPattern = regexp.MustCompile(`\x1b\]([^\x07\x1b]*)([\x07]|\x1b\\)`)
-
-	// Simple escape sequences
-	simpleEscPattern = regexp.MustCompile(`\x1b([7-8DEHM])`)
-)
-
-// ProcessOutput processes terminal output and updates state
-func (ts *TerminalState) ProcessOutput(data []byte) error {
+// SetNewlineMode sets whether a bare line feed also returns the carriage.
+func (ts *TerminalState) SetNewlineMode(on bool) {
 	ts.mu.Lock()
 	defer ts.mu.Unlock()
+	ts.NewlineMode = on
+}
 
-	text := string(data)
-	pos := 0
-
-	for pos < len(text) {
-		char := text[pos]
-
-		// Handle ANSI escape sequences
-		if char == '\x1b' {
-			remaining := text[pos:]
-
-			// Try CSI pattern first (most common)
-			if match := csiPattern.FindStringSubmatchIndex(remaining); match != nil {
-				params := remaining[match[2]:match[3]]
-				command := remaining[match[4]:match[5]]
-				ts.handleCSI(params, command)
-				pos += match[1]
-				continue
-			}
-
-			// Try OSC pattern
-			if match := oscPattern.FindStringSubmatchIndex(remaining); match != nil {
-				// OSC sequences don't affect state (title changes, etc)
-				pos += match[1]
-				continue
-			}
+// SetScrollbackLimit sets how many lines scrolled off the top of the main
+// screen are kept for HistoryLines. Zero (the default) keeps none.
+func (ts *TerminalState) SetScrollbackLimit(lines int) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if lines < 0 {
+		lines = 0
+	}
+	ts.scrollbackLimit = lines
+	ts.trimScrollback(true)
+}
 
-			// Try simple escape sequences
-			if match := simpleEscPattern.FindStringSubmatchIndex(remaining); match != nil {
-				command := remaining[match[2]:match[3]]
-				ts.handleSimpleEscape(command)
-				pos += match[1]
-				continue
-			}
+// blankRow returns a row of blank cells carrying style's background.
+func blankRow(cols int, style CellStyle) []Cell {
+	row := make([]Cell, cols)
+	blank := Cell{Char: ' ', Style: eraseStyle(style)}
+	for j := range row {
+		row[j] = blank
+	}
+	return row
+}
 
-			// Unknown escape sequence, skip ESC character
-			pos++
-			continue
-		}
+// eraseStyle is the style of cells cleared while style is current: erased
+// cells keep the background colour (xterm's back colour erase) and nothing else.
+func eraseStyle(style CellStyle) CellStyle {
+	return CellStyle{BgColor: style.BgColor}
+}
 
-		// Handle control characters
-		switch char {
-		case '\r': // Carriage return
-			ts.CursorCol = 0
-		case '\n': // Line feed (also does implicit carriage return in most terminals)
-			ts.CursorCol = 0
-			ts.CursorRow++
-			if ts.CursorRow >= ts.Rows {
-				ts.scrollUp()
-				ts.CursorRow = ts.Rows - 1
-			}
-		case '\b': // Backspace
-			if ts.CursorCol > 0 {
-				ts.CursorCol--
-			}
-		case '\t': // Tab (advance to next tab stop)
-			found := false
-			for i := ts.CursorCol + 1; i < ts.Cols; i++ {
-				if ts.TabStops[i] {
-					ts.CursorCol = i
-					found = true
-					break
-				}
-			}
-			if !found {
-				ts.CursorCol = ts.Cols - 1
-			}
-		default:
-			// Printable character - add to grid
-			if char >= 32 && char < 127 {
-				if ts.CursorRow < ts.Rows && ts.CursorCol < ts.Cols {
-					ts.Grid[ts.CursorRow][ts.CursorCol] = Cell{
-						Char:  rune(char),
-						Style: ts.CurrentStyle,
-					}
-					ts.CursorCol++
-					if ts.CursorCol >= ts.Cols {
-						ts.CursorCol = 0
-						ts.CursorRow++
-						if ts.CursorRow >= ts.Rows {
-							ts.scrollUp()
-							ts.CursorRow = ts.Rows - 1
-						}
-					}
-				}
-			}
-		}
+// blankCell returns the cell left behind by erase operations.
+func (ts *TerminalState) blankCell() Cell {
+	return Cell{Char: ' ', Style: eraseStyle(ts.CurrentStyle)}
+}
 
-		pos++
-	}
+// Utility functions for grid manipulation
 
-	// Increment version after processing output
-	ts.Version++
+// scrollUp scrolls the whole screen up one line.
+func (ts *TerminalState) scrollUp() {
+	ts.scrollRegionUp(0, ts.Rows-1, 1)
+}
 
-	return nil
+// scrollDown scrolls the whole screen down one line.
+func (ts *TerminalState) scrollDown() {
+	ts.scrollRegionDown(0, ts.Rows-1, 1)
 }
 
-// handleCSI handles CSI escape sequences (ESC [ params letter)
-func (ts *TerminalState) handleCSI(params string, command string) {
-	switch command {
-	case "A": // Cursor up
-		n := parseIntParam(params, 1)
-		ts.CursorRow -= n
-		if ts.CursorRow < 0 {
-			ts.CursorRow = 0
-		}
-	case "B": // Cursor down
-		n := parseIntParam(params, 1)
-		ts.CursorRow += n
-		if ts.CursorRow >= ts.Rows {
-			ts.CursorRow = ts.Rows - 1
-		}
-	case "C": // Cursor forward
-		n := parseIntParam(params, 1)
-		ts.CursorCol += n
-		if ts.CursorCol >= ts.Cols {
-			ts.CursorCol = ts.Cols - 1
-		}
-	case "D": // Cursor backward
-		n := parseIntParam(params, 1)
-		ts.CursorCol -= n
-		if ts.CursorCol < 0 {
-			ts.CursorCol = 0
-		}
-	case "H", "f": // Cursor position (row;col)
-		parts := strings.Split(params, ";")
-		row := 1
-		col := 1
-		if len(parts) >= 1 && parts[0] != "" {
-			row = parseIntParam(parts[0], 1)
-		}
-		if len(parts) >= 2 && parts[1] != "" {
-			col = parseIntParam(parts[1], 1)
-		}
-		// Convert from 1-based to 0-based
-		ts.CursorRow = row - 1
-		ts.CursorCol = col - 1
-		// Clamp to valid range
-		if ts.CursorRow < 0 {
-			ts.CursorRow = 0
-		}
-		if ts.CursorRow >= ts.Rows {
-			ts.CursorRow = ts.Rows - 1
-		}
-		if ts.CursorCol < 0 {
-			ts.CursorCol = 0
-		}
-		if ts.CursorCol >= ts.Cols {
-			ts.CursorCol = ts.Cols - 1
-		}
-	case "J": // Erase in display
-		n := parseIntParam(params, 0)
-		switch n {
-		case 0: // Clear from cursor to end of screen
-			ts.clearFromCursor(true)
-		case 1: // Clear from beginning to cursor
-			ts.clearToCursor(true)
-		case 2, 3: // Clear entire screen
-			ts.clearScreen()
-		}
-	case "K": // Erase in line
-		n := parseIntParam(params, 0)
-		switch n {
-		case 0: // Clear from cursor to end of line
-			ts.clearFromCursor(false)
-		case 1: // Clear from beginning of line to cursor
-			ts.clearToCursor(false)
-		case 2: // Clear entire line
-			ts.clearLine(ts.CursorRow)
-		}
-	case "m": // SGR (Select Graphic Rendition) - text styling
-		ts.handleSGR(params)
-	case "L": // Insert lines
-		n := parseIntParam(params, 1)
-		ts.insertLines(n)
-	case "M": // Delete lines
-		n := parseIntParam(params, 1)
-		ts.deleteLines(n)
-	case "h": // Set mode
-		if params == "?25" {
-			ts.CursorVisible = true
-		}
-	case "l": // Reset mode
-		if params == "?25" {
-			ts.CursorVisible = false
-		}
-	case "g": // Clear tab stops
-		n := parseIntParam(params, 0)
-		switch n {
-		case 0: // Clear tab stop at current column
-			delete(ts.TabStops, ts.CursorCol)
-		case 3: // Clear all tab stops
-			ts.TabStops = make(map[int]bool)
-		}
-	}
+// scrollRegionUp moves rows top..bottom up n lines, blanking the bottom n.
+// Lines leaving the top of the main screen are kept as scrollback.
+func (ts *TerminalState) scrollRegionUp(top, bottom, n int) {
+	ts.shiftRowsUp(top, bottom, n, top == 0 && !ts.AltScreen)
 }
 
-// handleSimpleEscape handles simple escape sequences
-func (ts *TerminalState) handleSimpleEscape(command string) {
-	switch command {
-	case "7": // Save cursor position
-		ts.SavedCursorRow = ts.CursorRow
-		ts.SavedCursorCol = ts.CursorCol
-	case "8": // Restore cursor position
-		ts.CursorRow = ts.SavedCursorRow
-		ts.CursorCol = ts.SavedCursorCol
-		// Clamp to valid range in case of resize
-		if ts.CursorRow >= ts.Rows {
-			ts.CursorRow = ts.Rows - 1
-		}
-		if ts.CursorCol >= ts.Cols {
-			ts.CursorCol = ts.Cols - 1
-		}
-		if ts.CursorRow < 0 {
-			ts.CursorRow = 0
-		}
-		if ts.CursorCol < 0 {
-			ts.CursorCol = 0
-		}
-	case "D": // Line feed
-		ts.CursorRow++
-		if ts.CursorRow >= ts.Rows {
-			ts.scrollUp()
-			ts.CursorRow = ts.Rows - 1
-		}
-	case "E": // Next line (CR + LF)
-		ts.CursorCol = 0
-		ts.CursorRow++
-		if ts.CursorRow >= ts.Rows {
-			ts.scrollUp()
-			ts.CursorRow = ts.Rows - 1
-		}
-	case "H": // Set tab stop
-		ts.TabStops[ts.CursorCol] = true
-	case "M": // Reverse line feed
-		ts.CursorRow--
-		if ts.CursorRow < 0 {
-			ts.scrollDown()
-			ts.CursorRow = 0
+// shiftRowsUp moves rows top..bottom up n lines, blanking the bottom n, and
+// keeps the rows shifted out as scrollback when save is set.
+func (ts *TerminalState) shiftRowsUp(top, bottom, n int, save bool) {
+	if top < 0 || bottom >= ts.Rows || top > bottom || n <= 0 {
+		return
+	}
+	if n > bottom-top+1 {
+		n = bottom - top + 1
+	}
+	if save {
+		for i := top; i < top+n; i++ {
+			ts.pushScrollback(ts.Grid[i], ts.wrapped[i])
 		}
 	}
+	copy(ts.Grid[top:bottom+1], ts.Grid[top+n:bottom+1])
+	copy(ts.wrapped[top:bottom+1], ts.wrapped[top+n:bottom+1])
+	for i := bottom - n + 1; i <= bottom; i++ {
+		ts.Grid[i] = blankRow(ts.Cols, ts.CurrentStyle)
+		ts.wrapped[i] = false
+	}
 }
 
-// handleSGR handles SGR (Select Graphic Rendition) escape sequences
-func (ts *TerminalState) handleSGR(params string) {
-	if params == "" || params == "0" {
-		// Reset all attributes
-		ts.CurrentStyle = DefaultStyle()
+// scrollRegionDown moves rows top..bottom down n lines, blanking the top n.
+func (ts *TerminalState) scrollRegionDown(top, bottom, n int) {
+	if top < 0 || bottom >= ts.Rows || top > bottom || n <= 0 {
 		return
 	}
-
-	parts := strings.Split(params, ";")
-	for i := 0; i < len(parts); i++ {
-		code := parseIntParam(parts[i], 0)
-		switch code {
-		case 0: // Reset
-			ts.CurrentStyle = DefaultStyle()
-		case 1: // Bold
-			ts.CurrentStyle.Bold = true
-		case 3: // Italic
-			ts.CurrentStyle.Italic = true
-		case 4: // Underline
-			ts.CurrentStyle.Underline = true
-		case 7: // Reverse
-			ts.CurrentStyle.Reverse = true
-		case 22: // Normal intensity (not bold)
-			ts.CurrentStyle.Bold = false
-		case 23: // Not italic
-			ts.CurrentStyle.Italic = false
-		case 24: // Not underlined
-			ts.CurrentStyle.Underline = false
-		case 27: // Not reversed
-			ts.CurrentStyle.Reverse = false
-		case 30, 31, 32, 33, 34, 35, 36, 37: // Foreground colors
-			ts.CurrentStyle.FgColor = fmt.Sprintf("color%d", code-30)
-		case 39: // Default foreground color
-			ts.CurrentStyle.FgColor = ""
-		case 40, 41, 42, 43, 44, 45, 46, 47: // Background colors
-			ts.CurrentStyle.BgColor = fmt.Sprintf("color%d", code-40)
-		case 49: // Default background color
-			ts.CurrentStyle.BgColor = ""
-		case 90, 91, 92, 93, 94, 95, 96, 97: // Bright foreground colors
-			ts.CurrentStyle.FgColor = fmt.Sprintf("bright-color%d", code-90)
-		case 100, 101, 102, 103, 104, 105, 106, 107: // Bright background colors
-			ts.CurrentStyle.BgColor = fmt.Sprintf("bright-color%d", code-100)
-		case 38, 48: // Extended colors (38 = fg, 48 = bg)
-			// Format: 38;5;n (256 colors) or 38;2;r;g;b (RGB)
-			if i+2 < len(parts) {
-				if parts[i+1] == "5" && i+2 < len(parts) {
-					// 256 color mode
-					colorIdx := parseIntParam(parts[i+2], 0)
-					if code == 38 {
-						ts.CurrentStyle.FgColor = fmt.Sprintf("color-%d", colorIdx)
-					} else {
-						ts.CurrentStyle.BgColor = fmt.Sprintf("color-%d", colorIdx)
-					}
-					i += 2
-				} else if parts[i+1] == "2" && i+4 < len(parts) {
-					// RGB mode
-					r := parseIntParam(parts[i+2], 0)
-					g := parseIntParam(parts[i+3], 0)
-					b := parseIntParam(parts[i+4], 0)
-					if code == 38 {
-						ts.CurrentStyle.FgColor = fmt.Sprintf("rgb(%d,%d,%d)", r, g, b)
-					} else {
-						ts.CurrentStyle.BgColor = fmt.Sprintf("rgb(%d,%d,%d)", r, g, b)
-					}
-					i += 4
-				}
-			}
-		}
+	if n > bottom-top+1 {
+		n = bottom - top + 1
+	}
+	copy(ts.Grid[top+n:bottom+1], ts.Grid[top:bottom+1-n])
+	copy(ts.wrapped[top+n:bottom+1], ts.wrapped[top:bottom+1-n])
+	for i := top; i < top+n; i++ {
+		ts.Grid[i] = blankRow(ts.Cols, ts.CurrentStyle)
+		ts.wrapped[i] = false
 	}
 }
 
-// Utility functions for grid manipulation
-
-func (ts *TerminalState) scrollUp() {
-	// Remove first line and add empty line at bottom
-	copy(ts.Grid[0:], ts.Grid[1:])
-	ts.Grid[ts.Rows-1] = make([]Cell, ts.Cols)
-	for j := 0; j < ts.Cols; j++ {
-		ts.Grid[ts.Rows-1][j] = Cell{
-			Char:  ' ',
-			Style: DefaultStyle(),
-		}
+// pushScrollback records a row leaving the top of the main screen.
+func (ts *TerminalState) pushScrollback(row []Cell, wrapped bool) {
+	if ts.scrollbackLimit <= 0 {
+		return
 	}
+	ts.scrollback = append(ts.scrollback, scrollbackLine{cells: row, wrapped: wrapped})
+	ts.trimScrollback(false)
 }
 
-func (ts *TerminalState) scrollDown() {
-	// Insert empty line at top, remove last line
-	copy(ts.Grid[1:], ts.Grid[0:ts.Rows-1])
-	ts.Grid[0] = make([]Cell, ts.Cols)
-	for j := 0; j < ts.Cols; j++ {
-		ts.Grid[0][j] = Cell{
-			Char:  ' ',
-			Style: DefaultStyle(),
-		}
+// trimScrollback drops the oldest lines beyond the limit. Unless force is
+// set it lets the slice run a quarter over the limit first, so trimming
+// costs amortized O(1) per line.
+func (ts *TerminalState) trimScrollback(force bool) {
+	limit := ts.scrollbackLimit
+	slack := limit / 4
+	if force {
+		slack = 0
 	}
+	if len(ts.scrollback) <= limit+slack {
+		return
+	}
+	kept := make([]scrollbackLine, limit, limit+limit/4+1)
+	copy(kept, ts.scrollback[len(ts.scrollback)-limit:])
+	ts.scrollback = kept
 }
 
 func (ts *TerminalState) clearScreen() {
@@ -463,29 +305,49 @@ func (ts *TerminalState) clearScreen() {
 
 func (ts *TerminalState) clearLine(row int) {
 	if row >= 0 && row < ts.Rows {
-		for j := 0; j < ts.Cols; j++ {
-			ts.Grid[row][j] = Cell{
-				Char:  ' ',
-				Style: DefaultStyle(),
-			}
-		}
+		ts.eraseCells(row, 0, ts.Cols)
+		ts.wrapped[row] = false
+	}
+}
+
+// eraseCells blanks columns [from, to) of row. Erasing half of a wide
+// character blanks the other half too.
+func (ts *TerminalState) eraseCells(row, from, to int) {
+	if row < 0 || row >= ts.Rows {
+		return
+	}
+	if from < 0 {
+		from = 0
+	}
+	if to > ts.Cols {
+		to = ts.Cols
+	}
+	if from >= to {
+		return
+	}
+	line := ts.Grid[row]
+	if line[from].Spacer && from > 0 {
+		from--
+	}
+	if to < ts.Cols && line[to].Spacer {
+		to++
+	}
+	blank := ts.blankCell()
+	for j := from; j < to; j++ {
+		line[j] = blank
 	}
 }
 
 func (ts *TerminalState) clearFromCursor(toEnd bool) {
+	ts.eraseCells(ts.CursorRow, ts.CursorCol, ts.Cols)
+	if ts.CursorRow < ts.Rows {
+		ts.wrapped[ts.CursorRow] = false
+	}
 	if toEnd {
-		// Clear from cursor to end of screen
-		for j := ts.CursorCol; j < ts.Cols; j++ {
-			ts.Grid[ts.CursorRow][j] = Cell{Char: ' ', Style: DefaultStyle()}
-		}
+		// Clear the rest of the screen
 		for i := ts.CursorRow + 1; i < ts.Rows; i++ {
 			ts.clearLine(i)
 		}
-	} else {
-		// Clear from cursor to end of line
-		for j := ts.CursorCol; j < ts.Cols; j++ {
-			ts.Grid[ts.CursorRow][j] = Cell{Char: ' ', Style: DefaultStyle()}
-		}
 	}
 }
 
@@ -495,85 +357,119 @@ func (ts *TerminalState) clearToCursor(fromStart bool) {
 		for i := 0; i < ts.CursorRow; i++ {
 			ts.clearLine(i)
 		}
-		for j := 0; j <= ts.CursorCol && j < ts.Cols; j++ {
-			ts.Grid[ts.CursorRow][j] = Cell{Char: ' ', Style: DefaultStyle()}
-		}
-	} else {
-		// Clear from start of line to cursor
-		for j := 0; j <= ts.CursorCol && j < ts.Cols; j++ {
-			ts.Grid[ts.CursorRow][j] = Cell{Char: ' ', Style: DefaultStyle()}
-		}
 	}
+	ts.eraseCells(ts.CursorRow, 0, ts.CursorCol+1)
 }
 
+// insertLines inserts n blank lines at the cursor row, pushing the rows
+// below it down within the scroll region.
 func (ts *TerminalState) insertLines(n int) {
-	// Insert n blank lines at cursor position
-	if ts.CursorRow+n >= ts.Rows {
-		// Inserting more lines than available, just clear from cursor to end
-		for i := ts.CursorRow; i < ts.Rows; i++ {
-			ts.clearLine(i)
-		}
+	if ts.CursorRow < ts.ScrollTop || ts.CursorRow > ts.ScrollBottom {
 		return
 	}
-
-	// Shift lines down
-	copy(ts.Grid[ts.CursorRow+n:], ts.Grid[ts.CursorRow:ts.Rows-n])
-
-	// Clear inserted lines
-	for i := 0; i < n; i++ {
-		ts.Grid[ts.CursorRow+i] = make([]Cell, ts.Cols)
-		for j := 0; j < ts.Cols; j++ {
-			ts.Grid[ts.CursorRow+i][j] = Cell{Char: ' ', Style: DefaultStyle()}
-		}
-	}
+	ts.scrollRegionDown(ts.CursorRow, ts.ScrollBottom, n)
+	ts.CursorCol = 0
+	ts.wrapPending = false
 }
 
+// deleteLines deletes n lines at the cursor row, pulling the rows below it
+// up within the scroll region.
 func (ts *TerminalState) deleteLines(n int) {
-	// Delete n lines starting at cursor position
-	if ts.CursorRow+n >= ts.Rows {
-		// Deleting more lines than available, just clear from cursor to end
-		for i := ts.CursorRow; i < ts.Rows; i++ {
-			ts.clearLine(i)
-		}
+	if ts.CursorRow < ts.ScrollTop || ts.CursorRow > ts.ScrollBottom {
 		return
 	}
+	// Deleted lines are gone, not scrollback.
+	ts.shiftRowsUp(ts.CursorRow, ts.ScrollBottom, n, false)
+	ts.CursorCol = 0
+	ts.wrapPending = false
+}
 
-	// Shift lines up
-	copy(ts.Grid[ts.CursorRow:], ts.Grid[ts.CursorRow+n:])
+// insertChars shifts the cells from the cursor right by n, blanking the gap.
+func (ts *TerminalState) insertChars(n int) {
+	row := ts.Grid[ts.CursorRow]
+	col := ts.CursorCol
+	if n > ts.Cols-col {
+		n = ts.Cols - col
+	}
+	if n <= 0 {
+		return
+	}
+	if row[col].Spacer {
+		ts.eraseCells(ts.CursorRow, col-1, col+1)
+	}
+	copy(row[col+n:], row[col:ts.Cols-n])
+	blank := ts.blankCell()
+	for j := col; j < col+n; j++ {
+		row[j] = blank
+	}
+	// A wide character pushed half off the right edge is lost.
+	if isWideLead(row, ts.Cols-1) {
+		row[ts.Cols-1] = blank
+	}
+}
 
-	// Clear bottom lines
-	for i := ts.Rows - n; i < ts.Rows; i++ {
-		ts.clearLine(i)
+// deleteChars removes n cells at the cursor, pulling the rest of the line left.
+func (ts *TerminalState) deleteChars(n int) {
+	row := ts.Grid[ts.CursorRow]
+	col := ts.CursorCol
+	if n > ts.Cols-col {
+		n = ts.Cols - col
+	}
+	if n <= 0 {
+		return
+	}
+	if row[col].Spacer {
+		ts.eraseCells(ts.CursorRow, col-1, col+1)
+	}
+	copy(row[col:], row[col+n:])
+	blank := ts.blankCell()
+	for j := ts.Cols - n; j < ts.Cols; j++ {
+		row[j] = blank
+	}
+	if row[col].Spacer {
+		row[col] = blank
 	}
 }
 
-// Resize resizes the terminal state
+// isWideLead reports whether row[col] is the first half of a wide
+// character whose second half is missing.
+func isWideLead(row []Cell, col int) bool {
+	return col == len(row)-1 && col > 0 && !row[col].Spacer && runeIsWide(row[col].Char)
+}
+
+// Resize resizes the terminal state. Shrinking the height keeps the cursor
+// row on screen by moving the rows above it into scrollback, as xterm does.
 func (ts *TerminalState) Resize(rows, cols int) {
 	ts.mu.Lock()
 	defer ts.mu.Unlock()
 
+	if rows < 1 {
+		rows = 1
+	}
+	if cols < 1 {
+		cols = 1
+	}
 	if rows == ts.Rows && cols == ts.Cols {
 		return
 	}
 
-	// Create new grid
-	newGrid := make([][]Cell, rows)
-	for i := 0; i < rows; i++ {
-		newGrid[i] = make([]Cell, cols)
-		for j := 0; j < cols; j++ {
-			if i < ts.Rows && j < ts.Cols {
-				// Copy existing content
-				newGrid[i][j] = ts.Grid[i][j]
-			} else {
-				// Fill with empty cells
-				newGrid[i][j] = Cell{
-					Char:  ' ',
-					Style: DefaultStyle(),
-				}
-			}
+	// Rows that fall off the top go to scrollback (main screen only).
+	shift := 0
+	if ts.CursorRow >= rows {
+		shift = ts.CursorRow - rows + 1
+	}
+	if !ts.AltScreen {
+		for i := 0; i < shift; i++ {
+			ts.pushScrollback(ts.Grid[i], ts.wrapped[i])
 		}
 	}
 
+	ts.Grid, ts.wrapped = resizeGrid(ts.Grid[shift:], ts.wrapped[shift:], rows, cols)
+	if ts.mainGrid != nil {
+		ts.mainGrid, ts.mainWrapped = resizeGrid(ts.mainGrid, ts.mainWrapped, rows, cols)
+	}
+	ts.CursorRow -= shift
+
 	// Add default tab stops for new columns if terminal grew
 	if cols > ts.Cols {
 		startCol := ts.Cols
@@ -585,9 +481,11 @@ func (ts *TerminalState) Resize(rows, cols int) {
 		}
 	}
 
-	ts.Grid = newGrid
 	ts.Rows = rows
 	ts.Cols = cols
+	ts.ScrollTop = 0
+	ts.ScrollBottom = rows - 1
+	ts.wrapPending = false
 
 	// Clamp cursor position
 	if ts.CursorRow >= rows {
@@ -600,6 +498,24 @@ func (ts *TerminalState) Resize(rows, cols int) {
 	ts.Version++
 }
 
+// resizeGrid copies grid into a rows x cols grid, truncating or padding with
+// blank cells.
+func resizeGrid(grid [][]Cell, wrapped []bool, rows, cols int) ([][]Cell, []bool) {
+	newGrid := make([][]Cell, rows)
+	newWrapped := make([]bool, rows)
+	for i := 0; i < rows; i++ {
+		newGrid[i] = blankRow(cols, DefaultStyle())
+		if i < len(grid) {
+			copy(newGrid[i], grid[i])
+			newWrapped[i] = wrapped[i] && len(grid[i]) <= cols
+			if isWideLead(newGrid[i], cols-1) && len(grid[i]) > cols {
+				newGrid[i][cols-1] = Cell{Char: ' ', Style: DefaultStyle()}
+			}
+		}
+	}
+	return newGrid, newWrapped
+}
+
 // GenerateDelta generates a delta from another state to this state
 func (ts *TerminalState) GenerateDelta(fromState *TerminalState) *sessionv1.TerminalData {
 	ts.mu.RLock()
@@ -704,6 +620,72 @@ func (ts *TerminalState) GenerateState() *sessionv1.TerminalData {
 	}
 }
 
+// ScreenLines returns the visible rows, top to bottom, with trailing spaces
+// trimmed. When styled is set, lines carry SGR sequences for their attributes.
+func (ts *TerminalState) ScreenLines(styled bool) []string {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	lines := make([]string, ts.Rows)
+	for i := 0; i < ts.Rows; i++ {
+		lines[i] = renderCells(ts.Grid[i], styled)
+	}
+	return lines
+}
+
+// HistoryLines returns up to the last n lines of scrollback followed by the
+// screen, like `tmux capture-pane -J`: rows joined where text autowrapped,
+// and blank rows below both the cursor and the last text dropped. n <= 0
+// returns everything.
+func (ts *TerminalState) HistoryLines(n int, styled bool) []string {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	// Last screen row worth reporting.
+	last := ts.CursorRow
+	for i := ts.Rows - 1; i > last; i-- {
+		if !rowBlank(ts.Grid[i]) {
+			last = i
+			break
+		}
+	}
+
+	var lines []string
+	var b strings.Builder
+	add := func(cells []Cell, wrapped bool) {
+		b.WriteString(renderCells(cells, styled))
+		if wrapped {
+			return
+		}
+		lines = append(lines, b.String())
+		b.Reset()
+	}
+	for _, l := range ts.scrollback {
+		add(l.cells, l.wrapped)
+	}
+	for i := 0; i <= last; i++ {
+		add(ts.Grid[i], ts.wrapped[i] && i < last)
+	}
+	if b.Len() > 0 {
+		lines = append(lines, b.String())
+	}
+
+	if n > 0 && len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
+}
+
+// rowBlank reports whether a row shows nothing: spaces on the default background.
+func rowBlank(row []Cell) bool {
+	for _, c := range row {
+		if c.Char != ' ' && !c.Spacer || c.Style.BgColor != "" || c.Style.Reverse {
+			return false
+		}
+	}
+	return true
+}
+
 // linesEqual checks if a line is equal between two states
 func (ts *TerminalState) linesEqual(other *TerminalState, row int) bool {
 	if row >= ts.Rows || row >= other.Rows {
@@ -777,67 +759,97 @@ func (ts *TerminalState) getLineText(row int) string {
 	if row >= ts.Rows {
 		return ""
 	}
+	return renderCells(ts.Grid[row], true)
+}
 
+// renderCells renders a row as text, trimming trailing spaces. When styled
+// is set, attribute changes are written as SGR sequences and the line ends
+// with a reset if any attribute is still on.
+func renderCells(cells []Cell, styled bool) string {
 	var sb strings.Builder
 	currentStyle := DefaultStyle()
 
-	for col := 0; col < ts.Cols; col++ {
-		cell := ts.Grid[row][col]
-
-		// Add style codes if style changed
-		if cell.Style != currentStyle {
-			// Determine if we need a full reset
-			needsReset := false
-
-			if (currentStyle.Bold && !cell.Style.Bold) ||
-				(currentStyle.Italic && !cell.Style.Italic) ||
-				(currentStyle.Underline && !cell.Style.Underline) ||
-				(currentStyle.Reverse && !cell.Style.Reverse) ||
-				(currentStyle.FgColor != "" && cell.Style.FgColor == "") ||
-				(currentStyle.BgColor != "" && cell.Style.BgColor == "") {
-				needsReset = true
-			}
-
-			if needsReset {
-				sb.WriteString("\x1b[0m")
-				currentStyle = DefaultStyle()
-			}
+	// Width of the line without trailing default-styled blanks.
+	end := len(cells)
+	for end > 0 {
+		c := cells[end-1]
+		if c.Spacer || c.Char != ' ' || (styled && c.Style != DefaultStyle()) {
+			break
+		}
+		end--
+	}
 
-			// Apply new style
-			if cell.Style.Bold && !currentStyle.Bold {
-				sb.WriteString("\x1b[1m")
-			}
-			if cell.Style.Italic && !currentStyle.Italic {
-				sb.WriteString("\x1b[3m")
-			}
-			if cell.Style.Underline && !currentStyle.Underline {
-				sb.WriteString("\x1b[4m")
-			}
-			if cell.Style.Reverse && !currentStyle.Reverse {
-				sb.WriteString("\x1b[7m")
-			}
-			if cell.Style.FgColor != currentStyle.FgColor && cell.Style.FgColor != "" {
-				writeColorCode(&sb, cell.Style.FgColor, false)
-			}
-			if cell.Style.BgColor != currentStyle.BgColor && cell.Style.BgColor != "" {
-				writeColorCode(&sb, cell.Style.BgColor, true)
-			}
+	for col := 0; col < end; col++ {
+		cell := cells[col]
+		if cell.Spacer {
+			continue
+		}
 
+		// Add style codes if style changed
+		if styled && cell.Style != currentStyle {
+			writeStyleTransition(&sb, currentStyle, cell.Style)
 			currentStyle = cell.Style
 		}
 
-		sb.WriteRune(cell.Char)
+		if cell.Char == 0 {
+			sb.WriteByte(' ')
+		} else {
+			sb.WriteRune(cell.Char)
+		}
 	}
 
 	// Reset style at end of line
-	if currentStyle.Bold || currentStyle.Italic || currentStyle.Underline ||
-		currentStyle.Reverse || currentStyle.FgColor != "" || currentStyle.BgColor != "" {
+	if currentStyle != DefaultStyle() {
 		sb.WriteString("\x1b[0m")
 	}
 
-	// Trim trailing spaces
-	result := sb.String()
-	return strings.TrimRight(result, " ")
+	return strings.TrimRight(sb.String(), " ")
+}
+
+// writeStyleTransition writes the SGR sequences that change from to to.
+func writeStyleTransition(sb *strings.Builder, from, to CellStyle) {
+	// Determine if we need a full reset
+	if (from.Bold && !to.Bold) ||
+		(from.Dim && !to.Dim) ||
+		(from.Italic && !to.Italic) ||
+		(from.Underline && !to.Underline) ||
+		(from.Reverse && !to.Reverse) ||
+		(from.Hidden && !to.Hidden) ||
+		(from.Strikethrough && !to.Strikethrough) ||
+		(from.FgColor != "" && to.FgColor == "") ||
+		(from.BgColor != "" && to.BgColor == "") {
+		sb.WriteString("\x1b[0m")
+		from = DefaultStyle()
+	}
+
+	// Apply new style
+	if to.Bold && !from.Bold {
+		sb.WriteString("\x1b[1m")
+	}
+	if to.Dim && !from.Dim {
+		sb.WriteString("\x1b[2m")
+	}
+	if to.Italic && !from.Italic {
+		sb.WriteString("\x1b[3m")
+	}
+	if to.Underline && !from.Underline {
+		sb.WriteString("\x1b[4m")
+	}
+	if to.Reverse && !from.Reverse {
+		sb.WriteString("\x1b[7m")
+	}
+	if to.Hidden && !from.Hidden {
+		sb.WriteString("\x1b[8m")
+	}
+	if to.Strikethrough && !from.Strikethrough {
+		sb.WriteString("\x1b[9m")
+	}
+	if to.FgColor != from.FgColor && to.FgColor != "" {
+		writeColorCode(sb, to.FgColor, false)
+	}
+	if to.BgColor != from.BgColor && to.BgColor != "" {
+		writeColorCode(sb, to.BgColor, true)
+	}
 }
 
 // Clone creates a deep copy of the terminal state
@@ -846,32 +858,59 @@ func (ts *TerminalState) Clone() *TerminalState {
 	defer ts.mu.RUnlock()
 
 	clone := &TerminalState{
-		Rows:           ts.Rows,
-		Cols:           ts.Cols,
-		Grid:           make([][]Cell, ts.Rows),
-		CursorRow:      ts.CursorRow,
-		CursorCol:      ts.CursorCol,
-		CursorVisible:  ts.CursorVisible,
-		SavedCursorRow: ts.SavedCursorRow,
-		SavedCursorCol: ts.SavedCursorCol,
-		CurrentStyle:   ts.CurrentStyle,
-		TabStops:       make(map[int]bool, len(ts.TabStops)),
-		Version:        ts.Version,
+		Rows:            ts.Rows,
+		Cols:            ts.Cols,
+		Grid:            cloneGrid(ts.Grid),
+		CursorRow:       ts.CursorRow,
+		CursorCol:       ts.CursorCol,
+		CursorVisible:   ts.CursorVisible,
+		SavedCursorRow:  ts.SavedCursorRow,
+		SavedCursorCol:  ts.SavedCursorCol,
+		CurrentStyle:    ts.CurrentStyle,
+		TabStops:        make(map[int]bool, len(ts.TabStops)),
+		Version:         ts.Version,
+		ScrollTop:       ts.ScrollTop,
+		ScrollBottom:    ts.ScrollBottom,
+		AutoWrap:        ts.AutoWrap,
+		OriginMode:      ts.OriginMode,
+		InsertMode:      ts.InsertMode,
+		NewlineMode:     ts.NewlineMode,
+		AltScreen:       ts.AltScreen,
+		wrapped:         append([]bool(nil), ts.wrapped...),
+		wrapPending:     ts.wrapPending,
+		saved:           ts.saved,
+		charsets:        ts.charsets,
+		charset:         ts.charset,
+		lastChar:        ts.lastChar,
+		scrollbackLimit: ts.scrollbackLimit,
+		parser:          ts.parser.clone(),
 	}
 
 	for k, v := range ts.TabStops {
 		clone.TabStops[k] = v
 	}
-
-	// Deep copy grid
-	for i := 0; i < ts.Rows; i++ {
-		clone.Grid[i] = make([]Cell, ts.Cols)
-		copy(clone.Grid[i], ts.Grid[i])
+	if ts.mainGrid != nil {
+		clone.mainGrid = cloneGrid(ts.mainGrid)
+		clone.mainWrapped = append([]bool(nil), ts.mainWrapped...)
+	}
+	// Scrollback rows are immutable; copying the index is enough.
+	if len(ts.scrollback) > 0 {
+		clone.scrollback = append([]scrollbackLine(nil), ts.scrollback...)
 	}
 
 	return clone
 }
 
+// cloneGrid deep-copies a cell grid.
+func cloneGrid(grid [][]Cell) [][]Cell {
+	out := make([][]Cell, len(grid))
+	for i, row := range grid {
+		out[i] = make([]Cell, len(row))
+		copy(out[i], row)
+	}
+	return out
+}
+
 // Helper function to parse integer parameter from escape sequence
 func parseIntParam(param string, defaultVal int) int {
 	if param == "" {
@@ -884,6 +923,17 @@ func parseIntParam(param string, defaultVal int) int {
 	return val
 }
 
+// ReplayTerminalOutput feeds recorded terminal output through a fresh
+// rows x cols emulator and returns up to the last n lines of the resulting
+// history and screen (see HistoryLines). Recorded output may not have passed
+// through a tty driver, so bare line feeds also return the carriage.
+func ReplayTerminalOutput(data []byte, rows, cols, n int, styled bool) []string {
+	state := NewTerminalState(rows, cols)
+	state.SetScrollbackLimit(max(n, DefaultScreenScrollback))
+	_ = state.ProcessOutput(data)
+	return state.HistoryLines(n, styled)
+}
+
 // CreateFullSyncDeltaFromRawContent creates a full-sync delta from raw tmux content.
 // This is used when sending initial pane content to clients over WebSocket.
 // The raw content is processed into a proper delta to avoid xterm.js parsing errors.
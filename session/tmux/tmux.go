@@ -2016,3 +2016,29 @@ func (t *TmuxSession) GetPanePID() (int32, error) {
 
 	return int32(pid), nil
 }
+
+// PanePiped reports whether the pane's output is currently piped to a
+// command by pipe-pane.
+func (t *TmuxSession) PanePiped() (bool, error) {
+	cmd := t.buildTmuxCommand("display-message", "-p", "-t", t.sanitizedName, "#{pane_pipe}")
+	output, err := t.cmdExec.Output(cmd)
+	if err != nil {
+		return false, fmt.Errorf("failed to get pane pipe state for session '%s': %w", t.sanitizedName, err)
+	}
+	return strings.TrimSpace(string(output)) == "1", nil
+}
+
+// PipePaneOutput pipes everything the pane outputs to shellCommand, closing
+// any pipe already open. An empty shellCommand only closes the open pipe.
+// Unlike control mode this does not attach a client, so it does not affect
+// window sizing.
+func (t *TmuxSession) PipePaneOutput(shellCommand string) error {
+	args := []string{"pipe-pane", "-t", t.sanitizedName}
+	if shellCommand != "" {
+		args = append(args, shellCommand)
+	}
+	if err := t.cmdExec.Run(t.buildTmuxCommand(args...)); err != nil {
+		return fmt.Errorf("failed to pipe pane for session '%s': %w", t.sanitizedName, err)
+	}
+	return nil
+}
@@ -293,6 +293,22 @@ func (tm *TmuxProcessManager) GetPanePID() (int32, error) {
 	return tm.session.GetPanePID()
 }
 
+// PanePiped reports whether the pane's output is piped by pipe-pane.
+func (tm *TmuxProcessManager) PanePiped() (bool, error) {
+	if tm.session == nil {
+		return false, fmt.Errorf("tmux session not initialized")
+	}
+	return tm.session.PanePiped()
+}
+
+// PipePaneOutput pipes the pane's output to shellCommand; empty closes the pipe.
+func (tm *TmuxProcessManager) PipePaneOutput(shellCommand string) error {
+	if tm.session == nil {
+		return fmt.Errorf("tmux session not initialized")
+	}
+	return tm.session.PipePaneOutput(shellCommand)
+}
+
 // SetOnExitCallback registers a callback that fires when the tmux session exits
 // unexpectedly. No-op if no session is initialized.
 func (tm *TmuxProcessManager) SetOnExitCallback(fn func(string)) {
@@ -212,6 +212,15 @@ export const resumeModalPath = style({
   wordBreak: "break-all",
 });
 
+export const resumeModalCheckbox = style({
+  display: "flex",
+  alignItems: "center",
+  gap: "8px",
+  fontSize: "13px",
+  color: vars.color.textSecondary,
+  cursor: "pointer",
+});
+
 export const resumeModalActions = style({
   display: "flex",
   justifyContent: "flex-end",
@@ -14,7 +14,7 @@ import {
   HistoryDetailPanel, HistoryMessagesModal,
 } from "@/components/history";
 import { useHistoryFullTextSearch, SearchResultItem } from "@/lib/hooks/useHistoryFullTextSearch";
-import { useHistoryFilters, GroupingStrategyLabels } from "@/lib/hooks/useHistoryFilters";
+import { useHistoryFilters, GroupingStrategyLabels, agentLabel, agentOf } from "@/lib/hooks/useHistoryFilters";
 import { useHistoryGrouping } from "@/lib/hooks/useHistoryGrouping";
 import { useAnalytics } from "@/lib/contexts/AnalyticsContext";
 import * as styles from "./history.css";
@@ -42,12 +42,13 @@ export default function HistoryBrowserPage() {
   const [messageSearchQuery, setMessageSearchQuery] = useState("");
   const [resumeTarget, setResumeTarget] = useState<ClaudeHistoryEntry | null>(null);
   const [resumeTitle, setResumeTitle] = useState("");
+  const [forkResume, setForkResume] = useState(false);
 
   // Hooks
   const { filterState, setters, derived, actions } = useHistoryFilters(entries);
-  const { searchQuery, selectedModel, dateFilter, sortField, sortOrder, groupingStrategy, searchMode } = filterState;
-  const { setSearchQuery, setSelectedModel, setDateFilter, setSortField, setSortOrder, setGroupingStrategy, setSearchMode } = setters;
-  const { uniqueModels, filteredEntries, hasActiveFilters } = derived;
+  const { searchQuery, selectedModel, selectedAgent, dateFilter, sortField, sortOrder, groupingStrategy, searchMode } = filterState;
+  const { setSearchQuery, setSelectedModel, setSelectedAgent, setDateFilter, setSortField, setSortOrder, setGroupingStrategy, setSearchMode } = setters;
+  const { uniqueModels, uniqueAgents, filteredEntries, hasActiveFilters } = derived;
   const { clearFilters, cycleGroupingStrategy } = actions;
   const fullTextSearch = useHistoryFullTextSearch({ debounceMs: 300, autoSearch: true });
   const { groupedEntries, flatEntries } = useHistoryGrouping(filteredEntries, groupingStrategy);
@@ -166,6 +167,7 @@ export default function HistoryBrowserPage() {
     if (!entry.project) { setError("Cannot resume: No project path recorded for this session"); return; }
     setResumeTarget(entry);
     setResumeTitle(entry.name.substring(0, 60));
+    setForkResume(false);
   }, []);
 
   const handleResumeSession = useCallback(async () => {
@@ -177,11 +179,12 @@ export default function HistoryBrowserPage() {
       track({ name: "history_resume_session", category: "user_action" });
       const response = await clientRef.current.createSession({
         title, path: resumeTarget.project, resumeId: resumeTarget.id, category: "Resumed",
+        resumeProvider: agentOf(resumeTarget), forkResume,
       });
       if (response.session) { setResumeTarget(null); router.push("/"); }
     } catch (err) { setError(`Failed to resume session: ${err}`); }
     finally { setResuming(false); }
-  }, [router, resumeTarget, resumeTitle, track]);
+  }, [router, resumeTarget, resumeTitle, forkResume, track]);
 
   // Keyboard navigation
   useEffect(() => {
@@ -224,7 +227,7 @@ export default function HistoryBrowserPage() {
   return (
     <main id="main-content" className={styles.container}>
       <div className={styles.header}>
-        <h1 className={styles.title}>📚 Claude History Browser</h1>
+        <h1 className={styles.title}>📚 Agent History Browser</h1>
         <div className={styles.groupingIndicator}>
           📊 {GroupingStrategyLabels[groupingStrategy]}
           <span className={styles.shortcutHint}>(Press G to cycle)</span>
@@ -246,11 +249,13 @@ export default function HistoryBrowserPage() {
       )}
 
       <HistoryFilterBar
-        searchQuery={searchQuery} selectedModel={selectedModel} dateFilter={dateFilter}
+        searchQuery={searchQuery} selectedModel={selectedModel} selectedAgent={selectedAgent} dateFilter={dateFilter}
         sortField={sortField} sortOrder={sortOrder} groupingStrategy={groupingStrategy} searchMode={searchMode}
-        setSearchQuery={setSearchQuery} setSelectedModel={setSelectedModel} setDateFilter={setDateFilter}
+        setSearchQuery={setSearchQuery} setSelectedModel={setSelectedModel} setSelectedAgent={setSelectedAgent}
+        setDateFilter={setDateFilter}
         setSortField={setSortField} setSortOrder={setSortOrder} setGroupingStrategy={setGroupingStrategy}
-        setSearchMode={setSearchMode} uniqueModels={uniqueModels} hasActiveFilters={hasActiveFilters}
+        setSearchMode={setSearchMode} uniqueModels={uniqueModels} uniqueAgents={uniqueAgents}
+        hasActiveFilters={hasActiveFilters}
         searching={searching} onSearch={handleSearch} onClearFilters={clearFilters}
         searchInputRef={searchInputRef} fullTextSearch={fullTextSearch}
       />
@@ -263,6 +268,7 @@ export default function HistoryBrowserPage() {
               <HistorySearchResults
                 results={fullTextSearch.results} totalMatches={fullTextSearch.totalMatches}
                 queryTimeMs={fullTextSearch.queryTimeMs} hasMore={fullTextSearch.hasMore}
+                relaxed={fullTextSearch.relaxed}
                 loading={fullTextSearch.loading} error={fullTextSearch.error} query={fullTextSearch.query}
                 onResultClick={handleSearchResultClick} onLoadMore={fullTextSearch.loadMore}
               />
@@ -318,7 +324,7 @@ export default function HistoryBrowserPage() {
           <div className={styles.resumeModal} role="dialog" aria-modal="true" aria-labelledby="resume-modal-title">
             <h2 id="resume-modal-title" className={styles.resumeModalTitle}>Resume Session</h2>
             <p className={styles.resumeModalSubtitle}>
-              This will start a new session continuing the conversation with Claude.
+              This will start a new session continuing the conversation with {agentLabel(agentOf(resumeTarget))}.
             </p>
             <div className={styles.resumeModalField}>
               <label htmlFor="resume-title" className={styles.resumeModalLabel}>Session name</label>
@@ -337,6 +343,14 @@ export default function HistoryBrowserPage() {
               <span className={styles.resumeModalLabel}>Directory</span>
               <code className={styles.resumeModalPath}>{resumeTarget.project}</code>
             </div>
+            <label className={styles.resumeModalCheckbox}>
+              <input
+                type="checkbox"
+                checked={forkResume}
+                onChange={(e) => setForkResume(e.target.checked)}
+              />
+              Fork — continue from a copy and leave the original conversation untouched
+            </label>
             <div className={styles.resumeModalActions}>
               <button onClick={() => setResumeTarget(null)} className="btn btn-secondary">Cancel</button>
               <button
@@ -51,7 +51,7 @@ function ReviewQueueContent() {
   const modalContentRef = useRef<HTMLDivElement>(null);
 
   // Use the global session service context — avoids a competing WebSocket stream
-  const { sessions, runOneShot } = useSessionServiceContext();
+  const { sessions, runOneShot, sendCIFixPrompt } = useSessionServiceContext();
 
   // S3-3: Adapter from RunOneShotResponse to the shape ReviewQueuePanel expects
   const handleRunOneShot = useCallback(
@@ -258,6 +258,7 @@ function ReviewQueueContent() {
           onItemsChange={handleItemsChange}
           onAcknowledged={handleAcknowledged}
           onRunOneShot={handleRunOneShot}
+          onSendCIFix={sendCIFixPrompt}
         />
       </main>
 
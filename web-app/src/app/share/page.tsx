@@ -0,0 +1,66 @@
+"use client";
+
+import { useEffect, useState } from "react";
+import dynamic from "next/dynamic";
+import { getApiBaseUrl } from "@/lib/config";
+import { RedeemedShare, redeemShare } from "@/lib/auth/shares";
+import * as styles from "./share.css";
+
+// xterm.js requires a browser environment.
+const TerminalOutput = dynamic(
+  () => import("@/components/sessions/TerminalOutput").then((mod) => mod.TerminalOutput),
+  { ssr: false }
+);
+
+/**
+ * Share-link viewer. The token arrives in the URL fragment (never sent to the
+ * server in a request line), is exchanged for a cookie scoped to the terminal
+ * stream, and is then removed from the address bar. Read-only access is
+ * enforced by the server; this page only renders the stream.
+ */
+export default function SharePage() {
+  const [share, setShare] = useState<RedeemedShare | null>(null);
+  const [error, setError] = useState("");
+
+  useEffect(() => {
+    const token = new URLSearchParams(window.location.hash.slice(1)).get("token");
+    if (!token) {
+      setError("This share link is incomplete. Ask for a new one.");
+      return;
+    }
+    window.history.replaceState(null, "", window.location.pathname);
+    redeemShare(token)
+      .then(setShare)
+      .catch((e) => setError(e instanceof Error ? e.message : String(e)));
+  }, []);
+
+  if (error) {
+    return (
+      <div className={styles.container}>
+        <p className={styles.message}>{error}</p>
+      </div>
+    );
+  }
+  if (!share) {
+    return (
+      <div className={styles.container}>
+        <p className={styles.message}>Opening shared session…</p>
+      </div>
+    );
+  }
+
+  return (
+    <div className={styles.container}>
+      <div className={styles.bar}>
+        <span className={styles.sessionName}>{share.label || share.session_id}</span>
+        <span className={styles.mode}>{share.allow_input ? "Interactive" : "View only"}</span>
+        <span className={styles.expiry}>
+          Link expires {new Date(share.expires_at).toLocaleString()}
+        </span>
+      </div>
+      <div className={styles.terminal}>
+        <TerminalOutput sessionId={share.session_id} baseUrl={getApiBaseUrl()} />
+      </div>
+    </div>
+  );
+}
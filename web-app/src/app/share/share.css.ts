@@ -0,0 +1,61 @@
+import { style } from "@vanilla-extract/css";
+import { vars } from "@/styles/theme.css";
+
+export const container = style({
+  height: "var(--viewport-height, 100dvh)",
+  display: "flex",
+  flexDirection: "column",
+  background: vars.color.background,
+});
+
+export const bar = style({
+  display: "flex",
+  alignItems: "center",
+  gap: vars.space[3],
+  minHeight: "44px",
+  padding: `0 ${vars.space[3]}`,
+  borderBottom: `1px solid ${vars.color.borderColor}`,
+  background: vars.color.cardBackground,
+  fontFamily: vars.font.mono,
+  fontSize: vars.fontSize.sm,
+  color: vars.color.textSecondary,
+  flexShrink: 0,
+});
+
+export const sessionName = style({
+  color: vars.color.textPrimary,
+  fontWeight: vars.fontWeight.medium,
+  overflow: "hidden",
+  textOverflow: "ellipsis",
+  whiteSpace: "nowrap",
+});
+
+export const mode = style({
+  padding: "0.1rem 0.4rem",
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: vars.radii.sm,
+  fontSize: vars.fontSize.xs,
+});
+
+export const expiry = style({
+  marginLeft: "auto",
+  color: vars.color.textMuted,
+  fontSize: vars.fontSize.xs,
+  whiteSpace: "nowrap",
+});
+
+export const terminal = style({
+  flex: 1,
+  minHeight: 0,
+  display: "flex",
+  flexDirection: "column",
+});
+
+export const message = style({
+  margin: "auto",
+  padding: "2rem",
+  maxWidth: "400px",
+  textAlign: "center",
+  color: vars.color.textSecondary,
+  lineHeight: "1.5",
+});
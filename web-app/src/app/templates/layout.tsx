@@ -0,0 +1,10 @@
+import type { Metadata } from "next";
+
+export const metadata: Metadata = {
+  title: "Session Templates - Stapler Squad",
+  description: "Create sessions from versioned, repository-shareable templates in Stapler Squad.",
+};
+
+export default function TemplatesLayout({ children }: { children: React.ReactNode }) {
+  return <>{children}</>;
+}
@@ -0,0 +1,17 @@
+"use client";
+// +feature: session-templates
+
+import { TemplatesPanel } from "@/components/sessions/TemplatesPanel";
+import { usePageView } from "@/lib/analytics/usePageView";
+import * as styles from "./page.css";
+
+export default function TemplatesPage() {
+  usePageView();
+  return (
+    <div className={styles.page}>
+      <main id="main-content" className={styles.main}>
+        <TemplatesPanel />
+      </main>
+    </div>
+  );
+}
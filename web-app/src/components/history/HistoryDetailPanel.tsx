@@ -4,6 +4,7 @@ import { ClaudeHistoryEntry, ClaudeMessage } from "@/gen/session/v1/session_pb";
 import { VCSType } from "@/gen/session/v1/types_pb";
 import { formatDate } from "@/lib/utils/timestamp";
 import { VcsStatusDisplay } from "@/components/shared/VcsStatusDisplay";
+import { agentLabel, agentOf } from "@/lib/hooks/useHistoryFilters";
 import * as styles from "./HistoryDetailPanel.css";
 
 interface HistoryDetailPanelProps {
@@ -81,6 +82,10 @@ export function HistoryDetailPanel({
               <VcsStatusDisplay status={entry.vcsStatus} />
             </div>
           )}
+          <div className={styles.detailField}>
+            <div className={styles.fieldLabel}>Agent:</div>
+            <div className="text-primary">{agentLabel(agentOf(entry))}</div>
+          </div>
           <div className={styles.detailField}>
             <div className={styles.fieldLabel}>Model:</div>
             <div className="text-primary">{entry.model}</div>
@@ -83,6 +83,15 @@ export const entryModel = style({
   fontWeight: "500",
 });
 
+export const entryAgent = style({
+  fontSize: "11px",
+  fontWeight: "600",
+  padding: "1px 6px",
+  borderRadius: "4px",
+  border: `1px solid ${vars.color.borderColor}`,
+  color: vars.color.primary,
+});
+
 export const entryDivider = style({
   margin: "0 8px",
   color: vars.color.textMuted,
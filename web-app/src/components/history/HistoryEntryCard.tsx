@@ -2,6 +2,7 @@
 
 import { ClaudeHistoryEntry } from "@/gen/session/v1/session_pb";
 import { formatTimeAgo } from "@/lib/utils/timestamp";
+import { agentLabel, agentOf } from "@/lib/hooks/useHistoryFilters";
 import * as styles from "./HistoryEntryCard.css";
 
 interface HistoryEntryCardProps {
@@ -37,7 +38,13 @@ export function HistoryEntryCard({ entry, isSelected, enrichedEntry, onSelect }:
         <div className={styles.entryTime}>{formatTimeAgo(entry.updatedAt)}</div>
       </div>
       <div className={styles.entryMeta}>
-        <span className={styles.entryModel}>{entry.model}</span>
+        <span className={styles.entryAgent}>{agentLabel(agentOf(entry))}</span>
+        {entry.model && (
+          <>
+            <span className={styles.entryDivider}>•</span>
+            <span className={styles.entryModel}>{entry.model}</span>
+          </>
+        )}
         <span className={styles.entryDivider}>•</span>
         <span className={styles.entryMessages}>
           {entry.messageCount} {entry.messageCount === 1 ? "message" : "messages"}
@@ -5,6 +5,7 @@ import { HistorySearchInput } from "@/components/history/HistorySearchInput";
 import {
   GroupingStrategyLabels,
   HistoryGroupingStrategy,
+  agentLabel,
 } from "@/lib/hooks/useHistoryFilters";
 import type {
   SortField,
@@ -23,6 +24,7 @@ interface HistoryFilterBarProps {
   // Filter state
   searchQuery: string;
   selectedModel: string;
+  selectedAgent: string;
   dateFilter: DateFilter;
   sortField: SortField;
   sortOrder: "asc" | "desc";
@@ -32,6 +34,7 @@ interface HistoryFilterBarProps {
   // Setters
   setSearchQuery: (value: string) => void;
   setSelectedModel: (value: string) => void;
+  setSelectedAgent: (value: string) => void;
   setDateFilter: (value: DateFilter) => void;
   setSortField: (value: SortField) => void;
   setSortOrder: (value: "asc" | "desc") => void;
@@ -40,6 +43,7 @@ interface HistoryFilterBarProps {
 
   // Derived
   uniqueModels: string[];
+  uniqueAgents: string[];
   hasActiveFilters: boolean;
 
   // Search
@@ -59,6 +63,7 @@ interface HistoryFilterBarProps {
 export function HistoryFilterBar({
   searchQuery,
   selectedModel,
+  selectedAgent,
   dateFilter,
   sortField,
   sortOrder,
@@ -66,12 +71,14 @@ export function HistoryFilterBar({
   searchMode,
   setSearchQuery,
   setSelectedModel,
+  setSelectedAgent,
   setDateFilter,
   setSortField,
   setSortOrder,
   setGroupingStrategy,
   setSearchMode,
   uniqueModels,
+  uniqueAgents,
   hasActiveFilters,
   searching,
   onSearch,
@@ -149,6 +156,17 @@ export function HistoryFilterBar({
 
       {/* Filters */}
       <ActionBar scroll compact gap="sm" className={styles.filters}>
+        <select
+          value={selectedAgent}
+          onChange={(e) => setSelectedAgent(e.target.value)}
+          className={styles.select}
+        >
+          <option value="all">All Agents</option>
+          {uniqueAgents.map(agent => (
+            <option key={agent} value={agent}>{agentLabel(agent)}</option>
+          ))}
+        </select>
+
         <select
           value={selectedModel}
           onChange={(e) => setSelectedModel(e.target.value)}
@@ -2,6 +2,7 @@
 
 import { useMemo } from "react";
 import type { SearchResultItem, SearchSnippetItem } from "@/lib/hooks/useHistoryFullTextSearch";
+import { agentLabel } from "@/lib/hooks/useHistoryFilters";
 import * as styles from "./HistorySearchResults.css";
 
 interface HistorySearchResultsProps {
@@ -13,6 +14,8 @@ interface HistorySearchResultsProps {
   queryTimeMs: number;
   /** Whether more results are available */
   hasMore: boolean;
+  /** True when results match any query word rather than all of them */
+  relaxed?: boolean;
   /** Loading state */
   loading: boolean;
   /** Error state */
@@ -100,6 +103,7 @@ function SearchResultCard({
     <div className={styles.resultCard} onClick={onClick} role="button" tabIndex={0}>
       <div className={styles.resultHeader}>
         <h3 className={styles.sessionName}>{result.sessionName || result.sessionId}</h3>
+        <span className={styles.modelBadge}>{agentLabel(result.metadata.provider)}</span>
         {result.metadata.model && (
           <span className={styles.modelBadge}>{result.metadata.model}</span>
         )}
@@ -138,6 +142,7 @@ export function HistorySearchResults({
   totalMatches,
   queryTimeMs,
   hasMore,
+  relaxed = false,
   loading,
   error,
   onResultClick,
@@ -156,7 +161,9 @@ export function HistorySearchResults({
           Search across all your Claude conversations
         </p>
         <p className={styles.emptyHint}>
-          Try searching for topics, code snippets, or specific discussions
+          Try searching for topics, code snippets, or specific discussions.
+          Use &quot;exact phrases&quot;, -exclusions, OR, prefix*, and filters like
+          role:assistant, tool:Bash, project:api or after:7d
         </p>
       </div>
     );
@@ -199,7 +206,7 @@ export function HistorySearchResults({
           No results found for &quot;{query}&quot;
         </p>
         <p className={styles.emptyHint}>
-          Try different keywords or check your spelling
+          Try different keywords, fewer filters, or OR between alternatives
         </p>
       </div>
     );
@@ -215,6 +222,11 @@ export function HistorySearchResults({
         <span className={styles.queryTime}>
           ({queryTimeMs}ms)
         </span>
+        {relaxed && (
+          <span className={styles.queryTime}>
+            · no message contains every word; showing messages with any of them
+          </span>
+        )}
       </div>
 
       {/* Results list */}
@@ -17,6 +17,7 @@ import {
   MoreHorizontal,
   User,
   Hand,
+  FileText,
 } from "lucide-react";
 import { AppLink } from "@/components/ui/AppLink";
 import { usePathname } from "next/navigation";
@@ -41,6 +42,7 @@ const moreItems: BottomNavItem[] = [
   { href: routes.history, label: "History", icon: History },
   { href: routes.logs, label: "Logs", icon: ScrollText },
   { href: routes.rules, label: "Rules", icon: BookOpen },
+  { href: routes.templates, label: "Templates", icon: FileText },
   { href: routes.config, label: "Config", icon: SlidersHorizontal },
   { href: routes.settings, label: "Settings", icon: Settings },
 ];
@@ -1,6 +1,7 @@
 "use client";
 
 import { useState, useCallback, useEffect, ReactNode } from "react";
+import { usePathname } from "next/navigation";
 import { DrawerNav } from "./DrawerNav";
 import { BottomNav } from "./BottomNav";
 import { KeyboardShortcutOverlay } from "@/components/ui/KeyboardShortcutOverlay";
@@ -22,6 +23,7 @@ interface CockpitShellProps {
 export function CockpitShell({ children }: CockpitShellProps) {
   const [shortcutsOpen, setShortcutsOpen] = useState(false);
   const { toggleDrawer } = useNavigation();
+  const pathname = usePathname();
 
   const openShortcuts = useCallback(() => setShortcutsOpen(true), []);
   const closeShortcuts = useCallback(() => setShortcutsOpen(false), []);
@@ -58,6 +60,12 @@ export function CockpitShell({ children }: CockpitShellProps) {
     action: openShortcuts,
   });
 
+  // Share-link viewers get the terminal alone, without navigation to pages
+  // their link does not grant access to.
+  if (pathname?.startsWith("/share")) {
+    return <>{children}</>;
+  }
+
   return (
     <>
       <div className={cockpitRoot}>
@@ -5,6 +5,6 @@ import { Header } from "./Header";
 
 export function ConditionalHeader() {
   const pathname = usePathname();
-  if (pathname === "/login" || pathname.startsWith("/test/")) return null;
+  if (pathname === "/login" || pathname.startsWith("/share") || pathname.startsWith("/test/")) return null;
   return <Header />;
 }
@@ -73,6 +73,7 @@ export function ApprovalAnalyticsPanel() {
   const escalateCount  = (summary?.decisionCounts["escalate"] ?? 0)
                        + (summary?.decisionCounts["manual_allow"] ?? 0)
                        + (summary?.decisionCounts["manual_deny"] ?? 0);
+  const sandboxDenyCount = summary?.decisionCounts["sandbox_deny"] ?? 0;
 
   const autoAllowRate = pct(autoAllowCount, total);
   const autoDenyRate  = pct(autoDenyCount, total);
@@ -143,6 +144,13 @@ export function ApprovalAnalyticsPanel() {
           <span className={cardLabel}>Manual review</span>
           <span className={cardSub}>{escalateCount} requests</span>
         </div>
+        {sandboxDenyCount > 0 && (
+          <div className={`${card} ${cardDeny}`}>
+            <span className={cardValue}>{sandboxDenyCount}</span>
+            <span className={cardLabel}>Sandbox-blocked</span>
+            <span className={cardSub}>network requests</span>
+          </div>
+        )}
         <div className={card}>
           <span className={cardValue}>{avgPerDay}</span>
           <span className={cardLabel}>Avg / day</span>
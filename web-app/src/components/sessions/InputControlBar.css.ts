@@ -0,0 +1,109 @@
+import { style } from "@vanilla-extract/css";
+import { vars } from "@/styles/theme.css";
+
+export const bar = style({
+  display: "flex",
+  flexWrap: "wrap",
+  alignItems: "center",
+  gap: vars.space[2],
+  padding: "0.35rem 1rem",
+  background: vars.color.cardBackground,
+  borderBottom: `1px solid ${vars.color.borderColor}`,
+  fontSize: vars.fontSize.xs,
+  color: vars.color.textSecondary,
+  flexShrink: 0,
+});
+
+export const mode = style({
+  display: "flex",
+  alignItems: "center",
+  gap: "0.35rem",
+});
+
+export const select = style({
+  background: vars.color.background,
+  color: vars.color.textPrimary,
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: vars.radii.sm,
+  fontSize: vars.fontSize.xs,
+  padding: "0.15rem 0.3rem",
+});
+
+export const clients = style({
+  display: "flex",
+  flexWrap: "wrap",
+  gap: "0.35rem",
+  listStyle: "none",
+  margin: 0,
+  padding: 0,
+});
+
+export const client = style({
+  display: "inline-flex",
+  alignItems: "center",
+  gap: "0.3rem",
+  padding: "0.1rem 0.45rem",
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: "999px",
+});
+
+export const writer = style({
+  borderColor: vars.color.primary,
+  color: vars.color.textPrimary,
+});
+
+export const dot = style({
+  width: "0.45rem",
+  height: "0.45rem",
+  borderRadius: "50%",
+  background: vars.color.borderColor,
+});
+
+export const dotFocused = style({
+  background: vars.color.success,
+});
+
+export const tag = style({
+  opacity: 0.75,
+  fontStyle: "italic",
+});
+
+export const actions = style({
+  display: "flex",
+  flexWrap: "wrap",
+  alignItems: "center",
+  gap: "0.35rem",
+  marginLeft: "auto",
+});
+
+export const request = style({
+  display: "inline-flex",
+  alignItems: "center",
+  gap: "0.3rem",
+  color: vars.color.textPrimary,
+});
+
+export const button = style({
+  background: "transparent",
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: vars.radii.sm,
+  color: vars.color.textSecondary,
+  fontSize: vars.fontSize.xs,
+  padding: "0.15rem 0.45rem",
+  cursor: "pointer",
+  selectors: {
+    "&:hover:not(:disabled)": {
+      background: vars.color.hoverBackground,
+      color: vars.color.textPrimary,
+    },
+    "&:disabled": {
+      opacity: 0.6,
+      cursor: "default",
+    },
+  },
+});
+
+export const notice = style({
+  width: "100%",
+  color: vars.color.warning,
+});
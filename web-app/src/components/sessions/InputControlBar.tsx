@@ -0,0 +1,91 @@
+"use client";
+
+import type { InputControlState } from "@/gen/session/v1/events_pb";
+import type { InputControlAction, InputMode } from "@/lib/hooks/useTerminalStream";
+import * as styles from "./InputControlBar.css";
+
+const MODE_OPTIONS: { value: InputMode; label: string }[] = [
+  { value: "shared", label: "Shared" },
+  { value: "single_writer", label: "Single writer" },
+  { value: "driver", label: "Driver" },
+];
+
+interface InputControlBarProps {
+  state: InputControlState | null;
+  onAction: (action: InputControlAction, mode?: InputMode, targetClientId?: string) => void;
+}
+
+/**
+ * InputControlBar — shows who may type into a terminal that several clients
+ * are watching, and lets clients hand control over. Only the session owner's
+ * clients switch the input mode or take control back.
+ * Renders nothing while this is the only client connected.
+ */
+export function InputControlBar({ state, onAction }: InputControlBarProps) {
+  if (!state || state.clients.length < 2) return null;
+
+  const me = state.clients.find((c) => c.clientId === state.yourClientId);
+  const isWriter = state.writerClientId !== "" && state.writerClientId === state.yourClientId;
+  const requesting = state.pendingClientIds.includes(state.yourClientId);
+  const pending = state.clients.filter((c) => state.pendingClientIds.includes(c.clientId));
+  const labelFor = (c: { clientId: string; label: string }) =>
+    c.clientId === state.yourClientId ? "you" : c.label || c.clientId.slice(0, 8);
+
+  return (
+    <div className={styles.bar} role="region" aria-label="Terminal input control">
+      <label className={styles.mode}>
+        Input
+        <select
+          className={styles.select}
+          value={state.mode}
+          disabled={!me || me.readOnly || !me.owner}
+          onChange={(e) => onAction("set_mode", e.target.value as InputMode)}
+        >
+          {MODE_OPTIONS.map((o) => (
+            <option key={o.value} value={o.value}>{o.label}</option>
+          ))}
+        </select>
+      </label>
+
+      <ul className={styles.clients}>
+        {state.clients.map((c) => (
+          <li
+            key={c.clientId}
+            className={`${styles.client} ${c.canWrite ? styles.writer : ""}`}
+            title={c.focused ? `Focused, cursor ${c.cursorRow + 1}:${c.cursorCol + 1}` : "Not focused"}
+          >
+            <span className={`${styles.dot} ${c.focused ? styles.dotFocused : ""}`} />
+            {labelFor(c)}
+            {c.canWrite && state.mode !== "shared" && <span className={styles.tag}>typing</span>}
+            {c.readOnly && <span className={styles.tag}>view only</span>}
+            {!c.owner && <span className={styles.tag}>guest</span>}
+            {c.requesting && <span className={styles.tag}>wants control</span>}
+          </li>
+        ))}
+      </ul>
+
+      <div className={styles.actions}>
+        {isWriter && pending.map((c) => (
+          <span key={c.clientId} className={styles.request}>
+            {labelFor(c)} asks for control
+            <button className={styles.button} onClick={() => onAction("grant", undefined, c.clientId)}>Grant</button>
+            <button className={styles.button} onClick={() => onAction("deny", undefined, c.clientId)}>Deny</button>
+          </span>
+        ))}
+        {isWriter && pending.length > 0 && (
+          <button className={styles.button} onClick={() => onAction("release")}>Release</button>
+        )}
+        {state.mode !== "shared" && !state.canWrite && me?.owner && !me.readOnly && (
+          <button className={styles.button} onClick={() => onAction("take")}>Take control</button>
+        )}
+        {state.mode !== "shared" && !state.canWrite && me && !me.readOnly && !me.owner && (
+          <button className={styles.button} disabled={requesting} onClick={() => onAction("request")}>
+            {requesting ? "Requested" : "Request control"}
+          </button>
+        )}
+      </div>
+
+      {state.notice && <span className={styles.notice} role="status">{state.notice}</span>}
+    </div>
+  );
+}
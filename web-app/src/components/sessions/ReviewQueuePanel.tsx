@@ -70,6 +70,7 @@ interface ReviewQueuePanelProps {
   onItemsChange?: (items: ReviewItem[]) => void; // Callback to expose queue items for navigation
   onAcknowledged?: (sessionId: string) => void; // Notifies parent when a session is acknowledged (for auto-advance)
   onRunOneShot?: (sessionId: string, prompt: string) => Promise<{ prUrl?: string; error?: string } | null>; // S3-3
+  onSendCIFix?: (sessionId: string) => Promise<boolean>; // Sends the failing CI log excerpts to the agent
 }
 
 /**
@@ -99,6 +100,7 @@ export function ReviewQueuePanel({
   onItemsChange,
   onAcknowledged,
   onRunOneShot,
+  onSendCIFix,
 }: ReviewQueuePanelProps) {
   // S3-3: PR creation modal state
   const [prModal, setPrModal] = useState<{ sessionId: string; prompt: string } | null>(null);
@@ -304,6 +306,10 @@ export function ReviewQueuePanel({
         return "Complete";
       case AttentionReason.STALE:
         return "Stale";
+      case AttentionReason.CI_FAILING:
+        return "CI";
+      case AttentionReason.RESOURCE_LIMIT:
+        return "Resources";
       default:
         return "All";
     }
@@ -325,6 +331,8 @@ export function ReviewQueuePanel({
       [AttentionReason.APPROVAL_PENDING, "approval"],
       [AttentionReason.INPUT_REQUIRED, "input needed"],
       [AttentionReason.ERROR_STATE, "error"],
+      [AttentionReason.CI_FAILING, "CI failure"],
+      [AttentionReason.RESOURCE_LIMIT, "resource limit"],
       [AttentionReason.IDLE_TIMEOUT, "timed out"],
       [AttentionReason.IDLE, "idle"],
       [AttentionReason.STALE, "stale"],
@@ -481,6 +489,8 @@ export function ReviewQueuePanel({
                 AttentionReason.APPROVAL_PENDING,
                 AttentionReason.INPUT_REQUIRED,
                 AttentionReason.ERROR_STATE,
+                AttentionReason.CI_FAILING,
+                AttentionReason.RESOURCE_LIMIT,
                 AttentionReason.IDLE_TIMEOUT,
                 AttentionReason.IDLE,
                 AttentionReason.STALE,
@@ -686,6 +696,24 @@ export function ReviewQueuePanel({
                       ⏭ Skip
                     </Button>
                   )}
+                  {/* CI_FAILING items can hand the failing job logs to the agent on demand */}
+                  {queueItem.reason === AttentionReason.CI_FAILING && onSendCIFix && (
+                    <Button
+                      intent="primary"
+                      size="md"
+                      onClick={(e) => {
+                        e.stopPropagation();
+                        onSendCIFix(queueItem.sessionId).then((sent) => {
+                          if (sent) onAcknowledged?.(queueItem.sessionId);
+                        });
+                      }}
+                      title="Send the failing CI log excerpts to the agent"
+                      aria-label="Send CI log to agent"
+                      data-testid={`send-ci-fix-${queueItem.sessionId}`}
+                    >
+                      🔧 Fix CI
+                    </Button>
+                  )}
                   {/* S3-3: Create PR button — only for TASK_COMPLETE items without an existing PR URL */}
                   {queueItem.reason === AttentionReason.TASK_COMPLETE &&
                     !queueItem.githubPrUrl &&
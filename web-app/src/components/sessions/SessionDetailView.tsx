@@ -10,6 +10,7 @@ import { VcsPanel } from "./VcsPanel";
 import { WorkspaceSwitchModal } from "./WorkspaceSwitchModal";
 import { SessionLogsTab } from "./SessionLogsTab";
 import { FilesTab } from "./FilesTab";
+import { SessionResourceUsage } from "./SessionResourceUsage";
 import { ActionBar } from "@/components/ui/ActionBar";
 import { useSessionActions } from "@/lib/hooks/useSessionActions";
 import { getApiBaseUrl } from "@/lib/config";
@@ -17,6 +18,7 @@ import { getProgramDisplay, isKnownProgram, PROGRAMS } from "@/lib/constants/pro
 import { Modal, ModalContent, ModalTitle, ModalFooter } from "@/components/ui/Modal";
 import { ResumeSessionModal } from "./ResumeSessionModal";
 import { TagEditor } from "./TagEditor";
+import { ShareSessionButton } from "./ShareSessionButton";
 import { BacklogItemPanel } from "@/components/backlog/BacklogItemPanel";
 import * as styles from "./SessionDetail.css";
 import { diffAdded } from "./SessionDetailView.css";
@@ -376,6 +378,8 @@ export function SessionDetailView({
               ⏭
             </button>
           )}
+          {/* Share links — shows live viewer count */}
+          <ShareSessionButton sessionId={session.id} />
           {/* Switch workspace — less frequent */}
           {session.instanceType !== InstanceType.EXTERNAL && (
             <button
@@ -850,6 +854,29 @@ export function SessionDetailView({
                   </span>
                 </div>
               )}
+              {/* Resource usage (cgroup v2) */}
+              <SessionResourceUsage sessionId={session.id} initial={session.resourceUsage} />
+              {/* Sandbox (bubblewrap) */}
+              {session.sandbox && (
+                <div className={styles.infoItem}>
+                  <span className={styles.infoLabel}>Sandbox:</span>
+                  <span
+                    className={styles.infoValue}
+                    title={`Writable: ${session.sandbox.writablePaths.join(", ")}`}
+                  >
+                    {session.sandbox.networkMode === "none"
+                      ? "no network"
+                      : session.sandbox.networkMode === "allowlist"
+                        ? `network limited to ${session.sandbox.allowedHosts.join(", ")}`
+                        : "host network"}
+                    {session.sandbox.deniedCount > 0 && (
+                      <span style={{ color: "var(--color-error, #ef4444)" }}>
+                        {` · ${session.sandbox.deniedCount} request(s) blocked`}
+                      </span>
+                    )}
+                  </span>
+                </div>
+              )}
               {/* GitHub PR */}
               {session.githubPrUrl && (
                 <div className={styles.infoItem}>
@@ -0,0 +1,99 @@
+"use client";
+
+import { useEffect, useState } from "react";
+import { createClient } from "@connectrpc/connect";
+import { createConnectTransport } from "@connectrpc/connect-web";
+import { SessionService } from "@/gen/session/v1/session_pb";
+import type { ResourceUsage } from "@/gen/session/v1/types_pb";
+import { getApiBaseUrl } from "@/lib/config";
+import * as styles from "./SessionDetail.css";
+
+/** Refresh interval; matches the server's default cgroup sampling interval. */
+const POLL_INTERVAL_MS = 5000;
+
+interface SessionResourceUsageProps {
+  sessionId: string;
+  /** Usage from the session list; used until the first refresh completes. */
+  initial?: ResourceUsage;
+}
+
+function formatBytes(bytes: bigint): string {
+  const n = Number(bytes);
+  if (n >= 1 << 30) return `${(n / (1 << 30)).toFixed(1)} GiB`;
+  if (n >= 1 << 20) return `${(n / (1 << 20)).toFixed(0)} MiB`;
+  if (n >= 1 << 10) return `${(n / (1 << 10)).toFixed(0)} KiB`;
+  return `${n} B`;
+}
+
+/**
+ * SessionResourceUsage shows live cgroup accounting (CPU, memory, IO, PIDs)
+ * for a session as Info-tab rows. Renders nothing when the server has no
+ * cgroup data for the session.
+ */
+export function SessionResourceUsage({ sessionId, initial }: SessionResourceUsageProps) {
+  const [usage, setUsage] = useState<ResourceUsage | undefined>(initial);
+
+  useEffect(() => {
+    const client = createClient(SessionService, createConnectTransport({ baseUrl: getApiBaseUrl() }));
+    let cancelled = false;
+    const refresh = async () => {
+      try {
+        const response = await client.getSession({ id: sessionId });
+        if (!cancelled) setUsage(response.session?.resourceUsage);
+      } catch {
+        // Keep the last sample; the session list reports connection errors.
+      }
+    };
+    refresh();
+    const timer = setInterval(refresh, POLL_INTERVAL_MS);
+    return () => {
+      cancelled = true;
+      clearInterval(timer);
+    };
+  }, [sessionId]);
+
+  if (!usage) return null;
+
+  const withLimit = (value: string, limit: string | null) => (limit ? `${value} / ${limit}` : value);
+  const warn = usage.oomKills > 0 || usage.limitEvents > 0;
+
+  return (
+    <>
+      <div className={styles.infoItem}>
+        <span className={styles.infoLabel}>CPU:</span>
+        <span className={styles.infoValue}>
+          {withLimit(`${usage.cpuPercent.toFixed(0)}%`, usage.cpuLimitPercent > 0 ? `${usage.cpuLimitPercent}%` : null)}
+        </span>
+      </div>
+      <div className={styles.infoItem}>
+        <span className={styles.infoLabel}>Memory:</span>
+        <span className={styles.infoValue}>
+          {withLimit(formatBytes(usage.memoryBytes), usage.memoryLimitBytes > 0 ? formatBytes(usage.memoryLimitBytes) : null)}
+          {usage.memoryPeakBytes > 0 && ` (peak ${formatBytes(usage.memoryPeakBytes)})`}
+        </span>
+      </div>
+      <div className={styles.infoItem}>
+        <span className={styles.infoLabel}>Disk IO:</span>
+        <span className={styles.infoValue}>
+          {formatBytes(usage.ioReadBytes)} read · {formatBytes(usage.ioWriteBytes)} written
+        </span>
+      </div>
+      <div className={styles.infoItem}>
+        <span className={styles.infoLabel}>Processes:</span>
+        <span className={styles.infoValue}>
+          {withLimit(String(usage.pids), usage.pidsLimit > 0 ? String(usage.pidsLimit) : null)}
+        </span>
+      </div>
+      {warn && (
+        <div className={styles.infoItem}>
+          <span className={styles.infoLabel}>Limit Events:</span>
+          <span className={styles.infoValue} style={{ color: "var(--color-error, #ef4444)" }}>
+            {usage.oomKills > 0 && `${usage.oomKills} OOM kill(s)`}
+            {usage.oomKills > 0 && usage.limitEvents > 0 && " · "}
+            {usage.limitEvents > 0 && `${usage.limitEvents} limit hit(s)`}
+          </span>
+        </div>
+      )}
+    </>
+  );
+}
@@ -0,0 +1,132 @@
+import { style } from "@vanilla-extract/css";
+import { vars } from "@/styles/theme.css";
+
+export const shareButton = style({
+  display: "flex",
+  alignItems: "center",
+  gap: "0.25rem",
+  background: "transparent",
+  border: `1px solid ${vars.color.borderColor}`,
+  fontSize: vars.fontSize.xs,
+  fontWeight: 500,
+  cursor: "pointer",
+  color: vars.color.textSecondary,
+  padding: "0.25rem 0.5rem",
+  lineHeight: 1,
+  borderRadius: vars.radii.sm,
+  selectors: {
+    "&:hover": {
+      background: vars.color.hoverBackground,
+      color: vars.color.textPrimary,
+    },
+  },
+});
+
+export const viewerBadge = style({
+  display: "inline-flex",
+  alignItems: "center",
+  justifyContent: "center",
+  minWidth: "1.25rem",
+  padding: "0 0.3rem",
+  borderRadius: "999px",
+  background: vars.color.primary,
+  color: vars.color.primaryText,
+  fontSize: vars.fontSize.xs,
+});
+
+export const form = style({
+  display: "flex",
+  flexDirection: "column",
+  gap: vars.space[2],
+  marginBottom: vars.space[3],
+});
+
+export const row = style({
+  display: "flex",
+  alignItems: "center",
+  gap: vars.space[2],
+  fontSize: vars.fontSize.sm,
+  color: vars.color.textSecondary,
+});
+
+export const input = style({
+  flex: 1,
+  padding: "0.4rem 0.5rem",
+  background: vars.color.background,
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: vars.radii.sm,
+  color: vars.color.textPrimary,
+  fontFamily: vars.font.mono,
+  fontSize: vars.fontSize.sm,
+});
+
+export const primaryButton = style({
+  alignSelf: "flex-start",
+  padding: "0.4rem 0.75rem",
+  background: vars.color.primary,
+  color: vars.color.primaryText,
+  border: "none",
+  borderRadius: vars.radii.sm,
+  cursor: "pointer",
+  fontSize: vars.fontSize.sm,
+  selectors: {
+    "&:disabled": { opacity: 0.6, cursor: "not-allowed" },
+  },
+});
+
+export const createdUrl = style({
+  display: "flex",
+  gap: vars.space[2],
+  alignItems: "center",
+});
+
+export const hint = style({
+  fontSize: vars.fontSize.xs,
+  color: vars.color.textMuted,
+  margin: 0,
+});
+
+export const error = style({
+  fontSize: vars.fontSize.sm,
+  color: vars.color.error,
+  margin: 0,
+});
+
+export const list = style({
+  listStyle: "none",
+  margin: 0,
+  padding: 0,
+  display: "flex",
+  flexDirection: "column",
+  gap: vars.space[2],
+});
+
+export const link = style({
+  display: "flex",
+  alignItems: "flex-start",
+  justifyContent: "space-between",
+  gap: vars.space[2],
+  padding: vars.space[2],
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: vars.radii.sm,
+  fontSize: vars.fontSize.sm,
+});
+
+export const linkMeta = style({
+  display: "flex",
+  flexDirection: "column",
+  gap: "0.2rem",
+  minWidth: 0,
+  color: vars.color.textSecondary,
+});
+
+export const revokeButton = style({
+  flexShrink: 0,
+  padding: "0.25rem 0.5rem",
+  background: "transparent",
+  border: `1px solid ${vars.color.error}`,
+  borderRadius: vars.radii.sm,
+  color: vars.color.error,
+  cursor: "pointer",
+  fontSize: vars.fontSize.xs,
+});
@@ -0,0 +1,219 @@
+"use client";
+
+import { useState, useEffect, useCallback } from "react";
+import { Modal, ModalContent, ModalTitle } from "@/components/ui/Modal";
+import {
+  ShareLink,
+  SharesUnavailableError,
+  createShare,
+  listShares,
+  revokeShare,
+} from "@/lib/auth/shares";
+import * as styles from "./ShareSessionButton.css";
+
+const POLL_INTERVAL_MS = 5000;
+
+const TTL_OPTIONS: { label: string; seconds: number }[] = [
+  { label: "15 minutes", seconds: 15 * 60 },
+  { label: "1 hour", seconds: 60 * 60 },
+  { label: "8 hours", seconds: 8 * 60 * 60 },
+  { label: "1 day", seconds: 24 * 60 * 60 },
+  { label: "7 days", seconds: 7 * 24 * 60 * 60 },
+];
+
+interface ShareSessionButtonProps {
+  sessionId: string;
+}
+
+/**
+ * ShareSessionButton — header control for a session's share links.
+ * Shows how many people are watching through share links, and opens a dialog
+ * to create and revoke links. Renders nothing when share links are
+ * unavailable (remote access not enabled).
+ */
+export function ShareSessionButton({ sessionId }: ShareSessionButtonProps) {
+  const [available, setAvailable] = useState(true);
+  const [links, setLinks] = useState<ShareLink[]>([]);
+  const [open, setOpen] = useState(false);
+
+  const [label, setLabel] = useState("");
+  const [ttlSeconds, setTtlSeconds] = useState(TTL_OPTIONS[1].seconds);
+  const [maxViewers, setMaxViewers] = useState(0);
+  const [allowInput, setAllowInput] = useState(false);
+  const [creating, setCreating] = useState(false);
+  const [createdUrl, setCreatedUrl] = useState("");
+  const [error, setError] = useState("");
+
+  const refresh = useCallback(async () => {
+    try {
+      setLinks(await listShares(sessionId));
+    } catch (e) {
+      if (e instanceof SharesUnavailableError) {
+        setAvailable(false);
+      }
+    }
+  }, [sessionId]);
+
+  // Poll for viewer presence; stops once share links turn out to be unavailable.
+  useEffect(() => {
+    if (!available) return;
+    refresh();
+    const timer = setInterval(refresh, POLL_INTERVAL_MS);
+    return () => clearInterval(timer);
+  }, [available, refresh]);
+
+  useEffect(() => {
+    setAvailable(true);
+    setLinks([]);
+    setCreatedUrl("");
+  }, [sessionId]);
+
+  if (!available) return null;
+
+  const viewerCount = links.reduce((n, l) => n + l.viewers.length, 0);
+
+  const handleCreate = async () => {
+    setCreating(true);
+    setError("");
+    try {
+      const resp = await createShare({
+        session_id: sessionId,
+        label: label.trim(),
+        allow_input: allowInput,
+        ttl_seconds: ttlSeconds,
+        max_viewers: maxViewers,
+      });
+      setCreatedUrl(resp.url);
+      setLabel("");
+      await refresh();
+    } catch (e) {
+      setError(e instanceof Error ? e.message : String(e));
+    } finally {
+      setCreating(false);
+    }
+  };
+
+  const handleRevoke = async (id: string) => {
+    setError("");
+    try {
+      await revokeShare(id);
+      await refresh();
+    } catch (e) {
+      setError(e instanceof Error ? e.message : String(e));
+    }
+  };
+
+  return (
+    <>
+      <button
+        className={styles.shareButton}
+        onClick={() => setOpen(true)}
+        aria-label={viewerCount > 0 ? `Share session (${viewerCount} watching)` : "Share session"}
+        title={viewerCount > 0 ? `${viewerCount} watching via share links` : "Share a view of this terminal"}
+        data-testid="share-session-button"
+      >
+        🔗 Share
+        {viewerCount > 0 && (
+          <span className={styles.viewerBadge} aria-live="polite">
+            👁 {viewerCount}
+          </span>
+        )}
+      </button>
+
+      <Modal open={open} onOpenChange={setOpen}>
+        <ModalContent fallbackTitle="Share session">
+          <ModalTitle>Share session</ModalTitle>
+
+          <div className={styles.form}>
+            <label className={styles.row}>
+              Label
+              <input
+                className={styles.input}
+                value={label}
+                onChange={(e) => setLabel(e.target.value)}
+                placeholder="Who is this for?"
+              />
+            </label>
+            <label className={styles.row}>
+              Expires after
+              <select
+                className={styles.input}
+                value={ttlSeconds}
+                onChange={(e) => setTtlSeconds(Number(e.target.value))}
+              >
+                {TTL_OPTIONS.map((o) => (
+                  <option key={o.seconds} value={o.seconds}>
+                    {o.label}
+                  </option>
+                ))}
+              </select>
+            </label>
+            <label className={styles.row}>
+              Max viewers
+              <input
+                className={styles.input}
+                type="number"
+                min={0}
+                value={maxViewers}
+                onChange={(e) => setMaxViewers(Math.max(0, Number(e.target.value) || 0))}
+              />
+            </label>
+            <p className={styles.hint}>0 means unlimited.</p>
+            <label className={styles.row}>
+              <input
+                type="checkbox"
+                checked={allowInput}
+                onChange={(e) => setAllowInput(e.target.checked)}
+              />
+              Allow viewers to type into the terminal
+            </label>
+            <button className={styles.primaryButton} onClick={handleCreate} disabled={creating}>
+              {creating ? "Creating…" : "Create link"}
+            </button>
+            {createdUrl && (
+              <>
+                <div className={styles.createdUrl}>
+                  <input className={styles.input} value={createdUrl} readOnly onFocus={(e) => e.target.select()} />
+                  <button
+                    className={styles.primaryButton}
+                    onClick={() => navigator.clipboard?.writeText(createdUrl)}
+                  >
+                    Copy
+                  </button>
+                </div>
+                <p className={styles.hint}>This link is only shown once. Viewers also need to trust this server&apos;s certificate.</p>
+              </>
+            )}
+            {error && <p className={styles.error}>{error}</p>}
+          </div>
+
+          {links.length === 0 ? (
+            <p className={styles.hint}>No active share links.</p>
+          ) : (
+            <ul className={styles.list}>
+              {links.map((l) => (
+                <li key={l.id} className={styles.link}>
+                  <div className={styles.linkMeta}>
+                    <strong>{l.label || l.id}</strong>
+                    <span>
+                      {l.allow_input ? "Can type" : "View only"} · expires{" "}
+                      {new Date(l.expires_at).toLocaleString()}
+                    </span>
+                    <span>
+                      {l.viewers.length} watching
+                      {l.max_viewers > 0 ? ` of ${l.max_viewers}` : ""}
+                      {l.viewers.length > 0 && `: ${l.viewers.map((v) => v.addr).join(", ")}`}
+                    </span>
+                  </div>
+                  <button className={styles.revokeButton} onClick={() => handleRevoke(l.id)}>
+                    Revoke
+                  </button>
+                </li>
+              ))}
+            </ul>
+          )}
+        </ModalContent>
+      </Modal>
+    </>
+  );
+}
@@ -30,6 +30,10 @@ export function getAttentionReasonInfo(reason: AttentionReason): StatusInfo {
       return { label: "Stale", icon: "⌛", variant: "stale" };
     case AttentionReason.WAITING_FOR_USER:
       return { label: "Waiting", icon: "✏️", variant: "input" };
+    case AttentionReason.CI_FAILING:
+      return { label: "CI Failing", icon: "❌", variant: "testsFailing" };
+    case AttentionReason.RESOURCE_LIMIT:
+      return { label: "Resource Limit", icon: "🧱", variant: "error" };
     default:
       return { label: "Unknown", icon: "●", variant: "unknown" };
   }
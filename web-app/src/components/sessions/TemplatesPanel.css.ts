@@ -0,0 +1,203 @@
+import { style } from "@vanilla-extract/css";
+import { vars } from "@/styles/theme.css";
+
+export const container = style({
+  display: "flex",
+  flexDirection: "column",
+  gap: "1rem",
+});
+
+export const headerRow = style({
+  display: "flex",
+  justifyContent: "space-between",
+  alignItems: "center",
+  gap: "1rem",
+});
+
+export const heading = style({
+  color: vars.color.textPrimary,
+  fontSize: "1.25rem",
+  fontWeight: 600,
+  margin: 0,
+});
+
+export const subtitle = style({
+  color: vars.color.textMuted,
+  fontSize: "0.8125rem",
+  margin: 0,
+});
+
+export const repoRow = style({
+  display: "flex",
+  gap: "0.5rem",
+  alignItems: "center",
+});
+
+export const mutedText = style({
+  color: vars.color.textMuted,
+  fontSize: "0.875rem",
+});
+
+export const warning = style({
+  padding: "0.5rem 0.75rem",
+  backgroundColor: vars.color.warningBg,
+  border: `1px solid ${vars.color.warning}`,
+  borderRadius: "4px",
+  color: vars.color.warningText,
+  fontSize: "0.8125rem",
+});
+
+export const templateRow = style({
+  display: "flex",
+  justifyContent: "space-between",
+  alignItems: "flex-start",
+  gap: "1rem",
+  padding: "0.75rem",
+  backgroundColor: vars.color.cardBackground,
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: "6px",
+});
+
+export const templateRowSelected = style({
+  borderColor: vars.color.inputFocusBorder,
+});
+
+export const templateInfo = style({
+  display: "flex",
+  flexDirection: "column",
+  gap: "0.25rem",
+  minWidth: 0,
+});
+
+export const templateName = style({
+  color: vars.color.textPrimary,
+  fontWeight: 600,
+  fontSize: "0.9375rem",
+});
+
+export const templateDesc = style({
+  color: vars.color.textSecondary,
+  fontSize: "0.8125rem",
+});
+
+export const templateMeta = style({
+  color: vars.color.textMuted,
+  fontSize: "0.75rem",
+});
+
+export const sourceBadge = style({
+  marginLeft: "0.5rem",
+  padding: "0.125rem 0.375rem",
+  backgroundColor: vars.color.accentBg,
+  border: `1px solid ${vars.color.borderSubtle}`,
+  borderRadius: "10px",
+  color: vars.color.textSecondary,
+  fontSize: "0.6875rem",
+  fontWeight: 500,
+});
+
+export const templateActions = style({
+  display: "flex",
+  gap: "0.375rem",
+  flexShrink: 0,
+});
+
+export const formCard = style({
+  padding: "1rem",
+  backgroundColor: vars.color.cardBackground,
+  border: `1px solid ${vars.color.borderColor}`,
+  borderRadius: "6px",
+});
+
+export const formTitle = style({
+  color: vars.color.textPrimary,
+  fontSize: "1rem",
+  fontWeight: 600,
+  margin: "0 0 0.75rem 0",
+});
+
+export const formFields = style({
+  display: "flex",
+  flexDirection: "column",
+  gap: "0.75rem",
+});
+
+export const field = style({
+  display: "flex",
+  flexDirection: "column",
+  gap: "0.375rem",
+});
+
+export const label = style({
+  color: vars.color.textSecondary,
+  fontSize: "0.8125rem",
+  fontWeight: 600,
+});
+
+export const hint = style({
+  color: vars.color.textMuted,
+  fontSize: "0.75rem",
+  fontWeight: 400,
+});
+
+export const checkboxLabel = style({
+  display: "flex",
+  alignItems: "center",
+  gap: "0.5rem",
+  color: vars.color.textSecondary,
+  fontSize: "0.8125rem",
+  fontWeight: 600,
+  cursor: "pointer",
+});
+
+export const input = style({
+  padding: "0.5rem 0.75rem",
+  backgroundColor: vars.color.inputBackground,
+  border: `1px solid ${vars.color.inputBorder}`,
+  borderRadius: "4px",
+  color: vars.color.inputText,
+  fontSize: "0.875rem",
+  flex: 1,
+  selectors: {
+    "&:focus": {
+      outline: "none",
+      borderColor: vars.color.inputFocusBorder,
+    },
+  },
+});
+
+export const select = style({
+  padding: "0.5rem 0.75rem",
+  backgroundColor: vars.color.inputBackground,
+  border: `1px solid ${vars.color.inputBorder}`,
+  borderRadius: "4px",
+  color: vars.color.inputText,
+  fontSize: "0.875rem",
+  selectors: {
+    "&:focus": {
+      outline: "none",
+      borderColor: vars.color.inputFocusBorder,
+    },
+  },
+});
+
+export const preview = style({
+  margin: 0,
+  padding: "0.75rem",
+  maxHeight: "16rem",
+  overflowY: "auto",
+  backgroundColor: vars.color.inputBackground,
+  border: `1px solid ${vars.color.borderSubtle}`,
+  borderRadius: "4px",
+  color: vars.color.textSecondary,
+  fontFamily: "monospace",
+  fontSize: "0.75rem",
+  whiteSpace: "pre-wrap",
+  wordBreak: "break-word",
+});
+
+export const formActions = style({
+  display: "flex",
+  gap: "0.5rem",
+  paddingTop: "0.75rem",
+});
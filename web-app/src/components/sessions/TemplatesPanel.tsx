@@ -0,0 +1,412 @@
+"use client";
+
+import { useState, useEffect, useRef, useCallback } from "react";
+import { useRouter } from "next/navigation";
+import {
+  SessionService,
+  type SessionTemplate,
+  type TemplateVariable,
+} from "@/gen/session/v1/session_pb";
+import { createClient } from "@connectrpc/connect";
+import { createConnectTransport } from "@connectrpc/connect-web";
+import { getApiBaseUrl } from "@/lib/config";
+import { routes } from "@/lib/routes";
+import * as styles from "./TemplatesPanel.css";
+
+const REPO_KEY = "stapler-squad:templates-repo";
+
+/** Returns the initial form values: each variable's default. */
+function defaultValues(template: SessionTemplate): Record<string, string> {
+  const values: Record<string, string> = {};
+  for (const v of template.variables) {
+    values[v.name] = v.defaultValue || (v.type === "bool" ? "false" : "");
+  }
+  return values;
+}
+
+/** Substitutes known values into text, leaving unset placeholders visible. */
+function preview(text: string, values: Record<string, string>): string {
+  return text.replace(/\{\{\s*([a-z][a-z0-9_]*)\s*\}\}/g, (match, name: string) =>
+    values[name] ? values[name] : match
+  );
+}
+
+function VariableInput({
+  variable,
+  value,
+  onChange,
+}: {
+  variable: TemplateVariable;
+  value: string;
+  onChange: (value: string) => void;
+}) {
+  const id = `template-var-${variable.name}`;
+  const hint = variable.description && (
+    <span className={styles.hint}>{variable.description}</span>
+  );
+  if (variable.type === "bool") {
+    return (
+      <div className={styles.field}>
+        <label className={styles.checkboxLabel}>
+          <input
+            type="checkbox"
+            checked={value === "true"}
+            onChange={(e) => onChange(e.target.checked ? "true" : "false")}
+          />
+          {variable.name}
+        </label>
+        {hint}
+      </div>
+    );
+  }
+  return (
+    <div className={styles.field}>
+      <label className={styles.label} htmlFor={id}>
+        {variable.name}
+        {variable.required && " *"}
+      </label>
+      {variable.type === "enum" ? (
+        <select
+          id={id}
+          className={styles.select}
+          value={value}
+          onChange={(e) => onChange(e.target.value)}
+        >
+          {!variable.required && <option value="">—</option>}
+          {variable.options.map((o) => (
+            <option key={o} value={o}>
+              {o}
+            </option>
+          ))}
+        </select>
+      ) : (
+        <input
+          id={id}
+          type={
+            variable.type === "url"
+              ? "url"
+              : variable.type === "int"
+                ? "number"
+                : "text"
+          }
+          step={variable.type === "int" ? 1 : undefined}
+          className={styles.input}
+          placeholder={variable.type === "url" ? "https://" : undefined}
+          value={value}
+          onChange={(e) => onChange(e.target.value)}
+        />
+      )}
+      {hint}
+    </div>
+  );
+}
+
+/**
+ * TemplatesPanel lists the session templates available in a repository and
+ * creates sessions from them, prompting for each template variable with an
+ * input that matches its type.
+ */
+export function TemplatesPanel() {
+  const router = useRouter();
+  const [repoPath, setRepoPath] = useState("");
+  const [templates, setTemplates] = useState<SessionTemplate[]>([]);
+  const [warnings, setWarnings] = useState<string[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [error, setError] = useState<string | null>(null);
+  const [selected, setSelected] = useState<SessionTemplate | null>(null);
+  const [values, setValues] = useState<Record<string, string>>({});
+  const [title, setTitle] = useState("");
+  const [trustSetup, setTrustSetup] = useState(false);
+  const [creating, setCreating] = useState(false);
+
+  const clientRef = useRef<ReturnType<
+    typeof createClient<typeof SessionService>
+  > | null>(null);
+
+  const loadTemplates = useCallback(async (repo: string) => {
+    if (!clientRef.current) return;
+    try {
+      setLoading(true);
+      setError(null);
+      const response = await clientRef.current.listTemplates({ repoPath: repo });
+      setTemplates(response.templates);
+      setWarnings(response.warnings);
+    } catch (err) {
+      setError(`Failed to load templates: ${err}`);
+    } finally {
+      setLoading(false);
+    }
+  }, []);
+
+  useEffect(() => {
+    const transport = createConnectTransport({ baseUrl: getApiBaseUrl() });
+    clientRef.current = createClient(SessionService, transport);
+    let repo = "";
+    try {
+      repo = localStorage.getItem(REPO_KEY) ?? "";
+    } catch { /* ignore */ }
+    setRepoPath(repo);
+    loadTemplates(repo);
+  }, [loadTemplates]);
+
+  const handleLoad = () => {
+    try {
+      localStorage.setItem(REPO_KEY, repoPath.trim());
+    } catch { /* ignore */ }
+    setSelected(null);
+    loadTemplates(repoPath.trim());
+  };
+
+  const handleSelect = (template: SessionTemplate) => {
+    setSelected(template);
+    setValues(defaultValues(template));
+    setTitle("");
+    setTrustSetup(false);
+    setError(null);
+  };
+
+  const handleDelete = async (template: SessionTemplate) => {
+    if (!clientRef.current) return;
+    if (!confirm(`Delete every version of template "${template.name}"?`)) return;
+    try {
+      setError(null);
+      await clientRef.current.deleteTemplate({ name: template.name });
+      if (selected?.name === template.name) setSelected(null);
+      await loadTemplates(repoPath.trim());
+    } catch (err) {
+      setError(`Failed to delete template: ${err}`);
+    }
+  };
+
+  const handleCreate = async () => {
+    if (!clientRef.current || !selected) return;
+    if (!repoPath.trim()) {
+      setError("A repository path is required.");
+      return;
+    }
+    const missing = selected.variables.filter(
+      (v) => v.required && !values[v.name]?.trim()
+    );
+    if (missing.length > 0) {
+      setError(`Missing ${missing.map((v) => v.name).join(", ")}.`);
+      return;
+    }
+    const variables: Record<string, string> = {};
+    for (const [name, value] of Object.entries(values)) {
+      if (value.trim()) variables[name] = value.trim();
+    }
+    try {
+      setCreating(true);
+      setError(null);
+      const response = await clientRef.current.createSessionFromTemplate({
+        template: `${selected.name}@${selected.version}`,
+        repoPath: repoPath.trim(),
+        title: title.trim(),
+        variables,
+        trustSetupScript: trustSetup,
+      });
+      if (response.session) {
+        router.push(routes.sessionDetail(response.session.id));
+      }
+    } catch (err) {
+      setError(`Failed to create session: ${err}`);
+    } finally {
+      setCreating(false);
+    }
+  };
+
+  // {{repo}} is built in; {{date}} is left for the server to fill in.
+  const previewValues = {
+    ...values,
+    repo: repoPath.trim().split("/").filter(Boolean).pop() ?? "",
+  };
+
+  return (
+    <div className={styles.container}>
+      <div className={styles.headerRow}>
+        <div>
+          <h2 className={styles.heading}>Session Templates</h2>
+          <p className={styles.subtitle}>
+            Shared templates live in the repository&apos;s{" "}
+            <code>.stapler-squad/templates</code> directory; save personal
+            ones with <code>ssq template save</code>.
+          </p>
+        </div>
+      </div>
+
+      <div className={styles.repoRow}>
+        <input
+          type="text"
+          className={styles.input}
+          aria-label="Repository path"
+          placeholder="/path/to/repository"
+          value={repoPath}
+          onChange={(e) => setRepoPath(e.target.value)}
+          onKeyDown={(e) => {
+            if (e.key === "Enter") handleLoad();
+          }}
+        />
+        <button type="button" className="btn btn-secondary" onClick={handleLoad}>
+          Load
+        </button>
+      </div>
+
+      {error && <div className="alert alert-error">{error}</div>}
+      {warnings.map((w) => (
+        <div key={w} className={styles.warning}>
+          {w}
+        </div>
+      ))}
+
+      {loading && <div className={styles.mutedText}>Loading...</div>}
+      {!loading && templates.length === 0 && (
+        <div className={styles.mutedText}>No templates found.</div>
+      )}
+      {templates.map((t) => (
+        <div
+          key={`${t.source}:${t.name}`}
+          className={`${styles.templateRow} ${
+            selected?.name === t.name ? styles.templateRowSelected : ""
+          }`}
+        >
+          <div className={styles.templateInfo}>
+            <span className={styles.templateName}>
+              {t.name}@{t.version}
+              <span className={styles.sourceBadge}>
+                {t.source === "repo" ? "Shared" : "Personal"}
+              </span>
+            </span>
+            {t.description && (
+              <span className={styles.templateDesc}>{t.description}</span>
+            )}
+            <span className={styles.templateMeta}>
+              {[
+                t.program && `Program: ${t.program}`,
+                t.profile && `Profile: ${t.profile}`,
+                t.branchPattern && `Branch: ${t.branchPattern}`,
+                t.tags.length > 0 && `Tags: ${t.tags.join(", ")}`,
+                t.setupScript && "Setup script",
+              ]
+                .filter(Boolean)
+                .join(" · ")}
+            </span>
+          </div>
+          <div className={styles.templateActions}>
+            <button
+              type="button"
+              className="btn btn-primary"
+              onClick={() => handleSelect(t)}
+            >
+              Use
+            </button>
+            {t.source === "user" && (
+              <button
+                type="button"
+                className="btn btn-danger"
+                onClick={() => handleDelete(t)}
+              >
+                Delete
+              </button>
+            )}
+          </div>
+        </div>
+      ))}
+
+      {selected && (
+        <div className={styles.formCard}>
+          <h3 className={styles.formTitle}>
+            New session from {selected.name}@{selected.version}
+          </h3>
+          <div className={styles.formFields}>
+            <div className={styles.field}>
+              <label className={styles.label} htmlFor="template-title">
+                Title
+              </label>
+              <input
+                id="template-title"
+                type="text"
+                className={styles.input}
+                placeholder={`${selected.name}-<timestamp>`}
+                value={title}
+                onChange={(e) => setTitle(e.target.value)}
+              />
+            </div>
+            {selected.variables.map((v) => (
+              <VariableInput
+                key={v.name}
+                variable={v}
+                value={values[v.name] ?? ""}
+                onChange={(value) => setValues({ ...values, [v.name]: value })}
+              />
+            ))}
+            {selected.branchPattern && (
+              <div className={styles.field}>
+                <span className={styles.label}>Branch</span>
+                <span className={styles.hint}>
+                  {preview(selected.branchPattern, previewValues)}
+                </span>
+              </div>
+            )}
+            <div className={styles.field}>
+              <span className={styles.label}>Prompt</span>
+              <pre className={styles.preview}>
+                {preview(selected.prompt, previewValues)}
+                {selected.acceptanceCriteria.length > 0 &&
+                  "\n\nAcceptance criteria:\n" +
+                    selected.acceptanceCriteria
+                      .map((c) => `- [ ] ${preview(c, previewValues)}`)
+                      .join("\n")}
+              </pre>
+            </div>
+            {selected.setupScript && (
+              <div className={styles.field}>
+                <span className={styles.label}>
+                  Setup script{" "}
+                  <span className={styles.hint}>
+                    runs in the session&apos;s worktree, inside its sandbox if
+                    it has one, before the agent starts
+                  </span>
+                </span>
+                <pre className={styles.preview}>{selected.setupScript}</pre>
+                {selected.source === "repo" && (
+                  <>
+                    <label className={styles.checkboxLabel}>
+                      <input
+                        type="checkbox"
+                        checked={trustSetup}
+                        onChange={(e) => setTrustSetup(e.target.checked)}
+                      />
+                      Trust this script in this repository
+                    </label>
+                    <span className={styles.hint}>
+                      Shared setup scripts come from the repository and run
+                      only once trusted. Trust lasts until the script changes.
+                    </span>
+                  </>
+                )}
+              </div>
+            )}
+          </div>
+          <div className={styles.formActions}>
+            <button
+              type="button"
+              className="btn btn-primary"
+              onClick={handleCreate}
+              disabled={creating}
+            >
+              {creating ? "Creating..." : "Create Session"}
+            </button>
+            <button
+              type="button"
+              className="btn btn-secondary"
+              onClick={() => setSelected(null)}
+              disabled={creating}
+            >
+              Cancel
+            </button>
+          </div>
+        </div>
+      )}
+    </div>
+  );
+}
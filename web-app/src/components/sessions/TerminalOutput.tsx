@@ -28,9 +28,10 @@ const ALT_KEY_MAP: Record<string, string> = {
   '\x1b[5~': '\x1b[5;3~', // Alt+PgUp
   '\x1b[6~': '\x1b[6;3~', // Alt+PgDn
 };
-import { useTerminalStream } from "@/lib/hooks/useTerminalStream";
+import { useTerminalStream, type StreamingMode } from "@/lib/hooks/useTerminalStream";
 import { useBrowserLogStream } from "@/lib/hooks/useBrowserLogStream";
 import { XtermTerminal, type XtermTerminalHandle } from "./XtermTerminal";
+import { InputControlBar } from "./InputControlBar";
 import { TerminalStreamManager } from "@/lib/terminal/TerminalStreamManager";
 import { getCachedDimensions, saveDimensions, validateCellDimensions } from "@/lib/terminal/TerminalDimensionCache";
 import { DEFAULT_TERMINAL_CONFIG } from "@/lib/config/terminalConfig";
@@ -238,7 +239,7 @@ export function TerminalOutput({ sessionId, baseUrl, isExternal = false, tmuxSes
   }, []);
 
   // Streaming mode selection
-  const [streamingMode, setStreamingMode] = useState<"raw" | "raw-compressed" | "state" | "hybrid">("raw");
+  const [streamingMode, setStreamingMode] = useState<StreamingMode>("raw");
 
   // Recording state
   const [isRecording, setIsRecording] = useState(false);
@@ -381,7 +382,7 @@ export function TerminalOutput({ sessionId, baseUrl, isExternal = false, tmuxSes
     }
   }, []);
 
-  const { isConnected, error, sendInput, sendInputWithEcho, resize, connect, disconnect, scrollbackLoaded, requestScrollback, sendFlowControl, getIsApplyingState, sspNegotiated, startRecording, stopRecording, terminalState } = useTerminalStream({
+  const { isConnected, error, sendInput, sendInputWithEcho, resize, connect, disconnect, scrollbackLoaded, requestScrollback, sendFlowControl, getIsApplyingState, sspNegotiated, startRecording, stopRecording, terminalState, inputControl, sendInputControl, sendPresence } = useTerminalStream({
     baseUrl,
     sessionId: effectiveSessionId,
     getTerminal,
@@ -896,6 +897,13 @@ export function TerminalOutput({ sessionId, baseUrl, isExternal = false, tmuxSes
     }
   }, [logStreamEnabled]);
 
+  // Tell other clients watching this session whether we're focused and where
+  // our cursor is, so the input control bar can show who is actively typing.
+  const reportPresence = useCallback((focused: boolean) => {
+    const cursor = xtermRef.current?.terminal?.buffer.active;
+    sendPresence(focused, cursor?.cursorY ?? 0, cursor?.cursorX ?? 0);
+  }, [sendPresence]);
+
   const handleCopyOutput = () => {
     const selectedText = xtermRef.current?.terminal?.getSelection();
     if (selectedText) {
@@ -1220,7 +1228,7 @@ export function TerminalOutput({ sessionId, baseUrl, isExternal = false, tmuxSes
               </button>
               <select
                 value={streamingMode}
-                onChange={(e) => setStreamingMode(e.target.value as "raw" | "raw-compressed" | "state" | "hybrid")}
+                onChange={(e) => setStreamingMode(e.target.value as StreamingMode)}
                 className={`${styles.toolbarButton} ${styles.devOnly}`}
                 title="Terminal streaming mode - choose how terminal output is delivered"
                 aria-label="Select terminal streaming mode"
@@ -1229,6 +1237,7 @@ export function TerminalOutput({ sessionId, baseUrl, isExternal = false, tmuxSes
               >
                 <option value="raw">🚀 Raw</option>
                 <option value="raw-compressed">📦 Raw+LZMA</option>
+                <option value="frames">📡 Frames+zstd</option>
                 <option value="state">🔄 State Sync</option>
                 <option value="hybrid">🔬 Hybrid</option>
               </select>
@@ -1302,7 +1311,13 @@ export function TerminalOutput({ sessionId, baseUrl, isExternal = false, tmuxSes
           ))}
         </div>
       )}
-      <div className={styles.terminal} ref={terminalContainerRef}>
+      <InputControlBar state={inputControl} onAction={sendInputControl} />
+      <div
+        className={styles.terminal}
+        ref={terminalContainerRef}
+        onFocus={() => reportPresence(true)}
+        onBlur={() => reportPresence(false)}
+      >
         {isVisible !== false && isLoadingInitialContent && (
           <div className={styles.loadingOverlay}>
             <div className={styles.loadingSpinner} />
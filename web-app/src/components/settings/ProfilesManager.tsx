@@ -43,6 +43,13 @@ interface ProfileFormData {
   autoYes: boolean;
   tags: string[];
   tagInput: string;
+  memoryMaxMb: string;
+  cpuPercent: string;
+  pidsMax: string;
+  sandboxEnabled: boolean;
+  sandboxNetwork: string;
+  /** Comma-separated allowlist hosts. */
+  sandboxHosts: string;
 }
 
 const emptyForm: ProfileFormData = {
@@ -52,8 +59,25 @@ const emptyForm: ProfileFormData = {
   autoYes: false,
   tags: [],
   tagInput: "",
+  memoryMaxMb: "",
+  cpuPercent: "",
+  pidsMax: "",
+  sandboxEnabled: false,
+  sandboxNetwork: "",
+  sandboxHosts: "",
 };
 
+/** Formats a zero-means-unlimited limit for a form input. */
+function limitInput(value: bigint | number | undefined): string {
+  return value ? String(value) : "";
+}
+
+/** Parses a form input; blank or invalid means unlimited (0). */
+function parseLimit(value: string): number {
+  const n = parseInt(value, 10);
+  return Number.isFinite(n) && n > 0 ? n : 0;
+}
+
 export function ProfilesManager() {
   const [profiles, setProfiles] = useState<
     { key: string; profile: ProfileDefaultsProto }[]
@@ -108,6 +132,12 @@ export function ProfilesManager() {
       autoYes: profile.autoYes,
       tags: [...profile.tags],
       tagInput: "",
+      memoryMaxMb: limitInput(profile.resourceLimits?.memoryMaxMb),
+      cpuPercent: limitInput(profile.resourceLimits?.cpuPercent),
+      pidsMax: limitInput(profile.resourceLimits?.pidsMax),
+      sandboxEnabled: profile.sandbox?.enabled ?? false,
+      sandboxNetwork: profile.sandbox?.network ?? "",
+      sandboxHosts: (profile.sandbox?.allowedHosts ?? []).join(", "),
     });
     setShowForm(true);
   };
@@ -130,6 +160,7 @@ export function ProfilesManager() {
       setError("Profile name is required.");
       return;
     }
+    const existing = profiles.find((p) => p.key === editingKey)?.profile;
     try {
       setSaving(true);
       setError(null);
@@ -143,6 +174,22 @@ export function ProfilesManager() {
           tags: form.tags,
           envVars: {},
           cliFlags: "",
+          resourceLimits: {
+            memoryMaxMb: BigInt(parseLimit(form.memoryMaxMb)),
+            memoryHighMb: existing?.resourceLimits?.memoryHighMb ?? BigInt(0),
+            cpuPercent: parseLimit(form.cpuPercent),
+            pidsMax: BigInt(parseLimit(form.pidsMax)),
+            ioWeight: existing?.resourceLimits?.ioWeight ?? 0,
+          },
+          sandbox: {
+            enabled: form.sandboxEnabled,
+            network: form.sandboxNetwork,
+            allowedHosts: form.sandboxHosts
+              .split(",")
+              .map((h) => h.trim())
+              .filter(Boolean),
+            writablePaths: existing?.sandbox?.writablePaths ?? [],
+          },
         } as unknown as ProfileDefaultsProto,
       });
       setSuccess(`Profile "${form.name.trim()}" saved.`);
@@ -242,6 +289,26 @@ export function ProfilesManager() {
                 Tags: {profile.tags.join(", ")}
               </span>
             )}
+            {profile.resourceLimits && (
+              <span className={profileMeta}>
+                Limits:{" "}
+                {[
+                  profile.resourceLimits.memoryMaxMb > 0 &&
+                    `${profile.resourceLimits.memoryMaxMb} MB`,
+                  profile.resourceLimits.cpuPercent > 0 &&
+                    `${profile.resourceLimits.cpuPercent}% CPU`,
+                  profile.resourceLimits.pidsMax > 0 &&
+                    `${profile.resourceLimits.pidsMax} processes`,
+                ]
+                  .filter(Boolean)
+                  .join(", ") || "custom"}
+              </span>
+            )}
+            {profile.sandbox?.enabled && (
+              <span className={profileMeta}>
+                Sandbox: network {profile.sandbox.network || "host"}
+              </span>
+            )}
           </div>
           <div className={profileActions}>
             <button
@@ -328,6 +395,102 @@ export function ProfilesManager() {
                 Auto-yes
               </label>
             </div>
+            <div className={field}>
+              <label className={labelClass} htmlFor="profile-memory">
+                Memory limit (MB)
+              </label>
+              <input
+                id="profile-memory"
+                type="number"
+                min={0}
+                className={input}
+                placeholder="Unlimited"
+                value={form.memoryMaxMb}
+                onChange={(e) =>
+                  setForm({ ...form, memoryMaxMb: e.target.value })
+                }
+              />
+            </div>
+            <div className={field}>
+              <label className={labelClass} htmlFor="profile-cpu">
+                CPU limit (% of one core)
+              </label>
+              <input
+                id="profile-cpu"
+                type="number"
+                min={0}
+                className={input}
+                placeholder="Unlimited"
+                value={form.cpuPercent}
+                onChange={(e) =>
+                  setForm({ ...form, cpuPercent: e.target.value })
+                }
+              />
+            </div>
+            <div className={field}>
+              <label className={labelClass} htmlFor="profile-pids">
+                Process limit
+              </label>
+              <input
+                id="profile-pids"
+                type="number"
+                min={0}
+                className={input}
+                placeholder="Unlimited"
+                value={form.pidsMax}
+                onChange={(e) => setForm({ ...form, pidsMax: e.target.value })}
+              />
+            </div>
+            <div className={field}>
+              <label className={checkboxLabel}>
+                <input
+                  type="checkbox"
+                  checked={form.sandboxEnabled}
+                  onChange={(e) =>
+                    setForm({ ...form, sandboxEnabled: e.target.checked })
+                  }
+                />
+                Sandbox (read-only filesystem outside the worktree; requires bwrap)
+              </label>
+            </div>
+            {form.sandboxEnabled && (
+              <>
+                <div className={field}>
+                  <label className={labelClass} htmlFor="profile-sandbox-network">
+                    Sandbox network
+                  </label>
+                  <select
+                    id="profile-sandbox-network"
+                    className={select}
+                    value={form.sandboxNetwork}
+                    onChange={(e) =>
+                      setForm({ ...form, sandboxNetwork: e.target.value })
+                    }
+                  >
+                    <option value="">Host network</option>
+                    <option value="none">No network</option>
+                    <option value="allowlist">Allowlisted hosts only</option>
+                  </select>
+                </div>
+                {form.sandboxNetwork === "allowlist" && (
+                  <div className={field}>
+                    <label className={labelClass} htmlFor="profile-sandbox-hosts">
+                      Allowed hosts
+                    </label>
+                    <input
+                      id="profile-sandbox-hosts"
+                      type="text"
+                      className={input}
+                      placeholder="github.com, *.npmjs.org, api.anthropic.com"
+                      value={form.sandboxHosts}
+                      onChange={(e) =>
+                        setForm({ ...form, sandboxHosts: e.target.value })
+                      }
+                    />
+                  </div>
+                )}
+              </>
+            )}
             <div className={field}>
               <label className={labelClass}>Tags</label>
               <div className={tagList}>
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file session/v1/backlog.proto.
  */
 export const file_session_v1_backlog: GenFile = /*@__PURE__*/
-  fileDesc("ChhzZXNzaW9uL3YxL2JhY2tsb2cucHJvdG8SCnNlc3Npb24udjEiOgoLQWNDcml0ZXJpb24SDQoFaW5kZXgYASABKAUSDAoEdGV4dBgCIAEoCRIOCgZzdGF0dXMYAyABKAkiTgoQQ3JpdGVyaW9uVmVyZGljdBIXCg9jcml0ZXJpb25faW5kZXgYASABKAUSDwoHb3V0Y29tZRgCIAEoCRIQCghldmlkZW5jZRgDIAEoCSLOAgoNUmV2aWV3VmVyZGljdBIKCgJpZBgBIAEoCRIXCg9vdmVyYWxsX291dGNvbWUYAiABKAkSMwoNcGVyX2NyaXRlcmlvbhgDIAMoCzIcLnNlc3Npb24udjEuQ3JpdGVyaW9uVmVyZGljdBIPCgdzdW1tYXJ5GAQgASgJEhEKCWRpZmZfaGFzaBgFIAEoCRIYChBkaWZmX3Rva2VuX2NvdW50GAYgASgFEhYKDmRpZmZfdHJ1bmNhdGVkGAcgASgIEhMKC292ZXJyaWRlX2J5GAggASgJEhcKD292ZXJyaWRlX3JlYXNvbhgJIAEoCRIvCgtvdmVycmlkZV9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAisQMKC0l0ZW1TZXNzaW9uEgoKAmlkGAEgASgJEhQKDHNlc3Npb25fdXVpZBgCIAEoCRIUCgxzZXNzaW9uX3JvbGUYAyABKAkSLgoKc3RhcnRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhsKE2xhc3RfY29tbWl0X21lc3NhZ2UYBiABKAkSMgoObGFzdF9jb21taXRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiAKGGNvbW1pdF9jb3VudF9zaW5jZV9zcGF3bhgIIAEoBRI2ChJsYXN0X2ZpbGVfdG91Y2hfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDnJldmlld192ZXJkaWN0GAsgASgLMhkuc2Vzc2lvbi52MS5SZXZpZXdWZXJkaWN0IrsECgtCYWNrbG9nSXRlbRIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRI0ChNhY2NlcHRhbmNlX2NyaXRlcmlhGAQgAygLMhcuc2Vzc2lvbi52MS5BY0NyaXRlcmlvbhIQCghwcmlvcml0eRgFIAEoBRIOCgZzdGF0dXMYBiABKAkSEQoJcmVwb19wYXRoGAcgASgJEhgKEHNraXBfcmV2aWV3X2dhdGUYCCABKAgSFQoNc2tpcF9wbGFubmluZxgJIAEoCBIVCg1wbGFuX2FwcHJvdmVkGAogASgIEjQKEHBsYW5fYXBwcm92ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhsKE3BsYW5fYXJ0aWZhY3RzX3BhdGgYDCABKAkSDQoFbm90ZXMYDSABKAkSEwoLZXh0ZXJuYWxfaWQYDiABKAkSLwoLYXJjaGl2ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KDWl0ZW1fc2Vzc2lvbnMYEiADKAsyFy5zZXNzaW9uLnYxLkl0ZW1TZXNzaW9uEhEKCXNvdXJjZV9pZBgTIAEoCSKAAgoKSXRlbVNvdXJjZRIKCgJpZBgBIAEoCRIRCglwbHVnaW5faWQYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEg8KB2VuYWJsZWQYBCABKAgSMgoObGFzdF9zeW5jZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhgKEHRva2VuX2NvbmZpZ3VyZWQYBiABKAgSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi8QEKD1NvdXJjZVN5bmNFdmVudBIKCgJpZBgBIAEoCRIuCgpzdGFydGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtmaW5pc2hlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNaXRlbXNfY3JlYXRlZBgEIAEoBRIVCg1pdGVtc191cGRhdGVkGAUgASgFEhUKDWl0ZW1zX3NraXBwZWQYBiABKAUSFQoNaXRlbXNfZXJyb3JlZBgHIAEoBRIVCg1lcnJvcl9tZXNzYWdlGAggASgJItkBChhDcmVhdGVCYWNrbG9nSXRlbVJlcXVlc3QSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSNAoTYWNjZXB0YW5jZV9jcml0ZXJpYRgDIAMoCzIXLnNlc3Npb24udjEuQWNDcml0ZXJpb24SEAoIcHJpb3JpdHkYBCABKAUSGAoQc2tpcF9yZXZpZXdfZ2F0ZRgFIAEoCBIVCg1za2lwX3BsYW5uaW5nGAYgASgIEhEKCXJlcG9fcGF0aBgHIAEoCRINCgVub3RlcxgIIAEoCSJCChlDcmVhdGVCYWNrbG9nSXRlbVJlc3BvbnNlEiUKBGl0ZW0YASABKAsyFy5zZXNzaW9uLnYxLkJhY2tsb2dJdGVtIigKFUdldEJhY2tsb2dJdGVtUmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJIj8KFkdldEJhY2tsb2dJdGVtUmVzcG9uc2USJQoEaXRlbRgBIAEoCzIXLnNlc3Npb24udjEuQmFja2xvZ0l0ZW0iZgoXTGlzdEJhY2tsb2dJdGVtc1JlcXVlc3QSDgoGc3RhdHVzGAEgAygJEhAKCHByaW9yaXR5GAIgAygFEg8KB3NvcnRfYnkYAyABKAkSGAoQaW5jbHVkZV90ZXJtaW5hbBgEIAEoCCJCChhMaXN0QmFja2xvZ0l0ZW1zUmVzcG9uc2USJgoFaXRlbXMYASADKAsyFy5zZXNzaW9uLnYxLkJhY2tsb2dJdGVtIrwCChhVcGRhdGVCYWNrbG9nSXRlbVJlcXVlc3QSDwoHaXRlbV9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRI0ChNhY2NlcHRhbmNlX2NyaXRlcmlhGAQgAygLMhcuc2Vzc2lvbi52MS5BY0NyaXRlcmlvbhIQCghwcmlvcml0eRgFIAEoBRIYChBza2lwX3Jldmlld19nYXRlGAYgASgIEhUKDXNraXBfcGxhbm5pbmcYByABKAgSEQoJcmVwb19wYXRoGAggASgJEg0KBW5vdGVzGAkgASgJEhcKD2V4cGVjdGVkX3N0YXR1cxgKIAEoCRI3ChNleHBlY3RlZF91cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJCChlVcGRhdGVCYWNrbG9nSXRlbVJlc3BvbnNlEiUKBGl0ZW0YASABKAsyFy5zZXNzaW9uLnYxLkJhY2tsb2dJdGVtIiwKGUFyY2hpdmVCYWNrbG9nSXRlbVJlcXVlc3QSDwoHaXRlbV9pZBgBIAEoCSJDChpBcmNoaXZlQmFja2xvZ0l0ZW1SZXNwb25zZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbSK3AQoiVHJhbnNpdGlvbkJhY2tsb2dJdGVtU3RhdHVzUmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJEhUKDXRhcmdldF9zdGF0dXMYAiABKAkSFwoPZXhwZWN0ZWRfc3RhdHVzGAMgASgJEjcKE2V4cGVjdGVkX3VwZGF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKD292ZXJyaWRlX3JlYXNvbhgFIAEoCSJMCiNUcmFuc2l0aW9uQmFja2xvZ0l0ZW1TdGF0dXNSZXNwb25zZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbSIuChtTcGF3blNlc3Npb25Gcm9tSXRlbVJlcXVlc3QSDwoHaXRlbV9pZBgBIAEoCSJjChxTcGF3blNlc3Npb25Gcm9tSXRlbVJlc3BvbnNlEhQKDHNlc3Npb25fdXVpZBgBIAEoCRItCgxpdGVtX3Nlc3Npb24YAiABKAsyFy5zZXNzaW9uLnYxLkl0ZW1TZXNzaW9uIkMKGkF0dGFjaFNlc3Npb25Ub0l0ZW1SZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkSFAoMc2Vzc2lvbl91dWlkGAIgASgJIkwKG0F0dGFjaFNlc3Npb25Ub0l0ZW1SZXNwb25zZRItCgxpdGVtX3Nlc3Npb24YASABKAsyFy5zZXNzaW9uLnYxLkl0ZW1TZXNzaW9uIicKFFRyaWdnZXJUcmlhZ2VSZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiRgoVVHJpZ2dlclRyaWFnZVJlc3BvbnNlEi0KDGl0ZW1fc2Vzc2lvbhgBIAEoCzIXLnNlc3Npb24udjEuSXRlbVNlc3Npb24iJQoSQXBwcm92ZVBsYW5SZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiPAoTQXBwcm92ZVBsYW5SZXNwb25zZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbSIYChZTdWdnZXN0TmV4dEl0ZW1SZXF1ZXN0Im8KF1N1Z2dlc3ROZXh0SXRlbVJlc3BvbnNlEi0KDGl0ZW1fc2Vzc2lvbhgBIAEoCzIXLnNlc3Npb24udjEuSXRlbVNlc3Npb24SJQoEaXRlbRgCIAEoCzIXLnNlc3Npb24udjEuQmFja2xvZ0l0ZW0iXQoWT3ZlcnJpZGVWZXJkaWN0UmVxdWVzdBIXCg9pdGVtX3Nlc3Npb25faWQYASABKAkSEQoJdG9fc3RhdHVzGAIgASgJEhcKD292ZXJyaWRlX3JlYXNvbhgDIAEoCSJAChdPdmVycmlkZVZlcmRpY3RSZXNwb25zZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbSIpChZUcmlnZ2VyUmVSZXZpZXdSZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiSAoXVHJpZ2dlclJlUmV2aWV3UmVzcG9uc2USLQoMaXRlbV9zZXNzaW9uGAEgASgLMhcuc2Vzc2lvbi52MS5JdGVtU2Vzc2lvbiInChJUcmlnZ2VyU3luY1JlcXVlc3QSEQoJc291cmNlX2lkGAEgASgJIhUKE1RyaWdnZXJTeW5jUmVzcG9uc2UiZgoXQ3JlYXRlSXRlbVNvdXJjZVJlcXVlc3QSEQoJcGx1Z2luX2lkGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgtjb25maWdfanNvbhgDIAEoCRINCgV0b2tlbhgEIAEoCSJCChhDcmVhdGVJdGVtU291cmNlUmVzcG9uc2USJgoGc291cmNlGAEgASgLMhYuc2Vzc2lvbi52MS5JdGVtU291cmNlIhgKFkxpc3RJdGVtU291cmNlc1JlcXVlc3QiQgoXTGlzdEl0ZW1Tb3VyY2VzUmVzcG9uc2USJwoHc291cmNlcxgBIAMoCzIWLnNlc3Npb24udjEuSXRlbVNvdXJjZSJiChdVcGRhdGVJdGVtU291cmNlUmVxdWVzdBIRCglzb3VyY2VfaWQYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEg8KB2VuYWJsZWQYAyABKAgSDQoFdG9rZW4YBCABKAkiQgoYVXBkYXRlSXRlbVNvdXJjZVJlc3BvbnNlEiYKBnNvdXJjZRgBIAEoCzIWLnNlc3Npb24udjEuSXRlbVNvdXJjZSIsChdEZWxldGVJdGVtU291cmNlUmVxdWVzdBIRCglzb3VyY2VfaWQYASABKAkiGgoYRGVsZXRlSXRlbVNvdXJjZVJlc3BvbnNlIioKFUdldFN5bmNIaXN0b3J5UmVxdWVzdBIRCglzb3VyY2VfaWQYASABKAkiRQoWR2V0U3luY0hpc3RvcnlSZXNwb25zZRIrCgZldmVudHMYASADKAsyGy5zZXNzaW9uLnYxLlNvdXJjZVN5bmNFdmVudDLHDgoOQmFja2xvZ1NlcnZpY2USYgoRQ3JlYXRlQmFja2xvZ0l0ZW0SJC5zZXNzaW9uLnYxLkNyZWF0ZUJhY2tsb2dJdGVtUmVxdWVzdBolLnNlc3Npb24udjEuQ3JlYXRlQmFja2xvZ0l0ZW1SZXNwb25zZSIAElkKDkdldEJhY2tsb2dJdGVtEiEuc2Vzc2lvbi52MS5HZXRCYWNrbG9nSXRlbVJlcXVlc3QaIi5zZXNzaW9uLnYxLkdldEJhY2tsb2dJdGVtUmVzcG9uc2UiABJfChBMaXN0QmFja2xvZ0l0ZW1zEiMuc2Vzc2lvbi52MS5MaXN0QmFja2xvZ0l0ZW1zUmVxdWVzdBokLnNlc3Npb24udjEuTGlzdEJhY2tsb2dJdGVtc1Jlc3BvbnNlIgASYgoRVXBkYXRlQmFja2xvZ0l0ZW0SJC5zZXNzaW9uLnYxLlVwZGF0ZUJhY2tsb2dJdGVtUmVxdWVzdBolLnNlc3Npb24udjEuVXBkYXRlQmFja2xvZ0l0ZW1SZXNwb25zZSIAEmUKEkFyY2hpdmVCYWNrbG9nSXRlbRIlLnNlc3Npb24udjEuQXJjaGl2ZUJhY2tsb2dJdGVtUmVxdWVzdBomLnNlc3Npb24udjEuQXJjaGl2ZUJhY2tsb2dJdGVtUmVzcG9uc2UiABKAAQobVHJhbnNpdGlvbkJhY2tsb2dJdGVtU3RhdHVzEi4uc2Vzc2lvbi52MS5UcmFuc2l0aW9uQmFja2xvZ0l0ZW1TdGF0dXNSZXF1ZXN0Gi8uc2Vzc2lvbi52MS5UcmFuc2l0aW9uQmFja2xvZ0l0ZW1TdGF0dXNSZXNwb25zZSIAEmsKFFNwYXduU2Vzc2lvbkZyb21JdGVtEicuc2Vzc2lvbi52MS5TcGF3blNlc3Npb25Gcm9tSXRlbVJlcXVlc3QaKC5zZXNzaW9uLnYxLlNwYXduU2Vzc2lvbkZyb21JdGVtUmVzcG9uc2UiABJoChNBdHRhY2hTZXNzaW9uVG9JdGVtEiYuc2Vzc2lvbi52MS5BdHRhY2hTZXNzaW9uVG9JdGVtUmVxdWVzdBonLnNlc3Npb24udjEuQXR0YWNoU2Vzc2lvblRvSXRlbVJlc3BvbnNlIgASVgoNVHJpZ2dlclRyaWFnZRIgLnNlc3Npb24udjEuVHJpZ2dlclRyaWFnZVJlcXVlc3QaIS5zZXNzaW9uLnYxLlRyaWdnZXJUcmlhZ2VSZXNwb25zZSIAElAKC0FwcHJvdmVQbGFuEh4uc2Vzc2lvbi52MS5BcHByb3ZlUGxhblJlcXVlc3QaHy5zZXNzaW9uLnYxLkFwcHJvdmVQbGFuUmVzcG9uc2UiABJcCg9TdWdnZXN0TmV4dEl0ZW0SIi5zZXNzaW9uLnYxLlN1Z2dlc3ROZXh0SXRlbVJlcXVlc3QaIy5zZXNzaW9uLnYxLlN1Z2dlc3ROZXh0SXRlbVJlc3BvbnNlIgASXAoPT3ZlcnJpZGVWZXJkaWN0EiIuc2Vzc2lvbi52MS5PdmVycmlkZVZlcmRpY3RSZXF1ZXN0GiMuc2Vzc2lvbi52MS5PdmVycmlkZVZlcmRpY3RSZXNwb25zZSIAElwKD1RyaWdnZXJSZVJldmlldxIiLnNlc3Npb24udjEuVHJpZ2dlclJlUmV2aWV3UmVxdWVzdBojLnNlc3Npb24udjEuVHJpZ2dlclJlUmV2aWV3UmVzcG9uc2UiABJQCgtUcmlnZ2VyU3luYxIeLnNlc3Npb24udjEuVHJpZ2dlclN5bmNSZXF1ZXN0Gh8uc2Vzc2lvbi52MS5UcmlnZ2VyU3luY1Jlc3BvbnNlIgASXwoQQ3JlYXRlSXRlbVNvdXJjZRIjLnNlc3Npb24udjEuQ3JlYXRlSXRlbVNvdXJjZVJlcXVlc3QaJC5zZXNzaW9uLnYxLkNyZWF0ZUl0ZW1Tb3VyY2VSZXNwb25zZSIAElwKD0xpc3RJdGVtU291cmNlcxIiLnNlc3Npb24udjEuTGlzdEl0ZW1Tb3VyY2VzUmVxdWVzdBojLnNlc3Npb24udjEuTGlzdEl0ZW1Tb3VyY2VzUmVzcG9uc2UiABJfChBVcGRhdGVJdGVtU291cmNlEiMuc2Vzc2lvbi52MS5VcGRhdGVJdGVtU291cmNlUmVxdWVzdBokLnNlc3Npb24udjEuVXBkYXRlSXRlbVNvdXJjZVJlc3BvbnNlIgASXwoQRGVsZXRlSXRlbVNvdXJjZRIjLnNlc3Npb24udjEuRGVsZXRlSXRlbVNvdXJjZVJlcXVlc3QaJC5zZXNzaW9uLnYxLkRlbGV0ZUl0ZW1Tb3VyY2VSZXNwb25zZSIAElkKDkdldFN5bmNIaXN0b3J5EiEuc2Vzc2lvbi52MS5HZXRTeW5jSGlzdG9yeVJlcXVlc3QaIi5zZXNzaW9uLnYxLkdldFN5bmNIaXN0b3J5UmVzcG9uc2UiAEKsAQoOY29tLnNlc3Npb24udjFCDEJhY2tsb2dQcm90b1ABWkNnaXRodWIuY29tL3RzdGFwbGVyL3N0YXBsZXItc3F1YWQvZ2VuL3Byb3RvL2dvL3Nlc3Npb24vdjE7c2Vzc2lvbnYxogIDU1hYqgIKU2Vzc2lvbi5WMcoCClNlc3Npb25cVjHiAhZTZXNzaW9uXFYxXEdQQk1ldGFkYXRh6gILU2Vzc2lvbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChhzZXNzaW9uL3YxL2JhY2tsb2cucHJvdG8SCnNlc3Npb24udjEiOgoLQWNDcml0ZXJpb24SDQoFaW5kZXgYASABKAUSDAoEdGV4dBgCIAEoCRIOCgZzdGF0dXMYAyABKAkiTgoQQ3JpdGVyaW9uVmVyZGljdBIXCg9jcml0ZXJpb25faW5kZXgYASABKAUSDwoHb3V0Y29tZRgCIAEoCRIQCghldmlkZW5jZRgDIAEoCSL9AgoNUmV2aWV3VmVyZGljdBIKCgJpZBgBIAEoCRIXCg9vdmVyYWxsX291dGNvbWUYAiABKAkSMwoNcGVyX2NyaXRlcmlvbhgDIAMoCzIcLnNlc3Npb24udjEuQ3JpdGVyaW9uVmVyZGljdBIPCgdzdW1tYXJ5GAQgASgJEhEKCWRpZmZfaGFzaBgFIAEoCRIYChBkaWZmX3Rva2VuX2NvdW50GAYgASgFEhYKDmRpZmZfdHJ1bmNhdGVkGAcgASgIEhMKC292ZXJyaWRlX2J5GAggASgJEhcKD292ZXJyaWRlX3JlYXNvbhgJIAEoCRIvCgtvdmVycmlkZV9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoGY2hlY2tzGAwgAygLMh0uc2Vzc2lvbi52MS5SZXZpZXdDaGVja1Jlc3VsdCKxAwoLSXRlbVNlc3Npb24SCgoCaWQYASABKAkSFAoMc2Vzc2lvbl91dWlkGAIgASgJEhQKDHNlc3Npb25fcm9sZRgDIAEoCRIuCgpzdGFydGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGwoTbGFzdF9jb21taXRfbWVzc2FnZRgGIAEoCRIyCg5sYXN0X2NvbW1pdF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASIAoYY29tbWl0X2NvdW50X3NpbmNlX3NwYXduGAggASgFEjYKEmxhc3RfZmlsZV90b3VjaF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoOcmV2aWV3X3ZlcmRpY3QYCyABKAsyGS5zZXNzaW9uLnYxLlJldmlld1ZlcmRpY3QiuwQKC0JhY2tsb2dJdGVtEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjQKE2FjY2VwdGFuY2VfY3JpdGVyaWEYBCADKAsyFy5zZXNzaW9uLnYxLkFjQ3JpdGVyaW9uEhAKCHByaW9yaXR5GAUgASgFEg4KBnN0YXR1cxgGIAEoCRIRCglyZXBvX3BhdGgYByABKAkSGAoQc2tpcF9yZXZpZXdfZ2F0ZRgIIAEoCBIVCg1za2lwX3BsYW5uaW5nGAkgASgIEhUKDXBsYW5fYXBwcm92ZWQYCiABKAgSNAoQcGxhbl9hcHByb3ZlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGwoTcGxhbl9hcnRpZmFjdHNfcGF0aBgMIAEoCRINCgVub3RlcxgNIAEoCRITCgtleHRlcm5hbF9pZBgOIAEoCRIvCgthcmNoaXZlZF9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoNaXRlbV9zZXNzaW9ucxgSIAMoCzIXLnNlc3Npb24udjEuSXRlbVNlc3Npb24SEQoJc291cmNlX2lkGBMgASgJIoACCgpJdGVtU291cmNlEgoKAmlkGAEgASgJEhEKCXBsdWdpbl9pZBgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSDwoHZW5hYmxlZBgEIAEoCBIyCg5sYXN0X3N5bmNlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGAoQdG9rZW5fY29uZmlndXJlZBgGIAEoCBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLxAQoPU291cmNlU3luY0V2ZW50EgoKAmlkGAEgASgJEi4KCnN0YXJ0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC2ZpbmlzaGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1pdGVtc19jcmVhdGVkGAQgASgFEhUKDWl0ZW1zX3VwZGF0ZWQYBSABKAUSFQoNaXRlbXNfc2tpcHBlZBgGIAEoBRIVCg1pdGVtc19lcnJvcmVkGAcgASgFEhUKDWVycm9yX21lc3NhZ2UYCCABKAki2QEKGENyZWF0ZUJhY2tsb2dJdGVtUmVxdWVzdBINCgV0aXRsZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRI0ChNhY2NlcHRhbmNlX2NyaXRlcmlhGAMgAygLMhcuc2Vzc2lvbi52MS5BY0NyaXRlcmlvbhIQCghwcmlvcml0eRgEIAEoBRIYChBza2lwX3Jldmlld19nYXRlGAUgASgIEhUKDXNraXBfcGxhbm5pbmcYBiABKAgSEQoJcmVwb19wYXRoGAcgASgJEg0KBW5vdGVzGAggASgJIkIKGUNyZWF0ZUJhY2tsb2dJdGVtUmVzcG9uc2USJQoEaXRlbRgBIAEoCzIXLnNlc3Npb24udjEuQmFja2xvZ0l0ZW0iKAoVR2V0QmFja2xvZ0l0ZW1SZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiPwoWR2V0QmFja2xvZ0l0ZW1SZXNwb25zZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbSJmChdMaXN0QmFja2xvZ0l0ZW1zUmVxdWVzdBIOCgZzdGF0dXMYASADKAkSEAoIcHJpb3JpdHkYAiADKAUSDwoHc29ydF9ieRgDIAEoCRIYChBpbmNsdWRlX3Rlcm1pbmFsGAQgASgIIkIKGExpc3RCYWNrbG9nSXRlbXNSZXNwb25zZRImCgVpdGVtcxgBIAMoCzIXLnNlc3Npb24udjEuQmFja2xvZ0l0ZW0ivAIKGFVwZGF0ZUJhY2tsb2dJdGVtUmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjQKE2FjY2VwdGFuY2VfY3JpdGVyaWEYBCADKAsyFy5zZXNzaW9uLnYxLkFjQ3JpdGVyaW9uEhAKCHByaW9yaXR5GAUgASgFEhgKEHNraXBfcmV2aWV3X2dhdGUYBiABKAgSFQoNc2tpcF9wbGFubmluZxgHIAEoCBIRCglyZXBvX3BhdGgYCCABKAkSDQoFbm90ZXMYCSABKAkSFwoPZXhwZWN0ZWRfc3RhdHVzGAogASgJEjcKE2V4cGVjdGVkX3VwZGF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkIKGVVwZGF0ZUJhY2tsb2dJdGVtUmVzcG9uc2USJQoEaXRlbRgBIAEoCzIXLnNlc3Npb24udjEuQmFja2xvZ0l0ZW0iLAoZQXJjaGl2ZUJhY2tsb2dJdGVtUmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJIkMKGkFyY2hpdmVCYWNrbG9nSXRlbVJlc3BvbnNlEiUKBGl0ZW0YASABKAsyFy5zZXNzaW9uLnYxLkJhY2tsb2dJdGVtIrcBCiJUcmFuc2l0aW9uQmFja2xvZ0l0ZW1TdGF0dXNSZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkSFQoNdGFyZ2V0X3N0YXR1cxgCIAEoCRIXCg9leHBlY3RlZF9zdGF0dXMYAyABKAkSNwoTZXhwZWN0ZWRfdXBkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPb3ZlcnJpZGVfcmVhc29uGAUgASgJIkwKI1RyYW5zaXRpb25CYWNrbG9nSXRlbVN0YXR1c1Jlc3BvbnNlEiUKBGl0ZW0YASABKAsyFy5zZXNzaW9uLnYxLkJhY2tsb2dJdGVtItYBChtTcGF3blNlc3Npb25Gcm9tSXRlbVJlcXVlc3QSDwoHaXRlbV9pZBgBIAEoCRIQCgh0ZW1wbGF0ZRgCIAEoCRJaChJ0ZW1wbGF0ZV92YXJpYWJsZXMYAyADKAsyPi5zZXNzaW9uLnYxLlNwYXduU2Vzc2lvbkZyb21JdGVtUmVxdWVzdC5UZW1wbGF0ZVZhcmlhYmxlc0VudHJ5GjgKFlRlbXBsYXRlVmFyaWFibGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJjChxTcGF3blNlc3Npb25Gcm9tSXRlbVJlc3BvbnNlEhQKDHNlc3Npb25fdXVpZBgBIAEoCRItCgxpdGVtX3Nlc3Npb24YAiABKAsyFy5zZXNzaW9uLnYxLkl0ZW1TZXNzaW9uIkMKGkF0dGFjaFNlc3Npb25Ub0l0ZW1SZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkSFAoMc2Vzc2lvbl91dWlkGAIgASgJIkwKG0F0dGFjaFNlc3Npb25Ub0l0ZW1SZXNwb25zZRItCgxpdGVtX3Nlc3Npb24YASABKAsyFy5zZXNzaW9uLnYxLkl0ZW1TZXNzaW9uIicKFFRyaWdnZXJUcmlhZ2VSZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiRgoVVHJpZ2dlclRyaWFnZVJlc3BvbnNlEi0KDGl0ZW1fc2Vzc2lvbhgBIAEoCzIXLnNlc3Npb24udjEuSXRlbVNlc3Npb24iJQoSQXBwcm92ZVBsYW5SZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiPAoTQXBwcm92ZVBsYW5SZXNwb25zZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbSIYChZTdWdnZXN0TmV4dEl0ZW1SZXF1ZXN0Im8KF1N1Z2dlc3ROZXh0SXRlbVJlc3BvbnNlEi0KDGl0ZW1fc2Vzc2lvbhgBIAEoCzIXLnNlc3Npb24udjEuSXRlbVNlc3Npb24SJQoEaXRlbRgCIAEoCzIXLnNlc3Npb24udjEuQmFja2xvZ0l0ZW0iXQoWT3ZlcnJpZGVWZXJkaWN0UmVxdWVzdBIXCg9pdGVtX3Nlc3Npb25faWQYASABKAkSEQoJdG9fc3RhdHVzGAIgASgJEhcKD292ZXJyaWRlX3JlYXNvbhgDIAEoCSJAChdPdmVycmlkZVZlcmRpY3RSZXNwb25zZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbSIpChZUcmlnZ2VyUmVSZXZpZXdSZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkiSAoXVHJpZ2dlclJlUmV2aWV3UmVzcG9uc2USLQoMaXRlbV9zZXNzaW9uGAEgASgLMhcuc2Vzc2lvbi52MS5JdGVtU2Vzc2lvbiInChJUcmlnZ2VyU3luY1JlcXVlc3QSEQoJc291cmNlX2lkGAEgASgJIhUKE1RyaWdnZXJTeW5jUmVzcG9uc2UiZgoXQ3JlYXRlSXRlbVNvdXJjZVJlcXVlc3QSEQoJcGx1Z2luX2lkGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgtjb25maWdfanNvbhgDIAEoCRINCgV0b2tlbhgEIAEoCSJCChhDcmVhdGVJdGVtU291cmNlUmVzcG9uc2USJgoGc291cmNlGAEgASgLMhYuc2Vzc2lvbi52MS5JdGVtU291cmNlIhgKFkxpc3RJdGVtU291cmNlc1JlcXVlc3QiQgoXTGlzdEl0ZW1Tb3VyY2VzUmVzcG9uc2USJwoHc291cmNlcxgBIAMoCzIWLnNlc3Npb24udjEuSXRlbVNvdXJjZSJiChdVcGRhdGVJdGVtU291cmNlUmVxdWVzdBIRCglzb3VyY2VfaWQYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEg8KB2VuYWJsZWQYAyABKAgSDQoFdG9rZW4YBCABKAkiQgoYVXBkYXRlSXRlbVNvdXJjZVJlc3BvbnNlEiYKBnNvdXJjZRgBIAEoCzIWLnNlc3Npb24udjEuSXRlbVNvdXJjZSIsChdEZWxldGVJdGVtU291cmNlUmVxdWVzdBIRCglzb3VyY2VfaWQYASABKAkiGgoYRGVsZXRlSXRlbVNvdXJjZVJlc3BvbnNlIioKFUdldFN5bmNIaXN0b3J5UmVxdWVzdBIRCglzb3VyY2VfaWQYASABKAkiRQoWR2V0U3luY0hpc3RvcnlSZXNwb25zZRIrCgZldmVudHMYASADKAsyGy5zZXNzaW9uLnYxLlNvdXJjZVN5bmNFdmVudCJrChFCYWNrbG9nRGVwZW5kZW5jeRIPCgdpdGVtX2lkGAEgASgJEhUKDWJsb2NrZWRfYnlfaWQYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAifwoQQmFja2xvZ0dyYXBoTm9kZRIlCgRpdGVtGAEgASgLMhcuc2Vzc2lvbi52MS5CYWNrbG9nSXRlbRIWCg5ibG9ja2VkX2J5X2lkcxgCIAMoCRIbChNwZW5kaW5nX2Jsb2NrZXJfaWRzGAMgAygJEg8KB2Jsb2NrZWQYBCABKAgiRQobQWRkQmFja2xvZ0RlcGVuZGVuY3lSZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkSFQoNYmxvY2tlZF9ieV9pZBgCIAEoCSJRChxBZGRCYWNrbG9nRGVwZW5kZW5jeVJlc3BvbnNlEjEKCmRlcGVuZGVuY3kYASABKAsyHS5zZXNzaW9uLnYxLkJhY2tsb2dEZXBlbmRlbmN5IkgKHlJlbW92ZUJhY2tsb2dEZXBlbmRlbmN5UmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJEhUKDWJsb2NrZWRfYnlfaWQYAiABKAkiIQofUmVtb3ZlQmFja2xvZ0RlcGVuZGVuY3lSZXNwb25zZSIpChZHZXRCYWNrbG9nR3JhcGhSZXF1ZXN0Eg8KB2l0ZW1faWQYASABKAkidAoXR2V0QmFja2xvZ0dyYXBoUmVzcG9uc2USKwoFbm9kZXMYASADKAsyHC5zZXNzaW9uLnYxLkJhY2tsb2dHcmFwaE5vZGUSLAoFZWRnZXMYAiADKAsyHS5zZXNzaW9uLnYxLkJhY2tsb2dEZXBlbmRlbmN5IlMKDFJ1YnJpY1Njb3JlcxITCgtjb3JyZWN0bmVzcxgBIAEoARINCgV0ZXN0cxgCIAEoARINCgVzdHlsZRgDIAEoARIQCghzZWN1cml0eRgEIAEoASKOAgoUUGFuZWxSZXZpZXdlclZlcmRpY3QSDAoEbmFtZRgBIAEoCRIPCgdwcm9ncmFtGAIgASgJEg4KBndlaWdodBgDIAEoARIUCgxzZXNzaW9uX3V1aWQYBCABKAkSDwoHb3V0Y29tZRgFIAEoCRIPCgdzdW1tYXJ5GAYgASgJEjMKDXBlcl9jcml0ZXJpb24YByADKAsyHC5zZXNzaW9uLnYxLkNyaXRlcmlvblZlcmRpY3QSKAoGcnVicmljGAggASgLMhguc2Vzc2lvbi52MS5SdWJyaWNTY29yZXMSMAoMc3VibWl0dGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKeAQoSUmV2aWV3RGlzYWdyZWVtZW50EhcKD2NyaXRlcmlvbl9pbmRleBgBIAEoBRI+CghvdXRjb21lcxgCIAMoCzIsLnNlc3Npb24udjEuUmV2aWV3RGlzYWdyZWVtZW50Lk91dGNvbWVzRW50cnkaLwoNT3V0Y29tZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIrQDCg5SZXZpZXdQYW5lbFJ1bhIKCgJpZBgBIAEoCRIPCgdpdGVtX2lkGAIgASgJEhEKCXJlcG9fcGF0aBgDIAEoCRIRCglkaWZmX2hhc2gYBCABKAkSEQoJY29uc2Vuc3VzGAUgASgJEhYKDnBhc3NfdGhyZXNob2xkGAYgASgBEjMKCXJldmlld2VycxgHIAMoCzIgLnNlc3Npb24udjEuUGFuZWxSZXZpZXdlclZlcmRpY3QSDwoHb3V0Y29tZRgIIAEoCRI1Cg1kaXNhZ3JlZW1lbnRzGAkgAygLMh4uc2Vzc2lvbi52MS5SZXZpZXdEaXNhZ3JlZW1lbnQSKAoGcnVicmljGAogASgLMhguc2Vzc2lvbi52MS5SdWJyaWNTY29yZXMSLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZGVjaWRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoGY2hlY2tzGA0gAygLMh0uc2Vzc2lvbi52MS5SZXZpZXdDaGVja1Jlc3VsdCJMChdMaXN0UmV2aWV3UGFuZWxzUmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJEhEKCXJlcG9fcGF0aBgCIAEoCRINCgVsaW1pdBgDIAEoBSJEChhMaXN0UmV2aWV3UGFuZWxzUmVzcG9uc2USKAoEcnVucxgBIAMoCzIaLnNlc3Npb24udjEuUmV2aWV3UGFuZWxSdW4i0gEKEFJ1YnJpY1RyZW5kUG9pbnQSDgoGcnVuX2lkGAEgASgJEg8KB2l0ZW1faWQYAiABKAkSDwoHb3V0Y29tZRgDIAEoCRIoCgZydWJyaWMYBCABKAsyGC5zZXNzaW9uLnYxLlJ1YnJpY1Njb3JlcxIuCgpkZWNpZGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg5yZXZpZXdlcl9jb3VudBgGIAEoBRIaChJkaXNhZ3JlZW1lbnRfY291bnQYByABKAUiPwobR2V0UmV2aWV3UnVicmljVHJlbmRSZXF1ZXN0EhEKCXJlcG9fcGF0aBgBIAEoCRINCgVsaW1pdBgCIAEoBSJ3ChxHZXRSZXZpZXdSdWJyaWNUcmVuZFJlc3BvbnNlEiwKBnBvaW50cxgBIAMoCzIcLnNlc3Npb24udjEuUnVicmljVHJlbmRQb2ludBIpCgdhdmVyYWdlGAIgASgLMhguc2Vzc2lvbi52MS5SdWJyaWNTY29yZXMicgoRUmV2aWV3Q2hlY2tSZXN1bHQSDAoEbmFtZRgBIAEoCRIMCgRraW5kGAIgASgJEg4KBnBhc3NlZBgDIAEoCBIMCgRoYXJkGAQgASgIEg4KBm91dHB1dBgFIAEoCRITCgtkdXJhdGlvbl9tcxgGIAEoAzLWEgoOQmFja2xvZ1NlcnZpY2USYgoRQ3JlYXRlQmFja2xvZ0l0ZW0SJC5zZXNzaW9uLnYxLkNyZWF0ZUJhY2tsb2dJdGVtUmVxdWVzdBolLnNlc3Npb24udjEuQ3JlYXRlQmFja2xvZ0l0ZW1SZXNwb25zZSIAElkKDkdldEJhY2tsb2dJdGVtEiEuc2Vzc2lvbi52MS5HZXRCYWNrbG9nSXRlbVJlcXVlc3QaIi5zZXNzaW9uLnYxLkdldEJhY2tsb2dJdGVtUmVzcG9uc2UiABJfChBMaXN0QmFja2xvZ0l0ZW1zEiMuc2Vzc2lvbi52MS5MaXN0QmFja2xvZ0l0ZW1zUmVxdWVzdBokLnNlc3Npb24udjEuTGlzdEJhY2tsb2dJdGVtc1Jlc3BvbnNlIgASYgoRVXBkYXRlQmFja2xvZ0l0ZW0SJC5zZXNzaW9uLnYxLlVwZGF0ZUJhY2tsb2dJdGVtUmVxdWVzdBolLnNlc3Npb24udjEuVXBkYXRlQmFja2xvZ0l0ZW1SZXNwb25zZSIAEmUKEkFyY2hpdmVCYWNrbG9nSXRlbRIlLnNlc3Npb24udjEuQXJjaGl2ZUJhY2tsb2dJdGVtUmVxdWVzdBomLnNlc3Npb24udjEuQXJjaGl2ZUJhY2tsb2dJdGVtUmVzcG9uc2UiABKAAQobVHJhbnNpdGlvbkJhY2tsb2dJdGVtU3RhdHVzEi4uc2Vzc2lvbi52MS5UcmFuc2l0aW9uQmFja2xvZ0l0ZW1TdGF0dXNSZXF1ZXN0Gi8uc2Vzc2lvbi52MS5UcmFuc2l0aW9uQmFja2xvZ0l0ZW1TdGF0dXNSZXNwb25zZSIAEmsKFFNwYXduU2Vzc2lvbkZyb21JdGVtEicuc2Vzc2lvbi52MS5TcGF3blNlc3Npb25Gcm9tSXRlbVJlcXVlc3QaKC5zZXNzaW9uLnYxLlNwYXduU2Vzc2lvbkZyb21JdGVtUmVzcG9uc2UiABJoChNBdHRhY2hTZXNzaW9uVG9JdGVtEiYuc2Vzc2lvbi52MS5BdHRhY2hTZXNzaW9uVG9JdGVtUmVxdWVzdBonLnNlc3Npb24udjEuQXR0YWNoU2Vzc2lvblRvSXRlbVJlc3BvbnNlIgASVgoNVHJpZ2dlclRyaWFnZRIgLnNlc3Npb24udjEuVHJpZ2dlclRyaWFnZVJlcXVlc3QaIS5zZXNzaW9uLnYxLlRyaWdnZXJUcmlhZ2VSZXNwb25zZSIAElAKC0FwcHJvdmVQbGFuEh4uc2Vzc2lvbi52MS5BcHByb3ZlUGxhblJlcXVlc3QaHy5zZXNzaW9uLnYxLkFwcHJvdmVQbGFuUmVzcG9uc2UiABJcCg9TdWdnZXN0TmV4dEl0ZW0SIi5zZXNzaW9uLnYxLlN1Z2dlc3ROZXh0SXRlbVJlcXVlc3QaIy5zZXNzaW9uLnYxLlN1Z2dlc3ROZXh0SXRlbVJlc3BvbnNlIgASXAoPT3ZlcnJpZGVWZXJkaWN0EiIuc2Vzc2lvbi52MS5PdmVycmlkZVZlcmRpY3RSZXF1ZXN0GiMuc2Vzc2lvbi52MS5PdmVycmlkZVZlcmRpY3RSZXNwb25zZSIAElwKD1RyaWdnZXJSZVJldmlldxIiLnNlc3Npb24udjEuVHJpZ2dlclJlUmV2aWV3UmVxdWVzdBojLnNlc3Npb24udjEuVHJpZ2dlclJlUmV2aWV3UmVzcG9uc2UiABJQCgtUcmlnZ2VyU3luYxIeLnNlc3Npb24udjEuVHJpZ2dlclN5bmNSZXF1ZXN0Gh8uc2Vzc2lvbi52MS5UcmlnZ2VyU3luY1Jlc3BvbnNlIgASXwoQQ3JlYXRlSXRlbVNvdXJjZRIjLnNlc3Npb24udjEuQ3JlYXRlSXRlbVNvdXJjZVJlcXVlc3QaJC5zZXNzaW9uLnYxLkNyZWF0ZUl0ZW1Tb3VyY2VSZXNwb25zZSIAElwKD0xpc3RJdGVtU291cmNlcxIiLnNlc3Npb24udjEuTGlzdEl0ZW1Tb3VyY2VzUmVxdWVzdBojLnNlc3Npb24udjEuTGlzdEl0ZW1Tb3VyY2VzUmVzcG9uc2UiABJfChBVcGRhdGVJdGVtU291cmNlEiMuc2Vzc2lvbi52MS5VcGRhdGVJdGVtU291cmNlUmVxdWVzdBokLnNlc3Npb24udjEuVXBkYXRlSXRlbVNvdXJjZVJlc3BvbnNlIgASXwoQRGVsZXRlSXRlbVNvdXJjZRIjLnNlc3Npb24udjEuRGVsZXRlSXRlbVNvdXJjZVJlcXVlc3QaJC5zZXNzaW9uLnYxLkRlbGV0ZUl0ZW1Tb3VyY2VSZXNwb25zZSIAElkKDkdldFN5bmNIaXN0b3J5EiEuc2Vzc2lvbi52MS5HZXRTeW5jSGlzdG9yeVJlcXVlc3QaIi5zZXNzaW9uLnYxLkdldFN5bmNIaXN0b3J5UmVzcG9uc2UiABJrChRBZGRCYWNrbG9nRGVwZW5kZW5jeRInLnNlc3Npb24udjEuQWRkQmFja2xvZ0RlcGVuZGVuY3lSZXF1ZXN0Giguc2Vzc2lvbi52MS5BZGRCYWNrbG9nRGVwZW5kZW5jeVJlc3BvbnNlIgASdAoXUmVtb3ZlQmFja2xvZ0RlcGVuZGVuY3kSKi5zZXNzaW9uLnYxLlJlbW92ZUJhY2tsb2dEZXBlbmRlbmN5UmVxdWVzdBorLnNlc3Npb24udjEuUmVtb3ZlQmFja2xvZ0RlcGVuZGVuY3lSZXNwb25zZSIAElwKD0dldEJhY2tsb2dHcmFwaBIiLnNlc3Npb24udjEuR2V0QmFja2xvZ0dyYXBoUmVxdWVzdBojLnNlc3Npb24udjEuR2V0QmFja2xvZ0dyYXBoUmVzcG9uc2UiABJfChBMaXN0UmV2aWV3UGFuZWxzEiMuc2Vzc2lvbi52MS5MaXN0UmV2aWV3UGFuZWxzUmVxdWVzdBokLnNlc3Npb24udjEuTGlzdFJldmlld1BhbmVsc1Jlc3BvbnNlIgASawoUR2V0UmV2aWV3UnVicmljVHJlbmQSJy5zZXNzaW9uLnYxLkdldFJldmlld1J1YnJpY1RyZW5kUmVxdWVzdBooLnNlc3Npb24udjEuR2V0UmV2aWV3UnVicmljVHJlbmRSZXNwb25zZSIAQqwBCg5jb20uc2Vzc2lvbi52MUIMQmFja2xvZ1Byb3RvUAFaQ2dpdGh1Yi5jb20vdHN0YXBsZXIvc3RhcGxlci1zcXVhZC9nZW4vcHJvdG8vZ28vc2Vzc2lvbi92MTtzZXNzaW9udjGiAgNTWFiqAgpTZXNzaW9uLlYxygIKU2Vzc2lvblxWMeICFlNlc3Npb25cVjFcR1BCTWV0YWRhdGHqAgtTZXNzaW9uOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * AcCriterion represents a single acceptance criterion for a backlog item.
@@ -135,6 +135,13 @@ export type ReviewVerdict = Message<"session.v1.ReviewVerdict"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 11;
    */
   createdAt?: Timestamp;
+
+  /**
+   * Deterministic checks the gate ran before the reviewers.
+   *
+   * @generated from field: repeated session.v1.ReviewCheckResult checks = 12;
+   */
+  checks: ReviewCheckResult[];
 };
 
 /**
@@ -763,6 +770,21 @@ export type SpawnSessionFromItemRequest = Message<"session.v1.SpawnSessionFromIt
    * @generated from field: string item_id = 1;
    */
   itemId: string;
+
+  /**
+   * Optional session template (name, optionally name@version). The item's
+   * context is appended to the template's prompt, and its title, description
+   * and ID fill {{item_title}}, {{item_description}} and {{item_id}} when the
+   * template declares them.
+   *
+   * @generated from field: string template = 2;
+   */
+  template: string;
+
+  /**
+   * @generated from field: map<string, string> template_variables = 3;
+   */
+  templateVariables: { [key: string]: string };
 };
 
 /**
@@ -1238,6 +1260,599 @@ export type GetSyncHistoryResponse = Message<"session.v1.GetSyncHistoryResponse"
 export const GetSyncHistoryResponseSchema: GenMessage<GetSyncHistoryResponse> = /*@__PURE__*/
   messageDesc(file_session_v1_backlog, 44);
 
+/**
+ * BacklogDependency is a "blocked by" edge: item_id cannot start until
+ * blocked_by_id is done or has a passing review verdict.
+ *
+ * @generated from message session.v1.BacklogDependency
+ */
+export type BacklogDependency = Message<"session.v1.BacklogDependency"> & {
+  /**
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: string blocked_by_id = 2;
+   */
+  blockedById: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message session.v1.BacklogDependency.
+ * Use `create(BacklogDependencySchema)` to create a new message.
+ */
+export const BacklogDependencySchema: GenMessage<BacklogDependency> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 45);
+
+/**
+ * BacklogGraphNode is a backlog item in the dependency graph.
+ *
+ * @generated from message session.v1.BacklogGraphNode
+ */
+export type BacklogGraphNode = Message<"session.v1.BacklogGraphNode"> & {
+  /**
+   * @generated from field: session.v1.BacklogItem item = 1;
+   */
+  item?: BacklogItem;
+
+  /**
+   * Direct blockers of the item.
+   *
+   * @generated from field: repeated string blocked_by_ids = 2;
+   */
+  blockedByIds: string[];
+
+  /**
+   * Direct blockers that are not yet done or passed review.
+   *
+   * @generated from field: repeated string pending_blocker_ids = 3;
+   */
+  pendingBlockerIds: string[];
+
+  /**
+   * @generated from field: bool blocked = 4;
+   */
+  blocked: boolean;
+};
+
+/**
+ * Describes the message session.v1.BacklogGraphNode.
+ * Use `create(BacklogGraphNodeSchema)` to create a new message.
+ */
+export const BacklogGraphNodeSchema: GenMessage<BacklogGraphNode> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 46);
+
+/**
+ * @generated from message session.v1.AddBacklogDependencyRequest
+ */
+export type AddBacklogDependencyRequest = Message<"session.v1.AddBacklogDependencyRequest"> & {
+  /**
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: string blocked_by_id = 2;
+   */
+  blockedById: string;
+};
+
+/**
+ * Describes the message session.v1.AddBacklogDependencyRequest.
+ * Use `create(AddBacklogDependencyRequestSchema)` to create a new message.
+ */
+export const AddBacklogDependencyRequestSchema: GenMessage<AddBacklogDependencyRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 47);
+
+/**
+ * @generated from message session.v1.AddBacklogDependencyResponse
+ */
+export type AddBacklogDependencyResponse = Message<"session.v1.AddBacklogDependencyResponse"> & {
+  /**
+   * @generated from field: session.v1.BacklogDependency dependency = 1;
+   */
+  dependency?: BacklogDependency;
+};
+
+/**
+ * Describes the message session.v1.AddBacklogDependencyResponse.
+ * Use `create(AddBacklogDependencyResponseSchema)` to create a new message.
+ */
+export const AddBacklogDependencyResponseSchema: GenMessage<AddBacklogDependencyResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 48);
+
+/**
+ * @generated from message session.v1.RemoveBacklogDependencyRequest
+ */
+export type RemoveBacklogDependencyRequest = Message<"session.v1.RemoveBacklogDependencyRequest"> & {
+  /**
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: string blocked_by_id = 2;
+   */
+  blockedById: string;
+};
+
+/**
+ * Describes the message session.v1.RemoveBacklogDependencyRequest.
+ * Use `create(RemoveBacklogDependencyRequestSchema)` to create a new message.
+ */
+export const RemoveBacklogDependencyRequestSchema: GenMessage<RemoveBacklogDependencyRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 49);
+
+/**
+ * @generated from message session.v1.RemoveBacklogDependencyResponse
+ */
+export type RemoveBacklogDependencyResponse = Message<"session.v1.RemoveBacklogDependencyResponse"> & {
+};
+
+/**
+ * Describes the message session.v1.RemoveBacklogDependencyResponse.
+ * Use `create(RemoveBacklogDependencyResponseSchema)` to create a new message.
+ */
+export const RemoveBacklogDependencyResponseSchema: GenMessage<RemoveBacklogDependencyResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 50);
+
+/**
+ * @generated from message session.v1.GetBacklogGraphRequest
+ */
+export type GetBacklogGraphRequest = Message<"session.v1.GetBacklogGraphRequest"> & {
+  /**
+   * Restrict the graph to the item's transitive blockers and dependents.
+   * Empty returns every item that has at least one dependency edge.
+   *
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+};
+
+/**
+ * Describes the message session.v1.GetBacklogGraphRequest.
+ * Use `create(GetBacklogGraphRequestSchema)` to create a new message.
+ */
+export const GetBacklogGraphRequestSchema: GenMessage<GetBacklogGraphRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 51);
+
+/**
+ * @generated from message session.v1.GetBacklogGraphResponse
+ */
+export type GetBacklogGraphResponse = Message<"session.v1.GetBacklogGraphResponse"> & {
+  /**
+   * @generated from field: repeated session.v1.BacklogGraphNode nodes = 1;
+   */
+  nodes: BacklogGraphNode[];
+
+  /**
+   * @generated from field: repeated session.v1.BacklogDependency edges = 2;
+   */
+  edges: BacklogDependency[];
+};
+
+/**
+ * Describes the message session.v1.GetBacklogGraphResponse.
+ * Use `create(GetBacklogGraphResponseSchema)` to create a new message.
+ */
+export const GetBacklogGraphResponseSchema: GenMessage<GetBacklogGraphResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 52);
+
+/**
+ * RubricScores rates a change from 0 to 10 on each review dimension.
+ *
+ * @generated from message session.v1.RubricScores
+ */
+export type RubricScores = Message<"session.v1.RubricScores"> & {
+  /**
+   * @generated from field: double correctness = 1;
+   */
+  correctness: number;
+
+  /**
+   * @generated from field: double tests = 2;
+   */
+  tests: number;
+
+  /**
+   * @generated from field: double style = 3;
+   */
+  style: number;
+
+  /**
+   * @generated from field: double security = 4;
+   */
+  security: number;
+};
+
+/**
+ * Describes the message session.v1.RubricScores.
+ * Use `create(RubricScoresSchema)` to create a new message.
+ */
+export const RubricScoresSchema: GenMessage<RubricScores> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 53);
+
+/**
+ * PanelReviewerVerdict is one reviewer's verdict within a review panel run.
+ * outcome is empty until the reviewer submits.
+ *
+ * @generated from message session.v1.PanelReviewerVerdict
+ */
+export type PanelReviewerVerdict = Message<"session.v1.PanelReviewerVerdict"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string program = 2;
+   */
+  program: string;
+
+  /**
+   * @generated from field: double weight = 3;
+   */
+  weight: number;
+
+  /**
+   * @generated from field: string session_uuid = 4;
+   */
+  sessionUuid: string;
+
+  /**
+   * @generated from field: string outcome = 5;
+   */
+  outcome: string;
+
+  /**
+   * @generated from field: string summary = 6;
+   */
+  summary: string;
+
+  /**
+   * @generated from field: repeated session.v1.CriterionVerdict per_criterion = 7;
+   */
+  perCriterion: CriterionVerdict[];
+
+  /**
+   * @generated from field: session.v1.RubricScores rubric = 8;
+   */
+  rubric?: RubricScores;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp submitted_at = 9;
+   */
+  submittedAt?: Timestamp;
+};
+
+/**
+ * Describes the message session.v1.PanelReviewerVerdict.
+ * Use `create(PanelReviewerVerdictSchema)` to create a new message.
+ */
+export const PanelReviewerVerdictSchema: GenMessage<PanelReviewerVerdict> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 54);
+
+/**
+ * ReviewDisagreement is a point on which reviewers reached different outcomes.
+ *
+ * @generated from message session.v1.ReviewDisagreement
+ */
+export type ReviewDisagreement = Message<"session.v1.ReviewDisagreement"> & {
+  /**
+   * -1 for the overall outcome.
+   *
+   * @generated from field: int32 criterion_index = 1;
+   */
+  criterionIndex: number;
+
+  /**
+   * Reviewer name → outcome.
+   *
+   * @generated from field: map<string, string> outcomes = 2;
+   */
+  outcomes: { [key: string]: string };
+};
+
+/**
+ * Describes the message session.v1.ReviewDisagreement.
+ * Use `create(ReviewDisagreementSchema)` to create a new message.
+ */
+export const ReviewDisagreementSchema: GenMessage<ReviewDisagreement> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 55);
+
+/**
+ * ReviewPanelRun is one review-gate evaluation of an item by a panel of
+ * reviewers that all saw the same diff.
+ *
+ * @generated from message session.v1.ReviewPanelRun
+ */
+export type ReviewPanelRun = Message<"session.v1.ReviewPanelRun"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string item_id = 2;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: string repo_path = 3;
+   */
+  repoPath: string;
+
+  /**
+   * @generated from field: string diff_hash = 4;
+   */
+  diffHash: string;
+
+  /**
+   * "unanimous", "majority" or "weighted".
+   *
+   * @generated from field: string consensus = 5;
+   */
+  consensus: string;
+
+  /**
+   * @generated from field: double pass_threshold = 6;
+   */
+  passThreshold: number;
+
+  /**
+   * @generated from field: repeated session.v1.PanelReviewerVerdict reviewers = 7;
+   */
+  reviewers: PanelReviewerVerdict[];
+
+  /**
+   * Consensus outcome; empty until every reviewer has submitted.
+   *
+   * @generated from field: string outcome = 8;
+   */
+  outcome: string;
+
+  /**
+   * @generated from field: repeated session.v1.ReviewDisagreement disagreements = 9;
+   */
+  disagreements: ReviewDisagreement[];
+
+  /**
+   * Weighted mean of the reviewers' rubric scores.
+   *
+   * @generated from field: session.v1.RubricScores rubric = 10;
+   */
+  rubric?: RubricScores;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp decided_at = 12;
+   */
+  decidedAt?: Timestamp;
+
+  /**
+   * Deterministic checks run before the reviewers. When a hard check fails
+   * the run is decided FAIL with no reviewers.
+   *
+   * @generated from field: repeated session.v1.ReviewCheckResult checks = 13;
+   */
+  checks: ReviewCheckResult[];
+};
+
+/**
+ * Describes the message session.v1.ReviewPanelRun.
+ * Use `create(ReviewPanelRunSchema)` to create a new message.
+ */
+export const ReviewPanelRunSchema: GenMessage<ReviewPanelRun> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 56);
+
+/**
+ * @generated from message session.v1.ListReviewPanelsRequest
+ */
+export type ListReviewPanelsRequest = Message<"session.v1.ListReviewPanelsRequest"> & {
+  /**
+   * Filters; empty matches all.
+   *
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: string repo_path = 2;
+   */
+  repoPath: string;
+
+  /**
+   * Maximum runs to return, newest first. 0 uses the server default.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message session.v1.ListReviewPanelsRequest.
+ * Use `create(ListReviewPanelsRequestSchema)` to create a new message.
+ */
+export const ListReviewPanelsRequestSchema: GenMessage<ListReviewPanelsRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 57);
+
+/**
+ * @generated from message session.v1.ListReviewPanelsResponse
+ */
+export type ListReviewPanelsResponse = Message<"session.v1.ListReviewPanelsResponse"> & {
+  /**
+   * @generated from field: repeated session.v1.ReviewPanelRun runs = 1;
+   */
+  runs: ReviewPanelRun[];
+};
+
+/**
+ * Describes the message session.v1.ListReviewPanelsResponse.
+ * Use `create(ListReviewPanelsResponseSchema)` to create a new message.
+ */
+export const ListReviewPanelsResponseSchema: GenMessage<ListReviewPanelsResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 58);
+
+/**
+ * RubricTrendPoint is the rubric of one decided review panel run.
+ *
+ * @generated from message session.v1.RubricTrendPoint
+ */
+export type RubricTrendPoint = Message<"session.v1.RubricTrendPoint"> & {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId: string;
+
+  /**
+   * @generated from field: string item_id = 2;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: string outcome = 3;
+   */
+  outcome: string;
+
+  /**
+   * @generated from field: session.v1.RubricScores rubric = 4;
+   */
+  rubric?: RubricScores;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp decided_at = 5;
+   */
+  decidedAt?: Timestamp;
+
+  /**
+   * @generated from field: int32 reviewer_count = 6;
+   */
+  reviewerCount: number;
+
+  /**
+   * @generated from field: int32 disagreement_count = 7;
+   */
+  disagreementCount: number;
+};
+
+/**
+ * Describes the message session.v1.RubricTrendPoint.
+ * Use `create(RubricTrendPointSchema)` to create a new message.
+ */
+export const RubricTrendPointSchema: GenMessage<RubricTrendPoint> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 59);
+
+/**
+ * @generated from message session.v1.GetReviewRubricTrendRequest
+ */
+export type GetReviewRubricTrendRequest = Message<"session.v1.GetReviewRubricTrendRequest"> & {
+  /**
+   * Restrict to one repository; empty covers all.
+   *
+   * @generated from field: string repo_path = 1;
+   */
+  repoPath: string;
+
+  /**
+   * Maximum points (the most recent ones). 0 uses the server default.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message session.v1.GetReviewRubricTrendRequest.
+ * Use `create(GetReviewRubricTrendRequestSchema)` to create a new message.
+ */
+export const GetReviewRubricTrendRequestSchema: GenMessage<GetReviewRubricTrendRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 60);
+
+/**
+ * @generated from message session.v1.GetReviewRubricTrendResponse
+ */
+export type GetReviewRubricTrendResponse = Message<"session.v1.GetReviewRubricTrendResponse"> & {
+  /**
+   * @generated from field: repeated session.v1.RubricTrendPoint points = 1;
+   */
+  points: RubricTrendPoint[];
+
+  /**
+   * Mean of the returned points' rubric scores.
+   *
+   * @generated from field: session.v1.RubricScores average = 2;
+   */
+  average?: RubricScores;
+};
+
+/**
+ * Describes the message session.v1.GetReviewRubricTrendResponse.
+ * Use `create(GetReviewRubricTrendResponseSchema)` to create a new message.
+ */
+export const GetReviewRubricTrendResponseSchema: GenMessage<GetReviewRubricTrendResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 61);
+
+/**
+ * ReviewCheckResult is the outcome of one deterministic review-gate check
+ * (build, lint, tests, license headers, forbidden paths).
+ *
+ * @generated from message session.v1.ReviewCheckResult
+ */
+export type ReviewCheckResult = Message<"session.v1.ReviewCheckResult"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * "command", "license_header" or "forbidden_paths".
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: bool passed = 3;
+   */
+  passed: boolean;
+
+  /**
+   * A failed hard check fails the gate without spawning reviewers.
+   *
+   * @generated from field: bool hard = 4;
+   */
+  hard: boolean;
+
+  /**
+   * Tail of the command output, or the offending files.
+   *
+   * @generated from field: string output = 5;
+   */
+  output: string;
+
+  /**
+   * @generated from field: int64 duration_ms = 6;
+   */
+  durationMs: bigint;
+};
+
+/**
+ * Describes the message session.v1.ReviewCheckResult.
+ * Use `create(ReviewCheckResultSchema)` to create a new message.
+ */
+export const ReviewCheckResultSchema: GenMessage<ReviewCheckResult> = /*@__PURE__*/
+  messageDesc(file_session_v1_backlog, 62);
+
 /**
  * BacklogService manages backlog items and their lifecycle through AI-assisted
  * planning, implementation, and review workflows.
@@ -1435,6 +2050,59 @@ export const BacklogService: GenService<{
     input: typeof GetSyncHistoryRequestSchema;
     output: typeof GetSyncHistoryResponseSchema;
   },
+  /**
+   * AddBacklogDependency records that an item is blocked by another item.
+   * Edges that would create a dependency cycle are rejected.
+   *
+   * @generated from rpc session.v1.BacklogService.AddBacklogDependency
+   */
+  addBacklogDependency: {
+    methodKind: "unary";
+    input: typeof AddBacklogDependencyRequestSchema;
+    output: typeof AddBacklogDependencyResponseSchema;
+  },
+  /**
+   * RemoveBacklogDependency deletes a "blocked by" edge.
+   *
+   * @generated from rpc session.v1.BacklogService.RemoveBacklogDependency
+   */
+  removeBacklogDependency: {
+    methodKind: "unary";
+    input: typeof RemoveBacklogDependencyRequestSchema;
+    output: typeof RemoveBacklogDependencyResponseSchema;
+  },
+  /**
+   * GetBacklogGraph returns the dependency graph with each item's blocked state.
+   *
+   * @generated from rpc session.v1.BacklogService.GetBacklogGraph
+   */
+  getBacklogGraph: {
+    methodKind: "unary";
+    input: typeof GetBacklogGraphRequestSchema;
+    output: typeof GetBacklogGraphResponseSchema;
+  },
+  /**
+   * ListReviewPanels returns review-gate panel runs with each reviewer's
+   * verdict, rubric scores and the disagreements between reviewers.
+   *
+   * @generated from rpc session.v1.BacklogService.ListReviewPanels
+   */
+  listReviewPanels: {
+    methodKind: "unary";
+    input: typeof ListReviewPanelsRequestSchema;
+    output: typeof ListReviewPanelsResponseSchema;
+  },
+  /**
+   * GetReviewRubricTrend returns the rubric scores of decided review panels
+   * over time, oldest first.
+   *
+   * @generated from rpc session.v1.BacklogService.GetReviewRubricTrend
+   */
+  getReviewRubricTrend: {
+    methodKind: "unary";
+    input: typeof GetReviewRubricTrendRequestSchema;
+    output: typeof GetReviewRubricTrendResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_session_v1_backlog, 0);
 
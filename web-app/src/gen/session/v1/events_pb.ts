@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file session/v1/events.proto.
  */
 export const file_session_v1_events: GenFile = /*@__PURE__*/
-  fileDesc("ChdzZXNzaW9uL3YxL2V2ZW50cy5wcm90bxIKc2Vzc2lvbi52MSLDBAoMU2Vzc2lvbkV2ZW50Ei0KCXRpbWVzdGFtcBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOgoPc2Vzc2lvbl9jcmVhdGVkGAIgASgLMh8uc2Vzc2lvbi52MS5TZXNzaW9uQ3JlYXRlZEV2ZW50SAASOgoPc2Vzc2lvbl91cGRhdGVkGAMgASgLMh8uc2Vzc2lvbi52MS5TZXNzaW9uVXBkYXRlZEV2ZW50SAASOgoPc2Vzc2lvbl9kZWxldGVkGAQgASgLMh8uc2Vzc2lvbi52MS5TZXNzaW9uRGVsZXRlZEV2ZW50SAASPwoOc3RhdHVzX2NoYW5nZWQYBSABKAsyJS5zZXNzaW9uLnYxLlNlc3Npb25TdGF0dXNDaGFuZ2VkRXZlbnRIABI8ChB1c2VyX2ludGVyYWN0aW9uGAYgASgLMiAuc2Vzc2lvbi52MS5Vc2VySW50ZXJhY3Rpb25FdmVudEgAEkQKFHNlc3Npb25fYWNrbm93bGVkZ2VkGAcgASgLMiQuc2Vzc2lvbi52MS5TZXNzaW9uQWNrbm93bGVkZ2VkRXZlbnRIABI+ChFhcHByb3ZhbF9yZXNwb25zZRgIIAEoCzIhLnNlc3Npb24udjEuQXBwcm92YWxSZXNwb25zZUV2ZW50SAASNQoMbm90aWZpY2F0aW9uGAkgASgLMh0uc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25FdmVudEgAEgsKA3NlcRgKIAEoBEIHCgVldmVudCI7ChNTZXNzaW9uQ3JlYXRlZEV2ZW50EiQKB3Nlc3Npb24YASABKAsyEy5zZXNzaW9uLnYxLlNlc3Npb24iUwoTU2Vzc2lvblVwZGF0ZWRFdmVudBIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uEhYKDnVwZGF0ZWRfZmllbGRzGAIgAygJIjkKE1Nlc3Npb25EZWxldGVkRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIOCgZyZWFzb24YAiABKAkipAIKGVNlc3Npb25TdGF0dXNDaGFuZ2VkRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRItCgpvbGRfc3RhdHVzGAIgASgOMhkuc2Vzc2lvbi52MS5TZXNzaW9uU3RhdHVzEi0KCm5ld19zdGF0dXMYAyABKA4yGS5zZXNzaW9uLnYxLlNlc3Npb25TdGF0dXMSHAoPZGV0ZWN0ZWRfc3RhdHVzGAQgASgJSACIAQESHQoQZGV0ZWN0ZWRfY29udGV4dBgFIAEoCUgBiAEBEi8KDXdvcmtpbmdfc3RhdGUYBiABKA4yGC5zZXNzaW9uLnYxLldvcmtpbmdTdGF0ZUISChBfZGV0ZWN0ZWRfc3RhdHVzQhMKEV9kZXRlY3RlZF9jb250ZXh0IrIGCgxUZXJtaW5hbERhdGESEgoKc2Vzc2lvbl9pZBgBIAEoCRIsCgZvdXRwdXQYAiABKAsyGi5zZXNzaW9uLnYxLlRlcm1pbmFsT3V0cHV0SAASKgoFaW5wdXQYAyABKAsyGS5zZXNzaW9uLnYxLlRlcm1pbmFsSW5wdXRIABIsCgZyZXNpemUYBCABKAsyGi5zZXNzaW9uLnYxLlRlcm1pbmFsUmVzaXplSAASKgoFZXJyb3IYBSABKAsyGS5zZXNzaW9uLnYxLlRlcm1pbmFsRXJyb3JIABI7ChJzY3JvbGxiYWNrX3JlcXVlc3QYBiABKAsyHS5zZXNzaW9uLnYxLlNjcm9sbGJhY2tSZXF1ZXN0SAASPQoTc2Nyb2xsYmFja19yZXNwb25zZRgHIAEoCzIeLnNlc3Npb24udjEuU2Nyb2xsYmFja1Jlc3BvbnNlSAASKgoFZGVsdGEYCCABKAsyGS5zZXNzaW9uLnYxLlRlcm1pbmFsRGVsdGFIABI+ChRjdXJyZW50X3BhbmVfcmVxdWVzdBgJIAEoCzIeLnNlc3Npb24udjEuQ3VycmVudFBhbmVSZXF1ZXN0SAASQAoVY3VycmVudF9wYW5lX3Jlc3BvbnNlGAogASgLMh8uc2Vzc2lvbi52MS5DdXJyZW50UGFuZVJlc3BvbnNlSAASLwoMZmxvd19jb250cm9sGAsgASgLMhcuc2Vzc2lvbi52MS5GbG93Q29udHJvbEgAEioKBXN0YXRlGAwgASgLMhkuc2Vzc2lvbi52MS5UZXJtaW5hbFN0YXRlSAASKAoEZGlmZhgNIAEoCzIYLnNlc3Npb24udjEuVGVybWluYWxEaWZmSAASLwoKaW5wdXRfZWNobxgOIAEoCzIZLnNlc3Npb24udjEuSW5wdXRXaXRoRWNob0gAEjUKD3NzcF9uZWdvdGlhdGlvbhgPIAEoCzIaLnNlc3Npb24udjEuU1NQTmVnb3RpYXRpb25IABI5ChFyZXNpemVfcXVpZXNjZW5jZRgQIAEoCzIcLnNlc3Npb24udjEuUmVzaXplUXVpZXNjZW5jZUgAQgYKBGRhdGEiQAoQUmVzaXplUXVpZXNjZW5jZRIQCghyZXNpemluZxgBIAEoCBIMCgRjb2xzGAIgASgFEgwKBHJvd3MYAyABKAUiHgoOVGVybWluYWxPdXRwdXQSDAoEZGF0YRgBIAEoDCIdCg1UZXJtaW5hbElucHV0EgwKBGRhdGEYASABKAwiLAoOVGVybWluYWxSZXNpemUSDAoEcm93cxgBIAEoBRIMCgRjb2xzGAIgASgFIi4KDVRlcm1pbmFsRXJyb3ISDwoHbWVzc2FnZRgBIAEoCRIMCgRjb2RlGAIgASgJIkMKC0Zsb3dDb250cm9sEg4KBnBhdXNlZBgBIAEoCBIWCgl3YXRlcm1hcmsYAiABKARIAIgBAUIMCgpfd2F0ZXJtYXJrIjkKEVNjcm9sbGJhY2tSZXF1ZXN0EhUKDWZyb21fc2VxdWVuY2UYASABKAQSDQoFbGltaXQYAiABKAUimgEKElNjcm9sbGJhY2tSZXNwb25zZRIrCgZjaHVua3MYASADKAsyGy5zZXNzaW9uLnYxLlNjcm9sbGJhY2tDaHVuaxIQCghoYXNfbW9yZRgCIAEoCBITCgt0b3RhbF9saW5lcxgDIAEoBBIXCg9vbGRlc3Rfc2VxdWVuY2UYBCABKAQSFwoPbmV3ZXN0X3NlcXVlbmNlGAUgASgEIkcKD1Njcm9sbGJhY2tDaHVuaxIMCgRkYXRhGAEgASgMEhAKCHNlcXVlbmNlGAIgASgEEhQKDHRpbWVzdGFtcF9tcxgDIAEoAyLAAQoSQ3VycmVudFBhbmVSZXF1ZXN0Eg0KBWxpbmVzGAEgASgFEhcKD2luY2x1ZGVfZXNjYXBlcxgCIAEoCBIYCgt0YXJnZXRfY29scxgDIAEoBUgAiAEBEhgKC3RhcmdldF9yb3dzGAQgASgFSAGIAQESGwoOc3RyZWFtaW5nX21vZGUYBSABKAlIAogBAUIOCgxfdGFyZ2V0X2NvbHNCDgoMX3RhcmdldF9yb3dzQhEKD19zdHJlYW1pbmdfbW9kZSJzChNDdXJyZW50UGFuZVJlc3BvbnNlEg8KB2NvbnRlbnQYASABKAwSEAoIY3Vyc29yX3gYAiABKAUSEAoIY3Vyc29yX3kYAyABKAUSEgoKcGFuZV93aWR0aBgEIAEoBRITCgtwYW5lX2hlaWdodBgFIAEoBSLiAQoNVGVybWluYWxEZWx0YRISCgpmcm9tX3N0YXRlGAEgASgEEhAKCHRvX3N0YXRlGAIgASgEEiQKBWxpbmVzGAMgAygLMhUuc2Vzc2lvbi52MS5MaW5lRGVsdGESKgoGY3Vyc29yGAQgASgLMhouc2Vzc2lvbi52MS5DdXJzb3JQb3NpdGlvbhIRCglmdWxsX3N5bmMYBSABKAgSNwoKZGltZW5zaW9ucxgGIAEoCzIeLnNlc3Npb24udjEuVGVybWluYWxEaW1lbnNpb25zSACIAQFCDQoLX2RpbWVuc2lvbnMiwgEKCUxpbmVEZWx0YRITCgtsaW5lX251bWJlchgBIAEoDRIWCgxyZXBsYWNlX2xpbmUYAiABKAxIABIkCgRlZGl0GAMgASgLMhQuc2Vzc2lvbi52MS5MaW5lRWRpdEgAEhUKC2RlbGV0ZV9saW5lGAQgASgISAASKAoGaW5zZXJ0GAUgASgLMhYuc2Vzc2lvbi52MS5JbnNlcnRMaW5lSAASFAoKY2xlYXJfbGluZRgGIAEoCEgAQgsKCW9wZXJhdGlvbiI8CghMaW5lRWRpdBIRCglzdGFydF9jb2wYASABKA0SDwoHZW5kX2NvbBgCIAEoDRIMCgR0ZXh0GAMgASgMIi0KCkluc2VydExpbmUSDAoEdGV4dBgBIAEoDBIRCglhdF9jdXJzb3IYAiABKAgiOwoOQ3Vyc29yUG9zaXRpb24SCwoDcm93GAEgASgNEgsKA2NvbBgCIAEoDRIPCgd2aXNpYmxlGAMgASgIIjAKElRlcm1pbmFsRGltZW5zaW9ucxIMCgRyb3dzGAEgASgNEgwKBGNvbHMYAiABKA0ilwIKDFRlcm1pbmFsRGlmZhIVCg1mcm9tX3NlcXVlbmNlGAEgASgEEhMKC3RvX3NlcXVlbmNlGAIgASgEEhIKCmRpZmZfYnl0ZXMYAyABKAwSKgoIZWNob19hY2sYBCABKAsyEy5zZXNzaW9uLnYxLkVjaG9BY2tIAIgBARITCgtmdWxsX3JlZHJhdxgFIAEoCBIVCg1jaGFuZ2VkX2NlbGxzGAYgASgNEhcKD3VuY2hhbmdlZF9jZWxscxgHIAEoDRI5Cgtjb21wcmVzc2lvbhgIIAEoCzIfLnNlc3Npb24udjEuQ29tcHJlc3Npb25NZXRhZGF0YUgBiAEBQgsKCV9lY2hvX2Fja0IOCgxfY29tcHJlc3Npb24iPAoHRWNob0FjaxIUCgxlY2hvX2Fja19udW0YASABKAQSGwoTc2VydmVyX3RpbWVzdGFtcF9tcxgCIAEoAyJMCg1JbnB1dFdpdGhFY2hvEgwKBGRhdGEYASABKAwSEAoIZWNob19udW0YAiABKAQSGwoTY2xpZW50X3RpbWVzdGFtcF9tcxgDIAEoAyKEAgoPU1NQQ2FwYWJpbGl0aWVzEiAKGHN1cHBvcnRzX3ByZWRpY3RpdmVfZWNobxgBIAEoCBIdChVzdXBwb3J0c19kaWZmX3VwZGF0ZXMYAiABKAgSHgoWY29tcHJlc3Npb25fYWxnb3JpdGhtcxgDIAMoCRIYChBwcm90b2NvbF92ZXJzaW9uGAQgASgNEhoKDW1heF9kaWZmX3NpemUYBSABKA1IAIgBARIoChtwcmVmZXJyZWRfZnJhbWVfaW50ZXJ2YWxfbXMYBiABKA1IAYgBAUIQCg5fbWF4X2RpZmZfc2l6ZUIeChxfcHJlZmVycmVkX2ZyYW1lX2ludGVydmFsX21zIpwBCg5TU1BOZWdvdGlhdGlvbhIxCgxjYXBhYmlsaXRpZXMYASABKAsyGy5zZXNzaW9uLnYxLlNTUENhcGFiaWxpdGllcxISCgppc19yZXF1ZXN0GAIgASgIEjQKCm5lZ290aWF0ZWQYAyABKAsyGy5zZXNzaW9uLnYxLlNTUENhcGFiaWxpdGllc0gAiAEBQg0KC19uZWdvdGlhdGVkIrkCCg1UZXJtaW5hbFN0YXRlEhAKCHNlcXVlbmNlGAEgASgEEjIKCmRpbWVuc2lvbnMYAiABKAsyHi5zZXNzaW9uLnYxLlRlcm1pbmFsRGltZW5zaW9ucxInCgVsaW5lcxgDIAMoCzIYLnNlc3Npb24udjEuVGVybWluYWxMaW5lEioKBmN1cnNvchgEIAEoCzIaLnNlc3Npb24udjEuQ3Vyc29yUG9zaXRpb24SMwoKc2Nyb2xsYmFjaxgFIAEoCzIaLnNlc3Npb24udjEuU2Nyb2xsYmFja0luZm9IAIgBARI5Cgtjb21wcmVzc2lvbhgGIAEoCzIfLnNlc3Npb24udjEuQ29tcHJlc3Npb25NZXRhZGF0YUgBiAEBQg0KC19zY3JvbGxiYWNrQg4KDF9jb21wcmVzc2lvbiJjCgxUZXJtaW5hbExpbmUSDwoHY29udGVudBgBIAEoDBIzCgphdHRyaWJ1dGVzGAIgASgLMhouc2Vzc2lvbi52MS5MaW5lQXR0cmlidXRlc0gAiAEBQg0KC19hdHRyaWJ1dGVzIoYBCg5MaW5lQXR0cmlidXRlcxIQCghpc19lbXB0eRgBIAEoCBISCgphc2NpaV9vbmx5GAIgASgIEhUKCGVuY29kaW5nGAMgASgJSACIAQESGQoMcGF0dGVybl9oYXNoGAQgASgESAGIAQFCCwoJX2VuY29kaW5nQg8KDV9wYXR0ZXJuX2hhc2giUgoOU2Nyb2xsYmFja0luZm8SEwoLdG90YWxfbGluZXMYASABKAQSFQoNZmlyc3RfdmlzaWJsZRgCIAEoBBIUCgxsYXN0X3Zpc2libGUYAyABKAQi7QEKE0NvbXByZXNzaW9uTWV0YWRhdGESEQoJYWxnb3JpdGhtGAEgASgJEhoKDWRpY3Rpb25hcnlfaWQYAiABKAlIAIgBARIZChF1bmNvbXByZXNzZWRfc2l6ZRgDIAEoBBIXCg9jb21wcmVzc2VkX3NpemUYBCABKAQSGQoRY29tcHJlc3Npb25fcmF0aW8YBSABKAISNwoKZGljdGlvbmFyeRgGIAEoCzIeLnNlc3Npb24udjEuRGljdGlvbmFyeU1ldGFkYXRhSAGIAQFCEAoOX2RpY3Rpb25hcnlfaWRCDQoLX2RpY3Rpb25hcnkieQoSRGljdGlvbmFyeU1ldGFkYXRhEg0KBWxldmVsGAEgASgJEhUKDXBhdHRlcm5fY291bnQYAiABKAQSFQoNZWZmZWN0aXZlbmVzcxgDIAEoAhISCgp1cGRhdGVkX2F0GAQgASgDEhIKCnNpemVfYnl0ZXMYBSABKAQivwYKFFVzZXJJbnRlcmFjdGlvbkV2ZW50EhIKCnNlc3Npb25faWQYASABKAkSPgoEdHlwZRgCIAEoDjIwLnNlc3Npb24udjEuVXNlckludGVyYWN0aW9uRXZlbnQuSW50ZXJhY3Rpb25UeXBlEg8KB2NvbnRleHQYAyABKAkiwQUKD0ludGVyYWN0aW9uVHlwZRIgChxJTlRFUkFDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASIwofSU5URVJBQ1RJT05fVFlQRV9URVJNSU5BTF9JTlBVVBABEiMKH0lOVEVSQUNUSU9OX1RZUEVfQVBQUk9WQUxfR0lWRU4QAhIkCiBJTlRFUkFDVElPTl9UWVBFX0FQUFJPVkFMX0RFTklFRBADEiUKIUlOVEVSQUNUSU9OX1RZUEVfQ09NTUFORF9FWEVDVVRFRBAEEiUKIUlOVEVSQUNUSU9OX1RZUEVfU0VTU0lPTl9BVFRBQ0hFRBAFEiUKIUlOVEVSQUNUSU9OX1RZUEVfU0VTU0lPTl9ERVRBQ0hFRBAGEi4KKklOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX1BBTkVMX09QRU5FRBAHEi4KKklOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX1BBTkVMX0NMT1NFRBAIEigKJElOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX1ZJRVdFRBAJEisKJ0lOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX0RJU01JU1NFRBAKEi0KKUlOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX01BUktFRF9SRUFEEAsSMQotSU5URVJBQ1RJT05fVFlQRV9OT1RJRklDQVRJT05fTUFSS0VEX0FMTF9SRUFEEAwSKQolSU5URVJBQ1RJT05fVFlQRV9OT1RJRklDQVRJT05fUkVNT1ZFRBANEjEKLUlOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX0hJU1RPUllfQ0xFQVJFRBAOEjAKLElOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX1NFU1NJT05fVklFV0VEEA8icwoYU2Vzc2lvbkFja25vd2xlZGdlZEV2ZW50EhIKCnNlc3Npb25faWQYASABKAkSMwoPYWNrbm93bGVkZ2VkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZyZWFzb24YAyABKAkigAEKFUFwcHJvdmFsUmVzcG9uc2VFdmVudBISCgpzZXNzaW9uX2lkGAEgASgJEhAKCGFwcHJvdmVkGAIgASgIEg8KB2NvbnRleHQYAyABKAkSMAoMcmVzcG9uZGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLHAgoQUmV2aWV3UXVldWVFdmVudBItCgl0aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjsKCml0ZW1fYWRkZWQYAiABKAsyJS5zZXNzaW9uLnYxLlJldmlld1F1ZXVlSXRlbUFkZGVkRXZlbnRIABI/CgxpdGVtX3JlbW92ZWQYAyABKAsyJy5zZXNzaW9uLnYxLlJldmlld1F1ZXVlSXRlbVJlbW92ZWRFdmVudEgAEj8KDGl0ZW1fdXBkYXRlZBgEIAEoCzInLnNlc3Npb24udjEuUmV2aWV3UXVldWVJdGVtVXBkYXRlZEV2ZW50SAASPAoKc3RhdGlzdGljcxgFIAEoCzImLnNlc3Npb24udjEuUmV2aWV3UXVldWVTdGF0aXN0aWNzRXZlbnRIAEIHCgVldmVudCJnChlSZXZpZXdRdWV1ZUl0ZW1BZGRlZEV2ZW50EiQKBGl0ZW0YASABKAsyFi5zZXNzaW9uLnYxLlJldmlld0l0ZW0SDwoHdHJpZ2dlchgCIAEoCRITCgtpc19zbmFwc2hvdBgDIAEoCCJBChtSZXZpZXdRdWV1ZUl0ZW1SZW1vdmVkRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIOCgZyZWFzb24YAiABKAkibwobUmV2aWV3UXVldWVJdGVtVXBkYXRlZEV2ZW50EhIKCnNlc3Npb25faWQYASABKAkSJAoEaXRlbRgCIAEoCzIWLnNlc3Npb24udjEuUmV2aWV3SXRlbRIWCg51cGRhdGVkX2ZpZWxkcxgDIAMoCSLcAgoaUmV2aWV3UXVldWVTdGF0aXN0aWNzRXZlbnQSEwoLdG90YWxfaXRlbXMYASABKAUSSwoLYnlfcHJpb3JpdHkYAiADKAsyNi5zZXNzaW9uLnYxLlJldmlld1F1ZXVlU3RhdGlzdGljc0V2ZW50LkJ5UHJpb3JpdHlFbnRyeRJHCglieV9yZWFzb24YAyADKAsyNC5zZXNzaW9uLnYxLlJldmlld1F1ZXVlU3RhdGlzdGljc0V2ZW50LkJ5UmVhc29uRW50cnkSFgoOYXZlcmFnZV9hZ2VfbXMYBCABKAMSFwoPZXNjYWxhdGVkX2l0ZW1zGAUgAygJGjEKD0J5UHJpb3JpdHlFbnRyeRILCgNrZXkYASABKAUSDQoFdmFsdWUYAiABKAU6AjgBGi8KDUJ5UmVhc29uRW50cnkSCwoDa2V5GAEgASgFEg0KBXZhbHVlGAIgASgFOgI4ASKCAwoRTm90aWZpY2F0aW9uRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIUCgxzZXNzaW9uX25hbWUYAiABKAkSNwoRbm90aWZpY2F0aW9uX3R5cGUYAyABKA4yHC5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvblR5cGUSMgoIcHJpb3JpdHkYBCABKA4yIC5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvblByaW9yaXR5Eg0KBXRpdGxlGAUgASgJEg8KB21lc3NhZ2UYBiABKAkSPQoIbWV0YWRhdGEYByADKAsyKy5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvbkV2ZW50Lk1ldGFkYXRhRW50cnkSLQoJdGltZXN0YW1wGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9ub3RpZmljYXRpb25faWQYCSABKAkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQqsBCg5jb20uc2Vzc2lvbi52MUILRXZlbnRzUHJvdG9QAVpDZ2l0aHViLmNvbS90c3RhcGxlci9zdGFwbGVyLXNxdWFkL2dlbi9wcm90by9nby9zZXNzaW9uL3YxO3Nlc3Npb252MaICA1NYWKoCClNlc3Npb24uVjHKAgpTZXNzaW9uXFYx4gIWU2Vzc2lvblxWMVxHUEJNZXRhZGF0YeoCC1Nlc3Npb246OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_session_v1_types]);
+  fileDesc("ChdzZXNzaW9uL3YxL2V2ZW50cy5wcm90bxIKc2Vzc2lvbi52MSLDBAoMU2Vzc2lvbkV2ZW50Ei0KCXRpbWVzdGFtcBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOgoPc2Vzc2lvbl9jcmVhdGVkGAIgASgLMh8uc2Vzc2lvbi52MS5TZXNzaW9uQ3JlYXRlZEV2ZW50SAASOgoPc2Vzc2lvbl91cGRhdGVkGAMgASgLMh8uc2Vzc2lvbi52MS5TZXNzaW9uVXBkYXRlZEV2ZW50SAASOgoPc2Vzc2lvbl9kZWxldGVkGAQgASgLMh8uc2Vzc2lvbi52MS5TZXNzaW9uRGVsZXRlZEV2ZW50SAASPwoOc3RhdHVzX2NoYW5nZWQYBSABKAsyJS5zZXNzaW9uLnYxLlNlc3Npb25TdGF0dXNDaGFuZ2VkRXZlbnRIABI8ChB1c2VyX2ludGVyYWN0aW9uGAYgASgLMiAuc2Vzc2lvbi52MS5Vc2VySW50ZXJhY3Rpb25FdmVudEgAEkQKFHNlc3Npb25fYWNrbm93bGVkZ2VkGAcgASgLMiQuc2Vzc2lvbi52MS5TZXNzaW9uQWNrbm93bGVkZ2VkRXZlbnRIABI+ChFhcHByb3ZhbF9yZXNwb25zZRgIIAEoCzIhLnNlc3Npb24udjEuQXBwcm92YWxSZXNwb25zZUV2ZW50SAASNQoMbm90aWZpY2F0aW9uGAkgASgLMh0uc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25FdmVudEgAEgsKA3NlcRgKIAEoBEIHCgVldmVudCI7ChNTZXNzaW9uQ3JlYXRlZEV2ZW50EiQKB3Nlc3Npb24YASABKAsyEy5zZXNzaW9uLnYxLlNlc3Npb24iUwoTU2Vzc2lvblVwZGF0ZWRFdmVudBIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uEhYKDnVwZGF0ZWRfZmllbGRzGAIgAygJIjkKE1Nlc3Npb25EZWxldGVkRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIOCgZyZWFzb24YAiABKAkipAIKGVNlc3Npb25TdGF0dXNDaGFuZ2VkRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRItCgpvbGRfc3RhdHVzGAIgASgOMhkuc2Vzc2lvbi52MS5TZXNzaW9uU3RhdHVzEi0KCm5ld19zdGF0dXMYAyABKA4yGS5zZXNzaW9uLnYxLlNlc3Npb25TdGF0dXMSHAoPZGV0ZWN0ZWRfc3RhdHVzGAQgASgJSACIAQESHQoQZGV0ZWN0ZWRfY29udGV4dBgFIAEoCUgBiAEBEi8KDXdvcmtpbmdfc3RhdGUYBiABKA4yGC5zZXNzaW9uLnYxLldvcmtpbmdTdGF0ZUISChBfZGV0ZWN0ZWRfc3RhdHVzQhMKEV9kZXRlY3RlZF9jb250ZXh0IvsICgxUZXJtaW5hbERhdGESEgoKc2Vzc2lvbl9pZBgBIAEoCRIsCgZvdXRwdXQYAiABKAsyGi5zZXNzaW9uLnYxLlRlcm1pbmFsT3V0cHV0SAASKgoFaW5wdXQYAyABKAsyGS5zZXNzaW9uLnYxLlRlcm1pbmFsSW5wdXRIABIsCgZyZXNpemUYBCABKAsyGi5zZXNzaW9uLnYxLlRlcm1pbmFsUmVzaXplSAASKgoFZXJyb3IYBSABKAsyGS5zZXNzaW9uLnYxLlRlcm1pbmFsRXJyb3JIABI7ChJzY3JvbGxiYWNrX3JlcXVlc3QYBiABKAsyHS5zZXNzaW9uLnYxLlNjcm9sbGJhY2tSZXF1ZXN0SAASPQoTc2Nyb2xsYmFja19yZXNwb25zZRgHIAEoCzIeLnNlc3Npb24udjEuU2Nyb2xsYmFja1Jlc3BvbnNlSAASKgoFZGVsdGEYCCABKAsyGS5zZXNzaW9uLnYxLlRlcm1pbmFsRGVsdGFIABI+ChRjdXJyZW50X3BhbmVfcmVxdWVzdBgJIAEoCzIeLnNlc3Npb24udjEuQ3VycmVudFBhbmVSZXF1ZXN0SAASQAoVY3VycmVudF9wYW5lX3Jlc3BvbnNlGAogASgLMh8uc2Vzc2lvbi52MS5DdXJyZW50UGFuZVJlc3BvbnNlSAASLwoMZmxvd19jb250cm9sGAsgASgLMhcuc2Vzc2lvbi52MS5GbG93Q29udHJvbEgAEioKBXN0YXRlGAwgASgLMhkuc2Vzc2lvbi52MS5UZXJtaW5hbFN0YXRlSAASKAoEZGlmZhgNIAEoCzIYLnNlc3Npb24udjEuVGVybWluYWxEaWZmSAASLwoKaW5wdXRfZWNobxgOIAEoCzIZLnNlc3Npb24udjEuSW5wdXRXaXRoRWNob0gAEjUKD3NzcF9uZWdvdGlhdGlvbhgPIAEoCzIaLnNlc3Npb24udjEuU1NQTmVnb3RpYXRpb25IABI5ChFyZXNpemVfcXVpZXNjZW5jZRgQIAEoCzIcLnNlc3Npb24udjEuUmVzaXplUXVpZXNjZW5jZUgAEjEKDWlucHV0X2NvbnRyb2wYESABKAsyGC5zZXNzaW9uLnYxLklucHV0Q29udHJvbEgAEjwKE2lucHV0X2NvbnRyb2xfc3RhdGUYEiABKAsyHS5zZXNzaW9uLnYxLklucHV0Q29udHJvbFN0YXRlSAASNQoPcHJlc2VuY2VfdXBkYXRlGBMgASgLMhouc2Vzc2lvbi52MS5QcmVzZW5jZVVwZGF0ZUgAEjoKEmZyYW1lX3N0cmVhbV9zdGFydBgUIAEoCzIcLnNlc3Npb24udjEuRnJhbWVTdHJlYW1TdGFydEgAEjcKEGZyYW1lX2RpY3Rpb25hcnkYFSABKAsyGy5zZXNzaW9uLnYxLkZyYW1lRGljdGlvbmFyeUgAEioKBWZyYW1lGBYgASgLMhkuc2Vzc2lvbi52MS5UZXJtaW5hbEZyYW1lSABCBgoEZGF0YSJAChBSZXNpemVRdWllc2NlbmNlEhAKCHJlc2l6aW5nGAEgASgIEgwKBGNvbHMYAiABKAUSDAoEcm93cxgDIAEoBSIeCg5UZXJtaW5hbE91dHB1dBIMCgRkYXRhGAEgASgMIh0KDVRlcm1pbmFsSW5wdXQSDAoEZGF0YRgBIAEoDCIsCg5UZXJtaW5hbFJlc2l6ZRIMCgRyb3dzGAEgASgFEgwKBGNvbHMYAiABKAUiLgoNVGVybWluYWxFcnJvchIPCgdtZXNzYWdlGAEgASgJEgwKBGNvZGUYAiABKAkiQwoLRmxvd0NvbnRyb2wSDgoGcGF1c2VkGAEgASgIEhYKCXdhdGVybWFyaxgCIAEoBEgAiAEBQgwKCl93YXRlcm1hcmsiOQoRU2Nyb2xsYmFja1JlcXVlc3QSFQoNZnJvbV9zZXF1ZW5jZRgBIAEoBBINCgVsaW1pdBgCIAEoBSKaAQoSU2Nyb2xsYmFja1Jlc3BvbnNlEisKBmNodW5rcxgBIAMoCzIbLnNlc3Npb24udjEuU2Nyb2xsYmFja0NodW5rEhAKCGhhc19tb3JlGAIgASgIEhMKC3RvdGFsX2xpbmVzGAMgASgEEhcKD29sZGVzdF9zZXF1ZW5jZRgEIAEoBBIXCg9uZXdlc3Rfc2VxdWVuY2UYBSABKAQiRwoPU2Nyb2xsYmFja0NodW5rEgwKBGRhdGEYASABKAwSEAoIc2VxdWVuY2UYAiABKAQSFAoMdGltZXN0YW1wX21zGAMgASgDIocCChJDdXJyZW50UGFuZVJlcXVlc3QSDQoFbGluZXMYASABKAUSFwoPaW5jbHVkZV9lc2NhcGVzGAIgASgIEhgKC3RhcmdldF9jb2xzGAMgASgFSACIAQESGAoLdGFyZ2V0X3Jvd3MYBCABKAVIAYgBARIbCg5zdHJlYW1pbmdfbW9kZRgFIAEoCUgCiAEBEjMKDXJlc3VtZV9jdXJzb3IYBiABKAsyFy5zZXNzaW9uLnYxLkZyYW1lQ3Vyc29ySAOIAQFCDgoMX3RhcmdldF9jb2xzQg4KDF90YXJnZXRfcm93c0IRCg9fc3RyZWFtaW5nX21vZGVCEAoOX3Jlc3VtZV9jdXJzb3IicwoTQ3VycmVudFBhbmVSZXNwb25zZRIPCgdjb250ZW50GAEgASgMEhAKCGN1cnNvcl94GAIgASgFEhAKCGN1cnNvcl95GAMgASgFEhIKCnBhbmVfd2lkdGgYBCABKAUSEwoLcGFuZV9oZWlnaHQYBSABKAUi4gEKDVRlcm1pbmFsRGVsdGESEgoKZnJvbV9zdGF0ZRgBIAEoBBIQCgh0b19zdGF0ZRgCIAEoBBIkCgVsaW5lcxgDIAMoCzIVLnNlc3Npb24udjEuTGluZURlbHRhEioKBmN1cnNvchgEIAEoCzIaLnNlc3Npb24udjEuQ3Vyc29yUG9zaXRpb24SEQoJZnVsbF9zeW5jGAUgASgIEjcKCmRpbWVuc2lvbnMYBiABKAsyHi5zZXNzaW9uLnYxLlRlcm1pbmFsRGltZW5zaW9uc0gAiAEBQg0KC19kaW1lbnNpb25zIsIBCglMaW5lRGVsdGESEwoLbGluZV9udW1iZXIYASABKA0SFgoMcmVwbGFjZV9saW5lGAIgASgMSAASJAoEZWRpdBgDIAEoCzIULnNlc3Npb24udjEuTGluZUVkaXRIABIVCgtkZWxldGVfbGluZRgEIAEoCEgAEigKBmluc2VydBgFIAEoCzIWLnNlc3Npb24udjEuSW5zZXJ0TGluZUgAEhQKCmNsZWFyX2xpbmUYBiABKAhIAEILCglvcGVyYXRpb24iPAoITGluZUVkaXQSEQoJc3RhcnRfY29sGAEgASgNEg8KB2VuZF9jb2wYAiABKA0SDAoEdGV4dBgDIAEoDCItCgpJbnNlcnRMaW5lEgwKBHRleHQYASABKAwSEQoJYXRfY3Vyc29yGAIgASgIIjsKDkN1cnNvclBvc2l0aW9uEgsKA3JvdxgBIAEoDRILCgNjb2wYAiABKA0SDwoHdmlzaWJsZRgDIAEoCCIwChJUZXJtaW5hbERpbWVuc2lvbnMSDAoEcm93cxgBIAEoDRIMCgRjb2xzGAIgASgNIpcCCgxUZXJtaW5hbERpZmYSFQoNZnJvbV9zZXF1ZW5jZRgBIAEoBBITCgt0b19zZXF1ZW5jZRgCIAEoBBISCgpkaWZmX2J5dGVzGAMgASgMEioKCGVjaG9fYWNrGAQgASgLMhMuc2Vzc2lvbi52MS5FY2hvQWNrSACIAQESEwoLZnVsbF9yZWRyYXcYBSABKAgSFQoNY2hhbmdlZF9jZWxscxgGIAEoDRIXCg91bmNoYW5nZWRfY2VsbHMYByABKA0SOQoLY29tcHJlc3Npb24YCCABKAsyHy5zZXNzaW9uLnYxLkNvbXByZXNzaW9uTWV0YWRhdGFIAYgBAUILCglfZWNob19hY2tCDgoMX2NvbXByZXNzaW9uIjwKB0VjaG9BY2sSFAoMZWNob19hY2tfbnVtGAEgASgEEhsKE3NlcnZlcl90aW1lc3RhbXBfbXMYAiABKAMiTAoNSW5wdXRXaXRoRWNobxIMCgRkYXRhGAEgASgMEhAKCGVjaG9fbnVtGAIgASgEEhsKE2NsaWVudF90aW1lc3RhbXBfbXMYAyABKAMihAIKD1NTUENhcGFiaWxpdGllcxIgChhzdXBwb3J0c19wcmVkaWN0aXZlX2VjaG8YASABKAgSHQoVc3VwcG9ydHNfZGlmZl91cGRhdGVzGAIgASgIEh4KFmNvbXByZXNzaW9uX2FsZ29yaXRobXMYAyADKAkSGAoQcHJvdG9jb2xfdmVyc2lvbhgEIAEoDRIaCg1tYXhfZGlmZl9zaXplGAUgASgNSACIAQESKAobcHJlZmVycmVkX2ZyYW1lX2ludGVydmFsX21zGAYgASgNSAGIAQFCEAoOX21heF9kaWZmX3NpemVCHgocX3ByZWZlcnJlZF9mcmFtZV9pbnRlcnZhbF9tcyKcAQoOU1NQTmVnb3RpYXRpb24SMQoMY2FwYWJpbGl0aWVzGAEgASgLMhsuc2Vzc2lvbi52MS5TU1BDYXBhYmlsaXRpZXMSEgoKaXNfcmVxdWVzdBgCIAEoCBI0CgpuZWdvdGlhdGVkGAMgASgLMhsuc2Vzc2lvbi52MS5TU1BDYXBhYmlsaXRpZXNIAIgBAUINCgtfbmVnb3RpYXRlZCK5AgoNVGVybWluYWxTdGF0ZRIQCghzZXF1ZW5jZRgBIAEoBBIyCgpkaW1lbnNpb25zGAIgASgLMh4uc2Vzc2lvbi52MS5UZXJtaW5hbERpbWVuc2lvbnMSJwoFbGluZXMYAyADKAsyGC5zZXNzaW9uLnYxLlRlcm1pbmFsTGluZRIqCgZjdXJzb3IYBCABKAsyGi5zZXNzaW9uLnYxLkN1cnNvclBvc2l0aW9uEjMKCnNjcm9sbGJhY2sYBSABKAsyGi5zZXNzaW9uLnYxLlNjcm9sbGJhY2tJbmZvSACIAQESOQoLY29tcHJlc3Npb24YBiABKAsyHy5zZXNzaW9uLnYxLkNvbXByZXNzaW9uTWV0YWRhdGFIAYgBAUINCgtfc2Nyb2xsYmFja0IOCgxfY29tcHJlc3Npb24iYwoMVGVybWluYWxMaW5lEg8KB2NvbnRlbnQYASABKAwSMwoKYXR0cmlidXRlcxgCIAEoCzIaLnNlc3Npb24udjEuTGluZUF0dHJpYnV0ZXNIAIgBAUINCgtfYXR0cmlidXRlcyKGAQoOTGluZUF0dHJpYnV0ZXMSEAoIaXNfZW1wdHkYASABKAgSEgoKYXNjaWlfb25seRgCIAEoCBIVCghlbmNvZGluZxgDIAEoCUgAiAEBEhkKDHBhdHRlcm5faGFzaBgEIAEoBEgBiAEBQgsKCV9lbmNvZGluZ0IPCg1fcGF0dGVybl9oYXNoIlIKDlNjcm9sbGJhY2tJbmZvEhMKC3RvdGFsX2xpbmVzGAEgASgEEhUKDWZpcnN0X3Zpc2libGUYAiABKAQSFAoMbGFzdF92aXNpYmxlGAMgASgEIu0BChNDb21wcmVzc2lvbk1ldGFkYXRhEhEKCWFsZ29yaXRobRgBIAEoCRIaCg1kaWN0aW9uYXJ5X2lkGAIgASgJSACIAQESGQoRdW5jb21wcmVzc2VkX3NpemUYAyABKAQSFwoPY29tcHJlc3NlZF9zaXplGAQgASgEEhkKEWNvbXByZXNzaW9uX3JhdGlvGAUgASgCEjcKCmRpY3Rpb25hcnkYBiABKAsyHi5zZXNzaW9uLnYxLkRpY3Rpb25hcnlNZXRhZGF0YUgBiAEBQhAKDl9kaWN0aW9uYXJ5X2lkQg0KC19kaWN0aW9uYXJ5InkKEkRpY3Rpb25hcnlNZXRhZGF0YRINCgVsZXZlbBgBIAEoCRIVCg1wYXR0ZXJuX2NvdW50GAIgASgEEhUKDWVmZmVjdGl2ZW5lc3MYAyABKAISEgoKdXBkYXRlZF9hdBgEIAEoAxISCgpzaXplX2J5dGVzGAUgASgEIr8GChRVc2VySW50ZXJhY3Rpb25FdmVudBISCgpzZXNzaW9uX2lkGAEgASgJEj4KBHR5cGUYAiABKA4yMC5zZXNzaW9uLnYxLlVzZXJJbnRlcmFjdGlvbkV2ZW50LkludGVyYWN0aW9uVHlwZRIPCgdjb250ZXh0GAMgASgJIsEFCg9JbnRlcmFjdGlvblR5cGUSIAocSU5URVJBQ1RJT05fVFlQRV9VTlNQRUNJRklFRBAAEiMKH0lOVEVSQUNUSU9OX1RZUEVfVEVSTUlOQUxfSU5QVVQQARIjCh9JTlRFUkFDVElPTl9UWVBFX0FQUFJPVkFMX0dJVkVOEAISJAogSU5URVJBQ1RJT05fVFlQRV9BUFBST1ZBTF9ERU5JRUQQAxIlCiFJTlRFUkFDVElPTl9UWVBFX0NPTU1BTkRfRVhFQ1VURUQQBBIlCiFJTlRFUkFDVElPTl9UWVBFX1NFU1NJT05fQVRUQUNIRUQQBRIlCiFJTlRFUkFDVElPTl9UWVBFX1NFU1NJT05fREVUQUNIRUQQBhIuCipJTlRFUkFDVElPTl9UWVBFX05PVElGSUNBVElPTl9QQU5FTF9PUEVORUQQBxIuCipJTlRFUkFDVElPTl9UWVBFX05PVElGSUNBVElPTl9QQU5FTF9DTE9TRUQQCBIoCiRJTlRFUkFDVElPTl9UWVBFX05PVElGSUNBVElPTl9WSUVXRUQQCRIrCidJTlRFUkFDVElPTl9UWVBFX05PVElGSUNBVElPTl9ESVNNSVNTRUQQChItCilJTlRFUkFDVElPTl9UWVBFX05PVElGSUNBVElPTl9NQVJLRURfUkVBRBALEjEKLUlOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX01BUktFRF9BTExfUkVBRBAMEikKJUlOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OX1JFTU9WRUQQDRIxCi1JTlRFUkFDVElPTl9UWVBFX05PVElGSUNBVElPTl9ISVNUT1JZX0NMRUFSRUQQDhIwCixJTlRFUkFDVElPTl9UWVBFX05PVElGSUNBVElPTl9TRVNTSU9OX1ZJRVdFRBAPInMKGFNlc3Npb25BY2tub3dsZWRnZWRFdmVudBISCgpzZXNzaW9uX2lkGAEgASgJEjMKD2Fja25vd2xlZGdlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGcmVhc29uGAMgASgJIoABChVBcHByb3ZhbFJlc3BvbnNlRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIQCghhcHByb3ZlZBgCIAEoCBIPCgdjb250ZXh0GAMgASgJEjAKDHJlc3BvbmRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAixwIKEFJldmlld1F1ZXVlRXZlbnQSLQoJdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI7CgppdGVtX2FkZGVkGAIgASgLMiUuc2Vzc2lvbi52MS5SZXZpZXdRdWV1ZUl0ZW1BZGRlZEV2ZW50SAASPwoMaXRlbV9yZW1vdmVkGAMgASgLMicuc2Vzc2lvbi52MS5SZXZpZXdRdWV1ZUl0ZW1SZW1vdmVkRXZlbnRIABI/CgxpdGVtX3VwZGF0ZWQYBCABKAsyJy5zZXNzaW9uLnYxLlJldmlld1F1ZXVlSXRlbVVwZGF0ZWRFdmVudEgAEjwKCnN0YXRpc3RpY3MYBSABKAsyJi5zZXNzaW9uLnYxLlJldmlld1F1ZXVlU3RhdGlzdGljc0V2ZW50SABCBwoFZXZlbnQiZwoZUmV2aWV3UXVldWVJdGVtQWRkZWRFdmVudBIkCgRpdGVtGAEgASgLMhYuc2Vzc2lvbi52MS5SZXZpZXdJdGVtEg8KB3RyaWdnZXIYAiABKAkSEwoLaXNfc25hcHNob3QYAyABKAgiQQobUmV2aWV3UXVldWVJdGVtUmVtb3ZlZEV2ZW50EhIKCnNlc3Npb25faWQYASABKAkSDgoGcmVhc29uGAIgASgJIm8KG1Jldmlld1F1ZXVlSXRlbVVwZGF0ZWRFdmVudBISCgpzZXNzaW9uX2lkGAEgASgJEiQKBGl0ZW0YAiABKAsyFi5zZXNzaW9uLnYxLlJldmlld0l0ZW0SFgoOdXBkYXRlZF9maWVsZHMYAyADKAki3AIKGlJldmlld1F1ZXVlU3RhdGlzdGljc0V2ZW50EhMKC3RvdGFsX2l0ZW1zGAEgASgFEksKC2J5X3ByaW9yaXR5GAIgAygLMjYuc2Vzc2lvbi52MS5SZXZpZXdRdWV1ZVN0YXRpc3RpY3NFdmVudC5CeVByaW9yaXR5RW50cnkSRwoJYnlfcmVhc29uGAMgAygLMjQuc2Vzc2lvbi52MS5SZXZpZXdRdWV1ZVN0YXRpc3RpY3NFdmVudC5CeVJlYXNvbkVudHJ5EhYKDmF2ZXJhZ2VfYWdlX21zGAQgASgDEhcKD2VzY2FsYXRlZF9pdGVtcxgFIAMoCRoxCg9CeVByaW9yaXR5RW50cnkSCwoDa2V5GAEgASgFEg0KBXZhbHVlGAIgASgFOgI4ARovCg1CeVJlYXNvbkVudHJ5EgsKA2tleRgBIAEoBRINCgV2YWx1ZRgCIAEoBToCOAEiggMKEU5vdGlmaWNhdGlvbkV2ZW50EhIKCnNlc3Npb25faWQYASABKAkSFAoMc2Vzc2lvbl9uYW1lGAIgASgJEjcKEW5vdGlmaWNhdGlvbl90eXBlGAMgASgOMhwuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25UeXBlEjIKCHByaW9yaXR5GAQgASgOMiAuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgFIAEoCRIPCgdtZXNzYWdlGAYgASgJEj0KCG1ldGFkYXRhGAcgAygLMisuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25FdmVudC5NZXRhZGF0YUVudHJ5Ei0KCXRpbWVzdGFtcBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPbm90aWZpY2F0aW9uX2lkGAkgASgJGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJGCgxJbnB1dENvbnRyb2wSDgoGYWN0aW9uGAEgASgJEgwKBG1vZGUYAiABKAkSGAoQdGFyZ2V0X2NsaWVudF9pZBgDIAEoCSK/AQoRSW5wdXRDb250cm9sU3RhdGUSDAoEbW9kZRgBIAEoCRIYChB3cml0ZXJfY2xpZW50X2lkGAIgASgJEhYKDnlvdXJfY2xpZW50X2lkGAMgASgJEhEKCWNhbl93cml0ZRgEIAEoCBIaChJwZW5kaW5nX2NsaWVudF9pZHMYBSADKAkSKwoHY2xpZW50cxgGIAMoCzIaLnNlc3Npb24udjEuQ2xpZW50UHJlc2VuY2USDgoGbm90aWNlGAcgASgJIq0CCg5DbGllbnRQcmVzZW5jZRIRCgljbGllbnRfaWQYASABKAkSDQoFbGFiZWwYAiABKAkSEQoJcmVhZF9vbmx5GAMgASgIEhEKCWNhbl93cml0ZRgEIAEoCBISCgpyZXF1ZXN0aW5nGAUgASgIEg8KB2ZvY3VzZWQYBiABKAgSEgoKY3Vyc29yX3JvdxgHIAEoBRISCgpjdXJzb3JfY29sGAggASgFEi0KCWpvaW5lZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNgoNbGFzdF9pbnB1dF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARINCgVvd25lchgLIAEoCEIQCg5fbGFzdF9pbnB1dF9hdCJYCg5QcmVzZW5jZVVwZGF0ZRINCgVsYWJlbBgBIAEoCRIPCgdmb2N1c2VkGAIgASgIEhIKCmN1cnNvcl9yb3cYAyABKAUSEgoKY3Vyc29yX2NvbBgEIAEoBSIyCgtGcmFtZUN1cnNvchIRCglzdHJlYW1faWQYASABKAkSEAoIc2VxdWVuY2UYAiABKAQiXwoQRnJhbWVTdHJlYW1TdGFydBIRCglzdHJlYW1faWQYASABKAkSDwoHcmVzdW1lZBgCIAEoCBIQCghzZXF1ZW5jZRgDIAEoBBIVCg1yZXN5bmNfcmVhc29uGAQgASgJIisKD0ZyYW1lRGljdGlvbmFyeRIKCgJpZBgBIAEoDRIMCgRkYXRhGAIgASgMIn4KDVRlcm1pbmFsRnJhbWUSEAoIc2VxdWVuY2UYASABKAQSDAoEZGF0YRgCIAEoDBISCgpjb21wcmVzc2VkGAMgASgIEhUKDWRpY3Rpb25hcnlfaWQYBCABKA0SEAoIcmF3X3NpemUYBSABKA0SEAoIc25hcHNob3QYBiABKAhCqwEKDmNvbS5zZXNzaW9uLnYxQgtFdmVudHNQcm90b1ABWkNnaXRodWIuY29tL3RzdGFwbGVyL3N0YXBsZXItc3F1YWQvZ2VuL3Byb3RvL2dvL3Nlc3Npb24vdjE7c2Vzc2lvbnYxogIDU1hYqgIKU2Vzc2lvbi5WMcoCClNlc3Npb25cVjHiAhZTZXNzaW9uXFYxXEdQQk1ldGFkYXRh6gILU2Vzc2lvbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_session_v1_types]);
 
 /**
  * SessionEvent represents a real-time event about session state changes.
@@ -357,6 +357,59 @@ export type TerminalData = Message<"session.v1.TerminalData"> & {
      */
     value: ResizeQuiescence;
     case: "resizeQuiescence";
+  } | {
+    /**
+     * Input arbitration between clients sharing a terminal
+     *
+     * Control request (client → server)
+     *
+     * @generated from field: session.v1.InputControl input_control = 17;
+     */
+    value: InputControl;
+    case: "inputControl";
+  } | {
+    /**
+     * Who may type and who is watching (server → client)
+     *
+     * @generated from field: session.v1.InputControlState input_control_state = 18;
+     */
+    value: InputControlState;
+    case: "inputControlState";
+  } | {
+    /**
+     * Client focus/cursor report (client → server)
+     *
+     * @generated from field: session.v1.PresenceUpdate presence_update = 19;
+     */
+    value: PresenceUpdate;
+    case: "presenceUpdate";
+  } | {
+    /**
+     * "frames" streaming mode: sequenced, zstd-compressed output that a
+     * reconnecting client can resume without a full redraw
+     *
+     * Resume outcome, sent first (server → client)
+     *
+     * @generated from field: session.v1.FrameStreamStart frame_stream_start = 20;
+     */
+    value: FrameStreamStart;
+    case: "frameStreamStart";
+  } | {
+    /**
+     * Compression dictionary for later frames (server → client)
+     *
+     * @generated from field: session.v1.FrameDictionary frame_dictionary = 21;
+     */
+    value: FrameDictionary;
+    case: "frameDictionary";
+  } | {
+    /**
+     * Sequenced output (server → client)
+     *
+     * @generated from field: session.v1.TerminalFrame frame = 22;
+     */
+    value: TerminalFrame;
+    case: "frame";
   } | { case: undefined; value?: undefined };
 };
 
@@ -697,12 +750,22 @@ export type CurrentPaneRequest = Message<"session.v1.CurrentPaneRequest"> & {
   /**
    * Streaming mode for terminal output (optional)
    * Options: "raw" (direct PTY bytes), "raw-compressed" (PTY bytes with LZMA),
-   *          "state" (MOSH-style state sync), "hybrid" (both raw and state)
+   * "state" (MOSH-style state sync), "hybrid" (both raw and state),
+   * "frames" (sequenced zstd frames, resumable; see TerminalFrame)
    * Default: "raw" if not specified
    *
    * @generated from field: optional string streaming_mode = 5;
    */
   streamingMode?: string;
+
+  /**
+   * Where a "frames" client left off on its previous connection. When the
+   * server still holds everything after it, the stream resumes there with no
+   * redraw; otherwise the client is resynced with a snapshot.
+   *
+   * @generated from field: optional session.v1.FrameCursor resume_cursor = 6;
+   */
+  resumeCursor?: FrameCursor;
 };
 
 /**
@@ -2063,3 +2126,379 @@ export type NotificationEvent = Message<"session.v1.NotificationEvent"> & {
 export const NotificationEventSchema: GenMessage<NotificationEvent> = /*@__PURE__*/
   messageDesc(file_session_v1_events, 42);
 
+/**
+ * InputControl asks the server to change who may type into a shared terminal.
+ * The server answers with an InputControlState (with a notice when refused).
+ *
+ * @generated from message session.v1.InputControl
+ */
+export type InputControl = Message<"session.v1.InputControl"> & {
+  /**
+   * Action: "set_mode", "take", "request", "grant", "deny" or "release".
+   * Only the session owner's clients may "set_mode" or "take" (control at once).
+   *
+   * @generated from field: string action = 1;
+   */
+  action: string;
+
+  /**
+   * New input mode for "set_mode": "shared" (everyone types), "single_writer"
+   * (one writer, request/grant handoff) or "driver" (driver + observers)
+   *
+   * @generated from field: string mode = 2;
+   */
+  mode: string;
+
+  /**
+   * Client the action applies to, for "grant" (empty = oldest request) and "deny"
+   *
+   * @generated from field: string target_client_id = 3;
+   */
+  targetClientId: string;
+};
+
+/**
+ * Describes the message session.v1.InputControl.
+ * Use `create(InputControlSchema)` to create a new message.
+ */
+export const InputControlSchema: GenMessage<InputControl> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 43);
+
+/**
+ * InputControlState tells a client who may type into the terminal and who is
+ * attached to it. Sent on connect, whenever it changes, and when input or a
+ * control request from this client is refused.
+ *
+ * @generated from message session.v1.InputControlState
+ */
+export type InputControlState = Message<"session.v1.InputControlState"> & {
+  /**
+   * Current input mode: "shared", "single_writer" or "driver"
+   *
+   * @generated from field: string mode = 1;
+   */
+  mode: string;
+
+  /**
+   * Client in control (single writer or driver); empty in shared mode or when nobody is
+   *
+   * @generated from field: string writer_client_id = 2;
+   */
+  writerClientId: string;
+
+  /**
+   * The receiving client's own ID
+   *
+   * @generated from field: string your_client_id = 3;
+   */
+  yourClientId: string;
+
+  /**
+   * Whether the receiving client may type right now
+   *
+   * @generated from field: bool can_write = 4;
+   */
+  canWrite: boolean;
+
+  /**
+   * Clients waiting for control, oldest request first
+   *
+   * @generated from field: repeated string pending_client_ids = 5;
+   */
+  pendingClientIds: string[];
+
+  /**
+   * Everyone attached to the terminal, longest connected first
+   *
+   * @generated from field: repeated session.v1.ClientPresence clients = 6;
+   */
+  clients: ClientPresence[];
+
+  /**
+   * Why the client's last input or request was refused, if it was
+   *
+   * @generated from field: string notice = 7;
+   */
+  notice: string;
+};
+
+/**
+ * Describes the message session.v1.InputControlState.
+ * Use `create(InputControlStateSchema)` to create a new message.
+ */
+export const InputControlStateSchema: GenMessage<InputControlState> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 44);
+
+/**
+ * ClientPresence describes one client attached to a terminal.
+ *
+ * @generated from message session.v1.ClientPresence
+ */
+export type ClientPresence = Message<"session.v1.ClientPresence"> & {
+  /**
+   * @generated from field: string client_id = 1;
+   */
+  clientId: string;
+
+  /**
+   * e.g. "IntelliJ", or the client's address
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * never allowed to type (e.g. view-only share links)
+   *
+   * @generated from field: bool read_only = 3;
+   */
+  readOnly: boolean;
+
+  /**
+   * @generated from field: bool can_write = 4;
+   */
+  canWrite: boolean;
+
+  /**
+   * has a pending control request
+   *
+   * @generated from field: bool requesting = 5;
+   */
+  requesting: boolean;
+
+  /**
+   * client-reported: terminal has focus
+   *
+   * @generated from field: bool focused = 6;
+   */
+  focused: boolean;
+
+  /**
+   * client-reported pointer position in the terminal
+   *
+   * @generated from field: int32 cursor_row = 7;
+   */
+  cursorRow: number;
+
+  /**
+   * @generated from field: int32 cursor_col = 8;
+   */
+  cursorCol: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp joined_at = 9;
+   */
+  joinedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_input_at = 10;
+   */
+  lastInputAt?: Timestamp;
+
+  /**
+   * belongs to the session owner, not a share link
+   *
+   * @generated from field: bool owner = 11;
+   */
+  owner: boolean;
+};
+
+/**
+ * Describes the message session.v1.ClientPresence.
+ * Use `create(ClientPresenceSchema)` to create a new message.
+ */
+export const ClientPresenceSchema: GenMessage<ClientPresence> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 45);
+
+/**
+ * PresenceUpdate reports a client's presence details to the other clients.
+ *
+ * @generated from message session.v1.PresenceUpdate
+ */
+export type PresenceUpdate = Message<"session.v1.PresenceUpdate"> & {
+  /**
+   * Display label; empty keeps the current one
+   *
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: bool focused = 2;
+   */
+  focused: boolean;
+
+  /**
+   * @generated from field: int32 cursor_row = 3;
+   */
+  cursorRow: number;
+
+  /**
+   * @generated from field: int32 cursor_col = 4;
+   */
+  cursorCol: number;
+};
+
+/**
+ * Describes the message session.v1.PresenceUpdate.
+ * Use `create(PresenceUpdateSchema)` to create a new message.
+ */
+export const PresenceUpdateSchema: GenMessage<PresenceUpdate> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 46);
+
+/**
+ * FrameCursor identifies the last frame a client applied.
+ *
+ * @generated from message session.v1.FrameCursor
+ */
+export type FrameCursor = Message<"session.v1.FrameCursor"> & {
+  /**
+   * stream_id from the FrameStreamStart of the stream the frame came from
+   *
+   * @generated from field: string stream_id = 1;
+   */
+  streamId: string;
+
+  /**
+   * Sequence of the last frame applied
+   *
+   * @generated from field: uint64 sequence = 2;
+   */
+  sequence: bigint;
+};
+
+/**
+ * Describes the message session.v1.FrameCursor.
+ * Use `create(FrameCursorSchema)` to create a new message.
+ */
+export const FrameCursorSchema: GenMessage<FrameCursor> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 47);
+
+/**
+ * FrameStreamStart opens a "frames" stream and tells the client whether its
+ * resume cursor was honoured.
+ *
+ * @generated from message session.v1.FrameStreamStart
+ */
+export type FrameStreamStart = Message<"session.v1.FrameStreamStart"> & {
+  /**
+   * Identifies this session's frame sequence. It changes whenever the server
+   * may have missed output (restart, output capture interrupted), so cursors
+   * from an older stream are never resumed.
+   *
+   * @generated from field: string stream_id = 1;
+   */
+  streamId: string;
+
+  /**
+   * True: frames continue right after the client's cursor and the client
+   * keeps its screen. False: the client must reset its terminal; a snapshot
+   * frame follows.
+   *
+   * @generated from field: bool resumed = 2;
+   */
+  resumed: boolean;
+
+  /**
+   * Sequence the stream continues from; the next frame is sequence + 1
+   *
+   * @generated from field: uint64 sequence = 3;
+   */
+  sequence: bigint;
+
+  /**
+   * Why the resume cursor could not be honoured (empty when resumed or when
+   * no cursor was sent), e.g. "evicted", "stream_changed"
+   *
+   * @generated from field: string resync_reason = 4;
+   */
+  resyncReason: string;
+};
+
+/**
+ * Describes the message session.v1.FrameStreamStart.
+ * Use `create(FrameStreamStartSchema)` to create a new message.
+ */
+export const FrameStreamStartSchema: GenMessage<FrameStreamStart> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 48);
+
+/**
+ * FrameDictionary carries a zstd dictionary trained on the session's output.
+ * Frames naming its id can only be decoded with it.
+ *
+ * @generated from message session.v1.FrameDictionary
+ */
+export type FrameDictionary = Message<"session.v1.FrameDictionary"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message session.v1.FrameDictionary.
+ * Use `create(FrameDictionarySchema)` to create a new message.
+ */
+export const FrameDictionarySchema: GenMessage<FrameDictionary> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 49);
+
+/**
+ * TerminalFrame carries one chunk of terminal output in "frames" mode.
+ *
+ * @generated from message session.v1.TerminalFrame
+ */
+export type TerminalFrame = Message<"session.v1.TerminalFrame"> & {
+  /**
+   * Scrollback sequence of this output. For snapshots, the sequence the
+   * snapshot is current as of.
+   *
+   * @generated from field: uint64 sequence = 1;
+   */
+  sequence: bigint;
+
+  /**
+   * Output bytes, zstd-compressed when compressed is set
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: bool compressed = 3;
+   */
+  compressed: boolean;
+
+  /**
+   * Dictionary the data was compressed with (0 = none)
+   *
+   * @generated from field: uint32 dictionary_id = 4;
+   */
+  dictionaryId: number;
+
+  /**
+   * Uncompressed length of data
+   *
+   * @generated from field: uint32 raw_size = 5;
+   */
+  rawSize: number;
+
+  /**
+   * True for a full-screen snapshot sent on resync rather than recorded
+   * output; it replaces the client's screen
+   *
+   * @generated from field: bool snapshot = 6;
+   */
+  snapshot: boolean;
+};
+
+/**
+ * Describes the message session.v1.TerminalFrame.
+ * Use `create(TerminalFrameSchema)` to create a new message.
+ */
+export const TerminalFrameSchema: GenMessage<TerminalFrame> = /*@__PURE__*/
+  messageDesc(file_session_v1_events, 50);
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file session/v1/session.proto.
  */
 export const file_session_v1_session: GenFile = /*@__PURE__*/
-  fileDesc("ChhzZXNzaW9uL3YxL3Nlc3Npb24ucHJvdG8SCnNlc3Npb24udjEi3QEKE0xpc3RTZXNzaW9uc1JlcXVlc3QSLgoGc3RhdHVzGAEgASgOMhkuc2Vzc2lvbi52MS5TZXNzaW9uU3RhdHVzSACIAQESFQoIY2F0ZWdvcnkYAiABKAlIAYgBARITCgtoaWRlX3BhdXNlZBgDIAEoCBIZCgxzZWFyY2hfcXVlcnkYBCABKAlIAogBARIXCgpwcm9qZWN0X2lkGAUgASgJSAOIAQFCCQoHX3N0YXR1c0ILCglfY2F0ZWdvcnlCDwoNX3NlYXJjaF9xdWVyeUINCgtfcHJvamVjdF9pZCI9ChRMaXN0U2Vzc2lvbnNSZXNwb25zZRIlCghzZXNzaW9ucxgBIAMoCzITLnNlc3Npb24udjEuU2Vzc2lvbiIfChFHZXRTZXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCSI6ChJHZXRTZXNzaW9uUmVzcG9uc2USJAoHc2Vzc2lvbhgBIAEoCzITLnNlc3Npb24udjEuU2Vzc2lvbiKMAwoUQ3JlYXRlU2Vzc2lvblJlcXVlc3QSDQoFdGl0bGUYASABKAkSDAoEcGF0aBgCIAEoCRITCgt3b3JraW5nX2RpchgDIAEoCRIOCgZicmFuY2gYBCABKAkSDwoHcHJvZ3JhbRgFIAEoCRIQCghjYXRlZ29yeRgGIAEoCRIOCgZwcm9tcHQYByABKAkSEAoIYXV0b195ZXMYCCABKAgSGQoRZXhpc3Rpbmdfd29ya3RyZWUYCSABKAkSEQoJcmVzdW1lX2lkGAogASgJEg8KB3Byb2ZpbGUYCyABKAkSFQoNc2tpcF9kZWZhdWx0cxgMIAEoCBItCgxzZXNzaW9uX3R5cGUYDSABKA4yFy5zZXNzaW9uLnYxLlNlc3Npb25UeXBlEg8KB29uZV9vZmYYDiABKAgSFgoOaW5pdGlhbF9wcm9tcHQYDyABKAkSEAoIb25lX3Nob3QYECABKAgSEgoKcHJvamVjdF9pZBgRIAEoCRIZChFjcmVhdGVfaWZfbWlzc2luZxgSIAEoCCI9ChVDcmVhdGVTZXNzaW9uUmVzcG9uc2USJAoHc2Vzc2lvbhgBIAEoCzITLnNlc3Npb24udjEuU2Vzc2lvbiKxAgoUVXBkYXRlU2Vzc2lvblJlcXVlc3QSCgoCaWQYASABKAkSLgoGc3RhdHVzGAIgASgOMhkuc2Vzc2lvbi52MS5TZXNzaW9uU3RhdHVzSACIAQESFQoIY2F0ZWdvcnkYAyABKAlIAYgBARISCgV0aXRsZRgEIAEoCUgCiAEBEhQKB3Byb2dyYW0YBSABKAlIA4gBARIMCgR0YWdzGAYgAygJEhgKC3dvcmtpbmdfZGlyGAcgASgJSASIAQESHwoScmF0ZV9saW1pdF9lbmFibGVkGAggASgISAWIAQFCCQoHX3N0YXR1c0ILCglfY2F0ZWdvcnlCCAoGX3RpdGxlQgoKCF9wcm9ncmFtQg4KDF93b3JraW5nX2RpckIVChNfcmF0ZV9saW1pdF9lbmFibGVkIj0KFVVwZGF0ZVNlc3Npb25SZXNwb25zZRIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uIjEKFERlbGV0ZVNlc3Npb25SZXF1ZXN0EgoKAmlkGAEgASgJEg0KBWZvcmNlGAIgASgIIjkKFURlbGV0ZVNlc3Npb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkipAEKFFdhdGNoU2Vzc2lvbnNSZXF1ZXN0EhwKD2NhdGVnb3J5X2ZpbHRlchgBIAEoCUgAiAEBEjUKDXN0YXR1c19maWx0ZXIYAiABKA4yGS5zZXNzaW9uLnYxLlNlc3Npb25TdGF0dXNIAYgBARIRCglhZnRlcl9zZXEYAyABKARCEgoQX2NhdGVnb3J5X2ZpbHRlckIQCg5fc3RhdHVzX2ZpbHRlciIjChVHZXRTZXNzaW9uRGlmZlJlcXVlc3QSCgoCaWQYASABKAkiQwoWR2V0U2Vzc2lvbkRpZmZSZXNwb25zZRIpCgpkaWZmX3N0YXRzGAEgASgLMhUuc2Vzc2lvbi52MS5EaWZmU3RhdHMiIQoTR2V0VkNTU3RhdHVzUmVxdWVzdBIKCgJpZBgBIAEoCSJQChRHZXRWQ1NTdGF0dXNSZXNwb25zZRIpCgp2Y3Nfc3RhdHVzGAEgASgLMhUuc2Vzc2lvbi52MS5WQ1NTdGF0dXMSDQoFZXJyb3IYAiABKAkiqgEKFUdldFJldmlld1F1ZXVlUmVxdWVzdBIyCg9wcmlvcml0eV9maWx0ZXIYASABKA4yFC5zZXNzaW9uLnYxLlByaW9yaXR5SACIAQESNwoNcmVhc29uX2ZpbHRlchgCIAEoDjIbLnNlc3Npb24udjEuQXR0ZW50aW9uUmVhc29uSAGIAQFCEgoQX3ByaW9yaXR5X2ZpbHRlckIQCg5fcmVhc29uX2ZpbHRlciJHChZHZXRSZXZpZXdRdWV1ZVJlc3BvbnNlEi0KDHJldmlld19xdWV1ZRgBIAEoCzIXLnNlc3Npb24udjEuUmV2aWV3UXVldWUiJwoZQWNrbm93bGVkZ2VTZXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCSI+ChpBY2tub3dsZWRnZVNlc3Npb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAki1AIKDkdldExvZ3NSZXF1ZXN0EhkKDHNlYXJjaF9xdWVyeRgBIAEoCUgAiAEBEhIKBWxldmVsGAIgASgJSAGIAQESMwoKc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBARIxCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBARISCgVsaW1pdBgFIAEoBUgEiAEBEhMKBm9mZnNldBgGIAEoBUgFiAEBEhcKCnNlc3Npb25faWQYByABKAlIBogBARIOCgZsZXZlbHMYCCADKAlCDwoNX3NlYXJjaF9xdWVyeUIICgZfbGV2ZWxCDQoLX3N0YXJ0X3RpbWVCCwoJX2VuZF90aW1lQggKBl9saW1pdEIJCgdfb2Zmc2V0Qg0KC19zZXNzaW9uX2lkIl8KD0dldExvZ3NSZXNwb25zZRIlCgdlbnRyaWVzGAEgAygLMhQuc2Vzc2lvbi52MS5Mb2dFbnRyeRITCgt0b3RhbF9jb3VudBgCIAEoBRIQCghoYXNfbW9yZRgDIAEoCCJ5CghMb2dFbnRyeRItCgl0aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxldmVsGAIgASgJEg8KB21lc3NhZ2UYAyABKAkSEwoGc291cmNlGAQgASgJSACIAQFCCQoHX3NvdXJjZSLHAQoXV2F0Y2hSZXZpZXdRdWV1ZVJlcXVlc3QSLQoPcHJpb3JpdHlfZmlsdGVyGAEgAygOMhQuc2Vzc2lvbi52MS5Qcmlvcml0eRIyCg1yZWFzb25fZmlsdGVyGAIgAygOMhsuc2Vzc2lvbi52MS5BdHRlbnRpb25SZWFzb24SGgoSaW5jbHVkZV9zdGF0aXN0aWNzGAMgASgIEhgKEGluaXRpYWxfc25hcHNob3QYBCABKAgSEwoLc2Vzc2lvbl9pZHMYBSADKAki2wIKGUxvZ1VzZXJJbnRlcmFjdGlvblJlcXVlc3QSFwoKc2Vzc2lvbl9pZBgBIAEoCUgAiAEBEkoKEGludGVyYWN0aW9uX3R5cGUYAiABKA4yMC5zZXNzaW9uLnYxLlVzZXJJbnRlcmFjdGlvbkV2ZW50LkludGVyYWN0aW9uVHlwZRIUCgdjb250ZXh0GAMgASgJSAGIAQESHAoPbm90aWZpY2F0aW9uX2lkGAQgASgJSAKIAQESRQoIbWV0YWRhdGEYBSADKAsyMy5zZXNzaW9uLnYxLkxvZ1VzZXJJbnRlcmFjdGlvblJlcXVlc3QuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDQoLX3Nlc3Npb25faWRCCgoIX2NvbnRleHRCEgoQX25vdGlmaWNhdGlvbl9pZCJLChpMb2dVc2VySW50ZXJhY3Rpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhIKBWVycm9yGAIgASgJSACIAQFCCAoGX2Vycm9yIioKFkdldENsYXVkZUNvbmZpZ1JlcXVlc3QSEAoIZmlsZW5hbWUYASABKAkiRwoXR2V0Q2xhdWRlQ29uZmlnUmVzcG9uc2USLAoGY29uZmlnGAEgASgLMhwuc2Vzc2lvbi52MS5DbGF1ZGVDb25maWdGaWxlIhoKGExpc3RDbGF1ZGVDb25maWdzUmVxdWVzdCJKChlMaXN0Q2xhdWRlQ29uZmlnc1Jlc3BvbnNlEi0KB2NvbmZpZ3MYASADKAsyHC5zZXNzaW9uLnYxLkNsYXVkZUNvbmZpZ0ZpbGUiUAoZVXBkYXRlQ2xhdWRlQ29uZmlnUmVxdWVzdBIQCghmaWxlbmFtZRgBIAEoCRIPCgdjb250ZW50GAIgASgJEhAKCHZhbGlkYXRlGAMgASgIIkoKGlVwZGF0ZUNsYXVkZUNvbmZpZ1Jlc3BvbnNlEiwKBmNvbmZpZxgBIAEoCzIcLnNlc3Npb24udjEuQ2xhdWRlQ29uZmlnRmlsZSJtChBDbGF1ZGVDb25maWdGaWxlEgwKBG5hbWUYASABKAkSDAoEcGF0aBgCIAEoCRIPCgdjb250ZW50GAMgASgJEiwKCG1vZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKeAQoYTGlzdENsYXVkZUhpc3RvcnlSZXF1ZXN0EhQKB3Byb2plY3QYASABKAlIAIgBARIZCgxzZWFyY2hfcXVlcnkYAiABKAlIAYgBARINCgVsaW1pdBgDIAEoBRIRCglwYWdlX3NpemUYBCABKAUSEgoKcGFnZV90b2tlbhgFIAEoCUIKCghfcHJvamVjdEIPCg1fc2VhcmNoX3F1ZXJ5InoKGUxpc3RDbGF1ZGVIaXN0b3J5UmVzcG9uc2USLwoHZW50cmllcxgBIAMoCzIeLnNlc3Npb24udjEuQ2xhdWRlSGlzdG9yeUVudHJ5EhMKC3RvdGFsX2NvdW50GAIgASgFEhcKD25leHRfcGFnZV90b2tlbhgDIAEoCSIrCh1HZXRDbGF1ZGVIaXN0b3J5RGV0YWlsUmVxdWVzdBIKCgJpZBgBIAEoCSJPCh5HZXRDbGF1ZGVIaXN0b3J5RGV0YWlsUmVzcG9uc2USLQoFZW50cnkYASABKAsyHi5zZXNzaW9uLnYxLkNsYXVkZUhpc3RvcnlFbnRyeSLwAQoSQ2xhdWRlSGlzdG9yeUVudHJ5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDwoHcHJvamVjdBgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVtb2RlbBgGIAEoCRIVCg1tZXNzYWdlX2NvdW50GAcgASgFEikKCnZjc19zdGF0dXMYCCABKAsyFS5zZXNzaW9uLnYxLlZDU1N0YXR1cyJaCh9HZXRDbGF1ZGVIaXN0b3J5TWVzc2FnZXNSZXF1ZXN0EgoKAmlkGAEgASgJEg0KBWxpbWl0GAIgASgFEg4KBm9mZnNldBgDIAEoBRIMCgR0YWlsGAQgASgIImQKIEdldENsYXVkZUhpc3RvcnlNZXNzYWdlc1Jlc3BvbnNlEisKCG1lc3NhZ2VzGAEgAygLMhkuc2Vzc2lvbi52MS5DbGF1ZGVNZXNzYWdlEhMKC3RvdGFsX2NvdW50GAIgASgFImwKDUNsYXVkZU1lc3NhZ2USDAoEcm9sZRgBIAEoCRIPCgdjb250ZW50GAIgASgJEi0KCXRpbWVzdGFtcBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbW9kZWwYBCABKAkijgIKGlNlYXJjaENsYXVkZUhpc3RvcnlSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhQKB3Byb2plY3QYAiABKAlIAIgBARISCgVtb2RlbBgDIAEoCUgBiAEBEjMKCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQESMQoIZW5kX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAOIAQESDQoFbGltaXQYBiABKAUSDgoGb2Zmc2V0GAcgASgFQgoKCF9wcm9qZWN0QggKBl9tb2RlbEINCgtfc3RhcnRfdGltZUILCglfZW5kX3RpbWUiiAEKG1NlYXJjaENsYXVkZUhpc3RvcnlSZXNwb25zZRIpCgdyZXN1bHRzGAEgAygLMhguc2Vzc2lvbi52MS5TZWFyY2hSZXN1bHQSFQoNdG90YWxfbWF0Y2hlcxgCIAEoBRIVCg1xdWVyeV90aW1lX21zGAMgASgDEhAKCGhhc19tb3JlGAQgASgIItABCgxTZWFyY2hSZXN1bHQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIUCgxzZXNzaW9uX25hbWUYAiABKAkSDwoHcHJvamVjdBgDIAEoCRIVCg1tZXNzYWdlX2luZGV4GAQgASgFEg0KBXNjb3JlGAUgASgCEisKCHNuaXBwZXRzGAYgAygLMhkuc2Vzc2lvbi52MS5TZWFyY2hTbmlwcGV0EjIKCG1ldGFkYXRhGAcgASgLMiAuc2Vzc2lvbi52MS5TZWFyY2hSZXN1bHRNZXRhZGF0YSKbAQoNU2VhcmNoU25pcHBldBIMCgR0ZXh0GAEgASgJEjQKEGhpZ2hsaWdodF9yYW5nZXMYAiADKAsyGi5zZXNzaW9uLnYxLkhpZ2hsaWdodFJhbmdlEhQKDG1lc3NhZ2Vfcm9sZRgDIAEoCRIwCgxtZXNzYWdlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIiwKDkhpZ2hsaWdodFJhbmdlEg0KBXN0YXJ0GAEgASgFEgsKA2VuZBgCIAEoBSKGAQoUU2VhcmNoUmVzdWx0TWV0YWRhdGESGQoRaXNfbWV0YWRhdGFfbWF0Y2gYASABKAgSFAoMbWF0Y2hfc291cmNlGAIgASgJEg0KBW1vZGVsGAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIh4KEEdldFBSSW5mb1JlcXVlc3QSCgoCaWQYASABKAkiOAoRR2V0UFJJbmZvUmVzcG9uc2USIwoHcHJfaW5mbxgBIAEoCzISLnNlc3Npb24udjEuUFJJbmZvIiIKFEdldFBSQ29tbWVudHNSZXF1ZXN0EgoKAmlkGAEgASgJIkAKFUdldFBSQ29tbWVudHNSZXNwb25zZRInCghjb21tZW50cxgBIAMoCzIVLnNlc3Npb24udjEuUFJDb21tZW50IjAKFFBvc3RQUkNvbW1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBGJvZHkYAiABKAkiOQoVUG9zdFBSQ29tbWVudFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSI8Cg5NZXJnZVBSUmVxdWVzdBIKCgJpZBgBIAEoCRITCgZtZXRob2QYAiABKAlIAIgBAUIJCgdfbWV0aG9kIjMKD01lcmdlUFJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiHAoOQ2xvc2VQUlJlcXVlc3QSCgoCaWQYASABKAkiMwoPQ2xvc2VQUlJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSKwAgoXU2VuZE5vdGlmaWNhdGlvblJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRI3ChFub3RpZmljYXRpb25fdHlwZRgCIAEoDjIcLnNlc3Npb24udjEuTm90aWZpY2F0aW9uVHlwZRIyCghwcmlvcml0eRgDIAEoDjIgLnNlc3Npb24udjEuTm90aWZpY2F0aW9uUHJpb3JpdHkSDQoFdGl0bGUYBCABKAkSDwoHbWVzc2FnZRgFIAEoCRJDCghtZXRhZGF0YRgGIAMoCzIxLnNlc3Npb24udjEuU2VuZE5vdGlmaWNhdGlvblJlcXVlc3QuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiVQoYU2VuZE5vdGlmaWNhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCRIXCg9ub3RpZmljYXRpb25faWQYAyABKAkimgEKEkZvY3VzV2luZG93UmVxdWVzdBIWCglidW5kbGVfaWQYASABKAlIAIgBARIVCghhcHBfbmFtZRgCIAEoCUgBiAEBEhAKA3BpZBgDIAEoBUgCiAEBEhQKB3Byb2plY3QYBCABKAlIA4gBAUIMCgpfYnVuZGxlX2lkQgsKCV9hcHBfbmFtZUIGCgRfcGlkQgoKCF9wcm9qZWN0IkkKE0ZvY3VzV2luZG93UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhAKCHBsYXRmb3JtGAMgASgJIjUKFFJlbmFtZVNlc3Npb25SZXF1ZXN0EgoKAmlkGAEgASgJEhEKCW5ld190aXRsZRgCIAEoCSI9ChVSZW5hbWVTZXNzaW9uUmVzcG9uc2USJAoHc2Vzc2lvbhgBIAEoCzITLnNlc3Npb24udjEuU2Vzc2lvbiI8ChVSZXN0YXJ0U2Vzc2lvblJlcXVlc3QSCgoCaWQYASABKAkSFwoPcHJlc2VydmVfb3V0cHV0GAIgASgIImAKFlJlc3RhcnRTZXNzaW9uUmVzcG9uc2USJAoHc2Vzc2lvbhgBIAEoCzITLnNlc3Npb24udjEuU2Vzc2lvbhIPCgdzdWNjZXNzGAIgASgIEg8KB21lc3NhZ2UYAyABKAkiJQoXR2V0V29ya3NwYWNlSW5mb1JlcXVlc3QSCgoCaWQYASABKAkiUAoYR2V0V29ya3NwYWNlSW5mb1Jlc3BvbnNlEiUKCHZjc19pbmZvGAEgASgLMhMuc2Vzc2lvbi52MS5WQ1NJbmZvEg0KBWVycm9yGAIgASgJIikKG0xpc3RXb3Jrc3BhY2VUYXJnZXRzUmVxdWVzdBIKCgJpZBgBIAEoCSJlChxMaXN0V29ya3NwYWNlVGFyZ2V0c1Jlc3BvbnNlEjYKB3RhcmdldHMYASABKAsyJS5zZXNzaW9uLnYxLkF2YWlsYWJsZVdvcmtzcGFjZVRhcmdldHMSDQoFZXJyb3IYAiABKAki0QEKFlN3aXRjaFdvcmtzcGFjZVJlcXVlc3QSCgoCaWQYASABKAkSNAoLc3dpdGNoX3R5cGUYAiABKA4yHy5zZXNzaW9uLnYxLldvcmtzcGFjZVN3aXRjaFR5cGUSDgoGdGFyZ2V0GAMgASgJEjMKD2NoYW5nZV9zdHJhdGVneRgEIAEoDjIaLnNlc3Npb24udjEuQ2hhbmdlU3RyYXRlZ3kSGQoRY3JlYXRlX2lmX21pc3NpbmcYBSABKAgSFQoNYmFzZV9yZXZpc2lvbhgGIAEoCSJhChZSZXNvbHZlQXBwcm92YWxSZXF1ZXN0EhMKC2FwcHJvdmFsX2lkGAEgASgJEhAKCGRlY2lzaW9uGAIgASgJEhQKB21lc3NhZ2UYAyABKAlIAIgBAUIKCghfbWVzc2FnZSI7ChdSZXNvbHZlQXBwcm92YWxSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiRQobTGlzdFBlbmRpbmdBcHByb3ZhbHNSZXF1ZXN0EhcKCnNlc3Npb25faWQYASABKAlIAIgBAUINCgtfc2Vzc2lvbl9pZCJTChxMaXN0UGVuZGluZ0FwcHJvdmFsc1Jlc3BvbnNlEjMKCWFwcHJvdmFscxgBIAMoCzIgLnNlc3Npb24udjEuUGVuZGluZ0FwcHJvdmFsUHJvdG8i1gEKF1N3aXRjaFdvcmtzcGFjZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCRIZChFwcmV2aW91c19yZXZpc2lvbhgDIAEoCRIYChBjdXJyZW50X3JldmlzaW9uGAQgASgJEiUKCHZjc190eXBlGAUgASgOMhMuc2Vzc2lvbi52MS5WQ1NUeXBlEhcKD2NoYW5nZXNfaGFuZGxlZBgGIAEoCRIkCgdzZXNzaW9uGAcgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uIl4KGkNyZWF0ZURlYnVnU25hcHNob3RSZXF1ZXN0EhEKBG5vdGUYASABKAlIAIgBARIWCglsb2dfbGluZXMYAiABKAVIAYgBAUIHCgVfbm90ZUIMCgpfbG9nX2xpbmVzIm0KG0NyZWF0ZURlYnVnU25hcHNob3RSZXNwb25zZRIRCglmaWxlX3BhdGgYASABKAkSDwoHc3VtbWFyeRgCIAEoCRIRCgl0aW1lc3RhbXAYAyABKAkSFwoPZmlsZV9zaXplX2J5dGVzGAQgASgDIr8EChlOb3RpZmljYXRpb25IaXN0b3J5UmVjb3JkEgoKAmlkGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkSFAoMc2Vzc2lvbl9uYW1lGAMgASgJEjcKEW5vdGlmaWNhdGlvbl90eXBlGAQgASgOMhwuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25UeXBlEjIKCHByaW9yaXR5GAUgASgOMiAuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgGIAEoCRIPCgdtZXNzYWdlGAcgASgJEkUKCG1ldGFkYXRhGAggAygLMjMuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25IaXN0b3J5UmVjb3JkLk1ldGFkYXRhRW50cnkSLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHaXNfcmVhZBgKIAEoCBIwCgdyZWFkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhgKEG9jY3VycmVuY2VfY291bnQYDCABKAUSOQoQbGFzdF9vY2N1cnJlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCCgoIX3JlYWRfYXRCEwoRX2xhc3Rfb2NjdXJyZWRfYXQi9wEKHUdldE5vdGlmaWNhdGlvbkhpc3RvcnlSZXF1ZXN0EhIKBWxpbWl0GAEgASgFSACIAQESEwoGb2Zmc2V0GAIgASgFSAGIAQESNgoLdHlwZV9maWx0ZXIYAyABKA4yHC5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvblR5cGVIAogBARIXCgpzZXNzaW9uX2lkGAQgASgJSAOIAQESGAoLdW5yZWFkX29ubHkYBSABKAhIBIgBAUIICgZfbGltaXRCCQoHX29mZnNldEIOCgxfdHlwZV9maWx0ZXJCDQoLX3Nlc3Npb25faWRCDgoMX3VucmVhZF9vbmx5IpsBCh5HZXROb3RpZmljYXRpb25IaXN0b3J5UmVzcG9uc2USPAoNbm90aWZpY2F0aW9ucxgBIAMoCzIlLnNlc3Npb24udjEuTm90aWZpY2F0aW9uSGlzdG9yeVJlY29yZBITCgt0b3RhbF9jb3VudBgCIAEoBRIUCgx1bnJlYWRfY291bnQYAyABKAUSEAoIaGFzX21vcmUYBCABKAgiNwobTWFya05vdGlmaWNhdGlvblJlYWRSZXF1ZXN0EhgKEG5vdGlmaWNhdGlvbl9pZHMYASADKAkiRQocTWFya05vdGlmaWNhdGlvblJlYWRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhQKDG1hcmtlZF9jb3VudBgCIAEoBSJVCh9DbGVhck5vdGlmaWNhdGlvbkhpc3RvcnlSZXF1ZXN0Eh0KEGJlZm9yZV90aW1lc3RhbXAYASABKAlIAIgBAUITChFfYmVmb3JlX3RpbWVzdGFtcCJKCiBDbGVhck5vdGlmaWNhdGlvbkhpc3RvcnlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWNsZWFyZWRfY291bnQYAiABKAUiSAoYTGlzdEFwcHJvdmFsUnVsZXNSZXF1ZXN0EhoKDXNvdXJjZV9maWx0ZXIYASABKAlIAIgBAUIQCg5fc291cmNlX2ZpbHRlciJJChlMaXN0QXBwcm92YWxSdWxlc1Jlc3BvbnNlEiwKBXJ1bGVzGAEgAygLMh0uc2Vzc2lvbi52MS5BcHByb3ZhbFJ1bGVQcm90byJIChlVcHNlcnRBcHByb3ZhbFJ1bGVSZXF1ZXN0EisKBHJ1bGUYASABKAsyHS5zZXNzaW9uLnYxLkFwcHJvdmFsUnVsZVByb3RvIloKGlVwc2VydEFwcHJvdmFsUnVsZVJlc3BvbnNlEisKBHJ1bGUYASABKAsyHS5zZXNzaW9uLnYxLkFwcHJvdmFsUnVsZVByb3RvEg8KB2NyZWF0ZWQYAiABKAgiJwoZRGVsZXRlQXBwcm92YWxSdWxlUmVxdWVzdBIKCgJpZBgBIAEoCSI+ChpEZWxldGVBcHByb3ZhbFJ1bGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiRwobR2V0QXBwcm92YWxBbmFseXRpY3NSZXF1ZXN0EhgKC3dpbmRvd19kYXlzGAEgASgFSACIAQFCDgoMX3dpbmRvd19kYXlzIocBChxHZXRBcHByb3ZhbEFuYWx5dGljc1Jlc3BvbnNlEjIKB3N1bW1hcnkYASABKAsyIS5zZXNzaW9uLnYxLkFuYWx5dGljc1N1bW1hcnlQcm90bxIzCg1kYWlseV9idWNrZXRzGAIgAygLMhwuc2Vzc2lvbi52MS5EYWlseUJ1Y2tldFByb3RvIhYKFExpc3REYXRhYmFzZXNSZXF1ZXN0ImIKFUxpc3REYXRhYmFzZXNSZXNwb25zZRIrCglkYXRhYmFzZXMYASADKAsyGC5zZXNzaW9uLnYxLkRhdGFiYXNlSW5mbxIcChRjdXJyZW50X3dvcmtzcGFjZV9pZBgCIAEoCSIbChlHZXRDdXJyZW50RGF0YWJhc2VSZXF1ZXN0IkgKGkdldEN1cnJlbnREYXRhYmFzZVJlc3BvbnNlEioKCGRhdGFiYXNlGAEgASgLMhguc2Vzc2lvbi52MS5EYXRhYmFzZUluZm8iKwoVU3dpdGNoRGF0YWJhc2VSZXF1ZXN0EhIKCmNvbmZpZ19kaXIYASABKAkiOgoWU3dpdGNoRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiKgoUTWVyZ2VEYXRhYmFzZVJlcXVlc3QSEgoKY29uZmlnX2RpchgBIAEoCSJuChVNZXJnZURhdGFiYXNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhkKEXNlc3Npb25zX2ltcG9ydGVkGAMgASgFEhgKEHNlc3Npb25zX3NraXBwZWQYBCABKAUiPAoXQ3JlYXRlQ2hlY2twb2ludFJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRINCgVsYWJlbBgCIAEoCSJLChhDcmVhdGVDaGVja3BvaW50UmVzcG9uc2USLwoKY2hlY2twb2ludBgBIAEoCzIbLnNlc3Npb24udjEuQ2hlY2twb2ludFByb3RvIiwKFkxpc3RDaGVja3BvaW50c1JlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCSJLChdMaXN0Q2hlY2twb2ludHNSZXNwb25zZRIwCgtjaGVja3BvaW50cxgBIAMoCzIbLnNlc3Npb24udjEuQ2hlY2twb2ludFByb3RvIlIKEkZvcmtTZXNzaW9uUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEhUKDWNoZWNrcG9pbnRfaWQYAiABKAkSEQoJbmV3X3RpdGxlGAMgASgJIjsKE0ZvcmtTZXNzaW9uUmVzcG9uc2USJAoHc2Vzc2lvbhgBIAEoCzITLnNlc3Npb24udjEuU2Vzc2lvbiJNChBMaXN0RmlsZXNSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSDAoEcGF0aBgCIAEoCRIXCg9pbmNsdWRlX2lnbm9yZWQYAyABKAgicwoRTGlzdEZpbGVzUmVzcG9uc2USIwoFZmlsZXMYASADKAsyFC5zZXNzaW9uLnYxLkZpbGVOb2RlEhEKCWJhc2VfcGF0aBgCIAEoCRIRCgl0cnVuY2F0ZWQYAyABKAgSEwoLdG90YWxfY291bnQYBCABKAUiOQoVR2V0RmlsZUNvbnRlbnRSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSDAoEcGF0aBgCIAEoCSKIAQoWR2V0RmlsZUNvbnRlbnRSZXNwb25zZRIPCgdjb250ZW50GAEgASgJEhAKCGVuY29kaW5nGAIgASgJEhEKCWlzX2JpbmFyeRgDIAEoCBIMCgRzaXplGAQgASgDEhQKDGNvbnRlbnRfdHlwZRgFIAEoCRIUCgxpc190cnVuY2F0ZWQYBiABKAgiZQoSU2VhcmNoRmlsZXNSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSDQoFcXVlcnkYAiABKAkSFwoPaW5jbHVkZV9pZ25vcmVkGAMgASgIEhMKC21heF9yZXN1bHRzGAQgASgFImQKE1NlYXJjaEZpbGVzUmVzcG9uc2USIwoFZmlsZXMYASADKAsyFC5zZXNzaW9uLnYxLkZpbGVOb2RlEhEKCXRydW5jYXRlZBgCIAEoCBIVCg10b3RhbF9tYXRjaGVzGAMgASgFImAKGkxpc3RQYXRoQ29tcGxldGlvbnNSZXF1ZXN0EhMKC3BhdGhfcHJlZml4GAEgASgJEhMKC21heF9yZXN1bHRzGAIgASgFEhgKEGRpcmVjdG9yaWVzX29ubHkYAyABKAgimAEKG0xpc3RQYXRoQ29tcGxldGlvbnNSZXNwb25zZRImCgdlbnRyaWVzGAEgAygLMhUuc2Vzc2lvbi52MS5QYXRoRW50cnkSEAoIYmFzZV9kaXIYAiABKAkSEQoJdHJ1bmNhdGVkGAMgASgIEhcKD2Jhc2VfZGlyX2V4aXN0cxgEIAEoCBITCgtwYXRoX2V4aXN0cxgFIAEoCCI9CglQYXRoRW50cnkSDAoEcGF0aBgBIAEoCRIMCgRuYW1lGAIgASgJEhQKDGlzX2RpcmVjdG9yeRgDIAEoCCLOAgoUUHJvZmlsZURlZmF1bHRzUHJvdG8SDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIPCgdwcm9ncmFtGAMgASgJEhAKCGF1dG9feWVzGAQgASgIEgwKBHRhZ3MYBSADKAkSPwoIZW52X3ZhcnMYBiADKAsyLS5zZXNzaW9uLnYxLlByb2ZpbGVEZWZhdWx0c1Byb3RvLkVudlZhcnNFbnRyeRIRCgljbGlfZmxhZ3MYByABKAkSLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaLgoMRW52VmFyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiaAoSRGlyZWN0b3J5UnVsZVByb3RvEgwKBHBhdGgYASABKAkSDwoHcHJvZmlsZRgCIAEoCRIzCglvdmVycmlkZXMYAyABKAsyIC5zZXNzaW9uLnYxLlByb2ZpbGVEZWZhdWx0c1Byb3RvItQDChVTZXNzaW9uRGVmYXVsdHNDb25maWcSDwoHcHJvZ3JhbRgBIAEoCRIQCghhdXRvX3llcxgCIAEoCBIMCgR0YWdzGAMgAygJEkAKCGVudl92YXJzGAQgAygLMi4uc2Vzc2lvbi52MS5TZXNzaW9uRGVmYXVsdHNDb25maWcuRW52VmFyc0VudHJ5EhEKCWNsaV9mbGFncxgFIAEoCRJBCghwcm9maWxlcxgGIAMoCzIvLnNlc3Npb24udjEuU2Vzc2lvbkRlZmF1bHRzQ29uZmlnLlByb2ZpbGVzRW50cnkSNwoPZGlyZWN0b3J5X3J1bGVzGAcgAygLMh4uc2Vzc2lvbi52MS5EaXJlY3RvcnlSdWxlUHJvdG8SGAoQb25lX29mZl9iYXNlX2RpchgIIAEoCRIcChRuZXdfcHJvamVjdF9iYXNlX2RpchgJIAEoCRouCgxFbnZWYXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARpRCg1Qcm9maWxlc0VudHJ5EgsKA2tleRgBIAEoCRIvCgV2YWx1ZRgCIAEoCzIgLnNlc3Npb24udjEuUHJvZmlsZURlZmF1bHRzUHJvdG86AjgBIhsKGUdldFNlc3Npb25EZWZhdWx0c1JlcXVlc3QiUQoaR2V0U2Vzc2lvbkRlZmF1bHRzUmVzcG9uc2USMwoIZGVmYXVsdHMYASABKAsyIS5zZXNzaW9uLnYxLlNlc3Npb25EZWZhdWx0c0NvbmZpZyJDChZSZXNvbHZlRGVmYXVsdHNSZXF1ZXN0EhMKC3dvcmtpbmdfZGlyGAEgASgJEhQKDHByb2ZpbGVfbmFtZRgCIAEoCSKvAgoXUmVzb2x2ZURlZmF1bHRzUmVzcG9uc2USDwoHcHJvZ3JhbRgBIAEoCRIQCghhdXRvX3llcxgCIAEoCBIMCgR0YWdzGAMgAygJEkIKCGVudl92YXJzGAQgAygLMjAuc2Vzc2lvbi52MS5SZXNvbHZlRGVmYXVsdHNSZXNwb25zZS5FbnZWYXJzRW50cnkSEQoJY2xpX2ZsYWdzGAUgASgJEhMKC3VzZWRfZ2xvYmFsGAYgASgIEhYKDnVzZWRfZGlyZWN0b3J5GAcgASgIEhQKDHVzZWRfcHJvZmlsZRgIIAEoCBIZChFtYXRjaGVkX2RpcmVjdG9yeRgJIAEoCRouCgxFbnZWYXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKRAgobVXBkYXRlR2xvYmFsRGVmYXVsdHNSZXF1ZXN0Eg8KB3Byb2dyYW0YASABKAkSEAoIYXV0b195ZXMYAiABKAgSDAoEdGFncxgDIAMoCRJGCghlbnZfdmFycxgEIAMoCzI0LnNlc3Npb24udjEuVXBkYXRlR2xvYmFsRGVmYXVsdHNSZXF1ZXN0LkVudlZhcnNFbnRyeRIRCgljbGlfZmxhZ3MYBSABKAkSGAoQb25lX29mZl9iYXNlX2RpchgGIAEoCRIcChRuZXdfcHJvamVjdF9iYXNlX2RpchgHIAEoCRouCgxFbnZWYXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJTChxVcGRhdGVHbG9iYWxEZWZhdWx0c1Jlc3BvbnNlEjMKCGRlZmF1bHRzGAEgASgLMiEuc2Vzc2lvbi52MS5TZXNzaW9uRGVmYXVsdHNDb25maWciSQoUVXBzZXJ0UHJvZmlsZVJlcXVlc3QSMQoHcHJvZmlsZRgBIAEoCzIgLnNlc3Npb24udjEuUHJvZmlsZURlZmF1bHRzUHJvdG8iSgoVVXBzZXJ0UHJvZmlsZVJlc3BvbnNlEjEKB3Byb2ZpbGUYASABKAsyIC5zZXNzaW9uLnYxLlByb2ZpbGVEZWZhdWx0c1Byb3RvIiQKFERlbGV0ZVByb2ZpbGVSZXF1ZXN0EgwKBG5hbWUYASABKAkiFwoVRGVsZXRlUHJvZmlsZVJlc3BvbnNlIkoKGlVwc2VydERpcmVjdG9yeVJ1bGVSZXF1ZXN0EiwKBHJ1bGUYASABKAsyHi5zZXNzaW9uLnYxLkRpcmVjdG9yeVJ1bGVQcm90byJLChtVcHNlcnREaXJlY3RvcnlSdWxlUmVzcG9uc2USLAoEcnVsZRgBIAEoCzIeLnNlc3Npb24udjEuRGlyZWN0b3J5UnVsZVByb3RvIioKGkRlbGV0ZURpcmVjdG9yeVJ1bGVSZXF1ZXN0EgwKBHBhdGgYASABKAkiHQobRGVsZXRlRGlyZWN0b3J5UnVsZVJlc3BvbnNlIikKFExpc3RXb3JrdHJlZXNSZXF1ZXN0EhEKCXJlcG9fcGF0aBgBIAEoCSI+Cg1Xb3JrdHJlZUVudHJ5EgwKBHBhdGgYASABKAkSDgoGYnJhbmNoGAIgASgJEg8KB2lzX21haW4YAyABKAgiRQoVTGlzdFdvcmt0cmVlc1Jlc3BvbnNlEiwKCXdvcmt0cmVlcxgBIAMoCzIZLnNlc3Npb24udjEuV29ya3RyZWVFbnRyeSKwAQoSUHJvbXB0SGlzdG9yeUVudHJ5EgoKAmlkGAEgASgJEgwKBHRleHQYAiABKAkSDQoFbGFiZWwYAyABKAkSEgoKdXNlZF9jb3VudBgEIAEoBRItCglsYXN0X3VzZWQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIikKGExpc3RQcm9tcHRIaXN0b3J5UmVxdWVzdBINCgVsaW1pdBgBIAEoBSJMChlMaXN0UHJvbXB0SGlzdG9yeVJlc3BvbnNlEi8KB2VudHJpZXMYASADKAsyHi5zZXNzaW9uLnYxLlByb21wdEhpc3RvcnlFbnRyeSIoChpEZWxldGVQcm9tcHRIaXN0b3J5UmVxdWVzdBIKCgJpZBgBIAEoCSIdChtEZWxldGVQcm9tcHRIaXN0b3J5UmVzcG9uc2Ui9QEKE0JhdGNoU2Vzc2lvblJlcXVlc3QSDQoFdGl0bGUYASABKAkSDAoEcGF0aBgCIAEoCRITCgt3b3JraW5nX2RpchgDIAEoCRIOCgZicmFuY2gYBCABKAkSDwoHcHJvZ3JhbRgFIAEoCRIQCghjYXRlZ29yeRgGIAEoCRIWCg5pbml0aWFsX3Byb21wdBgHIAEoCRIQCghhdXRvX3llcxgIIAEoCBItCgxzZXNzaW9uX3R5cGUYCSABKA4yFy5zZXNzaW9uLnYxLlNlc3Npb25UeXBlEhIKCnByb2plY3RfaWQYCiABKAkSDAoEdGFncxgLIAMoCSJWChFCYXRjaENyZWF0ZVJlc3VsdBIPCgdzdWNjZXNzGAEgASgIEhIKCnNlc3Npb25faWQYAiABKAkSDQoFZXJyb3IYAyABKAkSDQoFdGl0bGUYBCABKAkiaAoaQmF0Y2hDcmVhdGVTZXNzaW9uc1JlcXVlc3QSMQoIc2Vzc2lvbnMYASADKAsyHy5zZXNzaW9uLnYxLkJhdGNoU2Vzc2lvblJlcXVlc3QSFwoPbWF4X2NvbmN1cnJlbmN5GAIgASgFInAKG0JhdGNoQ3JlYXRlU2Vzc2lvbnNSZXNwb25zZRIuCgdyZXN1bHRzGAEgAygLMh0uc2Vzc2lvbi52MS5CYXRjaENyZWF0ZVJlc3VsdBIRCglzdWNjZWVkZWQYAiABKAUSDgoGZmFpbGVkGAMgASgFIlAKEVJ1bk9uZVNob3RSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSDgoGcHJvbXB0GAIgASgJEhcKD3RpbWVvdXRfc2Vjb25kcxgDIAEoBSJ5ChJSdW5PbmVTaG90UmVzcG9uc2USDgoGb3V0cHV0GAEgASgJEg0KBWVycm9yGAIgASgJEhEKCWV4aXRfY29kZRgDIAEoBRIOCgZwcl91cmwYBCABKAkSIQoZYnJhbmNoX2RpdmVyZ2VkX2Zyb21fYmFzZRgFIAEoCCL6AQoHUHJvamVjdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDXNlc3Npb25fY291bnQYBiABKAUSFQoNcnVubmluZ19jb3VudBgHIAEoBRIWCg5jb21wbGV0ZV9jb3VudBgIIAEoBRIaChJyZXZpZXdfcmVhZHlfY291bnQYCSABKAUiOQoUQ3JlYXRlUHJvamVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCSI9ChVDcmVhdGVQcm9qZWN0UmVzcG9uc2USJAoHcHJvamVjdBgBIAEoCzITLnNlc3Npb24udjEuUHJvamVjdCIVChNMaXN0UHJvamVjdHNSZXF1ZXN0Ij0KFExpc3RQcm9qZWN0c1Jlc3BvbnNlEiUKCHByb2plY3RzGAEgAygLMhMuc2Vzc2lvbi52MS5Qcm9qZWN0IkUKFFVwZGF0ZVByb2plY3RSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkiPQoVVXBkYXRlUHJvamVjdFJlc3BvbnNlEiQKB3Byb2plY3QYASABKAsyEy5zZXNzaW9uLnYxLlByb2plY3QiIgoURGVsZXRlUHJvamVjdFJlcXVlc3QSCgoCaWQYASABKAkiKAoVRGVsZXRlUHJvamVjdFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiSQoeQXNzaWduU2Vzc2lvbnNUb1Byb2plY3RSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEwoLc2Vzc2lvbl9pZHMYAiADKAkiOAofQXNzaWduU2Vzc2lvbnNUb1Byb2plY3RSZXNwb25zZRIVCg11cGRhdGVkX2NvdW50GAEgASgFImUKE0xpc3RCcmFuY2hlc1JlcXVlc3QSEQoJcmVwb19wYXRoGAEgASgJEg4KBmZpbHRlchgCIAEoCRITCgttYXhfcmVzdWx0cxgDIAEoBRIWCg5pbmNsdWRlX3JlbW90ZRgEIAEoCCJQChRMaXN0QnJhbmNoZXNSZXNwb25zZRIQCghicmFuY2hlcxgBIAMoCRITCgt0b3RhbF9jb3VudBgCIAEoBRIRCgl0cnVuY2F0ZWQYAyABKAgiRgoaR2V0VGVybWluYWxTbmFwc2hvdFJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRIUCgxsYXN0X25fbGluZXMYAiABKAUiQAobR2V0VGVybWluYWxTbmFwc2hvdFJlc3BvbnNlEg8KB2NvbnRlbnQYASABKAkSEAoIaXNfZW1wdHkYAiABKAgieAoOQ2xpZW50TG9nRW50cnkSDQoFbGV2ZWwYASABKAkSDwoHbWVzc2FnZRgCIAEoCRIRCgl0aW1lc3RhbXAYAyABKAkSCwoDdXJsGAQgASgJEhIKCnVzZXJfYWdlbnQYBSABKAkSEgoKc2Vzc2lvbl9pZBgGIAEoCSJFChZMb2dDbGllbnRFdmVudHNSZXF1ZXN0EisKB2VudHJpZXMYASADKAsyGi5zZXNzaW9uLnYxLkNsaWVudExvZ0VudHJ5IhkKF0xvZ0NsaWVudEV2ZW50c1Jlc3BvbnNlIjEKEUxpc3RFcnJvcnNSZXF1ZXN0EhwKFGluY2x1ZGVfYWNrbm93bGVkZ2VkGAEgASgIIocCChBFcnJvckV2ZW50UmVjb3JkEhMKC2ZpbmdlcnByaW50GAEgASgJEhIKCmVycm9yX3R5cGUYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRITCgtzdGFja190cmFjZRgEIAEoCRIVCg1ycGNfcHJvY2VkdXJlGAUgASgJEhgKEG9jY3VycmVuY2VfY291bnQYBiABKAUSLgoKZmlyc3Rfc2VlbhgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoJbGFzdF9zZWVuGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhY2tub3dsZWRnZWQYCSABKAgiQgoSTGlzdEVycm9yc1Jlc3BvbnNlEiwKBmVycm9ycxgBIAMoCzIcLnNlc3Npb24udjEuRXJyb3JFdmVudFJlY29yZCIuChdBY2tub3dsZWRnZUVycm9yUmVxdWVzdBITCgtmaW5nZXJwcmludBgBIAEoCSIaChhBY2tub3dsZWRnZUVycm9yUmVzcG9uc2UiKwodQ2xlYXJDb252ZXJzYXRpb25TdGF0ZVJlcXVlc3QSCgoCaWQYASABKAkiQgoeQ2xlYXJDb252ZXJzYXRpb25TdGF0ZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJBCgtGZWF0dXJlRmxhZxIMCgRuYW1lGAEgASgJEg8KB2VuYWJsZWQYAiABKAgSEwoLZGVzY3JpcHRpb24YAyABKAkiGAoWR2V0RmVhdHVyZUZsYWdzUmVxdWVzdCJBChdHZXRGZWF0dXJlRmxhZ3NSZXNwb25zZRImCgVmbGFncxgBIAMoCzIXLnNlc3Npb24udjEuRmVhdHVyZUZsYWciOQoYVXBkYXRlRmVhdHVyZUZsYWdSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHZW5hYmxlZBgCIAEoCCJCChlVcGRhdGVGZWF0dXJlRmxhZ1Jlc3BvbnNlEiUKBGZsYWcYASABKAsyFy5zZXNzaW9uLnYxLkZlYXR1cmVGbGFnIpoCChBFc2NhcGVFdmVudFByb3RvEgoKAmlkGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkSDQoFc3RhZ2UYAyABKAkSFQoNc2VxdWVuY2VfdHlwZRgEIAEoCRIYChBzZXF1ZW5jZV9zdWJ0eXBlGAUgASgJEhMKC2J5dGVfbGVuZ3RoGAYgASgFEhQKDHBheWxvYWRfaGFzaBgHIAEoCRIRCglyYXdfYnl0ZXMYCCABKAwSDwoHbWFuZ2xlZBgJIAEoCBITCgttYW5nbGVfdHlwZRgKIAEoCRItCgl3YWxsX3RpbWUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhMKC3Nlc3Npb25fc2VxGAwgASgDIvIBChtRdWVyeUVzY2FwZUFuYWx5dGljc1JlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRINCgVzdGFnZRgCIAEoCRIVCg1zZXF1ZW5jZV90eXBlGAMgASgJEhQKDG1hbmdsZWRfb25seRgEIAEoCBIuCgpzdGFydF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJcGFnZV9zaXplGAcgASgFEhIKCnBhZ2VfdG9rZW4YCCABKAkiegocUXVlcnlFc2NhcGVBbmFseXRpY3NSZXNwb25zZRIsCgZldmVudHMYASADKAsyHC5zZXNzaW9uLnYxLkVzY2FwZUV2ZW50UHJvdG8SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFIlIKE0VzY2FwZVNlcXVlbmNlQ291bnQSFQoNc2VxdWVuY2VfdHlwZRgBIAEoCRINCgVjb3VudBgCIAEoAxIVCg1tYW5nbGVkX2NvdW50GAMgASgDIpQBCiBHZXRFc2NhcGVBbmFseXRpY3NTdW1tYXJ5UmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEi4KCnN0YXJ0X3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKcAQohR2V0RXNjYXBlQW5hbHl0aWNzU3VtbWFyeVJlc3BvbnNlEjIKCWhpc3RvZ3JhbRgBIAMoCzIfLnNlc3Npb24udjEuRXNjYXBlU2VxdWVuY2VDb3VudBIXCg90b3RhbF9zZXF1ZW5jZXMYAiABKAMSFQoNdG90YWxfbWFuZ2xlZBgDIAEoAxITCgttYW5nbGVfcmF0ZRgEIAEoATK2PAoOU2Vzc2lvblNlcnZpY2USUwoMTGlzdFNlc3Npb25zEh8uc2Vzc2lvbi52MS5MaXN0U2Vzc2lvbnNSZXF1ZXN0GiAuc2Vzc2lvbi52MS5MaXN0U2Vzc2lvbnNSZXNwb25zZSIAEk0KCkdldFNlc3Npb24SHS5zZXNzaW9uLnYxLkdldFNlc3Npb25SZXF1ZXN0Gh4uc2Vzc2lvbi52MS5HZXRTZXNzaW9uUmVzcG9uc2UiABJWCg1DcmVhdGVTZXNzaW9uEiAuc2Vzc2lvbi52MS5DcmVhdGVTZXNzaW9uUmVxdWVzdBohLnNlc3Npb24udjEuQ3JlYXRlU2Vzc2lvblJlc3BvbnNlIgASVgoNVXBkYXRlU2Vzc2lvbhIgLnNlc3Npb24udjEuVXBkYXRlU2Vzc2lvblJlcXVlc3QaIS5zZXNzaW9uLnYxLlVwZGF0ZVNlc3Npb25SZXNwb25zZSIAElYKDURlbGV0ZVNlc3Npb24SIC5zZXNzaW9uLnYxLkRlbGV0ZVNlc3Npb25SZXF1ZXN0GiEuc2Vzc2lvbi52MS5EZWxldGVTZXNzaW9uUmVzcG9uc2UiABJPCg1XYXRjaFNlc3Npb25zEiAuc2Vzc2lvbi52MS5XYXRjaFNlc3Npb25zUmVxdWVzdBoYLnNlc3Npb24udjEuU2Vzc2lvbkV2ZW50IgAwARJKCg5TdHJlYW1UZXJtaW5hbBIYLnNlc3Npb24udjEuVGVybWluYWxEYXRhGhguc2Vzc2lvbi52MS5UZXJtaW5hbERhdGEiACgBMAESWQoOR2V0U2Vzc2lvbkRpZmYSIS5zZXNzaW9uLnYxLkdldFNlc3Npb25EaWZmUmVxdWVzdBoiLnNlc3Npb24udjEuR2V0U2Vzc2lvbkRpZmZSZXNwb25zZSIAElMKDEdldFZDU1N0YXR1cxIfLnNlc3Npb24udjEuR2V0VkNTU3RhdHVzUmVxdWVzdBogLnNlc3Npb24udjEuR2V0VkNTU3RhdHVzUmVzcG9uc2UiABJZCg5HZXRSZXZpZXdRdWV1ZRIhLnNlc3Npb24udjEuR2V0UmV2aWV3UXVldWVSZXF1ZXN0GiIuc2Vzc2lvbi52MS5HZXRSZXZpZXdRdWV1ZVJlc3BvbnNlIgASZQoSQWNrbm93bGVkZ2VTZXNzaW9uEiUuc2Vzc2lvbi52MS5BY2tub3dsZWRnZVNlc3Npb25SZXF1ZXN0GiYuc2Vzc2lvbi52MS5BY2tub3dsZWRnZVNlc3Npb25SZXNwb25zZSIAEkQKB0dldExvZ3MSGi5zZXNzaW9uLnYxLkdldExvZ3NSZXF1ZXN0Ghsuc2Vzc2lvbi52MS5HZXRMb2dzUmVzcG9uc2UiABJZChBXYXRjaFJldmlld1F1ZXVlEiMuc2Vzc2lvbi52MS5XYXRjaFJldmlld1F1ZXVlUmVxdWVzdBocLnNlc3Npb24udjEuUmV2aWV3UXVldWVFdmVudCIAMAESZQoSTG9nVXNlckludGVyYWN0aW9uEiUuc2Vzc2lvbi52MS5Mb2dVc2VySW50ZXJhY3Rpb25SZXF1ZXN0GiYuc2Vzc2lvbi52MS5Mb2dVc2VySW50ZXJhY3Rpb25SZXNwb25zZSIAElwKD0dldENsYXVkZUNvbmZpZxIiLnNlc3Npb24udjEuR2V0Q2xhdWRlQ29uZmlnUmVxdWVzdBojLnNlc3Npb24udjEuR2V0Q2xhdWRlQ29uZmlnUmVzcG9uc2UiABJiChFMaXN0Q2xhdWRlQ29uZmlncxIkLnNlc3Npb24udjEuTGlzdENsYXVkZUNvbmZpZ3NSZXF1ZXN0GiUuc2Vzc2lvbi52MS5MaXN0Q2xhdWRlQ29uZmlnc1Jlc3BvbnNlIgASZQoSVXBkYXRlQ2xhdWRlQ29uZmlnEiUuc2Vzc2lvbi52MS5VcGRhdGVDbGF1ZGVDb25maWdSZXF1ZXN0GiYuc2Vzc2lvbi52MS5VcGRhdGVDbGF1ZGVDb25maWdSZXNwb25zZSIAEmIKEUxpc3RDbGF1ZGVIaXN0b3J5EiQuc2Vzc2lvbi52MS5MaXN0Q2xhdWRlSGlzdG9yeVJlcXVlc3QaJS5zZXNzaW9uLnYxLkxpc3RDbGF1ZGVIaXN0b3J5UmVzcG9uc2UiABJxChZHZXRDbGF1ZGVIaXN0b3J5RGV0YWlsEikuc2Vzc2lvbi52MS5HZXRDbGF1ZGVIaXN0b3J5RGV0YWlsUmVxdWVzdBoqLnNlc3Npb24udjEuR2V0Q2xhdWRlSGlzdG9yeURldGFpbFJlc3BvbnNlIgASdwoYR2V0Q2xhdWRlSGlzdG9yeU1lc3NhZ2VzEisuc2Vzc2lvbi52MS5HZXRDbGF1ZGVIaXN0b3J5TWVzc2FnZXNSZXF1ZXN0Giwuc2Vzc2lvbi52MS5HZXRDbGF1ZGVIaXN0b3J5TWVzc2FnZXNSZXNwb25zZSIAEmgKE1NlYXJjaENsYXVkZUhpc3RvcnkSJi5zZXNzaW9uLnYxLlNlYXJjaENsYXVkZUhpc3RvcnlSZXF1ZXN0Gicuc2Vzc2lvbi52MS5TZWFyY2hDbGF1ZGVIaXN0b3J5UmVzcG9uc2UiABJKCglHZXRQUkluZm8SHC5zZXNzaW9uLnYxLkdldFBSSW5mb1JlcXVlc3QaHS5zZXNzaW9uLnYxLkdldFBSSW5mb1Jlc3BvbnNlIgASVgoNR2V0UFJDb21tZW50cxIgLnNlc3Npb24udjEuR2V0UFJDb21tZW50c1JlcXVlc3QaIS5zZXNzaW9uLnYxLkdldFBSQ29tbWVudHNSZXNwb25zZSIAElYKDVBvc3RQUkNvbW1lbnQSIC5zZXNzaW9uLnYxLlBvc3RQUkNvbW1lbnRSZXF1ZXN0GiEuc2Vzc2lvbi52MS5Qb3N0UFJDb21tZW50UmVzcG9uc2UiABJECgdNZXJnZVBSEhouc2Vzc2lvbi52MS5NZXJnZVBSUmVxdWVzdBobLnNlc3Npb24udjEuTWVyZ2VQUlJlc3BvbnNlIgASRAoHQ2xvc2VQUhIaLnNlc3Npb24udjEuQ2xvc2VQUlJlcXVlc3QaGy5zZXNzaW9uLnYxLkNsb3NlUFJSZXNwb25zZSIAEl8KEFNlbmROb3RpZmljYXRpb24SIy5zZXNzaW9uLnYxLlNlbmROb3RpZmljYXRpb25SZXF1ZXN0GiQuc2Vzc2lvbi52MS5TZW5kTm90aWZpY2F0aW9uUmVzcG9uc2UiABJQCgtGb2N1c1dpbmRvdxIeLnNlc3Npb24udjEuRm9jdXNXaW5kb3dSZXF1ZXN0Gh8uc2Vzc2lvbi52MS5Gb2N1c1dpbmRvd1Jlc3BvbnNlIgASVgoNUmVuYW1lU2Vzc2lvbhIgLnNlc3Npb24udjEuUmVuYW1lU2Vzc2lvblJlcXVlc3QaIS5zZXNzaW9uLnYxLlJlbmFtZVNlc3Npb25SZXNwb25zZSIAElkKDlJlc3RhcnRTZXNzaW9uEiEuc2Vzc2lvbi52MS5SZXN0YXJ0U2Vzc2lvblJlcXVlc3QaIi5zZXNzaW9uLnYxLlJlc3RhcnRTZXNzaW9uUmVzcG9uc2UiABJfChBHZXRXb3Jrc3BhY2VJbmZvEiMuc2Vzc2lvbi52MS5HZXRXb3Jrc3BhY2VJbmZvUmVxdWVzdBokLnNlc3Npb24udjEuR2V0V29ya3NwYWNlSW5mb1Jlc3BvbnNlIgASawoUTGlzdFdvcmtzcGFjZVRhcmdldHMSJy5zZXNzaW9uLnYxLkxpc3RXb3Jrc3BhY2VUYXJnZXRzUmVxdWVzdBooLnNlc3Npb24udjEuTGlzdFdvcmtzcGFjZVRhcmdldHNSZXNwb25zZSIAElwKD1N3aXRjaFdvcmtzcGFjZRIiLnNlc3Npb24udjEuU3dpdGNoV29ya3NwYWNlUmVxdWVzdBojLnNlc3Npb24udjEuU3dpdGNoV29ya3NwYWNlUmVzcG9uc2UiABJcCg9SZXNvbHZlQXBwcm92YWwSIi5zZXNzaW9uLnYxLlJlc29sdmVBcHByb3ZhbFJlcXVlc3QaIy5zZXNzaW9uLnYxLlJlc29sdmVBcHByb3ZhbFJlc3BvbnNlIgASawoUTGlzdFBlbmRpbmdBcHByb3ZhbHMSJy5zZXNzaW9uLnYxLkxpc3RQZW5kaW5nQXBwcm92YWxzUmVxdWVzdBooLnNlc3Npb24udjEuTGlzdFBlbmRpbmdBcHByb3ZhbHNSZXNwb25zZSIAEmgKE0NyZWF0ZURlYnVnU25hcHNob3QSJi5zZXNzaW9uLnYxLkNyZWF0ZURlYnVnU25hcHNob3RSZXF1ZXN0Gicuc2Vzc2lvbi52MS5DcmVhdGVEZWJ1Z1NuYXBzaG90UmVzcG9uc2UiABJxChZHZXROb3RpZmljYXRpb25IaXN0b3J5Eikuc2Vzc2lvbi52MS5HZXROb3RpZmljYXRpb25IaXN0b3J5UmVxdWVzdBoqLnNlc3Npb24udjEuR2V0Tm90aWZpY2F0aW9uSGlzdG9yeVJlc3BvbnNlIgASawoUTWFya05vdGlmaWNhdGlvblJlYWQSJy5zZXNzaW9uLnYxLk1hcmtOb3RpZmljYXRpb25SZWFkUmVxdWVzdBooLnNlc3Npb24udjEuTWFya05vdGlmaWNhdGlvblJlYWRSZXNwb25zZSIAEncKGENsZWFyTm90aWZpY2F0aW9uSGlzdG9yeRIrLnNlc3Npb24udjEuQ2xlYXJOb3RpZmljYXRpb25IaXN0b3J5UmVxdWVzdBosLnNlc3Npb24udjEuQ2xlYXJOb3RpZmljYXRpb25IaXN0b3J5UmVzcG9uc2UiABJiChFMaXN0QXBwcm92YWxSdWxlcxIkLnNlc3Npb24udjEuTGlzdEFwcHJvdmFsUnVsZXNSZXF1ZXN0GiUuc2Vzc2lvbi52MS5MaXN0QXBwcm92YWxSdWxlc1Jlc3BvbnNlIgASZQoSVXBzZXJ0QXBwcm92YWxSdWxlEiUuc2Vzc2lvbi52MS5VcHNlcnRBcHByb3ZhbFJ1bGVSZXF1ZXN0GiYuc2Vzc2lvbi52MS5VcHNlcnRBcHByb3ZhbFJ1bGVSZXNwb25zZSIAEmUKEkRlbGV0ZUFwcHJvdmFsUnVsZRIlLnNlc3Npb24udjEuRGVsZXRlQXBwcm92YWxSdWxlUmVxdWVzdBomLnNlc3Npb24udjEuRGVsZXRlQXBwcm92YWxSdWxlUmVzcG9uc2UiABJrChRHZXRBcHByb3ZhbEFuYWx5dGljcxInLnNlc3Npb24udjEuR2V0QXBwcm92YWxBbmFseXRpY3NSZXF1ZXN0Giguc2Vzc2lvbi52MS5HZXRBcHByb3ZhbEFuYWx5dGljc1Jlc3BvbnNlIgASVgoNTGlzdERhdGFiYXNlcxIgLnNlc3Npb24udjEuTGlzdERhdGFiYXNlc1JlcXVlc3QaIS5zZXNzaW9uLnYxLkxpc3REYXRhYmFzZXNSZXNwb25zZSIAEmUKEkdldEN1cnJlbnREYXRhYmFzZRIlLnNlc3Npb24udjEuR2V0Q3VycmVudERhdGFiYXNlUmVxdWVzdBomLnNlc3Npb24udjEuR2V0Q3VycmVudERhdGFiYXNlUmVzcG9uc2UiABJZCg5Td2l0Y2hEYXRhYmFzZRIhLnNlc3Npb24udjEuU3dpdGNoRGF0YWJhc2VSZXF1ZXN0GiIuc2Vzc2lvbi52MS5Td2l0Y2hEYXRhYmFzZVJlc3BvbnNlIgASVgoNTWVyZ2VEYXRhYmFzZRIgLnNlc3Npb24udjEuTWVyZ2VEYXRhYmFzZVJlcXVlc3QaIS5zZXNzaW9uLnYxLk1lcmdlRGF0YWJhc2VSZXNwb25zZSIAEl8KEENyZWF0ZUNoZWNrcG9pbnQSIy5zZXNzaW9uLnYxLkNyZWF0ZUNoZWNrcG9pbnRSZXF1ZXN0GiQuc2Vzc2lvbi52MS5DcmVhdGVDaGVja3BvaW50UmVzcG9uc2UiABJcCg9MaXN0Q2hlY2twb2ludHMSIi5zZXNzaW9uLnYxLkxpc3RDaGVja3BvaW50c1JlcXVlc3QaIy5zZXNzaW9uLnYxLkxpc3RDaGVja3BvaW50c1Jlc3BvbnNlIgASUAoLRm9ya1Nlc3Npb24SHi5zZXNzaW9uLnYxLkZvcmtTZXNzaW9uUmVxdWVzdBofLnNlc3Npb24udjEuRm9ya1Nlc3Npb25SZXNwb25zZSIAEnEKFkNsZWFyQ29udmVyc2F0aW9uU3RhdGUSKS5zZXNzaW9uLnYxLkNsZWFyQ29udmVyc2F0aW9uU3RhdGVSZXF1ZXN0Giouc2Vzc2lvbi52MS5DbGVhckNvbnZlcnNhdGlvblN0YXRlUmVzcG9uc2UiABJKCglMaXN0RmlsZXMSHC5zZXNzaW9uLnYxLkxpc3RGaWxlc1JlcXVlc3QaHS5zZXNzaW9uLnYxLkxpc3RGaWxlc1Jlc3BvbnNlIgASWQoOR2V0RmlsZUNvbnRlbnQSIS5zZXNzaW9uLnYxLkdldEZpbGVDb250ZW50UmVxdWVzdBoiLnNlc3Npb24udjEuR2V0RmlsZUNvbnRlbnRSZXNwb25zZSIAElAKC1NlYXJjaEZpbGVzEh4uc2Vzc2lvbi52MS5TZWFyY2hGaWxlc1JlcXVlc3QaHy5zZXNzaW9uLnYxLlNlYXJjaEZpbGVzUmVzcG9uc2UiABJoChNMaXN0UGF0aENvbXBsZXRpb25zEiYuc2Vzc2lvbi52MS5MaXN0UGF0aENvbXBsZXRpb25zUmVxdWVzdBonLnNlc3Npb24udjEuTGlzdFBhdGhDb21wbGV0aW9uc1Jlc3BvbnNlIgASZQoSR2V0U2Vzc2lvbkRlZmF1bHRzEiUuc2Vzc2lvbi52MS5HZXRTZXNzaW9uRGVmYXVsdHNSZXF1ZXN0GiYuc2Vzc2lvbi52MS5HZXRTZXNzaW9uRGVmYXVsdHNSZXNwb25zZSIAElwKD1Jlc29sdmVEZWZhdWx0cxIiLnNlc3Npb24udjEuUmVzb2x2ZURlZmF1bHRzUmVxdWVzdBojLnNlc3Npb24udjEuUmVzb2x2ZURlZmF1bHRzUmVzcG9uc2UiABJrChRVcGRhdGVHbG9iYWxEZWZhdWx0cxInLnNlc3Npb24udjEuVXBkYXRlR2xvYmFsRGVmYXVsdHNSZXF1ZXN0Giguc2Vzc2lvbi52MS5VcGRhdGVHbG9iYWxEZWZhdWx0c1Jlc3BvbnNlIgASVgoNVXBzZXJ0UHJvZmlsZRIgLnNlc3Npb24udjEuVXBzZXJ0UHJvZmlsZVJlcXVlc3QaIS5zZXNzaW9uLnYxLlVwc2VydFByb2ZpbGVSZXNwb25zZSIAElYKDURlbGV0ZVByb2ZpbGUSIC5zZXNzaW9uLnYxLkRlbGV0ZVByb2ZpbGVSZXF1ZXN0GiEuc2Vzc2lvbi52MS5EZWxldGVQcm9maWxlUmVzcG9uc2UiABJoChNVcHNlcnREaXJlY3RvcnlSdWxlEiYuc2Vzc2lvbi52MS5VcHNlcnREaXJlY3RvcnlSdWxlUmVxdWVzdBonLnNlc3Npb24udjEuVXBzZXJ0RGlyZWN0b3J5UnVsZVJlc3BvbnNlIgASaAoTRGVsZXRlRGlyZWN0b3J5UnVsZRImLnNlc3Npb24udjEuRGVsZXRlRGlyZWN0b3J5UnVsZVJlcXVlc3QaJy5zZXNzaW9uLnYxLkRlbGV0ZURpcmVjdG9yeVJ1bGVSZXNwb25zZSIAElYKDUxpc3RXb3JrdHJlZXMSIC5zZXNzaW9uLnYxLkxpc3RXb3JrdHJlZXNSZXF1ZXN0GiEuc2Vzc2lvbi52MS5MaXN0V29ya3RyZWVzUmVzcG9uc2UiABJiChFMaXN0UHJvbXB0SGlzdG9yeRIkLnNlc3Npb24udjEuTGlzdFByb21wdEhpc3RvcnlSZXF1ZXN0GiUuc2Vzc2lvbi52MS5MaXN0UHJvbXB0SGlzdG9yeVJlc3BvbnNlIgASaAoTRGVsZXRlUHJvbXB0SGlzdG9yeRImLnNlc3Npb24udjEuRGVsZXRlUHJvbXB0SGlzdG9yeVJlcXVlc3QaJy5zZXNzaW9uLnYxLkRlbGV0ZVByb21wdEhpc3RvcnlSZXNwb25zZSIAEmgKE0JhdGNoQ3JlYXRlU2Vzc2lvbnMSJi5zZXNzaW9uLnYxLkJhdGNoQ3JlYXRlU2Vzc2lvbnNSZXF1ZXN0Gicuc2Vzc2lvbi52MS5CYXRjaENyZWF0ZVNlc3Npb25zUmVzcG9uc2UiABJNCgpSdW5PbmVTaG90Eh0uc2Vzc2lvbi52MS5SdW5PbmVTaG90UmVxdWVzdBoeLnNlc3Npb24udjEuUnVuT25lU2hvdFJlc3BvbnNlIgASVgoNQ3JlYXRlUHJvamVjdBIgLnNlc3Npb24udjEuQ3JlYXRlUHJvamVjdFJlcXVlc3QaIS5zZXNzaW9uLnYxLkNyZWF0ZVByb2plY3RSZXNwb25zZSIAElMKDExpc3RQcm9qZWN0cxIfLnNlc3Npb24udjEuTGlzdFByb2plY3RzUmVxdWVzdBogLnNlc3Npb24udjEuTGlzdFByb2plY3RzUmVzcG9uc2UiABJWCg1VcGRhdGVQcm9qZWN0EiAuc2Vzc2lvbi52MS5VcGRhdGVQcm9qZWN0UmVxdWVzdBohLnNlc3Npb24udjEuVXBkYXRlUHJvamVjdFJlc3BvbnNlIgASVgoNRGVsZXRlUHJvamVjdBIgLnNlc3Npb24udjEuRGVsZXRlUHJvamVjdFJlcXVlc3QaIS5zZXNzaW9uLnYxLkRlbGV0ZVByb2plY3RSZXNwb25zZSIAEnQKF0Fzc2lnblNlc3Npb25zVG9Qcm9qZWN0Eiouc2Vzc2lvbi52MS5Bc3NpZ25TZXNzaW9uc1RvUHJvamVjdFJlcXVlc3QaKy5zZXNzaW9uLnYxLkFzc2lnblNlc3Npb25zVG9Qcm9qZWN0UmVzcG9uc2UiABJTCgxMaXN0QnJhbmNoZXMSHy5zZXNzaW9uLnYxLkxpc3RCcmFuY2hlc1JlcXVlc3QaIC5zZXNzaW9uLnYxLkxpc3RCcmFuY2hlc1Jlc3BvbnNlIgASaAoTR2V0VGVybWluYWxTbmFwc2hvdBImLnNlc3Npb24udjEuR2V0VGVybWluYWxTbmFwc2hvdFJlcXVlc3QaJy5zZXNzaW9uLnYxLkdldFRlcm1pbmFsU25hcHNob3RSZXNwb25zZSIAElwKD0xvZ0NsaWVudEV2ZW50cxIiLnNlc3Npb24udjEuTG9nQ2xpZW50RXZlbnRzUmVxdWVzdBojLnNlc3Npb24udjEuTG9nQ2xpZW50RXZlbnRzUmVzcG9uc2UiABJNCgpMaXN0RXJyb3JzEh0uc2Vzc2lvbi52MS5MaXN0RXJyb3JzUmVxdWVzdBoeLnNlc3Npb24udjEuTGlzdEVycm9yc1Jlc3BvbnNlIgASXwoQQWNrbm93bGVkZ2VFcnJvchIjLnNlc3Npb24udjEuQWNrbm93bGVkZ2VFcnJvclJlcXVlc3QaJC5zZXNzaW9uLnYxLkFja25vd2xlZGdlRXJyb3JSZXNwb25zZSIAElwKD0dldEZlYXR1cmVGbGFncxIiLnNlc3Npb24udjEuR2V0RmVhdHVyZUZsYWdzUmVxdWVzdBojLnNlc3Npb24udjEuR2V0RmVhdHVyZUZsYWdzUmVzcG9uc2UiABJiChFVcGRhdGVGZWF0dXJlRmxhZxIkLnNlc3Npb24udjEuVXBkYXRlRmVhdHVyZUZsYWdSZXF1ZXN0GiUuc2Vzc2lvbi52MS5VcGRhdGVGZWF0dXJlRmxhZ1Jlc3BvbnNlIgASawoUUXVlcnlFc2NhcGVBbmFseXRpY3MSJy5zZXNzaW9uLnYxLlF1ZXJ5RXNjYXBlQW5hbHl0aWNzUmVxdWVzdBooLnNlc3Npb24udjEuUXVlcnlFc2NhcGVBbmFseXRpY3NSZXNwb25zZSIAEnoKGUdldEVzY2FwZUFuYWx5dGljc1N1bW1hcnkSLC5zZXNzaW9uLnYxLkdldEVzY2FwZUFuYWx5dGljc1N1bW1hcnlSZXF1ZXN0Gi0uc2Vzc2lvbi52MS5HZXRFc2NhcGVBbmFseXRpY3NTdW1tYXJ5UmVzcG9uc2UiAEKsAQoOY29tLnNlc3Npb24udjFCDFNlc3Npb25Qcm90b1ABWkNnaXRodWIuY29tL3RzdGFwbGVyL3N0YXBsZXItc3F1YWQvZ2VuL3Byb3RvL2dvL3Nlc3Npb24vdjE7c2Vzc2lvbnYxogIDU1hYqgIKU2Vzc2lvbi5WMcoCClNlc3Npb25cVjHiAhZTZXNzaW9uXFYxXEdQQk1ldGFkYXRh6gILU2Vzc2lvbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_session_v1_types, file_session_v1_events]);
+  fileDesc("ChhzZXNzaW9uL3YxL3Nlc3Npb24ucHJvdG8SCnNlc3Npb24udjEi3QEKE0xpc3RTZXNzaW9uc1JlcXVlc3QSLgoGc3RhdHVzGAEgASgOMhkuc2Vzc2lvbi52MS5TZXNzaW9uU3RhdHVzSACIAQESFQoIY2F0ZWdvcnkYAiABKAlIAYgBARITCgtoaWRlX3BhdXNlZBgDIAEoCBIZCgxzZWFyY2hfcXVlcnkYBCABKAlIAogBARIXCgpwcm9qZWN0X2lkGAUgASgJSAOIAQFCCQoHX3N0YXR1c0ILCglfY2F0ZWdvcnlCDwoNX3NlYXJjaF9xdWVyeUINCgtfcHJvamVjdF9pZCI9ChRMaXN0U2Vzc2lvbnNSZXNwb25zZRIlCghzZXNzaW9ucxgBIAMoCzITLnNlc3Npb24udjEuU2Vzc2lvbiIfChFHZXRTZXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCSI6ChJHZXRTZXNzaW9uUmVzcG9uc2USJAoHc2Vzc2lvbhgBIAEoCzITLnNlc3Npb24udjEuU2Vzc2lvbiLIAwoUQ3JlYXRlU2Vzc2lvblJlcXVlc3QSDQoFdGl0bGUYASABKAkSDAoEcGF0aBgCIAEoCRITCgt3b3JraW5nX2RpchgDIAEoCRIOCgZicmFuY2gYBCABKAkSDwoHcHJvZ3JhbRgFIAEoCRIQCghjYXRlZ29yeRgGIAEoCRIOCgZwcm9tcHQYByABKAkSEAoIYXV0b195ZXMYCCABKAgSGQoRZXhpc3Rpbmdfd29ya3RyZWUYCSABKAkSEQoJcmVzdW1lX2lkGAogASgJEg8KB3Byb2ZpbGUYCyABKAkSFQoNc2tpcF9kZWZhdWx0cxgMIAEoCBItCgxzZXNzaW9uX3R5cGUYDSABKA4yFy5zZXNzaW9uLnYxLlNlc3Npb25UeXBlEg8KB29uZV9vZmYYDiABKAgSFgoOaW5pdGlhbF9wcm9tcHQYDyABKAkSEAoIb25lX3Nob3QYECABKAgSEgoKcHJvamVjdF9pZBgRIAEoCRIZChFjcmVhdGVfaWZfbWlzc2luZxgSIAEoCBIXCg9yZXN1bWVfcHJvdmlkZXIYEyABKAkSEwoLZm9ya19yZXN1bWUYFCABKAgSDAoEdGFncxgVIAMoCSI9ChVDcmVhdGVTZXNzaW9uUmVzcG9uc2USJAoHc2Vzc2lvbhgBIAEoCzITLnNlc3Npb24udjEuU2Vzc2lvbiKjAwoUVXBkYXRlU2Vzc2lvblJlcXVlc3QSCgoCaWQYASABKAkSLgoGc3RhdHVzGAIgASgOMhkuc2Vzc2lvbi52MS5TZXNzaW9uU3RhdHVzSACIAQESFQoIY2F0ZWdvcnkYAyABKAlIAYgBARISCgV0aXRsZRgEIAEoCUgCiAEBEhQKB3Byb2dyYW0YBSABKAlIA4gBARIMCgR0YWdzGAYgAygJEhgKC3dvcmtpbmdfZGlyGAcgASgJSASIAQESHwoScmF0ZV9saW1pdF9lbmFibGVkGAggASgISAWIAQESIAoTcHJfZmVlZGJhY2tfZW5hYmxlZBgJIAEoCEgGiAEBEh8KEmNpX2F1dG9maXhfZW5hYmxlZBgKIAEoCEgHiAEBQgkKB19zdGF0dXNCCwoJX2NhdGVnb3J5QggKBl90aXRsZUIKCghfcHJvZ3JhbUIOCgxfd29ya2luZ19kaXJCFQoTX3JhdGVfbGltaXRfZW5hYmxlZEIWChRfcHJfZmVlZGJhY2tfZW5hYmxlZEIVChNfY2lfYXV0b2ZpeF9lbmFibGVkIj0KFVVwZGF0ZVNlc3Npb25SZXNwb25zZRIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uIjEKFERlbGV0ZVNlc3Npb25SZXF1ZXN0EgoKAmlkGAEgASgJEg0KBWZvcmNlGAIgASgIIjkKFURlbGV0ZVNlc3Npb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkipAEKFFdhdGNoU2Vzc2lvbnNSZXF1ZXN0EhwKD2NhdGVnb3J5X2ZpbHRlchgBIAEoCUgAiAEBEjUKDXN0YXR1c19maWx0ZXIYAiABKA4yGS5zZXNzaW9uLnYxLlNlc3Npb25TdGF0dXNIAYgBARIRCglhZnRlcl9zZXEYAyABKARCEgoQX2NhdGVnb3J5X2ZpbHRlckIQCg5fc3RhdHVzX2ZpbHRlciIjChVHZXRTZXNzaW9uRGlmZlJlcXVlc3QSCgoCaWQYASABKAkiQwoWR2V0U2Vzc2lvbkRpZmZSZXNwb25zZRIpCgpkaWZmX3N0YXRzGAEgASgLMhUuc2Vzc2lvbi52MS5EaWZmU3RhdHMiIQoTR2V0VkNTU3RhdHVzUmVxdWVzdBIKCgJpZBgBIAEoCSJQChRHZXRWQ1NTdGF0dXNSZXNwb25zZRIpCgp2Y3Nfc3RhdHVzGAEgASgLMhUuc2Vzc2lvbi52MS5WQ1NTdGF0dXMSDQoFZXJyb3IYAiABKAkiqgEKFUdldFJldmlld1F1ZXVlUmVxdWVzdBIyCg9wcmlvcml0eV9maWx0ZXIYASABKA4yFC5zZXNzaW9uLnYxLlByaW9yaXR5SACIAQESNwoNcmVhc29uX2ZpbHRlchgCIAEoDjIbLnNlc3Npb24udjEuQXR0ZW50aW9uUmVhc29uSAGIAQFCEgoQX3ByaW9yaXR5X2ZpbHRlckIQCg5fcmVhc29uX2ZpbHRlciJHChZHZXRSZXZpZXdRdWV1ZVJlc3BvbnNlEi0KDHJldmlld19xdWV1ZRgBIAEoCzIXLnNlc3Npb24udjEuUmV2aWV3UXVldWUiJwoZQWNrbm93bGVkZ2VTZXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCSI+ChpBY2tub3dsZWRnZVNlc3Npb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAki1AIKDkdldExvZ3NSZXF1ZXN0EhkKDHNlYXJjaF9xdWVyeRgBIAEoCUgAiAEBEhIKBWxldmVsGAIgASgJSAGIAQESMwoKc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBARIxCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBARISCgVsaW1pdBgFIAEoBUgEiAEBEhMKBm9mZnNldBgGIAEoBUgFiAEBEhcKCnNlc3Npb25faWQYByABKAlIBogBARIOCgZsZXZlbHMYCCADKAlCDwoNX3NlYXJjaF9xdWVyeUIICgZfbGV2ZWxCDQoLX3N0YXJ0X3RpbWVCCwoJX2VuZF90aW1lQggKBl9saW1pdEIJCgdfb2Zmc2V0Qg0KC19zZXNzaW9uX2lkIl8KD0dldExvZ3NSZXNwb25zZRIlCgdlbnRyaWVzGAEgAygLMhQuc2Vzc2lvbi52MS5Mb2dFbnRyeRITCgt0b3RhbF9jb3VudBgCIAEoBRIQCghoYXNfbW9yZRgDIAEoCCJ5CghMb2dFbnRyeRItCgl0aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxldmVsGAIgASgJEg8KB21lc3NhZ2UYAyABKAkSEwoGc291cmNlGAQgASgJSACIAQFCCQoHX3NvdXJjZSLHAQoXV2F0Y2hSZXZpZXdRdWV1ZVJlcXVlc3QSLQoPcHJpb3JpdHlfZmlsdGVyGAEgAygOMhQuc2Vzc2lvbi52MS5Qcmlvcml0eRIyCg1yZWFzb25fZmlsdGVyGAIgAygOMhsuc2Vzc2lvbi52MS5BdHRlbnRpb25SZWFzb24SGgoSaW5jbHVkZV9zdGF0aXN0aWNzGAMgASgIEhgKEGluaXRpYWxfc25hcHNob3QYBCABKAgSEwoLc2Vzc2lvbl9pZHMYBSADKAki2wIKGUxvZ1VzZXJJbnRlcmFjdGlvblJlcXVlc3QSFwoKc2Vzc2lvbl9pZBgBIAEoCUgAiAEBEkoKEGludGVyYWN0aW9uX3R5cGUYAiABKA4yMC5zZXNzaW9uLnYxLlVzZXJJbnRlcmFjdGlvbkV2ZW50LkludGVyYWN0aW9uVHlwZRIUCgdjb250ZXh0GAMgASgJSAGIAQESHAoPbm90aWZpY2F0aW9uX2lkGAQgASgJSAKIAQESRQoIbWV0YWRhdGEYBSADKAsyMy5zZXNzaW9uLnYxLkxvZ1VzZXJJbnRlcmFjdGlvblJlcXVlc3QuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDQoLX3Nlc3Npb25faWRCCgoIX2NvbnRleHRCEgoQX25vdGlmaWNhdGlvbl9pZCJLChpMb2dVc2VySW50ZXJhY3Rpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhIKBWVycm9yGAIgASgJSACIAQFCCAoGX2Vycm9yIioKFkdldENsYXVkZUNvbmZpZ1JlcXVlc3QSEAoIZmlsZW5hbWUYASABKAkiRwoXR2V0Q2xhdWRlQ29uZmlnUmVzcG9uc2USLAoGY29uZmlnGAEgASgLMhwuc2Vzc2lvbi52MS5DbGF1ZGVDb25maWdGaWxlIhoKGExpc3RDbGF1ZGVDb25maWdzUmVxdWVzdCJKChlMaXN0Q2xhdWRlQ29uZmlnc1Jlc3BvbnNlEi0KB2NvbmZpZ3MYASADKAsyHC5zZXNzaW9uLnYxLkNsYXVkZUNvbmZpZ0ZpbGUiUAoZVXBkYXRlQ2xhdWRlQ29uZmlnUmVxdWVzdBIQCghmaWxlbmFtZRgBIAEoCRIPCgdjb250ZW50GAIgASgJEhAKCHZhbGlkYXRlGAMgASgIIkoKGlVwZGF0ZUNsYXVkZUNvbmZpZ1Jlc3BvbnNlEiwKBmNvbmZpZxgBIAEoCzIcLnNlc3Npb24udjEuQ2xhdWRlQ29uZmlnRmlsZSJtChBDbGF1ZGVDb25maWdGaWxlEgwKBG5hbWUYASABKAkSDAoEcGF0aBgCIAEoCRIPCgdjb250ZW50GAMgASgJEiwKCG1vZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLCAQoYTGlzdENsYXVkZUhpc3RvcnlSZXF1ZXN0EhQKB3Byb2plY3QYASABKAlIAIgBARIZCgxzZWFyY2hfcXVlcnkYAiABKAlIAYgBARINCgVsaW1pdBgDIAEoBRIRCglwYWdlX3NpemUYBCABKAUSEgoKcGFnZV90b2tlbhgFIAEoCRIVCghwcm92aWRlchgGIAEoCUgCiAEBQgoKCF9wcm9qZWN0Qg8KDV9zZWFyY2hfcXVlcnlCCwoJX3Byb3ZpZGVyInoKGUxpc3RDbGF1ZGVIaXN0b3J5UmVzcG9uc2USLwoHZW50cmllcxgBIAMoCzIeLnNlc3Npb24udjEuQ2xhdWRlSGlzdG9yeUVudHJ5EhMKC3RvdGFsX2NvdW50GAIgASgFEhcKD25leHRfcGFnZV90b2tlbhgDIAEoCSIrCh1HZXRDbGF1ZGVIaXN0b3J5RGV0YWlsUmVxdWVzdBIKCgJpZBgBIAEoCSJPCh5HZXRDbGF1ZGVIaXN0b3J5RGV0YWlsUmVzcG9uc2USLQoFZW50cnkYASABKAsyHi5zZXNzaW9uLnYxLkNsYXVkZUhpc3RvcnlFbnRyeSKCAgoSQ2xhdWRlSGlzdG9yeUVudHJ5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDwoHcHJvamVjdBgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVtb2RlbBgGIAEoCRIVCg1tZXNzYWdlX2NvdW50GAcgASgFEikKCnZjc19zdGF0dXMYCCABKAsyFS5zZXNzaW9uLnYxLlZDU1N0YXR1cxIQCghwcm92aWRlchgJIAEoCSJaCh9HZXRDbGF1ZGVIaXN0b3J5TWVzc2FnZXNSZXF1ZXN0EgoKAmlkGAEgASgJEg0KBWxpbWl0GAIgASgFEg4KBm9mZnNldBgDIAEoBRIMCgR0YWlsGAQgASgIImQKIEdldENsYXVkZUhpc3RvcnlNZXNzYWdlc1Jlc3BvbnNlEisKCG1lc3NhZ2VzGAEgAygLMhkuc2Vzc2lvbi52MS5DbGF1ZGVNZXNzYWdlEhMKC3RvdGFsX2NvdW50GAIgASgFImwKDUNsYXVkZU1lc3NhZ2USDAoEcm9sZRgBIAEoCRIPCgdjb250ZW50GAIgASgJEi0KCXRpbWVzdGFtcBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbW9kZWwYBCABKAkinwIKGlNlYXJjaENsYXVkZUhpc3RvcnlSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhQKB3Byb2plY3QYAiABKAlIAIgBARISCgVtb2RlbBgDIAEoCUgBiAEBEjMKCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQESMQoIZW5kX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAOIAQESDQoFbGltaXQYBiABKAUSDgoGb2Zmc2V0GAcgASgFEg8KB2V4cGxhaW4YCCABKAhCCgoIX3Byb2plY3RCCAoGX21vZGVsQg0KC19zdGFydF90aW1lQgsKCV9lbmRfdGltZSKvAQobU2VhcmNoQ2xhdWRlSGlzdG9yeVJlc3BvbnNlEikKB3Jlc3VsdHMYASADKAsyGC5zZXNzaW9uLnYxLlNlYXJjaFJlc3VsdBIVCg10b3RhbF9tYXRjaGVzGAIgASgFEhUKDXF1ZXJ5X3RpbWVfbXMYAyABKAMSEAoIaGFzX21vcmUYBCABKAgSFAoMcGFyc2VkX3F1ZXJ5GAUgASgJEg8KB3JlbGF4ZWQYBiABKAgigwIKDFNlYXJjaFJlc3VsdBISCgpzZXNzaW9uX2lkGAEgASgJEhQKDHNlc3Npb25fbmFtZRgCIAEoCRIPCgdwcm9qZWN0GAMgASgJEhUKDW1lc3NhZ2VfaW5kZXgYBCABKAUSDQoFc2NvcmUYBSABKAISKwoIc25pcHBldHMYBiADKAsyGS5zZXNzaW9uLnYxLlNlYXJjaFNuaXBwZXQSMgoIbWV0YWRhdGEYByABKAsyIC5zZXNzaW9uLnYxLlNlYXJjaFJlc3VsdE1ldGFkYXRhEjEKC2V4cGxhbmF0aW9uGAggASgLMhwuc2Vzc2lvbi52MS5TY29yZUV4cGxhbmF0aW9uIpsBCg1TZWFyY2hTbmlwcGV0EgwKBHRleHQYASABKAkSNAoQaGlnaGxpZ2h0X3JhbmdlcxgCIAMoCzIaLnNlc3Npb24udjEuSGlnaGxpZ2h0UmFuZ2USFAoMbWVzc2FnZV9yb2xlGAMgASgJEjAKDG1lc3NhZ2VfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiLAoOSGlnaGxpZ2h0UmFuZ2USDQoFc3RhcnQYASABKAUSCwoDZW5kGAIgASgFIpgBChRTZWFyY2hSZXN1bHRNZXRhZGF0YRIZChFpc19tZXRhZGF0YV9tYXRjaBgBIAEoCBIUCgxtYXRjaF9zb3VyY2UYAiABKAkSDQoFbW9kZWwYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIcHJvdmlkZXIYBSABKAkiHgoQR2V0UFJJbmZvUmVxdWVzdBIKCgJpZBgBIAEoCSI4ChFHZXRQUkluZm9SZXNwb25zZRIjCgdwcl9pbmZvGAEgASgLMhIuc2Vzc2lvbi52MS5QUkluZm8iIgoUR2V0UFJDb21tZW50c1JlcXVlc3QSCgoCaWQYASABKAkiQAoVR2V0UFJDb21tZW50c1Jlc3BvbnNlEicKCGNvbW1lbnRzGAEgAygLMhUuc2Vzc2lvbi52MS5QUkNvbW1lbnQiMAoUUG9zdFBSQ29tbWVudFJlcXVlc3QSCgoCaWQYASABKAkSDAoEYm9keRgCIAEoCSI5ChVQb3N0UFJDb21tZW50UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIjwKDk1lcmdlUFJSZXF1ZXN0EgoKAmlkGAEgASgJEhMKBm1ldGhvZBgCIAEoCUgAiAEBQgkKB19tZXRob2QiMwoPTWVyZ2VQUlJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSIcCg5DbG9zZVBSUmVxdWVzdBIKCgJpZBgBIAEoCSIzCg9DbG9zZVBSUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIrACChdTZW5kTm90aWZpY2F0aW9uUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEjcKEW5vdGlmaWNhdGlvbl90eXBlGAIgASgOMhwuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25UeXBlEjIKCHByaW9yaXR5GAMgASgOMiAuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgEIAEoCRIPCgdtZXNzYWdlGAUgASgJEkMKCG1ldGFkYXRhGAYgAygLMjEuc2Vzc2lvbi52MS5TZW5kTm90aWZpY2F0aW9uUmVxdWVzdC5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJVChhTZW5kTm90aWZpY2F0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhcKD25vdGlmaWNhdGlvbl9pZBgDIAEoCSKaAQoSRm9jdXNXaW5kb3dSZXF1ZXN0EhYKCWJ1bmRsZV9pZBgBIAEoCUgAiAEBEhUKCGFwcF9uYW1lGAIgASgJSAGIAQESEAoDcGlkGAMgASgFSAKIAQESFAoHcHJvamVjdBgEIAEoCUgDiAEBQgwKCl9idW5kbGVfaWRCCwoJX2FwcF9uYW1lQgYKBF9waWRCCgoIX3Byb2plY3QiSQoTRm9jdXNXaW5kb3dSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSEAoIcGxhdGZvcm0YAyABKAkiNQoUUmVuYW1lU2Vzc2lvblJlcXVlc3QSCgoCaWQYASABKAkSEQoJbmV3X3RpdGxlGAIgASgJIj0KFVJlbmFtZVNlc3Npb25SZXNwb25zZRIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uIjwKFVJlc3RhcnRTZXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCRIXCg9wcmVzZXJ2ZV9vdXRwdXQYAiABKAgiYAoWUmVzdGFydFNlc3Npb25SZXNwb25zZRIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uEg8KB3N1Y2Nlc3MYAiABKAgSDwoHbWVzc2FnZRgDIAEoCSIlChdHZXRXb3Jrc3BhY2VJbmZvUmVxdWVzdBIKCgJpZBgBIAEoCSJQChhHZXRXb3Jrc3BhY2VJbmZvUmVzcG9uc2USJQoIdmNzX2luZm8YASABKAsyEy5zZXNzaW9uLnYxLlZDU0luZm8SDQoFZXJyb3IYAiABKAkiKQobTGlzdFdvcmtzcGFjZVRhcmdldHNSZXF1ZXN0EgoKAmlkGAEgASgJImUKHExpc3RXb3Jrc3BhY2VUYXJnZXRzUmVzcG9uc2USNgoHdGFyZ2V0cxgBIAEoCzIlLnNlc3Npb24udjEuQXZhaWxhYmxlV29ya3NwYWNlVGFyZ2V0cxINCgVlcnJvchgCIAEoCSLRAQoWU3dpdGNoV29ya3NwYWNlUmVxdWVzdBIKCgJpZBgBIAEoCRI0Cgtzd2l0Y2hfdHlwZRgCIAEoDjIfLnNlc3Npb24udjEuV29ya3NwYWNlU3dpdGNoVHlwZRIOCgZ0YXJnZXQYAyABKAkSMwoPY2hhbmdlX3N0cmF0ZWd5GAQgASgOMhouc2Vzc2lvbi52MS5DaGFuZ2VTdHJhdGVneRIZChFjcmVhdGVfaWZfbWlzc2luZxgFIAEoCBIVCg1iYXNlX3JldmlzaW9uGAYgASgJImEKFlJlc29sdmVBcHByb3ZhbFJlcXVlc3QSEwoLYXBwcm92YWxfaWQYASABKAkSEAoIZGVjaXNpb24YAiABKAkSFAoHbWVzc2FnZRgDIAEoCUgAiAEBQgoKCF9tZXNzYWdlIjsKF1Jlc29sdmVBcHByb3ZhbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJFChtMaXN0UGVuZGluZ0FwcHJvdmFsc1JlcXVlc3QSFwoKc2Vzc2lvbl9pZBgBIAEoCUgAiAEBQg0KC19zZXNzaW9uX2lkIlMKHExpc3RQZW5kaW5nQXBwcm92YWxzUmVzcG9uc2USMwoJYXBwcm92YWxzGAEgAygLMiAuc2Vzc2lvbi52MS5QZW5kaW5nQXBwcm92YWxQcm90byLWAQoXU3dpdGNoV29ya3NwYWNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhkKEXByZXZpb3VzX3JldmlzaW9uGAMgASgJEhgKEGN1cnJlbnRfcmV2aXNpb24YBCABKAkSJQoIdmNzX3R5cGUYBSABKA4yEy5zZXNzaW9uLnYxLlZDU1R5cGUSFwoPY2hhbmdlc19oYW5kbGVkGAYgASgJEiQKB3Nlc3Npb24YByABKAsyEy5zZXNzaW9uLnYxLlNlc3Npb24iXgoaQ3JlYXRlRGVidWdTbmFwc2hvdFJlcXVlc3QSEQoEbm90ZRgBIAEoCUgAiAEBEhYKCWxvZ19saW5lcxgCIAEoBUgBiAEBQgcKBV9ub3RlQgwKCl9sb2dfbGluZXMibQobQ3JlYXRlRGVidWdTbmFwc2hvdFJlc3BvbnNlEhEKCWZpbGVfcGF0aBgBIAEoCRIPCgdzdW1tYXJ5GAIgASgJEhEKCXRpbWVzdGFtcBgDIAEoCRIXCg9maWxlX3NpemVfYnl0ZXMYBCABKAMivwQKGU5vdGlmaWNhdGlvbkhpc3RvcnlSZWNvcmQSCgoCaWQYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCRIUCgxzZXNzaW9uX25hbWUYAyABKAkSNwoRbm90aWZpY2F0aW9uX3R5cGUYBCABKA4yHC5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvblR5cGUSMgoIcHJpb3JpdHkYBSABKA4yIC5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvblByaW9yaXR5Eg0KBXRpdGxlGAYgASgJEg8KB21lc3NhZ2UYByABKAkSRQoIbWV0YWRhdGEYCCADKAsyMy5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvbkhpc3RvcnlSZWNvcmQuTWV0YWRhdGFFbnRyeRIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdpc19yZWFkGAogASgIEjAKB3JlYWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESGAoQb2NjdXJyZW5jZV9jb3VudBgMIAEoBRI5ChBsYXN0X29jY3VycmVkX2F0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIKCghfcmVhZF9hdEITChFfbGFzdF9vY2N1cnJlZF9hdCL3AQodR2V0Tm90aWZpY2F0aW9uSGlzdG9yeVJlcXVlc3QSEgoFbGltaXQYASABKAVIAIgBARITCgZvZmZzZXQYAiABKAVIAYgBARI2Cgt0eXBlX2ZpbHRlchgDIAEoDjIcLnNlc3Npb24udjEuTm90aWZpY2F0aW9uVHlwZUgCiAEBEhcKCnNlc3Npb25faWQYBCABKAlIA4gBARIYCgt1bnJlYWRfb25seRgFIAEoCEgEiAEBQggKBl9saW1pdEIJCgdfb2Zmc2V0Qg4KDF90eXBlX2ZpbHRlckINCgtfc2Vzc2lvbl9pZEIOCgxfdW5yZWFkX29ubHkimwEKHkdldE5vdGlmaWNhdGlvbkhpc3RvcnlSZXNwb25zZRI8Cg1ub3RpZmljYXRpb25zGAEgAygLMiUuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25IaXN0b3J5UmVjb3JkEhMKC3RvdGFsX2NvdW50GAIgASgFEhQKDHVucmVhZF9jb3VudBgDIAEoBRIQCghoYXNfbW9yZRgEIAEoCCI3ChtNYXJrTm90aWZpY2F0aW9uUmVhZFJlcXVlc3QSGAoQbm90aWZpY2F0aW9uX2lkcxgBIAMoCSJFChxNYXJrTm90aWZpY2F0aW9uUmVhZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSFAoMbWFya2VkX2NvdW50GAIgASgFIlUKH0NsZWFyTm90aWZpY2F0aW9uSGlzdG9yeVJlcXVlc3QSHQoQYmVmb3JlX3RpbWVzdGFtcBgBIAEoCUgAiAEBQhMKEV9iZWZvcmVfdGltZXN0YW1wIkoKIENsZWFyTm90aWZpY2F0aW9uSGlzdG9yeVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSFQoNY2xlYXJlZF9jb3VudBgCIAEoBSJIChhMaXN0QXBwcm92YWxSdWxlc1JlcXVlc3QSGgoNc291cmNlX2ZpbHRlchgBIAEoCUgAiAEBQhAKDl9zb3VyY2VfZmlsdGVyIkkKGUxpc3RBcHByb3ZhbFJ1bGVzUmVzcG9uc2USLAoFcnVsZXMYASADKAsyHS5zZXNzaW9uLnYxLkFwcHJvdmFsUnVsZVByb3RvIkgKGVVwc2VydEFwcHJvdmFsUnVsZVJlcXVlc3QSKwoEcnVsZRgBIAEoCzIdLnNlc3Npb24udjEuQXBwcm92YWxSdWxlUHJvdG8iWgoaVXBzZXJ0QXBwcm92YWxSdWxlUmVzcG9uc2USKwoEcnVsZRgBIAEoCzIdLnNlc3Npb24udjEuQXBwcm92YWxSdWxlUHJvdG8SDwoHY3JlYXRlZBgCIAEoCCInChlEZWxldGVBcHByb3ZhbFJ1bGVSZXF1ZXN0EgoKAmlkGAEgASgJIj4KGkRlbGV0ZUFwcHJvdmFsUnVsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJHChtHZXRBcHByb3ZhbEFuYWx5dGljc1JlcXVlc3QSGAoLd2luZG93X2RheXMYASABKAVIAIgBAUIOCgxfd2luZG93X2RheXMihwEKHEdldEFwcHJvdmFsQW5hbHl0aWNzUmVzcG9uc2USMgoHc3VtbWFyeRgBIAEoCzIhLnNlc3Npb24udjEuQW5hbHl0aWNzU3VtbWFyeVByb3RvEjMKDWRhaWx5X2J1Y2tldHMYAiADKAsyHC5zZXNzaW9uLnYxLkRhaWx5QnVja2V0UHJvdG8iFgoUTGlzdERhdGFiYXNlc1JlcXVlc3QiYgoVTGlzdERhdGFiYXNlc1Jlc3BvbnNlEisKCWRhdGFiYXNlcxgBIAMoCzIYLnNlc3Npb24udjEuRGF0YWJhc2VJbmZvEhwKFGN1cnJlbnRfd29ya3NwYWNlX2lkGAIgASgJIhsKGUdldEN1cnJlbnREYXRhYmFzZVJlcXVlc3QiSAoaR2V0Q3VycmVudERhdGFiYXNlUmVzcG9uc2USKgoIZGF0YWJhc2UYASABKAsyGC5zZXNzaW9uLnYxLkRhdGFiYXNlSW5mbyIrChVTd2l0Y2hEYXRhYmFzZVJlcXVlc3QSEgoKY29uZmlnX2RpchgBIAEoCSI6ChZTd2l0Y2hEYXRhYmFzZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSIqChRNZXJnZURhdGFiYXNlUmVxdWVzdBISCgpjb25maWdfZGlyGAEgASgJIm4KFU1lcmdlRGF0YWJhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSGQoRc2Vzc2lvbnNfaW1wb3J0ZWQYAyABKAUSGAoQc2Vzc2lvbnNfc2tpcHBlZBgEIAEoBSI8ChdDcmVhdGVDaGVja3BvaW50UmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEg0KBWxhYmVsGAIgASgJIksKGENyZWF0ZUNoZWNrcG9pbnRSZXNwb25zZRIvCgpjaGVja3BvaW50GAEgASgLMhsuc2Vzc2lvbi52MS5DaGVja3BvaW50UHJvdG8iLAoWTGlzdENoZWNrcG9pbnRzUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJIksKF0xpc3RDaGVja3BvaW50c1Jlc3BvbnNlEjAKC2NoZWNrcG9pbnRzGAEgAygLMhsuc2Vzc2lvbi52MS5DaGVja3BvaW50UHJvdG8iUgoSRm9ya1Nlc3Npb25SZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSFQoNY2hlY2twb2ludF9pZBgCIAEoCRIRCgluZXdfdGl0bGUYAyABKAkiOwoTRm9ya1Nlc3Npb25SZXNwb25zZRIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uIk0KEExpc3RGaWxlc1JlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRIMCgRwYXRoGAIgASgJEhcKD2luY2x1ZGVfaWdub3JlZBgDIAEoCCJzChFMaXN0RmlsZXNSZXNwb25zZRIjCgVmaWxlcxgBIAMoCzIULnNlc3Npb24udjEuRmlsZU5vZGUSEQoJYmFzZV9wYXRoGAIgASgJEhEKCXRydW5jYXRlZBgDIAEoCBITCgt0b3RhbF9jb3VudBgEIAEoBSI5ChVHZXRGaWxlQ29udGVudFJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRIMCgRwYXRoGAIgASgJIogBChZHZXRGaWxlQ29udGVudFJlc3BvbnNlEg8KB2NvbnRlbnQYASABKAkSEAoIZW5jb2RpbmcYAiABKAkSEQoJaXNfYmluYXJ5GAMgASgIEgwKBHNpemUYBCABKAMSFAoMY29udGVudF90eXBlGAUgASgJEhQKDGlzX3RydW5jYXRlZBgGIAEoCCJlChJTZWFyY2hGaWxlc1JlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRINCgVxdWVyeRgCIAEoCRIXCg9pbmNsdWRlX2lnbm9yZWQYAyABKAgSEwoLbWF4X3Jlc3VsdHMYBCABKAUiZAoTU2VhcmNoRmlsZXNSZXNwb25zZRIjCgVmaWxlcxgBIAMoCzIULnNlc3Npb24udjEuRmlsZU5vZGUSEQoJdHJ1bmNhdGVkGAIgASgIEhUKDXRvdGFsX21hdGNoZXMYAyABKAUiYAoaTGlzdFBhdGhDb21wbGV0aW9uc1JlcXVlc3QSEwoLcGF0aF9wcmVmaXgYASABKAkSEwoLbWF4X3Jlc3VsdHMYAiABKAUSGAoQZGlyZWN0b3JpZXNfb25seRgDIAEoCCKYAQobTGlzdFBhdGhDb21wbGV0aW9uc1Jlc3BvbnNlEiYKB2VudHJpZXMYASADKAsyFS5zZXNzaW9uLnYxLlBhdGhFbnRyeRIQCghiYXNlX2RpchgCIAEoCRIRCgl0cnVuY2F0ZWQYAyABKAgSFwoPYmFzZV9kaXJfZXhpc3RzGAQgASgIEhMKC3BhdGhfZXhpc3RzGAUgASgIIj0KCVBhdGhFbnRyeRIMCgRwYXRoGAEgASgJEgwKBG5hbWUYAiABKAkSFAoMaXNfZGlyZWN0b3J5GAMgASgIIrkDChRQcm9maWxlRGVmYXVsdHNQcm90bxIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg8KB3Byb2dyYW0YAyABKAkSEAoIYXV0b195ZXMYBCABKAgSDAoEdGFncxgFIAMoCRI/CghlbnZfdmFycxgGIAMoCzItLnNlc3Npb24udjEuUHJvZmlsZURlZmF1bHRzUHJvdG8uRW52VmFyc0VudHJ5EhEKCWNsaV9mbGFncxgHIAEoCRIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4Cg9yZXNvdXJjZV9saW1pdHMYCiABKAsyHy5zZXNzaW9uLnYxLlJlc291cmNlTGltaXRzUHJvdG8SLwoHc2FuZGJveBgLIAEoCzIeLnNlc3Npb24udjEuU2FuZGJveENvbmZpZ1Byb3RvGi4KDEVudlZhcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBImgKEkRpcmVjdG9yeVJ1bGVQcm90bxIMCgRwYXRoGAEgASgJEg8KB3Byb2ZpbGUYAiABKAkSMwoJb3ZlcnJpZGVzGAMgASgLMiAuc2Vzc2lvbi52MS5Qcm9maWxlRGVmYXVsdHNQcm90byLUAwoVU2Vzc2lvbkRlZmF1bHRzQ29uZmlnEg8KB3Byb2dyYW0YASABKAkSEAoIYXV0b195ZXMYAiABKAgSDAoEdGFncxgDIAMoCRJACghlbnZfdmFycxgEIAMoCzIuLnNlc3Npb24udjEuU2Vzc2lvbkRlZmF1bHRzQ29uZmlnLkVudlZhcnNFbnRyeRIRCgljbGlfZmxhZ3MYBSABKAkSQQoIcHJvZmlsZXMYBiADKAsyLy5zZXNzaW9uLnYxLlNlc3Npb25EZWZhdWx0c0NvbmZpZy5Qcm9maWxlc0VudHJ5EjcKD2RpcmVjdG9yeV9ydWxlcxgHIAMoCzIeLnNlc3Npb24udjEuRGlyZWN0b3J5UnVsZVByb3RvEhgKEG9uZV9vZmZfYmFzZV9kaXIYCCABKAkSHAoUbmV3X3Byb2plY3RfYmFzZV9kaXIYCSABKAkaLgoMRW52VmFyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaUQoNUHJvZmlsZXNFbnRyeRILCgNrZXkYASABKAkSLwoFdmFsdWUYAiABKAsyIC5zZXNzaW9uLnYxLlByb2ZpbGVEZWZhdWx0c1Byb3RvOgI4ASIbChlHZXRTZXNzaW9uRGVmYXVsdHNSZXF1ZXN0IlEKGkdldFNlc3Npb25EZWZhdWx0c1Jlc3BvbnNlEjMKCGRlZmF1bHRzGAEgASgLMiEuc2Vzc2lvbi52MS5TZXNzaW9uRGVmYXVsdHNDb25maWciQwoWUmVzb2x2ZURlZmF1bHRzUmVxdWVzdBITCgt3b3JraW5nX2RpchgBIAEoCRIUCgxwcm9maWxlX25hbWUYAiABKAkirwIKF1Jlc29sdmVEZWZhdWx0c1Jlc3BvbnNlEg8KB3Byb2dyYW0YASABKAkSEAoIYXV0b195ZXMYAiABKAgSDAoEdGFncxgDIAMoCRJCCghlbnZfdmFycxgEIAMoCzIwLnNlc3Npb24udjEuUmVzb2x2ZURlZmF1bHRzUmVzcG9uc2UuRW52VmFyc0VudHJ5EhEKCWNsaV9mbGFncxgFIAEoCRITCgt1c2VkX2dsb2JhbBgGIAEoCBIWCg51c2VkX2RpcmVjdG9yeRgHIAEoCBIUCgx1c2VkX3Byb2ZpbGUYCCABKAgSGQoRbWF0Y2hlZF9kaXJlY3RvcnkYCSABKAkaLgoMRW52VmFyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEikQIKG1VwZGF0ZUdsb2JhbERlZmF1bHRzUmVxdWVzdBIPCgdwcm9ncmFtGAEgASgJEhAKCGF1dG9feWVzGAIgASgIEgwKBHRhZ3MYAyADKAkSRgoIZW52X3ZhcnMYBCADKAsyNC5zZXNzaW9uLnYxLlVwZGF0ZUdsb2JhbERlZmF1bHRzUmVxdWVzdC5FbnZWYXJzRW50cnkSEQoJY2xpX2ZsYWdzGAUgASgJEhgKEG9uZV9vZmZfYmFzZV9kaXIYBiABKAkSHAoUbmV3X3Byb2plY3RfYmFzZV9kaXIYByABKAkaLgoMRW52VmFyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiUwocVXBkYXRlR2xvYmFsRGVmYXVsdHNSZXNwb25zZRIzCghkZWZhdWx0cxgBIAEoCzIhLnNlc3Npb24udjEuU2Vzc2lvbkRlZmF1bHRzQ29uZmlnIkkKFFVwc2VydFByb2ZpbGVSZXF1ZXN0EjEKB3Byb2ZpbGUYASABKAsyIC5zZXNzaW9uLnYxLlByb2ZpbGVEZWZhdWx0c1Byb3RvIkoKFVVwc2VydFByb2ZpbGVSZXNwb25zZRIxCgdwcm9maWxlGAEgASgLMiAuc2Vzc2lvbi52MS5Qcm9maWxlRGVmYXVsdHNQcm90byIkChREZWxldGVQcm9maWxlUmVxdWVzdBIMCgRuYW1lGAEgASgJIhcKFURlbGV0ZVByb2ZpbGVSZXNwb25zZSJKChpVcHNlcnREaXJlY3RvcnlSdWxlUmVxdWVzdBIsCgRydWxlGAEgASgLMh4uc2Vzc2lvbi52MS5EaXJlY3RvcnlSdWxlUHJvdG8iSwobVXBzZXJ0RGlyZWN0b3J5UnVsZVJlc3BvbnNlEiwKBHJ1bGUYASABKAsyHi5zZXNzaW9uLnYxLkRpcmVjdG9yeVJ1bGVQcm90byIqChpEZWxldGVEaXJlY3RvcnlSdWxlUmVxdWVzdBIMCgRwYXRoGAEgASgJIh0KG0RlbGV0ZURpcmVjdG9yeVJ1bGVSZXNwb25zZSIpChRMaXN0V29ya3RyZWVzUmVxdWVzdBIRCglyZXBvX3BhdGgYASABKAkiPgoNV29ya3RyZWVFbnRyeRIMCgRwYXRoGAEgASgJEg4KBmJyYW5jaBgCIAEoCRIPCgdpc19tYWluGAMgASgIIkUKFUxpc3RXb3JrdHJlZXNSZXNwb25zZRIsCgl3b3JrdHJlZXMYASADKAsyGS5zZXNzaW9uLnYxLldvcmt0cmVlRW50cnkisAEKElByb21wdEhpc3RvcnlFbnRyeRIKCgJpZBgBIAEoCRIMCgR0ZXh0GAIgASgJEg0KBWxhYmVsGAMgASgJEhIKCnVzZWRfY291bnQYBCABKAUSLQoJbGFzdF91c2VkGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIpChhMaXN0UHJvbXB0SGlzdG9yeVJlcXVlc3QSDQoFbGltaXQYASABKAUiTAoZTGlzdFByb21wdEhpc3RvcnlSZXNwb25zZRIvCgdlbnRyaWVzGAEgAygLMh4uc2Vzc2lvbi52MS5Qcm9tcHRIaXN0b3J5RW50cnkiKAoaRGVsZXRlUHJvbXB0SGlzdG9yeVJlcXVlc3QSCgoCaWQYASABKAkiHQobRGVsZXRlUHJvbXB0SGlzdG9yeVJlc3BvbnNlIpUDChNCYXRjaFNlc3Npb25SZXF1ZXN0Eg0KBXRpdGxlGAEgASgJEgwKBHBhdGgYAiABKAkSEwoLd29ya2luZ19kaXIYAyABKAkSDgoGYnJhbmNoGAQgASgJEg8KB3Byb2dyYW0YBSABKAkSEAoIY2F0ZWdvcnkYBiABKAkSFgoOaW5pdGlhbF9wcm9tcHQYByABKAkSEAoIYXV0b195ZXMYCCABKAgSLQoMc2Vzc2lvbl90eXBlGAkgASgOMhcuc2Vzc2lvbi52MS5TZXNzaW9uVHlwZRISCgpwcm9qZWN0X2lkGAogASgJEgwKBHRhZ3MYCyADKAkSEAoIdGVtcGxhdGUYDCABKAkSUgoSdGVtcGxhdGVfdmFyaWFibGVzGA0gAygLMjYuc2Vzc2lvbi52MS5CYXRjaFNlc3Npb25SZXF1ZXN0LlRlbXBsYXRlVmFyaWFibGVzRW50cnkaOAoWVGVtcGxhdGVWYXJpYWJsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIlYKEUJhdGNoQ3JlYXRlUmVzdWx0Eg8KB3N1Y2Nlc3MYASABKAgSEgoKc2Vzc2lvbl9pZBgCIAEoCRINCgVlcnJvchgDIAEoCRINCgV0aXRsZRgEIAEoCSJoChpCYXRjaENyZWF0ZVNlc3Npb25zUmVxdWVzdBIxCghzZXNzaW9ucxgBIAMoCzIfLnNlc3Npb24udjEuQmF0Y2hTZXNzaW9uUmVxdWVzdBIXCg9tYXhfY29uY3VycmVuY3kYAiABKAUicAobQmF0Y2hDcmVhdGVTZXNzaW9uc1Jlc3BvbnNlEi4KB3Jlc3VsdHMYASADKAsyHS5zZXNzaW9uLnYxLkJhdGNoQ3JlYXRlUmVzdWx0EhEKCXN1Y2NlZWRlZBgCIAEoBRIOCgZmYWlsZWQYAyABKAUiUAoRUnVuT25lU2hvdFJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRIOCgZwcm9tcHQYAiABKAkSFwoPdGltZW91dF9zZWNvbmRzGAMgASgFInkKElJ1bk9uZVNob3RSZXNwb25zZRIOCgZvdXRwdXQYASABKAkSDQoFZXJyb3IYAiABKAkSEQoJZXhpdF9jb2RlGAMgASgFEg4KBnByX3VybBgEIAEoCRIhChlicmFuY2hfZGl2ZXJnZWRfZnJvbV9iYXNlGAUgASgIIvoBCgdQcm9qZWN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNc2Vzc2lvbl9jb3VudBgGIAEoBRIVCg1ydW5uaW5nX2NvdW50GAcgASgFEhYKDmNvbXBsZXRlX2NvdW50GAggASgFEhoKEnJldmlld19yZWFkeV9jb3VudBgJIAEoBSI5ChRDcmVhdGVQcm9qZWN0UmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJIj0KFUNyZWF0ZVByb2plY3RSZXNwb25zZRIkCgdwcm9qZWN0GAEgASgLMhMuc2Vzc2lvbi52MS5Qcm9qZWN0IhUKE0xpc3RQcm9qZWN0c1JlcXVlc3QiPQoUTGlzdFByb2plY3RzUmVzcG9uc2USJQoIcHJvamVjdHMYASADKAsyEy5zZXNzaW9uLnYxLlByb2plY3QiRQoUVXBkYXRlUHJvamVjdFJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCSI9ChVVcGRhdGVQcm9qZWN0UmVzcG9uc2USJAoHcHJvamVjdBgBIAEoCzITLnNlc3Npb24udjEuUHJvamVjdCIiChREZWxldGVQcm9qZWN0UmVxdWVzdBIKCgJpZBgBIAEoCSIoChVEZWxldGVQcm9qZWN0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJJCh5Bc3NpZ25TZXNzaW9uc1RvUHJvamVjdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRITCgtzZXNzaW9uX2lkcxgCIAMoCSI4Ch9Bc3NpZ25TZXNzaW9uc1RvUHJvamVjdFJlc3BvbnNlEhUKDXVwZGF0ZWRfY291bnQYASABKAUiZQoTTGlzdEJyYW5jaGVzUmVxdWVzdBIRCglyZXBvX3BhdGgYASABKAkSDgoGZmlsdGVyGAIgASgJEhMKC21heF9yZXN1bHRzGAMgASgFEhYKDmluY2x1ZGVfcmVtb3RlGAQgASgIIlAKFExpc3RCcmFuY2hlc1Jlc3BvbnNlEhAKCGJyYW5jaGVzGAEgAygJEhMKC3RvdGFsX2NvdW50GAIgASgFEhEKCXRydW5jYXRlZBgDIAEoCCJGChpHZXRUZXJtaW5hbFNuYXBzaG90UmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEhQKDGxhc3Rfbl9saW5lcxgCIAEoBSJAChtHZXRUZXJtaW5hbFNuYXBzaG90UmVzcG9uc2USDwoHY29udGVudBgBIAEoCRIQCghpc19lbXB0eRgCIAEoCCJ4Cg5DbGllbnRMb2dFbnRyeRINCgVsZXZlbBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEhEKCXRpbWVzdGFtcBgDIAEoCRILCgN1cmwYBCABKAkSEgoKdXNlcl9hZ2VudBgFIAEoCRISCgpzZXNzaW9uX2lkGAYgASgJIkUKFkxvZ0NsaWVudEV2ZW50c1JlcXVlc3QSKwoHZW50cmllcxgBIAMoCzIaLnNlc3Npb24udjEuQ2xpZW50TG9nRW50cnkiGQoXTG9nQ2xpZW50RXZlbnRzUmVzcG9uc2UiMQoRTGlzdEVycm9yc1JlcXVlc3QSHAoUaW5jbHVkZV9hY2tub3dsZWRnZWQYASABKAgihwIKEEVycm9yRXZlbnRSZWNvcmQSEwoLZmluZ2VycHJpbnQYASABKAkSEgoKZXJyb3JfdHlwZRgCIAEoCRIPCgdtZXNzYWdlGAMgASgJEhMKC3N0YWNrX3RyYWNlGAQgASgJEhUKDXJwY19wcm9jZWR1cmUYBSABKAkSGAoQb2NjdXJyZW5jZV9jb3VudBgGIAEoBRIuCgpmaXJzdF9zZWVuGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCglsYXN0X3NlZW4YCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDGFja25vd2xlZGdlZBgJIAEoCCJCChJMaXN0RXJyb3JzUmVzcG9uc2USLAoGZXJyb3JzGAEgAygLMhwuc2Vzc2lvbi52MS5FcnJvckV2ZW50UmVjb3JkIi4KF0Fja25vd2xlZGdlRXJyb3JSZXF1ZXN0EhMKC2ZpbmdlcnByaW50GAEgASgJIhoKGEFja25vd2xlZGdlRXJyb3JSZXNwb25zZSIrCh1DbGVhckNvbnZlcnNhdGlvblN0YXRlUmVxdWVzdBIKCgJpZBgBIAEoCSJCCh5DbGVhckNvbnZlcnNhdGlvblN0YXRlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIkEKC0ZlYXR1cmVGbGFnEgwKBG5hbWUYASABKAkSDwoHZW5hYmxlZBgCIAEoCBITCgtkZXNjcmlwdGlvbhgDIAEoCSIYChZHZXRGZWF0dXJlRmxhZ3NSZXF1ZXN0IkEKF0dldEZlYXR1cmVGbGFnc1Jlc3BvbnNlEiYKBWZsYWdzGAEgAygLMhcuc2Vzc2lvbi52MS5GZWF0dXJlRmxhZyI5ChhVcGRhdGVGZWF0dXJlRmxhZ1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIPCgdlbmFibGVkGAIgASgIIkIKGVVwZGF0ZUZlYXR1cmVGbGFnUmVzcG9uc2USJQoEZmxhZxgBIAEoCzIXLnNlc3Npb24udjEuRmVhdHVyZUZsYWcimgIKEEVzY2FwZUV2ZW50UHJvdG8SCgoCaWQYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCRINCgVzdGFnZRgDIAEoCRIVCg1zZXF1ZW5jZV90eXBlGAQgASgJEhgKEHNlcXVlbmNlX3N1YnR5cGUYBSABKAkSEwoLYnl0ZV9sZW5ndGgYBiABKAUSFAoMcGF5bG9hZF9oYXNoGAcgASgJEhEKCXJhd19ieXRlcxgIIAEoDBIPCgdtYW5nbGVkGAkgASgIEhMKC21hbmdsZV90eXBlGAogASgJEi0KCXdhbGxfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLc2Vzc2lvbl9zZXEYDCABKAMi8gEKG1F1ZXJ5RXNjYXBlQW5hbHl0aWNzUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEg0KBXN0YWdlGAIgASgJEhUKDXNlcXVlbmNlX3R5cGUYAyABKAkSFAoMbWFuZ2xlZF9vbmx5GAQgASgIEi4KCnN0YXJ0X3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglwYWdlX3NpemUYByABKAUSEgoKcGFnZV90b2tlbhgIIAEoCSJ6ChxRdWVyeUVzY2FwZUFuYWx5dGljc1Jlc3BvbnNlEiwKBmV2ZW50cxgBIAMoCzIcLnNlc3Npb24udjEuRXNjYXBlRXZlbnRQcm90bxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEwoLdG90YWxfY291bnQYAyABKAUiUgoTRXNjYXBlU2VxdWVuY2VDb3VudBIVCg1zZXF1ZW5jZV90eXBlGAEgASgJEg0KBWNvdW50GAIgASgDEhUKDW1hbmdsZWRfY291bnQYAyABKAMilAEKIEdldEVzY2FwZUFuYWx5dGljc1N1bW1hcnlSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSLgoKc3RhcnRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpwBCiFHZXRFc2NhcGVBbmFseXRpY3NTdW1tYXJ5UmVzcG9uc2USMgoJaGlzdG9ncmFtGAEgAygLMh8uc2Vzc2lvbi52MS5Fc2NhcGVTZXF1ZW5jZUNvdW50EhcKD3RvdGFsX3NlcXVlbmNlcxgCIAEoAxIVCg10b3RhbF9tYW5nbGVkGAMgASgDEhMKC21hbmdsZV9yYXRlGAQgASgBIn4KE1Jlc291cmNlTGltaXRzUHJvdG8SFQoNbWVtb3J5X21heF9tYhgBIAEoAxIWCg5tZW1vcnlfaGlnaF9tYhgCIAEoAxITCgtjcHVfcGVyY2VudBgDIAEoBRIQCghwaWRzX21heBgEIAEoAxIRCglpb193ZWlnaHQYBSABKAUiZQoSU2FuZGJveENvbmZpZ1Byb3RvEg8KB2VuYWJsZWQYASABKAgSDwoHbmV0d29yaxgCIAEoCRIVCg1hbGxvd2VkX2hvc3RzGAMgAygJEhYKDndyaXRhYmxlX3BhdGhzGAQgAygJIlIKGUdldFNlc3Npb25UaW1lbGluZVJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRISCgpzaW5jZV90dXJuGAIgASgFEg0KBWxpbWl0GAMgASgFInMKGkdldFNlc3Npb25UaW1lbGluZVJlc3BvbnNlEiUKBXR1cm5zGAEgAygLMhYuc2Vzc2lvbi52MS5UdXJuRGlnZXN0EhMKC3RvdGFsX3R1cm5zGAIgASgFEhkKEWNvbnZlcnNhdGlvbl9wYXRoGAMgASgJIo4CCgpUdXJuRGlnZXN0Eg0KBWluZGV4GAEgASgFEi4KCnN0YXJ0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZwcm9tcHQYBCABKAkSKAoFdG9vbHMYBSADKAsyGS5zZXNzaW9uLnYxLlR1cm5Ub29sQ291bnQSFQoNZmlsZXNfdG91Y2hlZBgGIAMoCRIQCghjb21tYW5kcxgHIAMoCRIOCgZlcnJvcnMYCCADKAkSDwoHb3V0Y29tZRgJIAEoCRIPCgdzdW1tYXJ5GAogASgJIiwKDVR1cm5Ub29sQ291bnQSDAoEbmFtZRgBIAEoCRINCgVjb3VudBgCIAEoBSJvChBTY29yZUV4cGxhbmF0aW9uEhMKC3RvdGFsX3Njb3JlGAEgASgBEgoKAmsxGAIgASgBEgkKAWIYAyABKAESLwoFdGVybXMYBCADKAsyIC5zZXNzaW9uLnYxLlRlcm1TY29yZUV4cGxhbmF0aW9uIsoBChRUZXJtU2NvcmVFeHBsYW5hdGlvbhIMCgR0ZXJtGAEgASgJEg4KBmNsYXVzZRgCIAEoCRIOCgZ3ZWlnaHQYAyABKAESFgoOdGVybV9mcmVxdWVuY3kYBCABKAESGgoSZG9jdW1lbnRfZnJlcXVlbmN5GAUgASgFEgsKA2lkZhgGIAEoARINCgVzY29yZRgHIAEoARIXCg9kb2N1bWVudF9sZW5ndGgYCCABKAUSGwoTYXZnX2RvY3VtZW50X2xlbmd0aBgJIAEoASJYChpTZXRTZXNzaW9uUmVjb3JkaW5nUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEg8KB2VuYWJsZWQYAiABKAgSFQoNaW5jbHVkZV9pbnB1dBgDIAEoCCJVChtTZXRTZXNzaW9uUmVjb3JkaW5nUmVzcG9uc2USNgoIc2V0dGluZ3MYASABKAsyJC5zZXNzaW9uLnYxLlNlc3Npb25SZWNvcmRpbmdTZXR0aW5ncyKCAQoYU2Vzc2lvblJlY29yZGluZ1NldHRpbmdzEg8KB2VuYWJsZWQYASABKAgSFQoNaW5jbHVkZV9pbnB1dBgCIAEoCBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZhY3RpdmUYBCABKAgijAEKFkV4cG9ydFJlY29yZGluZ1JlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRIXCg9mcm9tX2NoZWNrcG9pbnQYAiABKAkSFQoNdG9fY2hlY2twb2ludBgDIAEoCRIVCg1pbmNsdWRlX2lucHV0GAQgASgIEhcKD2lkbGVfdGltZV9saW1pdBgFIAEoASJWChdFeHBvcnRSZWNvcmRpbmdSZXNwb25zZRIMCgRjYXN0GAEgASgMEhMKC2V2ZW50X2NvdW50GAIgASgFEhgKEGR1cmF0aW9uX3NlY29uZHMYAyABKAEiSwoXU2VuZFNlc3Npb25JbnB1dFJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRIMCgR0ZXh0GAIgASgJEg4KBnN1Ym1pdBgDIAEoCCIxChhTZW5kU2Vzc2lvbklucHV0UmVzcG9uc2USFQoNYnl0ZXNfd3JpdHRlbhgBIAEoBSLoAwoPU2Vzc2lvblNjaGVkdWxlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEY3JvbhgDIAEoCRIQCgh0aW1lem9uZRgEIAEoCRIPCgdlbmFibGVkGAUgASgIEgwKBGtpbmQYBiABKAkSDgoGcHJvbXB0GAcgASgJEg8KB3Byb2ZpbGUYCCABKAkSEQoJcmVwb19wYXRoGAkgASgJEhUKDWJyYW5jaF9wcmVmaXgYCiABKAkSDwoHcHJvZ3JhbRgLIAEoCRIQCghjYXRlZ29yeRgMIAEoCRIWCg50YXJnZXRfc2Vzc2lvbhgNIAEoCRIXCg90aW1lb3V0X3NlY29uZHMYDiABKAUSDwoHb3ZlcmxhcBgPIAEoCRIQCghjYXRjaF91cBgQIAEoCRIuCgpjcmVhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtuZXh0X3J1bl9hdBgTIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKQoIbGFzdF9ydW4YFCABKAsyFy5zZXNzaW9uLnYxLlNjaGVkdWxlUnVuIs8CCgtTY2hlZHVsZVJ1bhIKCgJpZBgBIAEoCRITCgtzY2hlZHVsZV9pZBgCIAEoCRIPCgd0cmlnZ2VyGAMgASgJEg4KBnN0YXR1cxgEIAEoCRIxCg1zY2hlZHVsZWRfZm9yGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpzdGFydGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtmaW5pc2hlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKc2Vzc2lvbl9pZBgIIAEoCRIUCgxzZXNzaW9uX3V1aWQYCSABKAkSDQoFZXJyb3IYCiABKAkSEQoJZXhpdF9jb2RlGAsgASgFEg4KBnByX3VybBgMIAEoCRIOCgZvdXRwdXQYDSABKAkiFgoUTGlzdFNjaGVkdWxlc1JlcXVlc3QiRwoVTGlzdFNjaGVkdWxlc1Jlc3BvbnNlEi4KCXNjaGVkdWxlcxgBIAMoCzIbLnNlc3Npb24udjEuU2Vzc2lvblNjaGVkdWxlIkYKFUNyZWF0ZVNjaGVkdWxlUmVxdWVzdBItCghzY2hlZHVsZRgBIAEoCzIbLnNlc3Npb24udjEuU2Vzc2lvblNjaGVkdWxlIkcKFkNyZWF0ZVNjaGVkdWxlUmVzcG9uc2USLQoIc2NoZWR1bGUYASABKAsyGy5zZXNzaW9uLnYxLlNlc3Npb25TY2hlZHVsZSLBBAoVVXBkYXRlU2NoZWR1bGVSZXF1ZXN0EgoKAmlkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIRCgRjcm9uGAMgASgJSAGIAQESFQoIdGltZXpvbmUYBCABKAlIAogBARIUCgdlbmFibGVkGAUgASgISAOIAQESEQoEa2luZBgGIAEoCUgEiAEBEhMKBnByb21wdBgHIAEoCUgFiAEBEhQKB3Byb2ZpbGUYCCABKAlIBogBARIWCglyZXBvX3BhdGgYCSABKAlIB4gBARIaCg1icmFuY2hfcHJlZml4GAogASgJSAiIAQESFAoHcHJvZ3JhbRgLIAEoCUgJiAEBEhUKCGNhdGVnb3J5GAwgASgJSAqIAQESGwoOdGFyZ2V0X3Nlc3Npb24YDSABKAlIC4gBARIcCg90aW1lb3V0X3NlY29uZHMYDiABKAVIDIgBARIUCgdvdmVybGFwGA8gASgJSA2IAQESFQoIY2F0Y2hfdXAYECABKAlIDogBAUIHCgVfbmFtZUIHCgVfY3JvbkILCglfdGltZXpvbmVCCgoIX2VuYWJsZWRCBwoFX2tpbmRCCQoHX3Byb21wdEIKCghfcHJvZmlsZUIMCgpfcmVwb19wYXRoQhAKDl9icmFuY2hfcHJlZml4QgoKCF9wcm9ncmFtQgsKCV9jYXRlZ29yeUIRCg9fdGFyZ2V0X3Nlc3Npb25CEgoQX3RpbWVvdXRfc2Vjb25kc0IKCghfb3ZlcmxhcEILCglfY2F0Y2hfdXAiRwoWVXBkYXRlU2NoZWR1bGVSZXNwb25zZRItCghzY2hlZHVsZRgBIAEoCzIbLnNlc3Npb24udjEuU2Vzc2lvblNjaGVkdWxlIiMKFURlbGV0ZVNjaGVkdWxlUmVxdWVzdBIKCgJpZBgBIAEoCSIYChZEZWxldGVTY2hlZHVsZVJlc3BvbnNlIiQKFlRyaWdnZXJTY2hlZHVsZVJlcXVlc3QSCgoCaWQYASABKAkiPwoXVHJpZ2dlclNjaGVkdWxlUmVzcG9uc2USJAoDcnVuGAEgASgLMhcuc2Vzc2lvbi52MS5TY2hlZHVsZVJ1biI9ChdMaXN0U2NoZWR1bGVSdW5zUmVxdWVzdBITCgtzY2hlZHVsZV9pZBgBIAEoCRINCgVsaW1pdBgCIAEoBSJBChhMaXN0U2NoZWR1bGVSdW5zUmVzcG9uc2USJQoEcnVucxgBIAMoCzIXLnNlc3Npb24udjEuU2NoZWR1bGVSdW4ifQoQVGVtcGxhdGVWYXJpYWJsZRIMCgRuYW1lGAEgASgJEgwKBHR5cGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEAoIcmVxdWlyZWQYBCABKAgSFQoNZGVmYXVsdF92YWx1ZRgFIAEoCRIPCgdvcHRpb25zGAYgAygJIocDCg9TZXNzaW9uVGVtcGxhdGUSDAoEbmFtZRgBIAEoCRIPCgd2ZXJzaW9uGAIgASgFEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg8KB3Byb2dyYW0YBCABKAkSDwoHcHJvZmlsZRgFIAEoCRIUCgxzZXNzaW9uX3R5cGUYBiABKAkSFgoOYnJhbmNoX3BhdHRlcm4YByABKAkSDAoEdGFncxgIIAMoCRIOCgZwcm9tcHQYCSABKAkSLwoJdmFyaWFibGVzGAogAygLMhwuc2Vzc2lvbi52MS5UZW1wbGF0ZVZhcmlhYmxlEhQKDHNldHVwX3NjcmlwdBgLIAEoCRIbChNhY2NlcHRhbmNlX2NyaXRlcmlhGAwgAygJEg4KBnNvdXJjZRgNIAEoCRIuCgpjcmVhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIpChRMaXN0VGVtcGxhdGVzUmVxdWVzdBIRCglyZXBvX3BhdGgYASABKAkiWQoVTGlzdFRlbXBsYXRlc1Jlc3BvbnNlEi4KCXRlbXBsYXRlcxgBIAMoCzIbLnNlc3Npb24udjEuU2Vzc2lvblRlbXBsYXRlEhAKCHdhcm5pbmdzGAIgAygJIkYKEkdldFRlbXBsYXRlUmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3ZlcnNpb24YAiABKAUSEQoJcmVwb19wYXRoGAMgASgJIlYKE0dldFRlbXBsYXRlUmVzcG9uc2USLQoIdGVtcGxhdGUYASABKAsyGy5zZXNzaW9uLnYxLlNlc3Npb25UZW1wbGF0ZRIQCgh2ZXJzaW9ucxgCIAMoBSJnChNTYXZlVGVtcGxhdGVSZXF1ZXN0Ei0KCHRlbXBsYXRlGAEgASgLMhsuc2Vzc2lvbi52MS5TZXNzaW9uVGVtcGxhdGUSDgoGc2hhcmVkGAIgASgIEhEKCXJlcG9fcGF0aBgDIAEoCSJFChRTYXZlVGVtcGxhdGVSZXNwb25zZRItCgh0ZW1wbGF0ZRgBIAEoCzIbLnNlc3Npb24udjEuU2Vzc2lvblRlbXBsYXRlIiUKFURlbGV0ZVRlbXBsYXRlUmVxdWVzdBIMCgRuYW1lGAEgASgJIhgKFkRlbGV0ZVRlbXBsYXRlUmVzcG9uc2UixwIKIENyZWF0ZVNlc3Npb25Gcm9tVGVtcGxhdGVSZXF1ZXN0EhAKCHRlbXBsYXRlGAEgASgJEhEKCXJlcG9fcGF0aBgCIAEoCRINCgV0aXRsZRgDIAEoCRJOCgl2YXJpYWJsZXMYBCADKAsyOy5zZXNzaW9uLnYxLkNyZWF0ZVNlc3Npb25Gcm9tVGVtcGxhdGVSZXF1ZXN0LlZhcmlhYmxlc0VudHJ5Eg4KBmJyYW5jaBgFIAEoCRIPCgdwcm9ncmFtGAYgASgJEhAKCGNhdGVnb3J5GAcgASgJEgwKBHRhZ3MYCCADKAkSEAoIYXV0b195ZXMYCSABKAgSGgoSdHJ1c3Rfc2V0dXBfc2NyaXB0GAogASgIGjAKDlZhcmlhYmxlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEijgEKIUNyZWF0ZVNlc3Npb25Gcm9tVGVtcGxhdGVSZXNwb25zZRIkCgdzZXNzaW9uGAEgASgLMhMuc2Vzc2lvbi52MS5TZXNzaW9uEi0KCHRlbXBsYXRlGAIgASgLMhsuc2Vzc2lvbi52MS5TZXNzaW9uVGVtcGxhdGUSFAoMc2V0dXBfb3V0cHV0GAMgASgJIiQKFlNlbmRDSUZpeFByb21wdFJlcXVlc3QSCgoCaWQYASABKAkiKQoXU2VuZENJRml4UHJvbXB0UmVzcG9uc2USDgoGcHJvbXB0GAEgASgJMqJICg5TZXNzaW9uU2VydmljZRJTCgxMaXN0U2Vzc2lvbnMSHy5zZXNzaW9uLnYxLkxpc3RTZXNzaW9uc1JlcXVlc3QaIC5zZXNzaW9uLnYxLkxpc3RTZXNzaW9uc1Jlc3BvbnNlIgASTQoKR2V0U2Vzc2lvbhIdLnNlc3Npb24udjEuR2V0U2Vzc2lvblJlcXVlc3QaHi5zZXNzaW9uLnYxLkdldFNlc3Npb25SZXNwb25zZSIAElYKDUNyZWF0ZVNlc3Npb24SIC5zZXNzaW9uLnYxLkNyZWF0ZVNlc3Npb25SZXF1ZXN0GiEuc2Vzc2lvbi52MS5DcmVhdGVTZXNzaW9uUmVzcG9uc2UiABJWCg1VcGRhdGVTZXNzaW9uEiAuc2Vzc2lvbi52MS5VcGRhdGVTZXNzaW9uUmVxdWVzdBohLnNlc3Npb24udjEuVXBkYXRlU2Vzc2lvblJlc3BvbnNlIgASVgoNRGVsZXRlU2Vzc2lvbhIgLnNlc3Npb24udjEuRGVsZXRlU2Vzc2lvblJlcXVlc3QaIS5zZXNzaW9uLnYxLkRlbGV0ZVNlc3Npb25SZXNwb25zZSIAEk8KDVdhdGNoU2Vzc2lvbnMSIC5zZXNzaW9uLnYxLldhdGNoU2Vzc2lvbnNSZXF1ZXN0Ghguc2Vzc2lvbi52MS5TZXNzaW9uRXZlbnQiADABEkoKDlN0cmVhbVRlcm1pbmFsEhguc2Vzc2lvbi52MS5UZXJtaW5hbERhdGEaGC5zZXNzaW9uLnYxLlRlcm1pbmFsRGF0YSIAKAEwARJZCg5HZXRTZXNzaW9uRGlmZhIhLnNlc3Npb24udjEuR2V0U2Vzc2lvbkRpZmZSZXF1ZXN0GiIuc2Vzc2lvbi52MS5HZXRTZXNzaW9uRGlmZlJlc3BvbnNlIgASUwoMR2V0VkNTU3RhdHVzEh8uc2Vzc2lvbi52MS5HZXRWQ1NTdGF0dXNSZXF1ZXN0GiAuc2Vzc2lvbi52MS5HZXRWQ1NTdGF0dXNSZXNwb25zZSIAElkKDkdldFJldmlld1F1ZXVlEiEuc2Vzc2lvbi52MS5HZXRSZXZpZXdRdWV1ZVJlcXVlc3QaIi5zZXNzaW9uLnYxLkdldFJldmlld1F1ZXVlUmVzcG9uc2UiABJlChJBY2tub3dsZWRnZVNlc3Npb24SJS5zZXNzaW9uLnYxLkFja25vd2xlZGdlU2Vzc2lvblJlcXVlc3QaJi5zZXNzaW9uLnYxLkFja25vd2xlZGdlU2Vzc2lvblJlc3BvbnNlIgASRAoHR2V0TG9ncxIaLnNlc3Npb24udjEuR2V0TG9nc1JlcXVlc3QaGy5zZXNzaW9uLnYxLkdldExvZ3NSZXNwb25zZSIAElkKEFdhdGNoUmV2aWV3UXVldWUSIy5zZXNzaW9uLnYxLldhdGNoUmV2aWV3UXVldWVSZXF1ZXN0Ghwuc2Vzc2lvbi52MS5SZXZpZXdRdWV1ZUV2ZW50IgAwARJlChJMb2dVc2VySW50ZXJhY3Rpb24SJS5zZXNzaW9uLnYxLkxvZ1VzZXJJbnRlcmFjdGlvblJlcXVlc3QaJi5zZXNzaW9uLnYxLkxvZ1VzZXJJbnRlcmFjdGlvblJlc3BvbnNlIgASXAoPR2V0Q2xhdWRlQ29uZmlnEiIuc2Vzc2lvbi52MS5HZXRDbGF1ZGVDb25maWdSZXF1ZXN0GiMuc2Vzc2lvbi52MS5HZXRDbGF1ZGVDb25maWdSZXNwb25zZSIAEmIKEUxpc3RDbGF1ZGVDb25maWdzEiQuc2Vzc2lvbi52MS5MaXN0Q2xhdWRlQ29uZmlnc1JlcXVlc3QaJS5zZXNzaW9uLnYxLkxpc3RDbGF1ZGVDb25maWdzUmVzcG9uc2UiABJlChJVcGRhdGVDbGF1ZGVDb25maWcSJS5zZXNzaW9uLnYxLlVwZGF0ZUNsYXVkZUNvbmZpZ1JlcXVlc3QaJi5zZXNzaW9uLnYxLlVwZGF0ZUNsYXVkZUNvbmZpZ1Jlc3BvbnNlIgASYgoRTGlzdENsYXVkZUhpc3RvcnkSJC5zZXNzaW9uLnYxLkxpc3RDbGF1ZGVIaXN0b3J5UmVxdWVzdBolLnNlc3Npb24udjEuTGlzdENsYXVkZUhpc3RvcnlSZXNwb25zZSIAEnEKFkdldENsYXVkZUhpc3RvcnlEZXRhaWwSKS5zZXNzaW9uLnYxLkdldENsYXVkZUhpc3RvcnlEZXRhaWxSZXF1ZXN0Giouc2Vzc2lvbi52MS5HZXRDbGF1ZGVIaXN0b3J5RGV0YWlsUmVzcG9uc2UiABJ3ChhHZXRDbGF1ZGVIaXN0b3J5TWVzc2FnZXMSKy5zZXNzaW9uLnYxLkdldENsYXVkZUhpc3RvcnlNZXNzYWdlc1JlcXVlc3QaLC5zZXNzaW9uLnYxLkdldENsYXVkZUhpc3RvcnlNZXNzYWdlc1Jlc3BvbnNlIgASaAoTU2VhcmNoQ2xhdWRlSGlzdG9yeRImLnNlc3Npb24udjEuU2VhcmNoQ2xhdWRlSGlzdG9yeVJlcXVlc3QaJy5zZXNzaW9uLnYxLlNlYXJjaENsYXVkZUhpc3RvcnlSZXNwb25zZSIAEkoKCUdldFBSSW5mbxIcLnNlc3Npb24udjEuR2V0UFJJbmZvUmVxdWVzdBodLnNlc3Npb24udjEuR2V0UFJJbmZvUmVzcG9uc2UiABJWCg1HZXRQUkNvbW1lbnRzEiAuc2Vzc2lvbi52MS5HZXRQUkNvbW1lbnRzUmVxdWVzdBohLnNlc3Npb24udjEuR2V0UFJDb21tZW50c1Jlc3BvbnNlIgASVgoNUG9zdFBSQ29tbWVudBIgLnNlc3Npb24udjEuUG9zdFBSQ29tbWVudFJlcXVlc3QaIS5zZXNzaW9uLnYxLlBvc3RQUkNvbW1lbnRSZXNwb25zZSIAEkQKB01lcmdlUFISGi5zZXNzaW9uLnYxLk1lcmdlUFJSZXF1ZXN0Ghsuc2Vzc2lvbi52MS5NZXJnZVBSUmVzcG9uc2UiABJECgdDbG9zZVBSEhouc2Vzc2lvbi52MS5DbG9zZVBSUmVxdWVzdBobLnNlc3Npb24udjEuQ2xvc2VQUlJlc3BvbnNlIgASXAoPU2VuZENJRml4UHJvbXB0EiIuc2Vzc2lvbi52MS5TZW5kQ0lGaXhQcm9tcHRSZXF1ZXN0GiMuc2Vzc2lvbi52MS5TZW5kQ0lGaXhQcm9tcHRSZXNwb25zZSIAEl8KEFNlbmROb3RpZmljYXRpb24SIy5zZXNzaW9uLnYxLlNlbmROb3RpZmljYXRpb25SZXF1ZXN0GiQuc2Vzc2lvbi52MS5TZW5kTm90aWZpY2F0aW9uUmVzcG9uc2UiABJQCgtGb2N1c1dpbmRvdxIeLnNlc3Npb24udjEuRm9jdXNXaW5kb3dSZXF1ZXN0Gh8uc2Vzc2lvbi52MS5Gb2N1c1dpbmRvd1Jlc3BvbnNlIgASVgoNUmVuYW1lU2Vzc2lvbhIgLnNlc3Npb24udjEuUmVuYW1lU2Vzc2lvblJlcXVlc3QaIS5zZXNzaW9uLnYxLlJlbmFtZVNlc3Npb25SZXNwb25zZSIAElkKDlJlc3RhcnRTZXNzaW9uEiEuc2Vzc2lvbi52MS5SZXN0YXJ0U2Vzc2lvblJlcXVlc3QaIi5zZXNzaW9uLnYxLlJlc3RhcnRTZXNzaW9uUmVzcG9uc2UiABJfChBHZXRXb3Jrc3BhY2VJbmZvEiMuc2Vzc2lvbi52MS5HZXRXb3Jrc3BhY2VJbmZvUmVxdWVzdBokLnNlc3Npb24udjEuR2V0V29ya3NwYWNlSW5mb1Jlc3BvbnNlIgASawoUTGlzdFdvcmtzcGFjZVRhcmdldHMSJy5zZXNzaW9uLnYxLkxpc3RXb3Jrc3BhY2VUYXJnZXRzUmVxdWVzdBooLnNlc3Npb24udjEuTGlzdFdvcmtzcGFjZVRhcmdldHNSZXNwb25zZSIAElwKD1N3aXRjaFdvcmtzcGFjZRIiLnNlc3Npb24udjEuU3dpdGNoV29ya3NwYWNlUmVxdWVzdBojLnNlc3Npb24udjEuU3dpdGNoV29ya3NwYWNlUmVzcG9uc2UiABJcCg9SZXNvbHZlQXBwcm92YWwSIi5zZXNzaW9uLnYxLlJlc29sdmVBcHByb3ZhbFJlcXVlc3QaIy5zZXNzaW9uLnYxLlJlc29sdmVBcHByb3ZhbFJlc3BvbnNlIgASawoUTGlzdFBlbmRpbmdBcHByb3ZhbHMSJy5zZXNzaW9uLnYxLkxpc3RQZW5kaW5nQXBwcm92YWxzUmVxdWVzdBooLnNlc3Npb24udjEuTGlzdFBlbmRpbmdBcHByb3ZhbHNSZXNwb25zZSIAEmgKE0NyZWF0ZURlYnVnU25hcHNob3QSJi5zZXNzaW9uLnYxLkNyZWF0ZURlYnVnU25hcHNob3RSZXF1ZXN0Gicuc2Vzc2lvbi52MS5DcmVhdGVEZWJ1Z1NuYXBzaG90UmVzcG9uc2UiABJxChZHZXROb3RpZmljYXRpb25IaXN0b3J5Eikuc2Vzc2lvbi52MS5HZXROb3RpZmljYXRpb25IaXN0b3J5UmVxdWVzdBoqLnNlc3Npb24udjEuR2V0Tm90aWZpY2F0aW9uSGlzdG9yeVJlc3BvbnNlIgASawoUTWFya05vdGlmaWNhdGlvblJlYWQSJy5zZXNzaW9uLnYxLk1hcmtOb3RpZmljYXRpb25SZWFkUmVxdWVzdBooLnNlc3Npb24udjEuTWFya05vdGlmaWNhdGlvblJlYWRSZXNwb25zZSIAEncKGENsZWFyTm90aWZpY2F0aW9uSGlzdG9yeRIrLnNlc3Npb24udjEuQ2xlYXJOb3RpZmljYXRpb25IaXN0b3J5UmVxdWVzdBosLnNlc3Npb24udjEuQ2xlYXJOb3RpZmljYXRpb25IaXN0b3J5UmVzcG9uc2UiABJiChFMaXN0QXBwcm92YWxSdWxlcxIkLnNlc3Npb24udjEuTGlzdEFwcHJvdmFsUnVsZXNSZXF1ZXN0GiUuc2Vzc2lvbi52MS5MaXN0QXBwcm92YWxSdWxlc1Jlc3BvbnNlIgASZQoSVXBzZXJ0QXBwcm92YWxSdWxlEiUuc2Vzc2lvbi52MS5VcHNlcnRBcHByb3ZhbFJ1bGVSZXF1ZXN0GiYuc2Vzc2lvbi52MS5VcHNlcnRBcHByb3ZhbFJ1bGVSZXNwb25zZSIAEmUKEkRlbGV0ZUFwcHJvdmFsUnVsZRIlLnNlc3Npb24udjEuRGVsZXRlQXBwcm92YWxSdWxlUmVxdWVzdBomLnNlc3Npb24udjEuRGVsZXRlQXBwcm92YWxSdWxlUmVzcG9uc2UiABJrChRHZXRBcHByb3ZhbEFuYWx5dGljcxInLnNlc3Npb24udjEuR2V0QXBwcm92YWxBbmFseXRpY3NSZXF1ZXN0Giguc2Vzc2lvbi52MS5HZXRBcHByb3ZhbEFuYWx5dGljc1Jlc3BvbnNlIgASVgoNTGlzdERhdGFiYXNlcxIgLnNlc3Npb24udjEuTGlzdERhdGFiYXNlc1JlcXVlc3QaIS5zZXNzaW9uLnYxLkxpc3REYXRhYmFzZXNSZXNwb25zZSIAEmUKEkdldEN1cnJlbnREYXRhYmFzZRIlLnNlc3Npb24udjEuR2V0Q3VycmVudERhdGFiYXNlUmVxdWVzdBomLnNlc3Npb24udjEuR2V0Q3VycmVudERhdGFiYXNlUmVzcG9uc2UiABJZCg5Td2l0Y2hEYXRhYmFzZRIhLnNlc3Npb24udjEuU3dpdGNoRGF0YWJhc2VSZXF1ZXN0GiIuc2Vzc2lvbi52MS5Td2l0Y2hEYXRhYmFzZVJlc3BvbnNlIgASVgoNTWVyZ2VEYXRhYmFzZRIgLnNlc3Npb24udjEuTWVyZ2VEYXRhYmFzZVJlcXVlc3QaIS5zZXNzaW9uLnYxLk1lcmdlRGF0YWJhc2VSZXNwb25zZSIAEl8KEENyZWF0ZUNoZWNrcG9pbnQSIy5zZXNzaW9uLnYxLkNyZWF0ZUNoZWNrcG9pbnRSZXF1ZXN0GiQuc2Vzc2lvbi52MS5DcmVhdGVDaGVja3BvaW50UmVzcG9uc2UiABJcCg9MaXN0Q2hlY2twb2ludHMSIi5zZXNzaW9uLnYxLkxpc3RDaGVja3BvaW50c1JlcXVlc3QaIy5zZXNzaW9uLnYxLkxpc3RDaGVja3BvaW50c1Jlc3BvbnNlIgASUAoLRm9ya1Nlc3Npb24SHi5zZXNzaW9uLnYxLkZvcmtTZXNzaW9uUmVxdWVzdBofLnNlc3Npb24udjEuRm9ya1Nlc3Npb25SZXNwb25zZSIAEnEKFkNsZWFyQ29udmVyc2F0aW9uU3RhdGUSKS5zZXNzaW9uLnYxLkNsZWFyQ29udmVyc2F0aW9uU3RhdGVSZXF1ZXN0Giouc2Vzc2lvbi52MS5DbGVhckNvbnZlcnNhdGlvblN0YXRlUmVzcG9uc2UiABJKCglMaXN0RmlsZXMSHC5zZXNzaW9uLnYxLkxpc3RGaWxlc1JlcXVlc3QaHS5zZXNzaW9uLnYxLkxpc3RGaWxlc1Jlc3BvbnNlIgASWQoOR2V0RmlsZUNvbnRlbnQSIS5zZXNzaW9uLnYxLkdldEZpbGVDb250ZW50UmVxdWVzdBoiLnNlc3Npb24udjEuR2V0RmlsZUNvbnRlbnRSZXNwb25zZSIAElAKC1NlYXJjaEZpbGVzEh4uc2Vzc2lvbi52MS5TZWFyY2hGaWxlc1JlcXVlc3QaHy5zZXNzaW9uLnYxLlNlYXJjaEZpbGVzUmVzcG9uc2UiABJoChNMaXN0UGF0aENvbXBsZXRpb25zEiYuc2Vzc2lvbi52MS5MaXN0UGF0aENvbXBsZXRpb25zUmVxdWVzdBonLnNlc3Npb24udjEuTGlzdFBhdGhDb21wbGV0aW9uc1Jlc3BvbnNlIgASZQoSR2V0U2Vzc2lvbkRlZmF1bHRzEiUuc2Vzc2lvbi52MS5HZXRTZXNzaW9uRGVmYXVsdHNSZXF1ZXN0GiYuc2Vzc2lvbi52MS5HZXRTZXNzaW9uRGVmYXVsdHNSZXNwb25zZSIAElwKD1Jlc29sdmVEZWZhdWx0cxIiLnNlc3Npb24udjEuUmVzb2x2ZURlZmF1bHRzUmVxdWVzdBojLnNlc3Npb24udjEuUmVzb2x2ZURlZmF1bHRzUmVzcG9uc2UiABJrChRVcGRhdGVHbG9iYWxEZWZhdWx0cxInLnNlc3Npb24udjEuVXBkYXRlR2xvYmFsRGVmYXVsdHNSZXF1ZXN0Giguc2Vzc2lvbi52MS5VcGRhdGVHbG9iYWxEZWZhdWx0c1Jlc3BvbnNlIgASVgoNVXBzZXJ0UHJvZmlsZRIgLnNlc3Npb24udjEuVXBzZXJ0UHJvZmlsZVJlcXVlc3QaIS5zZXNzaW9uLnYxLlVwc2VydFByb2ZpbGVSZXNwb25zZSIAElYKDURlbGV0ZVByb2ZpbGUSIC5zZXNzaW9uLnYxLkRlbGV0ZVByb2ZpbGVSZXF1ZXN0GiEuc2Vzc2lvbi52MS5EZWxldGVQcm9maWxlUmVzcG9uc2UiABJoChNVcHNlcnREaXJlY3RvcnlSdWxlEiYuc2Vzc2lvbi52MS5VcHNlcnREaXJlY3RvcnlSdWxlUmVxdWVzdBonLnNlc3Npb24udjEuVXBzZXJ0RGlyZWN0b3J5UnVsZVJlc3BvbnNlIgASaAoTRGVsZXRlRGlyZWN0b3J5UnVsZRImLnNlc3Npb24udjEuRGVsZXRlRGlyZWN0b3J5UnVsZVJlcXVlc3QaJy5zZXNzaW9uLnYxLkRlbGV0ZURpcmVjdG9yeVJ1bGVSZXNwb25zZSIAElYKDUxpc3RXb3JrdHJlZXMSIC5zZXNzaW9uLnYxLkxpc3RXb3JrdHJlZXNSZXF1ZXN0GiEuc2Vzc2lvbi52MS5MaXN0V29ya3RyZWVzUmVzcG9uc2UiABJiChFMaXN0UHJvbXB0SGlzdG9yeRIkLnNlc3Npb24udjEuTGlzdFByb21wdEhpc3RvcnlSZXF1ZXN0GiUuc2Vzc2lvbi52MS5MaXN0UHJvbXB0SGlzdG9yeVJlc3BvbnNlIgASaAoTRGVsZXRlUHJvbXB0SGlzdG9yeRImLnNlc3Npb24udjEuRGVsZXRlUHJvbXB0SGlzdG9yeVJlcXVlc3QaJy5zZXNzaW9uLnYxLkRlbGV0ZVByb21wdEhpc3RvcnlSZXNwb25zZSIAEmgKE0JhdGNoQ3JlYXRlU2Vzc2lvbnMSJi5zZXNzaW9uLnYxLkJhdGNoQ3JlYXRlU2Vzc2lvbnNSZXF1ZXN0Gicuc2Vzc2lvbi52MS5CYXRjaENyZWF0ZVNlc3Npb25zUmVzcG9uc2UiABJNCgpSdW5PbmVTaG90Eh0uc2Vzc2lvbi52MS5SdW5PbmVTaG90UmVxdWVzdBoeLnNlc3Npb24udjEuUnVuT25lU2hvdFJlc3BvbnNlIgASVgoNQ3JlYXRlUHJvamVjdBIgLnNlc3Npb24udjEuQ3JlYXRlUHJvamVjdFJlcXVlc3QaIS5zZXNzaW9uLnYxLkNyZWF0ZVByb2plY3RSZXNwb25zZSIAElMKDExpc3RQcm9qZWN0cxIfLnNlc3Npb24udjEuTGlzdFByb2plY3RzUmVxdWVzdBogLnNlc3Npb24udjEuTGlzdFByb2plY3RzUmVzcG9uc2UiABJWCg1VcGRhdGVQcm9qZWN0EiAuc2Vzc2lvbi52MS5VcGRhdGVQcm9qZWN0UmVxdWVzdBohLnNlc3Npb24udjEuVXBkYXRlUHJvamVjdFJlc3BvbnNlIgASVgoNRGVsZXRlUHJvamVjdBIgLnNlc3Npb24udjEuRGVsZXRlUHJvamVjdFJlcXVlc3QaIS5zZXNzaW9uLnYxLkRlbGV0ZVByb2plY3RSZXNwb25zZSIAEnQKF0Fzc2lnblNlc3Npb25zVG9Qcm9qZWN0Eiouc2Vzc2lvbi52MS5Bc3NpZ25TZXNzaW9uc1RvUHJvamVjdFJlcXVlc3QaKy5zZXNzaW9uLnYxLkFzc2lnblNlc3Npb25zVG9Qcm9qZWN0UmVzcG9uc2UiABJTCgxMaXN0QnJhbmNoZXMSHy5zZXNzaW9uLnYxLkxpc3RCcmFuY2hlc1JlcXVlc3QaIC5zZXNzaW9uLnYxLkxpc3RCcmFuY2hlc1Jlc3BvbnNlIgASaAoTR2V0VGVybWluYWxTbmFwc2hvdBImLnNlc3Npb24udjEuR2V0VGVybWluYWxTbmFwc2hvdFJlcXVlc3QaJy5zZXNzaW9uLnYxLkdldFRlcm1pbmFsU25hcHNob3RSZXNwb25zZSIAElwKD0xvZ0NsaWVudEV2ZW50cxIiLnNlc3Npb24udjEuTG9nQ2xpZW50RXZlbnRzUmVxdWVzdBojLnNlc3Npb24udjEuTG9nQ2xpZW50RXZlbnRzUmVzcG9uc2UiABJNCgpMaXN0RXJyb3JzEh0uc2Vzc2lvbi52MS5MaXN0RXJyb3JzUmVxdWVzdBoeLnNlc3Npb24udjEuTGlzdEVycm9yc1Jlc3BvbnNlIgASXwoQQWNrbm93bGVkZ2VFcnJvchIjLnNlc3Npb24udjEuQWNrbm93bGVkZ2VFcnJvclJlcXVlc3QaJC5zZXNzaW9uLnYxLkFja25vd2xlZGdlRXJyb3JSZXNwb25zZSIAElwKD0dldEZlYXR1cmVGbGFncxIiLnNlc3Npb24udjEuR2V0RmVhdHVyZUZsYWdzUmVxdWVzdBojLnNlc3Npb24udjEuR2V0RmVhdHVyZUZsYWdzUmVzcG9uc2UiABJiChFVcGRhdGVGZWF0dXJlRmxhZxIkLnNlc3Npb24udjEuVXBkYXRlRmVhdHVyZUZsYWdSZXF1ZXN0GiUuc2Vzc2lvbi52MS5VcGRhdGVGZWF0dXJlRmxhZ1Jlc3BvbnNlIgASawoUUXVlcnlFc2NhcGVBbmFseXRpY3MSJy5zZXNzaW9uLnYxLlF1ZXJ5RXNjYXBlQW5hbHl0aWNzUmVxdWVzdBooLnNlc3Npb24udjEuUXVlcnlFc2NhcGVBbmFseXRpY3NSZXNwb25zZSIAEnoKGUdldEVzY2FwZUFuYWx5dGljc1N1bW1hcnkSLC5zZXNzaW9uLnYxLkdldEVzY2FwZUFuYWx5dGljc1N1bW1hcnlSZXF1ZXN0Gi0uc2Vzc2lvbi52MS5HZXRFc2NhcGVBbmFseXRpY3NTdW1tYXJ5UmVzcG9uc2UiABJlChJHZXRTZXNzaW9uVGltZWxpbmUSJS5zZXNzaW9uLnYxLkdldFNlc3Npb25UaW1lbGluZVJlcXVlc3QaJi5zZXNzaW9uLnYxLkdldFNlc3Npb25UaW1lbGluZVJlc3BvbnNlIgASaAoTU2V0U2Vzc2lvblJlY29yZGluZxImLnNlc3Npb24udjEuU2V0U2Vzc2lvblJlY29yZGluZ1JlcXVlc3QaJy5zZXNzaW9uLnYxLlNldFNlc3Npb25SZWNvcmRpbmdSZXNwb25zZSIAElwKD0V4cG9ydFJlY29yZGluZxIiLnNlc3Npb24udjEuRXhwb3J0UmVjb3JkaW5nUmVxdWVzdBojLnNlc3Npb24udjEuRXhwb3J0UmVjb3JkaW5nUmVzcG9uc2UiABJfChBTZW5kU2Vzc2lvbklucHV0EiMuc2Vzc2lvbi52MS5TZW5kU2Vzc2lvbklucHV0UmVxdWVzdBokLnNlc3Npb24udjEuU2VuZFNlc3Npb25JbnB1dFJlc3BvbnNlIgASVgoNTGlzdFNjaGVkdWxlcxIgLnNlc3Npb24udjEuTGlzdFNjaGVkdWxlc1JlcXVlc3QaIS5zZXNzaW9uLnYxLkxpc3RTY2hlZHVsZXNSZXNwb25zZSIAElkKDkNyZWF0ZVNjaGVkdWxlEiEuc2Vzc2lvbi52MS5DcmVhdGVTY2hlZHVsZVJlcXVlc3QaIi5zZXNzaW9uLnYxLkNyZWF0ZVNjaGVkdWxlUmVzcG9uc2UiABJZCg5VcGRhdGVTY2hlZHVsZRIhLnNlc3Npb24udjEuVXBkYXRlU2NoZWR1bGVSZXF1ZXN0GiIuc2Vzc2lvbi52MS5VcGRhdGVTY2hlZHVsZVJlc3BvbnNlIgASWQoORGVsZXRlU2NoZWR1bGUSIS5zZXNzaW9uLnYxLkRlbGV0ZVNjaGVkdWxlUmVxdWVzdBoiLnNlc3Npb24udjEuRGVsZXRlU2NoZWR1bGVSZXNwb25zZSIAElwKD1RyaWdnZXJTY2hlZHVsZRIiLnNlc3Npb24udjEuVHJpZ2dlclNjaGVkdWxlUmVxdWVzdBojLnNlc3Npb24udjEuVHJpZ2dlclNjaGVkdWxlUmVzcG9uc2UiABJfChBMaXN0U2NoZWR1bGVSdW5zEiMuc2Vzc2lvbi52MS5MaXN0U2NoZWR1bGVSdW5zUmVxdWVzdBokLnNlc3Npb24udjEuTGlzdFNjaGVkdWxlUnVuc1Jlc3BvbnNlIgASVgoNTGlzdFRlbXBsYXRlcxIgLnNlc3Npb24udjEuTGlzdFRlbXBsYXRlc1JlcXVlc3QaIS5zZXNzaW9uLnYxLkxpc3RUZW1wbGF0ZXNSZXNwb25zZSIAElAKC0dldFRlbXBsYXRlEh4uc2Vzc2lvbi52MS5HZXRUZW1wbGF0ZVJlcXVlc3QaHy5zZXNzaW9uLnYxLkdldFRlbXBsYXRlUmVzcG9uc2UiABJTCgxTYXZlVGVtcGxhdGUSHy5zZXNzaW9uLnYxLlNhdmVUZW1wbGF0ZVJlcXVlc3QaIC5zZXNzaW9uLnYxLlNhdmVUZW1wbGF0ZVJlc3BvbnNlIgASWQoORGVsZXRlVGVtcGxhdGUSIS5zZXNzaW9uLnYxLkRlbGV0ZVRlbXBsYXRlUmVxdWVzdBoiLnNlc3Npb24udjEuRGVsZXRlVGVtcGxhdGVSZXNwb25zZSIAEnoKGUNyZWF0ZVNlc3Npb25Gcm9tVGVtcGxhdGUSLC5zZXNzaW9uLnYxLkNyZWF0ZVNlc3Npb25Gcm9tVGVtcGxhdGVSZXF1ZXN0Gi0uc2Vzc2lvbi52MS5DcmVhdGVTZXNzaW9uRnJvbVRlbXBsYXRlUmVzcG9uc2UiAEKsAQoOY29tLnNlc3Npb24udjFCDFNlc3Npb25Qcm90b1ABWkNnaXRodWIuY29tL3RzdGFwbGVyL3N0YXBsZXItc3F1YWQvZ2VuL3Byb3RvL2dvL3Nlc3Npb24vdjE7c2Vzc2lvbnYxogIDU1hYqgIKU2Vzc2lvbi5WMcoCClNlc3Npb25cVjHiAhZTZXNzaW9uXFYxXEdQQk1ldGFkYXRh6gILU2Vzc2lvbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_session_v1_types, file_session_v1_events]);
 
 /**
  * ListSessionsRequest allows filtering sessions by various criteria.
@@ -188,8 +188,9 @@ export type CreateSessionRequest = Message<"session.v1.CreateSessionRequest"> &
   existingWorktree: string;
 
   /**
-   * Optional: Resume an existing Claude conversation by ID.
-   * This ID comes from ClaudeHistoryEntry.id and will use Claude's --resume flag.
+   * Optional: Resume an existing agent conversation by ID.
+   * This ID comes from ClaudeHistoryEntry.id and is passed to the program's
+   * resume flags (claude --resume, codex resume, gemini --resume).
    *
    * @generated from field: string resume_id = 10;
    */
@@ -254,6 +255,30 @@ export type CreateSessionRequest = Message<"session.v1.CreateSessionRequest"> &
    * @generated from field: bool create_if_missing = 18;
    */
   createIfMissing: boolean;
+
+  /**
+   * Optional: History provider that recorded resume_id ("claude", "codex",
+   * "gemini"; empty means claude). When the session's program is not that
+   * provider's CLI, the provider's CLI is run instead.
+   *
+   * @generated from field: string resume_provider = 19;
+   */
+  resumeProvider: string;
+
+  /**
+   * Optional: Fork the resume_id conversation and resume the copy, leaving the
+   * original conversation untouched.
+   *
+   * @generated from field: bool fork_resume = 20;
+   */
+  forkResume: boolean;
+
+  /**
+   * Optional: Tags applied to the new session.
+   *
+   * @generated from field: repeated string tags = 21;
+   */
+  tags: string[];
 };
 
 /**
@@ -340,6 +365,20 @@ export type UpdateSessionRequest = Message<"session.v1.UpdateSessionRequest"> &
    * @generated from field: optional bool rate_limit_enabled = 8;
    */
   rateLimitEnabled?: boolean;
+
+  /**
+   * Opt in to (or out of) the PR review feedback loop for this session.
+   *
+   * @generated from field: optional bool pr_feedback_enabled = 9;
+   */
+  prFeedbackEnabled?: boolean;
+
+  /**
+   * Opt in to (or out of) automatic CI fix prompts for this session.
+   *
+   * @generated from field: optional bool ci_autofix_enabled = 10;
+   */
+  ciAutofixEnabled?: boolean;
 };
 
 /**
@@ -1094,6 +1133,13 @@ export type ListClaudeHistoryRequest = Message<"session.v1.ListClaudeHistoryRequ
    * @generated from field: string page_token = 5;
    */
   pageToken: string;
+
+  /**
+   * Optional history provider filter ("claude", "codex", "gemini").
+   *
+   * @generated from field: optional string provider = 6;
+   */
+  provider?: string;
 };
 
 /**
@@ -1180,7 +1226,7 @@ export const GetClaudeHistoryDetailResponseSchema: GenMessage<GetClaudeHistoryDe
  */
 export type ClaudeHistoryEntry = Message<"session.v1.ClaudeHistoryEntry"> & {
   /**
-   * Unique conversation identifier
+   * Unique conversation identifier, as understood by the provider's resume flag
    *
    * @generated from field: string id = 1;
    */
@@ -1236,6 +1282,13 @@ export type ClaudeHistoryEntry = Message<"session.v1.ClaudeHistoryEntry"> & {
    * @generated from field: session.v1.VCSStatus vcs_status = 8;
    */
   vcsStatus?: VCSStatus;
+
+  /**
+   * Agent CLI that recorded the conversation ("claude", "codex", "gemini").
+   *
+   * @generated from field: string provider = 9;
+   */
+  provider: string;
 };
 
 /**
@@ -1359,7 +1412,9 @@ export const ClaudeMessageSchema: GenMessage<ClaudeMessage> = /*@__PURE__*/
  */
 export type SearchClaudeHistoryRequest = Message<"session.v1.SearchClaudeHistoryRequest"> & {
   /**
-   * Search query (required). Supports natural language queries.
+   * Search query (required). Words are ANDed; also supports "phrases", OR,
+   * -exclusion, (grouping), prefix*, fuzzy~, and role:, project:, tool:,
+   * provider:, session:, after:, before: and date: filters.
    *
    * @generated from field: string query = 1;
    */
@@ -1406,6 +1461,13 @@ export type SearchClaudeHistoryRequest = Message<"session.v1.SearchClaudeHistory
    * @generated from field: int32 offset = 7;
    */
   offset: number;
+
+  /**
+   * Attach a per-term score breakdown to each result.
+   *
+   * @generated from field: bool explain = 8;
+   */
+  explain: boolean;
 };
 
 /**
@@ -1446,6 +1508,20 @@ export type SearchClaudeHistoryResponse = Message<"session.v1.SearchClaudeHistor
    * @generated from field: bool has_more = 4;
    */
   hasMore: boolean;
+
+  /**
+   * The query as parsed, showing how it was interpreted.
+   *
+   * @generated from field: string parsed_query = 5;
+   */
+  parsedQuery: string;
+
+  /**
+   * True when no message contained every query word, so results match any of them.
+   *
+   * @generated from field: bool relaxed = 6;
+   */
+  relaxed: boolean;
 };
 
 /**
@@ -1507,6 +1583,13 @@ export type SearchResult = Message<"session.v1.SearchResult"> & {
    * @generated from field: session.v1.SearchResultMetadata metadata = 7;
    */
   metadata?: SearchResultMetadata;
+
+  /**
+   * Score breakdown, set when the request asked to explain.
+   *
+   * @generated from field: session.v1.ScoreExplanation explanation = 8;
+   */
+  explanation?: ScoreExplanation;
 };
 
 /**
@@ -1613,6 +1696,13 @@ export type SearchResultMetadata = Message<"session.v1.SearchResultMetadata"> &
    * @generated from field: google.protobuf.Timestamp created_at = 4;
    */
   createdAt?: Timestamp;
+
+  /**
+   * Agent CLI that recorded the conversation ("claude", "codex", "gemini").
+   *
+   * @generated from field: string provider = 5;
+   */
+  provider: string;
 };
 
 /**
@@ -3633,6 +3723,18 @@ export type ProfileDefaultsProto = Message<"session.v1.ProfileDefaultsProto"> &
    * @generated from field: google.protobuf.Timestamp updated_at = 9;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * cgroup v2 limits applied to sessions created with this profile.
+   *
+   * @generated from field: session.v1.ResourceLimitsProto resource_limits = 10;
+   */
+  resourceLimits?: ResourceLimitsProto;
+
+  /**
+   * @generated from field: session.v1.SandboxConfigProto sandbox = 11;
+   */
+  sandbox?: SandboxConfigProto;
 };
 
 /**
@@ -4270,6 +4372,19 @@ export type BatchSessionRequest = Message<"session.v1.BatchSessionRequest"> & {
    * @generated from field: repeated string tags = 11;
    */
   tags: string[];
+
+  /**
+   * Create the session from this template (name, optionally name@version).
+   * Non-empty fields above override the template's.
+   *
+   * @generated from field: string template = 12;
+   */
+  template: string;
+
+  /**
+   * @generated from field: map<string, string> template_variables = 13;
+   */
+  templateVariables: { [key: string]: string };
 };
 
 /**
@@ -5382,184 +5497,1713 @@ export const GetEscapeAnalyticsSummaryResponseSchema: GenMessage<GetEscapeAnalyt
   messageDesc(file_session_v1_session, 180);
 
 /**
- * SessionService manages AI agent session lifecycle operations.
- * Provides CRUD operations and real-time streaming for session management.
+ * ResourceLimitsProto caps a session's cgroup. Zero fields are unlimited.
  *
- * @generated from service session.v1.SessionService
+ * @generated from message session.v1.ResourceLimitsProto
  */
-export const SessionService: GenService<{
+export type ResourceLimitsProto = Message<"session.v1.ResourceLimitsProto"> & {
   /**
-   * ListSessions returns all sessions with optional filtering.
-   *
-   * @generated from rpc session.v1.SessionService.ListSessions
+   * @generated from field: int64 memory_max_mb = 1;
    */
-  listSessions: {
-    methodKind: "unary";
-    input: typeof ListSessionsRequestSchema;
-    output: typeof ListSessionsResponseSchema;
-  },
+  memoryMaxMb: bigint;
+
   /**
-   * GetSession retrieves a specific session by ID.
-   *
-   * @generated from rpc session.v1.SessionService.GetSession
+   * @generated from field: int64 memory_high_mb = 2;
    */
-  getSession: {
-    methodKind: "unary";
-    input: typeof GetSessionRequestSchema;
-    output: typeof GetSessionResponseSchema;
-  },
+  memoryHighMb: bigint;
+
   /**
-   * CreateSession initializes a new AI agent session with tmux and git worktree.
+   * CPU quota; 100 is one full CPU.
    *
-   * @generated from rpc session.v1.SessionService.CreateSession
+   * @generated from field: int32 cpu_percent = 3;
    */
-  createSession: {
-    methodKind: "unary";
-    input: typeof CreateSessionRequestSchema;
-    output: typeof CreateSessionResponseSchema;
-  },
+  cpuPercent: number;
+
   /**
-   * UpdateSession modifies session properties (pause/resume, category, etc).
+   * @generated from field: int64 pids_max = 4;
+   */
+  pidsMax: bigint;
+
+  /**
+   * Relative IO weight, 1-10000.
    *
-   * @generated from rpc session.v1.SessionService.UpdateSession
+   * @generated from field: int32 io_weight = 5;
    */
-  updateSession: {
-    methodKind: "unary";
-    input: typeof UpdateSessionRequestSchema;
-    output: typeof UpdateSessionResponseSchema;
-  },
+  ioWeight: number;
+};
+
+/**
+ * Describes the message session.v1.ResourceLimitsProto.
+ * Use `create(ResourceLimitsProtoSchema)` to create a new message.
+ */
+export const ResourceLimitsProtoSchema: GenMessage<ResourceLimitsProto> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 181);
+
+/**
+ * SandboxConfigProto confines a session's filesystem and network access.
+ *
+ * @generated from message session.v1.SandboxConfigProto
+ */
+export type SandboxConfigProto = Message<"session.v1.SandboxConfigProto"> & {
   /**
-   * DeleteSession stops and removes a session, cleaning up resources.
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * "host" (default), "none" or "allowlist".
    *
-   * @generated from rpc session.v1.SessionService.DeleteSession
+   * @generated from field: string network = 2;
    */
-  deleteSession: {
-    methodKind: "unary";
-    input: typeof DeleteSessionRequestSchema;
-    output: typeof DeleteSessionResponseSchema;
-  },
+  network: string;
+
   /**
-   * WatchSessions streams real-time session events (created/updated/deleted).
-   * Server-streaming RPC for live updates without polling.
+   * Hosts reachable in allowlist mode: "host", "host:port" or "*.domain".
    *
-   * @generated from rpc session.v1.SessionService.WatchSessions
+   * @generated from field: repeated string allowed_hosts = 3;
    */
-  watchSessions: {
-    methodKind: "server_streaming";
-    input: typeof WatchSessionsRequestSchema;
-    output: typeof SessionEventSchema;
-  },
+  allowedHosts: string[];
+
   /**
-   * StreamTerminal provides bidirectional streaming for terminal I/O.
-   * Clients can send input and receive output from the tmux PTY.
+   * Extra paths mounted read-write.
    *
-   * @generated from rpc session.v1.SessionService.StreamTerminal
+   * @generated from field: repeated string writable_paths = 4;
    */
-  streamTerminal: {
-    methodKind: "bidi_streaming";
-    input: typeof TerminalDataSchema;
-    output: typeof TerminalDataSchema;
-  },
+  writablePaths: string[];
+};
+
+/**
+ * Describes the message session.v1.SandboxConfigProto.
+ * Use `create(SandboxConfigProtoSchema)` to create a new message.
+ */
+export const SandboxConfigProtoSchema: GenMessage<SandboxConfigProto> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 182);
+
+/**
+ * @generated from message session.v1.GetSessionTimelineRequest
+ */
+export type GetSessionTimelineRequest = Message<"session.v1.GetSessionTimelineRequest"> & {
   /**
-   * GetSessionDiff retrieves the current git diff for a session.
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * Only return turns with index >= since_turn, for incremental polling.
    *
-   * @generated from rpc session.v1.SessionService.GetSessionDiff
+   * @generated from field: int32 since_turn = 2;
    */
-  getSessionDiff: {
-    methodKind: "unary";
-    input: typeof GetSessionDiffRequestSchema;
-    output: typeof GetSessionDiffResponseSchema;
-  },
+  sinceTurn: number;
+
   /**
-   * GetVCSStatus retrieves the current version control status for a session.
-   * Returns branch info, changed files, staged/unstaged status, and remote sync state.
+   * Maximum number of turns to return, keeping the most recent. 0 = all.
    *
-   * @generated from rpc session.v1.SessionService.GetVCSStatus
+   * @generated from field: int32 limit = 3;
    */
-  getVCSStatus: {
-    methodKind: "unary";
-    input: typeof GetVCSStatusRequestSchema;
-    output: typeof GetVCSStatusResponseSchema;
-  },
+  limit: number;
+};
+
+/**
+ * Describes the message session.v1.GetSessionTimelineRequest.
+ * Use `create(GetSessionTimelineRequestSchema)` to create a new message.
+ */
+export const GetSessionTimelineRequestSchema: GenMessage<GetSessionTimelineRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 183);
+
+/**
+ * @generated from message session.v1.GetSessionTimelineResponse
+ */
+export type GetSessionTimelineResponse = Message<"session.v1.GetSessionTimelineResponse"> & {
   /**
-   * GetReviewQueue returns sessions needing user attention with priority ordering.
+   * @generated from field: repeated session.v1.TurnDigest turns = 1;
+   */
+  turns: TurnDigest[];
+
+  /**
+   * Number of turns in the whole conversation, before since_turn and limit.
    *
-   * @generated from rpc session.v1.SessionService.GetReviewQueue
+   * @generated from field: int32 total_turns = 2;
    */
-  getReviewQueue: {
-    methodKind: "unary";
-    input: typeof GetReviewQueueRequestSchema;
-    output: typeof GetReviewQueueResponseSchema;
-  },
+  totalTurns: number;
+
   /**
-   * AcknowledgeSession marks a session as acknowledged in the review queue.
-   * The session won't reappear in the queue until it receives an update.
+   * Conversation file the digests were extracted from.
    *
-   * @generated from rpc session.v1.SessionService.AcknowledgeSession
+   * @generated from field: string conversation_path = 3;
    */
-  acknowledgeSession: {
-    methodKind: "unary";
-    input: typeof AcknowledgeSessionRequestSchema;
-    output: typeof AcknowledgeSessionResponseSchema;
-  },
+  conversationPath: string;
+};
+
+/**
+ * Describes the message session.v1.GetSessionTimelineResponse.
+ * Use `create(GetSessionTimelineResponseSchema)` to create a new message.
+ */
+export const GetSessionTimelineResponseSchema: GenMessage<GetSessionTimelineResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 184);
+
+/**
+ * TurnDigest summarises one conversation turn: a user prompt and the assistant
+ * work that followed it, up to the next prompt.
+ *
+ * @generated from message session.v1.TurnDigest
+ */
+export type TurnDigest = Message<"session.v1.TurnDigest"> & {
   /**
-   * GetLogs retrieves application logs with optional filtering and search.
+   * Zero-based position of the turn in the conversation.
    *
-   * @generated from rpc session.v1.SessionService.GetLogs
+   * @generated from field: int32 index = 1;
    */
-  getLogs: {
-    methodKind: "unary";
-    input: typeof GetLogsRequestSchema;
-    output: typeof GetLogsResponseSchema;
-  },
+  index: number;
+
   /**
-   * WatchReviewQueue streams real-time review queue events (items added/removed/updated).
-   * Server-streaming RPC for live queue updates without polling.
+   * @generated from field: google.protobuf.Timestamp started_at = 2;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ended_at = 3;
+   */
+  endedAt?: Timestamp;
+
+  /**
+   * First line of the prompt; empty for work before the first prompt.
    *
-   * @generated from rpc session.v1.SessionService.WatchReviewQueue
+   * @generated from field: string prompt = 4;
    */
-  watchReviewQueue: {
-    methodKind: "server_streaming";
-    input: typeof WatchReviewQueueRequestSchema;
-    output: typeof ReviewQueueEventSchema;
-  },
+  prompt: string;
+
   /**
-   * LogUserInteraction logs a user interaction event for audit trail.
-   * Records user actions for compliance, debugging, and analytics.
+   * Tools called, in order of first use.
    *
-   * @generated from rpc session.v1.SessionService.LogUserInteraction
+   * @generated from field: repeated session.v1.TurnToolCount tools = 5;
    */
-  logUserInteraction: {
-    methodKind: "unary";
-    input: typeof LogUserInteractionRequestSchema;
-    output: typeof LogUserInteractionResponseSchema;
-  },
+  tools: TurnToolCount[];
+
   /**
-   * GetClaudeConfig retrieves a Claude configuration file by name (CLAUDE.md, settings.json, agents.md).
+   * Files modified by Edit, MultiEdit, Write and NotebookEdit calls.
    *
-   * @generated from rpc session.v1.SessionService.GetClaudeConfig
+   * @generated from field: repeated string files_touched = 6;
    */
-  getClaudeConfig: {
-    methodKind: "unary";
-    input: typeof GetClaudeConfigRequestSchema;
-    output: typeof GetClaudeConfigResponseSchema;
-  },
+  filesTouched: string[];
+
   /**
-   * ListClaudeConfigs returns all configuration files in the ~/.claude directory.
+   * First line of each Bash command (at most 25).
    *
-   * @generated from rpc session.v1.SessionService.ListClaudeConfigs
+   * @generated from field: repeated string commands = 7;
    */
-  listClaudeConfigs: {
-    methodKind: "unary";
-    input: typeof ListClaudeConfigsRequestSchema;
-    output: typeof ListClaudeConfigsResponseSchema;
-  },
+  commands: string[];
+
   /**
-   * UpdateClaudeConfig updates a Claude configuration file with atomic write and backup.
+   * Failed tool calls as "Tool: first line of the result" (at most 25).
    *
-   * @generated from rpc session.v1.SessionService.UpdateClaudeConfig
+   * @generated from field: repeated string errors = 8;
    */
-  updateClaudeConfig: {
+  errors: string[];
+
+  /**
+   * First line of the turn's last assistant text.
+   *
+   * @generated from field: string outcome = 9;
+   */
+  outcome: string;
+
+  /**
+   * One-sentence rendering of the fields above.
+   *
+   * @generated from field: string summary = 10;
+   */
+  summary: string;
+};
+
+/**
+ * Describes the message session.v1.TurnDigest.
+ * Use `create(TurnDigestSchema)` to create a new message.
+ */
+export const TurnDigestSchema: GenMessage<TurnDigest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 185);
+
+/**
+ * @generated from message session.v1.TurnToolCount
+ */
+export type TurnToolCount = Message<"session.v1.TurnToolCount"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message session.v1.TurnToolCount.
+ * Use `create(TurnToolCountSchema)` to create a new message.
+ */
+export const TurnToolCountSchema: GenMessage<TurnToolCount> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 186);
+
+/**
+ * ScoreExplanation breaks a result's score into per-term BM25 contributions.
+ *
+ * @generated from message session.v1.ScoreExplanation
+ */
+export type ScoreExplanation = Message<"session.v1.ScoreExplanation"> & {
+  /**
+   * Sum of each term's score times its weight.
+   *
+   * @generated from field: double total_score = 1;
+   */
+  totalScore: number;
+
+  /**
+   * BM25 term frequency saturation parameter.
+   *
+   * @generated from field: double k1 = 2;
+   */
+  k1: number;
+
+  /**
+   * BM25 length normalization parameter.
+   *
+   * @generated from field: double b = 3;
+   */
+  b: number;
+
+  /**
+   * @generated from field: repeated session.v1.TermScoreExplanation terms = 4;
+   */
+  terms: TermScoreExplanation[];
+};
+
+/**
+ * Describes the message session.v1.ScoreExplanation.
+ * Use `create(ScoreExplanationSchema)` to create a new message.
+ */
+export const ScoreExplanationSchema: GenMessage<ScoreExplanation> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 187);
+
+/**
+ * @generated from message session.v1.TermScoreExplanation
+ */
+export type TermScoreExplanation = Message<"session.v1.TermScoreExplanation"> & {
+  /**
+   * Index term (stemmed).
+   *
+   * @generated from field: string term = 1;
+   */
+  term: string;
+
+  /**
+   * Query clause the term matched for, e.g. "go test" or auth*.
+   *
+   * @generated from field: string clause = 2;
+   */
+  clause: string;
+
+  /**
+   * Multiplier applied to the BM25 score (phrase > exact > prefix > fuzzy).
+   *
+   * @generated from field: double weight = 3;
+   */
+  weight: number;
+
+  /**
+   * @generated from field: double term_frequency = 4;
+   */
+  termFrequency: number;
+
+  /**
+   * @generated from field: int32 document_frequency = 5;
+   */
+  documentFrequency: number;
+
+  /**
+   * @generated from field: double idf = 6;
+   */
+  idf: number;
+
+  /**
+   * Unweighted BM25 score of the term.
+   *
+   * @generated from field: double score = 7;
+   */
+  score: number;
+
+  /**
+   * @generated from field: int32 document_length = 8;
+   */
+  documentLength: number;
+
+  /**
+   * @generated from field: double avg_document_length = 9;
+   */
+  avgDocumentLength: number;
+};
+
+/**
+ * Describes the message session.v1.TermScoreExplanation.
+ * Use `create(TermScoreExplanationSchema)` to create a new message.
+ */
+export const TermScoreExplanationSchema: GenMessage<TermScoreExplanation> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 188);
+
+/**
+ * @generated from message session.v1.SetSessionRecordingRequest
+ */
+export type SetSessionRecordingRequest = Message<"session.v1.SetSessionRecordingRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * Also record input sent to the terminal. Input is redacted line by line.
+   *
+   * @generated from field: bool include_input = 3;
+   */
+  includeInput: boolean;
+};
+
+/**
+ * Describes the message session.v1.SetSessionRecordingRequest.
+ * Use `create(SetSessionRecordingRequestSchema)` to create a new message.
+ */
+export const SetSessionRecordingRequestSchema: GenMessage<SetSessionRecordingRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 189);
+
+/**
+ * @generated from message session.v1.SetSessionRecordingResponse
+ */
+export type SetSessionRecordingResponse = Message<"session.v1.SetSessionRecordingResponse"> & {
+  /**
+   * @generated from field: session.v1.SessionRecordingSettings settings = 1;
+   */
+  settings?: SessionRecordingSettings;
+};
+
+/**
+ * Describes the message session.v1.SetSessionRecordingResponse.
+ * Use `create(SetSessionRecordingResponseSchema)` to create a new message.
+ */
+export const SetSessionRecordingResponseSchema: GenMessage<SetSessionRecordingResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 190);
+
+/**
+ * @generated from message session.v1.SessionRecordingSettings
+ */
+export type SessionRecordingSettings = Message<"session.v1.SessionRecordingSettings"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: bool include_input = 2;
+   */
+  includeInput: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 3;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Whether a recording segment is currently being written.
+   *
+   * @generated from field: bool active = 4;
+   */
+  active: boolean;
+};
+
+/**
+ * Describes the message session.v1.SessionRecordingSettings.
+ * Use `create(SessionRecordingSettingsSchema)` to create a new message.
+ */
+export const SessionRecordingSettingsSchema: GenMessage<SessionRecordingSettings> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 191);
+
+/**
+ * @generated from message session.v1.ExportRecordingRequest
+ */
+export type ExportRecordingRequest = Message<"session.v1.ExportRecordingRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * Start the export at this checkpoint (ID or label). Empty = recording start.
+   *
+   * @generated from field: string from_checkpoint = 2;
+   */
+  fromCheckpoint: string;
+
+  /**
+   * End the export at this checkpoint (ID or label). Empty = now.
+   *
+   * @generated from field: string to_checkpoint = 3;
+   */
+  toCheckpoint: string;
+
+  /**
+   * Include recorded input events. Off by default since input may contain
+   * secrets the redactor does not recognise.
+   *
+   * @generated from field: bool include_input = 4;
+   */
+  includeInput: boolean;
+
+  /**
+   * Cap pauses during playback at this many seconds. 0 = keep pauses.
+   *
+   * @generated from field: double idle_time_limit = 5;
+   */
+  idleTimeLimit: number;
+};
+
+/**
+ * Describes the message session.v1.ExportRecordingRequest.
+ * Use `create(ExportRecordingRequestSchema)` to create a new message.
+ */
+export const ExportRecordingRequestSchema: GenMessage<ExportRecordingRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 192);
+
+/**
+ * @generated from message session.v1.ExportRecordingResponse
+ */
+export type ExportRecordingResponse = Message<"session.v1.ExportRecordingResponse"> & {
+  /**
+   * The asciicast v2 file.
+   *
+   * @generated from field: bytes cast = 1;
+   */
+  cast: Uint8Array;
+
+  /**
+   * @generated from field: int32 event_count = 2;
+   */
+  eventCount: number;
+
+  /**
+   * @generated from field: double duration_seconds = 3;
+   */
+  durationSeconds: number;
+};
+
+/**
+ * Describes the message session.v1.ExportRecordingResponse.
+ * Use `create(ExportRecordingResponseSchema)` to create a new message.
+ */
+export const ExportRecordingResponseSchema: GenMessage<ExportRecordingResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 193);
+
+/**
+ * @generated from message session.v1.SendSessionInputRequest
+ */
+export type SendSessionInputRequest = Message<"session.v1.SendSessionInputRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * Text to type. Sent as-is; control characters such as "\x03" are allowed.
+   *
+   * @generated from field: string text = 2;
+   */
+  text: string;
+
+  /**
+   * Press Enter after the text, submitting it as a prompt.
+   *
+   * @generated from field: bool submit = 3;
+   */
+  submit: boolean;
+};
+
+/**
+ * Describes the message session.v1.SendSessionInputRequest.
+ * Use `create(SendSessionInputRequestSchema)` to create a new message.
+ */
+export const SendSessionInputRequestSchema: GenMessage<SendSessionInputRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 194);
+
+/**
+ * @generated from message session.v1.SendSessionInputResponse
+ */
+export type SendSessionInputResponse = Message<"session.v1.SendSessionInputResponse"> & {
+  /**
+   * @generated from field: int32 bytes_written = 1;
+   */
+  bytesWritten: number;
+};
+
+/**
+ * Describes the message session.v1.SendSessionInputResponse.
+ * Use `create(SendSessionInputResponseSchema)` to create a new message.
+ */
+export const SendSessionInputResponseSchema: GenMessage<SendSessionInputResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 195);
+
+/**
+ * @generated from message session.v1.SessionSchedule
+ */
+export type SessionSchedule = Message<"session.v1.SessionSchedule"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Five-field cron expression or a descriptor such as "@daily".
+   *
+   * @generated from field: string cron = 3;
+   */
+  cron: string;
+
+  /**
+   * IANA timezone the expression is evaluated in. Empty = server local time.
+   *
+   * @generated from field: string timezone = 4;
+   */
+  timezone: string;
+
+  /**
+   * @generated from field: bool enabled = 5;
+   */
+  enabled: boolean;
+
+  /**
+   * "session" (default) creates a session per firing; "one_shot" runs the
+   * prompt non-interactively in target_session.
+   *
+   * @generated from field: string kind = 6;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string prompt = 7;
+   */
+  prompt: string;
+
+  /**
+   * Session schedules: profile, repository and optional per-run branch.
+   *
+   * @generated from field: string profile = 8;
+   */
+  profile: string;
+
+  /**
+   * @generated from field: string repo_path = 9;
+   */
+  repoPath: string;
+
+  /**
+   * Each run gets a worktree on branch_prefix plus the firing time.
+   *
+   * @generated from field: string branch_prefix = 10;
+   */
+  branchPrefix: string;
+
+  /**
+   * @generated from field: string program = 11;
+   */
+  program: string;
+
+  /**
+   * @generated from field: string category = 12;
+   */
+  category: string;
+
+  /**
+   * One-shot schedules.
+   *
+   * @generated from field: string target_session = 13;
+   */
+  targetSession: string;
+
+  /**
+   * @generated from field: int32 timeout_seconds = 14;
+   */
+  timeoutSeconds: number;
+
+  /**
+   * What to do when a firing finds the previous run still going: "skip"
+   * (default), "queue" or "replace".
+   *
+   * @generated from field: string overlap = 15;
+   */
+  overlap: string;
+
+  /**
+   * Which firings missed while the server was down to run on startup:
+   * "none", "latest" (default) or "all".
+   *
+   * @generated from field: string catch_up = 16;
+   */
+  catchUp: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 17;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 18;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Output only. Unset when the schedule is disabled.
+   *
+   * @generated from field: google.protobuf.Timestamp next_run_at = 19;
+   */
+  nextRunAt?: Timestamp;
+
+  /**
+   * Output only.
+   *
+   * @generated from field: session.v1.ScheduleRun last_run = 20;
+   */
+  lastRun?: ScheduleRun;
+};
+
+/**
+ * Describes the message session.v1.SessionSchedule.
+ * Use `create(SessionScheduleSchema)` to create a new message.
+ */
+export const SessionScheduleSchema: GenMessage<SessionSchedule> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 196);
+
+/**
+ * @generated from message session.v1.ScheduleRun
+ */
+export type ScheduleRun = Message<"session.v1.ScheduleRun"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string schedule_id = 2;
+   */
+  scheduleId: string;
+
+  /**
+   * "schedule", "catch_up" or "manual".
+   *
+   * @generated from field: string trigger = 3;
+   */
+  trigger: string;
+
+  /**
+   * "queued", "running", "succeeded", "failed", "skipped" or "replaced".
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_for = 5;
+   */
+  scheduledFor?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 6;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 7;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * Title of the session the run spawned, or ran a one-shot in.
+   *
+   * @generated from field: string session_id = 8;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string session_uuid = 9;
+   */
+  sessionUuid: string;
+
+  /**
+   * @generated from field: string error = 10;
+   */
+  error: string;
+
+  /**
+   * @generated from field: int32 exit_code = 11;
+   */
+  exitCode: number;
+
+  /**
+   * @generated from field: string pr_url = 12;
+   */
+  prUrl: string;
+
+  /**
+   * Tail of a one-shot's output.
+   *
+   * @generated from field: string output = 13;
+   */
+  output: string;
+};
+
+/**
+ * Describes the message session.v1.ScheduleRun.
+ * Use `create(ScheduleRunSchema)` to create a new message.
+ */
+export const ScheduleRunSchema: GenMessage<ScheduleRun> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 197);
+
+/**
+ * @generated from message session.v1.ListSchedulesRequest
+ */
+export type ListSchedulesRequest = Message<"session.v1.ListSchedulesRequest"> & {
+};
+
+/**
+ * Describes the message session.v1.ListSchedulesRequest.
+ * Use `create(ListSchedulesRequestSchema)` to create a new message.
+ */
+export const ListSchedulesRequestSchema: GenMessage<ListSchedulesRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 198);
+
+/**
+ * @generated from message session.v1.ListSchedulesResponse
+ */
+export type ListSchedulesResponse = Message<"session.v1.ListSchedulesResponse"> & {
+  /**
+   * @generated from field: repeated session.v1.SessionSchedule schedules = 1;
+   */
+  schedules: SessionSchedule[];
+};
+
+/**
+ * Describes the message session.v1.ListSchedulesResponse.
+ * Use `create(ListSchedulesResponseSchema)` to create a new message.
+ */
+export const ListSchedulesResponseSchema: GenMessage<ListSchedulesResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 199);
+
+/**
+ * @generated from message session.v1.CreateScheduleRequest
+ */
+export type CreateScheduleRequest = Message<"session.v1.CreateScheduleRequest"> & {
+  /**
+   * id and output-only fields are ignored.
+   *
+   * @generated from field: session.v1.SessionSchedule schedule = 1;
+   */
+  schedule?: SessionSchedule;
+};
+
+/**
+ * Describes the message session.v1.CreateScheduleRequest.
+ * Use `create(CreateScheduleRequestSchema)` to create a new message.
+ */
+export const CreateScheduleRequestSchema: GenMessage<CreateScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 200);
+
+/**
+ * @generated from message session.v1.CreateScheduleResponse
+ */
+export type CreateScheduleResponse = Message<"session.v1.CreateScheduleResponse"> & {
+  /**
+   * @generated from field: session.v1.SessionSchedule schedule = 1;
+   */
+  schedule?: SessionSchedule;
+};
+
+/**
+ * Describes the message session.v1.CreateScheduleResponse.
+ * Use `create(CreateScheduleResponseSchema)` to create a new message.
+ */
+export const CreateScheduleResponseSchema: GenMessage<CreateScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 201);
+
+/**
+ * @generated from message session.v1.UpdateScheduleRequest
+ */
+export type UpdateScheduleRequest = Message<"session.v1.UpdateScheduleRequest"> & {
+  /**
+   * Schedule ID or name.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string cron = 3;
+   */
+  cron?: string;
+
+  /**
+   * @generated from field: optional string timezone = 4;
+   */
+  timezone?: string;
+
+  /**
+   * @generated from field: optional bool enabled = 5;
+   */
+  enabled?: boolean;
+
+  /**
+   * @generated from field: optional string kind = 6;
+   */
+  kind?: string;
+
+  /**
+   * @generated from field: optional string prompt = 7;
+   */
+  prompt?: string;
+
+  /**
+   * @generated from field: optional string profile = 8;
+   */
+  profile?: string;
+
+  /**
+   * @generated from field: optional string repo_path = 9;
+   */
+  repoPath?: string;
+
+  /**
+   * @generated from field: optional string branch_prefix = 10;
+   */
+  branchPrefix?: string;
+
+  /**
+   * @generated from field: optional string program = 11;
+   */
+  program?: string;
+
+  /**
+   * @generated from field: optional string category = 12;
+   */
+  category?: string;
+
+  /**
+   * @generated from field: optional string target_session = 13;
+   */
+  targetSession?: string;
+
+  /**
+   * @generated from field: optional int32 timeout_seconds = 14;
+   */
+  timeoutSeconds?: number;
+
+  /**
+   * @generated from field: optional string overlap = 15;
+   */
+  overlap?: string;
+
+  /**
+   * @generated from field: optional string catch_up = 16;
+   */
+  catchUp?: string;
+};
+
+/**
+ * Describes the message session.v1.UpdateScheduleRequest.
+ * Use `create(UpdateScheduleRequestSchema)` to create a new message.
+ */
+export const UpdateScheduleRequestSchema: GenMessage<UpdateScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 202);
+
+/**
+ * @generated from message session.v1.UpdateScheduleResponse
+ */
+export type UpdateScheduleResponse = Message<"session.v1.UpdateScheduleResponse"> & {
+  /**
+   * @generated from field: session.v1.SessionSchedule schedule = 1;
+   */
+  schedule?: SessionSchedule;
+};
+
+/**
+ * Describes the message session.v1.UpdateScheduleResponse.
+ * Use `create(UpdateScheduleResponseSchema)` to create a new message.
+ */
+export const UpdateScheduleResponseSchema: GenMessage<UpdateScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 203);
+
+/**
+ * @generated from message session.v1.DeleteScheduleRequest
+ */
+export type DeleteScheduleRequest = Message<"session.v1.DeleteScheduleRequest"> & {
+  /**
+   * Schedule ID or name.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message session.v1.DeleteScheduleRequest.
+ * Use `create(DeleteScheduleRequestSchema)` to create a new message.
+ */
+export const DeleteScheduleRequestSchema: GenMessage<DeleteScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 204);
+
+/**
+ * @generated from message session.v1.DeleteScheduleResponse
+ */
+export type DeleteScheduleResponse = Message<"session.v1.DeleteScheduleResponse"> & {
+};
+
+/**
+ * Describes the message session.v1.DeleteScheduleResponse.
+ * Use `create(DeleteScheduleResponseSchema)` to create a new message.
+ */
+export const DeleteScheduleResponseSchema: GenMessage<DeleteScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 205);
+
+/**
+ * @generated from message session.v1.TriggerScheduleRequest
+ */
+export type TriggerScheduleRequest = Message<"session.v1.TriggerScheduleRequest"> & {
+  /**
+   * Schedule ID or name.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message session.v1.TriggerScheduleRequest.
+ * Use `create(TriggerScheduleRequestSchema)` to create a new message.
+ */
+export const TriggerScheduleRequestSchema: GenMessage<TriggerScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 206);
+
+/**
+ * @generated from message session.v1.TriggerScheduleResponse
+ */
+export type TriggerScheduleResponse = Message<"session.v1.TriggerScheduleResponse"> & {
+  /**
+   * @generated from field: session.v1.ScheduleRun run = 1;
+   */
+  run?: ScheduleRun;
+};
+
+/**
+ * Describes the message session.v1.TriggerScheduleResponse.
+ * Use `create(TriggerScheduleResponseSchema)` to create a new message.
+ */
+export const TriggerScheduleResponseSchema: GenMessage<TriggerScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 207);
+
+/**
+ * @generated from message session.v1.ListScheduleRunsRequest
+ */
+export type ListScheduleRunsRequest = Message<"session.v1.ListScheduleRunsRequest"> & {
+  /**
+   * Schedule ID or name. Empty = runs of every schedule.
+   *
+   * @generated from field: string schedule_id = 1;
+   */
+  scheduleId: string;
+
+  /**
+   * Max runs returned (default 20, max 100).
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message session.v1.ListScheduleRunsRequest.
+ * Use `create(ListScheduleRunsRequestSchema)` to create a new message.
+ */
+export const ListScheduleRunsRequestSchema: GenMessage<ListScheduleRunsRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 208);
+
+/**
+ * @generated from message session.v1.ListScheduleRunsResponse
+ */
+export type ListScheduleRunsResponse = Message<"session.v1.ListScheduleRunsResponse"> & {
+  /**
+   * @generated from field: repeated session.v1.ScheduleRun runs = 1;
+   */
+  runs: ScheduleRun[];
+};
+
+/**
+ * Describes the message session.v1.ListScheduleRunsResponse.
+ * Use `create(ListScheduleRunsResponseSchema)` to create a new message.
+ */
+export const ListScheduleRunsResponseSchema: GenMessage<ListScheduleRunsResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 209);
+
+/**
+ * @generated from message session.v1.TemplateVariable
+ */
+export type TemplateVariable = Message<"session.v1.TemplateVariable"> & {
+  /**
+   * Referenced as {{name}} in the prompt and branch pattern.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * "string" (default), "url", "int", "bool" or "enum".
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: bool required = 4;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: string default_value = 5;
+   */
+  defaultValue: string;
+
+  /**
+   * Accepted values of an enum variable.
+   *
+   * @generated from field: repeated string options = 6;
+   */
+  options: string[];
+};
+
+/**
+ * Describes the message session.v1.TemplateVariable.
+ * Use `create(TemplateVariableSchema)` to create a new message.
+ */
+export const TemplateVariableSchema: GenMessage<TemplateVariable> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 210);
+
+/**
+ * @generated from message session.v1.SessionTemplate
+ */
+export type SessionTemplate = Message<"session.v1.SessionTemplate"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Output only on save: personal templates count up from 1 per name.
+   *
+   * @generated from field: int32 version = 2;
+   */
+  version: number;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string program = 4;
+   */
+  program: string;
+
+  /**
+   * Named session_defaults profile applied first.
+   *
+   * @generated from field: string profile = 5;
+   */
+  profile: string;
+
+  /**
+   * "directory" or "new_worktree"; empty infers from branch_pattern.
+   *
+   * @generated from field: string session_type = 6;
+   */
+  sessionType: string;
+
+  /**
+   * Branch name with {{variables}}, e.g. "fix/{{module}}-{{date}}". The
+   * built-ins {{date}} and {{repo}} are always available.
+   *
+   * @generated from field: string branch_pattern = 7;
+   */
+  branchPattern: string;
+
+  /**
+   * @generated from field: repeated string tags = 8;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: string prompt = 9;
+   */
+  prompt: string;
+
+  /**
+   * @generated from field: repeated session.v1.TemplateVariable variables = 10;
+   */
+  variables: TemplateVariable[];
+
+  /**
+   * Runs with sh in the new session's working directory, inside its sandbox
+   * if it has one, before the agent starts. Variable values are passed as
+   * SSQ_VAR_<NAME> environment variables.
+   *
+   * @generated from field: string setup_script = 11;
+   */
+  setupScript: string;
+
+  /**
+   * Appended to the prompt as a checklist.
+   *
+   * @generated from field: repeated string acceptance_criteria = 12;
+   */
+  acceptanceCriteria: string[];
+
+  /**
+   * Output only: "user" or "repo".
+   *
+   * @generated from field: string source = 13;
+   */
+  source: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 14;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 15;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message session.v1.SessionTemplate.
+ * Use `create(SessionTemplateSchema)` to create a new message.
+ */
+export const SessionTemplateSchema: GenMessage<SessionTemplate> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 211);
+
+/**
+ * @generated from message session.v1.ListTemplatesRequest
+ */
+export type ListTemplatesRequest = Message<"session.v1.ListTemplatesRequest"> & {
+  /**
+   * Include the shared templates of this repository.
+   *
+   * @generated from field: string repo_path = 1;
+   */
+  repoPath: string;
+};
+
+/**
+ * Describes the message session.v1.ListTemplatesRequest.
+ * Use `create(ListTemplatesRequestSchema)` to create a new message.
+ */
+export const ListTemplatesRequestSchema: GenMessage<ListTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 212);
+
+/**
+ * @generated from message session.v1.ListTemplatesResponse
+ */
+export type ListTemplatesResponse = Message<"session.v1.ListTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated session.v1.SessionTemplate templates = 1;
+   */
+  templates: SessionTemplate[];
+
+  /**
+   * Shared template files that could not be loaded.
+   *
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[];
+};
+
+/**
+ * Describes the message session.v1.ListTemplatesResponse.
+ * Use `create(ListTemplatesResponseSchema)` to create a new message.
+ */
+export const ListTemplatesResponseSchema: GenMessage<ListTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 213);
+
+/**
+ * @generated from message session.v1.GetTemplateRequest
+ */
+export type GetTemplateRequest = Message<"session.v1.GetTemplateRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * 0 = latest.
+   *
+   * @generated from field: int32 version = 2;
+   */
+  version: number;
+
+  /**
+   * @generated from field: string repo_path = 3;
+   */
+  repoPath: string;
+};
+
+/**
+ * Describes the message session.v1.GetTemplateRequest.
+ * Use `create(GetTemplateRequestSchema)` to create a new message.
+ */
+export const GetTemplateRequestSchema: GenMessage<GetTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 214);
+
+/**
+ * @generated from message session.v1.GetTemplateResponse
+ */
+export type GetTemplateResponse = Message<"session.v1.GetTemplateResponse"> & {
+  /**
+   * @generated from field: session.v1.SessionTemplate template = 1;
+   */
+  template?: SessionTemplate;
+
+  /**
+   * Stored versions of a personal template, newest first.
+   *
+   * @generated from field: repeated int32 versions = 2;
+   */
+  versions: number[];
+};
+
+/**
+ * Describes the message session.v1.GetTemplateResponse.
+ * Use `create(GetTemplateResponseSchema)` to create a new message.
+ */
+export const GetTemplateResponseSchema: GenMessage<GetTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 215);
+
+/**
+ * @generated from message session.v1.SaveTemplateRequest
+ */
+export type SaveTemplateRequest = Message<"session.v1.SaveTemplateRequest"> & {
+  /**
+   * @generated from field: session.v1.SessionTemplate template = 1;
+   */
+  template?: SessionTemplate;
+
+  /**
+   * Write the template to repo_path's templates directory instead of the
+   * personal store.
+   *
+   * @generated from field: bool shared = 2;
+   */
+  shared: boolean;
+
+  /**
+   * @generated from field: string repo_path = 3;
+   */
+  repoPath: string;
+};
+
+/**
+ * Describes the message session.v1.SaveTemplateRequest.
+ * Use `create(SaveTemplateRequestSchema)` to create a new message.
+ */
+export const SaveTemplateRequestSchema: GenMessage<SaveTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 216);
+
+/**
+ * @generated from message session.v1.SaveTemplateResponse
+ */
+export type SaveTemplateResponse = Message<"session.v1.SaveTemplateResponse"> & {
+  /**
+   * @generated from field: session.v1.SessionTemplate template = 1;
+   */
+  template?: SessionTemplate;
+};
+
+/**
+ * Describes the message session.v1.SaveTemplateResponse.
+ * Use `create(SaveTemplateResponseSchema)` to create a new message.
+ */
+export const SaveTemplateResponseSchema: GenMessage<SaveTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 217);
+
+/**
+ * @generated from message session.v1.DeleteTemplateRequest
+ */
+export type DeleteTemplateRequest = Message<"session.v1.DeleteTemplateRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message session.v1.DeleteTemplateRequest.
+ * Use `create(DeleteTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteTemplateRequestSchema: GenMessage<DeleteTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 218);
+
+/**
+ * @generated from message session.v1.DeleteTemplateResponse
+ */
+export type DeleteTemplateResponse = Message<"session.v1.DeleteTemplateResponse"> & {
+};
+
+/**
+ * Describes the message session.v1.DeleteTemplateResponse.
+ * Use `create(DeleteTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteTemplateResponseSchema: GenMessage<DeleteTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 219);
+
+/**
+ * @generated from message session.v1.CreateSessionFromTemplateRequest
+ */
+export type CreateSessionFromTemplateRequest = Message<"session.v1.CreateSessionFromTemplateRequest"> & {
+  /**
+   * Template name, optionally name@version.
+   *
+   * @generated from field: string template = 1;
+   */
+  template: string;
+
+  /**
+   * @generated from field: string repo_path = 2;
+   */
+  repoPath: string;
+
+  /**
+   * Default: the template name plus a timestamp.
+   *
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: map<string, string> variables = 4;
+   */
+  variables: { [key: string]: string };
+
+  /**
+   * Optional overrides of the template's fields.
+   *
+   * @generated from field: string branch = 5;
+   */
+  branch: string;
+
+  /**
+   * @generated from field: string program = 6;
+   */
+  program: string;
+
+  /**
+   * @generated from field: string category = 7;
+   */
+  category: string;
+
+  /**
+   * @generated from field: repeated string tags = 8;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: bool auto_yes = 9;
+   */
+  autoYes: boolean;
+
+  /**
+   * Trust the shared template's setup script, as it is now, in this
+   * repository. Untrusted shared setup scripts fail the creation.
+   *
+   * @generated from field: bool trust_setup_script = 10;
+   */
+  trustSetupScript: boolean;
+};
+
+/**
+ * Describes the message session.v1.CreateSessionFromTemplateRequest.
+ * Use `create(CreateSessionFromTemplateRequestSchema)` to create a new message.
+ */
+export const CreateSessionFromTemplateRequestSchema: GenMessage<CreateSessionFromTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 220);
+
+/**
+ * @generated from message session.v1.CreateSessionFromTemplateResponse
+ */
+export type CreateSessionFromTemplateResponse = Message<"session.v1.CreateSessionFromTemplateResponse"> & {
+  /**
+   * @generated from field: session.v1.Session session = 1;
+   */
+  session?: Session;
+
+  /**
+   * The template version that was used.
+   *
+   * @generated from field: session.v1.SessionTemplate template = 2;
+   */
+  template?: SessionTemplate;
+
+  /**
+   * Tail of the setup script's output.
+   *
+   * @generated from field: string setup_output = 3;
+   */
+  setupOutput: string;
+};
+
+/**
+ * Describes the message session.v1.CreateSessionFromTemplateResponse.
+ * Use `create(CreateSessionFromTemplateResponseSchema)` to create a new message.
+ */
+export const CreateSessionFromTemplateResponseSchema: GenMessage<CreateSessionFromTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 221);
+
+/**
+ * @generated from message session.v1.SendCIFixPromptRequest
+ */
+export type SendCIFixPromptRequest = Message<"session.v1.SendCIFixPromptRequest"> & {
+  /**
+   * Session identifier
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message session.v1.SendCIFixPromptRequest.
+ * Use `create(SendCIFixPromptRequestSchema)` to create a new message.
+ */
+export const SendCIFixPromptRequestSchema: GenMessage<SendCIFixPromptRequest> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 222);
+
+/**
+ * @generated from message session.v1.SendCIFixPromptResponse
+ */
+export type SendCIFixPromptResponse = Message<"session.v1.SendCIFixPromptResponse"> & {
+  /**
+   * The prompt that was queued to the agent
+   *
+   * @generated from field: string prompt = 1;
+   */
+  prompt: string;
+};
+
+/**
+ * Describes the message session.v1.SendCIFixPromptResponse.
+ * Use `create(SendCIFixPromptResponseSchema)` to create a new message.
+ */
+export const SendCIFixPromptResponseSchema: GenMessage<SendCIFixPromptResponse> = /*@__PURE__*/
+  messageDesc(file_session_v1_session, 223);
+
+/**
+ * SessionService manages AI agent session lifecycle operations.
+ * Provides CRUD operations and real-time streaming for session management.
+ *
+ * @generated from service session.v1.SessionService
+ */
+export const SessionService: GenService<{
+  /**
+   * ListSessions returns all sessions with optional filtering.
+   *
+   * @generated from rpc session.v1.SessionService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * GetSession retrieves a specific session by ID.
+   *
+   * @generated from rpc session.v1.SessionService.GetSession
+   */
+  getSession: {
+    methodKind: "unary";
+    input: typeof GetSessionRequestSchema;
+    output: typeof GetSessionResponseSchema;
+  },
+  /**
+   * CreateSession initializes a new AI agent session with tmux and git worktree.
+   *
+   * @generated from rpc session.v1.SessionService.CreateSession
+   */
+  createSession: {
+    methodKind: "unary";
+    input: typeof CreateSessionRequestSchema;
+    output: typeof CreateSessionResponseSchema;
+  },
+  /**
+   * UpdateSession modifies session properties (pause/resume, category, etc).
+   *
+   * @generated from rpc session.v1.SessionService.UpdateSession
+   */
+  updateSession: {
+    methodKind: "unary";
+    input: typeof UpdateSessionRequestSchema;
+    output: typeof UpdateSessionResponseSchema;
+  },
+  /**
+   * DeleteSession stops and removes a session, cleaning up resources.
+   *
+   * @generated from rpc session.v1.SessionService.DeleteSession
+   */
+  deleteSession: {
+    methodKind: "unary";
+    input: typeof DeleteSessionRequestSchema;
+    output: typeof DeleteSessionResponseSchema;
+  },
+  /**
+   * WatchSessions streams real-time session events (created/updated/deleted).
+   * Server-streaming RPC for live updates without polling.
+   *
+   * @generated from rpc session.v1.SessionService.WatchSessions
+   */
+  watchSessions: {
+    methodKind: "server_streaming";
+    input: typeof WatchSessionsRequestSchema;
+    output: typeof SessionEventSchema;
+  },
+  /**
+   * StreamTerminal provides bidirectional streaming for terminal I/O.
+   * Clients can send input and receive output from the tmux PTY.
+   *
+   * @generated from rpc session.v1.SessionService.StreamTerminal
+   */
+  streamTerminal: {
+    methodKind: "bidi_streaming";
+    input: typeof TerminalDataSchema;
+    output: typeof TerminalDataSchema;
+  },
+  /**
+   * GetSessionDiff retrieves the current git diff for a session.
+   *
+   * @generated from rpc session.v1.SessionService.GetSessionDiff
+   */
+  getSessionDiff: {
+    methodKind: "unary";
+    input: typeof GetSessionDiffRequestSchema;
+    output: typeof GetSessionDiffResponseSchema;
+  },
+  /**
+   * GetVCSStatus retrieves the current version control status for a session.
+   * Returns branch info, changed files, staged/unstaged status, and remote sync state.
+   *
+   * @generated from rpc session.v1.SessionService.GetVCSStatus
+   */
+  getVCSStatus: {
+    methodKind: "unary";
+    input: typeof GetVCSStatusRequestSchema;
+    output: typeof GetVCSStatusResponseSchema;
+  },
+  /**
+   * GetReviewQueue returns sessions needing user attention with priority ordering.
+   *
+   * @generated from rpc session.v1.SessionService.GetReviewQueue
+   */
+  getReviewQueue: {
+    methodKind: "unary";
+    input: typeof GetReviewQueueRequestSchema;
+    output: typeof GetReviewQueueResponseSchema;
+  },
+  /**
+   * AcknowledgeSession marks a session as acknowledged in the review queue.
+   * The session won't reappear in the queue until it receives an update.
+   *
+   * @generated from rpc session.v1.SessionService.AcknowledgeSession
+   */
+  acknowledgeSession: {
+    methodKind: "unary";
+    input: typeof AcknowledgeSessionRequestSchema;
+    output: typeof AcknowledgeSessionResponseSchema;
+  },
+  /**
+   * GetLogs retrieves application logs with optional filtering and search.
+   *
+   * @generated from rpc session.v1.SessionService.GetLogs
+   */
+  getLogs: {
+    methodKind: "unary";
+    input: typeof GetLogsRequestSchema;
+    output: typeof GetLogsResponseSchema;
+  },
+  /**
+   * WatchReviewQueue streams real-time review queue events (items added/removed/updated).
+   * Server-streaming RPC for live queue updates without polling.
+   *
+   * @generated from rpc session.v1.SessionService.WatchReviewQueue
+   */
+  watchReviewQueue: {
+    methodKind: "server_streaming";
+    input: typeof WatchReviewQueueRequestSchema;
+    output: typeof ReviewQueueEventSchema;
+  },
+  /**
+   * LogUserInteraction logs a user interaction event for audit trail.
+   * Records user actions for compliance, debugging, and analytics.
+   *
+   * @generated from rpc session.v1.SessionService.LogUserInteraction
+   */
+  logUserInteraction: {
+    methodKind: "unary";
+    input: typeof LogUserInteractionRequestSchema;
+    output: typeof LogUserInteractionResponseSchema;
+  },
+  /**
+   * GetClaudeConfig retrieves a Claude configuration file by name (CLAUDE.md, settings.json, agents.md).
+   *
+   * @generated from rpc session.v1.SessionService.GetClaudeConfig
+   */
+  getClaudeConfig: {
+    methodKind: "unary";
+    input: typeof GetClaudeConfigRequestSchema;
+    output: typeof GetClaudeConfigResponseSchema;
+  },
+  /**
+   * ListClaudeConfigs returns all configuration files in the ~/.claude directory.
+   *
+   * @generated from rpc session.v1.SessionService.ListClaudeConfigs
+   */
+  listClaudeConfigs: {
+    methodKind: "unary";
+    input: typeof ListClaudeConfigsRequestSchema;
+    output: typeof ListClaudeConfigsResponseSchema;
+  },
+  /**
+   * UpdateClaudeConfig updates a Claude configuration file with atomic write and backup.
+   *
+   * @generated from rpc session.v1.SessionService.UpdateClaudeConfig
+   */
+  updateClaudeConfig: {
     methodKind: "unary";
     input: typeof UpdateClaudeConfigRequestSchema;
     output: typeof UpdateClaudeConfigResponseSchema;
@@ -5647,6 +7291,17 @@ export const SessionService: GenService<{
     input: typeof ClosePRRequestSchema;
     output: typeof ClosePRResponseSchema;
   },
+  /**
+   * SendCIFixPrompt queues the prompt prepared for the session's failing CI
+   * checks (job log excerpts) to its agent.
+   *
+   * @generated from rpc session.v1.SessionService.SendCIFixPrompt
+   */
+  sendCIFixPrompt: {
+    methodKind: "unary";
+    input: typeof SendCIFixPromptRequestSchema;
+    output: typeof SendCIFixPromptResponseSchema;
+  },
   /**
    * SendNotification allows tmux sessions to send notifications to the server.
    * Notifications are broadcast to all connected clients (web UI and TUI).
@@ -6231,6 +7886,162 @@ export const SessionService: GenService<{
     input: typeof GetEscapeAnalyticsSummaryRequestSchema;
     output: typeof GetEscapeAnalyticsSummaryResponseSchema;
   },
+  /**
+   * GetSessionTimeline returns a per-turn digest of a session's conversation:
+   * tools used, files edited, commands run and errors hit. Digests are extracted
+   * from the conversation file, so the session must have a linked history file.
+   *
+   * @generated from rpc session.v1.SessionService.GetSessionTimeline
+   */
+  getSessionTimeline: {
+    methodKind: "unary";
+    input: typeof GetSessionTimelineRequestSchema;
+    output: typeof GetSessionTimelineResponseSchema;
+  },
+  /**
+   * SetSessionRecording opts a session in to or out of terminal recording.
+   * Recording starts within a few seconds while the session is running.
+   *
+   * @generated from rpc session.v1.SessionService.SetSessionRecording
+   */
+  setSessionRecording: {
+    methodKind: "unary";
+    input: typeof SetSessionRecordingRequestSchema;
+    output: typeof SetSessionRecordingResponseSchema;
+  },
+  /**
+   * ExportRecording exports a session's terminal recording as an asciicast v2
+   * file, optionally limited to the range between two checkpoints.
+   *
+   * @generated from rpc session.v1.SessionService.ExportRecording
+   */
+  exportRecording: {
+    methodKind: "unary";
+    input: typeof ExportRecordingRequestSchema;
+    output: typeof ExportRecordingResponseSchema;
+  },
+  /**
+   * SendSessionInput types text into a session's terminal, optionally
+   * submitting it with Enter, for scripted clients that do not hold a
+   * StreamTerminal connection.
+   *
+   * @generated from rpc session.v1.SessionService.SendSessionInput
+   */
+  sendSessionInput: {
+    methodKind: "unary";
+    input: typeof SendSessionInputRequestSchema;
+    output: typeof SendSessionInputResponseSchema;
+  },
+  /**
+   * Schedules run recurring chores: on a cron expression in a timezone, each
+   * firing creates a session from a profile, prompt and repository, or runs a
+   * one-shot prompt in an existing session.
+   *
+   * @generated from rpc session.v1.SessionService.ListSchedules
+   */
+  listSchedules: {
+    methodKind: "unary";
+    input: typeof ListSchedulesRequestSchema;
+    output: typeof ListSchedulesResponseSchema;
+  },
+  /**
+   * @generated from rpc session.v1.SessionService.CreateSchedule
+   */
+  createSchedule: {
+    methodKind: "unary";
+    input: typeof CreateScheduleRequestSchema;
+    output: typeof CreateScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc session.v1.SessionService.UpdateSchedule
+   */
+  updateSchedule: {
+    methodKind: "unary";
+    input: typeof UpdateScheduleRequestSchema;
+    output: typeof UpdateScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc session.v1.SessionService.DeleteSchedule
+   */
+  deleteSchedule: {
+    methodKind: "unary";
+    input: typeof DeleteScheduleRequestSchema;
+    output: typeof DeleteScheduleResponseSchema;
+  },
+  /**
+   * TriggerSchedule runs a schedule now, applying its overlap policy.
+   *
+   * @generated from rpc session.v1.SessionService.TriggerSchedule
+   */
+  triggerSchedule: {
+    methodKind: "unary";
+    input: typeof TriggerScheduleRequestSchema;
+    output: typeof TriggerScheduleResponseSchema;
+  },
+  /**
+   * ListScheduleRuns returns a schedule's run history, newest first.
+   *
+   * @generated from rpc session.v1.SessionService.ListScheduleRuns
+   */
+  listScheduleRuns: {
+    methodKind: "unary";
+    input: typeof ListScheduleRunsRequestSchema;
+    output: typeof ListScheduleRunsResponseSchema;
+  },
+  /**
+   * Templates bundle a program, profile, session type, branch pattern, tags,
+   * a prompt with typed {{variables}}, a setup script and acceptance criteria.
+   * Personal templates are versioned on the server and take precedence;
+   * shared ones live in a repository's .stapler-squad/templates directory.
+   *
+   * @generated from rpc session.v1.SessionService.ListTemplates
+   */
+  listTemplates: {
+    methodKind: "unary";
+    input: typeof ListTemplatesRequestSchema;
+    output: typeof ListTemplatesResponseSchema;
+  },
+  /**
+   * @generated from rpc session.v1.SessionService.GetTemplate
+   */
+  getTemplate: {
+    methodKind: "unary";
+    input: typeof GetTemplateRequestSchema;
+    output: typeof GetTemplateResponseSchema;
+  },
+  /**
+   * SaveTemplate stores a new version of a personal template, or writes it to
+   * the repository's templates directory when shared is set.
+   *
+   * @generated from rpc session.v1.SessionService.SaveTemplate
+   */
+  saveTemplate: {
+    methodKind: "unary";
+    input: typeof SaveTemplateRequestSchema;
+    output: typeof SaveTemplateResponseSchema;
+  },
+  /**
+   * DeleteTemplate removes every version of a personal template.
+   *
+   * @generated from rpc session.v1.SessionService.DeleteTemplate
+   */
+  deleteTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteTemplateRequestSchema;
+    output: typeof DeleteTemplateResponseSchema;
+  },
+  /**
+   * CreateSessionFromTemplate renders a template with the given variables,
+   * creates the session and runs the template's setup script in its working
+   * directory before the agent starts.
+   *
+   * @generated from rpc session.v1.SessionService.CreateSessionFromTemplate
+   */
+  createSessionFromTemplate: {
+    methodKind: "unary";
+    input: typeof CreateSessionFromTemplateRequestSchema;
+    output: typeof CreateSessionFromTemplateResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_session_v1_session, 0);
 
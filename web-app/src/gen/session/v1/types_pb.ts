@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file session/v1/types.proto.
  */
 export const file_session_v1_types: GenFile = /*@__PURE__*/
-  fileDesc("ChZzZXNzaW9uL3YxL3R5cGVzLnByb3RvEgpzZXNzaW9uLnYxIvoLCgdTZXNzaW9uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEgwKBHBhdGgYAyABKAkSEwoLd29ya2luZ19kaXIYBCABKAkSDgoGYnJhbmNoGAUgASgJEikKBnN0YXR1cxgGIAEoDjIZLnNlc3Npb24udjEuU2Vzc2lvblN0YXR1cxIPCgdwcm9ncmFtGAcgASgJEg4KBmhlaWdodBgIIAEoBRINCgV3aWR0aBgJIAEoBRIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4ChRsYXN0X3Rlcm1pbmFsX3VwZGF0ZRgWIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOgoWbGFzdF9tZWFuaW5nZnVsX291dHB1dBgXIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIYXV0b195ZXMYDCABKAgSDgoGcHJvbXB0GA0gASgJEhkKEWV4aXN0aW5nX3dvcmt0cmVlGA4gASgJEhAKCGNhdGVnb3J5GA8gASgJEhMKC2lzX2V4cGFuZGVkGBAgASgIEi0KDHNlc3Npb25fdHlwZRgRIAEoDjIXLnNlc3Npb24udjEuU2Vzc2lvblR5cGUSEwoLdG11eF9wcmVmaXgYEiABKAkSKQoKZGlmZl9zdGF0cxgTIAEoCzIVLnNlc3Npb24udjEuRGlmZlN0YXRzEi0KDGdpdF93b3JrdHJlZRgUIAEoCzIXLnNlc3Npb24udjEuR2l0V29ya3RyZWUSMQoOY2xhdWRlX3Nlc3Npb24YFSABKAsyGS5zZXNzaW9uLnYxLkNsYXVkZVNlc3Npb24SDAoEdGFncxgYIAMoCRIYChBnaXRodWJfcHJfbnVtYmVyGBkgASgFEhUKDWdpdGh1Yl9wcl91cmwYGiABKAkSFAoMZ2l0aHViX293bmVyGBsgASgJEhMKC2dpdGh1Yl9yZXBvGBwgASgJEhkKEWdpdGh1Yl9zb3VyY2VfcmVmGB0gASgJEhgKEGNsb25lZF9yZXBvX3BhdGgYHiABKAkSLwoNaW5zdGFuY2VfdHlwZRgfIAEoDjIYLnNlc3Npb24udjEuSW5zdGFuY2VUeXBlEj8KEWV4dGVybmFsX21ldGFkYXRhGCAgASgLMiQuc2Vzc2lvbi52MS5FeHRlcm5hbEluc3RhbmNlTWV0YWRhdGESFwoPZ2l0aHViX3ByX3N0YXRlGCEgASgJEhoKEmdpdGh1Yl9wcl9pc19kcmFmdBgiIAEoCBIaChJnaXRodWJfcHJfcHJpb3JpdHkYIyABKAkSHQoVZ2l0aHViX2FwcHJvdmVkX2NvdW50GCQgASgFEiAKGGdpdGh1Yl9jaGFuZ2VzX3JlcV9jb3VudBglIAEoBRIfChdnaXRodWJfY2hlY2tfY29uY2x1c2lvbhgmIAEoCRI4ChRsYXN0X3ByX3N0YXR1c19jaGVjaxgnIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoQcmF0ZV9saW1pdF9zdGF0ZRgoIAEoDjIaLnNlc3Npb24udjEuUmF0ZUxpbWl0U3RhdGUSOQoVcmF0ZV9saW1pdF9yZXNldF90aW1lGC4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIaChJyYXRlX2xpbWl0X2VuYWJsZWQYLyABKAgSGQoRaGlzdG9yeV9maWxlX3BhdGgYKSABKAkSIAoYY2xhdWRlX2NvbnZlcnNhdGlvbl91dWlkGCogASgJEhIKCnByb2plY3RfaWQYKyABKAkSFgoOaW5pdGlhbF9wcm9tcHQYLCABKAkSFgoObGF1bmNoX2NvbW1hbmQYLSABKAkSLwoNd29ya2luZ19zdGF0ZRgyIAEoDjIYLnNlc3Npb24udjEuV29ya2luZ1N0YXRlIokCChhFeHRlcm5hbEluc3RhbmNlTWV0YWRhdGESEwoLdG11eF9zb2NrZXQYASABKAkSGQoRdG11eF9zZXNzaW9uX25hbWUYAiABKAkSMQoNZGlzY292ZXJlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoJbGFzdF9zZWVuGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxvcmlnaW5hbF9waWQYBSABKAUSFwoPbXV4X3NvY2tldF9wYXRoGAYgASgJEhMKC211eF9lbmFibGVkGAcgASgIEhcKD3NvdXJjZV90ZXJtaW5hbBgIIAEoCSI8CglEaWZmU3RhdHMSDQoFYWRkZWQYASABKAUSDwoHcmVtb3ZlZBgCIAEoBRIPCgdjb250ZW50GAMgASgJInsKC0dpdFdvcmt0cmVlEhEKCXJlcG9fcGF0aBgBIAEoCRIVCg13b3JrdHJlZV9wYXRoGAIgASgJEhQKDHNlc3Npb25fbmFtZRgDIAEoCRITCgticmFuY2hfbmFtZRgEIAEoCRIXCg9iYXNlX2NvbW1pdF9zaGEYBSABKAkinwIKDUNsYXVkZVNlc3Npb24SEgoKc2Vzc2lvbl9pZBgBIAEoCRIXCg9jb252ZXJzYXRpb25faWQYAiABKAkSFAoMcHJvamVjdF9uYW1lGAMgASgJEjEKDWxhc3RfYXR0YWNoZWQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCHNldHRpbmdzGAUgASgLMhouc2Vzc2lvbi52MS5DbGF1ZGVTZXR0aW5ncxI5CghtZXRhZGF0YRgGIAMoCzInLnNlc3Npb24udjEuQ2xhdWRlU2Vzc2lvbi5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKmAQoOQ2xhdWRlU2V0dGluZ3MSFQoNYXV0b19yZWF0dGFjaBgBIAEoCBIeChZwcmVmZXJyZWRfc2Vzc2lvbl9uYW1lGAIgASgJEh0KFWNyZWF0ZV9uZXdfb25fbWlzc2luZxgDIAEoCBIdChVzaG93X3Nlc3Npb25fc2VsZWN0b3IYBCABKAgSHwoXc2Vzc2lvbl90aW1lb3V0X21pbnV0ZXMYBSABKAUipAUKClJldmlld0l0ZW0SEgoKc2Vzc2lvbl9pZBgBIAEoCRIUCgxzZXNzaW9uX25hbWUYAiABKAkSKwoGcmVhc29uGAMgASgOMhsuc2Vzc2lvbi52MS5BdHRlbnRpb25SZWFzb24SJgoIcHJpb3JpdHkYBCABKA4yFC5zZXNzaW9uLnYxLlByaW9yaXR5Ei8KC2RldGVjdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjb250ZXh0GAYgASgJEhQKDHBhdHRlcm5fbmFtZRgHIAEoCRI2CghtZXRhZGF0YRgIIAMoCzIkLnNlc3Npb24udjEuUmV2aWV3SXRlbS5NZXRhZGF0YUVudHJ5Eg8KB3Byb2dyYW0YCSABKAkSDgoGYnJhbmNoGAogASgJEgwKBHBhdGgYCyABKAkSEwoLd29ya2luZ19kaXIYDCABKAkSKQoGc3RhdHVzGA0gASgOMhkuc2Vzc2lvbi52MS5TZXNzaW9uU3RhdHVzEgwKBHRhZ3MYDiADKAkSEAoIY2F0ZWdvcnkYDyABKAkSKQoKZGlmZl9zdGF0cxgQIAEoCzIVLnNlc3Npb24udjEuRGlmZlN0YXRzEjEKDWxhc3RfYWN0aXZpdHkYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWdpdGh1Yl9wcl91cmwYEiABKAkSIQoZYnJhbmNoX2RpdmVyZ2VkX2Zyb21fYmFzZRgTIAEoCBIvCg13b3JraW5nX3N0YXRlGBQgASgOMhguc2Vzc2lvbi52MS5Xb3JraW5nU3RhdGUaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBItwCCgZQUkluZm8SDgoGbnVtYmVyGAEgASgFEg0KBXRpdGxlGAIgASgJEgwKBGJvZHkYAyABKAkSEAoIaGVhZF9yZWYYBCABKAkSEAoIYmFzZV9yZWYYBSABKAkSDQoFc3RhdGUYBiABKAkSDgoGYXV0aG9yGAcgASgJEg4KBmxhYmVscxgIIAMoCRIQCghodG1sX3VybBgJIAEoCRIQCghpc19kcmFmdBgKIAEoCBIRCgltZXJnZWFibGUYCyABKAkSEQoJYWRkaXRpb25zGAwgASgFEhEKCWRlbGV0aW9ucxgNIAEoBRIVCg1jaGFuZ2VkX2ZpbGVzGA4gASgFEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIrABCglQUkNvbW1lbnQSCgoCaWQYASABKAUSDgoGYXV0aG9yGAIgASgJEgwKBGJvZHkYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoEcGF0aBgFIAEoCUgAiAEBEhEKBGxpbmUYBiABKAVIAYgBARIRCglpc19yZXZpZXcYByABKAhCBwoFX3BhdGhCBwoFX2xpbmUi9gIKC1Jldmlld1F1ZXVlEhMKC3RvdGFsX2l0ZW1zGAEgASgFEiUKBWl0ZW1zGAIgAygLMhYuc2Vzc2lvbi52MS5SZXZpZXdJdGVtEjwKC2J5X3ByaW9yaXR5GAMgAygLMicuc2Vzc2lvbi52MS5SZXZpZXdRdWV1ZS5CeVByaW9yaXR5RW50cnkSOAoJYnlfcmVhc29uGAQgAygLMiUuc2Vzc2lvbi52MS5SZXZpZXdRdWV1ZS5CeVJlYXNvbkVudHJ5EhsKE2F2ZXJhZ2VfYWdlX3NlY29uZHMYBSABKAMSFgoOb2xkZXN0X2l0ZW1faWQYBiABKAkSGgoSb2xkZXN0X2FnZV9zZWNvbmRzGAcgASgDGjEKD0J5UHJpb3JpdHlFbnRyeRILCgNrZXkYASABKAUSDQoFdmFsdWUYAiABKAU6AjgBGi8KDUJ5UmVhc29uRW50cnkSCwoDa2V5GAEgASgFEg0KBXZhbHVlGAIgASgFOgI4ASLrAgoMTm90aWZpY2F0aW9uEgoKAmlkGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkSFAoMc2Vzc2lvbl9uYW1lGAMgASgJEjcKEW5vdGlmaWNhdGlvbl90eXBlGAQgASgOMhwuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25UeXBlEjIKCHByaW9yaXR5GAUgASgOMiAuc2Vzc2lvbi52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgGIAEoCRIPCgdtZXNzYWdlGAcgASgJEi0KCXRpbWVzdGFtcBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoIbWV0YWRhdGEYCSADKAsyJi5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvbi5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJnCgpGaWxlQ2hhbmdlEgwKBHBhdGgYASABKAkSJgoGc3RhdHVzGAIgASgOMhYuc2Vzc2lvbi52MS5GaWxlU3RhdHVzEhEKCWlzX3N0YWdlZBgDIAEoCBIQCghvbGRfcGF0aBgEIAEoCSLIAwoJVkNTU3RhdHVzEiEKBHR5cGUYASABKA4yEy5zZXNzaW9uLnYxLlZDU1R5cGUSDgoGYnJhbmNoGAIgASgJEhMKC2hlYWRfY29tbWl0GAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhAKCGFoZWFkX2J5GAUgASgFEhEKCWJlaGluZF9ieRgGIAEoBRIQCgh1cHN0cmVhbRgHIAEoCRISCgpoYXNfc3RhZ2VkGAggASgIEhQKDGhhc191bnN0YWdlZBgJIAEoCBIVCg1oYXNfdW50cmFja2VkGAogASgIEhUKDWhhc19jb25mbGljdHMYCyABKAgSEAoIaXNfY2xlYW4YDCABKAgSLAoMc3RhZ2VkX2ZpbGVzGA0gAygLMhYuc2Vzc2lvbi52MS5GaWxlQ2hhbmdlEi4KDnVuc3RhZ2VkX2ZpbGVzGA4gAygLMhYuc2Vzc2lvbi52MS5GaWxlQ2hhbmdlEi8KD3VudHJhY2tlZF9maWxlcxgPIAMoCzIWLnNlc3Npb24udjEuRmlsZUNoYW5nZRIuCg5jb25mbGljdF9maWxlcxgQIAMoCzIWLnNlc3Npb24udjEuRmlsZUNoYW5nZSJYCg5Cb29rbWFya1RhcmdldBIMCgRuYW1lGAEgASgJEhMKC3JldmlzaW9uX2lkGAIgASgJEhEKCWlzX3JlbW90ZRgDIAEoCBIQCgh1cHN0cmVhbRgEIAEoCSKpAQoOUmV2aXNpb25UYXJnZXQSCgoCaWQYASABKAkSEAoIc2hvcnRfaWQYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGYXV0aG9yGAQgASgJEi0KCXRpbWVzdGFtcBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKaXNfY3VycmVudBgGIAEoCBIRCglib29rbWFya3MYByADKAkiZwoOV29ya3RyZWVUYXJnZXQSDAoEbmFtZRgBIAEoCRIMCgRwYXRoGAIgASgJEhAKCGJvb2ttYXJrGAMgASgJEhMKC3JldmlzaW9uX2lkGAQgASgJEhIKCmlzX2N1cnJlbnQYBSABKAgi1gEKGUF2YWlsYWJsZVdvcmtzcGFjZVRhcmdldHMSJQoIdmNzX3R5cGUYASABKA4yEy5zZXNzaW9uLnYxLlZDU1R5cGUSLQoJYm9va21hcmtzGAIgAygLMhouc2Vzc2lvbi52MS5Cb29rbWFya1RhcmdldBI0ChByZWNlbnRfcmV2aXNpb25zGAMgAygLMhouc2Vzc2lvbi52MS5SZXZpc2lvblRhcmdldBItCgl3b3JrdHJlZXMYBCADKAsyGi5zZXNzaW9uLnYxLldvcmt0cmVlVGFyZ2V0IuwBCgdWQ1NJbmZvEiUKCHZjc190eXBlGAEgASgOMhMuc2Vzc2lvbi52MS5WQ1NUeXBlEg4KBmhhc19qahgCIAEoCBIPCgdoYXNfZ2l0GAMgASgIEhQKDGlzX2NvbG9jYXRlZBgEIAEoCBIRCglyZXBvX3BhdGgYBSABKAkSGAoQY3VycmVudF9ib29rbWFyaxgGIAEoCRIYChBjdXJyZW50X3JldmlzaW9uGAcgASgJEh8KF2hhc191bmNvbW1pdHRlZF9jaGFuZ2VzGAggASgIEhsKE21vZGlmaWVkX2ZpbGVfY291bnQYCSABKAUi4QIKFFBlbmRpbmdBcHByb3ZhbFByb3RvEgoKAmlkGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkSEQoJdG9vbF9uYW1lGAMgASgJEkMKCnRvb2xfaW5wdXQYBCADKAsyLy5zZXNzaW9uLnYxLlBlbmRpbmdBcHByb3ZhbFByb3RvLlRvb2xJbnB1dEVudHJ5EgsKA2N3ZBgFIAEoCRIXCg9wZXJtaXNzaW9uX21vZGUYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGQoRc2Vjb25kc19yZW1haW5pbmcYCSABKAUaMAoOVG9vbElucHV0RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLNAgoRQXBwcm92YWxSdWxlUHJvdG8SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIRCgl0b29sX25hbWUYAyABKAkSFAoMdG9vbF9wYXR0ZXJuGAQgASgJEhcKD2NvbW1hbmRfcGF0dGVybhgFIAEoCRIUCgxmaWxlX3BhdHRlcm4YBiABKAkSKgoIZGVjaXNpb24YByABKA4yGC5zZXNzaW9uLnYxLkF1dG9EZWNpc2lvbhISCgpyaXNrX2xldmVsGAggASgJEg4KBnJlYXNvbhgJIAEoCRITCgthbHRlcm5hdGl2ZRgKIAEoCRIQCghwcmlvcml0eRgLIAEoBRIPCgdlbmFibGVkGAwgASgIEg4KBnNvdXJjZRgNIAEoCRIuCgpjcmVhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLWBgoVQW5hbHl0aWNzU3VtbWFyeVByb3RvEhcKD3RvdGFsX2RlY2lzaW9ucxgBIAEoBRJOCg9kZWNpc2lvbl9jb3VudHMYAiADKAsyNS5zZXNzaW9uLnYxLkFuYWx5dGljc1N1bW1hcnlQcm90by5EZWNpc2lvbkNvdW50c0VudHJ5EiwKCXRvcF90b29scxgDIAMoCzIZLnNlc3Npb24udjEuVG9vbFN0YXRQcm90bxI5ChN0b3BfZGVuaWVkX2NvbW1hbmRzGAQgAygLMhwuc2Vzc2lvbi52MS5Db21tYW5kU3RhdFByb3RvEjYKE3RvcF90cmlnZ2VyZWRfcnVsZXMYBSADKAsyGS5zZXNzaW9uLnYxLlJ1bGVTdGF0UHJvdG8SGQoRYXV0b19hcHByb3ZlX3JhdGUYBiABKAESGgoSbWFudWFsX3Jldmlld19yYXRlGAcgASgBEjAKDHdpbmRvd19zdGFydBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOgoUdG9wX2NvbW1hbmRfcHJvZ3JhbXMYCiADKAsyHC5zZXNzaW9uLnYxLlByb2dyYW1TdGF0UHJvdG8SNwoSdG9wX3B5dGhvbl9pbXBvcnRzGAsgAygLMhsuc2Vzc2lvbi52MS5JbXBvcnRTdGF0UHJvdG8SGgoSY292ZXJhZ2VfZ2FwX2NvdW50GAwgASgFEhkKEWNvdmVyYWdlX2dhcF9yYXRlGA0gASgBEjYKE3RvcF91bmNvdmVyZWRfdG9vbHMYDiADKAsyGS5zZXNzaW9uLnYxLlRvb2xTdGF0UHJvdG8SPAoWdG9wX3VuY292ZXJlZF9wcm9ncmFtcxgPIAMoCzIcLnNlc3Npb24udjEuUHJvZ3JhbVN0YXRQcm90bxJBChhjb21tYW5kX3N1YmNvbW1hbmRfc3RhdHMYECADKAsyHy5zZXNzaW9uLnYxLlN1YmNvbW1hbmRTdGF0UHJvdG8aNQoTRGVjaXNpb25Db3VudHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBIjEKDVRvb2xTdGF0UHJvdG8SEQoJdG9vbF9uYW1lGAEgASgJEg0KBWNvdW50GAIgASgFIkUKEENvbW1hbmRTdGF0UHJvdG8SDwoHcHJldmlldxgBIAEoCRIRCgl0b29sX25hbWUYAiABKAkSDQoFY291bnQYAyABKAUiQgoNUnVsZVN0YXRQcm90bxIPCgdydWxlX2lkGAEgASgJEhEKCXJ1bGVfbmFtZRgCIAEoCRINCgVjb3VudBgDIAEoBSJJChBQcm9ncmFtU3RhdFByb3RvEhQKDHByb2dyYW1fbmFtZRgBIAEoCRIQCghjYXRlZ29yeRgCIAEoCRINCgVjb3VudBgDIAEoBSIwCg9JbXBvcnRTdGF0UHJvdG8SDgoGbW9kdWxlGAEgASgJEg0KBWNvdW50GAIgASgFImAKE1N1YmNvbW1hbmRTdGF0UHJvdG8SFAoMcHJvZ3JhbV9uYW1lGAEgASgJEhIKCnN1YmNvbW1hbmQYAiABKAkSEAoIY2F0ZWdvcnkYAyABKAkSDQoFY291bnQYBCABKAUikwEKEERhaWx5QnVja2V0UHJvdG8SDAoEZGF0ZRgBIAEoCRISCgphdXRvX2FsbG93GAIgASgFEhEKCWF1dG9fZGVueRgDIAEoBRIQCghlc2NhbGF0ZRgEIAEoBRIUCgxtYW51YWxfYWxsb3cYBSABKAUSEwoLbWFudWFsX2RlbnkYBiABKAUSDQoFdG90YWwYByABKAUiuwEKDERhdGFiYXNlSW5mbxIUCgx3b3Jrc3BhY2VfaWQYASABKAkSDAoEdHlwZRgCIAEoCRILCgNjd2QYAyABKAkSDAoEbmFtZRgEIAEoCRISCgpjb25maWdfZGlyGAUgASgJEhUKDXNlc3Npb25fY291bnQYBiABKAUSEgoKaXNfY3VycmVudBgHIAEoCBItCglsYXN0X3VzZWQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpgBCghGaWxlTm9kZRIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAiABKAkSDgoGaXNfZGlyGAMgASgIEgwKBHNpemUYBCABKAMSEgoKZ2l0X3N0YXR1cxgFIAEoCRISCgppc19zeW1saW5rGAYgASgIEhYKDnN5bWxpbmtfdGFyZ2V0GAcgASgJEhIKCmlzX2lnbm9yZWQYCCABKAgi5QEKD0NoZWNrcG9pbnRQcm90bxIKCgJpZBgBIAEoCRISCgpzZXNzaW9uX2lkGAIgASgJEhEKCXBhcmVudF9pZBgDIAEoCRINCgVsYWJlbBgEIAEoCRIWCg5zY3JvbGxiYWNrX3NlcRgFIAEoBBIXCg9zY3JvbGxiYWNrX3BhdGgYBiABKAkSGAoQY2xhdWRlX2NvbnZfdXVpZBgHIAEoCRIWCg5naXRfY29tbWl0X3NoYRgIIAEoCRItCgl0aW1lc3RhbXAYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIp8EChJVbmZpbmlzaGVkV29ya3RyZWUSEQoJcmVwb19wYXRoGAEgASgJEg4KBmJyYW5jaBgCIAEoCRIVCg13b3JrdHJlZV9wYXRoGAMgASgJEhEKCXJlcG9fbmFtZRgEIAEoCRIUCgxkaXNwbGF5X3BhdGgYBSABKAkSFwoPaGFzX3VuY29tbWl0dGVkGAYgASgIEhUKDWNvbW1pdHNfYWhlYWQYByABKAUSFgoOY29tbWl0c19iZWhpbmQYCCABKAUSFgoOZGVmYXVsdF9icmFuY2gYCSABKAkSFQoNY2hhbmdlZF9maWxlcxgKIAEoBRITCgtsaW5lc19hZGRlZBgLIAEoBRIVCg1saW5lc19yZW1vdmVkGAwgASgFEh0KFWFoZWFkX2NvbW1pdF9tZXNzYWdlcxgNIAMoCRIxCg1sYXN0X21vZGlmaWVkGA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCglzY2FuX3RpbWUYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKC3NjYW5fc3RhdHVzGBAgASgOMhYuc2Vzc2lvbi52MS5TY2FuU3RhdHVzEhYKDnNjYW5fZXJyb3JfbXNnGBEgASgJEhQKDGlzX2Rpc21pc3NlZBgSIAEoCBISCgppc19zbm9vemVkGBMgASgIEhMKC3Nlc3Npb25faWRzGBQgAygJIl4KFFVuZmluaXNoZWRXb3JrQ29uZmlnEhwKFGF1dG9fc3BpZGVyX3Nlc3Npb25zGAEgASgIEhIKCndhdGNoX2RpcnMYAiADKAkSFAoMcGlubmVkX3JlcG9zGAMgAygJKvgBCg1TZXNzaW9uU3RhdHVzEh4KGlNFU1NJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASGgoWU0VTU0lPTl9TVEFUVVNfUlVOTklORxABEhgKFFNFU1NJT05fU1RBVFVTX1JFQURZEAISGgoWU0VTU0lPTl9TVEFUVVNfTE9BRElORxADEhkKFVNFU1NJT05fU1RBVFVTX1BBVVNFRBAEEiEKHVNFU1NJT05fU1RBVFVTX05FRURTX0FQUFJPVkFMEAUSGwoXU0VTU0lPTl9TVEFUVVNfQ1JFQVRJTkcQBhIaChZTRVNTSU9OX1NUQVRVU19TVE9QUEVEEAcqqAEKC1Nlc3Npb25UeXBlEhwKGFNFU1NJT05fVFlQRV9VTlNQRUNJRklFRBAAEhoKFlNFU1NJT05fVFlQRV9ESVJFQ1RPUlkQARIdChlTRVNTSU9OX1RZUEVfTkVXX1dPUktUUkVFEAISIgoeU0VTU0lPTl9UWVBFX0VYSVNUSU5HX1dPUktUUkVFEAMSHAoYU0VTU0lPTl9UWVBFX05FV19QUk9KRUNUEAQqZAoMSW5zdGFuY2VUeXBlEh0KGUlOU1RBTkNFX1RZUEVfVU5TUEVDSUZJRUQQABIZChVJTlNUQU5DRV9UWVBFX01BTkFHRUQQARIaChZJTlNUQU5DRV9UWVBFX0VYVEVSTkFMEAIqmAEKDFdvcmtpbmdTdGF0ZRIdChlXT1JLSU5HX1NUQVRFX1VOU1BFQ0lGSUVEEAASGAoUV09SS0lOR19TVEFURV9BQ1RJVkUQARIcChhXT1JLSU5HX1NUQVRFX1BST0NFU1NJTkcQAhIWChJXT1JLSU5HX1NUQVRFX0lETEUQAxIZChVXT1JLSU5HX1NUQVRFX1dBSVRJTkcQBCrJAQoOUmF0ZUxpbWl0U3RhdGUSIAocUkFURV9MSU1JVF9TVEFURV9VTlNQRUNJRklFRBAAEhkKFVJBVEVfTElNSVRfU1RBVEVfTk9ORRABEhwKGFJBVEVfTElNSVRfU1RBVEVfV0FJVElORxACEh8KG1JBVEVfTElNSVRfU1RBVEVfUkVDT1ZFUklORxADEh4KGlJBVEVfTElNSVRfU1RBVEVfUkVDT1ZFUkVEEAQSGwoXUkFURV9MSU1JVF9TVEFURV9GQUlMRUQQBSpzCghQcmlvcml0eRIYChRQUklPUklUWV9VTlNQRUNJRklFRBAAEhMKD1BSSU9SSVRZX1VSR0VOVBABEhEKDVBSSU9SSVRZX0hJR0gQAhITCg9QUklPUklUWV9NRURJVU0QAxIQCgxQUklPUklUWV9MT1cQBCqUAwoPQXR0ZW50aW9uUmVhc29uEiAKHEFUVEVOVElPTl9SRUFTT05fVU5TUEVDSUZJRUQQABIlCiFBVFRFTlRJT05fUkVBU09OX0FQUFJPVkFMX1BFTkRJTkcQARIjCh9BVFRFTlRJT05fUkVBU09OX0lOUFVUX1JFUVVJUkVEEAISIAocQVRURU5USU9OX1JFQVNPTl9FUlJPUl9TVEFURRADEiEKHUFUVEVOVElPTl9SRUFTT05fSURMRV9USU1FT1VUEAQSIgoeQVRURU5USU9OX1JFQVNPTl9UQVNLX0NPTVBMRVRFEAUSKAokQVRURU5USU9OX1JFQVNPTl9VTkNPTU1JVFRFRF9DSEFOR0VTEAYSGQoVQVRURU5USU9OX1JFQVNPTl9JRExFEAcSGgoWQVRURU5USU9OX1JFQVNPTl9TVEFMRRAIEiUKIUFUVEVOVElPTl9SRUFTT05fV0FJVElOR19GT1JfVVNFUhAJEiIKHkFUVEVOVElPTl9SRUFTT05fVEVTVFNfRkFJTElORxAKKp0EChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASJQohTk9USUZJQ0FUSU9OX1RZUEVfQVBQUk9WQUxfTkVFREVEEAESJAogTk9USUZJQ0FUSU9OX1RZUEVfSU5QVVRfUkVRVUlSRUQQAhIpCiVOT1RJRklDQVRJT05fVFlQRV9DT05GSVJNQVRJT05fTkVFREVEEAMSIwofTk9USUZJQ0FUSU9OX1RZUEVfVEFTS19DT01QTEVURRAEEiUKIU5PVElGSUNBVElPTl9UWVBFX1BST0NFU1NfU1RBUlRFRBAFEiYKIk5PVElGSUNBVElPTl9UWVBFX1BST0NFU1NfRklOSVNIRUQQBhIbChdOT1RJRklDQVRJT05fVFlQRV9FUlJPUhAHEh0KGU5PVElGSUNBVElPTl9UWVBFX1dBUk5JTkcQCBIdChlOT1RJRklDQVRJT05fVFlQRV9GQUlMVVJFEAkSGgoWTk9USUZJQ0FUSU9OX1RZUEVfSU5GTxAKEhsKF05PVElGSUNBVElPTl9UWVBFX0RFQlVHEAsSIwofTk9USUZJQ0FUSU9OX1RZUEVfU1RBVFVTX0NIQU5HRRAMEiMKH05PVElGSUNBVElPTl9UWVBFX0FVVE9fQVBQUk9WRUQQDRIcChhOT1RJRklDQVRJT05fVFlQRV9DVVNUT00QZCrAAQoUTm90aWZpY2F0aW9uUHJpb3JpdHkSJQohTk9USUZJQ0FUSU9OX1BSSU9SSVRZX1VOU1BFQ0lGSUVEEAASHQoZTk9USUZJQ0FUSU9OX1BSSU9SSVRZX0xPVxABEiAKHE5PVElGSUNBVElPTl9QUklPUklUWV9NRURJVU0QAhIeChpOT1RJRklDQVRJT05fUFJJT1JJVFlfSElHSBADEiAKHE5PVElGSUNBVElPTl9QUklPUklUWV9VUkdFTlQQBCpLCgdWQ1NUeXBlEhgKFFZDU19UWVBFX1VOU1BFQ0lGSUVEEAASEAoMVkNTX1RZUEVfR0lUEAESFAoQVkNTX1RZUEVfSlVKVVRTVRACKvIBCgpGaWxlU3RhdHVzEhsKF0ZJTEVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGAoURklMRV9TVEFUVVNfTU9ESUZJRUQQARIVChFGSUxFX1NUQVRVU19BRERFRBACEhcKE0ZJTEVfU1RBVFVTX0RFTEVURUQQAxIXChNGSUxFX1NUQVRVU19SRU5BTUVEEAQSFgoSRklMRV9TVEFUVVNfQ09QSUVEEAUSGQoVRklMRV9TVEFUVVNfVU5UUkFDS0VEEAYSFwoTRklMRV9TVEFUVVNfSUdOT1JFRBAHEhgKFEZJTEVfU1RBVFVTX0NPTkZMSUNUEAgqqQEKE1dvcmtzcGFjZVN3aXRjaFR5cGUSJQohV09SS1NQQUNFX1NXSVRDSF9UWVBFX1VOU1BFQ0lGSUVEEAASIwofV09SS1NQQUNFX1NXSVRDSF9UWVBFX0RJUkVDVE9SWRABEiIKHldPUktTUEFDRV9TV0lUQ0hfVFlQRV9SRVZJU0lPThACEiIKHldPUktTUEFDRV9TV0lUQ0hfVFlQRV9XT1JLVFJFRRADKpABCg5DaGFuZ2VTdHJhdGVneRIfChtDSEFOR0VfU1RSQVRFR1lfVU5TUEVDSUZJRUQQABIfChtDSEFOR0VfU1RSQVRFR1lfS0VFUF9BU19XSVAQARIfChtDSEFOR0VfU1RSQVRFR1lfQlJJTkdfQUxPTkcQAhIbChdDSEFOR0VfU1RSQVRFR1lfQUJBTkRPThADKnoKDEF1dG9EZWNpc2lvbhIdChlBVVRPX0RFQ0lTSU9OX1VOU1BFQ0lGSUVEEAASFwoTQVVUT19ERUNJU0lPTl9BTExPVxABEhYKEkFVVE9fREVDSVNJT05fREVOWRACEhoKFkFVVE9fREVDSVNJT05fRVNDQUxBVEUQAyqJAQoKU2NhblN0YXR1cxIbChdTQ0FOX1NUQVRVU19VTlNQRUNJRklFRBAAEhIKDlNDQU5fU1RBVFVTX09LEAESFwoTU0NBTl9TVEFUVVNfVElNRU9VVBACEhoKFlNDQU5fU1RBVFVTX1BFUk1JU1NJT04QAxIVChFTQ0FOX1NUQVRVU19FUlJPUhAEQqoBCg5jb20uc2Vzc2lvbi52MUIKVHlwZXNQcm90b1ABWkNnaXRodWIuY29tL3RzdGFwbGVyL3N0YXBsZXItc3F1YWQvZ2VuL3Byb3RvL2dvL3Nlc3Npb24vdjE7c2Vzc2lvbnYxogIDU1hYqgIKU2Vzc2lvbi5WMcoCClNlc3Npb25cVjHiAhZTZXNzaW9uXFYxXEdQQk1ldGFkYXRh6gILU2Vzc2lvbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChZzZXNzaW9uL3YxL3R5cGVzLnByb3RvEgpzZXNzaW9uLnYxIpINCgdTZXNzaW9uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEgwKBHBhdGgYAyABKAkSEwoLd29ya2luZ19kaXIYBCABKAkSDgoGYnJhbmNoGAUgASgJEikKBnN0YXR1cxgGIAEoDjIZLnNlc3Npb24udjEuU2Vzc2lvblN0YXR1cxIPCgdwcm9ncmFtGAcgASgJEg4KBmhlaWdodBgIIAEoBRINCgV3aWR0aBgJIAEoBRIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4ChRsYXN0X3Rlcm1pbmFsX3VwZGF0ZRgWIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOgoWbGFzdF9tZWFuaW5nZnVsX291dHB1dBgXIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIYXV0b195ZXMYDCABKAgSDgoGcHJvbXB0GA0gASgJEhkKEWV4aXN0aW5nX3dvcmt0cmVlGA4gASgJEhAKCGNhdGVnb3J5GA8gASgJEhMKC2lzX2V4cGFuZGVkGBAgASgIEi0KDHNlc3Npb25fdHlwZRgRIAEoDjIXLnNlc3Npb24udjEuU2Vzc2lvblR5cGUSEwoLdG11eF9wcmVmaXgYEiABKAkSKQoKZGlmZl9zdGF0cxgTIAEoCzIVLnNlc3Npb24udjEuRGlmZlN0YXRzEi0KDGdpdF93b3JrdHJlZRgUIAEoCzIXLnNlc3Npb24udjEuR2l0V29ya3RyZWUSMQoOY2xhdWRlX3Nlc3Npb24YFSABKAsyGS5zZXNzaW9uLnYxLkNsYXVkZVNlc3Npb24SDAoEdGFncxgYIAMoCRIYChBnaXRodWJfcHJfbnVtYmVyGBkgASgFEhUKDWdpdGh1Yl9wcl91cmwYGiABKAkSFAoMZ2l0aHViX293bmVyGBsgASgJEhMKC2dpdGh1Yl9yZXBvGBwgASgJEhkKEWdpdGh1Yl9zb3VyY2VfcmVmGB0gASgJEhgKEGNsb25lZF9yZXBvX3BhdGgYHiABKAkSLwoNaW5zdGFuY2VfdHlwZRgfIAEoDjIYLnNlc3Npb24udjEuSW5zdGFuY2VUeXBlEj8KEWV4dGVybmFsX21ldGFkYXRhGCAgASgLMiQuc2Vzc2lvbi52MS5FeHRlcm5hbEluc3RhbmNlTWV0YWRhdGESFwoPZ2l0aHViX3ByX3N0YXRlGCEgASgJEhoKEmdpdGh1Yl9wcl9pc19kcmFmdBgiIAEoCBIaChJnaXRodWJfcHJfcHJpb3JpdHkYIyABKAkSHQoVZ2l0aHViX2FwcHJvdmVkX2NvdW50GCQgASgFEiAKGGdpdGh1Yl9jaGFuZ2VzX3JlcV9jb3VudBglIAEoBRIfChdnaXRodWJfY2hlY2tfY29uY2x1c2lvbhgmIAEoCRI4ChRsYXN0X3ByX3N0YXR1c19jaGVjaxgnIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoQcmF0ZV9saW1pdF9zdGF0ZRgoIAEoDjIaLnNlc3Npb24udjEuUmF0ZUxpbWl0U3RhdGUSOQoVcmF0ZV9saW1pdF9yZXNldF90aW1lGC4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIaChJyYXRlX2xpbWl0X2VuYWJsZWQYLyABKAgSGQoRaGlzdG9yeV9maWxlX3BhdGgYKSABKAkSIAoYY2xhdWRlX2NvbnZlcnNhdGlvbl91dWlkGCogASgJEhIKCnByb2plY3RfaWQYKyABKAkSFgoOaW5pdGlhbF9wcm9tcHQYLCABKAkSFgoObGF1bmNoX2NvbW1hbmQYLSABKAkSLwoNd29ya2luZ19zdGF0ZRgyIAEoDjIYLnNlc3Npb24udjEuV29ya2luZ1N0YXRlEhsKE3ByX2ZlZWRiYWNrX2VuYWJsZWQYMyABKAgSMQoOcmVzb3VyY2VfdXNhZ2UYNCABKAsyGS5zZXNzaW9uLnYxLlJlc291cmNlVXNhZ2USKgoHc2FuZGJveBg1IAEoCzIZLnNlc3Npb24udjEuU2FuZGJveFN0YXR1cxIaChJjaV9hdXRvZml4X2VuYWJsZWQYNiABKAgiiQIKGEV4dGVybmFsSW5zdGFuY2VNZXRhZGF0YRITCgt0bXV4X3NvY2tldBgBIAEoCRIZChF0bXV4X3Nlc3Npb25fbmFtZRgCIAEoCRIxCg1kaXNjb3ZlcmVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCglsYXN0X3NlZW4YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDG9yaWdpbmFsX3BpZBgFIAEoBRIXCg9tdXhfc29ja2V0X3BhdGgYBiABKAkSEwoLbXV4X2VuYWJsZWQYByABKAgSFwoPc291cmNlX3Rlcm1pbmFsGAggASgJIjwKCURpZmZTdGF0cxINCgVhZGRlZBgBIAEoBRIPCgdyZW1vdmVkGAIgASgFEg8KB2NvbnRlbnQYAyABKAkiewoLR2l0V29ya3RyZWUSEQoJcmVwb19wYXRoGAEgASgJEhUKDXdvcmt0cmVlX3BhdGgYAiABKAkSFAoMc2Vzc2lvbl9uYW1lGAMgASgJEhMKC2JyYW5jaF9uYW1lGAQgASgJEhcKD2Jhc2VfY29tbWl0X3NoYRgFIAEoCSKfAgoNQ2xhdWRlU2Vzc2lvbhISCgpzZXNzaW9uX2lkGAEgASgJEhcKD2NvbnZlcnNhdGlvbl9pZBgCIAEoCRIUCgxwcm9qZWN0X25hbWUYAyABKAkSMQoNbGFzdF9hdHRhY2hlZBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIc2V0dGluZ3MYBSABKAsyGi5zZXNzaW9uLnYxLkNsYXVkZVNldHRpbmdzEjkKCG1ldGFkYXRhGAYgAygLMicuc2Vzc2lvbi52MS5DbGF1ZGVTZXNzaW9uLk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIqYBCg5DbGF1ZGVTZXR0aW5ncxIVCg1hdXRvX3JlYXR0YWNoGAEgASgIEh4KFnByZWZlcnJlZF9zZXNzaW9uX25hbWUYAiABKAkSHQoVY3JlYXRlX25ld19vbl9taXNzaW5nGAMgASgIEh0KFXNob3dfc2Vzc2lvbl9zZWxlY3RvchgEIAEoCBIfChdzZXNzaW9uX3RpbWVvdXRfbWludXRlcxgFIAEoBSKkBQoKUmV2aWV3SXRlbRISCgpzZXNzaW9uX2lkGAEgASgJEhQKDHNlc3Npb25fbmFtZRgCIAEoCRIrCgZyZWFzb24YAyABKA4yGy5zZXNzaW9uLnYxLkF0dGVudGlvblJlYXNvbhImCghwcmlvcml0eRgEIAEoDjIULnNlc3Npb24udjEuUHJpb3JpdHkSLwoLZGV0ZWN0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB2NvbnRleHQYBiABKAkSFAoMcGF0dGVybl9uYW1lGAcgASgJEjYKCG1ldGFkYXRhGAggAygLMiQuc2Vzc2lvbi52MS5SZXZpZXdJdGVtLk1ldGFkYXRhRW50cnkSDwoHcHJvZ3JhbRgJIAEoCRIOCgZicmFuY2gYCiABKAkSDAoEcGF0aBgLIAEoCRITCgt3b3JraW5nX2RpchgMIAEoCRIpCgZzdGF0dXMYDSABKA4yGS5zZXNzaW9uLnYxLlNlc3Npb25TdGF0dXMSDAoEdGFncxgOIAMoCRIQCghjYXRlZ29yeRgPIAEoCRIpCgpkaWZmX3N0YXRzGBAgASgLMhUuc2Vzc2lvbi52MS5EaWZmU3RhdHMSMQoNbGFzdF9hY3Rpdml0eRgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNZ2l0aHViX3ByX3VybBgSIAEoCRIhChlicmFuY2hfZGl2ZXJnZWRfZnJvbV9iYXNlGBMgASgIEi8KDXdvcmtpbmdfc3RhdGUYFCABKA4yGC5zZXNzaW9uLnYxLldvcmtpbmdTdGF0ZRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEi3AIKBlBSSW5mbxIOCgZudW1iZXIYASABKAUSDQoFdGl0bGUYAiABKAkSDAoEYm9keRgDIAEoCRIQCghoZWFkX3JlZhgEIAEoCRIQCghiYXNlX3JlZhgFIAEoCRINCgVzdGF0ZRgGIAEoCRIOCgZhdXRob3IYByABKAkSDgoGbGFiZWxzGAggAygJEhAKCGh0bWxfdXJsGAkgASgJEhAKCGlzX2RyYWZ0GAogASgIEhEKCW1lcmdlYWJsZRgLIAEoCRIRCglhZGRpdGlvbnMYDCABKAUSEQoJZGVsZXRpb25zGA0gASgFEhUKDWNoYW5nZWRfZmlsZXMYDiABKAUSLgoKY3JlYXRlZF9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAisAEKCVBSQ29tbWVudBIKCgJpZBgBIAEoBRIOCgZhdXRob3IYAiABKAkSDAoEYm9keRgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCgRwYXRoGAUgASgJSACIAQESEQoEbGluZRgGIAEoBUgBiAEBEhEKCWlzX3JldmlldxgHIAEoCEIHCgVfcGF0aEIHCgVfbGluZSL2AgoLUmV2aWV3UXVldWUSEwoLdG90YWxfaXRlbXMYASABKAUSJQoFaXRlbXMYAiADKAsyFi5zZXNzaW9uLnYxLlJldmlld0l0ZW0SPAoLYnlfcHJpb3JpdHkYAyADKAsyJy5zZXNzaW9uLnYxLlJldmlld1F1ZXVlLkJ5UHJpb3JpdHlFbnRyeRI4CglieV9yZWFzb24YBCADKAsyJS5zZXNzaW9uLnYxLlJldmlld1F1ZXVlLkJ5UmVhc29uRW50cnkSGwoTYXZlcmFnZV9hZ2Vfc2Vjb25kcxgFIAEoAxIWCg5vbGRlc3RfaXRlbV9pZBgGIAEoCRIaChJvbGRlc3RfYWdlX3NlY29uZHMYByABKAMaMQoPQnlQcmlvcml0eUVudHJ5EgsKA2tleRgBIAEoBRINCgV2YWx1ZRgCIAEoBToCOAEaLwoNQnlSZWFzb25FbnRyeRILCgNrZXkYASABKAUSDQoFdmFsdWUYAiABKAU6AjgBIusCCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCRIUCgxzZXNzaW9uX25hbWUYAyABKAkSNwoRbm90aWZpY2F0aW9uX3R5cGUYBCABKA4yHC5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvblR5cGUSMgoIcHJpb3JpdHkYBSABKA4yIC5zZXNzaW9uLnYxLk5vdGlmaWNhdGlvblByaW9yaXR5Eg0KBXRpdGxlGAYgASgJEg8KB21lc3NhZ2UYByABKAkSLQoJdGltZXN0YW1wGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4CghtZXRhZGF0YRgJIAMoCzImLnNlc3Npb24udjEuTm90aWZpY2F0aW9uLk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBImcKCkZpbGVDaGFuZ2USDAoEcGF0aBgBIAEoCRImCgZzdGF0dXMYAiABKA4yFi5zZXNzaW9uLnYxLkZpbGVTdGF0dXMSEQoJaXNfc3RhZ2VkGAMgASgIEhAKCG9sZF9wYXRoGAQgASgJIsgDCglWQ1NTdGF0dXMSIQoEdHlwZRgBIAEoDjITLnNlc3Npb24udjEuVkNTVHlwZRIOCgZicmFuY2gYAiABKAkSEwoLaGVhZF9jb21taXQYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSEAoIYWhlYWRfYnkYBSABKAUSEQoJYmVoaW5kX2J5GAYgASgFEhAKCHVwc3RyZWFtGAcgASgJEhIKCmhhc19zdGFnZWQYCCABKAgSFAoMaGFzX3Vuc3RhZ2VkGAkgASgIEhUKDWhhc191bnRyYWNrZWQYCiABKAgSFQoNaGFzX2NvbmZsaWN0cxgLIAEoCBIQCghpc19jbGVhbhgMIAEoCBIsCgxzdGFnZWRfZmlsZXMYDSADKAsyFi5zZXNzaW9uLnYxLkZpbGVDaGFuZ2USLgoOdW5zdGFnZWRfZmlsZXMYDiADKAsyFi5zZXNzaW9uLnYxLkZpbGVDaGFuZ2USLwoPdW50cmFja2VkX2ZpbGVzGA8gAygLMhYuc2Vzc2lvbi52MS5GaWxlQ2hhbmdlEi4KDmNvbmZsaWN0X2ZpbGVzGBAgAygLMhYuc2Vzc2lvbi52MS5GaWxlQ2hhbmdlIlgKDkJvb2ttYXJrVGFyZ2V0EgwKBG5hbWUYASABKAkSEwoLcmV2aXNpb25faWQYAiABKAkSEQoJaXNfcmVtb3RlGAMgASgIEhAKCHVwc3RyZWFtGAQgASgJIqkBCg5SZXZpc2lvblRhcmdldBIKCgJpZBgBIAEoCRIQCghzaG9ydF9pZBgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZhdXRob3IYBCABKAkSLQoJdGltZXN0YW1wGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgppc19jdXJyZW50GAYgASgIEhEKCWJvb2ttYXJrcxgHIAMoCSJnCg5Xb3JrdHJlZVRhcmdldBIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAiABKAkSEAoIYm9va21hcmsYAyABKAkSEwoLcmV2aXNpb25faWQYBCABKAkSEgoKaXNfY3VycmVudBgFIAEoCCLWAQoZQXZhaWxhYmxlV29ya3NwYWNlVGFyZ2V0cxIlCgh2Y3NfdHlwZRgBIAEoDjITLnNlc3Npb24udjEuVkNTVHlwZRItCglib29rbWFya3MYAiADKAsyGi5zZXNzaW9uLnYxLkJvb2ttYXJrVGFyZ2V0EjQKEHJlY2VudF9yZXZpc2lvbnMYAyADKAsyGi5zZXNzaW9uLnYxLlJldmlzaW9uVGFyZ2V0Ei0KCXdvcmt0cmVlcxgEIAMoCzIaLnNlc3Npb24udjEuV29ya3RyZWVUYXJnZXQi7AEKB1ZDU0luZm8SJQoIdmNzX3R5cGUYASABKA4yEy5zZXNzaW9uLnYxLlZDU1R5cGUSDgoGaGFzX2pqGAIgASgIEg8KB2hhc19naXQYAyABKAgSFAoMaXNfY29sb2NhdGVkGAQgASgIEhEKCXJlcG9fcGF0aBgFIAEoCRIYChBjdXJyZW50X2Jvb2ttYXJrGAYgASgJEhgKEGN1cnJlbnRfcmV2aXNpb24YByABKAkSHwoXaGFzX3VuY29tbWl0dGVkX2NoYW5nZXMYCCABKAgSGwoTbW9kaWZpZWRfZmlsZV9jb3VudBgJIAEoBSLhAgoUUGVuZGluZ0FwcHJvdmFsUHJvdG8SCgoCaWQYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCRIRCgl0b29sX25hbWUYAyABKAkSQwoKdG9vbF9pbnB1dBgEIAMoCzIvLnNlc3Npb24udjEuUGVuZGluZ0FwcHJvdmFsUHJvdG8uVG9vbElucHV0RW50cnkSCwoDY3dkGAUgASgJEhcKD3Blcm1pc3Npb25fbW9kZRgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChFzZWNvbmRzX3JlbWFpbmluZxgJIAEoBRowCg5Ub29sSW5wdXRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIs0CChFBcHByb3ZhbFJ1bGVQcm90bxIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCXRvb2xfbmFtZRgDIAEoCRIUCgx0b29sX3BhdHRlcm4YBCABKAkSFwoPY29tbWFuZF9wYXR0ZXJuGAUgASgJEhQKDGZpbGVfcGF0dGVybhgGIAEoCRIqCghkZWNpc2lvbhgHIAEoDjIYLnNlc3Npb24udjEuQXV0b0RlY2lzaW9uEhIKCnJpc2tfbGV2ZWwYCCABKAkSDgoGcmVhc29uGAkgASgJEhMKC2FsdGVybmF0aXZlGAogASgJEhAKCHByaW9yaXR5GAsgASgFEg8KB2VuYWJsZWQYDCABKAgSDgoGc291cmNlGA0gASgJEi4KCmNyZWF0ZWRfYXQYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItYGChVBbmFseXRpY3NTdW1tYXJ5UHJvdG8SFwoPdG90YWxfZGVjaXNpb25zGAEgASgFEk4KD2RlY2lzaW9uX2NvdW50cxgCIAMoCzI1LnNlc3Npb24udjEuQW5hbHl0aWNzU3VtbWFyeVByb3RvLkRlY2lzaW9uQ291bnRzRW50cnkSLAoJdG9wX3Rvb2xzGAMgAygLMhkuc2Vzc2lvbi52MS5Ub29sU3RhdFByb3RvEjkKE3RvcF9kZW5pZWRfY29tbWFuZHMYBCADKAsyHC5zZXNzaW9uLnYxLkNvbW1hbmRTdGF0UHJvdG8SNgoTdG9wX3RyaWdnZXJlZF9ydWxlcxgFIAMoCzIZLnNlc3Npb24udjEuUnVsZVN0YXRQcm90bxIZChFhdXRvX2FwcHJvdmVfcmF0ZRgGIAEoARIaChJtYW51YWxfcmV2aWV3X3JhdGUYByABKAESMAoMd2luZG93X3N0YXJ0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI6ChR0b3BfY29tbWFuZF9wcm9ncmFtcxgKIAMoCzIcLnNlc3Npb24udjEuUHJvZ3JhbVN0YXRQcm90bxI3ChJ0b3BfcHl0aG9uX2ltcG9ydHMYCyADKAsyGy5zZXNzaW9uLnYxLkltcG9ydFN0YXRQcm90bxIaChJjb3ZlcmFnZV9nYXBfY291bnQYDCABKAUSGQoRY292ZXJhZ2VfZ2FwX3JhdGUYDSABKAESNgoTdG9wX3VuY292ZXJlZF90b29scxgOIAMoCzIZLnNlc3Npb24udjEuVG9vbFN0YXRQcm90bxI8ChZ0b3BfdW5jb3ZlcmVkX3Byb2dyYW1zGA8gAygLMhwuc2Vzc2lvbi52MS5Qcm9ncmFtU3RhdFByb3RvEkEKGGNvbW1hbmRfc3ViY29tbWFuZF9zdGF0cxgQIAMoCzIfLnNlc3Npb24udjEuU3ViY29tbWFuZFN0YXRQcm90bxo1ChNEZWNpc2lvbkNvdW50c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEiMQoNVG9vbFN0YXRQcm90bxIRCgl0b29sX25hbWUYASABKAkSDQoFY291bnQYAiABKAUiRQoQQ29tbWFuZFN0YXRQcm90bxIPCgdwcmV2aWV3GAEgASgJEhEKCXRvb2xfbmFtZRgCIAEoCRINCgVjb3VudBgDIAEoBSJCCg1SdWxlU3RhdFByb3RvEg8KB3J1bGVfaWQYASABKAkSEQoJcnVsZV9uYW1lGAIgASgJEg0KBWNvdW50GAMgASgFIkkKEFByb2dyYW1TdGF0UHJvdG8SFAoMcHJvZ3JhbV9uYW1lGAEgASgJEhAKCGNhdGVnb3J5GAIgASgJEg0KBWNvdW50GAMgASgFIjAKD0ltcG9ydFN0YXRQcm90bxIOCgZtb2R1bGUYASABKAkSDQoFY291bnQYAiABKAUiYAoTU3ViY29tbWFuZFN0YXRQcm90bxIUCgxwcm9ncmFtX25hbWUYASABKAkSEgoKc3ViY29tbWFuZBgCIAEoCRIQCghjYXRlZ29yeRgDIAEoCRINCgVjb3VudBgEIAEoBSKpAQoQRGFpbHlCdWNrZXRQcm90bxIMCgRkYXRlGAEgASgJEhIKCmF1dG9fYWxsb3cYAiABKAUSEQoJYXV0b19kZW55GAMgASgFEhAKCGVzY2FsYXRlGAQgASgFEhQKDG1hbnVhbF9hbGxvdxgFIAEoBRITCgttYW51YWxfZGVueRgGIAEoBRINCgV0b3RhbBgHIAEoBRIUCgxzYW5kYm94X2RlbnkYCCABKAUiuwEKDERhdGFiYXNlSW5mbxIUCgx3b3Jrc3BhY2VfaWQYASABKAkSDAoEdHlwZRgCIAEoCRILCgNjd2QYAyABKAkSDAoEbmFtZRgEIAEoCRISCgpjb25maWdfZGlyGAUgASgJEhUKDXNlc3Npb25fY291bnQYBiABKAUSEgoKaXNfY3VycmVudBgHIAEoCBItCglsYXN0X3VzZWQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpgBCghGaWxlTm9kZRIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAiABKAkSDgoGaXNfZGlyGAMgASgIEgwKBHNpemUYBCABKAMSEgoKZ2l0X3N0YXR1cxgFIAEoCRISCgppc19zeW1saW5rGAYgASgIEhYKDnN5bWxpbmtfdGFyZ2V0GAcgASgJEhIKCmlzX2lnbm9yZWQYCCABKAgi5QEKD0NoZWNrcG9pbnRQcm90bxIKCgJpZBgBIAEoCRISCgpzZXNzaW9uX2lkGAIgASgJEhEKCXBhcmVudF9pZBgDIAEoCRINCgVsYWJlbBgEIAEoCRIWCg5zY3JvbGxiYWNrX3NlcRgFIAEoBBIXCg9zY3JvbGxiYWNrX3BhdGgYBiABKAkSGAoQY2xhdWRlX2NvbnZfdXVpZBgHIAEoCRIWCg5naXRfY29tbWl0X3NoYRgIIAEoCRItCgl0aW1lc3RhbXAYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIp8EChJVbmZpbmlzaGVkV29ya3RyZWUSEQoJcmVwb19wYXRoGAEgASgJEg4KBmJyYW5jaBgCIAEoCRIVCg13b3JrdHJlZV9wYXRoGAMgASgJEhEKCXJlcG9fbmFtZRgEIAEoCRIUCgxkaXNwbGF5X3BhdGgYBSABKAkSFwoPaGFzX3VuY29tbWl0dGVkGAYgASgIEhUKDWNvbW1pdHNfYWhlYWQYByABKAUSFgoOY29tbWl0c19iZWhpbmQYCCABKAUSFgoOZGVmYXVsdF9icmFuY2gYCSABKAkSFQoNY2hhbmdlZF9maWxlcxgKIAEoBRITCgtsaW5lc19hZGRlZBgLIAEoBRIVCg1saW5lc19yZW1vdmVkGAwgASgFEh0KFWFoZWFkX2NvbW1pdF9tZXNzYWdlcxgNIAMoCRIxCg1sYXN0X21vZGlmaWVkGA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCglzY2FuX3RpbWUYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKC3NjYW5fc3RhdHVzGBAgASgOMhYuc2Vzc2lvbi52MS5TY2FuU3RhdHVzEhYKDnNjYW5fZXJyb3JfbXNnGBEgASgJEhQKDGlzX2Rpc21pc3NlZBgSIAEoCBISCgppc19zbm9vemVkGBMgASgIEhMKC3Nlc3Npb25faWRzGBQgAygJIl4KFFVuZmluaXNoZWRXb3JrQ29uZmlnEhwKFGF1dG9fc3BpZGVyX3Nlc3Npb25zGAEgASgIEhIKCndhdGNoX2RpcnMYAiADKAkSFAoMcGlubmVkX3JlcG9zGAMgAygJIuECCg1SZXNvdXJjZVVzYWdlEhMKC2Nncm91cF9wYXRoGAEgASgJEhMKC2NwdV9wZXJjZW50GAIgASgBEhQKDGNwdV91c2FnZV9tcxgDIAEoAxIZChFjcHVfbGltaXRfcGVyY2VudBgEIAEoBRIUCgxtZW1vcnlfYnl0ZXMYBSABKAMSGQoRbWVtb3J5X3BlYWtfYnl0ZXMYBiABKAMSGgoSbWVtb3J5X2xpbWl0X2J5dGVzGAcgASgDEhUKDWlvX3JlYWRfYnl0ZXMYCCABKAMSFgoOaW9fd3JpdGVfYnl0ZXMYCSABKAMSDAoEcGlkcxgKIAEoAxISCgpwaWRzX2xpbWl0GAsgASgDEhEKCW9vbV9raWxscxgMIAEoAxIUCgxsaW1pdF9ldmVudHMYDSABKAMSLgoKc2FtcGxlZF9hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiagoNU2FuZGJveFN0YXR1cxIUCgxuZXR3b3JrX21vZGUYASABKAkSFQoNYWxsb3dlZF9ob3N0cxgCIAMoCRIWCg53cml0YWJsZV9wYXRocxgDIAMoCRIUCgxkZW5pZWRfY291bnQYBCABKAUq+AEKDVNlc3Npb25TdGF0dXMSHgoaU0VTU0lPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIaChZTRVNTSU9OX1NUQVRVU19SVU5OSU5HEAESGAoUU0VTU0lPTl9TVEFUVVNfUkVBRFkQAhIaChZTRVNTSU9OX1NUQVRVU19MT0FESU5HEAMSGQoVU0VTU0lPTl9TVEFUVVNfUEFVU0VEEAQSIQodU0VTU0lPTl9TVEFUVVNfTkVFRFNfQVBQUk9WQUwQBRIbChdTRVNTSU9OX1NUQVRVU19DUkVBVElORxAGEhoKFlNFU1NJT05fU1RBVFVTX1NUT1BQRUQQByqoAQoLU2Vzc2lvblR5cGUSHAoYU0VTU0lPTl9UWVBFX1VOU1BFQ0lGSUVEEAASGgoWU0VTU0lPTl9UWVBFX0RJUkVDVE9SWRABEh0KGVNFU1NJT05fVFlQRV9ORVdfV09SS1RSRUUQAhIiCh5TRVNTSU9OX1RZUEVfRVhJU1RJTkdfV09SS1RSRUUQAxIcChhTRVNTSU9OX1RZUEVfTkVXX1BST0pFQ1QQBCpkCgxJbnN0YW5jZVR5cGUSHQoZSU5TVEFOQ0VfVFlQRV9VTlNQRUNJRklFRBAAEhkKFUlOU1RBTkNFX1RZUEVfTUFOQUdFRBABEhoKFklOU1RBTkNFX1RZUEVfRVhURVJOQUwQAiqYAQoMV29ya2luZ1N0YXRlEh0KGVdPUktJTkdfU1RBVEVfVU5TUEVDSUZJRUQQABIYChRXT1JLSU5HX1NUQVRFX0FDVElWRRABEhwKGFdPUktJTkdfU1RBVEVfUFJPQ0VTU0lORxACEhYKEldPUktJTkdfU1RBVEVfSURMRRADEhkKFVdPUktJTkdfU1RBVEVfV0FJVElORxAEKskBCg5SYXRlTGltaXRTdGF0ZRIgChxSQVRFX0xJTUlUX1NUQVRFX1VOU1BFQ0lGSUVEEAASGQoVUkFURV9MSU1JVF9TVEFURV9OT05FEAESHAoYUkFURV9MSU1JVF9TVEFURV9XQUlUSU5HEAISHwobUkFURV9MSU1JVF9TVEFURV9SRUNPVkVSSU5HEAMSHgoaUkFURV9MSU1JVF9TVEFURV9SRUNPVkVSRUQQBBIbChdSQVRFX0xJTUlUX1NUQVRFX0ZBSUxFRBAFKnMKCFByaW9yaXR5EhgKFFBSSU9SSVRZX1VOU1BFQ0lGSUVEEAASEwoPUFJJT1JJVFlfVVJHRU5UEAESEQoNUFJJT1JJVFlfSElHSBACEhMKD1BSSU9SSVRZX01FRElVTRADEhAKDFBSSU9SSVRZX0xPVxAEKtoDCg9BdHRlbnRpb25SZWFzb24SIAocQVRURU5USU9OX1JFQVNPTl9VTlNQRUNJRklFRBAAEiUKIUFUVEVOVElPTl9SRUFTT05fQVBQUk9WQUxfUEVORElORxABEiMKH0FUVEVOVElPTl9SRUFTT05fSU5QVVRfUkVRVUlSRUQQAhIgChxBVFRFTlRJT05fUkVBU09OX0VSUk9SX1NUQVRFEAMSIQodQVRURU5USU9OX1JFQVNPTl9JRExFX1RJTUVPVVQQBBIiCh5BVFRFTlRJT05fUkVBU09OX1RBU0tfQ09NUExFVEUQBRIoCiRBVFRFTlRJT05fUkVBU09OX1VOQ09NTUlUVEVEX0NIQU5HRVMQBhIZChVBVFRFTlRJT05fUkVBU09OX0lETEUQBxIaChZBVFRFTlRJT05fUkVBU09OX1NUQUxFEAgSJQohQVRURU5USU9OX1JFQVNPTl9XQUlUSU5HX0ZPUl9VU0VSEAkSIgoeQVRURU5USU9OX1JFQVNPTl9URVNUU19GQUlMSU5HEAoSHwobQVRURU5USU9OX1JFQVNPTl9DSV9GQUlMSU5HEAsSIwofQVRURU5USU9OX1JFQVNPTl9SRVNPVVJDRV9MSU1JVBAMKp0EChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASJQohTk9USUZJQ0FUSU9OX1RZUEVfQVBQUk9WQUxfTkVFREVEEAESJAogTk9USUZJQ0FUSU9OX1RZUEVfSU5QVVRfUkVRVUlSRUQQAhIpCiVOT1RJRklDQVRJT05fVFlQRV9DT05GSVJNQVRJT05fTkVFREVEEAMSIwofTk9USUZJQ0FUSU9OX1RZUEVfVEFTS19DT01QTEVURRAEEiUKIU5PVElGSUNBVElPTl9UWVBFX1BST0NFU1NfU1RBUlRFRBAFEiYKIk5PVElGSUNBVElPTl9UWVBFX1BST0NFU1NfRklOSVNIRUQQBhIbChdOT1RJRklDQVRJT05fVFlQRV9FUlJPUhAHEh0KGU5PVElGSUNBVElPTl9UWVBFX1dBUk5JTkcQCBIdChlOT1RJRklDQVRJT05fVFlQRV9GQUlMVVJFEAkSGgoWTk9USUZJQ0FUSU9OX1RZUEVfSU5GTxAKEhsKF05PVElGSUNBVElPTl9UWVBFX0RFQlVHEAsSIwofTk9USUZJQ0FUSU9OX1RZUEVfU1RBVFVTX0NIQU5HRRAMEiMKH05PVElGSUNBVElPTl9UWVBFX0FVVE9fQVBQUk9WRUQQDRIcChhOT1RJRklDQVRJT05fVFlQRV9DVVNUT00QZCrAAQoUTm90aWZpY2F0aW9uUHJpb3JpdHkSJQohTk9USUZJQ0FUSU9OX1BSSU9SSVRZX1VOU1BFQ0lGSUVEEAASHQoZTk9USUZJQ0FUSU9OX1BSSU9SSVRZX0xPVxABEiAKHE5PVElGSUNBVElPTl9QUklPUklUWV9NRURJVU0QAhIeChpOT1RJRklDQVRJT05fUFJJT1JJVFlfSElHSBADEiAKHE5PVElGSUNBVElPTl9QUklPUklUWV9VUkdFTlQQBCpLCgdWQ1NUeXBlEhgKFFZDU19UWVBFX1VOU1BFQ0lGSUVEEAASEAoMVkNTX1RZUEVfR0lUEAESFAoQVkNTX1RZUEVfSlVKVVRTVRACKvIBCgpGaWxlU3RhdHVzEhsKF0ZJTEVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGAoURklMRV9TVEFUVVNfTU9ESUZJRUQQARIVChFGSUxFX1NUQVRVU19BRERFRBACEhcKE0ZJTEVfU1RBVFVTX0RFTEVURUQQAxIXChNGSUxFX1NUQVRVU19SRU5BTUVEEAQSFgoSRklMRV9TVEFUVVNfQ09QSUVEEAUSGQoVRklMRV9TVEFUVVNfVU5UUkFDS0VEEAYSFwoTRklMRV9TVEFUVVNfSUdOT1JFRBAHEhgKFEZJTEVfU1RBVFVTX0NPTkZMSUNUEAgqqQEKE1dvcmtzcGFjZVN3aXRjaFR5cGUSJQohV09SS1NQQUNFX1NXSVRDSF9UWVBFX1VOU1BFQ0lGSUVEEAASIwofV09SS1NQQUNFX1NXSVRDSF9UWVBFX0RJUkVDVE9SWRABEiIKHldPUktTUEFDRV9TV0lUQ0hfVFlQRV9SRVZJU0lPThACEiIKHldPUktTUEFDRV9TV0lUQ0hfVFlQRV9XT1JLVFJFRRADKpABCg5DaGFuZ2VTdHJhdGVneRIfChtDSEFOR0VfU1RSQVRFR1lfVU5TUEVDSUZJRUQQABIfChtDSEFOR0VfU1RSQVRFR1lfS0VFUF9BU19XSVAQARIfChtDSEFOR0VfU1RSQVRFR1lfQlJJTkdfQUxPTkcQAhIbChdDSEFOR0VfU1RSQVRFR1lfQUJBTkRPThADKnoKDEF1dG9EZWNpc2lvbhIdChlBVVRPX0RFQ0lTSU9OX1VOU1BFQ0lGSUVEEAASFwoTQVVUT19ERUNJU0lPTl9BTExPVxABEhYKEkFVVE9fREVDSVNJT05fREVOWRACEhoKFkFVVE9fREVDSVNJT05fRVNDQUxBVEUQAyqJAQoKU2NhblN0YXR1cxIbChdTQ0FOX1NUQVRVU19VTlNQRUNJRklFRBAAEhIKDlNDQU5fU1RBVFVTX09LEAESFwoTU0NBTl9TVEFUVVNfVElNRU9VVBACEhoKFlNDQU5fU1RBVFVTX1BFUk1JU1NJT04QAxIVChFTQ0FOX1NUQVRVU19FUlJPUhAEQqoBCg5jb20uc2Vzc2lvbi52MUIKVHlwZXNQcm90b1ABWkNnaXRodWIuY29tL3RzdGFwbGVyL3N0YXBsZXItc3F1YWQvZ2VuL3Byb3RvL2dvL3Nlc3Npb24vdjE7c2Vzc2lvbnYxogIDU1hYqgIKU2Vzc2lvbi5WMcoCClNlc3Npb25cVjHiAhZTZXNzaW9uXFYxXEdQQk1ldGFkYXRh6gILU2Vzc2lvbjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * Session represents a running AI agent instance with its associated state.
@@ -363,6 +363,35 @@ export type Session = Message<"session.v1.Session"> & {
    * @generated from field: session.v1.WorkingState working_state = 50;
    */
   workingState: WorkingState;
+
+  /**
+   * Whether new PR review comments are automatically queued to the agent.
+   *
+   * @generated from field: bool pr_feedback_enabled = 51;
+   */
+  prFeedbackEnabled: boolean;
+
+  /**
+   * Live resource usage of the session's cgroup. Unset when cgroups are
+   * unavailable or the session has not been sampled yet.
+   *
+   * @generated from field: session.v1.ResourceUsage resource_usage = 52;
+   */
+  resourceUsage?: ResourceUsage;
+
+  /**
+   * Sandbox the session runs in. Unset when the session is not sandboxed.
+   *
+   * @generated from field: session.v1.SandboxStatus sandbox = 53;
+   */
+  sandbox?: SandboxStatus;
+
+  /**
+   * Whether failing CI logs on the session's PR are automatically queued to the agent.
+   *
+   * @generated from field: bool ci_autofix_enabled = 54;
+   */
+  ciAutofixEnabled: boolean;
 };
 
 /**
@@ -2048,6 +2077,13 @@ export type DailyBucketProto = Message<"session.v1.DailyBucketProto"> & {
    * @generated from field: int32 total = 7;
    */
   total: number;
+
+  /**
+   * Network requests blocked by session sandboxes.
+   *
+   * @generated from field: int32 sandbox_deny = 8;
+   */
+  sandboxDeny: number;
 };
 
 /**
@@ -2463,6 +2499,141 @@ export type UnfinishedWorkConfig = Message<"session.v1.UnfinishedWorkConfig"> &
 export const UnfinishedWorkConfigSchema: GenMessage<UnfinishedWorkConfig> = /*@__PURE__*/
   messageDesc(file_session_v1_types, 32);
 
+/**
+ * ResourceUsage is a sample of a session's cgroup v2 resource accounting.
+ * Limit fields are 0 when the resource is unlimited.
+ *
+ * @generated from message session.v1.ResourceUsage
+ */
+export type ResourceUsage = Message<"session.v1.ResourceUsage"> & {
+  /**
+   * @generated from field: string cgroup_path = 1;
+   */
+  cgroupPath: string;
+
+  /**
+   * CPU usage over the last poll interval; 100 is one full CPU.
+   *
+   * @generated from field: double cpu_percent = 2;
+   */
+  cpuPercent: number;
+
+  /**
+   * Cumulative CPU time in milliseconds.
+   *
+   * @generated from field: int64 cpu_usage_ms = 3;
+   */
+  cpuUsageMs: bigint;
+
+  /**
+   * @generated from field: int32 cpu_limit_percent = 4;
+   */
+  cpuLimitPercent: number;
+
+  /**
+   * @generated from field: int64 memory_bytes = 5;
+   */
+  memoryBytes: bigint;
+
+  /**
+   * @generated from field: int64 memory_peak_bytes = 6;
+   */
+  memoryPeakBytes: bigint;
+
+  /**
+   * @generated from field: int64 memory_limit_bytes = 7;
+   */
+  memoryLimitBytes: bigint;
+
+  /**
+   * @generated from field: int64 io_read_bytes = 8;
+   */
+  ioReadBytes: bigint;
+
+  /**
+   * @generated from field: int64 io_write_bytes = 9;
+   */
+  ioWriteBytes: bigint;
+
+  /**
+   * @generated from field: int64 pids = 10;
+   */
+  pids: bigint;
+
+  /**
+   * @generated from field: int64 pids_limit = 11;
+   */
+  pidsLimit: bigint;
+
+  /**
+   * Processes killed by the OOM killer since the group was created.
+   *
+   * @generated from field: int64 oom_kills = 12;
+   */
+  oomKills: bigint;
+
+  /**
+   * Times the memory.max or pids.max limit was hit.
+   *
+   * @generated from field: int64 limit_events = 13;
+   */
+  limitEvents: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp sampled_at = 14;
+   */
+  sampledAt?: Timestamp;
+};
+
+/**
+ * Describes the message session.v1.ResourceUsage.
+ * Use `create(ResourceUsageSchema)` to create a new message.
+ */
+export const ResourceUsageSchema: GenMessage<ResourceUsage> = /*@__PURE__*/
+  messageDesc(file_session_v1_types, 33);
+
+/**
+ * SandboxStatus describes the namespace sandbox confining a session.
+ *
+ * @generated from message session.v1.SandboxStatus
+ */
+export type SandboxStatus = Message<"session.v1.SandboxStatus"> & {
+  /**
+   * "host", "none" or "allowlist".
+   *
+   * @generated from field: string network_mode = 1;
+   */
+  networkMode: string;
+
+  /**
+   * Hosts reachable in allowlist mode.
+   *
+   * @generated from field: repeated string allowed_hosts = 2;
+   */
+  allowedHosts: string[];
+
+  /**
+   * Paths mounted read-write; the rest of the filesystem is read-only.
+   *
+   * @generated from field: repeated string writable_paths = 3;
+   */
+  writablePaths: string[];
+
+  /**
+   * Network requests blocked since the server started.
+   *
+   * @generated from field: int32 denied_count = 4;
+   */
+  deniedCount: number;
+};
+
+/**
+ * Describes the message session.v1.SandboxStatus.
+ * Use `create(SandboxStatusSchema)` to create a new message.
+ */
+export const SandboxStatusSchema: GenMessage<SandboxStatus> = /*@__PURE__*/
+  messageDesc(file_session_v1_types, 34);
+
 /**
  * SessionStatus represents the current state of a session.
  * Maps to session.Status enum in Go.
@@ -2836,6 +3007,20 @@ export enum AttentionReason {
    * @generated from enum value: ATTENTION_REASON_TESTS_FAILING = 10;
    */
   TESTS_FAILING = 10,
+
+  /**
+   * CI checks are failing on the session's pull request.
+   *
+   * @generated from enum value: ATTENTION_REASON_CI_FAILING = 11;
+   */
+  CI_FAILING = 11,
+
+  /**
+   * The session's cgroup hit a memory or PID limit, or the OOM killer fired.
+   *
+   * @generated from enum value: ATTENTION_REASON_RESOURCE_LIMIT = 12;
+   */
+  RESOURCE_LIMIT = 12,
 }
 
 /**
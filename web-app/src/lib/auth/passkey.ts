@@ -16,7 +16,7 @@ export interface AuthStatus {
 }
 
 /** Returns the /auth base URL using the current origin. */
-function authBase(): string {
+export function authBase(): string {
   if (typeof window !== "undefined") {
     return window.location.origin + "/auth";
   }
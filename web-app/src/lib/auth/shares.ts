@@ -0,0 +1,104 @@
+/**
+ * Session share-link client utilities.
+ * Share links let someone without a passkey watch one session's terminal
+ * over the remote-access server, until the link expires or is revoked.
+ */
+
+import { authBase } from "./passkey";
+
+export interface ShareViewer {
+  id: string;
+  addr: string;
+  joined_at: string;
+}
+
+export interface ShareLink {
+  id: string;
+  session_id: string;
+  label: string;
+  allow_input: boolean;
+  max_viewers: number;
+  created_at: string;
+  expires_at: string;
+  viewers: ShareViewer[];
+}
+
+export interface CreateShareRequest {
+  session_id: string;
+  label?: string;
+  allow_input?: boolean;
+  ttl_seconds?: number;
+  max_viewers?: number;
+}
+
+export interface CreateShareResponse {
+  share: ShareLink;
+  token: string;
+  url: string;
+}
+
+export interface RedeemedShare {
+  session_id: string;
+  label: string;
+  allow_input: boolean;
+  expires_at: string;
+}
+
+/** Thrown when share links are unavailable (remote access not enabled). */
+export class SharesUnavailableError extends Error {}
+
+async function check(resp: Response, what: string): Promise<Response> {
+  if (resp.status === 404 || resp.status === 503) {
+    throw new SharesUnavailableError(`${what}: share links are not available`);
+  }
+  if (!resp.ok) {
+    const text = await resp.text();
+    throw new Error(`${what} failed: ${text}`);
+  }
+  return resp;
+}
+
+/** Create a share link for a session. The token is only returned now. */
+export async function createShare(req: CreateShareRequest): Promise<CreateShareResponse> {
+  const resp = await fetch(`${authBase()}/shares`, {
+    method: "POST",
+    credentials: "include",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify(req),
+  });
+  return (await check(resp, "create share link")).json();
+}
+
+/** List a session's live share links and who is watching through them. */
+export async function listShares(sessionId: string): Promise<ShareLink[]> {
+  const resp = await fetch(
+    `${authBase()}/shares?session_id=${encodeURIComponent(sessionId)}`,
+    { credentials: "include" }
+  );
+  const data = await (await check(resp, "list share links")).json();
+  return data.shares ?? [];
+}
+
+/** Revoke a share link, disconnecting its viewers. */
+export async function revokeShare(id: string): Promise<void> {
+  const resp = await fetch(`${authBase()}/shares/${encodeURIComponent(id)}/revoke`, {
+    method: "POST",
+    credentials: "include",
+  });
+  if (resp.status === 404) return; // already expired or revoked
+  await check(resp, "revoke share link");
+}
+
+/** Exchange a share-link token for the stream cookie; returns the shared session. */
+export async function redeemShare(token: string): Promise<RedeemedShare> {
+  const resp = await fetch(`${authBase()}/share/redeem`, {
+    method: "POST",
+    credentials: "include",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify({ token }),
+  });
+  if (resp.status === 404) {
+    throw new Error("This share link has expired or been revoked.");
+  }
+  return (await check(resp, "open share link")).json();
+}
@@ -0,0 +1,81 @@
+// zstd-codec is an emscripten build of the reference zstd library. It is the
+// only browser decoder that supports dictionaries, which the "frames"
+// streaming mode trains per session. Loaded on first use to keep it out of the
+// main bundle.
+interface ZstdBinding {
+  Simple: new () => {
+    decompress: (data: Uint8Array) => Uint8Array | null;
+    decompressUsingDict: (data: Uint8Array, ddict: ZstdDecompressionDict) => Uint8Array | null;
+  };
+  Dict: {
+    Decompression: new (dict: Uint8Array) => ZstdDecompressionDict;
+  };
+}
+
+interface ZstdDecompressionDict {
+  close: () => void;
+}
+
+let bindingPromise: Promise<ZstdBinding> | null = null;
+
+function loadZstd(): Promise<ZstdBinding> {
+  if (!bindingPromise) {
+    bindingPromise = import('zstd-codec').then(
+      ({ ZstdCodec }) => new Promise<ZstdBinding>((resolve) => ZstdCodec.run(resolve))
+    );
+    // Allow a retry if the module failed to load.
+    bindingPromise.catch(() => { bindingPromise = null; });
+  }
+  return bindingPromise;
+}
+
+/**
+ * Decodes zstd frames for one frame stream, tracking the dictionaries the
+ * server announces. Frames name the dictionary they were compressed with
+ * (0 for none), so a frame is decoded correctly even if it was encoded just
+ * before a new dictionary was announced.
+ */
+export class ZstdFrameDecoder {
+  private dicts = new Map<number, ZstdDecompressionDict>();
+  private pending = new Map<number, Uint8Array>();
+
+  /** Registers a dictionary announced by the server. */
+  addDictionary(id: number, data: Uint8Array): void {
+    this.pending.set(id, data);
+  }
+
+  /** Decompresses a frame compressed with dictionaryId (0 for none). */
+  async decompress(data: Uint8Array, dictionaryId: number): Promise<Uint8Array> {
+    const zstd = await loadZstd();
+    const simple = new zstd.Simple();
+
+    let result: Uint8Array | null;
+    if (dictionaryId === 0) {
+      result = simple.decompress(data);
+    } else {
+      let ddict = this.dicts.get(dictionaryId);
+      const raw = this.pending.get(dictionaryId);
+      if (!ddict && raw) {
+        ddict = new zstd.Dict.Decompression(raw);
+        this.dicts.set(dictionaryId, ddict);
+        this.pending.delete(dictionaryId);
+      }
+      if (!ddict) {
+        throw new Error(`zstd frame uses unknown dictionary ${dictionaryId}`);
+      }
+      result = simple.decompressUsingDict(data, ddict);
+    }
+
+    if (!result) {
+      throw new Error('zstd decompression failed');
+    }
+    return result;
+  }
+
+  /** Releases every dictionary. */
+  reset(): void {
+    this.dicts.forEach((d) => d.close());
+    this.dicts.clear();
+    this.pending.clear();
+  }
+}
@@ -36,6 +36,7 @@ export function createAuthInterceptor(): Interceptor {
       if (
         typeof window !== 'undefined' &&
         !window.location.pathname.startsWith('/login') &&
+        !window.location.pathname.startsWith('/share') &&
         err instanceof ConnectError &&
         err.code === Code.Unauthenticated
       ) {
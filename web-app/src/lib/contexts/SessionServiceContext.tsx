@@ -37,6 +37,7 @@ interface SessionServiceContextValue {
   listCheckpoints: (sessionId: string) => Promise<CheckpointProto[]>;
   forkSession: (sessionId: string, checkpointId: string, newTitle: string) => Promise<Session | null>;
   runOneShot: (sessionId: string, prompt: string, timeoutSeconds?: number) => Promise<RunOneShotResponse | null>;
+  sendCIFixPrompt: (sessionId: string) => Promise<boolean>;
   listPromptHistory: (limit?: number) => Promise<PromptHistoryEntry[]>;
   watchSessions: (options?: { categoryFilter?: string; statusFilter?: SessionStatus }) => void;
   stopWatching: () => void;
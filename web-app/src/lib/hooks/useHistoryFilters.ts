@@ -19,6 +19,7 @@ export enum HistoryGroupingStrategy {
   Date = "date",
   Project = "project",
   Model = "model",
+  Agent = "agent",
 }
 
 export const GroupingStrategyLabels: Record<HistoryGroupingStrategy, string> = {
@@ -26,12 +27,26 @@ export const GroupingStrategyLabels: Record<HistoryGroupingStrategy, string> = {
   [HistoryGroupingStrategy.Date]: "Date",
   [HistoryGroupingStrategy.Project]: "Project",
   [HistoryGroupingStrategy.Model]: "Model",
+  [HistoryGroupingStrategy.Agent]: "Agent",
 };
 
+// Display names for ClaudeHistoryEntry.provider. Entries from servers that
+// predate multi-agent history have no provider and are Claude conversations.
+export const AgentLabels: Record<string, string> = {
+  claude: "Claude",
+  codex: "Codex",
+  gemini: "Gemini",
+};
+
+export const agentOf = (entry: ClaudeHistoryEntry): string => entry.provider || "claude";
+
+export const agentLabel = (agent: string): string => AgentLabels[agent] ?? agent;
+
 // Local storage keys
 const STORAGE_KEYS = {
   SEARCH_QUERY: 'claude-history-search-query',
   SELECTED_MODEL: 'claude-history-selected-model',
+  SELECTED_AGENT: 'claude-history-selected-agent',
   DATE_FILTER: 'claude-history-date-filter',
   SORT_FIELD: 'claude-history-sort-field',
   SORT_ORDER: 'claude-history-sort-order',
@@ -69,6 +84,7 @@ const saveToStorage = <T,>(key: string, value: T): void => {
 export interface HistoryFilterState {
   searchQuery: string;
   selectedModel: string;
+  selectedAgent: string;
   dateFilter: DateFilter;
   sortField: SortField;
   sortOrder: SortOrder;
@@ -80,6 +96,7 @@ export interface HistoryFilterState {
 export interface HistoryFilterSetters {
   setSearchQuery: (value: string) => void;
   setSelectedModel: (value: string) => void;
+  setSelectedAgent: (value: string) => void;
   setDateFilter: (value: DateFilter) => void;
   setSortField: (value: SortField) => void;
   setSortOrder: (value: SortOrder) => void;
@@ -89,6 +106,7 @@ export interface HistoryFilterSetters {
 
 export interface HistoryFilterDerived {
   uniqueModels: string[];
+  uniqueAgents: string[];
   filteredEntries: ClaudeHistoryEntry[];
   hasActiveFilters: boolean;
 }
@@ -113,6 +131,7 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
   // Filter state (persisted) - use defaults initially to avoid hydration mismatch
   const [searchQuery, setSearchQuery] = useState("");
   const [selectedModel, setSelectedModel] = useState<string>("all");
+  const [selectedAgent, setSelectedAgent] = useState<string>("all");
   const [dateFilter, setDateFilter] = useState<DateFilter>("all");
   const [sortField, setSortField] = useState<SortField>("updated");
   const [sortOrder, setSortOrder] = useState<SortOrder>("desc");
@@ -126,6 +145,7 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
   useEffect(() => {
     setSearchQuery(loadFromStorage(STORAGE_KEYS.SEARCH_QUERY, ""));
     setSelectedModel(loadFromStorage(STORAGE_KEYS.SELECTED_MODEL, "all"));
+    setSelectedAgent(loadFromStorage(STORAGE_KEYS.SELECTED_AGENT, "all"));
     setDateFilter(loadFromStorage(STORAGE_KEYS.DATE_FILTER, "all"));
     setSortField(loadFromStorage(STORAGE_KEYS.SORT_FIELD, "updated"));
     setSortOrder(loadFromStorage(STORAGE_KEYS.SORT_ORDER, "desc"));
@@ -137,6 +157,7 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
   // Persist filter preferences
   useEffect(() => { saveToStorage(STORAGE_KEYS.SEARCH_QUERY, searchQuery); }, [searchQuery]);
   useEffect(() => { saveToStorage(STORAGE_KEYS.SELECTED_MODEL, selectedModel); }, [selectedModel]);
+  useEffect(() => { saveToStorage(STORAGE_KEYS.SELECTED_AGENT, selectedAgent); }, [selectedAgent]);
   useEffect(() => { saveToStorage(STORAGE_KEYS.DATE_FILTER, dateFilter); }, [dateFilter]);
   useEffect(() => { saveToStorage(STORAGE_KEYS.SORT_FIELD, sortField); }, [sortField]);
   useEffect(() => { saveToStorage(STORAGE_KEYS.SORT_ORDER, sortOrder); }, [sortOrder]);
@@ -152,6 +173,13 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
     return Array.from(modelSet).sort();
   }, [entries]);
 
+  // Extract agents present in history for the agent filter
+  const uniqueAgents = useMemo(() => {
+    const agentSet = new Set<string>();
+    entries.forEach(entry => agentSet.add(agentOf(entry)));
+    return Array.from(agentSet).sort();
+  }, [entries]);
+
   // Filter and sort entries
   const filteredEntries = useMemo(() => {
     let result = entries.filter(entry => {
@@ -159,6 +187,10 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
       if (selectedModel !== "all" && entry.model !== selectedModel) {
         return false;
       }
+      // Agent filter
+      if (selectedAgent !== "all" && agentOf(entry) !== selectedAgent) {
+        return false;
+      }
       // Date filter
       if (!isWithinDateFilter(entry.updatedAt, dateFilter)) {
         return false;
@@ -196,15 +228,16 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
     });
 
     return result;
-  }, [entries, selectedModel, dateFilter, searchQuery, sortField, sortOrder]);
+  }, [entries, selectedModel, selectedAgent, dateFilter, searchQuery, sortField, sortOrder]);
 
   // Check if any filters are active
-  const hasActiveFilters = !!(searchQuery || selectedModel !== "all" || dateFilter !== "all");
+  const hasActiveFilters = !!(searchQuery || selectedModel !== "all" || selectedAgent !== "all" || dateFilter !== "all");
 
   // Actions
   const clearFilters = useCallback(() => {
     setSearchQuery("");
     setSelectedModel("all");
+    setSelectedAgent("all");
     setDateFilter("all");
   }, []);
 
@@ -219,6 +252,7 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
     filterState: {
       searchQuery,
       selectedModel,
+      selectedAgent,
       dateFilter,
       sortField,
       sortOrder,
@@ -229,6 +263,7 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
     setters: {
       setSearchQuery,
       setSelectedModel,
+      setSelectedAgent,
       setDateFilter,
       setSortField,
       setSortOrder,
@@ -237,6 +272,7 @@ export function useHistoryFilters(entries: ClaudeHistoryEntry[]): UseHistoryFilt
     },
     derived: {
       uniqueModels,
+      uniqueAgents,
       filteredEntries,
       hasActiveFilters,
     },
@@ -49,6 +49,8 @@ export interface SearchResultItem {
     isMetadataMatch: boolean;
     matchSource: string;
     model: string;
+    /** Agent CLI that recorded the conversation ("claude", "codex", "gemini") */
+    provider: string;
     createdAt: Date | null;
   };
 }
@@ -73,6 +75,8 @@ export interface SearchState {
   queryTimeMs: number;
   /** Whether more results are available */
   hasMore: boolean;
+  /** True when no message matched every word, so results match any of them */
+  relaxed: boolean;
   /** Loading state */
   loading: boolean;
   /** Error state */
@@ -102,6 +106,7 @@ export function useHistoryFullTextSearch(
     totalMatches: 0,
     queryTimeMs: 0,
     hasMore: false,
+    relaxed: false,
     loading: false,
     error: null,
   });
@@ -138,6 +143,7 @@ export function useHistoryFullTextSearch(
         isMetadataMatch: result.metadata?.isMetadataMatch ?? false,
         matchSource: result.metadata?.matchSource ?? "",
         model: result.metadata?.model ?? "",
+        provider: result.metadata?.provider || "claude",
         createdAt: result.metadata?.createdAt ? timestampDate(result.metadata.createdAt) : null,
       },
     };
@@ -188,6 +194,7 @@ export function useHistoryFullTextSearch(
           totalMatches: response.totalMatches,
           queryTimeMs: Number(response.queryTimeMs),
           hasMore: response.hasMore,
+          relaxed: response.relaxed,
           loading: false,
           error: null,
         }));
@@ -244,6 +251,7 @@ export function useHistoryFullTextSearch(
       totalMatches: 0,
       queryTimeMs: 0,
       hasMore: false,
+      relaxed: false,
       loading: false,
       error: null,
     });
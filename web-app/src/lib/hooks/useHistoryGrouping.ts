@@ -3,7 +3,7 @@
 import { useMemo } from "react";
 import { ClaudeHistoryEntry } from "@/gen/session/v1/session_pb";
 import { getDateGroup } from "@/lib/utils/timestamp";
-import { HistoryGroupingStrategy } from "@/lib/hooks/useHistoryFilters";
+import { HistoryGroupingStrategy, agentLabel, agentOf } from "@/lib/hooks/useHistoryFilters";
 
 // ============================================================================
 // Types
@@ -43,6 +43,9 @@ export function useHistoryGrouping(
         case HistoryGroupingStrategy.Model:
           groupKey = entry.model || "Unknown Model";
           break;
+        case HistoryGroupingStrategy.Agent:
+          groupKey = agentLabel(agentOf(entry));
+          break;
         default:
           groupKey = "all";
       }
@@ -33,6 +33,8 @@ const TIER1_REASONS = new Set([
 const TIER2_REASONS = new Set([
   AttentionReason.ERROR_STATE,
   AttentionReason.TESTS_FAILING,
+  AttentionReason.CI_FAILING,
+  AttentionReason.RESOURCE_LIMIT,
   AttentionReason.STALE,
 ]);
 
@@ -105,7 +107,7 @@ interface UseReviewQueueNotificationsOptions {
  * Tier 1 (APPROVAL_PENDING, INPUT_REQUIRED, WAITING_FOR_USER):
  *   - Persistent toast (no auto-close) + browser notification with OS sound + history
  *
- * Tier 2 (ERROR_STATE, TESTS_FAILING, STALE):
+ * Tier 2 (ERROR_STATE, TESTS_FAILING, CI_FAILING, RESOURCE_LIMIT, STALE):
  *   - Brief toast (auto-minimizes) + history only (no browser notification unless tab hidden)
  *
  * Tier 3 (TASK_COMPLETE, IDLE, UNCOMMITTED_CHANGES, etc.):
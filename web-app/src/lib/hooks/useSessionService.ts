@@ -64,6 +64,7 @@ interface UseSessionServiceReturn {
   updateSession: (id: string, updates: Partial<UpdateSessionRequest>) => Promise<Session | null>;
   deleteSession: (id: string, force?: boolean) => Promise<boolean>;
   runOneShot: (sessionId: string, prompt: string, timeoutSeconds?: number) => Promise<RunOneShotResponse | null>;
+  sendCIFixPrompt: (sessionId: string) => Promise<boolean>;
   listPromptHistory: (limit?: number) => Promise<PromptHistoryEntry[]>;
   pauseSession: (id: string) => Promise<Session | null>;
   resumeSession: (id: string, updates?: { title?: string; tags?: string[] }) => Promise<Session | null>;
@@ -228,6 +229,8 @@ export function useSessionService(
           tags: updates.tags ?? [],
           workingDir: updates.workingDir,
           rateLimitEnabled: updates.rateLimitEnabled,
+          prFeedbackEnabled: updates.prFeedbackEnabled,
+          ciAutofixEnabled: updates.ciAutofixEnabled,
         });
 
         // Update in store
@@ -452,6 +455,24 @@ export function useSessionService(
     [dispatch]
   );
 
+  // Send the prepared "CI failed, here is the log" prompt to a session's agent
+  const sendCIFixPrompt = useCallback(
+    async (sessionId: string): Promise<boolean> => {
+      if (!clientRef.current) return false;
+
+      dispatch(setError(null));
+
+      try {
+        await clientRef.current.sendCIFixPrompt({ id: sessionId });
+        return true;
+      } catch (err) {
+        dispatch(setError(err instanceof Error ? err.message : "Failed to send CI fix prompt"));
+        return false;
+      }
+    },
+    [dispatch]
+  );
+
   // List prompt history entries (S1)
   const listPromptHistory = useCallback(
     async (limit?: number): Promise<PromptHistoryEntry[]> => {
@@ -669,6 +690,7 @@ export function useSessionService(
     listCheckpoints,
     forkSession,
     runOneShot,
+    sendCIFixPrompt,
     listPromptHistory,
     watchSessions,
     stopWatching,
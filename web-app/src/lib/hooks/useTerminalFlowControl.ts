@@ -6,10 +6,11 @@ import { create } from "@bufbuild/protobuf";
 import { StateApplicator } from "@/lib/terminal/StateApplicator";
 import { EchoOverlay } from "@/lib/terminal/EchoOverlay";
 import type { Terminal } from '@xterm/xterm';
+import type { StreamingMode } from "./useTerminalStream";
 
 export interface UseTerminalFlowControlOptions {
   sessionId: string;
-  streamingMode: "raw" | "raw-compressed" | "state" | "hybrid" | "ssp";
+  streamingMode: StreamingMode;
   enablePredictiveEcho: boolean;
   getTerminal: () => Terminal | null;
   /** Push a message onto the connection queue. Stored via ref to avoid stale closures. */
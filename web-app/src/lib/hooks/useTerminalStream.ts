@@ -2,13 +2,14 @@
 
 import { createClient } from "@connectrpc/connect";
 import { SessionService } from "@/gen/session/v1/session_pb";
-import { TerminalData, TerminalDataSchema, CurrentPaneRequest, CurrentPaneRequestSchema } from "@/gen/session/v1/events_pb";
+import { TerminalData, TerminalDataSchema, CurrentPaneRequest, CurrentPaneRequestSchema, FrameCursorSchema, InputControlSchema, InputControlState, PresenceUpdateSchema } from "@/gen/session/v1/events_pb";
 import { create } from "@bufbuild/protobuf";
 import { createWebsocketBasedTransport } from "@/lib/transport/websocket-transport";
 import { createAuthInterceptor } from "@/lib/config";
 import { useEffect, useRef, useState, useCallback } from "react";
 import { MessageQueue } from "@/lib/terminal/MessageQueue";
 import { decompressLZMA, isLZMACompressed } from "@/lib/compression/lzma";
+import { ZstdFrameDecoder } from "@/lib/compression/zstd";
 import { useTerminalFlowControl } from "./useTerminalFlowControl";
 import { useTerminalMetrics } from "./useTerminalMetrics";
 import type { Terminal } from '@xterm/xterm';
@@ -32,6 +33,15 @@ export type TerminalState =
   | 'RESIZING'
   | 'FETCHING_SCROLLBACK';
 
+/** Terminal streaming modes understood by the server. */
+export type StreamingMode = "raw" | "raw-compressed" | "state" | "hybrid" | "ssp" | "frames";
+
+/** Input arbitration modes understood by the server. */
+export type InputMode = "shared" | "single_writer" | "driver";
+
+/** Input control actions a client can send. */
+export type InputControlAction = "set_mode" | "take" | "request" | "grant" | "deny" | "release";
+
 interface UseTerminalStreamOptions {
   baseUrl: string;
   sessionId: string;
@@ -43,7 +53,7 @@ interface UseTerminalStreamOptions {
   autoConnect?: boolean; // If false, requires manual connect() call (default: true)
   initialCols?: number; // Initial terminal columns (prevents size mismatch on first load)
   initialRows?: number; // Initial terminal rows (prevents size mismatch on first load)
-  streamingMode?: "raw" | "raw-compressed" | "state" | "hybrid" | "ssp"; // Terminal streaming mode (default: "raw")
+  streamingMode?: StreamingMode; // Terminal streaming mode (default: "raw")
   isExternal?: boolean; // Whether this is an external session (uses /ws/external endpoint)
   enablePredictiveEcho?: boolean; // Enable Mosh-style predictive echo (default: false)
   onEchoAck?: (echoNum: bigint, latencyMs: number) => void; // Callback when echo is acknowledged (for RTT stats)
@@ -67,6 +77,10 @@ interface TerminalStreamResult {
   stopRecording: () => void; // Stop recording and download recorded messages
   /** Terminal state machine (R1.4) — typed lifecycle state driven by server messages. */
   terminalState: TerminalState;
+  /** Who may type and who else is connected; null until the server reports it. */
+  inputControl: InputControlState | null;
+  sendInputControl: (action: InputControlAction, mode?: InputMode, targetClientId?: string) => void;
+  sendPresence: (focused: boolean, cursorRow?: number, cursorCol?: number, label?: string) => void;
 }
 
 export function useTerminalStream({
@@ -90,6 +104,7 @@ export function useTerminalStream({
   const [scrollbackLoaded, setScrollbackLoaded] = useState(false);
   // Task 4.1.1 — Terminal state machine (R1.4)
   const [terminalState, setTerminalState] = useState<TerminalState>('DISCONNECTED');
+  const [inputControl, setInputControl] = useState<InputControlState | null>(null);
 
   const messageQueueRef = useRef<MessageQueue | null>(null);
   const abortControllerRef = useRef<AbortController | null>(null);
@@ -97,6 +112,17 @@ export function useTerminalStream({
   const isConnectedRef = useRef(false);
   const textDecoderRef = useRef(new TextDecoder());
 
+  // "frames" mode: the last frame applied, sent on reconnect so the server
+  // replays only what was missed instead of redrawing the screen.
+  const frameCursorRef = useRef<{ streamId: string; sequence: bigint } | null>(null);
+  const frameDecoderRef = useRef(new ZstdFrameDecoder());
+
+  // A cursor only makes sense for the session it came from.
+  useEffect(() => {
+    frameCursorRef.current = null;
+    frameDecoderRef.current.reset();
+  }, [sessionId]);
+
   const clientRef = useRef(createClient(
     SessionService,
     createWebsocketBasedTransport({
@@ -175,6 +201,11 @@ export function useTerminalStream({
         streamingMode: streamingMode,
       });
 
+      if (streamingMode === "frames" && frameCursorRef.current) {
+        currentPaneReq.resumeCursor = create(FrameCursorSchema, frameCursorRef.current);
+        console.log(`[useTerminalStream] Resuming frame stream from sequence ${frameCursorRef.current.sequence}`);
+      }
+
       if (targetCols !== undefined && targetRows !== undefined) {
         currentPaneReq.targetCols = targetCols;
         currentPaneReq.targetRows = targetRows;
@@ -197,6 +228,32 @@ export function useTerminalStream({
 
       setError(null);
 
+      // writeOutput hands decoded terminal output to the terminal.
+      const writeOutput = (decodedData: Uint8Array) => {
+        const text = textDecoderRef.current.decode(decodedData, { stream: true });
+
+        // Record message if recording is active
+        metrics.recordMessage({
+          timestamp: Date.now(),
+          type: 'raw',
+          data: decodedData,
+          decoded: text,
+        });
+
+        if (typeof window !== "undefined" && localStorage.getItem("debug-terminal") === "true") {
+          console.debug(`[useTerminalStream] Received output: ${text.length} bytes`);
+        }
+
+        // Use callback if provided, otherwise batch via RAF
+        if (onOutput) {
+          onOutput(text);
+        } else {
+          metrics.scheduleOutputUpdate(text);
+        }
+        // First raw output → terminal is stable (not resizing)
+        setTerminalState((prev) => prev === 'LOADING' || prev === 'CONNECTING' ? 'STABLE' : prev);
+      };
+
       // Message processing loop
       (async () => {
         try {
@@ -221,6 +278,11 @@ export function useTerminalStream({
               continue; // No further processing for quiescence messages
             }
 
+            if (msg.data.case === "inputControlState") {
+              setInputControl(msg.data.value);
+              continue;
+            }
+
             // Dispatch to sub-hooks based on message type
             if (msg.data.case === "state") {
               flowControl.handleStateMessage(msg.data.value);
@@ -247,28 +309,38 @@ export function useTerminalStream({
                 decodedData = rawData;
               }
 
-              const text = textDecoderRef.current.decode(decodedData, { stream: true });
-
-              // Record message if recording is active
-              metrics.recordMessage({
-                timestamp: Date.now(),
-                type: 'raw',
-                data: decodedData,
-                decoded: text,
-              });
-
-              if (typeof window !== "undefined" && localStorage.getItem("debug-terminal") === "true") {
-                console.debug(`[useTerminalStream] Received output: ${text.length} bytes`);
+              writeOutput(decodedData);
+            } else if (msg.data.case === "frameStreamStart") {
+              const start = msg.data.value;
+              if (!start.resumed) {
+                // New stream or resync: dictionaries from an earlier stream no
+                // longer apply, and the snapshot frame that follows redraws the screen.
+                frameDecoderRef.current.reset();
+                if (start.resyncReason) {
+                  console.log(`[useTerminalStream] Frame stream resynced: ${start.resyncReason}`);
+                }
               }
-
-              // Use callback if provided, otherwise batch via RAF
-              if (onOutput) {
-                onOutput(text);
-              } else {
-                metrics.scheduleOutputUpdate(text);
+              frameCursorRef.current = { streamId: start.streamId, sequence: start.sequence };
+            } else if (msg.data.case === "frameDictionary") {
+              frameDecoderRef.current.addDictionary(msg.data.value.id, msg.data.value.data);
+            } else if (msg.data.case === "frame") {
+              const frame = msg.data.value;
+              let decodedData = frame.data;
+              if (frame.compressed) {
+                try {
+                  decodedData = await frameDecoderRef.current.decompress(frame.data, frame.dictionaryId);
+                } catch (err) {
+                  // Without this frame the screen is wrong; drop the cursor so the
+                  // next connection starts with a fresh snapshot.
+                  console.error(`[useTerminalStream] zstd decompression failed for frame ${frame.sequence}:`, err);
+                  frameCursorRef.current = null;
+                  continue;
+                }
+              }
+              writeOutput(decodedData);
+              if (frameCursorRef.current) {
+                frameCursorRef.current.sequence = frame.sequence;
               }
-              // First raw output → terminal is stable (not resizing)
-              setTerminalState((prev) => prev === 'LOADING' || prev === 'CONNECTING' ? 'STABLE' : prev);
             } else if (msg.data.case === "currentPaneResponse") {
               flowControl.handleCurrentPaneResponse(msg.data.value);
 
@@ -314,6 +386,7 @@ export function useTerminalStream({
         } finally {
           setIsConnected(false);
           setTerminalState('DISCONNECTED');
+          setInputControl(null);
         }
       })();
     } catch (err) {
@@ -365,6 +438,27 @@ export function useTerminalStream({
     isDisconnectingRef.current = false;
   }, [getIsResyncingRef]);
 
+  // ---- Input control ----
+  const sendInputControl = useCallback((action: InputControlAction, mode?: InputMode, targetClientId?: string) => {
+    messageQueueRef.current?.push(create(TerminalDataSchema, {
+      sessionId,
+      data: {
+        case: "inputControl",
+        value: create(InputControlSchema, { action, mode: mode ?? "", targetClientId: targetClientId ?? "" }),
+      },
+    }));
+  }, [sessionId]);
+
+  const sendPresence = useCallback((focused: boolean, cursorRow = 0, cursorCol = 0, label = "") => {
+    messageQueueRef.current?.push(create(TerminalDataSchema, {
+      sessionId,
+      data: {
+        case: "presenceUpdate",
+        value: create(PresenceUpdateSchema, { label, focused, cursorRow, cursorCol }),
+      },
+    }));
+  }, [sessionId]);
+
   // ---- Auto-connect / cleanup ----
   useEffect(() => {
     if (autoConnect) {
@@ -394,5 +488,8 @@ export function useTerminalStream({
     startRecording: metrics.startRecording,
     stopRecording: metrics.stopRecording,
     terminalState,
+    inputControl,
+    sendInputControl,
+    sendPresence,
   };
 }
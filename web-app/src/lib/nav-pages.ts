@@ -13,6 +13,7 @@ import {
   HelpCircle,
   BarChart2,
   LayoutList,
+  FileText,
 } from "lucide-react";
 import { routes } from "./routes";
 
@@ -40,6 +41,7 @@ export const NAV_PAGES: NavPage[] = [
   // Secondary — hamburger / More-sheet only
   { href: routes.insights, label: "Insights", icon: BarChart2, mobileNav: false, headerNav: false },
   { href: routes.rules,   label: "Rules",   icon: BookOpen,          headerNav: false },
+  { href: routes.templates, label: "Templates", icon: FileText,     headerNav: false },
   { href: routes.history, label: "History", icon: History,           headerNav: false },
   { href: routes.settings + "?tab=config-files", label: "Config Files", icon: SlidersHorizontal, headerNav: false },
   { href: routes.settingsFeatures, label: "Features", icon: Settings, headerNav: false },
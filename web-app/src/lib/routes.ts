@@ -8,6 +8,7 @@ export const routes = {
   reviewQueue: "/review-queue",
   unfinished: "/unfinished",
   rules: "/rules",
+  templates: "/templates",
   history: "/history",
   logs: "/logs",
   errors: "/errors",
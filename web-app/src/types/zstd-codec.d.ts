@@ -0,0 +1,9 @@
+// Type declarations for zstd-codec
+// The library ships without types; src/lib/compression/zstd.ts describes the
+// parts of the binding it uses.
+
+declare module 'zstd-codec' {
+  export const ZstdCodec: {
+    run: (callback: (binding: any) => void) => void;
+  };
+}